@@ -0,0 +1,80 @@
+// backend/cmd/export_user/main.go
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"word_app/backend/config"
+	"word_app/backend/database"
+	"word_app/backend/logger"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure"
+	exportRepo "word_app/backend/src/infrastructure/repository/export"
+	"word_app/backend/src/usecase/export"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ユーザーの登録単語とクイズ履歴を書き出す（データポータビリティの依頼対応用）。
+// API の GET /export と同じ内容・形式になる。
+func main() {
+	var (
+		userID int
+		format string
+		out    string
+	)
+	flag.IntVar(&userID, "user", 0, "user id to export (required)")
+	flag.StringVar(&format, "format", domain.ExportFormatJSON, "csv (zip), json or anki (tsv)")
+	flag.StringVar(&out, "out", "", "output file (default stdout)")
+	flag.Parse()
+
+	if userID <= 0 {
+		log.Fatal("-user is required")
+	}
+	if !domain.IsValidExportFormat(format) {
+		log.Fatalf("unsupported format %q (csv, json, anki)", format)
+	}
+
+	config.LoadEnv()
+	logger.InitLogger()
+	if err := database.InitEntClient(); err != nil {
+		logrus.Error(err)
+	}
+	cli := database.GetEntClient()
+	defer func() {
+		if err := cli.Close(); err != nil {
+			logrus.Fatalf("failed to close ent client: %v", err)
+		}
+	}()
+
+	var w io.Writer = os.Stdout
+	if out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			log.Fatalf("failed to create %s: %v", out, err)
+		}
+		defer func() {
+			if err := f.Close(); err != nil {
+				log.Printf("failed to close %s: %v", out, err)
+			}
+		}()
+		w = f
+	}
+	bw := bufio.NewWriter(w)
+
+	uc := export.NewUsecase(exportRepo.NewEntExportRepo(infrastructure.NewAppClient(cli)))
+	if err := uc.Export(context.Background(), export.Input{UserID: userID, Format: format}, bw); err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+	if err := bw.Flush(); err != nil {
+		log.Fatalf("export failed: %v", err)
+	}
+	if out != "" {
+		log.Printf("exported user %d as %s to %s", userID, format, out)
+	}
+}
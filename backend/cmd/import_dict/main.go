@@ -17,23 +17,48 @@ import (
 )
 
 func main() {
-	log.Println("JMdict import start")
+	log.Println("dictionary import start")
 	// -------- CLI フラグ --------
 	var (
 		file      string
 		workers   int
 		batchSize int
+		progress  time.Duration
+		resume    bool
+		retry     bool
+		interval  time.Duration
+		format    string
+		dryRun    bool
 	)
-	flag.StringVar(&file, "file", "jmdict.json", "path to JMdict JSON (unzipped)")
+	flag.StringVar(&file, "file", "jmdict.json", "path to the dictionary file (JMdict JSON must be unzipped)")
+	flag.StringVar(&format, "format", dictimport.FormatJMdict, "jmdict, csv, tsv, anki (.apkg / .txt) or edict")
+	flag.BoolVar(&dryRun, "dry-run", false, "print the diff against the current words without writing")
 	flag.IntVar(&workers, "workers", 4, "concurrent workers")
-	flag.IntVar(&batchSize, "batch", 500, "bulk insert chunk size")
+	flag.IntVar(&batchSize, "batch", 500, "glosses written per transaction")
+	flag.DurationVar(&progress, "progress", 5*time.Second, "progress report interval (0 to disable)")
+	flag.BoolVar(&resume, "resume", false, "resume the unfinished run of the same file from its checkpoint")
+	flag.BoolVar(&retry, "retry-failed", false, "re-import only the entries that failed in the latest run of the same file")
+	flag.DurationVar(&interval, "checkpoint", 5*time.Second, "checkpoint save interval")
 	flag.Parse()
+	if resume && retry {
+		log.Fatal("-resume and -retry-failed cannot be used together")
+	}
+	src, err := dictimport.SourceFor(format)
+	if err != nil {
+		log.Fatal(err)
+	}
+	mode := dictimport.ModeFresh
+	switch {
+	case resume:
+		mode = dictimport.ModeResume
+	case retry:
+		mode = dictimport.ModeRetryFailed
+	}
 
 	// -------- 共通初期化 --------
 	config.LoadEnv()    // .env 読み込み
 	logger.InitLogger() // logrus 設定
-	err := database.InitEntClient()
-	if err != nil {
+	if err := database.InitEntClient(); err != nil {
 		logrus.Error(err)
 	}
 	cli := database.GetEntClient()
@@ -43,32 +68,51 @@ func main() {
 		}
 	}()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// -------- ドライラン（DB には書き込まない） --------
+	if dryRun {
+		sum, err := dictimport.DryRun(ctx, file, cli, src, os.Stdout)
+		if err != nil {
+			log.Fatalf("dry run failed: %v", err)
+		}
+		log.Printf("dry run finished. %s", sum)
+		return
+	}
+
 	// スキーマを作成（存在すれば no‑op）
-	if err := cli.Schema.Create(context.Background()); err != nil {
+	if err := cli.Schema.Create(ctx); err != nil {
 		log.Fatalf("schema create failed: %v", err)
 	}
 
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
-
 	start := time.Now()
-	log.Printf("JMdict import start (file=%s)", file)
+	log.Printf("dictionary import start (file=%s, format=%s)", file, src.Format())
 
 	// -------- インポート実行 --------
 	opts := dictimport.Options{
-		Workers:   workers,
-		BatchSize: batchSize,
+		Workers:            workers,
+		BatchSize:          batchSize,
+		ProgressInterval:   progress,
+		OnProgress:         func(p dictimport.Progress) { log.Println(p) },
+		Source:             src,
+		Mode:               mode,
+		CheckpointInterval: interval,
 	}
 
-	errs, fatal := dictimport.ImportJMdict(ctx, file, cli, opts)
+	res, fatal := dictimport.Import(ctx, file, cli, opts)
 	if fatal != nil {
+		if res != nil {
+			log.Fatalf("import failed (run=%d, rerun with -resume to continue): %v", res.RunID, fatal)
+		}
 		log.Fatalf("import failed: %v", fatal)
 	}
-	log.Printf("import finished. failures=%d\n", len(errs))
-	for _, e := range errs {
+	log.Printf("import finished. run=%d skipped=%d retried=%d failures=%d\n",
+		res.RunID, res.Skipped, res.Retried, len(res.Failures))
+	for _, e := range res.Failures {
 		log.Println(e.ID, e.Message)
 	}
 
-	log.Printf("JMdict import completed in %s", time.Since(start))
+	log.Printf("dictionary import completed in %s", time.Since(start))
 	os.Exit(0)
 }
@@ -0,0 +1,79 @@
+// backend/cmd/reclassify_pos/main.go
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+
+	"word_app/backend/config"
+	"word_app/backend/database"
+	"word_app/backend/internal/dictimport"
+	"word_app/backend/logger"
+
+	"github.com/sirupsen/logrus"
+)
+
+// 品詞コードの対応表（root が設定画面で更新したもの）を既存の WordInfo に反映する。
+// 品詞コードを保存する前に取り込んだ WordInfo は、-file に同じ辞書ファイルを渡すと品詞コードを補ってから反映する。
+func main() {
+	var (
+		batchSize int
+		dryRun    bool
+		file      string
+		format    string
+	)
+	flag.IntVar(&batchSize, "batch", 500, "word infos processed per transaction")
+	flag.BoolVar(&dryRun, "dry-run", false, "count the changes without writing")
+	flag.StringVar(&file, "file", "", "dictionary file the words were imported from; backfills missing pos codes")
+	flag.StringVar(&format, "format", dictimport.FormatJMdict, "format of -file: jmdict, csv, tsv, anki or edict")
+	flag.Parse()
+
+	var backfill *dictimport.PosCodeIndex
+	if file != "" {
+		src, err := dictimport.SourceFor(format)
+		if err != nil {
+			log.Fatal(err)
+		}
+		f, err := os.Open(file)
+		if err != nil {
+			log.Fatalf("open %s: %v", file, err)
+		}
+		backfill, err = dictimport.LegacyPosCodes(context.Background(), f, src)
+		_ = f.Close()
+		if err != nil {
+			log.Fatalf("read %s: %v", file, err)
+		}
+		log.Printf("loaded pos codes for %d word/pos pairs from %s", backfill.Len(), file)
+	}
+
+	config.LoadEnv()
+	logger.InitLogger()
+	if err := database.InitEntClient(); err != nil {
+		logrus.Error(err)
+	}
+	cli := database.GetEntClient()
+	defer func() {
+		if err := cli.Close(); err != nil {
+			logrus.Fatalf("failed to close ent client: %v", err)
+		}
+	}()
+
+	res, err := dictimport.Reclassify(context.Background(), cli, dictimport.ReclassifyOptions{
+		BatchSize: batchSize,
+		DryRun:    dryRun,
+		Backfill:  backfill,
+	})
+	if err != nil {
+		if res != nil {
+			log.Printf("partial result: %s", res)
+		}
+		log.Fatalf("reclassify failed: %v", err)
+	}
+	if dryRun {
+		log.Printf("dry run finished. %s", res)
+		return
+	}
+	log.Printf("reclassify finished. %s", res)
+}
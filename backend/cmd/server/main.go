@@ -523,7 +523,8 @@ func setupRouter(client interfaces.ClientInterface, runner sqlexec.Runner, corsO
 
 	routerImpl := routerConfig.NewRouter(
 		middlewares.Auth, handlers.Auth, handlers.Bulk, handlers.User,
-		handlers.Setting, handlers.Word, handlers.Quiz, handlers.Result)
+		handlers.Setting, handlers.Word, handlers.Deck, handlers.Quiz, handlers.Result,
+		handlers.Export, handlers.Import, handlers.Proposal, handlers.WellKnown)
 	routerImpl.MountRoutes(router)
 
 	// テスト用エンドポイント（開発環境のみ動作）
@@ -5,7 +5,11 @@ package config
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
@@ -26,15 +30,27 @@ type AppCfg struct {
 	Port string
 }
 
-// JWTCfg defines secrets and default expiration for JWTs issued by the app.
-// Secret is the primary signing key.
-// TempSecret is intended for short-lived/temporary flows (e.g. email link).
-// ExpireHour and ExpireMinute together define the default token TTL.
+// JWTCfg defines keys and default expiration for JWTs issued by the app.
+// SigningKey is the current RS256/EdDSA private key (PEM or file path);
+// access tokens carry its kid and the public half is published as JWKS.
+// VerifyKeys are retired public keys still accepted during a rotation.
+// Secret is an HS256 fallback used only when SigningKey is empty.
+// TempSecret signs short-lived tokens (LINE sign-up, email links) and must
+// differ from the access token keys.
+// ExpireHour and ExpireMinute together define the access token TTL.
+// RefreshTTLDays is the lifetime of a refresh token; each refresh rotates it.
+// EmailVerifyTTLMinutes and PasswordResetTTLMinutes are the lifetimes of
+// the email verification and password reset links signed with TempSecret.
 type JWTCfg struct {
-	Secret       string
-	TempSecret   string
-	ExpireHour   int // 例: 1
-	ExpireMinute int // 例: 30
+	SigningKey              string
+	VerifyKeys              []string
+	Secret                  string
+	TempSecret              string
+	ExpireHour              int // 例: 0
+	ExpireMinute            int // 例: 15
+	RefreshTTLDays          int // 例: 30
+	EmailVerifyTTLMinutes   int // 例: 1440
+	PasswordResetTTLMinutes int // 例: 30
 }
 
 // DBCfg contains database connectivity settings.
@@ -52,6 +68,21 @@ type LineOAuthCfg struct {
 	RedirectURI  string
 }
 
+// MailCfg selects how outgoing mail is delivered.
+// Driver is "smtp" for real delivery, or "file"/"log" for local development
+// (file writes .eml files into FileDir, log only prints them).
+// LinkBaseURL is the frontend origin used to build links in mail bodies.
+type MailCfg struct {
+	Driver       string
+	From         string
+	SMTPHost     string
+	SMTPPort     int
+	SMTPUser     string
+	SMTPPassword string
+	FileDir      string
+	LinkBaseURL  string
+}
+
 // リミット設定
 // 登録単語数の上限など
 type LimitsCfg struct {
@@ -62,6 +93,12 @@ type LimitsCfg struct {
 	BulkMaxBytes           int // 51200 (=50KB)
 	BulkTokenizeMaxTokens  int // 200
 	BulkRegisterMaxItems   int // 200
+	QuizAbandonMinutes     int // 1440 (制限時間なしのクイズを放置とみなすまでの時間)
+	DecksPerUser           int // 50
+	DeckMaxWords           int // 500
+	VocabImportMaxBytes    int // 524288 (=512KB)
+	VocabImportMaxRows     int // 2000
+	WordProposalMaxPending int // 20
 }
 
 // Config aggregates all sub-config sections used across the application.
@@ -73,11 +110,15 @@ type Config struct {
 	Line           LineOAuthCfg
 	Lambda         LambdaCfg
 	Limits         LimitsCfg
+	Mail           MailCfg
+	OIDC           []OIDCProviderCfg
 	RateLimitTable rateLimitTable
 }
 
 type appSecret struct {
 	JWTSecret        string `json:"JWT_SECRET"`
+	JWTSigningKey    string `json:"JWT_SIGNING_KEY"`
+	TempJWTSecret    string `json:"TEMP_JWT_SECRET"`
 	LineClientID     string `json:"LINE_CLIENT_ID"`
 	LineClientSecret string `json:"LINE_CLIENT_SECRET"`
 	LineRedirectURI  string `json:"LINE_REDIRECT_URI"`
@@ -95,8 +136,8 @@ type rateLimitTable struct {
 // a Config. It terminates the process (logrus.Fatalf) if required variables
 // are missing. If you need testability without process exit, consider a
 // constructor that returns (Config, error) instead.
-// NOTE: TempSecret currently reads JWT_SECRET as well. If you intend a
-// separate key for temporary tokens, change it to read TEMP_JWT_SECRET.
+// TempSecret reads TEMP_JWT_SECRET; when unset it is derived from
+// JWT_SECRET (or from JWT_SIGNING_KEY) so that the two never share a key.
 func NewConfig() *Config {
 	// 1) 非秘匿は環境変数
 	appEnv := getenv("APP_ENV", "production")
@@ -108,13 +149,15 @@ func NewConfig() *Config {
 	// dbName := must("DB_NAME")
 	lambdaRuntime := getenv("AWS_LAMBDA_RUNTIME_API", "")
 	jwtSecret := getenv("JWT_SECRET", "")
+	jwtSigningKey := getenv("JWT_SIGNING_KEY", "")
+	tempJWTSecret := getenv("TEMP_JWT_SECRET", "")
 	lineID := getenv("LINE_CLIENT_ID", "")
 	lineSec := getenv("LINE_CLIENT_SECRET", "")
 	lineRedirect := getenv("LINE_REDIRECT_URI", "")
 
 	// 3) Secrets Manager から読み出し（存在すれば）
 	// 未設定の時だけ Secrets Manager を使いたい場合はフォールバック
-	if (jwtSecret == "" || lineID == "" || lineSec == "" || lineRedirect == "") && os.Getenv("APP_SECRET_ARN") != "" {
+	if ((jwtSecret == "" && jwtSigningKey == "") || lineID == "" || lineSec == "" || lineRedirect == "") && os.Getenv("APP_SECRET_ARN") != "" {
 		// 必須の一部がない時だけ取りに行く
 		s, err := fetchSecretJSON[appSecret](context.Background(), os.Getenv("APP_SECRET_ARN"))
 		if err != nil {
@@ -123,6 +166,12 @@ func NewConfig() *Config {
 		if jwtSecret == "" {
 			jwtSecret = s.JWTSecret
 		}
+		if jwtSigningKey == "" {
+			jwtSigningKey = s.JWTSigningKey
+		}
+		if tempJWTSecret == "" {
+			tempJWTSecret = s.TempJWTSecret
+		}
 		if lineID == "" {
 			lineID = s.LineClientID
 		}
@@ -140,6 +189,8 @@ func NewConfig() *Config {
 	quizMaxPerDay := getenvInt("LIMIT_QUIZ_MAX_PER_DAY", 20)
 	// quiz_create 作成時に一度に作成できる質問数(quiz_questionの上限)
 	quizMaxQuestions := getenvInt("LIMIT_QUIZ_MAX_QUESTIONS", 100)
+	// 制限時間なしの実行中クイズを放置とみなして自動終了するまでの時間(分)
+	quizAbandonMinutes := getenvInt("LIMIT_QUIZ_ABANDON_MINUTES", 1440)
 	// bulk_tokenize を使用できる回数の上限/日
 	bulkMaxPerDay := getenvInt("LIMIT_BULK_MAX_PER_DAY", 5)
 	// bulk_tokenize で一度に処理できるデータサイズの上限(byte)
@@ -148,6 +199,44 @@ func NewConfig() *Config {
 	bulkTokenizeMaxTokens := getenvInt("LIMIT_BULK_TOKENIZE_MAX_TOKENS", 50*1024) // 51200
 	// bulk_register で一度に登録できる単語数の上限
 	bulkRegisterMaxItems := getenvInt("LIMIT_BULK_REGISTER_MAX_ITEMS", 50*1024) // 51200
+	// 1 ユーザーが作れるデッキ数の上限
+	decksPerUser := getenvInt("LIMIT_DECKS_PER_USER", 50)
+	// 1 デッキに入れられる単語数の上限
+	deckMaxWords := getenvInt("LIMIT_DECK_MAX_WORDS", 500)
+	// 単語帳ファイル（CSV/TSV）の取り込みで受け付けるファイルサイズの上限(byte)
+	vocabImportMaxBytes := getenvInt("LIMIT_VOCAB_IMPORT_MAX_BYTES", 512*1024)
+	// 単語帳ファイルの取り込みで一度に扱える行数の上限
+	vocabImportMaxRows := getenvInt("LIMIT_VOCAB_IMPORT_MAX_ROWS", 2000)
+	// 単語マスタ変更の提案で、1 ユーザーが同時に出せる承認待ちの件数
+	wordProposalMaxPending := getenvInt("LIMIT_WORD_PROPOSAL_MAX_PENDING", 20)
+
+	// アクセストークン（短命）とリフレッシュトークンの有効期限
+	accessHours := getenvInt("JWT_EXPIRATION_HOURS", 0)
+	accessMinutes := getenvInt("JWT_EXPIRATION_MINUTES", 15)
+	refreshTTLDays := getenvInt("JWT_REFRESH_TTL_DAYS", 30)
+	// メール確認リンク・パスワード再設定リンクの有効期限(分)
+	emailVerifyTTL := getenvInt("EMAIL_VERIFY_TTL_MINUTES", 24*60)
+	passwordResetTTL := getenvInt("PASSWORD_RESET_TTL_MINUTES", 30)
+
+	// 鍵のローテーション中に受け付ける古い公開鍵（PEM またはファイルパスをカンマ区切り）
+	jwtVerifyKeys := splitList(getenv("JWT_VERIFY_KEYS", ""))
+	// 一時トークン用の鍵。未設定ならアクセストークンと同じ鍵にならないよう JWT_SECRET か署名鍵から派生させる
+	tempJWTSecret, err := resolveTempSecret(tempJWTSecret, jwtSecret, jwtSigningKey)
+	if err != nil {
+		logrus.Fatalf("%v", err)
+	}
+
+	// LINE 以外のソーシャルログイン。クライアントシークレットは Secrets Manager にも置ける
+	oidcLookup := func(key string) string { return getenv(key, "") }
+	if arn := os.Getenv("APP_SECRET_ARN"); arn != "" && getenv("OIDC_PROVIDERS", "") != "" {
+		s, err := fetchSecretJSON[map[string]string](context.Background(), arn)
+		if err != nil {
+			logrus.Warnf("read OIDC settings from APP_SECRET_ARN: %v", err)
+		} else {
+			oidcLookup = func(key string) string { return getenv(key, s[key]) }
+		}
+	}
+	oidcProviders := loadOIDCProviders(oidcLookup)
 
 	rateLimitTableName := getenv("RATE_LIMIT_TABLE", "rate_limits")
 	// // 4) DB 認証（ユーザー/パス）は Secrets Manager
@@ -172,7 +261,12 @@ func NewConfig() *Config {
 	// ♦ 3. 構造体に詰めて返す
 	return &Config{
 		App: AppCfg{Env: appEnv, Port: appPort},
-		JWT: JWTCfg{Secret: jwtSecret, TempSecret: jwtSecret, ExpireHour: 1, ExpireMinute: 30},
+		JWT: JWTCfg{
+			SigningKey: jwtSigningKey, VerifyKeys: jwtVerifyKeys,
+			Secret: jwtSecret, TempSecret: tempJWTSecret,
+			ExpireHour: accessHours, ExpireMinute: accessMinutes, RefreshTTLDays: refreshTTLDays,
+			EmailVerifyTTLMinutes: emailVerifyTTL, PasswordResetTTLMinutes: passwordResetTTL,
+		},
 		// DB:  DBCfg{DSN: dsn},
 		Line: LineOAuthCfg{
 			ClientID: lineID, ClientSecret: lineSec, RedirectURI: lineRedirect,
@@ -186,7 +280,24 @@ func NewConfig() *Config {
 			BulkMaxBytes:           bulkMaxBytes, // 51200
 			BulkTokenizeMaxTokens:  bulkTokenizeMaxTokens,
 			BulkRegisterMaxItems:   bulkRegisterMaxItems,
+			QuizAbandonMinutes:     quizAbandonMinutes,
+			DecksPerUser:           decksPerUser,
+			DeckMaxWords:           deckMaxWords,
+			VocabImportMaxBytes:    vocabImportMaxBytes,
+			VocabImportMaxRows:     vocabImportMaxRows,
+			WordProposalMaxPending: wordProposalMaxPending,
 		},
+		Mail: MailCfg{
+			Driver:       getenv("MAIL_DRIVER", "log"),
+			From:         getenv("MAIL_FROM", "no-reply@localhost"),
+			SMTPHost:     getenv("SMTP_HOST", ""),
+			SMTPPort:     getenvInt("SMTP_PORT", 587),
+			SMTPUser:     getenv("SMTP_USER", ""),
+			SMTPPassword: getenv("SMTP_PASSWORD", ""),
+			FileDir:      getenv("MAIL_FILE_DIR", "tmp/mail"),
+			LinkBaseURL:  getenv("MAIL_LINK_BASE_URL", getenv("CORS_ORIGIN", "http://localhost:3000")),
+		},
+		OIDC:           oidcProviders,
 		RateLimitTable: rateLimitTable{RateLimitTableName: rateLimitTableName},
 	}
 }
@@ -218,6 +329,47 @@ func GetenvBool(key string, def bool) bool {
 	return b
 }
 
+// splitList はカンマ区切りの値を分け、空の要素は捨てる
+func splitList(s string) []string {
+	var out []string
+	for _, p := range strings.Split(s, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// deriveKey は HMAC-SHA256(secret, label) を16進で返す。鍵が 1 つしか無くても用途ごとに別の鍵にする
+func deriveKey(secret, label string) string {
+	m := hmac.New(sha256.New, []byte(secret))
+	m.Write([]byte(label))
+	return hex.EncodeToString(m.Sum(nil))
+}
+
+// resolveTempSecret は一時トークン用の鍵を決める（TEMP_JWT_SECRET → JWT_SECRET → JWT_SIGNING_KEY の順）。
+// HS256 は空の鍵でも署名できてしまうので、どれも無ければエラーにする
+func resolveTempSecret(temp, secret, signingKey string) (string, error) {
+	switch {
+	case temp != "":
+		return temp, nil
+	case secret != "":
+		return deriveKey(secret, "temp"), nil
+	case signingKey != "":
+		// パスのままだと鍵の中身と無関係になるので、PEM を読んでから派生させる
+		data := []byte(strings.ReplaceAll(signingKey, `\n`, "\n"))
+		if !strings.Contains(signingKey, "-----BEGIN") {
+			var err error
+			if data, err = os.ReadFile(signingKey); err != nil {
+				return "", fmt.Errorf("JWT_SIGNING_KEY: %w", err)
+			}
+		}
+		return deriveKey(string(data), "temp"), nil
+	default:
+		return "", errors.New("TEMP_JWT_SECRET, JWT_SECRET or JWT_SIGNING_KEY is required")
+	}
+}
+
 // ParseTrustedProxies parses TRUSTED_PROXIES environment variable (comma-separated CIDR).
 // Returns nil if empty (Gin recommended: reject all).
 func ParseTrustedProxies(s string) []string {
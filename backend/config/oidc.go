@@ -0,0 +1,57 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// OIDCProviderCfg holds one social login provider besides LINE.
+// Name is the registry key used in /users/auth/:provider/* and stored as
+// ExternalAuth.provider. Issuer is the OIDC discovery base URL; "github"
+// is plain OAuth2 and ignores it.
+type OIDCProviderCfg struct {
+	Name         string
+	Issuer       string
+	ClientID     string
+	ClientSecret string
+	RedirectURI  string
+	Scopes       []string
+}
+
+// 既知のプロバイダは issuer を省略できる
+var defaultIssuers = map[string]string{
+	"google": "https://accounts.google.com",
+}
+
+// loadOIDCProviders reads OIDC_PROVIDERS (e.g. "google,github,keycloak") and
+// OIDC_<NAME>_ISSUER / _CLIENT_ID / _CLIENT_SECRET / _REDIRECT_URI / _SCOPES
+// for each name. Incomplete entries are skipped with a warning.
+func loadOIDCProviders(lookup func(key string) string) []OIDCProviderCfg {
+	var out []OIDCProviderCfg
+	for _, name := range splitList(lookup("OIDC_PROVIDERS")) {
+		name = strings.ToLower(name)
+		if name == "line" {
+			logrus.Warnf("OIDC_PROVIDERS: line is configured with LINE_CLIENT_ID, skipping")
+			continue
+		}
+		prefix := "OIDC_" + strings.ToUpper(strings.ReplaceAll(name, "-", "_")) + "_"
+		c := OIDCProviderCfg{
+			Name:         name,
+			Issuer:       lookup(prefix + "ISSUER"),
+			ClientID:     lookup(prefix + "CLIENT_ID"),
+			ClientSecret: lookup(prefix + "CLIENT_SECRET"),
+			RedirectURI:  lookup(prefix + "REDIRECT_URI"),
+			Scopes:       splitList(lookup(prefix + "SCOPES")),
+		}
+		if c.Issuer == "" {
+			c.Issuer = defaultIssuers[name]
+		}
+		if c.ClientID == "" || (c.Issuer == "" && name != "github") {
+			logrus.Warnf("OIDC provider %q needs %sCLIENT_ID and %sISSUER, skipping", name, prefix, prefix)
+			continue
+		}
+		out = append(out, c)
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package config_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/config"
+)
+
+// clearJWTEnv は鍵まわりの環境変数を空にする（Secrets Manager にも取りに行かせない）
+func clearJWTEnv(t *testing.T) {
+	for _, k := range []string{"JWT_SECRET", "JWT_SIGNING_KEY", "JWT_VERIFY_KEYS", "TEMP_JWT_SECRET", "APP_SECRET_ARN", "OIDC_PROVIDERS"} {
+		t.Setenv(k, "")
+	}
+}
+
+func newSigningKeyPEM(t *testing.T) string {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+}
+
+func TestNewConfig_TempSecret(t *testing.T) {
+	t.Run("署名鍵だけでも一時トークン用の鍵は空にならない", func(t *testing.T) {
+		clearJWTEnv(t)
+		key := newSigningKeyPEM(t)
+		t.Setenv("JWT_SIGNING_KEY", key)
+
+		cfg := config.NewConfig()
+		assert.NotEmpty(t, cfg.JWT.TempSecret)
+		assert.NotContains(t, cfg.JWT.TempSecret, "BEGIN")
+
+		// ファイルパスで渡しても鍵の中身から同じ値になる
+		path := filepath.Join(t.TempDir(), "signing.pem")
+		require.NoError(t, os.WriteFile(path, []byte(key), 0o600))
+		t.Setenv("JWT_SIGNING_KEY", path)
+		assert.Equal(t, cfg.JWT.TempSecret, config.NewConfig().JWT.TempSecret)
+
+		// 別の鍵なら別の値
+		t.Setenv("JWT_SIGNING_KEY", newSigningKeyPEM(t))
+		assert.NotEqual(t, cfg.JWT.TempSecret, config.NewConfig().JWT.TempSecret)
+	})
+
+	t.Run("JWT_SECRET からはアクセストークンと別の鍵を派生させる", func(t *testing.T) {
+		clearJWTEnv(t)
+		t.Setenv("JWT_SECRET", "jwt_secret")
+
+		cfg := config.NewConfig()
+		assert.NotEmpty(t, cfg.JWT.TempSecret)
+		assert.NotEqual(t, "jwt_secret", cfg.JWT.TempSecret)
+	})
+
+	t.Run("TEMP_JWT_SECRET があればそのまま使う", func(t *testing.T) {
+		clearJWTEnv(t)
+		t.Setenv("JWT_SECRET", "jwt_secret")
+		t.Setenv("TEMP_JWT_SECRET", "temp_secret")
+
+		assert.Equal(t, "temp_secret", config.NewConfig().JWT.TempSecret)
+	})
+}
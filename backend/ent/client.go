@@ -11,11 +11,18 @@ import (
 
 	"word_app/backend/ent/migrate"
 
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
 	"word_app/backend/ent/externalauth"
 	"word_app/backend/ent/japanesemean"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/refreshtoken"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/rootconfig"
 	"word_app/backend/ent/user"
@@ -23,6 +30,7 @@ import (
 	"word_app/backend/ent/userdailyusage"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/ent/wordproposal"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -37,16 +45,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Deck is the client for interacting with the Deck builders.
+	Deck *DeckClient
+	// DeckWord is the client for interacting with the DeckWord builders.
+	DeckWord *DeckWordClient
+	// DictImportFailure is the client for interacting with the DictImportFailure builders.
+	DictImportFailure *DictImportFailureClient
+	// DictImportRun is the client for interacting with the DictImportRun builders.
+	DictImportRun *DictImportRunClient
 	// ExternalAuth is the client for interacting with the ExternalAuth builders.
 	ExternalAuth *ExternalAuthClient
 	// JapaneseMean is the client for interacting with the JapaneseMean builders.
 	JapaneseMean *JapaneseMeanClient
 	// PartOfSpeech is the client for interacting with the PartOfSpeech builders.
 	PartOfSpeech *PartOfSpeechClient
+	// PosMapping is the client for interacting with the PosMapping builders.
+	PosMapping *PosMappingClient
+	// PosMappingVersion is the client for interacting with the PosMappingVersion builders.
+	PosMappingVersion *PosMappingVersionClient
 	// Quiz is the client for interacting with the Quiz builders.
 	Quiz *QuizClient
 	// QuizQuestion is the client for interacting with the QuizQuestion builders.
 	QuizQuestion *QuizQuestionClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
 	// RegisteredWord is the client for interacting with the RegisteredWord builders.
 	RegisteredWord *RegisteredWordClient
 	// RootConfig is the client for interacting with the RootConfig builders.
@@ -61,6 +83,8 @@ type Client struct {
 	Word *WordClient
 	// WordInfo is the client for interacting with the WordInfo builders.
 	WordInfo *WordInfoClient
+	// WordProposal is the client for interacting with the WordProposal builders.
+	WordProposal *WordProposalClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -72,11 +96,18 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Deck = NewDeckClient(c.config)
+	c.DeckWord = NewDeckWordClient(c.config)
+	c.DictImportFailure = NewDictImportFailureClient(c.config)
+	c.DictImportRun = NewDictImportRunClient(c.config)
 	c.ExternalAuth = NewExternalAuthClient(c.config)
 	c.JapaneseMean = NewJapaneseMeanClient(c.config)
 	c.PartOfSpeech = NewPartOfSpeechClient(c.config)
+	c.PosMapping = NewPosMappingClient(c.config)
+	c.PosMappingVersion = NewPosMappingVersionClient(c.config)
 	c.Quiz = NewQuizClient(c.config)
 	c.QuizQuestion = NewQuizQuestionClient(c.config)
+	c.RefreshToken = NewRefreshTokenClient(c.config)
 	c.RegisteredWord = NewRegisteredWordClient(c.config)
 	c.RootConfig = NewRootConfigClient(c.config)
 	c.User = NewUserClient(c.config)
@@ -84,6 +115,7 @@ func (c *Client) init() {
 	c.UserDailyUsage = NewUserDailyUsageClient(c.config)
 	c.Word = NewWordClient(c.config)
 	c.WordInfo = NewWordInfoClient(c.config)
+	c.WordProposal = NewWordProposalClient(c.config)
 }
 
 type (
@@ -174,20 +206,28 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ExternalAuth:   NewExternalAuthClient(cfg),
-		JapaneseMean:   NewJapaneseMeanClient(cfg),
-		PartOfSpeech:   NewPartOfSpeechClient(cfg),
-		Quiz:           NewQuizClient(cfg),
-		QuizQuestion:   NewQuizQuestionClient(cfg),
-		RegisteredWord: NewRegisteredWordClient(cfg),
-		RootConfig:     NewRootConfigClient(cfg),
-		User:           NewUserClient(cfg),
-		UserConfig:     NewUserConfigClient(cfg),
-		UserDailyUsage: NewUserDailyUsageClient(cfg),
-		Word:           NewWordClient(cfg),
-		WordInfo:       NewWordInfoClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Deck:              NewDeckClient(cfg),
+		DeckWord:          NewDeckWordClient(cfg),
+		DictImportFailure: NewDictImportFailureClient(cfg),
+		DictImportRun:     NewDictImportRunClient(cfg),
+		ExternalAuth:      NewExternalAuthClient(cfg),
+		JapaneseMean:      NewJapaneseMeanClient(cfg),
+		PartOfSpeech:      NewPartOfSpeechClient(cfg),
+		PosMapping:        NewPosMappingClient(cfg),
+		PosMappingVersion: NewPosMappingVersionClient(cfg),
+		Quiz:              NewQuizClient(cfg),
+		QuizQuestion:      NewQuizQuestionClient(cfg),
+		RefreshToken:      NewRefreshTokenClient(cfg),
+		RegisteredWord:    NewRegisteredWordClient(cfg),
+		RootConfig:        NewRootConfigClient(cfg),
+		User:              NewUserClient(cfg),
+		UserConfig:        NewUserConfigClient(cfg),
+		UserDailyUsage:    NewUserDailyUsageClient(cfg),
+		Word:              NewWordClient(cfg),
+		WordInfo:          NewWordInfoClient(cfg),
+		WordProposal:      NewWordProposalClient(cfg),
 	}, nil
 }
 
@@ -205,27 +245,35 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ExternalAuth:   NewExternalAuthClient(cfg),
-		JapaneseMean:   NewJapaneseMeanClient(cfg),
-		PartOfSpeech:   NewPartOfSpeechClient(cfg),
-		Quiz:           NewQuizClient(cfg),
-		QuizQuestion:   NewQuizQuestionClient(cfg),
-		RegisteredWord: NewRegisteredWordClient(cfg),
-		RootConfig:     NewRootConfigClient(cfg),
-		User:           NewUserClient(cfg),
-		UserConfig:     NewUserConfigClient(cfg),
-		UserDailyUsage: NewUserDailyUsageClient(cfg),
-		Word:           NewWordClient(cfg),
-		WordInfo:       NewWordInfoClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		Deck:              NewDeckClient(cfg),
+		DeckWord:          NewDeckWordClient(cfg),
+		DictImportFailure: NewDictImportFailureClient(cfg),
+		DictImportRun:     NewDictImportRunClient(cfg),
+		ExternalAuth:      NewExternalAuthClient(cfg),
+		JapaneseMean:      NewJapaneseMeanClient(cfg),
+		PartOfSpeech:      NewPartOfSpeechClient(cfg),
+		PosMapping:        NewPosMappingClient(cfg),
+		PosMappingVersion: NewPosMappingVersionClient(cfg),
+		Quiz:              NewQuizClient(cfg),
+		QuizQuestion:      NewQuizQuestionClient(cfg),
+		RefreshToken:      NewRefreshTokenClient(cfg),
+		RegisteredWord:    NewRegisteredWordClient(cfg),
+		RootConfig:        NewRootConfigClient(cfg),
+		User:              NewUserClient(cfg),
+		UserConfig:        NewUserConfigClient(cfg),
+		UserDailyUsage:    NewUserDailyUsageClient(cfg),
+		Word:              NewWordClient(cfg),
+		WordInfo:          NewWordInfoClient(cfg),
+		WordProposal:      NewWordProposalClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		ExternalAuth.
+//		Deck.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -248,9 +296,10 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.ExternalAuth, c.JapaneseMean, c.PartOfSpeech, c.Quiz, c.QuizQuestion,
-		c.RegisteredWord, c.RootConfig, c.User, c.UserConfig, c.UserDailyUsage, c.Word,
-		c.WordInfo,
+		c.Deck, c.DeckWord, c.DictImportFailure, c.DictImportRun, c.ExternalAuth,
+		c.JapaneseMean, c.PartOfSpeech, c.PosMapping, c.PosMappingVersion, c.Quiz,
+		c.QuizQuestion, c.RefreshToken, c.RegisteredWord, c.RootConfig, c.User,
+		c.UserConfig, c.UserDailyUsage, c.Word, c.WordInfo, c.WordProposal,
 	} {
 		n.Use(hooks...)
 	}
@@ -260,9 +309,10 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.ExternalAuth, c.JapaneseMean, c.PartOfSpeech, c.Quiz, c.QuizQuestion,
-		c.RegisteredWord, c.RootConfig, c.User, c.UserConfig, c.UserDailyUsage, c.Word,
-		c.WordInfo,
+		c.Deck, c.DeckWord, c.DictImportFailure, c.DictImportRun, c.ExternalAuth,
+		c.JapaneseMean, c.PartOfSpeech, c.PosMapping, c.PosMappingVersion, c.Quiz,
+		c.QuizQuestion, c.RefreshToken, c.RegisteredWord, c.RootConfig, c.User,
+		c.UserConfig, c.UserDailyUsage, c.Word, c.WordInfo, c.WordProposal,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -271,16 +321,30 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *DeckMutation:
+		return c.Deck.mutate(ctx, m)
+	case *DeckWordMutation:
+		return c.DeckWord.mutate(ctx, m)
+	case *DictImportFailureMutation:
+		return c.DictImportFailure.mutate(ctx, m)
+	case *DictImportRunMutation:
+		return c.DictImportRun.mutate(ctx, m)
 	case *ExternalAuthMutation:
 		return c.ExternalAuth.mutate(ctx, m)
 	case *JapaneseMeanMutation:
 		return c.JapaneseMean.mutate(ctx, m)
 	case *PartOfSpeechMutation:
 		return c.PartOfSpeech.mutate(ctx, m)
+	case *PosMappingMutation:
+		return c.PosMapping.mutate(ctx, m)
+	case *PosMappingVersionMutation:
+		return c.PosMappingVersion.mutate(ctx, m)
 	case *QuizMutation:
 		return c.Quiz.mutate(ctx, m)
 	case *QuizQuestionMutation:
 		return c.QuizQuestion.mutate(ctx, m)
+	case *RefreshTokenMutation:
+		return c.RefreshToken.mutate(ctx, m)
 	case *RegisteredWordMutation:
 		return c.RegisteredWord.mutate(ctx, m)
 	case *RootConfigMutation:
@@ -295,11 +359,657 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Word.mutate(ctx, m)
 	case *WordInfoMutation:
 		return c.WordInfo.mutate(ctx, m)
+	case *WordProposalMutation:
+		return c.WordProposal.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// DeckClient is a client for the Deck schema.
+type DeckClient struct {
+	config
+}
+
+// NewDeckClient returns a client for the Deck from the given config.
+func NewDeckClient(c config) *DeckClient {
+	return &DeckClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deck.Hooks(f(g(h())))`.
+func (c *DeckClient) Use(hooks ...Hook) {
+	c.hooks.Deck = append(c.hooks.Deck, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deck.Intercept(f(g(h())))`.
+func (c *DeckClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Deck = append(c.inters.Deck, interceptors...)
+}
+
+// Create returns a builder for creating a Deck entity.
+func (c *DeckClient) Create() *DeckCreate {
+	mutation := newDeckMutation(c.config, OpCreate)
+	return &DeckCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Deck entities.
+func (c *DeckClient) CreateBulk(builders ...*DeckCreate) *DeckCreateBulk {
+	return &DeckCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeckClient) MapCreateBulk(slice any, setFunc func(*DeckCreate, int)) *DeckCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeckCreateBulk{err: fmt.Errorf("calling to DeckClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeckCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeckCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Deck.
+func (c *DeckClient) Update() *DeckUpdate {
+	mutation := newDeckMutation(c.config, OpUpdate)
+	return &DeckUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeckClient) UpdateOne(d *Deck) *DeckUpdateOne {
+	mutation := newDeckMutation(c.config, OpUpdateOne, withDeck(d))
+	return &DeckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeckClient) UpdateOneID(id int) *DeckUpdateOne {
+	mutation := newDeckMutation(c.config, OpUpdateOne, withDeckID(id))
+	return &DeckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Deck.
+func (c *DeckClient) Delete() *DeckDelete {
+	mutation := newDeckMutation(c.config, OpDelete)
+	return &DeckDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeckClient) DeleteOne(d *Deck) *DeckDeleteOne {
+	return c.DeleteOneID(d.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeckClient) DeleteOneID(id int) *DeckDeleteOne {
+	builder := c.Delete().Where(deck.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeckDeleteOne{builder}
+}
+
+// Query returns a query builder for Deck.
+func (c *DeckClient) Query() *DeckQuery {
+	return &DeckQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeck},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Deck entity by its id.
+func (c *DeckClient) Get(ctx context.Context, id int) (*Deck, error) {
+	return c.Query().Where(deck.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeckClient) GetX(ctx context.Context, id int) *Deck {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Deck.
+func (c *DeckClient) QueryUser(d *Deck) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deck.Table, deck.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deck.UserTable, deck.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryDeckWords queries the deck_words edge of a Deck.
+func (c *DeckClient) QueryDeckWords(d *Deck) *DeckWordQuery {
+	query := (&DeckWordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deck.Table, deck.FieldID, id),
+			sqlgraph.To(deckword.Table, deckword.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deck.DeckWordsTable, deck.DeckWordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryQuizzes queries the quizzes edge of a Deck.
+func (c *DeckClient) QueryQuizzes(d *Deck) *QuizQuery {
+	query := (&QuizClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := d.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deck.Table, deck.FieldID, id),
+			sqlgraph.To(quiz.Table, quiz.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, deck.QuizzesTable, deck.QuizzesColumn),
+		)
+		fromV = sqlgraph.Neighbors(d.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeckClient) Hooks() []Hook {
+	return c.hooks.Deck
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeckClient) Interceptors() []Interceptor {
+	return c.inters.Deck
+}
+
+func (c *DeckClient) mutate(ctx context.Context, m *DeckMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeckCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeckUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeckUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeckDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Deck mutation op: %q", m.Op())
+	}
+}
+
+// DeckWordClient is a client for the DeckWord schema.
+type DeckWordClient struct {
+	config
+}
+
+// NewDeckWordClient returns a client for the DeckWord from the given config.
+func NewDeckWordClient(c config) *DeckWordClient {
+	return &DeckWordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deckword.Hooks(f(g(h())))`.
+func (c *DeckWordClient) Use(hooks ...Hook) {
+	c.hooks.DeckWord = append(c.hooks.DeckWord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deckword.Intercept(f(g(h())))`.
+func (c *DeckWordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeckWord = append(c.inters.DeckWord, interceptors...)
+}
+
+// Create returns a builder for creating a DeckWord entity.
+func (c *DeckWordClient) Create() *DeckWordCreate {
+	mutation := newDeckWordMutation(c.config, OpCreate)
+	return &DeckWordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeckWord entities.
+func (c *DeckWordClient) CreateBulk(builders ...*DeckWordCreate) *DeckWordCreateBulk {
+	return &DeckWordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeckWordClient) MapCreateBulk(slice any, setFunc func(*DeckWordCreate, int)) *DeckWordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeckWordCreateBulk{err: fmt.Errorf("calling to DeckWordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeckWordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeckWordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeckWord.
+func (c *DeckWordClient) Update() *DeckWordUpdate {
+	mutation := newDeckWordMutation(c.config, OpUpdate)
+	return &DeckWordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeckWordClient) UpdateOne(dw *DeckWord) *DeckWordUpdateOne {
+	mutation := newDeckWordMutation(c.config, OpUpdateOne, withDeckWord(dw))
+	return &DeckWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeckWordClient) UpdateOneID(id int) *DeckWordUpdateOne {
+	mutation := newDeckWordMutation(c.config, OpUpdateOne, withDeckWordID(id))
+	return &DeckWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeckWord.
+func (c *DeckWordClient) Delete() *DeckWordDelete {
+	mutation := newDeckWordMutation(c.config, OpDelete)
+	return &DeckWordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeckWordClient) DeleteOne(dw *DeckWord) *DeckWordDeleteOne {
+	return c.DeleteOneID(dw.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeckWordClient) DeleteOneID(id int) *DeckWordDeleteOne {
+	builder := c.Delete().Where(deckword.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeckWordDeleteOne{builder}
+}
+
+// Query returns a query builder for DeckWord.
+func (c *DeckWordClient) Query() *DeckWordQuery {
+	return &DeckWordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeckWord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeckWord entity by its id.
+func (c *DeckWordClient) Get(ctx context.Context, id int) (*DeckWord, error) {
+	return c.Query().Where(deckword.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeckWordClient) GetX(ctx context.Context, id int) *DeckWord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryDeck queries the deck edge of a DeckWord.
+func (c *DeckWordClient) QueryDeck(dw *DeckWord) *DeckQuery {
+	query := (&DeckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deckword.Table, deckword.FieldID, id),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deckword.DeckTable, deckword.DeckColumn),
+		)
+		fromV = sqlgraph.Neighbors(dw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWord queries the word edge of a DeckWord.
+func (c *DeckWordClient) QueryWord(dw *DeckWord) *WordQuery {
+	query := (&WordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dw.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deckword.Table, deckword.FieldID, id),
+			sqlgraph.To(word.Table, word.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deckword.WordTable, deckword.WordColumn),
+		)
+		fromV = sqlgraph.Neighbors(dw.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DeckWordClient) Hooks() []Hook {
+	return c.hooks.DeckWord
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeckWordClient) Interceptors() []Interceptor {
+	return c.inters.DeckWord
+}
+
+func (c *DeckWordClient) mutate(ctx context.Context, m *DeckWordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeckWordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeckWordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeckWordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeckWordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeckWord mutation op: %q", m.Op())
+	}
+}
+
+// DictImportFailureClient is a client for the DictImportFailure schema.
+type DictImportFailureClient struct {
+	config
+}
+
+// NewDictImportFailureClient returns a client for the DictImportFailure from the given config.
+func NewDictImportFailureClient(c config) *DictImportFailureClient {
+	return &DictImportFailureClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dictimportfailure.Hooks(f(g(h())))`.
+func (c *DictImportFailureClient) Use(hooks ...Hook) {
+	c.hooks.DictImportFailure = append(c.hooks.DictImportFailure, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dictimportfailure.Intercept(f(g(h())))`.
+func (c *DictImportFailureClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DictImportFailure = append(c.inters.DictImportFailure, interceptors...)
+}
+
+// Create returns a builder for creating a DictImportFailure entity.
+func (c *DictImportFailureClient) Create() *DictImportFailureCreate {
+	mutation := newDictImportFailureMutation(c.config, OpCreate)
+	return &DictImportFailureCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DictImportFailure entities.
+func (c *DictImportFailureClient) CreateBulk(builders ...*DictImportFailureCreate) *DictImportFailureCreateBulk {
+	return &DictImportFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DictImportFailureClient) MapCreateBulk(slice any, setFunc func(*DictImportFailureCreate, int)) *DictImportFailureCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DictImportFailureCreateBulk{err: fmt.Errorf("calling to DictImportFailureClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DictImportFailureCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DictImportFailureCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DictImportFailure.
+func (c *DictImportFailureClient) Update() *DictImportFailureUpdate {
+	mutation := newDictImportFailureMutation(c.config, OpUpdate)
+	return &DictImportFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DictImportFailureClient) UpdateOne(dif *DictImportFailure) *DictImportFailureUpdateOne {
+	mutation := newDictImportFailureMutation(c.config, OpUpdateOne, withDictImportFailure(dif))
+	return &DictImportFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DictImportFailureClient) UpdateOneID(id int) *DictImportFailureUpdateOne {
+	mutation := newDictImportFailureMutation(c.config, OpUpdateOne, withDictImportFailureID(id))
+	return &DictImportFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DictImportFailure.
+func (c *DictImportFailureClient) Delete() *DictImportFailureDelete {
+	mutation := newDictImportFailureMutation(c.config, OpDelete)
+	return &DictImportFailureDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DictImportFailureClient) DeleteOne(dif *DictImportFailure) *DictImportFailureDeleteOne {
+	return c.DeleteOneID(dif.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DictImportFailureClient) DeleteOneID(id int) *DictImportFailureDeleteOne {
+	builder := c.Delete().Where(dictimportfailure.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DictImportFailureDeleteOne{builder}
+}
+
+// Query returns a query builder for DictImportFailure.
+func (c *DictImportFailureClient) Query() *DictImportFailureQuery {
+	return &DictImportFailureQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDictImportFailure},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DictImportFailure entity by its id.
+func (c *DictImportFailureClient) Get(ctx context.Context, id int) (*DictImportFailure, error) {
+	return c.Query().Where(dictimportfailure.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DictImportFailureClient) GetX(ctx context.Context, id int) *DictImportFailure {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryRun queries the run edge of a DictImportFailure.
+func (c *DictImportFailureClient) QueryRun(dif *DictImportFailure) *DictImportRunQuery {
+	query := (&DictImportRunClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dif.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dictimportfailure.Table, dictimportfailure.FieldID, id),
+			sqlgraph.To(dictimportrun.Table, dictimportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dictimportfailure.RunTable, dictimportfailure.RunColumn),
+		)
+		fromV = sqlgraph.Neighbors(dif.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DictImportFailureClient) Hooks() []Hook {
+	return c.hooks.DictImportFailure
+}
+
+// Interceptors returns the client interceptors.
+func (c *DictImportFailureClient) Interceptors() []Interceptor {
+	return c.inters.DictImportFailure
+}
+
+func (c *DictImportFailureClient) mutate(ctx context.Context, m *DictImportFailureMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DictImportFailureCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DictImportFailureUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DictImportFailureUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DictImportFailureDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DictImportFailure mutation op: %q", m.Op())
+	}
+}
+
+// DictImportRunClient is a client for the DictImportRun schema.
+type DictImportRunClient struct {
+	config
+}
+
+// NewDictImportRunClient returns a client for the DictImportRun from the given config.
+func NewDictImportRunClient(c config) *DictImportRunClient {
+	return &DictImportRunClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dictimportrun.Hooks(f(g(h())))`.
+func (c *DictImportRunClient) Use(hooks ...Hook) {
+	c.hooks.DictImportRun = append(c.hooks.DictImportRun, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dictimportrun.Intercept(f(g(h())))`.
+func (c *DictImportRunClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DictImportRun = append(c.inters.DictImportRun, interceptors...)
+}
+
+// Create returns a builder for creating a DictImportRun entity.
+func (c *DictImportRunClient) Create() *DictImportRunCreate {
+	mutation := newDictImportRunMutation(c.config, OpCreate)
+	return &DictImportRunCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DictImportRun entities.
+func (c *DictImportRunClient) CreateBulk(builders ...*DictImportRunCreate) *DictImportRunCreateBulk {
+	return &DictImportRunCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DictImportRunClient) MapCreateBulk(slice any, setFunc func(*DictImportRunCreate, int)) *DictImportRunCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DictImportRunCreateBulk{err: fmt.Errorf("calling to DictImportRunClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DictImportRunCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DictImportRunCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DictImportRun.
+func (c *DictImportRunClient) Update() *DictImportRunUpdate {
+	mutation := newDictImportRunMutation(c.config, OpUpdate)
+	return &DictImportRunUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DictImportRunClient) UpdateOne(dir *DictImportRun) *DictImportRunUpdateOne {
+	mutation := newDictImportRunMutation(c.config, OpUpdateOne, withDictImportRun(dir))
+	return &DictImportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DictImportRunClient) UpdateOneID(id int) *DictImportRunUpdateOne {
+	mutation := newDictImportRunMutation(c.config, OpUpdateOne, withDictImportRunID(id))
+	return &DictImportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DictImportRun.
+func (c *DictImportRunClient) Delete() *DictImportRunDelete {
+	mutation := newDictImportRunMutation(c.config, OpDelete)
+	return &DictImportRunDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DictImportRunClient) DeleteOne(dir *DictImportRun) *DictImportRunDeleteOne {
+	return c.DeleteOneID(dir.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DictImportRunClient) DeleteOneID(id int) *DictImportRunDeleteOne {
+	builder := c.Delete().Where(dictimportrun.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DictImportRunDeleteOne{builder}
+}
+
+// Query returns a query builder for DictImportRun.
+func (c *DictImportRunClient) Query() *DictImportRunQuery {
+	return &DictImportRunQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDictImportRun},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DictImportRun entity by its id.
+func (c *DictImportRunClient) Get(ctx context.Context, id int) (*DictImportRun, error) {
+	return c.Query().Where(dictimportrun.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DictImportRunClient) GetX(ctx context.Context, id int) *DictImportRun {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryFailures queries the failures edge of a DictImportRun.
+func (c *DictImportRunClient) QueryFailures(dir *DictImportRun) *DictImportFailureQuery {
+	query := (&DictImportFailureClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := dir.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dictimportrun.Table, dictimportrun.FieldID, id),
+			sqlgraph.To(dictimportfailure.Table, dictimportfailure.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dictimportrun.FailuresTable, dictimportrun.FailuresColumn),
+		)
+		fromV = sqlgraph.Neighbors(dir.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *DictImportRunClient) Hooks() []Hook {
+	return c.hooks.DictImportRun
+}
+
+// Interceptors returns the client interceptors.
+func (c *DictImportRunClient) Interceptors() []Interceptor {
+	return c.inters.DictImportRun
+}
+
+func (c *DictImportRunClient) mutate(ctx context.Context, m *DictImportRunMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DictImportRunCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DictImportRunUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DictImportRunUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DictImportRunDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DictImportRun mutation op: %q", m.Op())
+	}
+}
+
 // ExternalAuthClient is a client for the ExternalAuth schema.
 type ExternalAuthClient struct {
 	config
@@ -687,34 +1397,364 @@ func (c *PartOfSpeechClient) Delete() *PartOfSpeechDelete {
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PartOfSpeechClient) DeleteOne(pos *PartOfSpeech) *PartOfSpeechDeleteOne {
-	return c.DeleteOneID(pos.ID)
+func (c *PartOfSpeechClient) DeleteOne(pos *PartOfSpeech) *PartOfSpeechDeleteOne {
+	return c.DeleteOneID(pos.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PartOfSpeechClient) DeleteOneID(id int) *PartOfSpeechDeleteOne {
+	builder := c.Delete().Where(partofspeech.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PartOfSpeechDeleteOne{builder}
+}
+
+// Query returns a query builder for PartOfSpeech.
+func (c *PartOfSpeechClient) Query() *PartOfSpeechQuery {
+	return &PartOfSpeechQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePartOfSpeech},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PartOfSpeech entity by its id.
+func (c *PartOfSpeechClient) Get(ctx context.Context, id int) (*PartOfSpeech, error) {
+	return c.Query().Where(partofspeech.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PartOfSpeechClient) GetX(ctx context.Context, id int) *PartOfSpeech {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWordInfos queries the word_infos edge of a PartOfSpeech.
+func (c *PartOfSpeechClient) QueryWordInfos(pos *PartOfSpeech) *WordInfoQuery {
+	query := (&WordInfoClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pos.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(partofspeech.Table, partofspeech.FieldID, id),
+			sqlgraph.To(wordinfo.Table, wordinfo.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, partofspeech.WordInfosTable, partofspeech.WordInfosColumn),
+		)
+		fromV = sqlgraph.Neighbors(pos.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPosMappings queries the pos_mappings edge of a PartOfSpeech.
+func (c *PartOfSpeechClient) QueryPosMappings(pos *PartOfSpeech) *PosMappingQuery {
+	query := (&PosMappingClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pos.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(partofspeech.Table, partofspeech.FieldID, id),
+			sqlgraph.To(posmapping.Table, posmapping.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, partofspeech.PosMappingsTable, partofspeech.PosMappingsColumn),
+		)
+		fromV = sqlgraph.Neighbors(pos.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PartOfSpeechClient) Hooks() []Hook {
+	return c.hooks.PartOfSpeech
+}
+
+// Interceptors returns the client interceptors.
+func (c *PartOfSpeechClient) Interceptors() []Interceptor {
+	return c.inters.PartOfSpeech
+}
+
+func (c *PartOfSpeechClient) mutate(ctx context.Context, m *PartOfSpeechMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PartOfSpeechCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PartOfSpeechUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PartOfSpeechUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PartOfSpeechDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PartOfSpeech mutation op: %q", m.Op())
+	}
+}
+
+// PosMappingClient is a client for the PosMapping schema.
+type PosMappingClient struct {
+	config
+}
+
+// NewPosMappingClient returns a client for the PosMapping from the given config.
+func NewPosMappingClient(c config) *PosMappingClient {
+	return &PosMappingClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posmapping.Hooks(f(g(h())))`.
+func (c *PosMappingClient) Use(hooks ...Hook) {
+	c.hooks.PosMapping = append(c.hooks.PosMapping, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posmapping.Intercept(f(g(h())))`.
+func (c *PosMappingClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PosMapping = append(c.inters.PosMapping, interceptors...)
+}
+
+// Create returns a builder for creating a PosMapping entity.
+func (c *PosMappingClient) Create() *PosMappingCreate {
+	mutation := newPosMappingMutation(c.config, OpCreate)
+	return &PosMappingCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PosMapping entities.
+func (c *PosMappingClient) CreateBulk(builders ...*PosMappingCreate) *PosMappingCreateBulk {
+	return &PosMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PosMappingClient) MapCreateBulk(slice any, setFunc func(*PosMappingCreate, int)) *PosMappingCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PosMappingCreateBulk{err: fmt.Errorf("calling to PosMappingClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PosMappingCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PosMappingCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PosMapping.
+func (c *PosMappingClient) Update() *PosMappingUpdate {
+	mutation := newPosMappingMutation(c.config, OpUpdate)
+	return &PosMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PosMappingClient) UpdateOne(pm *PosMapping) *PosMappingUpdateOne {
+	mutation := newPosMappingMutation(c.config, OpUpdateOne, withPosMapping(pm))
+	return &PosMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PosMappingClient) UpdateOneID(id int) *PosMappingUpdateOne {
+	mutation := newPosMappingMutation(c.config, OpUpdateOne, withPosMappingID(id))
+	return &PosMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PosMapping.
+func (c *PosMappingClient) Delete() *PosMappingDelete {
+	mutation := newPosMappingMutation(c.config, OpDelete)
+	return &PosMappingDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PosMappingClient) DeleteOne(pm *PosMapping) *PosMappingDeleteOne {
+	return c.DeleteOneID(pm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PosMappingClient) DeleteOneID(id int) *PosMappingDeleteOne {
+	builder := c.Delete().Where(posmapping.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PosMappingDeleteOne{builder}
+}
+
+// Query returns a query builder for PosMapping.
+func (c *PosMappingClient) Query() *PosMappingQuery {
+	return &PosMappingQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePosMapping},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PosMapping entity by its id.
+func (c *PosMappingClient) Get(ctx context.Context, id int) (*PosMapping, error) {
+	return c.Query().Where(posmapping.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PosMappingClient) GetX(ctx context.Context, id int) *PosMapping {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryVersion queries the version edge of a PosMapping.
+func (c *PosMappingClient) QueryVersion(pm *PosMapping) *PosMappingVersionQuery {
+	query := (&PosMappingVersionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posmapping.Table, posmapping.FieldID, id),
+			sqlgraph.To(posmappingversion.Table, posmappingversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, posmapping.VersionTable, posmapping.VersionColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryPartOfSpeech queries the part_of_speech edge of a PosMapping.
+func (c *PosMappingClient) QueryPartOfSpeech(pm *PosMapping) *PartOfSpeechQuery {
+	query := (&PartOfSpeechClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := pm.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posmapping.Table, posmapping.FieldID, id),
+			sqlgraph.To(partofspeech.Table, partofspeech.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, posmapping.PartOfSpeechTable, posmapping.PartOfSpeechColumn),
+		)
+		fromV = sqlgraph.Neighbors(pm.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PosMappingClient) Hooks() []Hook {
+	return c.hooks.PosMapping
+}
+
+// Interceptors returns the client interceptors.
+func (c *PosMappingClient) Interceptors() []Interceptor {
+	return c.inters.PosMapping
+}
+
+func (c *PosMappingClient) mutate(ctx context.Context, m *PosMappingMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PosMappingCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PosMappingUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PosMappingUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PosMappingDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PosMapping mutation op: %q", m.Op())
+	}
+}
+
+// PosMappingVersionClient is a client for the PosMappingVersion schema.
+type PosMappingVersionClient struct {
+	config
+}
+
+// NewPosMappingVersionClient returns a client for the PosMappingVersion from the given config.
+func NewPosMappingVersionClient(c config) *PosMappingVersionClient {
+	return &PosMappingVersionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `posmappingversion.Hooks(f(g(h())))`.
+func (c *PosMappingVersionClient) Use(hooks ...Hook) {
+	c.hooks.PosMappingVersion = append(c.hooks.PosMappingVersion, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `posmappingversion.Intercept(f(g(h())))`.
+func (c *PosMappingVersionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PosMappingVersion = append(c.inters.PosMappingVersion, interceptors...)
+}
+
+// Create returns a builder for creating a PosMappingVersion entity.
+func (c *PosMappingVersionClient) Create() *PosMappingVersionCreate {
+	mutation := newPosMappingVersionMutation(c.config, OpCreate)
+	return &PosMappingVersionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PosMappingVersion entities.
+func (c *PosMappingVersionClient) CreateBulk(builders ...*PosMappingVersionCreate) *PosMappingVersionCreateBulk {
+	return &PosMappingVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PosMappingVersionClient) MapCreateBulk(slice any, setFunc func(*PosMappingVersionCreate, int)) *PosMappingVersionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PosMappingVersionCreateBulk{err: fmt.Errorf("calling to PosMappingVersionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PosMappingVersionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PosMappingVersionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PosMappingVersion.
+func (c *PosMappingVersionClient) Update() *PosMappingVersionUpdate {
+	mutation := newPosMappingVersionMutation(c.config, OpUpdate)
+	return &PosMappingVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PosMappingVersionClient) UpdateOne(pmv *PosMappingVersion) *PosMappingVersionUpdateOne {
+	mutation := newPosMappingVersionMutation(c.config, OpUpdateOne, withPosMappingVersion(pmv))
+	return &PosMappingVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PosMappingVersionClient) UpdateOneID(id int) *PosMappingVersionUpdateOne {
+	mutation := newPosMappingVersionMutation(c.config, OpUpdateOne, withPosMappingVersionID(id))
+	return &PosMappingVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PosMappingVersion.
+func (c *PosMappingVersionClient) Delete() *PosMappingVersionDelete {
+	mutation := newPosMappingVersionMutation(c.config, OpDelete)
+	return &PosMappingVersionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PosMappingVersionClient) DeleteOne(pmv *PosMappingVersion) *PosMappingVersionDeleteOne {
+	return c.DeleteOneID(pmv.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PartOfSpeechClient) DeleteOneID(id int) *PartOfSpeechDeleteOne {
-	builder := c.Delete().Where(partofspeech.ID(id))
+func (c *PosMappingVersionClient) DeleteOneID(id int) *PosMappingVersionDeleteOne {
+	builder := c.Delete().Where(posmappingversion.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PartOfSpeechDeleteOne{builder}
+	return &PosMappingVersionDeleteOne{builder}
 }
 
-// Query returns a query builder for PartOfSpeech.
-func (c *PartOfSpeechClient) Query() *PartOfSpeechQuery {
-	return &PartOfSpeechQuery{
+// Query returns a query builder for PosMappingVersion.
+func (c *PosMappingVersionClient) Query() *PosMappingVersionQuery {
+	return &PosMappingVersionQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePartOfSpeech},
+		ctx:    &QueryContext{Type: TypePosMappingVersion},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a PartOfSpeech entity by its id.
-func (c *PartOfSpeechClient) Get(ctx context.Context, id int) (*PartOfSpeech, error) {
-	return c.Query().Where(partofspeech.ID(id)).Only(ctx)
+// Get returns a PosMappingVersion entity by its id.
+func (c *PosMappingVersionClient) Get(ctx context.Context, id int) (*PosMappingVersion, error) {
+	return c.Query().Where(posmappingversion.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PartOfSpeechClient) GetX(ctx context.Context, id int) *PartOfSpeech {
+func (c *PosMappingVersionClient) GetX(ctx context.Context, id int) *PosMappingVersion {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -722,44 +1762,44 @@ func (c *PartOfSpeechClient) GetX(ctx context.Context, id int) *PartOfSpeech {
 	return obj
 }
 
-// QueryWordInfos queries the word_infos edge of a PartOfSpeech.
-func (c *PartOfSpeechClient) QueryWordInfos(pos *PartOfSpeech) *WordInfoQuery {
-	query := (&WordInfoClient{config: c.config}).Query()
+// QueryMappings queries the mappings edge of a PosMappingVersion.
+func (c *PosMappingVersionClient) QueryMappings(pmv *PosMappingVersion) *PosMappingQuery {
+	query := (&PosMappingClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := pos.ID
+		id := pmv.ID
 		step := sqlgraph.NewStep(
-			sqlgraph.From(partofspeech.Table, partofspeech.FieldID, id),
-			sqlgraph.To(wordinfo.Table, wordinfo.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, partofspeech.WordInfosTable, partofspeech.WordInfosColumn),
+			sqlgraph.From(posmappingversion.Table, posmappingversion.FieldID, id),
+			sqlgraph.To(posmapping.Table, posmapping.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, posmappingversion.MappingsTable, posmappingversion.MappingsColumn),
 		)
-		fromV = sqlgraph.Neighbors(pos.driver.Dialect(), step)
+		fromV = sqlgraph.Neighbors(pmv.driver.Dialect(), step)
 		return fromV, nil
 	}
 	return query
 }
 
 // Hooks returns the client hooks.
-func (c *PartOfSpeechClient) Hooks() []Hook {
-	return c.hooks.PartOfSpeech
+func (c *PosMappingVersionClient) Hooks() []Hook {
+	return c.hooks.PosMappingVersion
 }
 
 // Interceptors returns the client interceptors.
-func (c *PartOfSpeechClient) Interceptors() []Interceptor {
-	return c.inters.PartOfSpeech
+func (c *PosMappingVersionClient) Interceptors() []Interceptor {
+	return c.inters.PosMappingVersion
 }
 
-func (c *PartOfSpeechClient) mutate(ctx context.Context, m *PartOfSpeechMutation) (Value, error) {
+func (c *PosMappingVersionClient) mutate(ctx context.Context, m *PosMappingVersionMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PartOfSpeechCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PosMappingVersionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PartOfSpeechUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PosMappingVersionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PartOfSpeechUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&PosMappingVersionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PartOfSpeechDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&PosMappingVersionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown PartOfSpeech mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown PosMappingVersion mutation op: %q", m.Op())
 	}
 }
 
@@ -903,6 +1943,22 @@ func (c *QuizClient) QueryQuizQuestions(q *Quiz) *QuizQuestionQuery {
 	return query
 }
 
+// QueryDeck queries the deck edge of a Quiz.
+func (c *QuizClient) QueryDeck(q *Quiz) *DeckQuery {
+	query := (&DeckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := q.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, id),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quiz.DeckTable, quiz.DeckColumn),
+		)
+		fromV = sqlgraph.Neighbors(q.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *QuizClient) Hooks() []Hook {
 	return c.hooks.Quiz
@@ -1109,6 +2165,155 @@ func (c *QuizQuestionClient) mutate(ctx context.Context, m *QuizQuestionMutation
 	}
 }
 
+// RefreshTokenClient is a client for the RefreshToken schema.
+type RefreshTokenClient struct {
+	config
+}
+
+// NewRefreshTokenClient returns a client for the RefreshToken from the given config.
+func NewRefreshTokenClient(c config) *RefreshTokenClient {
+	return &RefreshTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `refreshtoken.Hooks(f(g(h())))`.
+func (c *RefreshTokenClient) Use(hooks ...Hook) {
+	c.hooks.RefreshToken = append(c.hooks.RefreshToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `refreshtoken.Intercept(f(g(h())))`.
+func (c *RefreshTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RefreshToken = append(c.inters.RefreshToken, interceptors...)
+}
+
+// Create returns a builder for creating a RefreshToken entity.
+func (c *RefreshTokenClient) Create() *RefreshTokenCreate {
+	mutation := newRefreshTokenMutation(c.config, OpCreate)
+	return &RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RefreshToken entities.
+func (c *RefreshTokenClient) CreateBulk(builders ...*RefreshTokenCreate) *RefreshTokenCreateBulk {
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RefreshTokenClient) MapCreateBulk(slice any, setFunc func(*RefreshTokenCreate, int)) *RefreshTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RefreshTokenCreateBulk{err: fmt.Errorf("calling to RefreshTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RefreshTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RefreshTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RefreshToken.
+func (c *RefreshTokenClient) Update() *RefreshTokenUpdate {
+	mutation := newRefreshTokenMutation(c.config, OpUpdate)
+	return &RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RefreshTokenClient) UpdateOne(rt *RefreshToken) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshToken(rt))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RefreshTokenClient) UpdateOneID(id int) *RefreshTokenUpdateOne {
+	mutation := newRefreshTokenMutation(c.config, OpUpdateOne, withRefreshTokenID(id))
+	return &RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RefreshToken.
+func (c *RefreshTokenClient) Delete() *RefreshTokenDelete {
+	mutation := newRefreshTokenMutation(c.config, OpDelete)
+	return &RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RefreshTokenClient) DeleteOne(rt *RefreshToken) *RefreshTokenDeleteOne {
+	return c.DeleteOneID(rt.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RefreshTokenClient) DeleteOneID(id int) *RefreshTokenDeleteOne {
+	builder := c.Delete().Where(refreshtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RefreshTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RefreshToken.
+func (c *RefreshTokenClient) Query() *RefreshTokenQuery {
+	return &RefreshTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRefreshToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RefreshToken entity by its id.
+func (c *RefreshTokenClient) Get(ctx context.Context, id int) (*RefreshToken, error) {
+	return c.Query().Where(refreshtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RefreshTokenClient) GetX(ctx context.Context, id int) *RefreshToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a RefreshToken.
+func (c *RefreshTokenClient) QueryUser(rt *RefreshToken) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := rt.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(refreshtoken.Table, refreshtoken.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, refreshtoken.UserTable, refreshtoken.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(rt.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RefreshTokenClient) Hooks() []Hook {
+	return c.hooks.RefreshToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RefreshTokenClient) Interceptors() []Interceptor {
+	return c.inters.RefreshToken
+}
+
+func (c *RefreshTokenClient) mutate(ctx context.Context, m *RefreshTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RefreshTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RefreshTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RefreshTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RefreshTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RefreshToken mutation op: %q", m.Op())
+	}
+}
+
 // RegisteredWordClient is a client for the RegisteredWord schema.
 type RegisteredWordClient struct {
 	config
@@ -1611,6 +2816,54 @@ func (c *UserClient) QueryUserDailyUsage(u *User) *UserDailyUsageQuery {
 	return query
 }
 
+// QueryDecks queries the decks edge of a User.
+func (c *UserClient) QueryDecks(u *User) *DeckQuery {
+	query := (&DeckClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DecksTable, user.DecksColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWordProposals queries the word_proposals edge of a User.
+func (c *UserClient) QueryWordProposals(u *User) *WordProposalQuery {
+	query := (&WordProposalClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(wordproposal.Table, wordproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WordProposalsTable, user.WordProposalsColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryRefreshTokens queries the refresh_tokens edge of a User.
+func (c *UserClient) QueryRefreshTokens(u *User) *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := u.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromV = sqlgraph.Neighbors(u.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -2090,6 +3343,22 @@ func (c *WordClient) QueryQuizQuestions(w *Word) *QuizQuestionQuery {
 	return query
 }
 
+// QueryDeckWords queries the deck_words edge of a Word.
+func (c *WordClient) QueryDeckWords(w *Word) *DeckWordQuery {
+	query := (&DeckWordClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := w.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(word.Table, word.FieldID, id),
+			sqlgraph.To(deckword.Table, deckword.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, word.DeckWordsTable, word.DeckWordsColumn),
+		)
+		fromV = sqlgraph.Neighbors(w.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *WordClient) Hooks() []Hook {
 	return c.hooks.Word
@@ -2296,15 +3565,168 @@ func (c *WordInfoClient) mutate(ctx context.Context, m *WordInfoMutation) (Value
 	}
 }
 
+// WordProposalClient is a client for the WordProposal schema.
+type WordProposalClient struct {
+	config
+}
+
+// NewWordProposalClient returns a client for the WordProposal from the given config.
+func NewWordProposalClient(c config) *WordProposalClient {
+	return &WordProposalClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wordproposal.Hooks(f(g(h())))`.
+func (c *WordProposalClient) Use(hooks ...Hook) {
+	c.hooks.WordProposal = append(c.hooks.WordProposal, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wordproposal.Intercept(f(g(h())))`.
+func (c *WordProposalClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WordProposal = append(c.inters.WordProposal, interceptors...)
+}
+
+// Create returns a builder for creating a WordProposal entity.
+func (c *WordProposalClient) Create() *WordProposalCreate {
+	mutation := newWordProposalMutation(c.config, OpCreate)
+	return &WordProposalCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WordProposal entities.
+func (c *WordProposalClient) CreateBulk(builders ...*WordProposalCreate) *WordProposalCreateBulk {
+	return &WordProposalCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WordProposalClient) MapCreateBulk(slice any, setFunc func(*WordProposalCreate, int)) *WordProposalCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WordProposalCreateBulk{err: fmt.Errorf("calling to WordProposalClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WordProposalCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WordProposalCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WordProposal.
+func (c *WordProposalClient) Update() *WordProposalUpdate {
+	mutation := newWordProposalMutation(c.config, OpUpdate)
+	return &WordProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WordProposalClient) UpdateOne(wp *WordProposal) *WordProposalUpdateOne {
+	mutation := newWordProposalMutation(c.config, OpUpdateOne, withWordProposal(wp))
+	return &WordProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WordProposalClient) UpdateOneID(id int) *WordProposalUpdateOne {
+	mutation := newWordProposalMutation(c.config, OpUpdateOne, withWordProposalID(id))
+	return &WordProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WordProposal.
+func (c *WordProposalClient) Delete() *WordProposalDelete {
+	mutation := newWordProposalMutation(c.config, OpDelete)
+	return &WordProposalDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WordProposalClient) DeleteOne(wp *WordProposal) *WordProposalDeleteOne {
+	return c.DeleteOneID(wp.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WordProposalClient) DeleteOneID(id int) *WordProposalDeleteOne {
+	builder := c.Delete().Where(wordproposal.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WordProposalDeleteOne{builder}
+}
+
+// Query returns a query builder for WordProposal.
+func (c *WordProposalClient) Query() *WordProposalQuery {
+	return &WordProposalQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWordProposal},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WordProposal entity by its id.
+func (c *WordProposalClient) Get(ctx context.Context, id int) (*WordProposal, error) {
+	return c.Query().Where(wordproposal.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WordProposalClient) GetX(ctx context.Context, id int) *WordProposal {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a WordProposal.
+func (c *WordProposalClient) QueryUser(wp *WordProposal) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := wp.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wordproposal.Table, wordproposal.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wordproposal.UserTable, wordproposal.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(wp.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WordProposalClient) Hooks() []Hook {
+	return c.hooks.WordProposal
+}
+
+// Interceptors returns the client interceptors.
+func (c *WordProposalClient) Interceptors() []Interceptor {
+	return c.inters.WordProposal
+}
+
+func (c *WordProposalClient) mutate(ctx context.Context, m *WordProposalMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WordProposalCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WordProposalUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WordProposalUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WordProposalDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WordProposal mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ExternalAuth, JapaneseMean, PartOfSpeech, Quiz, QuizQuestion, RegisteredWord,
-		RootConfig, User, UserConfig, UserDailyUsage, Word, WordInfo []ent.Hook
+		Deck, DeckWord, DictImportFailure, DictImportRun, ExternalAuth, JapaneseMean,
+		PartOfSpeech, PosMapping, PosMappingVersion, Quiz, QuizQuestion, RefreshToken,
+		RegisteredWord, RootConfig, User, UserConfig, UserDailyUsage, Word, WordInfo,
+		WordProposal []ent.Hook
 	}
 	inters struct {
-		ExternalAuth, JapaneseMean, PartOfSpeech, Quiz, QuizQuestion, RegisteredWord,
-		RootConfig, User, UserConfig, UserDailyUsage, Word, WordInfo []ent.Interceptor
+		Deck, DeckWord, DictImportFailure, DictImportRun, ExternalAuth, JapaneseMean,
+		PartOfSpeech, PosMapping, PosMappingVersion, Quiz, QuizQuestion, RefreshToken,
+		RegisteredWord, RootConfig, User, UserConfig, UserDailyUsage, Word, WordInfo,
+		WordProposal []ent.Interceptor
 	}
 )
 
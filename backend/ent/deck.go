@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/user"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Deck is the model entity for the Deck schema.
+type Deck struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// 長さの上限は usecase で文字数として検査する
+	Name string `json:"name,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Visibility holds the value of the "visibility" field.
+	Visibility deck.Visibility `json:"visibility,omitempty"`
+	// リンク共有用のトークン。link 以外では NULL
+	ShareToken *string `json:"share_token,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeckQuery when eager-loading is set.
+	Edges        DeckEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeckEdges holds the relations/edges for other nodes in the graph.
+type DeckEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// DeckWords holds the value of the deck_words edge.
+	DeckWords []*DeckWord `json:"deck_words,omitempty"`
+	// Quizzes holds the value of the quizzes edge.
+	Quizzes []*Quiz `json:"quizzes,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeckEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// DeckWordsOrErr returns the DeckWords value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeckEdges) DeckWordsOrErr() ([]*DeckWord, error) {
+	if e.loadedTypes[1] {
+		return e.DeckWords, nil
+	}
+	return nil, &NotLoadedError{edge: "deck_words"}
+}
+
+// QuizzesOrErr returns the Quizzes value or an error if the edge
+// was not loaded in eager-loading.
+func (e DeckEdges) QuizzesOrErr() ([]*Quiz, error) {
+	if e.loadedTypes[2] {
+		return e.Quizzes, nil
+	}
+	return nil, &NotLoadedError{edge: "quizzes"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Deck) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deck.FieldID, deck.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case deck.FieldName, deck.FieldDescription, deck.FieldVisibility, deck.FieldShareToken:
+			values[i] = new(sql.NullString)
+		case deck.FieldCreatedAt, deck.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Deck fields.
+func (d *Deck) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deck.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			d.ID = int(value.Int64)
+		case deck.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				d.UserID = int(value.Int64)
+			}
+		case deck.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				d.Name = value.String
+			}
+		case deck.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				d.Description = value.String
+			}
+		case deck.FieldVisibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field visibility", values[i])
+			} else if value.Valid {
+				d.Visibility = deck.Visibility(value.String)
+			}
+		case deck.FieldShareToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field share_token", values[i])
+			} else if value.Valid {
+				d.ShareToken = new(string)
+				*d.ShareToken = value.String
+			}
+		case deck.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				d.CreatedAt = value.Time
+			}
+		case deck.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				d.UpdatedAt = value.Time
+			}
+		default:
+			d.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Deck.
+// This includes values selected through modifiers, order, etc.
+func (d *Deck) Value(name string) (ent.Value, error) {
+	return d.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Deck entity.
+func (d *Deck) QueryUser() *UserQuery {
+	return NewDeckClient(d.config).QueryUser(d)
+}
+
+// QueryDeckWords queries the "deck_words" edge of the Deck entity.
+func (d *Deck) QueryDeckWords() *DeckWordQuery {
+	return NewDeckClient(d.config).QueryDeckWords(d)
+}
+
+// QueryQuizzes queries the "quizzes" edge of the Deck entity.
+func (d *Deck) QueryQuizzes() *QuizQuery {
+	return NewDeckClient(d.config).QueryQuizzes(d)
+}
+
+// Update returns a builder for updating this Deck.
+// Note that you need to call Deck.Unwrap() before calling this method if this Deck
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (d *Deck) Update() *DeckUpdateOne {
+	return NewDeckClient(d.config).UpdateOne(d)
+}
+
+// Unwrap unwraps the Deck entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (d *Deck) Unwrap() *Deck {
+	_tx, ok := d.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Deck is not a transactional entity")
+	}
+	d.config.driver = _tx.drv
+	return d
+}
+
+// String implements the fmt.Stringer.
+func (d *Deck) String() string {
+	var builder strings.Builder
+	builder.WriteString("Deck(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", d.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", d.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(d.Name)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(d.Description)
+	builder.WriteString(", ")
+	builder.WriteString("visibility=")
+	builder.WriteString(fmt.Sprintf("%v", d.Visibility))
+	builder.WriteString(", ")
+	if v := d.ShareToken; v != nil {
+		builder.WriteString("share_token=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(d.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(d.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Decks is a parsable slice of Deck.
+type Decks []*Deck
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deck
+
+import (
+	"time"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Deck {
+	return predicate.Deck(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldName, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldDescription, v))
+}
+
+// ShareToken applies equality check predicate on the "share_token" field. It's identical to ShareTokenEQ.
+func ShareToken(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldShareToken, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldContainsFold(FieldName, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// VisibilityEQ applies the EQ predicate on the "visibility" field.
+func VisibilityEQ(v Visibility) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldVisibility, v))
+}
+
+// VisibilityNEQ applies the NEQ predicate on the "visibility" field.
+func VisibilityNEQ(v Visibility) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldVisibility, v))
+}
+
+// VisibilityIn applies the In predicate on the "visibility" field.
+func VisibilityIn(vs ...Visibility) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldVisibility, vs...))
+}
+
+// VisibilityNotIn applies the NotIn predicate on the "visibility" field.
+func VisibilityNotIn(vs ...Visibility) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldVisibility, vs...))
+}
+
+// ShareTokenEQ applies the EQ predicate on the "share_token" field.
+func ShareTokenEQ(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldShareToken, v))
+}
+
+// ShareTokenNEQ applies the NEQ predicate on the "share_token" field.
+func ShareTokenNEQ(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldShareToken, v))
+}
+
+// ShareTokenIn applies the In predicate on the "share_token" field.
+func ShareTokenIn(vs ...string) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldShareToken, vs...))
+}
+
+// ShareTokenNotIn applies the NotIn predicate on the "share_token" field.
+func ShareTokenNotIn(vs ...string) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldShareToken, vs...))
+}
+
+// ShareTokenGT applies the GT predicate on the "share_token" field.
+func ShareTokenGT(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldGT(FieldShareToken, v))
+}
+
+// ShareTokenGTE applies the GTE predicate on the "share_token" field.
+func ShareTokenGTE(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldGTE(FieldShareToken, v))
+}
+
+// ShareTokenLT applies the LT predicate on the "share_token" field.
+func ShareTokenLT(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldLT(FieldShareToken, v))
+}
+
+// ShareTokenLTE applies the LTE predicate on the "share_token" field.
+func ShareTokenLTE(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldLTE(FieldShareToken, v))
+}
+
+// ShareTokenContains applies the Contains predicate on the "share_token" field.
+func ShareTokenContains(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldContains(FieldShareToken, v))
+}
+
+// ShareTokenHasPrefix applies the HasPrefix predicate on the "share_token" field.
+func ShareTokenHasPrefix(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldHasPrefix(FieldShareToken, v))
+}
+
+// ShareTokenHasSuffix applies the HasSuffix predicate on the "share_token" field.
+func ShareTokenHasSuffix(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldHasSuffix(FieldShareToken, v))
+}
+
+// ShareTokenIsNil applies the IsNil predicate on the "share_token" field.
+func ShareTokenIsNil() predicate.Deck {
+	return predicate.Deck(sql.FieldIsNull(FieldShareToken))
+}
+
+// ShareTokenNotNil applies the NotNil predicate on the "share_token" field.
+func ShareTokenNotNil() predicate.Deck {
+	return predicate.Deck(sql.FieldNotNull(FieldShareToken))
+}
+
+// ShareTokenEqualFold applies the EqualFold predicate on the "share_token" field.
+func ShareTokenEqualFold(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldEqualFold(FieldShareToken, v))
+}
+
+// ShareTokenContainsFold applies the ContainsFold predicate on the "share_token" field.
+func ShareTokenContainsFold(v string) predicate.Deck {
+	return predicate.Deck(sql.FieldContainsFold(FieldShareToken, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Deck {
+	return predicate.Deck(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Deck {
+	return predicate.Deck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Deck {
+	return predicate.Deck(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasDeckWords applies the HasEdge predicate on the "deck_words" edge.
+func HasDeckWords() predicate.Deck {
+	return predicate.Deck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeckWordsTable, DeckWordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeckWordsWith applies the HasEdge predicate on the "deck_words" edge with a given conditions (other predicates).
+func HasDeckWordsWith(preds ...predicate.DeckWord) predicate.Deck {
+	return predicate.Deck(func(s *sql.Selector) {
+		step := newDeckWordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasQuizzes applies the HasEdge predicate on the "quizzes" edge.
+func HasQuizzes() predicate.Deck {
+	return predicate.Deck(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, QuizzesTable, QuizzesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasQuizzesWith applies the HasEdge predicate on the "quizzes" edge with a given conditions (other predicates).
+func HasQuizzesWith(preds ...predicate.Quiz) predicate.Deck {
+	return predicate.Deck(func(s *sql.Selector) {
+		step := newQuizzesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Deck) predicate.Deck {
+	return predicate.Deck(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Deck) predicate.Deck {
+	return predicate.Deck(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Deck) predicate.Deck {
+	return predicate.Deck(sql.NotPredicates(p))
+}
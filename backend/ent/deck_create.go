@@ -0,0 +1,925 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckCreate is the builder for creating a Deck entity.
+type DeckCreate struct {
+	config
+	mutation *DeckMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (dc *DeckCreate) SetUserID(i int) *DeckCreate {
+	dc.mutation.SetUserID(i)
+	return dc
+}
+
+// SetName sets the "name" field.
+func (dc *DeckCreate) SetName(s string) *DeckCreate {
+	dc.mutation.SetName(s)
+	return dc
+}
+
+// SetDescription sets the "description" field.
+func (dc *DeckCreate) SetDescription(s string) *DeckCreate {
+	dc.mutation.SetDescription(s)
+	return dc
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableDescription(s *string) *DeckCreate {
+	if s != nil {
+		dc.SetDescription(*s)
+	}
+	return dc
+}
+
+// SetVisibility sets the "visibility" field.
+func (dc *DeckCreate) SetVisibility(d deck.Visibility) *DeckCreate {
+	dc.mutation.SetVisibility(d)
+	return dc
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableVisibility(d *deck.Visibility) *DeckCreate {
+	if d != nil {
+		dc.SetVisibility(*d)
+	}
+	return dc
+}
+
+// SetShareToken sets the "share_token" field.
+func (dc *DeckCreate) SetShareToken(s string) *DeckCreate {
+	dc.mutation.SetShareToken(s)
+	return dc
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableShareToken(s *string) *DeckCreate {
+	if s != nil {
+		dc.SetShareToken(*s)
+	}
+	return dc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dc *DeckCreate) SetCreatedAt(t time.Time) *DeckCreate {
+	dc.mutation.SetCreatedAt(t)
+	return dc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableCreatedAt(t *time.Time) *DeckCreate {
+	if t != nil {
+		dc.SetCreatedAt(*t)
+	}
+	return dc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dc *DeckCreate) SetUpdatedAt(t time.Time) *DeckCreate {
+	dc.mutation.SetUpdatedAt(t)
+	return dc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dc *DeckCreate) SetNillableUpdatedAt(t *time.Time) *DeckCreate {
+	if t != nil {
+		dc.SetUpdatedAt(*t)
+	}
+	return dc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (dc *DeckCreate) SetUser(u *User) *DeckCreate {
+	return dc.SetUserID(u.ID)
+}
+
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by IDs.
+func (dc *DeckCreate) AddDeckWordIDs(ids ...int) *DeckCreate {
+	dc.mutation.AddDeckWordIDs(ids...)
+	return dc
+}
+
+// AddDeckWords adds the "deck_words" edges to the DeckWord entity.
+func (dc *DeckCreate) AddDeckWords(d ...*DeckWord) *DeckCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dc.AddDeckWordIDs(ids...)
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (dc *DeckCreate) AddQuizIDs(ids ...int) *DeckCreate {
+	dc.mutation.AddQuizIDs(ids...)
+	return dc
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (dc *DeckCreate) AddQuizzes(q ...*Quiz) *DeckCreate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return dc.AddQuizIDs(ids...)
+}
+
+// Mutation returns the DeckMutation object of the builder.
+func (dc *DeckCreate) Mutation() *DeckMutation {
+	return dc.mutation
+}
+
+// Save creates the Deck in the database.
+func (dc *DeckCreate) Save(ctx context.Context) (*Deck, error) {
+	dc.defaults()
+	return withHooks(ctx, dc.sqlSave, dc.mutation, dc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dc *DeckCreate) SaveX(ctx context.Context) *Deck {
+	v, err := dc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dc *DeckCreate) Exec(ctx context.Context) error {
+	_, err := dc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dc *DeckCreate) ExecX(ctx context.Context) {
+	if err := dc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dc *DeckCreate) defaults() {
+	if _, ok := dc.mutation.Description(); !ok {
+		v := deck.DefaultDescription
+		dc.mutation.SetDescription(v)
+	}
+	if _, ok := dc.mutation.Visibility(); !ok {
+		v := deck.DefaultVisibility
+		dc.mutation.SetVisibility(v)
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		v := deck.DefaultCreatedAt()
+		dc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		v := deck.DefaultUpdatedAt()
+		dc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dc *DeckCreate) check() error {
+	if _, ok := dc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Deck.user_id"`)}
+	}
+	if v, ok := dc.mutation.UserID(); ok {
+		if err := deck.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Deck.user_id": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Deck.name"`)}
+	}
+	if v, ok := dc.mutation.Name(); ok {
+		if err := deck.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Deck.name": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.Description(); !ok {
+		return &ValidationError{Name: "description", err: errors.New(`ent: missing required field "Deck.description"`)}
+	}
+	if _, ok := dc.mutation.Visibility(); !ok {
+		return &ValidationError{Name: "visibility", err: errors.New(`ent: missing required field "Deck.visibility"`)}
+	}
+	if v, ok := dc.mutation.Visibility(); ok {
+		if err := deck.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Deck.visibility": %w`, err)}
+		}
+	}
+	if _, ok := dc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Deck.created_at"`)}
+	}
+	if _, ok := dc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Deck.updated_at"`)}
+	}
+	if len(dc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Deck.user"`)}
+	}
+	return nil
+}
+
+func (dc *DeckCreate) sqlSave(ctx context.Context) (*Deck, error) {
+	if err := dc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dc.mutation.id = &_node.ID
+	dc.mutation.done = true
+	return _node, nil
+}
+
+func (dc *DeckCreate) createSpec() (*Deck, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Deck{config: dc.config}
+		_spec = sqlgraph.NewCreateSpec(deck.Table, sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = dc.conflict
+	if value, ok := dc.mutation.Name(); ok {
+		_spec.SetField(deck.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := dc.mutation.Description(); ok {
+		_spec.SetField(deck.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := dc.mutation.Visibility(); ok {
+		_spec.SetField(deck.FieldVisibility, field.TypeEnum, value)
+		_node.Visibility = value
+	}
+	if value, ok := dc.mutation.ShareToken(); ok {
+		_spec.SetField(deck.FieldShareToken, field.TypeString, value)
+		_node.ShareToken = &value
+	}
+	if value, ok := dc.mutation.CreatedAt(); ok {
+		_spec.SetField(deck.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := dc.mutation.UpdatedAt(); ok {
+		_spec.SetField(deck.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := dc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.UserTable,
+			Columns: []string{deck.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.DeckWordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dc.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Deck.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeckUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (dc *DeckCreate) OnConflict(opts ...sql.ConflictOption) *DeckUpsertOne {
+	dc.conflict = opts
+	return &DeckUpsertOne{
+		create: dc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Deck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dc *DeckCreate) OnConflictColumns(columns ...string) *DeckUpsertOne {
+	dc.conflict = append(dc.conflict, sql.ConflictColumns(columns...))
+	return &DeckUpsertOne{
+		create: dc,
+	}
+}
+
+type (
+	// DeckUpsertOne is the builder for "upsert"-ing
+	//  one Deck node.
+	DeckUpsertOne struct {
+		create *DeckCreate
+	}
+
+	// DeckUpsert is the "OnConflict" setter.
+	DeckUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *DeckUpsert) SetUserID(v int) *DeckUpsert {
+	u.Set(deck.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeckUpsert) UpdateUserID() *DeckUpsert {
+	u.SetExcluded(deck.FieldUserID)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *DeckUpsert) SetName(v string) *DeckUpsert {
+	u.Set(deck.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DeckUpsert) UpdateName() *DeckUpsert {
+	u.SetExcluded(deck.FieldName)
+	return u
+}
+
+// SetDescription sets the "description" field.
+func (u *DeckUpsert) SetDescription(v string) *DeckUpsert {
+	u.Set(deck.FieldDescription, v)
+	return u
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DeckUpsert) UpdateDescription() *DeckUpsert {
+	u.SetExcluded(deck.FieldDescription)
+	return u
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *DeckUpsert) SetVisibility(v deck.Visibility) *DeckUpsert {
+	u.Set(deck.FieldVisibility, v)
+	return u
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *DeckUpsert) UpdateVisibility() *DeckUpsert {
+	u.SetExcluded(deck.FieldVisibility)
+	return u
+}
+
+// SetShareToken sets the "share_token" field.
+func (u *DeckUpsert) SetShareToken(v string) *DeckUpsert {
+	u.Set(deck.FieldShareToken, v)
+	return u
+}
+
+// UpdateShareToken sets the "share_token" field to the value that was provided on create.
+func (u *DeckUpsert) UpdateShareToken() *DeckUpsert {
+	u.SetExcluded(deck.FieldShareToken)
+	return u
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (u *DeckUpsert) ClearShareToken() *DeckUpsert {
+	u.SetNull(deck.FieldShareToken)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeckUpsert) SetUpdatedAt(v time.Time) *DeckUpsert {
+	u.Set(deck.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeckUpsert) UpdateUpdatedAt() *DeckUpsert {
+	u.SetExcluded(deck.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.Deck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeckUpsertOne) UpdateNewValues() *DeckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deck.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Deck.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeckUpsertOne) Ignore() *DeckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeckUpsertOne) DoNothing() *DeckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeckCreate.OnConflict
+// documentation for more info.
+func (u *DeckUpsertOne) Update(set func(*DeckUpsert)) *DeckUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeckUpsertOne) SetUserID(v int) *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeckUpsertOne) UpdateUserID() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DeckUpsertOne) SetName(v string) *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DeckUpsertOne) UpdateName() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *DeckUpsertOne) SetDescription(v string) *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DeckUpsertOne) UpdateDescription() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *DeckUpsertOne) SetVisibility(v deck.Visibility) *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *DeckUpsertOne) UpdateVisibility() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetShareToken sets the "share_token" field.
+func (u *DeckUpsertOne) SetShareToken(v string) *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetShareToken(v)
+	})
+}
+
+// UpdateShareToken sets the "share_token" field to the value that was provided on create.
+func (u *DeckUpsertOne) UpdateShareToken() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateShareToken()
+	})
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (u *DeckUpsertOne) ClearShareToken() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.ClearShareToken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeckUpsertOne) SetUpdatedAt(v time.Time) *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeckUpsertOne) UpdateUpdatedAt() *DeckUpsertOne {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeckUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeckCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeckUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeckUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeckUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeckCreateBulk is the builder for creating many Deck entities in bulk.
+type DeckCreateBulk struct {
+	config
+	err      error
+	builders []*DeckCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the Deck entities in the database.
+func (dcb *DeckCreateBulk) Save(ctx context.Context) ([]*Deck, error) {
+	if dcb.err != nil {
+		return nil, dcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dcb.builders))
+	nodes := make([]*Deck, len(dcb.builders))
+	mutators := make([]Mutator, len(dcb.builders))
+	for i := range dcb.builders {
+		func(i int, root context.Context) {
+			builder := dcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeckMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dcb *DeckCreateBulk) SaveX(ctx context.Context) []*Deck {
+	v, err := dcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dcb *DeckCreateBulk) Exec(ctx context.Context) error {
+	_, err := dcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dcb *DeckCreateBulk) ExecX(ctx context.Context) {
+	if err := dcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Deck.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeckUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (dcb *DeckCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeckUpsertBulk {
+	dcb.conflict = opts
+	return &DeckUpsertBulk{
+		create: dcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Deck.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dcb *DeckCreateBulk) OnConflictColumns(columns ...string) *DeckUpsertBulk {
+	dcb.conflict = append(dcb.conflict, sql.ConflictColumns(columns...))
+	return &DeckUpsertBulk{
+		create: dcb,
+	}
+}
+
+// DeckUpsertBulk is the builder for "upsert"-ing
+// a bulk of Deck nodes.
+type DeckUpsertBulk struct {
+	create *DeckCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Deck.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeckUpsertBulk) UpdateNewValues() *DeckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deck.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Deck.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeckUpsertBulk) Ignore() *DeckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeckUpsertBulk) DoNothing() *DeckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeckCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeckUpsertBulk) Update(set func(*DeckUpsert)) *DeckUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeckUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *DeckUpsertBulk) SetUserID(v int) *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *DeckUpsertBulk) UpdateUserID() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *DeckUpsertBulk) SetName(v string) *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *DeckUpsertBulk) UpdateName() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetDescription sets the "description" field.
+func (u *DeckUpsertBulk) SetDescription(v string) *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetDescription(v)
+	})
+}
+
+// UpdateDescription sets the "description" field to the value that was provided on create.
+func (u *DeckUpsertBulk) UpdateDescription() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateDescription()
+	})
+}
+
+// SetVisibility sets the "visibility" field.
+func (u *DeckUpsertBulk) SetVisibility(v deck.Visibility) *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetVisibility(v)
+	})
+}
+
+// UpdateVisibility sets the "visibility" field to the value that was provided on create.
+func (u *DeckUpsertBulk) UpdateVisibility() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateVisibility()
+	})
+}
+
+// SetShareToken sets the "share_token" field.
+func (u *DeckUpsertBulk) SetShareToken(v string) *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetShareToken(v)
+	})
+}
+
+// UpdateShareToken sets the "share_token" field to the value that was provided on create.
+func (u *DeckUpsertBulk) UpdateShareToken() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateShareToken()
+	})
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (u *DeckUpsertBulk) ClearShareToken() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.ClearShareToken()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DeckUpsertBulk) SetUpdatedAt(v time.Time) *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DeckUpsertBulk) UpdateUpdatedAt() *DeckUpsertBulk {
+	return u.Update(func(s *DeckUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DeckUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeckCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeckCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeckUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,869 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/user"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckUpdate is the builder for updating Deck entities.
+type DeckUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeckMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeckUpdate builder.
+func (du *DeckUpdate) Where(ps ...predicate.Deck) *DeckUpdate {
+	du.mutation.Where(ps...)
+	return du
+}
+
+// SetUserID sets the "user_id" field.
+func (du *DeckUpdate) SetUserID(i int) *DeckUpdate {
+	du.mutation.SetUserID(i)
+	return du
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (du *DeckUpdate) SetNillableUserID(i *int) *DeckUpdate {
+	if i != nil {
+		du.SetUserID(*i)
+	}
+	return du
+}
+
+// SetName sets the "name" field.
+func (du *DeckUpdate) SetName(s string) *DeckUpdate {
+	du.mutation.SetName(s)
+	return du
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (du *DeckUpdate) SetNillableName(s *string) *DeckUpdate {
+	if s != nil {
+		du.SetName(*s)
+	}
+	return du
+}
+
+// SetDescription sets the "description" field.
+func (du *DeckUpdate) SetDescription(s string) *DeckUpdate {
+	du.mutation.SetDescription(s)
+	return du
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (du *DeckUpdate) SetNillableDescription(s *string) *DeckUpdate {
+	if s != nil {
+		du.SetDescription(*s)
+	}
+	return du
+}
+
+// SetVisibility sets the "visibility" field.
+func (du *DeckUpdate) SetVisibility(d deck.Visibility) *DeckUpdate {
+	du.mutation.SetVisibility(d)
+	return du
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (du *DeckUpdate) SetNillableVisibility(d *deck.Visibility) *DeckUpdate {
+	if d != nil {
+		du.SetVisibility(*d)
+	}
+	return du
+}
+
+// SetShareToken sets the "share_token" field.
+func (du *DeckUpdate) SetShareToken(s string) *DeckUpdate {
+	du.mutation.SetShareToken(s)
+	return du
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (du *DeckUpdate) SetNillableShareToken(s *string) *DeckUpdate {
+	if s != nil {
+		du.SetShareToken(*s)
+	}
+	return du
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (du *DeckUpdate) ClearShareToken() *DeckUpdate {
+	du.mutation.ClearShareToken()
+	return du
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (du *DeckUpdate) SetUpdatedAt(t time.Time) *DeckUpdate {
+	du.mutation.SetUpdatedAt(t)
+	return du
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (du *DeckUpdate) SetUser(u *User) *DeckUpdate {
+	return du.SetUserID(u.ID)
+}
+
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by IDs.
+func (du *DeckUpdate) AddDeckWordIDs(ids ...int) *DeckUpdate {
+	du.mutation.AddDeckWordIDs(ids...)
+	return du
+}
+
+// AddDeckWords adds the "deck_words" edges to the DeckWord entity.
+func (du *DeckUpdate) AddDeckWords(d ...*DeckWord) *DeckUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.AddDeckWordIDs(ids...)
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (du *DeckUpdate) AddQuizIDs(ids ...int) *DeckUpdate {
+	du.mutation.AddQuizIDs(ids...)
+	return du
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (du *DeckUpdate) AddQuizzes(q ...*Quiz) *DeckUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return du.AddQuizIDs(ids...)
+}
+
+// Mutation returns the DeckMutation object of the builder.
+func (du *DeckUpdate) Mutation() *DeckMutation {
+	return du.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (du *DeckUpdate) ClearUser() *DeckUpdate {
+	du.mutation.ClearUser()
+	return du
+}
+
+// ClearDeckWords clears all "deck_words" edges to the DeckWord entity.
+func (du *DeckUpdate) ClearDeckWords() *DeckUpdate {
+	du.mutation.ClearDeckWords()
+	return du
+}
+
+// RemoveDeckWordIDs removes the "deck_words" edge to DeckWord entities by IDs.
+func (du *DeckUpdate) RemoveDeckWordIDs(ids ...int) *DeckUpdate {
+	du.mutation.RemoveDeckWordIDs(ids...)
+	return du
+}
+
+// RemoveDeckWords removes "deck_words" edges to DeckWord entities.
+func (du *DeckUpdate) RemoveDeckWords(d ...*DeckWord) *DeckUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return du.RemoveDeckWordIDs(ids...)
+}
+
+// ClearQuizzes clears all "quizzes" edges to the Quiz entity.
+func (du *DeckUpdate) ClearQuizzes() *DeckUpdate {
+	du.mutation.ClearQuizzes()
+	return du
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to Quiz entities by IDs.
+func (du *DeckUpdate) RemoveQuizIDs(ids ...int) *DeckUpdate {
+	du.mutation.RemoveQuizIDs(ids...)
+	return du
+}
+
+// RemoveQuizzes removes "quizzes" edges to Quiz entities.
+func (du *DeckUpdate) RemoveQuizzes(q ...*Quiz) *DeckUpdate {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return du.RemoveQuizIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (du *DeckUpdate) Save(ctx context.Context) (int, error) {
+	du.defaults()
+	return withHooks(ctx, du.sqlSave, du.mutation, du.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (du *DeckUpdate) SaveX(ctx context.Context) int {
+	affected, err := du.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (du *DeckUpdate) Exec(ctx context.Context) error {
+	_, err := du.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (du *DeckUpdate) ExecX(ctx context.Context) {
+	if err := du.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (du *DeckUpdate) defaults() {
+	if _, ok := du.mutation.UpdatedAt(); !ok {
+		v := deck.UpdateDefaultUpdatedAt()
+		du.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (du *DeckUpdate) check() error {
+	if v, ok := du.mutation.UserID(); ok {
+		if err := deck.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Deck.user_id": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Name(); ok {
+		if err := deck.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Deck.name": %w`, err)}
+		}
+	}
+	if v, ok := du.mutation.Visibility(); ok {
+		if err := deck.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Deck.visibility": %w`, err)}
+		}
+	}
+	if du.mutation.UserCleared() && len(du.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Deck.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (du *DeckUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeckUpdate {
+	du.modifiers = append(du.modifiers, modifiers...)
+	return du
+}
+
+func (du *DeckUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := du.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deck.Table, deck.Columns, sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt))
+	if ps := du.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := du.mutation.Name(); ok {
+		_spec.SetField(deck.FieldName, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Description(); ok {
+		_spec.SetField(deck.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := du.mutation.Visibility(); ok {
+		_spec.SetField(deck.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := du.mutation.ShareToken(); ok {
+		_spec.SetField(deck.FieldShareToken, field.TypeString, value)
+	}
+	if du.mutation.ShareTokenCleared() {
+		_spec.ClearField(deck.FieldShareToken, field.TypeString)
+	}
+	if value, ok := du.mutation.UpdatedAt(); ok {
+		_spec.SetField(deck.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if du.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.UserTable,
+			Columns: []string{deck.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.UserTable,
+			Columns: []string{deck.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedDeckWordsIDs(); len(nodes) > 0 && !du.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.DeckWordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if du.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.RemovedQuizzesIDs(); len(nodes) > 0 && !du.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := du.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(du.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, du.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	du.mutation.done = true
+	return n, nil
+}
+
+// DeckUpdateOne is the builder for updating a single Deck entity.
+type DeckUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeckMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (duo *DeckUpdateOne) SetUserID(i int) *DeckUpdateOne {
+	duo.mutation.SetUserID(i)
+	return duo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (duo *DeckUpdateOne) SetNillableUserID(i *int) *DeckUpdateOne {
+	if i != nil {
+		duo.SetUserID(*i)
+	}
+	return duo
+}
+
+// SetName sets the "name" field.
+func (duo *DeckUpdateOne) SetName(s string) *DeckUpdateOne {
+	duo.mutation.SetName(s)
+	return duo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (duo *DeckUpdateOne) SetNillableName(s *string) *DeckUpdateOne {
+	if s != nil {
+		duo.SetName(*s)
+	}
+	return duo
+}
+
+// SetDescription sets the "description" field.
+func (duo *DeckUpdateOne) SetDescription(s string) *DeckUpdateOne {
+	duo.mutation.SetDescription(s)
+	return duo
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (duo *DeckUpdateOne) SetNillableDescription(s *string) *DeckUpdateOne {
+	if s != nil {
+		duo.SetDescription(*s)
+	}
+	return duo
+}
+
+// SetVisibility sets the "visibility" field.
+func (duo *DeckUpdateOne) SetVisibility(d deck.Visibility) *DeckUpdateOne {
+	duo.mutation.SetVisibility(d)
+	return duo
+}
+
+// SetNillableVisibility sets the "visibility" field if the given value is not nil.
+func (duo *DeckUpdateOne) SetNillableVisibility(d *deck.Visibility) *DeckUpdateOne {
+	if d != nil {
+		duo.SetVisibility(*d)
+	}
+	return duo
+}
+
+// SetShareToken sets the "share_token" field.
+func (duo *DeckUpdateOne) SetShareToken(s string) *DeckUpdateOne {
+	duo.mutation.SetShareToken(s)
+	return duo
+}
+
+// SetNillableShareToken sets the "share_token" field if the given value is not nil.
+func (duo *DeckUpdateOne) SetNillableShareToken(s *string) *DeckUpdateOne {
+	if s != nil {
+		duo.SetShareToken(*s)
+	}
+	return duo
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (duo *DeckUpdateOne) ClearShareToken() *DeckUpdateOne {
+	duo.mutation.ClearShareToken()
+	return duo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (duo *DeckUpdateOne) SetUpdatedAt(t time.Time) *DeckUpdateOne {
+	duo.mutation.SetUpdatedAt(t)
+	return duo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (duo *DeckUpdateOne) SetUser(u *User) *DeckUpdateOne {
+	return duo.SetUserID(u.ID)
+}
+
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by IDs.
+func (duo *DeckUpdateOne) AddDeckWordIDs(ids ...int) *DeckUpdateOne {
+	duo.mutation.AddDeckWordIDs(ids...)
+	return duo
+}
+
+// AddDeckWords adds the "deck_words" edges to the DeckWord entity.
+func (duo *DeckUpdateOne) AddDeckWords(d ...*DeckWord) *DeckUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.AddDeckWordIDs(ids...)
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by IDs.
+func (duo *DeckUpdateOne) AddQuizIDs(ids ...int) *DeckUpdateOne {
+	duo.mutation.AddQuizIDs(ids...)
+	return duo
+}
+
+// AddQuizzes adds the "quizzes" edges to the Quiz entity.
+func (duo *DeckUpdateOne) AddQuizzes(q ...*Quiz) *DeckUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return duo.AddQuizIDs(ids...)
+}
+
+// Mutation returns the DeckMutation object of the builder.
+func (duo *DeckUpdateOne) Mutation() *DeckMutation {
+	return duo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (duo *DeckUpdateOne) ClearUser() *DeckUpdateOne {
+	duo.mutation.ClearUser()
+	return duo
+}
+
+// ClearDeckWords clears all "deck_words" edges to the DeckWord entity.
+func (duo *DeckUpdateOne) ClearDeckWords() *DeckUpdateOne {
+	duo.mutation.ClearDeckWords()
+	return duo
+}
+
+// RemoveDeckWordIDs removes the "deck_words" edge to DeckWord entities by IDs.
+func (duo *DeckUpdateOne) RemoveDeckWordIDs(ids ...int) *DeckUpdateOne {
+	duo.mutation.RemoveDeckWordIDs(ids...)
+	return duo
+}
+
+// RemoveDeckWords removes "deck_words" edges to DeckWord entities.
+func (duo *DeckUpdateOne) RemoveDeckWords(d ...*DeckWord) *DeckUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return duo.RemoveDeckWordIDs(ids...)
+}
+
+// ClearQuizzes clears all "quizzes" edges to the Quiz entity.
+func (duo *DeckUpdateOne) ClearQuizzes() *DeckUpdateOne {
+	duo.mutation.ClearQuizzes()
+	return duo
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to Quiz entities by IDs.
+func (duo *DeckUpdateOne) RemoveQuizIDs(ids ...int) *DeckUpdateOne {
+	duo.mutation.RemoveQuizIDs(ids...)
+	return duo
+}
+
+// RemoveQuizzes removes "quizzes" edges to Quiz entities.
+func (duo *DeckUpdateOne) RemoveQuizzes(q ...*Quiz) *DeckUpdateOne {
+	ids := make([]int, len(q))
+	for i := range q {
+		ids[i] = q[i].ID
+	}
+	return duo.RemoveQuizIDs(ids...)
+}
+
+// Where appends a list predicates to the DeckUpdate builder.
+func (duo *DeckUpdateOne) Where(ps ...predicate.Deck) *DeckUpdateOne {
+	duo.mutation.Where(ps...)
+	return duo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (duo *DeckUpdateOne) Select(field string, fields ...string) *DeckUpdateOne {
+	duo.fields = append([]string{field}, fields...)
+	return duo
+}
+
+// Save executes the query and returns the updated Deck entity.
+func (duo *DeckUpdateOne) Save(ctx context.Context) (*Deck, error) {
+	duo.defaults()
+	return withHooks(ctx, duo.sqlSave, duo.mutation, duo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (duo *DeckUpdateOne) SaveX(ctx context.Context) *Deck {
+	node, err := duo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (duo *DeckUpdateOne) Exec(ctx context.Context) error {
+	_, err := duo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (duo *DeckUpdateOne) ExecX(ctx context.Context) {
+	if err := duo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (duo *DeckUpdateOne) defaults() {
+	if _, ok := duo.mutation.UpdatedAt(); !ok {
+		v := deck.UpdateDefaultUpdatedAt()
+		duo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (duo *DeckUpdateOne) check() error {
+	if v, ok := duo.mutation.UserID(); ok {
+		if err := deck.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "Deck.user_id": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Name(); ok {
+		if err := deck.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Deck.name": %w`, err)}
+		}
+	}
+	if v, ok := duo.mutation.Visibility(); ok {
+		if err := deck.VisibilityValidator(v); err != nil {
+			return &ValidationError{Name: "visibility", err: fmt.Errorf(`ent: validator failed for field "Deck.visibility": %w`, err)}
+		}
+	}
+	if duo.mutation.UserCleared() && len(duo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Deck.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (duo *DeckUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeckUpdateOne {
+	duo.modifiers = append(duo.modifiers, modifiers...)
+	return duo
+}
+
+func (duo *DeckUpdateOne) sqlSave(ctx context.Context) (_node *Deck, err error) {
+	if err := duo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deck.Table, deck.Columns, sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt))
+	id, ok := duo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Deck.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := duo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deck.FieldID)
+		for _, f := range fields {
+			if !deck.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deck.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := duo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := duo.mutation.Name(); ok {
+		_spec.SetField(deck.FieldName, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Description(); ok {
+		_spec.SetField(deck.FieldDescription, field.TypeString, value)
+	}
+	if value, ok := duo.mutation.Visibility(); ok {
+		_spec.SetField(deck.FieldVisibility, field.TypeEnum, value)
+	}
+	if value, ok := duo.mutation.ShareToken(); ok {
+		_spec.SetField(deck.FieldShareToken, field.TypeString, value)
+	}
+	if duo.mutation.ShareTokenCleared() {
+		_spec.ClearField(deck.FieldShareToken, field.TypeString)
+	}
+	if value, ok := duo.mutation.UpdatedAt(); ok {
+		_spec.SetField(deck.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if duo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.UserTable,
+			Columns: []string{deck.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.UserTable,
+			Columns: []string{deck.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedDeckWordsIDs(); len(nodes) > 0 && !duo.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.DeckWordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if duo.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.RemovedQuizzesIDs(); len(nodes) > 0 && !duo.mutation.QuizzesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := duo.mutation.QuizzesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(quiz.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(duo.modifiers...)
+	_node = &Deck{config: duo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, duo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deck.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	duo.mutation.done = true
+	return _node, nil
+}
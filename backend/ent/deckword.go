@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/word"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DeckWord is the model entity for the DeckWord schema.
+type DeckWord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// DeckID holds the value of the "deck_id" field.
+	DeckID int `json:"deck_id,omitempty"`
+	// WordID holds the value of the "word_id" field.
+	WordID int `json:"word_id,omitempty"`
+	// デッキ内の並び順（0 始まり）
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DeckWordQuery when eager-loading is set.
+	Edges        DeckWordEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DeckWordEdges holds the relations/edges for other nodes in the graph.
+type DeckWordEdges struct {
+	// Deck holds the value of the deck edge.
+	Deck *Deck `json:"deck,omitempty"`
+	// Word holds the value of the word edge.
+	Word *Word `json:"word,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// DeckOrErr returns the Deck value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeckWordEdges) DeckOrErr() (*Deck, error) {
+	if e.Deck != nil {
+		return e.Deck, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: deck.Label}
+	}
+	return nil, &NotLoadedError{edge: "deck"}
+}
+
+// WordOrErr returns the Word value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DeckWordEdges) WordOrErr() (*Word, error) {
+	if e.Word != nil {
+		return e.Word, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: word.Label}
+	}
+	return nil, &NotLoadedError{edge: "word"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DeckWord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case deckword.FieldID, deckword.FieldDeckID, deckword.FieldWordID, deckword.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case deckword.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DeckWord fields.
+func (dw *DeckWord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case deckword.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dw.ID = int(value.Int64)
+		case deckword.FieldDeckID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deck_id", values[i])
+			} else if value.Valid {
+				dw.DeckID = int(value.Int64)
+			}
+		case deckword.FieldWordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_id", values[i])
+			} else if value.Valid {
+				dw.WordID = int(value.Int64)
+			}
+		case deckword.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				dw.Position = int(value.Int64)
+			}
+		case deckword.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dw.CreatedAt = value.Time
+			}
+		default:
+			dw.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DeckWord.
+// This includes values selected through modifiers, order, etc.
+func (dw *DeckWord) Value(name string) (ent.Value, error) {
+	return dw.selectValues.Get(name)
+}
+
+// QueryDeck queries the "deck" edge of the DeckWord entity.
+func (dw *DeckWord) QueryDeck() *DeckQuery {
+	return NewDeckWordClient(dw.config).QueryDeck(dw)
+}
+
+// QueryWord queries the "word" edge of the DeckWord entity.
+func (dw *DeckWord) QueryWord() *WordQuery {
+	return NewDeckWordClient(dw.config).QueryWord(dw)
+}
+
+// Update returns a builder for updating this DeckWord.
+// Note that you need to call DeckWord.Unwrap() before calling this method if this DeckWord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dw *DeckWord) Update() *DeckWordUpdateOne {
+	return NewDeckWordClient(dw.config).UpdateOne(dw)
+}
+
+// Unwrap unwraps the DeckWord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dw *DeckWord) Unwrap() *DeckWord {
+	_tx, ok := dw.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DeckWord is not a transactional entity")
+	}
+	dw.config.driver = _tx.drv
+	return dw
+}
+
+// String implements the fmt.Stringer.
+func (dw *DeckWord) String() string {
+	var builder strings.Builder
+	builder.WriteString("DeckWord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dw.ID))
+	builder.WriteString("deck_id=")
+	builder.WriteString(fmt.Sprintf("%v", dw.DeckID))
+	builder.WriteString(", ")
+	builder.WriteString("word_id=")
+	builder.WriteString(fmt.Sprintf("%v", dw.WordID))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", dw.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dw.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DeckWords is a parsable slice of DeckWord.
+type DeckWords []*DeckWord
@@ -0,0 +1,127 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deckword
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the deckword type in the database.
+	Label = "deck_word"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldDeckID holds the string denoting the deck_id field in the database.
+	FieldDeckID = "deck_id"
+	// FieldWordID holds the string denoting the word_id field in the database.
+	FieldWordID = "word_id"
+	// FieldPosition holds the string denoting the position field in the database.
+	FieldPosition = "position"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeDeck holds the string denoting the deck edge name in mutations.
+	EdgeDeck = "deck"
+	// EdgeWord holds the string denoting the word edge name in mutations.
+	EdgeWord = "word"
+	// Table holds the table name of the deckword in the database.
+	Table = "deck_words"
+	// DeckTable is the table that holds the deck relation/edge.
+	DeckTable = "deck_words"
+	// DeckInverseTable is the table name for the Deck entity.
+	// It exists in this package in order to avoid circular dependency with the "deck" package.
+	DeckInverseTable = "decks"
+	// DeckColumn is the table column denoting the deck relation/edge.
+	DeckColumn = "deck_id"
+	// WordTable is the table that holds the word relation/edge.
+	WordTable = "deck_words"
+	// WordInverseTable is the table name for the Word entity.
+	// It exists in this package in order to avoid circular dependency with the "word" package.
+	WordInverseTable = "words"
+	// WordColumn is the table column denoting the word relation/edge.
+	WordColumn = "word_id"
+)
+
+// Columns holds all SQL columns for deckword fields.
+var Columns = []string{
+	FieldID,
+	FieldDeckID,
+	FieldWordID,
+	FieldPosition,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	PositionValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DeckWord queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByDeckID orders the results by the deck_id field.
+func ByDeckID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeckID, opts...).ToFunc()
+}
+
+// ByWordID orders the results by the word_id field.
+func ByWordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWordID, opts...).ToFunc()
+}
+
+// ByPosition orders the results by the position field.
+func ByPosition(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPosition, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByDeckField orders the results by deck field.
+func ByDeckField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeckStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByWordField orders the results by word field.
+func ByWordField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWordStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newDeckStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeckInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeckTable, DeckColumn),
+	)
+}
+func newWordStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WordInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WordTable, WordColumn),
+	)
+}
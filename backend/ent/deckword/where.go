@@ -0,0 +1,257 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deckword
+
+import (
+	"time"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldLTE(FieldID, id))
+}
+
+// DeckID applies equality check predicate on the "deck_id" field. It's identical to DeckIDEQ.
+func DeckID(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldDeckID, v))
+}
+
+// WordID applies equality check predicate on the "word_id" field. It's identical to WordIDEQ.
+func WordID(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldWordID, v))
+}
+
+// Position applies equality check predicate on the "position" field. It's identical to PositionEQ.
+func Position(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldPosition, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// DeckIDEQ applies the EQ predicate on the "deck_id" field.
+func DeckIDEQ(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldDeckID, v))
+}
+
+// DeckIDNEQ applies the NEQ predicate on the "deck_id" field.
+func DeckIDNEQ(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNEQ(FieldDeckID, v))
+}
+
+// DeckIDIn applies the In predicate on the "deck_id" field.
+func DeckIDIn(vs ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldIn(FieldDeckID, vs...))
+}
+
+// DeckIDNotIn applies the NotIn predicate on the "deck_id" field.
+func DeckIDNotIn(vs ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNotIn(FieldDeckID, vs...))
+}
+
+// WordIDEQ applies the EQ predicate on the "word_id" field.
+func WordIDEQ(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldWordID, v))
+}
+
+// WordIDNEQ applies the NEQ predicate on the "word_id" field.
+func WordIDNEQ(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNEQ(FieldWordID, v))
+}
+
+// WordIDIn applies the In predicate on the "word_id" field.
+func WordIDIn(vs ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldIn(FieldWordID, vs...))
+}
+
+// WordIDNotIn applies the NotIn predicate on the "word_id" field.
+func WordIDNotIn(vs ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNotIn(FieldWordID, vs...))
+}
+
+// PositionEQ applies the EQ predicate on the "position" field.
+func PositionEQ(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldPosition, v))
+}
+
+// PositionNEQ applies the NEQ predicate on the "position" field.
+func PositionNEQ(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNEQ(FieldPosition, v))
+}
+
+// PositionIn applies the In predicate on the "position" field.
+func PositionIn(vs ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldIn(FieldPosition, vs...))
+}
+
+// PositionNotIn applies the NotIn predicate on the "position" field.
+func PositionNotIn(vs ...int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNotIn(FieldPosition, vs...))
+}
+
+// PositionGT applies the GT predicate on the "position" field.
+func PositionGT(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldGT(FieldPosition, v))
+}
+
+// PositionGTE applies the GTE predicate on the "position" field.
+func PositionGTE(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldGTE(FieldPosition, v))
+}
+
+// PositionLT applies the LT predicate on the "position" field.
+func PositionLT(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldLT(FieldPosition, v))
+}
+
+// PositionLTE applies the LTE predicate on the "position" field.
+func PositionLTE(v int) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldLTE(FieldPosition, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DeckWord {
+	return predicate.DeckWord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasDeck applies the HasEdge predicate on the "deck" edge.
+func HasDeck() predicate.DeckWord {
+	return predicate.DeckWord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeckTable, DeckColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeckWith applies the HasEdge predicate on the "deck" edge with a given conditions (other predicates).
+func HasDeckWith(preds ...predicate.Deck) predicate.DeckWord {
+	return predicate.DeckWord(func(s *sql.Selector) {
+		step := newDeckStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWord applies the HasEdge predicate on the "word" edge.
+func HasWord() predicate.DeckWord {
+	return predicate.DeckWord(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WordTable, WordColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWordWith applies the HasEdge predicate on the "word" edge with a given conditions (other predicates).
+func HasWordWith(preds ...predicate.Word) predicate.DeckWord {
+	return predicate.DeckWord(func(s *sql.Selector) {
+		step := newWordStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeckWord) predicate.DeckWord {
+	return predicate.DeckWord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeckWord) predicate.DeckWord {
+	return predicate.DeckWord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeckWord) predicate.DeckWord {
+	return predicate.DeckWord(sql.NotPredicates(p))
+}
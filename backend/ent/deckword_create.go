@@ -0,0 +1,673 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/word"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckWordCreate is the builder for creating a DeckWord entity.
+type DeckWordCreate struct {
+	config
+	mutation *DeckWordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetDeckID sets the "deck_id" field.
+func (dwc *DeckWordCreate) SetDeckID(i int) *DeckWordCreate {
+	dwc.mutation.SetDeckID(i)
+	return dwc
+}
+
+// SetWordID sets the "word_id" field.
+func (dwc *DeckWordCreate) SetWordID(i int) *DeckWordCreate {
+	dwc.mutation.SetWordID(i)
+	return dwc
+}
+
+// SetPosition sets the "position" field.
+func (dwc *DeckWordCreate) SetPosition(i int) *DeckWordCreate {
+	dwc.mutation.SetPosition(i)
+	return dwc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (dwc *DeckWordCreate) SetCreatedAt(t time.Time) *DeckWordCreate {
+	dwc.mutation.SetCreatedAt(t)
+	return dwc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (dwc *DeckWordCreate) SetNillableCreatedAt(t *time.Time) *DeckWordCreate {
+	if t != nil {
+		dwc.SetCreatedAt(*t)
+	}
+	return dwc
+}
+
+// SetDeck sets the "deck" edge to the Deck entity.
+func (dwc *DeckWordCreate) SetDeck(d *Deck) *DeckWordCreate {
+	return dwc.SetDeckID(d.ID)
+}
+
+// SetWord sets the "word" edge to the Word entity.
+func (dwc *DeckWordCreate) SetWord(w *Word) *DeckWordCreate {
+	return dwc.SetWordID(w.ID)
+}
+
+// Mutation returns the DeckWordMutation object of the builder.
+func (dwc *DeckWordCreate) Mutation() *DeckWordMutation {
+	return dwc.mutation
+}
+
+// Save creates the DeckWord in the database.
+func (dwc *DeckWordCreate) Save(ctx context.Context) (*DeckWord, error) {
+	dwc.defaults()
+	return withHooks(ctx, dwc.sqlSave, dwc.mutation, dwc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dwc *DeckWordCreate) SaveX(ctx context.Context) *DeckWord {
+	v, err := dwc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dwc *DeckWordCreate) Exec(ctx context.Context) error {
+	_, err := dwc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dwc *DeckWordCreate) ExecX(ctx context.Context) {
+	if err := dwc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dwc *DeckWordCreate) defaults() {
+	if _, ok := dwc.mutation.CreatedAt(); !ok {
+		v := deckword.DefaultCreatedAt()
+		dwc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dwc *DeckWordCreate) check() error {
+	if _, ok := dwc.mutation.DeckID(); !ok {
+		return &ValidationError{Name: "deck_id", err: errors.New(`ent: missing required field "DeckWord.deck_id"`)}
+	}
+	if _, ok := dwc.mutation.WordID(); !ok {
+		return &ValidationError{Name: "word_id", err: errors.New(`ent: missing required field "DeckWord.word_id"`)}
+	}
+	if _, ok := dwc.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "DeckWord.position"`)}
+	}
+	if v, ok := dwc.mutation.Position(); ok {
+		if err := deckword.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "DeckWord.position": %w`, err)}
+		}
+	}
+	if _, ok := dwc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DeckWord.created_at"`)}
+	}
+	if len(dwc.mutation.DeckIDs()) == 0 {
+		return &ValidationError{Name: "deck", err: errors.New(`ent: missing required edge "DeckWord.deck"`)}
+	}
+	if len(dwc.mutation.WordIDs()) == 0 {
+		return &ValidationError{Name: "word", err: errors.New(`ent: missing required edge "DeckWord.word"`)}
+	}
+	return nil
+}
+
+func (dwc *DeckWordCreate) sqlSave(ctx context.Context) (*DeckWord, error) {
+	if err := dwc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dwc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dwc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dwc.mutation.id = &_node.ID
+	dwc.mutation.done = true
+	return _node, nil
+}
+
+func (dwc *DeckWordCreate) createSpec() (*DeckWord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeckWord{config: dwc.config}
+		_spec = sqlgraph.NewCreateSpec(deckword.Table, sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = dwc.conflict
+	if value, ok := dwc.mutation.Position(); ok {
+		_spec.SetField(deckword.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := dwc.mutation.CreatedAt(); ok {
+		_spec.SetField(deckword.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := dwc.mutation.DeckIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.DeckTable,
+			Columns: []string{deckword.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeckID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := dwc.mutation.WordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.WordTable,
+			Columns: []string{deckword.WordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(word.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WordID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeckWord.Create().
+//		SetDeckID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeckWordUpsert) {
+//			SetDeckID(v+v).
+//		}).
+//		Exec(ctx)
+func (dwc *DeckWordCreate) OnConflict(opts ...sql.ConflictOption) *DeckWordUpsertOne {
+	dwc.conflict = opts
+	return &DeckWordUpsertOne{
+		create: dwc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeckWord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dwc *DeckWordCreate) OnConflictColumns(columns ...string) *DeckWordUpsertOne {
+	dwc.conflict = append(dwc.conflict, sql.ConflictColumns(columns...))
+	return &DeckWordUpsertOne{
+		create: dwc,
+	}
+}
+
+type (
+	// DeckWordUpsertOne is the builder for "upsert"-ing
+	//  one DeckWord node.
+	DeckWordUpsertOne struct {
+		create *DeckWordCreate
+	}
+
+	// DeckWordUpsert is the "OnConflict" setter.
+	DeckWordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetDeckID sets the "deck_id" field.
+func (u *DeckWordUpsert) SetDeckID(v int) *DeckWordUpsert {
+	u.Set(deckword.FieldDeckID, v)
+	return u
+}
+
+// UpdateDeckID sets the "deck_id" field to the value that was provided on create.
+func (u *DeckWordUpsert) UpdateDeckID() *DeckWordUpsert {
+	u.SetExcluded(deckword.FieldDeckID)
+	return u
+}
+
+// SetWordID sets the "word_id" field.
+func (u *DeckWordUpsert) SetWordID(v int) *DeckWordUpsert {
+	u.Set(deckword.FieldWordID, v)
+	return u
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *DeckWordUpsert) UpdateWordID() *DeckWordUpsert {
+	u.SetExcluded(deckword.FieldWordID)
+	return u
+}
+
+// SetPosition sets the "position" field.
+func (u *DeckWordUpsert) SetPosition(v int) *DeckWordUpsert {
+	u.Set(deckword.FieldPosition, v)
+	return u
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *DeckWordUpsert) UpdatePosition() *DeckWordUpsert {
+	u.SetExcluded(deckword.FieldPosition)
+	return u
+}
+
+// AddPosition adds v to the "position" field.
+func (u *DeckWordUpsert) AddPosition(v int) *DeckWordUpsert {
+	u.Add(deckword.FieldPosition, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DeckWord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeckWordUpsertOne) UpdateNewValues() *DeckWordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(deckword.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeckWord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DeckWordUpsertOne) Ignore() *DeckWordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeckWordUpsertOne) DoNothing() *DeckWordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeckWordCreate.OnConflict
+// documentation for more info.
+func (u *DeckWordUpsertOne) Update(set func(*DeckWordUpsert)) *DeckWordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeckWordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeckID sets the "deck_id" field.
+func (u *DeckWordUpsertOne) SetDeckID(v int) *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.SetDeckID(v)
+	})
+}
+
+// UpdateDeckID sets the "deck_id" field to the value that was provided on create.
+func (u *DeckWordUpsertOne) UpdateDeckID() *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.UpdateDeckID()
+	})
+}
+
+// SetWordID sets the "word_id" field.
+func (u *DeckWordUpsertOne) SetWordID(v int) *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.SetWordID(v)
+	})
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *DeckWordUpsertOne) UpdateWordID() *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.UpdateWordID()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *DeckWordUpsertOne) SetPosition(v int) *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *DeckWordUpsertOne) AddPosition(v int) *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *DeckWordUpsertOne) UpdatePosition() *DeckWordUpsertOne {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *DeckWordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeckWordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeckWordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DeckWordUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DeckWordUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DeckWordCreateBulk is the builder for creating many DeckWord entities in bulk.
+type DeckWordCreateBulk struct {
+	config
+	err      error
+	builders []*DeckWordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DeckWord entities in the database.
+func (dwcb *DeckWordCreateBulk) Save(ctx context.Context) ([]*DeckWord, error) {
+	if dwcb.err != nil {
+		return nil, dwcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dwcb.builders))
+	nodes := make([]*DeckWord, len(dwcb.builders))
+	mutators := make([]Mutator, len(dwcb.builders))
+	for i := range dwcb.builders {
+		func(i int, root context.Context) {
+			builder := dwcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeckWordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dwcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dwcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dwcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dwcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dwcb *DeckWordCreateBulk) SaveX(ctx context.Context) []*DeckWord {
+	v, err := dwcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dwcb *DeckWordCreateBulk) Exec(ctx context.Context) error {
+	_, err := dwcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dwcb *DeckWordCreateBulk) ExecX(ctx context.Context) {
+	if err := dwcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DeckWord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DeckWordUpsert) {
+//			SetDeckID(v+v).
+//		}).
+//		Exec(ctx)
+func (dwcb *DeckWordCreateBulk) OnConflict(opts ...sql.ConflictOption) *DeckWordUpsertBulk {
+	dwcb.conflict = opts
+	return &DeckWordUpsertBulk{
+		create: dwcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DeckWord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dwcb *DeckWordCreateBulk) OnConflictColumns(columns ...string) *DeckWordUpsertBulk {
+	dwcb.conflict = append(dwcb.conflict, sql.ConflictColumns(columns...))
+	return &DeckWordUpsertBulk{
+		create: dwcb,
+	}
+}
+
+// DeckWordUpsertBulk is the builder for "upsert"-ing
+// a bulk of DeckWord nodes.
+type DeckWordUpsertBulk struct {
+	create *DeckWordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DeckWord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DeckWordUpsertBulk) UpdateNewValues() *DeckWordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(deckword.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DeckWord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DeckWordUpsertBulk) Ignore() *DeckWordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DeckWordUpsertBulk) DoNothing() *DeckWordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DeckWordCreateBulk.OnConflict
+// documentation for more info.
+func (u *DeckWordUpsertBulk) Update(set func(*DeckWordUpsert)) *DeckWordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DeckWordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetDeckID sets the "deck_id" field.
+func (u *DeckWordUpsertBulk) SetDeckID(v int) *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.SetDeckID(v)
+	})
+}
+
+// UpdateDeckID sets the "deck_id" field to the value that was provided on create.
+func (u *DeckWordUpsertBulk) UpdateDeckID() *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.UpdateDeckID()
+	})
+}
+
+// SetWordID sets the "word_id" field.
+func (u *DeckWordUpsertBulk) SetWordID(v int) *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.SetWordID(v)
+	})
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *DeckWordUpsertBulk) UpdateWordID() *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.UpdateWordID()
+	})
+}
+
+// SetPosition sets the "position" field.
+func (u *DeckWordUpsertBulk) SetPosition(v int) *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.SetPosition(v)
+	})
+}
+
+// AddPosition adds v to the "position" field.
+func (u *DeckWordUpsertBulk) AddPosition(v int) *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.AddPosition(v)
+	})
+}
+
+// UpdatePosition sets the "position" field to the value that was provided on create.
+func (u *DeckWordUpsertBulk) UpdatePosition() *DeckWordUpsertBulk {
+	return u.Update(func(s *DeckWordUpsert) {
+		s.UpdatePosition()
+	})
+}
+
+// Exec executes the query.
+func (u *DeckWordUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DeckWordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DeckWordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DeckWordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
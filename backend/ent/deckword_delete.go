@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckWordDelete is the builder for deleting a DeckWord entity.
+type DeckWordDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeckWordMutation
+}
+
+// Where appends a list predicates to the DeckWordDelete builder.
+func (dwd *DeckWordDelete) Where(ps ...predicate.DeckWord) *DeckWordDelete {
+	dwd.mutation.Where(ps...)
+	return dwd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dwd *DeckWordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dwd.sqlExec, dwd.mutation, dwd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dwd *DeckWordDelete) ExecX(ctx context.Context) int {
+	n, err := dwd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dwd *DeckWordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deckword.Table, sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt))
+	if ps := dwd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dwd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dwd.mutation.done = true
+	return affected, err
+}
+
+// DeckWordDeleteOne is the builder for deleting a single DeckWord entity.
+type DeckWordDeleteOne struct {
+	dwd *DeckWordDelete
+}
+
+// Where appends a list predicates to the DeckWordDelete builder.
+func (dwdo *DeckWordDeleteOne) Where(ps ...predicate.DeckWord) *DeckWordDeleteOne {
+	dwdo.dwd.mutation.Where(ps...)
+	return dwdo
+}
+
+// Exec executes the deletion query.
+func (dwdo *DeckWordDeleteOne) Exec(ctx context.Context) error {
+	n, err := dwdo.dwd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deckword.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dwdo *DeckWordDeleteOne) ExecX(ctx context.Context) {
+	if err := dwdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
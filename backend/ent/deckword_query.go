@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/ent/word"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckWordQuery is the builder for querying DeckWord entities.
+type DeckWordQuery struct {
+	config
+	ctx        *QueryContext
+	order      []deckword.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DeckWord
+	withDeck   *DeckQuery
+	withWord   *WordQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DeckWordQuery builder.
+func (dwq *DeckWordQuery) Where(ps ...predicate.DeckWord) *DeckWordQuery {
+	dwq.predicates = append(dwq.predicates, ps...)
+	return dwq
+}
+
+// Limit the number of records to be returned by this query.
+func (dwq *DeckWordQuery) Limit(limit int) *DeckWordQuery {
+	dwq.ctx.Limit = &limit
+	return dwq
+}
+
+// Offset to start from.
+func (dwq *DeckWordQuery) Offset(offset int) *DeckWordQuery {
+	dwq.ctx.Offset = &offset
+	return dwq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dwq *DeckWordQuery) Unique(unique bool) *DeckWordQuery {
+	dwq.ctx.Unique = &unique
+	return dwq
+}
+
+// Order specifies how the records should be ordered.
+func (dwq *DeckWordQuery) Order(o ...deckword.OrderOption) *DeckWordQuery {
+	dwq.order = append(dwq.order, o...)
+	return dwq
+}
+
+// QueryDeck chains the current query on the "deck" edge.
+func (dwq *DeckWordQuery) QueryDeck() *DeckQuery {
+	query := (&DeckClient{config: dwq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deckword.Table, deckword.FieldID, selector),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deckword.DeckTable, deckword.DeckColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWord chains the current query on the "word" edge.
+func (dwq *DeckWordQuery) QueryWord() *WordQuery {
+	query := (&WordClient{config: dwq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dwq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dwq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(deckword.Table, deckword.FieldID, selector),
+			sqlgraph.To(word.Table, word.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, deckword.WordTable, deckword.WordColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dwq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DeckWord entity from the query.
+// Returns a *NotFoundError when no DeckWord was found.
+func (dwq *DeckWordQuery) First(ctx context.Context) (*DeckWord, error) {
+	nodes, err := dwq.Limit(1).All(setContextOp(ctx, dwq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{deckword.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dwq *DeckWordQuery) FirstX(ctx context.Context) *DeckWord {
+	node, err := dwq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DeckWord ID from the query.
+// Returns a *NotFoundError when no DeckWord ID was found.
+func (dwq *DeckWordQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dwq.Limit(1).IDs(setContextOp(ctx, dwq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{deckword.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dwq *DeckWordQuery) FirstIDX(ctx context.Context) int {
+	id, err := dwq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DeckWord entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DeckWord entity is found.
+// Returns a *NotFoundError when no DeckWord entities are found.
+func (dwq *DeckWordQuery) Only(ctx context.Context) (*DeckWord, error) {
+	nodes, err := dwq.Limit(2).All(setContextOp(ctx, dwq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{deckword.Label}
+	default:
+		return nil, &NotSingularError{deckword.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dwq *DeckWordQuery) OnlyX(ctx context.Context) *DeckWord {
+	node, err := dwq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DeckWord ID in the query.
+// Returns a *NotSingularError when more than one DeckWord ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dwq *DeckWordQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dwq.Limit(2).IDs(setContextOp(ctx, dwq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{deckword.Label}
+	default:
+		err = &NotSingularError{deckword.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dwq *DeckWordQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dwq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DeckWords.
+func (dwq *DeckWordQuery) All(ctx context.Context) ([]*DeckWord, error) {
+	ctx = setContextOp(ctx, dwq.ctx, ent.OpQueryAll)
+	if err := dwq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DeckWord, *DeckWordQuery]()
+	return withInterceptors[[]*DeckWord](ctx, dwq, qr, dwq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dwq *DeckWordQuery) AllX(ctx context.Context) []*DeckWord {
+	nodes, err := dwq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DeckWord IDs.
+func (dwq *DeckWordQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dwq.ctx.Unique == nil && dwq.path != nil {
+		dwq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dwq.ctx, ent.OpQueryIDs)
+	if err = dwq.Select(deckword.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dwq *DeckWordQuery) IDsX(ctx context.Context) []int {
+	ids, err := dwq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dwq *DeckWordQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dwq.ctx, ent.OpQueryCount)
+	if err := dwq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dwq, querierCount[*DeckWordQuery](), dwq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dwq *DeckWordQuery) CountX(ctx context.Context) int {
+	count, err := dwq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dwq *DeckWordQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dwq.ctx, ent.OpQueryExist)
+	switch _, err := dwq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dwq *DeckWordQuery) ExistX(ctx context.Context) bool {
+	exist, err := dwq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DeckWordQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dwq *DeckWordQuery) Clone() *DeckWordQuery {
+	if dwq == nil {
+		return nil
+	}
+	return &DeckWordQuery{
+		config:     dwq.config,
+		ctx:        dwq.ctx.Clone(),
+		order:      append([]deckword.OrderOption{}, dwq.order...),
+		inters:     append([]Interceptor{}, dwq.inters...),
+		predicates: append([]predicate.DeckWord{}, dwq.predicates...),
+		withDeck:   dwq.withDeck.Clone(),
+		withWord:   dwq.withWord.Clone(),
+		// clone intermediate query.
+		sql:       dwq.sql.Clone(),
+		path:      dwq.path,
+		modifiers: append([]func(*sql.Selector){}, dwq.modifiers...),
+	}
+}
+
+// WithDeck tells the query-builder to eager-load the nodes that are connected to
+// the "deck" edge. The optional arguments are used to configure the query builder of the edge.
+func (dwq *DeckWordQuery) WithDeck(opts ...func(*DeckQuery)) *DeckWordQuery {
+	query := (&DeckClient{config: dwq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dwq.withDeck = query
+	return dwq
+}
+
+// WithWord tells the query-builder to eager-load the nodes that are connected to
+// the "word" edge. The optional arguments are used to configure the query builder of the edge.
+func (dwq *DeckWordQuery) WithWord(opts ...func(*WordQuery)) *DeckWordQuery {
+	query := (&WordClient{config: dwq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dwq.withWord = query
+	return dwq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		DeckID int `json:"deck_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DeckWord.Query().
+//		GroupBy(deckword.FieldDeckID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dwq *DeckWordQuery) GroupBy(field string, fields ...string) *DeckWordGroupBy {
+	dwq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DeckWordGroupBy{build: dwq}
+	grbuild.flds = &dwq.ctx.Fields
+	grbuild.label = deckword.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		DeckID int `json:"deck_id,omitempty"`
+//	}
+//
+//	client.DeckWord.Query().
+//		Select(deckword.FieldDeckID).
+//		Scan(ctx, &v)
+func (dwq *DeckWordQuery) Select(fields ...string) *DeckWordSelect {
+	dwq.ctx.Fields = append(dwq.ctx.Fields, fields...)
+	sbuild := &DeckWordSelect{DeckWordQuery: dwq}
+	sbuild.label = deckword.Label
+	sbuild.flds, sbuild.scan = &dwq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DeckWordSelect configured with the given aggregations.
+func (dwq *DeckWordQuery) Aggregate(fns ...AggregateFunc) *DeckWordSelect {
+	return dwq.Select().Aggregate(fns...)
+}
+
+func (dwq *DeckWordQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dwq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dwq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dwq.ctx.Fields {
+		if !deckword.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dwq.path != nil {
+		prev, err := dwq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dwq.sql = prev
+	}
+	return nil
+}
+
+func (dwq *DeckWordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DeckWord, error) {
+	var (
+		nodes       = []*DeckWord{}
+		_spec       = dwq.querySpec()
+		loadedTypes = [2]bool{
+			dwq.withDeck != nil,
+			dwq.withWord != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DeckWord).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DeckWord{config: dwq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(dwq.modifiers) > 0 {
+		_spec.Modifiers = dwq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dwq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dwq.withDeck; query != nil {
+		if err := dwq.loadDeck(ctx, query, nodes, nil,
+			func(n *DeckWord, e *Deck) { n.Edges.Deck = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := dwq.withWord; query != nil {
+		if err := dwq.loadWord(ctx, query, nodes, nil,
+			func(n *DeckWord, e *Word) { n.Edges.Word = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dwq *DeckWordQuery) loadDeck(ctx context.Context, query *DeckQuery, nodes []*DeckWord, init func(*DeckWord), assign func(*DeckWord, *Deck)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DeckWord)
+	for i := range nodes {
+		fk := nodes[i].DeckID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(deck.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "deck_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (dwq *DeckWordQuery) loadWord(ctx context.Context, query *WordQuery, nodes []*DeckWord, init func(*DeckWord), assign func(*DeckWord, *Word)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DeckWord)
+	for i := range nodes {
+		fk := nodes[i].WordID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(word.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "word_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (dwq *DeckWordQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dwq.querySpec()
+	if len(dwq.modifiers) > 0 {
+		_spec.Modifiers = dwq.modifiers
+	}
+	_spec.Node.Columns = dwq.ctx.Fields
+	if len(dwq.ctx.Fields) > 0 {
+		_spec.Unique = dwq.ctx.Unique != nil && *dwq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dwq.driver, _spec)
+}
+
+func (dwq *DeckWordQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(deckword.Table, deckword.Columns, sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt))
+	_spec.From = dwq.sql
+	if unique := dwq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dwq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dwq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deckword.FieldID)
+		for i := range fields {
+			if fields[i] != deckword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if dwq.withDeck != nil {
+			_spec.Node.AddColumnOnce(deckword.FieldDeckID)
+		}
+		if dwq.withWord != nil {
+			_spec.Node.AddColumnOnce(deckword.FieldWordID)
+		}
+	}
+	if ps := dwq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dwq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dwq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dwq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dwq *DeckWordQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dwq.driver.Dialect())
+	t1 := builder.Table(deckword.Table)
+	columns := dwq.ctx.Fields
+	if len(columns) == 0 {
+		columns = deckword.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dwq.sql != nil {
+		selector = dwq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dwq.ctx.Unique != nil && *dwq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dwq.modifiers {
+		m(selector)
+	}
+	for _, p := range dwq.predicates {
+		p(selector)
+	}
+	for _, p := range dwq.order {
+		p(selector)
+	}
+	if offset := dwq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dwq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dwq *DeckWordQuery) Modify(modifiers ...func(s *sql.Selector)) *DeckWordSelect {
+	dwq.modifiers = append(dwq.modifiers, modifiers...)
+	return dwq.Select()
+}
+
+// DeckWordGroupBy is the group-by builder for DeckWord entities.
+type DeckWordGroupBy struct {
+	selector
+	build *DeckWordQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dwgb *DeckWordGroupBy) Aggregate(fns ...AggregateFunc) *DeckWordGroupBy {
+	dwgb.fns = append(dwgb.fns, fns...)
+	return dwgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dwgb *DeckWordGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dwgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dwgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeckWordQuery, *DeckWordGroupBy](ctx, dwgb.build, dwgb, dwgb.build.inters, v)
+}
+
+func (dwgb *DeckWordGroupBy) sqlScan(ctx context.Context, root *DeckWordQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dwgb.fns))
+	for _, fn := range dwgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dwgb.flds)+len(dwgb.fns))
+		for _, f := range *dwgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dwgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dwgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DeckWordSelect is the builder for selecting fields of DeckWord entities.
+type DeckWordSelect struct {
+	*DeckWordQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dws *DeckWordSelect) Aggregate(fns ...AggregateFunc) *DeckWordSelect {
+	dws.fns = append(dws.fns, fns...)
+	return dws
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dws *DeckWordSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dws.ctx, ent.OpQuerySelect)
+	if err := dws.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DeckWordQuery, *DeckWordSelect](ctx, dws.DeckWordQuery, dws, dws.inters, v)
+}
+
+func (dws *DeckWordSelect) sqlScan(ctx context.Context, root *DeckWordQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dws.fns))
+	for _, fn := range dws.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dws.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dws.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dws *DeckWordSelect) Modify(modifiers ...func(s *sql.Selector)) *DeckWordSelect {
+	dws.modifiers = append(dws.modifiers, modifiers...)
+	return dws
+}
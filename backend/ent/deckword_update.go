@@ -0,0 +1,501 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/ent/word"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DeckWordUpdate is the builder for updating DeckWord entities.
+type DeckWordUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DeckWordMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DeckWordUpdate builder.
+func (dwu *DeckWordUpdate) Where(ps ...predicate.DeckWord) *DeckWordUpdate {
+	dwu.mutation.Where(ps...)
+	return dwu
+}
+
+// SetDeckID sets the "deck_id" field.
+func (dwu *DeckWordUpdate) SetDeckID(i int) *DeckWordUpdate {
+	dwu.mutation.SetDeckID(i)
+	return dwu
+}
+
+// SetNillableDeckID sets the "deck_id" field if the given value is not nil.
+func (dwu *DeckWordUpdate) SetNillableDeckID(i *int) *DeckWordUpdate {
+	if i != nil {
+		dwu.SetDeckID(*i)
+	}
+	return dwu
+}
+
+// SetWordID sets the "word_id" field.
+func (dwu *DeckWordUpdate) SetWordID(i int) *DeckWordUpdate {
+	dwu.mutation.SetWordID(i)
+	return dwu
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (dwu *DeckWordUpdate) SetNillableWordID(i *int) *DeckWordUpdate {
+	if i != nil {
+		dwu.SetWordID(*i)
+	}
+	return dwu
+}
+
+// SetPosition sets the "position" field.
+func (dwu *DeckWordUpdate) SetPosition(i int) *DeckWordUpdate {
+	dwu.mutation.ResetPosition()
+	dwu.mutation.SetPosition(i)
+	return dwu
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (dwu *DeckWordUpdate) SetNillablePosition(i *int) *DeckWordUpdate {
+	if i != nil {
+		dwu.SetPosition(*i)
+	}
+	return dwu
+}
+
+// AddPosition adds i to the "position" field.
+func (dwu *DeckWordUpdate) AddPosition(i int) *DeckWordUpdate {
+	dwu.mutation.AddPosition(i)
+	return dwu
+}
+
+// SetDeck sets the "deck" edge to the Deck entity.
+func (dwu *DeckWordUpdate) SetDeck(d *Deck) *DeckWordUpdate {
+	return dwu.SetDeckID(d.ID)
+}
+
+// SetWord sets the "word" edge to the Word entity.
+func (dwu *DeckWordUpdate) SetWord(w *Word) *DeckWordUpdate {
+	return dwu.SetWordID(w.ID)
+}
+
+// Mutation returns the DeckWordMutation object of the builder.
+func (dwu *DeckWordUpdate) Mutation() *DeckWordMutation {
+	return dwu.mutation
+}
+
+// ClearDeck clears the "deck" edge to the Deck entity.
+func (dwu *DeckWordUpdate) ClearDeck() *DeckWordUpdate {
+	dwu.mutation.ClearDeck()
+	return dwu
+}
+
+// ClearWord clears the "word" edge to the Word entity.
+func (dwu *DeckWordUpdate) ClearWord() *DeckWordUpdate {
+	dwu.mutation.ClearWord()
+	return dwu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (dwu *DeckWordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, dwu.sqlSave, dwu.mutation, dwu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dwu *DeckWordUpdate) SaveX(ctx context.Context) int {
+	affected, err := dwu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (dwu *DeckWordUpdate) Exec(ctx context.Context) error {
+	_, err := dwu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dwu *DeckWordUpdate) ExecX(ctx context.Context) {
+	if err := dwu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dwu *DeckWordUpdate) check() error {
+	if v, ok := dwu.mutation.Position(); ok {
+		if err := deckword.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "DeckWord.position": %w`, err)}
+		}
+	}
+	if dwu.mutation.DeckCleared() && len(dwu.mutation.DeckIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeckWord.deck"`)
+	}
+	if dwu.mutation.WordCleared() && len(dwu.mutation.WordIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeckWord.word"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dwu *DeckWordUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeckWordUpdate {
+	dwu.modifiers = append(dwu.modifiers, modifiers...)
+	return dwu
+}
+
+func (dwu *DeckWordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := dwu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deckword.Table, deckword.Columns, sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt))
+	if ps := dwu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dwu.mutation.Position(); ok {
+		_spec.SetField(deckword.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := dwu.mutation.AddedPosition(); ok {
+		_spec.AddField(deckword.FieldPosition, field.TypeInt, value)
+	}
+	if dwu.mutation.DeckCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.DeckTable,
+			Columns: []string{deckword.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dwu.mutation.DeckIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.DeckTable,
+			Columns: []string{deckword.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if dwu.mutation.WordCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.WordTable,
+			Columns: []string{deckword.WordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(word.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dwu.mutation.WordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.WordTable,
+			Columns: []string{deckword.WordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(word.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(dwu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, dwu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deckword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	dwu.mutation.done = true
+	return n, nil
+}
+
+// DeckWordUpdateOne is the builder for updating a single DeckWord entity.
+type DeckWordUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DeckWordMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetDeckID sets the "deck_id" field.
+func (dwuo *DeckWordUpdateOne) SetDeckID(i int) *DeckWordUpdateOne {
+	dwuo.mutation.SetDeckID(i)
+	return dwuo
+}
+
+// SetNillableDeckID sets the "deck_id" field if the given value is not nil.
+func (dwuo *DeckWordUpdateOne) SetNillableDeckID(i *int) *DeckWordUpdateOne {
+	if i != nil {
+		dwuo.SetDeckID(*i)
+	}
+	return dwuo
+}
+
+// SetWordID sets the "word_id" field.
+func (dwuo *DeckWordUpdateOne) SetWordID(i int) *DeckWordUpdateOne {
+	dwuo.mutation.SetWordID(i)
+	return dwuo
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (dwuo *DeckWordUpdateOne) SetNillableWordID(i *int) *DeckWordUpdateOne {
+	if i != nil {
+		dwuo.SetWordID(*i)
+	}
+	return dwuo
+}
+
+// SetPosition sets the "position" field.
+func (dwuo *DeckWordUpdateOne) SetPosition(i int) *DeckWordUpdateOne {
+	dwuo.mutation.ResetPosition()
+	dwuo.mutation.SetPosition(i)
+	return dwuo
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (dwuo *DeckWordUpdateOne) SetNillablePosition(i *int) *DeckWordUpdateOne {
+	if i != nil {
+		dwuo.SetPosition(*i)
+	}
+	return dwuo
+}
+
+// AddPosition adds i to the "position" field.
+func (dwuo *DeckWordUpdateOne) AddPosition(i int) *DeckWordUpdateOne {
+	dwuo.mutation.AddPosition(i)
+	return dwuo
+}
+
+// SetDeck sets the "deck" edge to the Deck entity.
+func (dwuo *DeckWordUpdateOne) SetDeck(d *Deck) *DeckWordUpdateOne {
+	return dwuo.SetDeckID(d.ID)
+}
+
+// SetWord sets the "word" edge to the Word entity.
+func (dwuo *DeckWordUpdateOne) SetWord(w *Word) *DeckWordUpdateOne {
+	return dwuo.SetWordID(w.ID)
+}
+
+// Mutation returns the DeckWordMutation object of the builder.
+func (dwuo *DeckWordUpdateOne) Mutation() *DeckWordMutation {
+	return dwuo.mutation
+}
+
+// ClearDeck clears the "deck" edge to the Deck entity.
+func (dwuo *DeckWordUpdateOne) ClearDeck() *DeckWordUpdateOne {
+	dwuo.mutation.ClearDeck()
+	return dwuo
+}
+
+// ClearWord clears the "word" edge to the Word entity.
+func (dwuo *DeckWordUpdateOne) ClearWord() *DeckWordUpdateOne {
+	dwuo.mutation.ClearWord()
+	return dwuo
+}
+
+// Where appends a list predicates to the DeckWordUpdate builder.
+func (dwuo *DeckWordUpdateOne) Where(ps ...predicate.DeckWord) *DeckWordUpdateOne {
+	dwuo.mutation.Where(ps...)
+	return dwuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (dwuo *DeckWordUpdateOne) Select(field string, fields ...string) *DeckWordUpdateOne {
+	dwuo.fields = append([]string{field}, fields...)
+	return dwuo
+}
+
+// Save executes the query and returns the updated DeckWord entity.
+func (dwuo *DeckWordUpdateOne) Save(ctx context.Context) (*DeckWord, error) {
+	return withHooks(ctx, dwuo.sqlSave, dwuo.mutation, dwuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dwuo *DeckWordUpdateOne) SaveX(ctx context.Context) *DeckWord {
+	node, err := dwuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (dwuo *DeckWordUpdateOne) Exec(ctx context.Context) error {
+	_, err := dwuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dwuo *DeckWordUpdateOne) ExecX(ctx context.Context) {
+	if err := dwuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dwuo *DeckWordUpdateOne) check() error {
+	if v, ok := dwuo.mutation.Position(); ok {
+		if err := deckword.PositionValidator(v); err != nil {
+			return &ValidationError{Name: "position", err: fmt.Errorf(`ent: validator failed for field "DeckWord.position": %w`, err)}
+		}
+	}
+	if dwuo.mutation.DeckCleared() && len(dwuo.mutation.DeckIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeckWord.deck"`)
+	}
+	if dwuo.mutation.WordCleared() && len(dwuo.mutation.WordIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DeckWord.word"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (dwuo *DeckWordUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DeckWordUpdateOne {
+	dwuo.modifiers = append(dwuo.modifiers, modifiers...)
+	return dwuo
+}
+
+func (dwuo *DeckWordUpdateOne) sqlSave(ctx context.Context) (_node *DeckWord, err error) {
+	if err := dwuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(deckword.Table, deckword.Columns, sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt))
+	id, ok := dwuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeckWord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := dwuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deckword.FieldID)
+		for _, f := range fields {
+			if !deckword.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deckword.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := dwuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := dwuo.mutation.Position(); ok {
+		_spec.SetField(deckword.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := dwuo.mutation.AddedPosition(); ok {
+		_spec.AddField(deckword.FieldPosition, field.TypeInt, value)
+	}
+	if dwuo.mutation.DeckCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.DeckTable,
+			Columns: []string{deckword.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dwuo.mutation.DeckIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.DeckTable,
+			Columns: []string{deckword.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if dwuo.mutation.WordCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.WordTable,
+			Columns: []string{deckword.WordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(word.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := dwuo.mutation.WordIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.WordTable,
+			Columns: []string{deckword.WordColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(word.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(dwuo.modifiers...)
+	_node = &DeckWord{config: dwuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, dwuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deckword.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	dwuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,193 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DictImportFailure is the model entity for the DictImportFailure schema.
+type DictImportFailure struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RunID holds the value of the "run_id" field.
+	RunID int `json:"run_id,omitempty"`
+	// JMdict のエントリ ID
+	EntryID string `json:"entry_id,omitempty"`
+	// ファイル内での 0 始まりの位置
+	EntryIndex int `json:"entry_index,omitempty"`
+	// Message holds the value of the "message" field.
+	Message string `json:"message,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// --retry-failed で再試行した日時。再び失敗した場合は新しい行が作られる
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DictImportFailureQuery when eager-loading is set.
+	Edges        DictImportFailureEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DictImportFailureEdges holds the relations/edges for other nodes in the graph.
+type DictImportFailureEdges struct {
+	// Run holds the value of the run edge.
+	Run *DictImportRun `json:"run,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RunOrErr returns the Run value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e DictImportFailureEdges) RunOrErr() (*DictImportRun, error) {
+	if e.Run != nil {
+		return e.Run, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: dictimportrun.Label}
+	}
+	return nil, &NotLoadedError{edge: "run"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DictImportFailure) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dictimportfailure.FieldID, dictimportfailure.FieldRunID, dictimportfailure.FieldEntryIndex:
+			values[i] = new(sql.NullInt64)
+		case dictimportfailure.FieldEntryID, dictimportfailure.FieldMessage:
+			values[i] = new(sql.NullString)
+		case dictimportfailure.FieldCreatedAt, dictimportfailure.FieldResolvedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DictImportFailure fields.
+func (dif *DictImportFailure) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dictimportfailure.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dif.ID = int(value.Int64)
+		case dictimportfailure.FieldRunID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field run_id", values[i])
+			} else if value.Valid {
+				dif.RunID = int(value.Int64)
+			}
+		case dictimportfailure.FieldEntryID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_id", values[i])
+			} else if value.Valid {
+				dif.EntryID = value.String
+			}
+		case dictimportfailure.FieldEntryIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entry_index", values[i])
+			} else if value.Valid {
+				dif.EntryIndex = int(value.Int64)
+			}
+		case dictimportfailure.FieldMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field message", values[i])
+			} else if value.Valid {
+				dif.Message = value.String
+			}
+		case dictimportfailure.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				dif.CreatedAt = value.Time
+			}
+		case dictimportfailure.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				dif.ResolvedAt = new(time.Time)
+				*dif.ResolvedAt = value.Time
+			}
+		default:
+			dif.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DictImportFailure.
+// This includes values selected through modifiers, order, etc.
+func (dif *DictImportFailure) Value(name string) (ent.Value, error) {
+	return dif.selectValues.Get(name)
+}
+
+// QueryRun queries the "run" edge of the DictImportFailure entity.
+func (dif *DictImportFailure) QueryRun() *DictImportRunQuery {
+	return NewDictImportFailureClient(dif.config).QueryRun(dif)
+}
+
+// Update returns a builder for updating this DictImportFailure.
+// Note that you need to call DictImportFailure.Unwrap() before calling this method if this DictImportFailure
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dif *DictImportFailure) Update() *DictImportFailureUpdateOne {
+	return NewDictImportFailureClient(dif.config).UpdateOne(dif)
+}
+
+// Unwrap unwraps the DictImportFailure entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dif *DictImportFailure) Unwrap() *DictImportFailure {
+	_tx, ok := dif.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DictImportFailure is not a transactional entity")
+	}
+	dif.config.driver = _tx.drv
+	return dif
+}
+
+// String implements the fmt.Stringer.
+func (dif *DictImportFailure) String() string {
+	var builder strings.Builder
+	builder.WriteString("DictImportFailure(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dif.ID))
+	builder.WriteString("run_id=")
+	builder.WriteString(fmt.Sprintf("%v", dif.RunID))
+	builder.WriteString(", ")
+	builder.WriteString("entry_id=")
+	builder.WriteString(dif.EntryID)
+	builder.WriteString(", ")
+	builder.WriteString("entry_index=")
+	builder.WriteString(fmt.Sprintf("%v", dif.EntryIndex))
+	builder.WriteString(", ")
+	builder.WriteString("message=")
+	builder.WriteString(dif.Message)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(dif.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := dif.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DictImportFailures is a parsable slice of DictImportFailure.
+type DictImportFailures []*DictImportFailure
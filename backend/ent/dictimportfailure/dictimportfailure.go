@@ -0,0 +1,120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dictimportfailure
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the dictimportfailure type in the database.
+	Label = "dict_import_failure"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRunID holds the string denoting the run_id field in the database.
+	FieldRunID = "run_id"
+	// FieldEntryID holds the string denoting the entry_id field in the database.
+	FieldEntryID = "entry_id"
+	// FieldEntryIndex holds the string denoting the entry_index field in the database.
+	FieldEntryIndex = "entry_index"
+	// FieldMessage holds the string denoting the message field in the database.
+	FieldMessage = "message"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
+	// EdgeRun holds the string denoting the run edge name in mutations.
+	EdgeRun = "run"
+	// Table holds the table name of the dictimportfailure in the database.
+	Table = "dict_import_failures"
+	// RunTable is the table that holds the run relation/edge.
+	RunTable = "dict_import_failures"
+	// RunInverseTable is the table name for the DictImportRun entity.
+	// It exists in this package in order to avoid circular dependency with the "dictimportrun" package.
+	RunInverseTable = "dict_import_runs"
+	// RunColumn is the table column denoting the run relation/edge.
+	RunColumn = "run_id"
+)
+
+// Columns holds all SQL columns for dictimportfailure fields.
+var Columns = []string{
+	FieldID,
+	FieldRunID,
+	FieldEntryID,
+	FieldEntryIndex,
+	FieldMessage,
+	FieldCreatedAt,
+	FieldResolvedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// EntryIndexValidator is a validator for the "entry_index" field. It is called by the builders before save.
+	EntryIndexValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DictImportFailure queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRunID orders the results by the run_id field.
+func ByRunID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRunID, opts...).ToFunc()
+}
+
+// ByEntryID orders the results by the entry_id field.
+func ByEntryID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntryID, opts...).ToFunc()
+}
+
+// ByEntryIndex orders the results by the entry_index field.
+func ByEntryIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEntryIndex, opts...).ToFunc()
+}
+
+// ByMessage orders the results by the message field.
+func ByMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessage, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
+// ByRunField orders the results by run field.
+func ByRunField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRunStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newRunStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RunInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+	)
+}
@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dictimportfailure
+
+import (
+	"time"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLTE(FieldID, id))
+}
+
+// RunID applies equality check predicate on the "run_id" field. It's identical to RunIDEQ.
+func RunID(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldRunID, v))
+}
+
+// EntryID applies equality check predicate on the "entry_id" field. It's identical to EntryIDEQ.
+func EntryID(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldEntryID, v))
+}
+
+// EntryIndex applies equality check predicate on the "entry_index" field. It's identical to EntryIndexEQ.
+func EntryIndex(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldEntryIndex, v))
+}
+
+// Message applies equality check predicate on the "message" field. It's identical to MessageEQ.
+func Message(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldMessage, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// RunIDEQ applies the EQ predicate on the "run_id" field.
+func RunIDEQ(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldRunID, v))
+}
+
+// RunIDNEQ applies the NEQ predicate on the "run_id" field.
+func RunIDNEQ(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldRunID, v))
+}
+
+// RunIDIn applies the In predicate on the "run_id" field.
+func RunIDIn(vs ...int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldRunID, vs...))
+}
+
+// RunIDNotIn applies the NotIn predicate on the "run_id" field.
+func RunIDNotIn(vs ...int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldRunID, vs...))
+}
+
+// EntryIDEQ applies the EQ predicate on the "entry_id" field.
+func EntryIDEQ(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldEntryID, v))
+}
+
+// EntryIDNEQ applies the NEQ predicate on the "entry_id" field.
+func EntryIDNEQ(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldEntryID, v))
+}
+
+// EntryIDIn applies the In predicate on the "entry_id" field.
+func EntryIDIn(vs ...string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldEntryID, vs...))
+}
+
+// EntryIDNotIn applies the NotIn predicate on the "entry_id" field.
+func EntryIDNotIn(vs ...string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldEntryID, vs...))
+}
+
+// EntryIDGT applies the GT predicate on the "entry_id" field.
+func EntryIDGT(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGT(FieldEntryID, v))
+}
+
+// EntryIDGTE applies the GTE predicate on the "entry_id" field.
+func EntryIDGTE(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGTE(FieldEntryID, v))
+}
+
+// EntryIDLT applies the LT predicate on the "entry_id" field.
+func EntryIDLT(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLT(FieldEntryID, v))
+}
+
+// EntryIDLTE applies the LTE predicate on the "entry_id" field.
+func EntryIDLTE(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLTE(FieldEntryID, v))
+}
+
+// EntryIDContains applies the Contains predicate on the "entry_id" field.
+func EntryIDContains(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldContains(FieldEntryID, v))
+}
+
+// EntryIDHasPrefix applies the HasPrefix predicate on the "entry_id" field.
+func EntryIDHasPrefix(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldHasPrefix(FieldEntryID, v))
+}
+
+// EntryIDHasSuffix applies the HasSuffix predicate on the "entry_id" field.
+func EntryIDHasSuffix(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldHasSuffix(FieldEntryID, v))
+}
+
+// EntryIDEqualFold applies the EqualFold predicate on the "entry_id" field.
+func EntryIDEqualFold(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEqualFold(FieldEntryID, v))
+}
+
+// EntryIDContainsFold applies the ContainsFold predicate on the "entry_id" field.
+func EntryIDContainsFold(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldContainsFold(FieldEntryID, v))
+}
+
+// EntryIndexEQ applies the EQ predicate on the "entry_index" field.
+func EntryIndexEQ(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldEntryIndex, v))
+}
+
+// EntryIndexNEQ applies the NEQ predicate on the "entry_index" field.
+func EntryIndexNEQ(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldEntryIndex, v))
+}
+
+// EntryIndexIn applies the In predicate on the "entry_index" field.
+func EntryIndexIn(vs ...int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldEntryIndex, vs...))
+}
+
+// EntryIndexNotIn applies the NotIn predicate on the "entry_index" field.
+func EntryIndexNotIn(vs ...int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldEntryIndex, vs...))
+}
+
+// EntryIndexGT applies the GT predicate on the "entry_index" field.
+func EntryIndexGT(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGT(FieldEntryIndex, v))
+}
+
+// EntryIndexGTE applies the GTE predicate on the "entry_index" field.
+func EntryIndexGTE(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGTE(FieldEntryIndex, v))
+}
+
+// EntryIndexLT applies the LT predicate on the "entry_index" field.
+func EntryIndexLT(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLT(FieldEntryIndex, v))
+}
+
+// EntryIndexLTE applies the LTE predicate on the "entry_index" field.
+func EntryIndexLTE(v int) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLTE(FieldEntryIndex, v))
+}
+
+// MessageEQ applies the EQ predicate on the "message" field.
+func MessageEQ(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldMessage, v))
+}
+
+// MessageNEQ applies the NEQ predicate on the "message" field.
+func MessageNEQ(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldMessage, v))
+}
+
+// MessageIn applies the In predicate on the "message" field.
+func MessageIn(vs ...string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldMessage, vs...))
+}
+
+// MessageNotIn applies the NotIn predicate on the "message" field.
+func MessageNotIn(vs ...string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldMessage, vs...))
+}
+
+// MessageGT applies the GT predicate on the "message" field.
+func MessageGT(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGT(FieldMessage, v))
+}
+
+// MessageGTE applies the GTE predicate on the "message" field.
+func MessageGTE(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGTE(FieldMessage, v))
+}
+
+// MessageLT applies the LT predicate on the "message" field.
+func MessageLT(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLT(FieldMessage, v))
+}
+
+// MessageLTE applies the LTE predicate on the "message" field.
+func MessageLTE(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLTE(FieldMessage, v))
+}
+
+// MessageContains applies the Contains predicate on the "message" field.
+func MessageContains(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldContains(FieldMessage, v))
+}
+
+// MessageHasPrefix applies the HasPrefix predicate on the "message" field.
+func MessageHasPrefix(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldHasPrefix(FieldMessage, v))
+}
+
+// MessageHasSuffix applies the HasSuffix predicate on the "message" field.
+func MessageHasSuffix(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldHasSuffix(FieldMessage, v))
+}
+
+// MessageEqualFold applies the EqualFold predicate on the "message" field.
+func MessageEqualFold(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEqualFold(FieldMessage, v))
+}
+
+// MessageContainsFold applies the ContainsFold predicate on the "message" field.
+func MessageContainsFold(v string) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldContainsFold(FieldMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.FieldNotNull(FieldResolvedAt))
+}
+
+// HasRun applies the HasEdge predicate on the "run" edge.
+func HasRun() predicate.DictImportFailure {
+	return predicate.DictImportFailure(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, RunTable, RunColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRunWith applies the HasEdge predicate on the "run" edge with a given conditions (other predicates).
+func HasRunWith(preds ...predicate.DictImportRun) predicate.DictImportFailure {
+	return predicate.DictImportFailure(func(s *sql.Selector) {
+		step := newRunStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DictImportFailure) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DictImportFailure) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DictImportFailure) predicate.DictImportFailure {
+	return predicate.DictImportFailure(sql.NotPredicates(p))
+}
@@ -0,0 +1,810 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportFailureCreate is the builder for creating a DictImportFailure entity.
+type DictImportFailureCreate struct {
+	config
+	mutation *DictImportFailureMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetRunID sets the "run_id" field.
+func (difc *DictImportFailureCreate) SetRunID(i int) *DictImportFailureCreate {
+	difc.mutation.SetRunID(i)
+	return difc
+}
+
+// SetEntryID sets the "entry_id" field.
+func (difc *DictImportFailureCreate) SetEntryID(s string) *DictImportFailureCreate {
+	difc.mutation.SetEntryID(s)
+	return difc
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (difc *DictImportFailureCreate) SetEntryIndex(i int) *DictImportFailureCreate {
+	difc.mutation.SetEntryIndex(i)
+	return difc
+}
+
+// SetMessage sets the "message" field.
+func (difc *DictImportFailureCreate) SetMessage(s string) *DictImportFailureCreate {
+	difc.mutation.SetMessage(s)
+	return difc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (difc *DictImportFailureCreate) SetCreatedAt(t time.Time) *DictImportFailureCreate {
+	difc.mutation.SetCreatedAt(t)
+	return difc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (difc *DictImportFailureCreate) SetNillableCreatedAt(t *time.Time) *DictImportFailureCreate {
+	if t != nil {
+		difc.SetCreatedAt(*t)
+	}
+	return difc
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (difc *DictImportFailureCreate) SetResolvedAt(t time.Time) *DictImportFailureCreate {
+	difc.mutation.SetResolvedAt(t)
+	return difc
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (difc *DictImportFailureCreate) SetNillableResolvedAt(t *time.Time) *DictImportFailureCreate {
+	if t != nil {
+		difc.SetResolvedAt(*t)
+	}
+	return difc
+}
+
+// SetRun sets the "run" edge to the DictImportRun entity.
+func (difc *DictImportFailureCreate) SetRun(d *DictImportRun) *DictImportFailureCreate {
+	return difc.SetRunID(d.ID)
+}
+
+// Mutation returns the DictImportFailureMutation object of the builder.
+func (difc *DictImportFailureCreate) Mutation() *DictImportFailureMutation {
+	return difc.mutation
+}
+
+// Save creates the DictImportFailure in the database.
+func (difc *DictImportFailureCreate) Save(ctx context.Context) (*DictImportFailure, error) {
+	difc.defaults()
+	return withHooks(ctx, difc.sqlSave, difc.mutation, difc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (difc *DictImportFailureCreate) SaveX(ctx context.Context) *DictImportFailure {
+	v, err := difc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (difc *DictImportFailureCreate) Exec(ctx context.Context) error {
+	_, err := difc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (difc *DictImportFailureCreate) ExecX(ctx context.Context) {
+	if err := difc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (difc *DictImportFailureCreate) defaults() {
+	if _, ok := difc.mutation.CreatedAt(); !ok {
+		v := dictimportfailure.DefaultCreatedAt()
+		difc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (difc *DictImportFailureCreate) check() error {
+	if _, ok := difc.mutation.RunID(); !ok {
+		return &ValidationError{Name: "run_id", err: errors.New(`ent: missing required field "DictImportFailure.run_id"`)}
+	}
+	if _, ok := difc.mutation.EntryID(); !ok {
+		return &ValidationError{Name: "entry_id", err: errors.New(`ent: missing required field "DictImportFailure.entry_id"`)}
+	}
+	if _, ok := difc.mutation.EntryIndex(); !ok {
+		return &ValidationError{Name: "entry_index", err: errors.New(`ent: missing required field "DictImportFailure.entry_index"`)}
+	}
+	if v, ok := difc.mutation.EntryIndex(); ok {
+		if err := dictimportfailure.EntryIndexValidator(v); err != nil {
+			return &ValidationError{Name: "entry_index", err: fmt.Errorf(`ent: validator failed for field "DictImportFailure.entry_index": %w`, err)}
+		}
+	}
+	if _, ok := difc.mutation.Message(); !ok {
+		return &ValidationError{Name: "message", err: errors.New(`ent: missing required field "DictImportFailure.message"`)}
+	}
+	if _, ok := difc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "DictImportFailure.created_at"`)}
+	}
+	if len(difc.mutation.RunIDs()) == 0 {
+		return &ValidationError{Name: "run", err: errors.New(`ent: missing required edge "DictImportFailure.run"`)}
+	}
+	return nil
+}
+
+func (difc *DictImportFailureCreate) sqlSave(ctx context.Context) (*DictImportFailure, error) {
+	if err := difc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := difc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, difc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	difc.mutation.id = &_node.ID
+	difc.mutation.done = true
+	return _node, nil
+}
+
+func (difc *DictImportFailureCreate) createSpec() (*DictImportFailure, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DictImportFailure{config: difc.config}
+		_spec = sqlgraph.NewCreateSpec(dictimportfailure.Table, sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = difc.conflict
+	if value, ok := difc.mutation.EntryID(); ok {
+		_spec.SetField(dictimportfailure.FieldEntryID, field.TypeString, value)
+		_node.EntryID = value
+	}
+	if value, ok := difc.mutation.EntryIndex(); ok {
+		_spec.SetField(dictimportfailure.FieldEntryIndex, field.TypeInt, value)
+		_node.EntryIndex = value
+	}
+	if value, ok := difc.mutation.Message(); ok {
+		_spec.SetField(dictimportfailure.FieldMessage, field.TypeString, value)
+		_node.Message = value
+	}
+	if value, ok := difc.mutation.CreatedAt(); ok {
+		_spec.SetField(dictimportfailure.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := difc.mutation.ResolvedAt(); ok {
+		_spec.SetField(dictimportfailure.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
+	if nodes := difc.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dictimportfailure.RunTable,
+			Columns: []string{dictimportfailure.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.RunID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DictImportFailure.Create().
+//		SetRunID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DictImportFailureUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (difc *DictImportFailureCreate) OnConflict(opts ...sql.ConflictOption) *DictImportFailureUpsertOne {
+	difc.conflict = opts
+	return &DictImportFailureUpsertOne{
+		create: difc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DictImportFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (difc *DictImportFailureCreate) OnConflictColumns(columns ...string) *DictImportFailureUpsertOne {
+	difc.conflict = append(difc.conflict, sql.ConflictColumns(columns...))
+	return &DictImportFailureUpsertOne{
+		create: difc,
+	}
+}
+
+type (
+	// DictImportFailureUpsertOne is the builder for "upsert"-ing
+	//  one DictImportFailure node.
+	DictImportFailureUpsertOne struct {
+		create *DictImportFailureCreate
+	}
+
+	// DictImportFailureUpsert is the "OnConflict" setter.
+	DictImportFailureUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetRunID sets the "run_id" field.
+func (u *DictImportFailureUpsert) SetRunID(v int) *DictImportFailureUpsert {
+	u.Set(dictimportfailure.FieldRunID, v)
+	return u
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DictImportFailureUpsert) UpdateRunID() *DictImportFailureUpsert {
+	u.SetExcluded(dictimportfailure.FieldRunID)
+	return u
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *DictImportFailureUpsert) SetEntryID(v string) *DictImportFailureUpsert {
+	u.Set(dictimportfailure.FieldEntryID, v)
+	return u
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *DictImportFailureUpsert) UpdateEntryID() *DictImportFailureUpsert {
+	u.SetExcluded(dictimportfailure.FieldEntryID)
+	return u
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (u *DictImportFailureUpsert) SetEntryIndex(v int) *DictImportFailureUpsert {
+	u.Set(dictimportfailure.FieldEntryIndex, v)
+	return u
+}
+
+// UpdateEntryIndex sets the "entry_index" field to the value that was provided on create.
+func (u *DictImportFailureUpsert) UpdateEntryIndex() *DictImportFailureUpsert {
+	u.SetExcluded(dictimportfailure.FieldEntryIndex)
+	return u
+}
+
+// AddEntryIndex adds v to the "entry_index" field.
+func (u *DictImportFailureUpsert) AddEntryIndex(v int) *DictImportFailureUpsert {
+	u.Add(dictimportfailure.FieldEntryIndex, v)
+	return u
+}
+
+// SetMessage sets the "message" field.
+func (u *DictImportFailureUpsert) SetMessage(v string) *DictImportFailureUpsert {
+	u.Set(dictimportfailure.FieldMessage, v)
+	return u
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DictImportFailureUpsert) UpdateMessage() *DictImportFailureUpsert {
+	u.SetExcluded(dictimportfailure.FieldMessage)
+	return u
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DictImportFailureUpsert) SetCreatedAt(v time.Time) *DictImportFailureUpsert {
+	u.Set(dictimportfailure.FieldCreatedAt, v)
+	return u
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DictImportFailureUpsert) UpdateCreatedAt() *DictImportFailureUpsert {
+	u.SetExcluded(dictimportfailure.FieldCreatedAt)
+	return u
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DictImportFailureUpsert) SetResolvedAt(v time.Time) *DictImportFailureUpsert {
+	u.Set(dictimportfailure.FieldResolvedAt, v)
+	return u
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DictImportFailureUpsert) UpdateResolvedAt() *DictImportFailureUpsert {
+	u.SetExcluded(dictimportfailure.FieldResolvedAt)
+	return u
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DictImportFailureUpsert) ClearResolvedAt() *DictImportFailureUpsert {
+	u.SetNull(dictimportfailure.FieldResolvedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DictImportFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DictImportFailureUpsertOne) UpdateNewValues() *DictImportFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DictImportFailure.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DictImportFailureUpsertOne) Ignore() *DictImportFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DictImportFailureUpsertOne) DoNothing() *DictImportFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DictImportFailureCreate.OnConflict
+// documentation for more info.
+func (u *DictImportFailureUpsertOne) Update(set func(*DictImportFailureUpsert)) *DictImportFailureUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DictImportFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DictImportFailureUpsertOne) SetRunID(v int) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DictImportFailureUpsertOne) UpdateRunID() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *DictImportFailureUpsertOne) SetEntryID(v string) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *DictImportFailureUpsertOne) UpdateEntryID() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (u *DictImportFailureUpsertOne) SetEntryIndex(v int) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetEntryIndex(v)
+	})
+}
+
+// AddEntryIndex adds v to the "entry_index" field.
+func (u *DictImportFailureUpsertOne) AddEntryIndex(v int) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.AddEntryIndex(v)
+	})
+}
+
+// UpdateEntryIndex sets the "entry_index" field to the value that was provided on create.
+func (u *DictImportFailureUpsertOne) UpdateEntryIndex() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateEntryIndex()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *DictImportFailureUpsertOne) SetMessage(v string) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DictImportFailureUpsertOne) UpdateMessage() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DictImportFailureUpsertOne) SetCreatedAt(v time.Time) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DictImportFailureUpsertOne) UpdateCreatedAt() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DictImportFailureUpsertOne) SetResolvedAt(v time.Time) *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DictImportFailureUpsertOne) UpdateResolvedAt() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DictImportFailureUpsertOne) ClearResolvedAt() *DictImportFailureUpsertOne {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DictImportFailureUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DictImportFailureCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DictImportFailureUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DictImportFailureUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DictImportFailureUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DictImportFailureCreateBulk is the builder for creating many DictImportFailure entities in bulk.
+type DictImportFailureCreateBulk struct {
+	config
+	err      error
+	builders []*DictImportFailureCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DictImportFailure entities in the database.
+func (difcb *DictImportFailureCreateBulk) Save(ctx context.Context) ([]*DictImportFailure, error) {
+	if difcb.err != nil {
+		return nil, difcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(difcb.builders))
+	nodes := make([]*DictImportFailure, len(difcb.builders))
+	mutators := make([]Mutator, len(difcb.builders))
+	for i := range difcb.builders {
+		func(i int, root context.Context) {
+			builder := difcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DictImportFailureMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, difcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = difcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, difcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, difcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (difcb *DictImportFailureCreateBulk) SaveX(ctx context.Context) []*DictImportFailure {
+	v, err := difcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (difcb *DictImportFailureCreateBulk) Exec(ctx context.Context) error {
+	_, err := difcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (difcb *DictImportFailureCreateBulk) ExecX(ctx context.Context) {
+	if err := difcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DictImportFailure.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DictImportFailureUpsert) {
+//			SetRunID(v+v).
+//		}).
+//		Exec(ctx)
+func (difcb *DictImportFailureCreateBulk) OnConflict(opts ...sql.ConflictOption) *DictImportFailureUpsertBulk {
+	difcb.conflict = opts
+	return &DictImportFailureUpsertBulk{
+		create: difcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DictImportFailure.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (difcb *DictImportFailureCreateBulk) OnConflictColumns(columns ...string) *DictImportFailureUpsertBulk {
+	difcb.conflict = append(difcb.conflict, sql.ConflictColumns(columns...))
+	return &DictImportFailureUpsertBulk{
+		create: difcb,
+	}
+}
+
+// DictImportFailureUpsertBulk is the builder for "upsert"-ing
+// a bulk of DictImportFailure nodes.
+type DictImportFailureUpsertBulk struct {
+	create *DictImportFailureCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DictImportFailure.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DictImportFailureUpsertBulk) UpdateNewValues() *DictImportFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DictImportFailure.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DictImportFailureUpsertBulk) Ignore() *DictImportFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DictImportFailureUpsertBulk) DoNothing() *DictImportFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DictImportFailureCreateBulk.OnConflict
+// documentation for more info.
+func (u *DictImportFailureUpsertBulk) Update(set func(*DictImportFailureUpsert)) *DictImportFailureUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DictImportFailureUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetRunID sets the "run_id" field.
+func (u *DictImportFailureUpsertBulk) SetRunID(v int) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetRunID(v)
+	})
+}
+
+// UpdateRunID sets the "run_id" field to the value that was provided on create.
+func (u *DictImportFailureUpsertBulk) UpdateRunID() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateRunID()
+	})
+}
+
+// SetEntryID sets the "entry_id" field.
+func (u *DictImportFailureUpsertBulk) SetEntryID(v string) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetEntryID(v)
+	})
+}
+
+// UpdateEntryID sets the "entry_id" field to the value that was provided on create.
+func (u *DictImportFailureUpsertBulk) UpdateEntryID() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateEntryID()
+	})
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (u *DictImportFailureUpsertBulk) SetEntryIndex(v int) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetEntryIndex(v)
+	})
+}
+
+// AddEntryIndex adds v to the "entry_index" field.
+func (u *DictImportFailureUpsertBulk) AddEntryIndex(v int) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.AddEntryIndex(v)
+	})
+}
+
+// UpdateEntryIndex sets the "entry_index" field to the value that was provided on create.
+func (u *DictImportFailureUpsertBulk) UpdateEntryIndex() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateEntryIndex()
+	})
+}
+
+// SetMessage sets the "message" field.
+func (u *DictImportFailureUpsertBulk) SetMessage(v string) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetMessage(v)
+	})
+}
+
+// UpdateMessage sets the "message" field to the value that was provided on create.
+func (u *DictImportFailureUpsertBulk) UpdateMessage() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateMessage()
+	})
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (u *DictImportFailureUpsertBulk) SetCreatedAt(v time.Time) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetCreatedAt(v)
+	})
+}
+
+// UpdateCreatedAt sets the "created_at" field to the value that was provided on create.
+func (u *DictImportFailureUpsertBulk) UpdateCreatedAt() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateCreatedAt()
+	})
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (u *DictImportFailureUpsertBulk) SetResolvedAt(v time.Time) *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.SetResolvedAt(v)
+	})
+}
+
+// UpdateResolvedAt sets the "resolved_at" field to the value that was provided on create.
+func (u *DictImportFailureUpsertBulk) UpdateResolvedAt() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.UpdateResolvedAt()
+	})
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (u *DictImportFailureUpsertBulk) ClearResolvedAt() *DictImportFailureUpsertBulk {
+	return u.Update(func(s *DictImportFailureUpsert) {
+		s.ClearResolvedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DictImportFailureUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DictImportFailureCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DictImportFailureCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DictImportFailureUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportFailureDelete is the builder for deleting a DictImportFailure entity.
+type DictImportFailureDelete struct {
+	config
+	hooks    []Hook
+	mutation *DictImportFailureMutation
+}
+
+// Where appends a list predicates to the DictImportFailureDelete builder.
+func (difd *DictImportFailureDelete) Where(ps ...predicate.DictImportFailure) *DictImportFailureDelete {
+	difd.mutation.Where(ps...)
+	return difd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (difd *DictImportFailureDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, difd.sqlExec, difd.mutation, difd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (difd *DictImportFailureDelete) ExecX(ctx context.Context) int {
+	n, err := difd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (difd *DictImportFailureDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dictimportfailure.Table, sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt))
+	if ps := difd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, difd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	difd.mutation.done = true
+	return affected, err
+}
+
+// DictImportFailureDeleteOne is the builder for deleting a single DictImportFailure entity.
+type DictImportFailureDeleteOne struct {
+	difd *DictImportFailureDelete
+}
+
+// Where appends a list predicates to the DictImportFailureDelete builder.
+func (difdo *DictImportFailureDeleteOne) Where(ps ...predicate.DictImportFailure) *DictImportFailureDeleteOne {
+	difdo.difd.mutation.Where(ps...)
+	return difdo
+}
+
+// Exec executes the deletion query.
+func (difdo *DictImportFailureDeleteOne) Exec(ctx context.Context) error {
+	n, err := difdo.difd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dictimportfailure.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (difdo *DictImportFailureDeleteOne) ExecX(ctx context.Context) {
+	if err := difdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportFailureQuery is the builder for querying DictImportFailure entities.
+type DictImportFailureQuery struct {
+	config
+	ctx        *QueryContext
+	order      []dictimportfailure.OrderOption
+	inters     []Interceptor
+	predicates []predicate.DictImportFailure
+	withRun    *DictImportRunQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DictImportFailureQuery builder.
+func (difq *DictImportFailureQuery) Where(ps ...predicate.DictImportFailure) *DictImportFailureQuery {
+	difq.predicates = append(difq.predicates, ps...)
+	return difq
+}
+
+// Limit the number of records to be returned by this query.
+func (difq *DictImportFailureQuery) Limit(limit int) *DictImportFailureQuery {
+	difq.ctx.Limit = &limit
+	return difq
+}
+
+// Offset to start from.
+func (difq *DictImportFailureQuery) Offset(offset int) *DictImportFailureQuery {
+	difq.ctx.Offset = &offset
+	return difq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (difq *DictImportFailureQuery) Unique(unique bool) *DictImportFailureQuery {
+	difq.ctx.Unique = &unique
+	return difq
+}
+
+// Order specifies how the records should be ordered.
+func (difq *DictImportFailureQuery) Order(o ...dictimportfailure.OrderOption) *DictImportFailureQuery {
+	difq.order = append(difq.order, o...)
+	return difq
+}
+
+// QueryRun chains the current query on the "run" edge.
+func (difq *DictImportFailureQuery) QueryRun() *DictImportRunQuery {
+	query := (&DictImportRunClient{config: difq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := difq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := difq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dictimportfailure.Table, dictimportfailure.FieldID, selector),
+			sqlgraph.To(dictimportrun.Table, dictimportrun.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, dictimportfailure.RunTable, dictimportfailure.RunColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(difq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DictImportFailure entity from the query.
+// Returns a *NotFoundError when no DictImportFailure was found.
+func (difq *DictImportFailureQuery) First(ctx context.Context) (*DictImportFailure, error) {
+	nodes, err := difq.Limit(1).All(setContextOp(ctx, difq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dictimportfailure.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (difq *DictImportFailureQuery) FirstX(ctx context.Context) *DictImportFailure {
+	node, err := difq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DictImportFailure ID from the query.
+// Returns a *NotFoundError when no DictImportFailure ID was found.
+func (difq *DictImportFailureQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = difq.Limit(1).IDs(setContextOp(ctx, difq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dictimportfailure.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (difq *DictImportFailureQuery) FirstIDX(ctx context.Context) int {
+	id, err := difq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DictImportFailure entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DictImportFailure entity is found.
+// Returns a *NotFoundError when no DictImportFailure entities are found.
+func (difq *DictImportFailureQuery) Only(ctx context.Context) (*DictImportFailure, error) {
+	nodes, err := difq.Limit(2).All(setContextOp(ctx, difq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dictimportfailure.Label}
+	default:
+		return nil, &NotSingularError{dictimportfailure.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (difq *DictImportFailureQuery) OnlyX(ctx context.Context) *DictImportFailure {
+	node, err := difq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DictImportFailure ID in the query.
+// Returns a *NotSingularError when more than one DictImportFailure ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (difq *DictImportFailureQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = difq.Limit(2).IDs(setContextOp(ctx, difq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dictimportfailure.Label}
+	default:
+		err = &NotSingularError{dictimportfailure.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (difq *DictImportFailureQuery) OnlyIDX(ctx context.Context) int {
+	id, err := difq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DictImportFailures.
+func (difq *DictImportFailureQuery) All(ctx context.Context) ([]*DictImportFailure, error) {
+	ctx = setContextOp(ctx, difq.ctx, ent.OpQueryAll)
+	if err := difq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DictImportFailure, *DictImportFailureQuery]()
+	return withInterceptors[[]*DictImportFailure](ctx, difq, qr, difq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (difq *DictImportFailureQuery) AllX(ctx context.Context) []*DictImportFailure {
+	nodes, err := difq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DictImportFailure IDs.
+func (difq *DictImportFailureQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if difq.ctx.Unique == nil && difq.path != nil {
+		difq.Unique(true)
+	}
+	ctx = setContextOp(ctx, difq.ctx, ent.OpQueryIDs)
+	if err = difq.Select(dictimportfailure.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (difq *DictImportFailureQuery) IDsX(ctx context.Context) []int {
+	ids, err := difq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (difq *DictImportFailureQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, difq.ctx, ent.OpQueryCount)
+	if err := difq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, difq, querierCount[*DictImportFailureQuery](), difq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (difq *DictImportFailureQuery) CountX(ctx context.Context) int {
+	count, err := difq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (difq *DictImportFailureQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, difq.ctx, ent.OpQueryExist)
+	switch _, err := difq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (difq *DictImportFailureQuery) ExistX(ctx context.Context) bool {
+	exist, err := difq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DictImportFailureQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (difq *DictImportFailureQuery) Clone() *DictImportFailureQuery {
+	if difq == nil {
+		return nil
+	}
+	return &DictImportFailureQuery{
+		config:     difq.config,
+		ctx:        difq.ctx.Clone(),
+		order:      append([]dictimportfailure.OrderOption{}, difq.order...),
+		inters:     append([]Interceptor{}, difq.inters...),
+		predicates: append([]predicate.DictImportFailure{}, difq.predicates...),
+		withRun:    difq.withRun.Clone(),
+		// clone intermediate query.
+		sql:       difq.sql.Clone(),
+		path:      difq.path,
+		modifiers: append([]func(*sql.Selector){}, difq.modifiers...),
+	}
+}
+
+// WithRun tells the query-builder to eager-load the nodes that are connected to
+// the "run" edge. The optional arguments are used to configure the query builder of the edge.
+func (difq *DictImportFailureQuery) WithRun(opts ...func(*DictImportRunQuery)) *DictImportFailureQuery {
+	query := (&DictImportRunClient{config: difq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	difq.withRun = query
+	return difq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RunID int `json:"run_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DictImportFailure.Query().
+//		GroupBy(dictimportfailure.FieldRunID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (difq *DictImportFailureQuery) GroupBy(field string, fields ...string) *DictImportFailureGroupBy {
+	difq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DictImportFailureGroupBy{build: difq}
+	grbuild.flds = &difq.ctx.Fields
+	grbuild.label = dictimportfailure.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RunID int `json:"run_id,omitempty"`
+//	}
+//
+//	client.DictImportFailure.Query().
+//		Select(dictimportfailure.FieldRunID).
+//		Scan(ctx, &v)
+func (difq *DictImportFailureQuery) Select(fields ...string) *DictImportFailureSelect {
+	difq.ctx.Fields = append(difq.ctx.Fields, fields...)
+	sbuild := &DictImportFailureSelect{DictImportFailureQuery: difq}
+	sbuild.label = dictimportfailure.Label
+	sbuild.flds, sbuild.scan = &difq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DictImportFailureSelect configured with the given aggregations.
+func (difq *DictImportFailureQuery) Aggregate(fns ...AggregateFunc) *DictImportFailureSelect {
+	return difq.Select().Aggregate(fns...)
+}
+
+func (difq *DictImportFailureQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range difq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, difq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range difq.ctx.Fields {
+		if !dictimportfailure.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if difq.path != nil {
+		prev, err := difq.path(ctx)
+		if err != nil {
+			return err
+		}
+		difq.sql = prev
+	}
+	return nil
+}
+
+func (difq *DictImportFailureQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DictImportFailure, error) {
+	var (
+		nodes       = []*DictImportFailure{}
+		_spec       = difq.querySpec()
+		loadedTypes = [1]bool{
+			difq.withRun != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DictImportFailure).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DictImportFailure{config: difq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(difq.modifiers) > 0 {
+		_spec.Modifiers = difq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, difq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := difq.withRun; query != nil {
+		if err := difq.loadRun(ctx, query, nodes, nil,
+			func(n *DictImportFailure, e *DictImportRun) { n.Edges.Run = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (difq *DictImportFailureQuery) loadRun(ctx context.Context, query *DictImportRunQuery, nodes []*DictImportFailure, init func(*DictImportFailure), assign func(*DictImportFailure, *DictImportRun)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*DictImportFailure)
+	for i := range nodes {
+		fk := nodes[i].RunID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(dictimportrun.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "run_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (difq *DictImportFailureQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := difq.querySpec()
+	if len(difq.modifiers) > 0 {
+		_spec.Modifiers = difq.modifiers
+	}
+	_spec.Node.Columns = difq.ctx.Fields
+	if len(difq.ctx.Fields) > 0 {
+		_spec.Unique = difq.ctx.Unique != nil && *difq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, difq.driver, _spec)
+}
+
+func (difq *DictImportFailureQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dictimportfailure.Table, dictimportfailure.Columns, sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt))
+	_spec.From = difq.sql
+	if unique := difq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if difq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := difq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dictimportfailure.FieldID)
+		for i := range fields {
+			if fields[i] != dictimportfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if difq.withRun != nil {
+			_spec.Node.AddColumnOnce(dictimportfailure.FieldRunID)
+		}
+	}
+	if ps := difq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := difq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := difq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := difq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (difq *DictImportFailureQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(difq.driver.Dialect())
+	t1 := builder.Table(dictimportfailure.Table)
+	columns := difq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dictimportfailure.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if difq.sql != nil {
+		selector = difq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if difq.ctx.Unique != nil && *difq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range difq.modifiers {
+		m(selector)
+	}
+	for _, p := range difq.predicates {
+		p(selector)
+	}
+	for _, p := range difq.order {
+		p(selector)
+	}
+	if offset := difq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := difq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (difq *DictImportFailureQuery) Modify(modifiers ...func(s *sql.Selector)) *DictImportFailureSelect {
+	difq.modifiers = append(difq.modifiers, modifiers...)
+	return difq.Select()
+}
+
+// DictImportFailureGroupBy is the group-by builder for DictImportFailure entities.
+type DictImportFailureGroupBy struct {
+	selector
+	build *DictImportFailureQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (difgb *DictImportFailureGroupBy) Aggregate(fns ...AggregateFunc) *DictImportFailureGroupBy {
+	difgb.fns = append(difgb.fns, fns...)
+	return difgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (difgb *DictImportFailureGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, difgb.build.ctx, ent.OpQueryGroupBy)
+	if err := difgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DictImportFailureQuery, *DictImportFailureGroupBy](ctx, difgb.build, difgb, difgb.build.inters, v)
+}
+
+func (difgb *DictImportFailureGroupBy) sqlScan(ctx context.Context, root *DictImportFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(difgb.fns))
+	for _, fn := range difgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*difgb.flds)+len(difgb.fns))
+		for _, f := range *difgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*difgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := difgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DictImportFailureSelect is the builder for selecting fields of DictImportFailure entities.
+type DictImportFailureSelect struct {
+	*DictImportFailureQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (difs *DictImportFailureSelect) Aggregate(fns ...AggregateFunc) *DictImportFailureSelect {
+	difs.fns = append(difs.fns, fns...)
+	return difs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (difs *DictImportFailureSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, difs.ctx, ent.OpQuerySelect)
+	if err := difs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DictImportFailureQuery, *DictImportFailureSelect](ctx, difs.DictImportFailureQuery, difs, difs.inters, v)
+}
+
+func (difs *DictImportFailureSelect) sqlScan(ctx context.Context, root *DictImportFailureQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(difs.fns))
+	for _, fn := range difs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*difs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := difs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (difs *DictImportFailureSelect) Modify(modifiers ...func(s *sql.Selector)) *DictImportFailureSelect {
+	difs.modifiers = append(difs.modifiers, modifiers...)
+	return difs
+}
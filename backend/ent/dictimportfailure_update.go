@@ -0,0 +1,541 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportFailureUpdate is the builder for updating DictImportFailure entities.
+type DictImportFailureUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DictImportFailureMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DictImportFailureUpdate builder.
+func (difu *DictImportFailureUpdate) Where(ps ...predicate.DictImportFailure) *DictImportFailureUpdate {
+	difu.mutation.Where(ps...)
+	return difu
+}
+
+// SetRunID sets the "run_id" field.
+func (difu *DictImportFailureUpdate) SetRunID(i int) *DictImportFailureUpdate {
+	difu.mutation.SetRunID(i)
+	return difu
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (difu *DictImportFailureUpdate) SetNillableRunID(i *int) *DictImportFailureUpdate {
+	if i != nil {
+		difu.SetRunID(*i)
+	}
+	return difu
+}
+
+// SetEntryID sets the "entry_id" field.
+func (difu *DictImportFailureUpdate) SetEntryID(s string) *DictImportFailureUpdate {
+	difu.mutation.SetEntryID(s)
+	return difu
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (difu *DictImportFailureUpdate) SetNillableEntryID(s *string) *DictImportFailureUpdate {
+	if s != nil {
+		difu.SetEntryID(*s)
+	}
+	return difu
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (difu *DictImportFailureUpdate) SetEntryIndex(i int) *DictImportFailureUpdate {
+	difu.mutation.ResetEntryIndex()
+	difu.mutation.SetEntryIndex(i)
+	return difu
+}
+
+// SetNillableEntryIndex sets the "entry_index" field if the given value is not nil.
+func (difu *DictImportFailureUpdate) SetNillableEntryIndex(i *int) *DictImportFailureUpdate {
+	if i != nil {
+		difu.SetEntryIndex(*i)
+	}
+	return difu
+}
+
+// AddEntryIndex adds i to the "entry_index" field.
+func (difu *DictImportFailureUpdate) AddEntryIndex(i int) *DictImportFailureUpdate {
+	difu.mutation.AddEntryIndex(i)
+	return difu
+}
+
+// SetMessage sets the "message" field.
+func (difu *DictImportFailureUpdate) SetMessage(s string) *DictImportFailureUpdate {
+	difu.mutation.SetMessage(s)
+	return difu
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (difu *DictImportFailureUpdate) SetNillableMessage(s *string) *DictImportFailureUpdate {
+	if s != nil {
+		difu.SetMessage(*s)
+	}
+	return difu
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (difu *DictImportFailureUpdate) SetCreatedAt(t time.Time) *DictImportFailureUpdate {
+	difu.mutation.SetCreatedAt(t)
+	return difu
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (difu *DictImportFailureUpdate) SetNillableCreatedAt(t *time.Time) *DictImportFailureUpdate {
+	if t != nil {
+		difu.SetCreatedAt(*t)
+	}
+	return difu
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (difu *DictImportFailureUpdate) SetResolvedAt(t time.Time) *DictImportFailureUpdate {
+	difu.mutation.SetResolvedAt(t)
+	return difu
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (difu *DictImportFailureUpdate) SetNillableResolvedAt(t *time.Time) *DictImportFailureUpdate {
+	if t != nil {
+		difu.SetResolvedAt(*t)
+	}
+	return difu
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (difu *DictImportFailureUpdate) ClearResolvedAt() *DictImportFailureUpdate {
+	difu.mutation.ClearResolvedAt()
+	return difu
+}
+
+// SetRun sets the "run" edge to the DictImportRun entity.
+func (difu *DictImportFailureUpdate) SetRun(d *DictImportRun) *DictImportFailureUpdate {
+	return difu.SetRunID(d.ID)
+}
+
+// Mutation returns the DictImportFailureMutation object of the builder.
+func (difu *DictImportFailureUpdate) Mutation() *DictImportFailureMutation {
+	return difu.mutation
+}
+
+// ClearRun clears the "run" edge to the DictImportRun entity.
+func (difu *DictImportFailureUpdate) ClearRun() *DictImportFailureUpdate {
+	difu.mutation.ClearRun()
+	return difu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (difu *DictImportFailureUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, difu.sqlSave, difu.mutation, difu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (difu *DictImportFailureUpdate) SaveX(ctx context.Context) int {
+	affected, err := difu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (difu *DictImportFailureUpdate) Exec(ctx context.Context) error {
+	_, err := difu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (difu *DictImportFailureUpdate) ExecX(ctx context.Context) {
+	if err := difu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (difu *DictImportFailureUpdate) check() error {
+	if v, ok := difu.mutation.EntryIndex(); ok {
+		if err := dictimportfailure.EntryIndexValidator(v); err != nil {
+			return &ValidationError{Name: "entry_index", err: fmt.Errorf(`ent: validator failed for field "DictImportFailure.entry_index": %w`, err)}
+		}
+	}
+	if difu.mutation.RunCleared() && len(difu.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DictImportFailure.run"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (difu *DictImportFailureUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DictImportFailureUpdate {
+	difu.modifiers = append(difu.modifiers, modifiers...)
+	return difu
+}
+
+func (difu *DictImportFailureUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := difu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dictimportfailure.Table, dictimportfailure.Columns, sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt))
+	if ps := difu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := difu.mutation.EntryID(); ok {
+		_spec.SetField(dictimportfailure.FieldEntryID, field.TypeString, value)
+	}
+	if value, ok := difu.mutation.EntryIndex(); ok {
+		_spec.SetField(dictimportfailure.FieldEntryIndex, field.TypeInt, value)
+	}
+	if value, ok := difu.mutation.AddedEntryIndex(); ok {
+		_spec.AddField(dictimportfailure.FieldEntryIndex, field.TypeInt, value)
+	}
+	if value, ok := difu.mutation.Message(); ok {
+		_spec.SetField(dictimportfailure.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := difu.mutation.CreatedAt(); ok {
+		_spec.SetField(dictimportfailure.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := difu.mutation.ResolvedAt(); ok {
+		_spec.SetField(dictimportfailure.FieldResolvedAt, field.TypeTime, value)
+	}
+	if difu.mutation.ResolvedAtCleared() {
+		_spec.ClearField(dictimportfailure.FieldResolvedAt, field.TypeTime)
+	}
+	if difu.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dictimportfailure.RunTable,
+			Columns: []string{dictimportfailure.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := difu.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dictimportfailure.RunTable,
+			Columns: []string{dictimportfailure.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(difu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, difu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dictimportfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	difu.mutation.done = true
+	return n, nil
+}
+
+// DictImportFailureUpdateOne is the builder for updating a single DictImportFailure entity.
+type DictImportFailureUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DictImportFailureMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetRunID sets the "run_id" field.
+func (difuo *DictImportFailureUpdateOne) SetRunID(i int) *DictImportFailureUpdateOne {
+	difuo.mutation.SetRunID(i)
+	return difuo
+}
+
+// SetNillableRunID sets the "run_id" field if the given value is not nil.
+func (difuo *DictImportFailureUpdateOne) SetNillableRunID(i *int) *DictImportFailureUpdateOne {
+	if i != nil {
+		difuo.SetRunID(*i)
+	}
+	return difuo
+}
+
+// SetEntryID sets the "entry_id" field.
+func (difuo *DictImportFailureUpdateOne) SetEntryID(s string) *DictImportFailureUpdateOne {
+	difuo.mutation.SetEntryID(s)
+	return difuo
+}
+
+// SetNillableEntryID sets the "entry_id" field if the given value is not nil.
+func (difuo *DictImportFailureUpdateOne) SetNillableEntryID(s *string) *DictImportFailureUpdateOne {
+	if s != nil {
+		difuo.SetEntryID(*s)
+	}
+	return difuo
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (difuo *DictImportFailureUpdateOne) SetEntryIndex(i int) *DictImportFailureUpdateOne {
+	difuo.mutation.ResetEntryIndex()
+	difuo.mutation.SetEntryIndex(i)
+	return difuo
+}
+
+// SetNillableEntryIndex sets the "entry_index" field if the given value is not nil.
+func (difuo *DictImportFailureUpdateOne) SetNillableEntryIndex(i *int) *DictImportFailureUpdateOne {
+	if i != nil {
+		difuo.SetEntryIndex(*i)
+	}
+	return difuo
+}
+
+// AddEntryIndex adds i to the "entry_index" field.
+func (difuo *DictImportFailureUpdateOne) AddEntryIndex(i int) *DictImportFailureUpdateOne {
+	difuo.mutation.AddEntryIndex(i)
+	return difuo
+}
+
+// SetMessage sets the "message" field.
+func (difuo *DictImportFailureUpdateOne) SetMessage(s string) *DictImportFailureUpdateOne {
+	difuo.mutation.SetMessage(s)
+	return difuo
+}
+
+// SetNillableMessage sets the "message" field if the given value is not nil.
+func (difuo *DictImportFailureUpdateOne) SetNillableMessage(s *string) *DictImportFailureUpdateOne {
+	if s != nil {
+		difuo.SetMessage(*s)
+	}
+	return difuo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (difuo *DictImportFailureUpdateOne) SetCreatedAt(t time.Time) *DictImportFailureUpdateOne {
+	difuo.mutation.SetCreatedAt(t)
+	return difuo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (difuo *DictImportFailureUpdateOne) SetNillableCreatedAt(t *time.Time) *DictImportFailureUpdateOne {
+	if t != nil {
+		difuo.SetCreatedAt(*t)
+	}
+	return difuo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (difuo *DictImportFailureUpdateOne) SetResolvedAt(t time.Time) *DictImportFailureUpdateOne {
+	difuo.mutation.SetResolvedAt(t)
+	return difuo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (difuo *DictImportFailureUpdateOne) SetNillableResolvedAt(t *time.Time) *DictImportFailureUpdateOne {
+	if t != nil {
+		difuo.SetResolvedAt(*t)
+	}
+	return difuo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (difuo *DictImportFailureUpdateOne) ClearResolvedAt() *DictImportFailureUpdateOne {
+	difuo.mutation.ClearResolvedAt()
+	return difuo
+}
+
+// SetRun sets the "run" edge to the DictImportRun entity.
+func (difuo *DictImportFailureUpdateOne) SetRun(d *DictImportRun) *DictImportFailureUpdateOne {
+	return difuo.SetRunID(d.ID)
+}
+
+// Mutation returns the DictImportFailureMutation object of the builder.
+func (difuo *DictImportFailureUpdateOne) Mutation() *DictImportFailureMutation {
+	return difuo.mutation
+}
+
+// ClearRun clears the "run" edge to the DictImportRun entity.
+func (difuo *DictImportFailureUpdateOne) ClearRun() *DictImportFailureUpdateOne {
+	difuo.mutation.ClearRun()
+	return difuo
+}
+
+// Where appends a list predicates to the DictImportFailureUpdate builder.
+func (difuo *DictImportFailureUpdateOne) Where(ps ...predicate.DictImportFailure) *DictImportFailureUpdateOne {
+	difuo.mutation.Where(ps...)
+	return difuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (difuo *DictImportFailureUpdateOne) Select(field string, fields ...string) *DictImportFailureUpdateOne {
+	difuo.fields = append([]string{field}, fields...)
+	return difuo
+}
+
+// Save executes the query and returns the updated DictImportFailure entity.
+func (difuo *DictImportFailureUpdateOne) Save(ctx context.Context) (*DictImportFailure, error) {
+	return withHooks(ctx, difuo.sqlSave, difuo.mutation, difuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (difuo *DictImportFailureUpdateOne) SaveX(ctx context.Context) *DictImportFailure {
+	node, err := difuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (difuo *DictImportFailureUpdateOne) Exec(ctx context.Context) error {
+	_, err := difuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (difuo *DictImportFailureUpdateOne) ExecX(ctx context.Context) {
+	if err := difuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (difuo *DictImportFailureUpdateOne) check() error {
+	if v, ok := difuo.mutation.EntryIndex(); ok {
+		if err := dictimportfailure.EntryIndexValidator(v); err != nil {
+			return &ValidationError{Name: "entry_index", err: fmt.Errorf(`ent: validator failed for field "DictImportFailure.entry_index": %w`, err)}
+		}
+	}
+	if difuo.mutation.RunCleared() && len(difuo.mutation.RunIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "DictImportFailure.run"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (difuo *DictImportFailureUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DictImportFailureUpdateOne {
+	difuo.modifiers = append(difuo.modifiers, modifiers...)
+	return difuo
+}
+
+func (difuo *DictImportFailureUpdateOne) sqlSave(ctx context.Context) (_node *DictImportFailure, err error) {
+	if err := difuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dictimportfailure.Table, dictimportfailure.Columns, sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt))
+	id, ok := difuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DictImportFailure.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := difuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dictimportfailure.FieldID)
+		for _, f := range fields {
+			if !dictimportfailure.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dictimportfailure.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := difuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := difuo.mutation.EntryID(); ok {
+		_spec.SetField(dictimportfailure.FieldEntryID, field.TypeString, value)
+	}
+	if value, ok := difuo.mutation.EntryIndex(); ok {
+		_spec.SetField(dictimportfailure.FieldEntryIndex, field.TypeInt, value)
+	}
+	if value, ok := difuo.mutation.AddedEntryIndex(); ok {
+		_spec.AddField(dictimportfailure.FieldEntryIndex, field.TypeInt, value)
+	}
+	if value, ok := difuo.mutation.Message(); ok {
+		_spec.SetField(dictimportfailure.FieldMessage, field.TypeString, value)
+	}
+	if value, ok := difuo.mutation.CreatedAt(); ok {
+		_spec.SetField(dictimportfailure.FieldCreatedAt, field.TypeTime, value)
+	}
+	if value, ok := difuo.mutation.ResolvedAt(); ok {
+		_spec.SetField(dictimportfailure.FieldResolvedAt, field.TypeTime, value)
+	}
+	if difuo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(dictimportfailure.FieldResolvedAt, field.TypeTime)
+	}
+	if difuo.mutation.RunCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dictimportfailure.RunTable,
+			Columns: []string{dictimportfailure.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := difuo.mutation.RunIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dictimportfailure.RunTable,
+			Columns: []string{dictimportfailure.RunColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(difuo.modifiers...)
+	_node = &DictImportFailure{config: difuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, difuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dictimportfailure.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	difuo.mutation.done = true
+	return _node, nil
+}
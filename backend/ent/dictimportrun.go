@@ -0,0 +1,226 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"word_app/backend/ent/dictimportrun"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// DictImportRun is the model entity for the DictImportRun schema.
+type DictImportRun struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// SourcePath holds the value of the "source_path" field.
+	SourcePath string `json:"source_path,omitempty"`
+	// jmdict / csv / tsv / anki / edict
+	Format string `json:"format,omitempty"`
+	// 取り込んだファイルの SHA-256（hex）。同じファイルかの判定に使う
+	SourceHash string `json:"source_hash,omitempty"`
+	// running: 実行中（または異常終了）, completed: 完了, failed: エラーで中断
+	Status string `json:"status,omitempty"`
+	// この index までのエントリは書き込み済みか失敗を記録済み。-1 は未着手
+	LastCommittedIndex int `json:"last_committed_index,omitempty"`
+	// ErrorMessage holds the value of the "error_message" field.
+	ErrorMessage *string `json:"error_message,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the DictImportRunQuery when eager-loading is set.
+	Edges        DictImportRunEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// DictImportRunEdges holds the relations/edges for other nodes in the graph.
+type DictImportRunEdges struct {
+	// Failures holds the value of the failures edge.
+	Failures []*DictImportFailure `json:"failures,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// FailuresOrErr returns the Failures value or an error if the edge
+// was not loaded in eager-loading.
+func (e DictImportRunEdges) FailuresOrErr() ([]*DictImportFailure, error) {
+	if e.loadedTypes[0] {
+		return e.Failures, nil
+	}
+	return nil, &NotLoadedError{edge: "failures"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*DictImportRun) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case dictimportrun.FieldID, dictimportrun.FieldLastCommittedIndex:
+			values[i] = new(sql.NullInt64)
+		case dictimportrun.FieldSourcePath, dictimportrun.FieldFormat, dictimportrun.FieldSourceHash, dictimportrun.FieldStatus, dictimportrun.FieldErrorMessage:
+			values[i] = new(sql.NullString)
+		case dictimportrun.FieldStartedAt, dictimportrun.FieldFinishedAt, dictimportrun.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the DictImportRun fields.
+func (dir *DictImportRun) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case dictimportrun.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			dir.ID = int(value.Int64)
+		case dictimportrun.FieldSourcePath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_path", values[i])
+			} else if value.Valid {
+				dir.SourcePath = value.String
+			}
+		case dictimportrun.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				dir.Format = value.String
+			}
+		case dictimportrun.FieldSourceHash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_hash", values[i])
+			} else if value.Valid {
+				dir.SourceHash = value.String
+			}
+		case dictimportrun.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				dir.Status = value.String
+			}
+		case dictimportrun.FieldLastCommittedIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_committed_index", values[i])
+			} else if value.Valid {
+				dir.LastCommittedIndex = int(value.Int64)
+			}
+		case dictimportrun.FieldErrorMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error_message", values[i])
+			} else if value.Valid {
+				dir.ErrorMessage = new(string)
+				*dir.ErrorMessage = value.String
+			}
+		case dictimportrun.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				dir.StartedAt = value.Time
+			}
+		case dictimportrun.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				dir.FinishedAt = new(time.Time)
+				*dir.FinishedAt = value.Time
+			}
+		case dictimportrun.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				dir.UpdatedAt = value.Time
+			}
+		default:
+			dir.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the DictImportRun.
+// This includes values selected through modifiers, order, etc.
+func (dir *DictImportRun) Value(name string) (ent.Value, error) {
+	return dir.selectValues.Get(name)
+}
+
+// QueryFailures queries the "failures" edge of the DictImportRun entity.
+func (dir *DictImportRun) QueryFailures() *DictImportFailureQuery {
+	return NewDictImportRunClient(dir.config).QueryFailures(dir)
+}
+
+// Update returns a builder for updating this DictImportRun.
+// Note that you need to call DictImportRun.Unwrap() before calling this method if this DictImportRun
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (dir *DictImportRun) Update() *DictImportRunUpdateOne {
+	return NewDictImportRunClient(dir.config).UpdateOne(dir)
+}
+
+// Unwrap unwraps the DictImportRun entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (dir *DictImportRun) Unwrap() *DictImportRun {
+	_tx, ok := dir.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: DictImportRun is not a transactional entity")
+	}
+	dir.config.driver = _tx.drv
+	return dir
+}
+
+// String implements the fmt.Stringer.
+func (dir *DictImportRun) String() string {
+	var builder strings.Builder
+	builder.WriteString("DictImportRun(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", dir.ID))
+	builder.WriteString("source_path=")
+	builder.WriteString(dir.SourcePath)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(dir.Format)
+	builder.WriteString(", ")
+	builder.WriteString("source_hash=")
+	builder.WriteString(dir.SourceHash)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(dir.Status)
+	builder.WriteString(", ")
+	builder.WriteString("last_committed_index=")
+	builder.WriteString(fmt.Sprintf("%v", dir.LastCommittedIndex))
+	builder.WriteString(", ")
+	if v := dir.ErrorMessage; v != nil {
+		builder.WriteString("error_message=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("started_at=")
+	builder.WriteString(dir.StartedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := dir.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(dir.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// DictImportRuns is a parsable slice of DictImportRun.
+type DictImportRuns []*DictImportRun
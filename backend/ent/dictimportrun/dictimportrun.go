@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dictimportrun
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the dictimportrun type in the database.
+	Label = "dict_import_run"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSourcePath holds the string denoting the source_path field in the database.
+	FieldSourcePath = "source_path"
+	// FieldFormat holds the string denoting the format field in the database.
+	FieldFormat = "format"
+	// FieldSourceHash holds the string denoting the source_hash field in the database.
+	FieldSourceHash = "source_hash"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldLastCommittedIndex holds the string denoting the last_committed_index field in the database.
+	FieldLastCommittedIndex = "last_committed_index"
+	// FieldErrorMessage holds the string denoting the error_message field in the database.
+	FieldErrorMessage = "error_message"
+	// FieldStartedAt holds the string denoting the started_at field in the database.
+	FieldStartedAt = "started_at"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeFailures holds the string denoting the failures edge name in mutations.
+	EdgeFailures = "failures"
+	// Table holds the table name of the dictimportrun in the database.
+	Table = "dict_import_runs"
+	// FailuresTable is the table that holds the failures relation/edge.
+	FailuresTable = "dict_import_failures"
+	// FailuresInverseTable is the table name for the DictImportFailure entity.
+	// It exists in this package in order to avoid circular dependency with the "dictimportfailure" package.
+	FailuresInverseTable = "dict_import_failures"
+	// FailuresColumn is the table column denoting the failures relation/edge.
+	FailuresColumn = "run_id"
+)
+
+// Columns holds all SQL columns for dictimportrun fields.
+var Columns = []string{
+	FieldID,
+	FieldSourcePath,
+	FieldFormat,
+	FieldSourceHash,
+	FieldStatus,
+	FieldLastCommittedIndex,
+	FieldErrorMessage,
+	FieldStartedAt,
+	FieldFinishedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFormat holds the default value on creation for the "format" field.
+	DefaultFormat string
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	StatusValidator func(string) error
+	// DefaultLastCommittedIndex holds the default value on creation for the "last_committed_index" field.
+	DefaultLastCommittedIndex int
+	// DefaultStartedAt holds the default value on creation for the "started_at" field.
+	DefaultStartedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the DictImportRun queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySourcePath orders the results by the source_path field.
+func BySourcePath(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourcePath, opts...).ToFunc()
+}
+
+// ByFormat orders the results by the format field.
+func ByFormat(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFormat, opts...).ToFunc()
+}
+
+// BySourceHash orders the results by the source_hash field.
+func BySourceHash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceHash, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByLastCommittedIndex orders the results by the last_committed_index field.
+func ByLastCommittedIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastCommittedIndex, opts...).ToFunc()
+}
+
+// ByErrorMessage orders the results by the error_message field.
+func ByErrorMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrorMessage, opts...).ToFunc()
+}
+
+// ByStartedAt orders the results by the started_at field.
+func ByStartedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartedAt, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByFailuresCount orders the results by failures count.
+func ByFailuresCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newFailuresStep(), opts...)
+	}
+}
+
+// ByFailures orders the results by failures terms.
+func ByFailures(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newFailuresStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newFailuresStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(FailuresInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, FailuresTable, FailuresColumn),
+	)
+}
@@ -0,0 +1,644 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dictimportrun
+
+import (
+	"time"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldID, id))
+}
+
+// SourcePath applies equality check predicate on the "source_path" field. It's identical to SourcePathEQ.
+func SourcePath(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldSourcePath, v))
+}
+
+// Format applies equality check predicate on the "format" field. It's identical to FormatEQ.
+func Format(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldFormat, v))
+}
+
+// SourceHash applies equality check predicate on the "source_hash" field. It's identical to SourceHashEQ.
+func SourceHash(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldSourceHash, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// LastCommittedIndex applies equality check predicate on the "last_committed_index" field. It's identical to LastCommittedIndexEQ.
+func LastCommittedIndex(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldLastCommittedIndex, v))
+}
+
+// ErrorMessage applies equality check predicate on the "error_message" field. It's identical to ErrorMessageEQ.
+func ErrorMessage(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// StartedAt applies equality check predicate on the "started_at" field. It's identical to StartedAtEQ.
+func StartedAt(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SourcePathEQ applies the EQ predicate on the "source_path" field.
+func SourcePathEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldSourcePath, v))
+}
+
+// SourcePathNEQ applies the NEQ predicate on the "source_path" field.
+func SourcePathNEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldSourcePath, v))
+}
+
+// SourcePathIn applies the In predicate on the "source_path" field.
+func SourcePathIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldSourcePath, vs...))
+}
+
+// SourcePathNotIn applies the NotIn predicate on the "source_path" field.
+func SourcePathNotIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldSourcePath, vs...))
+}
+
+// SourcePathGT applies the GT predicate on the "source_path" field.
+func SourcePathGT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldSourcePath, v))
+}
+
+// SourcePathGTE applies the GTE predicate on the "source_path" field.
+func SourcePathGTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldSourcePath, v))
+}
+
+// SourcePathLT applies the LT predicate on the "source_path" field.
+func SourcePathLT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldSourcePath, v))
+}
+
+// SourcePathLTE applies the LTE predicate on the "source_path" field.
+func SourcePathLTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldSourcePath, v))
+}
+
+// SourcePathContains applies the Contains predicate on the "source_path" field.
+func SourcePathContains(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContains(FieldSourcePath, v))
+}
+
+// SourcePathHasPrefix applies the HasPrefix predicate on the "source_path" field.
+func SourcePathHasPrefix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasPrefix(FieldSourcePath, v))
+}
+
+// SourcePathHasSuffix applies the HasSuffix predicate on the "source_path" field.
+func SourcePathHasSuffix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasSuffix(FieldSourcePath, v))
+}
+
+// SourcePathEqualFold applies the EqualFold predicate on the "source_path" field.
+func SourcePathEqualFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEqualFold(FieldSourcePath, v))
+}
+
+// SourcePathContainsFold applies the ContainsFold predicate on the "source_path" field.
+func SourcePathContainsFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContainsFold(FieldSourcePath, v))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// FormatGT applies the GT predicate on the "format" field.
+func FormatGT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldFormat, v))
+}
+
+// FormatGTE applies the GTE predicate on the "format" field.
+func FormatGTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldFormat, v))
+}
+
+// FormatLT applies the LT predicate on the "format" field.
+func FormatLT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldFormat, v))
+}
+
+// FormatLTE applies the LTE predicate on the "format" field.
+func FormatLTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldFormat, v))
+}
+
+// FormatContains applies the Contains predicate on the "format" field.
+func FormatContains(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContains(FieldFormat, v))
+}
+
+// FormatHasPrefix applies the HasPrefix predicate on the "format" field.
+func FormatHasPrefix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasPrefix(FieldFormat, v))
+}
+
+// FormatHasSuffix applies the HasSuffix predicate on the "format" field.
+func FormatHasSuffix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasSuffix(FieldFormat, v))
+}
+
+// FormatEqualFold applies the EqualFold predicate on the "format" field.
+func FormatEqualFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEqualFold(FieldFormat, v))
+}
+
+// FormatContainsFold applies the ContainsFold predicate on the "format" field.
+func FormatContainsFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContainsFold(FieldFormat, v))
+}
+
+// SourceHashEQ applies the EQ predicate on the "source_hash" field.
+func SourceHashEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldSourceHash, v))
+}
+
+// SourceHashNEQ applies the NEQ predicate on the "source_hash" field.
+func SourceHashNEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldSourceHash, v))
+}
+
+// SourceHashIn applies the In predicate on the "source_hash" field.
+func SourceHashIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldSourceHash, vs...))
+}
+
+// SourceHashNotIn applies the NotIn predicate on the "source_hash" field.
+func SourceHashNotIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldSourceHash, vs...))
+}
+
+// SourceHashGT applies the GT predicate on the "source_hash" field.
+func SourceHashGT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldSourceHash, v))
+}
+
+// SourceHashGTE applies the GTE predicate on the "source_hash" field.
+func SourceHashGTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldSourceHash, v))
+}
+
+// SourceHashLT applies the LT predicate on the "source_hash" field.
+func SourceHashLT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldSourceHash, v))
+}
+
+// SourceHashLTE applies the LTE predicate on the "source_hash" field.
+func SourceHashLTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldSourceHash, v))
+}
+
+// SourceHashContains applies the Contains predicate on the "source_hash" field.
+func SourceHashContains(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContains(FieldSourceHash, v))
+}
+
+// SourceHashHasPrefix applies the HasPrefix predicate on the "source_hash" field.
+func SourceHashHasPrefix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasPrefix(FieldSourceHash, v))
+}
+
+// SourceHashHasSuffix applies the HasSuffix predicate on the "source_hash" field.
+func SourceHashHasSuffix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasSuffix(FieldSourceHash, v))
+}
+
+// SourceHashEqualFold applies the EqualFold predicate on the "source_hash" field.
+func SourceHashEqualFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEqualFold(FieldSourceHash, v))
+}
+
+// SourceHashContainsFold applies the ContainsFold predicate on the "source_hash" field.
+func SourceHashContainsFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContainsFold(FieldSourceHash, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// LastCommittedIndexEQ applies the EQ predicate on the "last_committed_index" field.
+func LastCommittedIndexEQ(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldLastCommittedIndex, v))
+}
+
+// LastCommittedIndexNEQ applies the NEQ predicate on the "last_committed_index" field.
+func LastCommittedIndexNEQ(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldLastCommittedIndex, v))
+}
+
+// LastCommittedIndexIn applies the In predicate on the "last_committed_index" field.
+func LastCommittedIndexIn(vs ...int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldLastCommittedIndex, vs...))
+}
+
+// LastCommittedIndexNotIn applies the NotIn predicate on the "last_committed_index" field.
+func LastCommittedIndexNotIn(vs ...int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldLastCommittedIndex, vs...))
+}
+
+// LastCommittedIndexGT applies the GT predicate on the "last_committed_index" field.
+func LastCommittedIndexGT(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldLastCommittedIndex, v))
+}
+
+// LastCommittedIndexGTE applies the GTE predicate on the "last_committed_index" field.
+func LastCommittedIndexGTE(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldLastCommittedIndex, v))
+}
+
+// LastCommittedIndexLT applies the LT predicate on the "last_committed_index" field.
+func LastCommittedIndexLT(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldLastCommittedIndex, v))
+}
+
+// LastCommittedIndexLTE applies the LTE predicate on the "last_committed_index" field.
+func LastCommittedIndexLTE(v int) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldLastCommittedIndex, v))
+}
+
+// ErrorMessageEQ applies the EQ predicate on the "error_message" field.
+func ErrorMessageEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageNEQ applies the NEQ predicate on the "error_message" field.
+func ErrorMessageNEQ(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldErrorMessage, v))
+}
+
+// ErrorMessageIn applies the In predicate on the "error_message" field.
+func ErrorMessageIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageNotIn applies the NotIn predicate on the "error_message" field.
+func ErrorMessageNotIn(vs ...string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldErrorMessage, vs...))
+}
+
+// ErrorMessageGT applies the GT predicate on the "error_message" field.
+func ErrorMessageGT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldErrorMessage, v))
+}
+
+// ErrorMessageGTE applies the GTE predicate on the "error_message" field.
+func ErrorMessageGTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageLT applies the LT predicate on the "error_message" field.
+func ErrorMessageLT(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldErrorMessage, v))
+}
+
+// ErrorMessageLTE applies the LTE predicate on the "error_message" field.
+func ErrorMessageLTE(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldErrorMessage, v))
+}
+
+// ErrorMessageContains applies the Contains predicate on the "error_message" field.
+func ErrorMessageContains(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContains(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasPrefix applies the HasPrefix predicate on the "error_message" field.
+func ErrorMessageHasPrefix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasPrefix(FieldErrorMessage, v))
+}
+
+// ErrorMessageHasSuffix applies the HasSuffix predicate on the "error_message" field.
+func ErrorMessageHasSuffix(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldHasSuffix(FieldErrorMessage, v))
+}
+
+// ErrorMessageIsNil applies the IsNil predicate on the "error_message" field.
+func ErrorMessageIsNil() predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIsNull(FieldErrorMessage))
+}
+
+// ErrorMessageNotNil applies the NotNil predicate on the "error_message" field.
+func ErrorMessageNotNil() predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotNull(FieldErrorMessage))
+}
+
+// ErrorMessageEqualFold applies the EqualFold predicate on the "error_message" field.
+func ErrorMessageEqualFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEqualFold(FieldErrorMessage, v))
+}
+
+// ErrorMessageContainsFold applies the ContainsFold predicate on the "error_message" field.
+func ErrorMessageContainsFold(v string) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldContainsFold(FieldErrorMessage, v))
+}
+
+// StartedAtEQ applies the EQ predicate on the "started_at" field.
+func StartedAtEQ(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldStartedAt, v))
+}
+
+// StartedAtNEQ applies the NEQ predicate on the "started_at" field.
+func StartedAtNEQ(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldStartedAt, v))
+}
+
+// StartedAtIn applies the In predicate on the "started_at" field.
+func StartedAtIn(vs ...time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldStartedAt, vs...))
+}
+
+// StartedAtNotIn applies the NotIn predicate on the "started_at" field.
+func StartedAtNotIn(vs ...time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldStartedAt, vs...))
+}
+
+// StartedAtGT applies the GT predicate on the "started_at" field.
+func StartedAtGT(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldStartedAt, v))
+}
+
+// StartedAtGTE applies the GTE predicate on the "started_at" field.
+func StartedAtGTE(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldStartedAt, v))
+}
+
+// StartedAtLT applies the LT predicate on the "started_at" field.
+func StartedAtLT(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldStartedAt, v))
+}
+
+// StartedAtLTE applies the LTE predicate on the "started_at" field.
+func StartedAtLTE(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldStartedAt, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasFailures applies the HasEdge predicate on the "failures" edge.
+func HasFailures() predicate.DictImportRun {
+	return predicate.DictImportRun(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FailuresTable, FailuresColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFailuresWith applies the HasEdge predicate on the "failures" edge with a given conditions (other predicates).
+func HasFailuresWith(preds ...predicate.DictImportFailure) predicate.DictImportRun {
+	return predicate.DictImportRun(func(s *sql.Selector) {
+		step := newFailuresStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DictImportRun) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DictImportRun) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DictImportRun) predicate.DictImportRun {
+	return predicate.DictImportRun(sql.NotPredicates(p))
+}
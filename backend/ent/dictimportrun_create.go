@@ -0,0 +1,1052 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportRunCreate is the builder for creating a DictImportRun entity.
+type DictImportRunCreate struct {
+	config
+	mutation *DictImportRunMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetSourcePath sets the "source_path" field.
+func (dirc *DictImportRunCreate) SetSourcePath(s string) *DictImportRunCreate {
+	dirc.mutation.SetSourcePath(s)
+	return dirc
+}
+
+// SetFormat sets the "format" field.
+func (dirc *DictImportRunCreate) SetFormat(s string) *DictImportRunCreate {
+	dirc.mutation.SetFormat(s)
+	return dirc
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableFormat(s *string) *DictImportRunCreate {
+	if s != nil {
+		dirc.SetFormat(*s)
+	}
+	return dirc
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (dirc *DictImportRunCreate) SetSourceHash(s string) *DictImportRunCreate {
+	dirc.mutation.SetSourceHash(s)
+	return dirc
+}
+
+// SetStatus sets the "status" field.
+func (dirc *DictImportRunCreate) SetStatus(s string) *DictImportRunCreate {
+	dirc.mutation.SetStatus(s)
+	return dirc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableStatus(s *string) *DictImportRunCreate {
+	if s != nil {
+		dirc.SetStatus(*s)
+	}
+	return dirc
+}
+
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (dirc *DictImportRunCreate) SetLastCommittedIndex(i int) *DictImportRunCreate {
+	dirc.mutation.SetLastCommittedIndex(i)
+	return dirc
+}
+
+// SetNillableLastCommittedIndex sets the "last_committed_index" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableLastCommittedIndex(i *int) *DictImportRunCreate {
+	if i != nil {
+		dirc.SetLastCommittedIndex(*i)
+	}
+	return dirc
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (dirc *DictImportRunCreate) SetErrorMessage(s string) *DictImportRunCreate {
+	dirc.mutation.SetErrorMessage(s)
+	return dirc
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableErrorMessage(s *string) *DictImportRunCreate {
+	if s != nil {
+		dirc.SetErrorMessage(*s)
+	}
+	return dirc
+}
+
+// SetStartedAt sets the "started_at" field.
+func (dirc *DictImportRunCreate) SetStartedAt(t time.Time) *DictImportRunCreate {
+	dirc.mutation.SetStartedAt(t)
+	return dirc
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableStartedAt(t *time.Time) *DictImportRunCreate {
+	if t != nil {
+		dirc.SetStartedAt(*t)
+	}
+	return dirc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (dirc *DictImportRunCreate) SetFinishedAt(t time.Time) *DictImportRunCreate {
+	dirc.mutation.SetFinishedAt(t)
+	return dirc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableFinishedAt(t *time.Time) *DictImportRunCreate {
+	if t != nil {
+		dirc.SetFinishedAt(*t)
+	}
+	return dirc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (dirc *DictImportRunCreate) SetUpdatedAt(t time.Time) *DictImportRunCreate {
+	dirc.mutation.SetUpdatedAt(t)
+	return dirc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (dirc *DictImportRunCreate) SetNillableUpdatedAt(t *time.Time) *DictImportRunCreate {
+	if t != nil {
+		dirc.SetUpdatedAt(*t)
+	}
+	return dirc
+}
+
+// AddFailureIDs adds the "failures" edge to the DictImportFailure entity by IDs.
+func (dirc *DictImportRunCreate) AddFailureIDs(ids ...int) *DictImportRunCreate {
+	dirc.mutation.AddFailureIDs(ids...)
+	return dirc
+}
+
+// AddFailures adds the "failures" edges to the DictImportFailure entity.
+func (dirc *DictImportRunCreate) AddFailures(d ...*DictImportFailure) *DictImportRunCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return dirc.AddFailureIDs(ids...)
+}
+
+// Mutation returns the DictImportRunMutation object of the builder.
+func (dirc *DictImportRunCreate) Mutation() *DictImportRunMutation {
+	return dirc.mutation
+}
+
+// Save creates the DictImportRun in the database.
+func (dirc *DictImportRunCreate) Save(ctx context.Context) (*DictImportRun, error) {
+	dirc.defaults()
+	return withHooks(ctx, dirc.sqlSave, dirc.mutation, dirc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (dirc *DictImportRunCreate) SaveX(ctx context.Context) *DictImportRun {
+	v, err := dirc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dirc *DictImportRunCreate) Exec(ctx context.Context) error {
+	_, err := dirc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dirc *DictImportRunCreate) ExecX(ctx context.Context) {
+	if err := dirc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (dirc *DictImportRunCreate) defaults() {
+	if _, ok := dirc.mutation.Format(); !ok {
+		v := dictimportrun.DefaultFormat
+		dirc.mutation.SetFormat(v)
+	}
+	if _, ok := dirc.mutation.Status(); !ok {
+		v := dictimportrun.DefaultStatus
+		dirc.mutation.SetStatus(v)
+	}
+	if _, ok := dirc.mutation.LastCommittedIndex(); !ok {
+		v := dictimportrun.DefaultLastCommittedIndex
+		dirc.mutation.SetLastCommittedIndex(v)
+	}
+	if _, ok := dirc.mutation.StartedAt(); !ok {
+		v := dictimportrun.DefaultStartedAt()
+		dirc.mutation.SetStartedAt(v)
+	}
+	if _, ok := dirc.mutation.UpdatedAt(); !ok {
+		v := dictimportrun.DefaultUpdatedAt()
+		dirc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (dirc *DictImportRunCreate) check() error {
+	if _, ok := dirc.mutation.SourcePath(); !ok {
+		return &ValidationError{Name: "source_path", err: errors.New(`ent: missing required field "DictImportRun.source_path"`)}
+	}
+	if _, ok := dirc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "DictImportRun.format"`)}
+	}
+	if _, ok := dirc.mutation.SourceHash(); !ok {
+		return &ValidationError{Name: "source_hash", err: errors.New(`ent: missing required field "DictImportRun.source_hash"`)}
+	}
+	if _, ok := dirc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "DictImportRun.status"`)}
+	}
+	if v, ok := dirc.mutation.Status(); ok {
+		if err := dictimportrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DictImportRun.status": %w`, err)}
+		}
+	}
+	if _, ok := dirc.mutation.LastCommittedIndex(); !ok {
+		return &ValidationError{Name: "last_committed_index", err: errors.New(`ent: missing required field "DictImportRun.last_committed_index"`)}
+	}
+	if _, ok := dirc.mutation.StartedAt(); !ok {
+		return &ValidationError{Name: "started_at", err: errors.New(`ent: missing required field "DictImportRun.started_at"`)}
+	}
+	if _, ok := dirc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "DictImportRun.updated_at"`)}
+	}
+	return nil
+}
+
+func (dirc *DictImportRunCreate) sqlSave(ctx context.Context) (*DictImportRun, error) {
+	if err := dirc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := dirc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, dirc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	dirc.mutation.id = &_node.ID
+	dirc.mutation.done = true
+	return _node, nil
+}
+
+func (dirc *DictImportRunCreate) createSpec() (*DictImportRun, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DictImportRun{config: dirc.config}
+		_spec = sqlgraph.NewCreateSpec(dictimportrun.Table, sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = dirc.conflict
+	if value, ok := dirc.mutation.SourcePath(); ok {
+		_spec.SetField(dictimportrun.FieldSourcePath, field.TypeString, value)
+		_node.SourcePath = value
+	}
+	if value, ok := dirc.mutation.Format(); ok {
+		_spec.SetField(dictimportrun.FieldFormat, field.TypeString, value)
+		_node.Format = value
+	}
+	if value, ok := dirc.mutation.SourceHash(); ok {
+		_spec.SetField(dictimportrun.FieldSourceHash, field.TypeString, value)
+		_node.SourceHash = value
+	}
+	if value, ok := dirc.mutation.Status(); ok {
+		_spec.SetField(dictimportrun.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := dirc.mutation.LastCommittedIndex(); ok {
+		_spec.SetField(dictimportrun.FieldLastCommittedIndex, field.TypeInt, value)
+		_node.LastCommittedIndex = value
+	}
+	if value, ok := dirc.mutation.ErrorMessage(); ok {
+		_spec.SetField(dictimportrun.FieldErrorMessage, field.TypeString, value)
+		_node.ErrorMessage = &value
+	}
+	if value, ok := dirc.mutation.StartedAt(); ok {
+		_spec.SetField(dictimportrun.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = value
+	}
+	if value, ok := dirc.mutation.FinishedAt(); ok {
+		_spec.SetField(dictimportrun.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if value, ok := dirc.mutation.UpdatedAt(); ok {
+		_spec.SetField(dictimportrun.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := dirc.mutation.FailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DictImportRun.Create().
+//		SetSourcePath(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DictImportRunUpsert) {
+//			SetSourcePath(v+v).
+//		}).
+//		Exec(ctx)
+func (dirc *DictImportRunCreate) OnConflict(opts ...sql.ConflictOption) *DictImportRunUpsertOne {
+	dirc.conflict = opts
+	return &DictImportRunUpsertOne{
+		create: dirc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DictImportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dirc *DictImportRunCreate) OnConflictColumns(columns ...string) *DictImportRunUpsertOne {
+	dirc.conflict = append(dirc.conflict, sql.ConflictColumns(columns...))
+	return &DictImportRunUpsertOne{
+		create: dirc,
+	}
+}
+
+type (
+	// DictImportRunUpsertOne is the builder for "upsert"-ing
+	//  one DictImportRun node.
+	DictImportRunUpsertOne struct {
+		create *DictImportRunCreate
+	}
+
+	// DictImportRunUpsert is the "OnConflict" setter.
+	DictImportRunUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetSourcePath sets the "source_path" field.
+func (u *DictImportRunUpsert) SetSourcePath(v string) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldSourcePath, v)
+	return u
+}
+
+// UpdateSourcePath sets the "source_path" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateSourcePath() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldSourcePath)
+	return u
+}
+
+// SetFormat sets the "format" field.
+func (u *DictImportRunUpsert) SetFormat(v string) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldFormat, v)
+	return u
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateFormat() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldFormat)
+	return u
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (u *DictImportRunUpsert) SetSourceHash(v string) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldSourceHash, v)
+	return u
+}
+
+// UpdateSourceHash sets the "source_hash" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateSourceHash() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldSourceHash)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *DictImportRunUpsert) SetStatus(v string) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateStatus() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldStatus)
+	return u
+}
+
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (u *DictImportRunUpsert) SetLastCommittedIndex(v int) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldLastCommittedIndex, v)
+	return u
+}
+
+// UpdateLastCommittedIndex sets the "last_committed_index" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateLastCommittedIndex() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldLastCommittedIndex)
+	return u
+}
+
+// AddLastCommittedIndex adds v to the "last_committed_index" field.
+func (u *DictImportRunUpsert) AddLastCommittedIndex(v int) *DictImportRunUpsert {
+	u.Add(dictimportrun.FieldLastCommittedIndex, v)
+	return u
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DictImportRunUpsert) SetErrorMessage(v string) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldErrorMessage, v)
+	return u
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateErrorMessage() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldErrorMessage)
+	return u
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DictImportRunUpsert) ClearErrorMessage() *DictImportRunUpsert {
+	u.SetNull(dictimportrun.FieldErrorMessage)
+	return u
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DictImportRunUpsert) SetStartedAt(v time.Time) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldStartedAt, v)
+	return u
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateStartedAt() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldStartedAt)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DictImportRunUpsert) SetFinishedAt(v time.Time) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateFinishedAt() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *DictImportRunUpsert) ClearFinishedAt() *DictImportRunUpsert {
+	u.SetNull(dictimportrun.FieldFinishedAt)
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DictImportRunUpsert) SetUpdatedAt(v time.Time) *DictImportRunUpsert {
+	u.Set(dictimportrun.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DictImportRunUpsert) UpdateUpdatedAt() *DictImportRunUpsert {
+	u.SetExcluded(dictimportrun.FieldUpdatedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.DictImportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DictImportRunUpsertOne) UpdateNewValues() *DictImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DictImportRun.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *DictImportRunUpsertOne) Ignore() *DictImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DictImportRunUpsertOne) DoNothing() *DictImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DictImportRunCreate.OnConflict
+// documentation for more info.
+func (u *DictImportRunUpsertOne) Update(set func(*DictImportRunUpsert)) *DictImportRunUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DictImportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourcePath sets the "source_path" field.
+func (u *DictImportRunUpsertOne) SetSourcePath(v string) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetSourcePath(v)
+	})
+}
+
+// UpdateSourcePath sets the "source_path" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateSourcePath() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateSourcePath()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *DictImportRunUpsertOne) SetFormat(v string) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateFormat() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (u *DictImportRunUpsertOne) SetSourceHash(v string) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetSourceHash(v)
+	})
+}
+
+// UpdateSourceHash sets the "source_hash" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateSourceHash() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateSourceHash()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DictImportRunUpsertOne) SetStatus(v string) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateStatus() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (u *DictImportRunUpsertOne) SetLastCommittedIndex(v int) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetLastCommittedIndex(v)
+	})
+}
+
+// AddLastCommittedIndex adds v to the "last_committed_index" field.
+func (u *DictImportRunUpsertOne) AddLastCommittedIndex(v int) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.AddLastCommittedIndex(v)
+	})
+}
+
+// UpdateLastCommittedIndex sets the "last_committed_index" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateLastCommittedIndex() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateLastCommittedIndex()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DictImportRunUpsertOne) SetErrorMessage(v string) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateErrorMessage() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DictImportRunUpsertOne) ClearErrorMessage() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DictImportRunUpsertOne) SetStartedAt(v time.Time) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateStartedAt() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DictImportRunUpsertOne) SetFinishedAt(v time.Time) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateFinishedAt() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *DictImportRunUpsertOne) ClearFinishedAt() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DictImportRunUpsertOne) SetUpdatedAt(v time.Time) *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DictImportRunUpsertOne) UpdateUpdatedAt() *DictImportRunUpsertOne {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DictImportRunUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DictImportRunCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DictImportRunUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *DictImportRunUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *DictImportRunUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// DictImportRunCreateBulk is the builder for creating many DictImportRun entities in bulk.
+type DictImportRunCreateBulk struct {
+	config
+	err      error
+	builders []*DictImportRunCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the DictImportRun entities in the database.
+func (dircb *DictImportRunCreateBulk) Save(ctx context.Context) ([]*DictImportRun, error) {
+	if dircb.err != nil {
+		return nil, dircb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(dircb.builders))
+	nodes := make([]*DictImportRun, len(dircb.builders))
+	mutators := make([]Mutator, len(dircb.builders))
+	for i := range dircb.builders {
+		func(i int, root context.Context) {
+			builder := dircb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DictImportRunMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, dircb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = dircb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, dircb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, dircb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (dircb *DictImportRunCreateBulk) SaveX(ctx context.Context) []*DictImportRun {
+	v, err := dircb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (dircb *DictImportRunCreateBulk) Exec(ctx context.Context) error {
+	_, err := dircb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dircb *DictImportRunCreateBulk) ExecX(ctx context.Context) {
+	if err := dircb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.DictImportRun.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.DictImportRunUpsert) {
+//			SetSourcePath(v+v).
+//		}).
+//		Exec(ctx)
+func (dircb *DictImportRunCreateBulk) OnConflict(opts ...sql.ConflictOption) *DictImportRunUpsertBulk {
+	dircb.conflict = opts
+	return &DictImportRunUpsertBulk{
+		create: dircb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.DictImportRun.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (dircb *DictImportRunCreateBulk) OnConflictColumns(columns ...string) *DictImportRunUpsertBulk {
+	dircb.conflict = append(dircb.conflict, sql.ConflictColumns(columns...))
+	return &DictImportRunUpsertBulk{
+		create: dircb,
+	}
+}
+
+// DictImportRunUpsertBulk is the builder for "upsert"-ing
+// a bulk of DictImportRun nodes.
+type DictImportRunUpsertBulk struct {
+	create *DictImportRunCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.DictImportRun.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *DictImportRunUpsertBulk) UpdateNewValues() *DictImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.DictImportRun.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *DictImportRunUpsertBulk) Ignore() *DictImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *DictImportRunUpsertBulk) DoNothing() *DictImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the DictImportRunCreateBulk.OnConflict
+// documentation for more info.
+func (u *DictImportRunUpsertBulk) Update(set func(*DictImportRunUpsert)) *DictImportRunUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&DictImportRunUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetSourcePath sets the "source_path" field.
+func (u *DictImportRunUpsertBulk) SetSourcePath(v string) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetSourcePath(v)
+	})
+}
+
+// UpdateSourcePath sets the "source_path" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateSourcePath() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateSourcePath()
+	})
+}
+
+// SetFormat sets the "format" field.
+func (u *DictImportRunUpsertBulk) SetFormat(v string) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetFormat(v)
+	})
+}
+
+// UpdateFormat sets the "format" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateFormat() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateFormat()
+	})
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (u *DictImportRunUpsertBulk) SetSourceHash(v string) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetSourceHash(v)
+	})
+}
+
+// UpdateSourceHash sets the "source_hash" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateSourceHash() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateSourceHash()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *DictImportRunUpsertBulk) SetStatus(v string) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateStatus() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (u *DictImportRunUpsertBulk) SetLastCommittedIndex(v int) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetLastCommittedIndex(v)
+	})
+}
+
+// AddLastCommittedIndex adds v to the "last_committed_index" field.
+func (u *DictImportRunUpsertBulk) AddLastCommittedIndex(v int) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.AddLastCommittedIndex(v)
+	})
+}
+
+// UpdateLastCommittedIndex sets the "last_committed_index" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateLastCommittedIndex() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateLastCommittedIndex()
+	})
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (u *DictImportRunUpsertBulk) SetErrorMessage(v string) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetErrorMessage(v)
+	})
+}
+
+// UpdateErrorMessage sets the "error_message" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateErrorMessage() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateErrorMessage()
+	})
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (u *DictImportRunUpsertBulk) ClearErrorMessage() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.ClearErrorMessage()
+	})
+}
+
+// SetStartedAt sets the "started_at" field.
+func (u *DictImportRunUpsertBulk) SetStartedAt(v time.Time) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetStartedAt(v)
+	})
+}
+
+// UpdateStartedAt sets the "started_at" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateStartedAt() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateStartedAt()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *DictImportRunUpsertBulk) SetFinishedAt(v time.Time) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateFinishedAt() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *DictImportRunUpsertBulk) ClearFinishedAt() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *DictImportRunUpsertBulk) SetUpdatedAt(v time.Time) *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *DictImportRunUpsertBulk) UpdateUpdatedAt() *DictImportRunUpsertBulk {
+	return u.Update(func(s *DictImportRunUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *DictImportRunUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the DictImportRunCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for DictImportRunCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *DictImportRunUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
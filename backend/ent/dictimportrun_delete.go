@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"word_app/backend/ent/dictimportrun"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportRunDelete is the builder for deleting a DictImportRun entity.
+type DictImportRunDelete struct {
+	config
+	hooks    []Hook
+	mutation *DictImportRunMutation
+}
+
+// Where appends a list predicates to the DictImportRunDelete builder.
+func (dird *DictImportRunDelete) Where(ps ...predicate.DictImportRun) *DictImportRunDelete {
+	dird.mutation.Where(ps...)
+	return dird
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (dird *DictImportRunDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, dird.sqlExec, dird.mutation, dird.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dird *DictImportRunDelete) ExecX(ctx context.Context) int {
+	n, err := dird.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (dird *DictImportRunDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(dictimportrun.Table, sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt))
+	if ps := dird.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, dird.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	dird.mutation.done = true
+	return affected, err
+}
+
+// DictImportRunDeleteOne is the builder for deleting a single DictImportRun entity.
+type DictImportRunDeleteOne struct {
+	dird *DictImportRunDelete
+}
+
+// Where appends a list predicates to the DictImportRunDelete builder.
+func (dirdo *DictImportRunDeleteOne) Where(ps ...predicate.DictImportRun) *DictImportRunDeleteOne {
+	dirdo.dird.mutation.Where(ps...)
+	return dirdo
+}
+
+// Exec executes the deletion query.
+func (dirdo *DictImportRunDeleteOne) Exec(ctx context.Context) error {
+	n, err := dirdo.dird.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{dictimportrun.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (dirdo *DictImportRunDeleteOne) ExecX(ctx context.Context) {
+	if err := dirdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportRunQuery is the builder for querying DictImportRun entities.
+type DictImportRunQuery struct {
+	config
+	ctx          *QueryContext
+	order        []dictimportrun.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.DictImportRun
+	withFailures *DictImportFailureQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the DictImportRunQuery builder.
+func (dirq *DictImportRunQuery) Where(ps ...predicate.DictImportRun) *DictImportRunQuery {
+	dirq.predicates = append(dirq.predicates, ps...)
+	return dirq
+}
+
+// Limit the number of records to be returned by this query.
+func (dirq *DictImportRunQuery) Limit(limit int) *DictImportRunQuery {
+	dirq.ctx.Limit = &limit
+	return dirq
+}
+
+// Offset to start from.
+func (dirq *DictImportRunQuery) Offset(offset int) *DictImportRunQuery {
+	dirq.ctx.Offset = &offset
+	return dirq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (dirq *DictImportRunQuery) Unique(unique bool) *DictImportRunQuery {
+	dirq.ctx.Unique = &unique
+	return dirq
+}
+
+// Order specifies how the records should be ordered.
+func (dirq *DictImportRunQuery) Order(o ...dictimportrun.OrderOption) *DictImportRunQuery {
+	dirq.order = append(dirq.order, o...)
+	return dirq
+}
+
+// QueryFailures chains the current query on the "failures" edge.
+func (dirq *DictImportRunQuery) QueryFailures() *DictImportFailureQuery {
+	query := (&DictImportFailureClient{config: dirq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := dirq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := dirq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(dictimportrun.Table, dictimportrun.FieldID, selector),
+			sqlgraph.To(dictimportfailure.Table, dictimportfailure.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, dictimportrun.FailuresTable, dictimportrun.FailuresColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(dirq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first DictImportRun entity from the query.
+// Returns a *NotFoundError when no DictImportRun was found.
+func (dirq *DictImportRunQuery) First(ctx context.Context) (*DictImportRun, error) {
+	nodes, err := dirq.Limit(1).All(setContextOp(ctx, dirq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{dictimportrun.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (dirq *DictImportRunQuery) FirstX(ctx context.Context) *DictImportRun {
+	node, err := dirq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first DictImportRun ID from the query.
+// Returns a *NotFoundError when no DictImportRun ID was found.
+func (dirq *DictImportRunQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dirq.Limit(1).IDs(setContextOp(ctx, dirq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{dictimportrun.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (dirq *DictImportRunQuery) FirstIDX(ctx context.Context) int {
+	id, err := dirq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single DictImportRun entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one DictImportRun entity is found.
+// Returns a *NotFoundError when no DictImportRun entities are found.
+func (dirq *DictImportRunQuery) Only(ctx context.Context) (*DictImportRun, error) {
+	nodes, err := dirq.Limit(2).All(setContextOp(ctx, dirq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{dictimportrun.Label}
+	default:
+		return nil, &NotSingularError{dictimportrun.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (dirq *DictImportRunQuery) OnlyX(ctx context.Context) *DictImportRun {
+	node, err := dirq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only DictImportRun ID in the query.
+// Returns a *NotSingularError when more than one DictImportRun ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (dirq *DictImportRunQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = dirq.Limit(2).IDs(setContextOp(ctx, dirq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{dictimportrun.Label}
+	default:
+		err = &NotSingularError{dictimportrun.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (dirq *DictImportRunQuery) OnlyIDX(ctx context.Context) int {
+	id, err := dirq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of DictImportRuns.
+func (dirq *DictImportRunQuery) All(ctx context.Context) ([]*DictImportRun, error) {
+	ctx = setContextOp(ctx, dirq.ctx, ent.OpQueryAll)
+	if err := dirq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*DictImportRun, *DictImportRunQuery]()
+	return withInterceptors[[]*DictImportRun](ctx, dirq, qr, dirq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (dirq *DictImportRunQuery) AllX(ctx context.Context) []*DictImportRun {
+	nodes, err := dirq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of DictImportRun IDs.
+func (dirq *DictImportRunQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if dirq.ctx.Unique == nil && dirq.path != nil {
+		dirq.Unique(true)
+	}
+	ctx = setContextOp(ctx, dirq.ctx, ent.OpQueryIDs)
+	if err = dirq.Select(dictimportrun.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (dirq *DictImportRunQuery) IDsX(ctx context.Context) []int {
+	ids, err := dirq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (dirq *DictImportRunQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, dirq.ctx, ent.OpQueryCount)
+	if err := dirq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, dirq, querierCount[*DictImportRunQuery](), dirq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (dirq *DictImportRunQuery) CountX(ctx context.Context) int {
+	count, err := dirq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (dirq *DictImportRunQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, dirq.ctx, ent.OpQueryExist)
+	switch _, err := dirq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (dirq *DictImportRunQuery) ExistX(ctx context.Context) bool {
+	exist, err := dirq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the DictImportRunQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (dirq *DictImportRunQuery) Clone() *DictImportRunQuery {
+	if dirq == nil {
+		return nil
+	}
+	return &DictImportRunQuery{
+		config:       dirq.config,
+		ctx:          dirq.ctx.Clone(),
+		order:        append([]dictimportrun.OrderOption{}, dirq.order...),
+		inters:       append([]Interceptor{}, dirq.inters...),
+		predicates:   append([]predicate.DictImportRun{}, dirq.predicates...),
+		withFailures: dirq.withFailures.Clone(),
+		// clone intermediate query.
+		sql:       dirq.sql.Clone(),
+		path:      dirq.path,
+		modifiers: append([]func(*sql.Selector){}, dirq.modifiers...),
+	}
+}
+
+// WithFailures tells the query-builder to eager-load the nodes that are connected to
+// the "failures" edge. The optional arguments are used to configure the query builder of the edge.
+func (dirq *DictImportRunQuery) WithFailures(opts ...func(*DictImportFailureQuery)) *DictImportRunQuery {
+	query := (&DictImportFailureClient{config: dirq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	dirq.withFailures = query
+	return dirq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SourcePath string `json:"source_path,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.DictImportRun.Query().
+//		GroupBy(dictimportrun.FieldSourcePath).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (dirq *DictImportRunQuery) GroupBy(field string, fields ...string) *DictImportRunGroupBy {
+	dirq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &DictImportRunGroupBy{build: dirq}
+	grbuild.flds = &dirq.ctx.Fields
+	grbuild.label = dictimportrun.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SourcePath string `json:"source_path,omitempty"`
+//	}
+//
+//	client.DictImportRun.Query().
+//		Select(dictimportrun.FieldSourcePath).
+//		Scan(ctx, &v)
+func (dirq *DictImportRunQuery) Select(fields ...string) *DictImportRunSelect {
+	dirq.ctx.Fields = append(dirq.ctx.Fields, fields...)
+	sbuild := &DictImportRunSelect{DictImportRunQuery: dirq}
+	sbuild.label = dictimportrun.Label
+	sbuild.flds, sbuild.scan = &dirq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a DictImportRunSelect configured with the given aggregations.
+func (dirq *DictImportRunQuery) Aggregate(fns ...AggregateFunc) *DictImportRunSelect {
+	return dirq.Select().Aggregate(fns...)
+}
+
+func (dirq *DictImportRunQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range dirq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, dirq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range dirq.ctx.Fields {
+		if !dictimportrun.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if dirq.path != nil {
+		prev, err := dirq.path(ctx)
+		if err != nil {
+			return err
+		}
+		dirq.sql = prev
+	}
+	return nil
+}
+
+func (dirq *DictImportRunQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*DictImportRun, error) {
+	var (
+		nodes       = []*DictImportRun{}
+		_spec       = dirq.querySpec()
+		loadedTypes = [1]bool{
+			dirq.withFailures != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*DictImportRun).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &DictImportRun{config: dirq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(dirq.modifiers) > 0 {
+		_spec.Modifiers = dirq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, dirq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := dirq.withFailures; query != nil {
+		if err := dirq.loadFailures(ctx, query, nodes,
+			func(n *DictImportRun) { n.Edges.Failures = []*DictImportFailure{} },
+			func(n *DictImportRun, e *DictImportFailure) { n.Edges.Failures = append(n.Edges.Failures, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (dirq *DictImportRunQuery) loadFailures(ctx context.Context, query *DictImportFailureQuery, nodes []*DictImportRun, init func(*DictImportRun), assign func(*DictImportRun, *DictImportFailure)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*DictImportRun)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(dictimportfailure.FieldRunID)
+	}
+	query.Where(predicate.DictImportFailure(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(dictimportrun.FailuresColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.RunID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "run_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (dirq *DictImportRunQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := dirq.querySpec()
+	if len(dirq.modifiers) > 0 {
+		_spec.Modifiers = dirq.modifiers
+	}
+	_spec.Node.Columns = dirq.ctx.Fields
+	if len(dirq.ctx.Fields) > 0 {
+		_spec.Unique = dirq.ctx.Unique != nil && *dirq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, dirq.driver, _spec)
+}
+
+func (dirq *DictImportRunQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(dictimportrun.Table, dictimportrun.Columns, sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt))
+	_spec.From = dirq.sql
+	if unique := dirq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if dirq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := dirq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dictimportrun.FieldID)
+		for i := range fields {
+			if fields[i] != dictimportrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := dirq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := dirq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := dirq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := dirq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (dirq *DictImportRunQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(dirq.driver.Dialect())
+	t1 := builder.Table(dictimportrun.Table)
+	columns := dirq.ctx.Fields
+	if len(columns) == 0 {
+		columns = dictimportrun.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if dirq.sql != nil {
+		selector = dirq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if dirq.ctx.Unique != nil && *dirq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range dirq.modifiers {
+		m(selector)
+	}
+	for _, p := range dirq.predicates {
+		p(selector)
+	}
+	for _, p := range dirq.order {
+		p(selector)
+	}
+	if offset := dirq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := dirq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dirq *DictImportRunQuery) Modify(modifiers ...func(s *sql.Selector)) *DictImportRunSelect {
+	dirq.modifiers = append(dirq.modifiers, modifiers...)
+	return dirq.Select()
+}
+
+// DictImportRunGroupBy is the group-by builder for DictImportRun entities.
+type DictImportRunGroupBy struct {
+	selector
+	build *DictImportRunQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (dirgb *DictImportRunGroupBy) Aggregate(fns ...AggregateFunc) *DictImportRunGroupBy {
+	dirgb.fns = append(dirgb.fns, fns...)
+	return dirgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dirgb *DictImportRunGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dirgb.build.ctx, ent.OpQueryGroupBy)
+	if err := dirgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DictImportRunQuery, *DictImportRunGroupBy](ctx, dirgb.build, dirgb, dirgb.build.inters, v)
+}
+
+func (dirgb *DictImportRunGroupBy) sqlScan(ctx context.Context, root *DictImportRunQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(dirgb.fns))
+	for _, fn := range dirgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*dirgb.flds)+len(dirgb.fns))
+		for _, f := range *dirgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*dirgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dirgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// DictImportRunSelect is the builder for selecting fields of DictImportRun entities.
+type DictImportRunSelect struct {
+	*DictImportRunQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (dirs *DictImportRunSelect) Aggregate(fns ...AggregateFunc) *DictImportRunSelect {
+	dirs.fns = append(dirs.fns, fns...)
+	return dirs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (dirs *DictImportRunSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, dirs.ctx, ent.OpQuerySelect)
+	if err := dirs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*DictImportRunQuery, *DictImportRunSelect](ctx, dirs.DictImportRunQuery, dirs, dirs.inters, v)
+}
+
+func (dirs *DictImportRunSelect) sqlScan(ctx context.Context, root *DictImportRunQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(dirs.fns))
+	for _, fn := range dirs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*dirs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := dirs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (dirs *DictImportRunSelect) Modify(modifiers ...func(s *sql.Selector)) *DictImportRunSelect {
+	dirs.modifiers = append(dirs.modifiers, modifiers...)
+	return dirs
+}
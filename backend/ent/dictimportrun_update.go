@@ -0,0 +1,745 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// DictImportRunUpdate is the builder for updating DictImportRun entities.
+type DictImportRunUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *DictImportRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the DictImportRunUpdate builder.
+func (diru *DictImportRunUpdate) Where(ps ...predicate.DictImportRun) *DictImportRunUpdate {
+	diru.mutation.Where(ps...)
+	return diru
+}
+
+// SetSourcePath sets the "source_path" field.
+func (diru *DictImportRunUpdate) SetSourcePath(s string) *DictImportRunUpdate {
+	diru.mutation.SetSourcePath(s)
+	return diru
+}
+
+// SetNillableSourcePath sets the "source_path" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableSourcePath(s *string) *DictImportRunUpdate {
+	if s != nil {
+		diru.SetSourcePath(*s)
+	}
+	return diru
+}
+
+// SetFormat sets the "format" field.
+func (diru *DictImportRunUpdate) SetFormat(s string) *DictImportRunUpdate {
+	diru.mutation.SetFormat(s)
+	return diru
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableFormat(s *string) *DictImportRunUpdate {
+	if s != nil {
+		diru.SetFormat(*s)
+	}
+	return diru
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (diru *DictImportRunUpdate) SetSourceHash(s string) *DictImportRunUpdate {
+	diru.mutation.SetSourceHash(s)
+	return diru
+}
+
+// SetNillableSourceHash sets the "source_hash" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableSourceHash(s *string) *DictImportRunUpdate {
+	if s != nil {
+		diru.SetSourceHash(*s)
+	}
+	return diru
+}
+
+// SetStatus sets the "status" field.
+func (diru *DictImportRunUpdate) SetStatus(s string) *DictImportRunUpdate {
+	diru.mutation.SetStatus(s)
+	return diru
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableStatus(s *string) *DictImportRunUpdate {
+	if s != nil {
+		diru.SetStatus(*s)
+	}
+	return diru
+}
+
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (diru *DictImportRunUpdate) SetLastCommittedIndex(i int) *DictImportRunUpdate {
+	diru.mutation.ResetLastCommittedIndex()
+	diru.mutation.SetLastCommittedIndex(i)
+	return diru
+}
+
+// SetNillableLastCommittedIndex sets the "last_committed_index" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableLastCommittedIndex(i *int) *DictImportRunUpdate {
+	if i != nil {
+		diru.SetLastCommittedIndex(*i)
+	}
+	return diru
+}
+
+// AddLastCommittedIndex adds i to the "last_committed_index" field.
+func (diru *DictImportRunUpdate) AddLastCommittedIndex(i int) *DictImportRunUpdate {
+	diru.mutation.AddLastCommittedIndex(i)
+	return diru
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (diru *DictImportRunUpdate) SetErrorMessage(s string) *DictImportRunUpdate {
+	diru.mutation.SetErrorMessage(s)
+	return diru
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableErrorMessage(s *string) *DictImportRunUpdate {
+	if s != nil {
+		diru.SetErrorMessage(*s)
+	}
+	return diru
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (diru *DictImportRunUpdate) ClearErrorMessage() *DictImportRunUpdate {
+	diru.mutation.ClearErrorMessage()
+	return diru
+}
+
+// SetStartedAt sets the "started_at" field.
+func (diru *DictImportRunUpdate) SetStartedAt(t time.Time) *DictImportRunUpdate {
+	diru.mutation.SetStartedAt(t)
+	return diru
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableStartedAt(t *time.Time) *DictImportRunUpdate {
+	if t != nil {
+		diru.SetStartedAt(*t)
+	}
+	return diru
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (diru *DictImportRunUpdate) SetFinishedAt(t time.Time) *DictImportRunUpdate {
+	diru.mutation.SetFinishedAt(t)
+	return diru
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (diru *DictImportRunUpdate) SetNillableFinishedAt(t *time.Time) *DictImportRunUpdate {
+	if t != nil {
+		diru.SetFinishedAt(*t)
+	}
+	return diru
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (diru *DictImportRunUpdate) ClearFinishedAt() *DictImportRunUpdate {
+	diru.mutation.ClearFinishedAt()
+	return diru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (diru *DictImportRunUpdate) SetUpdatedAt(t time.Time) *DictImportRunUpdate {
+	diru.mutation.SetUpdatedAt(t)
+	return diru
+}
+
+// AddFailureIDs adds the "failures" edge to the DictImportFailure entity by IDs.
+func (diru *DictImportRunUpdate) AddFailureIDs(ids ...int) *DictImportRunUpdate {
+	diru.mutation.AddFailureIDs(ids...)
+	return diru
+}
+
+// AddFailures adds the "failures" edges to the DictImportFailure entity.
+func (diru *DictImportRunUpdate) AddFailures(d ...*DictImportFailure) *DictImportRunUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return diru.AddFailureIDs(ids...)
+}
+
+// Mutation returns the DictImportRunMutation object of the builder.
+func (diru *DictImportRunUpdate) Mutation() *DictImportRunMutation {
+	return diru.mutation
+}
+
+// ClearFailures clears all "failures" edges to the DictImportFailure entity.
+func (diru *DictImportRunUpdate) ClearFailures() *DictImportRunUpdate {
+	diru.mutation.ClearFailures()
+	return diru
+}
+
+// RemoveFailureIDs removes the "failures" edge to DictImportFailure entities by IDs.
+func (diru *DictImportRunUpdate) RemoveFailureIDs(ids ...int) *DictImportRunUpdate {
+	diru.mutation.RemoveFailureIDs(ids...)
+	return diru
+}
+
+// RemoveFailures removes "failures" edges to DictImportFailure entities.
+func (diru *DictImportRunUpdate) RemoveFailures(d ...*DictImportFailure) *DictImportRunUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return diru.RemoveFailureIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (diru *DictImportRunUpdate) Save(ctx context.Context) (int, error) {
+	diru.defaults()
+	return withHooks(ctx, diru.sqlSave, diru.mutation, diru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (diru *DictImportRunUpdate) SaveX(ctx context.Context) int {
+	affected, err := diru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (diru *DictImportRunUpdate) Exec(ctx context.Context) error {
+	_, err := diru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (diru *DictImportRunUpdate) ExecX(ctx context.Context) {
+	if err := diru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (diru *DictImportRunUpdate) defaults() {
+	if _, ok := diru.mutation.UpdatedAt(); !ok {
+		v := dictimportrun.UpdateDefaultUpdatedAt()
+		diru.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (diru *DictImportRunUpdate) check() error {
+	if v, ok := diru.mutation.Status(); ok {
+		if err := dictimportrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DictImportRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (diru *DictImportRunUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DictImportRunUpdate {
+	diru.modifiers = append(diru.modifiers, modifiers...)
+	return diru
+}
+
+func (diru *DictImportRunUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := diru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dictimportrun.Table, dictimportrun.Columns, sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt))
+	if ps := diru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := diru.mutation.SourcePath(); ok {
+		_spec.SetField(dictimportrun.FieldSourcePath, field.TypeString, value)
+	}
+	if value, ok := diru.mutation.Format(); ok {
+		_spec.SetField(dictimportrun.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := diru.mutation.SourceHash(); ok {
+		_spec.SetField(dictimportrun.FieldSourceHash, field.TypeString, value)
+	}
+	if value, ok := diru.mutation.Status(); ok {
+		_spec.SetField(dictimportrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := diru.mutation.LastCommittedIndex(); ok {
+		_spec.SetField(dictimportrun.FieldLastCommittedIndex, field.TypeInt, value)
+	}
+	if value, ok := diru.mutation.AddedLastCommittedIndex(); ok {
+		_spec.AddField(dictimportrun.FieldLastCommittedIndex, field.TypeInt, value)
+	}
+	if value, ok := diru.mutation.ErrorMessage(); ok {
+		_spec.SetField(dictimportrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if diru.mutation.ErrorMessageCleared() {
+		_spec.ClearField(dictimportrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := diru.mutation.StartedAt(); ok {
+		_spec.SetField(dictimportrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := diru.mutation.FinishedAt(); ok {
+		_spec.SetField(dictimportrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if diru.mutation.FinishedAtCleared() {
+		_spec.ClearField(dictimportrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := diru.mutation.UpdatedAt(); ok {
+		_spec.SetField(dictimportrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if diru.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := diru.mutation.RemovedFailuresIDs(); len(nodes) > 0 && !diru.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := diru.mutation.FailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(diru.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, diru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dictimportrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	diru.mutation.done = true
+	return n, nil
+}
+
+// DictImportRunUpdateOne is the builder for updating a single DictImportRun entity.
+type DictImportRunUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *DictImportRunMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetSourcePath sets the "source_path" field.
+func (diruo *DictImportRunUpdateOne) SetSourcePath(s string) *DictImportRunUpdateOne {
+	diruo.mutation.SetSourcePath(s)
+	return diruo
+}
+
+// SetNillableSourcePath sets the "source_path" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableSourcePath(s *string) *DictImportRunUpdateOne {
+	if s != nil {
+		diruo.SetSourcePath(*s)
+	}
+	return diruo
+}
+
+// SetFormat sets the "format" field.
+func (diruo *DictImportRunUpdateOne) SetFormat(s string) *DictImportRunUpdateOne {
+	diruo.mutation.SetFormat(s)
+	return diruo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableFormat(s *string) *DictImportRunUpdateOne {
+	if s != nil {
+		diruo.SetFormat(*s)
+	}
+	return diruo
+}
+
+// SetSourceHash sets the "source_hash" field.
+func (diruo *DictImportRunUpdateOne) SetSourceHash(s string) *DictImportRunUpdateOne {
+	diruo.mutation.SetSourceHash(s)
+	return diruo
+}
+
+// SetNillableSourceHash sets the "source_hash" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableSourceHash(s *string) *DictImportRunUpdateOne {
+	if s != nil {
+		diruo.SetSourceHash(*s)
+	}
+	return diruo
+}
+
+// SetStatus sets the "status" field.
+func (diruo *DictImportRunUpdateOne) SetStatus(s string) *DictImportRunUpdateOne {
+	diruo.mutation.SetStatus(s)
+	return diruo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableStatus(s *string) *DictImportRunUpdateOne {
+	if s != nil {
+		diruo.SetStatus(*s)
+	}
+	return diruo
+}
+
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (diruo *DictImportRunUpdateOne) SetLastCommittedIndex(i int) *DictImportRunUpdateOne {
+	diruo.mutation.ResetLastCommittedIndex()
+	diruo.mutation.SetLastCommittedIndex(i)
+	return diruo
+}
+
+// SetNillableLastCommittedIndex sets the "last_committed_index" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableLastCommittedIndex(i *int) *DictImportRunUpdateOne {
+	if i != nil {
+		diruo.SetLastCommittedIndex(*i)
+	}
+	return diruo
+}
+
+// AddLastCommittedIndex adds i to the "last_committed_index" field.
+func (diruo *DictImportRunUpdateOne) AddLastCommittedIndex(i int) *DictImportRunUpdateOne {
+	diruo.mutation.AddLastCommittedIndex(i)
+	return diruo
+}
+
+// SetErrorMessage sets the "error_message" field.
+func (diruo *DictImportRunUpdateOne) SetErrorMessage(s string) *DictImportRunUpdateOne {
+	diruo.mutation.SetErrorMessage(s)
+	return diruo
+}
+
+// SetNillableErrorMessage sets the "error_message" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableErrorMessage(s *string) *DictImportRunUpdateOne {
+	if s != nil {
+		diruo.SetErrorMessage(*s)
+	}
+	return diruo
+}
+
+// ClearErrorMessage clears the value of the "error_message" field.
+func (diruo *DictImportRunUpdateOne) ClearErrorMessage() *DictImportRunUpdateOne {
+	diruo.mutation.ClearErrorMessage()
+	return diruo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (diruo *DictImportRunUpdateOne) SetStartedAt(t time.Time) *DictImportRunUpdateOne {
+	diruo.mutation.SetStartedAt(t)
+	return diruo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableStartedAt(t *time.Time) *DictImportRunUpdateOne {
+	if t != nil {
+		diruo.SetStartedAt(*t)
+	}
+	return diruo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (diruo *DictImportRunUpdateOne) SetFinishedAt(t time.Time) *DictImportRunUpdateOne {
+	diruo.mutation.SetFinishedAt(t)
+	return diruo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (diruo *DictImportRunUpdateOne) SetNillableFinishedAt(t *time.Time) *DictImportRunUpdateOne {
+	if t != nil {
+		diruo.SetFinishedAt(*t)
+	}
+	return diruo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (diruo *DictImportRunUpdateOne) ClearFinishedAt() *DictImportRunUpdateOne {
+	diruo.mutation.ClearFinishedAt()
+	return diruo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (diruo *DictImportRunUpdateOne) SetUpdatedAt(t time.Time) *DictImportRunUpdateOne {
+	diruo.mutation.SetUpdatedAt(t)
+	return diruo
+}
+
+// AddFailureIDs adds the "failures" edge to the DictImportFailure entity by IDs.
+func (diruo *DictImportRunUpdateOne) AddFailureIDs(ids ...int) *DictImportRunUpdateOne {
+	diruo.mutation.AddFailureIDs(ids...)
+	return diruo
+}
+
+// AddFailures adds the "failures" edges to the DictImportFailure entity.
+func (diruo *DictImportRunUpdateOne) AddFailures(d ...*DictImportFailure) *DictImportRunUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return diruo.AddFailureIDs(ids...)
+}
+
+// Mutation returns the DictImportRunMutation object of the builder.
+func (diruo *DictImportRunUpdateOne) Mutation() *DictImportRunMutation {
+	return diruo.mutation
+}
+
+// ClearFailures clears all "failures" edges to the DictImportFailure entity.
+func (diruo *DictImportRunUpdateOne) ClearFailures() *DictImportRunUpdateOne {
+	diruo.mutation.ClearFailures()
+	return diruo
+}
+
+// RemoveFailureIDs removes the "failures" edge to DictImportFailure entities by IDs.
+func (diruo *DictImportRunUpdateOne) RemoveFailureIDs(ids ...int) *DictImportRunUpdateOne {
+	diruo.mutation.RemoveFailureIDs(ids...)
+	return diruo
+}
+
+// RemoveFailures removes "failures" edges to DictImportFailure entities.
+func (diruo *DictImportRunUpdateOne) RemoveFailures(d ...*DictImportFailure) *DictImportRunUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return diruo.RemoveFailureIDs(ids...)
+}
+
+// Where appends a list predicates to the DictImportRunUpdate builder.
+func (diruo *DictImportRunUpdateOne) Where(ps ...predicate.DictImportRun) *DictImportRunUpdateOne {
+	diruo.mutation.Where(ps...)
+	return diruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (diruo *DictImportRunUpdateOne) Select(field string, fields ...string) *DictImportRunUpdateOne {
+	diruo.fields = append([]string{field}, fields...)
+	return diruo
+}
+
+// Save executes the query and returns the updated DictImportRun entity.
+func (diruo *DictImportRunUpdateOne) Save(ctx context.Context) (*DictImportRun, error) {
+	diruo.defaults()
+	return withHooks(ctx, diruo.sqlSave, diruo.mutation, diruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (diruo *DictImportRunUpdateOne) SaveX(ctx context.Context) *DictImportRun {
+	node, err := diruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (diruo *DictImportRunUpdateOne) Exec(ctx context.Context) error {
+	_, err := diruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (diruo *DictImportRunUpdateOne) ExecX(ctx context.Context) {
+	if err := diruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (diruo *DictImportRunUpdateOne) defaults() {
+	if _, ok := diruo.mutation.UpdatedAt(); !ok {
+		v := dictimportrun.UpdateDefaultUpdatedAt()
+		diruo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (diruo *DictImportRunUpdateOne) check() error {
+	if v, ok := diruo.mutation.Status(); ok {
+		if err := dictimportrun.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "DictImportRun.status": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (diruo *DictImportRunUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *DictImportRunUpdateOne {
+	diruo.modifiers = append(diruo.modifiers, modifiers...)
+	return diruo
+}
+
+func (diruo *DictImportRunUpdateOne) sqlSave(ctx context.Context) (_node *DictImportRun, err error) {
+	if err := diruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dictimportrun.Table, dictimportrun.Columns, sqlgraph.NewFieldSpec(dictimportrun.FieldID, field.TypeInt))
+	id, ok := diruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DictImportRun.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := diruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dictimportrun.FieldID)
+		for _, f := range fields {
+			if !dictimportrun.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dictimportrun.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := diruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := diruo.mutation.SourcePath(); ok {
+		_spec.SetField(dictimportrun.FieldSourcePath, field.TypeString, value)
+	}
+	if value, ok := diruo.mutation.Format(); ok {
+		_spec.SetField(dictimportrun.FieldFormat, field.TypeString, value)
+	}
+	if value, ok := diruo.mutation.SourceHash(); ok {
+		_spec.SetField(dictimportrun.FieldSourceHash, field.TypeString, value)
+	}
+	if value, ok := diruo.mutation.Status(); ok {
+		_spec.SetField(dictimportrun.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := diruo.mutation.LastCommittedIndex(); ok {
+		_spec.SetField(dictimportrun.FieldLastCommittedIndex, field.TypeInt, value)
+	}
+	if value, ok := diruo.mutation.AddedLastCommittedIndex(); ok {
+		_spec.AddField(dictimportrun.FieldLastCommittedIndex, field.TypeInt, value)
+	}
+	if value, ok := diruo.mutation.ErrorMessage(); ok {
+		_spec.SetField(dictimportrun.FieldErrorMessage, field.TypeString, value)
+	}
+	if diruo.mutation.ErrorMessageCleared() {
+		_spec.ClearField(dictimportrun.FieldErrorMessage, field.TypeString)
+	}
+	if value, ok := diruo.mutation.StartedAt(); ok {
+		_spec.SetField(dictimportrun.FieldStartedAt, field.TypeTime, value)
+	}
+	if value, ok := diruo.mutation.FinishedAt(); ok {
+		_spec.SetField(dictimportrun.FieldFinishedAt, field.TypeTime, value)
+	}
+	if diruo.mutation.FinishedAtCleared() {
+		_spec.ClearField(dictimportrun.FieldFinishedAt, field.TypeTime)
+	}
+	if value, ok := diruo.mutation.UpdatedAt(); ok {
+		_spec.SetField(dictimportrun.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if diruo.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := diruo.mutation.RemovedFailuresIDs(); len(nodes) > 0 && !diruo.mutation.FailuresCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := diruo.mutation.FailuresIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(dictimportfailure.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(diruo.modifiers...)
+	_node = &DictImportRun{config: diruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, diruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dictimportrun.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	diruo.mutation.done = true
+	return _node, nil
+}
@@ -8,11 +8,18 @@ import (
 	"fmt"
 	"reflect"
 	"sync"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
 	"word_app/backend/ent/externalauth"
 	"word_app/backend/ent/japanesemean"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/refreshtoken"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/rootconfig"
 	"word_app/backend/ent/user"
@@ -20,6 +27,7 @@ import (
 	"word_app/backend/ent/userdailyusage"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/ent/wordproposal"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -84,18 +92,26 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			externalauth.Table:   externalauth.ValidColumn,
-			japanesemean.Table:   japanesemean.ValidColumn,
-			partofspeech.Table:   partofspeech.ValidColumn,
-			quiz.Table:           quiz.ValidColumn,
-			quizquestion.Table:   quizquestion.ValidColumn,
-			registeredword.Table: registeredword.ValidColumn,
-			rootconfig.Table:     rootconfig.ValidColumn,
-			user.Table:           user.ValidColumn,
-			userconfig.Table:     userconfig.ValidColumn,
-			userdailyusage.Table: userdailyusage.ValidColumn,
-			word.Table:           word.ValidColumn,
-			wordinfo.Table:       wordinfo.ValidColumn,
+			deck.Table:              deck.ValidColumn,
+			deckword.Table:          deckword.ValidColumn,
+			dictimportfailure.Table: dictimportfailure.ValidColumn,
+			dictimportrun.Table:     dictimportrun.ValidColumn,
+			externalauth.Table:      externalauth.ValidColumn,
+			japanesemean.Table:      japanesemean.ValidColumn,
+			partofspeech.Table:      partofspeech.ValidColumn,
+			posmapping.Table:        posmapping.ValidColumn,
+			posmappingversion.Table: posmappingversion.ValidColumn,
+			quiz.Table:              quiz.ValidColumn,
+			quizquestion.Table:      quizquestion.ValidColumn,
+			refreshtoken.Table:      refreshtoken.ValidColumn,
+			registeredword.Table:    registeredword.ValidColumn,
+			rootconfig.Table:        rootconfig.ValidColumn,
+			user.Table:              user.ValidColumn,
+			userconfig.Table:        userconfig.ValidColumn,
+			userdailyusage.Table:    userdailyusage.ValidColumn,
+			word.Table:              word.ValidColumn,
+			wordinfo.Table:          wordinfo.ValidColumn,
+			wordproposal.Table:      wordproposal.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
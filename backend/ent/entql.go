@@ -3,12 +3,19 @@
 package ent
 
 import (
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
 	"word_app/backend/ent/externalauth"
 	"word_app/backend/ent/japanesemean"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/refreshtoken"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/rootconfig"
 	"word_app/backend/ent/user"
@@ -16,6 +23,7 @@ import (
 	"word_app/backend/ent/userdailyusage"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/ent/wordproposal"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -25,8 +33,86 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 12)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 20)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   deck.Table,
+			Columns: deck.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deck.FieldID,
+			},
+		},
+		Type: "Deck",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			deck.FieldUserID:      {Type: field.TypeInt, Column: deck.FieldUserID},
+			deck.FieldName:        {Type: field.TypeString, Column: deck.FieldName},
+			deck.FieldDescription: {Type: field.TypeString, Column: deck.FieldDescription},
+			deck.FieldVisibility:  {Type: field.TypeEnum, Column: deck.FieldVisibility},
+			deck.FieldShareToken:  {Type: field.TypeString, Column: deck.FieldShareToken},
+			deck.FieldCreatedAt:   {Type: field.TypeTime, Column: deck.FieldCreatedAt},
+			deck.FieldUpdatedAt:   {Type: field.TypeTime, Column: deck.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   deckword.Table,
+			Columns: deckword.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deckword.FieldID,
+			},
+		},
+		Type: "DeckWord",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			deckword.FieldDeckID:    {Type: field.TypeInt, Column: deckword.FieldDeckID},
+			deckword.FieldWordID:    {Type: field.TypeInt, Column: deckword.FieldWordID},
+			deckword.FieldPosition:  {Type: field.TypeInt, Column: deckword.FieldPosition},
+			deckword.FieldCreatedAt: {Type: field.TypeTime, Column: deckword.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   dictimportfailure.Table,
+			Columns: dictimportfailure.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: dictimportfailure.FieldID,
+			},
+		},
+		Type: "DictImportFailure",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			dictimportfailure.FieldRunID:      {Type: field.TypeInt, Column: dictimportfailure.FieldRunID},
+			dictimportfailure.FieldEntryID:    {Type: field.TypeString, Column: dictimportfailure.FieldEntryID},
+			dictimportfailure.FieldEntryIndex: {Type: field.TypeInt, Column: dictimportfailure.FieldEntryIndex},
+			dictimportfailure.FieldMessage:    {Type: field.TypeString, Column: dictimportfailure.FieldMessage},
+			dictimportfailure.FieldCreatedAt:  {Type: field.TypeTime, Column: dictimportfailure.FieldCreatedAt},
+			dictimportfailure.FieldResolvedAt: {Type: field.TypeTime, Column: dictimportfailure.FieldResolvedAt},
+		},
+	}
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   dictimportrun.Table,
+			Columns: dictimportrun.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: dictimportrun.FieldID,
+			},
+		},
+		Type: "DictImportRun",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			dictimportrun.FieldSourcePath:         {Type: field.TypeString, Column: dictimportrun.FieldSourcePath},
+			dictimportrun.FieldFormat:             {Type: field.TypeString, Column: dictimportrun.FieldFormat},
+			dictimportrun.FieldSourceHash:         {Type: field.TypeString, Column: dictimportrun.FieldSourceHash},
+			dictimportrun.FieldStatus:             {Type: field.TypeString, Column: dictimportrun.FieldStatus},
+			dictimportrun.FieldLastCommittedIndex: {Type: field.TypeInt, Column: dictimportrun.FieldLastCommittedIndex},
+			dictimportrun.FieldErrorMessage:       {Type: field.TypeString, Column: dictimportrun.FieldErrorMessage},
+			dictimportrun.FieldStartedAt:          {Type: field.TypeTime, Column: dictimportrun.FieldStartedAt},
+			dictimportrun.FieldFinishedAt:         {Type: field.TypeTime, Column: dictimportrun.FieldFinishedAt},
+			dictimportrun.FieldUpdatedAt:          {Type: field.TypeTime, Column: dictimportrun.FieldUpdatedAt},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   externalauth.Table,
 			Columns: externalauth.Columns,
@@ -43,7 +129,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			externalauth.FieldDeletedAt:      {Type: field.TypeTime, Column: externalauth.FieldDeletedAt},
 		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[5] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   japanesemean.Table,
 			Columns: japanesemean.Columns,
@@ -55,12 +141,13 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Type: "JapaneseMean",
 		Fields: map[string]*sqlgraph.FieldSpec{
 			japanesemean.FieldName:       {Type: field.TypeString, Column: japanesemean.FieldName},
+			japanesemean.FieldReading:    {Type: field.TypeString, Column: japanesemean.FieldReading},
 			japanesemean.FieldWordInfoID: {Type: field.TypeInt, Column: japanesemean.FieldWordInfoID},
 			japanesemean.FieldCreatedAt:  {Type: field.TypeTime, Column: japanesemean.FieldCreatedAt},
 			japanesemean.FieldUpdatedAt:  {Type: field.TypeTime, Column: japanesemean.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[2] = &sqlgraph.Node{
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   partofspeech.Table,
 			Columns: partofspeech.Columns,
@@ -76,7 +163,40 @@ var schemaGraph = func() *sqlgraph.Schema {
 			partofspeech.FieldUpdatedAt: {Type: field.TypeTime, Column: partofspeech.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[3] = &sqlgraph.Node{
+	graph.Nodes[7] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   posmapping.Table,
+			Columns: posmapping.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: posmapping.FieldID,
+			},
+		},
+		Type: "PosMapping",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			posmapping.FieldVersionID:      {Type: field.TypeInt, Column: posmapping.FieldVersionID},
+			posmapping.FieldCode:           {Type: field.TypeString, Column: posmapping.FieldCode},
+			posmapping.FieldPartOfSpeechID: {Type: field.TypeInt, Column: posmapping.FieldPartOfSpeechID},
+		},
+	}
+	graph.Nodes[8] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   posmappingversion.Table,
+			Columns: posmappingversion.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: posmappingversion.FieldID,
+			},
+		},
+		Type: "PosMappingVersion",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			posmappingversion.FieldFallbackPosID: {Type: field.TypeInt, Column: posmappingversion.FieldFallbackPosID},
+			posmappingversion.FieldNote:          {Type: field.TypeString, Column: posmappingversion.FieldNote},
+			posmappingversion.FieldCreatedBy:     {Type: field.TypeInt, Column: posmappingversion.FieldCreatedBy},
+			posmappingversion.FieldCreatedAt:     {Type: field.TypeTime, Column: posmappingversion.FieldCreatedAt},
+		},
+	}
+	graph.Nodes[9] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   quiz.Table,
 			Columns: quiz.Columns,
@@ -87,24 +207,34 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "Quiz",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			quiz.FieldUserID:              {Type: field.TypeInt, Column: quiz.FieldUserID},
-			quiz.FieldQuizNumber:          {Type: field.TypeInt, Column: quiz.FieldQuizNumber},
-			quiz.FieldIsRunning:           {Type: field.TypeBool, Column: quiz.FieldIsRunning},
-			quiz.FieldTotalQuestionsCount: {Type: field.TypeInt, Column: quiz.FieldTotalQuestionsCount},
-			quiz.FieldCorrectCount:        {Type: field.TypeInt, Column: quiz.FieldCorrectCount},
-			quiz.FieldResultCorrectRate:   {Type: field.TypeFloat64, Column: quiz.FieldResultCorrectRate},
-			quiz.FieldIsSaveResult:        {Type: field.TypeBool, Column: quiz.FieldIsSaveResult},
-			quiz.FieldIsRegisteredWords:   {Type: field.TypeInt, Column: quiz.FieldIsRegisteredWords},
-			quiz.FieldSettingCorrectRate:  {Type: field.TypeInt, Column: quiz.FieldSettingCorrectRate},
-			quiz.FieldIsIdioms:            {Type: field.TypeInt, Column: quiz.FieldIsIdioms},
-			quiz.FieldIsSpecialCharacters: {Type: field.TypeInt, Column: quiz.FieldIsSpecialCharacters},
-			quiz.FieldAttentionLevelList:  {Type: field.TypeJSON, Column: quiz.FieldAttentionLevelList},
-			quiz.FieldChoicesPosIds:       {Type: field.TypeJSON, Column: quiz.FieldChoicesPosIds},
-			quiz.FieldCreatedAt:           {Type: field.TypeTime, Column: quiz.FieldCreatedAt},
-			quiz.FieldDeletedAt:           {Type: field.TypeTime, Column: quiz.FieldDeletedAt},
+			quiz.FieldUserID:               {Type: field.TypeInt, Column: quiz.FieldUserID},
+			quiz.FieldQuizNumber:           {Type: field.TypeInt, Column: quiz.FieldQuizNumber},
+			quiz.FieldIsRunning:            {Type: field.TypeBool, Column: quiz.FieldIsRunning},
+			quiz.FieldTotalQuestionsCount:  {Type: field.TypeInt, Column: quiz.FieldTotalQuestionsCount},
+			quiz.FieldCorrectCount:         {Type: field.TypeInt, Column: quiz.FieldCorrectCount},
+			quiz.FieldResultCorrectRate:    {Type: field.TypeFloat64, Column: quiz.FieldResultCorrectRate},
+			quiz.FieldIsSaveResult:         {Type: field.TypeBool, Column: quiz.FieldIsSaveResult},
+			quiz.FieldIsRegisteredWords:    {Type: field.TypeInt, Column: quiz.FieldIsRegisteredWords},
+			quiz.FieldSettingCorrectRate:   {Type: field.TypeInt, Column: quiz.FieldSettingCorrectRate},
+			quiz.FieldIsIdioms:             {Type: field.TypeInt, Column: quiz.FieldIsIdioms},
+			quiz.FieldIsSpecialCharacters:  {Type: field.TypeInt, Column: quiz.FieldIsSpecialCharacters},
+			quiz.FieldAttentionLevelList:   {Type: field.TypeJSON, Column: quiz.FieldAttentionLevelList},
+			quiz.FieldChoicesPosIds:        {Type: field.TypeJSON, Column: quiz.FieldChoicesPosIds},
+			quiz.FieldMode:                 {Type: field.TypeString, Column: quiz.FieldMode},
+			quiz.FieldDirection:            {Type: field.TypeString, Column: quiz.FieldDirection},
+			quiz.FieldQuestionType:         {Type: field.TypeString, Column: quiz.FieldQuestionType},
+			quiz.FieldDifficulty:           {Type: field.TypeString, Column: quiz.FieldDifficulty},
+			quiz.FieldQuestionTimeLimitSec: {Type: field.TypeInt, Column: quiz.FieldQuestionTimeLimitSec},
+			quiz.FieldTimeLimitSec:         {Type: field.TypeInt, Column: quiz.FieldTimeLimitSec},
+			quiz.FieldExpiresAt:            {Type: field.TypeTime, Column: quiz.FieldExpiresAt},
+			quiz.FieldFinishStatus:         {Type: field.TypeString, Column: quiz.FieldFinishStatus},
+			quiz.FieldFinishedAt:           {Type: field.TypeTime, Column: quiz.FieldFinishedAt},
+			quiz.FieldDeckID:               {Type: field.TypeInt, Column: quiz.FieldDeckID},
+			quiz.FieldCreatedAt:            {Type: field.TypeTime, Column: quiz.FieldCreatedAt},
+			quiz.FieldDeletedAt:            {Type: field.TypeTime, Column: quiz.FieldDeletedAt},
 		},
 	}
-	graph.Nodes[4] = &sqlgraph.Node{
+	graph.Nodes[10] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   quizquestion.Table,
 			Columns: quizquestion.Columns,
@@ -121,16 +251,46 @@ var schemaGraph = func() *sqlgraph.Schema {
 			quizquestion.FieldWordName:       {Type: field.TypeString, Column: quizquestion.FieldWordName},
 			quizquestion.FieldPosID:          {Type: field.TypeInt, Column: quizquestion.FieldPosID},
 			quizquestion.FieldCorrectJpmID:   {Type: field.TypeInt, Column: quizquestion.FieldCorrectJpmID},
+			quizquestion.FieldDirection:      {Type: field.TypeString, Column: quizquestion.FieldDirection},
+			quizquestion.FieldQuestionType:   {Type: field.TypeString, Column: quizquestion.FieldQuestionType},
+			quizquestion.FieldMeaningName:    {Type: field.TypeString, Column: quizquestion.FieldMeaningName},
 			quizquestion.FieldChoicesJpms:    {Type: field.TypeJSON, Column: quizquestion.FieldChoicesJpms},
+			quizquestion.FieldChoicesWords:   {Type: field.TypeJSON, Column: quizquestion.FieldChoicesWords},
 			quizquestion.FieldAnswerJpmID:    {Type: field.TypeInt, Column: quizquestion.FieldAnswerJpmID},
+			quizquestion.FieldAnswerWordID:   {Type: field.TypeInt, Column: quizquestion.FieldAnswerWordID},
+			quizquestion.FieldTypedAnswer:    {Type: field.TypeString, Column: quizquestion.FieldTypedAnswer},
+			quizquestion.FieldGrade:          {Type: field.TypeString, Column: quizquestion.FieldGrade},
+			quizquestion.FieldCredit:         {Type: field.TypeFloat64, Column: quizquestion.FieldCredit},
 			quizquestion.FieldIsCorrect:      {Type: field.TypeBool, Column: quizquestion.FieldIsCorrect},
+			quizquestion.FieldServedAt:       {Type: field.TypeTime, Column: quizquestion.FieldServedAt},
 			quizquestion.FieldAnsweredAt:     {Type: field.TypeTime, Column: quizquestion.FieldAnsweredAt},
+			quizquestion.FieldIdempotencyKey: {Type: field.TypeString, Column: quizquestion.FieldIdempotencyKey},
 			quizquestion.FieldTimeMs:         {Type: field.TypeInt, Column: quizquestion.FieldTimeMs},
 			quizquestion.FieldCreatedAt:      {Type: field.TypeTime, Column: quizquestion.FieldCreatedAt},
 			quizquestion.FieldDeletedAt:      {Type: field.TypeTime, Column: quizquestion.FieldDeletedAt},
 		},
 	}
-	graph.Nodes[5] = &sqlgraph.Node{
+	graph.Nodes[11] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   refreshtoken.Table,
+			Columns: refreshtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: refreshtoken.FieldID,
+			},
+		},
+		Type: "RefreshToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			refreshtoken.FieldUserID:    {Type: field.TypeInt, Column: refreshtoken.FieldUserID},
+			refreshtoken.FieldFamilyID:  {Type: field.TypeString, Column: refreshtoken.FieldFamilyID},
+			refreshtoken.FieldTokenHash: {Type: field.TypeString, Column: refreshtoken.FieldTokenHash},
+			refreshtoken.FieldExpiresAt: {Type: field.TypeTime, Column: refreshtoken.FieldExpiresAt},
+			refreshtoken.FieldCreatedAt: {Type: field.TypeTime, Column: refreshtoken.FieldCreatedAt},
+			refreshtoken.FieldUsedAt:    {Type: field.TypeTime, Column: refreshtoken.FieldUsedAt},
+			refreshtoken.FieldRevokedAt: {Type: field.TypeTime, Column: refreshtoken.FieldRevokedAt},
+		},
+	}
+	graph.Nodes[12] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   registeredword.Table,
 			Columns: registeredword.Columns,
@@ -148,12 +308,20 @@ var schemaGraph = func() *sqlgraph.Schema {
 			registeredword.FieldQuizCount:      {Type: field.TypeInt, Column: registeredword.FieldQuizCount},
 			registeredword.FieldCorrectCount:   {Type: field.TypeInt, Column: registeredword.FieldCorrectCount},
 			registeredword.FieldCorrectRate:    {Type: field.TypeInt, Column: registeredword.FieldCorrectRate},
+			registeredword.FieldEaseFactor:     {Type: field.TypeFloat64, Column: registeredword.FieldEaseFactor},
+			registeredword.FieldIntervalDays:   {Type: field.TypeInt, Column: registeredword.FieldIntervalDays},
+			registeredword.FieldRepetitions:    {Type: field.TypeInt, Column: registeredword.FieldRepetitions},
+			registeredword.FieldLapses:         {Type: field.TypeInt, Column: registeredword.FieldLapses},
+			registeredword.FieldStability:      {Type: field.TypeFloat64, Column: registeredword.FieldStability},
+			registeredword.FieldDifficulty:     {Type: field.TypeFloat64, Column: registeredword.FieldDifficulty},
+			registeredword.FieldDueAt:          {Type: field.TypeTime, Column: registeredword.FieldDueAt},
+			registeredword.FieldLastReviewedAt: {Type: field.TypeTime, Column: registeredword.FieldLastReviewedAt},
 			registeredword.FieldMemo:           {Type: field.TypeString, Column: registeredword.FieldMemo},
 			registeredword.FieldCreatedAt:      {Type: field.TypeTime, Column: registeredword.FieldCreatedAt},
 			registeredword.FieldUpdatedAt:      {Type: field.TypeTime, Column: registeredword.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[6] = &sqlgraph.Node{
+	graph.Nodes[13] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   rootconfig.Table,
 			Columns: rootconfig.Columns,
@@ -171,7 +339,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			rootconfig.FieldUpdatedAt:                  {Type: field.TypeTime, Column: rootconfig.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[7] = &sqlgraph.Node{
+	graph.Nodes[14] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   user.Table,
 			Columns: user.Columns,
@@ -182,18 +350,20 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "User",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			user.FieldEmail:     {Type: field.TypeString, Column: user.FieldEmail},
-			user.FieldPassword:  {Type: field.TypeString, Column: user.FieldPassword},
-			user.FieldName:      {Type: field.TypeString, Column: user.FieldName},
-			user.FieldCreatedAt: {Type: field.TypeTime, Column: user.FieldCreatedAt},
-			user.FieldUpdatedAt: {Type: field.TypeTime, Column: user.FieldUpdatedAt},
-			user.FieldDeletedAt: {Type: field.TypeTime, Column: user.FieldDeletedAt},
-			user.FieldIsAdmin:   {Type: field.TypeBool, Column: user.FieldIsAdmin},
-			user.FieldIsRoot:    {Type: field.TypeBool, Column: user.FieldIsRoot},
-			user.FieldIsTest:    {Type: field.TypeBool, Column: user.FieldIsTest},
+			user.FieldEmail:           {Type: field.TypeString, Column: user.FieldEmail},
+			user.FieldPassword:        {Type: field.TypeString, Column: user.FieldPassword},
+			user.FieldName:            {Type: field.TypeString, Column: user.FieldName},
+			user.FieldCreatedAt:       {Type: field.TypeTime, Column: user.FieldCreatedAt},
+			user.FieldUpdatedAt:       {Type: field.TypeTime, Column: user.FieldUpdatedAt},
+			user.FieldDeletedAt:       {Type: field.TypeTime, Column: user.FieldDeletedAt},
+			user.FieldIsAdmin:         {Type: field.TypeBool, Column: user.FieldIsAdmin},
+			user.FieldIsRoot:          {Type: field.TypeBool, Column: user.FieldIsRoot},
+			user.FieldIsTest:          {Type: field.TypeBool, Column: user.FieldIsTest},
+			user.FieldEmailVerifiedAt: {Type: field.TypeTime, Column: user.FieldEmailVerifiedAt},
+			user.FieldTokenVersion:    {Type: field.TypeInt, Column: user.FieldTokenVersion},
 		},
 	}
-	graph.Nodes[8] = &sqlgraph.Node{
+	graph.Nodes[15] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userconfig.Table,
 			Columns: userconfig.Columns,
@@ -204,12 +374,13 @@ var schemaGraph = func() *sqlgraph.Schema {
 		},
 		Type: "UserConfig",
 		Fields: map[string]*sqlgraph.FieldSpec{
-			userconfig.FieldUserID:     {Type: field.TypeInt, Column: userconfig.FieldUserID},
-			userconfig.FieldIsDarkMode: {Type: field.TypeBool, Column: userconfig.FieldIsDarkMode},
-			userconfig.FieldDeletedAt:  {Type: field.TypeTime, Column: userconfig.FieldDeletedAt},
+			userconfig.FieldUserID:       {Type: field.TypeInt, Column: userconfig.FieldUserID},
+			userconfig.FieldIsDarkMode:   {Type: field.TypeBool, Column: userconfig.FieldIsDarkMode},
+			userconfig.FieldSrsAlgorithm: {Type: field.TypeString, Column: userconfig.FieldSrsAlgorithm},
+			userconfig.FieldDeletedAt:    {Type: field.TypeTime, Column: userconfig.FieldDeletedAt},
 		},
 	}
-	graph.Nodes[9] = &sqlgraph.Node{
+	graph.Nodes[16] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   userdailyusage.Table,
 			Columns: userdailyusage.Columns,
@@ -227,7 +398,7 @@ var schemaGraph = func() *sqlgraph.Schema {
 			userdailyusage.FieldUpdatedAt:     {Type: field.TypeTime, Column: userdailyusage.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[10] = &sqlgraph.Node{
+	graph.Nodes[17] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   word.Table,
 			Columns: word.Columns,
@@ -243,11 +414,12 @@ var schemaGraph = func() *sqlgraph.Schema {
 			word.FieldIsIdioms:            {Type: field.TypeBool, Column: word.FieldIsIdioms},
 			word.FieldIsSpecialCharacters: {Type: field.TypeBool, Column: word.FieldIsSpecialCharacters},
 			word.FieldRegistrationCount:   {Type: field.TypeInt, Column: word.FieldRegistrationCount},
+			word.FieldFrequencyRank:       {Type: field.TypeInt, Column: word.FieldFrequencyRank},
 			word.FieldCreatedAt:           {Type: field.TypeTime, Column: word.FieldCreatedAt},
 			word.FieldUpdatedAt:           {Type: field.TypeTime, Column: word.FieldUpdatedAt},
 		},
 	}
-	graph.Nodes[11] = &sqlgraph.Node{
+	graph.Nodes[18] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   wordinfo.Table,
 			Columns: wordinfo.Columns,
@@ -260,10 +432,122 @@ var schemaGraph = func() *sqlgraph.Schema {
 		Fields: map[string]*sqlgraph.FieldSpec{
 			wordinfo.FieldWordID:         {Type: field.TypeInt, Column: wordinfo.FieldWordID},
 			wordinfo.FieldPartOfSpeechID: {Type: field.TypeInt, Column: wordinfo.FieldPartOfSpeechID},
+			wordinfo.FieldPosCodes:       {Type: field.TypeJSON, Column: wordinfo.FieldPosCodes},
+			wordinfo.FieldFieldTags:      {Type: field.TypeJSON, Column: wordinfo.FieldFieldTags},
+			wordinfo.FieldMiscTags:       {Type: field.TypeJSON, Column: wordinfo.FieldMiscTags},
+			wordinfo.FieldDialectTags:    {Type: field.TypeJSON, Column: wordinfo.FieldDialectTags},
+			wordinfo.FieldExamples:       {Type: field.TypeJSON, Column: wordinfo.FieldExamples},
 			wordinfo.FieldCreatedAt:      {Type: field.TypeTime, Column: wordinfo.FieldCreatedAt},
 			wordinfo.FieldUpdatedAt:      {Type: field.TypeTime, Column: wordinfo.FieldUpdatedAt},
 		},
 	}
+	graph.Nodes[19] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   wordproposal.Table,
+			Columns: wordproposal.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: wordproposal.FieldID,
+			},
+		},
+		Type: "WordProposal",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			wordproposal.FieldUserID:     {Type: field.TypeInt, Column: wordproposal.FieldUserID},
+			wordproposal.FieldAction:     {Type: field.TypeEnum, Column: wordproposal.FieldAction},
+			wordproposal.FieldWordID:     {Type: field.TypeInt, Column: wordproposal.FieldWordID},
+			wordproposal.FieldWordName:   {Type: field.TypeString, Column: wordproposal.FieldWordName},
+			wordproposal.FieldPayload:    {Type: field.TypeString, Column: wordproposal.FieldPayload},
+			wordproposal.FieldStatus:     {Type: field.TypeEnum, Column: wordproposal.FieldStatus},
+			wordproposal.FieldReviewerID: {Type: field.TypeInt, Column: wordproposal.FieldReviewerID},
+			wordproposal.FieldReviewNote: {Type: field.TypeString, Column: wordproposal.FieldReviewNote},
+			wordproposal.FieldCreatedAt:  {Type: field.TypeTime, Column: wordproposal.FieldCreatedAt},
+			wordproposal.FieldReviewedAt: {Type: field.TypeTime, Column: wordproposal.FieldReviewedAt},
+		},
+	}
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deck.UserTable,
+			Columns: []string{deck.UserColumn},
+			Bidi:    false,
+		},
+		"Deck",
+		"User",
+	)
+	graph.MustAddE(
+		"deck_words",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.DeckWordsTable,
+			Columns: []string{deck.DeckWordsColumn},
+			Bidi:    false,
+		},
+		"Deck",
+		"DeckWord",
+	)
+	graph.MustAddE(
+		"quizzes",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   deck.QuizzesTable,
+			Columns: []string{deck.QuizzesColumn},
+			Bidi:    false,
+		},
+		"Deck",
+		"Quiz",
+	)
+	graph.MustAddE(
+		"deck",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.DeckTable,
+			Columns: []string{deckword.DeckColumn},
+			Bidi:    false,
+		},
+		"DeckWord",
+		"Deck",
+	)
+	graph.MustAddE(
+		"word",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   deckword.WordTable,
+			Columns: []string{deckword.WordColumn},
+			Bidi:    false,
+		},
+		"DeckWord",
+		"Word",
+	)
+	graph.MustAddE(
+		"run",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   dictimportfailure.RunTable,
+			Columns: []string{dictimportfailure.RunColumn},
+			Bidi:    false,
+		},
+		"DictImportFailure",
+		"DictImportRun",
+	)
+	graph.MustAddE(
+		"failures",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   dictimportrun.FailuresTable,
+			Columns: []string{dictimportrun.FailuresColumn},
+			Bidi:    false,
+		},
+		"DictImportRun",
+		"DictImportFailure",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -312,6 +596,54 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"PartOfSpeech",
 		"WordInfo",
 	)
+	graph.MustAddE(
+		"pos_mappings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+		},
+		"PartOfSpeech",
+		"PosMapping",
+	)
+	graph.MustAddE(
+		"version",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.VersionTable,
+			Columns: []string{posmapping.VersionColumn},
+			Bidi:    false,
+		},
+		"PosMapping",
+		"PosMappingVersion",
+	)
+	graph.MustAddE(
+		"part_of_speech",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.PartOfSpeechTable,
+			Columns: []string{posmapping.PartOfSpeechColumn},
+			Bidi:    false,
+		},
+		"PosMapping",
+		"PartOfSpeech",
+	)
+	graph.MustAddE(
+		"mappings",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+		},
+		"PosMappingVersion",
+		"PosMapping",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -336,6 +668,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Quiz",
 		"QuizQuestion",
 	)
+	graph.MustAddE(
+		"deck",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.DeckTable,
+			Columns: []string{quiz.DeckColumn},
+			Bidi:    false,
+		},
+		"Quiz",
+		"Deck",
+	)
 	graph.MustAddE(
 		"quiz",
 		&sqlgraph.EdgeSpec{
@@ -372,6 +716,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"QuizQuestion",
 		"JapaneseMean",
 	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   refreshtoken.UserTable,
+			Columns: []string{refreshtoken.UserColumn},
+			Bidi:    false,
+		},
+		"RefreshToken",
+		"User",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -468,6 +824,42 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"User",
 		"UserDailyUsage",
 	)
+	graph.MustAddE(
+		"decks",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.DecksTable,
+			Columns: []string{user.DecksColumn},
+			Bidi:    false,
+		},
+		"User",
+		"Deck",
+	)
+	graph.MustAddE(
+		"word_proposals",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WordProposalsTable,
+			Columns: []string{user.WordProposalsColumn},
+			Bidi:    false,
+		},
+		"User",
+		"WordProposal",
+	)
+	graph.MustAddE(
+		"refresh_tokens",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.RefreshTokensTable,
+			Columns: []string{user.RefreshTokensColumn},
+			Bidi:    false,
+		},
+		"User",
+		"RefreshToken",
+	)
 	graph.MustAddE(
 		"user",
 		&sqlgraph.EdgeSpec{
@@ -528,6 +920,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"Word",
 		"QuizQuestion",
 	)
+	graph.MustAddE(
+		"deck_words",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+		},
+		"Word",
+		"DeckWord",
+	)
 	graph.MustAddE(
 		"word",
 		&sqlgraph.EdgeSpec{
@@ -564,6 +968,18 @@ var schemaGraph = func() *sqlgraph.Schema {
 		"WordInfo",
 		"JapaneseMean",
 	)
+	graph.MustAddE(
+		"user",
+		&sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wordproposal.UserTable,
+			Columns: []string{wordproposal.UserColumn},
+			Bidi:    false,
+		},
+		"WordProposal",
+		"User",
+	)
 	return graph
 }()
 
@@ -574,33 +990,33 @@ type predicateAdder interface {
 }
 
 // addPredicate implements the predicateAdder interface.
-func (eaq *ExternalAuthQuery) addPredicate(pred func(s *sql.Selector)) {
-	eaq.predicates = append(eaq.predicates, pred)
+func (dq *DeckQuery) addPredicate(pred func(s *sql.Selector)) {
+	dq.predicates = append(dq.predicates, pred)
 }
 
-// Filter returns a Filter implementation to apply filters on the ExternalAuthQuery builder.
-func (eaq *ExternalAuthQuery) Filter() *ExternalAuthFilter {
-	return &ExternalAuthFilter{config: eaq.config, predicateAdder: eaq}
+// Filter returns a Filter implementation to apply filters on the DeckQuery builder.
+func (dq *DeckQuery) Filter() *DeckFilter {
+	return &DeckFilter{config: dq.config, predicateAdder: dq}
 }
 
 // addPredicate implements the predicateAdder interface.
-func (m *ExternalAuthMutation) addPredicate(pred func(s *sql.Selector)) {
+func (m *DeckMutation) addPredicate(pred func(s *sql.Selector)) {
 	m.predicates = append(m.predicates, pred)
 }
 
-// Filter returns an entql.Where implementation to apply filters on the ExternalAuthMutation builder.
-func (m *ExternalAuthMutation) Filter() *ExternalAuthFilter {
-	return &ExternalAuthFilter{config: m.config, predicateAdder: m}
+// Filter returns an entql.Where implementation to apply filters on the DeckMutation builder.
+func (m *DeckMutation) Filter() *DeckFilter {
+	return &DeckFilter{config: m.config, predicateAdder: m}
 }
 
-// ExternalAuthFilter provides a generic filtering capability at runtime for ExternalAuthQuery.
-type ExternalAuthFilter struct {
+// DeckFilter provides a generic filtering capability at runtime for DeckQuery.
+type DeckFilter struct {
 	predicateAdder
 	config
 }
 
 // Where applies the entql predicate on the query filter.
-func (f *ExternalAuthFilter) Where(p entql.P) {
+func (f *DeckFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
 		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
 			s.AddError(err)
@@ -609,28 +1025,416 @@ func (f *ExternalAuthFilter) Where(p entql.P) {
 }
 
 // WhereID applies the entql int predicate on the id field.
-func (f *ExternalAuthFilter) WhereID(p entql.IntP) {
-	f.Where(p.Field(externalauth.FieldID))
+func (f *DeckFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(deck.FieldID))
 }
 
 // WhereUserID applies the entql int predicate on the user_id field.
-func (f *ExternalAuthFilter) WhereUserID(p entql.IntP) {
-	f.Where(p.Field(externalauth.FieldUserID))
+func (f *DeckFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(deck.FieldUserID))
 }
 
-// WhereProvider applies the entql string predicate on the provider field.
-func (f *ExternalAuthFilter) WhereProvider(p entql.StringP) {
-	f.Where(p.Field(externalauth.FieldProvider))
+// WhereName applies the entql string predicate on the name field.
+func (f *DeckFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(deck.FieldName))
 }
 
-// WhereProviderUserID applies the entql string predicate on the provider_user_id field.
-func (f *ExternalAuthFilter) WhereProviderUserID(p entql.StringP) {
-	f.Where(p.Field(externalauth.FieldProviderUserID))
+// WhereDescription applies the entql string predicate on the description field.
+func (f *DeckFilter) WhereDescription(p entql.StringP) {
+	f.Where(p.Field(deck.FieldDescription))
 }
 
-// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
-func (f *ExternalAuthFilter) WhereDeletedAt(p entql.TimeP) {
-	f.Where(p.Field(externalauth.FieldDeletedAt))
+// WhereVisibility applies the entql string predicate on the visibility field.
+func (f *DeckFilter) WhereVisibility(p entql.StringP) {
+	f.Where(p.Field(deck.FieldVisibility))
+}
+
+// WhereShareToken applies the entql string predicate on the share_token field.
+func (f *DeckFilter) WhereShareToken(p entql.StringP) {
+	f.Where(p.Field(deck.FieldShareToken))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *DeckFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(deck.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *DeckFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(deck.FieldUpdatedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *DeckFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *DeckFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasDeckWords applies a predicate to check if query has an edge deck_words.
+func (f *DeckFilter) WhereHasDeckWords() {
+	f.Where(entql.HasEdge("deck_words"))
+}
+
+// WhereHasDeckWordsWith applies a predicate to check if query has an edge deck_words with a given conditions (other predicates).
+func (f *DeckFilter) WhereHasDeckWordsWith(preds ...predicate.DeckWord) {
+	f.Where(entql.HasEdgeWith("deck_words", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasQuizzes applies a predicate to check if query has an edge quizzes.
+func (f *DeckFilter) WhereHasQuizzes() {
+	f.Where(entql.HasEdge("quizzes"))
+}
+
+// WhereHasQuizzesWith applies a predicate to check if query has an edge quizzes with a given conditions (other predicates).
+func (f *DeckFilter) WhereHasQuizzesWith(preds ...predicate.Quiz) {
+	f.Where(entql.HasEdgeWith("quizzes", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (dwq *DeckWordQuery) addPredicate(pred func(s *sql.Selector)) {
+	dwq.predicates = append(dwq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DeckWordQuery builder.
+func (dwq *DeckWordQuery) Filter() *DeckWordFilter {
+	return &DeckWordFilter{config: dwq.config, predicateAdder: dwq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DeckWordMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DeckWordMutation builder.
+func (m *DeckWordMutation) Filter() *DeckWordFilter {
+	return &DeckWordFilter{config: m.config, predicateAdder: m}
+}
+
+// DeckWordFilter provides a generic filtering capability at runtime for DeckWordQuery.
+type DeckWordFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DeckWordFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DeckWordFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(deckword.FieldID))
+}
+
+// WhereDeckID applies the entql int predicate on the deck_id field.
+func (f *DeckWordFilter) WhereDeckID(p entql.IntP) {
+	f.Where(p.Field(deckword.FieldDeckID))
+}
+
+// WhereWordID applies the entql int predicate on the word_id field.
+func (f *DeckWordFilter) WhereWordID(p entql.IntP) {
+	f.Where(p.Field(deckword.FieldWordID))
+}
+
+// WherePosition applies the entql int predicate on the position field.
+func (f *DeckWordFilter) WherePosition(p entql.IntP) {
+	f.Where(p.Field(deckword.FieldPosition))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *DeckWordFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(deckword.FieldCreatedAt))
+}
+
+// WhereHasDeck applies a predicate to check if query has an edge deck.
+func (f *DeckWordFilter) WhereHasDeck() {
+	f.Where(entql.HasEdge("deck"))
+}
+
+// WhereHasDeckWith applies a predicate to check if query has an edge deck with a given conditions (other predicates).
+func (f *DeckWordFilter) WhereHasDeckWith(preds ...predicate.Deck) {
+	f.Where(entql.HasEdgeWith("deck", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWord applies a predicate to check if query has an edge word.
+func (f *DeckWordFilter) WhereHasWord() {
+	f.Where(entql.HasEdge("word"))
+}
+
+// WhereHasWordWith applies a predicate to check if query has an edge word with a given conditions (other predicates).
+func (f *DeckWordFilter) WhereHasWordWith(preds ...predicate.Word) {
+	f.Where(entql.HasEdgeWith("word", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (difq *DictImportFailureQuery) addPredicate(pred func(s *sql.Selector)) {
+	difq.predicates = append(difq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DictImportFailureQuery builder.
+func (difq *DictImportFailureQuery) Filter() *DictImportFailureFilter {
+	return &DictImportFailureFilter{config: difq.config, predicateAdder: difq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DictImportFailureMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DictImportFailureMutation builder.
+func (m *DictImportFailureMutation) Filter() *DictImportFailureFilter {
+	return &DictImportFailureFilter{config: m.config, predicateAdder: m}
+}
+
+// DictImportFailureFilter provides a generic filtering capability at runtime for DictImportFailureQuery.
+type DictImportFailureFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DictImportFailureFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DictImportFailureFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(dictimportfailure.FieldID))
+}
+
+// WhereRunID applies the entql int predicate on the run_id field.
+func (f *DictImportFailureFilter) WhereRunID(p entql.IntP) {
+	f.Where(p.Field(dictimportfailure.FieldRunID))
+}
+
+// WhereEntryID applies the entql string predicate on the entry_id field.
+func (f *DictImportFailureFilter) WhereEntryID(p entql.StringP) {
+	f.Where(p.Field(dictimportfailure.FieldEntryID))
+}
+
+// WhereEntryIndex applies the entql int predicate on the entry_index field.
+func (f *DictImportFailureFilter) WhereEntryIndex(p entql.IntP) {
+	f.Where(p.Field(dictimportfailure.FieldEntryIndex))
+}
+
+// WhereMessage applies the entql string predicate on the message field.
+func (f *DictImportFailureFilter) WhereMessage(p entql.StringP) {
+	f.Where(p.Field(dictimportfailure.FieldMessage))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *DictImportFailureFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(dictimportfailure.FieldCreatedAt))
+}
+
+// WhereResolvedAt applies the entql time.Time predicate on the resolved_at field.
+func (f *DictImportFailureFilter) WhereResolvedAt(p entql.TimeP) {
+	f.Where(p.Field(dictimportfailure.FieldResolvedAt))
+}
+
+// WhereHasRun applies a predicate to check if query has an edge run.
+func (f *DictImportFailureFilter) WhereHasRun() {
+	f.Where(entql.HasEdge("run"))
+}
+
+// WhereHasRunWith applies a predicate to check if query has an edge run with a given conditions (other predicates).
+func (f *DictImportFailureFilter) WhereHasRunWith(preds ...predicate.DictImportRun) {
+	f.Where(entql.HasEdgeWith("run", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (dirq *DictImportRunQuery) addPredicate(pred func(s *sql.Selector)) {
+	dirq.predicates = append(dirq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DictImportRunQuery builder.
+func (dirq *DictImportRunQuery) Filter() *DictImportRunFilter {
+	return &DictImportRunFilter{config: dirq.config, predicateAdder: dirq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DictImportRunMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DictImportRunMutation builder.
+func (m *DictImportRunMutation) Filter() *DictImportRunFilter {
+	return &DictImportRunFilter{config: m.config, predicateAdder: m}
+}
+
+// DictImportRunFilter provides a generic filtering capability at runtime for DictImportRunQuery.
+type DictImportRunFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DictImportRunFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DictImportRunFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(dictimportrun.FieldID))
+}
+
+// WhereSourcePath applies the entql string predicate on the source_path field.
+func (f *DictImportRunFilter) WhereSourcePath(p entql.StringP) {
+	f.Where(p.Field(dictimportrun.FieldSourcePath))
+}
+
+// WhereFormat applies the entql string predicate on the format field.
+func (f *DictImportRunFilter) WhereFormat(p entql.StringP) {
+	f.Where(p.Field(dictimportrun.FieldFormat))
+}
+
+// WhereSourceHash applies the entql string predicate on the source_hash field.
+func (f *DictImportRunFilter) WhereSourceHash(p entql.StringP) {
+	f.Where(p.Field(dictimportrun.FieldSourceHash))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *DictImportRunFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(dictimportrun.FieldStatus))
+}
+
+// WhereLastCommittedIndex applies the entql int predicate on the last_committed_index field.
+func (f *DictImportRunFilter) WhereLastCommittedIndex(p entql.IntP) {
+	f.Where(p.Field(dictimportrun.FieldLastCommittedIndex))
+}
+
+// WhereErrorMessage applies the entql string predicate on the error_message field.
+func (f *DictImportRunFilter) WhereErrorMessage(p entql.StringP) {
+	f.Where(p.Field(dictimportrun.FieldErrorMessage))
+}
+
+// WhereStartedAt applies the entql time.Time predicate on the started_at field.
+func (f *DictImportRunFilter) WhereStartedAt(p entql.TimeP) {
+	f.Where(p.Field(dictimportrun.FieldStartedAt))
+}
+
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *DictImportRunFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(dictimportrun.FieldFinishedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *DictImportRunFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(dictimportrun.FieldUpdatedAt))
+}
+
+// WhereHasFailures applies a predicate to check if query has an edge failures.
+func (f *DictImportRunFilter) WhereHasFailures() {
+	f.Where(entql.HasEdge("failures"))
+}
+
+// WhereHasFailuresWith applies a predicate to check if query has an edge failures with a given conditions (other predicates).
+func (f *DictImportRunFilter) WhereHasFailuresWith(preds ...predicate.DictImportFailure) {
+	f.Where(entql.HasEdgeWith("failures", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (eaq *ExternalAuthQuery) addPredicate(pred func(s *sql.Selector)) {
+	eaq.predicates = append(eaq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the ExternalAuthQuery builder.
+func (eaq *ExternalAuthQuery) Filter() *ExternalAuthFilter {
+	return &ExternalAuthFilter{config: eaq.config, predicateAdder: eaq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *ExternalAuthMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the ExternalAuthMutation builder.
+func (m *ExternalAuthMutation) Filter() *ExternalAuthFilter {
+	return &ExternalAuthFilter{config: m.config, predicateAdder: m}
+}
+
+// ExternalAuthFilter provides a generic filtering capability at runtime for ExternalAuthQuery.
+type ExternalAuthFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *ExternalAuthFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *ExternalAuthFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(externalauth.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *ExternalAuthFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(externalauth.FieldUserID))
+}
+
+// WhereProvider applies the entql string predicate on the provider field.
+func (f *ExternalAuthFilter) WhereProvider(p entql.StringP) {
+	f.Where(p.Field(externalauth.FieldProvider))
+}
+
+// WhereProviderUserID applies the entql string predicate on the provider_user_id field.
+func (f *ExternalAuthFilter) WhereProviderUserID(p entql.StringP) {
+	f.Where(p.Field(externalauth.FieldProviderUserID))
+}
+
+// WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
+func (f *ExternalAuthFilter) WhereDeletedAt(p entql.TimeP) {
+	f.Where(p.Field(externalauth.FieldDeletedAt))
 }
 
 // WhereHasUser applies a predicate to check if query has an edge user.
@@ -676,7 +1480,7 @@ type JapaneseMeanFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *JapaneseMeanFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -692,6 +1496,11 @@ func (f *JapaneseMeanFilter) WhereName(p entql.StringP) {
 	f.Where(p.Field(japanesemean.FieldName))
 }
 
+// WhereReading applies the entql string predicate on the reading field.
+func (f *JapaneseMeanFilter) WhereReading(p entql.StringP) {
+	f.Where(p.Field(japanesemean.FieldReading))
+}
+
 // WhereWordInfoID applies the entql int predicate on the word_info_id field.
 func (f *JapaneseMeanFilter) WhereWordInfoID(p entql.IntP) {
 	f.Where(p.Field(japanesemean.FieldWordInfoID))
@@ -764,7 +1573,7 @@ type PartOfSpeechFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *PartOfSpeechFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -775,29 +1584,200 @@ func (f *PartOfSpeechFilter) WhereID(p entql.IntP) {
 	f.Where(p.Field(partofspeech.FieldID))
 }
 
-// WhereName applies the entql string predicate on the name field.
-func (f *PartOfSpeechFilter) WhereName(p entql.StringP) {
-	f.Where(p.Field(partofspeech.FieldName))
+// WhereName applies the entql string predicate on the name field.
+func (f *PartOfSpeechFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(partofspeech.FieldName))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PartOfSpeechFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(partofspeech.FieldCreatedAt))
+}
+
+// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
+func (f *PartOfSpeechFilter) WhereUpdatedAt(p entql.TimeP) {
+	f.Where(p.Field(partofspeech.FieldUpdatedAt))
+}
+
+// WhereHasWordInfos applies a predicate to check if query has an edge word_infos.
+func (f *PartOfSpeechFilter) WhereHasWordInfos() {
+	f.Where(entql.HasEdge("word_infos"))
+}
+
+// WhereHasWordInfosWith applies a predicate to check if query has an edge word_infos with a given conditions (other predicates).
+func (f *PartOfSpeechFilter) WhereHasWordInfosWith(preds ...predicate.WordInfo) {
+	f.Where(entql.HasEdgeWith("word_infos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPosMappings applies a predicate to check if query has an edge pos_mappings.
+func (f *PartOfSpeechFilter) WhereHasPosMappings() {
+	f.Where(entql.HasEdge("pos_mappings"))
+}
+
+// WhereHasPosMappingsWith applies a predicate to check if query has an edge pos_mappings with a given conditions (other predicates).
+func (f *PartOfSpeechFilter) WhereHasPosMappingsWith(preds ...predicate.PosMapping) {
+	f.Where(entql.HasEdgeWith("pos_mappings", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (pmq *PosMappingQuery) addPredicate(pred func(s *sql.Selector)) {
+	pmq.predicates = append(pmq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PosMappingQuery builder.
+func (pmq *PosMappingQuery) Filter() *PosMappingFilter {
+	return &PosMappingFilter{config: pmq.config, predicateAdder: pmq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PosMappingMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PosMappingMutation builder.
+func (m *PosMappingMutation) Filter() *PosMappingFilter {
+	return &PosMappingFilter{config: m.config, predicateAdder: m}
+}
+
+// PosMappingFilter provides a generic filtering capability at runtime for PosMappingQuery.
+type PosMappingFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PosMappingFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PosMappingFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(posmapping.FieldID))
+}
+
+// WhereVersionID applies the entql int predicate on the version_id field.
+func (f *PosMappingFilter) WhereVersionID(p entql.IntP) {
+	f.Where(p.Field(posmapping.FieldVersionID))
+}
+
+// WhereCode applies the entql string predicate on the code field.
+func (f *PosMappingFilter) WhereCode(p entql.StringP) {
+	f.Where(p.Field(posmapping.FieldCode))
+}
+
+// WherePartOfSpeechID applies the entql int predicate on the part_of_speech_id field.
+func (f *PosMappingFilter) WherePartOfSpeechID(p entql.IntP) {
+	f.Where(p.Field(posmapping.FieldPartOfSpeechID))
+}
+
+// WhereHasVersion applies a predicate to check if query has an edge version.
+func (f *PosMappingFilter) WhereHasVersion() {
+	f.Where(entql.HasEdge("version"))
+}
+
+// WhereHasVersionWith applies a predicate to check if query has an edge version with a given conditions (other predicates).
+func (f *PosMappingFilter) WhereHasVersionWith(preds ...predicate.PosMappingVersion) {
+	f.Where(entql.HasEdgeWith("version", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasPartOfSpeech applies a predicate to check if query has an edge part_of_speech.
+func (f *PosMappingFilter) WhereHasPartOfSpeech() {
+	f.Where(entql.HasEdge("part_of_speech"))
+}
+
+// WhereHasPartOfSpeechWith applies a predicate to check if query has an edge part_of_speech with a given conditions (other predicates).
+func (f *PosMappingFilter) WhereHasPartOfSpeechWith(preds ...predicate.PartOfSpeech) {
+	f.Where(entql.HasEdgeWith("part_of_speech", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (pmvq *PosMappingVersionQuery) addPredicate(pred func(s *sql.Selector)) {
+	pmvq.predicates = append(pmvq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the PosMappingVersionQuery builder.
+func (pmvq *PosMappingVersionQuery) Filter() *PosMappingVersionFilter {
+	return &PosMappingVersionFilter{config: pmvq.config, predicateAdder: pmvq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *PosMappingVersionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the PosMappingVersionMutation builder.
+func (m *PosMappingVersionMutation) Filter() *PosMappingVersionFilter {
+	return &PosMappingVersionFilter{config: m.config, predicateAdder: m}
+}
+
+// PosMappingVersionFilter provides a generic filtering capability at runtime for PosMappingVersionQuery.
+type PosMappingVersionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *PosMappingVersionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *PosMappingVersionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(posmappingversion.FieldID))
+}
+
+// WhereFallbackPosID applies the entql int predicate on the fallback_pos_id field.
+func (f *PosMappingVersionFilter) WhereFallbackPosID(p entql.IntP) {
+	f.Where(p.Field(posmappingversion.FieldFallbackPosID))
+}
+
+// WhereNote applies the entql string predicate on the note field.
+func (f *PosMappingVersionFilter) WhereNote(p entql.StringP) {
+	f.Where(p.Field(posmappingversion.FieldNote))
 }
 
-// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
-func (f *PartOfSpeechFilter) WhereCreatedAt(p entql.TimeP) {
-	f.Where(p.Field(partofspeech.FieldCreatedAt))
+// WhereCreatedBy applies the entql int predicate on the created_by field.
+func (f *PosMappingVersionFilter) WhereCreatedBy(p entql.IntP) {
+	f.Where(p.Field(posmappingversion.FieldCreatedBy))
 }
 
-// WhereUpdatedAt applies the entql time.Time predicate on the updated_at field.
-func (f *PartOfSpeechFilter) WhereUpdatedAt(p entql.TimeP) {
-	f.Where(p.Field(partofspeech.FieldUpdatedAt))
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *PosMappingVersionFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(posmappingversion.FieldCreatedAt))
 }
 
-// WhereHasWordInfos applies a predicate to check if query has an edge word_infos.
-func (f *PartOfSpeechFilter) WhereHasWordInfos() {
-	f.Where(entql.HasEdge("word_infos"))
+// WhereHasMappings applies a predicate to check if query has an edge mappings.
+func (f *PosMappingVersionFilter) WhereHasMappings() {
+	f.Where(entql.HasEdge("mappings"))
 }
 
-// WhereHasWordInfosWith applies a predicate to check if query has an edge word_infos with a given conditions (other predicates).
-func (f *PartOfSpeechFilter) WhereHasWordInfosWith(preds ...predicate.WordInfo) {
-	f.Where(entql.HasEdgeWith("word_infos", sqlgraph.WrapFunc(func(s *sql.Selector) {
+// WhereHasMappingsWith applies a predicate to check if query has an edge mappings with a given conditions (other predicates).
+func (f *PosMappingVersionFilter) WhereHasMappingsWith(preds ...predicate.PosMapping) {
+	f.Where(entql.HasEdgeWith("mappings", sqlgraph.WrapFunc(func(s *sql.Selector) {
 		for _, p := range preds {
 			p(s)
 		}
@@ -833,7 +1813,7 @@ type QuizFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *QuizFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -909,6 +1889,56 @@ func (f *QuizFilter) WhereChoicesPosIds(p entql.BytesP) {
 	f.Where(p.Field(quiz.FieldChoicesPosIds))
 }
 
+// WhereMode applies the entql string predicate on the mode field.
+func (f *QuizFilter) WhereMode(p entql.StringP) {
+	f.Where(p.Field(quiz.FieldMode))
+}
+
+// WhereDirection applies the entql string predicate on the direction field.
+func (f *QuizFilter) WhereDirection(p entql.StringP) {
+	f.Where(p.Field(quiz.FieldDirection))
+}
+
+// WhereQuestionType applies the entql string predicate on the question_type field.
+func (f *QuizFilter) WhereQuestionType(p entql.StringP) {
+	f.Where(p.Field(quiz.FieldQuestionType))
+}
+
+// WhereDifficulty applies the entql string predicate on the difficulty field.
+func (f *QuizFilter) WhereDifficulty(p entql.StringP) {
+	f.Where(p.Field(quiz.FieldDifficulty))
+}
+
+// WhereQuestionTimeLimitSec applies the entql int predicate on the question_time_limit_sec field.
+func (f *QuizFilter) WhereQuestionTimeLimitSec(p entql.IntP) {
+	f.Where(p.Field(quiz.FieldQuestionTimeLimitSec))
+}
+
+// WhereTimeLimitSec applies the entql int predicate on the time_limit_sec field.
+func (f *QuizFilter) WhereTimeLimitSec(p entql.IntP) {
+	f.Where(p.Field(quiz.FieldTimeLimitSec))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *QuizFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(quiz.FieldExpiresAt))
+}
+
+// WhereFinishStatus applies the entql string predicate on the finish_status field.
+func (f *QuizFilter) WhereFinishStatus(p entql.StringP) {
+	f.Where(p.Field(quiz.FieldFinishStatus))
+}
+
+// WhereFinishedAt applies the entql time.Time predicate on the finished_at field.
+func (f *QuizFilter) WhereFinishedAt(p entql.TimeP) {
+	f.Where(p.Field(quiz.FieldFinishedAt))
+}
+
+// WhereDeckID applies the entql int predicate on the deck_id field.
+func (f *QuizFilter) WhereDeckID(p entql.IntP) {
+	f.Where(p.Field(quiz.FieldDeckID))
+}
+
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
 func (f *QuizFilter) WhereCreatedAt(p entql.TimeP) {
 	f.Where(p.Field(quiz.FieldCreatedAt))
@@ -947,6 +1977,20 @@ func (f *QuizFilter) WhereHasQuizQuestionsWith(preds ...predicate.QuizQuestion)
 	})))
 }
 
+// WhereHasDeck applies a predicate to check if query has an edge deck.
+func (f *QuizFilter) WhereHasDeck() {
+	f.Where(entql.HasEdge("deck"))
+}
+
+// WhereHasDeckWith applies a predicate to check if query has an edge deck with a given conditions (other predicates).
+func (f *QuizFilter) WhereHasDeckWith(preds ...predicate.Deck) {
+	f.Where(entql.HasEdgeWith("deck", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (qqq *QuizQuestionQuery) addPredicate(pred func(s *sql.Selector)) {
 	qqq.predicates = append(qqq.predicates, pred)
@@ -976,7 +2020,7 @@ type QuizQuestionFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *QuizQuestionFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1017,26 +2061,76 @@ func (f *QuizQuestionFilter) WhereCorrectJpmID(p entql.IntP) {
 	f.Where(p.Field(quizquestion.FieldCorrectJpmID))
 }
 
+// WhereDirection applies the entql string predicate on the direction field.
+func (f *QuizQuestionFilter) WhereDirection(p entql.StringP) {
+	f.Where(p.Field(quizquestion.FieldDirection))
+}
+
+// WhereQuestionType applies the entql string predicate on the question_type field.
+func (f *QuizQuestionFilter) WhereQuestionType(p entql.StringP) {
+	f.Where(p.Field(quizquestion.FieldQuestionType))
+}
+
+// WhereMeaningName applies the entql string predicate on the meaning_name field.
+func (f *QuizQuestionFilter) WhereMeaningName(p entql.StringP) {
+	f.Where(p.Field(quizquestion.FieldMeaningName))
+}
+
 // WhereChoicesJpms applies the entql json.RawMessage predicate on the choices_jpms field.
 func (f *QuizQuestionFilter) WhereChoicesJpms(p entql.BytesP) {
 	f.Where(p.Field(quizquestion.FieldChoicesJpms))
 }
 
+// WhereChoicesWords applies the entql json.RawMessage predicate on the choices_words field.
+func (f *QuizQuestionFilter) WhereChoicesWords(p entql.BytesP) {
+	f.Where(p.Field(quizquestion.FieldChoicesWords))
+}
+
 // WhereAnswerJpmID applies the entql int predicate on the answer_jpm_id field.
 func (f *QuizQuestionFilter) WhereAnswerJpmID(p entql.IntP) {
 	f.Where(p.Field(quizquestion.FieldAnswerJpmID))
 }
 
+// WhereAnswerWordID applies the entql int predicate on the answer_word_id field.
+func (f *QuizQuestionFilter) WhereAnswerWordID(p entql.IntP) {
+	f.Where(p.Field(quizquestion.FieldAnswerWordID))
+}
+
+// WhereTypedAnswer applies the entql string predicate on the typed_answer field.
+func (f *QuizQuestionFilter) WhereTypedAnswer(p entql.StringP) {
+	f.Where(p.Field(quizquestion.FieldTypedAnswer))
+}
+
+// WhereGrade applies the entql string predicate on the grade field.
+func (f *QuizQuestionFilter) WhereGrade(p entql.StringP) {
+	f.Where(p.Field(quizquestion.FieldGrade))
+}
+
+// WhereCredit applies the entql float64 predicate on the credit field.
+func (f *QuizQuestionFilter) WhereCredit(p entql.Float64P) {
+	f.Where(p.Field(quizquestion.FieldCredit))
+}
+
 // WhereIsCorrect applies the entql bool predicate on the is_correct field.
 func (f *QuizQuestionFilter) WhereIsCorrect(p entql.BoolP) {
 	f.Where(p.Field(quizquestion.FieldIsCorrect))
 }
 
+// WhereServedAt applies the entql time.Time predicate on the served_at field.
+func (f *QuizQuestionFilter) WhereServedAt(p entql.TimeP) {
+	f.Where(p.Field(quizquestion.FieldServedAt))
+}
+
 // WhereAnsweredAt applies the entql time.Time predicate on the answered_at field.
 func (f *QuizQuestionFilter) WhereAnsweredAt(p entql.TimeP) {
 	f.Where(p.Field(quizquestion.FieldAnsweredAt))
 }
 
+// WhereIdempotencyKey applies the entql string predicate on the idempotency_key field.
+func (f *QuizQuestionFilter) WhereIdempotencyKey(p entql.StringP) {
+	f.Where(p.Field(quizquestion.FieldIdempotencyKey))
+}
+
 // WhereTimeMs applies the entql int predicate on the time_ms field.
 func (f *QuizQuestionFilter) WhereTimeMs(p entql.IntP) {
 	f.Where(p.Field(quizquestion.FieldTimeMs))
@@ -1094,6 +2188,95 @@ func (f *QuizQuestionFilter) WhereHasJapaneseMeanWith(preds ...predicate.Japanes
 	})))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (rtq *RefreshTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	rtq.predicates = append(rtq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RefreshTokenQuery builder.
+func (rtq *RefreshTokenQuery) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{config: rtq.config, predicateAdder: rtq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RefreshTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Filter() *RefreshTokenFilter {
+	return &RefreshTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// RefreshTokenFilter provides a generic filtering capability at runtime for RefreshTokenQuery.
+type RefreshTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RefreshTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RefreshTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(refreshtoken.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *RefreshTokenFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(refreshtoken.FieldUserID))
+}
+
+// WhereFamilyID applies the entql string predicate on the family_id field.
+func (f *RefreshTokenFilter) WhereFamilyID(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldFamilyID))
+}
+
+// WhereTokenHash applies the entql string predicate on the token_hash field.
+func (f *RefreshTokenFilter) WhereTokenHash(p entql.StringP) {
+	f.Where(p.Field(refreshtoken.FieldTokenHash))
+}
+
+// WhereExpiresAt applies the entql time.Time predicate on the expires_at field.
+func (f *RefreshTokenFilter) WhereExpiresAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldExpiresAt))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *RefreshTokenFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldCreatedAt))
+}
+
+// WhereUsedAt applies the entql time.Time predicate on the used_at field.
+func (f *RefreshTokenFilter) WhereUsedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldUsedAt))
+}
+
+// WhereRevokedAt applies the entql time.Time predicate on the revoked_at field.
+func (f *RefreshTokenFilter) WhereRevokedAt(p entql.TimeP) {
+	f.Where(p.Field(refreshtoken.FieldRevokedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *RefreshTokenFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *RefreshTokenFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (rwq *RegisteredWordQuery) addPredicate(pred func(s *sql.Selector)) {
 	rwq.predicates = append(rwq.predicates, pred)
@@ -1123,7 +2306,7 @@ type RegisteredWordFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RegisteredWordFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[12].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1169,6 +2352,46 @@ func (f *RegisteredWordFilter) WhereCorrectRate(p entql.IntP) {
 	f.Where(p.Field(registeredword.FieldCorrectRate))
 }
 
+// WhereEaseFactor applies the entql float64 predicate on the ease_factor field.
+func (f *RegisteredWordFilter) WhereEaseFactor(p entql.Float64P) {
+	f.Where(p.Field(registeredword.FieldEaseFactor))
+}
+
+// WhereIntervalDays applies the entql int predicate on the interval_days field.
+func (f *RegisteredWordFilter) WhereIntervalDays(p entql.IntP) {
+	f.Where(p.Field(registeredword.FieldIntervalDays))
+}
+
+// WhereRepetitions applies the entql int predicate on the repetitions field.
+func (f *RegisteredWordFilter) WhereRepetitions(p entql.IntP) {
+	f.Where(p.Field(registeredword.FieldRepetitions))
+}
+
+// WhereLapses applies the entql int predicate on the lapses field.
+func (f *RegisteredWordFilter) WhereLapses(p entql.IntP) {
+	f.Where(p.Field(registeredword.FieldLapses))
+}
+
+// WhereStability applies the entql float64 predicate on the stability field.
+func (f *RegisteredWordFilter) WhereStability(p entql.Float64P) {
+	f.Where(p.Field(registeredword.FieldStability))
+}
+
+// WhereDifficulty applies the entql float64 predicate on the difficulty field.
+func (f *RegisteredWordFilter) WhereDifficulty(p entql.Float64P) {
+	f.Where(p.Field(registeredword.FieldDifficulty))
+}
+
+// WhereDueAt applies the entql time.Time predicate on the due_at field.
+func (f *RegisteredWordFilter) WhereDueAt(p entql.TimeP) {
+	f.Where(p.Field(registeredword.FieldDueAt))
+}
+
+// WhereLastReviewedAt applies the entql time.Time predicate on the last_reviewed_at field.
+func (f *RegisteredWordFilter) WhereLastReviewedAt(p entql.TimeP) {
+	f.Where(p.Field(registeredword.FieldLastReviewedAt))
+}
+
 // WhereMemo applies the entql string predicate on the memo field.
 func (f *RegisteredWordFilter) WhereMemo(p entql.StringP) {
 	f.Where(p.Field(registeredword.FieldMemo))
@@ -1255,7 +2478,7 @@ type RootConfigFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *RootConfigFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[13].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1320,7 +2543,7 @@ type UserFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[7].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[14].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1376,6 +2599,16 @@ func (f *UserFilter) WhereIsTest(p entql.BoolP) {
 	f.Where(p.Field(user.FieldIsTest))
 }
 
+// WhereEmailVerifiedAt applies the entql time.Time predicate on the email_verified_at field.
+func (f *UserFilter) WhereEmailVerifiedAt(p entql.TimeP) {
+	f.Where(p.Field(user.FieldEmailVerifiedAt))
+}
+
+// WhereTokenVersion applies the entql int predicate on the token_version field.
+func (f *UserFilter) WhereTokenVersion(p entql.IntP) {
+	f.Where(p.Field(user.FieldTokenVersion))
+}
+
 // WhereHasRegisteredWords applies a predicate to check if query has an edge registered_words.
 func (f *UserFilter) WhereHasRegisteredWords() {
 	f.Where(entql.HasEdge("registered_words"))
@@ -1446,6 +2679,48 @@ func (f *UserFilter) WhereHasUserDailyUsageWith(preds ...predicate.UserDailyUsag
 	})))
 }
 
+// WhereHasDecks applies a predicate to check if query has an edge decks.
+func (f *UserFilter) WhereHasDecks() {
+	f.Where(entql.HasEdge("decks"))
+}
+
+// WhereHasDecksWith applies a predicate to check if query has an edge decks with a given conditions (other predicates).
+func (f *UserFilter) WhereHasDecksWith(preds ...predicate.Deck) {
+	f.Where(entql.HasEdgeWith("decks", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasWordProposals applies a predicate to check if query has an edge word_proposals.
+func (f *UserFilter) WhereHasWordProposals() {
+	f.Where(entql.HasEdge("word_proposals"))
+}
+
+// WhereHasWordProposalsWith applies a predicate to check if query has an edge word_proposals with a given conditions (other predicates).
+func (f *UserFilter) WhereHasWordProposalsWith(preds ...predicate.WordProposal) {
+	f.Where(entql.HasEdgeWith("word_proposals", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
+// WhereHasRefreshTokens applies a predicate to check if query has an edge refresh_tokens.
+func (f *UserFilter) WhereHasRefreshTokens() {
+	f.Where(entql.HasEdge("refresh_tokens"))
+}
+
+// WhereHasRefreshTokensWith applies a predicate to check if query has an edge refresh_tokens with a given conditions (other predicates).
+func (f *UserFilter) WhereHasRefreshTokensWith(preds ...predicate.RefreshToken) {
+	f.Where(entql.HasEdgeWith("refresh_tokens", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (ucq *UserConfigQuery) addPredicate(pred func(s *sql.Selector)) {
 	ucq.predicates = append(ucq.predicates, pred)
@@ -1475,7 +2750,7 @@ type UserConfigFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserConfigFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[8].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[15].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1496,6 +2771,11 @@ func (f *UserConfigFilter) WhereIsDarkMode(p entql.BoolP) {
 	f.Where(p.Field(userconfig.FieldIsDarkMode))
 }
 
+// WhereSrsAlgorithm applies the entql string predicate on the srs_algorithm field.
+func (f *UserConfigFilter) WhereSrsAlgorithm(p entql.StringP) {
+	f.Where(p.Field(userconfig.FieldSrsAlgorithm))
+}
+
 // WhereDeletedAt applies the entql time.Time predicate on the deleted_at field.
 func (f *UserConfigFilter) WhereDeletedAt(p entql.TimeP) {
 	f.Where(p.Field(userconfig.FieldDeletedAt))
@@ -1544,7 +2824,7 @@ type UserDailyUsageFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *UserDailyUsageFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[9].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[16].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1623,7 +2903,7 @@ type WordFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *WordFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[10].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[17].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1659,6 +2939,11 @@ func (f *WordFilter) WhereRegistrationCount(p entql.IntP) {
 	f.Where(p.Field(word.FieldRegistrationCount))
 }
 
+// WhereFrequencyRank applies the entql int predicate on the frequency_rank field.
+func (f *WordFilter) WhereFrequencyRank(p entql.IntP) {
+	f.Where(p.Field(word.FieldFrequencyRank))
+}
+
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
 func (f *WordFilter) WhereCreatedAt(p entql.TimeP) {
 	f.Where(p.Field(word.FieldCreatedAt))
@@ -1711,6 +2996,20 @@ func (f *WordFilter) WhereHasQuizQuestionsWith(preds ...predicate.QuizQuestion)
 	})))
 }
 
+// WhereHasDeckWords applies a predicate to check if query has an edge deck_words.
+func (f *WordFilter) WhereHasDeckWords() {
+	f.Where(entql.HasEdge("deck_words"))
+}
+
+// WhereHasDeckWordsWith applies a predicate to check if query has an edge deck_words with a given conditions (other predicates).
+func (f *WordFilter) WhereHasDeckWordsWith(preds ...predicate.DeckWord) {
+	f.Where(entql.HasEdgeWith("deck_words", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (wiq *WordInfoQuery) addPredicate(pred func(s *sql.Selector)) {
 	wiq.predicates = append(wiq.predicates, pred)
@@ -1740,7 +3039,7 @@ type WordInfoFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *WordInfoFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[11].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[18].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -1761,6 +3060,31 @@ func (f *WordInfoFilter) WherePartOfSpeechID(p entql.IntP) {
 	f.Where(p.Field(wordinfo.FieldPartOfSpeechID))
 }
 
+// WherePosCodes applies the entql json.RawMessage predicate on the pos_codes field.
+func (f *WordInfoFilter) WherePosCodes(p entql.BytesP) {
+	f.Where(p.Field(wordinfo.FieldPosCodes))
+}
+
+// WhereFieldTags applies the entql json.RawMessage predicate on the field_tags field.
+func (f *WordInfoFilter) WhereFieldTags(p entql.BytesP) {
+	f.Where(p.Field(wordinfo.FieldFieldTags))
+}
+
+// WhereMiscTags applies the entql json.RawMessage predicate on the misc_tags field.
+func (f *WordInfoFilter) WhereMiscTags(p entql.BytesP) {
+	f.Where(p.Field(wordinfo.FieldMiscTags))
+}
+
+// WhereDialectTags applies the entql json.RawMessage predicate on the dialect_tags field.
+func (f *WordInfoFilter) WhereDialectTags(p entql.BytesP) {
+	f.Where(p.Field(wordinfo.FieldDialectTags))
+}
+
+// WhereExamples applies the entql json.RawMessage predicate on the examples field.
+func (f *WordInfoFilter) WhereExamples(p entql.BytesP) {
+	f.Where(p.Field(wordinfo.FieldExamples))
+}
+
 // WhereCreatedAt applies the entql time.Time predicate on the created_at field.
 func (f *WordInfoFilter) WhereCreatedAt(p entql.TimeP) {
 	f.Where(p.Field(wordinfo.FieldCreatedAt))
@@ -1812,3 +3136,107 @@ func (f *WordInfoFilter) WhereHasJapaneseMeansWith(preds ...predicate.JapaneseMe
 		}
 	})))
 }
+
+// addPredicate implements the predicateAdder interface.
+func (wpq *WordProposalQuery) addPredicate(pred func(s *sql.Selector)) {
+	wpq.predicates = append(wpq.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the WordProposalQuery builder.
+func (wpq *WordProposalQuery) Filter() *WordProposalFilter {
+	return &WordProposalFilter{config: wpq.config, predicateAdder: wpq}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *WordProposalMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the WordProposalMutation builder.
+func (m *WordProposalMutation) Filter() *WordProposalFilter {
+	return &WordProposalFilter{config: m.config, predicateAdder: m}
+}
+
+// WordProposalFilter provides a generic filtering capability at runtime for WordProposalQuery.
+type WordProposalFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *WordProposalFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[19].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *WordProposalFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(wordproposal.FieldID))
+}
+
+// WhereUserID applies the entql int predicate on the user_id field.
+func (f *WordProposalFilter) WhereUserID(p entql.IntP) {
+	f.Where(p.Field(wordproposal.FieldUserID))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *WordProposalFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(wordproposal.FieldAction))
+}
+
+// WhereWordID applies the entql int predicate on the word_id field.
+func (f *WordProposalFilter) WhereWordID(p entql.IntP) {
+	f.Where(p.Field(wordproposal.FieldWordID))
+}
+
+// WhereWordName applies the entql string predicate on the word_name field.
+func (f *WordProposalFilter) WhereWordName(p entql.StringP) {
+	f.Where(p.Field(wordproposal.FieldWordName))
+}
+
+// WherePayload applies the entql string predicate on the payload field.
+func (f *WordProposalFilter) WherePayload(p entql.StringP) {
+	f.Where(p.Field(wordproposal.FieldPayload))
+}
+
+// WhereStatus applies the entql string predicate on the status field.
+func (f *WordProposalFilter) WhereStatus(p entql.StringP) {
+	f.Where(p.Field(wordproposal.FieldStatus))
+}
+
+// WhereReviewerID applies the entql int predicate on the reviewer_id field.
+func (f *WordProposalFilter) WhereReviewerID(p entql.IntP) {
+	f.Where(p.Field(wordproposal.FieldReviewerID))
+}
+
+// WhereReviewNote applies the entql string predicate on the review_note field.
+func (f *WordProposalFilter) WhereReviewNote(p entql.StringP) {
+	f.Where(p.Field(wordproposal.FieldReviewNote))
+}
+
+// WhereCreatedAt applies the entql time.Time predicate on the created_at field.
+func (f *WordProposalFilter) WhereCreatedAt(p entql.TimeP) {
+	f.Where(p.Field(wordproposal.FieldCreatedAt))
+}
+
+// WhereReviewedAt applies the entql time.Time predicate on the reviewed_at field.
+func (f *WordProposalFilter) WhereReviewedAt(p entql.TimeP) {
+	f.Where(p.Field(wordproposal.FieldReviewedAt))
+}
+
+// WhereHasUser applies a predicate to check if query has an edge user.
+func (f *WordProposalFilter) WhereHasUser() {
+	f.Where(entql.HasEdge("user"))
+}
+
+// WhereHasUserWith applies a predicate to check if query has an edge user with a given conditions (other predicates).
+func (f *WordProposalFilter) WhereHasUserWith(preds ...predicate.User) {
+	f.Where(entql.HasEdgeWith("user", sqlgraph.WrapFunc(func(s *sql.Selector) {
+		for _, p := range preds {
+			p(s)
+		}
+	})))
+}
@@ -8,6 +8,54 @@ import (
 	"word_app/backend/ent"
 )
 
+// The DeckFunc type is an adapter to allow the use of ordinary
+// function as Deck mutator.
+type DeckFunc func(context.Context, *ent.DeckMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeckFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeckMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeckMutation", m)
+}
+
+// The DeckWordFunc type is an adapter to allow the use of ordinary
+// function as DeckWord mutator.
+type DeckWordFunc func(context.Context, *ent.DeckWordMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DeckWordFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DeckWordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DeckWordMutation", m)
+}
+
+// The DictImportFailureFunc type is an adapter to allow the use of ordinary
+// function as DictImportFailure mutator.
+type DictImportFailureFunc func(context.Context, *ent.DictImportFailureMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DictImportFailureFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DictImportFailureMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DictImportFailureMutation", m)
+}
+
+// The DictImportRunFunc type is an adapter to allow the use of ordinary
+// function as DictImportRun mutator.
+type DictImportRunFunc func(context.Context, *ent.DictImportRunMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f DictImportRunFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.DictImportRunMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.DictImportRunMutation", m)
+}
+
 // The ExternalAuthFunc type is an adapter to allow the use of ordinary
 // function as ExternalAuth mutator.
 type ExternalAuthFunc func(context.Context, *ent.ExternalAuthMutation) (ent.Value, error)
@@ -44,6 +92,30 @@ func (f PartOfSpeechFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PartOfSpeechMutation", m)
 }
 
+// The PosMappingFunc type is an adapter to allow the use of ordinary
+// function as PosMapping mutator.
+type PosMappingFunc func(context.Context, *ent.PosMappingMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PosMappingFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PosMappingMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PosMappingMutation", m)
+}
+
+// The PosMappingVersionFunc type is an adapter to allow the use of ordinary
+// function as PosMappingVersion mutator.
+type PosMappingVersionFunc func(context.Context, *ent.PosMappingVersionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PosMappingVersionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PosMappingVersionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PosMappingVersionMutation", m)
+}
+
 // The QuizFunc type is an adapter to allow the use of ordinary
 // function as Quiz mutator.
 type QuizFunc func(context.Context, *ent.QuizMutation) (ent.Value, error)
@@ -68,6 +140,18 @@ func (f QuizQuestionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.QuizQuestionMutation", m)
 }
 
+// The RefreshTokenFunc type is an adapter to allow the use of ordinary
+// function as RefreshToken mutator.
+type RefreshTokenFunc func(context.Context, *ent.RefreshTokenMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RefreshTokenFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RefreshTokenMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RefreshTokenMutation", m)
+}
+
 // The RegisteredWordFunc type is an adapter to allow the use of ordinary
 // function as RegisteredWord mutator.
 type RegisteredWordFunc func(context.Context, *ent.RegisteredWordMutation) (ent.Value, error)
@@ -152,6 +236,18 @@ func (f WordInfoFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, er
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WordInfoMutation", m)
 }
 
+// The WordProposalFunc type is an adapter to allow the use of ordinary
+// function as WordProposal mutator.
+type WordProposalFunc func(context.Context, *ent.WordProposalMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WordProposalFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WordProposalMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WordProposalMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
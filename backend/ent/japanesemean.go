@@ -20,6 +20,8 @@ type JapaneseMean struct {
 	ID int `json:"id,omitempty"`
 	// Name holds the value of the "name" field.
 	Name string `json:"name,omitempty"`
+	// name が漢字表記のときの読み（かな）
+	Reading *string `json:"reading,omitempty"`
 	// WordInfoID holds the value of the "word_info_id" field.
 	WordInfoID int `json:"word_info_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -70,7 +72,7 @@ func (*JapaneseMean) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case japanesemean.FieldID, japanesemean.FieldWordInfoID:
 			values[i] = new(sql.NullInt64)
-		case japanesemean.FieldName:
+		case japanesemean.FieldName, japanesemean.FieldReading:
 			values[i] = new(sql.NullString)
 		case japanesemean.FieldCreatedAt, japanesemean.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -101,6 +103,13 @@ func (jm *JapaneseMean) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				jm.Name = value.String
 			}
+		case japanesemean.FieldReading:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reading", values[i])
+			} else if value.Valid {
+				jm.Reading = new(string)
+				*jm.Reading = value.String
+			}
 		case japanesemean.FieldWordInfoID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field word_info_id", values[i])
@@ -168,6 +177,11 @@ func (jm *JapaneseMean) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(jm.Name)
 	builder.WriteString(", ")
+	if v := jm.Reading; v != nil {
+		builder.WriteString("reading=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	builder.WriteString("word_info_id=")
 	builder.WriteString(fmt.Sprintf("%v", jm.WordInfoID))
 	builder.WriteString(", ")
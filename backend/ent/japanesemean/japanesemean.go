@@ -16,6 +16,8 @@ const (
 	FieldID = "id"
 	// FieldName holds the string denoting the name field in the database.
 	FieldName = "name"
+	// FieldReading holds the string denoting the reading field in the database.
+	FieldReading = "reading"
 	// FieldWordInfoID holds the string denoting the word_info_id field in the database.
 	FieldWordInfoID = "word_info_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -48,6 +50,7 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldName,
+	FieldReading,
 	FieldWordInfoID,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -89,6 +92,11 @@ func ByName(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldName, opts...).ToFunc()
 }
 
+// ByReading orders the results by the reading field.
+func ByReading(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReading, opts...).ToFunc()
+}
+
 // ByWordInfoID orders the results by the word_info_id field.
 func ByWordInfoID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldWordInfoID, opts...).ToFunc()
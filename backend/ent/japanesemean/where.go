@@ -60,6 +60,11 @@ func Name(v string) predicate.JapaneseMean {
 	return predicate.JapaneseMean(sql.FieldEQ(FieldName, v))
 }
 
+// Reading applies equality check predicate on the "reading" field. It's identical to ReadingEQ.
+func Reading(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldEQ(FieldReading, v))
+}
+
 // WordInfoID applies equality check predicate on the "word_info_id" field. It's identical to WordInfoIDEQ.
 func WordInfoID(v int) predicate.JapaneseMean {
 	return predicate.JapaneseMean(sql.FieldEQ(FieldWordInfoID, v))
@@ -140,6 +145,81 @@ func NameContainsFold(v string) predicate.JapaneseMean {
 	return predicate.JapaneseMean(sql.FieldContainsFold(FieldName, v))
 }
 
+// ReadingEQ applies the EQ predicate on the "reading" field.
+func ReadingEQ(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldEQ(FieldReading, v))
+}
+
+// ReadingNEQ applies the NEQ predicate on the "reading" field.
+func ReadingNEQ(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldNEQ(FieldReading, v))
+}
+
+// ReadingIn applies the In predicate on the "reading" field.
+func ReadingIn(vs ...string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldIn(FieldReading, vs...))
+}
+
+// ReadingNotIn applies the NotIn predicate on the "reading" field.
+func ReadingNotIn(vs ...string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldNotIn(FieldReading, vs...))
+}
+
+// ReadingGT applies the GT predicate on the "reading" field.
+func ReadingGT(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldGT(FieldReading, v))
+}
+
+// ReadingGTE applies the GTE predicate on the "reading" field.
+func ReadingGTE(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldGTE(FieldReading, v))
+}
+
+// ReadingLT applies the LT predicate on the "reading" field.
+func ReadingLT(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldLT(FieldReading, v))
+}
+
+// ReadingLTE applies the LTE predicate on the "reading" field.
+func ReadingLTE(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldLTE(FieldReading, v))
+}
+
+// ReadingContains applies the Contains predicate on the "reading" field.
+func ReadingContains(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldContains(FieldReading, v))
+}
+
+// ReadingHasPrefix applies the HasPrefix predicate on the "reading" field.
+func ReadingHasPrefix(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldHasPrefix(FieldReading, v))
+}
+
+// ReadingHasSuffix applies the HasSuffix predicate on the "reading" field.
+func ReadingHasSuffix(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldHasSuffix(FieldReading, v))
+}
+
+// ReadingIsNil applies the IsNil predicate on the "reading" field.
+func ReadingIsNil() predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldIsNull(FieldReading))
+}
+
+// ReadingNotNil applies the NotNil predicate on the "reading" field.
+func ReadingNotNil() predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldNotNull(FieldReading))
+}
+
+// ReadingEqualFold applies the EqualFold predicate on the "reading" field.
+func ReadingEqualFold(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldEqualFold(FieldReading, v))
+}
+
+// ReadingContainsFold applies the ContainsFold predicate on the "reading" field.
+func ReadingContainsFold(v string) predicate.JapaneseMean {
+	return predicate.JapaneseMean(sql.FieldContainsFold(FieldReading, v))
+}
+
 // WordInfoIDEQ applies the EQ predicate on the "word_info_id" field.
 func WordInfoIDEQ(v int) predicate.JapaneseMean {
 	return predicate.JapaneseMean(sql.FieldEQ(FieldWordInfoID, v))
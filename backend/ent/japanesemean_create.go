@@ -30,6 +30,20 @@ func (jmc *JapaneseMeanCreate) SetName(s string) *JapaneseMeanCreate {
 	return jmc
 }
 
+// SetReading sets the "reading" field.
+func (jmc *JapaneseMeanCreate) SetReading(s string) *JapaneseMeanCreate {
+	jmc.mutation.SetReading(s)
+	return jmc
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (jmc *JapaneseMeanCreate) SetNillableReading(s *string) *JapaneseMeanCreate {
+	if s != nil {
+		jmc.SetReading(*s)
+	}
+	return jmc
+}
+
 // SetWordInfoID sets the "word_info_id" field.
 func (jmc *JapaneseMeanCreate) SetWordInfoID(i int) *JapaneseMeanCreate {
 	jmc.mutation.SetWordInfoID(i)
@@ -187,6 +201,10 @@ func (jmc *JapaneseMeanCreate) createSpec() (*JapaneseMean, *sqlgraph.CreateSpec
 		_spec.SetField(japanesemean.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := jmc.mutation.Reading(); ok {
+		_spec.SetField(japanesemean.FieldReading, field.TypeString, value)
+		_node.Reading = &value
+	}
 	if value, ok := jmc.mutation.CreatedAt(); ok {
 		_spec.SetField(japanesemean.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -292,6 +310,24 @@ func (u *JapaneseMeanUpsert) UpdateName() *JapaneseMeanUpsert {
 	return u
 }
 
+// SetReading sets the "reading" field.
+func (u *JapaneseMeanUpsert) SetReading(v string) *JapaneseMeanUpsert {
+	u.Set(japanesemean.FieldReading, v)
+	return u
+}
+
+// UpdateReading sets the "reading" field to the value that was provided on create.
+func (u *JapaneseMeanUpsert) UpdateReading() *JapaneseMeanUpsert {
+	u.SetExcluded(japanesemean.FieldReading)
+	return u
+}
+
+// ClearReading clears the value of the "reading" field.
+func (u *JapaneseMeanUpsert) ClearReading() *JapaneseMeanUpsert {
+	u.SetNull(japanesemean.FieldReading)
+	return u
+}
+
 // SetWordInfoID sets the "word_info_id" field.
 func (u *JapaneseMeanUpsert) SetWordInfoID(v int) *JapaneseMeanUpsert {
 	u.Set(japanesemean.FieldWordInfoID, v)
@@ -382,6 +418,27 @@ func (u *JapaneseMeanUpsertOne) UpdateName() *JapaneseMeanUpsertOne {
 	})
 }
 
+// SetReading sets the "reading" field.
+func (u *JapaneseMeanUpsertOne) SetReading(v string) *JapaneseMeanUpsertOne {
+	return u.Update(func(s *JapaneseMeanUpsert) {
+		s.SetReading(v)
+	})
+}
+
+// UpdateReading sets the "reading" field to the value that was provided on create.
+func (u *JapaneseMeanUpsertOne) UpdateReading() *JapaneseMeanUpsertOne {
+	return u.Update(func(s *JapaneseMeanUpsert) {
+		s.UpdateReading()
+	})
+}
+
+// ClearReading clears the value of the "reading" field.
+func (u *JapaneseMeanUpsertOne) ClearReading() *JapaneseMeanUpsertOne {
+	return u.Update(func(s *JapaneseMeanUpsert) {
+		s.ClearReading()
+	})
+}
+
 // SetWordInfoID sets the "word_info_id" field.
 func (u *JapaneseMeanUpsertOne) SetWordInfoID(v int) *JapaneseMeanUpsertOne {
 	return u.Update(func(s *JapaneseMeanUpsert) {
@@ -642,6 +699,27 @@ func (u *JapaneseMeanUpsertBulk) UpdateName() *JapaneseMeanUpsertBulk {
 	})
 }
 
+// SetReading sets the "reading" field.
+func (u *JapaneseMeanUpsertBulk) SetReading(v string) *JapaneseMeanUpsertBulk {
+	return u.Update(func(s *JapaneseMeanUpsert) {
+		s.SetReading(v)
+	})
+}
+
+// UpdateReading sets the "reading" field to the value that was provided on create.
+func (u *JapaneseMeanUpsertBulk) UpdateReading() *JapaneseMeanUpsertBulk {
+	return u.Update(func(s *JapaneseMeanUpsert) {
+		s.UpdateReading()
+	})
+}
+
+// ClearReading clears the value of the "reading" field.
+func (u *JapaneseMeanUpsertBulk) ClearReading() *JapaneseMeanUpsertBulk {
+	return u.Update(func(s *JapaneseMeanUpsert) {
+		s.ClearReading()
+	})
+}
+
 // SetWordInfoID sets the "word_info_id" field.
 func (u *JapaneseMeanUpsertBulk) SetWordInfoID(v int) *JapaneseMeanUpsertBulk {
 	return u.Update(func(s *JapaneseMeanUpsert) {
@@ -45,6 +45,26 @@ func (jmu *JapaneseMeanUpdate) SetNillableName(s *string) *JapaneseMeanUpdate {
 	return jmu
 }
 
+// SetReading sets the "reading" field.
+func (jmu *JapaneseMeanUpdate) SetReading(s string) *JapaneseMeanUpdate {
+	jmu.mutation.SetReading(s)
+	return jmu
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (jmu *JapaneseMeanUpdate) SetNillableReading(s *string) *JapaneseMeanUpdate {
+	if s != nil {
+		jmu.SetReading(*s)
+	}
+	return jmu
+}
+
+// ClearReading clears the value of the "reading" field.
+func (jmu *JapaneseMeanUpdate) ClearReading() *JapaneseMeanUpdate {
+	jmu.mutation.ClearReading()
+	return jmu
+}
+
 // SetWordInfoID sets the "word_info_id" field.
 func (jmu *JapaneseMeanUpdate) SetWordInfoID(i int) *JapaneseMeanUpdate {
 	jmu.mutation.SetWordInfoID(i)
@@ -206,6 +226,12 @@ func (jmu *JapaneseMeanUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := jmu.mutation.Name(); ok {
 		_spec.SetField(japanesemean.FieldName, field.TypeString, value)
 	}
+	if value, ok := jmu.mutation.Reading(); ok {
+		_spec.SetField(japanesemean.FieldReading, field.TypeString, value)
+	}
+	if jmu.mutation.ReadingCleared() {
+		_spec.ClearField(japanesemean.FieldReading, field.TypeString)
+	}
 	if value, ok := jmu.mutation.CreatedAt(); ok {
 		_spec.SetField(japanesemean.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -322,6 +348,26 @@ func (jmuo *JapaneseMeanUpdateOne) SetNillableName(s *string) *JapaneseMeanUpdat
 	return jmuo
 }
 
+// SetReading sets the "reading" field.
+func (jmuo *JapaneseMeanUpdateOne) SetReading(s string) *JapaneseMeanUpdateOne {
+	jmuo.mutation.SetReading(s)
+	return jmuo
+}
+
+// SetNillableReading sets the "reading" field if the given value is not nil.
+func (jmuo *JapaneseMeanUpdateOne) SetNillableReading(s *string) *JapaneseMeanUpdateOne {
+	if s != nil {
+		jmuo.SetReading(*s)
+	}
+	return jmuo
+}
+
+// ClearReading clears the value of the "reading" field.
+func (jmuo *JapaneseMeanUpdateOne) ClearReading() *JapaneseMeanUpdateOne {
+	jmuo.mutation.ClearReading()
+	return jmuo
+}
+
 // SetWordInfoID sets the "word_info_id" field.
 func (jmuo *JapaneseMeanUpdateOne) SetWordInfoID(i int) *JapaneseMeanUpdateOne {
 	jmuo.mutation.SetWordInfoID(i)
@@ -513,6 +559,12 @@ func (jmuo *JapaneseMeanUpdateOne) sqlSave(ctx context.Context) (_node *Japanese
 	if value, ok := jmuo.mutation.Name(); ok {
 		_spec.SetField(japanesemean.FieldName, field.TypeString, value)
 	}
+	if value, ok := jmuo.mutation.Reading(); ok {
+		_spec.SetField(japanesemean.FieldReading, field.TypeString, value)
+	}
+	if jmuo.mutation.ReadingCleared() {
+		_spec.ClearField(japanesemean.FieldReading, field.TypeString)
+	}
 	if value, ok := jmuo.mutation.CreatedAt(); ok {
 		_spec.SetField(japanesemean.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -9,6 +9,145 @@ import (
 )
 
 var (
+	// DecksColumns holds the columns for the "decks" table.
+	DecksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Default: ""},
+		{Name: "visibility", Type: field.TypeEnum, Enums: []string{"private", "link", "public"}, Default: "private"},
+		{Name: "share_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// DecksTable holds the schema information for the "decks" table.
+	DecksTable = &schema.Table{
+		Name:       "decks",
+		Columns:    DecksColumns,
+		PrimaryKey: []*schema.Column{DecksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "decks_users_decks",
+				Columns:    []*schema.Column{DecksColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deck_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{DecksColumns[7]},
+			},
+			{
+				Name:    "deck_visibility_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{DecksColumns[3], DecksColumns[6]},
+			},
+		},
+	}
+	// DeckWordsColumns holds the columns for the "deck_words" table.
+	DeckWordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "deck_id", Type: field.TypeInt},
+		{Name: "word_id", Type: field.TypeInt},
+	}
+	// DeckWordsTable holds the schema information for the "deck_words" table.
+	DeckWordsTable = &schema.Table{
+		Name:       "deck_words",
+		Columns:    DeckWordsColumns,
+		PrimaryKey: []*schema.Column{DeckWordsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "deck_words_decks_deck_words",
+				Columns:    []*schema.Column{DeckWordsColumns[3]},
+				RefColumns: []*schema.Column{DecksColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "deck_words_words_deck_words",
+				Columns:    []*schema.Column{DeckWordsColumns[4]},
+				RefColumns: []*schema.Column{WordsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deckword_deck_id_word_id",
+				Unique:  true,
+				Columns: []*schema.Column{DeckWordsColumns[3], DeckWordsColumns[4]},
+			},
+			{
+				Name:    "deckword_deck_id_position",
+				Unique:  false,
+				Columns: []*schema.Column{DeckWordsColumns[3], DeckWordsColumns[1]},
+			},
+		},
+	}
+	// DictImportFailuresColumns holds the columns for the "dict_import_failures" table.
+	DictImportFailuresColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "entry_id", Type: field.TypeString},
+		{Name: "entry_index", Type: field.TypeInt},
+		{Name: "message", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "run_id", Type: field.TypeInt},
+	}
+	// DictImportFailuresTable holds the schema information for the "dict_import_failures" table.
+	DictImportFailuresTable = &schema.Table{
+		Name:       "dict_import_failures",
+		Columns:    DictImportFailuresColumns,
+		PrimaryKey: []*schema.Column{DictImportFailuresColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "dict_import_failures_dict_import_runs_failures",
+				Columns:    []*schema.Column{DictImportFailuresColumns[6]},
+				RefColumns: []*schema.Column{DictImportRunsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dictimportfailure_run_id_entry_index",
+				Unique:  false,
+				Columns: []*schema.Column{DictImportFailuresColumns[6], DictImportFailuresColumns[2]},
+			},
+			{
+				Name:    "dictimportfailure_run_id_entry_id",
+				Unique:  false,
+				Columns: []*schema.Column{DictImportFailuresColumns[6], DictImportFailuresColumns[1]},
+			},
+		},
+	}
+	// DictImportRunsColumns holds the columns for the "dict_import_runs" table.
+	DictImportRunsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "source_path", Type: field.TypeString},
+		{Name: "format", Type: field.TypeString, Default: "jmdict"},
+		{Name: "source_hash", Type: field.TypeString},
+		{Name: "status", Type: field.TypeString, Default: "running"},
+		{Name: "last_committed_index", Type: field.TypeInt, Default: -1},
+		{Name: "error_message", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "started_at", Type: field.TypeTime},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// DictImportRunsTable holds the schema information for the "dict_import_runs" table.
+	DictImportRunsTable = &schema.Table{
+		Name:       "dict_import_runs",
+		Columns:    DictImportRunsColumns,
+		PrimaryKey: []*schema.Column{DictImportRunsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dictimportrun_source_hash",
+				Unique:  false,
+				Columns: []*schema.Column{DictImportRunsColumns[3]},
+			},
+		},
+	}
 	// ExternalAuthsColumns holds the columns for the "external_auths" table.
 	ExternalAuthsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -47,6 +186,7 @@ var (
 	JapaneseMeansColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "reading", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "word_info_id", Type: field.TypeInt},
@@ -59,7 +199,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "japanese_means_word_infos_japanese_means",
-				Columns:    []*schema.Column{JapaneseMeansColumns[4]},
+				Columns:    []*schema.Column{JapaneseMeansColumns[5]},
 				RefColumns: []*schema.Column{WordInfosColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -68,7 +208,7 @@ var (
 			{
 				Name:    "japanesemean_word_info_id_name",
 				Unique:  true,
-				Columns: []*schema.Column{JapaneseMeansColumns[4], JapaneseMeansColumns[1]},
+				Columns: []*schema.Column{JapaneseMeansColumns[5], JapaneseMeansColumns[1]},
 			},
 		},
 	}
@@ -85,6 +225,54 @@ var (
 		Columns:    PartOfSpeechesColumns,
 		PrimaryKey: []*schema.Column{PartOfSpeechesColumns[0]},
 	}
+	// PosMappingsColumns holds the columns for the "pos_mappings" table.
+	PosMappingsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "code", Type: field.TypeString},
+		{Name: "part_of_speech_id", Type: field.TypeInt},
+		{Name: "version_id", Type: field.TypeInt},
+	}
+	// PosMappingsTable holds the schema information for the "pos_mappings" table.
+	PosMappingsTable = &schema.Table{
+		Name:       "pos_mappings",
+		Columns:    PosMappingsColumns,
+		PrimaryKey: []*schema.Column{PosMappingsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "pos_mappings_part_of_speeches_pos_mappings",
+				Columns:    []*schema.Column{PosMappingsColumns[2]},
+				RefColumns: []*schema.Column{PartOfSpeechesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "pos_mappings_pos_mapping_versions_mappings",
+				Columns:    []*schema.Column{PosMappingsColumns[3]},
+				RefColumns: []*schema.Column{PosMappingVersionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "posmapping_version_id_code",
+				Unique:  true,
+				Columns: []*schema.Column{PosMappingsColumns[3], PosMappingsColumns[1]},
+			},
+		},
+	}
+	// PosMappingVersionsColumns holds the columns for the "pos_mapping_versions" table.
+	PosMappingVersionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "fallback_pos_id", Type: field.TypeInt},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_by", Type: field.TypeInt, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// PosMappingVersionsTable holds the schema information for the "pos_mapping_versions" table.
+	PosMappingVersionsTable = &schema.Table{
+		Name:       "pos_mapping_versions",
+		Columns:    PosMappingVersionsColumns,
+		PrimaryKey: []*schema.Column{PosMappingVersionsColumns[0]},
+	}
 	// QuizsColumns holds the columns for the "quizs" table.
 	QuizsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -100,8 +288,18 @@ var (
 		{Name: "is_special_characters", Type: field.TypeInt, Default: 0},
 		{Name: "attention_level_list", Type: field.TypeJSON},
 		{Name: "choices_pos_ids", Type: field.TypeJSON},
+		{Name: "mode", Type: field.TypeString, Default: "random"},
+		{Name: "direction", Type: field.TypeString, Default: "en_to_ja"},
+		{Name: "question_type", Type: field.TypeString, Default: "choice"},
+		{Name: "difficulty", Type: field.TypeString, Default: "normal"},
+		{Name: "question_time_limit_sec", Type: field.TypeInt, Default: 0},
+		{Name: "time_limit_sec", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finish_status", Type: field.TypeString, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deck_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt},
 	}
 	// QuizsTable holds the schema information for the "quizs" table.
@@ -110,9 +308,15 @@ var (
 		Columns:    QuizsColumns,
 		PrimaryKey: []*schema.Column{QuizsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "quizs_decks_quizzes",
+				Columns:    []*schema.Column{QuizsColumns[24]},
+				RefColumns: []*schema.Column{DecksColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "quizs_users_quizzes",
-				Columns:    []*schema.Column{QuizsColumns[15]},
+				Columns:    []*schema.Column{QuizsColumns[25]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -121,7 +325,7 @@ var (
 			{
 				Name:    "quiz_user_id",
 				Unique:  true,
-				Columns: []*schema.Column{QuizsColumns[15]},
+				Columns: []*schema.Column{QuizsColumns[25]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "is_running = TRUE",
 				},
@@ -134,10 +338,20 @@ var (
 		{Name: "question_number", Type: field.TypeInt},
 		{Name: "word_name", Type: field.TypeString},
 		{Name: "pos_id", Type: field.TypeInt},
+		{Name: "direction", Type: field.TypeString, Default: "en_to_ja"},
+		{Name: "question_type", Type: field.TypeString, Default: "choice"},
+		{Name: "meaning_name", Type: field.TypeString, Nullable: true},
 		{Name: "choices_jpms", Type: field.TypeJSON},
+		{Name: "choices_words", Type: field.TypeJSON, Nullable: true},
 		{Name: "answer_jpm_id", Type: field.TypeInt, Nullable: true},
+		{Name: "answer_word_id", Type: field.TypeInt, Nullable: true},
+		{Name: "typed_answer", Type: field.TypeString, Nullable: true},
+		{Name: "grade", Type: field.TypeString, Nullable: true},
+		{Name: "credit", Type: field.TypeFloat64, Default: 0},
 		{Name: "is_correct", Type: field.TypeBool, Nullable: true},
+		{Name: "served_at", Type: field.TypeTime, Nullable: true},
 		{Name: "answered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "idempotency_key", Type: field.TypeString, Nullable: true, Size: 128},
 		{Name: "time_ms", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
@@ -154,30 +368,67 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "quiz_questions_japanese_means_quiz_questions",
-				Columns:    []*schema.Column{QuizQuestionsColumns[11]},
+				Columns:    []*schema.Column{QuizQuestionsColumns[21]},
 				RefColumns: []*schema.Column{JapaneseMeansColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "quiz_questions_quizs_quiz_questions",
-				Columns:    []*schema.Column{QuizQuestionsColumns[12]},
+				Columns:    []*schema.Column{QuizQuestionsColumns[22]},
 				RefColumns: []*schema.Column{QuizsColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "quiz_questions_registered_words_quiz_questions",
-				Columns:    []*schema.Column{QuizQuestionsColumns[13]},
+				Columns:    []*schema.Column{QuizQuestionsColumns[23]},
 				RefColumns: []*schema.Column{RegisteredWordsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "quiz_questions_words_quiz_questions",
-				Columns:    []*schema.Column{QuizQuestionsColumns[14]},
+				Columns:    []*schema.Column{QuizQuestionsColumns[24]},
 				RefColumns: []*schema.Column{WordsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// RefreshTokensColumns holds the columns for the "refresh_tokens" table.
+	RefreshTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "family_id", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// RefreshTokensTable holds the schema information for the "refresh_tokens" table.
+	RefreshTokensTable = &schema.Table{
+		Name:       "refresh_tokens",
+		Columns:    RefreshTokensColumns,
+		PrimaryKey: []*schema.Column{RefreshTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "refresh_tokens_users_refresh_tokens",
+				Columns:    []*schema.Column{RefreshTokensColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "refreshtoken_family_id",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[1]},
+			},
+			{
+				Name:    "refreshtoken_user_id_revoked_at",
+				Unique:  false,
+				Columns: []*schema.Column{RefreshTokensColumns[7], RefreshTokensColumns[6]},
+			},
+		},
+	}
 	// RegisteredWordsColumns holds the columns for the "registered_words" table.
 	RegisteredWordsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -186,6 +437,14 @@ var (
 		{Name: "quiz_count", Type: field.TypeInt, Default: 0},
 		{Name: "correct_count", Type: field.TypeInt, Default: 0},
 		{Name: "correct_rate", Type: field.TypeInt, Default: 0},
+		{Name: "ease_factor", Type: field.TypeFloat64, Default: 2.5},
+		{Name: "interval_days", Type: field.TypeInt, Default: 0},
+		{Name: "repetitions", Type: field.TypeInt, Default: 0},
+		{Name: "lapses", Type: field.TypeInt, Default: 0},
+		{Name: "stability", Type: field.TypeFloat64, Default: 0},
+		{Name: "difficulty", Type: field.TypeFloat64, Default: 0},
+		{Name: "due_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_reviewed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "memo", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -200,13 +459,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "registered_words_users_registered_words",
-				Columns:    []*schema.Column{RegisteredWordsColumns[9]},
+				Columns:    []*schema.Column{RegisteredWordsColumns[17]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
 			{
 				Symbol:     "registered_words_words_registered_words",
-				Columns:    []*schema.Column{RegisteredWordsColumns[10]},
+				Columns:    []*schema.Column{RegisteredWordsColumns[18]},
 				RefColumns: []*schema.Column{WordsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -215,7 +474,12 @@ var (
 			{
 				Name:    "registeredword_user_id_word_id",
 				Unique:  true,
-				Columns: []*schema.Column{RegisteredWordsColumns[9], RegisteredWordsColumns[10]},
+				Columns: []*schema.Column{RegisteredWordsColumns[17], RegisteredWordsColumns[18]},
+			},
+			{
+				Name:    "registeredword_user_id_due_at",
+				Unique:  false,
+				Columns: []*schema.Column{RegisteredWordsColumns[17], RegisteredWordsColumns[12]},
 			},
 		},
 	}
@@ -246,6 +510,8 @@ var (
 		{Name: "is_admin", Type: field.TypeBool, Default: false},
 		{Name: "is_root", Type: field.TypeBool, Default: false},
 		{Name: "is_test", Type: field.TypeBool, Default: false},
+		{Name: "email_verified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "token_version", Type: field.TypeInt, Default: 0},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -267,6 +533,7 @@ var (
 	UserConfigsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
 		{Name: "is_dark_mode", Type: field.TypeBool, Default: false},
+		{Name: "srs_algorithm", Type: field.TypeString, Default: "sm2"},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt, Unique: true},
 	}
@@ -278,7 +545,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "user_configs_users_user_config",
-				Columns:    []*schema.Column{UserConfigsColumns[3]},
+				Columns:    []*schema.Column{UserConfigsColumns[4]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.Cascade,
 			},
@@ -322,6 +589,7 @@ var (
 		{Name: "is_idioms", Type: field.TypeBool, Default: false},
 		{Name: "is_special_characters", Type: field.TypeBool, Default: false},
 		{Name: "registration_count", Type: field.TypeInt, Default: 0},
+		{Name: "frequency_rank", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -336,11 +604,21 @@ var (
 				Unique:  false,
 				Columns: []*schema.Column{WordsColumns[5]},
 			},
+			{
+				Name:    "word_frequency_rank",
+				Unique:  false,
+				Columns: []*schema.Column{WordsColumns[6]},
+			},
 		},
 	}
 	// WordInfosColumns holds the columns for the "word_infos" table.
 	WordInfosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "pos_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "field_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "misc_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "dialect_tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "examples", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "part_of_speech_id", Type: field.TypeInt},
@@ -354,25 +632,72 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "word_infos_part_of_speeches_word_infos",
-				Columns:    []*schema.Column{WordInfosColumns[3]},
+				Columns:    []*schema.Column{WordInfosColumns[8]},
 				RefColumns: []*schema.Column{PartOfSpeechesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "word_infos_words_word_infos",
-				Columns:    []*schema.Column{WordInfosColumns[4]},
+				Columns:    []*schema.Column{WordInfosColumns[9]},
 				RefColumns: []*schema.Column{WordsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
 	}
+	// WordProposalsColumns holds the columns for the "word_proposals" table.
+	WordProposalsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"create", "update", "delete"}},
+		{Name: "word_id", Type: field.TypeInt, Nullable: true},
+		{Name: "word_name", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "approved", "rejected"}, Default: "pending"},
+		{Name: "reviewer_id", Type: field.TypeInt, Nullable: true},
+		{Name: "review_note", Type: field.TypeString, Default: ""},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// WordProposalsTable holds the schema information for the "word_proposals" table.
+	WordProposalsTable = &schema.Table{
+		Name:       "word_proposals",
+		Columns:    WordProposalsColumns,
+		PrimaryKey: []*schema.Column{WordProposalsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "word_proposals_users_word_proposals",
+				Columns:    []*schema.Column{WordProposalsColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wordproposal_status_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WordProposalsColumns[5], WordProposalsColumns[8]},
+			},
+			{
+				Name:    "wordproposal_user_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{WordProposalsColumns[10], WordProposalsColumns[5]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		DecksTable,
+		DeckWordsTable,
+		DictImportFailuresTable,
+		DictImportRunsTable,
 		ExternalAuthsTable,
 		JapaneseMeansTable,
 		PartOfSpeechesTable,
+		PosMappingsTable,
+		PosMappingVersionsTable,
 		QuizsTable,
 		QuizQuestionsTable,
+		RefreshTokensTable,
 		RegisteredWordsTable,
 		RootConfigsTable,
 		UsersTable,
@@ -380,21 +705,31 @@ var (
 		UserDailyUsagesTable,
 		WordsTable,
 		WordInfosTable,
+		WordProposalsTable,
 	}
 )
 
 func init() {
+	DecksTable.ForeignKeys[0].RefTable = UsersTable
+	DeckWordsTable.ForeignKeys[0].RefTable = DecksTable
+	DeckWordsTable.ForeignKeys[1].RefTable = WordsTable
+	DictImportFailuresTable.ForeignKeys[0].RefTable = DictImportRunsTable
 	ExternalAuthsTable.ForeignKeys[0].RefTable = UsersTable
 	JapaneseMeansTable.ForeignKeys[0].RefTable = WordInfosTable
-	QuizsTable.ForeignKeys[0].RefTable = UsersTable
+	PosMappingsTable.ForeignKeys[0].RefTable = PartOfSpeechesTable
+	PosMappingsTable.ForeignKeys[1].RefTable = PosMappingVersionsTable
+	QuizsTable.ForeignKeys[0].RefTable = DecksTable
+	QuizsTable.ForeignKeys[1].RefTable = UsersTable
 	QuizQuestionsTable.ForeignKeys[0].RefTable = JapaneseMeansTable
 	QuizQuestionsTable.ForeignKeys[1].RefTable = QuizsTable
 	QuizQuestionsTable.ForeignKeys[2].RefTable = RegisteredWordsTable
 	QuizQuestionsTable.ForeignKeys[3].RefTable = WordsTable
+	RefreshTokensTable.ForeignKeys[0].RefTable = UsersTable
 	RegisteredWordsTable.ForeignKeys[0].RefTable = UsersTable
 	RegisteredWordsTable.ForeignKeys[1].RefTable = WordsTable
 	UserConfigsTable.ForeignKeys[0].RefTable = UsersTable
 	UserDailyUsagesTable.ForeignKeys[0].RefTable = UsersTable
 	WordInfosTable.ForeignKeys[0].RefTable = PartOfSpeechesTable
 	WordInfosTable.ForeignKeys[1].RefTable = WordsTable
+	WordProposalsTable.ForeignKeys[0].RefTable = UsersTable
 }
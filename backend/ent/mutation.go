@@ -8,12 +8,19 @@ import (
 	"fmt"
 	"sync"
 	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
 	"word_app/backend/ent/externalauth"
 	"word_app/backend/ent/japanesemean"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/refreshtoken"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/rootconfig"
 	"word_app/backend/ent/user"
@@ -21,6 +28,7 @@ import (
 	"word_app/backend/ent/userdailyusage"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/ent/wordproposal"
 	"word_app/backend/src/models"
 
 	"entgo.io/ent"
@@ -36,48 +44,65 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeExternalAuth   = "ExternalAuth"
-	TypeJapaneseMean   = "JapaneseMean"
-	TypePartOfSpeech   = "PartOfSpeech"
-	TypeQuiz           = "Quiz"
-	TypeQuizQuestion   = "QuizQuestion"
-	TypeRegisteredWord = "RegisteredWord"
-	TypeRootConfig     = "RootConfig"
-	TypeUser           = "User"
-	TypeUserConfig     = "UserConfig"
-	TypeUserDailyUsage = "UserDailyUsage"
-	TypeWord           = "Word"
-	TypeWordInfo       = "WordInfo"
+	TypeDeck              = "Deck"
+	TypeDeckWord          = "DeckWord"
+	TypeDictImportFailure = "DictImportFailure"
+	TypeDictImportRun     = "DictImportRun"
+	TypeExternalAuth      = "ExternalAuth"
+	TypeJapaneseMean      = "JapaneseMean"
+	TypePartOfSpeech      = "PartOfSpeech"
+	TypePosMapping        = "PosMapping"
+	TypePosMappingVersion = "PosMappingVersion"
+	TypeQuiz              = "Quiz"
+	TypeQuizQuestion      = "QuizQuestion"
+	TypeRefreshToken      = "RefreshToken"
+	TypeRegisteredWord    = "RegisteredWord"
+	TypeRootConfig        = "RootConfig"
+	TypeUser              = "User"
+	TypeUserConfig        = "UserConfig"
+	TypeUserDailyUsage    = "UserDailyUsage"
+	TypeWord              = "Word"
+	TypeWordInfo          = "WordInfo"
+	TypeWordProposal      = "WordProposal"
 )
 
-// ExternalAuthMutation represents an operation that mutates the ExternalAuth nodes in the graph.
-type ExternalAuthMutation struct {
+// DeckMutation represents an operation that mutates the Deck nodes in the graph.
+type DeckMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *int
-	provider         *string
-	provider_user_id *string
-	deleted_at       *time.Time
-	clearedFields    map[string]struct{}
-	user             *int
-	cleareduser      bool
-	done             bool
-	oldValue         func(context.Context) (*ExternalAuth, error)
-	predicates       []predicate.ExternalAuth
+	op                Op
+	typ               string
+	id                *int
+	name              *string
+	description       *string
+	visibility        *deck.Visibility
+	share_token       *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	user              *int
+	cleareduser       bool
+	deck_words        map[int]struct{}
+	removeddeck_words map[int]struct{}
+	cleareddeck_words bool
+	quizzes           map[int]struct{}
+	removedquizzes    map[int]struct{}
+	clearedquizzes    bool
+	done              bool
+	oldValue          func(context.Context) (*Deck, error)
+	predicates        []predicate.Deck
 }
 
-var _ ent.Mutation = (*ExternalAuthMutation)(nil)
+var _ ent.Mutation = (*DeckMutation)(nil)
 
-// externalauthOption allows management of the mutation configuration using functional options.
-type externalauthOption func(*ExternalAuthMutation)
+// deckOption allows management of the mutation configuration using functional options.
+type deckOption func(*DeckMutation)
 
-// newExternalAuthMutation creates new mutation for the ExternalAuth entity.
-func newExternalAuthMutation(c config, op Op, opts ...externalauthOption) *ExternalAuthMutation {
-	m := &ExternalAuthMutation{
+// newDeckMutation creates new mutation for the Deck entity.
+func newDeckMutation(c config, op Op, opts ...deckOption) *DeckMutation {
+	m := &DeckMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeExternalAuth,
+		typ:           TypeDeck,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -86,20 +111,20 @@ func newExternalAuthMutation(c config, op Op, opts ...externalauthOption) *Exter
 	return m
 }
 
-// withExternalAuthID sets the ID field of the mutation.
-func withExternalAuthID(id int) externalauthOption {
-	return func(m *ExternalAuthMutation) {
+// withDeckID sets the ID field of the mutation.
+func withDeckID(id int) deckOption {
+	return func(m *DeckMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *ExternalAuth
+			value *Deck
 		)
-		m.oldValue = func(ctx context.Context) (*ExternalAuth, error) {
+		m.oldValue = func(ctx context.Context) (*Deck, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().ExternalAuth.Get(ctx, id)
+					value, err = m.Client().Deck.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -108,10 +133,10 @@ func withExternalAuthID(id int) externalauthOption {
 	}
 }
 
-// withExternalAuth sets the old ExternalAuth of the mutation.
-func withExternalAuth(node *ExternalAuth) externalauthOption {
-	return func(m *ExternalAuthMutation) {
-		m.oldValue = func(context.Context) (*ExternalAuth, error) {
+// withDeck sets the old Deck of the mutation.
+func withDeck(node *Deck) deckOption {
+	return func(m *DeckMutation) {
+		m.oldValue = func(context.Context) (*Deck, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -120,7 +145,7 @@ func withExternalAuth(node *ExternalAuth) externalauthOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ExternalAuthMutation) Client() *Client {
+func (m DeckMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -128,7 +153,7 @@ func (m ExternalAuthMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ExternalAuthMutation) Tx() (*Tx, error) {
+func (m DeckMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -139,7 +164,7 @@ func (m ExternalAuthMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ExternalAuthMutation) ID() (id int, exists bool) {
+func (m *DeckMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -150,7 +175,7 @@ func (m *ExternalAuthMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ExternalAuthMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeckMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -159,19 +184,19 @@ func (m *ExternalAuthMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().ExternalAuth.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Deck.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetUserID sets the "user_id" field.
-func (m *ExternalAuthMutation) SetUserID(i int) {
+func (m *DeckMutation) SetUserID(i int) {
 	m.user = &i
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *ExternalAuthMutation) UserID() (r int, exists bool) {
+func (m *DeckMutation) UserID() (r int, exists bool) {
 	v := m.user
 	if v == nil {
 		return
@@ -179,10 +204,10 @@ func (m *ExternalAuthMutation) UserID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the ExternalAuth entity.
-// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalAuthMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *DeckMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -197,146 +222,254 @@ func (m *ExternalAuthMutation) OldUserID(ctx context.Context) (v int, err error)
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *ExternalAuthMutation) ResetUserID() {
+func (m *DeckMutation) ResetUserID() {
 	m.user = nil
 }
 
-// SetProvider sets the "provider" field.
-func (m *ExternalAuthMutation) SetProvider(s string) {
-	m.provider = &s
+// SetName sets the "name" field.
+func (m *DeckMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *ExternalAuthMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// Name returns the value of the "name" field in the mutation.
+func (m *DeckMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the ExternalAuth entity.
-// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalAuthMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *DeckMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.Name, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *ExternalAuthMutation) ResetProvider() {
-	m.provider = nil
+// ResetName resets all changes to the "name" field.
+func (m *DeckMutation) ResetName() {
+	m.name = nil
 }
 
-// SetProviderUserID sets the "provider_user_id" field.
-func (m *ExternalAuthMutation) SetProviderUserID(s string) {
-	m.provider_user_id = &s
+// SetDescription sets the "description" field.
+func (m *DeckMutation) SetDescription(s string) {
+	m.description = &s
 }
 
-// ProviderUserID returns the value of the "provider_user_id" field in the mutation.
-func (m *ExternalAuthMutation) ProviderUserID() (r string, exists bool) {
-	v := m.provider_user_id
+// Description returns the value of the "description" field in the mutation.
+func (m *DeckMutation) Description() (r string, exists bool) {
+	v := m.description
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProviderUserID returns the old "provider_user_id" field's value of the ExternalAuth entity.
-// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
+// OldDescription returns the old "description" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalAuthMutation) OldProviderUserID(ctx context.Context) (v string, err error) {
+func (m *DeckMutation) OldDescription(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProviderUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProviderUserID requires an ID field in the mutation")
+		return v, errors.New("OldDescription requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProviderUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
 	}
-	return oldValue.ProviderUserID, nil
+	return oldValue.Description, nil
 }
 
-// ResetProviderUserID resets all changes to the "provider_user_id" field.
-func (m *ExternalAuthMutation) ResetProviderUserID() {
-	m.provider_user_id = nil
+// ResetDescription resets all changes to the "description" field.
+func (m *DeckMutation) ResetDescription() {
+	m.description = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *ExternalAuthMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetVisibility sets the "visibility" field.
+func (m *DeckMutation) SetVisibility(d deck.Visibility) {
+	m.visibility = &d
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *ExternalAuthMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Visibility returns the value of the "visibility" field in the mutation.
+func (m *DeckMutation) Visibility() (r deck.Visibility, exists bool) {
+	v := m.visibility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the ExternalAuth entity.
-// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
+// OldVisibility returns the old "visibility" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ExternalAuthMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *DeckMutation) OldVisibility(ctx context.Context) (v deck.Visibility, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldVisibility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldVisibility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldVisibility: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.Visibility, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *ExternalAuthMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[externalauth.FieldDeletedAt] = struct{}{}
+// ResetVisibility resets all changes to the "visibility" field.
+func (m *DeckMutation) ResetVisibility() {
+	m.visibility = nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *ExternalAuthMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[externalauth.FieldDeletedAt]
+// SetShareToken sets the "share_token" field.
+func (m *DeckMutation) SetShareToken(s string) {
+	m.share_token = &s
+}
+
+// ShareToken returns the value of the "share_token" field in the mutation.
+func (m *DeckMutation) ShareToken() (r string, exists bool) {
+	v := m.share_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldShareToken returns the old "share_token" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeckMutation) OldShareToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareToken: %w", err)
+	}
+	return oldValue.ShareToken, nil
+}
+
+// ClearShareToken clears the value of the "share_token" field.
+func (m *DeckMutation) ClearShareToken() {
+	m.share_token = nil
+	m.clearedFields[deck.FieldShareToken] = struct{}{}
+}
+
+// ShareTokenCleared returns if the "share_token" field was cleared in this mutation.
+func (m *DeckMutation) ShareTokenCleared() bool {
+	_, ok := m.clearedFields[deck.FieldShareToken]
 	return ok
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *ExternalAuthMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, externalauth.FieldDeletedAt)
+// ResetShareToken resets all changes to the "share_token" field.
+func (m *DeckMutation) ResetShareToken() {
+	m.share_token = nil
+	delete(m.clearedFields, deck.FieldShareToken)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeckMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeckMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeckMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeckMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DeckMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DeckMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Deck entity.
+// If the Deck object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeckMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DeckMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *ExternalAuthMutation) ClearUser() {
+func (m *DeckMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[externalauth.FieldUserID] = struct{}{}
+	m.clearedFields[deck.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *ExternalAuthMutation) UserCleared() bool {
+func (m *DeckMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *ExternalAuthMutation) UserIDs() (ids []int) {
+func (m *DeckMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -344,74 +477,197 @@ func (m *ExternalAuthMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *ExternalAuthMutation) ResetUser() {
+func (m *DeckMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the ExternalAuthMutation builder.
-func (m *ExternalAuthMutation) Where(ps ...predicate.ExternalAuth) {
-	m.predicates = append(m.predicates, ps...)
-}
-
-// WhereP appends storage-level predicates to the ExternalAuthMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ExternalAuthMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.ExternalAuth, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by ids.
+func (m *DeckMutation) AddDeckWordIDs(ids ...int) {
+	if m.deck_words == nil {
+		m.deck_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deck_words[ids[i]] = struct{}{}
 	}
-	m.Where(p...)
 }
 
-// Op returns the operation name.
-func (m *ExternalAuthMutation) Op() Op {
-	return m.op
+// ClearDeckWords clears the "deck_words" edge to the DeckWord entity.
+func (m *DeckMutation) ClearDeckWords() {
+	m.cleareddeck_words = true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ExternalAuthMutation) SetOp(op Op) {
-	m.op = op
+// DeckWordsCleared reports if the "deck_words" edge to the DeckWord entity was cleared.
+func (m *DeckMutation) DeckWordsCleared() bool {
+	return m.cleareddeck_words
 }
 
-// Type returns the node type of this mutation (ExternalAuth).
-func (m *ExternalAuthMutation) Type() string {
-	return m.typ
+// RemoveDeckWordIDs removes the "deck_words" edge to the DeckWord entity by IDs.
+func (m *DeckMutation) RemoveDeckWordIDs(ids ...int) {
+	if m.removeddeck_words == nil {
+		m.removeddeck_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deck_words, ids[i])
+		m.removeddeck_words[ids[i]] = struct{}{}
+	}
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *ExternalAuthMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.user != nil {
-		fields = append(fields, externalauth.FieldUserID)
+// RemovedDeckWords returns the removed IDs of the "deck_words" edge to the DeckWord entity.
+func (m *DeckMutation) RemovedDeckWordsIDs() (ids []int) {
+	for id := range m.removeddeck_words {
+		ids = append(ids, id)
 	}
-	if m.provider != nil {
-		fields = append(fields, externalauth.FieldProvider)
+	return
+}
+
+// DeckWordsIDs returns the "deck_words" edge IDs in the mutation.
+func (m *DeckMutation) DeckWordsIDs() (ids []int) {
+	for id := range m.deck_words {
+		ids = append(ids, id)
 	}
-	if m.provider_user_id != nil {
-		fields = append(fields, externalauth.FieldProviderUserID)
+	return
+}
+
+// ResetDeckWords resets all changes to the "deck_words" edge.
+func (m *DeckMutation) ResetDeckWords() {
+	m.deck_words = nil
+	m.cleareddeck_words = false
+	m.removeddeck_words = nil
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by ids.
+func (m *DeckMutation) AddQuizIDs(ids ...int) {
+	if m.quizzes == nil {
+		m.quizzes = make(map[int]struct{})
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, externalauth.FieldDeletedAt)
+	for i := range ids {
+		m.quizzes[ids[i]] = struct{}{}
 	}
-	return fields
+}
+
+// ClearQuizzes clears the "quizzes" edge to the Quiz entity.
+func (m *DeckMutation) ClearQuizzes() {
+	m.clearedquizzes = true
+}
+
+// QuizzesCleared reports if the "quizzes" edge to the Quiz entity was cleared.
+func (m *DeckMutation) QuizzesCleared() bool {
+	return m.clearedquizzes
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to the Quiz entity by IDs.
+func (m *DeckMutation) RemoveQuizIDs(ids ...int) {
+	if m.removedquizzes == nil {
+		m.removedquizzes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.quizzes, ids[i])
+		m.removedquizzes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizzes returns the removed IDs of the "quizzes" edge to the Quiz entity.
+func (m *DeckMutation) RemovedQuizzesIDs() (ids []int) {
+	for id := range m.removedquizzes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizzesIDs returns the "quizzes" edge IDs in the mutation.
+func (m *DeckMutation) QuizzesIDs() (ids []int) {
+	for id := range m.quizzes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizzes resets all changes to the "quizzes" edge.
+func (m *DeckMutation) ResetQuizzes() {
+	m.quizzes = nil
+	m.clearedquizzes = false
+	m.removedquizzes = nil
+}
+
+// Where appends a list predicates to the DeckMutation builder.
+func (m *DeckMutation) Where(ps ...predicate.Deck) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeckMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeckMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Deck, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeckMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeckMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Deck).
+func (m *DeckMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeckMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user != nil {
+		fields = append(fields, deck.FieldUserID)
+	}
+	if m.name != nil {
+		fields = append(fields, deck.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, deck.FieldDescription)
+	}
+	if m.visibility != nil {
+		fields = append(fields, deck.FieldVisibility)
+	}
+	if m.share_token != nil {
+		fields = append(fields, deck.FieldShareToken)
+	}
+	if m.created_at != nil {
+		fields = append(fields, deck.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, deck.FieldUpdatedAt)
+	}
+	return fields
 }
 
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ExternalAuthMutation) Field(name string) (ent.Value, bool) {
+func (m *DeckMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case externalauth.FieldUserID:
+	case deck.FieldUserID:
 		return m.UserID()
-	case externalauth.FieldProvider:
-		return m.Provider()
-	case externalauth.FieldProviderUserID:
-		return m.ProviderUserID()
-	case externalauth.FieldDeletedAt:
-		return m.DeletedAt()
+	case deck.FieldName:
+		return m.Name()
+	case deck.FieldDescription:
+		return m.Description()
+	case deck.FieldVisibility:
+		return m.Visibility()
+	case deck.FieldShareToken:
+		return m.ShareToken()
+	case deck.FieldCreatedAt:
+		return m.CreatedAt()
+	case deck.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -419,60 +675,87 @@ func (m *ExternalAuthMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ExternalAuthMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeckMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case externalauth.FieldUserID:
+	case deck.FieldUserID:
 		return m.OldUserID(ctx)
-	case externalauth.FieldProvider:
-		return m.OldProvider(ctx)
-	case externalauth.FieldProviderUserID:
-		return m.OldProviderUserID(ctx)
-	case externalauth.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case deck.FieldName:
+		return m.OldName(ctx)
+	case deck.FieldDescription:
+		return m.OldDescription(ctx)
+	case deck.FieldVisibility:
+		return m.OldVisibility(ctx)
+	case deck.FieldShareToken:
+		return m.OldShareToken(ctx)
+	case deck.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case deck.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown ExternalAuth field %s", name)
+	return nil, fmt.Errorf("unknown Deck field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExternalAuthMutation) SetField(name string, value ent.Value) error {
+func (m *DeckMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case externalauth.FieldUserID:
+	case deck.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case externalauth.FieldProvider:
+	case deck.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProvider(v)
+		m.SetName(v)
 		return nil
-	case externalauth.FieldProviderUserID:
+	case deck.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProviderUserID(v)
+		m.SetDescription(v)
 		return nil
-	case externalauth.FieldDeletedAt:
+	case deck.FieldVisibility:
+		v, ok := value.(deck.Visibility)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibility(v)
+		return nil
+	case deck.FieldShareToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareToken(v)
+		return nil
+	case deck.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCreatedAt(v)
+		return nil
+	case deck.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalAuth field %s", name)
+	return fmt.Errorf("unknown Deck field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ExternalAuthMutation) AddedFields() []string {
+func (m *DeckMutation) AddedFields() []string {
 	var fields []string
 	return fields
 }
@@ -480,7 +763,7 @@ func (m *ExternalAuthMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ExternalAuthMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeckMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	}
 	return nil, false
@@ -489,165 +772,227 @@ func (m *ExternalAuthMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ExternalAuthMutation) AddField(name string, value ent.Value) error {
+func (m *DeckMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown ExternalAuth numeric field %s", name)
+	return fmt.Errorf("unknown Deck numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ExternalAuthMutation) ClearedFields() []string {
+func (m *DeckMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(externalauth.FieldDeletedAt) {
-		fields = append(fields, externalauth.FieldDeletedAt)
+	if m.FieldCleared(deck.FieldShareToken) {
+		fields = append(fields, deck.FieldShareToken)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ExternalAuthMutation) FieldCleared(name string) bool {
+func (m *DeckMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ExternalAuthMutation) ClearField(name string) error {
+func (m *DeckMutation) ClearField(name string) error {
 	switch name {
-	case externalauth.FieldDeletedAt:
-		m.ClearDeletedAt()
+	case deck.FieldShareToken:
+		m.ClearShareToken()
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalAuth nullable field %s", name)
+	return fmt.Errorf("unknown Deck nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ExternalAuthMutation) ResetField(name string) error {
+func (m *DeckMutation) ResetField(name string) error {
 	switch name {
-	case externalauth.FieldUserID:
+	case deck.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case externalauth.FieldProvider:
-		m.ResetProvider()
+	case deck.FieldName:
+		m.ResetName()
 		return nil
-	case externalauth.FieldProviderUserID:
-		m.ResetProviderUserID()
+	case deck.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case externalauth.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case deck.FieldVisibility:
+		m.ResetVisibility()
+		return nil
+	case deck.FieldShareToken:
+		m.ResetShareToken()
+		return nil
+	case deck.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case deck.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalAuth field %s", name)
+	return fmt.Errorf("unknown Deck field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ExternalAuthMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DeckMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.user != nil {
-		edges = append(edges, externalauth.EdgeUser)
+		edges = append(edges, deck.EdgeUser)
+	}
+	if m.deck_words != nil {
+		edges = append(edges, deck.EdgeDeckWords)
+	}
+	if m.quizzes != nil {
+		edges = append(edges, deck.EdgeQuizzes)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ExternalAuthMutation) AddedIDs(name string) []ent.Value {
+func (m *DeckMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case externalauth.EdgeUser:
+	case deck.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case deck.EdgeDeckWords:
+		ids := make([]ent.Value, 0, len(m.deck_words))
+		for id := range m.deck_words {
+			ids = append(ids, id)
+		}
+		return ids
+	case deck.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.quizzes))
+		for id := range m.quizzes {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ExternalAuthMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DeckMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removeddeck_words != nil {
+		edges = append(edges, deck.EdgeDeckWords)
+	}
+	if m.removedquizzes != nil {
+		edges = append(edges, deck.EdgeQuizzes)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ExternalAuthMutation) RemovedIDs(name string) []ent.Value {
+func (m *DeckMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case deck.EdgeDeckWords:
+		ids := make([]ent.Value, 0, len(m.removeddeck_words))
+		for id := range m.removeddeck_words {
+			ids = append(ids, id)
+		}
+		return ids
+	case deck.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.removedquizzes))
+		for id := range m.removedquizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ExternalAuthMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *DeckMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.cleareduser {
-		edges = append(edges, externalauth.EdgeUser)
+		edges = append(edges, deck.EdgeUser)
+	}
+	if m.cleareddeck_words {
+		edges = append(edges, deck.EdgeDeckWords)
+	}
+	if m.clearedquizzes {
+		edges = append(edges, deck.EdgeQuizzes)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ExternalAuthMutation) EdgeCleared(name string) bool {
+func (m *DeckMutation) EdgeCleared(name string) bool {
 	switch name {
-	case externalauth.EdgeUser:
+	case deck.EdgeUser:
 		return m.cleareduser
+	case deck.EdgeDeckWords:
+		return m.cleareddeck_words
+	case deck.EdgeQuizzes:
+		return m.clearedquizzes
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ExternalAuthMutation) ClearEdge(name string) error {
+func (m *DeckMutation) ClearEdge(name string) error {
 	switch name {
-	case externalauth.EdgeUser:
+	case deck.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown ExternalAuth unique edge %s", name)
+	return fmt.Errorf("unknown Deck unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ExternalAuthMutation) ResetEdge(name string) error {
+func (m *DeckMutation) ResetEdge(name string) error {
 	switch name {
-	case externalauth.EdgeUser:
+	case deck.EdgeUser:
 		m.ResetUser()
 		return nil
+	case deck.EdgeDeckWords:
+		m.ResetDeckWords()
+		return nil
+	case deck.EdgeQuizzes:
+		m.ResetQuizzes()
+		return nil
 	}
-	return fmt.Errorf("unknown ExternalAuth edge %s", name)
+	return fmt.Errorf("unknown Deck edge %s", name)
 }
 
-// JapaneseMeanMutation represents an operation that mutates the JapaneseMean nodes in the graph.
-type JapaneseMeanMutation struct {
+// DeckWordMutation represents an operation that mutates the DeckWord nodes in the graph.
+type DeckWordMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	name                  *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	word_info             *int
-	clearedword_info      bool
-	quiz_questions        map[int]struct{}
-	removedquiz_questions map[int]struct{}
-	clearedquiz_questions bool
-	done                  bool
-	oldValue              func(context.Context) (*JapaneseMean, error)
-	predicates            []predicate.JapaneseMean
+	op            Op
+	typ           string
+	id            *int
+	position      *int
+	addposition   *int
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	deck          *int
+	cleareddeck   bool
+	word          *int
+	clearedword   bool
+	done          bool
+	oldValue      func(context.Context) (*DeckWord, error)
+	predicates    []predicate.DeckWord
 }
 
-var _ ent.Mutation = (*JapaneseMeanMutation)(nil)
+var _ ent.Mutation = (*DeckWordMutation)(nil)
 
-// japanesemeanOption allows management of the mutation configuration using functional options.
-type japanesemeanOption func(*JapaneseMeanMutation)
+// deckwordOption allows management of the mutation configuration using functional options.
+type deckwordOption func(*DeckWordMutation)
 
-// newJapaneseMeanMutation creates new mutation for the JapaneseMean entity.
-func newJapaneseMeanMutation(c config, op Op, opts ...japanesemeanOption) *JapaneseMeanMutation {
-	m := &JapaneseMeanMutation{
+// newDeckWordMutation creates new mutation for the DeckWord entity.
+func newDeckWordMutation(c config, op Op, opts ...deckwordOption) *DeckWordMutation {
+	m := &DeckWordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJapaneseMean,
+		typ:           TypeDeckWord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -656,20 +1001,20 @@ func newJapaneseMeanMutation(c config, op Op, opts ...japanesemeanOption) *Japan
 	return m
 }
 
-// withJapaneseMeanID sets the ID field of the mutation.
-func withJapaneseMeanID(id int) japanesemeanOption {
-	return func(m *JapaneseMeanMutation) {
+// withDeckWordID sets the ID field of the mutation.
+func withDeckWordID(id int) deckwordOption {
+	return func(m *DeckWordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *JapaneseMean
+			value *DeckWord
 		)
-		m.oldValue = func(ctx context.Context) (*JapaneseMean, error) {
+		m.oldValue = func(ctx context.Context) (*DeckWord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().JapaneseMean.Get(ctx, id)
+					value, err = m.Client().DeckWord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -678,10 +1023,10 @@ func withJapaneseMeanID(id int) japanesemeanOption {
 	}
 }
 
-// withJapaneseMean sets the old JapaneseMean of the mutation.
-func withJapaneseMean(node *JapaneseMean) japanesemeanOption {
-	return func(m *JapaneseMeanMutation) {
-		m.oldValue = func(context.Context) (*JapaneseMean, error) {
+// withDeckWord sets the old DeckWord of the mutation.
+func withDeckWord(node *DeckWord) deckwordOption {
+	return func(m *DeckWordMutation) {
+		m.oldValue = func(context.Context) (*DeckWord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -690,7 +1035,7 @@ func withJapaneseMean(node *JapaneseMean) japanesemeanOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JapaneseMeanMutation) Client() *Client {
+func (m DeckWordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -698,7 +1043,7 @@ func (m JapaneseMeanMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JapaneseMeanMutation) Tx() (*Tx, error) {
+func (m DeckWordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -709,7 +1054,7 @@ func (m JapaneseMeanMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JapaneseMeanMutation) ID() (id int, exists bool) {
+func (m *DeckWordMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -720,7 +1065,7 @@ func (m *JapaneseMeanMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JapaneseMeanMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DeckWordMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -729,246 +1074,239 @@ func (m *JapaneseMeanMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().JapaneseMean.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DeckWord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *JapaneseMeanMutation) SetName(s string) {
-	m.name = &s
+// SetDeckID sets the "deck_id" field.
+func (m *DeckWordMutation) SetDeckID(i int) {
+	m.deck = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *JapaneseMeanMutation) Name() (r string, exists bool) {
-	v := m.name
+// DeckID returns the value of the "deck_id" field in the mutation.
+func (m *DeckWordMutation) DeckID() (r int, exists bool) {
+	v := m.deck
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the JapaneseMean entity.
-// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
+// OldDeckID returns the old "deck_id" field's value of the DeckWord entity.
+// If the DeckWord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JapaneseMeanMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DeckWordMutation) OldDeckID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeckID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldDeckID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeckID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.DeckID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *JapaneseMeanMutation) ResetName() {
-	m.name = nil
+// ResetDeckID resets all changes to the "deck_id" field.
+func (m *DeckWordMutation) ResetDeckID() {
+	m.deck = nil
 }
 
-// SetWordInfoID sets the "word_info_id" field.
-func (m *JapaneseMeanMutation) SetWordInfoID(i int) {
-	m.word_info = &i
+// SetWordID sets the "word_id" field.
+func (m *DeckWordMutation) SetWordID(i int) {
+	m.word = &i
 }
 
-// WordInfoID returns the value of the "word_info_id" field in the mutation.
-func (m *JapaneseMeanMutation) WordInfoID() (r int, exists bool) {
-	v := m.word_info
+// WordID returns the value of the "word_id" field in the mutation.
+func (m *DeckWordMutation) WordID() (r int, exists bool) {
+	v := m.word
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWordInfoID returns the old "word_info_id" field's value of the JapaneseMean entity.
-// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
+// OldWordID returns the old "word_id" field's value of the DeckWord entity.
+// If the DeckWord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JapaneseMeanMutation) OldWordInfoID(ctx context.Context) (v int, err error) {
+func (m *DeckWordMutation) OldWordID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWordInfoID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWordInfoID requires an ID field in the mutation")
+		return v, errors.New("OldWordID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWordInfoID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
 	}
-	return oldValue.WordInfoID, nil
+	return oldValue.WordID, nil
 }
 
-// ResetWordInfoID resets all changes to the "word_info_id" field.
-func (m *JapaneseMeanMutation) ResetWordInfoID() {
-	m.word_info = nil
+// ResetWordID resets all changes to the "word_id" field.
+func (m *DeckWordMutation) ResetWordID() {
+	m.word = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *JapaneseMeanMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPosition sets the "position" field.
+func (m *DeckWordMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JapaneseMeanMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Position returns the value of the "position" field in the mutation.
+func (m *DeckWordMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the JapaneseMean entity.
-// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the DeckWord entity.
+// If the DeckWord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JapaneseMeanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeckWordMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Position, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JapaneseMeanMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddPosition adds i to the "position" field.
+func (m *DeckWordMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *JapaneseMeanMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *DeckWordMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *JapaneseMeanMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetPosition resets all changes to the "position" field.
+func (m *DeckWordMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *DeckWordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DeckWordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the JapaneseMean entity.
-// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DeckWord entity.
+// If the DeckWord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JapaneseMeanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DeckWordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *JapaneseMeanMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DeckWordMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearWordInfo clears the "word_info" edge to the WordInfo entity.
-func (m *JapaneseMeanMutation) ClearWordInfo() {
-	m.clearedword_info = true
-	m.clearedFields[japanesemean.FieldWordInfoID] = struct{}{}
+// ClearDeck clears the "deck" edge to the Deck entity.
+func (m *DeckWordMutation) ClearDeck() {
+	m.cleareddeck = true
+	m.clearedFields[deckword.FieldDeckID] = struct{}{}
 }
 
-// WordInfoCleared reports if the "word_info" edge to the WordInfo entity was cleared.
-func (m *JapaneseMeanMutation) WordInfoCleared() bool {
-	return m.clearedword_info
+// DeckCleared reports if the "deck" edge to the Deck entity was cleared.
+func (m *DeckWordMutation) DeckCleared() bool {
+	return m.cleareddeck
 }
 
-// WordInfoIDs returns the "word_info" edge IDs in the mutation.
+// DeckIDs returns the "deck" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WordInfoID instead. It exists only for internal usage by the builders.
-func (m *JapaneseMeanMutation) WordInfoIDs() (ids []int) {
-	if id := m.word_info; id != nil {
+// DeckID instead. It exists only for internal usage by the builders.
+func (m *DeckWordMutation) DeckIDs() (ids []int) {
+	if id := m.deck; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetWordInfo resets all changes to the "word_info" edge.
-func (m *JapaneseMeanMutation) ResetWordInfo() {
-	m.word_info = nil
-	m.clearedword_info = false
-}
-
-// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
-func (m *JapaneseMeanMutation) AddQuizQuestionIDs(ids ...int) {
-	if m.quiz_questions == nil {
-		m.quiz_questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.quiz_questions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
-func (m *JapaneseMeanMutation) ClearQuizQuestions() {
-	m.clearedquiz_questions = true
-}
-
-// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
-func (m *JapaneseMeanMutation) QuizQuestionsCleared() bool {
-	return m.clearedquiz_questions
+// ResetDeck resets all changes to the "deck" edge.
+func (m *DeckWordMutation) ResetDeck() {
+	m.deck = nil
+	m.cleareddeck = false
 }
 
-// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
-func (m *JapaneseMeanMutation) RemoveQuizQuestionIDs(ids ...int) {
-	if m.removedquiz_questions == nil {
-		m.removedquiz_questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.quiz_questions, ids[i])
-		m.removedquiz_questions[ids[i]] = struct{}{}
-	}
+// ClearWord clears the "word" edge to the Word entity.
+func (m *DeckWordMutation) ClearWord() {
+	m.clearedword = true
+	m.clearedFields[deckword.FieldWordID] = struct{}{}
 }
 
-// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
-func (m *JapaneseMeanMutation) RemovedQuizQuestionsIDs() (ids []int) {
-	for id := range m.removedquiz_questions {
-		ids = append(ids, id)
-	}
-	return
+// WordCleared reports if the "word" edge to the Word entity was cleared.
+func (m *DeckWordMutation) WordCleared() bool {
+	return m.clearedword
 }
 
-// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
-func (m *JapaneseMeanMutation) QuizQuestionsIDs() (ids []int) {
-	for id := range m.quiz_questions {
-		ids = append(ids, id)
+// WordIDs returns the "word" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WordID instead. It exists only for internal usage by the builders.
+func (m *DeckWordMutation) WordIDs() (ids []int) {
+	if id := m.word; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
-func (m *JapaneseMeanMutation) ResetQuizQuestions() {
-	m.quiz_questions = nil
-	m.clearedquiz_questions = false
-	m.removedquiz_questions = nil
+// ResetWord resets all changes to the "word" edge.
+func (m *DeckWordMutation) ResetWord() {
+	m.word = nil
+	m.clearedword = false
 }
 
-// Where appends a list predicates to the JapaneseMeanMutation builder.
-func (m *JapaneseMeanMutation) Where(ps ...predicate.JapaneseMean) {
+// Where appends a list predicates to the DeckWordMutation builder.
+func (m *DeckWordMutation) Where(ps ...predicate.DeckWord) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JapaneseMeanMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DeckWordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JapaneseMeanMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.JapaneseMean, len(ps))
+func (m *DeckWordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeckWord, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -976,36 +1314,36 @@ func (m *JapaneseMeanMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JapaneseMeanMutation) Op() Op {
+func (m *DeckWordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JapaneseMeanMutation) SetOp(op Op) {
+func (m *DeckWordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (JapaneseMean).
-func (m *JapaneseMeanMutation) Type() string {
+// Type returns the node type of this mutation (DeckWord).
+func (m *DeckWordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JapaneseMeanMutation) Fields() []string {
+func (m *DeckWordMutation) Fields() []string {
 	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, japanesemean.FieldName)
+	if m.deck != nil {
+		fields = append(fields, deckword.FieldDeckID)
 	}
-	if m.word_info != nil {
-		fields = append(fields, japanesemean.FieldWordInfoID)
+	if m.word != nil {
+		fields = append(fields, deckword.FieldWordID)
 	}
-	if m.created_at != nil {
-		fields = append(fields, japanesemean.FieldCreatedAt)
+	if m.position != nil {
+		fields = append(fields, deckword.FieldPosition)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, japanesemean.FieldUpdatedAt)
+	if m.created_at != nil {
+		fields = append(fields, deckword.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1013,16 +1351,16 @@ func (m *JapaneseMeanMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JapaneseMeanMutation) Field(name string) (ent.Value, bool) {
+func (m *DeckWordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case japanesemean.FieldName:
-		return m.Name()
-	case japanesemean.FieldWordInfoID:
-		return m.WordInfoID()
-	case japanesemean.FieldCreatedAt:
+	case deckword.FieldDeckID:
+		return m.DeckID()
+	case deckword.FieldWordID:
+		return m.WordID()
+	case deckword.FieldPosition:
+		return m.Position()
+	case deckword.FieldCreatedAt:
 		return m.CreatedAt()
-	case japanesemean.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1030,69 +1368,74 @@ func (m *JapaneseMeanMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JapaneseMeanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DeckWordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case japanesemean.FieldName:
-		return m.OldName(ctx)
-	case japanesemean.FieldWordInfoID:
-		return m.OldWordInfoID(ctx)
-	case japanesemean.FieldCreatedAt:
+	case deckword.FieldDeckID:
+		return m.OldDeckID(ctx)
+	case deckword.FieldWordID:
+		return m.OldWordID(ctx)
+	case deckword.FieldPosition:
+		return m.OldPosition(ctx)
+	case deckword.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case japanesemean.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown JapaneseMean field %s", name)
+	return nil, fmt.Errorf("unknown DeckWord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JapaneseMeanMutation) SetField(name string, value ent.Value) error {
+func (m *DeckWordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case japanesemean.FieldName:
-		v, ok := value.(string)
+	case deckword.FieldDeckID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetDeckID(v)
 		return nil
-	case japanesemean.FieldWordInfoID:
+	case deckword.FieldWordID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWordInfoID(v)
+		m.SetWordID(v)
 		return nil
-	case japanesemean.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case deckword.FieldPosition:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetPosition(v)
 		return nil
-	case japanesemean.FieldUpdatedAt:
+	case deckword.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown JapaneseMean field %s", name)
+	return fmt.Errorf("unknown DeckWord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JapaneseMeanMutation) AddedFields() []string {
+func (m *DeckWordMutation) AddedFields() []string {
 	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, deckword.FieldPosition)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JapaneseMeanMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DeckWordMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case deckword.FieldPosition:
+		return m.AddedPosition()
 	}
 	return nil, false
 }
@@ -1100,182 +1443,181 @@ func (m *JapaneseMeanMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JapaneseMeanMutation) AddField(name string, value ent.Value) error {
+func (m *DeckWordMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case deckword.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
 	}
-	return fmt.Errorf("unknown JapaneseMean numeric field %s", name)
+	return fmt.Errorf("unknown DeckWord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JapaneseMeanMutation) ClearedFields() []string {
+func (m *DeckWordMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JapaneseMeanMutation) FieldCleared(name string) bool {
+func (m *DeckWordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JapaneseMeanMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown JapaneseMean nullable field %s", name)
+func (m *DeckWordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DeckWord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JapaneseMeanMutation) ResetField(name string) error {
+func (m *DeckWordMutation) ResetField(name string) error {
 	switch name {
-	case japanesemean.FieldName:
-		m.ResetName()
+	case deckword.FieldDeckID:
+		m.ResetDeckID()
 		return nil
-	case japanesemean.FieldWordInfoID:
-		m.ResetWordInfoID()
+	case deckword.FieldWordID:
+		m.ResetWordID()
 		return nil
-	case japanesemean.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case deckword.FieldPosition:
+		m.ResetPosition()
 		return nil
-	case japanesemean.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case deckword.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown JapaneseMean field %s", name)
+	return fmt.Errorf("unknown DeckWord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JapaneseMeanMutation) AddedEdges() []string {
+func (m *DeckWordMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.word_info != nil {
-		edges = append(edges, japanesemean.EdgeWordInfo)
+	if m.deck != nil {
+		edges = append(edges, deckword.EdgeDeck)
 	}
-	if m.quiz_questions != nil {
-		edges = append(edges, japanesemean.EdgeQuizQuestions)
+	if m.word != nil {
+		edges = append(edges, deckword.EdgeWord)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JapaneseMeanMutation) AddedIDs(name string) []ent.Value {
+func (m *DeckWordMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case japanesemean.EdgeWordInfo:
-		if id := m.word_info; id != nil {
+	case deckword.EdgeDeck:
+		if id := m.deck; id != nil {
 			return []ent.Value{*id}
 		}
-	case japanesemean.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.quiz_questions))
-		for id := range m.quiz_questions {
-			ids = append(ids, id)
+	case deckword.EdgeWord:
+		if id := m.word; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JapaneseMeanMutation) RemovedEdges() []string {
+func (m *DeckWordMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.removedquiz_questions != nil {
-		edges = append(edges, japanesemean.EdgeQuizQuestions)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JapaneseMeanMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case japanesemean.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
-		for id := range m.removedquiz_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DeckWordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JapaneseMeanMutation) ClearedEdges() []string {
+func (m *DeckWordMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.clearedword_info {
-		edges = append(edges, japanesemean.EdgeWordInfo)
+	if m.cleareddeck {
+		edges = append(edges, deckword.EdgeDeck)
 	}
-	if m.clearedquiz_questions {
-		edges = append(edges, japanesemean.EdgeQuizQuestions)
+	if m.clearedword {
+		edges = append(edges, deckword.EdgeWord)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JapaneseMeanMutation) EdgeCleared(name string) bool {
+func (m *DeckWordMutation) EdgeCleared(name string) bool {
 	switch name {
-	case japanesemean.EdgeWordInfo:
-		return m.clearedword_info
-	case japanesemean.EdgeQuizQuestions:
-		return m.clearedquiz_questions
+	case deckword.EdgeDeck:
+		return m.cleareddeck
+	case deckword.EdgeWord:
+		return m.clearedword
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JapaneseMeanMutation) ClearEdge(name string) error {
+func (m *DeckWordMutation) ClearEdge(name string) error {
 	switch name {
-	case japanesemean.EdgeWordInfo:
-		m.ClearWordInfo()
+	case deckword.EdgeDeck:
+		m.ClearDeck()
+		return nil
+	case deckword.EdgeWord:
+		m.ClearWord()
 		return nil
 	}
-	return fmt.Errorf("unknown JapaneseMean unique edge %s", name)
+	return fmt.Errorf("unknown DeckWord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JapaneseMeanMutation) ResetEdge(name string) error {
+func (m *DeckWordMutation) ResetEdge(name string) error {
 	switch name {
-	case japanesemean.EdgeWordInfo:
-		m.ResetWordInfo()
+	case deckword.EdgeDeck:
+		m.ResetDeck()
 		return nil
-	case japanesemean.EdgeQuizQuestions:
-		m.ResetQuizQuestions()
+	case deckword.EdgeWord:
+		m.ResetWord()
 		return nil
 	}
-	return fmt.Errorf("unknown JapaneseMean edge %s", name)
+	return fmt.Errorf("unknown DeckWord edge %s", name)
 }
 
-// PartOfSpeechMutation represents an operation that mutates the PartOfSpeech nodes in the graph.
-type PartOfSpeechMutation struct {
+// DictImportFailureMutation represents an operation that mutates the DictImportFailure nodes in the graph.
+type DictImportFailureMutation struct {
 	config
-	op                Op
-	typ               string
-	id                *int
-	name              *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	word_infos        map[int]struct{}
-	removedword_infos map[int]struct{}
-	clearedword_infos bool
-	done              bool
-	oldValue          func(context.Context) (*PartOfSpeech, error)
-	predicates        []predicate.PartOfSpeech
-}
-
-var _ ent.Mutation = (*PartOfSpeechMutation)(nil)
-
-// partofspeechOption allows management of the mutation configuration using functional options.
-type partofspeechOption func(*PartOfSpeechMutation)
-
-// newPartOfSpeechMutation creates new mutation for the PartOfSpeech entity.
-func newPartOfSpeechMutation(c config, op Op, opts ...partofspeechOption) *PartOfSpeechMutation {
-	m := &PartOfSpeechMutation{
+	op             Op
+	typ            string
+	id             *int
+	entry_id       *string
+	entry_index    *int
+	addentry_index *int
+	message        *string
+	created_at     *time.Time
+	resolved_at    *time.Time
+	clearedFields  map[string]struct{}
+	run            *int
+	clearedrun     bool
+	done           bool
+	oldValue       func(context.Context) (*DictImportFailure, error)
+	predicates     []predicate.DictImportFailure
+}
+
+var _ ent.Mutation = (*DictImportFailureMutation)(nil)
+
+// dictimportfailureOption allows management of the mutation configuration using functional options.
+type dictimportfailureOption func(*DictImportFailureMutation)
+
+// newDictImportFailureMutation creates new mutation for the DictImportFailure entity.
+func newDictImportFailureMutation(c config, op Op, opts ...dictimportfailureOption) *DictImportFailureMutation {
+	m := &DictImportFailureMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePartOfSpeech,
+		typ:           TypeDictImportFailure,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1284,20 +1626,20 @@ func newPartOfSpeechMutation(c config, op Op, opts ...partofspeechOption) *PartO
 	return m
 }
 
-// withPartOfSpeechID sets the ID field of the mutation.
-func withPartOfSpeechID(id int) partofspeechOption {
-	return func(m *PartOfSpeechMutation) {
+// withDictImportFailureID sets the ID field of the mutation.
+func withDictImportFailureID(id int) dictimportfailureOption {
+	return func(m *DictImportFailureMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *PartOfSpeech
+			value *DictImportFailure
 		)
-		m.oldValue = func(ctx context.Context) (*PartOfSpeech, error) {
+		m.oldValue = func(ctx context.Context) (*DictImportFailure, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().PartOfSpeech.Get(ctx, id)
+					value, err = m.Client().DictImportFailure.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1306,10 +1648,10 @@ func withPartOfSpeechID(id int) partofspeechOption {
 	}
 }
 
-// withPartOfSpeech sets the old PartOfSpeech of the mutation.
-func withPartOfSpeech(node *PartOfSpeech) partofspeechOption {
-	return func(m *PartOfSpeechMutation) {
-		m.oldValue = func(context.Context) (*PartOfSpeech, error) {
+// withDictImportFailure sets the old DictImportFailure of the mutation.
+func withDictImportFailure(node *DictImportFailure) dictimportfailureOption {
+	return func(m *DictImportFailureMutation) {
+		m.oldValue = func(context.Context) (*DictImportFailure, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1318,7 +1660,7 @@ func withPartOfSpeech(node *PartOfSpeech) partofspeechOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PartOfSpeechMutation) Client() *Client {
+func (m DictImportFailureMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1326,7 +1668,7 @@ func (m PartOfSpeechMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PartOfSpeechMutation) Tx() (*Tx, error) {
+func (m DictImportFailureMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1337,7 +1679,7 @@ func (m PartOfSpeechMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PartOfSpeechMutation) ID() (id int, exists bool) {
+func (m *DictImportFailureMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1348,7 +1690,7 @@ func (m *PartOfSpeechMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PartOfSpeechMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DictImportFailureMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1357,55 +1699,183 @@ func (m *PartOfSpeechMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().PartOfSpeech.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DictImportFailure.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *PartOfSpeechMutation) SetName(s string) {
-	m.name = &s
+// SetRunID sets the "run_id" field.
+func (m *DictImportFailureMutation) SetRunID(i int) {
+	m.run = &i
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *PartOfSpeechMutation) Name() (r string, exists bool) {
-	v := m.name
+// RunID returns the value of the "run_id" field in the mutation.
+func (m *DictImportFailureMutation) RunID() (r int, exists bool) {
+	v := m.run
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the PartOfSpeech entity.
-// If the PartOfSpeech object wasn't provided to the builder, the object is fetched from the database.
+// OldRunID returns the old "run_id" field's value of the DictImportFailure entity.
+// If the DictImportFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartOfSpeechMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *DictImportFailureMutation) OldRunID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldRunID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldRunID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldRunID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.RunID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *PartOfSpeechMutation) ResetName() {
-	m.name = nil
+// ResetRunID resets all changes to the "run_id" field.
+func (m *DictImportFailureMutation) ResetRunID() {
+	m.run = nil
+}
+
+// SetEntryID sets the "entry_id" field.
+func (m *DictImportFailureMutation) SetEntryID(s string) {
+	m.entry_id = &s
+}
+
+// EntryID returns the value of the "entry_id" field in the mutation.
+func (m *DictImportFailureMutation) EntryID() (r string, exists bool) {
+	v := m.entry_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryID returns the old "entry_id" field's value of the DictImportFailure entity.
+// If the DictImportFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DictImportFailureMutation) OldEntryID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryID: %w", err)
+	}
+	return oldValue.EntryID, nil
+}
+
+// ResetEntryID resets all changes to the "entry_id" field.
+func (m *DictImportFailureMutation) ResetEntryID() {
+	m.entry_id = nil
+}
+
+// SetEntryIndex sets the "entry_index" field.
+func (m *DictImportFailureMutation) SetEntryIndex(i int) {
+	m.entry_index = &i
+	m.addentry_index = nil
+}
+
+// EntryIndex returns the value of the "entry_index" field in the mutation.
+func (m *DictImportFailureMutation) EntryIndex() (r int, exists bool) {
+	v := m.entry_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEntryIndex returns the old "entry_index" field's value of the DictImportFailure entity.
+// If the DictImportFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DictImportFailureMutation) OldEntryIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEntryIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEntryIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEntryIndex: %w", err)
+	}
+	return oldValue.EntryIndex, nil
+}
+
+// AddEntryIndex adds i to the "entry_index" field.
+func (m *DictImportFailureMutation) AddEntryIndex(i int) {
+	if m.addentry_index != nil {
+		*m.addentry_index += i
+	} else {
+		m.addentry_index = &i
+	}
+}
+
+// AddedEntryIndex returns the value that was added to the "entry_index" field in this mutation.
+func (m *DictImportFailureMutation) AddedEntryIndex() (r int, exists bool) {
+	v := m.addentry_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEntryIndex resets all changes to the "entry_index" field.
+func (m *DictImportFailureMutation) ResetEntryIndex() {
+	m.entry_index = nil
+	m.addentry_index = nil
+}
+
+// SetMessage sets the "message" field.
+func (m *DictImportFailureMutation) SetMessage(s string) {
+	m.message = &s
+}
+
+// Message returns the value of the "message" field in the mutation.
+func (m *DictImportFailureMutation) Message() (r string, exists bool) {
+	v := m.message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessage returns the old "message" field's value of the DictImportFailure entity.
+// If the DictImportFailure object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DictImportFailureMutation) OldMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessage: %w", err)
+	}
+	return oldValue.Message, nil
+}
+
+// ResetMessage resets all changes to the "message" field.
+func (m *DictImportFailureMutation) ResetMessage() {
+	m.message = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PartOfSpeechMutation) SetCreatedAt(t time.Time) {
+func (m *DictImportFailureMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PartOfSpeechMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *DictImportFailureMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1413,10 +1883,10 @@ func (m *PartOfSpeechMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the PartOfSpeech entity.
-// If the PartOfSpeech object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the DictImportFailure entity.
+// If the DictImportFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartOfSpeechMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DictImportFailureMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1431,109 +1901,95 @@ func (m *PartOfSpeechMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PartOfSpeechMutation) ResetCreatedAt() {
+func (m *DictImportFailureMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PartOfSpeechMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetResolvedAt sets the "resolved_at" field.
+func (m *DictImportFailureMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PartOfSpeechMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *DictImportFailureMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the PartOfSpeech entity.
-// If the PartOfSpeech object wasn't provided to the builder, the object is fetched from the database.
+// OldResolvedAt returns the old "resolved_at" field's value of the DictImportFailure entity.
+// If the DictImportFailure object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PartOfSpeechMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DictImportFailureMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PartOfSpeechMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return oldValue.ResolvedAt, nil
 }
 
-// AddWordInfoIDs adds the "word_infos" edge to the WordInfo entity by ids.
-func (m *PartOfSpeechMutation) AddWordInfoIDs(ids ...int) {
-	if m.word_infos == nil {
-		m.word_infos = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.word_infos[ids[i]] = struct{}{}
-	}
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *DictImportFailureMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[dictimportfailure.FieldResolvedAt] = struct{}{}
 }
 
-// ClearWordInfos clears the "word_infos" edge to the WordInfo entity.
-func (m *PartOfSpeechMutation) ClearWordInfos() {
-	m.clearedword_infos = true
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *DictImportFailureMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[dictimportfailure.FieldResolvedAt]
+	return ok
 }
 
-// WordInfosCleared reports if the "word_infos" edge to the WordInfo entity was cleared.
-func (m *PartOfSpeechMutation) WordInfosCleared() bool {
-	return m.clearedword_infos
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *DictImportFailureMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, dictimportfailure.FieldResolvedAt)
 }
 
-// RemoveWordInfoIDs removes the "word_infos" edge to the WordInfo entity by IDs.
-func (m *PartOfSpeechMutation) RemoveWordInfoIDs(ids ...int) {
-	if m.removedword_infos == nil {
-		m.removedword_infos = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.word_infos, ids[i])
-		m.removedword_infos[ids[i]] = struct{}{}
-	}
+// ClearRun clears the "run" edge to the DictImportRun entity.
+func (m *DictImportFailureMutation) ClearRun() {
+	m.clearedrun = true
+	m.clearedFields[dictimportfailure.FieldRunID] = struct{}{}
 }
 
-// RemovedWordInfos returns the removed IDs of the "word_infos" edge to the WordInfo entity.
-func (m *PartOfSpeechMutation) RemovedWordInfosIDs() (ids []int) {
-	for id := range m.removedword_infos {
-		ids = append(ids, id)
-	}
-	return
+// RunCleared reports if the "run" edge to the DictImportRun entity was cleared.
+func (m *DictImportFailureMutation) RunCleared() bool {
+	return m.clearedrun
 }
 
-// WordInfosIDs returns the "word_infos" edge IDs in the mutation.
-func (m *PartOfSpeechMutation) WordInfosIDs() (ids []int) {
-	for id := range m.word_infos {
-		ids = append(ids, id)
+// RunIDs returns the "run" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RunID instead. It exists only for internal usage by the builders.
+func (m *DictImportFailureMutation) RunIDs() (ids []int) {
+	if id := m.run; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetWordInfos resets all changes to the "word_infos" edge.
-func (m *PartOfSpeechMutation) ResetWordInfos() {
-	m.word_infos = nil
-	m.clearedword_infos = false
-	m.removedword_infos = nil
+// ResetRun resets all changes to the "run" edge.
+func (m *DictImportFailureMutation) ResetRun() {
+	m.run = nil
+	m.clearedrun = false
 }
 
-// Where appends a list predicates to the PartOfSpeechMutation builder.
-func (m *PartOfSpeechMutation) Where(ps ...predicate.PartOfSpeech) {
+// Where appends a list predicates to the DictImportFailureMutation builder.
+func (m *DictImportFailureMutation) Where(ps ...predicate.DictImportFailure) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PartOfSpeechMutation builder. Using this method,
+// WhereP appends storage-level predicates to the DictImportFailureMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PartOfSpeechMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.PartOfSpeech, len(ps))
+func (m *DictImportFailureMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DictImportFailure, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1541,33 +1997,42 @@ func (m *PartOfSpeechMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PartOfSpeechMutation) Op() Op {
+func (m *DictImportFailureMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PartOfSpeechMutation) SetOp(op Op) {
+func (m *DictImportFailureMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (PartOfSpeech).
-func (m *PartOfSpeechMutation) Type() string {
+// Type returns the node type of this mutation (DictImportFailure).
+func (m *DictImportFailureMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PartOfSpeechMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.name != nil {
-		fields = append(fields, partofspeech.FieldName)
+func (m *DictImportFailureMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.run != nil {
+		fields = append(fields, dictimportfailure.FieldRunID)
+	}
+	if m.entry_id != nil {
+		fields = append(fields, dictimportfailure.FieldEntryID)
+	}
+	if m.entry_index != nil {
+		fields = append(fields, dictimportfailure.FieldEntryIndex)
+	}
+	if m.message != nil {
+		fields = append(fields, dictimportfailure.FieldMessage)
 	}
 	if m.created_at != nil {
-		fields = append(fields, partofspeech.FieldCreatedAt)
+		fields = append(fields, dictimportfailure.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, partofspeech.FieldUpdatedAt)
+	if m.resolved_at != nil {
+		fields = append(fields, dictimportfailure.FieldResolvedAt)
 	}
 	return fields
 }
@@ -1575,14 +2040,20 @@ func (m *PartOfSpeechMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PartOfSpeechMutation) Field(name string) (ent.Value, bool) {
+func (m *DictImportFailureMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case partofspeech.FieldName:
-		return m.Name()
-	case partofspeech.FieldCreatedAt:
+	case dictimportfailure.FieldRunID:
+		return m.RunID()
+	case dictimportfailure.FieldEntryID:
+		return m.EntryID()
+	case dictimportfailure.FieldEntryIndex:
+		return m.EntryIndex()
+	case dictimportfailure.FieldMessage:
+		return m.Message()
+	case dictimportfailure.FieldCreatedAt:
 		return m.CreatedAt()
-	case partofspeech.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case dictimportfailure.FieldResolvedAt:
+		return m.ResolvedAt()
 	}
 	return nil, false
 }
@@ -1590,242 +2061,276 @@ func (m *PartOfSpeechMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PartOfSpeechMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *DictImportFailureMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case partofspeech.FieldName:
-		return m.OldName(ctx)
-	case partofspeech.FieldCreatedAt:
+	case dictimportfailure.FieldRunID:
+		return m.OldRunID(ctx)
+	case dictimportfailure.FieldEntryID:
+		return m.OldEntryID(ctx)
+	case dictimportfailure.FieldEntryIndex:
+		return m.OldEntryIndex(ctx)
+	case dictimportfailure.FieldMessage:
+		return m.OldMessage(ctx)
+	case dictimportfailure.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case partofspeech.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case dictimportfailure.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown PartOfSpeech field %s", name)
+	return nil, fmt.Errorf("unknown DictImportFailure field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PartOfSpeechMutation) SetField(name string, value ent.Value) error {
+func (m *DictImportFailureMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case partofspeech.FieldName:
+	case dictimportfailure.FieldRunID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRunID(v)
+		return nil
+	case dictimportfailure.FieldEntryID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetEntryID(v)
 		return nil
-	case partofspeech.FieldCreatedAt:
+	case dictimportfailure.FieldEntryIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEntryIndex(v)
+		return nil
+	case dictimportfailure.FieldMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessage(v)
+		return nil
+	case dictimportfailure.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case partofspeech.FieldUpdatedAt:
+	case dictimportfailure.FieldResolvedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetResolvedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown PartOfSpeech field %s", name)
+	return fmt.Errorf("unknown DictImportFailure field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PartOfSpeechMutation) AddedFields() []string {
-	return nil
+func (m *DictImportFailureMutation) AddedFields() []string {
+	var fields []string
+	if m.addentry_index != nil {
+		fields = append(fields, dictimportfailure.FieldEntryIndex)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PartOfSpeechMutation) AddedField(name string) (ent.Value, bool) {
+func (m *DictImportFailureMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dictimportfailure.FieldEntryIndex:
+		return m.AddedEntryIndex()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PartOfSpeechMutation) AddField(name string, value ent.Value) error {
+func (m *DictImportFailureMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case dictimportfailure.FieldEntryIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEntryIndex(v)
+		return nil
 	}
-	return fmt.Errorf("unknown PartOfSpeech numeric field %s", name)
+	return fmt.Errorf("unknown DictImportFailure numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PartOfSpeechMutation) ClearedFields() []string {
-	return nil
+func (m *DictImportFailureMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dictimportfailure.FieldResolvedAt) {
+		fields = append(fields, dictimportfailure.FieldResolvedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PartOfSpeechMutation) FieldCleared(name string) bool {
+func (m *DictImportFailureMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PartOfSpeechMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown PartOfSpeech nullable field %s", name)
+func (m *DictImportFailureMutation) ClearField(name string) error {
+	switch name {
+	case dictimportfailure.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown DictImportFailure nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PartOfSpeechMutation) ResetField(name string) error {
+func (m *DictImportFailureMutation) ResetField(name string) error {
 	switch name {
-	case partofspeech.FieldName:
-		m.ResetName()
+	case dictimportfailure.FieldRunID:
+		m.ResetRunID()
 		return nil
-	case partofspeech.FieldCreatedAt:
+	case dictimportfailure.FieldEntryID:
+		m.ResetEntryID()
+		return nil
+	case dictimportfailure.FieldEntryIndex:
+		m.ResetEntryIndex()
+		return nil
+	case dictimportfailure.FieldMessage:
+		m.ResetMessage()
+		return nil
+	case dictimportfailure.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case partofspeech.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case dictimportfailure.FieldResolvedAt:
+		m.ResetResolvedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown PartOfSpeech field %s", name)
+	return fmt.Errorf("unknown DictImportFailure field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PartOfSpeechMutation) AddedEdges() []string {
+func (m *DictImportFailureMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.word_infos != nil {
-		edges = append(edges, partofspeech.EdgeWordInfos)
+	if m.run != nil {
+		edges = append(edges, dictimportfailure.EdgeRun)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PartOfSpeechMutation) AddedIDs(name string) []ent.Value {
+func (m *DictImportFailureMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case partofspeech.EdgeWordInfos:
-		ids := make([]ent.Value, 0, len(m.word_infos))
-		for id := range m.word_infos {
-			ids = append(ids, id)
+	case dictimportfailure.EdgeRun:
+		if id := m.run; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PartOfSpeechMutation) RemovedEdges() []string {
+func (m *DictImportFailureMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.removedword_infos != nil {
-		edges = append(edges, partofspeech.EdgeWordInfos)
-	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PartOfSpeechMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case partofspeech.EdgeWordInfos:
-		ids := make([]ent.Value, 0, len(m.removedword_infos))
-		for id := range m.removedword_infos {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *DictImportFailureMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PartOfSpeechMutation) ClearedEdges() []string {
+func (m *DictImportFailureMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedword_infos {
-		edges = append(edges, partofspeech.EdgeWordInfos)
+	if m.clearedrun {
+		edges = append(edges, dictimportfailure.EdgeRun)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PartOfSpeechMutation) EdgeCleared(name string) bool {
+func (m *DictImportFailureMutation) EdgeCleared(name string) bool {
 	switch name {
-	case partofspeech.EdgeWordInfos:
-		return m.clearedword_infos
+	case dictimportfailure.EdgeRun:
+		return m.clearedrun
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PartOfSpeechMutation) ClearEdge(name string) error {
+func (m *DictImportFailureMutation) ClearEdge(name string) error {
 	switch name {
+	case dictimportfailure.EdgeRun:
+		m.ClearRun()
+		return nil
 	}
-	return fmt.Errorf("unknown PartOfSpeech unique edge %s", name)
+	return fmt.Errorf("unknown DictImportFailure unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PartOfSpeechMutation) ResetEdge(name string) error {
+func (m *DictImportFailureMutation) ResetEdge(name string) error {
 	switch name {
-	case partofspeech.EdgeWordInfos:
-		m.ResetWordInfos()
+	case dictimportfailure.EdgeRun:
+		m.ResetRun()
 		return nil
 	}
-	return fmt.Errorf("unknown PartOfSpeech edge %s", name)
+	return fmt.Errorf("unknown DictImportFailure edge %s", name)
 }
 
-// QuizMutation represents an operation that mutates the Quiz nodes in the graph.
-type QuizMutation struct {
+// DictImportRunMutation represents an operation that mutates the DictImportRun nodes in the graph.
+type DictImportRunMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *int
-	quiz_number                *int
-	addquiz_number             *int
-	is_running                 *bool
-	total_questions_count      *int
-	addtotal_questions_count   *int
-	correct_count              *int
-	addcorrect_count           *int
-	result_correct_rate        *float64
-	addresult_correct_rate     *float64
-	is_save_result             *bool
-	is_registered_words        *int
-	addis_registered_words     *int
-	setting_correct_rate       *int
-	addsetting_correct_rate    *int
-	is_idioms                  *int
-	addis_idioms               *int
-	is_special_characters      *int
-	addis_special_characters   *int
-	attention_level_list       *[]int
-	appendattention_level_list []int
-	choices_pos_ids            *[]int
-	appendchoices_pos_ids      []int
-	created_at                 *time.Time
-	deleted_at                 *time.Time
-	clearedFields              map[string]struct{}
-	user                       *int
-	cleareduser                bool
-	quiz_questions             map[int]struct{}
-	removedquiz_questions      map[int]struct{}
-	clearedquiz_questions      bool
-	done                       bool
-	oldValue                   func(context.Context) (*Quiz, error)
-	predicates                 []predicate.Quiz
+	op                      Op
+	typ                     string
+	id                      *int
+	source_path             *string
+	format                  *string
+	source_hash             *string
+	status                  *string
+	last_committed_index    *int
+	addlast_committed_index *int
+	error_message           *string
+	started_at              *time.Time
+	finished_at             *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	failures                map[int]struct{}
+	removedfailures         map[int]struct{}
+	clearedfailures         bool
+	done                    bool
+	oldValue                func(context.Context) (*DictImportRun, error)
+	predicates              []predicate.DictImportRun
 }
 
-var _ ent.Mutation = (*QuizMutation)(nil)
+var _ ent.Mutation = (*DictImportRunMutation)(nil)
 
-// quizOption allows management of the mutation configuration using functional options.
-type quizOption func(*QuizMutation)
+// dictimportrunOption allows management of the mutation configuration using functional options.
+type dictimportrunOption func(*DictImportRunMutation)
 
-// newQuizMutation creates new mutation for the Quiz entity.
-func newQuizMutation(c config, op Op, opts ...quizOption) *QuizMutation {
-	m := &QuizMutation{
+// newDictImportRunMutation creates new mutation for the DictImportRun entity.
+func newDictImportRunMutation(c config, op Op, opts ...dictimportrunOption) *DictImportRunMutation {
+	m := &DictImportRunMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuiz,
+		typ:           TypeDictImportRun,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1834,20 +2339,20 @@ func newQuizMutation(c config, op Op, opts ...quizOption) *QuizMutation {
 	return m
 }
 
-// withQuizID sets the ID field of the mutation.
-func withQuizID(id int) quizOption {
-	return func(m *QuizMutation) {
+// withDictImportRunID sets the ID field of the mutation.
+func withDictImportRunID(id int) dictimportrunOption {
+	return func(m *DictImportRunMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Quiz
+			value *DictImportRun
 		)
-		m.oldValue = func(ctx context.Context) (*Quiz, error) {
+		m.oldValue = func(ctx context.Context) (*DictImportRun, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Quiz.Get(ctx, id)
+					value, err = m.Client().DictImportRun.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1856,10 +2361,10 @@ func withQuizID(id int) quizOption {
 	}
 }
 
-// withQuiz sets the old Quiz of the mutation.
-func withQuiz(node *Quiz) quizOption {
-	return func(m *QuizMutation) {
-		m.oldValue = func(context.Context) (*Quiz, error) {
+// withDictImportRun sets the old DictImportRun of the mutation.
+func withDictImportRun(node *DictImportRun) dictimportrunOption {
+	return func(m *DictImportRunMutation) {
+		m.oldValue = func(context.Context) (*DictImportRun, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1868,7 +2373,7 @@ func withQuiz(node *Quiz) quizOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuizMutation) Client() *Client {
+func (m DictImportRunMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1876,7 +2381,7 @@ func (m QuizMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuizMutation) Tx() (*Tx, error) {
+func (m DictImportRunMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1887,7 +2392,7 @@ func (m QuizMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuizMutation) ID() (id int, exists bool) {
+func (m *DictImportRunMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1898,7 +2403,7 @@ func (m *QuizMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuizMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DictImportRunMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1907,713 +2412,1047 @@ func (m *QuizMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Quiz.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DictImportRun.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *QuizMutation) SetUserID(i int) {
-	m.user = &i
+// SetSourcePath sets the "source_path" field.
+func (m *DictImportRunMutation) SetSourcePath(s string) {
+	m.source_path = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *QuizMutation) UserID() (r int, exists bool) {
-	v := m.user
+// SourcePath returns the value of the "source_path" field in the mutation.
+func (m *DictImportRunMutation) SourcePath() (r string, exists bool) {
+	v := m.source_path
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldSourcePath returns the old "source_path" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *DictImportRunMutation) OldSourcePath(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSourcePath is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldSourcePath requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSourcePath: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.SourcePath, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *QuizMutation) ResetUserID() {
-	m.user = nil
+// ResetSourcePath resets all changes to the "source_path" field.
+func (m *DictImportRunMutation) ResetSourcePath() {
+	m.source_path = nil
 }
 
-// SetQuizNumber sets the "quiz_number" field.
-func (m *QuizMutation) SetQuizNumber(i int) {
-	m.quiz_number = &i
-	m.addquiz_number = nil
+// SetFormat sets the "format" field.
+func (m *DictImportRunMutation) SetFormat(s string) {
+	m.format = &s
 }
 
-// QuizNumber returns the value of the "quiz_number" field in the mutation.
-func (m *QuizMutation) QuizNumber() (r int, exists bool) {
-	v := m.quiz_number
+// Format returns the value of the "format" field in the mutation.
+func (m *DictImportRunMutation) Format() (r string, exists bool) {
+	v := m.format
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuizNumber returns the old "quiz_number" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldFormat returns the old "format" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldQuizNumber(ctx context.Context) (v int, err error) {
+func (m *DictImportRunMutation) OldFormat(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuizNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldFormat is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuizNumber requires an ID field in the mutation")
+		return v, errors.New("OldFormat requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuizNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldFormat: %w", err)
 	}
-	return oldValue.QuizNumber, nil
+	return oldValue.Format, nil
 }
 
-// AddQuizNumber adds i to the "quiz_number" field.
-func (m *QuizMutation) AddQuizNumber(i int) {
-	if m.addquiz_number != nil {
-		*m.addquiz_number += i
-	} else {
-		m.addquiz_number = &i
-	}
+// ResetFormat resets all changes to the "format" field.
+func (m *DictImportRunMutation) ResetFormat() {
+	m.format = nil
 }
 
-// AddedQuizNumber returns the value that was added to the "quiz_number" field in this mutation.
-func (m *QuizMutation) AddedQuizNumber() (r int, exists bool) {
-	v := m.addquiz_number
+// SetSourceHash sets the "source_hash" field.
+func (m *DictImportRunMutation) SetSourceHash(s string) {
+	m.source_hash = &s
+}
+
+// SourceHash returns the value of the "source_hash" field in the mutation.
+func (m *DictImportRunMutation) SourceHash() (r string, exists bool) {
+	v := m.source_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetQuizNumber resets all changes to the "quiz_number" field.
-func (m *QuizMutation) ResetQuizNumber() {
-	m.quiz_number = nil
-	m.addquiz_number = nil
+// OldSourceHash returns the old "source_hash" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DictImportRunMutation) OldSourceHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSourceHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSourceHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSourceHash: %w", err)
+	}
+	return oldValue.SourceHash, nil
 }
 
-// SetIsRunning sets the "is_running" field.
-func (m *QuizMutation) SetIsRunning(b bool) {
-	m.is_running = &b
+// ResetSourceHash resets all changes to the "source_hash" field.
+func (m *DictImportRunMutation) ResetSourceHash() {
+	m.source_hash = nil
 }
 
-// IsRunning returns the value of the "is_running" field in the mutation.
-func (m *QuizMutation) IsRunning() (r bool, exists bool) {
-	v := m.is_running
+// SetStatus sets the "status" field.
+func (m *DictImportRunMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *DictImportRunMutation) Status() (r string, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRunning returns the old "is_running" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldIsRunning(ctx context.Context) (v bool, err error) {
+func (m *DictImportRunMutation) OldStatus(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRunning is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRunning requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRunning: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.IsRunning, nil
+	return oldValue.Status, nil
 }
 
-// ResetIsRunning resets all changes to the "is_running" field.
-func (m *QuizMutation) ResetIsRunning() {
-	m.is_running = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *DictImportRunMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetTotalQuestionsCount sets the "total_questions_count" field.
-func (m *QuizMutation) SetTotalQuestionsCount(i int) {
-	m.total_questions_count = &i
-	m.addtotal_questions_count = nil
+// SetLastCommittedIndex sets the "last_committed_index" field.
+func (m *DictImportRunMutation) SetLastCommittedIndex(i int) {
+	m.last_committed_index = &i
+	m.addlast_committed_index = nil
 }
 
-// TotalQuestionsCount returns the value of the "total_questions_count" field in the mutation.
-func (m *QuizMutation) TotalQuestionsCount() (r int, exists bool) {
-	v := m.total_questions_count
+// LastCommittedIndex returns the value of the "last_committed_index" field in the mutation.
+func (m *DictImportRunMutation) LastCommittedIndex() (r int, exists bool) {
+	v := m.last_committed_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalQuestionsCount returns the old "total_questions_count" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldLastCommittedIndex returns the old "last_committed_index" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldTotalQuestionsCount(ctx context.Context) (v int, err error) {
+func (m *DictImportRunMutation) OldLastCommittedIndex(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalQuestionsCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastCommittedIndex is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalQuestionsCount requires an ID field in the mutation")
+		return v, errors.New("OldLastCommittedIndex requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalQuestionsCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastCommittedIndex: %w", err)
 	}
-	return oldValue.TotalQuestionsCount, nil
+	return oldValue.LastCommittedIndex, nil
 }
 
-// AddTotalQuestionsCount adds i to the "total_questions_count" field.
-func (m *QuizMutation) AddTotalQuestionsCount(i int) {
-	if m.addtotal_questions_count != nil {
-		*m.addtotal_questions_count += i
+// AddLastCommittedIndex adds i to the "last_committed_index" field.
+func (m *DictImportRunMutation) AddLastCommittedIndex(i int) {
+	if m.addlast_committed_index != nil {
+		*m.addlast_committed_index += i
 	} else {
-		m.addtotal_questions_count = &i
+		m.addlast_committed_index = &i
 	}
 }
 
-// AddedTotalQuestionsCount returns the value that was added to the "total_questions_count" field in this mutation.
-func (m *QuizMutation) AddedTotalQuestionsCount() (r int, exists bool) {
-	v := m.addtotal_questions_count
+// AddedLastCommittedIndex returns the value that was added to the "last_committed_index" field in this mutation.
+func (m *DictImportRunMutation) AddedLastCommittedIndex() (r int, exists bool) {
+	v := m.addlast_committed_index
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalQuestionsCount resets all changes to the "total_questions_count" field.
-func (m *QuizMutation) ResetTotalQuestionsCount() {
-	m.total_questions_count = nil
-	m.addtotal_questions_count = nil
+// ResetLastCommittedIndex resets all changes to the "last_committed_index" field.
+func (m *DictImportRunMutation) ResetLastCommittedIndex() {
+	m.last_committed_index = nil
+	m.addlast_committed_index = nil
 }
 
-// SetCorrectCount sets the "correct_count" field.
-func (m *QuizMutation) SetCorrectCount(i int) {
-	m.correct_count = &i
-	m.addcorrect_count = nil
+// SetErrorMessage sets the "error_message" field.
+func (m *DictImportRunMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// CorrectCount returns the value of the "correct_count" field in the mutation.
-func (m *QuizMutation) CorrectCount() (r int, exists bool) {
-	v := m.correct_count
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *DictImportRunMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCorrectCount returns the old "correct_count" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldErrorMessage returns the old "error_message" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldCorrectCount(ctx context.Context) (v int, err error) {
+func (m *DictImportRunMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrectCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrectCount requires an ID field in the mutation")
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrectCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
 	}
-	return oldValue.CorrectCount, nil
+	return oldValue.ErrorMessage, nil
 }
 
-// AddCorrectCount adds i to the "correct_count" field.
-func (m *QuizMutation) AddCorrectCount(i int) {
-	if m.addcorrect_count != nil {
-		*m.addcorrect_count += i
-	} else {
-		m.addcorrect_count = &i
-	}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *DictImportRunMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[dictimportrun.FieldErrorMessage] = struct{}{}
 }
 
-// AddedCorrectCount returns the value that was added to the "correct_count" field in this mutation.
-func (m *QuizMutation) AddedCorrectCount() (r int, exists bool) {
-	v := m.addcorrect_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *DictImportRunMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[dictimportrun.FieldErrorMessage]
+	return ok
 }
 
-// ResetCorrectCount resets all changes to the "correct_count" field.
-func (m *QuizMutation) ResetCorrectCount() {
-	m.correct_count = nil
-	m.addcorrect_count = nil
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *DictImportRunMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, dictimportrun.FieldErrorMessage)
 }
 
-// SetResultCorrectRate sets the "result_correct_rate" field.
-func (m *QuizMutation) SetResultCorrectRate(f float64) {
-	m.result_correct_rate = &f
-	m.addresult_correct_rate = nil
+// SetStartedAt sets the "started_at" field.
+func (m *DictImportRunMutation) SetStartedAt(t time.Time) {
+	m.started_at = &t
 }
 
-// ResultCorrectRate returns the value of the "result_correct_rate" field in the mutation.
-func (m *QuizMutation) ResultCorrectRate() (r float64, exists bool) {
-	v := m.result_correct_rate
+// StartedAt returns the value of the "started_at" field in the mutation.
+func (m *DictImportRunMutation) StartedAt() (r time.Time, exists bool) {
+	v := m.started_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResultCorrectRate returns the old "result_correct_rate" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldStartedAt returns the old "started_at" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldResultCorrectRate(ctx context.Context) (v float64, err error) {
+func (m *DictImportRunMutation) OldStartedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResultCorrectRate is only allowed on UpdateOne operations")
+		return v, errors.New("OldStartedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResultCorrectRate requires an ID field in the mutation")
+		return v, errors.New("OldStartedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResultCorrectRate: %w", err)
-	}
-	return oldValue.ResultCorrectRate, nil
-}
-
-// AddResultCorrectRate adds f to the "result_correct_rate" field.
-func (m *QuizMutation) AddResultCorrectRate(f float64) {
-	if m.addresult_correct_rate != nil {
-		*m.addresult_correct_rate += f
-	} else {
-		m.addresult_correct_rate = &f
-	}
-}
-
-// AddedResultCorrectRate returns the value that was added to the "result_correct_rate" field in this mutation.
-func (m *QuizMutation) AddedResultCorrectRate() (r float64, exists bool) {
-	v := m.addresult_correct_rate
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldStartedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.StartedAt, nil
 }
 
-// ResetResultCorrectRate resets all changes to the "result_correct_rate" field.
-func (m *QuizMutation) ResetResultCorrectRate() {
-	m.result_correct_rate = nil
-	m.addresult_correct_rate = nil
+// ResetStartedAt resets all changes to the "started_at" field.
+func (m *DictImportRunMutation) ResetStartedAt() {
+	m.started_at = nil
 }
 
-// SetIsSaveResult sets the "is_save_result" field.
-func (m *QuizMutation) SetIsSaveResult(b bool) {
-	m.is_save_result = &b
+// SetFinishedAt sets the "finished_at" field.
+func (m *DictImportRunMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
 }
 
-// IsSaveResult returns the value of the "is_save_result" field in the mutation.
-func (m *QuizMutation) IsSaveResult() (r bool, exists bool) {
-	v := m.is_save_result
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *DictImportRunMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSaveResult returns the old "is_save_result" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldFinishedAt returns the old "finished_at" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldIsSaveResult(ctx context.Context) (v bool, err error) {
+func (m *DictImportRunMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSaveResult is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSaveResult requires an ID field in the mutation")
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSaveResult: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
 	}
-	return oldValue.IsSaveResult, nil
+	return oldValue.FinishedAt, nil
 }
 
-// ResetIsSaveResult resets all changes to the "is_save_result" field.
-func (m *QuizMutation) ResetIsSaveResult() {
-	m.is_save_result = nil
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *DictImportRunMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[dictimportrun.FieldFinishedAt] = struct{}{}
 }
 
-// SetIsRegisteredWords sets the "is_registered_words" field.
-func (m *QuizMutation) SetIsRegisteredWords(i int) {
-	m.is_registered_words = &i
-	m.addis_registered_words = nil
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *DictImportRunMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[dictimportrun.FieldFinishedAt]
+	return ok
 }
 
-// IsRegisteredWords returns the value of the "is_registered_words" field in the mutation.
-func (m *QuizMutation) IsRegisteredWords() (r int, exists bool) {
-	v := m.is_registered_words
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *DictImportRunMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, dictimportrun.FieldFinishedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DictImportRunMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DictImportRunMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRegisteredWords returns the old "is_registered_words" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the DictImportRun entity.
+// If the DictImportRun object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldIsRegisteredWords(ctx context.Context) (v int, err error) {
+func (m *DictImportRunMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRegisteredWords is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRegisteredWords requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRegisteredWords: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.IsRegisteredWords, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddIsRegisteredWords adds i to the "is_registered_words" field.
-func (m *QuizMutation) AddIsRegisteredWords(i int) {
-	if m.addis_registered_words != nil {
-		*m.addis_registered_words += i
-	} else {
-		m.addis_registered_words = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DictImportRunMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedIsRegisteredWords returns the value that was added to the "is_registered_words" field in this mutation.
-func (m *QuizMutation) AddedIsRegisteredWords() (r int, exists bool) {
-	v := m.addis_registered_words
-	if v == nil {
-		return
+// AddFailureIDs adds the "failures" edge to the DictImportFailure entity by ids.
+func (m *DictImportRunMutation) AddFailureIDs(ids ...int) {
+	if m.failures == nil {
+		m.failures = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.failures[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetIsRegisteredWords resets all changes to the "is_registered_words" field.
-func (m *QuizMutation) ResetIsRegisteredWords() {
-	m.is_registered_words = nil
-	m.addis_registered_words = nil
+// ClearFailures clears the "failures" edge to the DictImportFailure entity.
+func (m *DictImportRunMutation) ClearFailures() {
+	m.clearedfailures = true
 }
 
-// SetSettingCorrectRate sets the "setting_correct_rate" field.
-func (m *QuizMutation) SetSettingCorrectRate(i int) {
-	m.setting_correct_rate = &i
-	m.addsetting_correct_rate = nil
+// FailuresCleared reports if the "failures" edge to the DictImportFailure entity was cleared.
+func (m *DictImportRunMutation) FailuresCleared() bool {
+	return m.clearedfailures
 }
 
-// SettingCorrectRate returns the value of the "setting_correct_rate" field in the mutation.
-func (m *QuizMutation) SettingCorrectRate() (r int, exists bool) {
-	v := m.setting_correct_rate
-	if v == nil {
-		return
+// RemoveFailureIDs removes the "failures" edge to the DictImportFailure entity by IDs.
+func (m *DictImportRunMutation) RemoveFailureIDs(ids ...int) {
+	if m.removedfailures == nil {
+		m.removedfailures = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.failures, ids[i])
+		m.removedfailures[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldSettingCorrectRate returns the old "setting_correct_rate" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldSettingCorrectRate(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettingCorrectRate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettingCorrectRate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettingCorrectRate: %w", err)
+// RemovedFailures returns the removed IDs of the "failures" edge to the DictImportFailure entity.
+func (m *DictImportRunMutation) RemovedFailuresIDs() (ids []int) {
+	for id := range m.removedfailures {
+		ids = append(ids, id)
 	}
-	return oldValue.SettingCorrectRate, nil
+	return
 }
 
-// AddSettingCorrectRate adds i to the "setting_correct_rate" field.
-func (m *QuizMutation) AddSettingCorrectRate(i int) {
-	if m.addsetting_correct_rate != nil {
-		*m.addsetting_correct_rate += i
-	} else {
-		m.addsetting_correct_rate = &i
+// FailuresIDs returns the "failures" edge IDs in the mutation.
+func (m *DictImportRunMutation) FailuresIDs() (ids []int) {
+	for id := range m.failures {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedSettingCorrectRate returns the value that was added to the "setting_correct_rate" field in this mutation.
-func (m *QuizMutation) AddedSettingCorrectRate() (r int, exists bool) {
-	v := m.addsetting_correct_rate
-	if v == nil {
-		return
+// ResetFailures resets all changes to the "failures" edge.
+func (m *DictImportRunMutation) ResetFailures() {
+	m.failures = nil
+	m.clearedfailures = false
+	m.removedfailures = nil
+}
+
+// Where appends a list predicates to the DictImportRunMutation builder.
+func (m *DictImportRunMutation) Where(ps ...predicate.DictImportRun) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DictImportRunMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DictImportRunMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DictImportRun, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetSettingCorrectRate resets all changes to the "setting_correct_rate" field.
-func (m *QuizMutation) ResetSettingCorrectRate() {
-	m.setting_correct_rate = nil
-	m.addsetting_correct_rate = nil
+// Op returns the operation name.
+func (m *DictImportRunMutation) Op() Op {
+	return m.op
 }
 
-// SetIsIdioms sets the "is_idioms" field.
-func (m *QuizMutation) SetIsIdioms(i int) {
-	m.is_idioms = &i
-	m.addis_idioms = nil
+// SetOp allows setting the mutation operation.
+func (m *DictImportRunMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// IsIdioms returns the value of the "is_idioms" field in the mutation.
-func (m *QuizMutation) IsIdioms() (r int, exists bool) {
-	v := m.is_idioms
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (DictImportRun).
+func (m *DictImportRunMutation) Type() string {
+	return m.typ
 }
 
-// OldIsIdioms returns the old "is_idioms" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldIsIdioms(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsIdioms is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DictImportRunMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.source_path != nil {
+		fields = append(fields, dictimportrun.FieldSourcePath)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsIdioms requires an ID field in the mutation")
+	if m.format != nil {
+		fields = append(fields, dictimportrun.FieldFormat)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsIdioms: %w", err)
+	if m.source_hash != nil {
+		fields = append(fields, dictimportrun.FieldSourceHash)
 	}
-	return oldValue.IsIdioms, nil
+	if m.status != nil {
+		fields = append(fields, dictimportrun.FieldStatus)
+	}
+	if m.last_committed_index != nil {
+		fields = append(fields, dictimportrun.FieldLastCommittedIndex)
+	}
+	if m.error_message != nil {
+		fields = append(fields, dictimportrun.FieldErrorMessage)
+	}
+	if m.started_at != nil {
+		fields = append(fields, dictimportrun.FieldStartedAt)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, dictimportrun.FieldFinishedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, dictimportrun.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// AddIsIdioms adds i to the "is_idioms" field.
-func (m *QuizMutation) AddIsIdioms(i int) {
-	if m.addis_idioms != nil {
-		*m.addis_idioms += i
-	} else {
-		m.addis_idioms = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DictImportRunMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dictimportrun.FieldSourcePath:
+		return m.SourcePath()
+	case dictimportrun.FieldFormat:
+		return m.Format()
+	case dictimportrun.FieldSourceHash:
+		return m.SourceHash()
+	case dictimportrun.FieldStatus:
+		return m.Status()
+	case dictimportrun.FieldLastCommittedIndex:
+		return m.LastCommittedIndex()
+	case dictimportrun.FieldErrorMessage:
+		return m.ErrorMessage()
+	case dictimportrun.FieldStartedAt:
+		return m.StartedAt()
+	case dictimportrun.FieldFinishedAt:
+		return m.FinishedAt()
+	case dictimportrun.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
+	return nil, false
 }
 
-// AddedIsIdioms returns the value that was added to the "is_idioms" field in this mutation.
-func (m *QuizMutation) AddedIsIdioms() (r int, exists bool) {
-	v := m.addis_idioms
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DictImportRunMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dictimportrun.FieldSourcePath:
+		return m.OldSourcePath(ctx)
+	case dictimportrun.FieldFormat:
+		return m.OldFormat(ctx)
+	case dictimportrun.FieldSourceHash:
+		return m.OldSourceHash(ctx)
+	case dictimportrun.FieldStatus:
+		return m.OldStatus(ctx)
+	case dictimportrun.FieldLastCommittedIndex:
+		return m.OldLastCommittedIndex(ctx)
+	case dictimportrun.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case dictimportrun.FieldStartedAt:
+		return m.OldStartedAt(ctx)
+	case dictimportrun.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case dictimportrun.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown DictImportRun field %s", name)
 }
 
-// ResetIsIdioms resets all changes to the "is_idioms" field.
-func (m *QuizMutation) ResetIsIdioms() {
-	m.is_idioms = nil
-	m.addis_idioms = nil
-}
-
-// SetIsSpecialCharacters sets the "is_special_characters" field.
-func (m *QuizMutation) SetIsSpecialCharacters(i int) {
-	m.is_special_characters = &i
-	m.addis_special_characters = nil
-}
-
-// IsSpecialCharacters returns the value of the "is_special_characters" field in the mutation.
-func (m *QuizMutation) IsSpecialCharacters() (r int, exists bool) {
-	v := m.is_special_characters
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DictImportRunMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dictimportrun.FieldSourcePath:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourcePath(v)
+		return nil
+	case dictimportrun.FieldFormat:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFormat(v)
+		return nil
+	case dictimportrun.FieldSourceHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSourceHash(v)
+		return nil
+	case dictimportrun.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case dictimportrun.FieldLastCommittedIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCommittedIndex(v)
+		return nil
+	case dictimportrun.FieldErrorMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrorMessage(v)
+		return nil
+	case dictimportrun.FieldStartedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartedAt(v)
+		return nil
+	case dictimportrun.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case dictimportrun.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown DictImportRun field %s", name)
 }
 
-// OldIsSpecialCharacters returns the old "is_special_characters" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldIsSpecialCharacters(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSpecialCharacters is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSpecialCharacters requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSpecialCharacters: %w", err)
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DictImportRunMutation) AddedFields() []string {
+	var fields []string
+	if m.addlast_committed_index != nil {
+		fields = append(fields, dictimportrun.FieldLastCommittedIndex)
 	}
-	return oldValue.IsSpecialCharacters, nil
+	return fields
 }
 
-// AddIsSpecialCharacters adds i to the "is_special_characters" field.
-func (m *QuizMutation) AddIsSpecialCharacters(i int) {
-	if m.addis_special_characters != nil {
-		*m.addis_special_characters += i
-	} else {
-		m.addis_special_characters = &i
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DictImportRunMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case dictimportrun.FieldLastCommittedIndex:
+		return m.AddedLastCommittedIndex()
 	}
+	return nil, false
 }
 
-// AddedIsSpecialCharacters returns the value that was added to the "is_special_characters" field in this mutation.
-func (m *QuizMutation) AddedIsSpecialCharacters() (r int, exists bool) {
-	v := m.addis_special_characters
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DictImportRunMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case dictimportrun.FieldLastCommittedIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastCommittedIndex(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown DictImportRun numeric field %s", name)
 }
 
-// ResetIsSpecialCharacters resets all changes to the "is_special_characters" field.
-func (m *QuizMutation) ResetIsSpecialCharacters() {
-	m.is_special_characters = nil
-	m.addis_special_characters = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DictImportRunMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(dictimportrun.FieldErrorMessage) {
+		fields = append(fields, dictimportrun.FieldErrorMessage)
+	}
+	if m.FieldCleared(dictimportrun.FieldFinishedAt) {
+		fields = append(fields, dictimportrun.FieldFinishedAt)
+	}
+	return fields
 }
 
-// SetAttentionLevelList sets the "attention_level_list" field.
-func (m *QuizMutation) SetAttentionLevelList(i []int) {
-	m.attention_level_list = &i
-	m.appendattention_level_list = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DictImportRunMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// AttentionLevelList returns the value of the "attention_level_list" field in the mutation.
-func (m *QuizMutation) AttentionLevelList() (r []int, exists bool) {
-	v := m.attention_level_list
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DictImportRunMutation) ClearField(name string) error {
+	switch name {
+	case dictimportrun.FieldErrorMessage:
+		m.ClearErrorMessage()
+		return nil
+	case dictimportrun.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown DictImportRun nullable field %s", name)
 }
 
-// OldAttentionLevelList returns the old "attention_level_list" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldAttentionLevelList(ctx context.Context) (v []int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttentionLevelList is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttentionLevelList requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttentionLevelList: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DictImportRunMutation) ResetField(name string) error {
+	switch name {
+	case dictimportrun.FieldSourcePath:
+		m.ResetSourcePath()
+		return nil
+	case dictimportrun.FieldFormat:
+		m.ResetFormat()
+		return nil
+	case dictimportrun.FieldSourceHash:
+		m.ResetSourceHash()
+		return nil
+	case dictimportrun.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case dictimportrun.FieldLastCommittedIndex:
+		m.ResetLastCommittedIndex()
+		return nil
+	case dictimportrun.FieldErrorMessage:
+		m.ResetErrorMessage()
+		return nil
+	case dictimportrun.FieldStartedAt:
+		m.ResetStartedAt()
+		return nil
+	case dictimportrun.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case dictimportrun.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.AttentionLevelList, nil
-}
-
-// AppendAttentionLevelList adds i to the "attention_level_list" field.
-func (m *QuizMutation) AppendAttentionLevelList(i []int) {
-	m.appendattention_level_list = append(m.appendattention_level_list, i...)
+	return fmt.Errorf("unknown DictImportRun field %s", name)
 }
 
-// AppendedAttentionLevelList returns the list of values that were appended to the "attention_level_list" field in this mutation.
-func (m *QuizMutation) AppendedAttentionLevelList() ([]int, bool) {
-	if len(m.appendattention_level_list) == 0 {
-		return nil, false
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DictImportRunMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.failures != nil {
+		edges = append(edges, dictimportrun.EdgeFailures)
 	}
-	return m.appendattention_level_list, true
+	return edges
 }
 
-// ResetAttentionLevelList resets all changes to the "attention_level_list" field.
-func (m *QuizMutation) ResetAttentionLevelList() {
-	m.attention_level_list = nil
-	m.appendattention_level_list = nil
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DictImportRunMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case dictimportrun.EdgeFailures:
+		ids := make([]ent.Value, 0, len(m.failures))
+		for id := range m.failures {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetChoicesPosIds sets the "choices_pos_ids" field.
-func (m *QuizMutation) SetChoicesPosIds(i []int) {
-	m.choices_pos_ids = &i
-	m.appendchoices_pos_ids = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DictImportRunMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedfailures != nil {
+		edges = append(edges, dictimportrun.EdgeFailures)
+	}
+	return edges
 }
 
-// ChoicesPosIds returns the value of the "choices_pos_ids" field in the mutation.
-func (m *QuizMutation) ChoicesPosIds() (r []int, exists bool) {
-	v := m.choices_pos_ids
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DictImportRunMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case dictimportrun.EdgeFailures:
+		ids := make([]ent.Value, 0, len(m.removedfailures))
+		for id := range m.removedfailures {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldChoicesPosIds returns the old "choices_pos_ids" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldChoicesPosIds(ctx context.Context) (v []int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChoicesPosIds is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChoicesPosIds requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChoicesPosIds: %w", err)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DictImportRunMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedfailures {
+		edges = append(edges, dictimportrun.EdgeFailures)
 	}
-	return oldValue.ChoicesPosIds, nil
+	return edges
 }
 
-// AppendChoicesPosIds adds i to the "choices_pos_ids" field.
-func (m *QuizMutation) AppendChoicesPosIds(i []int) {
-	m.appendchoices_pos_ids = append(m.appendchoices_pos_ids, i...)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DictImportRunMutation) EdgeCleared(name string) bool {
+	switch name {
+	case dictimportrun.EdgeFailures:
+		return m.clearedfailures
+	}
+	return false
 }
 
-// AppendedChoicesPosIds returns the list of values that were appended to the "choices_pos_ids" field in this mutation.
-func (m *QuizMutation) AppendedChoicesPosIds() ([]int, bool) {
-	if len(m.appendchoices_pos_ids) == 0 {
-		return nil, false
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DictImportRunMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return m.appendchoices_pos_ids, true
+	return fmt.Errorf("unknown DictImportRun unique edge %s", name)
 }
 
-// ResetChoicesPosIds resets all changes to the "choices_pos_ids" field.
-func (m *QuizMutation) ResetChoicesPosIds() {
-	m.choices_pos_ids = nil
-	m.appendchoices_pos_ids = nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DictImportRunMutation) ResetEdge(name string) error {
+	switch name {
+	case dictimportrun.EdgeFailures:
+		m.ResetFailures()
+		return nil
+	}
+	return fmt.Errorf("unknown DictImportRun edge %s", name)
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *QuizMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ExternalAuthMutation represents an operation that mutates the ExternalAuth nodes in the graph.
+type ExternalAuthMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	provider         *string
+	provider_user_id *string
+	deleted_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *int
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*ExternalAuth, error)
+	predicates       []predicate.ExternalAuth
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuizMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+var _ ent.Mutation = (*ExternalAuthMutation)(nil)
+
+// externalauthOption allows management of the mutation configuration using functional options.
+type externalauthOption func(*ExternalAuthMutation)
+
+// newExternalAuthMutation creates new mutation for the ExternalAuth entity.
+func newExternalAuthMutation(c config, op Op, opts ...externalauthOption) *ExternalAuthMutation {
+	m := &ExternalAuthMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeExternalAuth,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withExternalAuthID sets the ID field of the mutation.
+func withExternalAuthID(id int) externalauthOption {
+	return func(m *ExternalAuthMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ExternalAuth
+		)
+		m.oldValue = func(ctx context.Context) (*ExternalAuth, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ExternalAuth.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withExternalAuth sets the old ExternalAuth of the mutation.
+func withExternalAuth(node *ExternalAuth) externalauthOption {
+	return func(m *ExternalAuthMutation) {
+		m.oldValue = func(context.Context) (*ExternalAuth, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ExternalAuthMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ExternalAuthMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ExternalAuthMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ExternalAuthMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ExternalAuth.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ExternalAuthMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ExternalAuthMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the ExternalAuth entity.
+// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ExternalAuthMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuizMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ExternalAuthMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *ExternalAuthMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *ExternalAuthMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the ExternalAuth entity.
+// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalAuthMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *ExternalAuthMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetProviderUserID sets the "provider_user_id" field.
+func (m *ExternalAuthMutation) SetProviderUserID(s string) {
+	m.provider_user_id = &s
+}
+
+// ProviderUserID returns the value of the "provider_user_id" field in the mutation.
+func (m *ExternalAuthMutation) ProviderUserID() (r string, exists bool) {
+	v := m.provider_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProviderUserID returns the old "provider_user_id" field's value of the ExternalAuth entity.
+// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ExternalAuthMutation) OldProviderUserID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProviderUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProviderUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProviderUserID: %w", err)
+	}
+	return oldValue.ProviderUserID, nil
+}
+
+// ResetProviderUserID resets all changes to the "provider_user_id" field.
+func (m *ExternalAuthMutation) ResetProviderUserID() {
+	m.provider_user_id = nil
 }
 
 // SetDeletedAt sets the "deleted_at" field.
-func (m *QuizMutation) SetDeletedAt(t time.Time) {
+func (m *ExternalAuthMutation) SetDeletedAt(t time.Time) {
 	m.deleted_at = &t
 }
 
 // DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *QuizMutation) DeletedAt() (r time.Time, exists bool) {
+func (m *ExternalAuthMutation) DeletedAt() (r time.Time, exists bool) {
 	v := m.deleted_at
 	if v == nil {
 		return
@@ -2621,10 +3460,10 @@ func (m *QuizMutation) DeletedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Quiz entity.
-// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the ExternalAuth entity.
+// If the ExternalAuth object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *ExternalAuthMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
@@ -2639,38 +3478,38 @@ func (m *QuizMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err erro
 }
 
 // ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *QuizMutation) ClearDeletedAt() {
+func (m *ExternalAuthMutation) ClearDeletedAt() {
 	m.deleted_at = nil
-	m.clearedFields[quiz.FieldDeletedAt] = struct{}{}
+	m.clearedFields[externalauth.FieldDeletedAt] = struct{}{}
 }
 
 // DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *QuizMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[quiz.FieldDeletedAt]
+func (m *ExternalAuthMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[externalauth.FieldDeletedAt]
 	return ok
 }
 
 // ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *QuizMutation) ResetDeletedAt() {
+func (m *ExternalAuthMutation) ResetDeletedAt() {
 	m.deleted_at = nil
-	delete(m.clearedFields, quiz.FieldDeletedAt)
+	delete(m.clearedFields, externalauth.FieldDeletedAt)
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *QuizMutation) ClearUser() {
+func (m *ExternalAuthMutation) ClearUser() {
 	m.cleareduser = true
-	m.clearedFields[quiz.FieldUserID] = struct{}{}
+	m.clearedFields[externalauth.FieldUserID] = struct{}{}
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *QuizMutation) UserCleared() bool {
+func (m *ExternalAuthMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *QuizMutation) UserIDs() (ids []int) {
+func (m *ExternalAuthMutation) UserIDs() (ids []int) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2678,74 +3517,20 @@ func (m *QuizMutation) UserIDs() (ids []int) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *QuizMutation) ResetUser() {
+func (m *ExternalAuthMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
-func (m *QuizMutation) AddQuizQuestionIDs(ids ...int) {
-	if m.quiz_questions == nil {
-		m.quiz_questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.quiz_questions[ids[i]] = struct{}{}
-	}
-}
-
-// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
-func (m *QuizMutation) ClearQuizQuestions() {
-	m.clearedquiz_questions = true
-}
-
-// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
-func (m *QuizMutation) QuizQuestionsCleared() bool {
-	return m.clearedquiz_questions
-}
-
-// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
-func (m *QuizMutation) RemoveQuizQuestionIDs(ids ...int) {
-	if m.removedquiz_questions == nil {
-		m.removedquiz_questions = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.quiz_questions, ids[i])
-		m.removedquiz_questions[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
-func (m *QuizMutation) RemovedQuizQuestionsIDs() (ids []int) {
-	for id := range m.removedquiz_questions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
-func (m *QuizMutation) QuizQuestionsIDs() (ids []int) {
-	for id := range m.quiz_questions {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
-func (m *QuizMutation) ResetQuizQuestions() {
-	m.quiz_questions = nil
-	m.clearedquiz_questions = false
-	m.removedquiz_questions = nil
-}
-
-// Where appends a list predicates to the QuizMutation builder.
-func (m *QuizMutation) Where(ps ...predicate.Quiz) {
+// Where appends a list predicates to the ExternalAuthMutation builder.
+func (m *ExternalAuthMutation) Where(ps ...predicate.ExternalAuth) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuizMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ExternalAuthMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuizMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Quiz, len(ps))
+func (m *ExternalAuthMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExternalAuth, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2753,69 +3538,36 @@ func (m *QuizMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuizMutation) Op() Op {
+func (m *ExternalAuthMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuizMutation) SetOp(op Op) {
+func (m *ExternalAuthMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Quiz).
-func (m *QuizMutation) Type() string {
+// Type returns the node type of this mutation (ExternalAuth).
+func (m *ExternalAuthMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuizMutation) Fields() []string {
-	fields := make([]string, 0, 15)
+func (m *ExternalAuthMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.user != nil {
-		fields = append(fields, quiz.FieldUserID)
-	}
-	if m.quiz_number != nil {
-		fields = append(fields, quiz.FieldQuizNumber)
-	}
-	if m.is_running != nil {
-		fields = append(fields, quiz.FieldIsRunning)
-	}
-	if m.total_questions_count != nil {
-		fields = append(fields, quiz.FieldTotalQuestionsCount)
-	}
-	if m.correct_count != nil {
-		fields = append(fields, quiz.FieldCorrectCount)
-	}
-	if m.result_correct_rate != nil {
-		fields = append(fields, quiz.FieldResultCorrectRate)
-	}
-	if m.is_save_result != nil {
-		fields = append(fields, quiz.FieldIsSaveResult)
-	}
-	if m.is_registered_words != nil {
-		fields = append(fields, quiz.FieldIsRegisteredWords)
-	}
-	if m.setting_correct_rate != nil {
-		fields = append(fields, quiz.FieldSettingCorrectRate)
-	}
-	if m.is_idioms != nil {
-		fields = append(fields, quiz.FieldIsIdioms)
-	}
-	if m.is_special_characters != nil {
-		fields = append(fields, quiz.FieldIsSpecialCharacters)
-	}
-	if m.attention_level_list != nil {
-		fields = append(fields, quiz.FieldAttentionLevelList)
+		fields = append(fields, externalauth.FieldUserID)
 	}
-	if m.choices_pos_ids != nil {
-		fields = append(fields, quiz.FieldChoicesPosIds)
+	if m.provider != nil {
+		fields = append(fields, externalauth.FieldProvider)
 	}
-	if m.created_at != nil {
-		fields = append(fields, quiz.FieldCreatedAt)
+	if m.provider_user_id != nil {
+		fields = append(fields, externalauth.FieldProviderUserID)
 	}
 	if m.deleted_at != nil {
-		fields = append(fields, quiz.FieldDeletedAt)
+		fields = append(fields, externalauth.FieldDeletedAt)
 	}
 	return fields
 }
@@ -2823,37 +3575,15 @@ func (m *QuizMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuizMutation) Field(name string) (ent.Value, bool) {
+func (m *ExternalAuthMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case quiz.FieldUserID:
+	case externalauth.FieldUserID:
 		return m.UserID()
-	case quiz.FieldQuizNumber:
-		return m.QuizNumber()
-	case quiz.FieldIsRunning:
-		return m.IsRunning()
-	case quiz.FieldTotalQuestionsCount:
-		return m.TotalQuestionsCount()
-	case quiz.FieldCorrectCount:
-		return m.CorrectCount()
-	case quiz.FieldResultCorrectRate:
-		return m.ResultCorrectRate()
-	case quiz.FieldIsSaveResult:
-		return m.IsSaveResult()
-	case quiz.FieldIsRegisteredWords:
-		return m.IsRegisteredWords()
-	case quiz.FieldSettingCorrectRate:
-		return m.SettingCorrectRate()
-	case quiz.FieldIsIdioms:
-		return m.IsIdioms()
-	case quiz.FieldIsSpecialCharacters:
-		return m.IsSpecialCharacters()
-	case quiz.FieldAttentionLevelList:
-		return m.AttentionLevelList()
-	case quiz.FieldChoicesPosIds:
-		return m.ChoicesPosIds()
-	case quiz.FieldCreatedAt:
-		return m.CreatedAt()
-	case quiz.FieldDeletedAt:
+	case externalauth.FieldProvider:
+		return m.Provider()
+	case externalauth.FieldProviderUserID:
+		return m.ProviderUserID()
+	case externalauth.FieldDeletedAt:
 		return m.DeletedAt()
 	}
 	return nil, false
@@ -2862,208 +3592,69 @@ func (m *QuizMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuizMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ExternalAuthMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case quiz.FieldUserID:
+	case externalauth.FieldUserID:
 		return m.OldUserID(ctx)
-	case quiz.FieldQuizNumber:
-		return m.OldQuizNumber(ctx)
-	case quiz.FieldIsRunning:
-		return m.OldIsRunning(ctx)
-	case quiz.FieldTotalQuestionsCount:
-		return m.OldTotalQuestionsCount(ctx)
-	case quiz.FieldCorrectCount:
-		return m.OldCorrectCount(ctx)
-	case quiz.FieldResultCorrectRate:
-		return m.OldResultCorrectRate(ctx)
-	case quiz.FieldIsSaveResult:
-		return m.OldIsSaveResult(ctx)
-	case quiz.FieldIsRegisteredWords:
-		return m.OldIsRegisteredWords(ctx)
-	case quiz.FieldSettingCorrectRate:
-		return m.OldSettingCorrectRate(ctx)
-	case quiz.FieldIsIdioms:
-		return m.OldIsIdioms(ctx)
-	case quiz.FieldIsSpecialCharacters:
-		return m.OldIsSpecialCharacters(ctx)
-	case quiz.FieldAttentionLevelList:
-		return m.OldAttentionLevelList(ctx)
-	case quiz.FieldChoicesPosIds:
-		return m.OldChoicesPosIds(ctx)
-	case quiz.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case quiz.FieldDeletedAt:
+	case externalauth.FieldProvider:
+		return m.OldProvider(ctx)
+	case externalauth.FieldProviderUserID:
+		return m.OldProviderUserID(ctx)
+	case externalauth.FieldDeletedAt:
 		return m.OldDeletedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Quiz field %s", name)
+	return nil, fmt.Errorf("unknown ExternalAuth field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuizMutation) SetField(name string, value ent.Value) error {
+func (m *ExternalAuthMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case quiz.FieldUserID:
+	case externalauth.FieldUserID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case quiz.FieldQuizNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuizNumber(v)
-		return nil
-	case quiz.FieldIsRunning:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsRunning(v)
-		return nil
-	case quiz.FieldTotalQuestionsCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalQuestionsCount(v)
-		return nil
-	case quiz.FieldCorrectCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCorrectCount(v)
-		return nil
-	case quiz.FieldResultCorrectRate:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResultCorrectRate(v)
-		return nil
-	case quiz.FieldIsSaveResult:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsSaveResult(v)
-		return nil
-	case quiz.FieldIsRegisteredWords:
-		v, ok := value.(int)
+	case externalauth.FieldProvider:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsRegisteredWords(v)
+		m.SetProvider(v)
 		return nil
-	case quiz.FieldSettingCorrectRate:
-		v, ok := value.(int)
+	case externalauth.FieldProviderUserID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSettingCorrectRate(v)
+		m.SetProviderUserID(v)
 		return nil
-	case quiz.FieldIsIdioms:
-		v, ok := value.(int)
+	case externalauth.FieldDeletedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsIdioms(v)
-		return nil
-	case quiz.FieldIsSpecialCharacters:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsSpecialCharacters(v)
-		return nil
-	case quiz.FieldAttentionLevelList:
-		v, ok := value.([]int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAttentionLevelList(v)
-		return nil
-	case quiz.FieldChoicesPosIds:
-		v, ok := value.([]int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChoicesPosIds(v)
-		return nil
-	case quiz.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case quiz.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
+		m.SetDeletedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Quiz field %s", name)
+	return fmt.Errorf("unknown ExternalAuth field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuizMutation) AddedFields() []string {
+func (m *ExternalAuthMutation) AddedFields() []string {
 	var fields []string
-	if m.addquiz_number != nil {
-		fields = append(fields, quiz.FieldQuizNumber)
-	}
-	if m.addtotal_questions_count != nil {
-		fields = append(fields, quiz.FieldTotalQuestionsCount)
-	}
-	if m.addcorrect_count != nil {
-		fields = append(fields, quiz.FieldCorrectCount)
-	}
-	if m.addresult_correct_rate != nil {
-		fields = append(fields, quiz.FieldResultCorrectRate)
-	}
-	if m.addis_registered_words != nil {
-		fields = append(fields, quiz.FieldIsRegisteredWords)
-	}
-	if m.addsetting_correct_rate != nil {
-		fields = append(fields, quiz.FieldSettingCorrectRate)
-	}
-	if m.addis_idioms != nil {
-		fields = append(fields, quiz.FieldIsIdioms)
-	}
-	if m.addis_special_characters != nil {
-		fields = append(fields, quiz.FieldIsSpecialCharacters)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuizMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ExternalAuthMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case quiz.FieldQuizNumber:
-		return m.AddedQuizNumber()
-	case quiz.FieldTotalQuestionsCount:
-		return m.AddedTotalQuestionsCount()
-	case quiz.FieldCorrectCount:
-		return m.AddedCorrectCount()
-	case quiz.FieldResultCorrectRate:
-		return m.AddedResultCorrectRate()
-	case quiz.FieldIsRegisteredWords:
-		return m.AddedIsRegisteredWords()
-	case quiz.FieldSettingCorrectRate:
-		return m.AddedSettingCorrectRate()
-	case quiz.FieldIsIdioms:
-		return m.AddedIsIdioms()
-	case quiz.FieldIsSpecialCharacters:
-		return m.AddedIsSpecialCharacters()
 	}
 	return nil, false
 }
@@ -3071,295 +3662,166 @@ func (m *QuizMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuizMutation) AddField(name string, value ent.Value) error {
+func (m *ExternalAuthMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case quiz.FieldQuizNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuizNumber(v)
-		return nil
-	case quiz.FieldTotalQuestionsCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalQuestionsCount(v)
-		return nil
-	case quiz.FieldCorrectCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddCorrectCount(v)
-		return nil
-	case quiz.FieldResultCorrectRate:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddResultCorrectRate(v)
-		return nil
-	case quiz.FieldIsRegisteredWords:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIsRegisteredWords(v)
-		return nil
-	case quiz.FieldSettingCorrectRate:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddSettingCorrectRate(v)
-		return nil
-	case quiz.FieldIsIdioms:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIsIdioms(v)
-		return nil
-	case quiz.FieldIsSpecialCharacters:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddIsSpecialCharacters(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Quiz numeric field %s", name)
+	return fmt.Errorf("unknown ExternalAuth numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuizMutation) ClearedFields() []string {
+func (m *ExternalAuthMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(quiz.FieldDeletedAt) {
-		fields = append(fields, quiz.FieldDeletedAt)
+	if m.FieldCleared(externalauth.FieldDeletedAt) {
+		fields = append(fields, externalauth.FieldDeletedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuizMutation) FieldCleared(name string) bool {
+func (m *ExternalAuthMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuizMutation) ClearField(name string) error {
+func (m *ExternalAuthMutation) ClearField(name string) error {
 	switch name {
-	case quiz.FieldDeletedAt:
+	case externalauth.FieldDeletedAt:
 		m.ClearDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Quiz nullable field %s", name)
+	return fmt.Errorf("unknown ExternalAuth nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuizMutation) ResetField(name string) error {
+func (m *ExternalAuthMutation) ResetField(name string) error {
 	switch name {
-	case quiz.FieldUserID:
+	case externalauth.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case quiz.FieldQuizNumber:
-		m.ResetQuizNumber()
-		return nil
-	case quiz.FieldIsRunning:
-		m.ResetIsRunning()
-		return nil
-	case quiz.FieldTotalQuestionsCount:
-		m.ResetTotalQuestionsCount()
-		return nil
-	case quiz.FieldCorrectCount:
-		m.ResetCorrectCount()
-		return nil
-	case quiz.FieldResultCorrectRate:
-		m.ResetResultCorrectRate()
-		return nil
-	case quiz.FieldIsSaveResult:
-		m.ResetIsSaveResult()
-		return nil
-	case quiz.FieldIsRegisteredWords:
-		m.ResetIsRegisteredWords()
-		return nil
-	case quiz.FieldSettingCorrectRate:
-		m.ResetSettingCorrectRate()
-		return nil
-	case quiz.FieldIsIdioms:
-		m.ResetIsIdioms()
-		return nil
-	case quiz.FieldIsSpecialCharacters:
-		m.ResetIsSpecialCharacters()
-		return nil
-	case quiz.FieldAttentionLevelList:
-		m.ResetAttentionLevelList()
-		return nil
-	case quiz.FieldChoicesPosIds:
-		m.ResetChoicesPosIds()
+	case externalauth.FieldProvider:
+		m.ResetProvider()
 		return nil
-	case quiz.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case externalauth.FieldProviderUserID:
+		m.ResetProviderUserID()
 		return nil
-	case quiz.FieldDeletedAt:
+	case externalauth.FieldDeletedAt:
 		m.ResetDeletedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Quiz field %s", name)
+	return fmt.Errorf("unknown ExternalAuth field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuizMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ExternalAuthMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, quiz.EdgeUser)
-	}
-	if m.quiz_questions != nil {
-		edges = append(edges, quiz.EdgeQuizQuestions)
+		edges = append(edges, externalauth.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuizMutation) AddedIDs(name string) []ent.Value {
+func (m *ExternalAuthMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case quiz.EdgeUser:
+	case externalauth.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case quiz.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.quiz_questions))
-		for id := range m.quiz_questions {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuizMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedquiz_questions != nil {
-		edges = append(edges, quiz.EdgeQuizQuestions)
-	}
+func (m *ExternalAuthMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuizMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case quiz.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
-		for id := range m.removedquiz_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ExternalAuthMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuizMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ExternalAuthMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, quiz.EdgeUser)
-	}
-	if m.clearedquiz_questions {
-		edges = append(edges, quiz.EdgeQuizQuestions)
+		edges = append(edges, externalauth.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuizMutation) EdgeCleared(name string) bool {
+func (m *ExternalAuthMutation) EdgeCleared(name string) bool {
 	switch name {
-	case quiz.EdgeUser:
+	case externalauth.EdgeUser:
 		return m.cleareduser
-	case quiz.EdgeQuizQuestions:
-		return m.clearedquiz_questions
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuizMutation) ClearEdge(name string) error {
+func (m *ExternalAuthMutation) ClearEdge(name string) error {
 	switch name {
-	case quiz.EdgeUser:
+	case externalauth.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Quiz unique edge %s", name)
+	return fmt.Errorf("unknown ExternalAuth unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuizMutation) ResetEdge(name string) error {
+func (m *ExternalAuthMutation) ResetEdge(name string) error {
 	switch name {
-	case quiz.EdgeUser:
+	case externalauth.EdgeUser:
 		m.ResetUser()
 		return nil
-	case quiz.EdgeQuizQuestions:
-		m.ResetQuizQuestions()
-		return nil
 	}
-	return fmt.Errorf("unknown Quiz edge %s", name)
+	return fmt.Errorf("unknown ExternalAuth edge %s", name)
 }
 
-// QuizQuestionMutation represents an operation that mutates the QuizQuestion nodes in the graph.
-type QuizQuestionMutation struct {
+// JapaneseMeanMutation represents an operation that mutates the JapaneseMean nodes in the graph.
+type JapaneseMeanMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *int
-	question_number      *int
-	addquestion_number   *int
-	wordName             *string
-	pos_id               *int
-	addpos_id            *int
-	choices_jpms         *[]models.ChoiceJpm
-	appendchoices_jpms   []models.ChoiceJpm
-	answer_jpm_id        *int
-	addanswer_jpm_id     *int
-	is_correct           *bool
-	answered_at          *time.Time
-	time_ms              *int
-	addtime_ms           *int
-	created_at           *time.Time
-	deleted_at           *time.Time
-	clearedFields        map[string]struct{}
-	quiz                 *int
-	clearedquiz          bool
-	word                 *int
-	clearedword          bool
-	japanese_mean        *int
-	clearedjapanese_mean bool
-	done                 bool
-	oldValue             func(context.Context) (*QuizQuestion, error)
-	predicates           []predicate.QuizQuestion
+	op                    Op
+	typ                   string
+	id                    *int
+	name                  *string
+	reading               *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	word_info             *int
+	clearedword_info      bool
+	quiz_questions        map[int]struct{}
+	removedquiz_questions map[int]struct{}
+	clearedquiz_questions bool
+	done                  bool
+	oldValue              func(context.Context) (*JapaneseMean, error)
+	predicates            []predicate.JapaneseMean
 }
 
-var _ ent.Mutation = (*QuizQuestionMutation)(nil)
+var _ ent.Mutation = (*JapaneseMeanMutation)(nil)
 
-// quizquestionOption allows management of the mutation configuration using functional options.
-type quizquestionOption func(*QuizQuestionMutation)
+// japanesemeanOption allows management of the mutation configuration using functional options.
+type japanesemeanOption func(*JapaneseMeanMutation)
 
-// newQuizQuestionMutation creates new mutation for the QuizQuestion entity.
-func newQuizQuestionMutation(c config, op Op, opts ...quizquestionOption) *QuizQuestionMutation {
-	m := &QuizQuestionMutation{
+// newJapaneseMeanMutation creates new mutation for the JapaneseMean entity.
+func newJapaneseMeanMutation(c config, op Op, opts ...japanesemeanOption) *JapaneseMeanMutation {
+	m := &JapaneseMeanMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeQuizQuestion,
+		typ:           TypeJapaneseMean,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3368,20 +3830,20 @@ func newQuizQuestionMutation(c config, op Op, opts ...quizquestionOption) *QuizQ
 	return m
 }
 
-// withQuizQuestionID sets the ID field of the mutation.
-func withQuizQuestionID(id int) quizquestionOption {
-	return func(m *QuizQuestionMutation) {
+// withJapaneseMeanID sets the ID field of the mutation.
+func withJapaneseMeanID(id int) japanesemeanOption {
+	return func(m *JapaneseMeanMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *QuizQuestion
+			value *JapaneseMean
 		)
-		m.oldValue = func(ctx context.Context) (*QuizQuestion, error) {
+		m.oldValue = func(ctx context.Context) (*JapaneseMean, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().QuizQuestion.Get(ctx, id)
+					value, err = m.Client().JapaneseMean.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3390,10 +3852,10 @@ func withQuizQuestionID(id int) quizquestionOption {
 	}
 }
 
-// withQuizQuestion sets the old QuizQuestion of the mutation.
-func withQuizQuestion(node *QuizQuestion) quizquestionOption {
-	return func(m *QuizQuestionMutation) {
-		m.oldValue = func(context.Context) (*QuizQuestion, error) {
+// withJapaneseMean sets the old JapaneseMean of the mutation.
+func withJapaneseMean(node *JapaneseMean) japanesemeanOption {
+	return func(m *JapaneseMeanMutation) {
+		m.oldValue = func(context.Context) (*JapaneseMean, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3402,7 +3864,7 @@ func withQuizQuestion(node *QuizQuestion) quizquestionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m QuizQuestionMutation) Client() *Client {
+func (m JapaneseMeanMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3410,7 +3872,7 @@ func (m QuizQuestionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m QuizQuestionMutation) Tx() (*Tx, error) {
+func (m JapaneseMeanMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3421,7 +3883,7 @@ func (m QuizQuestionMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *QuizQuestionMutation) ID() (id int, exists bool) {
+func (m *JapaneseMeanMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3432,7 +3894,7 @@ func (m *QuizQuestionMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *QuizQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *JapaneseMeanMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3441,564 +3903,761 @@ func (m *QuizQuestionMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().QuizQuestion.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().JapaneseMean.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetQuizID sets the "quiz_id" field.
-func (m *QuizQuestionMutation) SetQuizID(i int) {
-	m.quiz = &i
+// SetName sets the "name" field.
+func (m *JapaneseMeanMutation) SetName(s string) {
+	m.name = &s
 }
 
-// QuizID returns the value of the "quiz_id" field in the mutation.
-func (m *QuizQuestionMutation) QuizID() (r int, exists bool) {
-	v := m.quiz
+// Name returns the value of the "name" field in the mutation.
+func (m *JapaneseMeanMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuizID returns the old "quiz_id" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the JapaneseMean entity.
+// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldQuizID(ctx context.Context) (v int, err error) {
+func (m *JapaneseMeanMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuizID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuizID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuizID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.QuizID, nil
+	return oldValue.Name, nil
 }
 
-// ResetQuizID resets all changes to the "quiz_id" field.
-func (m *QuizQuestionMutation) ResetQuizID() {
-	m.quiz = nil
+// ResetName resets all changes to the "name" field.
+func (m *JapaneseMeanMutation) ResetName() {
+	m.name = nil
 }
 
-// SetQuestionNumber sets the "question_number" field.
-func (m *QuizQuestionMutation) SetQuestionNumber(i int) {
-	m.question_number = &i
-	m.addquestion_number = nil
+// SetReading sets the "reading" field.
+func (m *JapaneseMeanMutation) SetReading(s string) {
+	m.reading = &s
 }
 
-// QuestionNumber returns the value of the "question_number" field in the mutation.
-func (m *QuizQuestionMutation) QuestionNumber() (r int, exists bool) {
-	v := m.question_number
+// Reading returns the value of the "reading" field in the mutation.
+func (m *JapaneseMeanMutation) Reading() (r string, exists bool) {
+	v := m.reading
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuestionNumber returns the old "question_number" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldReading returns the old "reading" field's value of the JapaneseMean entity.
+// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldQuestionNumber(ctx context.Context) (v int, err error) {
+func (m *JapaneseMeanMutation) OldReading(ctx context.Context) (v *string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuestionNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldReading is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuestionNumber requires an ID field in the mutation")
+		return v, errors.New("OldReading requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuestionNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldReading: %w", err)
 	}
-	return oldValue.QuestionNumber, nil
+	return oldValue.Reading, nil
 }
 
-// AddQuestionNumber adds i to the "question_number" field.
-func (m *QuizQuestionMutation) AddQuestionNumber(i int) {
-	if m.addquestion_number != nil {
-		*m.addquestion_number += i
-	} else {
-		m.addquestion_number = &i
-	}
+// ClearReading clears the value of the "reading" field.
+func (m *JapaneseMeanMutation) ClearReading() {
+	m.reading = nil
+	m.clearedFields[japanesemean.FieldReading] = struct{}{}
 }
 
-// AddedQuestionNumber returns the value that was added to the "question_number" field in this mutation.
-func (m *QuizQuestionMutation) AddedQuestionNumber() (r int, exists bool) {
-	v := m.addquestion_number
-	if v == nil {
-		return
-	}
-	return *v, true
+// ReadingCleared returns if the "reading" field was cleared in this mutation.
+func (m *JapaneseMeanMutation) ReadingCleared() bool {
+	_, ok := m.clearedFields[japanesemean.FieldReading]
+	return ok
 }
 
-// ResetQuestionNumber resets all changes to the "question_number" field.
-func (m *QuizQuestionMutation) ResetQuestionNumber() {
-	m.question_number = nil
-	m.addquestion_number = nil
+// ResetReading resets all changes to the "reading" field.
+func (m *JapaneseMeanMutation) ResetReading() {
+	m.reading = nil
+	delete(m.clearedFields, japanesemean.FieldReading)
 }
 
-// SetWordID sets the "word_id" field.
-func (m *QuizQuestionMutation) SetWordID(i int) {
-	m.word = &i
+// SetWordInfoID sets the "word_info_id" field.
+func (m *JapaneseMeanMutation) SetWordInfoID(i int) {
+	m.word_info = &i
 }
 
-// WordID returns the value of the "word_id" field in the mutation.
-func (m *QuizQuestionMutation) WordID() (r int, exists bool) {
-	v := m.word
+// WordInfoID returns the value of the "word_info_id" field in the mutation.
+func (m *JapaneseMeanMutation) WordInfoID() (r int, exists bool) {
+	v := m.word_info
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWordID returns the old "word_id" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldWordInfoID returns the old "word_info_id" field's value of the JapaneseMean entity.
+// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldWordID(ctx context.Context) (v int, err error) {
+func (m *JapaneseMeanMutation) OldWordInfoID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWordInfoID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWordID requires an ID field in the mutation")
+		return v, errors.New("OldWordInfoID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWordInfoID: %w", err)
 	}
-	return oldValue.WordID, nil
+	return oldValue.WordInfoID, nil
 }
 
-// ResetWordID resets all changes to the "word_id" field.
-func (m *QuizQuestionMutation) ResetWordID() {
-	m.word = nil
+// ResetWordInfoID resets all changes to the "word_info_id" field.
+func (m *JapaneseMeanMutation) ResetWordInfoID() {
+	m.word_info = nil
 }
 
-// SetWordName sets the "wordName" field.
-func (m *QuizQuestionMutation) SetWordName(s string) {
-	m.wordName = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *JapaneseMeanMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// WordName returns the value of the "wordName" field in the mutation.
-func (m *QuizQuestionMutation) WordName() (r string, exists bool) {
-	v := m.wordName
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JapaneseMeanMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWordName returns the old "wordName" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the JapaneseMean entity.
+// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldWordName(ctx context.Context) (v string, err error) {
+func (m *JapaneseMeanMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWordName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWordName requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWordName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.WordName, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetWordName resets all changes to the "wordName" field.
-func (m *QuizQuestionMutation) ResetWordName() {
-	m.wordName = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JapaneseMeanMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetPosID sets the "pos_id" field.
-func (m *QuizQuestionMutation) SetPosID(i int) {
-	m.pos_id = &i
-	m.addpos_id = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *JapaneseMeanMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// PosID returns the value of the "pos_id" field in the mutation.
-func (m *QuizQuestionMutation) PosID() (r int, exists bool) {
-	v := m.pos_id
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *JapaneseMeanMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPosID returns the old "pos_id" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the JapaneseMean entity.
+// If the JapaneseMean object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldPosID(ctx context.Context) (v int, err error) {
+func (m *JapaneseMeanMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPosID is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPosID requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPosID: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.PosID, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// AddPosID adds i to the "pos_id" field.
-func (m *QuizQuestionMutation) AddPosID(i int) {
-	if m.addpos_id != nil {
-		*m.addpos_id += i
-	} else {
-		m.addpos_id = &i
-	}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *JapaneseMeanMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// AddedPosID returns the value that was added to the "pos_id" field in this mutation.
-func (m *QuizQuestionMutation) AddedPosID() (r int, exists bool) {
-	v := m.addpos_id
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearWordInfo clears the "word_info" edge to the WordInfo entity.
+func (m *JapaneseMeanMutation) ClearWordInfo() {
+	m.clearedword_info = true
+	m.clearedFields[japanesemean.FieldWordInfoID] = struct{}{}
 }
 
-// ResetPosID resets all changes to the "pos_id" field.
-func (m *QuizQuestionMutation) ResetPosID() {
-	m.pos_id = nil
-	m.addpos_id = nil
+// WordInfoCleared reports if the "word_info" edge to the WordInfo entity was cleared.
+func (m *JapaneseMeanMutation) WordInfoCleared() bool {
+	return m.clearedword_info
 }
 
-// SetCorrectJpmID sets the "correct_jpm_id" field.
-func (m *QuizQuestionMutation) SetCorrectJpmID(i int) {
-	m.japanese_mean = &i
+// WordInfoIDs returns the "word_info" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WordInfoID instead. It exists only for internal usage by the builders.
+func (m *JapaneseMeanMutation) WordInfoIDs() (ids []int) {
+	if id := m.word_info; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// CorrectJpmID returns the value of the "correct_jpm_id" field in the mutation.
-func (m *QuizQuestionMutation) CorrectJpmID() (r int, exists bool) {
-	v := m.japanese_mean
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetWordInfo resets all changes to the "word_info" edge.
+func (m *JapaneseMeanMutation) ResetWordInfo() {
+	m.word_info = nil
+	m.clearedword_info = false
 }
 
-// OldCorrectJpmID returns the old "correct_jpm_id" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldCorrectJpmID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrectJpmID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrectJpmID requires an ID field in the mutation")
+// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
+func (m *JapaneseMeanMutation) AddQuizQuestionIDs(ids ...int) {
+	if m.quiz_questions == nil {
+		m.quiz_questions = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrectJpmID: %w", err)
+	for i := range ids {
+		m.quiz_questions[ids[i]] = struct{}{}
 	}
-	return oldValue.CorrectJpmID, nil
-}
-
-// ResetCorrectJpmID resets all changes to the "correct_jpm_id" field.
-func (m *QuizQuestionMutation) ResetCorrectJpmID() {
-	m.japanese_mean = nil
 }
 
-// SetChoicesJpms sets the "choices_jpms" field.
-func (m *QuizQuestionMutation) SetChoicesJpms(mj []models.ChoiceJpm) {
-	m.choices_jpms = &mj
-	m.appendchoices_jpms = nil
+// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
+func (m *JapaneseMeanMutation) ClearQuizQuestions() {
+	m.clearedquiz_questions = true
 }
 
-// ChoicesJpms returns the value of the "choices_jpms" field in the mutation.
-func (m *QuizQuestionMutation) ChoicesJpms() (r []models.ChoiceJpm, exists bool) {
-	v := m.choices_jpms
-	if v == nil {
-		return
-	}
-	return *v, true
+// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
+func (m *JapaneseMeanMutation) QuizQuestionsCleared() bool {
+	return m.clearedquiz_questions
 }
 
-// OldChoicesJpms returns the old "choices_jpms" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldChoicesJpms(ctx context.Context) (v []models.ChoiceJpm, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChoicesJpms is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChoicesJpms requires an ID field in the mutation")
+// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
+func (m *JapaneseMeanMutation) RemoveQuizQuestionIDs(ids ...int) {
+	if m.removedquiz_questions == nil {
+		m.removedquiz_questions = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChoicesJpms: %w", err)
+	for i := range ids {
+		delete(m.quiz_questions, ids[i])
+		m.removedquiz_questions[ids[i]] = struct{}{}
 	}
-	return oldValue.ChoicesJpms, nil
 }
 
-// AppendChoicesJpms adds mj to the "choices_jpms" field.
-func (m *QuizQuestionMutation) AppendChoicesJpms(mj []models.ChoiceJpm) {
-	m.appendchoices_jpms = append(m.appendchoices_jpms, mj...)
-}
-
-// AppendedChoicesJpms returns the list of values that were appended to the "choices_jpms" field in this mutation.
-func (m *QuizQuestionMutation) AppendedChoicesJpms() ([]models.ChoiceJpm, bool) {
-	if len(m.appendchoices_jpms) == 0 {
-		return nil, false
+// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
+func (m *JapaneseMeanMutation) RemovedQuizQuestionsIDs() (ids []int) {
+	for id := range m.removedquiz_questions {
+		ids = append(ids, id)
 	}
-	return m.appendchoices_jpms, true
-}
-
-// ResetChoicesJpms resets all changes to the "choices_jpms" field.
-func (m *QuizQuestionMutation) ResetChoicesJpms() {
-	m.choices_jpms = nil
-	m.appendchoices_jpms = nil
-}
-
-// SetAnswerJpmID sets the "answer_jpm_id" field.
-func (m *QuizQuestionMutation) SetAnswerJpmID(i int) {
-	m.answer_jpm_id = &i
-	m.addanswer_jpm_id = nil
+	return
 }
 
-// AnswerJpmID returns the value of the "answer_jpm_id" field in the mutation.
-func (m *QuizQuestionMutation) AnswerJpmID() (r int, exists bool) {
-	v := m.answer_jpm_id
-	if v == nil {
-		return
+// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
+func (m *JapaneseMeanMutation) QuizQuestionsIDs() (ids []int) {
+	for id := range m.quiz_questions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldAnswerJpmID returns the old "answer_jpm_id" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldAnswerJpmID(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnswerJpmID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnswerJpmID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnswerJpmID: %w", err)
-	}
-	return oldValue.AnswerJpmID, nil
+// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
+func (m *JapaneseMeanMutation) ResetQuizQuestions() {
+	m.quiz_questions = nil
+	m.clearedquiz_questions = false
+	m.removedquiz_questions = nil
 }
 
-// AddAnswerJpmID adds i to the "answer_jpm_id" field.
-func (m *QuizQuestionMutation) AddAnswerJpmID(i int) {
-	if m.addanswer_jpm_id != nil {
-		*m.addanswer_jpm_id += i
-	} else {
-		m.addanswer_jpm_id = &i
-	}
+// Where appends a list predicates to the JapaneseMeanMutation builder.
+func (m *JapaneseMeanMutation) Where(ps ...predicate.JapaneseMean) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddedAnswerJpmID returns the value that was added to the "answer_jpm_id" field in this mutation.
-func (m *QuizQuestionMutation) AddedAnswerJpmID() (r int, exists bool) {
-	v := m.addanswer_jpm_id
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the JapaneseMeanMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JapaneseMeanMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.JapaneseMean, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
-}
-
-// ClearAnswerJpmID clears the value of the "answer_jpm_id" field.
-func (m *QuizQuestionMutation) ClearAnswerJpmID() {
-	m.answer_jpm_id = nil
-	m.addanswer_jpm_id = nil
-	m.clearedFields[quizquestion.FieldAnswerJpmID] = struct{}{}
+	m.Where(p...)
 }
 
-// AnswerJpmIDCleared returns if the "answer_jpm_id" field was cleared in this mutation.
-func (m *QuizQuestionMutation) AnswerJpmIDCleared() bool {
-	_, ok := m.clearedFields[quizquestion.FieldAnswerJpmID]
-	return ok
+// Op returns the operation name.
+func (m *JapaneseMeanMutation) Op() Op {
+	return m.op
 }
 
-// ResetAnswerJpmID resets all changes to the "answer_jpm_id" field.
-func (m *QuizQuestionMutation) ResetAnswerJpmID() {
-	m.answer_jpm_id = nil
-	m.addanswer_jpm_id = nil
-	delete(m.clearedFields, quizquestion.FieldAnswerJpmID)
+// SetOp allows setting the mutation operation.
+func (m *JapaneseMeanMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetIsCorrect sets the "is_correct" field.
-func (m *QuizQuestionMutation) SetIsCorrect(b bool) {
-	m.is_correct = &b
+// Type returns the node type of this mutation (JapaneseMean).
+func (m *JapaneseMeanMutation) Type() string {
+	return m.typ
 }
 
-// IsCorrect returns the value of the "is_correct" field in the mutation.
-func (m *QuizQuestionMutation) IsCorrect() (r bool, exists bool) {
-	v := m.is_correct
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JapaneseMeanMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.name != nil {
+		fields = append(fields, japanesemean.FieldName)
 	}
-	return *v, true
-}
-
-// OldIsCorrect returns the old "is_correct" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldIsCorrect(ctx context.Context) (v *bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsCorrect is only allowed on UpdateOne operations")
+	if m.reading != nil {
+		fields = append(fields, japanesemean.FieldReading)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsCorrect requires an ID field in the mutation")
+	if m.word_info != nil {
+		fields = append(fields, japanesemean.FieldWordInfoID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsCorrect: %w", err)
+	if m.created_at != nil {
+		fields = append(fields, japanesemean.FieldCreatedAt)
 	}
-	return oldValue.IsCorrect, nil
+	if m.updated_at != nil {
+		fields = append(fields, japanesemean.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearIsCorrect clears the value of the "is_correct" field.
-func (m *QuizQuestionMutation) ClearIsCorrect() {
-	m.is_correct = nil
-	m.clearedFields[quizquestion.FieldIsCorrect] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JapaneseMeanMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case japanesemean.FieldName:
+		return m.Name()
+	case japanesemean.FieldReading:
+		return m.Reading()
+	case japanesemean.FieldWordInfoID:
+		return m.WordInfoID()
+	case japanesemean.FieldCreatedAt:
+		return m.CreatedAt()
+	case japanesemean.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// IsCorrectCleared returns if the "is_correct" field was cleared in this mutation.
-func (m *QuizQuestionMutation) IsCorrectCleared() bool {
-	_, ok := m.clearedFields[quizquestion.FieldIsCorrect]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JapaneseMeanMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case japanesemean.FieldName:
+		return m.OldName(ctx)
+	case japanesemean.FieldReading:
+		return m.OldReading(ctx)
+	case japanesemean.FieldWordInfoID:
+		return m.OldWordInfoID(ctx)
+	case japanesemean.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case japanesemean.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown JapaneseMean field %s", name)
 }
 
-// ResetIsCorrect resets all changes to the "is_correct" field.
-func (m *QuizQuestionMutation) ResetIsCorrect() {
-	m.is_correct = nil
-	delete(m.clearedFields, quizquestion.FieldIsCorrect)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JapaneseMeanMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case japanesemean.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case japanesemean.FieldReading:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReading(v)
+		return nil
+	case japanesemean.FieldWordInfoID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordInfoID(v)
+		return nil
+	case japanesemean.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case japanesemean.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown JapaneseMean field %s", name)
 }
 
-// SetAnsweredAt sets the "answered_at" field.
-func (m *QuizQuestionMutation) SetAnsweredAt(t time.Time) {
-	m.answered_at = &t
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JapaneseMeanMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// AnsweredAt returns the value of the "answered_at" field in the mutation.
-func (m *QuizQuestionMutation) AnsweredAt() (r time.Time, exists bool) {
-	v := m.answered_at
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JapaneseMeanMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldAnsweredAt returns the old "answered_at" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldAnsweredAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAnsweredAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAnsweredAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAnsweredAt: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JapaneseMeanMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.AnsweredAt, nil
+	return fmt.Errorf("unknown JapaneseMean numeric field %s", name)
 }
 
-// ClearAnsweredAt clears the value of the "answered_at" field.
-func (m *QuizQuestionMutation) ClearAnsweredAt() {
-	m.answered_at = nil
-	m.clearedFields[quizquestion.FieldAnsweredAt] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JapaneseMeanMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(japanesemean.FieldReading) {
+		fields = append(fields, japanesemean.FieldReading)
+	}
+	return fields
 }
 
-// AnsweredAtCleared returns if the "answered_at" field was cleared in this mutation.
-func (m *QuizQuestionMutation) AnsweredAtCleared() bool {
-	_, ok := m.clearedFields[quizquestion.FieldAnsweredAt]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JapaneseMeanMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetAnsweredAt resets all changes to the "answered_at" field.
-func (m *QuizQuestionMutation) ResetAnsweredAt() {
-	m.answered_at = nil
-	delete(m.clearedFields, quizquestion.FieldAnsweredAt)
-}
-
-// SetTimeMs sets the "time_ms" field.
-func (m *QuizQuestionMutation) SetTimeMs(i int) {
-	m.time_ms = &i
-	m.addtime_ms = nil
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JapaneseMeanMutation) ClearField(name string) error {
+	switch name {
+	case japanesemean.FieldReading:
+		m.ClearReading()
+		return nil
+	}
+	return fmt.Errorf("unknown JapaneseMean nullable field %s", name)
 }
 
-// TimeMs returns the value of the "time_ms" field in the mutation.
-func (m *QuizQuestionMutation) TimeMs() (r int, exists bool) {
-	v := m.time_ms
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JapaneseMeanMutation) ResetField(name string) error {
+	switch name {
+	case japanesemean.FieldName:
+		m.ResetName()
+		return nil
+	case japanesemean.FieldReading:
+		m.ResetReading()
+		return nil
+	case japanesemean.FieldWordInfoID:
+		m.ResetWordInfoID()
+		return nil
+	case japanesemean.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case japanesemean.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown JapaneseMean field %s", name)
 }
 
-// OldTimeMs returns the old "time_ms" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldTimeMs(ctx context.Context) (v *int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTimeMs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTimeMs requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JapaneseMeanMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.word_info != nil {
+		edges = append(edges, japanesemean.EdgeWordInfo)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTimeMs: %w", err)
+	if m.quiz_questions != nil {
+		edges = append(edges, japanesemean.EdgeQuizQuestions)
 	}
-	return oldValue.TimeMs, nil
+	return edges
 }
 
-// AddTimeMs adds i to the "time_ms" field.
-func (m *QuizQuestionMutation) AddTimeMs(i int) {
-	if m.addtime_ms != nil {
-		*m.addtime_ms += i
-	} else {
-		m.addtime_ms = &i
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JapaneseMeanMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case japanesemean.EdgeWordInfo:
+		if id := m.word_info; id != nil {
+			return []ent.Value{*id}
+		}
+	case japanesemean.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.quiz_questions))
+		for id := range m.quiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
 	}
+	return nil
 }
 
-// AddedTimeMs returns the value that was added to the "time_ms" field in this mutation.
-func (m *QuizQuestionMutation) AddedTimeMs() (r int, exists bool) {
-	v := m.addtime_ms
-	if v == nil {
-		return
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JapaneseMeanMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedquiz_questions != nil {
+		edges = append(edges, japanesemean.EdgeQuizQuestions)
 	}
-	return *v, true
+	return edges
 }
 
-// ClearTimeMs clears the value of the "time_ms" field.
-func (m *QuizQuestionMutation) ClearTimeMs() {
-	m.time_ms = nil
-	m.addtime_ms = nil
-	m.clearedFields[quizquestion.FieldTimeMs] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JapaneseMeanMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case japanesemean.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
+		for id := range m.removedquiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// TimeMsCleared returns if the "time_ms" field was cleared in this mutation.
-func (m *QuizQuestionMutation) TimeMsCleared() bool {
-	_, ok := m.clearedFields[quizquestion.FieldTimeMs]
-	return ok
-}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JapaneseMeanMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedword_info {
+		edges = append(edges, japanesemean.EdgeWordInfo)
+	}
+	if m.clearedquiz_questions {
+		edges = append(edges, japanesemean.EdgeQuizQuestions)
+	}
+	return edges
+}
 
-// ResetTimeMs resets all changes to the "time_ms" field.
-func (m *QuizQuestionMutation) ResetTimeMs() {
-	m.time_ms = nil
-	m.addtime_ms = nil
-	delete(m.clearedFields, quizquestion.FieldTimeMs)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JapaneseMeanMutation) EdgeCleared(name string) bool {
+	switch name {
+	case japanesemean.EdgeWordInfo:
+		return m.clearedword_info
+	case japanesemean.EdgeQuizQuestions:
+		return m.clearedquiz_questions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JapaneseMeanMutation) ClearEdge(name string) error {
+	switch name {
+	case japanesemean.EdgeWordInfo:
+		m.ClearWordInfo()
+		return nil
+	}
+	return fmt.Errorf("unknown JapaneseMean unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JapaneseMeanMutation) ResetEdge(name string) error {
+	switch name {
+	case japanesemean.EdgeWordInfo:
+		m.ResetWordInfo()
+		return nil
+	case japanesemean.EdgeQuizQuestions:
+		m.ResetQuizQuestions()
+		return nil
+	}
+	return fmt.Errorf("unknown JapaneseMean edge %s", name)
+}
+
+// PartOfSpeechMutation represents an operation that mutates the PartOfSpeech nodes in the graph.
+type PartOfSpeechMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	word_infos          map[int]struct{}
+	removedword_infos   map[int]struct{}
+	clearedword_infos   bool
+	pos_mappings        map[int]struct{}
+	removedpos_mappings map[int]struct{}
+	clearedpos_mappings bool
+	done                bool
+	oldValue            func(context.Context) (*PartOfSpeech, error)
+	predicates          []predicate.PartOfSpeech
+}
+
+var _ ent.Mutation = (*PartOfSpeechMutation)(nil)
+
+// partofspeechOption allows management of the mutation configuration using functional options.
+type partofspeechOption func(*PartOfSpeechMutation)
+
+// newPartOfSpeechMutation creates new mutation for the PartOfSpeech entity.
+func newPartOfSpeechMutation(c config, op Op, opts ...partofspeechOption) *PartOfSpeechMutation {
+	m := &PartOfSpeechMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePartOfSpeech,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPartOfSpeechID sets the ID field of the mutation.
+func withPartOfSpeechID(id int) partofspeechOption {
+	return func(m *PartOfSpeechMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PartOfSpeech
+		)
+		m.oldValue = func(ctx context.Context) (*PartOfSpeech, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PartOfSpeech.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPartOfSpeech sets the old PartOfSpeech of the mutation.
+func withPartOfSpeech(node *PartOfSpeech) partofspeechOption {
+	return func(m *PartOfSpeechMutation) {
+		m.oldValue = func(context.Context) (*PartOfSpeech, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PartOfSpeechMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PartOfSpeechMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PartOfSpeechMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PartOfSpeechMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PartOfSpeech.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *PartOfSpeechMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *PartOfSpeechMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the PartOfSpeech entity.
+// If the PartOfSpeech object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PartOfSpeechMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *PartOfSpeechMutation) ResetName() {
+	m.name = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *QuizQuestionMutation) SetCreatedAt(t time.Time) {
+func (m *PartOfSpeechMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *QuizQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PartOfSpeechMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4006,10 +4665,10 @@ func (m *QuizQuestionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PartOfSpeech entity.
+// If the PartOfSpeech object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PartOfSpeechMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4024,162 +4683,163 @@ func (m *QuizQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, e
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *QuizQuestionMutation) ResetCreatedAt() {
+func (m *PartOfSpeechMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *QuizQuestionMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PartOfSpeechMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *QuizQuestionMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PartOfSpeechMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the QuizQuestion entity.
-// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the PartOfSpeech entity.
+// If the PartOfSpeech object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *QuizQuestionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *PartOfSpeechMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *QuizQuestionMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[quizquestion.FieldDeletedAt] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PartOfSpeechMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *QuizQuestionMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[quizquestion.FieldDeletedAt]
-	return ok
+// AddWordInfoIDs adds the "word_infos" edge to the WordInfo entity by ids.
+func (m *PartOfSpeechMutation) AddWordInfoIDs(ids ...int) {
+	if m.word_infos == nil {
+		m.word_infos = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.word_infos[ids[i]] = struct{}{}
+	}
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *QuizQuestionMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, quizquestion.FieldDeletedAt)
+// ClearWordInfos clears the "word_infos" edge to the WordInfo entity.
+func (m *PartOfSpeechMutation) ClearWordInfos() {
+	m.clearedword_infos = true
 }
 
-// ClearQuiz clears the "quiz" edge to the Quiz entity.
-func (m *QuizQuestionMutation) ClearQuiz() {
-	m.clearedquiz = true
-	m.clearedFields[quizquestion.FieldQuizID] = struct{}{}
+// WordInfosCleared reports if the "word_infos" edge to the WordInfo entity was cleared.
+func (m *PartOfSpeechMutation) WordInfosCleared() bool {
+	return m.clearedword_infos
 }
 
-// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
-func (m *QuizQuestionMutation) QuizCleared() bool {
-	return m.clearedquiz
+// RemoveWordInfoIDs removes the "word_infos" edge to the WordInfo entity by IDs.
+func (m *PartOfSpeechMutation) RemoveWordInfoIDs(ids ...int) {
+	if m.removedword_infos == nil {
+		m.removedword_infos = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.word_infos, ids[i])
+		m.removedword_infos[ids[i]] = struct{}{}
+	}
 }
 
-// QuizIDs returns the "quiz" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// QuizID instead. It exists only for internal usage by the builders.
-func (m *QuizQuestionMutation) QuizIDs() (ids []int) {
-	if id := m.quiz; id != nil {
-		ids = append(ids, *id)
+// RemovedWordInfos returns the removed IDs of the "word_infos" edge to the WordInfo entity.
+func (m *PartOfSpeechMutation) RemovedWordInfosIDs() (ids []int) {
+	for id := range m.removedword_infos {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetQuiz resets all changes to the "quiz" edge.
-func (m *QuizQuestionMutation) ResetQuiz() {
-	m.quiz = nil
-	m.clearedquiz = false
-}
-
-// ClearWord clears the "word" edge to the Word entity.
-func (m *QuizQuestionMutation) ClearWord() {
-	m.clearedword = true
-	m.clearedFields[quizquestion.FieldWordID] = struct{}{}
+// WordInfosIDs returns the "word_infos" edge IDs in the mutation.
+func (m *PartOfSpeechMutation) WordInfosIDs() (ids []int) {
+	for id := range m.word_infos {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// WordCleared reports if the "word" edge to the Word entity was cleared.
-func (m *QuizQuestionMutation) WordCleared() bool {
-	return m.clearedword
+// ResetWordInfos resets all changes to the "word_infos" edge.
+func (m *PartOfSpeechMutation) ResetWordInfos() {
+	m.word_infos = nil
+	m.clearedword_infos = false
+	m.removedword_infos = nil
 }
 
-// WordIDs returns the "word" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WordID instead. It exists only for internal usage by the builders.
-func (m *QuizQuestionMutation) WordIDs() (ids []int) {
-	if id := m.word; id != nil {
-		ids = append(ids, *id)
+// AddPosMappingIDs adds the "pos_mappings" edge to the PosMapping entity by ids.
+func (m *PartOfSpeechMutation) AddPosMappingIDs(ids ...int) {
+	if m.pos_mappings == nil {
+		m.pos_mappings = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.pos_mappings[ids[i]] = struct{}{}
 	}
-	return
-}
-
-// ResetWord resets all changes to the "word" edge.
-func (m *QuizQuestionMutation) ResetWord() {
-	m.word = nil
-	m.clearedword = false
 }
 
-// SetJapaneseMeanID sets the "japanese_mean" edge to the JapaneseMean entity by id.
-func (m *QuizQuestionMutation) SetJapaneseMeanID(id int) {
-	m.japanese_mean = &id
+// ClearPosMappings clears the "pos_mappings" edge to the PosMapping entity.
+func (m *PartOfSpeechMutation) ClearPosMappings() {
+	m.clearedpos_mappings = true
 }
 
-// ClearJapaneseMean clears the "japanese_mean" edge to the JapaneseMean entity.
-func (m *QuizQuestionMutation) ClearJapaneseMean() {
-	m.clearedjapanese_mean = true
-	m.clearedFields[quizquestion.FieldCorrectJpmID] = struct{}{}
+// PosMappingsCleared reports if the "pos_mappings" edge to the PosMapping entity was cleared.
+func (m *PartOfSpeechMutation) PosMappingsCleared() bool {
+	return m.clearedpos_mappings
 }
 
-// JapaneseMeanCleared reports if the "japanese_mean" edge to the JapaneseMean entity was cleared.
-func (m *QuizQuestionMutation) JapaneseMeanCleared() bool {
-	return m.clearedjapanese_mean
+// RemovePosMappingIDs removes the "pos_mappings" edge to the PosMapping entity by IDs.
+func (m *PartOfSpeechMutation) RemovePosMappingIDs(ids ...int) {
+	if m.removedpos_mappings == nil {
+		m.removedpos_mappings = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.pos_mappings, ids[i])
+		m.removedpos_mappings[ids[i]] = struct{}{}
+	}
 }
 
-// JapaneseMeanID returns the "japanese_mean" edge ID in the mutation.
-func (m *QuizQuestionMutation) JapaneseMeanID() (id int, exists bool) {
-	if m.japanese_mean != nil {
-		return *m.japanese_mean, true
+// RemovedPosMappings returns the removed IDs of the "pos_mappings" edge to the PosMapping entity.
+func (m *PartOfSpeechMutation) RemovedPosMappingsIDs() (ids []int) {
+	for id := range m.removedpos_mappings {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// JapaneseMeanIDs returns the "japanese_mean" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// JapaneseMeanID instead. It exists only for internal usage by the builders.
-func (m *QuizQuestionMutation) JapaneseMeanIDs() (ids []int) {
-	if id := m.japanese_mean; id != nil {
-		ids = append(ids, *id)
+// PosMappingsIDs returns the "pos_mappings" edge IDs in the mutation.
+func (m *PartOfSpeechMutation) PosMappingsIDs() (ids []int) {
+	for id := range m.pos_mappings {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetJapaneseMean resets all changes to the "japanese_mean" edge.
-func (m *QuizQuestionMutation) ResetJapaneseMean() {
-	m.japanese_mean = nil
-	m.clearedjapanese_mean = false
+// ResetPosMappings resets all changes to the "pos_mappings" edge.
+func (m *PartOfSpeechMutation) ResetPosMappings() {
+	m.pos_mappings = nil
+	m.clearedpos_mappings = false
+	m.removedpos_mappings = nil
 }
 
-// Where appends a list predicates to the QuizQuestionMutation builder.
-func (m *QuizQuestionMutation) Where(ps ...predicate.QuizQuestion) {
+// Where appends a list predicates to the PartOfSpeechMutation builder.
+func (m *PartOfSpeechMutation) Where(ps ...predicate.PartOfSpeech) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the QuizQuestionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PartOfSpeechMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *QuizQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.QuizQuestion, len(ps))
+func (m *PartOfSpeechMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PartOfSpeech, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4187,63 +4847,33 @@ func (m *QuizQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *QuizQuestionMutation) Op() Op {
+func (m *PartOfSpeechMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *QuizQuestionMutation) SetOp(op Op) {
+func (m *PartOfSpeechMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (QuizQuestion).
-func (m *QuizQuestionMutation) Type() string {
+// Type returns the node type of this mutation (PartOfSpeech).
+func (m *PartOfSpeechMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *QuizQuestionMutation) Fields() []string {
-	fields := make([]string, 0, 13)
-	if m.quiz != nil {
-		fields = append(fields, quizquestion.FieldQuizID)
-	}
-	if m.question_number != nil {
-		fields = append(fields, quizquestion.FieldQuestionNumber)
-	}
-	if m.word != nil {
-		fields = append(fields, quizquestion.FieldWordID)
-	}
-	if m.wordName != nil {
-		fields = append(fields, quizquestion.FieldWordName)
-	}
-	if m.pos_id != nil {
-		fields = append(fields, quizquestion.FieldPosID)
-	}
-	if m.japanese_mean != nil {
-		fields = append(fields, quizquestion.FieldCorrectJpmID)
-	}
-	if m.choices_jpms != nil {
-		fields = append(fields, quizquestion.FieldChoicesJpms)
-	}
-	if m.answer_jpm_id != nil {
-		fields = append(fields, quizquestion.FieldAnswerJpmID)
-	}
-	if m.is_correct != nil {
-		fields = append(fields, quizquestion.FieldIsCorrect)
-	}
-	if m.answered_at != nil {
-		fields = append(fields, quizquestion.FieldAnsweredAt)
-	}
-	if m.time_ms != nil {
-		fields = append(fields, quizquestion.FieldTimeMs)
+func (m *PartOfSpeechMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.name != nil {
+		fields = append(fields, partofspeech.FieldName)
 	}
 	if m.created_at != nil {
-		fields = append(fields, quizquestion.FieldCreatedAt)
+		fields = append(fields, partofspeech.FieldCreatedAt)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, quizquestion.FieldDeletedAt)
+	if m.updated_at != nil {
+		fields = append(fields, partofspeech.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4251,34 +4881,14 @@ func (m *QuizQuestionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *QuizQuestionMutation) Field(name string) (ent.Value, bool) {
+func (m *PartOfSpeechMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case quizquestion.FieldQuizID:
-		return m.QuizID()
-	case quizquestion.FieldQuestionNumber:
-		return m.QuestionNumber()
-	case quizquestion.FieldWordID:
-		return m.WordID()
-	case quizquestion.FieldWordName:
-		return m.WordName()
-	case quizquestion.FieldPosID:
-		return m.PosID()
-	case quizquestion.FieldCorrectJpmID:
-		return m.CorrectJpmID()
-	case quizquestion.FieldChoicesJpms:
-		return m.ChoicesJpms()
-	case quizquestion.FieldAnswerJpmID:
-		return m.AnswerJpmID()
-	case quizquestion.FieldIsCorrect:
-		return m.IsCorrect()
-	case quizquestion.FieldAnsweredAt:
-		return m.AnsweredAt()
-	case quizquestion.FieldTimeMs:
-		return m.TimeMs()
-	case quizquestion.FieldCreatedAt:
+	case partofspeech.FieldName:
+		return m.Name()
+	case partofspeech.FieldCreatedAt:
 		return m.CreatedAt()
-	case quizquestion.FieldDeletedAt:
-		return m.DeletedAt()
+	case partofspeech.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -4286,462 +4896,244 @@ func (m *QuizQuestionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *QuizQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PartOfSpeechMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case quizquestion.FieldQuizID:
-		return m.OldQuizID(ctx)
-	case quizquestion.FieldQuestionNumber:
-		return m.OldQuestionNumber(ctx)
-	case quizquestion.FieldWordID:
-		return m.OldWordID(ctx)
-	case quizquestion.FieldWordName:
-		return m.OldWordName(ctx)
-	case quizquestion.FieldPosID:
-		return m.OldPosID(ctx)
-	case quizquestion.FieldCorrectJpmID:
-		return m.OldCorrectJpmID(ctx)
-	case quizquestion.FieldChoicesJpms:
-		return m.OldChoicesJpms(ctx)
-	case quizquestion.FieldAnswerJpmID:
-		return m.OldAnswerJpmID(ctx)
-	case quizquestion.FieldIsCorrect:
-		return m.OldIsCorrect(ctx)
-	case quizquestion.FieldAnsweredAt:
-		return m.OldAnsweredAt(ctx)
-	case quizquestion.FieldTimeMs:
-		return m.OldTimeMs(ctx)
-	case quizquestion.FieldCreatedAt:
+	case partofspeech.FieldName:
+		return m.OldName(ctx)
+	case partofspeech.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case quizquestion.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
+	case partofspeech.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown QuizQuestion field %s", name)
+	return nil, fmt.Errorf("unknown PartOfSpeech field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuizQuestionMutation) SetField(name string, value ent.Value) error {
+func (m *PartOfSpeechMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case quizquestion.FieldQuizID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuizID(v)
-		return nil
-	case quizquestion.FieldQuestionNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuestionNumber(v)
-		return nil
-	case quizquestion.FieldWordID:
-		v, ok := value.(int)
+	case partofspeech.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWordID(v)
+		m.SetName(v)
 		return nil
-	case quizquestion.FieldWordName:
-		v, ok := value.(string)
+	case partofspeech.FieldCreatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWordName(v)
+		m.SetCreatedAt(v)
 		return nil
-	case quizquestion.FieldPosID:
-		v, ok := value.(int)
+	case partofspeech.FieldUpdatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPosID(v)
-		return nil
-	case quizquestion.FieldCorrectJpmID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCorrectJpmID(v)
-		return nil
-	case quizquestion.FieldChoicesJpms:
-		v, ok := value.([]models.ChoiceJpm)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetChoicesJpms(v)
-		return nil
-	case quizquestion.FieldAnswerJpmID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAnswerJpmID(v)
-		return nil
-	case quizquestion.FieldIsCorrect:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsCorrect(v)
-		return nil
-	case quizquestion.FieldAnsweredAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAnsweredAt(v)
-		return nil
-	case quizquestion.FieldTimeMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTimeMs(v)
-		return nil
-	case quizquestion.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case quizquestion.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown QuizQuestion field %s", name)
+	return fmt.Errorf("unknown PartOfSpeech field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *QuizQuestionMutation) AddedFields() []string {
-	var fields []string
-	if m.addquestion_number != nil {
-		fields = append(fields, quizquestion.FieldQuestionNumber)
-	}
-	if m.addpos_id != nil {
-		fields = append(fields, quizquestion.FieldPosID)
-	}
-	if m.addanswer_jpm_id != nil {
-		fields = append(fields, quizquestion.FieldAnswerJpmID)
-	}
-	if m.addtime_ms != nil {
-		fields = append(fields, quizquestion.FieldTimeMs)
-	}
-	return fields
+func (m *PartOfSpeechMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *QuizQuestionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case quizquestion.FieldQuestionNumber:
-		return m.AddedQuestionNumber()
-	case quizquestion.FieldPosID:
-		return m.AddedPosID()
-	case quizquestion.FieldAnswerJpmID:
-		return m.AddedAnswerJpmID()
-	case quizquestion.FieldTimeMs:
-		return m.AddedTimeMs()
-	}
+func (m *PartOfSpeechMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *QuizQuestionMutation) AddField(name string, value ent.Value) error {
+func (m *PartOfSpeechMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case quizquestion.FieldQuestionNumber:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuestionNumber(v)
-		return nil
-	case quizquestion.FieldPosID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPosID(v)
-		return nil
-	case quizquestion.FieldAnswerJpmID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAnswerJpmID(v)
-		return nil
-	case quizquestion.FieldTimeMs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTimeMs(v)
-		return nil
 	}
-	return fmt.Errorf("unknown QuizQuestion numeric field %s", name)
+	return fmt.Errorf("unknown PartOfSpeech numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *QuizQuestionMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(quizquestion.FieldAnswerJpmID) {
-		fields = append(fields, quizquestion.FieldAnswerJpmID)
-	}
-	if m.FieldCleared(quizquestion.FieldIsCorrect) {
-		fields = append(fields, quizquestion.FieldIsCorrect)
-	}
-	if m.FieldCleared(quizquestion.FieldAnsweredAt) {
-		fields = append(fields, quizquestion.FieldAnsweredAt)
-	}
-	if m.FieldCleared(quizquestion.FieldTimeMs) {
-		fields = append(fields, quizquestion.FieldTimeMs)
-	}
-	if m.FieldCleared(quizquestion.FieldDeletedAt) {
-		fields = append(fields, quizquestion.FieldDeletedAt)
-	}
-	return fields
+func (m *PartOfSpeechMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *QuizQuestionMutation) FieldCleared(name string) bool {
+func (m *PartOfSpeechMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *QuizQuestionMutation) ClearField(name string) error {
-	switch name {
-	case quizquestion.FieldAnswerJpmID:
-		m.ClearAnswerJpmID()
-		return nil
-	case quizquestion.FieldIsCorrect:
-		m.ClearIsCorrect()
-		return nil
-	case quizquestion.FieldAnsweredAt:
-		m.ClearAnsweredAt()
-		return nil
-	case quizquestion.FieldTimeMs:
-		m.ClearTimeMs()
-		return nil
-	case quizquestion.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown QuizQuestion nullable field %s", name)
+func (m *PartOfSpeechMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PartOfSpeech nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *QuizQuestionMutation) ResetField(name string) error {
+func (m *PartOfSpeechMutation) ResetField(name string) error {
 	switch name {
-	case quizquestion.FieldQuizID:
-		m.ResetQuizID()
-		return nil
-	case quizquestion.FieldQuestionNumber:
-		m.ResetQuestionNumber()
-		return nil
-	case quizquestion.FieldWordID:
-		m.ResetWordID()
-		return nil
-	case quizquestion.FieldWordName:
-		m.ResetWordName()
-		return nil
-	case quizquestion.FieldPosID:
-		m.ResetPosID()
-		return nil
-	case quizquestion.FieldCorrectJpmID:
-		m.ResetCorrectJpmID()
-		return nil
-	case quizquestion.FieldChoicesJpms:
-		m.ResetChoicesJpms()
-		return nil
-	case quizquestion.FieldAnswerJpmID:
-		m.ResetAnswerJpmID()
-		return nil
-	case quizquestion.FieldIsCorrect:
-		m.ResetIsCorrect()
-		return nil
-	case quizquestion.FieldAnsweredAt:
-		m.ResetAnsweredAt()
-		return nil
-	case quizquestion.FieldTimeMs:
-		m.ResetTimeMs()
+	case partofspeech.FieldName:
+		m.ResetName()
 		return nil
-	case quizquestion.FieldCreatedAt:
+	case partofspeech.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case quizquestion.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case partofspeech.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown QuizQuestion field %s", name)
+	return fmt.Errorf("unknown PartOfSpeech field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *QuizQuestionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.quiz != nil {
-		edges = append(edges, quizquestion.EdgeQuiz)
-	}
-	if m.word != nil {
-		edges = append(edges, quizquestion.EdgeWord)
+func (m *PartOfSpeechMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.word_infos != nil {
+		edges = append(edges, partofspeech.EdgeWordInfos)
 	}
-	if m.japanese_mean != nil {
-		edges = append(edges, quizquestion.EdgeJapaneseMean)
+	if m.pos_mappings != nil {
+		edges = append(edges, partofspeech.EdgePosMappings)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *QuizQuestionMutation) AddedIDs(name string) []ent.Value {
+func (m *PartOfSpeechMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case quizquestion.EdgeQuiz:
-		if id := m.quiz; id != nil {
-			return []ent.Value{*id}
-		}
-	case quizquestion.EdgeWord:
-		if id := m.word; id != nil {
-			return []ent.Value{*id}
+	case partofspeech.EdgeWordInfos:
+		ids := make([]ent.Value, 0, len(m.word_infos))
+		for id := range m.word_infos {
+			ids = append(ids, id)
 		}
-	case quizquestion.EdgeJapaneseMean:
-		if id := m.japanese_mean; id != nil {
-			return []ent.Value{*id}
+		return ids
+	case partofspeech.EdgePosMappings:
+		ids := make([]ent.Value, 0, len(m.pos_mappings))
+		for id := range m.pos_mappings {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *QuizQuestionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *PartOfSpeechMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedword_infos != nil {
+		edges = append(edges, partofspeech.EdgeWordInfos)
+	}
+	if m.removedpos_mappings != nil {
+		edges = append(edges, partofspeech.EdgePosMappings)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *QuizQuestionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
-}
-
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *QuizQuestionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedquiz {
-		edges = append(edges, quizquestion.EdgeQuiz)
-	}
-	if m.clearedword {
-		edges = append(edges, quizquestion.EdgeWord)
+func (m *PartOfSpeechMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case partofspeech.EdgeWordInfos:
+		ids := make([]ent.Value, 0, len(m.removedword_infos))
+		for id := range m.removedword_infos {
+			ids = append(ids, id)
+		}
+		return ids
+	case partofspeech.EdgePosMappings:
+		ids := make([]ent.Value, 0, len(m.removedpos_mappings))
+		for id := range m.removedpos_mappings {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	if m.clearedjapanese_mean {
-		edges = append(edges, quizquestion.EdgeJapaneseMean)
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PartOfSpeechMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedword_infos {
+		edges = append(edges, partofspeech.EdgeWordInfos)
+	}
+	if m.clearedpos_mappings {
+		edges = append(edges, partofspeech.EdgePosMappings)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *QuizQuestionMutation) EdgeCleared(name string) bool {
+func (m *PartOfSpeechMutation) EdgeCleared(name string) bool {
 	switch name {
-	case quizquestion.EdgeQuiz:
-		return m.clearedquiz
-	case quizquestion.EdgeWord:
-		return m.clearedword
-	case quizquestion.EdgeJapaneseMean:
-		return m.clearedjapanese_mean
+	case partofspeech.EdgeWordInfos:
+		return m.clearedword_infos
+	case partofspeech.EdgePosMappings:
+		return m.clearedpos_mappings
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *QuizQuestionMutation) ClearEdge(name string) error {
+func (m *PartOfSpeechMutation) ClearEdge(name string) error {
 	switch name {
-	case quizquestion.EdgeQuiz:
-		m.ClearQuiz()
-		return nil
-	case quizquestion.EdgeWord:
-		m.ClearWord()
-		return nil
-	case quizquestion.EdgeJapaneseMean:
-		m.ClearJapaneseMean()
-		return nil
 	}
-	return fmt.Errorf("unknown QuizQuestion unique edge %s", name)
+	return fmt.Errorf("unknown PartOfSpeech unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *QuizQuestionMutation) ResetEdge(name string) error {
+func (m *PartOfSpeechMutation) ResetEdge(name string) error {
 	switch name {
-	case quizquestion.EdgeQuiz:
-		m.ResetQuiz()
-		return nil
-	case quizquestion.EdgeWord:
-		m.ResetWord()
+	case partofspeech.EdgeWordInfos:
+		m.ResetWordInfos()
 		return nil
-	case quizquestion.EdgeJapaneseMean:
-		m.ResetJapaneseMean()
+	case partofspeech.EdgePosMappings:
+		m.ResetPosMappings()
 		return nil
 	}
-	return fmt.Errorf("unknown QuizQuestion edge %s", name)
+	return fmt.Errorf("unknown PartOfSpeech edge %s", name)
 }
 
-// RegisteredWordMutation represents an operation that mutates the RegisteredWord nodes in the graph.
-type RegisteredWordMutation struct {
+// PosMappingMutation represents an operation that mutates the PosMapping nodes in the graph.
+type PosMappingMutation struct {
 	config
 	op                    Op
 	typ                   string
 	id                    *int
-	is_active             *bool
-	attention_level       *int
-	addattention_level    *int
-	quiz_count            *int
-	addquiz_count         *int
-	correct_count         *int
-	addcorrect_count      *int
-	correct_rate          *int
-	addcorrect_rate       *int
-	memo                  *string
-	created_at            *time.Time
-	updated_at            *time.Time
+	code                  *string
 	clearedFields         map[string]struct{}
-	user                  *int
-	cleareduser           bool
-	word                  *int
-	clearedword           bool
-	quiz_questions        map[int]struct{}
-	removedquiz_questions map[int]struct{}
-	clearedquiz_questions bool
+	version               *int
+	clearedversion        bool
+	part_of_speech        *int
+	clearedpart_of_speech bool
 	done                  bool
-	oldValue              func(context.Context) (*RegisteredWord, error)
-	predicates            []predicate.RegisteredWord
+	oldValue              func(context.Context) (*PosMapping, error)
+	predicates            []predicate.PosMapping
 }
 
-var _ ent.Mutation = (*RegisteredWordMutation)(nil)
+var _ ent.Mutation = (*PosMappingMutation)(nil)
 
-// registeredwordOption allows management of the mutation configuration using functional options.
-type registeredwordOption func(*RegisteredWordMutation)
+// posmappingOption allows management of the mutation configuration using functional options.
+type posmappingOption func(*PosMappingMutation)
 
-// newRegisteredWordMutation creates new mutation for the RegisteredWord entity.
-func newRegisteredWordMutation(c config, op Op, opts ...registeredwordOption) *RegisteredWordMutation {
-	m := &RegisteredWordMutation{
+// newPosMappingMutation creates new mutation for the PosMapping entity.
+func newPosMappingMutation(c config, op Op, opts ...posmappingOption) *PosMappingMutation {
+	m := &PosMappingMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRegisteredWord,
+		typ:           TypePosMapping,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4750,20 +5142,20 @@ func newRegisteredWordMutation(c config, op Op, opts ...registeredwordOption) *R
 	return m
 }
 
-// withRegisteredWordID sets the ID field of the mutation.
-func withRegisteredWordID(id int) registeredwordOption {
-	return func(m *RegisteredWordMutation) {
+// withPosMappingID sets the ID field of the mutation.
+func withPosMappingID(id int) posmappingOption {
+	return func(m *PosMappingMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *RegisteredWord
+			value *PosMapping
 		)
-		m.oldValue = func(ctx context.Context) (*RegisteredWord, error) {
+		m.oldValue = func(ctx context.Context) (*PosMapping, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().RegisteredWord.Get(ctx, id)
+					value, err = m.Client().PosMapping.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4772,10 +5164,10 @@ func withRegisteredWordID(id int) registeredwordOption {
 	}
 }
 
-// withRegisteredWord sets the old RegisteredWord of the mutation.
-func withRegisteredWord(node *RegisteredWord) registeredwordOption {
-	return func(m *RegisteredWordMutation) {
-		m.oldValue = func(context.Context) (*RegisteredWord, error) {
+// withPosMapping sets the old PosMapping of the mutation.
+func withPosMapping(node *PosMapping) posmappingOption {
+	return func(m *PosMappingMutation) {
+		m.oldValue = func(context.Context) (*PosMapping, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4784,7 +5176,7 @@ func withRegisteredWord(node *RegisteredWord) registeredwordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RegisteredWordMutation) Client() *Client {
+func (m PosMappingMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4792,7 +5184,7 @@ func (m RegisteredWordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RegisteredWordMutation) Tx() (*Tx, error) {
+func (m PosMappingMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4803,7 +5195,7 @@ func (m RegisteredWordMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *RegisteredWordMutation) ID() (id int, exists bool) {
+func (m *PosMappingMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4814,7 +5206,7 @@ func (m *RegisteredWordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *RegisteredWordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *PosMappingMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4823,582 +5215,827 @@ func (m *RegisteredWordMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RegisteredWord.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PosMapping.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *RegisteredWordMutation) SetUserID(i int) {
-	m.user = &i
+// SetVersionID sets the "version_id" field.
+func (m *PosMappingMutation) SetVersionID(i int) {
+	m.version = &i
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *RegisteredWordMutation) UserID() (r int, exists bool) {
-	v := m.user
+// VersionID returns the value of the "version_id" field in the mutation.
+func (m *PosMappingMutation) VersionID() (r int, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// OldVersionID returns the old "version_id" field's value of the PosMapping entity.
+// If the PosMapping object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *PosMappingMutation) OldVersionID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldVersionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldVersionID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.VersionID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *RegisteredWordMutation) ResetUserID() {
-	m.user = nil
+// ResetVersionID resets all changes to the "version_id" field.
+func (m *PosMappingMutation) ResetVersionID() {
+	m.version = nil
 }
 
-// SetWordID sets the "word_id" field.
-func (m *RegisteredWordMutation) SetWordID(i int) {
-	m.word = &i
+// SetCode sets the "code" field.
+func (m *PosMappingMutation) SetCode(s string) {
+	m.code = &s
 }
 
-// WordID returns the value of the "word_id" field in the mutation.
-func (m *RegisteredWordMutation) WordID() (r int, exists bool) {
-	v := m.word
+// Code returns the value of the "code" field in the mutation.
+func (m *PosMappingMutation) Code() (r string, exists bool) {
+	v := m.code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWordID returns the old "word_id" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// OldCode returns the old "code" field's value of the PosMapping entity.
+// If the PosMapping object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldWordID(ctx context.Context) (v int, err error) {
+func (m *PosMappingMutation) OldCode(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWordID requires an ID field in the mutation")
+		return v, errors.New("OldCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCode: %w", err)
 	}
-	return oldValue.WordID, nil
+	return oldValue.Code, nil
 }
 
-// ResetWordID resets all changes to the "word_id" field.
-func (m *RegisteredWordMutation) ResetWordID() {
-	m.word = nil
+// ResetCode resets all changes to the "code" field.
+func (m *PosMappingMutation) ResetCode() {
+	m.code = nil
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *RegisteredWordMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (m *PosMappingMutation) SetPartOfSpeechID(i int) {
+	m.part_of_speech = &i
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *RegisteredWordMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// PartOfSpeechID returns the value of the "part_of_speech_id" field in the mutation.
+func (m *PosMappingMutation) PartOfSpeechID() (r int, exists bool) {
+	v := m.part_of_speech
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// OldPartOfSpeechID returns the old "part_of_speech_id" field's value of the PosMapping entity.
+// If the PosMapping object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *PosMappingMutation) OldPartOfSpeechID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldPartOfSpeechID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldPartOfSpeechID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldPartOfSpeechID: %w", err)
 	}
-	return oldValue.IsActive, nil
+	return oldValue.PartOfSpeechID, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *RegisteredWordMutation) ResetIsActive() {
-	m.is_active = nil
+// ResetPartOfSpeechID resets all changes to the "part_of_speech_id" field.
+func (m *PosMappingMutation) ResetPartOfSpeechID() {
+	m.part_of_speech = nil
 }
 
-// SetAttentionLevel sets the "attention_level" field.
-func (m *RegisteredWordMutation) SetAttentionLevel(i int) {
-	m.attention_level = &i
-	m.addattention_level = nil
+// ClearVersion clears the "version" edge to the PosMappingVersion entity.
+func (m *PosMappingMutation) ClearVersion() {
+	m.clearedversion = true
+	m.clearedFields[posmapping.FieldVersionID] = struct{}{}
 }
 
-// AttentionLevel returns the value of the "attention_level" field in the mutation.
-func (m *RegisteredWordMutation) AttentionLevel() (r int, exists bool) {
-	v := m.attention_level
-	if v == nil {
-		return
-	}
-	return *v, true
+// VersionCleared reports if the "version" edge to the PosMappingVersion entity was cleared.
+func (m *PosMappingMutation) VersionCleared() bool {
+	return m.clearedversion
 }
 
-// OldAttentionLevel returns the old "attention_level" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldAttentionLevel(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttentionLevel is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttentionLevel requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttentionLevel: %w", err)
+// VersionIDs returns the "version" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// VersionID instead. It exists only for internal usage by the builders.
+func (m *PosMappingMutation) VersionIDs() (ids []int) {
+	if id := m.version; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.AttentionLevel, nil
+	return
 }
 
-// AddAttentionLevel adds i to the "attention_level" field.
-func (m *RegisteredWordMutation) AddAttentionLevel(i int) {
-	if m.addattention_level != nil {
-		*m.addattention_level += i
-	} else {
-		m.addattention_level = &i
-	}
+// ResetVersion resets all changes to the "version" edge.
+func (m *PosMappingMutation) ResetVersion() {
+	m.version = nil
+	m.clearedversion = false
 }
 
-// AddedAttentionLevel returns the value that was added to the "attention_level" field in this mutation.
-func (m *RegisteredWordMutation) AddedAttentionLevel() (r int, exists bool) {
-	v := m.addattention_level
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearPartOfSpeech clears the "part_of_speech" edge to the PartOfSpeech entity.
+func (m *PosMappingMutation) ClearPartOfSpeech() {
+	m.clearedpart_of_speech = true
+	m.clearedFields[posmapping.FieldPartOfSpeechID] = struct{}{}
 }
 
-// ResetAttentionLevel resets all changes to the "attention_level" field.
-func (m *RegisteredWordMutation) ResetAttentionLevel() {
-	m.attention_level = nil
-	m.addattention_level = nil
+// PartOfSpeechCleared reports if the "part_of_speech" edge to the PartOfSpeech entity was cleared.
+func (m *PosMappingMutation) PartOfSpeechCleared() bool {
+	return m.clearedpart_of_speech
 }
 
-// SetQuizCount sets the "quiz_count" field.
-func (m *RegisteredWordMutation) SetQuizCount(i int) {
-	m.quiz_count = &i
-	m.addquiz_count = nil
+// PartOfSpeechIDs returns the "part_of_speech" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PartOfSpeechID instead. It exists only for internal usage by the builders.
+func (m *PosMappingMutation) PartOfSpeechIDs() (ids []int) {
+	if id := m.part_of_speech; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// QuizCount returns the value of the "quiz_count" field in the mutation.
-func (m *RegisteredWordMutation) QuizCount() (r int, exists bool) {
-	v := m.quiz_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ResetPartOfSpeech resets all changes to the "part_of_speech" edge.
+func (m *PosMappingMutation) ResetPartOfSpeech() {
+	m.part_of_speech = nil
+	m.clearedpart_of_speech = false
 }
 
-// OldQuizCount returns the old "quiz_count" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldQuizCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuizCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuizCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuizCount: %w", err)
-	}
-	return oldValue.QuizCount, nil
+// Where appends a list predicates to the PosMappingMutation builder.
+func (m *PosMappingMutation) Where(ps ...predicate.PosMapping) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// AddQuizCount adds i to the "quiz_count" field.
-func (m *RegisteredWordMutation) AddQuizCount(i int) {
-	if m.addquiz_count != nil {
-		*m.addquiz_count += i
-	} else {
-		m.addquiz_count = &i
-	}
-}
-
-// AddedQuizCount returns the value that was added to the "quiz_count" field in this mutation.
-func (m *RegisteredWordMutation) AddedQuizCount() (r int, exists bool) {
-	v := m.addquiz_count
-	if v == nil {
-		return
+// WhereP appends storage-level predicates to the PosMappingMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PosMappingMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PosMapping, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return *v, true
+	m.Where(p...)
 }
 
-// ResetQuizCount resets all changes to the "quiz_count" field.
-func (m *RegisteredWordMutation) ResetQuizCount() {
-	m.quiz_count = nil
-	m.addquiz_count = nil
+// Op returns the operation name.
+func (m *PosMappingMutation) Op() Op {
+	return m.op
 }
 
-// SetCorrectCount sets the "correct_count" field.
-func (m *RegisteredWordMutation) SetCorrectCount(i int) {
-	m.correct_count = &i
-	m.addcorrect_count = nil
+// SetOp allows setting the mutation operation.
+func (m *PosMappingMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// CorrectCount returns the value of the "correct_count" field in the mutation.
-func (m *RegisteredWordMutation) CorrectCount() (r int, exists bool) {
-	v := m.correct_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (PosMapping).
+func (m *PosMappingMutation) Type() string {
+	return m.typ
 }
 
-// OldCorrectCount returns the old "correct_count" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldCorrectCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrectCount is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PosMappingMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.version != nil {
+		fields = append(fields, posmapping.FieldVersionID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrectCount requires an ID field in the mutation")
+	if m.code != nil {
+		fields = append(fields, posmapping.FieldCode)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrectCount: %w", err)
+	if m.part_of_speech != nil {
+		fields = append(fields, posmapping.FieldPartOfSpeechID)
 	}
-	return oldValue.CorrectCount, nil
+	return fields
 }
 
-// AddCorrectCount adds i to the "correct_count" field.
-func (m *RegisteredWordMutation) AddCorrectCount(i int) {
-	if m.addcorrect_count != nil {
-		*m.addcorrect_count += i
-	} else {
-		m.addcorrect_count = &i
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PosMappingMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case posmapping.FieldVersionID:
+		return m.VersionID()
+	case posmapping.FieldCode:
+		return m.Code()
+	case posmapping.FieldPartOfSpeechID:
+		return m.PartOfSpeechID()
 	}
+	return nil, false
 }
 
-// AddedCorrectCount returns the value that was added to the "correct_count" field in this mutation.
-func (m *RegisteredWordMutation) AddedCorrectCount() (r int, exists bool) {
-	v := m.addcorrect_count
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PosMappingMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case posmapping.FieldVersionID:
+		return m.OldVersionID(ctx)
+	case posmapping.FieldCode:
+		return m.OldCode(ctx)
+	case posmapping.FieldPartOfSpeechID:
+		return m.OldPartOfSpeechID(ctx)
 	}
-	return *v, true
-}
-
-// ResetCorrectCount resets all changes to the "correct_count" field.
-func (m *RegisteredWordMutation) ResetCorrectCount() {
-	m.correct_count = nil
-	m.addcorrect_count = nil
+	return nil, fmt.Errorf("unknown PosMapping field %s", name)
 }
 
-// SetCorrectRate sets the "correct_rate" field.
-func (m *RegisteredWordMutation) SetCorrectRate(i int) {
-	m.correct_rate = &i
-	m.addcorrect_rate = nil
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PosMappingMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case posmapping.FieldVersionID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVersionID(v)
+		return nil
+	case posmapping.FieldCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCode(v)
+		return nil
+	case posmapping.FieldPartOfSpeechID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPartOfSpeechID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PosMapping field %s", name)
 }
 
-// CorrectRate returns the value of the "correct_rate" field in the mutation.
-func (m *RegisteredWordMutation) CorrectRate() (r int, exists bool) {
-	v := m.correct_rate
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PosMappingMutation) AddedFields() []string {
+	var fields []string
+	return fields
 }
 
-// OldCorrectRate returns the old "correct_rate" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldCorrectRate(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCorrectRate is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCorrectRate requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCorrectRate: %w", err)
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PosMappingMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
 	}
-	return oldValue.CorrectRate, nil
+	return nil, false
 }
 
-// AddCorrectRate adds i to the "correct_rate" field.
-func (m *RegisteredWordMutation) AddCorrectRate(i int) {
-	if m.addcorrect_rate != nil {
-		*m.addcorrect_rate += i
-	} else {
-		m.addcorrect_rate = &i
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PosMappingMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown PosMapping numeric field %s", name)
 }
 
-// AddedCorrectRate returns the value that was added to the "correct_rate" field in this mutation.
-func (m *RegisteredWordMutation) AddedCorrectRate() (r int, exists bool) {
-	v := m.addcorrect_rate
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PosMappingMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetCorrectRate resets all changes to the "correct_rate" field.
-func (m *RegisteredWordMutation) ResetCorrectRate() {
-	m.correct_rate = nil
-	m.addcorrect_rate = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PosMappingMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetMemo sets the "memo" field.
-func (m *RegisteredWordMutation) SetMemo(s string) {
-	m.memo = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PosMappingMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PosMapping nullable field %s", name)
 }
 
-// Memo returns the value of the "memo" field in the mutation.
-func (m *RegisteredWordMutation) Memo() (r string, exists bool) {
-	v := m.memo
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PosMappingMutation) ResetField(name string) error {
+	switch name {
+	case posmapping.FieldVersionID:
+		m.ResetVersionID()
+		return nil
+	case posmapping.FieldCode:
+		m.ResetCode()
+		return nil
+	case posmapping.FieldPartOfSpeechID:
+		m.ResetPartOfSpeechID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PosMapping field %s", name)
 }
 
-// OldMemo returns the old "memo" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldMemo(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PosMappingMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.version != nil {
+		edges = append(edges, posmapping.EdgeVersion)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMemo requires an ID field in the mutation")
+	if m.part_of_speech != nil {
+		edges = append(edges, posmapping.EdgePartOfSpeech)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PosMappingMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case posmapping.EdgeVersion:
+		if id := m.version; id != nil {
+			return []ent.Value{*id}
+		}
+	case posmapping.EdgePartOfSpeech:
+		if id := m.part_of_speech; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Memo, nil
+	return nil
 }
 
-// ClearMemo clears the value of the "memo" field.
-func (m *RegisteredWordMutation) ClearMemo() {
-	m.memo = nil
-	m.clearedFields[registeredword.FieldMemo] = struct{}{}
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PosMappingMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
 }
 
-// MemoCleared returns if the "memo" field was cleared in this mutation.
-func (m *RegisteredWordMutation) MemoCleared() bool {
-	_, ok := m.clearedFields[registeredword.FieldMemo]
-	return ok
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PosMappingMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetMemo resets all changes to the "memo" field.
-func (m *RegisteredWordMutation) ResetMemo() {
-	m.memo = nil
-	delete(m.clearedFields, registeredword.FieldMemo)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PosMappingMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedversion {
+		edges = append(edges, posmapping.EdgeVersion)
+	}
+	if m.clearedpart_of_speech {
+		edges = append(edges, posmapping.EdgePartOfSpeech)
+	}
+	return edges
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RegisteredWordMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PosMappingMutation) EdgeCleared(name string) bool {
+	switch name {
+	case posmapping.EdgeVersion:
+		return m.clearedversion
+	case posmapping.EdgePartOfSpeech:
+		return m.clearedpart_of_speech
+	}
+	return false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RegisteredWordMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PosMappingMutation) ClearEdge(name string) error {
+	switch name {
+	case posmapping.EdgeVersion:
+		m.ClearVersion()
+		return nil
+	case posmapping.EdgePartOfSpeech:
+		m.ClearPartOfSpeech()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PosMapping unique edge %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PosMappingMutation) ResetEdge(name string) error {
+	switch name {
+	case posmapping.EdgeVersion:
+		m.ResetVersion()
+		return nil
+	case posmapping.EdgePartOfSpeech:
+		m.ResetPartOfSpeech()
+		return nil
+	}
+	return fmt.Errorf("unknown PosMapping edge %s", name)
+}
+
+// PosMappingVersionMutation represents an operation that mutates the PosMappingVersion nodes in the graph.
+type PosMappingVersionMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	fallback_pos_id    *int
+	addfallback_pos_id *int
+	note               *string
+	created_by         *int
+	addcreated_by      *int
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	mappings           map[int]struct{}
+	removedmappings    map[int]struct{}
+	clearedmappings    bool
+	done               bool
+	oldValue           func(context.Context) (*PosMappingVersion, error)
+	predicates         []predicate.PosMappingVersion
+}
+
+var _ ent.Mutation = (*PosMappingVersionMutation)(nil)
+
+// posmappingversionOption allows management of the mutation configuration using functional options.
+type posmappingversionOption func(*PosMappingVersionMutation)
+
+// newPosMappingVersionMutation creates new mutation for the PosMappingVersion entity.
+func newPosMappingVersionMutation(c config, op Op, opts ...posmappingversionOption) *PosMappingVersionMutation {
+	m := &PosMappingVersionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePosMappingVersion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPosMappingVersionID sets the ID field of the mutation.
+func withPosMappingVersionID(id int) posmappingversionOption {
+	return func(m *PosMappingVersionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PosMappingVersion
+		)
+		m.oldValue = func(ctx context.Context) (*PosMappingVersion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PosMappingVersion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPosMappingVersion sets the old PosMappingVersion of the mutation.
+func withPosMappingVersion(node *PosMappingVersion) posmappingversionOption {
+	return func(m *PosMappingVersionMutation) {
+		m.oldValue = func(context.Context) (*PosMappingVersion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PosMappingVersionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PosMappingVersionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PosMappingVersionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PosMappingVersionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PosMappingVersion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (m *PosMappingVersionMutation) SetFallbackPosID(i int) {
+	m.fallback_pos_id = &i
+	m.addfallback_pos_id = nil
+}
+
+// FallbackPosID returns the value of the "fallback_pos_id" field in the mutation.
+func (m *PosMappingVersionMutation) FallbackPosID() (r int, exists bool) {
+	v := m.fallback_pos_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFallbackPosID returns the old "fallback_pos_id" field's value of the PosMappingVersion entity.
+// If the PosMappingVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PosMappingVersionMutation) OldFallbackPosID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFallbackPosID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFallbackPosID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFallbackPosID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.FallbackPosID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RegisteredWordMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddFallbackPosID adds i to the "fallback_pos_id" field.
+func (m *PosMappingVersionMutation) AddFallbackPosID(i int) {
+	if m.addfallback_pos_id != nil {
+		*m.addfallback_pos_id += i
+	} else {
+		m.addfallback_pos_id = &i
+	}
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RegisteredWordMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// AddedFallbackPosID returns the value that was added to the "fallback_pos_id" field in this mutation.
+func (m *PosMappingVersionMutation) AddedFallbackPosID() (r int, exists bool) {
+	v := m.addfallback_pos_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RegisteredWordMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ResetFallbackPosID resets all changes to the "fallback_pos_id" field.
+func (m *PosMappingVersionMutation) ResetFallbackPosID() {
+	m.fallback_pos_id = nil
+	m.addfallback_pos_id = nil
+}
+
+// SetNote sets the "note" field.
+func (m *PosMappingVersionMutation) SetNote(s string) {
+	m.note = &s
+}
+
+// Note returns the value of the "note" field in the mutation.
+func (m *PosMappingVersionMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RegisteredWord entity.
-// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the PosMappingVersion entity.
+// If the PosMappingVersion object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RegisteredWordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PosMappingVersionMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Note, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RegisteredWordMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearNote clears the value of the "note" field.
+func (m *PosMappingVersionMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[posmappingversion.FieldNote] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *RegisteredWordMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[registeredword.FieldUserID] = struct{}{}
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *PosMappingVersionMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[posmappingversion.FieldNote]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *RegisteredWordMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetNote resets all changes to the "note" field.
+func (m *PosMappingVersionMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, posmappingversion.FieldNote)
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *RegisteredWordMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SetCreatedBy sets the "created_by" field.
+func (m *PosMappingVersionMutation) SetCreatedBy(i int) {
+	m.created_by = &i
+	m.addcreated_by = nil
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *PosMappingVersionMutation) CreatedBy() (r int, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *RegisteredWordMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldCreatedBy returns the old "created_by" field's value of the PosMappingVersion entity.
+// If the PosMappingVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PosMappingVersionMutation) OldCreatedBy(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
 }
 
-// ClearWord clears the "word" edge to the Word entity.
-func (m *RegisteredWordMutation) ClearWord() {
-	m.clearedword = true
-	m.clearedFields[registeredword.FieldWordID] = struct{}{}
+// AddCreatedBy adds i to the "created_by" field.
+func (m *PosMappingVersionMutation) AddCreatedBy(i int) {
+	if m.addcreated_by != nil {
+		*m.addcreated_by += i
+	} else {
+		m.addcreated_by = &i
+	}
 }
 
-// WordCleared reports if the "word" edge to the Word entity was cleared.
-func (m *RegisteredWordMutation) WordCleared() bool {
-	return m.clearedword
+// AddedCreatedBy returns the value that was added to the "created_by" field in this mutation.
+func (m *PosMappingVersionMutation) AddedCreatedBy() (r int, exists bool) {
+	v := m.addcreated_by
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WordIDs returns the "word" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WordID instead. It exists only for internal usage by the builders.
-func (m *RegisteredWordMutation) WordIDs() (ids []int) {
-	if id := m.word; id != nil {
-		ids = append(ids, *id)
+// ClearCreatedBy clears the value of the "created_by" field.
+func (m *PosMappingVersionMutation) ClearCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	m.clearedFields[posmappingversion.FieldCreatedBy] = struct{}{}
+}
+
+// CreatedByCleared returns if the "created_by" field was cleared in this mutation.
+func (m *PosMappingVersionMutation) CreatedByCleared() bool {
+	_, ok := m.clearedFields[posmappingversion.FieldCreatedBy]
+	return ok
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *PosMappingVersionMutation) ResetCreatedBy() {
+	m.created_by = nil
+	m.addcreated_by = nil
+	delete(m.clearedFields, posmappingversion.FieldCreatedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PosMappingVersionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PosMappingVersionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetWord resets all changes to the "word" edge.
-func (m *RegisteredWordMutation) ResetWord() {
-	m.word = nil
-	m.clearedword = false
+// OldCreatedAt returns the old "created_at" field's value of the PosMappingVersion entity.
+// If the PosMappingVersion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PosMappingVersionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
-func (m *RegisteredWordMutation) AddQuizQuestionIDs(ids ...int) {
-	if m.quiz_questions == nil {
-		m.quiz_questions = make(map[int]struct{})
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PosMappingVersionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddMappingIDs adds the "mappings" edge to the PosMapping entity by ids.
+func (m *PosMappingVersionMutation) AddMappingIDs(ids ...int) {
+	if m.mappings == nil {
+		m.mappings = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.quiz_questions[ids[i]] = struct{}{}
+		m.mappings[ids[i]] = struct{}{}
 	}
 }
 
-// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
-func (m *RegisteredWordMutation) ClearQuizQuestions() {
-	m.clearedquiz_questions = true
+// ClearMappings clears the "mappings" edge to the PosMapping entity.
+func (m *PosMappingVersionMutation) ClearMappings() {
+	m.clearedmappings = true
 }
 
-// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
-func (m *RegisteredWordMutation) QuizQuestionsCleared() bool {
-	return m.clearedquiz_questions
+// MappingsCleared reports if the "mappings" edge to the PosMapping entity was cleared.
+func (m *PosMappingVersionMutation) MappingsCleared() bool {
+	return m.clearedmappings
 }
 
-// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
-func (m *RegisteredWordMutation) RemoveQuizQuestionIDs(ids ...int) {
-	if m.removedquiz_questions == nil {
-		m.removedquiz_questions = make(map[int]struct{})
+// RemoveMappingIDs removes the "mappings" edge to the PosMapping entity by IDs.
+func (m *PosMappingVersionMutation) RemoveMappingIDs(ids ...int) {
+	if m.removedmappings == nil {
+		m.removedmappings = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.quiz_questions, ids[i])
-		m.removedquiz_questions[ids[i]] = struct{}{}
+		delete(m.mappings, ids[i])
+		m.removedmappings[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
-func (m *RegisteredWordMutation) RemovedQuizQuestionsIDs() (ids []int) {
-	for id := range m.removedquiz_questions {
+// RemovedMappings returns the removed IDs of the "mappings" edge to the PosMapping entity.
+func (m *PosMappingVersionMutation) RemovedMappingsIDs() (ids []int) {
+	for id := range m.removedmappings {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
-func (m *RegisteredWordMutation) QuizQuestionsIDs() (ids []int) {
-	for id := range m.quiz_questions {
+// MappingsIDs returns the "mappings" edge IDs in the mutation.
+func (m *PosMappingVersionMutation) MappingsIDs() (ids []int) {
+	for id := range m.mappings {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
-func (m *RegisteredWordMutation) ResetQuizQuestions() {
-	m.quiz_questions = nil
-	m.clearedquiz_questions = false
-	m.removedquiz_questions = nil
+// ResetMappings resets all changes to the "mappings" edge.
+func (m *PosMappingVersionMutation) ResetMappings() {
+	m.mappings = nil
+	m.clearedmappings = false
+	m.removedmappings = nil
 }
 
-// Where appends a list predicates to the RegisteredWordMutation builder.
-func (m *RegisteredWordMutation) Where(ps ...predicate.RegisteredWord) {
+// Where appends a list predicates to the PosMappingVersionMutation builder.
+func (m *PosMappingVersionMutation) Where(ps ...predicate.PosMappingVersion) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RegisteredWordMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PosMappingVersionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RegisteredWordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RegisteredWord, len(ps))
+func (m *PosMappingVersionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PosMappingVersion, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5406,54 +6043,36 @@ func (m *RegisteredWordMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RegisteredWordMutation) Op() Op {
+func (m *PosMappingVersionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RegisteredWordMutation) SetOp(op Op) {
+func (m *PosMappingVersionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RegisteredWord).
-func (m *RegisteredWordMutation) Type() string {
+// Type returns the node type of this mutation (PosMappingVersion).
+func (m *PosMappingVersionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RegisteredWordMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.user != nil {
-		fields = append(fields, registeredword.FieldUserID)
-	}
-	if m.word != nil {
-		fields = append(fields, registeredword.FieldWordID)
-	}
-	if m.is_active != nil {
-		fields = append(fields, registeredword.FieldIsActive)
-	}
-	if m.attention_level != nil {
-		fields = append(fields, registeredword.FieldAttentionLevel)
-	}
-	if m.quiz_count != nil {
-		fields = append(fields, registeredword.FieldQuizCount)
-	}
-	if m.correct_count != nil {
-		fields = append(fields, registeredword.FieldCorrectCount)
+func (m *PosMappingVersionMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.fallback_pos_id != nil {
+		fields = append(fields, posmappingversion.FieldFallbackPosID)
 	}
-	if m.correct_rate != nil {
-		fields = append(fields, registeredword.FieldCorrectRate)
+	if m.note != nil {
+		fields = append(fields, posmappingversion.FieldNote)
 	}
-	if m.memo != nil {
-		fields = append(fields, registeredword.FieldMemo)
+	if m.created_by != nil {
+		fields = append(fields, posmappingversion.FieldCreatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, registeredword.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, registeredword.FieldUpdatedAt)
+		fields = append(fields, posmappingversion.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5461,28 +6080,16 @@ func (m *RegisteredWordMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RegisteredWordMutation) Field(name string) (ent.Value, bool) {
+func (m *PosMappingVersionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case registeredword.FieldUserID:
-		return m.UserID()
-	case registeredword.FieldWordID:
-		return m.WordID()
-	case registeredword.FieldIsActive:
-		return m.IsActive()
-	case registeredword.FieldAttentionLevel:
-		return m.AttentionLevel()
-	case registeredword.FieldQuizCount:
-		return m.QuizCount()
-	case registeredword.FieldCorrectCount:
-		return m.CorrectCount()
-	case registeredword.FieldCorrectRate:
-		return m.CorrectRate()
-	case registeredword.FieldMemo:
-		return m.Memo()
-	case registeredword.FieldCreatedAt:
+	case posmappingversion.FieldFallbackPosID:
+		return m.FallbackPosID()
+	case posmappingversion.FieldNote:
+		return m.Note()
+	case posmappingversion.FieldCreatedBy:
+		return m.CreatedBy()
+	case posmappingversion.FieldCreatedAt:
 		return m.CreatedAt()
-	case registeredword.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5490,126 +6097,66 @@ func (m *RegisteredWordMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RegisteredWordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PosMappingVersionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case registeredword.FieldUserID:
-		return m.OldUserID(ctx)
-	case registeredword.FieldWordID:
-		return m.OldWordID(ctx)
-	case registeredword.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case registeredword.FieldAttentionLevel:
-		return m.OldAttentionLevel(ctx)
-	case registeredword.FieldQuizCount:
-		return m.OldQuizCount(ctx)
-	case registeredword.FieldCorrectCount:
-		return m.OldCorrectCount(ctx)
-	case registeredword.FieldCorrectRate:
-		return m.OldCorrectRate(ctx)
-	case registeredword.FieldMemo:
-		return m.OldMemo(ctx)
-	case registeredword.FieldCreatedAt:
+	case posmappingversion.FieldFallbackPosID:
+		return m.OldFallbackPosID(ctx)
+	case posmappingversion.FieldNote:
+		return m.OldNote(ctx)
+	case posmappingversion.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case posmappingversion.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case registeredword.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown RegisteredWord field %s", name)
+	return nil, fmt.Errorf("unknown PosMappingVersion field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RegisteredWordMutation) SetField(name string, value ent.Value) error {
+func (m *PosMappingVersionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case registeredword.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case registeredword.FieldWordID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetWordID(v)
-		return nil
-	case registeredword.FieldIsActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsActive(v)
-		return nil
-	case registeredword.FieldAttentionLevel:
+	case posmappingversion.FieldFallbackPosID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAttentionLevel(v)
-		return nil
-	case registeredword.FieldQuizCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuizCount(v)
+		m.SetFallbackPosID(v)
 		return nil
-	case registeredword.FieldCorrectCount:
-		v, ok := value.(int)
+	case posmappingversion.FieldNote:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCorrectCount(v)
+		m.SetNote(v)
 		return nil
-	case registeredword.FieldCorrectRate:
+	case posmappingversion.FieldCreatedBy:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCorrectRate(v)
-		return nil
-	case registeredword.FieldMemo:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMemo(v)
+		m.SetCreatedBy(v)
 		return nil
-	case registeredword.FieldCreatedAt:
+	case posmappingversion.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case registeredword.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown RegisteredWord field %s", name)
+	return fmt.Errorf("unknown PosMappingVersion field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RegisteredWordMutation) AddedFields() []string {
+func (m *PosMappingVersionMutation) AddedFields() []string {
 	var fields []string
-	if m.addattention_level != nil {
-		fields = append(fields, registeredword.FieldAttentionLevel)
-	}
-	if m.addquiz_count != nil {
-		fields = append(fields, registeredword.FieldQuizCount)
+	if m.addfallback_pos_id != nil {
+		fields = append(fields, posmappingversion.FieldFallbackPosID)
 	}
-	if m.addcorrect_count != nil {
-		fields = append(fields, registeredword.FieldCorrectCount)
-	}
-	if m.addcorrect_rate != nil {
-		fields = append(fields, registeredword.FieldCorrectRate)
+	if m.addcreated_by != nil {
+		fields = append(fields, posmappingversion.FieldCreatedBy)
 	}
 	return fields
 }
@@ -5617,16 +6164,12 @@ func (m *RegisteredWordMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RegisteredWordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PosMappingVersionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case registeredword.FieldAttentionLevel:
-		return m.AddedAttentionLevel()
-	case registeredword.FieldQuizCount:
-		return m.AddedQuizCount()
-	case registeredword.FieldCorrectCount:
-		return m.AddedCorrectCount()
-	case registeredword.FieldCorrectRate:
-		return m.AddedCorrectRate()
+	case posmappingversion.FieldFallbackPosID:
+		return m.AddedFallbackPosID()
+	case posmappingversion.FieldCreatedBy:
+		return m.AddedCreatedBy()
 	}
 	return nil, false
 }
@@ -5634,136 +6177,96 @@ func (m *RegisteredWordMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RegisteredWordMutation) AddField(name string, value ent.Value) error {
+func (m *PosMappingVersionMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case registeredword.FieldAttentionLevel:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAttentionLevel(v)
-		return nil
-	case registeredword.FieldQuizCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuizCount(v)
-		return nil
-	case registeredword.FieldCorrectCount:
+	case posmappingversion.FieldFallbackPosID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCorrectCount(v)
+		m.AddFallbackPosID(v)
 		return nil
-	case registeredword.FieldCorrectRate:
+	case posmappingversion.FieldCreatedBy:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddCorrectRate(v)
+		m.AddCreatedBy(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RegisteredWord numeric field %s", name)
+	return fmt.Errorf("unknown PosMappingVersion numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RegisteredWordMutation) ClearedFields() []string {
+func (m *PosMappingVersionMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(registeredword.FieldMemo) {
-		fields = append(fields, registeredword.FieldMemo)
+	if m.FieldCleared(posmappingversion.FieldNote) {
+		fields = append(fields, posmappingversion.FieldNote)
+	}
+	if m.FieldCleared(posmappingversion.FieldCreatedBy) {
+		fields = append(fields, posmappingversion.FieldCreatedBy)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RegisteredWordMutation) FieldCleared(name string) bool {
+func (m *PosMappingVersionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RegisteredWordMutation) ClearField(name string) error {
+func (m *PosMappingVersionMutation) ClearField(name string) error {
 	switch name {
-	case registeredword.FieldMemo:
-		m.ClearMemo()
+	case posmappingversion.FieldNote:
+		m.ClearNote()
+		return nil
+	case posmappingversion.FieldCreatedBy:
+		m.ClearCreatedBy()
 		return nil
 	}
-	return fmt.Errorf("unknown RegisteredWord nullable field %s", name)
+	return fmt.Errorf("unknown PosMappingVersion nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RegisteredWordMutation) ResetField(name string) error {
+func (m *PosMappingVersionMutation) ResetField(name string) error {
 	switch name {
-	case registeredword.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case registeredword.FieldWordID:
-		m.ResetWordID()
-		return nil
-	case registeredword.FieldIsActive:
-		m.ResetIsActive()
-		return nil
-	case registeredword.FieldAttentionLevel:
-		m.ResetAttentionLevel()
-		return nil
-	case registeredword.FieldQuizCount:
-		m.ResetQuizCount()
-		return nil
-	case registeredword.FieldCorrectCount:
-		m.ResetCorrectCount()
+	case posmappingversion.FieldFallbackPosID:
+		m.ResetFallbackPosID()
 		return nil
-	case registeredword.FieldCorrectRate:
-		m.ResetCorrectRate()
+	case posmappingversion.FieldNote:
+		m.ResetNote()
 		return nil
-	case registeredword.FieldMemo:
-		m.ResetMemo()
+	case posmappingversion.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case registeredword.FieldCreatedAt:
+	case posmappingversion.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case registeredword.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown RegisteredWord field %s", name)
+	return fmt.Errorf("unknown PosMappingVersion field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RegisteredWordMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.user != nil {
-		edges = append(edges, registeredword.EdgeUser)
-	}
-	if m.word != nil {
-		edges = append(edges, registeredword.EdgeWord)
-	}
-	if m.quiz_questions != nil {
-		edges = append(edges, registeredword.EdgeQuizQuestions)
+func (m *PosMappingVersionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.mappings != nil {
+		edges = append(edges, posmappingversion.EdgeMappings)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RegisteredWordMutation) AddedIDs(name string) []ent.Value {
+func (m *PosMappingVersionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case registeredword.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case registeredword.EdgeWord:
-		if id := m.word; id != nil {
-			return []ent.Value{*id}
-		}
-	case registeredword.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.quiz_questions))
-		for id := range m.quiz_questions {
+	case posmappingversion.EdgeMappings:
+		ids := make([]ent.Value, 0, len(m.mappings))
+		for id := range m.mappings {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5772,392 +6275,8612 @@ func (m *RegisteredWordMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RegisteredWordMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedquiz_questions != nil {
-		edges = append(edges, registeredword.EdgeQuizQuestions)
+func (m *PosMappingVersionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedmappings != nil {
+		edges = append(edges, posmappingversion.EdgeMappings)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PosMappingVersionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case posmappingversion.EdgeMappings:
+		ids := make([]ent.Value, 0, len(m.removedmappings))
+		for id := range m.removedmappings {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PosMappingVersionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmappings {
+		edges = append(edges, posmappingversion.EdgeMappings)
 	}
 	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RegisteredWordMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case registeredword.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
-		for id := range m.removedquiz_questions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PosMappingVersionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case posmappingversion.EdgeMappings:
+		return m.clearedmappings
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PosMappingVersionMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PosMappingVersion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PosMappingVersionMutation) ResetEdge(name string) error {
+	switch name {
+	case posmappingversion.EdgeMappings:
+		m.ResetMappings()
+		return nil
+	}
+	return fmt.Errorf("unknown PosMappingVersion edge %s", name)
+}
+
+// QuizMutation represents an operation that mutates the Quiz nodes in the graph.
+type QuizMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *int
+	quiz_number                *int
+	addquiz_number             *int
+	is_running                 *bool
+	total_questions_count      *int
+	addtotal_questions_count   *int
+	correct_count              *int
+	addcorrect_count           *int
+	result_correct_rate        *float64
+	addresult_correct_rate     *float64
+	is_save_result             *bool
+	is_registered_words        *int
+	addis_registered_words     *int
+	setting_correct_rate       *int
+	addsetting_correct_rate    *int
+	is_idioms                  *int
+	addis_idioms               *int
+	is_special_characters      *int
+	addis_special_characters   *int
+	attention_level_list       *[]int
+	appendattention_level_list []int
+	choices_pos_ids            *[]int
+	appendchoices_pos_ids      []int
+	mode                       *string
+	direction                  *string
+	question_type              *string
+	difficulty                 *string
+	question_time_limit_sec    *int
+	addquestion_time_limit_sec *int
+	time_limit_sec             *int
+	addtime_limit_sec          *int
+	expires_at                 *time.Time
+	finish_status              *string
+	finished_at                *time.Time
+	created_at                 *time.Time
+	deleted_at                 *time.Time
+	clearedFields              map[string]struct{}
+	user                       *int
+	cleareduser                bool
+	quiz_questions             map[int]struct{}
+	removedquiz_questions      map[int]struct{}
+	clearedquiz_questions      bool
+	deck                       *int
+	cleareddeck                bool
+	done                       bool
+	oldValue                   func(context.Context) (*Quiz, error)
+	predicates                 []predicate.Quiz
+}
+
+var _ ent.Mutation = (*QuizMutation)(nil)
+
+// quizOption allows management of the mutation configuration using functional options.
+type quizOption func(*QuizMutation)
+
+// newQuizMutation creates new mutation for the Quiz entity.
+func newQuizMutation(c config, op Op, opts ...quizOption) *QuizMutation {
+	m := &QuizMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuiz,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizID sets the ID field of the mutation.
+func withQuizID(id int) quizOption {
+	return func(m *QuizMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Quiz
+		)
+		m.oldValue = func(ctx context.Context) (*Quiz, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Quiz.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuiz sets the old Quiz of the mutation.
+func withQuiz(node *Quiz) quizOption {
+	return func(m *QuizMutation) {
+		m.oldValue = func(context.Context) (*Quiz, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Quiz.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *QuizMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *QuizMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *QuizMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetQuizNumber sets the "quiz_number" field.
+func (m *QuizMutation) SetQuizNumber(i int) {
+	m.quiz_number = &i
+	m.addquiz_number = nil
+}
+
+// QuizNumber returns the value of the "quiz_number" field in the mutation.
+func (m *QuizMutation) QuizNumber() (r int, exists bool) {
+	v := m.quiz_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuizNumber returns the old "quiz_number" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldQuizNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuizNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuizNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuizNumber: %w", err)
+	}
+	return oldValue.QuizNumber, nil
+}
+
+// AddQuizNumber adds i to the "quiz_number" field.
+func (m *QuizMutation) AddQuizNumber(i int) {
+	if m.addquiz_number != nil {
+		*m.addquiz_number += i
+	} else {
+		m.addquiz_number = &i
+	}
+}
+
+// AddedQuizNumber returns the value that was added to the "quiz_number" field in this mutation.
+func (m *QuizMutation) AddedQuizNumber() (r int, exists bool) {
+	v := m.addquiz_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuizNumber resets all changes to the "quiz_number" field.
+func (m *QuizMutation) ResetQuizNumber() {
+	m.quiz_number = nil
+	m.addquiz_number = nil
+}
+
+// SetIsRunning sets the "is_running" field.
+func (m *QuizMutation) SetIsRunning(b bool) {
+	m.is_running = &b
+}
+
+// IsRunning returns the value of the "is_running" field in the mutation.
+func (m *QuizMutation) IsRunning() (r bool, exists bool) {
+	v := m.is_running
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRunning returns the old "is_running" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldIsRunning(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRunning is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRunning requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRunning: %w", err)
+	}
+	return oldValue.IsRunning, nil
+}
+
+// ResetIsRunning resets all changes to the "is_running" field.
+func (m *QuizMutation) ResetIsRunning() {
+	m.is_running = nil
+}
+
+// SetTotalQuestionsCount sets the "total_questions_count" field.
+func (m *QuizMutation) SetTotalQuestionsCount(i int) {
+	m.total_questions_count = &i
+	m.addtotal_questions_count = nil
+}
+
+// TotalQuestionsCount returns the value of the "total_questions_count" field in the mutation.
+func (m *QuizMutation) TotalQuestionsCount() (r int, exists bool) {
+	v := m.total_questions_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalQuestionsCount returns the old "total_questions_count" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTotalQuestionsCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalQuestionsCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalQuestionsCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalQuestionsCount: %w", err)
+	}
+	return oldValue.TotalQuestionsCount, nil
+}
+
+// AddTotalQuestionsCount adds i to the "total_questions_count" field.
+func (m *QuizMutation) AddTotalQuestionsCount(i int) {
+	if m.addtotal_questions_count != nil {
+		*m.addtotal_questions_count += i
+	} else {
+		m.addtotal_questions_count = &i
+	}
+}
+
+// AddedTotalQuestionsCount returns the value that was added to the "total_questions_count" field in this mutation.
+func (m *QuizMutation) AddedTotalQuestionsCount() (r int, exists bool) {
+	v := m.addtotal_questions_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalQuestionsCount resets all changes to the "total_questions_count" field.
+func (m *QuizMutation) ResetTotalQuestionsCount() {
+	m.total_questions_count = nil
+	m.addtotal_questions_count = nil
+}
+
+// SetCorrectCount sets the "correct_count" field.
+func (m *QuizMutation) SetCorrectCount(i int) {
+	m.correct_count = &i
+	m.addcorrect_count = nil
+}
+
+// CorrectCount returns the value of the "correct_count" field in the mutation.
+func (m *QuizMutation) CorrectCount() (r int, exists bool) {
+	v := m.correct_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrectCount returns the old "correct_count" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldCorrectCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrectCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrectCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrectCount: %w", err)
+	}
+	return oldValue.CorrectCount, nil
+}
+
+// AddCorrectCount adds i to the "correct_count" field.
+func (m *QuizMutation) AddCorrectCount(i int) {
+	if m.addcorrect_count != nil {
+		*m.addcorrect_count += i
+	} else {
+		m.addcorrect_count = &i
+	}
+}
+
+// AddedCorrectCount returns the value that was added to the "correct_count" field in this mutation.
+func (m *QuizMutation) AddedCorrectCount() (r int, exists bool) {
+	v := m.addcorrect_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCorrectCount resets all changes to the "correct_count" field.
+func (m *QuizMutation) ResetCorrectCount() {
+	m.correct_count = nil
+	m.addcorrect_count = nil
+}
+
+// SetResultCorrectRate sets the "result_correct_rate" field.
+func (m *QuizMutation) SetResultCorrectRate(f float64) {
+	m.result_correct_rate = &f
+	m.addresult_correct_rate = nil
+}
+
+// ResultCorrectRate returns the value of the "result_correct_rate" field in the mutation.
+func (m *QuizMutation) ResultCorrectRate() (r float64, exists bool) {
+	v := m.result_correct_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultCorrectRate returns the old "result_correct_rate" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldResultCorrectRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultCorrectRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultCorrectRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultCorrectRate: %w", err)
+	}
+	return oldValue.ResultCorrectRate, nil
+}
+
+// AddResultCorrectRate adds f to the "result_correct_rate" field.
+func (m *QuizMutation) AddResultCorrectRate(f float64) {
+	if m.addresult_correct_rate != nil {
+		*m.addresult_correct_rate += f
+	} else {
+		m.addresult_correct_rate = &f
+	}
+}
+
+// AddedResultCorrectRate returns the value that was added to the "result_correct_rate" field in this mutation.
+func (m *QuizMutation) AddedResultCorrectRate() (r float64, exists bool) {
+	v := m.addresult_correct_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResultCorrectRate resets all changes to the "result_correct_rate" field.
+func (m *QuizMutation) ResetResultCorrectRate() {
+	m.result_correct_rate = nil
+	m.addresult_correct_rate = nil
+}
+
+// SetIsSaveResult sets the "is_save_result" field.
+func (m *QuizMutation) SetIsSaveResult(b bool) {
+	m.is_save_result = &b
+}
+
+// IsSaveResult returns the value of the "is_save_result" field in the mutation.
+func (m *QuizMutation) IsSaveResult() (r bool, exists bool) {
+	v := m.is_save_result
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSaveResult returns the old "is_save_result" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldIsSaveResult(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSaveResult is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSaveResult requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSaveResult: %w", err)
+	}
+	return oldValue.IsSaveResult, nil
+}
+
+// ResetIsSaveResult resets all changes to the "is_save_result" field.
+func (m *QuizMutation) ResetIsSaveResult() {
+	m.is_save_result = nil
+}
+
+// SetIsRegisteredWords sets the "is_registered_words" field.
+func (m *QuizMutation) SetIsRegisteredWords(i int) {
+	m.is_registered_words = &i
+	m.addis_registered_words = nil
+}
+
+// IsRegisteredWords returns the value of the "is_registered_words" field in the mutation.
+func (m *QuizMutation) IsRegisteredWords() (r int, exists bool) {
+	v := m.is_registered_words
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRegisteredWords returns the old "is_registered_words" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldIsRegisteredWords(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRegisteredWords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRegisteredWords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRegisteredWords: %w", err)
+	}
+	return oldValue.IsRegisteredWords, nil
+}
+
+// AddIsRegisteredWords adds i to the "is_registered_words" field.
+func (m *QuizMutation) AddIsRegisteredWords(i int) {
+	if m.addis_registered_words != nil {
+		*m.addis_registered_words += i
+	} else {
+		m.addis_registered_words = &i
+	}
+}
+
+// AddedIsRegisteredWords returns the value that was added to the "is_registered_words" field in this mutation.
+func (m *QuizMutation) AddedIsRegisteredWords() (r int, exists bool) {
+	v := m.addis_registered_words
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIsRegisteredWords resets all changes to the "is_registered_words" field.
+func (m *QuizMutation) ResetIsRegisteredWords() {
+	m.is_registered_words = nil
+	m.addis_registered_words = nil
+}
+
+// SetSettingCorrectRate sets the "setting_correct_rate" field.
+func (m *QuizMutation) SetSettingCorrectRate(i int) {
+	m.setting_correct_rate = &i
+	m.addsetting_correct_rate = nil
+}
+
+// SettingCorrectRate returns the value of the "setting_correct_rate" field in the mutation.
+func (m *QuizMutation) SettingCorrectRate() (r int, exists bool) {
+	v := m.setting_correct_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettingCorrectRate returns the old "setting_correct_rate" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldSettingCorrectRate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettingCorrectRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettingCorrectRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettingCorrectRate: %w", err)
+	}
+	return oldValue.SettingCorrectRate, nil
+}
+
+// AddSettingCorrectRate adds i to the "setting_correct_rate" field.
+func (m *QuizMutation) AddSettingCorrectRate(i int) {
+	if m.addsetting_correct_rate != nil {
+		*m.addsetting_correct_rate += i
+	} else {
+		m.addsetting_correct_rate = &i
+	}
+}
+
+// AddedSettingCorrectRate returns the value that was added to the "setting_correct_rate" field in this mutation.
+func (m *QuizMutation) AddedSettingCorrectRate() (r int, exists bool) {
+	v := m.addsetting_correct_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSettingCorrectRate resets all changes to the "setting_correct_rate" field.
+func (m *QuizMutation) ResetSettingCorrectRate() {
+	m.setting_correct_rate = nil
+	m.addsetting_correct_rate = nil
+}
+
+// SetIsIdioms sets the "is_idioms" field.
+func (m *QuizMutation) SetIsIdioms(i int) {
+	m.is_idioms = &i
+	m.addis_idioms = nil
+}
+
+// IsIdioms returns the value of the "is_idioms" field in the mutation.
+func (m *QuizMutation) IsIdioms() (r int, exists bool) {
+	v := m.is_idioms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsIdioms returns the old "is_idioms" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldIsIdioms(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsIdioms is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsIdioms requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsIdioms: %w", err)
+	}
+	return oldValue.IsIdioms, nil
+}
+
+// AddIsIdioms adds i to the "is_idioms" field.
+func (m *QuizMutation) AddIsIdioms(i int) {
+	if m.addis_idioms != nil {
+		*m.addis_idioms += i
+	} else {
+		m.addis_idioms = &i
+	}
+}
+
+// AddedIsIdioms returns the value that was added to the "is_idioms" field in this mutation.
+func (m *QuizMutation) AddedIsIdioms() (r int, exists bool) {
+	v := m.addis_idioms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIsIdioms resets all changes to the "is_idioms" field.
+func (m *QuizMutation) ResetIsIdioms() {
+	m.is_idioms = nil
+	m.addis_idioms = nil
+}
+
+// SetIsSpecialCharacters sets the "is_special_characters" field.
+func (m *QuizMutation) SetIsSpecialCharacters(i int) {
+	m.is_special_characters = &i
+	m.addis_special_characters = nil
+}
+
+// IsSpecialCharacters returns the value of the "is_special_characters" field in the mutation.
+func (m *QuizMutation) IsSpecialCharacters() (r int, exists bool) {
+	v := m.is_special_characters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSpecialCharacters returns the old "is_special_characters" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldIsSpecialCharacters(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSpecialCharacters is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSpecialCharacters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSpecialCharacters: %w", err)
+	}
+	return oldValue.IsSpecialCharacters, nil
+}
+
+// AddIsSpecialCharacters adds i to the "is_special_characters" field.
+func (m *QuizMutation) AddIsSpecialCharacters(i int) {
+	if m.addis_special_characters != nil {
+		*m.addis_special_characters += i
+	} else {
+		m.addis_special_characters = &i
+	}
+}
+
+// AddedIsSpecialCharacters returns the value that was added to the "is_special_characters" field in this mutation.
+func (m *QuizMutation) AddedIsSpecialCharacters() (r int, exists bool) {
+	v := m.addis_special_characters
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIsSpecialCharacters resets all changes to the "is_special_characters" field.
+func (m *QuizMutation) ResetIsSpecialCharacters() {
+	m.is_special_characters = nil
+	m.addis_special_characters = nil
+}
+
+// SetAttentionLevelList sets the "attention_level_list" field.
+func (m *QuizMutation) SetAttentionLevelList(i []int) {
+	m.attention_level_list = &i
+	m.appendattention_level_list = nil
+}
+
+// AttentionLevelList returns the value of the "attention_level_list" field in the mutation.
+func (m *QuizMutation) AttentionLevelList() (r []int, exists bool) {
+	v := m.attention_level_list
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttentionLevelList returns the old "attention_level_list" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldAttentionLevelList(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttentionLevelList is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttentionLevelList requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttentionLevelList: %w", err)
+	}
+	return oldValue.AttentionLevelList, nil
+}
+
+// AppendAttentionLevelList adds i to the "attention_level_list" field.
+func (m *QuizMutation) AppendAttentionLevelList(i []int) {
+	m.appendattention_level_list = append(m.appendattention_level_list, i...)
+}
+
+// AppendedAttentionLevelList returns the list of values that were appended to the "attention_level_list" field in this mutation.
+func (m *QuizMutation) AppendedAttentionLevelList() ([]int, bool) {
+	if len(m.appendattention_level_list) == 0 {
+		return nil, false
+	}
+	return m.appendattention_level_list, true
+}
+
+// ResetAttentionLevelList resets all changes to the "attention_level_list" field.
+func (m *QuizMutation) ResetAttentionLevelList() {
+	m.attention_level_list = nil
+	m.appendattention_level_list = nil
+}
+
+// SetChoicesPosIds sets the "choices_pos_ids" field.
+func (m *QuizMutation) SetChoicesPosIds(i []int) {
+	m.choices_pos_ids = &i
+	m.appendchoices_pos_ids = nil
+}
+
+// ChoicesPosIds returns the value of the "choices_pos_ids" field in the mutation.
+func (m *QuizMutation) ChoicesPosIds() (r []int, exists bool) {
+	v := m.choices_pos_ids
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoicesPosIds returns the old "choices_pos_ids" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldChoicesPosIds(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoicesPosIds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoicesPosIds requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoicesPosIds: %w", err)
+	}
+	return oldValue.ChoicesPosIds, nil
+}
+
+// AppendChoicesPosIds adds i to the "choices_pos_ids" field.
+func (m *QuizMutation) AppendChoicesPosIds(i []int) {
+	m.appendchoices_pos_ids = append(m.appendchoices_pos_ids, i...)
+}
+
+// AppendedChoicesPosIds returns the list of values that were appended to the "choices_pos_ids" field in this mutation.
+func (m *QuizMutation) AppendedChoicesPosIds() ([]int, bool) {
+	if len(m.appendchoices_pos_ids) == 0 {
+		return nil, false
+	}
+	return m.appendchoices_pos_ids, true
+}
+
+// ResetChoicesPosIds resets all changes to the "choices_pos_ids" field.
+func (m *QuizMutation) ResetChoicesPosIds() {
+	m.choices_pos_ids = nil
+	m.appendchoices_pos_ids = nil
+}
+
+// SetMode sets the "mode" field.
+func (m *QuizMutation) SetMode(s string) {
+	m.mode = &s
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *QuizMutation) Mode() (r string, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldMode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *QuizMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetDirection sets the "direction" field.
+func (m *QuizMutation) SetDirection(s string) {
+	m.direction = &s
+}
+
+// Direction returns the value of the "direction" field in the mutation.
+func (m *QuizMutation) Direction() (r string, exists bool) {
+	v := m.direction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirection returns the old "direction" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldDirection(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirection: %w", err)
+	}
+	return oldValue.Direction, nil
+}
+
+// ResetDirection resets all changes to the "direction" field.
+func (m *QuizMutation) ResetDirection() {
+	m.direction = nil
+}
+
+// SetQuestionType sets the "question_type" field.
+func (m *QuizMutation) SetQuestionType(s string) {
+	m.question_type = &s
+}
+
+// QuestionType returns the value of the "question_type" field in the mutation.
+func (m *QuizMutation) QuestionType() (r string, exists bool) {
+	v := m.question_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionType returns the old "question_type" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldQuestionType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionType: %w", err)
+	}
+	return oldValue.QuestionType, nil
+}
+
+// ResetQuestionType resets all changes to the "question_type" field.
+func (m *QuizMutation) ResetQuestionType() {
+	m.question_type = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *QuizMutation) SetDifficulty(s string) {
+	m.difficulty = &s
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *QuizMutation) Difficulty() (r string, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldDifficulty(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *QuizMutation) ResetDifficulty() {
+	m.difficulty = nil
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (m *QuizMutation) SetQuestionTimeLimitSec(i int) {
+	m.question_time_limit_sec = &i
+	m.addquestion_time_limit_sec = nil
+}
+
+// QuestionTimeLimitSec returns the value of the "question_time_limit_sec" field in the mutation.
+func (m *QuizMutation) QuestionTimeLimitSec() (r int, exists bool) {
+	v := m.question_time_limit_sec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionTimeLimitSec returns the old "question_time_limit_sec" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldQuestionTimeLimitSec(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionTimeLimitSec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionTimeLimitSec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionTimeLimitSec: %w", err)
+	}
+	return oldValue.QuestionTimeLimitSec, nil
+}
+
+// AddQuestionTimeLimitSec adds i to the "question_time_limit_sec" field.
+func (m *QuizMutation) AddQuestionTimeLimitSec(i int) {
+	if m.addquestion_time_limit_sec != nil {
+		*m.addquestion_time_limit_sec += i
+	} else {
+		m.addquestion_time_limit_sec = &i
+	}
+}
+
+// AddedQuestionTimeLimitSec returns the value that was added to the "question_time_limit_sec" field in this mutation.
+func (m *QuizMutation) AddedQuestionTimeLimitSec() (r int, exists bool) {
+	v := m.addquestion_time_limit_sec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionTimeLimitSec resets all changes to the "question_time_limit_sec" field.
+func (m *QuizMutation) ResetQuestionTimeLimitSec() {
+	m.question_time_limit_sec = nil
+	m.addquestion_time_limit_sec = nil
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (m *QuizMutation) SetTimeLimitSec(i int) {
+	m.time_limit_sec = &i
+	m.addtime_limit_sec = nil
+}
+
+// TimeLimitSec returns the value of the "time_limit_sec" field in the mutation.
+func (m *QuizMutation) TimeLimitSec() (r int, exists bool) {
+	v := m.time_limit_sec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeLimitSec returns the old "time_limit_sec" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldTimeLimitSec(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeLimitSec is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeLimitSec requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeLimitSec: %w", err)
+	}
+	return oldValue.TimeLimitSec, nil
+}
+
+// AddTimeLimitSec adds i to the "time_limit_sec" field.
+func (m *QuizMutation) AddTimeLimitSec(i int) {
+	if m.addtime_limit_sec != nil {
+		*m.addtime_limit_sec += i
+	} else {
+		m.addtime_limit_sec = &i
+	}
+}
+
+// AddedTimeLimitSec returns the value that was added to the "time_limit_sec" field in this mutation.
+func (m *QuizMutation) AddedTimeLimitSec() (r int, exists bool) {
+	v := m.addtime_limit_sec
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTimeLimitSec resets all changes to the "time_limit_sec" field.
+func (m *QuizMutation) ResetTimeLimitSec() {
+	m.time_limit_sec = nil
+	m.addtime_limit_sec = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *QuizMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *QuizMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *QuizMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[quiz.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *QuizMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *QuizMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, quiz.FieldExpiresAt)
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (m *QuizMutation) SetFinishStatus(s string) {
+	m.finish_status = &s
+}
+
+// FinishStatus returns the value of the "finish_status" field in the mutation.
+func (m *QuizMutation) FinishStatus() (r string, exists bool) {
+	v := m.finish_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishStatus returns the old "finish_status" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldFinishStatus(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishStatus: %w", err)
+	}
+	return oldValue.FinishStatus, nil
+}
+
+// ClearFinishStatus clears the value of the "finish_status" field.
+func (m *QuizMutation) ClearFinishStatus() {
+	m.finish_status = nil
+	m.clearedFields[quiz.FieldFinishStatus] = struct{}{}
+}
+
+// FinishStatusCleared returns if the "finish_status" field was cleared in this mutation.
+func (m *QuizMutation) FinishStatusCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldFinishStatus]
+	return ok
+}
+
+// ResetFinishStatus resets all changes to the "finish_status" field.
+func (m *QuizMutation) ResetFinishStatus() {
+	m.finish_status = nil
+	delete(m.clearedFields, quiz.FieldFinishStatus)
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (m *QuizMutation) SetFinishedAt(t time.Time) {
+	m.finished_at = &t
+}
+
+// FinishedAt returns the value of the "finished_at" field in the mutation.
+func (m *QuizMutation) FinishedAt() (r time.Time, exists bool) {
+	v := m.finished_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFinishedAt returns the old "finished_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldFinishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFinishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFinishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFinishedAt: %w", err)
+	}
+	return oldValue.FinishedAt, nil
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (m *QuizMutation) ClearFinishedAt() {
+	m.finished_at = nil
+	m.clearedFields[quiz.FieldFinishedAt] = struct{}{}
+}
+
+// FinishedAtCleared returns if the "finished_at" field was cleared in this mutation.
+func (m *QuizMutation) FinishedAtCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldFinishedAt]
+	return ok
+}
+
+// ResetFinishedAt resets all changes to the "finished_at" field.
+func (m *QuizMutation) ResetFinishedAt() {
+	m.finished_at = nil
+	delete(m.clearedFields, quiz.FieldFinishedAt)
+}
+
+// SetDeckID sets the "deck_id" field.
+func (m *QuizMutation) SetDeckID(i int) {
+	m.deck = &i
+}
+
+// DeckID returns the value of the "deck_id" field in the mutation.
+func (m *QuizMutation) DeckID() (r int, exists bool) {
+	v := m.deck
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeckID returns the old "deck_id" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldDeckID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeckID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeckID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeckID: %w", err)
+	}
+	return oldValue.DeckID, nil
+}
+
+// ClearDeckID clears the value of the "deck_id" field.
+func (m *QuizMutation) ClearDeckID() {
+	m.deck = nil
+	m.clearedFields[quiz.FieldDeckID] = struct{}{}
+}
+
+// DeckIDCleared returns if the "deck_id" field was cleared in this mutation.
+func (m *QuizMutation) DeckIDCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldDeckID]
+	return ok
+}
+
+// ResetDeckID resets all changes to the "deck_id" field.
+func (m *QuizMutation) ResetDeckID() {
+	m.deck = nil
+	delete(m.clearedFields, quiz.FieldDeckID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuizMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuizMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuizMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *QuizMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *QuizMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Quiz entity.
+// If the Quiz object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *QuizMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[quiz.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *QuizMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[quiz.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *QuizMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, quiz.FieldDeletedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *QuizMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[quiz.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *QuizMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *QuizMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *QuizMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
+func (m *QuizMutation) AddQuizQuestionIDs(ids ...int) {
+	if m.quiz_questions == nil {
+		m.quiz_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.quiz_questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
+func (m *QuizMutation) ClearQuizQuestions() {
+	m.clearedquiz_questions = true
+}
+
+// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
+func (m *QuizMutation) QuizQuestionsCleared() bool {
+	return m.clearedquiz_questions
+}
+
+// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
+func (m *QuizMutation) RemoveQuizQuestionIDs(ids ...int) {
+	if m.removedquiz_questions == nil {
+		m.removedquiz_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.quiz_questions, ids[i])
+		m.removedquiz_questions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
+func (m *QuizMutation) RemovedQuizQuestionsIDs() (ids []int) {
+	for id := range m.removedquiz_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
+func (m *QuizMutation) QuizQuestionsIDs() (ids []int) {
+	for id := range m.quiz_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
+func (m *QuizMutation) ResetQuizQuestions() {
+	m.quiz_questions = nil
+	m.clearedquiz_questions = false
+	m.removedquiz_questions = nil
+}
+
+// ClearDeck clears the "deck" edge to the Deck entity.
+func (m *QuizMutation) ClearDeck() {
+	m.cleareddeck = true
+	m.clearedFields[quiz.FieldDeckID] = struct{}{}
+}
+
+// DeckCleared reports if the "deck" edge to the Deck entity was cleared.
+func (m *QuizMutation) DeckCleared() bool {
+	return m.DeckIDCleared() || m.cleareddeck
+}
+
+// DeckIDs returns the "deck" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// DeckID instead. It exists only for internal usage by the builders.
+func (m *QuizMutation) DeckIDs() (ids []int) {
+	if id := m.deck; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetDeck resets all changes to the "deck" edge.
+func (m *QuizMutation) ResetDeck() {
+	m.deck = nil
+	m.cleareddeck = false
+}
+
+// Where appends a list predicates to the QuizMutation builder.
+func (m *QuizMutation) Where(ps ...predicate.Quiz) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Quiz, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Quiz).
+func (m *QuizMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizMutation) Fields() []string {
+	fields := make([]string, 0, 25)
+	if m.user != nil {
+		fields = append(fields, quiz.FieldUserID)
+	}
+	if m.quiz_number != nil {
+		fields = append(fields, quiz.FieldQuizNumber)
+	}
+	if m.is_running != nil {
+		fields = append(fields, quiz.FieldIsRunning)
+	}
+	if m.total_questions_count != nil {
+		fields = append(fields, quiz.FieldTotalQuestionsCount)
+	}
+	if m.correct_count != nil {
+		fields = append(fields, quiz.FieldCorrectCount)
+	}
+	if m.result_correct_rate != nil {
+		fields = append(fields, quiz.FieldResultCorrectRate)
+	}
+	if m.is_save_result != nil {
+		fields = append(fields, quiz.FieldIsSaveResult)
+	}
+	if m.is_registered_words != nil {
+		fields = append(fields, quiz.FieldIsRegisteredWords)
+	}
+	if m.setting_correct_rate != nil {
+		fields = append(fields, quiz.FieldSettingCorrectRate)
+	}
+	if m.is_idioms != nil {
+		fields = append(fields, quiz.FieldIsIdioms)
+	}
+	if m.is_special_characters != nil {
+		fields = append(fields, quiz.FieldIsSpecialCharacters)
+	}
+	if m.attention_level_list != nil {
+		fields = append(fields, quiz.FieldAttentionLevelList)
+	}
+	if m.choices_pos_ids != nil {
+		fields = append(fields, quiz.FieldChoicesPosIds)
+	}
+	if m.mode != nil {
+		fields = append(fields, quiz.FieldMode)
+	}
+	if m.direction != nil {
+		fields = append(fields, quiz.FieldDirection)
+	}
+	if m.question_type != nil {
+		fields = append(fields, quiz.FieldQuestionType)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, quiz.FieldDifficulty)
+	}
+	if m.question_time_limit_sec != nil {
+		fields = append(fields, quiz.FieldQuestionTimeLimitSec)
+	}
+	if m.time_limit_sec != nil {
+		fields = append(fields, quiz.FieldTimeLimitSec)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, quiz.FieldExpiresAt)
+	}
+	if m.finish_status != nil {
+		fields = append(fields, quiz.FieldFinishStatus)
+	}
+	if m.finished_at != nil {
+		fields = append(fields, quiz.FieldFinishedAt)
+	}
+	if m.deck != nil {
+		fields = append(fields, quiz.FieldDeckID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quiz.FieldCreatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, quiz.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quiz.FieldUserID:
+		return m.UserID()
+	case quiz.FieldQuizNumber:
+		return m.QuizNumber()
+	case quiz.FieldIsRunning:
+		return m.IsRunning()
+	case quiz.FieldTotalQuestionsCount:
+		return m.TotalQuestionsCount()
+	case quiz.FieldCorrectCount:
+		return m.CorrectCount()
+	case quiz.FieldResultCorrectRate:
+		return m.ResultCorrectRate()
+	case quiz.FieldIsSaveResult:
+		return m.IsSaveResult()
+	case quiz.FieldIsRegisteredWords:
+		return m.IsRegisteredWords()
+	case quiz.FieldSettingCorrectRate:
+		return m.SettingCorrectRate()
+	case quiz.FieldIsIdioms:
+		return m.IsIdioms()
+	case quiz.FieldIsSpecialCharacters:
+		return m.IsSpecialCharacters()
+	case quiz.FieldAttentionLevelList:
+		return m.AttentionLevelList()
+	case quiz.FieldChoicesPosIds:
+		return m.ChoicesPosIds()
+	case quiz.FieldMode:
+		return m.Mode()
+	case quiz.FieldDirection:
+		return m.Direction()
+	case quiz.FieldQuestionType:
+		return m.QuestionType()
+	case quiz.FieldDifficulty:
+		return m.Difficulty()
+	case quiz.FieldQuestionTimeLimitSec:
+		return m.QuestionTimeLimitSec()
+	case quiz.FieldTimeLimitSec:
+		return m.TimeLimitSec()
+	case quiz.FieldExpiresAt:
+		return m.ExpiresAt()
+	case quiz.FieldFinishStatus:
+		return m.FinishStatus()
+	case quiz.FieldFinishedAt:
+		return m.FinishedAt()
+	case quiz.FieldDeckID:
+		return m.DeckID()
+	case quiz.FieldCreatedAt:
+		return m.CreatedAt()
+	case quiz.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quiz.FieldUserID:
+		return m.OldUserID(ctx)
+	case quiz.FieldQuizNumber:
+		return m.OldQuizNumber(ctx)
+	case quiz.FieldIsRunning:
+		return m.OldIsRunning(ctx)
+	case quiz.FieldTotalQuestionsCount:
+		return m.OldTotalQuestionsCount(ctx)
+	case quiz.FieldCorrectCount:
+		return m.OldCorrectCount(ctx)
+	case quiz.FieldResultCorrectRate:
+		return m.OldResultCorrectRate(ctx)
+	case quiz.FieldIsSaveResult:
+		return m.OldIsSaveResult(ctx)
+	case quiz.FieldIsRegisteredWords:
+		return m.OldIsRegisteredWords(ctx)
+	case quiz.FieldSettingCorrectRate:
+		return m.OldSettingCorrectRate(ctx)
+	case quiz.FieldIsIdioms:
+		return m.OldIsIdioms(ctx)
+	case quiz.FieldIsSpecialCharacters:
+		return m.OldIsSpecialCharacters(ctx)
+	case quiz.FieldAttentionLevelList:
+		return m.OldAttentionLevelList(ctx)
+	case quiz.FieldChoicesPosIds:
+		return m.OldChoicesPosIds(ctx)
+	case quiz.FieldMode:
+		return m.OldMode(ctx)
+	case quiz.FieldDirection:
+		return m.OldDirection(ctx)
+	case quiz.FieldQuestionType:
+		return m.OldQuestionType(ctx)
+	case quiz.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case quiz.FieldQuestionTimeLimitSec:
+		return m.OldQuestionTimeLimitSec(ctx)
+	case quiz.FieldTimeLimitSec:
+		return m.OldTimeLimitSec(ctx)
+	case quiz.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case quiz.FieldFinishStatus:
+		return m.OldFinishStatus(ctx)
+	case quiz.FieldFinishedAt:
+		return m.OldFinishedAt(ctx)
+	case quiz.FieldDeckID:
+		return m.OldDeckID(ctx)
+	case quiz.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case quiz.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quiz.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case quiz.FieldQuizNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuizNumber(v)
+		return nil
+	case quiz.FieldIsRunning:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRunning(v)
+		return nil
+	case quiz.FieldTotalQuestionsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalQuestionsCount(v)
+		return nil
+	case quiz.FieldCorrectCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrectCount(v)
+		return nil
+	case quiz.FieldResultCorrectRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultCorrectRate(v)
+		return nil
+	case quiz.FieldIsSaveResult:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSaveResult(v)
+		return nil
+	case quiz.FieldIsRegisteredWords:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsRegisteredWords(v)
+		return nil
+	case quiz.FieldSettingCorrectRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettingCorrectRate(v)
+		return nil
+	case quiz.FieldIsIdioms:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsIdioms(v)
+		return nil
+	case quiz.FieldIsSpecialCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSpecialCharacters(v)
+		return nil
+	case quiz.FieldAttentionLevelList:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttentionLevelList(v)
+		return nil
+	case quiz.FieldChoicesPosIds:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoicesPosIds(v)
+		return nil
+	case quiz.FieldMode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case quiz.FieldDirection:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirection(v)
+		return nil
+	case quiz.FieldQuestionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionType(v)
+		return nil
+	case quiz.FieldDifficulty:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case quiz.FieldQuestionTimeLimitSec:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionTimeLimitSec(v)
+		return nil
+	case quiz.FieldTimeLimitSec:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeLimitSec(v)
+		return nil
+	case quiz.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case quiz.FieldFinishStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishStatus(v)
+		return nil
+	case quiz.FieldFinishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinishedAt(v)
+		return nil
+	case quiz.FieldDeckID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeckID(v)
+		return nil
+	case quiz.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case quiz.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuizMutation) AddedFields() []string {
+	var fields []string
+	if m.addquiz_number != nil {
+		fields = append(fields, quiz.FieldQuizNumber)
+	}
+	if m.addtotal_questions_count != nil {
+		fields = append(fields, quiz.FieldTotalQuestionsCount)
+	}
+	if m.addcorrect_count != nil {
+		fields = append(fields, quiz.FieldCorrectCount)
+	}
+	if m.addresult_correct_rate != nil {
+		fields = append(fields, quiz.FieldResultCorrectRate)
+	}
+	if m.addis_registered_words != nil {
+		fields = append(fields, quiz.FieldIsRegisteredWords)
+	}
+	if m.addsetting_correct_rate != nil {
+		fields = append(fields, quiz.FieldSettingCorrectRate)
+	}
+	if m.addis_idioms != nil {
+		fields = append(fields, quiz.FieldIsIdioms)
+	}
+	if m.addis_special_characters != nil {
+		fields = append(fields, quiz.FieldIsSpecialCharacters)
+	}
+	if m.addquestion_time_limit_sec != nil {
+		fields = append(fields, quiz.FieldQuestionTimeLimitSec)
+	}
+	if m.addtime_limit_sec != nil {
+		fields = append(fields, quiz.FieldTimeLimitSec)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuizMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quiz.FieldQuizNumber:
+		return m.AddedQuizNumber()
+	case quiz.FieldTotalQuestionsCount:
+		return m.AddedTotalQuestionsCount()
+	case quiz.FieldCorrectCount:
+		return m.AddedCorrectCount()
+	case quiz.FieldResultCorrectRate:
+		return m.AddedResultCorrectRate()
+	case quiz.FieldIsRegisteredWords:
+		return m.AddedIsRegisteredWords()
+	case quiz.FieldSettingCorrectRate:
+		return m.AddedSettingCorrectRate()
+	case quiz.FieldIsIdioms:
+		return m.AddedIsIdioms()
+	case quiz.FieldIsSpecialCharacters:
+		return m.AddedIsSpecialCharacters()
+	case quiz.FieldQuestionTimeLimitSec:
+		return m.AddedQuestionTimeLimitSec()
+	case quiz.FieldTimeLimitSec:
+		return m.AddedTimeLimitSec()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quiz.FieldQuizNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuizNumber(v)
+		return nil
+	case quiz.FieldTotalQuestionsCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalQuestionsCount(v)
+		return nil
+	case quiz.FieldCorrectCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCorrectCount(v)
+		return nil
+	case quiz.FieldResultCorrectRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResultCorrectRate(v)
+		return nil
+	case quiz.FieldIsRegisteredWords:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIsRegisteredWords(v)
+		return nil
+	case quiz.FieldSettingCorrectRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSettingCorrectRate(v)
+		return nil
+	case quiz.FieldIsIdioms:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIsIdioms(v)
+		return nil
+	case quiz.FieldIsSpecialCharacters:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIsSpecialCharacters(v)
+		return nil
+	case quiz.FieldQuestionTimeLimitSec:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionTimeLimitSec(v)
+		return nil
+	case quiz.FieldTimeLimitSec:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeLimitSec(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuizMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quiz.FieldExpiresAt) {
+		fields = append(fields, quiz.FieldExpiresAt)
+	}
+	if m.FieldCleared(quiz.FieldFinishStatus) {
+		fields = append(fields, quiz.FieldFinishStatus)
+	}
+	if m.FieldCleared(quiz.FieldFinishedAt) {
+		fields = append(fields, quiz.FieldFinishedAt)
+	}
+	if m.FieldCleared(quiz.FieldDeckID) {
+		fields = append(fields, quiz.FieldDeckID)
+	}
+	if m.FieldCleared(quiz.FieldDeletedAt) {
+		fields = append(fields, quiz.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuizMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuizMutation) ClearField(name string) error {
+	switch name {
+	case quiz.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case quiz.FieldFinishStatus:
+		m.ClearFinishStatus()
+		return nil
+	case quiz.FieldFinishedAt:
+		m.ClearFinishedAt()
+		return nil
+	case quiz.FieldDeckID:
+		m.ClearDeckID()
+		return nil
+	case quiz.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuizMutation) ResetField(name string) error {
+	switch name {
+	case quiz.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case quiz.FieldQuizNumber:
+		m.ResetQuizNumber()
+		return nil
+	case quiz.FieldIsRunning:
+		m.ResetIsRunning()
+		return nil
+	case quiz.FieldTotalQuestionsCount:
+		m.ResetTotalQuestionsCount()
+		return nil
+	case quiz.FieldCorrectCount:
+		m.ResetCorrectCount()
+		return nil
+	case quiz.FieldResultCorrectRate:
+		m.ResetResultCorrectRate()
+		return nil
+	case quiz.FieldIsSaveResult:
+		m.ResetIsSaveResult()
+		return nil
+	case quiz.FieldIsRegisteredWords:
+		m.ResetIsRegisteredWords()
+		return nil
+	case quiz.FieldSettingCorrectRate:
+		m.ResetSettingCorrectRate()
+		return nil
+	case quiz.FieldIsIdioms:
+		m.ResetIsIdioms()
+		return nil
+	case quiz.FieldIsSpecialCharacters:
+		m.ResetIsSpecialCharacters()
+		return nil
+	case quiz.FieldAttentionLevelList:
+		m.ResetAttentionLevelList()
+		return nil
+	case quiz.FieldChoicesPosIds:
+		m.ResetChoicesPosIds()
+		return nil
+	case quiz.FieldMode:
+		m.ResetMode()
+		return nil
+	case quiz.FieldDirection:
+		m.ResetDirection()
+		return nil
+	case quiz.FieldQuestionType:
+		m.ResetQuestionType()
+		return nil
+	case quiz.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case quiz.FieldQuestionTimeLimitSec:
+		m.ResetQuestionTimeLimitSec()
+		return nil
+	case quiz.FieldTimeLimitSec:
+		m.ResetTimeLimitSec()
+		return nil
+	case quiz.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case quiz.FieldFinishStatus:
+		m.ResetFinishStatus()
+		return nil
+	case quiz.FieldFinishedAt:
+		m.ResetFinishedAt()
+		return nil
+	case quiz.FieldDeckID:
+		m.ResetDeckID()
+		return nil
+	case quiz.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case quiz.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuizMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, quiz.EdgeUser)
+	}
+	if m.quiz_questions != nil {
+		edges = append(edges, quiz.EdgeQuizQuestions)
+	}
+	if m.deck != nil {
+		edges = append(edges, quiz.EdgeDeck)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuizMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case quiz.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case quiz.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.quiz_questions))
+		for id := range m.quiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case quiz.EdgeDeck:
+		if id := m.deck; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuizMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedquiz_questions != nil {
+		edges = append(edges, quiz.EdgeQuizQuestions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuizMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case quiz.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
+		for id := range m.removedquiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuizMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, quiz.EdgeUser)
+	}
+	if m.clearedquiz_questions {
+		edges = append(edges, quiz.EdgeQuizQuestions)
+	}
+	if m.cleareddeck {
+		edges = append(edges, quiz.EdgeDeck)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuizMutation) EdgeCleared(name string) bool {
+	switch name {
+	case quiz.EdgeUser:
+		return m.cleareduser
+	case quiz.EdgeQuizQuestions:
+		return m.clearedquiz_questions
+	case quiz.EdgeDeck:
+		return m.cleareddeck
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuizMutation) ClearEdge(name string) error {
+	switch name {
+	case quiz.EdgeUser:
+		m.ClearUser()
+		return nil
+	case quiz.EdgeDeck:
+		m.ClearDeck()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuizMutation) ResetEdge(name string) error {
+	switch name {
+	case quiz.EdgeUser:
+		m.ResetUser()
+		return nil
+	case quiz.EdgeQuizQuestions:
+		m.ResetQuizQuestions()
+		return nil
+	case quiz.EdgeDeck:
+		m.ResetDeck()
+		return nil
+	}
+	return fmt.Errorf("unknown Quiz edge %s", name)
+}
+
+// QuizQuestionMutation represents an operation that mutates the QuizQuestion nodes in the graph.
+type QuizQuestionMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	question_number      *int
+	addquestion_number   *int
+	wordName             *string
+	pos_id               *int
+	addpos_id            *int
+	direction            *string
+	question_type        *string
+	meaning_name         *string
+	choices_jpms         *[]models.ChoiceJpm
+	appendchoices_jpms   []models.ChoiceJpm
+	choices_words        *[]models.ChoiceWord
+	appendchoices_words  []models.ChoiceWord
+	answer_jpm_id        *int
+	addanswer_jpm_id     *int
+	answer_word_id       *int
+	addanswer_word_id    *int
+	typed_answer         *string
+	grade                *string
+	credit               *float64
+	addcredit            *float64
+	is_correct           *bool
+	served_at            *time.Time
+	answered_at          *time.Time
+	idempotency_key      *string
+	time_ms              *int
+	addtime_ms           *int
+	created_at           *time.Time
+	deleted_at           *time.Time
+	clearedFields        map[string]struct{}
+	quiz                 *int
+	clearedquiz          bool
+	word                 *int
+	clearedword          bool
+	japanese_mean        *int
+	clearedjapanese_mean bool
+	done                 bool
+	oldValue             func(context.Context) (*QuizQuestion, error)
+	predicates           []predicate.QuizQuestion
+}
+
+var _ ent.Mutation = (*QuizQuestionMutation)(nil)
+
+// quizquestionOption allows management of the mutation configuration using functional options.
+type quizquestionOption func(*QuizQuestionMutation)
+
+// newQuizQuestionMutation creates new mutation for the QuizQuestion entity.
+func newQuizQuestionMutation(c config, op Op, opts ...quizquestionOption) *QuizQuestionMutation {
+	m := &QuizQuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeQuizQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withQuizQuestionID sets the ID field of the mutation.
+func withQuizQuestionID(id int) quizquestionOption {
+	return func(m *QuizQuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *QuizQuestion
+		)
+		m.oldValue = func(ctx context.Context) (*QuizQuestion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().QuizQuestion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withQuizQuestion sets the old QuizQuestion of the mutation.
+func withQuizQuestion(node *QuizQuestion) quizquestionOption {
+	return func(m *QuizQuestionMutation) {
+		m.oldValue = func(context.Context) (*QuizQuestion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m QuizQuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m QuizQuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *QuizQuestionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *QuizQuestionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().QuizQuestion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetQuizID sets the "quiz_id" field.
+func (m *QuizQuestionMutation) SetQuizID(i int) {
+	m.quiz = &i
+}
+
+// QuizID returns the value of the "quiz_id" field in the mutation.
+func (m *QuizQuestionMutation) QuizID() (r int, exists bool) {
+	v := m.quiz
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuizID returns the old "quiz_id" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldQuizID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuizID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuizID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuizID: %w", err)
+	}
+	return oldValue.QuizID, nil
+}
+
+// ResetQuizID resets all changes to the "quiz_id" field.
+func (m *QuizQuestionMutation) ResetQuizID() {
+	m.quiz = nil
+}
+
+// SetQuestionNumber sets the "question_number" field.
+func (m *QuizQuestionMutation) SetQuestionNumber(i int) {
+	m.question_number = &i
+	m.addquestion_number = nil
+}
+
+// QuestionNumber returns the value of the "question_number" field in the mutation.
+func (m *QuizQuestionMutation) QuestionNumber() (r int, exists bool) {
+	v := m.question_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionNumber returns the old "question_number" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldQuestionNumber(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionNumber: %w", err)
+	}
+	return oldValue.QuestionNumber, nil
+}
+
+// AddQuestionNumber adds i to the "question_number" field.
+func (m *QuizQuestionMutation) AddQuestionNumber(i int) {
+	if m.addquestion_number != nil {
+		*m.addquestion_number += i
+	} else {
+		m.addquestion_number = &i
+	}
+}
+
+// AddedQuestionNumber returns the value that was added to the "question_number" field in this mutation.
+func (m *QuizQuestionMutation) AddedQuestionNumber() (r int, exists bool) {
+	v := m.addquestion_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuestionNumber resets all changes to the "question_number" field.
+func (m *QuizQuestionMutation) ResetQuestionNumber() {
+	m.question_number = nil
+	m.addquestion_number = nil
+}
+
+// SetWordID sets the "word_id" field.
+func (m *QuizQuestionMutation) SetWordID(i int) {
+	m.word = &i
+}
+
+// WordID returns the value of the "word_id" field in the mutation.
+func (m *QuizQuestionMutation) WordID() (r int, exists bool) {
+	v := m.word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordID returns the old "word_id" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldWordID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
+	}
+	return oldValue.WordID, nil
+}
+
+// ResetWordID resets all changes to the "word_id" field.
+func (m *QuizQuestionMutation) ResetWordID() {
+	m.word = nil
+}
+
+// SetWordName sets the "wordName" field.
+func (m *QuizQuestionMutation) SetWordName(s string) {
+	m.wordName = &s
+}
+
+// WordName returns the value of the "wordName" field in the mutation.
+func (m *QuizQuestionMutation) WordName() (r string, exists bool) {
+	v := m.wordName
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordName returns the old "wordName" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldWordName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordName: %w", err)
+	}
+	return oldValue.WordName, nil
+}
+
+// ResetWordName resets all changes to the "wordName" field.
+func (m *QuizQuestionMutation) ResetWordName() {
+	m.wordName = nil
+}
+
+// SetPosID sets the "pos_id" field.
+func (m *QuizQuestionMutation) SetPosID(i int) {
+	m.pos_id = &i
+	m.addpos_id = nil
+}
+
+// PosID returns the value of the "pos_id" field in the mutation.
+func (m *QuizQuestionMutation) PosID() (r int, exists bool) {
+	v := m.pos_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosID returns the old "pos_id" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldPosID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosID: %w", err)
+	}
+	return oldValue.PosID, nil
+}
+
+// AddPosID adds i to the "pos_id" field.
+func (m *QuizQuestionMutation) AddPosID(i int) {
+	if m.addpos_id != nil {
+		*m.addpos_id += i
+	} else {
+		m.addpos_id = &i
+	}
+}
+
+// AddedPosID returns the value that was added to the "pos_id" field in this mutation.
+func (m *QuizQuestionMutation) AddedPosID() (r int, exists bool) {
+	v := m.addpos_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosID resets all changes to the "pos_id" field.
+func (m *QuizQuestionMutation) ResetPosID() {
+	m.pos_id = nil
+	m.addpos_id = nil
+}
+
+// SetCorrectJpmID sets the "correct_jpm_id" field.
+func (m *QuizQuestionMutation) SetCorrectJpmID(i int) {
+	m.japanese_mean = &i
+}
+
+// CorrectJpmID returns the value of the "correct_jpm_id" field in the mutation.
+func (m *QuizQuestionMutation) CorrectJpmID() (r int, exists bool) {
+	v := m.japanese_mean
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrectJpmID returns the old "correct_jpm_id" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldCorrectJpmID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrectJpmID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrectJpmID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrectJpmID: %w", err)
+	}
+	return oldValue.CorrectJpmID, nil
+}
+
+// ResetCorrectJpmID resets all changes to the "correct_jpm_id" field.
+func (m *QuizQuestionMutation) ResetCorrectJpmID() {
+	m.japanese_mean = nil
+}
+
+// SetDirection sets the "direction" field.
+func (m *QuizQuestionMutation) SetDirection(s string) {
+	m.direction = &s
+}
+
+// Direction returns the value of the "direction" field in the mutation.
+func (m *QuizQuestionMutation) Direction() (r string, exists bool) {
+	v := m.direction
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDirection returns the old "direction" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldDirection(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDirection is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDirection requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDirection: %w", err)
+	}
+	return oldValue.Direction, nil
+}
+
+// ResetDirection resets all changes to the "direction" field.
+func (m *QuizQuestionMutation) ResetDirection() {
+	m.direction = nil
+}
+
+// SetQuestionType sets the "question_type" field.
+func (m *QuizQuestionMutation) SetQuestionType(s string) {
+	m.question_type = &s
+}
+
+// QuestionType returns the value of the "question_type" field in the mutation.
+func (m *QuizQuestionMutation) QuestionType() (r string, exists bool) {
+	v := m.question_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionType returns the old "question_type" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldQuestionType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionType: %w", err)
+	}
+	return oldValue.QuestionType, nil
+}
+
+// ResetQuestionType resets all changes to the "question_type" field.
+func (m *QuizQuestionMutation) ResetQuestionType() {
+	m.question_type = nil
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (m *QuizQuestionMutation) SetMeaningName(s string) {
+	m.meaning_name = &s
+}
+
+// MeaningName returns the value of the "meaning_name" field in the mutation.
+func (m *QuizQuestionMutation) MeaningName() (r string, exists bool) {
+	v := m.meaning_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMeaningName returns the old "meaning_name" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldMeaningName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMeaningName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMeaningName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMeaningName: %w", err)
+	}
+	return oldValue.MeaningName, nil
+}
+
+// ClearMeaningName clears the value of the "meaning_name" field.
+func (m *QuizQuestionMutation) ClearMeaningName() {
+	m.meaning_name = nil
+	m.clearedFields[quizquestion.FieldMeaningName] = struct{}{}
+}
+
+// MeaningNameCleared returns if the "meaning_name" field was cleared in this mutation.
+func (m *QuizQuestionMutation) MeaningNameCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldMeaningName]
+	return ok
+}
+
+// ResetMeaningName resets all changes to the "meaning_name" field.
+func (m *QuizQuestionMutation) ResetMeaningName() {
+	m.meaning_name = nil
+	delete(m.clearedFields, quizquestion.FieldMeaningName)
+}
+
+// SetChoicesJpms sets the "choices_jpms" field.
+func (m *QuizQuestionMutation) SetChoicesJpms(mj []models.ChoiceJpm) {
+	m.choices_jpms = &mj
+	m.appendchoices_jpms = nil
+}
+
+// ChoicesJpms returns the value of the "choices_jpms" field in the mutation.
+func (m *QuizQuestionMutation) ChoicesJpms() (r []models.ChoiceJpm, exists bool) {
+	v := m.choices_jpms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoicesJpms returns the old "choices_jpms" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldChoicesJpms(ctx context.Context) (v []models.ChoiceJpm, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoicesJpms is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoicesJpms requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoicesJpms: %w", err)
+	}
+	return oldValue.ChoicesJpms, nil
+}
+
+// AppendChoicesJpms adds mj to the "choices_jpms" field.
+func (m *QuizQuestionMutation) AppendChoicesJpms(mj []models.ChoiceJpm) {
+	m.appendchoices_jpms = append(m.appendchoices_jpms, mj...)
+}
+
+// AppendedChoicesJpms returns the list of values that were appended to the "choices_jpms" field in this mutation.
+func (m *QuizQuestionMutation) AppendedChoicesJpms() ([]models.ChoiceJpm, bool) {
+	if len(m.appendchoices_jpms) == 0 {
+		return nil, false
+	}
+	return m.appendchoices_jpms, true
+}
+
+// ResetChoicesJpms resets all changes to the "choices_jpms" field.
+func (m *QuizQuestionMutation) ResetChoicesJpms() {
+	m.choices_jpms = nil
+	m.appendchoices_jpms = nil
+}
+
+// SetChoicesWords sets the "choices_words" field.
+func (m *QuizQuestionMutation) SetChoicesWords(mw []models.ChoiceWord) {
+	m.choices_words = &mw
+	m.appendchoices_words = nil
+}
+
+// ChoicesWords returns the value of the "choices_words" field in the mutation.
+func (m *QuizQuestionMutation) ChoicesWords() (r []models.ChoiceWord, exists bool) {
+	v := m.choices_words
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChoicesWords returns the old "choices_words" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldChoicesWords(ctx context.Context) (v []models.ChoiceWord, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChoicesWords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChoicesWords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChoicesWords: %w", err)
+	}
+	return oldValue.ChoicesWords, nil
+}
+
+// AppendChoicesWords adds mw to the "choices_words" field.
+func (m *QuizQuestionMutation) AppendChoicesWords(mw []models.ChoiceWord) {
+	m.appendchoices_words = append(m.appendchoices_words, mw...)
+}
+
+// AppendedChoicesWords returns the list of values that were appended to the "choices_words" field in this mutation.
+func (m *QuizQuestionMutation) AppendedChoicesWords() ([]models.ChoiceWord, bool) {
+	if len(m.appendchoices_words) == 0 {
+		return nil, false
+	}
+	return m.appendchoices_words, true
+}
+
+// ClearChoicesWords clears the value of the "choices_words" field.
+func (m *QuizQuestionMutation) ClearChoicesWords() {
+	m.choices_words = nil
+	m.appendchoices_words = nil
+	m.clearedFields[quizquestion.FieldChoicesWords] = struct{}{}
+}
+
+// ChoicesWordsCleared returns if the "choices_words" field was cleared in this mutation.
+func (m *QuizQuestionMutation) ChoicesWordsCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldChoicesWords]
+	return ok
+}
+
+// ResetChoicesWords resets all changes to the "choices_words" field.
+func (m *QuizQuestionMutation) ResetChoicesWords() {
+	m.choices_words = nil
+	m.appendchoices_words = nil
+	delete(m.clearedFields, quizquestion.FieldChoicesWords)
+}
+
+// SetAnswerJpmID sets the "answer_jpm_id" field.
+func (m *QuizQuestionMutation) SetAnswerJpmID(i int) {
+	m.answer_jpm_id = &i
+	m.addanswer_jpm_id = nil
+}
+
+// AnswerJpmID returns the value of the "answer_jpm_id" field in the mutation.
+func (m *QuizQuestionMutation) AnswerJpmID() (r int, exists bool) {
+	v := m.answer_jpm_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerJpmID returns the old "answer_jpm_id" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldAnswerJpmID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerJpmID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerJpmID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerJpmID: %w", err)
+	}
+	return oldValue.AnswerJpmID, nil
+}
+
+// AddAnswerJpmID adds i to the "answer_jpm_id" field.
+func (m *QuizQuestionMutation) AddAnswerJpmID(i int) {
+	if m.addanswer_jpm_id != nil {
+		*m.addanswer_jpm_id += i
+	} else {
+		m.addanswer_jpm_id = &i
+	}
+}
+
+// AddedAnswerJpmID returns the value that was added to the "answer_jpm_id" field in this mutation.
+func (m *QuizQuestionMutation) AddedAnswerJpmID() (r int, exists bool) {
+	v := m.addanswer_jpm_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAnswerJpmID clears the value of the "answer_jpm_id" field.
+func (m *QuizQuestionMutation) ClearAnswerJpmID() {
+	m.answer_jpm_id = nil
+	m.addanswer_jpm_id = nil
+	m.clearedFields[quizquestion.FieldAnswerJpmID] = struct{}{}
+}
+
+// AnswerJpmIDCleared returns if the "answer_jpm_id" field was cleared in this mutation.
+func (m *QuizQuestionMutation) AnswerJpmIDCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldAnswerJpmID]
+	return ok
+}
+
+// ResetAnswerJpmID resets all changes to the "answer_jpm_id" field.
+func (m *QuizQuestionMutation) ResetAnswerJpmID() {
+	m.answer_jpm_id = nil
+	m.addanswer_jpm_id = nil
+	delete(m.clearedFields, quizquestion.FieldAnswerJpmID)
+}
+
+// SetAnswerWordID sets the "answer_word_id" field.
+func (m *QuizQuestionMutation) SetAnswerWordID(i int) {
+	m.answer_word_id = &i
+	m.addanswer_word_id = nil
+}
+
+// AnswerWordID returns the value of the "answer_word_id" field in the mutation.
+func (m *QuizQuestionMutation) AnswerWordID() (r int, exists bool) {
+	v := m.answer_word_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnswerWordID returns the old "answer_word_id" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldAnswerWordID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnswerWordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnswerWordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnswerWordID: %w", err)
+	}
+	return oldValue.AnswerWordID, nil
+}
+
+// AddAnswerWordID adds i to the "answer_word_id" field.
+func (m *QuizQuestionMutation) AddAnswerWordID(i int) {
+	if m.addanswer_word_id != nil {
+		*m.addanswer_word_id += i
+	} else {
+		m.addanswer_word_id = &i
+	}
+}
+
+// AddedAnswerWordID returns the value that was added to the "answer_word_id" field in this mutation.
+func (m *QuizQuestionMutation) AddedAnswerWordID() (r int, exists bool) {
+	v := m.addanswer_word_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAnswerWordID clears the value of the "answer_word_id" field.
+func (m *QuizQuestionMutation) ClearAnswerWordID() {
+	m.answer_word_id = nil
+	m.addanswer_word_id = nil
+	m.clearedFields[quizquestion.FieldAnswerWordID] = struct{}{}
+}
+
+// AnswerWordIDCleared returns if the "answer_word_id" field was cleared in this mutation.
+func (m *QuizQuestionMutation) AnswerWordIDCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldAnswerWordID]
+	return ok
+}
+
+// ResetAnswerWordID resets all changes to the "answer_word_id" field.
+func (m *QuizQuestionMutation) ResetAnswerWordID() {
+	m.answer_word_id = nil
+	m.addanswer_word_id = nil
+	delete(m.clearedFields, quizquestion.FieldAnswerWordID)
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (m *QuizQuestionMutation) SetTypedAnswer(s string) {
+	m.typed_answer = &s
+}
+
+// TypedAnswer returns the value of the "typed_answer" field in the mutation.
+func (m *QuizQuestionMutation) TypedAnswer() (r string, exists bool) {
+	v := m.typed_answer
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTypedAnswer returns the old "typed_answer" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldTypedAnswer(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTypedAnswer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTypedAnswer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTypedAnswer: %w", err)
+	}
+	return oldValue.TypedAnswer, nil
+}
+
+// ClearTypedAnswer clears the value of the "typed_answer" field.
+func (m *QuizQuestionMutation) ClearTypedAnswer() {
+	m.typed_answer = nil
+	m.clearedFields[quizquestion.FieldTypedAnswer] = struct{}{}
+}
+
+// TypedAnswerCleared returns if the "typed_answer" field was cleared in this mutation.
+func (m *QuizQuestionMutation) TypedAnswerCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldTypedAnswer]
+	return ok
+}
+
+// ResetTypedAnswer resets all changes to the "typed_answer" field.
+func (m *QuizQuestionMutation) ResetTypedAnswer() {
+	m.typed_answer = nil
+	delete(m.clearedFields, quizquestion.FieldTypedAnswer)
+}
+
+// SetGrade sets the "grade" field.
+func (m *QuizQuestionMutation) SetGrade(s string) {
+	m.grade = &s
+}
+
+// Grade returns the value of the "grade" field in the mutation.
+func (m *QuizQuestionMutation) Grade() (r string, exists bool) {
+	v := m.grade
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrade returns the old "grade" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldGrade(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrade is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrade requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrade: %w", err)
+	}
+	return oldValue.Grade, nil
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (m *QuizQuestionMutation) ClearGrade() {
+	m.grade = nil
+	m.clearedFields[quizquestion.FieldGrade] = struct{}{}
+}
+
+// GradeCleared returns if the "grade" field was cleared in this mutation.
+func (m *QuizQuestionMutation) GradeCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldGrade]
+	return ok
+}
+
+// ResetGrade resets all changes to the "grade" field.
+func (m *QuizQuestionMutation) ResetGrade() {
+	m.grade = nil
+	delete(m.clearedFields, quizquestion.FieldGrade)
+}
+
+// SetCredit sets the "credit" field.
+func (m *QuizQuestionMutation) SetCredit(f float64) {
+	m.credit = &f
+	m.addcredit = nil
+}
+
+// Credit returns the value of the "credit" field in the mutation.
+func (m *QuizQuestionMutation) Credit() (r float64, exists bool) {
+	v := m.credit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCredit returns the old "credit" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldCredit(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCredit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCredit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCredit: %w", err)
+	}
+	return oldValue.Credit, nil
+}
+
+// AddCredit adds f to the "credit" field.
+func (m *QuizQuestionMutation) AddCredit(f float64) {
+	if m.addcredit != nil {
+		*m.addcredit += f
+	} else {
+		m.addcredit = &f
+	}
+}
+
+// AddedCredit returns the value that was added to the "credit" field in this mutation.
+func (m *QuizQuestionMutation) AddedCredit() (r float64, exists bool) {
+	v := m.addcredit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCredit resets all changes to the "credit" field.
+func (m *QuizQuestionMutation) ResetCredit() {
+	m.credit = nil
+	m.addcredit = nil
+}
+
+// SetIsCorrect sets the "is_correct" field.
+func (m *QuizQuestionMutation) SetIsCorrect(b bool) {
+	m.is_correct = &b
+}
+
+// IsCorrect returns the value of the "is_correct" field in the mutation.
+func (m *QuizQuestionMutation) IsCorrect() (r bool, exists bool) {
+	v := m.is_correct
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsCorrect returns the old "is_correct" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldIsCorrect(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsCorrect is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsCorrect requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsCorrect: %w", err)
+	}
+	return oldValue.IsCorrect, nil
+}
+
+// ClearIsCorrect clears the value of the "is_correct" field.
+func (m *QuizQuestionMutation) ClearIsCorrect() {
+	m.is_correct = nil
+	m.clearedFields[quizquestion.FieldIsCorrect] = struct{}{}
+}
+
+// IsCorrectCleared returns if the "is_correct" field was cleared in this mutation.
+func (m *QuizQuestionMutation) IsCorrectCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldIsCorrect]
+	return ok
+}
+
+// ResetIsCorrect resets all changes to the "is_correct" field.
+func (m *QuizQuestionMutation) ResetIsCorrect() {
+	m.is_correct = nil
+	delete(m.clearedFields, quizquestion.FieldIsCorrect)
+}
+
+// SetServedAt sets the "served_at" field.
+func (m *QuizQuestionMutation) SetServedAt(t time.Time) {
+	m.served_at = &t
+}
+
+// ServedAt returns the value of the "served_at" field in the mutation.
+func (m *QuizQuestionMutation) ServedAt() (r time.Time, exists bool) {
+	v := m.served_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldServedAt returns the old "served_at" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldServedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldServedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldServedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldServedAt: %w", err)
+	}
+	return oldValue.ServedAt, nil
+}
+
+// ClearServedAt clears the value of the "served_at" field.
+func (m *QuizQuestionMutation) ClearServedAt() {
+	m.served_at = nil
+	m.clearedFields[quizquestion.FieldServedAt] = struct{}{}
+}
+
+// ServedAtCleared returns if the "served_at" field was cleared in this mutation.
+func (m *QuizQuestionMutation) ServedAtCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldServedAt]
+	return ok
+}
+
+// ResetServedAt resets all changes to the "served_at" field.
+func (m *QuizQuestionMutation) ResetServedAt() {
+	m.served_at = nil
+	delete(m.clearedFields, quizquestion.FieldServedAt)
+}
+
+// SetAnsweredAt sets the "answered_at" field.
+func (m *QuizQuestionMutation) SetAnsweredAt(t time.Time) {
+	m.answered_at = &t
+}
+
+// AnsweredAt returns the value of the "answered_at" field in the mutation.
+func (m *QuizQuestionMutation) AnsweredAt() (r time.Time, exists bool) {
+	v := m.answered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnsweredAt returns the old "answered_at" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldAnsweredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnsweredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnsweredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnsweredAt: %w", err)
+	}
+	return oldValue.AnsweredAt, nil
+}
+
+// ClearAnsweredAt clears the value of the "answered_at" field.
+func (m *QuizQuestionMutation) ClearAnsweredAt() {
+	m.answered_at = nil
+	m.clearedFields[quizquestion.FieldAnsweredAt] = struct{}{}
+}
+
+// AnsweredAtCleared returns if the "answered_at" field was cleared in this mutation.
+func (m *QuizQuestionMutation) AnsweredAtCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldAnsweredAt]
+	return ok
+}
+
+// ResetAnsweredAt resets all changes to the "answered_at" field.
+func (m *QuizQuestionMutation) ResetAnsweredAt() {
+	m.answered_at = nil
+	delete(m.clearedFields, quizquestion.FieldAnsweredAt)
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *QuizQuestionMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *QuizQuestionMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldIdempotencyKey(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (m *QuizQuestionMutation) ClearIdempotencyKey() {
+	m.idempotency_key = nil
+	m.clearedFields[quizquestion.FieldIdempotencyKey] = struct{}{}
+}
+
+// IdempotencyKeyCleared returns if the "idempotency_key" field was cleared in this mutation.
+func (m *QuizQuestionMutation) IdempotencyKeyCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldIdempotencyKey]
+	return ok
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *QuizQuestionMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+	delete(m.clearedFields, quizquestion.FieldIdempotencyKey)
+}
+
+// SetTimeMs sets the "time_ms" field.
+func (m *QuizQuestionMutation) SetTimeMs(i int) {
+	m.time_ms = &i
+	m.addtime_ms = nil
+}
+
+// TimeMs returns the value of the "time_ms" field in the mutation.
+func (m *QuizQuestionMutation) TimeMs() (r int, exists bool) {
+	v := m.time_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeMs returns the old "time_ms" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldTimeMs(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeMs: %w", err)
+	}
+	return oldValue.TimeMs, nil
+}
+
+// AddTimeMs adds i to the "time_ms" field.
+func (m *QuizQuestionMutation) AddTimeMs(i int) {
+	if m.addtime_ms != nil {
+		*m.addtime_ms += i
+	} else {
+		m.addtime_ms = &i
+	}
+}
+
+// AddedTimeMs returns the value that was added to the "time_ms" field in this mutation.
+func (m *QuizQuestionMutation) AddedTimeMs() (r int, exists bool) {
+	v := m.addtime_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearTimeMs clears the value of the "time_ms" field.
+func (m *QuizQuestionMutation) ClearTimeMs() {
+	m.time_ms = nil
+	m.addtime_ms = nil
+	m.clearedFields[quizquestion.FieldTimeMs] = struct{}{}
+}
+
+// TimeMsCleared returns if the "time_ms" field was cleared in this mutation.
+func (m *QuizQuestionMutation) TimeMsCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldTimeMs]
+	return ok
+}
+
+// ResetTimeMs resets all changes to the "time_ms" field.
+func (m *QuizQuestionMutation) ResetTimeMs() {
+	m.time_ms = nil
+	m.addtime_ms = nil
+	delete(m.clearedFields, quizquestion.FieldTimeMs)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *QuizQuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *QuizQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *QuizQuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *QuizQuestionMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *QuizQuestionMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the QuizQuestion entity.
+// If the QuizQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *QuizQuestionMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *QuizQuestionMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[quizquestion.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *QuizQuestionMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[quizquestion.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *QuizQuestionMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, quizquestion.FieldDeletedAt)
+}
+
+// ClearQuiz clears the "quiz" edge to the Quiz entity.
+func (m *QuizQuestionMutation) ClearQuiz() {
+	m.clearedquiz = true
+	m.clearedFields[quizquestion.FieldQuizID] = struct{}{}
+}
+
+// QuizCleared reports if the "quiz" edge to the Quiz entity was cleared.
+func (m *QuizQuestionMutation) QuizCleared() bool {
+	return m.clearedquiz
+}
+
+// QuizIDs returns the "quiz" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// QuizID instead. It exists only for internal usage by the builders.
+func (m *QuizQuestionMutation) QuizIDs() (ids []int) {
+	if id := m.quiz; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetQuiz resets all changes to the "quiz" edge.
+func (m *QuizQuestionMutation) ResetQuiz() {
+	m.quiz = nil
+	m.clearedquiz = false
+}
+
+// ClearWord clears the "word" edge to the Word entity.
+func (m *QuizQuestionMutation) ClearWord() {
+	m.clearedword = true
+	m.clearedFields[quizquestion.FieldWordID] = struct{}{}
+}
+
+// WordCleared reports if the "word" edge to the Word entity was cleared.
+func (m *QuizQuestionMutation) WordCleared() bool {
+	return m.clearedword
+}
+
+// WordIDs returns the "word" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WordID instead. It exists only for internal usage by the builders.
+func (m *QuizQuestionMutation) WordIDs() (ids []int) {
+	if id := m.word; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWord resets all changes to the "word" edge.
+func (m *QuizQuestionMutation) ResetWord() {
+	m.word = nil
+	m.clearedword = false
+}
+
+// SetJapaneseMeanID sets the "japanese_mean" edge to the JapaneseMean entity by id.
+func (m *QuizQuestionMutation) SetJapaneseMeanID(id int) {
+	m.japanese_mean = &id
+}
+
+// ClearJapaneseMean clears the "japanese_mean" edge to the JapaneseMean entity.
+func (m *QuizQuestionMutation) ClearJapaneseMean() {
+	m.clearedjapanese_mean = true
+	m.clearedFields[quizquestion.FieldCorrectJpmID] = struct{}{}
+}
+
+// JapaneseMeanCleared reports if the "japanese_mean" edge to the JapaneseMean entity was cleared.
+func (m *QuizQuestionMutation) JapaneseMeanCleared() bool {
+	return m.clearedjapanese_mean
+}
+
+// JapaneseMeanID returns the "japanese_mean" edge ID in the mutation.
+func (m *QuizQuestionMutation) JapaneseMeanID() (id int, exists bool) {
+	if m.japanese_mean != nil {
+		return *m.japanese_mean, true
+	}
+	return
+}
+
+// JapaneseMeanIDs returns the "japanese_mean" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// JapaneseMeanID instead. It exists only for internal usage by the builders.
+func (m *QuizQuestionMutation) JapaneseMeanIDs() (ids []int) {
+	if id := m.japanese_mean; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetJapaneseMean resets all changes to the "japanese_mean" edge.
+func (m *QuizQuestionMutation) ResetJapaneseMean() {
+	m.japanese_mean = nil
+	m.clearedjapanese_mean = false
+}
+
+// Where appends a list predicates to the QuizQuestionMutation builder.
+func (m *QuizQuestionMutation) Where(ps ...predicate.QuizQuestion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the QuizQuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *QuizQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.QuizQuestion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *QuizQuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *QuizQuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (QuizQuestion).
+func (m *QuizQuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *QuizQuestionMutation) Fields() []string {
+	fields := make([]string, 0, 23)
+	if m.quiz != nil {
+		fields = append(fields, quizquestion.FieldQuizID)
+	}
+	if m.question_number != nil {
+		fields = append(fields, quizquestion.FieldQuestionNumber)
+	}
+	if m.word != nil {
+		fields = append(fields, quizquestion.FieldWordID)
+	}
+	if m.wordName != nil {
+		fields = append(fields, quizquestion.FieldWordName)
+	}
+	if m.pos_id != nil {
+		fields = append(fields, quizquestion.FieldPosID)
+	}
+	if m.japanese_mean != nil {
+		fields = append(fields, quizquestion.FieldCorrectJpmID)
+	}
+	if m.direction != nil {
+		fields = append(fields, quizquestion.FieldDirection)
+	}
+	if m.question_type != nil {
+		fields = append(fields, quizquestion.FieldQuestionType)
+	}
+	if m.meaning_name != nil {
+		fields = append(fields, quizquestion.FieldMeaningName)
+	}
+	if m.choices_jpms != nil {
+		fields = append(fields, quizquestion.FieldChoicesJpms)
+	}
+	if m.choices_words != nil {
+		fields = append(fields, quizquestion.FieldChoicesWords)
+	}
+	if m.answer_jpm_id != nil {
+		fields = append(fields, quizquestion.FieldAnswerJpmID)
+	}
+	if m.answer_word_id != nil {
+		fields = append(fields, quizquestion.FieldAnswerWordID)
+	}
+	if m.typed_answer != nil {
+		fields = append(fields, quizquestion.FieldTypedAnswer)
+	}
+	if m.grade != nil {
+		fields = append(fields, quizquestion.FieldGrade)
+	}
+	if m.credit != nil {
+		fields = append(fields, quizquestion.FieldCredit)
+	}
+	if m.is_correct != nil {
+		fields = append(fields, quizquestion.FieldIsCorrect)
+	}
+	if m.served_at != nil {
+		fields = append(fields, quizquestion.FieldServedAt)
+	}
+	if m.answered_at != nil {
+		fields = append(fields, quizquestion.FieldAnsweredAt)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, quizquestion.FieldIdempotencyKey)
+	}
+	if m.time_ms != nil {
+		fields = append(fields, quizquestion.FieldTimeMs)
+	}
+	if m.created_at != nil {
+		fields = append(fields, quizquestion.FieldCreatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, quizquestion.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *QuizQuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case quizquestion.FieldQuizID:
+		return m.QuizID()
+	case quizquestion.FieldQuestionNumber:
+		return m.QuestionNumber()
+	case quizquestion.FieldWordID:
+		return m.WordID()
+	case quizquestion.FieldWordName:
+		return m.WordName()
+	case quizquestion.FieldPosID:
+		return m.PosID()
+	case quizquestion.FieldCorrectJpmID:
+		return m.CorrectJpmID()
+	case quizquestion.FieldDirection:
+		return m.Direction()
+	case quizquestion.FieldQuestionType:
+		return m.QuestionType()
+	case quizquestion.FieldMeaningName:
+		return m.MeaningName()
+	case quizquestion.FieldChoicesJpms:
+		return m.ChoicesJpms()
+	case quizquestion.FieldChoicesWords:
+		return m.ChoicesWords()
+	case quizquestion.FieldAnswerJpmID:
+		return m.AnswerJpmID()
+	case quizquestion.FieldAnswerWordID:
+		return m.AnswerWordID()
+	case quizquestion.FieldTypedAnswer:
+		return m.TypedAnswer()
+	case quizquestion.FieldGrade:
+		return m.Grade()
+	case quizquestion.FieldCredit:
+		return m.Credit()
+	case quizquestion.FieldIsCorrect:
+		return m.IsCorrect()
+	case quizquestion.FieldServedAt:
+		return m.ServedAt()
+	case quizquestion.FieldAnsweredAt:
+		return m.AnsweredAt()
+	case quizquestion.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case quizquestion.FieldTimeMs:
+		return m.TimeMs()
+	case quizquestion.FieldCreatedAt:
+		return m.CreatedAt()
+	case quizquestion.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *QuizQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case quizquestion.FieldQuizID:
+		return m.OldQuizID(ctx)
+	case quizquestion.FieldQuestionNumber:
+		return m.OldQuestionNumber(ctx)
+	case quizquestion.FieldWordID:
+		return m.OldWordID(ctx)
+	case quizquestion.FieldWordName:
+		return m.OldWordName(ctx)
+	case quizquestion.FieldPosID:
+		return m.OldPosID(ctx)
+	case quizquestion.FieldCorrectJpmID:
+		return m.OldCorrectJpmID(ctx)
+	case quizquestion.FieldDirection:
+		return m.OldDirection(ctx)
+	case quizquestion.FieldQuestionType:
+		return m.OldQuestionType(ctx)
+	case quizquestion.FieldMeaningName:
+		return m.OldMeaningName(ctx)
+	case quizquestion.FieldChoicesJpms:
+		return m.OldChoicesJpms(ctx)
+	case quizquestion.FieldChoicesWords:
+		return m.OldChoicesWords(ctx)
+	case quizquestion.FieldAnswerJpmID:
+		return m.OldAnswerJpmID(ctx)
+	case quizquestion.FieldAnswerWordID:
+		return m.OldAnswerWordID(ctx)
+	case quizquestion.FieldTypedAnswer:
+		return m.OldTypedAnswer(ctx)
+	case quizquestion.FieldGrade:
+		return m.OldGrade(ctx)
+	case quizquestion.FieldCredit:
+		return m.OldCredit(ctx)
+	case quizquestion.FieldIsCorrect:
+		return m.OldIsCorrect(ctx)
+	case quizquestion.FieldServedAt:
+		return m.OldServedAt(ctx)
+	case quizquestion.FieldAnsweredAt:
+		return m.OldAnsweredAt(ctx)
+	case quizquestion.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case quizquestion.FieldTimeMs:
+		return m.OldTimeMs(ctx)
+	case quizquestion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case quizquestion.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown QuizQuestion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizQuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case quizquestion.FieldQuizID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuizID(v)
+		return nil
+	case quizquestion.FieldQuestionNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionNumber(v)
+		return nil
+	case quizquestion.FieldWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordID(v)
+		return nil
+	case quizquestion.FieldWordName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordName(v)
+		return nil
+	case quizquestion.FieldPosID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosID(v)
+		return nil
+	case quizquestion.FieldCorrectJpmID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrectJpmID(v)
+		return nil
+	case quizquestion.FieldDirection:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirection(v)
+		return nil
+	case quizquestion.FieldQuestionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionType(v)
+		return nil
+	case quizquestion.FieldMeaningName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMeaningName(v)
+		return nil
+	case quizquestion.FieldChoicesJpms:
+		v, ok := value.([]models.ChoiceJpm)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoicesJpms(v)
+		return nil
+	case quizquestion.FieldChoicesWords:
+		v, ok := value.([]models.ChoiceWord)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChoicesWords(v)
+		return nil
+	case quizquestion.FieldAnswerJpmID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerJpmID(v)
+		return nil
+	case quizquestion.FieldAnswerWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnswerWordID(v)
+		return nil
+	case quizquestion.FieldTypedAnswer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTypedAnswer(v)
+		return nil
+	case quizquestion.FieldGrade:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrade(v)
+		return nil
+	case quizquestion.FieldCredit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCredit(v)
+		return nil
+	case quizquestion.FieldIsCorrect:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsCorrect(v)
+		return nil
+	case quizquestion.FieldServedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetServedAt(v)
+		return nil
+	case quizquestion.FieldAnsweredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnsweredAt(v)
+		return nil
+	case quizquestion.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case quizquestion.FieldTimeMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeMs(v)
+		return nil
+	case quizquestion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case quizquestion.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuizQuestion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *QuizQuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addquestion_number != nil {
+		fields = append(fields, quizquestion.FieldQuestionNumber)
+	}
+	if m.addpos_id != nil {
+		fields = append(fields, quizquestion.FieldPosID)
+	}
+	if m.addanswer_jpm_id != nil {
+		fields = append(fields, quizquestion.FieldAnswerJpmID)
+	}
+	if m.addanswer_word_id != nil {
+		fields = append(fields, quizquestion.FieldAnswerWordID)
+	}
+	if m.addcredit != nil {
+		fields = append(fields, quizquestion.FieldCredit)
+	}
+	if m.addtime_ms != nil {
+		fields = append(fields, quizquestion.FieldTimeMs)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *QuizQuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case quizquestion.FieldQuestionNumber:
+		return m.AddedQuestionNumber()
+	case quizquestion.FieldPosID:
+		return m.AddedPosID()
+	case quizquestion.FieldAnswerJpmID:
+		return m.AddedAnswerJpmID()
+	case quizquestion.FieldAnswerWordID:
+		return m.AddedAnswerWordID()
+	case quizquestion.FieldCredit:
+		return m.AddedCredit()
+	case quizquestion.FieldTimeMs:
+		return m.AddedTimeMs()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *QuizQuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case quizquestion.FieldQuestionNumber:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuestionNumber(v)
+		return nil
+	case quizquestion.FieldPosID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosID(v)
+		return nil
+	case quizquestion.FieldAnswerJpmID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerJpmID(v)
+		return nil
+	case quizquestion.FieldAnswerWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAnswerWordID(v)
+		return nil
+	case quizquestion.FieldCredit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCredit(v)
+		return nil
+	case quizquestion.FieldTimeMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTimeMs(v)
+		return nil
+	}
+	return fmt.Errorf("unknown QuizQuestion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *QuizQuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(quizquestion.FieldMeaningName) {
+		fields = append(fields, quizquestion.FieldMeaningName)
+	}
+	if m.FieldCleared(quizquestion.FieldChoicesWords) {
+		fields = append(fields, quizquestion.FieldChoicesWords)
+	}
+	if m.FieldCleared(quizquestion.FieldAnswerJpmID) {
+		fields = append(fields, quizquestion.FieldAnswerJpmID)
+	}
+	if m.FieldCleared(quizquestion.FieldAnswerWordID) {
+		fields = append(fields, quizquestion.FieldAnswerWordID)
+	}
+	if m.FieldCleared(quizquestion.FieldTypedAnswer) {
+		fields = append(fields, quizquestion.FieldTypedAnswer)
+	}
+	if m.FieldCleared(quizquestion.FieldGrade) {
+		fields = append(fields, quizquestion.FieldGrade)
+	}
+	if m.FieldCleared(quizquestion.FieldIsCorrect) {
+		fields = append(fields, quizquestion.FieldIsCorrect)
+	}
+	if m.FieldCleared(quizquestion.FieldServedAt) {
+		fields = append(fields, quizquestion.FieldServedAt)
+	}
+	if m.FieldCleared(quizquestion.FieldAnsweredAt) {
+		fields = append(fields, quizquestion.FieldAnsweredAt)
+	}
+	if m.FieldCleared(quizquestion.FieldIdempotencyKey) {
+		fields = append(fields, quizquestion.FieldIdempotencyKey)
+	}
+	if m.FieldCleared(quizquestion.FieldTimeMs) {
+		fields = append(fields, quizquestion.FieldTimeMs)
+	}
+	if m.FieldCleared(quizquestion.FieldDeletedAt) {
+		fields = append(fields, quizquestion.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *QuizQuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *QuizQuestionMutation) ClearField(name string) error {
+	switch name {
+	case quizquestion.FieldMeaningName:
+		m.ClearMeaningName()
+		return nil
+	case quizquestion.FieldChoicesWords:
+		m.ClearChoicesWords()
+		return nil
+	case quizquestion.FieldAnswerJpmID:
+		m.ClearAnswerJpmID()
+		return nil
+	case quizquestion.FieldAnswerWordID:
+		m.ClearAnswerWordID()
+		return nil
+	case quizquestion.FieldTypedAnswer:
+		m.ClearTypedAnswer()
+		return nil
+	case quizquestion.FieldGrade:
+		m.ClearGrade()
+		return nil
+	case quizquestion.FieldIsCorrect:
+		m.ClearIsCorrect()
+		return nil
+	case quizquestion.FieldServedAt:
+		m.ClearServedAt()
+		return nil
+	case quizquestion.FieldAnsweredAt:
+		m.ClearAnsweredAt()
+		return nil
+	case quizquestion.FieldIdempotencyKey:
+		m.ClearIdempotencyKey()
+		return nil
+	case quizquestion.FieldTimeMs:
+		m.ClearTimeMs()
+		return nil
+	case quizquestion.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizQuestion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *QuizQuestionMutation) ResetField(name string) error {
+	switch name {
+	case quizquestion.FieldQuizID:
+		m.ResetQuizID()
+		return nil
+	case quizquestion.FieldQuestionNumber:
+		m.ResetQuestionNumber()
+		return nil
+	case quizquestion.FieldWordID:
+		m.ResetWordID()
+		return nil
+	case quizquestion.FieldWordName:
+		m.ResetWordName()
+		return nil
+	case quizquestion.FieldPosID:
+		m.ResetPosID()
+		return nil
+	case quizquestion.FieldCorrectJpmID:
+		m.ResetCorrectJpmID()
+		return nil
+	case quizquestion.FieldDirection:
+		m.ResetDirection()
+		return nil
+	case quizquestion.FieldQuestionType:
+		m.ResetQuestionType()
+		return nil
+	case quizquestion.FieldMeaningName:
+		m.ResetMeaningName()
+		return nil
+	case quizquestion.FieldChoicesJpms:
+		m.ResetChoicesJpms()
+		return nil
+	case quizquestion.FieldChoicesWords:
+		m.ResetChoicesWords()
+		return nil
+	case quizquestion.FieldAnswerJpmID:
+		m.ResetAnswerJpmID()
+		return nil
+	case quizquestion.FieldAnswerWordID:
+		m.ResetAnswerWordID()
+		return nil
+	case quizquestion.FieldTypedAnswer:
+		m.ResetTypedAnswer()
+		return nil
+	case quizquestion.FieldGrade:
+		m.ResetGrade()
+		return nil
+	case quizquestion.FieldCredit:
+		m.ResetCredit()
+		return nil
+	case quizquestion.FieldIsCorrect:
+		m.ResetIsCorrect()
+		return nil
+	case quizquestion.FieldServedAt:
+		m.ResetServedAt()
+		return nil
+	case quizquestion.FieldAnsweredAt:
+		m.ResetAnsweredAt()
+		return nil
+	case quizquestion.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case quizquestion.FieldTimeMs:
+		m.ResetTimeMs()
+		return nil
+	case quizquestion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case quizquestion.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizQuestion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *QuizQuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.quiz != nil {
+		edges = append(edges, quizquestion.EdgeQuiz)
+	}
+	if m.word != nil {
+		edges = append(edges, quizquestion.EdgeWord)
+	}
+	if m.japanese_mean != nil {
+		edges = append(edges, quizquestion.EdgeJapaneseMean)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *QuizQuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case quizquestion.EdgeQuiz:
+		if id := m.quiz; id != nil {
+			return []ent.Value{*id}
+		}
+	case quizquestion.EdgeWord:
+		if id := m.word; id != nil {
+			return []ent.Value{*id}
+		}
+	case quizquestion.EdgeJapaneseMean:
+		if id := m.japanese_mean; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *QuizQuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *QuizQuestionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *QuizQuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedquiz {
+		edges = append(edges, quizquestion.EdgeQuiz)
+	}
+	if m.clearedword {
+		edges = append(edges, quizquestion.EdgeWord)
+	}
+	if m.clearedjapanese_mean {
+		edges = append(edges, quizquestion.EdgeJapaneseMean)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *QuizQuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case quizquestion.EdgeQuiz:
+		return m.clearedquiz
+	case quizquestion.EdgeWord:
+		return m.clearedword
+	case quizquestion.EdgeJapaneseMean:
+		return m.clearedjapanese_mean
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *QuizQuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case quizquestion.EdgeQuiz:
+		m.ClearQuiz()
+		return nil
+	case quizquestion.EdgeWord:
+		m.ClearWord()
+		return nil
+	case quizquestion.EdgeJapaneseMean:
+		m.ClearJapaneseMean()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizQuestion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *QuizQuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case quizquestion.EdgeQuiz:
+		m.ResetQuiz()
+		return nil
+	case quizquestion.EdgeWord:
+		m.ResetWord()
+		return nil
+	case quizquestion.EdgeJapaneseMean:
+		m.ResetJapaneseMean()
+		return nil
+	}
+	return fmt.Errorf("unknown QuizQuestion edge %s", name)
+}
+
+// RefreshTokenMutation represents an operation that mutates the RefreshToken nodes in the graph.
+type RefreshTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	family_id     *string
+	token_hash    *string
+	expires_at    *time.Time
+	created_at    *time.Time
+	used_at       *time.Time
+	revoked_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*RefreshToken, error)
+	predicates    []predicate.RefreshToken
+}
+
+var _ ent.Mutation = (*RefreshTokenMutation)(nil)
+
+// refreshtokenOption allows management of the mutation configuration using functional options.
+type refreshtokenOption func(*RefreshTokenMutation)
+
+// newRefreshTokenMutation creates new mutation for the RefreshToken entity.
+func newRefreshTokenMutation(c config, op Op, opts ...refreshtokenOption) *RefreshTokenMutation {
+	m := &RefreshTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRefreshToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRefreshTokenID sets the ID field of the mutation.
+func withRefreshTokenID(id int) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RefreshToken
+		)
+		m.oldValue = func(ctx context.Context) (*RefreshToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RefreshToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRefreshToken sets the old RefreshToken of the mutation.
+func withRefreshToken(node *RefreshToken) refreshtokenOption {
+	return func(m *RefreshTokenMutation) {
+		m.oldValue = func(context.Context) (*RefreshToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RefreshTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RefreshTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RefreshTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RefreshTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RefreshToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RefreshTokenMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RefreshTokenMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RefreshTokenMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetFamilyID sets the "family_id" field.
+func (m *RefreshTokenMutation) SetFamilyID(s string) {
+	m.family_id = &s
+}
+
+// FamilyID returns the value of the "family_id" field in the mutation.
+func (m *RefreshTokenMutation) FamilyID() (r string, exists bool) {
+	v := m.family_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFamilyID returns the old "family_id" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldFamilyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFamilyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFamilyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFamilyID: %w", err)
+	}
+	return oldValue.FamilyID, nil
+}
+
+// ResetFamilyID resets all changes to the "family_id" field.
+func (m *RefreshTokenMutation) ResetFamilyID() {
+	m.family_id = nil
+}
+
+// SetTokenHash sets the "token_hash" field.
+func (m *RefreshTokenMutation) SetTokenHash(s string) {
+	m.token_hash = &s
+}
+
+// TokenHash returns the value of the "token_hash" field in the mutation.
+func (m *RefreshTokenMutation) TokenHash() (r string, exists bool) {
+	v := m.token_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenHash returns the old "token_hash" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldTokenHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenHash: %w", err)
+	}
+	return oldValue.TokenHash, nil
+}
+
+// ResetTokenHash resets all changes to the "token_hash" field.
+func (m *RefreshTokenMutation) ResetTokenHash() {
+	m.token_hash = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *RefreshTokenMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *RefreshTokenMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *RefreshTokenMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RefreshTokenMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RefreshTokenMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RefreshTokenMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUsedAt sets the "used_at" field.
+func (m *RefreshTokenMutation) SetUsedAt(t time.Time) {
+	m.used_at = &t
+}
+
+// UsedAt returns the value of the "used_at" field in the mutation.
+func (m *RefreshTokenMutation) UsedAt() (r time.Time, exists bool) {
+	v := m.used_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUsedAt returns the old "used_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldUsedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUsedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUsedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUsedAt: %w", err)
+	}
+	return oldValue.UsedAt, nil
+}
+
+// ClearUsedAt clears the value of the "used_at" field.
+func (m *RefreshTokenMutation) ClearUsedAt() {
+	m.used_at = nil
+	m.clearedFields[refreshtoken.FieldUsedAt] = struct{}{}
+}
+
+// UsedAtCleared returns if the "used_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) UsedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldUsedAt]
+	return ok
+}
+
+// ResetUsedAt resets all changes to the "used_at" field.
+func (m *RefreshTokenMutation) ResetUsedAt() {
+	m.used_at = nil
+	delete(m.clearedFields, refreshtoken.FieldUsedAt)
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (m *RefreshTokenMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
+}
+
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *RefreshTokenMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevokedAt returns the old "revoked_at" field's value of the RefreshToken entity.
+// If the RefreshToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RefreshTokenMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
+	}
+	return oldValue.RevokedAt, nil
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *RefreshTokenMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[refreshtoken.FieldRevokedAt] = struct{}{}
+}
+
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *RefreshTokenMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[refreshtoken.FieldRevokedAt]
+	return ok
+}
+
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *RefreshTokenMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, refreshtoken.FieldRevokedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RefreshTokenMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[refreshtoken.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RefreshTokenMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RefreshTokenMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RefreshTokenMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the RefreshTokenMutation builder.
+func (m *RefreshTokenMutation) Where(ps ...predicate.RefreshToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RefreshTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RefreshTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RefreshToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RefreshTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RefreshTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RefreshToken).
+func (m *RefreshTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RefreshTokenMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.user != nil {
+		fields = append(fields, refreshtoken.FieldUserID)
+	}
+	if m.family_id != nil {
+		fields = append(fields, refreshtoken.FieldFamilyID)
+	}
+	if m.token_hash != nil {
+		fields = append(fields, refreshtoken.FieldTokenHash)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, refreshtoken.FieldExpiresAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, refreshtoken.FieldCreatedAt)
+	}
+	if m.used_at != nil {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RefreshTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case refreshtoken.FieldUserID:
+		return m.UserID()
+	case refreshtoken.FieldFamilyID:
+		return m.FamilyID()
+	case refreshtoken.FieldTokenHash:
+		return m.TokenHash()
+	case refreshtoken.FieldExpiresAt:
+		return m.ExpiresAt()
+	case refreshtoken.FieldCreatedAt:
+		return m.CreatedAt()
+	case refreshtoken.FieldUsedAt:
+		return m.UsedAt()
+	case refreshtoken.FieldRevokedAt:
+		return m.RevokedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RefreshTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case refreshtoken.FieldUserID:
+		return m.OldUserID(ctx)
+	case refreshtoken.FieldFamilyID:
+		return m.OldFamilyID(ctx)
+	case refreshtoken.FieldTokenHash:
+		return m.OldTokenHash(ctx)
+	case refreshtoken.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case refreshtoken.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case refreshtoken.FieldUsedAt:
+		return m.OldUsedAt(ctx)
+	case refreshtoken.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case refreshtoken.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case refreshtoken.FieldFamilyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFamilyID(v)
+		return nil
+	case refreshtoken.FieldTokenHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenHash(v)
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case refreshtoken.FieldUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUsedAt(v)
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RefreshTokenMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RefreshTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RefreshTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RefreshToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RefreshTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(refreshtoken.FieldUsedAt) {
+		fields = append(fields, refreshtoken.FieldUsedAt)
+	}
+	if m.FieldCleared(refreshtoken.FieldRevokedAt) {
+		fields = append(fields, refreshtoken.FieldRevokedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RefreshTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ClearField(name string) error {
+	switch name {
+	case refreshtoken.FieldUsedAt:
+		m.ClearUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RefreshTokenMutation) ResetField(name string) error {
+	switch name {
+	case refreshtoken.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case refreshtoken.FieldFamilyID:
+		m.ResetFamilyID()
+		return nil
+	case refreshtoken.FieldTokenHash:
+		m.ResetTokenHash()
+		return nil
+	case refreshtoken.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case refreshtoken.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case refreshtoken.FieldUsedAt:
+		m.ResetUsedAt()
+		return nil
+	case refreshtoken.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RefreshTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RefreshTokenMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case refreshtoken.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RefreshTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RefreshTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RefreshTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, refreshtoken.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RefreshTokenMutation) EdgeCleared(name string) bool {
+	switch name {
+	case refreshtoken.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RefreshTokenMutation) ClearEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RefreshTokenMutation) ResetEdge(name string) error {
+	switch name {
+	case refreshtoken.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown RefreshToken edge %s", name)
+}
+
+// RegisteredWordMutation represents an operation that mutates the RegisteredWord nodes in the graph.
+type RegisteredWordMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *int
+	is_active             *bool
+	attention_level       *int
+	addattention_level    *int
+	quiz_count            *int
+	addquiz_count         *int
+	correct_count         *int
+	addcorrect_count      *int
+	correct_rate          *int
+	addcorrect_rate       *int
+	ease_factor           *float64
+	addease_factor        *float64
+	interval_days         *int
+	addinterval_days      *int
+	repetitions           *int
+	addrepetitions        *int
+	lapses                *int
+	addlapses             *int
+	stability             *float64
+	addstability          *float64
+	difficulty            *float64
+	adddifficulty         *float64
+	due_at                *time.Time
+	last_reviewed_at      *time.Time
+	memo                  *string
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	user                  *int
+	cleareduser           bool
+	word                  *int
+	clearedword           bool
+	quiz_questions        map[int]struct{}
+	removedquiz_questions map[int]struct{}
+	clearedquiz_questions bool
+	done                  bool
+	oldValue              func(context.Context) (*RegisteredWord, error)
+	predicates            []predicate.RegisteredWord
+}
+
+var _ ent.Mutation = (*RegisteredWordMutation)(nil)
+
+// registeredwordOption allows management of the mutation configuration using functional options.
+type registeredwordOption func(*RegisteredWordMutation)
+
+// newRegisteredWordMutation creates new mutation for the RegisteredWord entity.
+func newRegisteredWordMutation(c config, op Op, opts ...registeredwordOption) *RegisteredWordMutation {
+	m := &RegisteredWordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegisteredWord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegisteredWordID sets the ID field of the mutation.
+func withRegisteredWordID(id int) registeredwordOption {
+	return func(m *RegisteredWordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RegisteredWord
+		)
+		m.oldValue = func(ctx context.Context) (*RegisteredWord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RegisteredWord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegisteredWord sets the old RegisteredWord of the mutation.
+func withRegisteredWord(node *RegisteredWord) registeredwordOption {
+	return func(m *RegisteredWordMutation) {
+		m.oldValue = func(context.Context) (*RegisteredWord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegisteredWordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegisteredWordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegisteredWordMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegisteredWordMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RegisteredWord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RegisteredWordMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RegisteredWordMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RegisteredWordMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetWordID sets the "word_id" field.
+func (m *RegisteredWordMutation) SetWordID(i int) {
+	m.word = &i
+}
+
+// WordID returns the value of the "word_id" field in the mutation.
+func (m *RegisteredWordMutation) WordID() (r int, exists bool) {
+	v := m.word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordID returns the old "word_id" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldWordID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
+	}
+	return oldValue.WordID, nil
+}
+
+// ResetWordID resets all changes to the "word_id" field.
+func (m *RegisteredWordMutation) ResetWordID() {
+	m.word = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *RegisteredWordMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *RegisteredWordMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *RegisteredWordMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetAttentionLevel sets the "attention_level" field.
+func (m *RegisteredWordMutation) SetAttentionLevel(i int) {
+	m.attention_level = &i
+	m.addattention_level = nil
+}
+
+// AttentionLevel returns the value of the "attention_level" field in the mutation.
+func (m *RegisteredWordMutation) AttentionLevel() (r int, exists bool) {
+	v := m.attention_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttentionLevel returns the old "attention_level" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldAttentionLevel(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttentionLevel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttentionLevel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttentionLevel: %w", err)
+	}
+	return oldValue.AttentionLevel, nil
+}
+
+// AddAttentionLevel adds i to the "attention_level" field.
+func (m *RegisteredWordMutation) AddAttentionLevel(i int) {
+	if m.addattention_level != nil {
+		*m.addattention_level += i
+	} else {
+		m.addattention_level = &i
+	}
+}
+
+// AddedAttentionLevel returns the value that was added to the "attention_level" field in this mutation.
+func (m *RegisteredWordMutation) AddedAttentionLevel() (r int, exists bool) {
+	v := m.addattention_level
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttentionLevel resets all changes to the "attention_level" field.
+func (m *RegisteredWordMutation) ResetAttentionLevel() {
+	m.attention_level = nil
+	m.addattention_level = nil
+}
+
+// SetQuizCount sets the "quiz_count" field.
+func (m *RegisteredWordMutation) SetQuizCount(i int) {
+	m.quiz_count = &i
+	m.addquiz_count = nil
+}
+
+// QuizCount returns the value of the "quiz_count" field in the mutation.
+func (m *RegisteredWordMutation) QuizCount() (r int, exists bool) {
+	v := m.quiz_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuizCount returns the old "quiz_count" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldQuizCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuizCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuizCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuizCount: %w", err)
+	}
+	return oldValue.QuizCount, nil
+}
+
+// AddQuizCount adds i to the "quiz_count" field.
+func (m *RegisteredWordMutation) AddQuizCount(i int) {
+	if m.addquiz_count != nil {
+		*m.addquiz_count += i
+	} else {
+		m.addquiz_count = &i
+	}
+}
+
+// AddedQuizCount returns the value that was added to the "quiz_count" field in this mutation.
+func (m *RegisteredWordMutation) AddedQuizCount() (r int, exists bool) {
+	v := m.addquiz_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetQuizCount resets all changes to the "quiz_count" field.
+func (m *RegisteredWordMutation) ResetQuizCount() {
+	m.quiz_count = nil
+	m.addquiz_count = nil
+}
+
+// SetCorrectCount sets the "correct_count" field.
+func (m *RegisteredWordMutation) SetCorrectCount(i int) {
+	m.correct_count = &i
+	m.addcorrect_count = nil
+}
+
+// CorrectCount returns the value of the "correct_count" field in the mutation.
+func (m *RegisteredWordMutation) CorrectCount() (r int, exists bool) {
+	v := m.correct_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrectCount returns the old "correct_count" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldCorrectCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrectCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrectCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrectCount: %w", err)
+	}
+	return oldValue.CorrectCount, nil
+}
+
+// AddCorrectCount adds i to the "correct_count" field.
+func (m *RegisteredWordMutation) AddCorrectCount(i int) {
+	if m.addcorrect_count != nil {
+		*m.addcorrect_count += i
+	} else {
+		m.addcorrect_count = &i
+	}
+}
+
+// AddedCorrectCount returns the value that was added to the "correct_count" field in this mutation.
+func (m *RegisteredWordMutation) AddedCorrectCount() (r int, exists bool) {
+	v := m.addcorrect_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCorrectCount resets all changes to the "correct_count" field.
+func (m *RegisteredWordMutation) ResetCorrectCount() {
+	m.correct_count = nil
+	m.addcorrect_count = nil
+}
+
+// SetCorrectRate sets the "correct_rate" field.
+func (m *RegisteredWordMutation) SetCorrectRate(i int) {
+	m.correct_rate = &i
+	m.addcorrect_rate = nil
+}
+
+// CorrectRate returns the value of the "correct_rate" field in the mutation.
+func (m *RegisteredWordMutation) CorrectRate() (r int, exists bool) {
+	v := m.correct_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCorrectRate returns the old "correct_rate" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldCorrectRate(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCorrectRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCorrectRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCorrectRate: %w", err)
+	}
+	return oldValue.CorrectRate, nil
+}
+
+// AddCorrectRate adds i to the "correct_rate" field.
+func (m *RegisteredWordMutation) AddCorrectRate(i int) {
+	if m.addcorrect_rate != nil {
+		*m.addcorrect_rate += i
+	} else {
+		m.addcorrect_rate = &i
+	}
+}
+
+// AddedCorrectRate returns the value that was added to the "correct_rate" field in this mutation.
+func (m *RegisteredWordMutation) AddedCorrectRate() (r int, exists bool) {
+	v := m.addcorrect_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCorrectRate resets all changes to the "correct_rate" field.
+func (m *RegisteredWordMutation) ResetCorrectRate() {
+	m.correct_rate = nil
+	m.addcorrect_rate = nil
+}
+
+// SetEaseFactor sets the "ease_factor" field.
+func (m *RegisteredWordMutation) SetEaseFactor(f float64) {
+	m.ease_factor = &f
+	m.addease_factor = nil
+}
+
+// EaseFactor returns the value of the "ease_factor" field in the mutation.
+func (m *RegisteredWordMutation) EaseFactor() (r float64, exists bool) {
+	v := m.ease_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEaseFactor returns the old "ease_factor" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldEaseFactor(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEaseFactor is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEaseFactor requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEaseFactor: %w", err)
+	}
+	return oldValue.EaseFactor, nil
+}
+
+// AddEaseFactor adds f to the "ease_factor" field.
+func (m *RegisteredWordMutation) AddEaseFactor(f float64) {
+	if m.addease_factor != nil {
+		*m.addease_factor += f
+	} else {
+		m.addease_factor = &f
+	}
+}
+
+// AddedEaseFactor returns the value that was added to the "ease_factor" field in this mutation.
+func (m *RegisteredWordMutation) AddedEaseFactor() (r float64, exists bool) {
+	v := m.addease_factor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEaseFactor resets all changes to the "ease_factor" field.
+func (m *RegisteredWordMutation) ResetEaseFactor() {
+	m.ease_factor = nil
+	m.addease_factor = nil
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (m *RegisteredWordMutation) SetIntervalDays(i int) {
+	m.interval_days = &i
+	m.addinterval_days = nil
+}
+
+// IntervalDays returns the value of the "interval_days" field in the mutation.
+func (m *RegisteredWordMutation) IntervalDays() (r int, exists bool) {
+	v := m.interval_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIntervalDays returns the old "interval_days" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldIntervalDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIntervalDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIntervalDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIntervalDays: %w", err)
+	}
+	return oldValue.IntervalDays, nil
+}
+
+// AddIntervalDays adds i to the "interval_days" field.
+func (m *RegisteredWordMutation) AddIntervalDays(i int) {
+	if m.addinterval_days != nil {
+		*m.addinterval_days += i
+	} else {
+		m.addinterval_days = &i
+	}
+}
+
+// AddedIntervalDays returns the value that was added to the "interval_days" field in this mutation.
+func (m *RegisteredWordMutation) AddedIntervalDays() (r int, exists bool) {
+	v := m.addinterval_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIntervalDays resets all changes to the "interval_days" field.
+func (m *RegisteredWordMutation) ResetIntervalDays() {
+	m.interval_days = nil
+	m.addinterval_days = nil
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (m *RegisteredWordMutation) SetRepetitions(i int) {
+	m.repetitions = &i
+	m.addrepetitions = nil
+}
+
+// Repetitions returns the value of the "repetitions" field in the mutation.
+func (m *RegisteredWordMutation) Repetitions() (r int, exists bool) {
+	v := m.repetitions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRepetitions returns the old "repetitions" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldRepetitions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRepetitions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRepetitions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRepetitions: %w", err)
+	}
+	return oldValue.Repetitions, nil
+}
+
+// AddRepetitions adds i to the "repetitions" field.
+func (m *RegisteredWordMutation) AddRepetitions(i int) {
+	if m.addrepetitions != nil {
+		*m.addrepetitions += i
+	} else {
+		m.addrepetitions = &i
+	}
+}
+
+// AddedRepetitions returns the value that was added to the "repetitions" field in this mutation.
+func (m *RegisteredWordMutation) AddedRepetitions() (r int, exists bool) {
+	v := m.addrepetitions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRepetitions resets all changes to the "repetitions" field.
+func (m *RegisteredWordMutation) ResetRepetitions() {
+	m.repetitions = nil
+	m.addrepetitions = nil
+}
+
+// SetLapses sets the "lapses" field.
+func (m *RegisteredWordMutation) SetLapses(i int) {
+	m.lapses = &i
+	m.addlapses = nil
+}
+
+// Lapses returns the value of the "lapses" field in the mutation.
+func (m *RegisteredWordMutation) Lapses() (r int, exists bool) {
+	v := m.lapses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLapses returns the old "lapses" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldLapses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLapses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLapses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLapses: %w", err)
+	}
+	return oldValue.Lapses, nil
+}
+
+// AddLapses adds i to the "lapses" field.
+func (m *RegisteredWordMutation) AddLapses(i int) {
+	if m.addlapses != nil {
+		*m.addlapses += i
+	} else {
+		m.addlapses = &i
+	}
+}
+
+// AddedLapses returns the value that was added to the "lapses" field in this mutation.
+func (m *RegisteredWordMutation) AddedLapses() (r int, exists bool) {
+	v := m.addlapses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLapses resets all changes to the "lapses" field.
+func (m *RegisteredWordMutation) ResetLapses() {
+	m.lapses = nil
+	m.addlapses = nil
+}
+
+// SetStability sets the "stability" field.
+func (m *RegisteredWordMutation) SetStability(f float64) {
+	m.stability = &f
+	m.addstability = nil
+}
+
+// Stability returns the value of the "stability" field in the mutation.
+func (m *RegisteredWordMutation) Stability() (r float64, exists bool) {
+	v := m.stability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStability returns the old "stability" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldStability(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStability is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStability requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStability: %w", err)
+	}
+	return oldValue.Stability, nil
+}
+
+// AddStability adds f to the "stability" field.
+func (m *RegisteredWordMutation) AddStability(f float64) {
+	if m.addstability != nil {
+		*m.addstability += f
+	} else {
+		m.addstability = &f
+	}
+}
+
+// AddedStability returns the value that was added to the "stability" field in this mutation.
+func (m *RegisteredWordMutation) AddedStability() (r float64, exists bool) {
+	v := m.addstability
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStability resets all changes to the "stability" field.
+func (m *RegisteredWordMutation) ResetStability() {
+	m.stability = nil
+	m.addstability = nil
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (m *RegisteredWordMutation) SetDifficulty(f float64) {
+	m.difficulty = &f
+	m.adddifficulty = nil
+}
+
+// Difficulty returns the value of the "difficulty" field in the mutation.
+func (m *RegisteredWordMutation) Difficulty() (r float64, exists bool) {
+	v := m.difficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDifficulty returns the old "difficulty" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldDifficulty(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDifficulty is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDifficulty requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDifficulty: %w", err)
+	}
+	return oldValue.Difficulty, nil
+}
+
+// AddDifficulty adds f to the "difficulty" field.
+func (m *RegisteredWordMutation) AddDifficulty(f float64) {
+	if m.adddifficulty != nil {
+		*m.adddifficulty += f
+	} else {
+		m.adddifficulty = &f
+	}
+}
+
+// AddedDifficulty returns the value that was added to the "difficulty" field in this mutation.
+func (m *RegisteredWordMutation) AddedDifficulty() (r float64, exists bool) {
+	v := m.adddifficulty
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDifficulty resets all changes to the "difficulty" field.
+func (m *RegisteredWordMutation) ResetDifficulty() {
+	m.difficulty = nil
+	m.adddifficulty = nil
+}
+
+// SetDueAt sets the "due_at" field.
+func (m *RegisteredWordMutation) SetDueAt(t time.Time) {
+	m.due_at = &t
+}
+
+// DueAt returns the value of the "due_at" field in the mutation.
+func (m *RegisteredWordMutation) DueAt() (r time.Time, exists bool) {
+	v := m.due_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDueAt returns the old "due_at" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldDueAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDueAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDueAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDueAt: %w", err)
+	}
+	return oldValue.DueAt, nil
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (m *RegisteredWordMutation) ClearDueAt() {
+	m.due_at = nil
+	m.clearedFields[registeredword.FieldDueAt] = struct{}{}
+}
+
+// DueAtCleared returns if the "due_at" field was cleared in this mutation.
+func (m *RegisteredWordMutation) DueAtCleared() bool {
+	_, ok := m.clearedFields[registeredword.FieldDueAt]
+	return ok
+}
+
+// ResetDueAt resets all changes to the "due_at" field.
+func (m *RegisteredWordMutation) ResetDueAt() {
+	m.due_at = nil
+	delete(m.clearedFields, registeredword.FieldDueAt)
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (m *RegisteredWordMutation) SetLastReviewedAt(t time.Time) {
+	m.last_reviewed_at = &t
+}
+
+// LastReviewedAt returns the value of the "last_reviewed_at" field in the mutation.
+func (m *RegisteredWordMutation) LastReviewedAt() (r time.Time, exists bool) {
+	v := m.last_reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastReviewedAt returns the old "last_reviewed_at" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldLastReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastReviewedAt: %w", err)
+	}
+	return oldValue.LastReviewedAt, nil
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (m *RegisteredWordMutation) ClearLastReviewedAt() {
+	m.last_reviewed_at = nil
+	m.clearedFields[registeredword.FieldLastReviewedAt] = struct{}{}
+}
+
+// LastReviewedAtCleared returns if the "last_reviewed_at" field was cleared in this mutation.
+func (m *RegisteredWordMutation) LastReviewedAtCleared() bool {
+	_, ok := m.clearedFields[registeredword.FieldLastReviewedAt]
+	return ok
+}
+
+// ResetLastReviewedAt resets all changes to the "last_reviewed_at" field.
+func (m *RegisteredWordMutation) ResetLastReviewedAt() {
+	m.last_reviewed_at = nil
+	delete(m.clearedFields, registeredword.FieldLastReviewedAt)
+}
+
+// SetMemo sets the "memo" field.
+func (m *RegisteredWordMutation) SetMemo(s string) {
+	m.memo = &s
+}
+
+// Memo returns the value of the "memo" field in the mutation.
+func (m *RegisteredWordMutation) Memo() (r string, exists bool) {
+	v := m.memo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMemo returns the old "memo" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldMemo(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMemo: %w", err)
+	}
+	return oldValue.Memo, nil
+}
+
+// ClearMemo clears the value of the "memo" field.
+func (m *RegisteredWordMutation) ClearMemo() {
+	m.memo = nil
+	m.clearedFields[registeredword.FieldMemo] = struct{}{}
+}
+
+// MemoCleared returns if the "memo" field was cleared in this mutation.
+func (m *RegisteredWordMutation) MemoCleared() bool {
+	_, ok := m.clearedFields[registeredword.FieldMemo]
+	return ok
+}
+
+// ResetMemo resets all changes to the "memo" field.
+func (m *RegisteredWordMutation) ResetMemo() {
+	m.memo = nil
+	delete(m.clearedFields, registeredword.FieldMemo)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegisteredWordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegisteredWordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegisteredWordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RegisteredWordMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RegisteredWordMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RegisteredWord entity.
+// If the RegisteredWord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegisteredWordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RegisteredWordMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *RegisteredWordMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[registeredword.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *RegisteredWordMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *RegisteredWordMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *RegisteredWordMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearWord clears the "word" edge to the Word entity.
+func (m *RegisteredWordMutation) ClearWord() {
+	m.clearedword = true
+	m.clearedFields[registeredword.FieldWordID] = struct{}{}
+}
+
+// WordCleared reports if the "word" edge to the Word entity was cleared.
+func (m *RegisteredWordMutation) WordCleared() bool {
+	return m.clearedword
+}
+
+// WordIDs returns the "word" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WordID instead. It exists only for internal usage by the builders.
+func (m *RegisteredWordMutation) WordIDs() (ids []int) {
+	if id := m.word; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetWord resets all changes to the "word" edge.
+func (m *RegisteredWordMutation) ResetWord() {
+	m.word = nil
+	m.clearedword = false
+}
+
+// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
+func (m *RegisteredWordMutation) AddQuizQuestionIDs(ids ...int) {
+	if m.quiz_questions == nil {
+		m.quiz_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.quiz_questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
+func (m *RegisteredWordMutation) ClearQuizQuestions() {
+	m.clearedquiz_questions = true
+}
+
+// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
+func (m *RegisteredWordMutation) QuizQuestionsCleared() bool {
+	return m.clearedquiz_questions
+}
+
+// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
+func (m *RegisteredWordMutation) RemoveQuizQuestionIDs(ids ...int) {
+	if m.removedquiz_questions == nil {
+		m.removedquiz_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.quiz_questions, ids[i])
+		m.removedquiz_questions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
+func (m *RegisteredWordMutation) RemovedQuizQuestionsIDs() (ids []int) {
+	for id := range m.removedquiz_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
+func (m *RegisteredWordMutation) QuizQuestionsIDs() (ids []int) {
+	for id := range m.quiz_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
+func (m *RegisteredWordMutation) ResetQuizQuestions() {
+	m.quiz_questions = nil
+	m.clearedquiz_questions = false
+	m.removedquiz_questions = nil
+}
+
+// Where appends a list predicates to the RegisteredWordMutation builder.
+func (m *RegisteredWordMutation) Where(ps ...predicate.RegisteredWord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegisteredWordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegisteredWordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RegisteredWord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegisteredWordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegisteredWordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RegisteredWord).
+func (m *RegisteredWordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegisteredWordMutation) Fields() []string {
+	fields := make([]string, 0, 18)
+	if m.user != nil {
+		fields = append(fields, registeredword.FieldUserID)
+	}
+	if m.word != nil {
+		fields = append(fields, registeredword.FieldWordID)
+	}
+	if m.is_active != nil {
+		fields = append(fields, registeredword.FieldIsActive)
+	}
+	if m.attention_level != nil {
+		fields = append(fields, registeredword.FieldAttentionLevel)
+	}
+	if m.quiz_count != nil {
+		fields = append(fields, registeredword.FieldQuizCount)
+	}
+	if m.correct_count != nil {
+		fields = append(fields, registeredword.FieldCorrectCount)
+	}
+	if m.correct_rate != nil {
+		fields = append(fields, registeredword.FieldCorrectRate)
+	}
+	if m.ease_factor != nil {
+		fields = append(fields, registeredword.FieldEaseFactor)
+	}
+	if m.interval_days != nil {
+		fields = append(fields, registeredword.FieldIntervalDays)
+	}
+	if m.repetitions != nil {
+		fields = append(fields, registeredword.FieldRepetitions)
+	}
+	if m.lapses != nil {
+		fields = append(fields, registeredword.FieldLapses)
+	}
+	if m.stability != nil {
+		fields = append(fields, registeredword.FieldStability)
+	}
+	if m.difficulty != nil {
+		fields = append(fields, registeredword.FieldDifficulty)
+	}
+	if m.due_at != nil {
+		fields = append(fields, registeredword.FieldDueAt)
+	}
+	if m.last_reviewed_at != nil {
+		fields = append(fields, registeredword.FieldLastReviewedAt)
+	}
+	if m.memo != nil {
+		fields = append(fields, registeredword.FieldMemo)
+	}
+	if m.created_at != nil {
+		fields = append(fields, registeredword.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, registeredword.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegisteredWordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case registeredword.FieldUserID:
+		return m.UserID()
+	case registeredword.FieldWordID:
+		return m.WordID()
+	case registeredword.FieldIsActive:
+		return m.IsActive()
+	case registeredword.FieldAttentionLevel:
+		return m.AttentionLevel()
+	case registeredword.FieldQuizCount:
+		return m.QuizCount()
+	case registeredword.FieldCorrectCount:
+		return m.CorrectCount()
+	case registeredword.FieldCorrectRate:
+		return m.CorrectRate()
+	case registeredword.FieldEaseFactor:
+		return m.EaseFactor()
+	case registeredword.FieldIntervalDays:
+		return m.IntervalDays()
+	case registeredword.FieldRepetitions:
+		return m.Repetitions()
+	case registeredword.FieldLapses:
+		return m.Lapses()
+	case registeredword.FieldStability:
+		return m.Stability()
+	case registeredword.FieldDifficulty:
+		return m.Difficulty()
+	case registeredword.FieldDueAt:
+		return m.DueAt()
+	case registeredword.FieldLastReviewedAt:
+		return m.LastReviewedAt()
+	case registeredword.FieldMemo:
+		return m.Memo()
+	case registeredword.FieldCreatedAt:
+		return m.CreatedAt()
+	case registeredword.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegisteredWordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case registeredword.FieldUserID:
+		return m.OldUserID(ctx)
+	case registeredword.FieldWordID:
+		return m.OldWordID(ctx)
+	case registeredword.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case registeredword.FieldAttentionLevel:
+		return m.OldAttentionLevel(ctx)
+	case registeredword.FieldQuizCount:
+		return m.OldQuizCount(ctx)
+	case registeredword.FieldCorrectCount:
+		return m.OldCorrectCount(ctx)
+	case registeredword.FieldCorrectRate:
+		return m.OldCorrectRate(ctx)
+	case registeredword.FieldEaseFactor:
+		return m.OldEaseFactor(ctx)
+	case registeredword.FieldIntervalDays:
+		return m.OldIntervalDays(ctx)
+	case registeredword.FieldRepetitions:
+		return m.OldRepetitions(ctx)
+	case registeredword.FieldLapses:
+		return m.OldLapses(ctx)
+	case registeredword.FieldStability:
+		return m.OldStability(ctx)
+	case registeredword.FieldDifficulty:
+		return m.OldDifficulty(ctx)
+	case registeredword.FieldDueAt:
+		return m.OldDueAt(ctx)
+	case registeredword.FieldLastReviewedAt:
+		return m.OldLastReviewedAt(ctx)
+	case registeredword.FieldMemo:
+		return m.OldMemo(ctx)
+	case registeredword.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case registeredword.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RegisteredWord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegisteredWordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case registeredword.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case registeredword.FieldWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordID(v)
+		return nil
+	case registeredword.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case registeredword.FieldAttentionLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttentionLevel(v)
+		return nil
+	case registeredword.FieldQuizCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuizCount(v)
+		return nil
+	case registeredword.FieldCorrectCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrectCount(v)
+		return nil
+	case registeredword.FieldCorrectRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCorrectRate(v)
+		return nil
+	case registeredword.FieldEaseFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEaseFactor(v)
+		return nil
+	case registeredword.FieldIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIntervalDays(v)
+		return nil
+	case registeredword.FieldRepetitions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRepetitions(v)
+		return nil
+	case registeredword.FieldLapses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLapses(v)
+		return nil
+	case registeredword.FieldStability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStability(v)
+		return nil
+	case registeredword.FieldDifficulty:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDifficulty(v)
+		return nil
+	case registeredword.FieldDueAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDueAt(v)
+		return nil
+	case registeredword.FieldLastReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastReviewedAt(v)
+		return nil
+	case registeredword.FieldMemo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMemo(v)
+		return nil
+	case registeredword.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case registeredword.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegisteredWord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegisteredWordMutation) AddedFields() []string {
+	var fields []string
+	if m.addattention_level != nil {
+		fields = append(fields, registeredword.FieldAttentionLevel)
+	}
+	if m.addquiz_count != nil {
+		fields = append(fields, registeredword.FieldQuizCount)
+	}
+	if m.addcorrect_count != nil {
+		fields = append(fields, registeredword.FieldCorrectCount)
+	}
+	if m.addcorrect_rate != nil {
+		fields = append(fields, registeredword.FieldCorrectRate)
+	}
+	if m.addease_factor != nil {
+		fields = append(fields, registeredword.FieldEaseFactor)
+	}
+	if m.addinterval_days != nil {
+		fields = append(fields, registeredword.FieldIntervalDays)
+	}
+	if m.addrepetitions != nil {
+		fields = append(fields, registeredword.FieldRepetitions)
+	}
+	if m.addlapses != nil {
+		fields = append(fields, registeredword.FieldLapses)
+	}
+	if m.addstability != nil {
+		fields = append(fields, registeredword.FieldStability)
+	}
+	if m.adddifficulty != nil {
+		fields = append(fields, registeredword.FieldDifficulty)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegisteredWordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case registeredword.FieldAttentionLevel:
+		return m.AddedAttentionLevel()
+	case registeredword.FieldQuizCount:
+		return m.AddedQuizCount()
+	case registeredword.FieldCorrectCount:
+		return m.AddedCorrectCount()
+	case registeredword.FieldCorrectRate:
+		return m.AddedCorrectRate()
+	case registeredword.FieldEaseFactor:
+		return m.AddedEaseFactor()
+	case registeredword.FieldIntervalDays:
+		return m.AddedIntervalDays()
+	case registeredword.FieldRepetitions:
+		return m.AddedRepetitions()
+	case registeredword.FieldLapses:
+		return m.AddedLapses()
+	case registeredword.FieldStability:
+		return m.AddedStability()
+	case registeredword.FieldDifficulty:
+		return m.AddedDifficulty()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegisteredWordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case registeredword.FieldAttentionLevel:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttentionLevel(v)
+		return nil
+	case registeredword.FieldQuizCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuizCount(v)
+		return nil
+	case registeredword.FieldCorrectCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCorrectCount(v)
+		return nil
+	case registeredword.FieldCorrectRate:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCorrectRate(v)
+		return nil
+	case registeredword.FieldEaseFactor:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEaseFactor(v)
+		return nil
+	case registeredword.FieldIntervalDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIntervalDays(v)
+		return nil
+	case registeredword.FieldRepetitions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRepetitions(v)
+		return nil
+	case registeredword.FieldLapses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLapses(v)
+		return nil
+	case registeredword.FieldStability:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStability(v)
+		return nil
+	case registeredword.FieldDifficulty:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDifficulty(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegisteredWord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegisteredWordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(registeredword.FieldDueAt) {
+		fields = append(fields, registeredword.FieldDueAt)
+	}
+	if m.FieldCleared(registeredword.FieldLastReviewedAt) {
+		fields = append(fields, registeredword.FieldLastReviewedAt)
+	}
+	if m.FieldCleared(registeredword.FieldMemo) {
+		fields = append(fields, registeredword.FieldMemo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegisteredWordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegisteredWordMutation) ClearField(name string) error {
+	switch name {
+	case registeredword.FieldDueAt:
+		m.ClearDueAt()
+		return nil
+	case registeredword.FieldLastReviewedAt:
+		m.ClearLastReviewedAt()
+		return nil
+	case registeredword.FieldMemo:
+		m.ClearMemo()
+		return nil
+	}
+	return fmt.Errorf("unknown RegisteredWord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegisteredWordMutation) ResetField(name string) error {
+	switch name {
+	case registeredword.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case registeredword.FieldWordID:
+		m.ResetWordID()
+		return nil
+	case registeredword.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case registeredword.FieldAttentionLevel:
+		m.ResetAttentionLevel()
+		return nil
+	case registeredword.FieldQuizCount:
+		m.ResetQuizCount()
+		return nil
+	case registeredword.FieldCorrectCount:
+		m.ResetCorrectCount()
+		return nil
+	case registeredword.FieldCorrectRate:
+		m.ResetCorrectRate()
+		return nil
+	case registeredword.FieldEaseFactor:
+		m.ResetEaseFactor()
+		return nil
+	case registeredword.FieldIntervalDays:
+		m.ResetIntervalDays()
+		return nil
+	case registeredword.FieldRepetitions:
+		m.ResetRepetitions()
+		return nil
+	case registeredword.FieldLapses:
+		m.ResetLapses()
+		return nil
+	case registeredword.FieldStability:
+		m.ResetStability()
+		return nil
+	case registeredword.FieldDifficulty:
+		m.ResetDifficulty()
+		return nil
+	case registeredword.FieldDueAt:
+		m.ResetDueAt()
+		return nil
+	case registeredword.FieldLastReviewedAt:
+		m.ResetLastReviewedAt()
+		return nil
+	case registeredword.FieldMemo:
+		m.ResetMemo()
+		return nil
+	case registeredword.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case registeredword.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RegisteredWord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegisteredWordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, registeredword.EdgeUser)
+	}
+	if m.word != nil {
+		edges = append(edges, registeredword.EdgeWord)
+	}
+	if m.quiz_questions != nil {
+		edges = append(edges, registeredword.EdgeQuizQuestions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegisteredWordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case registeredword.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case registeredword.EdgeWord:
+		if id := m.word; id != nil {
+			return []ent.Value{*id}
+		}
+	case registeredword.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.quiz_questions))
+		for id := range m.quiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegisteredWordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedquiz_questions != nil {
+		edges = append(edges, registeredword.EdgeQuizQuestions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegisteredWordMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case registeredword.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
+		for id := range m.removedquiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegisteredWordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, registeredword.EdgeUser)
+	}
+	if m.clearedword {
+		edges = append(edges, registeredword.EdgeWord)
+	}
+	if m.clearedquiz_questions {
+		edges = append(edges, registeredword.EdgeQuizQuestions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegisteredWordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case registeredword.EdgeUser:
+		return m.cleareduser
+	case registeredword.EdgeWord:
+		return m.clearedword
+	case registeredword.EdgeQuizQuestions:
+		return m.clearedquiz_questions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegisteredWordMutation) ClearEdge(name string) error {
+	switch name {
+	case registeredword.EdgeUser:
+		m.ClearUser()
+		return nil
+	case registeredword.EdgeWord:
+		m.ClearWord()
+		return nil
+	}
+	return fmt.Errorf("unknown RegisteredWord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegisteredWordMutation) ResetEdge(name string) error {
+	switch name {
+	case registeredword.EdgeUser:
+		m.ResetUser()
+		return nil
+	case registeredword.EdgeWord:
+		m.ResetWord()
+		return nil
+	case registeredword.EdgeQuizQuestions:
+		m.ResetQuizQuestions()
+		return nil
+	}
+	return fmt.Errorf("unknown RegisteredWord edge %s", name)
+}
+
+// RootConfigMutation represents an operation that mutates the RootConfig nodes in the graph.
+type RootConfigMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *int
+	editing_permission            *string
+	is_test_user_mode             *bool
+	is_email_authentication_check *bool
+	is_line_authentication        *bool
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	done                          bool
+	oldValue                      func(context.Context) (*RootConfig, error)
+	predicates                    []predicate.RootConfig
+}
+
+var _ ent.Mutation = (*RootConfigMutation)(nil)
+
+// rootconfigOption allows management of the mutation configuration using functional options.
+type rootconfigOption func(*RootConfigMutation)
+
+// newRootConfigMutation creates new mutation for the RootConfig entity.
+func newRootConfigMutation(c config, op Op, opts ...rootconfigOption) *RootConfigMutation {
+	m := &RootConfigMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRootConfig,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRootConfigID sets the ID field of the mutation.
+func withRootConfigID(id int) rootconfigOption {
+	return func(m *RootConfigMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RootConfig
+		)
+		m.oldValue = func(ctx context.Context) (*RootConfig, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RootConfig.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRootConfig sets the old RootConfig of the mutation.
+func withRootConfig(node *RootConfig) rootconfigOption {
+	return func(m *RootConfigMutation) {
+		m.oldValue = func(context.Context) (*RootConfig, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RootConfigMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RootConfigMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RootConfigMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RootConfigMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RootConfig.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEditingPermission sets the "editing_permission" field.
+func (m *RootConfigMutation) SetEditingPermission(s string) {
+	m.editing_permission = &s
+}
+
+// EditingPermission returns the value of the "editing_permission" field in the mutation.
+func (m *RootConfigMutation) EditingPermission() (r string, exists bool) {
+	v := m.editing_permission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditingPermission returns the old "editing_permission" field's value of the RootConfig entity.
+// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RootConfigMutation) OldEditingPermission(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditingPermission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditingPermission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditingPermission: %w", err)
+	}
+	return oldValue.EditingPermission, nil
+}
+
+// ResetEditingPermission resets all changes to the "editing_permission" field.
+func (m *RootConfigMutation) ResetEditingPermission() {
+	m.editing_permission = nil
+}
+
+// SetIsTestUserMode sets the "is_test_user_mode" field.
+func (m *RootConfigMutation) SetIsTestUserMode(b bool) {
+	m.is_test_user_mode = &b
+}
+
+// IsTestUserMode returns the value of the "is_test_user_mode" field in the mutation.
+func (m *RootConfigMutation) IsTestUserMode() (r bool, exists bool) {
+	v := m.is_test_user_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTestUserMode returns the old "is_test_user_mode" field's value of the RootConfig entity.
+// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RootConfigMutation) OldIsTestUserMode(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTestUserMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTestUserMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTestUserMode: %w", err)
+	}
+	return oldValue.IsTestUserMode, nil
+}
+
+// ResetIsTestUserMode resets all changes to the "is_test_user_mode" field.
+func (m *RootConfigMutation) ResetIsTestUserMode() {
+	m.is_test_user_mode = nil
+}
+
+// SetIsEmailAuthenticationCheck sets the "is_email_authentication_check" field.
+func (m *RootConfigMutation) SetIsEmailAuthenticationCheck(b bool) {
+	m.is_email_authentication_check = &b
+}
+
+// IsEmailAuthenticationCheck returns the value of the "is_email_authentication_check" field in the mutation.
+func (m *RootConfigMutation) IsEmailAuthenticationCheck() (r bool, exists bool) {
+	v := m.is_email_authentication_check
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEmailAuthenticationCheck returns the old "is_email_authentication_check" field's value of the RootConfig entity.
+// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RootConfigMutation) OldIsEmailAuthenticationCheck(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEmailAuthenticationCheck is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEmailAuthenticationCheck requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEmailAuthenticationCheck: %w", err)
+	}
+	return oldValue.IsEmailAuthenticationCheck, nil
+}
+
+// ResetIsEmailAuthenticationCheck resets all changes to the "is_email_authentication_check" field.
+func (m *RootConfigMutation) ResetIsEmailAuthenticationCheck() {
+	m.is_email_authentication_check = nil
+}
+
+// SetIsLineAuthentication sets the "is_line_authentication" field.
+func (m *RootConfigMutation) SetIsLineAuthentication(b bool) {
+	m.is_line_authentication = &b
+}
+
+// IsLineAuthentication returns the value of the "is_line_authentication" field in the mutation.
+func (m *RootConfigMutation) IsLineAuthentication() (r bool, exists bool) {
+	v := m.is_line_authentication
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsLineAuthentication returns the old "is_line_authentication" field's value of the RootConfig entity.
+// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RootConfigMutation) OldIsLineAuthentication(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsLineAuthentication is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsLineAuthentication requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsLineAuthentication: %w", err)
+	}
+	return oldValue.IsLineAuthentication, nil
+}
+
+// ResetIsLineAuthentication resets all changes to the "is_line_authentication" field.
+func (m *RootConfigMutation) ResetIsLineAuthentication() {
+	m.is_line_authentication = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RootConfigMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RootConfigMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RootConfig entity.
+// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RootConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RootConfigMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RootConfigMutation builder.
+func (m *RootConfigMutation) Where(ps ...predicate.RootConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RootConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RootConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RootConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RootConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RootConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RootConfig).
+func (m *RootConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RootConfigMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.editing_permission != nil {
+		fields = append(fields, rootconfig.FieldEditingPermission)
+	}
+	if m.is_test_user_mode != nil {
+		fields = append(fields, rootconfig.FieldIsTestUserMode)
+	}
+	if m.is_email_authentication_check != nil {
+		fields = append(fields, rootconfig.FieldIsEmailAuthenticationCheck)
+	}
+	if m.is_line_authentication != nil {
+		fields = append(fields, rootconfig.FieldIsLineAuthentication)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rootconfig.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RootConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case rootconfig.FieldEditingPermission:
+		return m.EditingPermission()
+	case rootconfig.FieldIsTestUserMode:
+		return m.IsTestUserMode()
+	case rootconfig.FieldIsEmailAuthenticationCheck:
+		return m.IsEmailAuthenticationCheck()
+	case rootconfig.FieldIsLineAuthentication:
+		return m.IsLineAuthentication()
+	case rootconfig.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RootConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case rootconfig.FieldEditingPermission:
+		return m.OldEditingPermission(ctx)
+	case rootconfig.FieldIsTestUserMode:
+		return m.OldIsTestUserMode(ctx)
+	case rootconfig.FieldIsEmailAuthenticationCheck:
+		return m.OldIsEmailAuthenticationCheck(ctx)
+	case rootconfig.FieldIsLineAuthentication:
+		return m.OldIsLineAuthentication(ctx)
+	case rootconfig.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RootConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RootConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case rootconfig.FieldEditingPermission:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditingPermission(v)
+		return nil
+	case rootconfig.FieldIsTestUserMode:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsTestUserMode(v)
+		return nil
+	case rootconfig.FieldIsEmailAuthenticationCheck:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEmailAuthenticationCheck(v)
+		return nil
+	case rootconfig.FieldIsLineAuthentication:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsLineAuthentication(v)
+		return nil
+	case rootconfig.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RootConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RootConfigMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RootConfigMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RootConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RootConfig numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RootConfigMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RootConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RootConfigMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RootConfig nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RootConfigMutation) ResetField(name string) error {
+	switch name {
+	case rootconfig.FieldEditingPermission:
+		m.ResetEditingPermission()
+		return nil
+	case rootconfig.FieldIsTestUserMode:
+		m.ResetIsTestUserMode()
+		return nil
+	case rootconfig.FieldIsEmailAuthenticationCheck:
+		m.ResetIsEmailAuthenticationCheck()
+		return nil
+	case rootconfig.FieldIsLineAuthentication:
+		m.ResetIsLineAuthentication()
+		return nil
+	case rootconfig.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RootConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RootConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RootConfigMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RootConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RootConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RootConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RootConfigMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RootConfigMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RootConfig unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RootConfigMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RootConfig edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                      Op
+	typ                     string
+	id                      *int
+	email                   *string
+	password                *string
+	name                    *string
+	created_at              *time.Time
+	updated_at              *time.Time
+	deleted_at              *time.Time
+	isAdmin                 *bool
+	isRoot                  *bool
+	isTest                  *bool
+	email_verified_at       *time.Time
+	token_version           *int
+	addtoken_version        *int
+	clearedFields           map[string]struct{}
+	registered_words        map[int]struct{}
+	removedregistered_words map[int]struct{}
+	clearedregistered_words bool
+	quizzes                 map[int]struct{}
+	removedquizzes          map[int]struct{}
+	clearedquizzes          bool
+	user_config             *int
+	cleareduser_config      bool
+	external_auths          map[int]struct{}
+	removedexternal_auths   map[int]struct{}
+	clearedexternal_auths   bool
+	user_daily_usage        *int
+	cleareduser_daily_usage bool
+	decks                   map[int]struct{}
+	removeddecks            map[int]struct{}
+	cleareddecks            bool
+	word_proposals          map[int]struct{}
+	removedword_proposals   map[int]struct{}
+	clearedword_proposals   bool
+	refresh_tokens          map[int]struct{}
+	removedrefresh_tokens   map[int]struct{}
+	clearedrefresh_tokens   bool
+	done                    bool
+	oldValue                func(context.Context) (*User, error)
+	predicates              []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id int) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ClearEmail clears the value of the "email" field.
+func (m *UserMutation) ClearEmail() {
+	m.email = nil
+	m.clearedFields[user.FieldEmail] = struct{}{}
+}
+
+// EmailCleared returns if the "email" field was cleared in this mutation.
+func (m *UserMutation) EmailCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmail]
+	return ok
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+	delete(m.clearedFields, user.FieldEmail)
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ClearPassword clears the value of the "password" field.
+func (m *UserMutation) ClearPassword() {
+	m.password = nil
+	m.clearedFields[user.FieldPassword] = struct{}{}
+}
+
+// PasswordCleared returns if the "password" field was cleared in this mutation.
+func (m *UserMutation) PasswordCleared() bool {
+	_, ok := m.clearedFields[user.FieldPassword]
+	return ok
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+	delete(m.clearedFields, user.FieldPassword)
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, user.FieldDeletedAt)
+}
+
+// SetIsAdmin sets the "isAdmin" field.
+func (m *UserMutation) SetIsAdmin(b bool) {
+	m.isAdmin = &b
+}
+
+// IsAdmin returns the value of the "isAdmin" field in the mutation.
+func (m *UserMutation) IsAdmin() (r bool, exists bool) {
+	v := m.isAdmin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAdmin returns the old "isAdmin" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAdmin: %w", err)
+	}
+	return oldValue.IsAdmin, nil
+}
+
+// ResetIsAdmin resets all changes to the "isAdmin" field.
+func (m *UserMutation) ResetIsAdmin() {
+	m.isAdmin = nil
+}
+
+// SetIsRoot sets the "isRoot" field.
+func (m *UserMutation) SetIsRoot(b bool) {
+	m.isRoot = &b
+}
+
+// IsRoot returns the value of the "isRoot" field in the mutation.
+func (m *UserMutation) IsRoot() (r bool, exists bool) {
+	v := m.isRoot
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsRoot returns the old "isRoot" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsRoot(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsRoot is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsRoot requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsRoot: %w", err)
+	}
+	return oldValue.IsRoot, nil
+}
+
+// ResetIsRoot resets all changes to the "isRoot" field.
+func (m *UserMutation) ResetIsRoot() {
+	m.isRoot = nil
+}
+
+// SetIsTest sets the "isTest" field.
+func (m *UserMutation) SetIsTest(b bool) {
+	m.isTest = &b
+}
+
+// IsTest returns the value of the "isTest" field in the mutation.
+func (m *UserMutation) IsTest() (r bool, exists bool) {
+	v := m.isTest
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsTest returns the old "isTest" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsTest(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsTest is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsTest requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsTest: %w", err)
+	}
+	return oldValue.IsTest, nil
+}
+
+// ResetIsTest resets all changes to the "isTest" field.
+func (m *UserMutation) ResetIsTest() {
+	m.isTest = nil
+}
+
+// SetEmailVerifiedAt sets the "email_verified_at" field.
+func (m *UserMutation) SetEmailVerifiedAt(t time.Time) {
+	m.email_verified_at = &t
+}
+
+// EmailVerifiedAt returns the value of the "email_verified_at" field in the mutation.
+func (m *UserMutation) EmailVerifiedAt() (r time.Time, exists bool) {
+	v := m.email_verified_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmailVerifiedAt returns the old "email_verified_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmailVerifiedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmailVerifiedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmailVerifiedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmailVerifiedAt: %w", err)
+	}
+	return oldValue.EmailVerifiedAt, nil
+}
+
+// ClearEmailVerifiedAt clears the value of the "email_verified_at" field.
+func (m *UserMutation) ClearEmailVerifiedAt() {
+	m.email_verified_at = nil
+	m.clearedFields[user.FieldEmailVerifiedAt] = struct{}{}
+}
+
+// EmailVerifiedAtCleared returns if the "email_verified_at" field was cleared in this mutation.
+func (m *UserMutation) EmailVerifiedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldEmailVerifiedAt]
+	return ok
+}
+
+// ResetEmailVerifiedAt resets all changes to the "email_verified_at" field.
+func (m *UserMutation) ResetEmailVerifiedAt() {
+	m.email_verified_at = nil
+	delete(m.clearedFields, user.FieldEmailVerifiedAt)
+}
+
+// SetTokenVersion sets the "token_version" field.
+func (m *UserMutation) SetTokenVersion(i int) {
+	m.token_version = &i
+	m.addtoken_version = nil
+}
+
+// TokenVersion returns the value of the "token_version" field in the mutation.
+func (m *UserMutation) TokenVersion() (r int, exists bool) {
+	v := m.token_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTokenVersion returns the old "token_version" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldTokenVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTokenVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTokenVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTokenVersion: %w", err)
+	}
+	return oldValue.TokenVersion, nil
+}
+
+// AddTokenVersion adds i to the "token_version" field.
+func (m *UserMutation) AddTokenVersion(i int) {
+	if m.addtoken_version != nil {
+		*m.addtoken_version += i
+	} else {
+		m.addtoken_version = &i
+	}
+}
+
+// AddedTokenVersion returns the value that was added to the "token_version" field in this mutation.
+func (m *UserMutation) AddedTokenVersion() (r int, exists bool) {
+	v := m.addtoken_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTokenVersion resets all changes to the "token_version" field.
+func (m *UserMutation) ResetTokenVersion() {
+	m.token_version = nil
+	m.addtoken_version = nil
+}
+
+// AddRegisteredWordIDs adds the "registered_words" edge to the RegisteredWord entity by ids.
+func (m *UserMutation) AddRegisteredWordIDs(ids ...int) {
+	if m.registered_words == nil {
+		m.registered_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.registered_words[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRegisteredWords clears the "registered_words" edge to the RegisteredWord entity.
+func (m *UserMutation) ClearRegisteredWords() {
+	m.clearedregistered_words = true
+}
+
+// RegisteredWordsCleared reports if the "registered_words" edge to the RegisteredWord entity was cleared.
+func (m *UserMutation) RegisteredWordsCleared() bool {
+	return m.clearedregistered_words
+}
+
+// RemoveRegisteredWordIDs removes the "registered_words" edge to the RegisteredWord entity by IDs.
+func (m *UserMutation) RemoveRegisteredWordIDs(ids ...int) {
+	if m.removedregistered_words == nil {
+		m.removedregistered_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.registered_words, ids[i])
+		m.removedregistered_words[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRegisteredWords returns the removed IDs of the "registered_words" edge to the RegisteredWord entity.
+func (m *UserMutation) RemovedRegisteredWordsIDs() (ids []int) {
+	for id := range m.removedregistered_words {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RegisteredWordsIDs returns the "registered_words" edge IDs in the mutation.
+func (m *UserMutation) RegisteredWordsIDs() (ids []int) {
+	for id := range m.registered_words {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRegisteredWords resets all changes to the "registered_words" edge.
+func (m *UserMutation) ResetRegisteredWords() {
+	m.registered_words = nil
+	m.clearedregistered_words = false
+	m.removedregistered_words = nil
+}
+
+// AddQuizIDs adds the "quizzes" edge to the Quiz entity by ids.
+func (m *UserMutation) AddQuizIDs(ids ...int) {
+	if m.quizzes == nil {
+		m.quizzes = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.quizzes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearQuizzes clears the "quizzes" edge to the Quiz entity.
+func (m *UserMutation) ClearQuizzes() {
+	m.clearedquizzes = true
+}
+
+// QuizzesCleared reports if the "quizzes" edge to the Quiz entity was cleared.
+func (m *UserMutation) QuizzesCleared() bool {
+	return m.clearedquizzes
+}
+
+// RemoveQuizIDs removes the "quizzes" edge to the Quiz entity by IDs.
+func (m *UserMutation) RemoveQuizIDs(ids ...int) {
+	if m.removedquizzes == nil {
+		m.removedquizzes = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.quizzes, ids[i])
+		m.removedquizzes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedQuizzes returns the removed IDs of the "quizzes" edge to the Quiz entity.
+func (m *UserMutation) RemovedQuizzesIDs() (ids []int) {
+	for id := range m.removedquizzes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// QuizzesIDs returns the "quizzes" edge IDs in the mutation.
+func (m *UserMutation) QuizzesIDs() (ids []int) {
+	for id := range m.quizzes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetQuizzes resets all changes to the "quizzes" edge.
+func (m *UserMutation) ResetQuizzes() {
+	m.quizzes = nil
+	m.clearedquizzes = false
+	m.removedquizzes = nil
+}
+
+// SetUserConfigID sets the "user_config" edge to the UserConfig entity by id.
+func (m *UserMutation) SetUserConfigID(id int) {
+	m.user_config = &id
+}
+
+// ClearUserConfig clears the "user_config" edge to the UserConfig entity.
+func (m *UserMutation) ClearUserConfig() {
+	m.cleareduser_config = true
+}
+
+// UserConfigCleared reports if the "user_config" edge to the UserConfig entity was cleared.
+func (m *UserMutation) UserConfigCleared() bool {
+	return m.cleareduser_config
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RegisteredWordMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.cleareduser {
-		edges = append(edges, registeredword.EdgeUser)
-	}
-	if m.clearedword {
-		edges = append(edges, registeredword.EdgeWord)
-	}
-	if m.clearedquiz_questions {
-		edges = append(edges, registeredword.EdgeQuizQuestions)
+// UserConfigID returns the "user_config" edge ID in the mutation.
+func (m *UserMutation) UserConfigID() (id int, exists bool) {
+	if m.user_config != nil {
+		return *m.user_config, true
 	}
-	return edges
+	return
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *RegisteredWordMutation) EdgeCleared(name string) bool {
-	switch name {
-	case registeredword.EdgeUser:
-		return m.cleareduser
-	case registeredword.EdgeWord:
-		return m.clearedword
-	case registeredword.EdgeQuizQuestions:
-		return m.clearedquiz_questions
+// UserConfigIDs returns the "user_config" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserConfigID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) UserConfigIDs() (ids []int) {
+	if id := m.user_config; id != nil {
+		ids = append(ids, *id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *RegisteredWordMutation) ClearEdge(name string) error {
-	switch name {
-	case registeredword.EdgeUser:
-		m.ClearUser()
-		return nil
-	case registeredword.EdgeWord:
-		m.ClearWord()
-		return nil
-	}
-	return fmt.Errorf("unknown RegisteredWord unique edge %s", name)
+// ResetUserConfig resets all changes to the "user_config" edge.
+func (m *UserMutation) ResetUserConfig() {
+	m.user_config = nil
+	m.cleareduser_config = false
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RegisteredWordMutation) ResetEdge(name string) error {
-	switch name {
-	case registeredword.EdgeUser:
-		m.ResetUser()
-		return nil
-	case registeredword.EdgeWord:
-		m.ResetWord()
-		return nil
-	case registeredword.EdgeQuizQuestions:
-		m.ResetQuizQuestions()
-		return nil
+// AddExternalAuthIDs adds the "external_auths" edge to the ExternalAuth entity by ids.
+func (m *UserMutation) AddExternalAuthIDs(ids ...int) {
+	if m.external_auths == nil {
+		m.external_auths = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.external_auths[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown RegisteredWord edge %s", name)
 }
 
-// RootConfigMutation represents an operation that mutates the RootConfig nodes in the graph.
-type RootConfigMutation struct {
-	config
-	op                            Op
-	typ                           string
-	id                            *int
-	editing_permission            *string
-	is_test_user_mode             *bool
-	is_email_authentication_check *bool
-	is_line_authentication        *bool
-	updated_at                    *time.Time
-	clearedFields                 map[string]struct{}
-	done                          bool
-	oldValue                      func(context.Context) (*RootConfig, error)
-	predicates                    []predicate.RootConfig
+// ClearExternalAuths clears the "external_auths" edge to the ExternalAuth entity.
+func (m *UserMutation) ClearExternalAuths() {
+	m.clearedexternal_auths = true
 }
 
-var _ ent.Mutation = (*RootConfigMutation)(nil)
-
-// rootconfigOption allows management of the mutation configuration using functional options.
-type rootconfigOption func(*RootConfigMutation)
+// ExternalAuthsCleared reports if the "external_auths" edge to the ExternalAuth entity was cleared.
+func (m *UserMutation) ExternalAuthsCleared() bool {
+	return m.clearedexternal_auths
+}
 
-// newRootConfigMutation creates new mutation for the RootConfig entity.
-func newRootConfigMutation(c config, op Op, opts ...rootconfigOption) *RootConfigMutation {
-	m := &RootConfigMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeRootConfig,
-		clearedFields: make(map[string]struct{}),
+// RemoveExternalAuthIDs removes the "external_auths" edge to the ExternalAuth entity by IDs.
+func (m *UserMutation) RemoveExternalAuthIDs(ids ...int) {
+	if m.removedexternal_auths == nil {
+		m.removedexternal_auths = make(map[int]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.external_auths, ids[i])
+		m.removedexternal_auths[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withRootConfigID sets the ID field of the mutation.
-func withRootConfigID(id int) rootconfigOption {
-	return func(m *RootConfigMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *RootConfig
-		)
-		m.oldValue = func(ctx context.Context) (*RootConfig, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().RootConfig.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedExternalAuths returns the removed IDs of the "external_auths" edge to the ExternalAuth entity.
+func (m *UserMutation) RemovedExternalAuthsIDs() (ids []int) {
+	for id := range m.removedexternal_auths {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withRootConfig sets the old RootConfig of the mutation.
-func withRootConfig(node *RootConfig) rootconfigOption {
-	return func(m *RootConfigMutation) {
-		m.oldValue = func(context.Context) (*RootConfig, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ExternalAuthsIDs returns the "external_auths" edge IDs in the mutation.
+func (m *UserMutation) ExternalAuthsIDs() (ids []int) {
+	for id := range m.external_auths {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RootConfigMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetExternalAuths resets all changes to the "external_auths" edge.
+func (m *UserMutation) ResetExternalAuths() {
+	m.external_auths = nil
+	m.clearedexternal_auths = false
+	m.removedexternal_auths = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m RootConfigMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetUserDailyUsageID sets the "user_daily_usage" edge to the UserDailyUsage entity by id.
+func (m *UserMutation) SetUserDailyUsageID(id int) {
+	m.user_daily_usage = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RootConfigMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// ClearUserDailyUsage clears the "user_daily_usage" edge to the UserDailyUsage entity.
+func (m *UserMutation) ClearUserDailyUsage() {
+	m.cleareduser_daily_usage = true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RootConfigMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().RootConfig.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// UserDailyUsageCleared reports if the "user_daily_usage" edge to the UserDailyUsage entity was cleared.
+func (m *UserMutation) UserDailyUsageCleared() bool {
+	return m.cleareduser_daily_usage
 }
 
-// SetEditingPermission sets the "editing_permission" field.
-func (m *RootConfigMutation) SetEditingPermission(s string) {
-	m.editing_permission = &s
+// UserDailyUsageID returns the "user_daily_usage" edge ID in the mutation.
+func (m *UserMutation) UserDailyUsageID() (id int, exists bool) {
+	if m.user_daily_usage != nil {
+		return *m.user_daily_usage, true
+	}
+	return
 }
 
-// EditingPermission returns the value of the "editing_permission" field in the mutation.
-func (m *RootConfigMutation) EditingPermission() (r string, exists bool) {
-	v := m.editing_permission
-	if v == nil {
-		return
+// UserDailyUsageIDs returns the "user_daily_usage" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserDailyUsageID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) UserDailyUsageIDs() (ids []int) {
+	if id := m.user_daily_usage; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldEditingPermission returns the old "editing_permission" field's value of the RootConfig entity.
-// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RootConfigMutation) OldEditingPermission(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEditingPermission is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEditingPermission requires an ID field in the mutation")
+// ResetUserDailyUsage resets all changes to the "user_daily_usage" edge.
+func (m *UserMutation) ResetUserDailyUsage() {
+	m.user_daily_usage = nil
+	m.cleareduser_daily_usage = false
+}
+
+// AddDeckIDs adds the "decks" edge to the Deck entity by ids.
+func (m *UserMutation) AddDeckIDs(ids ...int) {
+	if m.decks == nil {
+		m.decks = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEditingPermission: %w", err)
+	for i := range ids {
+		m.decks[ids[i]] = struct{}{}
 	}
-	return oldValue.EditingPermission, nil
 }
 
-// ResetEditingPermission resets all changes to the "editing_permission" field.
-func (m *RootConfigMutation) ResetEditingPermission() {
-	m.editing_permission = nil
+// ClearDecks clears the "decks" edge to the Deck entity.
+func (m *UserMutation) ClearDecks() {
+	m.cleareddecks = true
 }
 
-// SetIsTestUserMode sets the "is_test_user_mode" field.
-func (m *RootConfigMutation) SetIsTestUserMode(b bool) {
-	m.is_test_user_mode = &b
+// DecksCleared reports if the "decks" edge to the Deck entity was cleared.
+func (m *UserMutation) DecksCleared() bool {
+	return m.cleareddecks
 }
 
-// IsTestUserMode returns the value of the "is_test_user_mode" field in the mutation.
-func (m *RootConfigMutation) IsTestUserMode() (r bool, exists bool) {
-	v := m.is_test_user_mode
-	if v == nil {
-		return
+// RemoveDeckIDs removes the "decks" edge to the Deck entity by IDs.
+func (m *UserMutation) RemoveDeckIDs(ids ...int) {
+	if m.removeddecks == nil {
+		m.removeddecks = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.decks, ids[i])
+		m.removeddecks[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsTestUserMode returns the old "is_test_user_mode" field's value of the RootConfig entity.
-// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RootConfigMutation) OldIsTestUserMode(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsTestUserMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsTestUserMode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsTestUserMode: %w", err)
+// RemovedDecks returns the removed IDs of the "decks" edge to the Deck entity.
+func (m *UserMutation) RemovedDecksIDs() (ids []int) {
+	for id := range m.removeddecks {
+		ids = append(ids, id)
 	}
-	return oldValue.IsTestUserMode, nil
+	return
 }
 
-// ResetIsTestUserMode resets all changes to the "is_test_user_mode" field.
-func (m *RootConfigMutation) ResetIsTestUserMode() {
-	m.is_test_user_mode = nil
+// DecksIDs returns the "decks" edge IDs in the mutation.
+func (m *UserMutation) DecksIDs() (ids []int) {
+	for id := range m.decks {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetIsEmailAuthenticationCheck sets the "is_email_authentication_check" field.
-func (m *RootConfigMutation) SetIsEmailAuthenticationCheck(b bool) {
-	m.is_email_authentication_check = &b
+// ResetDecks resets all changes to the "decks" edge.
+func (m *UserMutation) ResetDecks() {
+	m.decks = nil
+	m.cleareddecks = false
+	m.removeddecks = nil
 }
 
-// IsEmailAuthenticationCheck returns the value of the "is_email_authentication_check" field in the mutation.
-func (m *RootConfigMutation) IsEmailAuthenticationCheck() (r bool, exists bool) {
-	v := m.is_email_authentication_check
-	if v == nil {
-		return
+// AddWordProposalIDs adds the "word_proposals" edge to the WordProposal entity by ids.
+func (m *UserMutation) AddWordProposalIDs(ids ...int) {
+	if m.word_proposals == nil {
+		m.word_proposals = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.word_proposals[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldIsEmailAuthenticationCheck returns the old "is_email_authentication_check" field's value of the RootConfig entity.
-// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RootConfigMutation) OldIsEmailAuthenticationCheck(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEmailAuthenticationCheck is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEmailAuthenticationCheck requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEmailAuthenticationCheck: %w", err)
-	}
-	return oldValue.IsEmailAuthenticationCheck, nil
+// ClearWordProposals clears the "word_proposals" edge to the WordProposal entity.
+func (m *UserMutation) ClearWordProposals() {
+	m.clearedword_proposals = true
 }
 
-// ResetIsEmailAuthenticationCheck resets all changes to the "is_email_authentication_check" field.
-func (m *RootConfigMutation) ResetIsEmailAuthenticationCheck() {
-	m.is_email_authentication_check = nil
+// WordProposalsCleared reports if the "word_proposals" edge to the WordProposal entity was cleared.
+func (m *UserMutation) WordProposalsCleared() bool {
+	return m.clearedword_proposals
 }
 
-// SetIsLineAuthentication sets the "is_line_authentication" field.
-func (m *RootConfigMutation) SetIsLineAuthentication(b bool) {
-	m.is_line_authentication = &b
+// RemoveWordProposalIDs removes the "word_proposals" edge to the WordProposal entity by IDs.
+func (m *UserMutation) RemoveWordProposalIDs(ids ...int) {
+	if m.removedword_proposals == nil {
+		m.removedword_proposals = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.word_proposals, ids[i])
+		m.removedword_proposals[ids[i]] = struct{}{}
+	}
 }
 
-// IsLineAuthentication returns the value of the "is_line_authentication" field in the mutation.
-func (m *RootConfigMutation) IsLineAuthentication() (r bool, exists bool) {
-	v := m.is_line_authentication
-	if v == nil {
-		return
+// RemovedWordProposals returns the removed IDs of the "word_proposals" edge to the WordProposal entity.
+func (m *UserMutation) RemovedWordProposalsIDs() (ids []int) {
+	for id := range m.removedword_proposals {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// OldIsLineAuthentication returns the old "is_line_authentication" field's value of the RootConfig entity.
-// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RootConfigMutation) OldIsLineAuthentication(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsLineAuthentication is only allowed on UpdateOne operations")
+// WordProposalsIDs returns the "word_proposals" edge IDs in the mutation.
+func (m *UserMutation) WordProposalsIDs() (ids []int) {
+	for id := range m.word_proposals {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsLineAuthentication requires an ID field in the mutation")
+	return
+}
+
+// ResetWordProposals resets all changes to the "word_proposals" edge.
+func (m *UserMutation) ResetWordProposals() {
+	m.word_proposals = nil
+	m.clearedword_proposals = false
+	m.removedword_proposals = nil
+}
+
+// AddRefreshTokenIDs adds the "refresh_tokens" edge to the RefreshToken entity by ids.
+func (m *UserMutation) AddRefreshTokenIDs(ids ...int) {
+	if m.refresh_tokens == nil {
+		m.refresh_tokens = make(map[int]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsLineAuthentication: %w", err)
+	for i := range ids {
+		m.refresh_tokens[ids[i]] = struct{}{}
 	}
-	return oldValue.IsLineAuthentication, nil
 }
 
-// ResetIsLineAuthentication resets all changes to the "is_line_authentication" field.
-func (m *RootConfigMutation) ResetIsLineAuthentication() {
-	m.is_line_authentication = nil
+// ClearRefreshTokens clears the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) ClearRefreshTokens() {
+	m.clearedrefresh_tokens = true
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RootConfigMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RefreshTokensCleared reports if the "refresh_tokens" edge to the RefreshToken entity was cleared.
+func (m *UserMutation) RefreshTokensCleared() bool {
+	return m.clearedrefresh_tokens
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RootConfigMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// RemoveRefreshTokenIDs removes the "refresh_tokens" edge to the RefreshToken entity by IDs.
+func (m *UserMutation) RemoveRefreshTokenIDs(ids ...int) {
+	if m.removedrefresh_tokens == nil {
+		m.removedrefresh_tokens = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.refresh_tokens, ids[i])
+		m.removedrefresh_tokens[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the RootConfig entity.
-// If the RootConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RootConfigMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// RemovedRefreshTokens returns the removed IDs of the "refresh_tokens" edge to the RefreshToken entity.
+func (m *UserMutation) RemovedRefreshTokensIDs() (ids []int) {
+	for id := range m.removedrefresh_tokens {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return
+}
+
+// RefreshTokensIDs returns the "refresh_tokens" edge IDs in the mutation.
+func (m *UserMutation) RefreshTokensIDs() (ids []int) {
+	for id := range m.refresh_tokens {
+		ids = append(ids, id)
 	}
-	return oldValue.UpdatedAt, nil
+	return
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RootConfigMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetRefreshTokens resets all changes to the "refresh_tokens" edge.
+func (m *UserMutation) ResetRefreshTokens() {
+	m.refresh_tokens = nil
+	m.clearedrefresh_tokens = false
+	m.removedrefresh_tokens = nil
 }
 
-// Where appends a list predicates to the RootConfigMutation builder.
-func (m *RootConfigMutation) Where(ps ...predicate.RootConfig) {
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RootConfigMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RootConfigMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.RootConfig, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6165,39 +14888,57 @@ func (m *RootConfigMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RootConfigMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RootConfigMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (RootConfig).
-func (m *RootConfigMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RootConfigMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.editing_permission != nil {
-		fields = append(fields, rootconfig.FieldEditingPermission)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
 	}
-	if m.is_test_user_mode != nil {
-		fields = append(fields, rootconfig.FieldIsTestUserMode)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
 	}
-	if m.is_email_authentication_check != nil {
-		fields = append(fields, rootconfig.FieldIsEmailAuthenticationCheck)
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
 	}
-	if m.is_line_authentication != nil {
-		fields = append(fields, rootconfig.FieldIsLineAuthentication)
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, rootconfig.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.isAdmin != nil {
+		fields = append(fields, user.FieldIsAdmin)
+	}
+	if m.isRoot != nil {
+		fields = append(fields, user.FieldIsRoot)
+	}
+	if m.isTest != nil {
+		fields = append(fields, user.FieldIsTest)
+	}
+	if m.email_verified_at != nil {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	if m.token_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
 	}
 	return fields
 }
@@ -6205,18 +14946,30 @@ func (m *RootConfigMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RootConfigMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case rootconfig.FieldEditingPermission:
-		return m.EditingPermission()
-	case rootconfig.FieldIsTestUserMode:
-		return m.IsTestUserMode()
-	case rootconfig.FieldIsEmailAuthenticationCheck:
-		return m.IsEmailAuthenticationCheck()
-	case rootconfig.FieldIsLineAuthentication:
-		return m.IsLineAuthentication()
-	case rootconfig.FieldUpdatedAt:
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
+	case user.FieldDeletedAt:
+		return m.DeletedAt()
+	case user.FieldIsAdmin:
+		return m.IsAdmin()
+	case user.FieldIsRoot:
+		return m.IsRoot()
+	case user.FieldIsTest:
+		return m.IsTest()
+	case user.FieldEmailVerifiedAt:
+		return m.EmailVerifiedAt()
+	case user.FieldTokenVersion:
+		return m.TokenVersion()
 	}
 	return nil, false
 }
@@ -6224,223 +14977,522 @@ func (m *RootConfigMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RootConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case rootconfig.FieldEditingPermission:
-		return m.OldEditingPermission(ctx)
-	case rootconfig.FieldIsTestUserMode:
-		return m.OldIsTestUserMode(ctx)
-	case rootconfig.FieldIsEmailAuthenticationCheck:
-		return m.OldIsEmailAuthenticationCheck(ctx)
-	case rootconfig.FieldIsLineAuthentication:
-		return m.OldIsLineAuthentication(ctx)
-	case rootconfig.FieldUpdatedAt:
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
+	case user.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case user.FieldIsAdmin:
+		return m.OldIsAdmin(ctx)
+	case user.FieldIsRoot:
+		return m.OldIsRoot(ctx)
+	case user.FieldIsTest:
+		return m.OldIsTest(ctx)
+	case user.FieldEmailVerifiedAt:
+		return m.OldEmailVerifiedAt(ctx)
+	case user.FieldTokenVersion:
+		return m.OldTokenVersion(ctx)
 	}
-	return nil, fmt.Errorf("unknown RootConfig field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RootConfigMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case rootconfig.FieldEditingPermission:
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEditingPermission(v)
+		m.SetPassword(v)
 		return nil
-	case rootconfig.FieldIsTestUserMode:
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case user.FieldIsAdmin:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsTestUserMode(v)
+		m.SetIsAdmin(v)
 		return nil
-	case rootconfig.FieldIsEmailAuthenticationCheck:
+	case user.FieldIsRoot:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsEmailAuthenticationCheck(v)
+		m.SetIsRoot(v)
 		return nil
-	case rootconfig.FieldIsLineAuthentication:
+	case user.FieldIsTest:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsLineAuthentication(v)
+		m.SetIsTest(v)
 		return nil
-	case rootconfig.FieldUpdatedAt:
+	case user.FieldEmailVerifiedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetEmailVerifiedAt(v)
+		return nil
+	case user.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown RootConfig field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RootConfigMutation) AddedFields() []string {
-	return nil
+func (m *UserMutation) AddedFields() []string {
+	var fields []string
+	if m.addtoken_version != nil {
+		fields = append(fields, user.FieldTokenVersion)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RootConfigMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldTokenVersion:
+		return m.AddedTokenVersion()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RootConfigMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case user.FieldTokenVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenVersion(v)
+		return nil
 	}
-	return fmt.Errorf("unknown RootConfig numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RootConfigMutation) ClearedFields() []string {
-	return nil
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldEmail) {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.FieldCleared(user.FieldPassword) {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.FieldCleared(user.FieldDeletedAt) {
+		fields = append(fields, user.FieldDeletedAt)
+	}
+	if m.FieldCleared(user.FieldEmailVerifiedAt) {
+		fields = append(fields, user.FieldEmailVerifiedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RootConfigMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RootConfigMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown RootConfig nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ClearEmail()
+		return nil
+	case user.FieldPassword:
+		m.ClearPassword()
+		return nil
+	case user.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ClearEmailVerifiedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RootConfigMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case rootconfig.FieldEditingPermission:
-		m.ResetEditingPermission()
+	case user.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case rootconfig.FieldIsTestUserMode:
-		m.ResetIsTestUserMode()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case rootconfig.FieldIsEmailAuthenticationCheck:
-		m.ResetIsEmailAuthenticationCheck()
+	case user.FieldName:
+		m.ResetName()
 		return nil
-	case rootconfig.FieldIsLineAuthentication:
-		m.ResetIsLineAuthentication()
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case rootconfig.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
+	case user.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case user.FieldIsAdmin:
+		m.ResetIsAdmin()
+		return nil
+	case user.FieldIsRoot:
+		m.ResetIsRoot()
+		return nil
+	case user.FieldIsTest:
+		m.ResetIsTest()
+		return nil
+	case user.FieldEmailVerifiedAt:
+		m.ResetEmailVerifiedAt()
+		return nil
+	case user.FieldTokenVersion:
+		m.ResetTokenVersion()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.registered_words != nil {
+		edges = append(edges, user.EdgeRegisteredWords)
+	}
+	if m.quizzes != nil {
+		edges = append(edges, user.EdgeQuizzes)
+	}
+	if m.user_config != nil {
+		edges = append(edges, user.EdgeUserConfig)
+	}
+	if m.external_auths != nil {
+		edges = append(edges, user.EdgeExternalAuths)
+	}
+	if m.user_daily_usage != nil {
+		edges = append(edges, user.EdgeUserDailyUsage)
+	}
+	if m.decks != nil {
+		edges = append(edges, user.EdgeDecks)
+	}
+	if m.word_proposals != nil {
+		edges = append(edges, user.EdgeWordProposals)
+	}
+	if m.refresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeRegisteredWords:
+		ids := make([]ent.Value, 0, len(m.registered_words))
+		for id := range m.registered_words {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.quizzes))
+		for id := range m.quizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserConfig:
+		if id := m.user_config; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeExternalAuths:
+		ids := make([]ent.Value, 0, len(m.external_auths))
+		for id := range m.external_auths {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeUserDailyUsage:
+		if id := m.user_daily_usage; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeDecks:
+		ids := make([]ent.Value, 0, len(m.decks))
+		for id := range m.decks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWordProposals:
+		ids := make([]ent.Value, 0, len(m.word_proposals))
+		for id := range m.word_proposals {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.refresh_tokens))
+		for id := range m.refresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.removedregistered_words != nil {
+		edges = append(edges, user.EdgeRegisteredWords)
+	}
+	if m.removedquizzes != nil {
+		edges = append(edges, user.EdgeQuizzes)
+	}
+	if m.removedexternal_auths != nil {
+		edges = append(edges, user.EdgeExternalAuths)
+	}
+	if m.removeddecks != nil {
+		edges = append(edges, user.EdgeDecks)
+	}
+	if m.removedword_proposals != nil {
+		edges = append(edges, user.EdgeWordProposals)
+	}
+	if m.removedrefresh_tokens != nil {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeRegisteredWords:
+		ids := make([]ent.Value, 0, len(m.removedregistered_words))
+		for id := range m.removedregistered_words {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeQuizzes:
+		ids := make([]ent.Value, 0, len(m.removedquizzes))
+		for id := range m.removedquizzes {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeExternalAuths:
+		ids := make([]ent.Value, 0, len(m.removedexternal_auths))
+		for id := range m.removedexternal_auths {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeDecks:
+		ids := make([]ent.Value, 0, len(m.removeddecks))
+		for id := range m.removeddecks {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWordProposals:
+		ids := make([]ent.Value, 0, len(m.removedword_proposals))
+		for id := range m.removedword_proposals {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeRefreshTokens:
+		ids := make([]ent.Value, 0, len(m.removedrefresh_tokens))
+		for id := range m.removedrefresh_tokens {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return fmt.Errorf("unknown RootConfig field %s", name)
-}
-
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RootConfigMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *RootConfigMutation) AddedIDs(name string) []ent.Value {
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RootConfigMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
-	return edges
-}
-
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *RootConfigMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RootConfigMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 8)
+	if m.clearedregistered_words {
+		edges = append(edges, user.EdgeRegisteredWords)
+	}
+	if m.clearedquizzes {
+		edges = append(edges, user.EdgeQuizzes)
+	}
+	if m.cleareduser_config {
+		edges = append(edges, user.EdgeUserConfig)
+	}
+	if m.clearedexternal_auths {
+		edges = append(edges, user.EdgeExternalAuths)
+	}
+	if m.cleareduser_daily_usage {
+		edges = append(edges, user.EdgeUserDailyUsage)
+	}
+	if m.cleareddecks {
+		edges = append(edges, user.EdgeDecks)
+	}
+	if m.clearedword_proposals {
+		edges = append(edges, user.EdgeWordProposals)
+	}
+	if m.clearedrefresh_tokens {
+		edges = append(edges, user.EdgeRefreshTokens)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RootConfigMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeRegisteredWords:
+		return m.clearedregistered_words
+	case user.EdgeQuizzes:
+		return m.clearedquizzes
+	case user.EdgeUserConfig:
+		return m.cleareduser_config
+	case user.EdgeExternalAuths:
+		return m.clearedexternal_auths
+	case user.EdgeUserDailyUsage:
+		return m.cleareduser_daily_usage
+	case user.EdgeDecks:
+		return m.cleareddecks
+	case user.EdgeWordProposals:
+		return m.clearedword_proposals
+	case user.EdgeRefreshTokens:
+		return m.clearedrefresh_tokens
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RootConfigMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown RootConfig unique edge %s", name)
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgeUserConfig:
+		m.ClearUserConfig()
+		return nil
+	case user.EdgeUserDailyUsage:
+		m.ClearUserDailyUsage()
+		return nil
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *RootConfigMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown RootConfig edge %s", name)
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeRegisteredWords:
+		m.ResetRegisteredWords()
+		return nil
+	case user.EdgeQuizzes:
+		m.ResetQuizzes()
+		return nil
+	case user.EdgeUserConfig:
+		m.ResetUserConfig()
+		return nil
+	case user.EdgeExternalAuths:
+		m.ResetExternalAuths()
+		return nil
+	case user.EdgeUserDailyUsage:
+		m.ResetUserDailyUsage()
+		return nil
+	case user.EdgeDecks:
+		m.ResetDecks()
+		return nil
+	case user.EdgeWordProposals:
+		m.ResetWordProposals()
+		return nil
+	case user.EdgeRefreshTokens:
+		m.ResetRefreshTokens()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// UserConfigMutation represents an operation that mutates the UserConfig nodes in the graph.
+type UserConfigMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	email                   *string
-	password                *string
-	name                    *string
-	created_at              *time.Time
-	updated_at              *time.Time
-	deleted_at              *time.Time
-	isAdmin                 *bool
-	isRoot                  *bool
-	isTest                  *bool
-	clearedFields           map[string]struct{}
-	registered_words        map[int]struct{}
-	removedregistered_words map[int]struct{}
-	clearedregistered_words bool
-	quizzes                 map[int]struct{}
-	removedquizzes          map[int]struct{}
-	clearedquizzes          bool
-	user_config             *int
-	cleareduser_config      bool
-	external_auths          map[int]struct{}
-	removedexternal_auths   map[int]struct{}
-	clearedexternal_auths   bool
-	user_daily_usage        *int
-	cleareduser_daily_usage bool
-	done                    bool
-	oldValue                func(context.Context) (*User, error)
-	predicates              []predicate.User
+	op            Op
+	typ           string
+	id            *int
+	is_dark_mode  *bool
+	srs_algorithm *string
+	deleted_at    *time.Time
+	clearedFields map[string]struct{}
+	user          *int
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*UserConfig, error)
+	predicates    []predicate.UserConfig
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*UserConfigMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// userconfigOption allows management of the mutation configuration using functional options.
+type userconfigOption func(*UserConfigMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newUserConfigMutation creates new mutation for the UserConfig entity.
+func newUserConfigMutation(c config, op Op, opts ...userconfigOption) *UserConfigMutation {
+	m := &UserConfigMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUserConfig,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6449,20 +15501,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int) userOption {
-	return func(m *UserMutation) {
+// withUserConfigID sets the ID field of the mutation.
+func withUserConfigID(id int) userconfigOption {
+	return func(m *UserConfigMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UserConfig
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UserConfig, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UserConfig.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6471,10 +15523,10 @@ func withUserID(id int) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUserConfig sets the old UserConfig of the mutation.
+func withUserConfig(node *UserConfig) userconfigOption {
+	return func(m *UserConfigMutation) {
+		m.oldValue = func(context.Context) (*UserConfig, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6483,7 +15535,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UserConfigMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6491,7 +15543,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UserConfigMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6502,7 +15554,7 @@ func (m UserMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int, exists bool) {
+func (m *UserConfigMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6513,7 +15565,7 @@ func (m *UserMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *UserConfigMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6522,624 +15574,838 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserConfig.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetUserID sets the "user_id" field.
+func (m *UserConfigMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserConfigMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserConfig entity.
+// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserConfigMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserConfigMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetIsDarkMode sets the "is_dark_mode" field.
+func (m *UserConfigMutation) SetIsDarkMode(b bool) {
+	m.is_dark_mode = &b
+}
+
+// IsDarkMode returns the value of the "is_dark_mode" field in the mutation.
+func (m *UserConfigMutation) IsDarkMode() (r bool, exists bool) {
+	v := m.is_dark_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDarkMode returns the old "is_dark_mode" field's value of the UserConfig entity.
+// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserConfigMutation) OldIsDarkMode(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDarkMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDarkMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDarkMode: %w", err)
+	}
+	return oldValue.IsDarkMode, nil
+}
+
+// ResetIsDarkMode resets all changes to the "is_dark_mode" field.
+func (m *UserConfigMutation) ResetIsDarkMode() {
+	m.is_dark_mode = nil
+}
+
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (m *UserConfigMutation) SetSrsAlgorithm(s string) {
+	m.srs_algorithm = &s
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// SrsAlgorithm returns the value of the "srs_algorithm" field in the mutation.
+func (m *UserConfigMutation) SrsAlgorithm() (r string, exists bool) {
+	v := m.srs_algorithm
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSrsAlgorithm returns the old "srs_algorithm" field's value of the UserConfig entity.
+// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v *string, err error) {
+func (m *UserConfigMutation) OldSrsAlgorithm(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldSrsAlgorithm is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldSrsAlgorithm requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldSrsAlgorithm: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ClearEmail clears the value of the "email" field.
-func (m *UserMutation) ClearEmail() {
-	m.email = nil
-	m.clearedFields[user.FieldEmail] = struct{}{}
+	return oldValue.SrsAlgorithm, nil
 }
 
-// EmailCleared returns if the "email" field was cleared in this mutation.
-func (m *UserMutation) EmailCleared() bool {
-	_, ok := m.clearedFields[user.FieldEmail]
-	return ok
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-	delete(m.clearedFields, user.FieldEmail)
+// ResetSrsAlgorithm resets all changes to the "srs_algorithm" field.
+func (m *UserConfigMutation) ResetSrsAlgorithm() {
+	m.srs_algorithm = nil
 }
 
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// SetDeletedAt sets the "deleted_at" field.
+func (m *UserConfigMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *UserConfigMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldDeletedAt returns the old "deleted_at" field's value of the UserConfig entity.
+// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v *string, err error) {
+func (m *UserConfigMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
 	}
-	return oldValue.Password, nil
+	return oldValue.DeletedAt, nil
 }
 
-// ClearPassword clears the value of the "password" field.
-func (m *UserMutation) ClearPassword() {
-	m.password = nil
-	m.clearedFields[user.FieldPassword] = struct{}{}
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *UserConfigMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[userconfig.FieldDeletedAt] = struct{}{}
 }
 
-// PasswordCleared returns if the "password" field was cleared in this mutation.
-func (m *UserMutation) PasswordCleared() bool {
-	_, ok := m.clearedFields[user.FieldPassword]
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *UserConfigMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[userconfig.FieldDeletedAt]
 	return ok
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
-	delete(m.clearedFields, user.FieldPassword)
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *UserConfigMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, userconfig.FieldDeletedAt)
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserConfigMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userconfig.FieldUserID] = struct{}{}
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserConfigMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserConfigMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return *v, true
+	return
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+// ResetUser resets all changes to the "user" edge.
+func (m *UserConfigMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserConfigMutation builder.
+func (m *UserConfigMutation) Where(ps ...predicate.UserConfig) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserConfigMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserConfigMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserConfig, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserConfigMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserConfigMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserConfig).
+func (m *UserConfigMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserConfigMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.user != nil {
+		fields = append(fields, userconfig.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	if m.is_dark_mode != nil {
+		fields = append(fields, userconfig.FieldIsDarkMode)
+	}
+	if m.srs_algorithm != nil {
+		fields = append(fields, userconfig.FieldSrsAlgorithm)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, userconfig.FieldDeletedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserConfigMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case userconfig.FieldUserID:
+		return m.UserID()
+	case userconfig.FieldIsDarkMode:
+		return m.IsDarkMode()
+	case userconfig.FieldSrsAlgorithm:
+		return m.SrsAlgorithm()
+	case userconfig.FieldDeletedAt:
+		return m.DeletedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case userconfig.FieldUserID:
+		return m.OldUserID(ctx)
+	case userconfig.FieldIsDarkMode:
+		return m.OldIsDarkMode(ctx)
+	case userconfig.FieldSrsAlgorithm:
+		return m.OldSrsAlgorithm(ctx)
+	case userconfig.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown UserConfig field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserConfigMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case userconfig.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case userconfig.FieldIsDarkMode:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDarkMode(v)
+		return nil
+	case userconfig.FieldSrsAlgorithm:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSrsAlgorithm(v)
+		return nil
+	case userconfig.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown UserConfig field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserConfigMutation) AddedFields() []string {
+	var fields []string
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserConfigMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserConfigMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.Name, nil
+	return fmt.Errorf("unknown UserConfig numeric field %s", name)
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserConfigMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(userconfig.FieldDeletedAt) {
+		fields = append(fields, userconfig.FieldDeletedAt)
+	}
+	return fields
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserConfigMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserConfigMutation) ClearField(name string) error {
+	switch name {
+	case userconfig.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown UserConfig nullable field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserConfigMutation) ResetField(name string) error {
+	switch name {
+	case userconfig.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case userconfig.FieldIsDarkMode:
+		m.ResetIsDarkMode()
+		return nil
+	case userconfig.FieldSrsAlgorithm:
+		m.ResetSrsAlgorithm()
+		return nil
+	case userconfig.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	return fmt.Errorf("unknown UserConfig field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserConfigMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, userconfig.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserConfigMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case userconfig.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.CreatedAt, nil
+	return nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
-	m.created_at = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserConfigMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserConfigMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserConfigMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, userconfig.EdgeUser)
 	}
-	return *v, true
+	return edges
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserConfigMutation) EdgeCleared(name string) bool {
+	switch name {
+	case userconfig.EdgeUser:
+		return m.cleareduser
 	}
-	return oldValue.UpdatedAt, nil
+	return false
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserConfigMutation) ClearEdge(name string) error {
+	switch name {
+	case userconfig.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserConfig unique edge %s", name)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *UserMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserConfigMutation) ResetEdge(name string) error {
+	switch name {
+	case userconfig.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown UserConfig edge %s", name)
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// UserDailyUsageMutation represents an operation that mutates the UserDailyUsage nodes in the graph.
+type UserDailyUsageMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	last_reset_date *time.Time
+	quiz_count      *int
+	addquiz_count   *int
+	bulk_count      *int
+	addbulk_count   *int
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *int
+	cleareduser     bool
+	done            bool
+	oldValue        func(context.Context) (*UserDailyUsage, error)
+	predicates      []predicate.UserDailyUsage
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+var _ ent.Mutation = (*UserDailyUsageMutation)(nil)
+
+// userdailyusageOption allows management of the mutation configuration using functional options.
+type userdailyusageOption func(*UserDailyUsageMutation)
+
+// newUserDailyUsageMutation creates new mutation for the UserDailyUsage entity.
+func newUserDailyUsageMutation(c config, op Op, opts ...userdailyusageOption) *UserDailyUsageMutation {
+	m := &UserDailyUsageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserDailyUsage,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[user.FieldDeletedAt] = struct{}{}
+	return m
 }
 
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[user.FieldDeletedAt]
-	return ok
+// withUserDailyUsageID sets the ID field of the mutation.
+func withUserDailyUsageID(id int) userdailyusageOption {
+	return func(m *UserDailyUsageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserDailyUsage
+		)
+		m.oldValue = func(ctx context.Context) (*UserDailyUsage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserDailyUsage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, user.FieldDeletedAt)
+// withUserDailyUsage sets the old UserDailyUsage of the mutation.
+func withUserDailyUsage(node *UserDailyUsage) userdailyusageOption {
+	return func(m *UserDailyUsageMutation) {
+		m.oldValue = func(context.Context) (*UserDailyUsage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetIsAdmin sets the "isAdmin" field.
-func (m *UserMutation) SetIsAdmin(b bool) {
-	m.isAdmin = &b
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserDailyUsageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// IsAdmin returns the value of the "isAdmin" field in the mutation.
-func (m *UserMutation) IsAdmin() (r bool, exists bool) {
-	v := m.isAdmin
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserDailyUsageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldIsAdmin returns the old "isAdmin" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsAdmin(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsAdmin is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsAdmin requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsAdmin: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserDailyUsageMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.IsAdmin, nil
+	return *m.id, true
 }
 
-// ResetIsAdmin resets all changes to the "isAdmin" field.
-func (m *UserMutation) ResetIsAdmin() {
-	m.isAdmin = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserDailyUsageMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserDailyUsage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetIsRoot sets the "isRoot" field.
-func (m *UserMutation) SetIsRoot(b bool) {
-	m.isRoot = &b
+// SetUserID sets the "user_id" field.
+func (m *UserDailyUsageMutation) SetUserID(i int) {
+	m.user = &i
 }
 
-// IsRoot returns the value of the "isRoot" field in the mutation.
-func (m *UserMutation) IsRoot() (r bool, exists bool) {
-	v := m.isRoot
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserDailyUsageMutation) UserID() (r int, exists bool) {
+	v := m.user
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsRoot returns the old "isRoot" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserDailyUsage entity.
+// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsRoot(ctx context.Context) (v bool, err error) {
+func (m *UserDailyUsageMutation) OldUserID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsRoot is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsRoot requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsRoot: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.IsRoot, nil
+	return oldValue.UserID, nil
 }
 
-// ResetIsRoot resets all changes to the "isRoot" field.
-func (m *UserMutation) ResetIsRoot() {
-	m.isRoot = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserDailyUsageMutation) ResetUserID() {
+	m.user = nil
 }
 
-// SetIsTest sets the "isTest" field.
-func (m *UserMutation) SetIsTest(b bool) {
-	m.isTest = &b
+// SetLastResetDate sets the "last_reset_date" field.
+func (m *UserDailyUsageMutation) SetLastResetDate(t time.Time) {
+	m.last_reset_date = &t
 }
 
-// IsTest returns the value of the "isTest" field in the mutation.
-func (m *UserMutation) IsTest() (r bool, exists bool) {
-	v := m.isTest
+// LastResetDate returns the value of the "last_reset_date" field in the mutation.
+func (m *UserDailyUsageMutation) LastResetDate() (r time.Time, exists bool) {
+	v := m.last_reset_date
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsTest returns the old "isTest" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastResetDate returns the old "last_reset_date" field's value of the UserDailyUsage entity.
+// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsTest(ctx context.Context) (v bool, err error) {
+func (m *UserDailyUsageMutation) OldLastResetDate(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsTest is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastResetDate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsTest requires an ID field in the mutation")
+		return v, errors.New("OldLastResetDate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsTest: %w", err)
-	}
-	return oldValue.IsTest, nil
-}
-
-// ResetIsTest resets all changes to the "isTest" field.
-func (m *UserMutation) ResetIsTest() {
-	m.isTest = nil
-}
-
-// AddRegisteredWordIDs adds the "registered_words" edge to the RegisteredWord entity by ids.
-func (m *UserMutation) AddRegisteredWordIDs(ids ...int) {
-	if m.registered_words == nil {
-		m.registered_words = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.registered_words[ids[i]] = struct{}{}
-	}
-}
-
-// ClearRegisteredWords clears the "registered_words" edge to the RegisteredWord entity.
-func (m *UserMutation) ClearRegisteredWords() {
-	m.clearedregistered_words = true
-}
-
-// RegisteredWordsCleared reports if the "registered_words" edge to the RegisteredWord entity was cleared.
-func (m *UserMutation) RegisteredWordsCleared() bool {
-	return m.clearedregistered_words
-}
-
-// RemoveRegisteredWordIDs removes the "registered_words" edge to the RegisteredWord entity by IDs.
-func (m *UserMutation) RemoveRegisteredWordIDs(ids ...int) {
-	if m.removedregistered_words == nil {
-		m.removedregistered_words = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.registered_words, ids[i])
-		m.removedregistered_words[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedRegisteredWords returns the removed IDs of the "registered_words" edge to the RegisteredWord entity.
-func (m *UserMutation) RemovedRegisteredWordsIDs() (ids []int) {
-	for id := range m.removedregistered_words {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// RegisteredWordsIDs returns the "registered_words" edge IDs in the mutation.
-func (m *UserMutation) RegisteredWordsIDs() (ids []int) {
-	for id := range m.registered_words {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetRegisteredWords resets all changes to the "registered_words" edge.
-func (m *UserMutation) ResetRegisteredWords() {
-	m.registered_words = nil
-	m.clearedregistered_words = false
-	m.removedregistered_words = nil
-}
-
-// AddQuizIDs adds the "quizzes" edge to the Quiz entity by ids.
-func (m *UserMutation) AddQuizIDs(ids ...int) {
-	if m.quizzes == nil {
-		m.quizzes = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.quizzes[ids[i]] = struct{}{}
+		return v, fmt.Errorf("querying old value for OldLastResetDate: %w", err)
 	}
+	return oldValue.LastResetDate, nil
 }
 
-// ClearQuizzes clears the "quizzes" edge to the Quiz entity.
-func (m *UserMutation) ClearQuizzes() {
-	m.clearedquizzes = true
+// ResetLastResetDate resets all changes to the "last_reset_date" field.
+func (m *UserDailyUsageMutation) ResetLastResetDate() {
+	m.last_reset_date = nil
 }
 
-// QuizzesCleared reports if the "quizzes" edge to the Quiz entity was cleared.
-func (m *UserMutation) QuizzesCleared() bool {
-	return m.clearedquizzes
+// SetQuizCount sets the "quiz_count" field.
+func (m *UserDailyUsageMutation) SetQuizCount(i int) {
+	m.quiz_count = &i
+	m.addquiz_count = nil
 }
 
-// RemoveQuizIDs removes the "quizzes" edge to the Quiz entity by IDs.
-func (m *UserMutation) RemoveQuizIDs(ids ...int) {
-	if m.removedquizzes == nil {
-		m.removedquizzes = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.quizzes, ids[i])
-		m.removedquizzes[ids[i]] = struct{}{}
+// QuizCount returns the value of the "quiz_count" field in the mutation.
+func (m *UserDailyUsageMutation) QuizCount() (r int, exists bool) {
+	v := m.quiz_count
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedQuizzes returns the removed IDs of the "quizzes" edge to the Quiz entity.
-func (m *UserMutation) RemovedQuizzesIDs() (ids []int) {
-	for id := range m.removedquizzes {
-		ids = append(ids, id)
+// OldQuizCount returns the old "quiz_count" field's value of the UserDailyUsage entity.
+// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDailyUsageMutation) OldQuizCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuizCount is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// QuizzesIDs returns the "quizzes" edge IDs in the mutation.
-func (m *UserMutation) QuizzesIDs() (ids []int) {
-	for id := range m.quizzes {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuizCount requires an ID field in the mutation")
 	}
-	return
-}
-
-// ResetQuizzes resets all changes to the "quizzes" edge.
-func (m *UserMutation) ResetQuizzes() {
-	m.quizzes = nil
-	m.clearedquizzes = false
-	m.removedquizzes = nil
-}
-
-// SetUserConfigID sets the "user_config" edge to the UserConfig entity by id.
-func (m *UserMutation) SetUserConfigID(id int) {
-	m.user_config = &id
-}
-
-// ClearUserConfig clears the "user_config" edge to the UserConfig entity.
-func (m *UserMutation) ClearUserConfig() {
-	m.cleareduser_config = true
-}
-
-// UserConfigCleared reports if the "user_config" edge to the UserConfig entity was cleared.
-func (m *UserMutation) UserConfigCleared() bool {
-	return m.cleareduser_config
-}
-
-// UserConfigID returns the "user_config" edge ID in the mutation.
-func (m *UserMutation) UserConfigID() (id int, exists bool) {
-	if m.user_config != nil {
-		return *m.user_config, true
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuizCount: %w", err)
 	}
-	return
+	return oldValue.QuizCount, nil
 }
 
-// UserConfigIDs returns the "user_config" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserConfigID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) UserConfigIDs() (ids []int) {
-	if id := m.user_config; id != nil {
-		ids = append(ids, *id)
+// AddQuizCount adds i to the "quiz_count" field.
+func (m *UserDailyUsageMutation) AddQuizCount(i int) {
+	if m.addquiz_count != nil {
+		*m.addquiz_count += i
+	} else {
+		m.addquiz_count = &i
 	}
-	return
 }
 
-// ResetUserConfig resets all changes to the "user_config" edge.
-func (m *UserMutation) ResetUserConfig() {
-	m.user_config = nil
-	m.cleareduser_config = false
+// AddedQuizCount returns the value that was added to the "quiz_count" field in this mutation.
+func (m *UserDailyUsageMutation) AddedQuizCount() (r int, exists bool) {
+	v := m.addquiz_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// AddExternalAuthIDs adds the "external_auths" edge to the ExternalAuth entity by ids.
-func (m *UserMutation) AddExternalAuthIDs(ids ...int) {
-	if m.external_auths == nil {
-		m.external_auths = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.external_auths[ids[i]] = struct{}{}
-	}
+// ResetQuizCount resets all changes to the "quiz_count" field.
+func (m *UserDailyUsageMutation) ResetQuizCount() {
+	m.quiz_count = nil
+	m.addquiz_count = nil
 }
 
-// ClearExternalAuths clears the "external_auths" edge to the ExternalAuth entity.
-func (m *UserMutation) ClearExternalAuths() {
-	m.clearedexternal_auths = true
+// SetBulkCount sets the "bulk_count" field.
+func (m *UserDailyUsageMutation) SetBulkCount(i int) {
+	m.bulk_count = &i
+	m.addbulk_count = nil
 }
 
-// ExternalAuthsCleared reports if the "external_auths" edge to the ExternalAuth entity was cleared.
-func (m *UserMutation) ExternalAuthsCleared() bool {
-	return m.clearedexternal_auths
+// BulkCount returns the value of the "bulk_count" field in the mutation.
+func (m *UserDailyUsageMutation) BulkCount() (r int, exists bool) {
+	v := m.bulk_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveExternalAuthIDs removes the "external_auths" edge to the ExternalAuth entity by IDs.
-func (m *UserMutation) RemoveExternalAuthIDs(ids ...int) {
-	if m.removedexternal_auths == nil {
-		m.removedexternal_auths = make(map[int]struct{})
+// OldBulkCount returns the old "bulk_count" field's value of the UserDailyUsage entity.
+// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDailyUsageMutation) OldBulkCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBulkCount is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.external_auths, ids[i])
-		m.removedexternal_auths[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBulkCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBulkCount: %w", err)
 	}
+	return oldValue.BulkCount, nil
 }
 
-// RemovedExternalAuths returns the removed IDs of the "external_auths" edge to the ExternalAuth entity.
-func (m *UserMutation) RemovedExternalAuthsIDs() (ids []int) {
-	for id := range m.removedexternal_auths {
-		ids = append(ids, id)
+// AddBulkCount adds i to the "bulk_count" field.
+func (m *UserDailyUsageMutation) AddBulkCount(i int) {
+	if m.addbulk_count != nil {
+		*m.addbulk_count += i
+	} else {
+		m.addbulk_count = &i
 	}
-	return
 }
 
-// ExternalAuthsIDs returns the "external_auths" edge IDs in the mutation.
-func (m *UserMutation) ExternalAuthsIDs() (ids []int) {
-	for id := range m.external_auths {
-		ids = append(ids, id)
+// AddedBulkCount returns the value that was added to the "bulk_count" field in this mutation.
+func (m *UserDailyUsageMutation) AddedBulkCount() (r int, exists bool) {
+	v := m.addbulk_count
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetExternalAuths resets all changes to the "external_auths" edge.
-func (m *UserMutation) ResetExternalAuths() {
-	m.external_auths = nil
-	m.clearedexternal_auths = false
-	m.removedexternal_auths = nil
+// ResetBulkCount resets all changes to the "bulk_count" field.
+func (m *UserDailyUsageMutation) ResetBulkCount() {
+	m.bulk_count = nil
+	m.addbulk_count = nil
 }
 
-// SetUserDailyUsageID sets the "user_daily_usage" edge to the UserDailyUsage entity by id.
-func (m *UserMutation) SetUserDailyUsageID(id int) {
-	m.user_daily_usage = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserDailyUsageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearUserDailyUsage clears the "user_daily_usage" edge to the UserDailyUsage entity.
-func (m *UserMutation) ClearUserDailyUsage() {
-	m.cleareduser_daily_usage = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserDailyUsageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// UserDailyUsageCleared reports if the "user_daily_usage" edge to the UserDailyUsage entity was cleared.
-func (m *UserMutation) UserDailyUsageCleared() bool {
-	return m.cleareduser_daily_usage
+// OldUpdatedAt returns the old "updated_at" field's value of the UserDailyUsage entity.
+// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserDailyUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// UserDailyUsageID returns the "user_daily_usage" edge ID in the mutation.
-func (m *UserMutation) UserDailyUsageID() (id int, exists bool) {
-	if m.user_daily_usage != nil {
-		return *m.user_daily_usage, true
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserDailyUsageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// UserDailyUsageIDs returns the "user_daily_usage" edge IDs in the mutation.
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserDailyUsageMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userdailyusage.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserDailyUsageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserDailyUsageID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) UserDailyUsageIDs() (ids []int) {
-	if id := m.user_daily_usage; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserDailyUsageMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUserDailyUsage resets all changes to the "user_daily_usage" edge.
-func (m *UserMutation) ResetUserDailyUsage() {
-	m.user_daily_usage = nil
-	m.cleareduser_daily_usage = false
+// ResetUser resets all changes to the "user" edge.
+func (m *UserDailyUsageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the UserDailyUsageMutation builder.
+func (m *UserDailyUsageMutation) Where(ps ...predicate.UserDailyUsage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserDailyUsageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserDailyUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserDailyUsage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7147,51 +16413,39 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserDailyUsageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserDailyUsageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserDailyUsage).
+func (m *UserDailyUsageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+func (m *UserDailyUsageMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user != nil {
+		fields = append(fields, userdailyusage.FieldUserID)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.last_reset_date != nil {
+		fields = append(fields, userdailyusage.FieldLastResetDate)
 	}
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
+	if m.quiz_count != nil {
+		fields = append(fields, userdailyusage.FieldQuizCount)
 	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+	if m.bulk_count != nil {
+		fields = append(fields, userdailyusage.FieldBulkCount)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
-	}
-	if m.deleted_at != nil {
-		fields = append(fields, user.FieldDeletedAt)
-	}
-	if m.isAdmin != nil {
-		fields = append(fields, user.FieldIsAdmin)
-	}
-	if m.isRoot != nil {
-		fields = append(fields, user.FieldIsRoot)
-	}
-	if m.isTest != nil {
-		fields = append(fields, user.FieldIsTest)
+		fields = append(fields, userdailyusage.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7199,26 +16453,18 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserDailyUsageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldName:
-		return m.Name()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case user.FieldDeletedAt:
-		return m.DeletedAt()
-	case user.FieldIsAdmin:
-		return m.IsAdmin()
-	case user.FieldIsRoot:
-		return m.IsRoot()
-	case user.FieldIsTest:
-		return m.IsTest()
+	case userdailyusage.FieldUserID:
+		return m.UserID()
+	case userdailyusage.FieldLastResetDate:
+		return m.LastResetDate()
+	case userdailyusage.FieldQuizCount:
+		return m.QuizCount()
+	case userdailyusage.FieldBulkCount:
+		return m.BulkCount()
+	case userdailyusage.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7226,398 +16472,276 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserDailyUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case userdailyusage.FieldUserID:
+		return m.OldUserID(ctx)
+	case userdailyusage.FieldLastResetDate:
+		return m.OldLastResetDate(ctx)
+	case userdailyusage.FieldQuizCount:
+		return m.OldQuizCount(ctx)
+	case userdailyusage.FieldBulkCount:
+		return m.OldBulkCount(ctx)
+	case userdailyusage.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case user.FieldIsAdmin:
-		return m.OldIsAdmin(ctx)
-	case user.FieldIsRoot:
-		return m.OldIsRoot(ctx)
-	case user.FieldIsTest:
-		return m.OldIsTest(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserDailyUsage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserDailyUsageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldEmail:
-		v, ok := value.(string)
+	case userdailyusage.FieldUserID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case userdailyusage.FieldLastResetDate:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetLastResetDate(v)
 		return nil
-	case user.FieldName:
-		v, ok := value.(string)
+	case userdailyusage.FieldQuizCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetQuizCount(v)
 		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case userdailyusage.FieldBulkCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetBulkCount(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case userdailyusage.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	case user.FieldIsAdmin:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsAdmin(v)
-		return nil
-	case user.FieldIsRoot:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsRoot(v)
-		return nil
-	case user.FieldIsTest:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsTest(v)
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserDailyUsage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+func (m *UserDailyUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addquiz_count != nil {
+		fields = append(fields, userdailyusage.FieldQuizCount)
+	}
+	if m.addbulk_count != nil {
+		fields = append(fields, userdailyusage.FieldBulkCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserDailyUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case userdailyusage.FieldQuizCount:
+		return m.AddedQuizCount()
+	case userdailyusage.FieldBulkCount:
+		return m.AddedBulkCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserDailyUsageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case userdailyusage.FieldQuizCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuizCount(v)
+		return nil
+	case userdailyusage.FieldBulkCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddBulkCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserDailyUsage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldEmail) {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.FieldCleared(user.FieldPassword) {
-		fields = append(fields, user.FieldPassword)
-	}
-	if m.FieldCleared(user.FieldDeletedAt) {
-		fields = append(fields, user.FieldDeletedAt)
-	}
-	return fields
+func (m *UserDailyUsageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserDailyUsageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldEmail:
-		m.ClearEmail()
-		return nil
-	case user.FieldPassword:
-		m.ClearPassword()
-		return nil
-	case user.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserDailyUsageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown UserDailyUsage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserDailyUsageMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
+	case userdailyusage.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case userdailyusage.FieldLastResetDate:
+		m.ResetLastResetDate()
 		return nil
-	case user.FieldName:
-		m.ResetName()
+	case userdailyusage.FieldQuizCount:
+		m.ResetQuizCount()
 		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case userdailyusage.FieldBulkCount:
+		m.ResetBulkCount()
 		return nil
-	case user.FieldUpdatedAt:
+	case userdailyusage.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	case user.FieldIsAdmin:
-		m.ResetIsAdmin()
-		return nil
-	case user.FieldIsRoot:
-		m.ResetIsRoot()
-		return nil
-	case user.FieldIsTest:
-		m.ResetIsTest()
-		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserDailyUsage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.registered_words != nil {
-		edges = append(edges, user.EdgeRegisteredWords)
-	}
-	if m.quizzes != nil {
-		edges = append(edges, user.EdgeQuizzes)
-	}
-	if m.user_config != nil {
-		edges = append(edges, user.EdgeUserConfig)
-	}
-	if m.external_auths != nil {
-		edges = append(edges, user.EdgeExternalAuths)
-	}
-	if m.user_daily_usage != nil {
-		edges = append(edges, user.EdgeUserDailyUsage)
+func (m *UserDailyUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, userdailyusage.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *UserDailyUsageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeRegisteredWords:
-		ids := make([]ent.Value, 0, len(m.registered_words))
-		for id := range m.registered_words {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeQuizzes:
-		ids := make([]ent.Value, 0, len(m.quizzes))
-		for id := range m.quizzes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUserConfig:
-		if id := m.user_config; id != nil {
-			return []ent.Value{*id}
-		}
-	case user.EdgeExternalAuths:
-		ids := make([]ent.Value, 0, len(m.external_auths))
-		for id := range m.external_auths {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeUserDailyUsage:
-		if id := m.user_daily_usage; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
-}
-
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedregistered_words != nil {
-		edges = append(edges, user.EdgeRegisteredWords)
-	}
-	if m.removedquizzes != nil {
-		edges = append(edges, user.EdgeQuizzes)
-	}
-	if m.removedexternal_auths != nil {
-		edges = append(edges, user.EdgeExternalAuths)
+	case userdailyusage.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserDailyUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeRegisteredWords:
-		ids := make([]ent.Value, 0, len(m.removedregistered_words))
-		for id := range m.removedregistered_words {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeQuizzes:
-		ids := make([]ent.Value, 0, len(m.removedquizzes))
-		for id := range m.removedquizzes {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeExternalAuths:
-		ids := make([]ent.Value, 0, len(m.removedexternal_auths))
-		for id := range m.removedexternal_auths {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserDailyUsageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.clearedregistered_words {
-		edges = append(edges, user.EdgeRegisteredWords)
-	}
-	if m.clearedquizzes {
-		edges = append(edges, user.EdgeQuizzes)
-	}
-	if m.cleareduser_config {
-		edges = append(edges, user.EdgeUserConfig)
-	}
-	if m.clearedexternal_auths {
-		edges = append(edges, user.EdgeExternalAuths)
-	}
-	if m.cleareduser_daily_usage {
-		edges = append(edges, user.EdgeUserDailyUsage)
+func (m *UserDailyUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, userdailyusage.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *UserDailyUsageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeRegisteredWords:
-		return m.clearedregistered_words
-	case user.EdgeQuizzes:
-		return m.clearedquizzes
-	case user.EdgeUserConfig:
-		return m.cleareduser_config
-	case user.EdgeExternalAuths:
-		return m.clearedexternal_auths
-	case user.EdgeUserDailyUsage:
-		return m.cleareduser_daily_usage
+	case userdailyusage.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *UserDailyUsageMutation) ClearEdge(name string) error {
 	switch name {
-	case user.EdgeUserConfig:
-		m.ClearUserConfig()
-		return nil
-	case user.EdgeUserDailyUsage:
-		m.ClearUserDailyUsage()
+	case userdailyusage.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown UserDailyUsage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *UserDailyUsageMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeRegisteredWords:
-		m.ResetRegisteredWords()
-		return nil
-	case user.EdgeQuizzes:
-		m.ResetQuizzes()
-		return nil
-	case user.EdgeUserConfig:
-		m.ResetUserConfig()
-		return nil
-	case user.EdgeExternalAuths:
-		m.ResetExternalAuths()
-		return nil
-	case user.EdgeUserDailyUsage:
-		m.ResetUserDailyUsage()
+	case userdailyusage.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown UserDailyUsage edge %s", name)
 }
 
-// UserConfigMutation represents an operation that mutates the UserConfig nodes in the graph.
-type UserConfigMutation struct {
+// WordMutation represents an operation that mutates the Word nodes in the graph.
+type WordMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	is_dark_mode  *bool
-	deleted_at    *time.Time
-	clearedFields map[string]struct{}
-	user          *int
-	cleareduser   bool
-	done          bool
-	oldValue      func(context.Context) (*UserConfig, error)
-	predicates    []predicate.UserConfig
+	op                      Op
+	typ                     string
+	id                      *int
+	name                    *string
+	voice_id                *string
+	is_idioms               *bool
+	is_special_characters   *bool
+	registration_count      *int
+	addregistration_count   *int
+	frequency_rank          *int
+	addfrequency_rank       *int
+	created_at              *time.Time
+	updated_at              *time.Time
+	clearedFields           map[string]struct{}
+	word_infos              map[int]struct{}
+	removedword_infos       map[int]struct{}
+	clearedword_infos       bool
+	registered_words        map[int]struct{}
+	removedregistered_words map[int]struct{}
+	clearedregistered_words bool
+	quiz_questions          map[int]struct{}
+	removedquiz_questions   map[int]struct{}
+	clearedquiz_questions   bool
+	deck_words              map[int]struct{}
+	removeddeck_words       map[int]struct{}
+	cleareddeck_words       bool
+	done                    bool
+	oldValue                func(context.Context) (*Word, error)
+	predicates              []predicate.Word
 }
 
-var _ ent.Mutation = (*UserConfigMutation)(nil)
+var _ ent.Mutation = (*WordMutation)(nil)
 
-// userconfigOption allows management of the mutation configuration using functional options.
-type userconfigOption func(*UserConfigMutation)
+// wordOption allows management of the mutation configuration using functional options.
+type wordOption func(*WordMutation)
 
-// newUserConfigMutation creates new mutation for the UserConfig entity.
-func newUserConfigMutation(c config, op Op, opts ...userconfigOption) *UserConfigMutation {
-	m := &UserConfigMutation{
+// newWordMutation creates new mutation for the Word entity.
+func newWordMutation(c config, op Op, opts ...wordOption) *WordMutation {
+	m := &WordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUserConfig,
+		typ:           TypeWord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -7626,20 +16750,20 @@ func newUserConfigMutation(c config, op Op, opts ...userconfigOption) *UserConfi
 	return m
 }
 
-// withUserConfigID sets the ID field of the mutation.
-func withUserConfigID(id int) userconfigOption {
-	return func(m *UserConfigMutation) {
+// withWordID sets the ID field of the mutation.
+func withWordID(id int) wordOption {
+	return func(m *WordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *UserConfig
+			value *Word
 		)
-		m.oldValue = func(ctx context.Context) (*UserConfig, error) {
+		m.oldValue = func(ctx context.Context) (*Word, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().UserConfig.Get(ctx, id)
+					value, err = m.Client().Word.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -7648,10 +16772,10 @@ func withUserConfigID(id int) userconfigOption {
 	}
 }
 
-// withUserConfig sets the old UserConfig of the mutation.
-func withUserConfig(node *UserConfig) userconfigOption {
-	return func(m *UserConfigMutation) {
-		m.oldValue = func(context.Context) (*UserConfig, error) {
+// withWord sets the old Word of the mutation.
+func withWord(node *Word) wordOption {
+	return func(m *WordMutation) {
+		m.oldValue = func(context.Context) (*Word, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -7660,7 +16784,7 @@ func withUserConfig(node *UserConfig) userconfigOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserConfigMutation) Client() *Client {
+func (m WordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -7668,7 +16792,7 @@ func (m UserConfigMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserConfigMutation) Tx() (*Tx, error) {
+func (m WordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -7679,7 +16803,7 @@ func (m UserConfigMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserConfigMutation) ID() (id int, exists bool) {
+func (m *WordMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7690,7 +16814,7 @@ func (m *UserConfigMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserConfigMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WordMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7699,785 +16823,592 @@ func (m *UserConfigMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserConfig.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Word.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserConfigMutation) SetUserID(i int) {
-	m.user = &i
-}
-
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserConfigMutation) UserID() (r int, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldUserID returns the old "user_id" field's value of the UserConfig entity.
-// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserConfigMutation) OldUserID(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
-	}
-	return oldValue.UserID, nil
-}
-
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserConfigMutation) ResetUserID() {
-	m.user = nil
-}
-
-// SetIsDarkMode sets the "is_dark_mode" field.
-func (m *UserConfigMutation) SetIsDarkMode(b bool) {
-	m.is_dark_mode = &b
-}
-
-// IsDarkMode returns the value of the "is_dark_mode" field in the mutation.
-func (m *UserConfigMutation) IsDarkMode() (r bool, exists bool) {
-	v := m.is_dark_mode
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldIsDarkMode returns the old "is_dark_mode" field's value of the UserConfig entity.
-// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserConfigMutation) OldIsDarkMode(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsDarkMode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsDarkMode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsDarkMode: %w", err)
-	}
-	return oldValue.IsDarkMode, nil
-}
-
-// ResetIsDarkMode resets all changes to the "is_dark_mode" field.
-func (m *UserConfigMutation) ResetIsDarkMode() {
-	m.is_dark_mode = nil
-}
-
-// SetDeletedAt sets the "deleted_at" field.
-func (m *UserConfigMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetName sets the "name" field.
+func (m *WordMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *UserConfigMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// Name returns the value of the "name" field in the mutation.
+func (m *WordMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the UserConfig entity.
-// If the UserConfig object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserConfigMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *WordMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
-	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *UserConfigMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[userconfig.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *UserConfigMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[userconfig.FieldDeletedAt]
-	return ok
-}
-
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *UserConfigMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, userconfig.FieldDeletedAt)
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserConfigMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[userconfig.FieldUserID] = struct{}{}
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserConfigMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserConfigMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return
+	return oldValue.Name, nil
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserConfigMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetName resets all changes to the "name" field.
+func (m *WordMutation) ResetName() {
+	m.name = nil
 }
 
-// Where appends a list predicates to the UserConfigMutation builder.
-func (m *UserConfigMutation) Where(ps ...predicate.UserConfig) {
-	m.predicates = append(m.predicates, ps...)
+// SetVoiceID sets the "voice_id" field.
+func (m *WordMutation) SetVoiceID(s string) {
+	m.voice_id = &s
 }
 
-// WhereP appends storage-level predicates to the UserConfigMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserConfigMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserConfig, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// VoiceID returns the value of the "voice_id" field in the mutation.
+func (m *WordMutation) VoiceID() (r string, exists bool) {
+	v := m.voice_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *UserConfigMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *UserConfigMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (UserConfig).
-func (m *UserConfigMutation) Type() string {
-	return m.typ
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserConfigMutation) Fields() []string {
-	fields := make([]string, 0, 3)
-	if m.user != nil {
-		fields = append(fields, userconfig.FieldUserID)
+// OldVoiceID returns the old "voice_id" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldVoiceID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVoiceID is only allowed on UpdateOne operations")
 	}
-	if m.is_dark_mode != nil {
-		fields = append(fields, userconfig.FieldIsDarkMode)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVoiceID requires an ID field in the mutation")
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, userconfig.FieldDeletedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVoiceID: %w", err)
 	}
-	return fields
+	return oldValue.VoiceID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserConfigMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case userconfig.FieldUserID:
-		return m.UserID()
-	case userconfig.FieldIsDarkMode:
-		return m.IsDarkMode()
-	case userconfig.FieldDeletedAt:
-		return m.DeletedAt()
-	}
-	return nil, false
+// ClearVoiceID clears the value of the "voice_id" field.
+func (m *WordMutation) ClearVoiceID() {
+	m.voice_id = nil
+	m.clearedFields[word.FieldVoiceID] = struct{}{}
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserConfigMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case userconfig.FieldUserID:
-		return m.OldUserID(ctx)
-	case userconfig.FieldIsDarkMode:
-		return m.OldIsDarkMode(ctx)
-	case userconfig.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown UserConfig field %s", name)
+// VoiceIDCleared returns if the "voice_id" field was cleared in this mutation.
+func (m *WordMutation) VoiceIDCleared() bool {
+	_, ok := m.clearedFields[word.FieldVoiceID]
+	return ok
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserConfigMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case userconfig.FieldUserID:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case userconfig.FieldIsDarkMode:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsDarkMode(v)
-		return nil
-	case userconfig.FieldDeletedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDeletedAt(v)
-		return nil
-	}
-	return fmt.Errorf("unknown UserConfig field %s", name)
+// ResetVoiceID resets all changes to the "voice_id" field.
+func (m *WordMutation) ResetVoiceID() {
+	m.voice_id = nil
+	delete(m.clearedFields, word.FieldVoiceID)
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UserConfigMutation) AddedFields() []string {
-	var fields []string
-	return fields
+// SetIsIdioms sets the "is_idioms" field.
+func (m *WordMutation) SetIsIdioms(b bool) {
+	m.is_idioms = &b
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UserConfigMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
+// IsIdioms returns the value of the "is_idioms" field in the mutation.
+func (m *WordMutation) IsIdioms() (r bool, exists bool) {
+	v := m.is_idioms
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserConfigMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldIsIdioms returns the old "is_idioms" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldIsIdioms(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsIdioms is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown UserConfig numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UserConfigMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(userconfig.FieldDeletedAt) {
-		fields = append(fields, userconfig.FieldDeletedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsIdioms requires an ID field in the mutation")
 	}
-	return fields
-}
-
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UserConfigMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UserConfigMutation) ClearField(name string) error {
-	switch name {
-	case userconfig.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsIdioms: %w", err)
 	}
-	return fmt.Errorf("unknown UserConfig nullable field %s", name)
+	return oldValue.IsIdioms, nil
+}
+
+// ResetIsIdioms resets all changes to the "is_idioms" field.
+func (m *WordMutation) ResetIsIdioms() {
+	m.is_idioms = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UserConfigMutation) ResetField(name string) error {
-	switch name {
-	case userconfig.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case userconfig.FieldIsDarkMode:
-		m.ResetIsDarkMode()
-		return nil
-	case userconfig.FieldDeletedAt:
-		m.ResetDeletedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown UserConfig field %s", name)
+// SetIsSpecialCharacters sets the "is_special_characters" field.
+func (m *WordMutation) SetIsSpecialCharacters(b bool) {
+	m.is_special_characters = &b
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserConfigMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, userconfig.EdgeUser)
+// IsSpecialCharacters returns the value of the "is_special_characters" field in the mutation.
+func (m *WordMutation) IsSpecialCharacters() (r bool, exists bool) {
+	v := m.is_special_characters
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UserConfigMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case userconfig.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
+// OldIsSpecialCharacters returns the old "is_special_characters" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldIsSpecialCharacters(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSpecialCharacters is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSpecialCharacters requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSpecialCharacters: %w", err)
+	}
+	return oldValue.IsSpecialCharacters, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserConfigMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetIsSpecialCharacters resets all changes to the "is_special_characters" field.
+func (m *WordMutation) ResetIsSpecialCharacters() {
+	m.is_special_characters = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserConfigMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetRegistrationCount sets the "registration_count" field.
+func (m *WordMutation) SetRegistrationCount(i int) {
+	m.registration_count = &i
+	m.addregistration_count = nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserConfigMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, userconfig.EdgeUser)
+// RegistrationCount returns the value of the "registration_count" field in the mutation.
+func (m *WordMutation) RegistrationCount() (r int, exists bool) {
+	v := m.registration_count
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserConfigMutation) EdgeCleared(name string) bool {
-	switch name {
-	case userconfig.EdgeUser:
-		return m.cleareduser
+// OldRegistrationCount returns the old "registration_count" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldRegistrationCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRegistrationCount is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRegistrationCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRegistrationCount: %w", err)
+	}
+	return oldValue.RegistrationCount, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserConfigMutation) ClearEdge(name string) error {
-	switch name {
-	case userconfig.EdgeUser:
-		m.ClearUser()
-		return nil
+// AddRegistrationCount adds i to the "registration_count" field.
+func (m *WordMutation) AddRegistrationCount(i int) {
+	if m.addregistration_count != nil {
+		*m.addregistration_count += i
+	} else {
+		m.addregistration_count = &i
 	}
-	return fmt.Errorf("unknown UserConfig unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserConfigMutation) ResetEdge(name string) error {
-	switch name {
-	case userconfig.EdgeUser:
-		m.ResetUser()
-		return nil
+// AddedRegistrationCount returns the value that was added to the "registration_count" field in this mutation.
+func (m *WordMutation) AddedRegistrationCount() (r int, exists bool) {
+	v := m.addregistration_count
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown UserConfig edge %s", name)
+	return *v, true
 }
 
-// UserDailyUsageMutation represents an operation that mutates the UserDailyUsage nodes in the graph.
-type UserDailyUsageMutation struct {
-	config
-	op              Op
-	typ             string
-	id              *int
-	last_reset_date *time.Time
-	quiz_count      *int
-	addquiz_count   *int
-	bulk_count      *int
-	addbulk_count   *int
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	user            *int
-	cleareduser     bool
-	done            bool
-	oldValue        func(context.Context) (*UserDailyUsage, error)
-	predicates      []predicate.UserDailyUsage
+// ResetRegistrationCount resets all changes to the "registration_count" field.
+func (m *WordMutation) ResetRegistrationCount() {
+	m.registration_count = nil
+	m.addregistration_count = nil
 }
 
-var _ ent.Mutation = (*UserDailyUsageMutation)(nil)
-
-// userdailyusageOption allows management of the mutation configuration using functional options.
-type userdailyusageOption func(*UserDailyUsageMutation)
+// SetFrequencyRank sets the "frequency_rank" field.
+func (m *WordMutation) SetFrequencyRank(i int) {
+	m.frequency_rank = &i
+	m.addfrequency_rank = nil
+}
 
-// newUserDailyUsageMutation creates new mutation for the UserDailyUsage entity.
-func newUserDailyUsageMutation(c config, op Op, opts ...userdailyusageOption) *UserDailyUsageMutation {
-	m := &UserDailyUsageMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUserDailyUsage,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// FrequencyRank returns the value of the "frequency_rank" field in the mutation.
+func (m *WordMutation) FrequencyRank() (r int, exists bool) {
+	v := m.frequency_rank
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withUserDailyUsageID sets the ID field of the mutation.
-func withUserDailyUsageID(id int) userdailyusageOption {
-	return func(m *UserDailyUsageMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *UserDailyUsage
-		)
-		m.oldValue = func(ctx context.Context) (*UserDailyUsage, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().UserDailyUsage.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldFrequencyRank returns the old "frequency_rank" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordMutation) OldFrequencyRank(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFrequencyRank is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFrequencyRank requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFrequencyRank: %w", err)
 	}
+	return oldValue.FrequencyRank, nil
 }
 
-// withUserDailyUsage sets the old UserDailyUsage of the mutation.
-func withUserDailyUsage(node *UserDailyUsage) userdailyusageOption {
-	return func(m *UserDailyUsageMutation) {
-		m.oldValue = func(context.Context) (*UserDailyUsage, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddFrequencyRank adds i to the "frequency_rank" field.
+func (m *WordMutation) AddFrequencyRank(i int) {
+	if m.addfrequency_rank != nil {
+		*m.addfrequency_rank += i
+	} else {
+		m.addfrequency_rank = &i
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserDailyUsageMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddedFrequencyRank returns the value that was added to the "frequency_rank" field in this mutation.
+func (m *WordMutation) AddedFrequencyRank() (r int, exists bool) {
+	v := m.addfrequency_rank
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserDailyUsageMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ClearFrequencyRank clears the value of the "frequency_rank" field.
+func (m *WordMutation) ClearFrequencyRank() {
+	m.frequency_rank = nil
+	m.addfrequency_rank = nil
+	m.clearedFields[word.FieldFrequencyRank] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserDailyUsageMutation) ID() (id int, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// FrequencyRankCleared returns if the "frequency_rank" field was cleared in this mutation.
+func (m *WordMutation) FrequencyRankCleared() bool {
+	_, ok := m.clearedFields[word.FieldFrequencyRank]
+	return ok
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserDailyUsageMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserDailyUsage.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// ResetFrequencyRank resets all changes to the "frequency_rank" field.
+func (m *WordMutation) ResetFrequencyRank() {
+	m.frequency_rank = nil
+	m.addfrequency_rank = nil
+	delete(m.clearedFields, word.FieldFrequencyRank)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserDailyUsageMutation) SetUserID(i int) {
-	m.user = &i
+// SetCreatedAt sets the "created_at" field.
+func (m *WordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserDailyUsageMutation) UserID() (r int, exists bool) {
-	v := m.user
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the UserDailyUsage entity.
-// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserDailyUsageMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *WordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserDailyUsageMutation) ResetUserID() {
-	m.user = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WordMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetLastResetDate sets the "last_reset_date" field.
-func (m *UserDailyUsageMutation) SetLastResetDate(t time.Time) {
-	m.last_reset_date = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WordMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// LastResetDate returns the value of the "last_reset_date" field in the mutation.
-func (m *UserDailyUsageMutation) LastResetDate() (r time.Time, exists bool) {
-	v := m.last_reset_date
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WordMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastResetDate returns the old "last_reset_date" field's value of the UserDailyUsage entity.
-// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Word entity.
+// If the Word object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserDailyUsageMutation) OldLastResetDate(ctx context.Context) (v time.Time, err error) {
+func (m *WordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastResetDate is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastResetDate requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastResetDate: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.LastResetDate, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetLastResetDate resets all changes to the "last_reset_date" field.
-func (m *UserDailyUsageMutation) ResetLastResetDate() {
-	m.last_reset_date = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WordMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetQuizCount sets the "quiz_count" field.
-func (m *UserDailyUsageMutation) SetQuizCount(i int) {
-	m.quiz_count = &i
-	m.addquiz_count = nil
+// AddWordInfoIDs adds the "word_infos" edge to the WordInfo entity by ids.
+func (m *WordMutation) AddWordInfoIDs(ids ...int) {
+	if m.word_infos == nil {
+		m.word_infos = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.word_infos[ids[i]] = struct{}{}
+	}
 }
 
-// QuizCount returns the value of the "quiz_count" field in the mutation.
-func (m *UserDailyUsageMutation) QuizCount() (r int, exists bool) {
-	v := m.quiz_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearWordInfos clears the "word_infos" edge to the WordInfo entity.
+func (m *WordMutation) ClearWordInfos() {
+	m.clearedword_infos = true
 }
 
-// OldQuizCount returns the old "quiz_count" field's value of the UserDailyUsage entity.
-// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserDailyUsageMutation) OldQuizCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuizCount is only allowed on UpdateOne operations")
+// WordInfosCleared reports if the "word_infos" edge to the WordInfo entity was cleared.
+func (m *WordMutation) WordInfosCleared() bool {
+	return m.clearedword_infos
+}
+
+// RemoveWordInfoIDs removes the "word_infos" edge to the WordInfo entity by IDs.
+func (m *WordMutation) RemoveWordInfoIDs(ids ...int) {
+	if m.removedword_infos == nil {
+		m.removedword_infos = make(map[int]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuizCount requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.word_infos, ids[i])
+		m.removedword_infos[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuizCount: %w", err)
+}
+
+// RemovedWordInfos returns the removed IDs of the "word_infos" edge to the WordInfo entity.
+func (m *WordMutation) RemovedWordInfosIDs() (ids []int) {
+	for id := range m.removedword_infos {
+		ids = append(ids, id)
 	}
-	return oldValue.QuizCount, nil
+	return
 }
 
-// AddQuizCount adds i to the "quiz_count" field.
-func (m *UserDailyUsageMutation) AddQuizCount(i int) {
-	if m.addquiz_count != nil {
-		*m.addquiz_count += i
-	} else {
-		m.addquiz_count = &i
+// WordInfosIDs returns the "word_infos" edge IDs in the mutation.
+func (m *WordMutation) WordInfosIDs() (ids []int) {
+	for id := range m.word_infos {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// AddedQuizCount returns the value that was added to the "quiz_count" field in this mutation.
-func (m *UserDailyUsageMutation) AddedQuizCount() (r int, exists bool) {
-	v := m.addquiz_count
-	if v == nil {
-		return
+// ResetWordInfos resets all changes to the "word_infos" edge.
+func (m *WordMutation) ResetWordInfos() {
+	m.word_infos = nil
+	m.clearedword_infos = false
+	m.removedword_infos = nil
+}
+
+// AddRegisteredWordIDs adds the "registered_words" edge to the RegisteredWord entity by ids.
+func (m *WordMutation) AddRegisteredWordIDs(ids ...int) {
+	if m.registered_words == nil {
+		m.registered_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.registered_words[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// ResetQuizCount resets all changes to the "quiz_count" field.
-func (m *UserDailyUsageMutation) ResetQuizCount() {
-	m.quiz_count = nil
-	m.addquiz_count = nil
+// ClearRegisteredWords clears the "registered_words" edge to the RegisteredWord entity.
+func (m *WordMutation) ClearRegisteredWords() {
+	m.clearedregistered_words = true
 }
 
-// SetBulkCount sets the "bulk_count" field.
-func (m *UserDailyUsageMutation) SetBulkCount(i int) {
-	m.bulk_count = &i
-	m.addbulk_count = nil
+// RegisteredWordsCleared reports if the "registered_words" edge to the RegisteredWord entity was cleared.
+func (m *WordMutation) RegisteredWordsCleared() bool {
+	return m.clearedregistered_words
 }
 
-// BulkCount returns the value of the "bulk_count" field in the mutation.
-func (m *UserDailyUsageMutation) BulkCount() (r int, exists bool) {
-	v := m.bulk_count
-	if v == nil {
-		return
+// RemoveRegisteredWordIDs removes the "registered_words" edge to the RegisteredWord entity by IDs.
+func (m *WordMutation) RemoveRegisteredWordIDs(ids ...int) {
+	if m.removedregistered_words == nil {
+		m.removedregistered_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.registered_words, ids[i])
+		m.removedregistered_words[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldBulkCount returns the old "bulk_count" field's value of the UserDailyUsage entity.
-// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserDailyUsageMutation) OldBulkCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBulkCount is only allowed on UpdateOne operations")
+// RemovedRegisteredWords returns the removed IDs of the "registered_words" edge to the RegisteredWord entity.
+func (m *WordMutation) RemovedRegisteredWordsIDs() (ids []int) {
+	for id := range m.removedregistered_words {
+		ids = append(ids, id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBulkCount requires an ID field in the mutation")
+	return
+}
+
+// RegisteredWordsIDs returns the "registered_words" edge IDs in the mutation.
+func (m *WordMutation) RegisteredWordsIDs() (ids []int) {
+	for id := range m.registered_words {
+		ids = append(ids, id)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBulkCount: %w", err)
+	return
+}
+
+// ResetRegisteredWords resets all changes to the "registered_words" edge.
+func (m *WordMutation) ResetRegisteredWords() {
+	m.registered_words = nil
+	m.clearedregistered_words = false
+	m.removedregistered_words = nil
+}
+
+// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
+func (m *WordMutation) AddQuizQuestionIDs(ids ...int) {
+	if m.quiz_questions == nil {
+		m.quiz_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.quiz_questions[ids[i]] = struct{}{}
 	}
-	return oldValue.BulkCount, nil
 }
 
-// AddBulkCount adds i to the "bulk_count" field.
-func (m *UserDailyUsageMutation) AddBulkCount(i int) {
-	if m.addbulk_count != nil {
-		*m.addbulk_count += i
-	} else {
-		m.addbulk_count = &i
+// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
+func (m *WordMutation) ClearQuizQuestions() {
+	m.clearedquiz_questions = true
+}
+
+// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
+func (m *WordMutation) QuizQuestionsCleared() bool {
+	return m.clearedquiz_questions
+}
+
+// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
+func (m *WordMutation) RemoveQuizQuestionIDs(ids ...int) {
+	if m.removedquiz_questions == nil {
+		m.removedquiz_questions = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.quiz_questions, ids[i])
+		m.removedquiz_questions[ids[i]] = struct{}{}
 	}
 }
 
-// AddedBulkCount returns the value that was added to the "bulk_count" field in this mutation.
-func (m *UserDailyUsageMutation) AddedBulkCount() (r int, exists bool) {
-	v := m.addbulk_count
-	if v == nil {
-		return
+// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
+func (m *WordMutation) RemovedQuizQuestionsIDs() (ids []int) {
+	for id := range m.removedquiz_questions {
+		ids = append(ids, id)
 	}
-	return *v, true
+	return
 }
 
-// ResetBulkCount resets all changes to the "bulk_count" field.
-func (m *UserDailyUsageMutation) ResetBulkCount() {
-	m.bulk_count = nil
-	m.addbulk_count = nil
+// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
+func (m *WordMutation) QuizQuestionsIDs() (ids []int) {
+	for id := range m.quiz_questions {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserDailyUsageMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
+func (m *WordMutation) ResetQuizQuestions() {
+	m.quiz_questions = nil
+	m.clearedquiz_questions = false
+	m.removedquiz_questions = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserDailyUsageMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by ids.
+func (m *WordMutation) AddDeckWordIDs(ids ...int) {
+	if m.deck_words == nil {
+		m.deck_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.deck_words[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UserDailyUsage entity.
-// If the UserDailyUsage object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserDailyUsageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
+// ClearDeckWords clears the "deck_words" edge to the DeckWord entity.
+func (m *WordMutation) ClearDeckWords() {
+	m.cleareddeck_words = true
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserDailyUsageMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// DeckWordsCleared reports if the "deck_words" edge to the DeckWord entity was cleared.
+func (m *WordMutation) DeckWordsCleared() bool {
+	return m.cleareddeck_words
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserDailyUsageMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[userdailyusage.FieldUserID] = struct{}{}
+// RemoveDeckWordIDs removes the "deck_words" edge to the DeckWord entity by IDs.
+func (m *WordMutation) RemoveDeckWordIDs(ids ...int) {
+	if m.removeddeck_words == nil {
+		m.removeddeck_words = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.deck_words, ids[i])
+		m.removeddeck_words[ids[i]] = struct{}{}
+	}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserDailyUsageMutation) UserCleared() bool {
-	return m.cleareduser
+// RemovedDeckWords returns the removed IDs of the "deck_words" edge to the DeckWord entity.
+func (m *WordMutation) RemovedDeckWordsIDs() (ids []int) {
+	for id := range m.removeddeck_words {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserDailyUsageMutation) UserIDs() (ids []int) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// DeckWordsIDs returns the "deck_words" edge IDs in the mutation.
+func (m *WordMutation) DeckWordsIDs() (ids []int) {
+	for id := range m.deck_words {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserDailyUsageMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetDeckWords resets all changes to the "deck_words" edge.
+func (m *WordMutation) ResetDeckWords() {
+	m.deck_words = nil
+	m.cleareddeck_words = false
+	m.removeddeck_words = nil
 }
 
-// Where appends a list predicates to the UserDailyUsageMutation builder.
-func (m *UserDailyUsageMutation) Where(ps ...predicate.UserDailyUsage) {
+// Where appends a list predicates to the WordMutation builder.
+func (m *WordMutation) Where(ps ...predicate.Word) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserDailyUsageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WordMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserDailyUsageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserDailyUsage, len(ps))
+func (m *WordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Word, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -8485,39 +17416,48 @@ func (m *UserDailyUsageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserDailyUsageMutation) Op() Op {
+func (m *WordMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserDailyUsageMutation) SetOp(op Op) {
+func (m *WordMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserDailyUsage).
-func (m *UserDailyUsageMutation) Type() string {
+// Type returns the node type of this mutation (Word).
+func (m *WordMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserDailyUsageMutation) Fields() []string {
-	fields := make([]string, 0, 5)
-	if m.user != nil {
-		fields = append(fields, userdailyusage.FieldUserID)
+func (m *WordMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, word.FieldName)
 	}
-	if m.last_reset_date != nil {
-		fields = append(fields, userdailyusage.FieldLastResetDate)
+	if m.voice_id != nil {
+		fields = append(fields, word.FieldVoiceID)
 	}
-	if m.quiz_count != nil {
-		fields = append(fields, userdailyusage.FieldQuizCount)
+	if m.is_idioms != nil {
+		fields = append(fields, word.FieldIsIdioms)
 	}
-	if m.bulk_count != nil {
-		fields = append(fields, userdailyusage.FieldBulkCount)
+	if m.is_special_characters != nil {
+		fields = append(fields, word.FieldIsSpecialCharacters)
+	}
+	if m.registration_count != nil {
+		fields = append(fields, word.FieldRegistrationCount)
+	}
+	if m.frequency_rank != nil {
+		fields = append(fields, word.FieldFrequencyRank)
+	}
+	if m.created_at != nil {
+		fields = append(fields, word.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, userdailyusage.FieldUpdatedAt)
+		fields = append(fields, word.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -8525,17 +17465,23 @@ func (m *UserDailyUsageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserDailyUsageMutation) Field(name string) (ent.Value, bool) {
+func (m *WordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userdailyusage.FieldUserID:
-		return m.UserID()
-	case userdailyusage.FieldLastResetDate:
-		return m.LastResetDate()
-	case userdailyusage.FieldQuizCount:
-		return m.QuizCount()
-	case userdailyusage.FieldBulkCount:
-		return m.BulkCount()
-	case userdailyusage.FieldUpdatedAt:
+	case word.FieldName:
+		return m.Name()
+	case word.FieldVoiceID:
+		return m.VoiceID()
+	case word.FieldIsIdioms:
+		return m.IsIdioms()
+	case word.FieldIsSpecialCharacters:
+		return m.IsSpecialCharacters()
+	case word.FieldRegistrationCount:
+		return m.RegistrationCount()
+	case word.FieldFrequencyRank:
+		return m.FrequencyRank()
+	case word.FieldCreatedAt:
+		return m.CreatedAt()
+	case word.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -8544,56 +17490,83 @@ func (m *UserDailyUsageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserDailyUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userdailyusage.FieldUserID:
-		return m.OldUserID(ctx)
-	case userdailyusage.FieldLastResetDate:
-		return m.OldLastResetDate(ctx)
-	case userdailyusage.FieldQuizCount:
-		return m.OldQuizCount(ctx)
-	case userdailyusage.FieldBulkCount:
-		return m.OldBulkCount(ctx)
-	case userdailyusage.FieldUpdatedAt:
+	case word.FieldName:
+		return m.OldName(ctx)
+	case word.FieldVoiceID:
+		return m.OldVoiceID(ctx)
+	case word.FieldIsIdioms:
+		return m.OldIsIdioms(ctx)
+	case word.FieldIsSpecialCharacters:
+		return m.OldIsSpecialCharacters(ctx)
+	case word.FieldRegistrationCount:
+		return m.OldRegistrationCount(ctx)
+	case word.FieldFrequencyRank:
+		return m.OldFrequencyRank(ctx)
+	case word.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case word.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserDailyUsage field %s", name)
+	return nil, fmt.Errorf("unknown Word field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserDailyUsageMutation) SetField(name string, value ent.Value) error {
+func (m *WordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userdailyusage.FieldUserID:
-		v, ok := value.(int)
+	case word.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetName(v)
 		return nil
-	case userdailyusage.FieldLastResetDate:
-		v, ok := value.(time.Time)
+	case word.FieldVoiceID:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastResetDate(v)
+		m.SetVoiceID(v)
 		return nil
-	case userdailyusage.FieldQuizCount:
+	case word.FieldIsIdioms:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsIdioms(v)
+		return nil
+	case word.FieldIsSpecialCharacters:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSpecialCharacters(v)
+		return nil
+	case word.FieldRegistrationCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuizCount(v)
+		m.SetRegistrationCount(v)
 		return nil
-	case userdailyusage.FieldBulkCount:
+	case word.FieldFrequencyRank:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBulkCount(v)
+		m.SetFrequencyRank(v)
 		return nil
-	case userdailyusage.FieldUpdatedAt:
+	case word.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case word.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -8601,18 +17574,18 @@ func (m *UserDailyUsageMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserDailyUsage field %s", name)
+	return fmt.Errorf("unknown Word field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserDailyUsageMutation) AddedFields() []string {
+func (m *WordMutation) AddedFields() []string {
 	var fields []string
-	if m.addquiz_count != nil {
-		fields = append(fields, userdailyusage.FieldQuizCount)
+	if m.addregistration_count != nil {
+		fields = append(fields, word.FieldRegistrationCount)
 	}
-	if m.addbulk_count != nil {
-		fields = append(fields, userdailyusage.FieldBulkCount)
+	if m.addfrequency_rank != nil {
+		fields = append(fields, word.FieldFrequencyRank)
 	}
 	return fields
 }
@@ -8620,12 +17593,12 @@ func (m *UserDailyUsageMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserDailyUsageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WordMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case userdailyusage.FieldQuizCount:
-		return m.AddedQuizCount()
-	case userdailyusage.FieldBulkCount:
-		return m.AddedBulkCount()
+	case word.FieldRegistrationCount:
+		return m.AddedRegistrationCount()
+	case word.FieldFrequencyRank:
+		return m.AddedFrequencyRank()
 	}
 	return nil, false
 }
@@ -8633,182 +17606,296 @@ func (m *UserDailyUsageMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserDailyUsageMutation) AddField(name string, value ent.Value) error {
+func (m *WordMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case userdailyusage.FieldQuizCount:
+	case word.FieldRegistrationCount:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddQuizCount(v)
+		m.AddRegistrationCount(v)
 		return nil
-	case userdailyusage.FieldBulkCount:
+	case word.FieldFrequencyRank:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddBulkCount(v)
+		m.AddFrequencyRank(v)
 		return nil
 	}
-	return fmt.Errorf("unknown UserDailyUsage numeric field %s", name)
+	return fmt.Errorf("unknown Word numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserDailyUsageMutation) ClearedFields() []string {
-	return nil
+func (m *WordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(word.FieldVoiceID) {
+		fields = append(fields, word.FieldVoiceID)
+	}
+	if m.FieldCleared(word.FieldFrequencyRank) {
+		fields = append(fields, word.FieldFrequencyRank)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserDailyUsageMutation) FieldCleared(name string) bool {
+func (m *WordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserDailyUsageMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown UserDailyUsage nullable field %s", name)
+func (m *WordMutation) ClearField(name string) error {
+	switch name {
+	case word.FieldVoiceID:
+		m.ClearVoiceID()
+		return nil
+	case word.FieldFrequencyRank:
+		m.ClearFrequencyRank()
+		return nil
+	}
+	return fmt.Errorf("unknown Word nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserDailyUsageMutation) ResetField(name string) error {
+func (m *WordMutation) ResetField(name string) error {
 	switch name {
-	case userdailyusage.FieldUserID:
-		m.ResetUserID()
+	case word.FieldName:
+		m.ResetName()
+		return nil
+	case word.FieldVoiceID:
+		m.ResetVoiceID()
 		return nil
-	case userdailyusage.FieldLastResetDate:
-		m.ResetLastResetDate()
+	case word.FieldIsIdioms:
+		m.ResetIsIdioms()
 		return nil
-	case userdailyusage.FieldQuizCount:
-		m.ResetQuizCount()
+	case word.FieldIsSpecialCharacters:
+		m.ResetIsSpecialCharacters()
 		return nil
-	case userdailyusage.FieldBulkCount:
-		m.ResetBulkCount()
+	case word.FieldRegistrationCount:
+		m.ResetRegistrationCount()
 		return nil
-	case userdailyusage.FieldUpdatedAt:
+	case word.FieldFrequencyRank:
+		m.ResetFrequencyRank()
+		return nil
+	case word.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case word.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown UserDailyUsage field %s", name)
+	return fmt.Errorf("unknown Word field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserDailyUsageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, userdailyusage.EdgeUser)
+func (m *WordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.word_infos != nil {
+		edges = append(edges, word.EdgeWordInfos)
+	}
+	if m.registered_words != nil {
+		edges = append(edges, word.EdgeRegisteredWords)
+	}
+	if m.quiz_questions != nil {
+		edges = append(edges, word.EdgeQuizQuestions)
+	}
+	if m.deck_words != nil {
+		edges = append(edges, word.EdgeDeckWords)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserDailyUsageMutation) AddedIDs(name string) []ent.Value {
+func (m *WordMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userdailyusage.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case word.EdgeWordInfos:
+		ids := make([]ent.Value, 0, len(m.word_infos))
+		for id := range m.word_infos {
+			ids = append(ids, id)
+		}
+		return ids
+	case word.EdgeRegisteredWords:
+		ids := make([]ent.Value, 0, len(m.registered_words))
+		for id := range m.registered_words {
+			ids = append(ids, id)
+		}
+		return ids
+	case word.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.quiz_questions))
+		for id := range m.quiz_questions {
+			ids = append(ids, id)
 		}
+		return ids
+	case word.EdgeDeckWords:
+		ids := make([]ent.Value, 0, len(m.deck_words))
+		for id := range m.deck_words {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserDailyUsageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *WordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedword_infos != nil {
+		edges = append(edges, word.EdgeWordInfos)
+	}
+	if m.removedregistered_words != nil {
+		edges = append(edges, word.EdgeRegisteredWords)
+	}
+	if m.removedquiz_questions != nil {
+		edges = append(edges, word.EdgeQuizQuestions)
+	}
+	if m.removeddeck_words != nil {
+		edges = append(edges, word.EdgeDeckWords)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserDailyUsageMutation) RemovedIDs(name string) []ent.Value {
+func (m *WordMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case word.EdgeWordInfos:
+		ids := make([]ent.Value, 0, len(m.removedword_infos))
+		for id := range m.removedword_infos {
+			ids = append(ids, id)
+		}
+		return ids
+	case word.EdgeRegisteredWords:
+		ids := make([]ent.Value, 0, len(m.removedregistered_words))
+		for id := range m.removedregistered_words {
+			ids = append(ids, id)
+		}
+		return ids
+	case word.EdgeQuizQuestions:
+		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
+		for id := range m.removedquiz_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	case word.EdgeDeckWords:
+		ids := make([]ent.Value, 0, len(m.removeddeck_words))
+		for id := range m.removeddeck_words {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserDailyUsageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, userdailyusage.EdgeUser)
+func (m *WordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedword_infos {
+		edges = append(edges, word.EdgeWordInfos)
+	}
+	if m.clearedregistered_words {
+		edges = append(edges, word.EdgeRegisteredWords)
+	}
+	if m.clearedquiz_questions {
+		edges = append(edges, word.EdgeQuizQuestions)
+	}
+	if m.cleareddeck_words {
+		edges = append(edges, word.EdgeDeckWords)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserDailyUsageMutation) EdgeCleared(name string) bool {
+func (m *WordMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userdailyusage.EdgeUser:
-		return m.cleareduser
+	case word.EdgeWordInfos:
+		return m.clearedword_infos
+	case word.EdgeRegisteredWords:
+		return m.clearedregistered_words
+	case word.EdgeQuizQuestions:
+		return m.clearedquiz_questions
+	case word.EdgeDeckWords:
+		return m.cleareddeck_words
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserDailyUsageMutation) ClearEdge(name string) error {
+func (m *WordMutation) ClearEdge(name string) error {
 	switch name {
-	case userdailyusage.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown UserDailyUsage unique edge %s", name)
+	return fmt.Errorf("unknown Word unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserDailyUsageMutation) ResetEdge(name string) error {
+func (m *WordMutation) ResetEdge(name string) error {
 	switch name {
-	case userdailyusage.EdgeUser:
-		m.ResetUser()
+	case word.EdgeWordInfos:
+		m.ResetWordInfos()
+		return nil
+	case word.EdgeRegisteredWords:
+		m.ResetRegisteredWords()
+		return nil
+	case word.EdgeQuizQuestions:
+		m.ResetQuizQuestions()
+		return nil
+	case word.EdgeDeckWords:
+		m.ResetDeckWords()
 		return nil
 	}
-	return fmt.Errorf("unknown UserDailyUsage edge %s", name)
+	return fmt.Errorf("unknown Word edge %s", name)
 }
 
-// WordMutation represents an operation that mutates the Word nodes in the graph.
-type WordMutation struct {
+// WordInfoMutation represents an operation that mutates the WordInfo nodes in the graph.
+type WordInfoMutation struct {
 	config
-	op                      Op
-	typ                     string
-	id                      *int
-	name                    *string
-	voice_id                *string
-	is_idioms               *bool
-	is_special_characters   *bool
-	registration_count      *int
-	addregistration_count   *int
-	created_at              *time.Time
-	updated_at              *time.Time
-	clearedFields           map[string]struct{}
-	word_infos              map[int]struct{}
-	removedword_infos       map[int]struct{}
-	clearedword_infos       bool
-	registered_words        map[int]struct{}
-	removedregistered_words map[int]struct{}
-	clearedregistered_words bool
-	quiz_questions          map[int]struct{}
-	removedquiz_questions   map[int]struct{}
-	clearedquiz_questions   bool
-	done                    bool
-	oldValue                func(context.Context) (*Word, error)
-	predicates              []predicate.Word
+	op                    Op
+	typ                   string
+	id                    *int
+	pos_codes             *[]string
+	appendpos_codes       []string
+	field_tags            *[]string
+	appendfield_tags      []string
+	misc_tags             *[]string
+	appendmisc_tags       []string
+	dialect_tags          *[]string
+	appenddialect_tags    []string
+	examples              *[]models.WordExample
+	appendexamples        []models.WordExample
+	created_at            *time.Time
+	updated_at            *time.Time
+	clearedFields         map[string]struct{}
+	word                  *int
+	clearedword           bool
+	part_of_speech        *int
+	clearedpart_of_speech bool
+	japanese_means        map[int]struct{}
+	removedjapanese_means map[int]struct{}
+	clearedjapanese_means bool
+	done                  bool
+	oldValue              func(context.Context) (*WordInfo, error)
+	predicates            []predicate.WordInfo
 }
 
-var _ ent.Mutation = (*WordMutation)(nil)
+var _ ent.Mutation = (*WordInfoMutation)(nil)
 
-// wordOption allows management of the mutation configuration using functional options.
-type wordOption func(*WordMutation)
+// wordinfoOption allows management of the mutation configuration using functional options.
+type wordinfoOption func(*WordInfoMutation)
 
-// newWordMutation creates new mutation for the Word entity.
-func newWordMutation(c config, op Op, opts ...wordOption) *WordMutation {
-	m := &WordMutation{
+// newWordInfoMutation creates new mutation for the WordInfo entity.
+func newWordInfoMutation(c config, op Op, opts ...wordinfoOption) *WordInfoMutation {
+	m := &WordInfoMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWord,
+		typ:           TypeWordInfo,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -8817,20 +17904,20 @@ func newWordMutation(c config, op Op, opts ...wordOption) *WordMutation {
 	return m
 }
 
-// withWordID sets the ID field of the mutation.
-func withWordID(id int) wordOption {
-	return func(m *WordMutation) {
+// withWordInfoID sets the ID field of the mutation.
+func withWordInfoID(id int) wordinfoOption {
+	return func(m *WordInfoMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Word
+			value *WordInfo
 		)
-		m.oldValue = func(ctx context.Context) (*Word, error) {
+		m.oldValue = func(ctx context.Context) (*WordInfo, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Word.Get(ctx, id)
+					value, err = m.Client().WordInfo.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -8839,10 +17926,10 @@ func withWordID(id int) wordOption {
 	}
 }
 
-// withWord sets the old Word of the mutation.
-func withWord(node *Word) wordOption {
-	return func(m *WordMutation) {
-		m.oldValue = func(context.Context) (*Word, error) {
+// withWordInfo sets the old WordInfo of the mutation.
+func withWordInfo(node *WordInfo) wordinfoOption {
+	return func(m *WordInfoMutation) {
+		m.oldValue = func(context.Context) (*WordInfo, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -8851,7 +17938,7 @@ func withWord(node *Word) wordOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WordMutation) Client() *Client {
+func (m WordInfoMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -8859,7 +17946,7 @@ func (m WordMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WordMutation) Tx() (*Tx, error) {
+func (m WordInfoMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -8870,7 +17957,7 @@ func (m WordMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WordMutation) ID() (id int, exists bool) {
+func (m *WordInfoMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -8881,7 +17968,7 @@ func (m *WordMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WordMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WordInfoMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -8890,232 +17977,416 @@ func (m *WordMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Word.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WordInfo.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *WordMutation) SetName(s string) {
-	m.name = &s
+// SetWordID sets the "word_id" field.
+func (m *WordInfoMutation) SetWordID(i int) {
+	m.word = &i
+}
+
+// WordID returns the value of the "word_id" field in the mutation.
+func (m *WordInfoMutation) WordID() (r int, exists bool) {
+	v := m.word
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWordID returns the old "word_id" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordInfoMutation) OldWordID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWordID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWordID: %w", err)
+	}
+	return oldValue.WordID, nil
+}
+
+// ResetWordID resets all changes to the "word_id" field.
+func (m *WordInfoMutation) ResetWordID() {
+	m.word = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *WordMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (m *WordInfoMutation) SetPartOfSpeechID(i int) {
+	m.part_of_speech = &i
+}
+
+// PartOfSpeechID returns the value of the "part_of_speech_id" field in the mutation.
+func (m *WordInfoMutation) PartOfSpeechID() (r int, exists bool) {
+	v := m.part_of_speech
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldPartOfSpeechID returns the old "part_of_speech_id" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WordInfoMutation) OldPartOfSpeechID(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldPartOfSpeechID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldPartOfSpeechID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldPartOfSpeechID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.PartOfSpeechID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *WordMutation) ResetName() {
-	m.name = nil
+// ResetPartOfSpeechID resets all changes to the "part_of_speech_id" field.
+func (m *WordInfoMutation) ResetPartOfSpeechID() {
+	m.part_of_speech = nil
 }
 
-// SetVoiceID sets the "voice_id" field.
-func (m *WordMutation) SetVoiceID(s string) {
-	m.voice_id = &s
+// SetPosCodes sets the "pos_codes" field.
+func (m *WordInfoMutation) SetPosCodes(s []string) {
+	m.pos_codes = &s
+	m.appendpos_codes = nil
 }
 
-// VoiceID returns the value of the "voice_id" field in the mutation.
-func (m *WordMutation) VoiceID() (r string, exists bool) {
-	v := m.voice_id
+// PosCodes returns the value of the "pos_codes" field in the mutation.
+func (m *WordInfoMutation) PosCodes() (r []string, exists bool) {
+	v := m.pos_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVoiceID returns the old "voice_id" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldPosCodes returns the old "pos_codes" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldVoiceID(ctx context.Context) (v *string, err error) {
+func (m *WordInfoMutation) OldPosCodes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVoiceID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosCodes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVoiceID requires an ID field in the mutation")
+		return v, errors.New("OldPosCodes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVoiceID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosCodes: %w", err)
 	}
-	return oldValue.VoiceID, nil
+	return oldValue.PosCodes, nil
 }
 
-// ClearVoiceID clears the value of the "voice_id" field.
-func (m *WordMutation) ClearVoiceID() {
-	m.voice_id = nil
-	m.clearedFields[word.FieldVoiceID] = struct{}{}
+// AppendPosCodes adds s to the "pos_codes" field.
+func (m *WordInfoMutation) AppendPosCodes(s []string) {
+	m.appendpos_codes = append(m.appendpos_codes, s...)
 }
 
-// VoiceIDCleared returns if the "voice_id" field was cleared in this mutation.
-func (m *WordMutation) VoiceIDCleared() bool {
-	_, ok := m.clearedFields[word.FieldVoiceID]
+// AppendedPosCodes returns the list of values that were appended to the "pos_codes" field in this mutation.
+func (m *WordInfoMutation) AppendedPosCodes() ([]string, bool) {
+	if len(m.appendpos_codes) == 0 {
+		return nil, false
+	}
+	return m.appendpos_codes, true
+}
+
+// ClearPosCodes clears the value of the "pos_codes" field.
+func (m *WordInfoMutation) ClearPosCodes() {
+	m.pos_codes = nil
+	m.appendpos_codes = nil
+	m.clearedFields[wordinfo.FieldPosCodes] = struct{}{}
+}
+
+// PosCodesCleared returns if the "pos_codes" field was cleared in this mutation.
+func (m *WordInfoMutation) PosCodesCleared() bool {
+	_, ok := m.clearedFields[wordinfo.FieldPosCodes]
 	return ok
 }
 
-// ResetVoiceID resets all changes to the "voice_id" field.
-func (m *WordMutation) ResetVoiceID() {
-	m.voice_id = nil
-	delete(m.clearedFields, word.FieldVoiceID)
+// ResetPosCodes resets all changes to the "pos_codes" field.
+func (m *WordInfoMutation) ResetPosCodes() {
+	m.pos_codes = nil
+	m.appendpos_codes = nil
+	delete(m.clearedFields, wordinfo.FieldPosCodes)
 }
 
-// SetIsIdioms sets the "is_idioms" field.
-func (m *WordMutation) SetIsIdioms(b bool) {
-	m.is_idioms = &b
+// SetFieldTags sets the "field_tags" field.
+func (m *WordInfoMutation) SetFieldTags(s []string) {
+	m.field_tags = &s
+	m.appendfield_tags = nil
 }
 
-// IsIdioms returns the value of the "is_idioms" field in the mutation.
-func (m *WordMutation) IsIdioms() (r bool, exists bool) {
-	v := m.is_idioms
+// FieldTags returns the value of the "field_tags" field in the mutation.
+func (m *WordInfoMutation) FieldTags() (r []string, exists bool) {
+	v := m.field_tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsIdioms returns the old "is_idioms" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldFieldTags returns the old "field_tags" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldIsIdioms(ctx context.Context) (v bool, err error) {
+func (m *WordInfoMutation) OldFieldTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsIdioms is only allowed on UpdateOne operations")
+		return v, errors.New("OldFieldTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsIdioms requires an ID field in the mutation")
+		return v, errors.New("OldFieldTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsIdioms: %w", err)
+		return v, fmt.Errorf("querying old value for OldFieldTags: %w", err)
 	}
-	return oldValue.IsIdioms, nil
+	return oldValue.FieldTags, nil
 }
 
-// ResetIsIdioms resets all changes to the "is_idioms" field.
-func (m *WordMutation) ResetIsIdioms() {
-	m.is_idioms = nil
+// AppendFieldTags adds s to the "field_tags" field.
+func (m *WordInfoMutation) AppendFieldTags(s []string) {
+	m.appendfield_tags = append(m.appendfield_tags, s...)
 }
 
-// SetIsSpecialCharacters sets the "is_special_characters" field.
-func (m *WordMutation) SetIsSpecialCharacters(b bool) {
-	m.is_special_characters = &b
+// AppendedFieldTags returns the list of values that were appended to the "field_tags" field in this mutation.
+func (m *WordInfoMutation) AppendedFieldTags() ([]string, bool) {
+	if len(m.appendfield_tags) == 0 {
+		return nil, false
+	}
+	return m.appendfield_tags, true
 }
 
-// IsSpecialCharacters returns the value of the "is_special_characters" field in the mutation.
-func (m *WordMutation) IsSpecialCharacters() (r bool, exists bool) {
-	v := m.is_special_characters
+// ClearFieldTags clears the value of the "field_tags" field.
+func (m *WordInfoMutation) ClearFieldTags() {
+	m.field_tags = nil
+	m.appendfield_tags = nil
+	m.clearedFields[wordinfo.FieldFieldTags] = struct{}{}
+}
+
+// FieldTagsCleared returns if the "field_tags" field was cleared in this mutation.
+func (m *WordInfoMutation) FieldTagsCleared() bool {
+	_, ok := m.clearedFields[wordinfo.FieldFieldTags]
+	return ok
+}
+
+// ResetFieldTags resets all changes to the "field_tags" field.
+func (m *WordInfoMutation) ResetFieldTags() {
+	m.field_tags = nil
+	m.appendfield_tags = nil
+	delete(m.clearedFields, wordinfo.FieldFieldTags)
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (m *WordInfoMutation) SetMiscTags(s []string) {
+	m.misc_tags = &s
+	m.appendmisc_tags = nil
+}
+
+// MiscTags returns the value of the "misc_tags" field in the mutation.
+func (m *WordInfoMutation) MiscTags() (r []string, exists bool) {
+	v := m.misc_tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSpecialCharacters returns the old "is_special_characters" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldMiscTags returns the old "misc_tags" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldIsSpecialCharacters(ctx context.Context) (v bool, err error) {
+func (m *WordInfoMutation) OldMiscTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSpecialCharacters is only allowed on UpdateOne operations")
+		return v, errors.New("OldMiscTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSpecialCharacters requires an ID field in the mutation")
+		return v, errors.New("OldMiscTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSpecialCharacters: %w", err)
+		return v, fmt.Errorf("querying old value for OldMiscTags: %w", err)
 	}
-	return oldValue.IsSpecialCharacters, nil
+	return oldValue.MiscTags, nil
 }
 
-// ResetIsSpecialCharacters resets all changes to the "is_special_characters" field.
-func (m *WordMutation) ResetIsSpecialCharacters() {
-	m.is_special_characters = nil
+// AppendMiscTags adds s to the "misc_tags" field.
+func (m *WordInfoMutation) AppendMiscTags(s []string) {
+	m.appendmisc_tags = append(m.appendmisc_tags, s...)
 }
 
-// SetRegistrationCount sets the "registration_count" field.
-func (m *WordMutation) SetRegistrationCount(i int) {
-	m.registration_count = &i
-	m.addregistration_count = nil
+// AppendedMiscTags returns the list of values that were appended to the "misc_tags" field in this mutation.
+func (m *WordInfoMutation) AppendedMiscTags() ([]string, bool) {
+	if len(m.appendmisc_tags) == 0 {
+		return nil, false
+	}
+	return m.appendmisc_tags, true
 }
 
-// RegistrationCount returns the value of the "registration_count" field in the mutation.
-func (m *WordMutation) RegistrationCount() (r int, exists bool) {
-	v := m.registration_count
+// ClearMiscTags clears the value of the "misc_tags" field.
+func (m *WordInfoMutation) ClearMiscTags() {
+	m.misc_tags = nil
+	m.appendmisc_tags = nil
+	m.clearedFields[wordinfo.FieldMiscTags] = struct{}{}
+}
+
+// MiscTagsCleared returns if the "misc_tags" field was cleared in this mutation.
+func (m *WordInfoMutation) MiscTagsCleared() bool {
+	_, ok := m.clearedFields[wordinfo.FieldMiscTags]
+	return ok
+}
+
+// ResetMiscTags resets all changes to the "misc_tags" field.
+func (m *WordInfoMutation) ResetMiscTags() {
+	m.misc_tags = nil
+	m.appendmisc_tags = nil
+	delete(m.clearedFields, wordinfo.FieldMiscTags)
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (m *WordInfoMutation) SetDialectTags(s []string) {
+	m.dialect_tags = &s
+	m.appenddialect_tags = nil
+}
+
+// DialectTags returns the value of the "dialect_tags" field in the mutation.
+func (m *WordInfoMutation) DialectTags() (r []string, exists bool) {
+	v := m.dialect_tags
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRegistrationCount returns the old "registration_count" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldDialectTags returns the old "dialect_tags" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldRegistrationCount(ctx context.Context) (v int, err error) {
+func (m *WordInfoMutation) OldDialectTags(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRegistrationCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldDialectTags is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRegistrationCount requires an ID field in the mutation")
+		return v, errors.New("OldDialectTags requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRegistrationCount: %w", err)
+		return v, fmt.Errorf("querying old value for OldDialectTags: %w", err)
 	}
-	return oldValue.RegistrationCount, nil
+	return oldValue.DialectTags, nil
 }
 
-// AddRegistrationCount adds i to the "registration_count" field.
-func (m *WordMutation) AddRegistrationCount(i int) {
-	if m.addregistration_count != nil {
-		*m.addregistration_count += i
-	} else {
-		m.addregistration_count = &i
+// AppendDialectTags adds s to the "dialect_tags" field.
+func (m *WordInfoMutation) AppendDialectTags(s []string) {
+	m.appenddialect_tags = append(m.appenddialect_tags, s...)
+}
+
+// AppendedDialectTags returns the list of values that were appended to the "dialect_tags" field in this mutation.
+func (m *WordInfoMutation) AppendedDialectTags() ([]string, bool) {
+	if len(m.appenddialect_tags) == 0 {
+		return nil, false
 	}
+	return m.appenddialect_tags, true
 }
 
-// AddedRegistrationCount returns the value that was added to the "registration_count" field in this mutation.
-func (m *WordMutation) AddedRegistrationCount() (r int, exists bool) {
-	v := m.addregistration_count
+// ClearDialectTags clears the value of the "dialect_tags" field.
+func (m *WordInfoMutation) ClearDialectTags() {
+	m.dialect_tags = nil
+	m.appenddialect_tags = nil
+	m.clearedFields[wordinfo.FieldDialectTags] = struct{}{}
+}
+
+// DialectTagsCleared returns if the "dialect_tags" field was cleared in this mutation.
+func (m *WordInfoMutation) DialectTagsCleared() bool {
+	_, ok := m.clearedFields[wordinfo.FieldDialectTags]
+	return ok
+}
+
+// ResetDialectTags resets all changes to the "dialect_tags" field.
+func (m *WordInfoMutation) ResetDialectTags() {
+	m.dialect_tags = nil
+	m.appenddialect_tags = nil
+	delete(m.clearedFields, wordinfo.FieldDialectTags)
+}
+
+// SetExamples sets the "examples" field.
+func (m *WordInfoMutation) SetExamples(me []models.WordExample) {
+	m.examples = &me
+	m.appendexamples = nil
+}
+
+// Examples returns the value of the "examples" field in the mutation.
+func (m *WordInfoMutation) Examples() (r []models.WordExample, exists bool) {
+	v := m.examples
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetRegistrationCount resets all changes to the "registration_count" field.
-func (m *WordMutation) ResetRegistrationCount() {
-	m.registration_count = nil
-	m.addregistration_count = nil
+// OldExamples returns the old "examples" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordInfoMutation) OldExamples(ctx context.Context) (v []models.WordExample, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExamples is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExamples requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExamples: %w", err)
+	}
+	return oldValue.Examples, nil
+}
+
+// AppendExamples adds me to the "examples" field.
+func (m *WordInfoMutation) AppendExamples(me []models.WordExample) {
+	m.appendexamples = append(m.appendexamples, me...)
+}
+
+// AppendedExamples returns the list of values that were appended to the "examples" field in this mutation.
+func (m *WordInfoMutation) AppendedExamples() ([]models.WordExample, bool) {
+	if len(m.appendexamples) == 0 {
+		return nil, false
+	}
+	return m.appendexamples, true
+}
+
+// ClearExamples clears the value of the "examples" field.
+func (m *WordInfoMutation) ClearExamples() {
+	m.examples = nil
+	m.appendexamples = nil
+	m.clearedFields[wordinfo.FieldExamples] = struct{}{}
+}
+
+// ExamplesCleared returns if the "examples" field was cleared in this mutation.
+func (m *WordInfoMutation) ExamplesCleared() bool {
+	_, ok := m.clearedFields[wordinfo.FieldExamples]
+	return ok
+}
+
+// ResetExamples resets all changes to the "examples" field.
+func (m *WordInfoMutation) ResetExamples() {
+	m.examples = nil
+	m.appendexamples = nil
+	delete(m.clearedFields, wordinfo.FieldExamples)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *WordMutation) SetCreatedAt(t time.Time) {
+func (m *WordInfoMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *WordMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WordInfoMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -9123,10 +18394,10 @@ func (m *WordMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WordInfoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -9141,17 +18412,17 @@ func (m *WordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *WordMutation) ResetCreatedAt() {
+func (m *WordInfoMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *WordMutation) SetUpdatedAt(t time.Time) {
+func (m *WordInfoMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *WordMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WordInfoMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -9159,199 +18430,145 @@ func (m *WordMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Word entity.
-// If the Word object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WordInfo entity.
+// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WordInfoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *WordMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// AddWordInfoIDs adds the "word_infos" edge to the WordInfo entity by ids.
-func (m *WordMutation) AddWordInfoIDs(ids ...int) {
-	if m.word_infos == nil {
-		m.word_infos = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.word_infos[ids[i]] = struct{}{}
-	}
-}
-
-// ClearWordInfos clears the "word_infos" edge to the WordInfo entity.
-func (m *WordMutation) ClearWordInfos() {
-	m.clearedword_infos = true
-}
-
-// WordInfosCleared reports if the "word_infos" edge to the WordInfo entity was cleared.
-func (m *WordMutation) WordInfosCleared() bool {
-	return m.clearedword_infos
-}
-
-// RemoveWordInfoIDs removes the "word_infos" edge to the WordInfo entity by IDs.
-func (m *WordMutation) RemoveWordInfoIDs(ids ...int) {
-	if m.removedword_infos == nil {
-		m.removedword_infos = make(map[int]struct{})
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.word_infos, ids[i])
-		m.removedword_infos[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-}
-
-// RemovedWordInfos returns the removed IDs of the "word_infos" edge to the WordInfo entity.
-func (m *WordMutation) RemovedWordInfosIDs() (ids []int) {
-	for id := range m.removedword_infos {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return
+	return oldValue.UpdatedAt, nil
 }
 
-// WordInfosIDs returns the "word_infos" edge IDs in the mutation.
-func (m *WordMutation) WordInfosIDs() (ids []int) {
-	for id := range m.word_infos {
-		ids = append(ids, id)
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WordInfoMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ResetWordInfos resets all changes to the "word_infos" edge.
-func (m *WordMutation) ResetWordInfos() {
-	m.word_infos = nil
-	m.clearedword_infos = false
-	m.removedword_infos = nil
+// ClearWord clears the "word" edge to the Word entity.
+func (m *WordInfoMutation) ClearWord() {
+	m.clearedword = true
+	m.clearedFields[wordinfo.FieldWordID] = struct{}{}
 }
 
-// AddRegisteredWordIDs adds the "registered_words" edge to the RegisteredWord entity by ids.
-func (m *WordMutation) AddRegisteredWordIDs(ids ...int) {
-	if m.registered_words == nil {
-		m.registered_words = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.registered_words[ids[i]] = struct{}{}
-	}
+// WordCleared reports if the "word" edge to the Word entity was cleared.
+func (m *WordInfoMutation) WordCleared() bool {
+	return m.clearedword
 }
 
-// ClearRegisteredWords clears the "registered_words" edge to the RegisteredWord entity.
-func (m *WordMutation) ClearRegisteredWords() {
-	m.clearedregistered_words = true
+// WordIDs returns the "word" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// WordID instead. It exists only for internal usage by the builders.
+func (m *WordInfoMutation) WordIDs() (ids []int) {
+	if id := m.word; id != nil {
+		ids = append(ids, *id)
+	}
+	return
 }
 
-// RegisteredWordsCleared reports if the "registered_words" edge to the RegisteredWord entity was cleared.
-func (m *WordMutation) RegisteredWordsCleared() bool {
-	return m.clearedregistered_words
+// ResetWord resets all changes to the "word" edge.
+func (m *WordInfoMutation) ResetWord() {
+	m.word = nil
+	m.clearedword = false
 }
 
-// RemoveRegisteredWordIDs removes the "registered_words" edge to the RegisteredWord entity by IDs.
-func (m *WordMutation) RemoveRegisteredWordIDs(ids ...int) {
-	if m.removedregistered_words == nil {
-		m.removedregistered_words = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.registered_words, ids[i])
-		m.removedregistered_words[ids[i]] = struct{}{}
-	}
+// ClearPartOfSpeech clears the "part_of_speech" edge to the PartOfSpeech entity.
+func (m *WordInfoMutation) ClearPartOfSpeech() {
+	m.clearedpart_of_speech = true
+	m.clearedFields[wordinfo.FieldPartOfSpeechID] = struct{}{}
 }
 
-// RemovedRegisteredWords returns the removed IDs of the "registered_words" edge to the RegisteredWord entity.
-func (m *WordMutation) RemovedRegisteredWordsIDs() (ids []int) {
-	for id := range m.removedregistered_words {
-		ids = append(ids, id)
-	}
-	return
+// PartOfSpeechCleared reports if the "part_of_speech" edge to the PartOfSpeech entity was cleared.
+func (m *WordInfoMutation) PartOfSpeechCleared() bool {
+	return m.clearedpart_of_speech
 }
 
-// RegisteredWordsIDs returns the "registered_words" edge IDs in the mutation.
-func (m *WordMutation) RegisteredWordsIDs() (ids []int) {
-	for id := range m.registered_words {
-		ids = append(ids, id)
+// PartOfSpeechIDs returns the "part_of_speech" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PartOfSpeechID instead. It exists only for internal usage by the builders.
+func (m *WordInfoMutation) PartOfSpeechIDs() (ids []int) {
+	if id := m.part_of_speech; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetRegisteredWords resets all changes to the "registered_words" edge.
-func (m *WordMutation) ResetRegisteredWords() {
-	m.registered_words = nil
-	m.clearedregistered_words = false
-	m.removedregistered_words = nil
+// ResetPartOfSpeech resets all changes to the "part_of_speech" edge.
+func (m *WordInfoMutation) ResetPartOfSpeech() {
+	m.part_of_speech = nil
+	m.clearedpart_of_speech = false
 }
 
-// AddQuizQuestionIDs adds the "quiz_questions" edge to the QuizQuestion entity by ids.
-func (m *WordMutation) AddQuizQuestionIDs(ids ...int) {
-	if m.quiz_questions == nil {
-		m.quiz_questions = make(map[int]struct{})
+// AddJapaneseMeanIDs adds the "japanese_means" edge to the JapaneseMean entity by ids.
+func (m *WordInfoMutation) AddJapaneseMeanIDs(ids ...int) {
+	if m.japanese_means == nil {
+		m.japanese_means = make(map[int]struct{})
 	}
 	for i := range ids {
-		m.quiz_questions[ids[i]] = struct{}{}
+		m.japanese_means[ids[i]] = struct{}{}
 	}
 }
 
-// ClearQuizQuestions clears the "quiz_questions" edge to the QuizQuestion entity.
-func (m *WordMutation) ClearQuizQuestions() {
-	m.clearedquiz_questions = true
+// ClearJapaneseMeans clears the "japanese_means" edge to the JapaneseMean entity.
+func (m *WordInfoMutation) ClearJapaneseMeans() {
+	m.clearedjapanese_means = true
 }
 
-// QuizQuestionsCleared reports if the "quiz_questions" edge to the QuizQuestion entity was cleared.
-func (m *WordMutation) QuizQuestionsCleared() bool {
-	return m.clearedquiz_questions
+// JapaneseMeansCleared reports if the "japanese_means" edge to the JapaneseMean entity was cleared.
+func (m *WordInfoMutation) JapaneseMeansCleared() bool {
+	return m.clearedjapanese_means
 }
 
-// RemoveQuizQuestionIDs removes the "quiz_questions" edge to the QuizQuestion entity by IDs.
-func (m *WordMutation) RemoveQuizQuestionIDs(ids ...int) {
-	if m.removedquiz_questions == nil {
-		m.removedquiz_questions = make(map[int]struct{})
+// RemoveJapaneseMeanIDs removes the "japanese_means" edge to the JapaneseMean entity by IDs.
+func (m *WordInfoMutation) RemoveJapaneseMeanIDs(ids ...int) {
+	if m.removedjapanese_means == nil {
+		m.removedjapanese_means = make(map[int]struct{})
 	}
 	for i := range ids {
-		delete(m.quiz_questions, ids[i])
-		m.removedquiz_questions[ids[i]] = struct{}{}
+		delete(m.japanese_means, ids[i])
+		m.removedjapanese_means[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedQuizQuestions returns the removed IDs of the "quiz_questions" edge to the QuizQuestion entity.
-func (m *WordMutation) RemovedQuizQuestionsIDs() (ids []int) {
-	for id := range m.removedquiz_questions {
+// RemovedJapaneseMeans returns the removed IDs of the "japanese_means" edge to the JapaneseMean entity.
+func (m *WordInfoMutation) RemovedJapaneseMeansIDs() (ids []int) {
+	for id := range m.removedjapanese_means {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// QuizQuestionsIDs returns the "quiz_questions" edge IDs in the mutation.
-func (m *WordMutation) QuizQuestionsIDs() (ids []int) {
-	for id := range m.quiz_questions {
+// JapaneseMeansIDs returns the "japanese_means" edge IDs in the mutation.
+func (m *WordInfoMutation) JapaneseMeansIDs() (ids []int) {
+	for id := range m.japanese_means {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetQuizQuestions resets all changes to the "quiz_questions" edge.
-func (m *WordMutation) ResetQuizQuestions() {
-	m.quiz_questions = nil
-	m.clearedquiz_questions = false
-	m.removedquiz_questions = nil
+// ResetJapaneseMeans resets all changes to the "japanese_means" edge.
+func (m *WordInfoMutation) ResetJapaneseMeans() {
+	m.japanese_means = nil
+	m.clearedjapanese_means = false
+	m.removedjapanese_means = nil
 }
 
-// Where appends a list predicates to the WordMutation builder.
-func (m *WordMutation) Where(ps ...predicate.Word) {
+// Where appends a list predicates to the WordInfoMutation builder.
+func (m *WordInfoMutation) Where(ps ...predicate.WordInfo) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the WordMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WordInfoMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *WordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Word, len(ps))
+func (m *WordInfoMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WordInfo, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -9359,45 +18576,51 @@ func (m *WordMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *WordMutation) Op() Op {
+func (m *WordInfoMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *WordMutation) SetOp(op Op) {
+func (m *WordInfoMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Word).
-func (m *WordMutation) Type() string {
+// Type returns the node type of this mutation (WordInfo).
+func (m *WordInfoMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WordMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.name != nil {
-		fields = append(fields, word.FieldName)
+func (m *WordInfoMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.word != nil {
+		fields = append(fields, wordinfo.FieldWordID)
 	}
-	if m.voice_id != nil {
-		fields = append(fields, word.FieldVoiceID)
+	if m.part_of_speech != nil {
+		fields = append(fields, wordinfo.FieldPartOfSpeechID)
 	}
-	if m.is_idioms != nil {
-		fields = append(fields, word.FieldIsIdioms)
+	if m.pos_codes != nil {
+		fields = append(fields, wordinfo.FieldPosCodes)
 	}
-	if m.is_special_characters != nil {
-		fields = append(fields, word.FieldIsSpecialCharacters)
+	if m.field_tags != nil {
+		fields = append(fields, wordinfo.FieldFieldTags)
 	}
-	if m.registration_count != nil {
-		fields = append(fields, word.FieldRegistrationCount)
+	if m.misc_tags != nil {
+		fields = append(fields, wordinfo.FieldMiscTags)
+	}
+	if m.dialect_tags != nil {
+		fields = append(fields, wordinfo.FieldDialectTags)
+	}
+	if m.examples != nil {
+		fields = append(fields, wordinfo.FieldExamples)
 	}
 	if m.created_at != nil {
-		fields = append(fields, word.FieldCreatedAt)
+		fields = append(fields, wordinfo.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, word.FieldUpdatedAt)
+		fields = append(fields, wordinfo.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -9405,21 +18628,25 @@ func (m *WordMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WordMutation) Field(name string) (ent.Value, bool) {
+func (m *WordInfoMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case word.FieldName:
-		return m.Name()
-	case word.FieldVoiceID:
-		return m.VoiceID()
-	case word.FieldIsIdioms:
-		return m.IsIdioms()
-	case word.FieldIsSpecialCharacters:
-		return m.IsSpecialCharacters()
-	case word.FieldRegistrationCount:
-		return m.RegistrationCount()
-	case word.FieldCreatedAt:
+	case wordinfo.FieldWordID:
+		return m.WordID()
+	case wordinfo.FieldPartOfSpeechID:
+		return m.PartOfSpeechID()
+	case wordinfo.FieldPosCodes:
+		return m.PosCodes()
+	case wordinfo.FieldFieldTags:
+		return m.FieldTags()
+	case wordinfo.FieldMiscTags:
+		return m.MiscTags()
+	case wordinfo.FieldDialectTags:
+		return m.DialectTags()
+	case wordinfo.FieldExamples:
+		return m.Examples()
+	case wordinfo.FieldCreatedAt:
 		return m.CreatedAt()
-	case word.FieldUpdatedAt:
+	case wordinfo.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -9428,74 +18655,92 @@ func (m *WordMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WordInfoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case word.FieldName:
-		return m.OldName(ctx)
-	case word.FieldVoiceID:
-		return m.OldVoiceID(ctx)
-	case word.FieldIsIdioms:
-		return m.OldIsIdioms(ctx)
-	case word.FieldIsSpecialCharacters:
-		return m.OldIsSpecialCharacters(ctx)
-	case word.FieldRegistrationCount:
-		return m.OldRegistrationCount(ctx)
-	case word.FieldCreatedAt:
+	case wordinfo.FieldWordID:
+		return m.OldWordID(ctx)
+	case wordinfo.FieldPartOfSpeechID:
+		return m.OldPartOfSpeechID(ctx)
+	case wordinfo.FieldPosCodes:
+		return m.OldPosCodes(ctx)
+	case wordinfo.FieldFieldTags:
+		return m.OldFieldTags(ctx)
+	case wordinfo.FieldMiscTags:
+		return m.OldMiscTags(ctx)
+	case wordinfo.FieldDialectTags:
+		return m.OldDialectTags(ctx)
+	case wordinfo.FieldExamples:
+		return m.OldExamples(ctx)
+	case wordinfo.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case word.FieldUpdatedAt:
+	case wordinfo.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Word field %s", name)
+	return nil, fmt.Errorf("unknown WordInfo field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WordMutation) SetField(name string, value ent.Value) error {
+func (m *WordInfoMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case word.FieldName:
-		v, ok := value.(string)
+	case wordinfo.FieldWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordID(v)
+		return nil
+	case wordinfo.FieldPartOfSpeechID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetPartOfSpeechID(v)
 		return nil
-	case word.FieldVoiceID:
-		v, ok := value.(string)
+	case wordinfo.FieldPosCodes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVoiceID(v)
+		m.SetPosCodes(v)
 		return nil
-	case word.FieldIsIdioms:
-		v, ok := value.(bool)
+	case wordinfo.FieldFieldTags:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsIdioms(v)
+		m.SetFieldTags(v)
 		return nil
-	case word.FieldIsSpecialCharacters:
-		v, ok := value.(bool)
+	case wordinfo.FieldMiscTags:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSpecialCharacters(v)
+		m.SetMiscTags(v)
 		return nil
-	case word.FieldRegistrationCount:
-		v, ok := value.(int)
+	case wordinfo.FieldDialectTags:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRegistrationCount(v)
+		m.SetDialectTags(v)
 		return nil
-	case word.FieldCreatedAt:
+	case wordinfo.FieldExamples:
+		v, ok := value.([]models.WordExample)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExamples(v)
+		return nil
+	case wordinfo.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case word.FieldUpdatedAt:
+	case wordinfo.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -9503,26 +18748,21 @@ func (m *WordMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Word field %s", name)
+	return fmt.Errorf("unknown WordInfo field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WordMutation) AddedFields() []string {
+func (m *WordInfoMutation) AddedFields() []string {
 	var fields []string
-	if m.addregistration_count != nil {
-		fields = append(fields, word.FieldRegistrationCount)
-	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WordInfoMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case word.FieldRegistrationCount:
-		return m.AddedRegistrationCount()
 	}
 	return nil, false
 }
@@ -9530,110 +18770,129 @@ func (m *WordMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WordMutation) AddField(name string, value ent.Value) error {
+func (m *WordInfoMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case word.FieldRegistrationCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddRegistrationCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Word numeric field %s", name)
+	return fmt.Errorf("unknown WordInfo numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WordMutation) ClearedFields() []string {
+func (m *WordInfoMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(word.FieldVoiceID) {
-		fields = append(fields, word.FieldVoiceID)
+	if m.FieldCleared(wordinfo.FieldPosCodes) {
+		fields = append(fields, wordinfo.FieldPosCodes)
+	}
+	if m.FieldCleared(wordinfo.FieldFieldTags) {
+		fields = append(fields, wordinfo.FieldFieldTags)
+	}
+	if m.FieldCleared(wordinfo.FieldMiscTags) {
+		fields = append(fields, wordinfo.FieldMiscTags)
+	}
+	if m.FieldCleared(wordinfo.FieldDialectTags) {
+		fields = append(fields, wordinfo.FieldDialectTags)
+	}
+	if m.FieldCleared(wordinfo.FieldExamples) {
+		fields = append(fields, wordinfo.FieldExamples)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WordMutation) FieldCleared(name string) bool {
+func (m *WordInfoMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WordMutation) ClearField(name string) error {
+func (m *WordInfoMutation) ClearField(name string) error {
 	switch name {
-	case word.FieldVoiceID:
-		m.ClearVoiceID()
+	case wordinfo.FieldPosCodes:
+		m.ClearPosCodes()
+		return nil
+	case wordinfo.FieldFieldTags:
+		m.ClearFieldTags()
+		return nil
+	case wordinfo.FieldMiscTags:
+		m.ClearMiscTags()
+		return nil
+	case wordinfo.FieldDialectTags:
+		m.ClearDialectTags()
+		return nil
+	case wordinfo.FieldExamples:
+		m.ClearExamples()
 		return nil
 	}
-	return fmt.Errorf("unknown Word nullable field %s", name)
+	return fmt.Errorf("unknown WordInfo nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WordMutation) ResetField(name string) error {
+func (m *WordInfoMutation) ResetField(name string) error {
 	switch name {
-	case word.FieldName:
-		m.ResetName()
+	case wordinfo.FieldWordID:
+		m.ResetWordID()
 		return nil
-	case word.FieldVoiceID:
-		m.ResetVoiceID()
+	case wordinfo.FieldPartOfSpeechID:
+		m.ResetPartOfSpeechID()
 		return nil
-	case word.FieldIsIdioms:
-		m.ResetIsIdioms()
+	case wordinfo.FieldPosCodes:
+		m.ResetPosCodes()
 		return nil
-	case word.FieldIsSpecialCharacters:
-		m.ResetIsSpecialCharacters()
+	case wordinfo.FieldFieldTags:
+		m.ResetFieldTags()
 		return nil
-	case word.FieldRegistrationCount:
-		m.ResetRegistrationCount()
+	case wordinfo.FieldMiscTags:
+		m.ResetMiscTags()
 		return nil
-	case word.FieldCreatedAt:
+	case wordinfo.FieldDialectTags:
+		m.ResetDialectTags()
+		return nil
+	case wordinfo.FieldExamples:
+		m.ResetExamples()
+		return nil
+	case wordinfo.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case word.FieldUpdatedAt:
+	case wordinfo.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Word field %s", name)
+	return fmt.Errorf("unknown WordInfo field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WordMutation) AddedEdges() []string {
+func (m *WordInfoMutation) AddedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.word_infos != nil {
-		edges = append(edges, word.EdgeWordInfos)
+	if m.word != nil {
+		edges = append(edges, wordinfo.EdgeWord)
 	}
-	if m.registered_words != nil {
-		edges = append(edges, word.EdgeRegisteredWords)
+	if m.part_of_speech != nil {
+		edges = append(edges, wordinfo.EdgePartOfSpeech)
 	}
-	if m.quiz_questions != nil {
-		edges = append(edges, word.EdgeQuizQuestions)
+	if m.japanese_means != nil {
+		edges = append(edges, wordinfo.EdgeJapaneseMeans)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WordMutation) AddedIDs(name string) []ent.Value {
+func (m *WordInfoMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case word.EdgeWordInfos:
-		ids := make([]ent.Value, 0, len(m.word_infos))
-		for id := range m.word_infos {
-			ids = append(ids, id)
+	case wordinfo.EdgeWord:
+		if id := m.word; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case word.EdgeRegisteredWords:
-		ids := make([]ent.Value, 0, len(m.registered_words))
-		for id := range m.registered_words {
-			ids = append(ids, id)
+	case wordinfo.EdgePartOfSpeech:
+		if id := m.part_of_speech; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case word.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.quiz_questions))
-		for id := range m.quiz_questions {
+	case wordinfo.EdgeJapaneseMeans:
+		ids := make([]ent.Value, 0, len(m.japanese_means))
+		for id := range m.japanese_means {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9642,39 +18901,21 @@ func (m *WordMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WordMutation) RemovedEdges() []string {
+func (m *WordInfoMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.removedword_infos != nil {
-		edges = append(edges, word.EdgeWordInfos)
-	}
-	if m.removedregistered_words != nil {
-		edges = append(edges, word.EdgeRegisteredWords)
-	}
-	if m.removedquiz_questions != nil {
-		edges = append(edges, word.EdgeQuizQuestions)
+	if m.removedjapanese_means != nil {
+		edges = append(edges, wordinfo.EdgeJapaneseMeans)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WordMutation) RemovedIDs(name string) []ent.Value {
+func (m *WordInfoMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case word.EdgeWordInfos:
-		ids := make([]ent.Value, 0, len(m.removedword_infos))
-		for id := range m.removedword_infos {
-			ids = append(ids, id)
-		}
-		return ids
-	case word.EdgeRegisteredWords:
-		ids := make([]ent.Value, 0, len(m.removedregistered_words))
-		for id := range m.removedregistered_words {
-			ids = append(ids, id)
-		}
-		return ids
-	case word.EdgeQuizQuestions:
-		ids := make([]ent.Value, 0, len(m.removedquiz_questions))
-		for id := range m.removedquiz_questions {
+	case wordinfo.EdgeJapaneseMeans:
+		ids := make([]ent.Value, 0, len(m.removedjapanese_means))
+		for id := range m.removedjapanese_means {
 			ids = append(ids, id)
 		}
 		return ids
@@ -9683,91 +18924,101 @@ func (m *WordMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WordMutation) ClearedEdges() []string {
+func (m *WordInfoMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 3)
-	if m.clearedword_infos {
-		edges = append(edges, word.EdgeWordInfos)
+	if m.clearedword {
+		edges = append(edges, wordinfo.EdgeWord)
 	}
-	if m.clearedregistered_words {
-		edges = append(edges, word.EdgeRegisteredWords)
+	if m.clearedpart_of_speech {
+		edges = append(edges, wordinfo.EdgePartOfSpeech)
 	}
-	if m.clearedquiz_questions {
-		edges = append(edges, word.EdgeQuizQuestions)
+	if m.clearedjapanese_means {
+		edges = append(edges, wordinfo.EdgeJapaneseMeans)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WordMutation) EdgeCleared(name string) bool {
+func (m *WordInfoMutation) EdgeCleared(name string) bool {
 	switch name {
-	case word.EdgeWordInfos:
-		return m.clearedword_infos
-	case word.EdgeRegisteredWords:
-		return m.clearedregistered_words
-	case word.EdgeQuizQuestions:
-		return m.clearedquiz_questions
+	case wordinfo.EdgeWord:
+		return m.clearedword
+	case wordinfo.EdgePartOfSpeech:
+		return m.clearedpart_of_speech
+	case wordinfo.EdgeJapaneseMeans:
+		return m.clearedjapanese_means
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WordMutation) ClearEdge(name string) error {
+func (m *WordInfoMutation) ClearEdge(name string) error {
 	switch name {
+	case wordinfo.EdgeWord:
+		m.ClearWord()
+		return nil
+	case wordinfo.EdgePartOfSpeech:
+		m.ClearPartOfSpeech()
+		return nil
 	}
-	return fmt.Errorf("unknown Word unique edge %s", name)
+	return fmt.Errorf("unknown WordInfo unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WordMutation) ResetEdge(name string) error {
+func (m *WordInfoMutation) ResetEdge(name string) error {
 	switch name {
-	case word.EdgeWordInfos:
-		m.ResetWordInfos()
+	case wordinfo.EdgeWord:
+		m.ResetWord()
 		return nil
-	case word.EdgeRegisteredWords:
-		m.ResetRegisteredWords()
+	case wordinfo.EdgePartOfSpeech:
+		m.ResetPartOfSpeech()
 		return nil
-	case word.EdgeQuizQuestions:
-		m.ResetQuizQuestions()
+	case wordinfo.EdgeJapaneseMeans:
+		m.ResetJapaneseMeans()
 		return nil
 	}
-	return fmt.Errorf("unknown Word edge %s", name)
+	return fmt.Errorf("unknown WordInfo edge %s", name)
 }
 
-// WordInfoMutation represents an operation that mutates the WordInfo nodes in the graph.
-type WordInfoMutation struct {
+// WordProposalMutation represents an operation that mutates the WordProposal nodes in the graph.
+type WordProposalMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int
-	created_at            *time.Time
-	updated_at            *time.Time
-	clearedFields         map[string]struct{}
-	word                  *int
-	clearedword           bool
-	part_of_speech        *int
-	clearedpart_of_speech bool
-	japanese_means        map[int]struct{}
-	removedjapanese_means map[int]struct{}
-	clearedjapanese_means bool
-	done                  bool
-	oldValue              func(context.Context) (*WordInfo, error)
-	predicates            []predicate.WordInfo
-}
-
-var _ ent.Mutation = (*WordInfoMutation)(nil)
-
-// wordinfoOption allows management of the mutation configuration using functional options.
-type wordinfoOption func(*WordInfoMutation)
-
-// newWordInfoMutation creates new mutation for the WordInfo entity.
-func newWordInfoMutation(c config, op Op, opts ...wordinfoOption) *WordInfoMutation {
-	m := &WordInfoMutation{
+	op             Op
+	typ            string
+	id             *int
+	action         *wordproposal.Action
+	word_id        *int
+	addword_id     *int
+	word_name      *string
+	payload        *string
+	status         *wordproposal.Status
+	reviewer_id    *int
+	addreviewer_id *int
+	review_note    *string
+	created_at     *time.Time
+	reviewed_at    *time.Time
+	clearedFields  map[string]struct{}
+	user           *int
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*WordProposal, error)
+	predicates     []predicate.WordProposal
+}
+
+var _ ent.Mutation = (*WordProposalMutation)(nil)
+
+// wordproposalOption allows management of the mutation configuration using functional options.
+type wordproposalOption func(*WordProposalMutation)
+
+// newWordProposalMutation creates new mutation for the WordProposal entity.
+func newWordProposalMutation(c config, op Op, opts ...wordproposalOption) *WordProposalMutation {
+	m := &WordProposalMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeWordInfo,
+		typ:           TypeWordProposal,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -9776,32 +19027,32 @@ func newWordInfoMutation(c config, op Op, opts ...wordinfoOption) *WordInfoMutat
 	return m
 }
 
-// withWordInfoID sets the ID field of the mutation.
-func withWordInfoID(id int) wordinfoOption {
-	return func(m *WordInfoMutation) {
+// withWordProposalID sets the ID field of the mutation.
+func withWordProposalID(id int) wordproposalOption {
+	return func(m *WordProposalMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *WordInfo
+			value *WordProposal
 		)
-		m.oldValue = func(ctx context.Context) (*WordInfo, error) {
+		m.oldValue = func(ctx context.Context) (*WordProposal, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().WordInfo.Get(ctx, id)
+					value, err = m.Client().WordProposal.Get(ctx, id)
 				}
 			})
 			return value, err
 		}
 		m.id = &id
-	}
-}
-
-// withWordInfo sets the old WordInfo of the mutation.
-func withWordInfo(node *WordInfo) wordinfoOption {
-	return func(m *WordInfoMutation) {
-		m.oldValue = func(context.Context) (*WordInfo, error) {
+	}
+}
+
+// withWordProposal sets the old WordProposal of the mutation.
+func withWordProposal(node *WordProposal) wordproposalOption {
+	return func(m *WordProposalMutation) {
+		m.oldValue = func(context.Context) (*WordProposal, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -9810,7 +19061,7 @@ func withWordInfo(node *WordInfo) wordinfoOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m WordInfoMutation) Client() *Client {
+func (m WordProposalMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -9818,7 +19069,7 @@ func (m WordInfoMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m WordInfoMutation) Tx() (*Tx, error) {
+func (m WordProposalMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -9829,7 +19080,7 @@ func (m WordInfoMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *WordInfoMutation) ID() (id int, exists bool) {
+func (m *WordProposalMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -9840,7 +19091,7 @@ func (m *WordInfoMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *WordInfoMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *WordProposalMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -9849,30 +19100,103 @@ func (m *WordInfoMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().WordInfo.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WordProposal.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetUserID sets the "user_id" field.
+func (m *WordProposalMutation) SetUserID(i int) {
+	m.user = &i
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WordProposalMutation) UserID() (r int, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordProposalMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WordProposalMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetAction sets the "action" field.
+func (m *WordProposalMutation) SetAction(w wordproposal.Action) {
+	m.action = &w
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *WordProposalMutation) Action() (r wordproposal.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordProposalMutation) OldAction(ctx context.Context) (v wordproposal.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *WordProposalMutation) ResetAction() {
+	m.action = nil
+}
+
 // SetWordID sets the "word_id" field.
-func (m *WordInfoMutation) SetWordID(i int) {
-	m.word = &i
+func (m *WordProposalMutation) SetWordID(i int) {
+	m.word_id = &i
+	m.addword_id = nil
 }
 
 // WordID returns the value of the "word_id" field in the mutation.
-func (m *WordInfoMutation) WordID() (r int, exists bool) {
-	v := m.word
+func (m *WordProposalMutation) WordID() (r int, exists bool) {
+	v := m.word_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWordID returns the old "word_id" field's value of the WordInfo entity.
-// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldWordID returns the old "word_id" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordInfoMutation) OldWordID(ctx context.Context) (v int, err error) {
+func (m *WordProposalMutation) OldWordID(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldWordID is only allowed on UpdateOne operations")
 	}
@@ -9886,236 +19210,379 @@ func (m *WordInfoMutation) OldWordID(ctx context.Context) (v int, err error) {
 	return oldValue.WordID, nil
 }
 
+// AddWordID adds i to the "word_id" field.
+func (m *WordProposalMutation) AddWordID(i int) {
+	if m.addword_id != nil {
+		*m.addword_id += i
+	} else {
+		m.addword_id = &i
+	}
+}
+
+// AddedWordID returns the value that was added to the "word_id" field in this mutation.
+func (m *WordProposalMutation) AddedWordID() (r int, exists bool) {
+	v := m.addword_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearWordID clears the value of the "word_id" field.
+func (m *WordProposalMutation) ClearWordID() {
+	m.word_id = nil
+	m.addword_id = nil
+	m.clearedFields[wordproposal.FieldWordID] = struct{}{}
+}
+
+// WordIDCleared returns if the "word_id" field was cleared in this mutation.
+func (m *WordProposalMutation) WordIDCleared() bool {
+	_, ok := m.clearedFields[wordproposal.FieldWordID]
+	return ok
+}
+
 // ResetWordID resets all changes to the "word_id" field.
-func (m *WordInfoMutation) ResetWordID() {
-	m.word = nil
+func (m *WordProposalMutation) ResetWordID() {
+	m.word_id = nil
+	m.addword_id = nil
+	delete(m.clearedFields, wordproposal.FieldWordID)
 }
 
-// SetPartOfSpeechID sets the "part_of_speech_id" field.
-func (m *WordInfoMutation) SetPartOfSpeechID(i int) {
-	m.part_of_speech = &i
+// SetWordName sets the "word_name" field.
+func (m *WordProposalMutation) SetWordName(s string) {
+	m.word_name = &s
 }
 
-// PartOfSpeechID returns the value of the "part_of_speech_id" field in the mutation.
-func (m *WordInfoMutation) PartOfSpeechID() (r int, exists bool) {
-	v := m.part_of_speech
+// WordName returns the value of the "word_name" field in the mutation.
+func (m *WordProposalMutation) WordName() (r string, exists bool) {
+	v := m.word_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPartOfSpeechID returns the old "part_of_speech_id" field's value of the WordInfo entity.
-// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldWordName returns the old "word_name" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordInfoMutation) OldPartOfSpeechID(ctx context.Context) (v int, err error) {
+func (m *WordProposalMutation) OldWordName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPartOfSpeechID is only allowed on UpdateOne operations")
+		return v, errors.New("OldWordName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPartOfSpeechID requires an ID field in the mutation")
+		return v, errors.New("OldWordName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPartOfSpeechID: %w", err)
+		return v, fmt.Errorf("querying old value for OldWordName: %w", err)
 	}
-	return oldValue.PartOfSpeechID, nil
+	return oldValue.WordName, nil
 }
 
-// ResetPartOfSpeechID resets all changes to the "part_of_speech_id" field.
-func (m *WordInfoMutation) ResetPartOfSpeechID() {
-	m.part_of_speech = nil
+// ResetWordName resets all changes to the "word_name" field.
+func (m *WordProposalMutation) ResetWordName() {
+	m.word_name = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *WordInfoMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetPayload sets the "payload" field.
+func (m *WordProposalMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *WordInfoMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WordProposalMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the WordInfo entity.
-// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordInfoMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WordProposalMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Payload, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *WordInfoMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *WordProposalMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *WordInfoMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetStatus sets the "status" field.
+func (m *WordProposalMutation) SetStatus(w wordproposal.Status) {
+	m.status = &w
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *WordInfoMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// Status returns the value of the "status" field in the mutation.
+func (m *WordProposalMutation) Status() (r wordproposal.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the WordInfo entity.
-// If the WordInfo object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *WordInfoMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WordProposalMutation) OldStatus(ctx context.Context) (v wordproposal.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.Status, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *WordInfoMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WordProposalMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ClearWord clears the "word" edge to the Word entity.
-func (m *WordInfoMutation) ClearWord() {
-	m.clearedword = true
-	m.clearedFields[wordinfo.FieldWordID] = struct{}{}
+// SetReviewerID sets the "reviewer_id" field.
+func (m *WordProposalMutation) SetReviewerID(i int) {
+	m.reviewer_id = &i
+	m.addreviewer_id = nil
 }
 
-// WordCleared reports if the "word" edge to the Word entity was cleared.
-func (m *WordInfoMutation) WordCleared() bool {
-	return m.clearedword
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *WordProposalMutation) ReviewerID() (r int, exists bool) {
+	v := m.reviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// WordIDs returns the "word" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// WordID instead. It exists only for internal usage by the builders.
-func (m *WordInfoMutation) WordIDs() (ids []int) {
-	if id := m.word; id != nil {
-		ids = append(ids, *id)
+// OldReviewerID returns the old "reviewer_id" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordProposalMutation) OldReviewerID(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
+	}
+	return oldValue.ReviewerID, nil
 }
 
-// ResetWord resets all changes to the "word" edge.
-func (m *WordInfoMutation) ResetWord() {
-	m.word = nil
-	m.clearedword = false
+// AddReviewerID adds i to the "reviewer_id" field.
+func (m *WordProposalMutation) AddReviewerID(i int) {
+	if m.addreviewer_id != nil {
+		*m.addreviewer_id += i
+	} else {
+		m.addreviewer_id = &i
+	}
 }
 
-// ClearPartOfSpeech clears the "part_of_speech" edge to the PartOfSpeech entity.
-func (m *WordInfoMutation) ClearPartOfSpeech() {
-	m.clearedpart_of_speech = true
-	m.clearedFields[wordinfo.FieldPartOfSpeechID] = struct{}{}
+// AddedReviewerID returns the value that was added to the "reviewer_id" field in this mutation.
+func (m *WordProposalMutation) AddedReviewerID() (r int, exists bool) {
+	v := m.addreviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// PartOfSpeechCleared reports if the "part_of_speech" edge to the PartOfSpeech entity was cleared.
-func (m *WordInfoMutation) PartOfSpeechCleared() bool {
-	return m.clearedpart_of_speech
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (m *WordProposalMutation) ClearReviewerID() {
+	m.reviewer_id = nil
+	m.addreviewer_id = nil
+	m.clearedFields[wordproposal.FieldReviewerID] = struct{}{}
 }
 
-// PartOfSpeechIDs returns the "part_of_speech" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PartOfSpeechID instead. It exists only for internal usage by the builders.
-func (m *WordInfoMutation) PartOfSpeechIDs() (ids []int) {
-	if id := m.part_of_speech; id != nil {
-		ids = append(ids, *id)
+// ReviewerIDCleared returns if the "reviewer_id" field was cleared in this mutation.
+func (m *WordProposalMutation) ReviewerIDCleared() bool {
+	_, ok := m.clearedFields[wordproposal.FieldReviewerID]
+	return ok
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *WordProposalMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+	m.addreviewer_id = nil
+	delete(m.clearedFields, wordproposal.FieldReviewerID)
+}
+
+// SetReviewNote sets the "review_note" field.
+func (m *WordProposalMutation) SetReviewNote(s string) {
+	m.review_note = &s
+}
+
+// ReviewNote returns the value of the "review_note" field in the mutation.
+func (m *WordProposalMutation) ReviewNote() (r string, exists bool) {
+	v := m.review_note
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetPartOfSpeech resets all changes to the "part_of_speech" edge.
-func (m *WordInfoMutation) ResetPartOfSpeech() {
-	m.part_of_speech = nil
-	m.clearedpart_of_speech = false
+// OldReviewNote returns the old "review_note" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordProposalMutation) OldReviewNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewNote: %w", err)
+	}
+	return oldValue.ReviewNote, nil
 }
 
-// AddJapaneseMeanIDs adds the "japanese_means" edge to the JapaneseMean entity by ids.
-func (m *WordInfoMutation) AddJapaneseMeanIDs(ids ...int) {
-	if m.japanese_means == nil {
-		m.japanese_means = make(map[int]struct{})
+// ResetReviewNote resets all changes to the "review_note" field.
+func (m *WordProposalMutation) ResetReviewNote() {
+	m.review_note = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WordProposalMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WordProposalMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.japanese_means[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordProposalMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WordProposalMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *WordProposalMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *WordProposalMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the WordProposal entity.
+// If the WordProposal object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WordProposalMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
 	}
+	return oldValue.ReviewedAt, nil
 }
 
-// ClearJapaneseMeans clears the "japanese_means" edge to the JapaneseMean entity.
-func (m *WordInfoMutation) ClearJapaneseMeans() {
-	m.clearedjapanese_means = true
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *WordProposalMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[wordproposal.FieldReviewedAt] = struct{}{}
 }
 
-// JapaneseMeansCleared reports if the "japanese_means" edge to the JapaneseMean entity was cleared.
-func (m *WordInfoMutation) JapaneseMeansCleared() bool {
-	return m.clearedjapanese_means
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *WordProposalMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[wordproposal.FieldReviewedAt]
+	return ok
 }
 
-// RemoveJapaneseMeanIDs removes the "japanese_means" edge to the JapaneseMean entity by IDs.
-func (m *WordInfoMutation) RemoveJapaneseMeanIDs(ids ...int) {
-	if m.removedjapanese_means == nil {
-		m.removedjapanese_means = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.japanese_means, ids[i])
-		m.removedjapanese_means[ids[i]] = struct{}{}
-	}
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *WordProposalMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, wordproposal.FieldReviewedAt)
 }
 
-// RemovedJapaneseMeans returns the removed IDs of the "japanese_means" edge to the JapaneseMean entity.
-func (m *WordInfoMutation) RemovedJapaneseMeansIDs() (ids []int) {
-	for id := range m.removedjapanese_means {
-		ids = append(ids, id)
-	}
-	return
+// ClearUser clears the "user" edge to the User entity.
+func (m *WordProposalMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[wordproposal.FieldUserID] = struct{}{}
 }
 
-// JapaneseMeansIDs returns the "japanese_means" edge IDs in the mutation.
-func (m *WordInfoMutation) JapaneseMeansIDs() (ids []int) {
-	for id := range m.japanese_means {
-		ids = append(ids, id)
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WordProposalMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WordProposalMutation) UserIDs() (ids []int) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetJapaneseMeans resets all changes to the "japanese_means" edge.
-func (m *WordInfoMutation) ResetJapaneseMeans() {
-	m.japanese_means = nil
-	m.clearedjapanese_means = false
-	m.removedjapanese_means = nil
+// ResetUser resets all changes to the "user" edge.
+func (m *WordProposalMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the WordInfoMutation builder.
-func (m *WordInfoMutation) Where(ps ...predicate.WordInfo) {
+// Where appends a list predicates to the WordProposalMutation builder.
+func (m *WordProposalMutation) Where(ps ...predicate.WordProposal) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the WordInfoMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WordProposalMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *WordInfoMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.WordInfo, len(ps))
+func (m *WordProposalMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WordProposal, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -10123,36 +19590,54 @@ func (m *WordInfoMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *WordInfoMutation) Op() Op {
+func (m *WordProposalMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *WordInfoMutation) SetOp(op Op) {
+func (m *WordProposalMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (WordInfo).
-func (m *WordInfoMutation) Type() string {
+// Type returns the node type of this mutation (WordProposal).
+func (m *WordProposalMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *WordInfoMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.word != nil {
-		fields = append(fields, wordinfo.FieldWordID)
+func (m *WordProposalMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user != nil {
+		fields = append(fields, wordproposal.FieldUserID)
 	}
-	if m.part_of_speech != nil {
-		fields = append(fields, wordinfo.FieldPartOfSpeechID)
+	if m.action != nil {
+		fields = append(fields, wordproposal.FieldAction)
+	}
+	if m.word_id != nil {
+		fields = append(fields, wordproposal.FieldWordID)
+	}
+	if m.word_name != nil {
+		fields = append(fields, wordproposal.FieldWordName)
+	}
+	if m.payload != nil {
+		fields = append(fields, wordproposal.FieldPayload)
+	}
+	if m.status != nil {
+		fields = append(fields, wordproposal.FieldStatus)
+	}
+	if m.reviewer_id != nil {
+		fields = append(fields, wordproposal.FieldReviewerID)
+	}
+	if m.review_note != nil {
+		fields = append(fields, wordproposal.FieldReviewNote)
 	}
 	if m.created_at != nil {
-		fields = append(fields, wordinfo.FieldCreatedAt)
+		fields = append(fields, wordproposal.FieldCreatedAt)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, wordinfo.FieldUpdatedAt)
+	if m.reviewed_at != nil {
+		fields = append(fields, wordproposal.FieldReviewedAt)
 	}
 	return fields
 }
@@ -10160,16 +19645,28 @@ func (m *WordInfoMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *WordInfoMutation) Field(name string) (ent.Value, bool) {
+func (m *WordProposalMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case wordinfo.FieldWordID:
+	case wordproposal.FieldUserID:
+		return m.UserID()
+	case wordproposal.FieldAction:
+		return m.Action()
+	case wordproposal.FieldWordID:
 		return m.WordID()
-	case wordinfo.FieldPartOfSpeechID:
-		return m.PartOfSpeechID()
-	case wordinfo.FieldCreatedAt:
+	case wordproposal.FieldWordName:
+		return m.WordName()
+	case wordproposal.FieldPayload:
+		return m.Payload()
+	case wordproposal.FieldStatus:
+		return m.Status()
+	case wordproposal.FieldReviewerID:
+		return m.ReviewerID()
+	case wordproposal.FieldReviewNote:
+		return m.ReviewNote()
+	case wordproposal.FieldCreatedAt:
 		return m.CreatedAt()
-	case wordinfo.FieldUpdatedAt:
-		return m.UpdatedAt()
+	case wordproposal.FieldReviewedAt:
+		return m.ReviewedAt()
 	}
 	return nil, false
 }
@@ -10177,69 +19674,133 @@ func (m *WordInfoMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *WordInfoMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WordProposalMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case wordinfo.FieldWordID:
+	case wordproposal.FieldUserID:
+		return m.OldUserID(ctx)
+	case wordproposal.FieldAction:
+		return m.OldAction(ctx)
+	case wordproposal.FieldWordID:
 		return m.OldWordID(ctx)
-	case wordinfo.FieldPartOfSpeechID:
-		return m.OldPartOfSpeechID(ctx)
-	case wordinfo.FieldCreatedAt:
+	case wordproposal.FieldWordName:
+		return m.OldWordName(ctx)
+	case wordproposal.FieldPayload:
+		return m.OldPayload(ctx)
+	case wordproposal.FieldStatus:
+		return m.OldStatus(ctx)
+	case wordproposal.FieldReviewerID:
+		return m.OldReviewerID(ctx)
+	case wordproposal.FieldReviewNote:
+		return m.OldReviewNote(ctx)
+	case wordproposal.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case wordinfo.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	case wordproposal.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown WordInfo field %s", name)
+	return nil, fmt.Errorf("unknown WordProposal field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WordInfoMutation) SetField(name string, value ent.Value) error {
+func (m *WordProposalMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case wordinfo.FieldWordID:
+	case wordproposal.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case wordproposal.FieldAction:
+		v, ok := value.(wordproposal.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case wordproposal.FieldWordID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetWordID(v)
 		return nil
-	case wordinfo.FieldPartOfSpeechID:
+	case wordproposal.FieldWordName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWordName(v)
+		return nil
+	case wordproposal.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case wordproposal.FieldStatus:
+		v, ok := value.(wordproposal.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case wordproposal.FieldReviewerID:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPartOfSpeechID(v)
+		m.SetReviewerID(v)
 		return nil
-	case wordinfo.FieldCreatedAt:
+	case wordproposal.FieldReviewNote:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewNote(v)
+		return nil
+	case wordproposal.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case wordinfo.FieldUpdatedAt:
+	case wordproposal.FieldReviewedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetReviewedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown WordInfo field %s", name)
+	return fmt.Errorf("unknown WordProposal field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *WordInfoMutation) AddedFields() []string {
+func (m *WordProposalMutation) AddedFields() []string {
 	var fields []string
+	if m.addword_id != nil {
+		fields = append(fields, wordproposal.FieldWordID)
+	}
+	if m.addreviewer_id != nil {
+		fields = append(fields, wordproposal.FieldReviewerID)
+	}
 	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *WordInfoMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WordProposalMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
+	case wordproposal.FieldWordID:
+		return m.AddedWordID()
+	case wordproposal.FieldReviewerID:
+		return m.AddedReviewerID()
 	}
 	return nil, false
 }
@@ -10247,167 +19808,174 @@ func (m *WordInfoMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *WordInfoMutation) AddField(name string, value ent.Value) error {
+func (m *WordProposalMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case wordproposal.FieldWordID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWordID(v)
+		return nil
+	case wordproposal.FieldReviewerID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewerID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown WordInfo numeric field %s", name)
+	return fmt.Errorf("unknown WordProposal numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *WordInfoMutation) ClearedFields() []string {
-	return nil
+func (m *WordProposalMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(wordproposal.FieldWordID) {
+		fields = append(fields, wordproposal.FieldWordID)
+	}
+	if m.FieldCleared(wordproposal.FieldReviewerID) {
+		fields = append(fields, wordproposal.FieldReviewerID)
+	}
+	if m.FieldCleared(wordproposal.FieldReviewedAt) {
+		fields = append(fields, wordproposal.FieldReviewedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *WordInfoMutation) FieldCleared(name string) bool {
+func (m *WordProposalMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *WordInfoMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown WordInfo nullable field %s", name)
+func (m *WordProposalMutation) ClearField(name string) error {
+	switch name {
+	case wordproposal.FieldWordID:
+		m.ClearWordID()
+		return nil
+	case wordproposal.FieldReviewerID:
+		m.ClearReviewerID()
+		return nil
+	case wordproposal.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown WordProposal nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *WordInfoMutation) ResetField(name string) error {
+func (m *WordProposalMutation) ResetField(name string) error {
 	switch name {
-	case wordinfo.FieldWordID:
+	case wordproposal.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case wordproposal.FieldAction:
+		m.ResetAction()
+		return nil
+	case wordproposal.FieldWordID:
 		m.ResetWordID()
 		return nil
-	case wordinfo.FieldPartOfSpeechID:
-		m.ResetPartOfSpeechID()
+	case wordproposal.FieldWordName:
+		m.ResetWordName()
 		return nil
-	case wordinfo.FieldCreatedAt:
+	case wordproposal.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case wordproposal.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case wordproposal.FieldReviewerID:
+		m.ResetReviewerID()
+		return nil
+	case wordproposal.FieldReviewNote:
+		m.ResetReviewNote()
+		return nil
+	case wordproposal.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case wordinfo.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case wordproposal.FieldReviewedAt:
+		m.ResetReviewedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown WordInfo field %s", name)
+	return fmt.Errorf("unknown WordProposal field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *WordInfoMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.word != nil {
-		edges = append(edges, wordinfo.EdgeWord)
-	}
-	if m.part_of_speech != nil {
-		edges = append(edges, wordinfo.EdgePartOfSpeech)
-	}
-	if m.japanese_means != nil {
-		edges = append(edges, wordinfo.EdgeJapaneseMeans)
+func (m *WordProposalMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, wordproposal.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *WordInfoMutation) AddedIDs(name string) []ent.Value {
+func (m *WordProposalMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case wordinfo.EdgeWord:
-		if id := m.word; id != nil {
-			return []ent.Value{*id}
-		}
-	case wordinfo.EdgePartOfSpeech:
-		if id := m.part_of_speech; id != nil {
+	case wordproposal.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case wordinfo.EdgeJapaneseMeans:
-		ids := make([]ent.Value, 0, len(m.japanese_means))
-		for id := range m.japanese_means {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *WordInfoMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedjapanese_means != nil {
-		edges = append(edges, wordinfo.EdgeJapaneseMeans)
-	}
+func (m *WordProposalMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *WordInfoMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case wordinfo.EdgeJapaneseMeans:
-		ids := make([]ent.Value, 0, len(m.removedjapanese_means))
-		for id := range m.removedjapanese_means {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *WordProposalMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *WordInfoMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedword {
-		edges = append(edges, wordinfo.EdgeWord)
-	}
-	if m.clearedpart_of_speech {
-		edges = append(edges, wordinfo.EdgePartOfSpeech)
-	}
-	if m.clearedjapanese_means {
-		edges = append(edges, wordinfo.EdgeJapaneseMeans)
+func (m *WordProposalMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, wordproposal.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *WordInfoMutation) EdgeCleared(name string) bool {
+func (m *WordProposalMutation) EdgeCleared(name string) bool {
 	switch name {
-	case wordinfo.EdgeWord:
-		return m.clearedword
-	case wordinfo.EdgePartOfSpeech:
-		return m.clearedpart_of_speech
-	case wordinfo.EdgeJapaneseMeans:
-		return m.clearedjapanese_means
+	case wordproposal.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *WordInfoMutation) ClearEdge(name string) error {
+func (m *WordProposalMutation) ClearEdge(name string) error {
 	switch name {
-	case wordinfo.EdgeWord:
-		m.ClearWord()
-		return nil
-	case wordinfo.EdgePartOfSpeech:
-		m.ClearPartOfSpeech()
+	case wordproposal.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown WordInfo unique edge %s", name)
+	return fmt.Errorf("unknown WordProposal unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *WordInfoMutation) ResetEdge(name string) error {
+func (m *WordProposalMutation) ResetEdge(name string) error {
 	switch name {
-	case wordinfo.EdgeWord:
-		m.ResetWord()
-		return nil
-	case wordinfo.EdgePartOfSpeech:
-		m.ResetPartOfSpeech()
-		return nil
-	case wordinfo.EdgeJapaneseMeans:
-		m.ResetJapaneseMeans()
+	case wordproposal.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown WordInfo edge %s", name)
+	return fmt.Errorf("unknown WordProposal edge %s", name)
 }
@@ -33,9 +33,11 @@ type PartOfSpeech struct {
 type PartOfSpeechEdges struct {
 	// WordInfos holds the value of the word_infos edge.
 	WordInfos []*WordInfo `json:"word_infos,omitempty"`
+	// PosMappings holds the value of the pos_mappings edge.
+	PosMappings []*PosMapping `json:"pos_mappings,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [2]bool
 }
 
 // WordInfosOrErr returns the WordInfos value or an error if the edge
@@ -47,6 +49,15 @@ func (e PartOfSpeechEdges) WordInfosOrErr() ([]*WordInfo, error) {
 	return nil, &NotLoadedError{edge: "word_infos"}
 }
 
+// PosMappingsOrErr returns the PosMappings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PartOfSpeechEdges) PosMappingsOrErr() ([]*PosMapping, error) {
+	if e.loadedTypes[1] {
+		return e.PosMappings, nil
+	}
+	return nil, &NotLoadedError{edge: "pos_mappings"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*PartOfSpeech) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -115,6 +126,11 @@ func (pos *PartOfSpeech) QueryWordInfos() *WordInfoQuery {
 	return NewPartOfSpeechClient(pos.config).QueryWordInfos(pos)
 }
 
+// QueryPosMappings queries the "pos_mappings" edge of the PartOfSpeech entity.
+func (pos *PartOfSpeech) QueryPosMappings() *PosMappingQuery {
+	return NewPartOfSpeechClient(pos.config).QueryPosMappings(pos)
+}
+
 // Update returns a builder for updating this PartOfSpeech.
 // Note that you need to call PartOfSpeech.Unwrap() before calling this method if this PartOfSpeech
 // was returned from a transaction, and the transaction was committed or rolled back.
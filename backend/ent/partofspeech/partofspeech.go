@@ -22,6 +22,8 @@ const (
 	FieldUpdatedAt = "updated_at"
 	// EdgeWordInfos holds the string denoting the word_infos edge name in mutations.
 	EdgeWordInfos = "word_infos"
+	// EdgePosMappings holds the string denoting the pos_mappings edge name in mutations.
+	EdgePosMappings = "pos_mappings"
 	// Table holds the table name of the partofspeech in the database.
 	Table = "part_of_speeches"
 	// WordInfosTable is the table that holds the word_infos relation/edge.
@@ -31,6 +33,13 @@ const (
 	WordInfosInverseTable = "word_infos"
 	// WordInfosColumn is the table column denoting the word_infos relation/edge.
 	WordInfosColumn = "part_of_speech_id"
+	// PosMappingsTable is the table that holds the pos_mappings relation/edge.
+	PosMappingsTable = "pos_mappings"
+	// PosMappingsInverseTable is the table name for the PosMapping entity.
+	// It exists in this package in order to avoid circular dependency with the "posmapping" package.
+	PosMappingsInverseTable = "pos_mappings"
+	// PosMappingsColumn is the table column denoting the pos_mappings relation/edge.
+	PosMappingsColumn = "part_of_speech_id"
 )
 
 // Columns holds all SQL columns for partofspeech fields.
@@ -98,6 +107,20 @@ func ByWordInfos(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newWordInfosStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByPosMappingsCount orders the results by pos_mappings count.
+func ByPosMappingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPosMappingsStep(), opts...)
+	}
+}
+
+// ByPosMappings orders the results by pos_mappings terms.
+func ByPosMappings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPosMappingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newWordInfosStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -105,3 +128,10 @@ func newWordInfosStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, WordInfosTable, WordInfosColumn),
 	)
 }
+func newPosMappingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PosMappingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PosMappingsTable, PosMappingsColumn),
+	)
+}
@@ -238,6 +238,29 @@ func HasWordInfosWith(preds ...predicate.WordInfo) predicate.PartOfSpeech {
 	})
 }
 
+// HasPosMappings applies the HasEdge predicate on the "pos_mappings" edge.
+func HasPosMappings() predicate.PartOfSpeech {
+	return predicate.PartOfSpeech(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PosMappingsTable, PosMappingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPosMappingsWith applies the HasEdge predicate on the "pos_mappings" edge with a given conditions (other predicates).
+func HasPosMappingsWith(preds ...predicate.PosMapping) predicate.PartOfSpeech {
+	return predicate.PartOfSpeech(func(s *sql.Selector) {
+		step := newPosMappingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.PartOfSpeech) predicate.PartOfSpeech {
 	return predicate.PartOfSpeech(sql.AndPredicates(predicates...))
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
 	"word_app/backend/ent/wordinfo"
 
 	"entgo.io/ent/dialect/sql"
@@ -72,6 +73,21 @@ func (posc *PartOfSpeechCreate) AddWordInfos(w ...*WordInfo) *PartOfSpeechCreate
 	return posc.AddWordInfoIDs(ids...)
 }
 
+// AddPosMappingIDs adds the "pos_mappings" edge to the PosMapping entity by IDs.
+func (posc *PartOfSpeechCreate) AddPosMappingIDs(ids ...int) *PartOfSpeechCreate {
+	posc.mutation.AddPosMappingIDs(ids...)
+	return posc
+}
+
+// AddPosMappings adds the "pos_mappings" edges to the PosMapping entity.
+func (posc *PartOfSpeechCreate) AddPosMappings(p ...*PosMapping) *PartOfSpeechCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return posc.AddPosMappingIDs(ids...)
+}
+
 // Mutation returns the PartOfSpeechMutation object of the builder.
 func (posc *PartOfSpeechCreate) Mutation() *PartOfSpeechMutation {
 	return posc.mutation
@@ -188,6 +204,22 @@ func (posc *PartOfSpeechCreate) createSpec() (*PartOfSpeech, *sqlgraph.CreateSpe
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := posc.mutation.PosMappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
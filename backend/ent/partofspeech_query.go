@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"math"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/wordinfo"
 
@@ -20,12 +21,13 @@ import (
 // PartOfSpeechQuery is the builder for querying PartOfSpeech entities.
 type PartOfSpeechQuery struct {
 	config
-	ctx           *QueryContext
-	order         []partofspeech.OrderOption
-	inters        []Interceptor
-	predicates    []predicate.PartOfSpeech
-	withWordInfos *WordInfoQuery
-	modifiers     []func(*sql.Selector)
+	ctx             *QueryContext
+	order           []partofspeech.OrderOption
+	inters          []Interceptor
+	predicates      []predicate.PartOfSpeech
+	withWordInfos   *WordInfoQuery
+	withPosMappings *PosMappingQuery
+	modifiers       []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -84,6 +86,28 @@ func (posq *PartOfSpeechQuery) QueryWordInfos() *WordInfoQuery {
 	return query
 }
 
+// QueryPosMappings chains the current query on the "pos_mappings" edge.
+func (posq *PartOfSpeechQuery) QueryPosMappings() *PosMappingQuery {
+	query := (&PosMappingClient{config: posq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := posq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := posq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(partofspeech.Table, partofspeech.FieldID, selector),
+			sqlgraph.To(posmapping.Table, posmapping.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, partofspeech.PosMappingsTable, partofspeech.PosMappingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(posq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first PartOfSpeech entity from the query.
 // Returns a *NotFoundError when no PartOfSpeech was found.
 func (posq *PartOfSpeechQuery) First(ctx context.Context) (*PartOfSpeech, error) {
@@ -271,12 +295,13 @@ func (posq *PartOfSpeechQuery) Clone() *PartOfSpeechQuery {
 		return nil
 	}
 	return &PartOfSpeechQuery{
-		config:        posq.config,
-		ctx:           posq.ctx.Clone(),
-		order:         append([]partofspeech.OrderOption{}, posq.order...),
-		inters:        append([]Interceptor{}, posq.inters...),
-		predicates:    append([]predicate.PartOfSpeech{}, posq.predicates...),
-		withWordInfos: posq.withWordInfos.Clone(),
+		config:          posq.config,
+		ctx:             posq.ctx.Clone(),
+		order:           append([]partofspeech.OrderOption{}, posq.order...),
+		inters:          append([]Interceptor{}, posq.inters...),
+		predicates:      append([]predicate.PartOfSpeech{}, posq.predicates...),
+		withWordInfos:   posq.withWordInfos.Clone(),
+		withPosMappings: posq.withPosMappings.Clone(),
 		// clone intermediate query.
 		sql:       posq.sql.Clone(),
 		path:      posq.path,
@@ -295,6 +320,17 @@ func (posq *PartOfSpeechQuery) WithWordInfos(opts ...func(*WordInfoQuery)) *Part
 	return posq
 }
 
+// WithPosMappings tells the query-builder to eager-load the nodes that are connected to
+// the "pos_mappings" edge. The optional arguments are used to configure the query builder of the edge.
+func (posq *PartOfSpeechQuery) WithPosMappings(opts ...func(*PosMappingQuery)) *PartOfSpeechQuery {
+	query := (&PosMappingClient{config: posq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	posq.withPosMappings = query
+	return posq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -373,8 +409,9 @@ func (posq *PartOfSpeechQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 	var (
 		nodes       = []*PartOfSpeech{}
 		_spec       = posq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [2]bool{
 			posq.withWordInfos != nil,
+			posq.withPosMappings != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -405,6 +442,13 @@ func (posq *PartOfSpeechQuery) sqlAll(ctx context.Context, hooks ...queryHook) (
 			return nil, err
 		}
 	}
+	if query := posq.withPosMappings; query != nil {
+		if err := posq.loadPosMappings(ctx, query, nodes,
+			func(n *PartOfSpeech) { n.Edges.PosMappings = []*PosMapping{} },
+			func(n *PartOfSpeech, e *PosMapping) { n.Edges.PosMappings = append(n.Edges.PosMappings, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -438,6 +482,36 @@ func (posq *PartOfSpeechQuery) loadWordInfos(ctx context.Context, query *WordInf
 	}
 	return nil
 }
+func (posq *PartOfSpeechQuery) loadPosMappings(ctx context.Context, query *PosMappingQuery, nodes []*PartOfSpeech, init func(*PartOfSpeech), assign func(*PartOfSpeech, *PosMapping)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PartOfSpeech)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(posmapping.FieldPartOfSpeechID)
+	}
+	query.Where(predicate.PosMapping(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(partofspeech.PosMappingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PartOfSpeechID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "part_of_speech_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (posq *PartOfSpeechQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := posq.querySpec()
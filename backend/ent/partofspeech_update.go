@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/wordinfo"
 
@@ -79,6 +80,21 @@ func (posu *PartOfSpeechUpdate) AddWordInfos(w ...*WordInfo) *PartOfSpeechUpdate
 	return posu.AddWordInfoIDs(ids...)
 }
 
+// AddPosMappingIDs adds the "pos_mappings" edge to the PosMapping entity by IDs.
+func (posu *PartOfSpeechUpdate) AddPosMappingIDs(ids ...int) *PartOfSpeechUpdate {
+	posu.mutation.AddPosMappingIDs(ids...)
+	return posu
+}
+
+// AddPosMappings adds the "pos_mappings" edges to the PosMapping entity.
+func (posu *PartOfSpeechUpdate) AddPosMappings(p ...*PosMapping) *PartOfSpeechUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return posu.AddPosMappingIDs(ids...)
+}
+
 // Mutation returns the PartOfSpeechMutation object of the builder.
 func (posu *PartOfSpeechUpdate) Mutation() *PartOfSpeechMutation {
 	return posu.mutation
@@ -105,6 +121,27 @@ func (posu *PartOfSpeechUpdate) RemoveWordInfos(w ...*WordInfo) *PartOfSpeechUpd
 	return posu.RemoveWordInfoIDs(ids...)
 }
 
+// ClearPosMappings clears all "pos_mappings" edges to the PosMapping entity.
+func (posu *PartOfSpeechUpdate) ClearPosMappings() *PartOfSpeechUpdate {
+	posu.mutation.ClearPosMappings()
+	return posu
+}
+
+// RemovePosMappingIDs removes the "pos_mappings" edge to PosMapping entities by IDs.
+func (posu *PartOfSpeechUpdate) RemovePosMappingIDs(ids ...int) *PartOfSpeechUpdate {
+	posu.mutation.RemovePosMappingIDs(ids...)
+	return posu
+}
+
+// RemovePosMappings removes "pos_mappings" edges to PosMapping entities.
+func (posu *PartOfSpeechUpdate) RemovePosMappings(p ...*PosMapping) *PartOfSpeechUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return posu.RemovePosMappingIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (posu *PartOfSpeechUpdate) Save(ctx context.Context) (int, error) {
 	posu.defaults()
@@ -223,6 +260,51 @@ func (posu *PartOfSpeechUpdate) sqlSave(ctx context.Context) (n int, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if posu.mutation.PosMappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := posu.mutation.RemovedPosMappingsIDs(); len(nodes) > 0 && !posu.mutation.PosMappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := posu.mutation.PosMappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(posu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, posu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -294,6 +376,21 @@ func (posuo *PartOfSpeechUpdateOne) AddWordInfos(w ...*WordInfo) *PartOfSpeechUp
 	return posuo.AddWordInfoIDs(ids...)
 }
 
+// AddPosMappingIDs adds the "pos_mappings" edge to the PosMapping entity by IDs.
+func (posuo *PartOfSpeechUpdateOne) AddPosMappingIDs(ids ...int) *PartOfSpeechUpdateOne {
+	posuo.mutation.AddPosMappingIDs(ids...)
+	return posuo
+}
+
+// AddPosMappings adds the "pos_mappings" edges to the PosMapping entity.
+func (posuo *PartOfSpeechUpdateOne) AddPosMappings(p ...*PosMapping) *PartOfSpeechUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return posuo.AddPosMappingIDs(ids...)
+}
+
 // Mutation returns the PartOfSpeechMutation object of the builder.
 func (posuo *PartOfSpeechUpdateOne) Mutation() *PartOfSpeechMutation {
 	return posuo.mutation
@@ -320,6 +417,27 @@ func (posuo *PartOfSpeechUpdateOne) RemoveWordInfos(w ...*WordInfo) *PartOfSpeec
 	return posuo.RemoveWordInfoIDs(ids...)
 }
 
+// ClearPosMappings clears all "pos_mappings" edges to the PosMapping entity.
+func (posuo *PartOfSpeechUpdateOne) ClearPosMappings() *PartOfSpeechUpdateOne {
+	posuo.mutation.ClearPosMappings()
+	return posuo
+}
+
+// RemovePosMappingIDs removes the "pos_mappings" edge to PosMapping entities by IDs.
+func (posuo *PartOfSpeechUpdateOne) RemovePosMappingIDs(ids ...int) *PartOfSpeechUpdateOne {
+	posuo.mutation.RemovePosMappingIDs(ids...)
+	return posuo
+}
+
+// RemovePosMappings removes "pos_mappings" edges to PosMapping entities.
+func (posuo *PartOfSpeechUpdateOne) RemovePosMappings(p ...*PosMapping) *PartOfSpeechUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return posuo.RemovePosMappingIDs(ids...)
+}
+
 // Where appends a list predicates to the PartOfSpeechUpdate builder.
 func (posuo *PartOfSpeechUpdateOne) Where(ps ...predicate.PartOfSpeech) *PartOfSpeechUpdateOne {
 	posuo.mutation.Where(ps...)
@@ -468,6 +586,51 @@ func (posuo *PartOfSpeechUpdateOne) sqlSave(ctx context.Context) (_node *PartOfS
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if posuo.mutation.PosMappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := posuo.mutation.RemovedPosMappingsIDs(); len(nodes) > 0 && !posuo.mutation.PosMappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := posuo.mutation.PosMappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   partofspeech.PosMappingsTable,
+			Columns: []string{partofspeech.PosMappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(posuo.modifiers...)
 	_node = &PartOfSpeech{config: posuo.config}
 	_spec.Assign = _node.assignValues
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PosMapping is the model entity for the PosMapping schema.
+type PosMapping struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// VersionID holds the value of the "version_id" field.
+	VersionID int `json:"version_id,omitempty"`
+	// JMdict の品詞コード（n, v5k, adj-no など）
+	Code string `json:"code,omitempty"`
+	// PartOfSpeechID holds the value of the "part_of_speech_id" field.
+	PartOfSpeechID int `json:"part_of_speech_id,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PosMappingQuery when eager-loading is set.
+	Edges        PosMappingEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PosMappingEdges holds the relations/edges for other nodes in the graph.
+type PosMappingEdges struct {
+	// Version holds the value of the version edge.
+	Version *PosMappingVersion `json:"version,omitempty"`
+	// PartOfSpeech holds the value of the part_of_speech edge.
+	PartOfSpeech *PartOfSpeech `json:"part_of_speech,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// VersionOrErr returns the Version value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosMappingEdges) VersionOrErr() (*PosMappingVersion, error) {
+	if e.Version != nil {
+		return e.Version, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: posmappingversion.Label}
+	}
+	return nil, &NotLoadedError{edge: "version"}
+}
+
+// PartOfSpeechOrErr returns the PartOfSpeech value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PosMappingEdges) PartOfSpeechOrErr() (*PartOfSpeech, error) {
+	if e.PartOfSpeech != nil {
+		return e.PartOfSpeech, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: partofspeech.Label}
+	}
+	return nil, &NotLoadedError{edge: "part_of_speech"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PosMapping) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case posmapping.FieldID, posmapping.FieldVersionID, posmapping.FieldPartOfSpeechID:
+			values[i] = new(sql.NullInt64)
+		case posmapping.FieldCode:
+			values[i] = new(sql.NullString)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PosMapping fields.
+func (pm *PosMapping) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case posmapping.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pm.ID = int(value.Int64)
+		case posmapping.FieldVersionID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version_id", values[i])
+			} else if value.Valid {
+				pm.VersionID = int(value.Int64)
+			}
+		case posmapping.FieldCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field code", values[i])
+			} else if value.Valid {
+				pm.Code = value.String
+			}
+		case posmapping.FieldPartOfSpeechID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field part_of_speech_id", values[i])
+			} else if value.Valid {
+				pm.PartOfSpeechID = int(value.Int64)
+			}
+		default:
+			pm.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PosMapping.
+// This includes values selected through modifiers, order, etc.
+func (pm *PosMapping) Value(name string) (ent.Value, error) {
+	return pm.selectValues.Get(name)
+}
+
+// QueryVersion queries the "version" edge of the PosMapping entity.
+func (pm *PosMapping) QueryVersion() *PosMappingVersionQuery {
+	return NewPosMappingClient(pm.config).QueryVersion(pm)
+}
+
+// QueryPartOfSpeech queries the "part_of_speech" edge of the PosMapping entity.
+func (pm *PosMapping) QueryPartOfSpeech() *PartOfSpeechQuery {
+	return NewPosMappingClient(pm.config).QueryPartOfSpeech(pm)
+}
+
+// Update returns a builder for updating this PosMapping.
+// Note that you need to call PosMapping.Unwrap() before calling this method if this PosMapping
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pm *PosMapping) Update() *PosMappingUpdateOne {
+	return NewPosMappingClient(pm.config).UpdateOne(pm)
+}
+
+// Unwrap unwraps the PosMapping entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pm *PosMapping) Unwrap() *PosMapping {
+	_tx, ok := pm.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PosMapping is not a transactional entity")
+	}
+	pm.config.driver = _tx.drv
+	return pm
+}
+
+// String implements the fmt.Stringer.
+func (pm *PosMapping) String() string {
+	var builder strings.Builder
+	builder.WriteString("PosMapping(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pm.ID))
+	builder.WriteString("version_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.VersionID))
+	builder.WriteString(", ")
+	builder.WriteString("code=")
+	builder.WriteString(pm.Code)
+	builder.WriteString(", ")
+	builder.WriteString("part_of_speech_id=")
+	builder.WriteString(fmt.Sprintf("%v", pm.PartOfSpeechID))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PosMappings is a parsable slice of PosMapping.
+type PosMappings []*PosMapping
@@ -0,0 +1,117 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posmapping
+
+import (
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the posmapping type in the database.
+	Label = "pos_mapping"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldVersionID holds the string denoting the version_id field in the database.
+	FieldVersionID = "version_id"
+	// FieldCode holds the string denoting the code field in the database.
+	FieldCode = "code"
+	// FieldPartOfSpeechID holds the string denoting the part_of_speech_id field in the database.
+	FieldPartOfSpeechID = "part_of_speech_id"
+	// EdgeVersion holds the string denoting the version edge name in mutations.
+	EdgeVersion = "version"
+	// EdgePartOfSpeech holds the string denoting the part_of_speech edge name in mutations.
+	EdgePartOfSpeech = "part_of_speech"
+	// Table holds the table name of the posmapping in the database.
+	Table = "pos_mappings"
+	// VersionTable is the table that holds the version relation/edge.
+	VersionTable = "pos_mappings"
+	// VersionInverseTable is the table name for the PosMappingVersion entity.
+	// It exists in this package in order to avoid circular dependency with the "posmappingversion" package.
+	VersionInverseTable = "pos_mapping_versions"
+	// VersionColumn is the table column denoting the version relation/edge.
+	VersionColumn = "version_id"
+	// PartOfSpeechTable is the table that holds the part_of_speech relation/edge.
+	PartOfSpeechTable = "pos_mappings"
+	// PartOfSpeechInverseTable is the table name for the PartOfSpeech entity.
+	// It exists in this package in order to avoid circular dependency with the "partofspeech" package.
+	PartOfSpeechInverseTable = "part_of_speeches"
+	// PartOfSpeechColumn is the table column denoting the part_of_speech relation/edge.
+	PartOfSpeechColumn = "part_of_speech_id"
+)
+
+// Columns holds all SQL columns for posmapping fields.
+var Columns = []string{
+	FieldID,
+	FieldVersionID,
+	FieldCode,
+	FieldPartOfSpeechID,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	CodeValidator func(string) error
+	// PartOfSpeechIDValidator is a validator for the "part_of_speech_id" field. It is called by the builders before save.
+	PartOfSpeechIDValidator func(int) error
+)
+
+// OrderOption defines the ordering options for the PosMapping queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByVersionID orders the results by the version_id field.
+func ByVersionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersionID, opts...).ToFunc()
+}
+
+// ByCode orders the results by the code field.
+func ByCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCode, opts...).ToFunc()
+}
+
+// ByPartOfSpeechID orders the results by the part_of_speech_id field.
+func ByPartOfSpeechID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPartOfSpeechID, opts...).ToFunc()
+}
+
+// ByVersionField orders the results by version field.
+func ByVersionField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newVersionStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByPartOfSpeechField orders the results by part_of_speech field.
+func ByPartOfSpeechField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPartOfSpeechStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newVersionStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(VersionInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, VersionTable, VersionColumn),
+	)
+}
+func newPartOfSpeechStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PartOfSpeechInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PartOfSpeechTable, PartOfSpeechColumn),
+	)
+}
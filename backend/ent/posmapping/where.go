@@ -0,0 +1,236 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posmapping
+
+import (
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldLTE(FieldID, id))
+}
+
+// VersionID applies equality check predicate on the "version_id" field. It's identical to VersionIDEQ.
+func VersionID(v int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldVersionID, v))
+}
+
+// Code applies equality check predicate on the "code" field. It's identical to CodeEQ.
+func Code(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldCode, v))
+}
+
+// PartOfSpeechID applies equality check predicate on the "part_of_speech_id" field. It's identical to PartOfSpeechIDEQ.
+func PartOfSpeechID(v int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldPartOfSpeechID, v))
+}
+
+// VersionIDEQ applies the EQ predicate on the "version_id" field.
+func VersionIDEQ(v int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldVersionID, v))
+}
+
+// VersionIDNEQ applies the NEQ predicate on the "version_id" field.
+func VersionIDNEQ(v int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNEQ(FieldVersionID, v))
+}
+
+// VersionIDIn applies the In predicate on the "version_id" field.
+func VersionIDIn(vs ...int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldIn(FieldVersionID, vs...))
+}
+
+// VersionIDNotIn applies the NotIn predicate on the "version_id" field.
+func VersionIDNotIn(vs ...int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNotIn(FieldVersionID, vs...))
+}
+
+// CodeEQ applies the EQ predicate on the "code" field.
+func CodeEQ(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldCode, v))
+}
+
+// CodeNEQ applies the NEQ predicate on the "code" field.
+func CodeNEQ(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNEQ(FieldCode, v))
+}
+
+// CodeIn applies the In predicate on the "code" field.
+func CodeIn(vs ...string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldIn(FieldCode, vs...))
+}
+
+// CodeNotIn applies the NotIn predicate on the "code" field.
+func CodeNotIn(vs ...string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNotIn(FieldCode, vs...))
+}
+
+// CodeGT applies the GT predicate on the "code" field.
+func CodeGT(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldGT(FieldCode, v))
+}
+
+// CodeGTE applies the GTE predicate on the "code" field.
+func CodeGTE(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldGTE(FieldCode, v))
+}
+
+// CodeLT applies the LT predicate on the "code" field.
+func CodeLT(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldLT(FieldCode, v))
+}
+
+// CodeLTE applies the LTE predicate on the "code" field.
+func CodeLTE(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldLTE(FieldCode, v))
+}
+
+// CodeContains applies the Contains predicate on the "code" field.
+func CodeContains(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldContains(FieldCode, v))
+}
+
+// CodeHasPrefix applies the HasPrefix predicate on the "code" field.
+func CodeHasPrefix(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldHasPrefix(FieldCode, v))
+}
+
+// CodeHasSuffix applies the HasSuffix predicate on the "code" field.
+func CodeHasSuffix(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldHasSuffix(FieldCode, v))
+}
+
+// CodeEqualFold applies the EqualFold predicate on the "code" field.
+func CodeEqualFold(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEqualFold(FieldCode, v))
+}
+
+// CodeContainsFold applies the ContainsFold predicate on the "code" field.
+func CodeContainsFold(v string) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldContainsFold(FieldCode, v))
+}
+
+// PartOfSpeechIDEQ applies the EQ predicate on the "part_of_speech_id" field.
+func PartOfSpeechIDEQ(v int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldEQ(FieldPartOfSpeechID, v))
+}
+
+// PartOfSpeechIDNEQ applies the NEQ predicate on the "part_of_speech_id" field.
+func PartOfSpeechIDNEQ(v int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNEQ(FieldPartOfSpeechID, v))
+}
+
+// PartOfSpeechIDIn applies the In predicate on the "part_of_speech_id" field.
+func PartOfSpeechIDIn(vs ...int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldIn(FieldPartOfSpeechID, vs...))
+}
+
+// PartOfSpeechIDNotIn applies the NotIn predicate on the "part_of_speech_id" field.
+func PartOfSpeechIDNotIn(vs ...int) predicate.PosMapping {
+	return predicate.PosMapping(sql.FieldNotIn(FieldPartOfSpeechID, vs...))
+}
+
+// HasVersion applies the HasEdge predicate on the "version" edge.
+func HasVersion() predicate.PosMapping {
+	return predicate.PosMapping(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, VersionTable, VersionColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasVersionWith applies the HasEdge predicate on the "version" edge with a given conditions (other predicates).
+func HasVersionWith(preds ...predicate.PosMappingVersion) predicate.PosMapping {
+	return predicate.PosMapping(func(s *sql.Selector) {
+		step := newVersionStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPartOfSpeech applies the HasEdge predicate on the "part_of_speech" edge.
+func HasPartOfSpeech() predicate.PosMapping {
+	return predicate.PosMapping(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PartOfSpeechTable, PartOfSpeechColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPartOfSpeechWith applies the HasEdge predicate on the "part_of_speech" edge with a given conditions (other predicates).
+func HasPartOfSpeechWith(preds ...predicate.PartOfSpeech) predicate.PosMapping {
+	return predicate.PosMapping(func(s *sql.Selector) {
+		step := newPartOfSpeechStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PosMapping) predicate.PosMapping {
+	return predicate.PosMapping(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PosMapping) predicate.PosMapping {
+	return predicate.PosMapping(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PosMapping) predicate.PosMapping {
+	return predicate.PosMapping(sql.NotPredicates(p))
+}
@@ -0,0 +1,614 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingCreate is the builder for creating a PosMapping entity.
+type PosMappingCreate struct {
+	config
+	mutation *PosMappingMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetVersionID sets the "version_id" field.
+func (pmc *PosMappingCreate) SetVersionID(i int) *PosMappingCreate {
+	pmc.mutation.SetVersionID(i)
+	return pmc
+}
+
+// SetCode sets the "code" field.
+func (pmc *PosMappingCreate) SetCode(s string) *PosMappingCreate {
+	pmc.mutation.SetCode(s)
+	return pmc
+}
+
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (pmc *PosMappingCreate) SetPartOfSpeechID(i int) *PosMappingCreate {
+	pmc.mutation.SetPartOfSpeechID(i)
+	return pmc
+}
+
+// SetVersion sets the "version" edge to the PosMappingVersion entity.
+func (pmc *PosMappingCreate) SetVersion(p *PosMappingVersion) *PosMappingCreate {
+	return pmc.SetVersionID(p.ID)
+}
+
+// SetPartOfSpeech sets the "part_of_speech" edge to the PartOfSpeech entity.
+func (pmc *PosMappingCreate) SetPartOfSpeech(p *PartOfSpeech) *PosMappingCreate {
+	return pmc.SetPartOfSpeechID(p.ID)
+}
+
+// Mutation returns the PosMappingMutation object of the builder.
+func (pmc *PosMappingCreate) Mutation() *PosMappingMutation {
+	return pmc.mutation
+}
+
+// Save creates the PosMapping in the database.
+func (pmc *PosMappingCreate) Save(ctx context.Context) (*PosMapping, error) {
+	return withHooks(ctx, pmc.sqlSave, pmc.mutation, pmc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmc *PosMappingCreate) SaveX(ctx context.Context) *PosMapping {
+	v, err := pmc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmc *PosMappingCreate) Exec(ctx context.Context) error {
+	_, err := pmc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmc *PosMappingCreate) ExecX(ctx context.Context) {
+	if err := pmc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmc *PosMappingCreate) check() error {
+	if _, ok := pmc.mutation.VersionID(); !ok {
+		return &ValidationError{Name: "version_id", err: errors.New(`ent: missing required field "PosMapping.version_id"`)}
+	}
+	if _, ok := pmc.mutation.Code(); !ok {
+		return &ValidationError{Name: "code", err: errors.New(`ent: missing required field "PosMapping.code"`)}
+	}
+	if v, ok := pmc.mutation.Code(); ok {
+		if err := posmapping.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PosMapping.code": %w`, err)}
+		}
+	}
+	if _, ok := pmc.mutation.PartOfSpeechID(); !ok {
+		return &ValidationError{Name: "part_of_speech_id", err: errors.New(`ent: missing required field "PosMapping.part_of_speech_id"`)}
+	}
+	if v, ok := pmc.mutation.PartOfSpeechID(); ok {
+		if err := posmapping.PartOfSpeechIDValidator(v); err != nil {
+			return &ValidationError{Name: "part_of_speech_id", err: fmt.Errorf(`ent: validator failed for field "PosMapping.part_of_speech_id": %w`, err)}
+		}
+	}
+	if len(pmc.mutation.VersionIDs()) == 0 {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required edge "PosMapping.version"`)}
+	}
+	if len(pmc.mutation.PartOfSpeechIDs()) == 0 {
+		return &ValidationError{Name: "part_of_speech", err: errors.New(`ent: missing required edge "PosMapping.part_of_speech"`)}
+	}
+	return nil
+}
+
+func (pmc *PosMappingCreate) sqlSave(ctx context.Context) (*PosMapping, error) {
+	if err := pmc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pmc.mutation.id = &_node.ID
+	pmc.mutation.done = true
+	return _node, nil
+}
+
+func (pmc *PosMappingCreate) createSpec() (*PosMapping, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PosMapping{config: pmc.config}
+		_spec = sqlgraph.NewCreateSpec(posmapping.Table, sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pmc.conflict
+	if value, ok := pmc.mutation.Code(); ok {
+		_spec.SetField(posmapping.FieldCode, field.TypeString, value)
+		_node.Code = value
+	}
+	if nodes := pmc.mutation.VersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.VersionTable,
+			Columns: []string{posmapping.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VersionID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := pmc.mutation.PartOfSpeechIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.PartOfSpeechTable,
+			Columns: []string{posmapping.PartOfSpeechColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(partofspeech.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PartOfSpeechID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PosMapping.Create().
+//		SetVersionID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PosMappingUpsert) {
+//			SetVersionID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmc *PosMappingCreate) OnConflict(opts ...sql.ConflictOption) *PosMappingUpsertOne {
+	pmc.conflict = opts
+	return &PosMappingUpsertOne{
+		create: pmc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PosMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmc *PosMappingCreate) OnConflictColumns(columns ...string) *PosMappingUpsertOne {
+	pmc.conflict = append(pmc.conflict, sql.ConflictColumns(columns...))
+	return &PosMappingUpsertOne{
+		create: pmc,
+	}
+}
+
+type (
+	// PosMappingUpsertOne is the builder for "upsert"-ing
+	//  one PosMapping node.
+	PosMappingUpsertOne struct {
+		create *PosMappingCreate
+	}
+
+	// PosMappingUpsert is the "OnConflict" setter.
+	PosMappingUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetVersionID sets the "version_id" field.
+func (u *PosMappingUpsert) SetVersionID(v int) *PosMappingUpsert {
+	u.Set(posmapping.FieldVersionID, v)
+	return u
+}
+
+// UpdateVersionID sets the "version_id" field to the value that was provided on create.
+func (u *PosMappingUpsert) UpdateVersionID() *PosMappingUpsert {
+	u.SetExcluded(posmapping.FieldVersionID)
+	return u
+}
+
+// SetCode sets the "code" field.
+func (u *PosMappingUpsert) SetCode(v string) *PosMappingUpsert {
+	u.Set(posmapping.FieldCode, v)
+	return u
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PosMappingUpsert) UpdateCode() *PosMappingUpsert {
+	u.SetExcluded(posmapping.FieldCode)
+	return u
+}
+
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (u *PosMappingUpsert) SetPartOfSpeechID(v int) *PosMappingUpsert {
+	u.Set(posmapping.FieldPartOfSpeechID, v)
+	return u
+}
+
+// UpdatePartOfSpeechID sets the "part_of_speech_id" field to the value that was provided on create.
+func (u *PosMappingUpsert) UpdatePartOfSpeechID() *PosMappingUpsert {
+	u.SetExcluded(posmapping.FieldPartOfSpeechID)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PosMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PosMappingUpsertOne) UpdateNewValues() *PosMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PosMapping.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PosMappingUpsertOne) Ignore() *PosMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PosMappingUpsertOne) DoNothing() *PosMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PosMappingCreate.OnConflict
+// documentation for more info.
+func (u *PosMappingUpsertOne) Update(set func(*PosMappingUpsert)) *PosMappingUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PosMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVersionID sets the "version_id" field.
+func (u *PosMappingUpsertOne) SetVersionID(v int) *PosMappingUpsertOne {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.SetVersionID(v)
+	})
+}
+
+// UpdateVersionID sets the "version_id" field to the value that was provided on create.
+func (u *PosMappingUpsertOne) UpdateVersionID() *PosMappingUpsertOne {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.UpdateVersionID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *PosMappingUpsertOne) SetCode(v string) *PosMappingUpsertOne {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PosMappingUpsertOne) UpdateCode() *PosMappingUpsertOne {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (u *PosMappingUpsertOne) SetPartOfSpeechID(v int) *PosMappingUpsertOne {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.SetPartOfSpeechID(v)
+	})
+}
+
+// UpdatePartOfSpeechID sets the "part_of_speech_id" field to the value that was provided on create.
+func (u *PosMappingUpsertOne) UpdatePartOfSpeechID() *PosMappingUpsertOne {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.UpdatePartOfSpeechID()
+	})
+}
+
+// Exec executes the query.
+func (u *PosMappingUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PosMappingCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PosMappingUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PosMappingUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PosMappingUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PosMappingCreateBulk is the builder for creating many PosMapping entities in bulk.
+type PosMappingCreateBulk struct {
+	config
+	err      error
+	builders []*PosMappingCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PosMapping entities in the database.
+func (pmcb *PosMappingCreateBulk) Save(ctx context.Context) ([]*PosMapping, error) {
+	if pmcb.err != nil {
+		return nil, pmcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmcb.builders))
+	nodes := make([]*PosMapping, len(pmcb.builders))
+	mutators := make([]Mutator, len(pmcb.builders))
+	for i := range pmcb.builders {
+		func(i int, root context.Context) {
+			builder := pmcb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PosMappingMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmcb *PosMappingCreateBulk) SaveX(ctx context.Context) []*PosMapping {
+	v, err := pmcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmcb *PosMappingCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmcb *PosMappingCreateBulk) ExecX(ctx context.Context) {
+	if err := pmcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PosMapping.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PosMappingUpsert) {
+//			SetVersionID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmcb *PosMappingCreateBulk) OnConflict(opts ...sql.ConflictOption) *PosMappingUpsertBulk {
+	pmcb.conflict = opts
+	return &PosMappingUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PosMapping.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmcb *PosMappingCreateBulk) OnConflictColumns(columns ...string) *PosMappingUpsertBulk {
+	pmcb.conflict = append(pmcb.conflict, sql.ConflictColumns(columns...))
+	return &PosMappingUpsertBulk{
+		create: pmcb,
+	}
+}
+
+// PosMappingUpsertBulk is the builder for "upsert"-ing
+// a bulk of PosMapping nodes.
+type PosMappingUpsertBulk struct {
+	create *PosMappingCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PosMapping.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PosMappingUpsertBulk) UpdateNewValues() *PosMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PosMapping.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PosMappingUpsertBulk) Ignore() *PosMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PosMappingUpsertBulk) DoNothing() *PosMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PosMappingCreateBulk.OnConflict
+// documentation for more info.
+func (u *PosMappingUpsertBulk) Update(set func(*PosMappingUpsert)) *PosMappingUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PosMappingUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetVersionID sets the "version_id" field.
+func (u *PosMappingUpsertBulk) SetVersionID(v int) *PosMappingUpsertBulk {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.SetVersionID(v)
+	})
+}
+
+// UpdateVersionID sets the "version_id" field to the value that was provided on create.
+func (u *PosMappingUpsertBulk) UpdateVersionID() *PosMappingUpsertBulk {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.UpdateVersionID()
+	})
+}
+
+// SetCode sets the "code" field.
+func (u *PosMappingUpsertBulk) SetCode(v string) *PosMappingUpsertBulk {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.SetCode(v)
+	})
+}
+
+// UpdateCode sets the "code" field to the value that was provided on create.
+func (u *PosMappingUpsertBulk) UpdateCode() *PosMappingUpsertBulk {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.UpdateCode()
+	})
+}
+
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (u *PosMappingUpsertBulk) SetPartOfSpeechID(v int) *PosMappingUpsertBulk {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.SetPartOfSpeechID(v)
+	})
+}
+
+// UpdatePartOfSpeechID sets the "part_of_speech_id" field to the value that was provided on create.
+func (u *PosMappingUpsertBulk) UpdatePartOfSpeechID() *PosMappingUpsertBulk {
+	return u.Update(func(s *PosMappingUpsert) {
+		s.UpdatePartOfSpeechID()
+	})
+}
+
+// Exec executes the query.
+func (u *PosMappingUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PosMappingCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PosMappingCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PosMappingUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingDelete is the builder for deleting a PosMapping entity.
+type PosMappingDelete struct {
+	config
+	hooks    []Hook
+	mutation *PosMappingMutation
+}
+
+// Where appends a list predicates to the PosMappingDelete builder.
+func (pmd *PosMappingDelete) Where(ps ...predicate.PosMapping) *PosMappingDelete {
+	pmd.mutation.Where(ps...)
+	return pmd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pmd *PosMappingDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmd.sqlExec, pmd.mutation, pmd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmd *PosMappingDelete) ExecX(ctx context.Context) int {
+	n, err := pmd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pmd *PosMappingDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(posmapping.Table, sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt))
+	if ps := pmd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pmd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pmd.mutation.done = true
+	return affected, err
+}
+
+// PosMappingDeleteOne is the builder for deleting a single PosMapping entity.
+type PosMappingDeleteOne struct {
+	pmd *PosMappingDelete
+}
+
+// Where appends a list predicates to the PosMappingDelete builder.
+func (pmdo *PosMappingDeleteOne) Where(ps ...predicate.PosMapping) *PosMappingDeleteOne {
+	pmdo.pmd.mutation.Where(ps...)
+	return pmdo
+}
+
+// Exec executes the deletion query.
+func (pmdo *PosMappingDeleteOne) Exec(ctx context.Context) error {
+	n, err := pmdo.pmd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{posmapping.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmdo *PosMappingDeleteOne) ExecX(ctx context.Context) {
+	if err := pmdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
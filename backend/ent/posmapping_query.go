@@ -0,0 +1,704 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingQuery is the builder for querying PosMapping entities.
+type PosMappingQuery struct {
+	config
+	ctx              *QueryContext
+	order            []posmapping.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.PosMapping
+	withVersion      *PosMappingVersionQuery
+	withPartOfSpeech *PartOfSpeechQuery
+	modifiers        []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PosMappingQuery builder.
+func (pmq *PosMappingQuery) Where(ps ...predicate.PosMapping) *PosMappingQuery {
+	pmq.predicates = append(pmq.predicates, ps...)
+	return pmq
+}
+
+// Limit the number of records to be returned by this query.
+func (pmq *PosMappingQuery) Limit(limit int) *PosMappingQuery {
+	pmq.ctx.Limit = &limit
+	return pmq
+}
+
+// Offset to start from.
+func (pmq *PosMappingQuery) Offset(offset int) *PosMappingQuery {
+	pmq.ctx.Offset = &offset
+	return pmq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pmq *PosMappingQuery) Unique(unique bool) *PosMappingQuery {
+	pmq.ctx.Unique = &unique
+	return pmq
+}
+
+// Order specifies how the records should be ordered.
+func (pmq *PosMappingQuery) Order(o ...posmapping.OrderOption) *PosMappingQuery {
+	pmq.order = append(pmq.order, o...)
+	return pmq
+}
+
+// QueryVersion chains the current query on the "version" edge.
+func (pmq *PosMappingQuery) QueryVersion() *PosMappingVersionQuery {
+	query := (&PosMappingVersionClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posmapping.Table, posmapping.FieldID, selector),
+			sqlgraph.To(posmappingversion.Table, posmappingversion.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, posmapping.VersionTable, posmapping.VersionColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryPartOfSpeech chains the current query on the "part_of_speech" edge.
+func (pmq *PosMappingQuery) QueryPartOfSpeech() *PartOfSpeechQuery {
+	query := (&PartOfSpeechClient{config: pmq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posmapping.Table, posmapping.FieldID, selector),
+			sqlgraph.To(partofspeech.Table, partofspeech.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, posmapping.PartOfSpeechTable, posmapping.PartOfSpeechColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PosMapping entity from the query.
+// Returns a *NotFoundError when no PosMapping was found.
+func (pmq *PosMappingQuery) First(ctx context.Context) (*PosMapping, error) {
+	nodes, err := pmq.Limit(1).All(setContextOp(ctx, pmq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{posmapping.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pmq *PosMappingQuery) FirstX(ctx context.Context) *PosMapping {
+	node, err := pmq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PosMapping ID from the query.
+// Returns a *NotFoundError when no PosMapping ID was found.
+func (pmq *PosMappingQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(1).IDs(setContextOp(ctx, pmq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{posmapping.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pmq *PosMappingQuery) FirstIDX(ctx context.Context) int {
+	id, err := pmq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PosMapping entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PosMapping entity is found.
+// Returns a *NotFoundError when no PosMapping entities are found.
+func (pmq *PosMappingQuery) Only(ctx context.Context) (*PosMapping, error) {
+	nodes, err := pmq.Limit(2).All(setContextOp(ctx, pmq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{posmapping.Label}
+	default:
+		return nil, &NotSingularError{posmapping.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pmq *PosMappingQuery) OnlyX(ctx context.Context) *PosMapping {
+	node, err := pmq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PosMapping ID in the query.
+// Returns a *NotSingularError when more than one PosMapping ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pmq *PosMappingQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmq.Limit(2).IDs(setContextOp(ctx, pmq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{posmapping.Label}
+	default:
+		err = &NotSingularError{posmapping.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pmq *PosMappingQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pmq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PosMappings.
+func (pmq *PosMappingQuery) All(ctx context.Context) ([]*PosMapping, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryAll)
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PosMapping, *PosMappingQuery]()
+	return withInterceptors[[]*PosMapping](ctx, pmq, qr, pmq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pmq *PosMappingQuery) AllX(ctx context.Context) []*PosMapping {
+	nodes, err := pmq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PosMapping IDs.
+func (pmq *PosMappingQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pmq.ctx.Unique == nil && pmq.path != nil {
+		pmq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryIDs)
+	if err = pmq.Select(posmapping.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pmq *PosMappingQuery) IDsX(ctx context.Context) []int {
+	ids, err := pmq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pmq *PosMappingQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryCount)
+	if err := pmq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pmq, querierCount[*PosMappingQuery](), pmq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pmq *PosMappingQuery) CountX(ctx context.Context) int {
+	count, err := pmq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pmq *PosMappingQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pmq.ctx, ent.OpQueryExist)
+	switch _, err := pmq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pmq *PosMappingQuery) ExistX(ctx context.Context) bool {
+	exist, err := pmq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PosMappingQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pmq *PosMappingQuery) Clone() *PosMappingQuery {
+	if pmq == nil {
+		return nil
+	}
+	return &PosMappingQuery{
+		config:           pmq.config,
+		ctx:              pmq.ctx.Clone(),
+		order:            append([]posmapping.OrderOption{}, pmq.order...),
+		inters:           append([]Interceptor{}, pmq.inters...),
+		predicates:       append([]predicate.PosMapping{}, pmq.predicates...),
+		withVersion:      pmq.withVersion.Clone(),
+		withPartOfSpeech: pmq.withPartOfSpeech.Clone(),
+		// clone intermediate query.
+		sql:       pmq.sql.Clone(),
+		path:      pmq.path,
+		modifiers: append([]func(*sql.Selector){}, pmq.modifiers...),
+	}
+}
+
+// WithVersion tells the query-builder to eager-load the nodes that are connected to
+// the "version" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *PosMappingQuery) WithVersion(opts ...func(*PosMappingVersionQuery)) *PosMappingQuery {
+	query := (&PosMappingVersionClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withVersion = query
+	return pmq
+}
+
+// WithPartOfSpeech tells the query-builder to eager-load the nodes that are connected to
+// the "part_of_speech" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmq *PosMappingQuery) WithPartOfSpeech(opts ...func(*PartOfSpeechQuery)) *PosMappingQuery {
+	query := (&PartOfSpeechClient{config: pmq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmq.withPartOfSpeech = query
+	return pmq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		VersionID int `json:"version_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PosMapping.Query().
+//		GroupBy(posmapping.FieldVersionID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pmq *PosMappingQuery) GroupBy(field string, fields ...string) *PosMappingGroupBy {
+	pmq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PosMappingGroupBy{build: pmq}
+	grbuild.flds = &pmq.ctx.Fields
+	grbuild.label = posmapping.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		VersionID int `json:"version_id,omitempty"`
+//	}
+//
+//	client.PosMapping.Query().
+//		Select(posmapping.FieldVersionID).
+//		Scan(ctx, &v)
+func (pmq *PosMappingQuery) Select(fields ...string) *PosMappingSelect {
+	pmq.ctx.Fields = append(pmq.ctx.Fields, fields...)
+	sbuild := &PosMappingSelect{PosMappingQuery: pmq}
+	sbuild.label = posmapping.Label
+	sbuild.flds, sbuild.scan = &pmq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PosMappingSelect configured with the given aggregations.
+func (pmq *PosMappingQuery) Aggregate(fns ...AggregateFunc) *PosMappingSelect {
+	return pmq.Select().Aggregate(fns...)
+}
+
+func (pmq *PosMappingQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pmq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pmq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pmq.ctx.Fields {
+		if !posmapping.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pmq.path != nil {
+		prev, err := pmq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pmq.sql = prev
+	}
+	return nil
+}
+
+func (pmq *PosMappingQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PosMapping, error) {
+	var (
+		nodes       = []*PosMapping{}
+		_spec       = pmq.querySpec()
+		loadedTypes = [2]bool{
+			pmq.withVersion != nil,
+			pmq.withPartOfSpeech != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PosMapping).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PosMapping{config: pmq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pmq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pmq.withVersion; query != nil {
+		if err := pmq.loadVersion(ctx, query, nodes, nil,
+			func(n *PosMapping, e *PosMappingVersion) { n.Edges.Version = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := pmq.withPartOfSpeech; query != nil {
+		if err := pmq.loadPartOfSpeech(ctx, query, nodes, nil,
+			func(n *PosMapping, e *PartOfSpeech) { n.Edges.PartOfSpeech = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pmq *PosMappingQuery) loadVersion(ctx context.Context, query *PosMappingVersionQuery, nodes []*PosMapping, init func(*PosMapping), assign func(*PosMapping, *PosMappingVersion)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PosMapping)
+	for i := range nodes {
+		fk := nodes[i].VersionID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(posmappingversion.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "version_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (pmq *PosMappingQuery) loadPartOfSpeech(ctx context.Context, query *PartOfSpeechQuery, nodes []*PosMapping, init func(*PosMapping), assign func(*PosMapping, *PartOfSpeech)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PosMapping)
+	for i := range nodes {
+		fk := nodes[i].PartOfSpeechID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(partofspeech.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "part_of_speech_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (pmq *PosMappingQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pmq.querySpec()
+	if len(pmq.modifiers) > 0 {
+		_spec.Modifiers = pmq.modifiers
+	}
+	_spec.Node.Columns = pmq.ctx.Fields
+	if len(pmq.ctx.Fields) > 0 {
+		_spec.Unique = pmq.ctx.Unique != nil && *pmq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pmq.driver, _spec)
+}
+
+func (pmq *PosMappingQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(posmapping.Table, posmapping.Columns, sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt))
+	_spec.From = pmq.sql
+	if unique := pmq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pmq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pmq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posmapping.FieldID)
+		for i := range fields {
+			if fields[i] != posmapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if pmq.withVersion != nil {
+			_spec.Node.AddColumnOnce(posmapping.FieldVersionID)
+		}
+		if pmq.withPartOfSpeech != nil {
+			_spec.Node.AddColumnOnce(posmapping.FieldPartOfSpeechID)
+		}
+	}
+	if ps := pmq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pmq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pmq *PosMappingQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pmq.driver.Dialect())
+	t1 := builder.Table(posmapping.Table)
+	columns := pmq.ctx.Fields
+	if len(columns) == 0 {
+		columns = posmapping.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pmq.sql != nil {
+		selector = pmq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pmq.ctx.Unique != nil && *pmq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pmq.modifiers {
+		m(selector)
+	}
+	for _, p := range pmq.predicates {
+		p(selector)
+	}
+	for _, p := range pmq.order {
+		p(selector)
+	}
+	if offset := pmq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pmq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pmq *PosMappingQuery) Modify(modifiers ...func(s *sql.Selector)) *PosMappingSelect {
+	pmq.modifiers = append(pmq.modifiers, modifiers...)
+	return pmq.Select()
+}
+
+// PosMappingGroupBy is the group-by builder for PosMapping entities.
+type PosMappingGroupBy struct {
+	selector
+	build *PosMappingQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pmgb *PosMappingGroupBy) Aggregate(fns ...AggregateFunc) *PosMappingGroupBy {
+	pmgb.fns = append(pmgb.fns, fns...)
+	return pmgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pmgb *PosMappingGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pmgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pmgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PosMappingQuery, *PosMappingGroupBy](ctx, pmgb.build, pmgb, pmgb.build.inters, v)
+}
+
+func (pmgb *PosMappingGroupBy) sqlScan(ctx context.Context, root *PosMappingQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pmgb.fns))
+	for _, fn := range pmgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pmgb.flds)+len(pmgb.fns))
+		for _, f := range *pmgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pmgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PosMappingSelect is the builder for selecting fields of PosMapping entities.
+type PosMappingSelect struct {
+	*PosMappingQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pms *PosMappingSelect) Aggregate(fns ...AggregateFunc) *PosMappingSelect {
+	pms.fns = append(pms.fns, fns...)
+	return pms
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pms *PosMappingSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pms.ctx, ent.OpQuerySelect)
+	if err := pms.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PosMappingQuery, *PosMappingSelect](ctx, pms.PosMappingQuery, pms, pms.inters, v)
+}
+
+func (pms *PosMappingSelect) sqlScan(ctx context.Context, root *PosMappingQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pms.fns))
+	for _, fn := range pms.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pms.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pms.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pms *PosMappingSelect) Modify(modifiers ...func(s *sql.Selector)) *PosMappingSelect {
+	pms.modifiers = append(pms.modifiers, modifiers...)
+	return pms
+}
@@ -0,0 +1,491 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingUpdate is the builder for updating PosMapping entities.
+type PosMappingUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PosMappingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PosMappingUpdate builder.
+func (pmu *PosMappingUpdate) Where(ps ...predicate.PosMapping) *PosMappingUpdate {
+	pmu.mutation.Where(ps...)
+	return pmu
+}
+
+// SetVersionID sets the "version_id" field.
+func (pmu *PosMappingUpdate) SetVersionID(i int) *PosMappingUpdate {
+	pmu.mutation.SetVersionID(i)
+	return pmu
+}
+
+// SetNillableVersionID sets the "version_id" field if the given value is not nil.
+func (pmu *PosMappingUpdate) SetNillableVersionID(i *int) *PosMappingUpdate {
+	if i != nil {
+		pmu.SetVersionID(*i)
+	}
+	return pmu
+}
+
+// SetCode sets the "code" field.
+func (pmu *PosMappingUpdate) SetCode(s string) *PosMappingUpdate {
+	pmu.mutation.SetCode(s)
+	return pmu
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (pmu *PosMappingUpdate) SetNillableCode(s *string) *PosMappingUpdate {
+	if s != nil {
+		pmu.SetCode(*s)
+	}
+	return pmu
+}
+
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (pmu *PosMappingUpdate) SetPartOfSpeechID(i int) *PosMappingUpdate {
+	pmu.mutation.SetPartOfSpeechID(i)
+	return pmu
+}
+
+// SetNillablePartOfSpeechID sets the "part_of_speech_id" field if the given value is not nil.
+func (pmu *PosMappingUpdate) SetNillablePartOfSpeechID(i *int) *PosMappingUpdate {
+	if i != nil {
+		pmu.SetPartOfSpeechID(*i)
+	}
+	return pmu
+}
+
+// SetVersion sets the "version" edge to the PosMappingVersion entity.
+func (pmu *PosMappingUpdate) SetVersion(p *PosMappingVersion) *PosMappingUpdate {
+	return pmu.SetVersionID(p.ID)
+}
+
+// SetPartOfSpeech sets the "part_of_speech" edge to the PartOfSpeech entity.
+func (pmu *PosMappingUpdate) SetPartOfSpeech(p *PartOfSpeech) *PosMappingUpdate {
+	return pmu.SetPartOfSpeechID(p.ID)
+}
+
+// Mutation returns the PosMappingMutation object of the builder.
+func (pmu *PosMappingUpdate) Mutation() *PosMappingMutation {
+	return pmu.mutation
+}
+
+// ClearVersion clears the "version" edge to the PosMappingVersion entity.
+func (pmu *PosMappingUpdate) ClearVersion() *PosMappingUpdate {
+	pmu.mutation.ClearVersion()
+	return pmu
+}
+
+// ClearPartOfSpeech clears the "part_of_speech" edge to the PartOfSpeech entity.
+func (pmu *PosMappingUpdate) ClearPartOfSpeech() *PosMappingUpdate {
+	pmu.mutation.ClearPartOfSpeech()
+	return pmu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmu *PosMappingUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmu.sqlSave, pmu.mutation, pmu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmu *PosMappingUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmu *PosMappingUpdate) Exec(ctx context.Context) error {
+	_, err := pmu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmu *PosMappingUpdate) ExecX(ctx context.Context) {
+	if err := pmu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmu *PosMappingUpdate) check() error {
+	if v, ok := pmu.mutation.Code(); ok {
+		if err := posmapping.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PosMapping.code": %w`, err)}
+		}
+	}
+	if v, ok := pmu.mutation.PartOfSpeechID(); ok {
+		if err := posmapping.PartOfSpeechIDValidator(v); err != nil {
+			return &ValidationError{Name: "part_of_speech_id", err: fmt.Errorf(`ent: validator failed for field "PosMapping.part_of_speech_id": %w`, err)}
+		}
+	}
+	if pmu.mutation.VersionCleared() && len(pmu.mutation.VersionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosMapping.version"`)
+	}
+	if pmu.mutation.PartOfSpeechCleared() && len(pmu.mutation.PartOfSpeechIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosMapping.part_of_speech"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pmu *PosMappingUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosMappingUpdate {
+	pmu.modifiers = append(pmu.modifiers, modifiers...)
+	return pmu
+}
+
+func (pmu *PosMappingUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posmapping.Table, posmapping.Columns, sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt))
+	if ps := pmu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmu.mutation.Code(); ok {
+		_spec.SetField(posmapping.FieldCode, field.TypeString, value)
+	}
+	if pmu.mutation.VersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.VersionTable,
+			Columns: []string{posmapping.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.VersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.VersionTable,
+			Columns: []string{posmapping.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmu.mutation.PartOfSpeechCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.PartOfSpeechTable,
+			Columns: []string{posmapping.PartOfSpeechColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(partofspeech.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmu.mutation.PartOfSpeechIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.PartOfSpeechTable,
+			Columns: []string{posmapping.PartOfSpeechColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(partofspeech.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pmu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pmu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmu.mutation.done = true
+	return n, nil
+}
+
+// PosMappingUpdateOne is the builder for updating a single PosMapping entity.
+type PosMappingUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PosMappingMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetVersionID sets the "version_id" field.
+func (pmuo *PosMappingUpdateOne) SetVersionID(i int) *PosMappingUpdateOne {
+	pmuo.mutation.SetVersionID(i)
+	return pmuo
+}
+
+// SetNillableVersionID sets the "version_id" field if the given value is not nil.
+func (pmuo *PosMappingUpdateOne) SetNillableVersionID(i *int) *PosMappingUpdateOne {
+	if i != nil {
+		pmuo.SetVersionID(*i)
+	}
+	return pmuo
+}
+
+// SetCode sets the "code" field.
+func (pmuo *PosMappingUpdateOne) SetCode(s string) *PosMappingUpdateOne {
+	pmuo.mutation.SetCode(s)
+	return pmuo
+}
+
+// SetNillableCode sets the "code" field if the given value is not nil.
+func (pmuo *PosMappingUpdateOne) SetNillableCode(s *string) *PosMappingUpdateOne {
+	if s != nil {
+		pmuo.SetCode(*s)
+	}
+	return pmuo
+}
+
+// SetPartOfSpeechID sets the "part_of_speech_id" field.
+func (pmuo *PosMappingUpdateOne) SetPartOfSpeechID(i int) *PosMappingUpdateOne {
+	pmuo.mutation.SetPartOfSpeechID(i)
+	return pmuo
+}
+
+// SetNillablePartOfSpeechID sets the "part_of_speech_id" field if the given value is not nil.
+func (pmuo *PosMappingUpdateOne) SetNillablePartOfSpeechID(i *int) *PosMappingUpdateOne {
+	if i != nil {
+		pmuo.SetPartOfSpeechID(*i)
+	}
+	return pmuo
+}
+
+// SetVersion sets the "version" edge to the PosMappingVersion entity.
+func (pmuo *PosMappingUpdateOne) SetVersion(p *PosMappingVersion) *PosMappingUpdateOne {
+	return pmuo.SetVersionID(p.ID)
+}
+
+// SetPartOfSpeech sets the "part_of_speech" edge to the PartOfSpeech entity.
+func (pmuo *PosMappingUpdateOne) SetPartOfSpeech(p *PartOfSpeech) *PosMappingUpdateOne {
+	return pmuo.SetPartOfSpeechID(p.ID)
+}
+
+// Mutation returns the PosMappingMutation object of the builder.
+func (pmuo *PosMappingUpdateOne) Mutation() *PosMappingMutation {
+	return pmuo.mutation
+}
+
+// ClearVersion clears the "version" edge to the PosMappingVersion entity.
+func (pmuo *PosMappingUpdateOne) ClearVersion() *PosMappingUpdateOne {
+	pmuo.mutation.ClearVersion()
+	return pmuo
+}
+
+// ClearPartOfSpeech clears the "part_of_speech" edge to the PartOfSpeech entity.
+func (pmuo *PosMappingUpdateOne) ClearPartOfSpeech() *PosMappingUpdateOne {
+	pmuo.mutation.ClearPartOfSpeech()
+	return pmuo
+}
+
+// Where appends a list predicates to the PosMappingUpdate builder.
+func (pmuo *PosMappingUpdateOne) Where(ps ...predicate.PosMapping) *PosMappingUpdateOne {
+	pmuo.mutation.Where(ps...)
+	return pmuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmuo *PosMappingUpdateOne) Select(field string, fields ...string) *PosMappingUpdateOne {
+	pmuo.fields = append([]string{field}, fields...)
+	return pmuo
+}
+
+// Save executes the query and returns the updated PosMapping entity.
+func (pmuo *PosMappingUpdateOne) Save(ctx context.Context) (*PosMapping, error) {
+	return withHooks(ctx, pmuo.sqlSave, pmuo.mutation, pmuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmuo *PosMappingUpdateOne) SaveX(ctx context.Context) *PosMapping {
+	node, err := pmuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmuo *PosMappingUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmuo *PosMappingUpdateOne) ExecX(ctx context.Context) {
+	if err := pmuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmuo *PosMappingUpdateOne) check() error {
+	if v, ok := pmuo.mutation.Code(); ok {
+		if err := posmapping.CodeValidator(v); err != nil {
+			return &ValidationError{Name: "code", err: fmt.Errorf(`ent: validator failed for field "PosMapping.code": %w`, err)}
+		}
+	}
+	if v, ok := pmuo.mutation.PartOfSpeechID(); ok {
+		if err := posmapping.PartOfSpeechIDValidator(v); err != nil {
+			return &ValidationError{Name: "part_of_speech_id", err: fmt.Errorf(`ent: validator failed for field "PosMapping.part_of_speech_id": %w`, err)}
+		}
+	}
+	if pmuo.mutation.VersionCleared() && len(pmuo.mutation.VersionIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosMapping.version"`)
+	}
+	if pmuo.mutation.PartOfSpeechCleared() && len(pmuo.mutation.PartOfSpeechIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PosMapping.part_of_speech"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pmuo *PosMappingUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosMappingUpdateOne {
+	pmuo.modifiers = append(pmuo.modifiers, modifiers...)
+	return pmuo
+}
+
+func (pmuo *PosMappingUpdateOne) sqlSave(ctx context.Context) (_node *PosMapping, err error) {
+	if err := pmuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posmapping.Table, posmapping.Columns, sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt))
+	id, ok := pmuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PosMapping.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posmapping.FieldID)
+		for _, f := range fields {
+			if !posmapping.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != posmapping.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmuo.mutation.Code(); ok {
+		_spec.SetField(posmapping.FieldCode, field.TypeString, value)
+	}
+	if pmuo.mutation.VersionCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.VersionTable,
+			Columns: []string{posmapping.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.VersionIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.VersionTable,
+			Columns: []string{posmapping.VersionColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pmuo.mutation.PartOfSpeechCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.PartOfSpeechTable,
+			Columns: []string{posmapping.PartOfSpeechColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(partofspeech.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmuo.mutation.PartOfSpeechIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   posmapping.PartOfSpeechTable,
+			Columns: []string{posmapping.PartOfSpeechColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(partofspeech.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pmuo.modifiers...)
+	_node = &PosMapping{config: pmuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posmapping.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,168 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"word_app/backend/ent/posmappingversion"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PosMappingVersion is the model entity for the PosMappingVersion schema.
+type PosMappingVersion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// 対応表に無いコードの品詞
+	FallbackPosID int `json:"fallback_pos_id,omitempty"`
+	// 変更内容のメモ
+	Note string `json:"note,omitempty"`
+	// 変更した root ユーザーの ID（シードは NULL）
+	CreatedBy *int `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PosMappingVersionQuery when eager-loading is set.
+	Edges        PosMappingVersionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PosMappingVersionEdges holds the relations/edges for other nodes in the graph.
+type PosMappingVersionEdges struct {
+	// Mappings holds the value of the mappings edge.
+	Mappings []*PosMapping `json:"mappings,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// MappingsOrErr returns the Mappings value or an error if the edge
+// was not loaded in eager-loading.
+func (e PosMappingVersionEdges) MappingsOrErr() ([]*PosMapping, error) {
+	if e.loadedTypes[0] {
+		return e.Mappings, nil
+	}
+	return nil, &NotLoadedError{edge: "mappings"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PosMappingVersion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case posmappingversion.FieldID, posmappingversion.FieldFallbackPosID, posmappingversion.FieldCreatedBy:
+			values[i] = new(sql.NullInt64)
+		case posmappingversion.FieldNote:
+			values[i] = new(sql.NullString)
+		case posmappingversion.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PosMappingVersion fields.
+func (pmv *PosMappingVersion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case posmappingversion.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pmv.ID = int(value.Int64)
+		case posmappingversion.FieldFallbackPosID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fallback_pos_id", values[i])
+			} else if value.Valid {
+				pmv.FallbackPosID = int(value.Int64)
+			}
+		case posmappingversion.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				pmv.Note = value.String
+			}
+		case posmappingversion.FieldCreatedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value.Valid {
+				pmv.CreatedBy = new(int)
+				*pmv.CreatedBy = int(value.Int64)
+			}
+		case posmappingversion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pmv.CreatedAt = value.Time
+			}
+		default:
+			pmv.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PosMappingVersion.
+// This includes values selected through modifiers, order, etc.
+func (pmv *PosMappingVersion) Value(name string) (ent.Value, error) {
+	return pmv.selectValues.Get(name)
+}
+
+// QueryMappings queries the "mappings" edge of the PosMappingVersion entity.
+func (pmv *PosMappingVersion) QueryMappings() *PosMappingQuery {
+	return NewPosMappingVersionClient(pmv.config).QueryMappings(pmv)
+}
+
+// Update returns a builder for updating this PosMappingVersion.
+// Note that you need to call PosMappingVersion.Unwrap() before calling this method if this PosMappingVersion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pmv *PosMappingVersion) Update() *PosMappingVersionUpdateOne {
+	return NewPosMappingVersionClient(pmv.config).UpdateOne(pmv)
+}
+
+// Unwrap unwraps the PosMappingVersion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pmv *PosMappingVersion) Unwrap() *PosMappingVersion {
+	_tx, ok := pmv.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PosMappingVersion is not a transactional entity")
+	}
+	pmv.config.driver = _tx.drv
+	return pmv
+}
+
+// String implements the fmt.Stringer.
+func (pmv *PosMappingVersion) String() string {
+	var builder strings.Builder
+	builder.WriteString("PosMappingVersion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pmv.ID))
+	builder.WriteString("fallback_pos_id=")
+	builder.WriteString(fmt.Sprintf("%v", pmv.FallbackPosID))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(pmv.Note)
+	builder.WriteString(", ")
+	if v := pmv.CreatedBy; v != nil {
+		builder.WriteString("created_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pmv.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PosMappingVersions is a parsable slice of PosMappingVersion.
+type PosMappingVersions []*PosMappingVersion
@@ -0,0 +1,111 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posmappingversion
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the posmappingversion type in the database.
+	Label = "pos_mapping_version"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldFallbackPosID holds the string denoting the fallback_pos_id field in the database.
+	FieldFallbackPosID = "fallback_pos_id"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeMappings holds the string denoting the mappings edge name in mutations.
+	EdgeMappings = "mappings"
+	// Table holds the table name of the posmappingversion in the database.
+	Table = "pos_mapping_versions"
+	// MappingsTable is the table that holds the mappings relation/edge.
+	MappingsTable = "pos_mappings"
+	// MappingsInverseTable is the table name for the PosMapping entity.
+	// It exists in this package in order to avoid circular dependency with the "posmapping" package.
+	MappingsInverseTable = "pos_mappings"
+	// MappingsColumn is the table column denoting the mappings relation/edge.
+	MappingsColumn = "version_id"
+)
+
+// Columns holds all SQL columns for posmappingversion fields.
+var Columns = []string{
+	FieldID,
+	FieldFallbackPosID,
+	FieldNote,
+	FieldCreatedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// FallbackPosIDValidator is a validator for the "fallback_pos_id" field. It is called by the builders before save.
+	FallbackPosIDValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the PosMappingVersion queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByFallbackPosID orders the results by the fallback_pos_id field.
+func ByFallbackPosID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFallbackPosID, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByMappingsCount orders the results by mappings count.
+func ByMappingsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newMappingsStep(), opts...)
+	}
+}
+
+// ByMappings orders the results by mappings terms.
+func ByMappings(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newMappingsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newMappingsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(MappingsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, MappingsTable, MappingsColumn),
+	)
+}
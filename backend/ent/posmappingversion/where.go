@@ -0,0 +1,319 @@
+// Code generated by ent, DO NOT EDIT.
+
+package posmappingversion
+
+import (
+	"time"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLTE(FieldID, id))
+}
+
+// FallbackPosID applies equality check predicate on the "fallback_pos_id" field. It's identical to FallbackPosIDEQ.
+func FallbackPosID(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldFallbackPosID, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// FallbackPosIDEQ applies the EQ predicate on the "fallback_pos_id" field.
+func FallbackPosIDEQ(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldFallbackPosID, v))
+}
+
+// FallbackPosIDNEQ applies the NEQ predicate on the "fallback_pos_id" field.
+func FallbackPosIDNEQ(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNEQ(FieldFallbackPosID, v))
+}
+
+// FallbackPosIDIn applies the In predicate on the "fallback_pos_id" field.
+func FallbackPosIDIn(vs ...int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIn(FieldFallbackPosID, vs...))
+}
+
+// FallbackPosIDNotIn applies the NotIn predicate on the "fallback_pos_id" field.
+func FallbackPosIDNotIn(vs ...int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotIn(FieldFallbackPosID, vs...))
+}
+
+// FallbackPosIDGT applies the GT predicate on the "fallback_pos_id" field.
+func FallbackPosIDGT(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGT(FieldFallbackPosID, v))
+}
+
+// FallbackPosIDGTE applies the GTE predicate on the "fallback_pos_id" field.
+func FallbackPosIDGTE(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGTE(FieldFallbackPosID, v))
+}
+
+// FallbackPosIDLT applies the LT predicate on the "fallback_pos_id" field.
+func FallbackPosIDLT(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLT(FieldFallbackPosID, v))
+}
+
+// FallbackPosIDLTE applies the LTE predicate on the "fallback_pos_id" field.
+func FallbackPosIDLTE(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLTE(FieldFallbackPosID, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v int) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedByIsNil applies the IsNil predicate on the "created_by" field.
+func CreatedByIsNil() predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIsNull(FieldCreatedBy))
+}
+
+// CreatedByNotNil applies the NotNil predicate on the "created_by" field.
+func CreatedByNotNil() predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotNull(FieldCreatedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasMappings applies the HasEdge predicate on the "mappings" edge.
+func HasMappings() predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, MappingsTable, MappingsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasMappingsWith applies the HasEdge predicate on the "mappings" edge with a given conditions (other predicates).
+func HasMappingsWith(preds ...predicate.PosMapping) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(func(s *sql.Selector) {
+		step := newMappingsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PosMappingVersion) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PosMappingVersion) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PosMappingVersion) predicate.PosMappingVersion {
+	return predicate.PosMappingVersion(sql.NotPredicates(p))
+}
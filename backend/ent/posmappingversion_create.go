@@ -0,0 +1,731 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingVersionCreate is the builder for creating a PosMappingVersion entity.
+type PosMappingVersionCreate struct {
+	config
+	mutation *PosMappingVersionMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (pmvc *PosMappingVersionCreate) SetFallbackPosID(i int) *PosMappingVersionCreate {
+	pmvc.mutation.SetFallbackPosID(i)
+	return pmvc
+}
+
+// SetNote sets the "note" field.
+func (pmvc *PosMappingVersionCreate) SetNote(s string) *PosMappingVersionCreate {
+	pmvc.mutation.SetNote(s)
+	return pmvc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (pmvc *PosMappingVersionCreate) SetNillableNote(s *string) *PosMappingVersionCreate {
+	if s != nil {
+		pmvc.SetNote(*s)
+	}
+	return pmvc
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pmvc *PosMappingVersionCreate) SetCreatedBy(i int) *PosMappingVersionCreate {
+	pmvc.mutation.SetCreatedBy(i)
+	return pmvc
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pmvc *PosMappingVersionCreate) SetNillableCreatedBy(i *int) *PosMappingVersionCreate {
+	if i != nil {
+		pmvc.SetCreatedBy(*i)
+	}
+	return pmvc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pmvc *PosMappingVersionCreate) SetCreatedAt(t time.Time) *PosMappingVersionCreate {
+	pmvc.mutation.SetCreatedAt(t)
+	return pmvc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pmvc *PosMappingVersionCreate) SetNillableCreatedAt(t *time.Time) *PosMappingVersionCreate {
+	if t != nil {
+		pmvc.SetCreatedAt(*t)
+	}
+	return pmvc
+}
+
+// AddMappingIDs adds the "mappings" edge to the PosMapping entity by IDs.
+func (pmvc *PosMappingVersionCreate) AddMappingIDs(ids ...int) *PosMappingVersionCreate {
+	pmvc.mutation.AddMappingIDs(ids...)
+	return pmvc
+}
+
+// AddMappings adds the "mappings" edges to the PosMapping entity.
+func (pmvc *PosMappingVersionCreate) AddMappings(p ...*PosMapping) *PosMappingVersionCreate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pmvc.AddMappingIDs(ids...)
+}
+
+// Mutation returns the PosMappingVersionMutation object of the builder.
+func (pmvc *PosMappingVersionCreate) Mutation() *PosMappingVersionMutation {
+	return pmvc.mutation
+}
+
+// Save creates the PosMappingVersion in the database.
+func (pmvc *PosMappingVersionCreate) Save(ctx context.Context) (*PosMappingVersion, error) {
+	pmvc.defaults()
+	return withHooks(ctx, pmvc.sqlSave, pmvc.mutation, pmvc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pmvc *PosMappingVersionCreate) SaveX(ctx context.Context) *PosMappingVersion {
+	v, err := pmvc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmvc *PosMappingVersionCreate) Exec(ctx context.Context) error {
+	_, err := pmvc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmvc *PosMappingVersionCreate) ExecX(ctx context.Context) {
+	if err := pmvc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pmvc *PosMappingVersionCreate) defaults() {
+	if _, ok := pmvc.mutation.CreatedAt(); !ok {
+		v := posmappingversion.DefaultCreatedAt()
+		pmvc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmvc *PosMappingVersionCreate) check() error {
+	if _, ok := pmvc.mutation.FallbackPosID(); !ok {
+		return &ValidationError{Name: "fallback_pos_id", err: errors.New(`ent: missing required field "PosMappingVersion.fallback_pos_id"`)}
+	}
+	if v, ok := pmvc.mutation.FallbackPosID(); ok {
+		if err := posmappingversion.FallbackPosIDValidator(v); err != nil {
+			return &ValidationError{Name: "fallback_pos_id", err: fmt.Errorf(`ent: validator failed for field "PosMappingVersion.fallback_pos_id": %w`, err)}
+		}
+	}
+	if _, ok := pmvc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PosMappingVersion.created_at"`)}
+	}
+	return nil
+}
+
+func (pmvc *PosMappingVersionCreate) sqlSave(ctx context.Context) (*PosMappingVersion, error) {
+	if err := pmvc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pmvc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pmvc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pmvc.mutation.id = &_node.ID
+	pmvc.mutation.done = true
+	return _node, nil
+}
+
+func (pmvc *PosMappingVersionCreate) createSpec() (*PosMappingVersion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PosMappingVersion{config: pmvc.config}
+		_spec = sqlgraph.NewCreateSpec(posmappingversion.Table, sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = pmvc.conflict
+	if value, ok := pmvc.mutation.FallbackPosID(); ok {
+		_spec.SetField(posmappingversion.FieldFallbackPosID, field.TypeInt, value)
+		_node.FallbackPosID = value
+	}
+	if value, ok := pmvc.mutation.Note(); ok {
+		_spec.SetField(posmappingversion.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := pmvc.mutation.CreatedBy(); ok {
+		_spec.SetField(posmappingversion.FieldCreatedBy, field.TypeInt, value)
+		_node.CreatedBy = &value
+	}
+	if value, ok := pmvc.mutation.CreatedAt(); ok {
+		_spec.SetField(posmappingversion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := pmvc.mutation.MappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PosMappingVersion.Create().
+//		SetFallbackPosID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PosMappingVersionUpsert) {
+//			SetFallbackPosID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmvc *PosMappingVersionCreate) OnConflict(opts ...sql.ConflictOption) *PosMappingVersionUpsertOne {
+	pmvc.conflict = opts
+	return &PosMappingVersionUpsertOne{
+		create: pmvc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PosMappingVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmvc *PosMappingVersionCreate) OnConflictColumns(columns ...string) *PosMappingVersionUpsertOne {
+	pmvc.conflict = append(pmvc.conflict, sql.ConflictColumns(columns...))
+	return &PosMappingVersionUpsertOne{
+		create: pmvc,
+	}
+}
+
+type (
+	// PosMappingVersionUpsertOne is the builder for "upsert"-ing
+	//  one PosMappingVersion node.
+	PosMappingVersionUpsertOne struct {
+		create *PosMappingVersionCreate
+	}
+
+	// PosMappingVersionUpsert is the "OnConflict" setter.
+	PosMappingVersionUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (u *PosMappingVersionUpsert) SetFallbackPosID(v int) *PosMappingVersionUpsert {
+	u.Set(posmappingversion.FieldFallbackPosID, v)
+	return u
+}
+
+// UpdateFallbackPosID sets the "fallback_pos_id" field to the value that was provided on create.
+func (u *PosMappingVersionUpsert) UpdateFallbackPosID() *PosMappingVersionUpsert {
+	u.SetExcluded(posmappingversion.FieldFallbackPosID)
+	return u
+}
+
+// AddFallbackPosID adds v to the "fallback_pos_id" field.
+func (u *PosMappingVersionUpsert) AddFallbackPosID(v int) *PosMappingVersionUpsert {
+	u.Add(posmappingversion.FieldFallbackPosID, v)
+	return u
+}
+
+// SetNote sets the "note" field.
+func (u *PosMappingVersionUpsert) SetNote(v string) *PosMappingVersionUpsert {
+	u.Set(posmappingversion.FieldNote, v)
+	return u
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *PosMappingVersionUpsert) UpdateNote() *PosMappingVersionUpsert {
+	u.SetExcluded(posmappingversion.FieldNote)
+	return u
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *PosMappingVersionUpsert) ClearNote() *PosMappingVersionUpsert {
+	u.SetNull(posmappingversion.FieldNote)
+	return u
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PosMappingVersionUpsert) SetCreatedBy(v int) *PosMappingVersionUpsert {
+	u.Set(posmappingversion.FieldCreatedBy, v)
+	return u
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PosMappingVersionUpsert) UpdateCreatedBy() *PosMappingVersionUpsert {
+	u.SetExcluded(posmappingversion.FieldCreatedBy)
+	return u
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *PosMappingVersionUpsert) AddCreatedBy(v int) *PosMappingVersionUpsert {
+	u.Add(posmappingversion.FieldCreatedBy, v)
+	return u
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PosMappingVersionUpsert) ClearCreatedBy() *PosMappingVersionUpsert {
+	u.SetNull(posmappingversion.FieldCreatedBy)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.PosMappingVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PosMappingVersionUpsertOne) UpdateNewValues() *PosMappingVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(posmappingversion.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PosMappingVersion.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *PosMappingVersionUpsertOne) Ignore() *PosMappingVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PosMappingVersionUpsertOne) DoNothing() *PosMappingVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PosMappingVersionCreate.OnConflict
+// documentation for more info.
+func (u *PosMappingVersionUpsertOne) Update(set func(*PosMappingVersionUpsert)) *PosMappingVersionUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PosMappingVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (u *PosMappingVersionUpsertOne) SetFallbackPosID(v int) *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.SetFallbackPosID(v)
+	})
+}
+
+// AddFallbackPosID adds v to the "fallback_pos_id" field.
+func (u *PosMappingVersionUpsertOne) AddFallbackPosID(v int) *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.AddFallbackPosID(v)
+	})
+}
+
+// UpdateFallbackPosID sets the "fallback_pos_id" field to the value that was provided on create.
+func (u *PosMappingVersionUpsertOne) UpdateFallbackPosID() *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.UpdateFallbackPosID()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *PosMappingVersionUpsertOne) SetNote(v string) *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *PosMappingVersionUpsertOne) UpdateNote() *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *PosMappingVersionUpsertOne) ClearNote() *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PosMappingVersionUpsertOne) SetCreatedBy(v int) *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *PosMappingVersionUpsertOne) AddCreatedBy(v int) *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PosMappingVersionUpsertOne) UpdateCreatedBy() *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PosMappingVersionUpsertOne) ClearCreatedBy() *PosMappingVersionUpsertOne {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *PosMappingVersionUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PosMappingVersionCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PosMappingVersionUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *PosMappingVersionUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *PosMappingVersionUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// PosMappingVersionCreateBulk is the builder for creating many PosMappingVersion entities in bulk.
+type PosMappingVersionCreateBulk struct {
+	config
+	err      error
+	builders []*PosMappingVersionCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the PosMappingVersion entities in the database.
+func (pmvcb *PosMappingVersionCreateBulk) Save(ctx context.Context) ([]*PosMappingVersion, error) {
+	if pmvcb.err != nil {
+		return nil, pmvcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pmvcb.builders))
+	nodes := make([]*PosMappingVersion, len(pmvcb.builders))
+	mutators := make([]Mutator, len(pmvcb.builders))
+	for i := range pmvcb.builders {
+		func(i int, root context.Context) {
+			builder := pmvcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PosMappingVersionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pmvcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = pmvcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pmvcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pmvcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmvcb *PosMappingVersionCreateBulk) SaveX(ctx context.Context) []*PosMappingVersion {
+	v, err := pmvcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pmvcb *PosMappingVersionCreateBulk) Exec(ctx context.Context) error {
+	_, err := pmvcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmvcb *PosMappingVersionCreateBulk) ExecX(ctx context.Context) {
+	if err := pmvcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.PosMappingVersion.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.PosMappingVersionUpsert) {
+//			SetFallbackPosID(v+v).
+//		}).
+//		Exec(ctx)
+func (pmvcb *PosMappingVersionCreateBulk) OnConflict(opts ...sql.ConflictOption) *PosMappingVersionUpsertBulk {
+	pmvcb.conflict = opts
+	return &PosMappingVersionUpsertBulk{
+		create: pmvcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.PosMappingVersion.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (pmvcb *PosMappingVersionCreateBulk) OnConflictColumns(columns ...string) *PosMappingVersionUpsertBulk {
+	pmvcb.conflict = append(pmvcb.conflict, sql.ConflictColumns(columns...))
+	return &PosMappingVersionUpsertBulk{
+		create: pmvcb,
+	}
+}
+
+// PosMappingVersionUpsertBulk is the builder for "upsert"-ing
+// a bulk of PosMappingVersion nodes.
+type PosMappingVersionUpsertBulk struct {
+	create *PosMappingVersionCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.PosMappingVersion.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *PosMappingVersionUpsertBulk) UpdateNewValues() *PosMappingVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(posmappingversion.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.PosMappingVersion.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *PosMappingVersionUpsertBulk) Ignore() *PosMappingVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *PosMappingVersionUpsertBulk) DoNothing() *PosMappingVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the PosMappingVersionCreateBulk.OnConflict
+// documentation for more info.
+func (u *PosMappingVersionUpsertBulk) Update(set func(*PosMappingVersionUpsert)) *PosMappingVersionUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&PosMappingVersionUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (u *PosMappingVersionUpsertBulk) SetFallbackPosID(v int) *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.SetFallbackPosID(v)
+	})
+}
+
+// AddFallbackPosID adds v to the "fallback_pos_id" field.
+func (u *PosMappingVersionUpsertBulk) AddFallbackPosID(v int) *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.AddFallbackPosID(v)
+	})
+}
+
+// UpdateFallbackPosID sets the "fallback_pos_id" field to the value that was provided on create.
+func (u *PosMappingVersionUpsertBulk) UpdateFallbackPosID() *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.UpdateFallbackPosID()
+	})
+}
+
+// SetNote sets the "note" field.
+func (u *PosMappingVersionUpsertBulk) SetNote(v string) *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.SetNote(v)
+	})
+}
+
+// UpdateNote sets the "note" field to the value that was provided on create.
+func (u *PosMappingVersionUpsertBulk) UpdateNote() *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.UpdateNote()
+	})
+}
+
+// ClearNote clears the value of the "note" field.
+func (u *PosMappingVersionUpsertBulk) ClearNote() *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.ClearNote()
+	})
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (u *PosMappingVersionUpsertBulk) SetCreatedBy(v int) *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.SetCreatedBy(v)
+	})
+}
+
+// AddCreatedBy adds v to the "created_by" field.
+func (u *PosMappingVersionUpsertBulk) AddCreatedBy(v int) *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.AddCreatedBy(v)
+	})
+}
+
+// UpdateCreatedBy sets the "created_by" field to the value that was provided on create.
+func (u *PosMappingVersionUpsertBulk) UpdateCreatedBy() *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.UpdateCreatedBy()
+	})
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (u *PosMappingVersionUpsertBulk) ClearCreatedBy() *PosMappingVersionUpsertBulk {
+	return u.Update(func(s *PosMappingVersionUpsert) {
+		s.ClearCreatedBy()
+	})
+}
+
+// Exec executes the query.
+func (u *PosMappingVersionUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the PosMappingVersionCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for PosMappingVersionCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *PosMappingVersionUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"word_app/backend/ent/posmappingversion"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingVersionDelete is the builder for deleting a PosMappingVersion entity.
+type PosMappingVersionDelete struct {
+	config
+	hooks    []Hook
+	mutation *PosMappingVersionMutation
+}
+
+// Where appends a list predicates to the PosMappingVersionDelete builder.
+func (pmvd *PosMappingVersionDelete) Where(ps ...predicate.PosMappingVersion) *PosMappingVersionDelete {
+	pmvd.mutation.Where(ps...)
+	return pmvd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pmvd *PosMappingVersionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmvd.sqlExec, pmvd.mutation, pmvd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmvd *PosMappingVersionDelete) ExecX(ctx context.Context) int {
+	n, err := pmvd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pmvd *PosMappingVersionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(posmappingversion.Table, sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt))
+	if ps := pmvd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pmvd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pmvd.mutation.done = true
+	return affected, err
+}
+
+// PosMappingVersionDeleteOne is the builder for deleting a single PosMappingVersion entity.
+type PosMappingVersionDeleteOne struct {
+	pmvd *PosMappingVersionDelete
+}
+
+// Where appends a list predicates to the PosMappingVersionDelete builder.
+func (pmvdo *PosMappingVersionDeleteOne) Where(ps ...predicate.PosMappingVersion) *PosMappingVersionDeleteOne {
+	pmvdo.pmvd.mutation.Where(ps...)
+	return pmvdo
+}
+
+// Exec executes the deletion query.
+func (pmvdo *PosMappingVersionDeleteOne) Exec(ctx context.Context) error {
+	n, err := pmvdo.pmvd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{posmappingversion.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmvdo *PosMappingVersionDeleteOne) ExecX(ctx context.Context) {
+	if err := pmvdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
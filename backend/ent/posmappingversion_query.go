@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingVersionQuery is the builder for querying PosMappingVersion entities.
+type PosMappingVersionQuery struct {
+	config
+	ctx          *QueryContext
+	order        []posmappingversion.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.PosMappingVersion
+	withMappings *PosMappingQuery
+	modifiers    []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PosMappingVersionQuery builder.
+func (pmvq *PosMappingVersionQuery) Where(ps ...predicate.PosMappingVersion) *PosMappingVersionQuery {
+	pmvq.predicates = append(pmvq.predicates, ps...)
+	return pmvq
+}
+
+// Limit the number of records to be returned by this query.
+func (pmvq *PosMappingVersionQuery) Limit(limit int) *PosMappingVersionQuery {
+	pmvq.ctx.Limit = &limit
+	return pmvq
+}
+
+// Offset to start from.
+func (pmvq *PosMappingVersionQuery) Offset(offset int) *PosMappingVersionQuery {
+	pmvq.ctx.Offset = &offset
+	return pmvq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (pmvq *PosMappingVersionQuery) Unique(unique bool) *PosMappingVersionQuery {
+	pmvq.ctx.Unique = &unique
+	return pmvq
+}
+
+// Order specifies how the records should be ordered.
+func (pmvq *PosMappingVersionQuery) Order(o ...posmappingversion.OrderOption) *PosMappingVersionQuery {
+	pmvq.order = append(pmvq.order, o...)
+	return pmvq
+}
+
+// QueryMappings chains the current query on the "mappings" edge.
+func (pmvq *PosMappingVersionQuery) QueryMappings() *PosMappingQuery {
+	query := (&PosMappingClient{config: pmvq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := pmvq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := pmvq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(posmappingversion.Table, posmappingversion.FieldID, selector),
+			sqlgraph.To(posmapping.Table, posmapping.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, posmappingversion.MappingsTable, posmappingversion.MappingsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(pmvq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PosMappingVersion entity from the query.
+// Returns a *NotFoundError when no PosMappingVersion was found.
+func (pmvq *PosMappingVersionQuery) First(ctx context.Context) (*PosMappingVersion, error) {
+	nodes, err := pmvq.Limit(1).All(setContextOp(ctx, pmvq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{posmappingversion.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) FirstX(ctx context.Context) *PosMappingVersion {
+	node, err := pmvq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PosMappingVersion ID from the query.
+// Returns a *NotFoundError when no PosMappingVersion ID was found.
+func (pmvq *PosMappingVersionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmvq.Limit(1).IDs(setContextOp(ctx, pmvq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{posmappingversion.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) FirstIDX(ctx context.Context) int {
+	id, err := pmvq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PosMappingVersion entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PosMappingVersion entity is found.
+// Returns a *NotFoundError when no PosMappingVersion entities are found.
+func (pmvq *PosMappingVersionQuery) Only(ctx context.Context) (*PosMappingVersion, error) {
+	nodes, err := pmvq.Limit(2).All(setContextOp(ctx, pmvq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{posmappingversion.Label}
+	default:
+		return nil, &NotSingularError{posmappingversion.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) OnlyX(ctx context.Context) *PosMappingVersion {
+	node, err := pmvq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PosMappingVersion ID in the query.
+// Returns a *NotSingularError when more than one PosMappingVersion ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (pmvq *PosMappingVersionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = pmvq.Limit(2).IDs(setContextOp(ctx, pmvq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{posmappingversion.Label}
+	default:
+		err = &NotSingularError{posmappingversion.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := pmvq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PosMappingVersions.
+func (pmvq *PosMappingVersionQuery) All(ctx context.Context) ([]*PosMappingVersion, error) {
+	ctx = setContextOp(ctx, pmvq.ctx, ent.OpQueryAll)
+	if err := pmvq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PosMappingVersion, *PosMappingVersionQuery]()
+	return withInterceptors[[]*PosMappingVersion](ctx, pmvq, qr, pmvq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) AllX(ctx context.Context) []*PosMappingVersion {
+	nodes, err := pmvq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PosMappingVersion IDs.
+func (pmvq *PosMappingVersionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if pmvq.ctx.Unique == nil && pmvq.path != nil {
+		pmvq.Unique(true)
+	}
+	ctx = setContextOp(ctx, pmvq.ctx, ent.OpQueryIDs)
+	if err = pmvq.Select(posmappingversion.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) IDsX(ctx context.Context) []int {
+	ids, err := pmvq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (pmvq *PosMappingVersionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, pmvq.ctx, ent.OpQueryCount)
+	if err := pmvq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, pmvq, querierCount[*PosMappingVersionQuery](), pmvq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) CountX(ctx context.Context) int {
+	count, err := pmvq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (pmvq *PosMappingVersionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, pmvq.ctx, ent.OpQueryExist)
+	switch _, err := pmvq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (pmvq *PosMappingVersionQuery) ExistX(ctx context.Context) bool {
+	exist, err := pmvq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PosMappingVersionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (pmvq *PosMappingVersionQuery) Clone() *PosMappingVersionQuery {
+	if pmvq == nil {
+		return nil
+	}
+	return &PosMappingVersionQuery{
+		config:       pmvq.config,
+		ctx:          pmvq.ctx.Clone(),
+		order:        append([]posmappingversion.OrderOption{}, pmvq.order...),
+		inters:       append([]Interceptor{}, pmvq.inters...),
+		predicates:   append([]predicate.PosMappingVersion{}, pmvq.predicates...),
+		withMappings: pmvq.withMappings.Clone(),
+		// clone intermediate query.
+		sql:       pmvq.sql.Clone(),
+		path:      pmvq.path,
+		modifiers: append([]func(*sql.Selector){}, pmvq.modifiers...),
+	}
+}
+
+// WithMappings tells the query-builder to eager-load the nodes that are connected to
+// the "mappings" edge. The optional arguments are used to configure the query builder of the edge.
+func (pmvq *PosMappingVersionQuery) WithMappings(opts ...func(*PosMappingQuery)) *PosMappingVersionQuery {
+	query := (&PosMappingClient{config: pmvq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	pmvq.withMappings = query
+	return pmvq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		FallbackPosID int `json:"fallback_pos_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PosMappingVersion.Query().
+//		GroupBy(posmappingversion.FieldFallbackPosID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (pmvq *PosMappingVersionQuery) GroupBy(field string, fields ...string) *PosMappingVersionGroupBy {
+	pmvq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PosMappingVersionGroupBy{build: pmvq}
+	grbuild.flds = &pmvq.ctx.Fields
+	grbuild.label = posmappingversion.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		FallbackPosID int `json:"fallback_pos_id,omitempty"`
+//	}
+//
+//	client.PosMappingVersion.Query().
+//		Select(posmappingversion.FieldFallbackPosID).
+//		Scan(ctx, &v)
+func (pmvq *PosMappingVersionQuery) Select(fields ...string) *PosMappingVersionSelect {
+	pmvq.ctx.Fields = append(pmvq.ctx.Fields, fields...)
+	sbuild := &PosMappingVersionSelect{PosMappingVersionQuery: pmvq}
+	sbuild.label = posmappingversion.Label
+	sbuild.flds, sbuild.scan = &pmvq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PosMappingVersionSelect configured with the given aggregations.
+func (pmvq *PosMappingVersionQuery) Aggregate(fns ...AggregateFunc) *PosMappingVersionSelect {
+	return pmvq.Select().Aggregate(fns...)
+}
+
+func (pmvq *PosMappingVersionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range pmvq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, pmvq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range pmvq.ctx.Fields {
+		if !posmappingversion.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if pmvq.path != nil {
+		prev, err := pmvq.path(ctx)
+		if err != nil {
+			return err
+		}
+		pmvq.sql = prev
+	}
+	return nil
+}
+
+func (pmvq *PosMappingVersionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PosMappingVersion, error) {
+	var (
+		nodes       = []*PosMappingVersion{}
+		_spec       = pmvq.querySpec()
+		loadedTypes = [1]bool{
+			pmvq.withMappings != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PosMappingVersion).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PosMappingVersion{config: pmvq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(pmvq.modifiers) > 0 {
+		_spec.Modifiers = pmvq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, pmvq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := pmvq.withMappings; query != nil {
+		if err := pmvq.loadMappings(ctx, query, nodes,
+			func(n *PosMappingVersion) { n.Edges.Mappings = []*PosMapping{} },
+			func(n *PosMappingVersion, e *PosMapping) { n.Edges.Mappings = append(n.Edges.Mappings, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (pmvq *PosMappingVersionQuery) loadMappings(ctx context.Context, query *PosMappingQuery, nodes []*PosMappingVersion, init func(*PosMappingVersion), assign func(*PosMappingVersion, *PosMapping)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*PosMappingVersion)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(posmapping.FieldVersionID)
+	}
+	query.Where(predicate.PosMapping(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(posmappingversion.MappingsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.VersionID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "version_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (pmvq *PosMappingVersionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := pmvq.querySpec()
+	if len(pmvq.modifiers) > 0 {
+		_spec.Modifiers = pmvq.modifiers
+	}
+	_spec.Node.Columns = pmvq.ctx.Fields
+	if len(pmvq.ctx.Fields) > 0 {
+		_spec.Unique = pmvq.ctx.Unique != nil && *pmvq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, pmvq.driver, _spec)
+}
+
+func (pmvq *PosMappingVersionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(posmappingversion.Table, posmappingversion.Columns, sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt))
+	_spec.From = pmvq.sql
+	if unique := pmvq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if pmvq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := pmvq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posmappingversion.FieldID)
+		for i := range fields {
+			if fields[i] != posmappingversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := pmvq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := pmvq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := pmvq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := pmvq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (pmvq *PosMappingVersionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(pmvq.driver.Dialect())
+	t1 := builder.Table(posmappingversion.Table)
+	columns := pmvq.ctx.Fields
+	if len(columns) == 0 {
+		columns = posmappingversion.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if pmvq.sql != nil {
+		selector = pmvq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if pmvq.ctx.Unique != nil && *pmvq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range pmvq.modifiers {
+		m(selector)
+	}
+	for _, p := range pmvq.predicates {
+		p(selector)
+	}
+	for _, p := range pmvq.order {
+		p(selector)
+	}
+	if offset := pmvq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := pmvq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pmvq *PosMappingVersionQuery) Modify(modifiers ...func(s *sql.Selector)) *PosMappingVersionSelect {
+	pmvq.modifiers = append(pmvq.modifiers, modifiers...)
+	return pmvq.Select()
+}
+
+// PosMappingVersionGroupBy is the group-by builder for PosMappingVersion entities.
+type PosMappingVersionGroupBy struct {
+	selector
+	build *PosMappingVersionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pmvgb *PosMappingVersionGroupBy) Aggregate(fns ...AggregateFunc) *PosMappingVersionGroupBy {
+	pmvgb.fns = append(pmvgb.fns, fns...)
+	return pmvgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pmvgb *PosMappingVersionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pmvgb.build.ctx, ent.OpQueryGroupBy)
+	if err := pmvgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PosMappingVersionQuery, *PosMappingVersionGroupBy](ctx, pmvgb.build, pmvgb, pmvgb.build.inters, v)
+}
+
+func (pmvgb *PosMappingVersionGroupBy) sqlScan(ctx context.Context, root *PosMappingVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pmvgb.fns))
+	for _, fn := range pmvgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pmvgb.flds)+len(pmvgb.fns))
+		for _, f := range *pmvgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pmvgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmvgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PosMappingVersionSelect is the builder for selecting fields of PosMappingVersion entities.
+type PosMappingVersionSelect struct {
+	*PosMappingVersionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pmvs *PosMappingVersionSelect) Aggregate(fns ...AggregateFunc) *PosMappingVersionSelect {
+	pmvs.fns = append(pmvs.fns, fns...)
+	return pmvs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pmvs *PosMappingVersionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pmvs.ctx, ent.OpQuerySelect)
+	if err := pmvs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PosMappingVersionQuery, *PosMappingVersionSelect](ctx, pmvs.PosMappingVersionQuery, pmvs, pmvs.inters, v)
+}
+
+func (pmvs *PosMappingVersionSelect) sqlScan(ctx context.Context, root *PosMappingVersionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pmvs.fns))
+	for _, fn := range pmvs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pmvs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pmvs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (pmvs *PosMappingVersionSelect) Modify(modifiers ...func(s *sql.Selector)) *PosMappingVersionSelect {
+	pmvs.modifiers = append(pmvs.modifiers, modifiers...)
+	return pmvs
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingVersionUpdate is the builder for updating PosMappingVersion entities.
+type PosMappingVersionUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *PosMappingVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the PosMappingVersionUpdate builder.
+func (pmvu *PosMappingVersionUpdate) Where(ps ...predicate.PosMappingVersion) *PosMappingVersionUpdate {
+	pmvu.mutation.Where(ps...)
+	return pmvu
+}
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (pmvu *PosMappingVersionUpdate) SetFallbackPosID(i int) *PosMappingVersionUpdate {
+	pmvu.mutation.ResetFallbackPosID()
+	pmvu.mutation.SetFallbackPosID(i)
+	return pmvu
+}
+
+// SetNillableFallbackPosID sets the "fallback_pos_id" field if the given value is not nil.
+func (pmvu *PosMappingVersionUpdate) SetNillableFallbackPosID(i *int) *PosMappingVersionUpdate {
+	if i != nil {
+		pmvu.SetFallbackPosID(*i)
+	}
+	return pmvu
+}
+
+// AddFallbackPosID adds i to the "fallback_pos_id" field.
+func (pmvu *PosMappingVersionUpdate) AddFallbackPosID(i int) *PosMappingVersionUpdate {
+	pmvu.mutation.AddFallbackPosID(i)
+	return pmvu
+}
+
+// SetNote sets the "note" field.
+func (pmvu *PosMappingVersionUpdate) SetNote(s string) *PosMappingVersionUpdate {
+	pmvu.mutation.SetNote(s)
+	return pmvu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (pmvu *PosMappingVersionUpdate) SetNillableNote(s *string) *PosMappingVersionUpdate {
+	if s != nil {
+		pmvu.SetNote(*s)
+	}
+	return pmvu
+}
+
+// ClearNote clears the value of the "note" field.
+func (pmvu *PosMappingVersionUpdate) ClearNote() *PosMappingVersionUpdate {
+	pmvu.mutation.ClearNote()
+	return pmvu
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pmvu *PosMappingVersionUpdate) SetCreatedBy(i int) *PosMappingVersionUpdate {
+	pmvu.mutation.ResetCreatedBy()
+	pmvu.mutation.SetCreatedBy(i)
+	return pmvu
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pmvu *PosMappingVersionUpdate) SetNillableCreatedBy(i *int) *PosMappingVersionUpdate {
+	if i != nil {
+		pmvu.SetCreatedBy(*i)
+	}
+	return pmvu
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (pmvu *PosMappingVersionUpdate) AddCreatedBy(i int) *PosMappingVersionUpdate {
+	pmvu.mutation.AddCreatedBy(i)
+	return pmvu
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (pmvu *PosMappingVersionUpdate) ClearCreatedBy() *PosMappingVersionUpdate {
+	pmvu.mutation.ClearCreatedBy()
+	return pmvu
+}
+
+// AddMappingIDs adds the "mappings" edge to the PosMapping entity by IDs.
+func (pmvu *PosMappingVersionUpdate) AddMappingIDs(ids ...int) *PosMappingVersionUpdate {
+	pmvu.mutation.AddMappingIDs(ids...)
+	return pmvu
+}
+
+// AddMappings adds the "mappings" edges to the PosMapping entity.
+func (pmvu *PosMappingVersionUpdate) AddMappings(p ...*PosMapping) *PosMappingVersionUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pmvu.AddMappingIDs(ids...)
+}
+
+// Mutation returns the PosMappingVersionMutation object of the builder.
+func (pmvu *PosMappingVersionUpdate) Mutation() *PosMappingVersionMutation {
+	return pmvu.mutation
+}
+
+// ClearMappings clears all "mappings" edges to the PosMapping entity.
+func (pmvu *PosMappingVersionUpdate) ClearMappings() *PosMappingVersionUpdate {
+	pmvu.mutation.ClearMappings()
+	return pmvu
+}
+
+// RemoveMappingIDs removes the "mappings" edge to PosMapping entities by IDs.
+func (pmvu *PosMappingVersionUpdate) RemoveMappingIDs(ids ...int) *PosMappingVersionUpdate {
+	pmvu.mutation.RemoveMappingIDs(ids...)
+	return pmvu
+}
+
+// RemoveMappings removes "mappings" edges to PosMapping entities.
+func (pmvu *PosMappingVersionUpdate) RemoveMappings(p ...*PosMapping) *PosMappingVersionUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pmvu.RemoveMappingIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pmvu *PosMappingVersionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pmvu.sqlSave, pmvu.mutation, pmvu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmvu *PosMappingVersionUpdate) SaveX(ctx context.Context) int {
+	affected, err := pmvu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pmvu *PosMappingVersionUpdate) Exec(ctx context.Context) error {
+	_, err := pmvu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmvu *PosMappingVersionUpdate) ExecX(ctx context.Context) {
+	if err := pmvu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmvu *PosMappingVersionUpdate) check() error {
+	if v, ok := pmvu.mutation.FallbackPosID(); ok {
+		if err := posmappingversion.FallbackPosIDValidator(v); err != nil {
+			return &ValidationError{Name: "fallback_pos_id", err: fmt.Errorf(`ent: validator failed for field "PosMappingVersion.fallback_pos_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pmvu *PosMappingVersionUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosMappingVersionUpdate {
+	pmvu.modifiers = append(pmvu.modifiers, modifiers...)
+	return pmvu
+}
+
+func (pmvu *PosMappingVersionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pmvu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posmappingversion.Table, posmappingversion.Columns, sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt))
+	if ps := pmvu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmvu.mutation.FallbackPosID(); ok {
+		_spec.SetField(posmappingversion.FieldFallbackPosID, field.TypeInt, value)
+	}
+	if value, ok := pmvu.mutation.AddedFallbackPosID(); ok {
+		_spec.AddField(posmappingversion.FieldFallbackPosID, field.TypeInt, value)
+	}
+	if value, ok := pmvu.mutation.Note(); ok {
+		_spec.SetField(posmappingversion.FieldNote, field.TypeString, value)
+	}
+	if pmvu.mutation.NoteCleared() {
+		_spec.ClearField(posmappingversion.FieldNote, field.TypeString)
+	}
+	if value, ok := pmvu.mutation.CreatedBy(); ok {
+		_spec.SetField(posmappingversion.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := pmvu.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(posmappingversion.FieldCreatedBy, field.TypeInt, value)
+	}
+	if pmvu.mutation.CreatedByCleared() {
+		_spec.ClearField(posmappingversion.FieldCreatedBy, field.TypeInt)
+	}
+	if pmvu.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmvu.mutation.RemovedMappingsIDs(); len(nodes) > 0 && !pmvu.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmvu.mutation.MappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pmvu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, pmvu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posmappingversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pmvu.mutation.done = true
+	return n, nil
+}
+
+// PosMappingVersionUpdateOne is the builder for updating a single PosMappingVersion entity.
+type PosMappingVersionUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *PosMappingVersionMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetFallbackPosID sets the "fallback_pos_id" field.
+func (pmvuo *PosMappingVersionUpdateOne) SetFallbackPosID(i int) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.ResetFallbackPosID()
+	pmvuo.mutation.SetFallbackPosID(i)
+	return pmvuo
+}
+
+// SetNillableFallbackPosID sets the "fallback_pos_id" field if the given value is not nil.
+func (pmvuo *PosMappingVersionUpdateOne) SetNillableFallbackPosID(i *int) *PosMappingVersionUpdateOne {
+	if i != nil {
+		pmvuo.SetFallbackPosID(*i)
+	}
+	return pmvuo
+}
+
+// AddFallbackPosID adds i to the "fallback_pos_id" field.
+func (pmvuo *PosMappingVersionUpdateOne) AddFallbackPosID(i int) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.AddFallbackPosID(i)
+	return pmvuo
+}
+
+// SetNote sets the "note" field.
+func (pmvuo *PosMappingVersionUpdateOne) SetNote(s string) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.SetNote(s)
+	return pmvuo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (pmvuo *PosMappingVersionUpdateOne) SetNillableNote(s *string) *PosMappingVersionUpdateOne {
+	if s != nil {
+		pmvuo.SetNote(*s)
+	}
+	return pmvuo
+}
+
+// ClearNote clears the value of the "note" field.
+func (pmvuo *PosMappingVersionUpdateOne) ClearNote() *PosMappingVersionUpdateOne {
+	pmvuo.mutation.ClearNote()
+	return pmvuo
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (pmvuo *PosMappingVersionUpdateOne) SetCreatedBy(i int) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.ResetCreatedBy()
+	pmvuo.mutation.SetCreatedBy(i)
+	return pmvuo
+}
+
+// SetNillableCreatedBy sets the "created_by" field if the given value is not nil.
+func (pmvuo *PosMappingVersionUpdateOne) SetNillableCreatedBy(i *int) *PosMappingVersionUpdateOne {
+	if i != nil {
+		pmvuo.SetCreatedBy(*i)
+	}
+	return pmvuo
+}
+
+// AddCreatedBy adds i to the "created_by" field.
+func (pmvuo *PosMappingVersionUpdateOne) AddCreatedBy(i int) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.AddCreatedBy(i)
+	return pmvuo
+}
+
+// ClearCreatedBy clears the value of the "created_by" field.
+func (pmvuo *PosMappingVersionUpdateOne) ClearCreatedBy() *PosMappingVersionUpdateOne {
+	pmvuo.mutation.ClearCreatedBy()
+	return pmvuo
+}
+
+// AddMappingIDs adds the "mappings" edge to the PosMapping entity by IDs.
+func (pmvuo *PosMappingVersionUpdateOne) AddMappingIDs(ids ...int) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.AddMappingIDs(ids...)
+	return pmvuo
+}
+
+// AddMappings adds the "mappings" edges to the PosMapping entity.
+func (pmvuo *PosMappingVersionUpdateOne) AddMappings(p ...*PosMapping) *PosMappingVersionUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pmvuo.AddMappingIDs(ids...)
+}
+
+// Mutation returns the PosMappingVersionMutation object of the builder.
+func (pmvuo *PosMappingVersionUpdateOne) Mutation() *PosMappingVersionMutation {
+	return pmvuo.mutation
+}
+
+// ClearMappings clears all "mappings" edges to the PosMapping entity.
+func (pmvuo *PosMappingVersionUpdateOne) ClearMappings() *PosMappingVersionUpdateOne {
+	pmvuo.mutation.ClearMappings()
+	return pmvuo
+}
+
+// RemoveMappingIDs removes the "mappings" edge to PosMapping entities by IDs.
+func (pmvuo *PosMappingVersionUpdateOne) RemoveMappingIDs(ids ...int) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.RemoveMappingIDs(ids...)
+	return pmvuo
+}
+
+// RemoveMappings removes "mappings" edges to PosMapping entities.
+func (pmvuo *PosMappingVersionUpdateOne) RemoveMappings(p ...*PosMapping) *PosMappingVersionUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pmvuo.RemoveMappingIDs(ids...)
+}
+
+// Where appends a list predicates to the PosMappingVersionUpdate builder.
+func (pmvuo *PosMappingVersionUpdateOne) Where(ps ...predicate.PosMappingVersion) *PosMappingVersionUpdateOne {
+	pmvuo.mutation.Where(ps...)
+	return pmvuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pmvuo *PosMappingVersionUpdateOne) Select(field string, fields ...string) *PosMappingVersionUpdateOne {
+	pmvuo.fields = append([]string{field}, fields...)
+	return pmvuo
+}
+
+// Save executes the query and returns the updated PosMappingVersion entity.
+func (pmvuo *PosMappingVersionUpdateOne) Save(ctx context.Context) (*PosMappingVersion, error) {
+	return withHooks(ctx, pmvuo.sqlSave, pmvuo.mutation, pmvuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pmvuo *PosMappingVersionUpdateOne) SaveX(ctx context.Context) *PosMappingVersion {
+	node, err := pmvuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pmvuo *PosMappingVersionUpdateOne) Exec(ctx context.Context) error {
+	_, err := pmvuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pmvuo *PosMappingVersionUpdateOne) ExecX(ctx context.Context) {
+	if err := pmvuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pmvuo *PosMappingVersionUpdateOne) check() error {
+	if v, ok := pmvuo.mutation.FallbackPosID(); ok {
+		if err := posmappingversion.FallbackPosIDValidator(v); err != nil {
+			return &ValidationError{Name: "fallback_pos_id", err: fmt.Errorf(`ent: validator failed for field "PosMappingVersion.fallback_pos_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (pmvuo *PosMappingVersionUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *PosMappingVersionUpdateOne {
+	pmvuo.modifiers = append(pmvuo.modifiers, modifiers...)
+	return pmvuo
+}
+
+func (pmvuo *PosMappingVersionUpdateOne) sqlSave(ctx context.Context) (_node *PosMappingVersion, err error) {
+	if err := pmvuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(posmappingversion.Table, posmappingversion.Columns, sqlgraph.NewFieldSpec(posmappingversion.FieldID, field.TypeInt))
+	id, ok := pmvuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PosMappingVersion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pmvuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, posmappingversion.FieldID)
+		for _, f := range fields {
+			if !posmappingversion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != posmappingversion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pmvuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pmvuo.mutation.FallbackPosID(); ok {
+		_spec.SetField(posmappingversion.FieldFallbackPosID, field.TypeInt, value)
+	}
+	if value, ok := pmvuo.mutation.AddedFallbackPosID(); ok {
+		_spec.AddField(posmappingversion.FieldFallbackPosID, field.TypeInt, value)
+	}
+	if value, ok := pmvuo.mutation.Note(); ok {
+		_spec.SetField(posmappingversion.FieldNote, field.TypeString, value)
+	}
+	if pmvuo.mutation.NoteCleared() {
+		_spec.ClearField(posmappingversion.FieldNote, field.TypeString)
+	}
+	if value, ok := pmvuo.mutation.CreatedBy(); ok {
+		_spec.SetField(posmappingversion.FieldCreatedBy, field.TypeInt, value)
+	}
+	if value, ok := pmvuo.mutation.AddedCreatedBy(); ok {
+		_spec.AddField(posmappingversion.FieldCreatedBy, field.TypeInt, value)
+	}
+	if pmvuo.mutation.CreatedByCleared() {
+		_spec.ClearField(posmappingversion.FieldCreatedBy, field.TypeInt)
+	}
+	if pmvuo.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmvuo.mutation.RemovedMappingsIDs(); len(nodes) > 0 && !pmvuo.mutation.MappingsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pmvuo.mutation.MappingsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   posmappingversion.MappingsTable,
+			Columns: []string{posmappingversion.MappingsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(posmapping.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(pmvuo.modifiers...)
+	_node = &PosMappingVersion{config: pmvuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pmvuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{posmappingversion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pmvuo.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,18 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Deck is the predicate function for deck builders.
+type Deck func(*sql.Selector)
+
+// DeckWord is the predicate function for deckword builders.
+type DeckWord func(*sql.Selector)
+
+// DictImportFailure is the predicate function for dictimportfailure builders.
+type DictImportFailure func(*sql.Selector)
+
+// DictImportRun is the predicate function for dictimportrun builders.
+type DictImportRun func(*sql.Selector)
+
 // ExternalAuth is the predicate function for externalauth builders.
 type ExternalAuth func(*sql.Selector)
 
@@ -15,12 +27,21 @@ type JapaneseMean func(*sql.Selector)
 // PartOfSpeech is the predicate function for partofspeech builders.
 type PartOfSpeech func(*sql.Selector)
 
+// PosMapping is the predicate function for posmapping builders.
+type PosMapping func(*sql.Selector)
+
+// PosMappingVersion is the predicate function for posmappingversion builders.
+type PosMappingVersion func(*sql.Selector)
+
 // Quiz is the predicate function for quiz builders.
 type Quiz func(*sql.Selector)
 
 // QuizQuestion is the predicate function for quizquestion builders.
 type QuizQuestion func(*sql.Selector)
 
+// RefreshToken is the predicate function for refreshtoken builders.
+type RefreshToken func(*sql.Selector)
+
 // RegisteredWord is the predicate function for registeredword builders.
 type RegisteredWord func(*sql.Selector)
 
@@ -41,3 +62,6 @@ type Word func(*sql.Selector)
 
 // WordInfo is the predicate function for wordinfo builders.
 type WordInfo func(*sql.Selector)
+
+// WordProposal is the predicate function for wordproposal builders.
+type WordProposal func(*sql.Selector)
@@ -111,6 +111,102 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The DeckQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeckQueryRuleFunc func(context.Context, *ent.DeckQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeckQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeckQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeckQuery", q)
+}
+
+// The DeckMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeckMutationRuleFunc func(context.Context, *ent.DeckMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeckMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeckMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeckMutation", m)
+}
+
+// The DeckWordQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeckWordQueryRuleFunc func(context.Context, *ent.DeckWordQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeckWordQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeckWordQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeckWordQuery", q)
+}
+
+// The DeckWordMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeckWordMutationRuleFunc func(context.Context, *ent.DeckWordMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeckWordMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeckWordMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeckWordMutation", m)
+}
+
+// The DictImportFailureQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DictImportFailureQueryRuleFunc func(context.Context, *ent.DictImportFailureQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DictImportFailureQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DictImportFailureQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DictImportFailureQuery", q)
+}
+
+// The DictImportFailureMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DictImportFailureMutationRuleFunc func(context.Context, *ent.DictImportFailureMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DictImportFailureMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DictImportFailureMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DictImportFailureMutation", m)
+}
+
+// The DictImportRunQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DictImportRunQueryRuleFunc func(context.Context, *ent.DictImportRunQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DictImportRunQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DictImportRunQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DictImportRunQuery", q)
+}
+
+// The DictImportRunMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DictImportRunMutationRuleFunc func(context.Context, *ent.DictImportRunMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DictImportRunMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DictImportRunMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DictImportRunMutation", m)
+}
+
 // The ExternalAuthQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ExternalAuthQueryRuleFunc func(context.Context, *ent.ExternalAuthQuery) error
@@ -183,6 +279,54 @@ func (f PartOfSpeechMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mu
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PartOfSpeechMutation", m)
 }
 
+// The PosMappingQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PosMappingQueryRuleFunc func(context.Context, *ent.PosMappingQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PosMappingQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PosMappingQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PosMappingQuery", q)
+}
+
+// The PosMappingMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PosMappingMutationRuleFunc func(context.Context, *ent.PosMappingMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PosMappingMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PosMappingMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PosMappingMutation", m)
+}
+
+// The PosMappingVersionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type PosMappingVersionQueryRuleFunc func(context.Context, *ent.PosMappingVersionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f PosMappingVersionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.PosMappingVersionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.PosMappingVersionQuery", q)
+}
+
+// The PosMappingVersionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type PosMappingVersionMutationRuleFunc func(context.Context, *ent.PosMappingVersionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f PosMappingVersionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.PosMappingVersionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.PosMappingVersionMutation", m)
+}
+
 // The QuizQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type QuizQueryRuleFunc func(context.Context, *ent.QuizQuery) error
@@ -231,6 +375,30 @@ func (f QuizQuestionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mu
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.QuizQuestionMutation", m)
 }
 
+// The RefreshTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RefreshTokenQueryRuleFunc func(context.Context, *ent.RefreshTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RefreshTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RefreshTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RefreshTokenQuery", q)
+}
+
+// The RefreshTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RefreshTokenMutationRuleFunc func(context.Context, *ent.RefreshTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RefreshTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RefreshTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RefreshTokenMutation", m)
+}
+
 // The RegisteredWordQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type RegisteredWordQueryRuleFunc func(context.Context, *ent.RegisteredWordQuery) error
@@ -399,6 +567,30 @@ func (f WordInfoMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutati
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WordInfoMutation", m)
 }
 
+// The WordProposalQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type WordProposalQueryRuleFunc func(context.Context, *ent.WordProposalQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f WordProposalQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.WordProposalQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.WordProposalQuery", q)
+}
+
+// The WordProposalMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type WordProposalMutationRuleFunc func(context.Context, *ent.WordProposalMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f WordProposalMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.WordProposalMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.WordProposalMutation", m)
+}
+
 type (
 	// Filter is the interface that wraps the Where function
 	// for filtering nodes in queries and mutations.
@@ -434,16 +626,30 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q ent.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *ent.DeckQuery:
+		return q.Filter(), nil
+	case *ent.DeckWordQuery:
+		return q.Filter(), nil
+	case *ent.DictImportFailureQuery:
+		return q.Filter(), nil
+	case *ent.DictImportRunQuery:
+		return q.Filter(), nil
 	case *ent.ExternalAuthQuery:
 		return q.Filter(), nil
 	case *ent.JapaneseMeanQuery:
 		return q.Filter(), nil
 	case *ent.PartOfSpeechQuery:
 		return q.Filter(), nil
+	case *ent.PosMappingQuery:
+		return q.Filter(), nil
+	case *ent.PosMappingVersionQuery:
+		return q.Filter(), nil
 	case *ent.QuizQuery:
 		return q.Filter(), nil
 	case *ent.QuizQuestionQuery:
 		return q.Filter(), nil
+	case *ent.RefreshTokenQuery:
+		return q.Filter(), nil
 	case *ent.RegisteredWordQuery:
 		return q.Filter(), nil
 	case *ent.RootConfigQuery:
@@ -458,6 +664,8 @@ func queryFilter(q ent.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *ent.WordInfoQuery:
 		return q.Filter(), nil
+	case *ent.WordProposalQuery:
+		return q.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected query type %T for query filter", q)
 	}
@@ -465,16 +673,30 @@ func queryFilter(q ent.Query) (Filter, error) {
 
 func mutationFilter(m ent.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *ent.DeckMutation:
+		return m.Filter(), nil
+	case *ent.DeckWordMutation:
+		return m.Filter(), nil
+	case *ent.DictImportFailureMutation:
+		return m.Filter(), nil
+	case *ent.DictImportRunMutation:
+		return m.Filter(), nil
 	case *ent.ExternalAuthMutation:
 		return m.Filter(), nil
 	case *ent.JapaneseMeanMutation:
 		return m.Filter(), nil
 	case *ent.PartOfSpeechMutation:
 		return m.Filter(), nil
+	case *ent.PosMappingMutation:
+		return m.Filter(), nil
+	case *ent.PosMappingVersionMutation:
+		return m.Filter(), nil
 	case *ent.QuizMutation:
 		return m.Filter(), nil
 	case *ent.QuizQuestionMutation:
 		return m.Filter(), nil
+	case *ent.RefreshTokenMutation:
+		return m.Filter(), nil
 	case *ent.RegisteredWordMutation:
 		return m.Filter(), nil
 	case *ent.RootConfigMutation:
@@ -489,6 +711,8 @@ func mutationFilter(m ent.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *ent.WordInfoMutation:
 		return m.Filter(), nil
+	case *ent.WordProposalMutation:
+		return m.Filter(), nil
 	default:
 		return nil, Denyf("ent/privacy: unexpected mutation type %T for mutation filter", m)
 	}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"word_app/backend/ent/deck"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/user"
 
@@ -45,6 +46,26 @@ type Quiz struct {
 	AttentionLevelList []int `json:"attention_level_list,omitempty"`
 	// ChoicesPosIds holds the value of the "choices_pos_ids" field.
 	ChoicesPosIds []int `json:"choices_pos_ids,omitempty"`
+	// random: ランダム出題, due: 復習期限順
+	Mode string `json:"mode,omitempty"`
+	// en_to_ja: 英単語→意味, ja_to_en: 意味→英単語
+	Direction string `json:"direction,omitempty"`
+	// choice: 選択式, typing: 意味を見て英単語を入力
+	QuestionType string `json:"question_type,omitempty"`
+	// 誤答の選び方 easy: 他品詞, normal: 同品詞+混同履歴少し, hard: 混同履歴優先
+	Difficulty string `json:"difficulty,omitempty"`
+	// 1 問あたりの制限時間（秒）。0 は無制限
+	QuestionTimeLimitSec int `json:"question_time_limit_sec,omitempty"`
+	// クイズ全体の制限時間（秒）。0 は無制限
+	TimeLimitSec int `json:"time_limit_sec,omitempty"`
+	// created_at + time_limit_sec
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// completed: 全問回答, cancelled: 途中で中断, expired: 期限切れ。実行中は NULL
+	FinishStatus *string `json:"finish_status,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// 出題元のデッキ。デッキが削除されると NULL
+	DeckID *int `json:"deck_id,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
@@ -61,9 +82,11 @@ type QuizEdges struct {
 	User *User `json:"user,omitempty"`
 	// QuizQuestions holds the value of the quiz_questions edge.
 	QuizQuestions []*QuizQuestion `json:"quiz_questions,omitempty"`
+	// Deck holds the value of the deck edge.
+	Deck *Deck `json:"deck,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -86,6 +109,17 @@ func (e QuizEdges) QuizQuestionsOrErr() ([]*QuizQuestion, error) {
 	return nil, &NotLoadedError{edge: "quiz_questions"}
 }
 
+// DeckOrErr returns the Deck value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e QuizEdges) DeckOrErr() (*Deck, error) {
+	if e.Deck != nil {
+		return e.Deck, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: deck.Label}
+	}
+	return nil, &NotLoadedError{edge: "deck"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Quiz) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -97,9 +131,11 @@ func (*Quiz) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case quiz.FieldResultCorrectRate:
 			values[i] = new(sql.NullFloat64)
-		case quiz.FieldID, quiz.FieldUserID, quiz.FieldQuizNumber, quiz.FieldTotalQuestionsCount, quiz.FieldCorrectCount, quiz.FieldIsRegisteredWords, quiz.FieldSettingCorrectRate, quiz.FieldIsIdioms, quiz.FieldIsSpecialCharacters:
+		case quiz.FieldID, quiz.FieldUserID, quiz.FieldQuizNumber, quiz.FieldTotalQuestionsCount, quiz.FieldCorrectCount, quiz.FieldIsRegisteredWords, quiz.FieldSettingCorrectRate, quiz.FieldIsIdioms, quiz.FieldIsSpecialCharacters, quiz.FieldQuestionTimeLimitSec, quiz.FieldTimeLimitSec, quiz.FieldDeckID:
 			values[i] = new(sql.NullInt64)
-		case quiz.FieldCreatedAt, quiz.FieldDeletedAt:
+		case quiz.FieldMode, quiz.FieldDirection, quiz.FieldQuestionType, quiz.FieldDifficulty, quiz.FieldFinishStatus:
+			values[i] = new(sql.NullString)
+		case quiz.FieldExpiresAt, quiz.FieldFinishedAt, quiz.FieldCreatedAt, quiz.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -204,6 +240,70 @@ func (q *Quiz) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field choices_pos_ids: %w", err)
 				}
 			}
+		case quiz.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				q.Mode = value.String
+			}
+		case quiz.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				q.Direction = value.String
+			}
+		case quiz.FieldQuestionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_type", values[i])
+			} else if value.Valid {
+				q.QuestionType = value.String
+			}
+		case quiz.FieldDifficulty:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field difficulty", values[i])
+			} else if value.Valid {
+				q.Difficulty = value.String
+			}
+		case quiz.FieldQuestionTimeLimitSec:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field question_time_limit_sec", values[i])
+			} else if value.Valid {
+				q.QuestionTimeLimitSec = int(value.Int64)
+			}
+		case quiz.FieldTimeLimitSec:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field time_limit_sec", values[i])
+			} else if value.Valid {
+				q.TimeLimitSec = int(value.Int64)
+			}
+		case quiz.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				q.ExpiresAt = new(time.Time)
+				*q.ExpiresAt = value.Time
+			}
+		case quiz.FieldFinishStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field finish_status", values[i])
+			} else if value.Valid {
+				q.FinishStatus = new(string)
+				*q.FinishStatus = value.String
+			}
+		case quiz.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				q.FinishedAt = new(time.Time)
+				*q.FinishedAt = value.Time
+			}
+		case quiz.FieldDeckID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field deck_id", values[i])
+			} else if value.Valid {
+				q.DeckID = new(int)
+				*q.DeckID = int(value.Int64)
+			}
 		case quiz.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -240,6 +340,11 @@ func (q *Quiz) QueryQuizQuestions() *QuizQuestionQuery {
 	return NewQuizClient(q.config).QueryQuizQuestions(q)
 }
 
+// QueryDeck queries the "deck" edge of the Quiz entity.
+func (q *Quiz) QueryDeck() *DeckQuery {
+	return NewQuizClient(q.config).QueryDeck(q)
+}
+
 // Update returns a builder for updating this Quiz.
 // Note that you need to call Quiz.Unwrap() before calling this method if this Quiz
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -302,6 +407,44 @@ func (q *Quiz) String() string {
 	builder.WriteString("choices_pos_ids=")
 	builder.WriteString(fmt.Sprintf("%v", q.ChoicesPosIds))
 	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(q.Mode)
+	builder.WriteString(", ")
+	builder.WriteString("direction=")
+	builder.WriteString(q.Direction)
+	builder.WriteString(", ")
+	builder.WriteString("question_type=")
+	builder.WriteString(q.QuestionType)
+	builder.WriteString(", ")
+	builder.WriteString("difficulty=")
+	builder.WriteString(q.Difficulty)
+	builder.WriteString(", ")
+	builder.WriteString("question_time_limit_sec=")
+	builder.WriteString(fmt.Sprintf("%v", q.QuestionTimeLimitSec))
+	builder.WriteString(", ")
+	builder.WriteString("time_limit_sec=")
+	builder.WriteString(fmt.Sprintf("%v", q.TimeLimitSec))
+	builder.WriteString(", ")
+	if v := q.ExpiresAt; v != nil {
+		builder.WriteString("expires_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := q.FinishStatus; v != nil {
+		builder.WriteString("finish_status=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := q.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := q.DeckID; v != nil {
+		builder.WriteString("deck_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(q.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
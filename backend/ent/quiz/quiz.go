@@ -40,6 +40,26 @@ const (
 	FieldAttentionLevelList = "attention_level_list"
 	// FieldChoicesPosIds holds the string denoting the choices_pos_ids field in the database.
 	FieldChoicesPosIds = "choices_pos_ids"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldDirection holds the string denoting the direction field in the database.
+	FieldDirection = "direction"
+	// FieldQuestionType holds the string denoting the question_type field in the database.
+	FieldQuestionType = "question_type"
+	// FieldDifficulty holds the string denoting the difficulty field in the database.
+	FieldDifficulty = "difficulty"
+	// FieldQuestionTimeLimitSec holds the string denoting the question_time_limit_sec field in the database.
+	FieldQuestionTimeLimitSec = "question_time_limit_sec"
+	// FieldTimeLimitSec holds the string denoting the time_limit_sec field in the database.
+	FieldTimeLimitSec = "time_limit_sec"
+	// FieldExpiresAt holds the string denoting the expires_at field in the database.
+	FieldExpiresAt = "expires_at"
+	// FieldFinishStatus holds the string denoting the finish_status field in the database.
+	FieldFinishStatus = "finish_status"
+	// FieldFinishedAt holds the string denoting the finished_at field in the database.
+	FieldFinishedAt = "finished_at"
+	// FieldDeckID holds the string denoting the deck_id field in the database.
+	FieldDeckID = "deck_id"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
@@ -48,6 +68,8 @@ const (
 	EdgeUser = "user"
 	// EdgeQuizQuestions holds the string denoting the quiz_questions edge name in mutations.
 	EdgeQuizQuestions = "quiz_questions"
+	// EdgeDeck holds the string denoting the deck edge name in mutations.
+	EdgeDeck = "deck"
 	// Table holds the table name of the quiz in the database.
 	Table = "quizs"
 	// UserTable is the table that holds the user relation/edge.
@@ -64,6 +86,13 @@ const (
 	QuizQuestionsInverseTable = "quiz_questions"
 	// QuizQuestionsColumn is the table column denoting the quiz_questions relation/edge.
 	QuizQuestionsColumn = "quiz_id"
+	// DeckTable is the table that holds the deck relation/edge.
+	DeckTable = "quizs"
+	// DeckInverseTable is the table name for the Deck entity.
+	// It exists in this package in order to avoid circular dependency with the "deck" package.
+	DeckInverseTable = "decks"
+	// DeckColumn is the table column denoting the deck relation/edge.
+	DeckColumn = "deck_id"
 )
 
 // Columns holds all SQL columns for quiz fields.
@@ -82,6 +111,16 @@ var Columns = []string{
 	FieldIsSpecialCharacters,
 	FieldAttentionLevelList,
 	FieldChoicesPosIds,
+	FieldMode,
+	FieldDirection,
+	FieldQuestionType,
+	FieldDifficulty,
+	FieldQuestionTimeLimitSec,
+	FieldTimeLimitSec,
+	FieldExpiresAt,
+	FieldFinishStatus,
+	FieldFinishedAt,
+	FieldDeckID,
 	FieldCreatedAt,
 	FieldDeletedAt,
 }
@@ -121,6 +160,32 @@ var (
 	DefaultIsSpecialCharacters int
 	// IsSpecialCharactersValidator is a validator for the "is_special_characters" field. It is called by the builders before save.
 	IsSpecialCharactersValidator func(int) error
+	// DefaultMode holds the default value on creation for the "mode" field.
+	DefaultMode string
+	// ModeValidator is a validator for the "mode" field. It is called by the builders before save.
+	ModeValidator func(string) error
+	// DefaultDirection holds the default value on creation for the "direction" field.
+	DefaultDirection string
+	// DirectionValidator is a validator for the "direction" field. It is called by the builders before save.
+	DirectionValidator func(string) error
+	// DefaultQuestionType holds the default value on creation for the "question_type" field.
+	DefaultQuestionType string
+	// QuestionTypeValidator is a validator for the "question_type" field. It is called by the builders before save.
+	QuestionTypeValidator func(string) error
+	// DefaultDifficulty holds the default value on creation for the "difficulty" field.
+	DefaultDifficulty string
+	// DifficultyValidator is a validator for the "difficulty" field. It is called by the builders before save.
+	DifficultyValidator func(string) error
+	// DefaultQuestionTimeLimitSec holds the default value on creation for the "question_time_limit_sec" field.
+	DefaultQuestionTimeLimitSec int
+	// QuestionTimeLimitSecValidator is a validator for the "question_time_limit_sec" field. It is called by the builders before save.
+	QuestionTimeLimitSecValidator func(int) error
+	// DefaultTimeLimitSec holds the default value on creation for the "time_limit_sec" field.
+	DefaultTimeLimitSec int
+	// TimeLimitSecValidator is a validator for the "time_limit_sec" field. It is called by the builders before save.
+	TimeLimitSecValidator func(int) error
+	// FinishStatusValidator is a validator for the "finish_status" field. It is called by the builders before save.
+	FinishStatusValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -188,6 +253,56 @@ func ByIsSpecialCharacters(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsSpecialCharacters, opts...).ToFunc()
 }
 
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// ByDirection orders the results by the direction field.
+func ByDirection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirection, opts...).ToFunc()
+}
+
+// ByQuestionType orders the results by the question_type field.
+func ByQuestionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionType, opts...).ToFunc()
+}
+
+// ByDifficulty orders the results by the difficulty field.
+func ByDifficulty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifficulty, opts...).ToFunc()
+}
+
+// ByQuestionTimeLimitSec orders the results by the question_time_limit_sec field.
+func ByQuestionTimeLimitSec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionTimeLimitSec, opts...).ToFunc()
+}
+
+// ByTimeLimitSec orders the results by the time_limit_sec field.
+func ByTimeLimitSec(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeLimitSec, opts...).ToFunc()
+}
+
+// ByExpiresAt orders the results by the expires_at field.
+func ByExpiresAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpiresAt, opts...).ToFunc()
+}
+
+// ByFinishStatus orders the results by the finish_status field.
+func ByFinishStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishStatus, opts...).ToFunc()
+}
+
+// ByFinishedAt orders the results by the finished_at field.
+func ByFinishedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinishedAt, opts...).ToFunc()
+}
+
+// ByDeckID orders the results by the deck_id field.
+func ByDeckID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDeckID, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -218,6 +333,13 @@ func ByQuizQuestions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newQuizQuestionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDeckField orders the results by deck field.
+func ByDeckField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeckStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -232,3 +354,10 @@ func newQuizQuestionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, QuizQuestionsTable, QuizQuestionsColumn),
 	)
 }
+func newDeckStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeckInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, DeckTable, DeckColumn),
+	)
+}
@@ -110,6 +110,56 @@ func IsSpecialCharacters(v int) predicate.Quiz {
 	return predicate.Quiz(sql.FieldEQ(FieldIsSpecialCharacters, v))
 }
 
+// Mode applies equality check predicate on the "mode" field. It's identical to ModeEQ.
+func Mode(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMode, v))
+}
+
+// Direction applies equality check predicate on the "direction" field. It's identical to DirectionEQ.
+func Direction(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDirection, v))
+}
+
+// QuestionType applies equality check predicate on the "question_type" field. It's identical to QuestionTypeEQ.
+func QuestionType(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionType, v))
+}
+
+// Difficulty applies equality check predicate on the "difficulty" field. It's identical to DifficultyEQ.
+func Difficulty(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// QuestionTimeLimitSec applies equality check predicate on the "question_time_limit_sec" field. It's identical to QuestionTimeLimitSecEQ.
+func QuestionTimeLimitSec(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionTimeLimitSec, v))
+}
+
+// TimeLimitSec applies equality check predicate on the "time_limit_sec" field. It's identical to TimeLimitSecEQ.
+func TimeLimitSec(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimitSec, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// FinishStatus applies equality check predicate on the "finish_status" field. It's identical to FinishStatusEQ.
+func FinishStatus(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldFinishStatus, v))
+}
+
+// FinishedAt applies equality check predicate on the "finished_at" field. It's identical to FinishedAtEQ.
+func FinishedAt(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// DeckID applies equality check predicate on the "deck_id" field. It's identical to DeckIDEQ.
+func DeckID(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDeckID, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Quiz {
 	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
@@ -480,6 +530,551 @@ func IsSpecialCharactersLTE(v int) predicate.Quiz {
 	return predicate.Quiz(sql.FieldLTE(FieldIsSpecialCharacters, v))
 }
 
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// ModeGT applies the GT predicate on the "mode" field.
+func ModeGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldMode, v))
+}
+
+// ModeGTE applies the GTE predicate on the "mode" field.
+func ModeGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldMode, v))
+}
+
+// ModeLT applies the LT predicate on the "mode" field.
+func ModeLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldMode, v))
+}
+
+// ModeLTE applies the LTE predicate on the "mode" field.
+func ModeLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldMode, v))
+}
+
+// ModeContains applies the Contains predicate on the "mode" field.
+func ModeContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldMode, v))
+}
+
+// ModeHasPrefix applies the HasPrefix predicate on the "mode" field.
+func ModeHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldMode, v))
+}
+
+// ModeHasSuffix applies the HasSuffix predicate on the "mode" field.
+func ModeHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldMode, v))
+}
+
+// ModeEqualFold applies the EqualFold predicate on the "mode" field.
+func ModeEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldMode, v))
+}
+
+// ModeContainsFold applies the ContainsFold predicate on the "mode" field.
+func ModeContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldMode, v))
+}
+
+// DirectionEQ applies the EQ predicate on the "direction" field.
+func DirectionEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDirection, v))
+}
+
+// DirectionNEQ applies the NEQ predicate on the "direction" field.
+func DirectionNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldDirection, v))
+}
+
+// DirectionIn applies the In predicate on the "direction" field.
+func DirectionIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldDirection, vs...))
+}
+
+// DirectionNotIn applies the NotIn predicate on the "direction" field.
+func DirectionNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldDirection, vs...))
+}
+
+// DirectionGT applies the GT predicate on the "direction" field.
+func DirectionGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldDirection, v))
+}
+
+// DirectionGTE applies the GTE predicate on the "direction" field.
+func DirectionGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldDirection, v))
+}
+
+// DirectionLT applies the LT predicate on the "direction" field.
+func DirectionLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldDirection, v))
+}
+
+// DirectionLTE applies the LTE predicate on the "direction" field.
+func DirectionLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldDirection, v))
+}
+
+// DirectionContains applies the Contains predicate on the "direction" field.
+func DirectionContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldDirection, v))
+}
+
+// DirectionHasPrefix applies the HasPrefix predicate on the "direction" field.
+func DirectionHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldDirection, v))
+}
+
+// DirectionHasSuffix applies the HasSuffix predicate on the "direction" field.
+func DirectionHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldDirection, v))
+}
+
+// DirectionEqualFold applies the EqualFold predicate on the "direction" field.
+func DirectionEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldDirection, v))
+}
+
+// DirectionContainsFold applies the ContainsFold predicate on the "direction" field.
+func DirectionContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldDirection, v))
+}
+
+// QuestionTypeEQ applies the EQ predicate on the "question_type" field.
+func QuestionTypeEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionType, v))
+}
+
+// QuestionTypeNEQ applies the NEQ predicate on the "question_type" field.
+func QuestionTypeNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldQuestionType, v))
+}
+
+// QuestionTypeIn applies the In predicate on the "question_type" field.
+func QuestionTypeIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldQuestionType, vs...))
+}
+
+// QuestionTypeNotIn applies the NotIn predicate on the "question_type" field.
+func QuestionTypeNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldQuestionType, vs...))
+}
+
+// QuestionTypeGT applies the GT predicate on the "question_type" field.
+func QuestionTypeGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldQuestionType, v))
+}
+
+// QuestionTypeGTE applies the GTE predicate on the "question_type" field.
+func QuestionTypeGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldQuestionType, v))
+}
+
+// QuestionTypeLT applies the LT predicate on the "question_type" field.
+func QuestionTypeLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldQuestionType, v))
+}
+
+// QuestionTypeLTE applies the LTE predicate on the "question_type" field.
+func QuestionTypeLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldQuestionType, v))
+}
+
+// QuestionTypeContains applies the Contains predicate on the "question_type" field.
+func QuestionTypeContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldQuestionType, v))
+}
+
+// QuestionTypeHasPrefix applies the HasPrefix predicate on the "question_type" field.
+func QuestionTypeHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldQuestionType, v))
+}
+
+// QuestionTypeHasSuffix applies the HasSuffix predicate on the "question_type" field.
+func QuestionTypeHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldQuestionType, v))
+}
+
+// QuestionTypeEqualFold applies the EqualFold predicate on the "question_type" field.
+func QuestionTypeEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldQuestionType, v))
+}
+
+// QuestionTypeContainsFold applies the ContainsFold predicate on the "question_type" field.
+func QuestionTypeContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldQuestionType, v))
+}
+
+// DifficultyEQ applies the EQ predicate on the "difficulty" field.
+func DifficultyEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// DifficultyNEQ applies the NEQ predicate on the "difficulty" field.
+func DifficultyNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldDifficulty, v))
+}
+
+// DifficultyIn applies the In predicate on the "difficulty" field.
+func DifficultyIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldDifficulty, vs...))
+}
+
+// DifficultyNotIn applies the NotIn predicate on the "difficulty" field.
+func DifficultyNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldDifficulty, vs...))
+}
+
+// DifficultyGT applies the GT predicate on the "difficulty" field.
+func DifficultyGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldDifficulty, v))
+}
+
+// DifficultyGTE applies the GTE predicate on the "difficulty" field.
+func DifficultyGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldDifficulty, v))
+}
+
+// DifficultyLT applies the LT predicate on the "difficulty" field.
+func DifficultyLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldDifficulty, v))
+}
+
+// DifficultyLTE applies the LTE predicate on the "difficulty" field.
+func DifficultyLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldDifficulty, v))
+}
+
+// DifficultyContains applies the Contains predicate on the "difficulty" field.
+func DifficultyContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldDifficulty, v))
+}
+
+// DifficultyHasPrefix applies the HasPrefix predicate on the "difficulty" field.
+func DifficultyHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldDifficulty, v))
+}
+
+// DifficultyHasSuffix applies the HasSuffix predicate on the "difficulty" field.
+func DifficultyHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldDifficulty, v))
+}
+
+// DifficultyEqualFold applies the EqualFold predicate on the "difficulty" field.
+func DifficultyEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldDifficulty, v))
+}
+
+// DifficultyContainsFold applies the ContainsFold predicate on the "difficulty" field.
+func DifficultyContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldDifficulty, v))
+}
+
+// QuestionTimeLimitSecEQ applies the EQ predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldQuestionTimeLimitSec, v))
+}
+
+// QuestionTimeLimitSecNEQ applies the NEQ predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldQuestionTimeLimitSec, v))
+}
+
+// QuestionTimeLimitSecIn applies the In predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldQuestionTimeLimitSec, vs...))
+}
+
+// QuestionTimeLimitSecNotIn applies the NotIn predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldQuestionTimeLimitSec, vs...))
+}
+
+// QuestionTimeLimitSecGT applies the GT predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldQuestionTimeLimitSec, v))
+}
+
+// QuestionTimeLimitSecGTE applies the GTE predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldQuestionTimeLimitSec, v))
+}
+
+// QuestionTimeLimitSecLT applies the LT predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldQuestionTimeLimitSec, v))
+}
+
+// QuestionTimeLimitSecLTE applies the LTE predicate on the "question_time_limit_sec" field.
+func QuestionTimeLimitSecLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldQuestionTimeLimitSec, v))
+}
+
+// TimeLimitSecEQ applies the EQ predicate on the "time_limit_sec" field.
+func TimeLimitSecEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldTimeLimitSec, v))
+}
+
+// TimeLimitSecNEQ applies the NEQ predicate on the "time_limit_sec" field.
+func TimeLimitSecNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldTimeLimitSec, v))
+}
+
+// TimeLimitSecIn applies the In predicate on the "time_limit_sec" field.
+func TimeLimitSecIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldTimeLimitSec, vs...))
+}
+
+// TimeLimitSecNotIn applies the NotIn predicate on the "time_limit_sec" field.
+func TimeLimitSecNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldTimeLimitSec, vs...))
+}
+
+// TimeLimitSecGT applies the GT predicate on the "time_limit_sec" field.
+func TimeLimitSecGT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldTimeLimitSec, v))
+}
+
+// TimeLimitSecGTE applies the GTE predicate on the "time_limit_sec" field.
+func TimeLimitSecGTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldTimeLimitSec, v))
+}
+
+// TimeLimitSecLT applies the LT predicate on the "time_limit_sec" field.
+func TimeLimitSecLT(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldTimeLimitSec, v))
+}
+
+// TimeLimitSecLTE applies the LTE predicate on the "time_limit_sec" field.
+func TimeLimitSecLTE(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldTimeLimitSec, v))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtIsNil applies the IsNil predicate on the "expires_at" field.
+func ExpiresAtIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldExpiresAt))
+}
+
+// ExpiresAtNotNil applies the NotNil predicate on the "expires_at" field.
+func ExpiresAtNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldExpiresAt))
+}
+
+// FinishStatusEQ applies the EQ predicate on the "finish_status" field.
+func FinishStatusEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldFinishStatus, v))
+}
+
+// FinishStatusNEQ applies the NEQ predicate on the "finish_status" field.
+func FinishStatusNEQ(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldFinishStatus, v))
+}
+
+// FinishStatusIn applies the In predicate on the "finish_status" field.
+func FinishStatusIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldFinishStatus, vs...))
+}
+
+// FinishStatusNotIn applies the NotIn predicate on the "finish_status" field.
+func FinishStatusNotIn(vs ...string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldFinishStatus, vs...))
+}
+
+// FinishStatusGT applies the GT predicate on the "finish_status" field.
+func FinishStatusGT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldFinishStatus, v))
+}
+
+// FinishStatusGTE applies the GTE predicate on the "finish_status" field.
+func FinishStatusGTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldFinishStatus, v))
+}
+
+// FinishStatusLT applies the LT predicate on the "finish_status" field.
+func FinishStatusLT(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldFinishStatus, v))
+}
+
+// FinishStatusLTE applies the LTE predicate on the "finish_status" field.
+func FinishStatusLTE(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldFinishStatus, v))
+}
+
+// FinishStatusContains applies the Contains predicate on the "finish_status" field.
+func FinishStatusContains(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContains(FieldFinishStatus, v))
+}
+
+// FinishStatusHasPrefix applies the HasPrefix predicate on the "finish_status" field.
+func FinishStatusHasPrefix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasPrefix(FieldFinishStatus, v))
+}
+
+// FinishStatusHasSuffix applies the HasSuffix predicate on the "finish_status" field.
+func FinishStatusHasSuffix(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldHasSuffix(FieldFinishStatus, v))
+}
+
+// FinishStatusIsNil applies the IsNil predicate on the "finish_status" field.
+func FinishStatusIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldFinishStatus))
+}
+
+// FinishStatusNotNil applies the NotNil predicate on the "finish_status" field.
+func FinishStatusNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldFinishStatus))
+}
+
+// FinishStatusEqualFold applies the EqualFold predicate on the "finish_status" field.
+func FinishStatusEqualFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEqualFold(FieldFinishStatus, v))
+}
+
+// FinishStatusContainsFold applies the ContainsFold predicate on the "finish_status" field.
+func FinishStatusContainsFold(v string) predicate.Quiz {
+	return predicate.Quiz(sql.FieldContainsFold(FieldFinishStatus, v))
+}
+
+// FinishedAtEQ applies the EQ predicate on the "finished_at" field.
+func FinishedAtEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtNEQ applies the NEQ predicate on the "finished_at" field.
+func FinishedAtNEQ(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldFinishedAt, v))
+}
+
+// FinishedAtIn applies the In predicate on the "finished_at" field.
+func FinishedAtIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtNotIn applies the NotIn predicate on the "finished_at" field.
+func FinishedAtNotIn(vs ...time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldFinishedAt, vs...))
+}
+
+// FinishedAtGT applies the GT predicate on the "finished_at" field.
+func FinishedAtGT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGT(FieldFinishedAt, v))
+}
+
+// FinishedAtGTE applies the GTE predicate on the "finished_at" field.
+func FinishedAtGTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldGTE(FieldFinishedAt, v))
+}
+
+// FinishedAtLT applies the LT predicate on the "finished_at" field.
+func FinishedAtLT(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLT(FieldFinishedAt, v))
+}
+
+// FinishedAtLTE applies the LTE predicate on the "finished_at" field.
+func FinishedAtLTE(v time.Time) predicate.Quiz {
+	return predicate.Quiz(sql.FieldLTE(FieldFinishedAt, v))
+}
+
+// FinishedAtIsNil applies the IsNil predicate on the "finished_at" field.
+func FinishedAtIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldFinishedAt))
+}
+
+// FinishedAtNotNil applies the NotNil predicate on the "finished_at" field.
+func FinishedAtNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldFinishedAt))
+}
+
+// DeckIDEQ applies the EQ predicate on the "deck_id" field.
+func DeckIDEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldEQ(FieldDeckID, v))
+}
+
+// DeckIDNEQ applies the NEQ predicate on the "deck_id" field.
+func DeckIDNEQ(v int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNEQ(FieldDeckID, v))
+}
+
+// DeckIDIn applies the In predicate on the "deck_id" field.
+func DeckIDIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldIn(FieldDeckID, vs...))
+}
+
+// DeckIDNotIn applies the NotIn predicate on the "deck_id" field.
+func DeckIDNotIn(vs ...int) predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotIn(FieldDeckID, vs...))
+}
+
+// DeckIDIsNil applies the IsNil predicate on the "deck_id" field.
+func DeckIDIsNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldIsNull(FieldDeckID))
+}
+
+// DeckIDNotNil applies the NotNil predicate on the "deck_id" field.
+func DeckIDNotNil() predicate.Quiz {
+	return predicate.Quiz(sql.FieldNotNull(FieldDeckID))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Quiz {
 	return predicate.Quiz(sql.FieldEQ(FieldCreatedAt, v))
@@ -616,6 +1211,29 @@ func HasQuizQuestionsWith(preds ...predicate.QuizQuestion) predicate.Quiz {
 	})
 }
 
+// HasDeck applies the HasEdge predicate on the "deck" edge.
+func HasDeck() predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, DeckTable, DeckColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeckWith applies the HasEdge predicate on the "deck" edge with a given conditions (other predicates).
+func HasDeckWith(preds ...predicate.Deck) predicate.Quiz {
+	return predicate.Quiz(func(s *sql.Selector) {
+		step := newDeckStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Quiz) predicate.Quiz {
 	return predicate.Quiz(sql.AndPredicates(predicates...))
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"word_app/backend/ent/deck"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
 	"word_app/backend/ent/user"
@@ -174,6 +175,146 @@ func (qc *QuizCreate) SetChoicesPosIds(i []int) *QuizCreate {
 	return qc
 }
 
+// SetMode sets the "mode" field.
+func (qc *QuizCreate) SetMode(s string) *QuizCreate {
+	qc.mutation.SetMode(s)
+	return qc
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableMode(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetMode(*s)
+	}
+	return qc
+}
+
+// SetDirection sets the "direction" field.
+func (qc *QuizCreate) SetDirection(s string) *QuizCreate {
+	qc.mutation.SetDirection(s)
+	return qc
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableDirection(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetDirection(*s)
+	}
+	return qc
+}
+
+// SetQuestionType sets the "question_type" field.
+func (qc *QuizCreate) SetQuestionType(s string) *QuizCreate {
+	qc.mutation.SetQuestionType(s)
+	return qc
+}
+
+// SetNillableQuestionType sets the "question_type" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableQuestionType(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetQuestionType(*s)
+	}
+	return qc
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (qc *QuizCreate) SetDifficulty(s string) *QuizCreate {
+	qc.mutation.SetDifficulty(s)
+	return qc
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableDifficulty(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetDifficulty(*s)
+	}
+	return qc
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (qc *QuizCreate) SetQuestionTimeLimitSec(i int) *QuizCreate {
+	qc.mutation.SetQuestionTimeLimitSec(i)
+	return qc
+}
+
+// SetNillableQuestionTimeLimitSec sets the "question_time_limit_sec" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableQuestionTimeLimitSec(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetQuestionTimeLimitSec(*i)
+	}
+	return qc
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (qc *QuizCreate) SetTimeLimitSec(i int) *QuizCreate {
+	qc.mutation.SetTimeLimitSec(i)
+	return qc
+}
+
+// SetNillableTimeLimitSec sets the "time_limit_sec" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableTimeLimitSec(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetTimeLimitSec(*i)
+	}
+	return qc
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qc *QuizCreate) SetExpiresAt(t time.Time) *QuizCreate {
+	qc.mutation.SetExpiresAt(t)
+	return qc
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableExpiresAt(t *time.Time) *QuizCreate {
+	if t != nil {
+		qc.SetExpiresAt(*t)
+	}
+	return qc
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (qc *QuizCreate) SetFinishStatus(s string) *QuizCreate {
+	qc.mutation.SetFinishStatus(s)
+	return qc
+}
+
+// SetNillableFinishStatus sets the "finish_status" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableFinishStatus(s *string) *QuizCreate {
+	if s != nil {
+		qc.SetFinishStatus(*s)
+	}
+	return qc
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (qc *QuizCreate) SetFinishedAt(t time.Time) *QuizCreate {
+	qc.mutation.SetFinishedAt(t)
+	return qc
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableFinishedAt(t *time.Time) *QuizCreate {
+	if t != nil {
+		qc.SetFinishedAt(*t)
+	}
+	return qc
+}
+
+// SetDeckID sets the "deck_id" field.
+func (qc *QuizCreate) SetDeckID(i int) *QuizCreate {
+	qc.mutation.SetDeckID(i)
+	return qc
+}
+
+// SetNillableDeckID sets the "deck_id" field if the given value is not nil.
+func (qc *QuizCreate) SetNillableDeckID(i *int) *QuizCreate {
+	if i != nil {
+		qc.SetDeckID(*i)
+	}
+	return qc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (qc *QuizCreate) SetCreatedAt(t time.Time) *QuizCreate {
 	qc.mutation.SetCreatedAt(t)
@@ -222,6 +363,11 @@ func (qc *QuizCreate) AddQuizQuestions(q ...*QuizQuestion) *QuizCreate {
 	return qc.AddQuizQuestionIDs(ids...)
 }
 
+// SetDeck sets the "deck" edge to the Deck entity.
+func (qc *QuizCreate) SetDeck(d *Deck) *QuizCreate {
+	return qc.SetDeckID(d.ID)
+}
+
 // Mutation returns the QuizMutation object of the builder.
 func (qc *QuizCreate) Mutation() *QuizMutation {
 	return qc.mutation
@@ -293,6 +439,30 @@ func (qc *QuizCreate) defaults() {
 		v := quiz.DefaultIsSpecialCharacters
 		qc.mutation.SetIsSpecialCharacters(v)
 	}
+	if _, ok := qc.mutation.Mode(); !ok {
+		v := quiz.DefaultMode
+		qc.mutation.SetMode(v)
+	}
+	if _, ok := qc.mutation.Direction(); !ok {
+		v := quiz.DefaultDirection
+		qc.mutation.SetDirection(v)
+	}
+	if _, ok := qc.mutation.QuestionType(); !ok {
+		v := quiz.DefaultQuestionType
+		qc.mutation.SetQuestionType(v)
+	}
+	if _, ok := qc.mutation.Difficulty(); !ok {
+		v := quiz.DefaultDifficulty
+		qc.mutation.SetDifficulty(v)
+	}
+	if _, ok := qc.mutation.QuestionTimeLimitSec(); !ok {
+		v := quiz.DefaultQuestionTimeLimitSec
+		qc.mutation.SetQuestionTimeLimitSec(v)
+	}
+	if _, ok := qc.mutation.TimeLimitSec(); !ok {
+		v := quiz.DefaultTimeLimitSec
+		qc.mutation.SetTimeLimitSec(v)
+	}
 	if _, ok := qc.mutation.CreatedAt(); !ok {
 		v := quiz.DefaultCreatedAt()
 		qc.mutation.SetCreatedAt(v)
@@ -355,6 +525,59 @@ func (qc *QuizCreate) check() error {
 	if _, ok := qc.mutation.ChoicesPosIds(); !ok {
 		return &ValidationError{Name: "choices_pos_ids", err: errors.New(`ent: missing required field "Quiz.choices_pos_ids"`)}
 	}
+	if _, ok := qc.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "Quiz.mode"`)}
+	}
+	if v, ok := qc.mutation.Mode(); ok {
+		if err := quiz.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Quiz.mode": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Direction(); !ok {
+		return &ValidationError{Name: "direction", err: errors.New(`ent: missing required field "Quiz.direction"`)}
+	}
+	if v, ok := qc.mutation.Direction(); ok {
+		if err := quiz.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "Quiz.direction": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.QuestionType(); !ok {
+		return &ValidationError{Name: "question_type", err: errors.New(`ent: missing required field "Quiz.question_type"`)}
+	}
+	if v, ok := qc.mutation.QuestionType(); ok {
+		if err := quiz.QuestionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "question_type", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_type": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.Difficulty(); !ok {
+		return &ValidationError{Name: "difficulty", err: errors.New(`ent: missing required field "Quiz.difficulty"`)}
+	}
+	if v, ok := qc.mutation.Difficulty(); ok {
+		if err := quiz.DifficultyValidator(v); err != nil {
+			return &ValidationError{Name: "difficulty", err: fmt.Errorf(`ent: validator failed for field "Quiz.difficulty": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.QuestionTimeLimitSec(); !ok {
+		return &ValidationError{Name: "question_time_limit_sec", err: errors.New(`ent: missing required field "Quiz.question_time_limit_sec"`)}
+	}
+	if v, ok := qc.mutation.QuestionTimeLimitSec(); ok {
+		if err := quiz.QuestionTimeLimitSecValidator(v); err != nil {
+			return &ValidationError{Name: "question_time_limit_sec", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_time_limit_sec": %w`, err)}
+		}
+	}
+	if _, ok := qc.mutation.TimeLimitSec(); !ok {
+		return &ValidationError{Name: "time_limit_sec", err: errors.New(`ent: missing required field "Quiz.time_limit_sec"`)}
+	}
+	if v, ok := qc.mutation.TimeLimitSec(); ok {
+		if err := quiz.TimeLimitSecValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_sec", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_sec": %w`, err)}
+		}
+	}
+	if v, ok := qc.mutation.FinishStatus(); ok {
+		if err := quiz.FinishStatusValidator(v); err != nil {
+			return &ValidationError{Name: "finish_status", err: fmt.Errorf(`ent: validator failed for field "Quiz.finish_status": %w`, err)}
+		}
+	}
 	if _, ok := qc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Quiz.created_at"`)}
 	}
@@ -436,6 +659,42 @@ func (qc *QuizCreate) createSpec() (*Quiz, *sqlgraph.CreateSpec) {
 		_spec.SetField(quiz.FieldChoicesPosIds, field.TypeJSON, value)
 		_node.ChoicesPosIds = value
 	}
+	if value, ok := qc.mutation.Mode(); ok {
+		_spec.SetField(quiz.FieldMode, field.TypeString, value)
+		_node.Mode = value
+	}
+	if value, ok := qc.mutation.Direction(); ok {
+		_spec.SetField(quiz.FieldDirection, field.TypeString, value)
+		_node.Direction = value
+	}
+	if value, ok := qc.mutation.QuestionType(); ok {
+		_spec.SetField(quiz.FieldQuestionType, field.TypeString, value)
+		_node.QuestionType = value
+	}
+	if value, ok := qc.mutation.Difficulty(); ok {
+		_spec.SetField(quiz.FieldDifficulty, field.TypeString, value)
+		_node.Difficulty = value
+	}
+	if value, ok := qc.mutation.QuestionTimeLimitSec(); ok {
+		_spec.SetField(quiz.FieldQuestionTimeLimitSec, field.TypeInt, value)
+		_node.QuestionTimeLimitSec = value
+	}
+	if value, ok := qc.mutation.TimeLimitSec(); ok {
+		_spec.SetField(quiz.FieldTimeLimitSec, field.TypeInt, value)
+		_node.TimeLimitSec = value
+	}
+	if value, ok := qc.mutation.ExpiresAt(); ok {
+		_spec.SetField(quiz.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := qc.mutation.FinishStatus(); ok {
+		_spec.SetField(quiz.FieldFinishStatus, field.TypeString, value)
+		_node.FinishStatus = &value
+	}
+	if value, ok := qc.mutation.FinishedAt(); ok {
+		_spec.SetField(quiz.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
 	if value, ok := qc.mutation.CreatedAt(); ok {
 		_spec.SetField(quiz.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -477,6 +736,23 @@ func (qc *QuizCreate) createSpec() (*Quiz, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := qc.mutation.DeckIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.DeckTable,
+			Columns: []string{quiz.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.DeckID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -733,6 +1009,162 @@ func (u *QuizUpsert) UpdateChoicesPosIds() *QuizUpsert {
 	return u
 }
 
+// SetMode sets the "mode" field.
+func (u *QuizUpsert) SetMode(v string) *QuizUpsert {
+	u.Set(quiz.FieldMode, v)
+	return u
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateMode() *QuizUpsert {
+	u.SetExcluded(quiz.FieldMode)
+	return u
+}
+
+// SetDirection sets the "direction" field.
+func (u *QuizUpsert) SetDirection(v string) *QuizUpsert {
+	u.Set(quiz.FieldDirection, v)
+	return u
+}
+
+// UpdateDirection sets the "direction" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateDirection() *QuizUpsert {
+	u.SetExcluded(quiz.FieldDirection)
+	return u
+}
+
+// SetQuestionType sets the "question_type" field.
+func (u *QuizUpsert) SetQuestionType(v string) *QuizUpsert {
+	u.Set(quiz.FieldQuestionType, v)
+	return u
+}
+
+// UpdateQuestionType sets the "question_type" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateQuestionType() *QuizUpsert {
+	u.SetExcluded(quiz.FieldQuestionType)
+	return u
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (u *QuizUpsert) SetDifficulty(v string) *QuizUpsert {
+	u.Set(quiz.FieldDifficulty, v)
+	return u
+}
+
+// UpdateDifficulty sets the "difficulty" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateDifficulty() *QuizUpsert {
+	u.SetExcluded(quiz.FieldDifficulty)
+	return u
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (u *QuizUpsert) SetQuestionTimeLimitSec(v int) *QuizUpsert {
+	u.Set(quiz.FieldQuestionTimeLimitSec, v)
+	return u
+}
+
+// UpdateQuestionTimeLimitSec sets the "question_time_limit_sec" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateQuestionTimeLimitSec() *QuizUpsert {
+	u.SetExcluded(quiz.FieldQuestionTimeLimitSec)
+	return u
+}
+
+// AddQuestionTimeLimitSec adds v to the "question_time_limit_sec" field.
+func (u *QuizUpsert) AddQuestionTimeLimitSec(v int) *QuizUpsert {
+	u.Add(quiz.FieldQuestionTimeLimitSec, v)
+	return u
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (u *QuizUpsert) SetTimeLimitSec(v int) *QuizUpsert {
+	u.Set(quiz.FieldTimeLimitSec, v)
+	return u
+}
+
+// UpdateTimeLimitSec sets the "time_limit_sec" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateTimeLimitSec() *QuizUpsert {
+	u.SetExcluded(quiz.FieldTimeLimitSec)
+	return u
+}
+
+// AddTimeLimitSec adds v to the "time_limit_sec" field.
+func (u *QuizUpsert) AddTimeLimitSec(v int) *QuizUpsert {
+	u.Add(quiz.FieldTimeLimitSec, v)
+	return u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *QuizUpsert) SetExpiresAt(v time.Time) *QuizUpsert {
+	u.Set(quiz.FieldExpiresAt, v)
+	return u
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateExpiresAt() *QuizUpsert {
+	u.SetExcluded(quiz.FieldExpiresAt)
+	return u
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *QuizUpsert) ClearExpiresAt() *QuizUpsert {
+	u.SetNull(quiz.FieldExpiresAt)
+	return u
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (u *QuizUpsert) SetFinishStatus(v string) *QuizUpsert {
+	u.Set(quiz.FieldFinishStatus, v)
+	return u
+}
+
+// UpdateFinishStatus sets the "finish_status" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateFinishStatus() *QuizUpsert {
+	u.SetExcluded(quiz.FieldFinishStatus)
+	return u
+}
+
+// ClearFinishStatus clears the value of the "finish_status" field.
+func (u *QuizUpsert) ClearFinishStatus() *QuizUpsert {
+	u.SetNull(quiz.FieldFinishStatus)
+	return u
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *QuizUpsert) SetFinishedAt(v time.Time) *QuizUpsert {
+	u.Set(quiz.FieldFinishedAt, v)
+	return u
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateFinishedAt() *QuizUpsert {
+	u.SetExcluded(quiz.FieldFinishedAt)
+	return u
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *QuizUpsert) ClearFinishedAt() *QuizUpsert {
+	u.SetNull(quiz.FieldFinishedAt)
+	return u
+}
+
+// SetDeckID sets the "deck_id" field.
+func (u *QuizUpsert) SetDeckID(v int) *QuizUpsert {
+	u.Set(quiz.FieldDeckID, v)
+	return u
+}
+
+// UpdateDeckID sets the "deck_id" field to the value that was provided on create.
+func (u *QuizUpsert) UpdateDeckID() *QuizUpsert {
+	u.SetExcluded(quiz.FieldDeckID)
+	return u
+}
+
+// ClearDeckID clears the value of the "deck_id" field.
+func (u *QuizUpsert) ClearDeckID() *QuizUpsert {
+	u.SetNull(quiz.FieldDeckID)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *QuizUpsert) SetCreatedAt(v time.Time) *QuizUpsert {
 	u.Set(quiz.FieldCreatedAt, v)
@@ -1041,6 +1473,188 @@ func (u *QuizUpsertOne) UpdateChoicesPosIds() *QuizUpsertOne {
 	})
 }
 
+// SetMode sets the "mode" field.
+func (u *QuizUpsertOne) SetMode(v string) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateMode() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetDirection sets the "direction" field.
+func (u *QuizUpsertOne) SetDirection(v string) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetDirection(v)
+	})
+}
+
+// UpdateDirection sets the "direction" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateDirection() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateDirection()
+	})
+}
+
+// SetQuestionType sets the "question_type" field.
+func (u *QuizUpsertOne) SetQuestionType(v string) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetQuestionType(v)
+	})
+}
+
+// UpdateQuestionType sets the "question_type" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateQuestionType() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateQuestionType()
+	})
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (u *QuizUpsertOne) SetDifficulty(v string) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetDifficulty(v)
+	})
+}
+
+// UpdateDifficulty sets the "difficulty" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateDifficulty() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateDifficulty()
+	})
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (u *QuizUpsertOne) SetQuestionTimeLimitSec(v int) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetQuestionTimeLimitSec(v)
+	})
+}
+
+// AddQuestionTimeLimitSec adds v to the "question_time_limit_sec" field.
+func (u *QuizUpsertOne) AddQuestionTimeLimitSec(v int) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.AddQuestionTimeLimitSec(v)
+	})
+}
+
+// UpdateQuestionTimeLimitSec sets the "question_time_limit_sec" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateQuestionTimeLimitSec() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateQuestionTimeLimitSec()
+	})
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (u *QuizUpsertOne) SetTimeLimitSec(v int) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetTimeLimitSec(v)
+	})
+}
+
+// AddTimeLimitSec adds v to the "time_limit_sec" field.
+func (u *QuizUpsertOne) AddTimeLimitSec(v int) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.AddTimeLimitSec(v)
+	})
+}
+
+// UpdateTimeLimitSec sets the "time_limit_sec" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateTimeLimitSec() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateTimeLimitSec()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *QuizUpsertOne) SetExpiresAt(v time.Time) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateExpiresAt() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *QuizUpsertOne) ClearExpiresAt() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (u *QuizUpsertOne) SetFinishStatus(v string) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetFinishStatus(v)
+	})
+}
+
+// UpdateFinishStatus sets the "finish_status" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateFinishStatus() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateFinishStatus()
+	})
+}
+
+// ClearFinishStatus clears the value of the "finish_status" field.
+func (u *QuizUpsertOne) ClearFinishStatus() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearFinishStatus()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *QuizUpsertOne) SetFinishedAt(v time.Time) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateFinishedAt() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *QuizUpsertOne) ClearFinishedAt() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDeckID sets the "deck_id" field.
+func (u *QuizUpsertOne) SetDeckID(v int) *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetDeckID(v)
+	})
+}
+
+// UpdateDeckID sets the "deck_id" field to the value that was provided on create.
+func (u *QuizUpsertOne) UpdateDeckID() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateDeckID()
+	})
+}
+
+// ClearDeckID clears the value of the "deck_id" field.
+func (u *QuizUpsertOne) ClearDeckID() *QuizUpsertOne {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearDeckID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *QuizUpsertOne) SetCreatedAt(v time.Time) *QuizUpsertOne {
 	return u.Update(func(s *QuizUpsert) {
@@ -1518,6 +2132,188 @@ func (u *QuizUpsertBulk) UpdateChoicesPosIds() *QuizUpsertBulk {
 	})
 }
 
+// SetMode sets the "mode" field.
+func (u *QuizUpsertBulk) SetMode(v string) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetMode(v)
+	})
+}
+
+// UpdateMode sets the "mode" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateMode() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateMode()
+	})
+}
+
+// SetDirection sets the "direction" field.
+func (u *QuizUpsertBulk) SetDirection(v string) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetDirection(v)
+	})
+}
+
+// UpdateDirection sets the "direction" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateDirection() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateDirection()
+	})
+}
+
+// SetQuestionType sets the "question_type" field.
+func (u *QuizUpsertBulk) SetQuestionType(v string) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetQuestionType(v)
+	})
+}
+
+// UpdateQuestionType sets the "question_type" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateQuestionType() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateQuestionType()
+	})
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (u *QuizUpsertBulk) SetDifficulty(v string) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetDifficulty(v)
+	})
+}
+
+// UpdateDifficulty sets the "difficulty" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateDifficulty() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateDifficulty()
+	})
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (u *QuizUpsertBulk) SetQuestionTimeLimitSec(v int) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetQuestionTimeLimitSec(v)
+	})
+}
+
+// AddQuestionTimeLimitSec adds v to the "question_time_limit_sec" field.
+func (u *QuizUpsertBulk) AddQuestionTimeLimitSec(v int) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.AddQuestionTimeLimitSec(v)
+	})
+}
+
+// UpdateQuestionTimeLimitSec sets the "question_time_limit_sec" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateQuestionTimeLimitSec() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateQuestionTimeLimitSec()
+	})
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (u *QuizUpsertBulk) SetTimeLimitSec(v int) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetTimeLimitSec(v)
+	})
+}
+
+// AddTimeLimitSec adds v to the "time_limit_sec" field.
+func (u *QuizUpsertBulk) AddTimeLimitSec(v int) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.AddTimeLimitSec(v)
+	})
+}
+
+// UpdateTimeLimitSec sets the "time_limit_sec" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateTimeLimitSec() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateTimeLimitSec()
+	})
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (u *QuizUpsertBulk) SetExpiresAt(v time.Time) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetExpiresAt(v)
+	})
+}
+
+// UpdateExpiresAt sets the "expires_at" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateExpiresAt() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateExpiresAt()
+	})
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (u *QuizUpsertBulk) ClearExpiresAt() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearExpiresAt()
+	})
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (u *QuizUpsertBulk) SetFinishStatus(v string) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetFinishStatus(v)
+	})
+}
+
+// UpdateFinishStatus sets the "finish_status" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateFinishStatus() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateFinishStatus()
+	})
+}
+
+// ClearFinishStatus clears the value of the "finish_status" field.
+func (u *QuizUpsertBulk) ClearFinishStatus() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearFinishStatus()
+	})
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (u *QuizUpsertBulk) SetFinishedAt(v time.Time) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetFinishedAt(v)
+	})
+}
+
+// UpdateFinishedAt sets the "finished_at" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateFinishedAt() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateFinishedAt()
+	})
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (u *QuizUpsertBulk) ClearFinishedAt() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearFinishedAt()
+	})
+}
+
+// SetDeckID sets the "deck_id" field.
+func (u *QuizUpsertBulk) SetDeckID(v int) *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.SetDeckID(v)
+	})
+}
+
+// UpdateDeckID sets the "deck_id" field to the value that was provided on create.
+func (u *QuizUpsertBulk) UpdateDeckID() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.UpdateDeckID()
+	})
+}
+
+// ClearDeckID clears the value of the "deck_id" field.
+func (u *QuizUpsertBulk) ClearDeckID() *QuizUpsertBulk {
+	return u.Update(func(s *QuizUpsert) {
+		s.ClearDeckID()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *QuizUpsertBulk) SetCreatedAt(v time.Time) *QuizUpsertBulk {
 	return u.Update(func(s *QuizUpsert) {
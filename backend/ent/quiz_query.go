@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"word_app/backend/ent/deck"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
@@ -27,6 +28,7 @@ type QuizQuery struct {
 	predicates        []predicate.Quiz
 	withUser          *UserQuery
 	withQuizQuestions *QuizQuestionQuery
+	withDeck          *DeckQuery
 	modifiers         []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -108,6 +110,28 @@ func (qq *QuizQuery) QueryQuizQuestions() *QuizQuestionQuery {
 	return query
 }
 
+// QueryDeck chains the current query on the "deck" edge.
+func (qq *QuizQuery) QueryDeck() *DeckQuery {
+	query := (&DeckClient{config: qq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := qq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := qq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(quiz.Table, quiz.FieldID, selector),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, quiz.DeckTable, quiz.DeckColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(qq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Quiz entity from the query.
 // Returns a *NotFoundError when no Quiz was found.
 func (qq *QuizQuery) First(ctx context.Context) (*Quiz, error) {
@@ -302,6 +326,7 @@ func (qq *QuizQuery) Clone() *QuizQuery {
 		predicates:        append([]predicate.Quiz{}, qq.predicates...),
 		withUser:          qq.withUser.Clone(),
 		withQuizQuestions: qq.withQuizQuestions.Clone(),
+		withDeck:          qq.withDeck.Clone(),
 		// clone intermediate query.
 		sql:       qq.sql.Clone(),
 		path:      qq.path,
@@ -331,6 +356,17 @@ func (qq *QuizQuery) WithQuizQuestions(opts ...func(*QuizQuestionQuery)) *QuizQu
 	return qq
 }
 
+// WithDeck tells the query-builder to eager-load the nodes that are connected to
+// the "deck" edge. The optional arguments are used to configure the query builder of the edge.
+func (qq *QuizQuery) WithDeck(opts ...func(*DeckQuery)) *QuizQuery {
+	query := (&DeckClient{config: qq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	qq.withDeck = query
+	return qq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +445,10 @@ func (qq *QuizQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Quiz, e
 	var (
 		nodes       = []*Quiz{}
 		_spec       = qq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			qq.withUser != nil,
 			qq.withQuizQuestions != nil,
+			qq.withDeck != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -448,6 +485,12 @@ func (qq *QuizQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Quiz, e
 			return nil, err
 		}
 	}
+	if query := qq.withDeck; query != nil {
+		if err := qq.loadDeck(ctx, query, nodes, nil,
+			func(n *Quiz, e *Deck) { n.Edges.Deck = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -511,6 +554,38 @@ func (qq *QuizQuery) loadQuizQuestions(ctx context.Context, query *QuizQuestionQ
 	}
 	return nil
 }
+func (qq *QuizQuery) loadDeck(ctx context.Context, query *DeckQuery, nodes []*Quiz, init func(*Quiz), assign func(*Quiz, *Deck)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Quiz)
+	for i := range nodes {
+		if nodes[i].DeckID == nil {
+			continue
+		}
+		fk := *nodes[i].DeckID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(deck.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "deck_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (qq *QuizQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := qq.querySpec()
@@ -543,6 +618,9 @@ func (qq *QuizQuery) querySpec() *sqlgraph.QuerySpec {
 		if qq.withUser != nil {
 			_spec.Node.AddColumnOnce(quiz.FieldUserID)
 		}
+		if qq.withDeck != nil {
+			_spec.Node.AddColumnOnce(quiz.FieldDeckID)
+		}
 	}
 	if ps := qq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
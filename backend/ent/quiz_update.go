@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"word_app/backend/ent/deck"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
@@ -266,6 +267,184 @@ func (qu *QuizUpdate) AppendChoicesPosIds(i []int) *QuizUpdate {
 	return qu
 }
 
+// SetMode sets the "mode" field.
+func (qu *QuizUpdate) SetMode(s string) *QuizUpdate {
+	qu.mutation.SetMode(s)
+	return qu
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableMode(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetMode(*s)
+	}
+	return qu
+}
+
+// SetDirection sets the "direction" field.
+func (qu *QuizUpdate) SetDirection(s string) *QuizUpdate {
+	qu.mutation.SetDirection(s)
+	return qu
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableDirection(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetDirection(*s)
+	}
+	return qu
+}
+
+// SetQuestionType sets the "question_type" field.
+func (qu *QuizUpdate) SetQuestionType(s string) *QuizUpdate {
+	qu.mutation.SetQuestionType(s)
+	return qu
+}
+
+// SetNillableQuestionType sets the "question_type" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableQuestionType(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetQuestionType(*s)
+	}
+	return qu
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (qu *QuizUpdate) SetDifficulty(s string) *QuizUpdate {
+	qu.mutation.SetDifficulty(s)
+	return qu
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableDifficulty(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetDifficulty(*s)
+	}
+	return qu
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (qu *QuizUpdate) SetQuestionTimeLimitSec(i int) *QuizUpdate {
+	qu.mutation.ResetQuestionTimeLimitSec()
+	qu.mutation.SetQuestionTimeLimitSec(i)
+	return qu
+}
+
+// SetNillableQuestionTimeLimitSec sets the "question_time_limit_sec" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableQuestionTimeLimitSec(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetQuestionTimeLimitSec(*i)
+	}
+	return qu
+}
+
+// AddQuestionTimeLimitSec adds i to the "question_time_limit_sec" field.
+func (qu *QuizUpdate) AddQuestionTimeLimitSec(i int) *QuizUpdate {
+	qu.mutation.AddQuestionTimeLimitSec(i)
+	return qu
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (qu *QuizUpdate) SetTimeLimitSec(i int) *QuizUpdate {
+	qu.mutation.ResetTimeLimitSec()
+	qu.mutation.SetTimeLimitSec(i)
+	return qu
+}
+
+// SetNillableTimeLimitSec sets the "time_limit_sec" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableTimeLimitSec(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetTimeLimitSec(*i)
+	}
+	return qu
+}
+
+// AddTimeLimitSec adds i to the "time_limit_sec" field.
+func (qu *QuizUpdate) AddTimeLimitSec(i int) *QuizUpdate {
+	qu.mutation.AddTimeLimitSec(i)
+	return qu
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (qu *QuizUpdate) SetExpiresAt(t time.Time) *QuizUpdate {
+	qu.mutation.SetExpiresAt(t)
+	return qu
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableExpiresAt(t *time.Time) *QuizUpdate {
+	if t != nil {
+		qu.SetExpiresAt(*t)
+	}
+	return qu
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (qu *QuizUpdate) ClearExpiresAt() *QuizUpdate {
+	qu.mutation.ClearExpiresAt()
+	return qu
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (qu *QuizUpdate) SetFinishStatus(s string) *QuizUpdate {
+	qu.mutation.SetFinishStatus(s)
+	return qu
+}
+
+// SetNillableFinishStatus sets the "finish_status" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableFinishStatus(s *string) *QuizUpdate {
+	if s != nil {
+		qu.SetFinishStatus(*s)
+	}
+	return qu
+}
+
+// ClearFinishStatus clears the value of the "finish_status" field.
+func (qu *QuizUpdate) ClearFinishStatus() *QuizUpdate {
+	qu.mutation.ClearFinishStatus()
+	return qu
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (qu *QuizUpdate) SetFinishedAt(t time.Time) *QuizUpdate {
+	qu.mutation.SetFinishedAt(t)
+	return qu
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableFinishedAt(t *time.Time) *QuizUpdate {
+	if t != nil {
+		qu.SetFinishedAt(*t)
+	}
+	return qu
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (qu *QuizUpdate) ClearFinishedAt() *QuizUpdate {
+	qu.mutation.ClearFinishedAt()
+	return qu
+}
+
+// SetDeckID sets the "deck_id" field.
+func (qu *QuizUpdate) SetDeckID(i int) *QuizUpdate {
+	qu.mutation.SetDeckID(i)
+	return qu
+}
+
+// SetNillableDeckID sets the "deck_id" field if the given value is not nil.
+func (qu *QuizUpdate) SetNillableDeckID(i *int) *QuizUpdate {
+	if i != nil {
+		qu.SetDeckID(*i)
+	}
+	return qu
+}
+
+// ClearDeckID clears the value of the "deck_id" field.
+func (qu *QuizUpdate) ClearDeckID() *QuizUpdate {
+	qu.mutation.ClearDeckID()
+	return qu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (qu *QuizUpdate) SetCreatedAt(t time.Time) *QuizUpdate {
 	qu.mutation.SetCreatedAt(t)
@@ -320,6 +499,11 @@ func (qu *QuizUpdate) AddQuizQuestions(q ...*QuizQuestion) *QuizUpdate {
 	return qu.AddQuizQuestionIDs(ids...)
 }
 
+// SetDeck sets the "deck" edge to the Deck entity.
+func (qu *QuizUpdate) SetDeck(d *Deck) *QuizUpdate {
+	return qu.SetDeckID(d.ID)
+}
+
 // Mutation returns the QuizMutation object of the builder.
 func (qu *QuizUpdate) Mutation() *QuizMutation {
 	return qu.mutation
@@ -352,6 +536,12 @@ func (qu *QuizUpdate) RemoveQuizQuestions(q ...*QuizQuestion) *QuizUpdate {
 	return qu.RemoveQuizQuestionIDs(ids...)
 }
 
+// ClearDeck clears the "deck" edge to the Deck entity.
+func (qu *QuizUpdate) ClearDeck() *QuizUpdate {
+	qu.mutation.ClearDeck()
+	return qu
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (qu *QuizUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, qu.sqlSave, qu.mutation, qu.hooks)
@@ -396,6 +586,41 @@ func (qu *QuizUpdate) check() error {
 			return &ValidationError{Name: "is_special_characters", err: fmt.Errorf(`ent: validator failed for field "Quiz.is_special_characters": %w`, err)}
 		}
 	}
+	if v, ok := qu.mutation.Mode(); ok {
+		if err := quiz.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Quiz.mode": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.Direction(); ok {
+		if err := quiz.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "Quiz.direction": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.QuestionType(); ok {
+		if err := quiz.QuestionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "question_type", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_type": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.Difficulty(); ok {
+		if err := quiz.DifficultyValidator(v); err != nil {
+			return &ValidationError{Name: "difficulty", err: fmt.Errorf(`ent: validator failed for field "Quiz.difficulty": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.QuestionTimeLimitSec(); ok {
+		if err := quiz.QuestionTimeLimitSecValidator(v); err != nil {
+			return &ValidationError{Name: "question_time_limit_sec", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_time_limit_sec": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.TimeLimitSec(); ok {
+		if err := quiz.TimeLimitSecValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_sec", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_sec": %w`, err)}
+		}
+	}
+	if v, ok := qu.mutation.FinishStatus(); ok {
+		if err := quiz.FinishStatusValidator(v); err != nil {
+			return &ValidationError{Name: "finish_status", err: fmt.Errorf(`ent: validator failed for field "Quiz.finish_status": %w`, err)}
+		}
+	}
 	if qu.mutation.UserCleared() && len(qu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Quiz.user"`)
 	}
@@ -490,6 +715,48 @@ func (qu *QuizUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, quiz.FieldChoicesPosIds, value)
 		})
 	}
+	if value, ok := qu.mutation.Mode(); ok {
+		_spec.SetField(quiz.FieldMode, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Direction(); ok {
+		_spec.SetField(quiz.FieldDirection, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.QuestionType(); ok {
+		_spec.SetField(quiz.FieldQuestionType, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.Difficulty(); ok {
+		_spec.SetField(quiz.FieldDifficulty, field.TypeString, value)
+	}
+	if value, ok := qu.mutation.QuestionTimeLimitSec(); ok {
+		_spec.SetField(quiz.FieldQuestionTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedQuestionTimeLimitSec(); ok {
+		_spec.AddField(quiz.FieldQuestionTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.TimeLimitSec(); ok {
+		_spec.SetField(quiz.FieldTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.AddedTimeLimitSec(); ok {
+		_spec.AddField(quiz.FieldTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := qu.mutation.ExpiresAt(); ok {
+		_spec.SetField(quiz.FieldExpiresAt, field.TypeTime, value)
+	}
+	if qu.mutation.ExpiresAtCleared() {
+		_spec.ClearField(quiz.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := qu.mutation.FinishStatus(); ok {
+		_spec.SetField(quiz.FieldFinishStatus, field.TypeString, value)
+	}
+	if qu.mutation.FinishStatusCleared() {
+		_spec.ClearField(quiz.FieldFinishStatus, field.TypeString)
+	}
+	if value, ok := qu.mutation.FinishedAt(); ok {
+		_spec.SetField(quiz.FieldFinishedAt, field.TypeTime, value)
+	}
+	if qu.mutation.FinishedAtCleared() {
+		_spec.ClearField(quiz.FieldFinishedAt, field.TypeTime)
+	}
 	if value, ok := qu.mutation.CreatedAt(); ok {
 		_spec.SetField(quiz.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -573,6 +840,35 @@ func (qu *QuizUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if qu.mutation.DeckCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.DeckTable,
+			Columns: []string{quiz.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := qu.mutation.DeckIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.DeckTable,
+			Columns: []string{quiz.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(qu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, qu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -829,6 +1125,184 @@ func (quo *QuizUpdateOne) AppendChoicesPosIds(i []int) *QuizUpdateOne {
 	return quo
 }
 
+// SetMode sets the "mode" field.
+func (quo *QuizUpdateOne) SetMode(s string) *QuizUpdateOne {
+	quo.mutation.SetMode(s)
+	return quo
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableMode(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetMode(*s)
+	}
+	return quo
+}
+
+// SetDirection sets the "direction" field.
+func (quo *QuizUpdateOne) SetDirection(s string) *QuizUpdateOne {
+	quo.mutation.SetDirection(s)
+	return quo
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableDirection(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetDirection(*s)
+	}
+	return quo
+}
+
+// SetQuestionType sets the "question_type" field.
+func (quo *QuizUpdateOne) SetQuestionType(s string) *QuizUpdateOne {
+	quo.mutation.SetQuestionType(s)
+	return quo
+}
+
+// SetNillableQuestionType sets the "question_type" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableQuestionType(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetQuestionType(*s)
+	}
+	return quo
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (quo *QuizUpdateOne) SetDifficulty(s string) *QuizUpdateOne {
+	quo.mutation.SetDifficulty(s)
+	return quo
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableDifficulty(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetDifficulty(*s)
+	}
+	return quo
+}
+
+// SetQuestionTimeLimitSec sets the "question_time_limit_sec" field.
+func (quo *QuizUpdateOne) SetQuestionTimeLimitSec(i int) *QuizUpdateOne {
+	quo.mutation.ResetQuestionTimeLimitSec()
+	quo.mutation.SetQuestionTimeLimitSec(i)
+	return quo
+}
+
+// SetNillableQuestionTimeLimitSec sets the "question_time_limit_sec" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableQuestionTimeLimitSec(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetQuestionTimeLimitSec(*i)
+	}
+	return quo
+}
+
+// AddQuestionTimeLimitSec adds i to the "question_time_limit_sec" field.
+func (quo *QuizUpdateOne) AddQuestionTimeLimitSec(i int) *QuizUpdateOne {
+	quo.mutation.AddQuestionTimeLimitSec(i)
+	return quo
+}
+
+// SetTimeLimitSec sets the "time_limit_sec" field.
+func (quo *QuizUpdateOne) SetTimeLimitSec(i int) *QuizUpdateOne {
+	quo.mutation.ResetTimeLimitSec()
+	quo.mutation.SetTimeLimitSec(i)
+	return quo
+}
+
+// SetNillableTimeLimitSec sets the "time_limit_sec" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableTimeLimitSec(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetTimeLimitSec(*i)
+	}
+	return quo
+}
+
+// AddTimeLimitSec adds i to the "time_limit_sec" field.
+func (quo *QuizUpdateOne) AddTimeLimitSec(i int) *QuizUpdateOne {
+	quo.mutation.AddTimeLimitSec(i)
+	return quo
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (quo *QuizUpdateOne) SetExpiresAt(t time.Time) *QuizUpdateOne {
+	quo.mutation.SetExpiresAt(t)
+	return quo
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableExpiresAt(t *time.Time) *QuizUpdateOne {
+	if t != nil {
+		quo.SetExpiresAt(*t)
+	}
+	return quo
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (quo *QuizUpdateOne) ClearExpiresAt() *QuizUpdateOne {
+	quo.mutation.ClearExpiresAt()
+	return quo
+}
+
+// SetFinishStatus sets the "finish_status" field.
+func (quo *QuizUpdateOne) SetFinishStatus(s string) *QuizUpdateOne {
+	quo.mutation.SetFinishStatus(s)
+	return quo
+}
+
+// SetNillableFinishStatus sets the "finish_status" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableFinishStatus(s *string) *QuizUpdateOne {
+	if s != nil {
+		quo.SetFinishStatus(*s)
+	}
+	return quo
+}
+
+// ClearFinishStatus clears the value of the "finish_status" field.
+func (quo *QuizUpdateOne) ClearFinishStatus() *QuizUpdateOne {
+	quo.mutation.ClearFinishStatus()
+	return quo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (quo *QuizUpdateOne) SetFinishedAt(t time.Time) *QuizUpdateOne {
+	quo.mutation.SetFinishedAt(t)
+	return quo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableFinishedAt(t *time.Time) *QuizUpdateOne {
+	if t != nil {
+		quo.SetFinishedAt(*t)
+	}
+	return quo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (quo *QuizUpdateOne) ClearFinishedAt() *QuizUpdateOne {
+	quo.mutation.ClearFinishedAt()
+	return quo
+}
+
+// SetDeckID sets the "deck_id" field.
+func (quo *QuizUpdateOne) SetDeckID(i int) *QuizUpdateOne {
+	quo.mutation.SetDeckID(i)
+	return quo
+}
+
+// SetNillableDeckID sets the "deck_id" field if the given value is not nil.
+func (quo *QuizUpdateOne) SetNillableDeckID(i *int) *QuizUpdateOne {
+	if i != nil {
+		quo.SetDeckID(*i)
+	}
+	return quo
+}
+
+// ClearDeckID clears the value of the "deck_id" field.
+func (quo *QuizUpdateOne) ClearDeckID() *QuizUpdateOne {
+	quo.mutation.ClearDeckID()
+	return quo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (quo *QuizUpdateOne) SetCreatedAt(t time.Time) *QuizUpdateOne {
 	quo.mutation.SetCreatedAt(t)
@@ -883,6 +1357,11 @@ func (quo *QuizUpdateOne) AddQuizQuestions(q ...*QuizQuestion) *QuizUpdateOne {
 	return quo.AddQuizQuestionIDs(ids...)
 }
 
+// SetDeck sets the "deck" edge to the Deck entity.
+func (quo *QuizUpdateOne) SetDeck(d *Deck) *QuizUpdateOne {
+	return quo.SetDeckID(d.ID)
+}
+
 // Mutation returns the QuizMutation object of the builder.
 func (quo *QuizUpdateOne) Mutation() *QuizMutation {
 	return quo.mutation
@@ -915,6 +1394,12 @@ func (quo *QuizUpdateOne) RemoveQuizQuestions(q ...*QuizQuestion) *QuizUpdateOne
 	return quo.RemoveQuizQuestionIDs(ids...)
 }
 
+// ClearDeck clears the "deck" edge to the Deck entity.
+func (quo *QuizUpdateOne) ClearDeck() *QuizUpdateOne {
+	quo.mutation.ClearDeck()
+	return quo
+}
+
 // Where appends a list predicates to the QuizUpdate builder.
 func (quo *QuizUpdateOne) Where(ps ...predicate.Quiz) *QuizUpdateOne {
 	quo.mutation.Where(ps...)
@@ -972,6 +1457,41 @@ func (quo *QuizUpdateOne) check() error {
 			return &ValidationError{Name: "is_special_characters", err: fmt.Errorf(`ent: validator failed for field "Quiz.is_special_characters": %w`, err)}
 		}
 	}
+	if v, ok := quo.mutation.Mode(); ok {
+		if err := quiz.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Quiz.mode": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.Direction(); ok {
+		if err := quiz.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "Quiz.direction": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.QuestionType(); ok {
+		if err := quiz.QuestionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "question_type", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_type": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.Difficulty(); ok {
+		if err := quiz.DifficultyValidator(v); err != nil {
+			return &ValidationError{Name: "difficulty", err: fmt.Errorf(`ent: validator failed for field "Quiz.difficulty": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.QuestionTimeLimitSec(); ok {
+		if err := quiz.QuestionTimeLimitSecValidator(v); err != nil {
+			return &ValidationError{Name: "question_time_limit_sec", err: fmt.Errorf(`ent: validator failed for field "Quiz.question_time_limit_sec": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.TimeLimitSec(); ok {
+		if err := quiz.TimeLimitSecValidator(v); err != nil {
+			return &ValidationError{Name: "time_limit_sec", err: fmt.Errorf(`ent: validator failed for field "Quiz.time_limit_sec": %w`, err)}
+		}
+	}
+	if v, ok := quo.mutation.FinishStatus(); ok {
+		if err := quiz.FinishStatusValidator(v); err != nil {
+			return &ValidationError{Name: "finish_status", err: fmt.Errorf(`ent: validator failed for field "Quiz.finish_status": %w`, err)}
+		}
+	}
 	if quo.mutation.UserCleared() && len(quo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Quiz.user"`)
 	}
@@ -1083,6 +1603,48 @@ func (quo *QuizUpdateOne) sqlSave(ctx context.Context) (_node *Quiz, err error)
 			sqljson.Append(u, quiz.FieldChoicesPosIds, value)
 		})
 	}
+	if value, ok := quo.mutation.Mode(); ok {
+		_spec.SetField(quiz.FieldMode, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Direction(); ok {
+		_spec.SetField(quiz.FieldDirection, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.QuestionType(); ok {
+		_spec.SetField(quiz.FieldQuestionType, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.Difficulty(); ok {
+		_spec.SetField(quiz.FieldDifficulty, field.TypeString, value)
+	}
+	if value, ok := quo.mutation.QuestionTimeLimitSec(); ok {
+		_spec.SetField(quiz.FieldQuestionTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedQuestionTimeLimitSec(); ok {
+		_spec.AddField(quiz.FieldQuestionTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.TimeLimitSec(); ok {
+		_spec.SetField(quiz.FieldTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.AddedTimeLimitSec(); ok {
+		_spec.AddField(quiz.FieldTimeLimitSec, field.TypeInt, value)
+	}
+	if value, ok := quo.mutation.ExpiresAt(); ok {
+		_spec.SetField(quiz.FieldExpiresAt, field.TypeTime, value)
+	}
+	if quo.mutation.ExpiresAtCleared() {
+		_spec.ClearField(quiz.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := quo.mutation.FinishStatus(); ok {
+		_spec.SetField(quiz.FieldFinishStatus, field.TypeString, value)
+	}
+	if quo.mutation.FinishStatusCleared() {
+		_spec.ClearField(quiz.FieldFinishStatus, field.TypeString)
+	}
+	if value, ok := quo.mutation.FinishedAt(); ok {
+		_spec.SetField(quiz.FieldFinishedAt, field.TypeTime, value)
+	}
+	if quo.mutation.FinishedAtCleared() {
+		_spec.ClearField(quiz.FieldFinishedAt, field.TypeTime)
+	}
 	if value, ok := quo.mutation.CreatedAt(); ok {
 		_spec.SetField(quiz.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -1166,6 +1728,35 @@ func (quo *QuizUpdateOne) sqlSave(ctx context.Context) (_node *Quiz, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if quo.mutation.DeckCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.DeckTable,
+			Columns: []string{quiz.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := quo.mutation.DeckIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   quiz.DeckTable,
+			Columns: []string{quiz.DeckColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deck.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(quo.modifiers...)
 	_node = &Quiz{config: quo.config}
 	_spec.Assign = _node.assignValues
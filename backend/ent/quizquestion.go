@@ -34,14 +34,34 @@ type QuizQuestion struct {
 	PosID int `json:"pos_id,omitempty"`
 	// correct japanese mean id
 	CorrectJpmID int `json:"correct_jpm_id,omitempty"`
+	// en_to_ja: 英単語→意味, ja_to_en: 意味→英単語
+	Direction string `json:"direction,omitempty"`
+	// choice: 選択式, typing: 入力式
+	QuestionType string `json:"question_type,omitempty"`
+	// ja_to_en で提示する意味
+	MeaningName string `json:"meaning_name,omitempty"`
 	// 4 つの選択肢 (正解 + 誤答)
 	ChoicesJpms []models.ChoiceJpm `json:"choices_jpms,omitempty"`
+	// ja_to_en の選択肢 (正解 + 誤答)
+	ChoicesWords []models.ChoiceWord `json:"choices_words,omitempty"`
 	// answered japanese mean ids
 	AnswerJpmID *int `json:"answer_jpm_id,omitempty"`
+	// ja_to_en で回答した word id
+	AnswerWordID *int `json:"answer_word_id,omitempty"`
+	// typing で入力された文字列（正規化前）
+	TypedAnswer *string `json:"typed_answer,omitempty"`
+	// correct / near_miss / wrong / timeout
+	Grade *string `json:"grade,omitempty"`
+	// 得点 (正解 1, 惜しい 0.5, 不正解 0)
+	Credit float64 `json:"credit,omitempty"`
 	// IsCorrect holds the value of the "is_correct" field.
 	IsCorrect *bool `json:"is_correct,omitempty"`
+	// 実際に出題した時刻（time_ms と制限時間の起点）
+	ServedAt *time.Time `json:"served_at,omitempty"`
 	// AnsweredAt holds the value of the "answered_at" field.
 	AnsweredAt *time.Time `json:"answered_at,omitempty"`
+	// 回答時の Idempotency-Key（再送時に同じ結果を返すため）
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 	// TimeMs holds the value of the "time_ms" field.
 	TimeMs *int `json:"time_ms,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -106,15 +126,17 @@ func (*QuizQuestion) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case quizquestion.FieldChoicesJpms:
+		case quizquestion.FieldChoicesJpms, quizquestion.FieldChoicesWords:
 			values[i] = new([]byte)
 		case quizquestion.FieldIsCorrect:
 			values[i] = new(sql.NullBool)
-		case quizquestion.FieldID, quizquestion.FieldQuizID, quizquestion.FieldQuestionNumber, quizquestion.FieldWordID, quizquestion.FieldPosID, quizquestion.FieldCorrectJpmID, quizquestion.FieldAnswerJpmID, quizquestion.FieldTimeMs:
+		case quizquestion.FieldCredit:
+			values[i] = new(sql.NullFloat64)
+		case quizquestion.FieldID, quizquestion.FieldQuizID, quizquestion.FieldQuestionNumber, quizquestion.FieldWordID, quizquestion.FieldPosID, quizquestion.FieldCorrectJpmID, quizquestion.FieldAnswerJpmID, quizquestion.FieldAnswerWordID, quizquestion.FieldTimeMs:
 			values[i] = new(sql.NullInt64)
-		case quizquestion.FieldWordName:
+		case quizquestion.FieldWordName, quizquestion.FieldDirection, quizquestion.FieldQuestionType, quizquestion.FieldMeaningName, quizquestion.FieldTypedAnswer, quizquestion.FieldGrade, quizquestion.FieldIdempotencyKey:
 			values[i] = new(sql.NullString)
-		case quizquestion.FieldAnsweredAt, quizquestion.FieldCreatedAt, quizquestion.FieldDeletedAt:
+		case quizquestion.FieldServedAt, quizquestion.FieldAnsweredAt, quizquestion.FieldCreatedAt, quizquestion.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		case quizquestion.ForeignKeys[0]: // registered_word_quiz_questions
 			values[i] = new(sql.NullInt64)
@@ -175,6 +197,24 @@ func (qq *QuizQuestion) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				qq.CorrectJpmID = int(value.Int64)
 			}
+		case quizquestion.FieldDirection:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field direction", values[i])
+			} else if value.Valid {
+				qq.Direction = value.String
+			}
+		case quizquestion.FieldQuestionType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_type", values[i])
+			} else if value.Valid {
+				qq.QuestionType = value.String
+			}
+		case quizquestion.FieldMeaningName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field meaning_name", values[i])
+			} else if value.Valid {
+				qq.MeaningName = value.String
+			}
 		case quizquestion.FieldChoicesJpms:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field choices_jpms", values[i])
@@ -183,6 +223,14 @@ func (qq *QuizQuestion) assignValues(columns []string, values []any) error {
 					return fmt.Errorf("unmarshal field choices_jpms: %w", err)
 				}
 			}
+		case quizquestion.FieldChoicesWords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field choices_words", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &qq.ChoicesWords); err != nil {
+					return fmt.Errorf("unmarshal field choices_words: %w", err)
+				}
+			}
 		case quizquestion.FieldAnswerJpmID:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field answer_jpm_id", values[i])
@@ -190,6 +238,33 @@ func (qq *QuizQuestion) assignValues(columns []string, values []any) error {
 				qq.AnswerJpmID = new(int)
 				*qq.AnswerJpmID = int(value.Int64)
 			}
+		case quizquestion.FieldAnswerWordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field answer_word_id", values[i])
+			} else if value.Valid {
+				qq.AnswerWordID = new(int)
+				*qq.AnswerWordID = int(value.Int64)
+			}
+		case quizquestion.FieldTypedAnswer:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field typed_answer", values[i])
+			} else if value.Valid {
+				qq.TypedAnswer = new(string)
+				*qq.TypedAnswer = value.String
+			}
+		case quizquestion.FieldGrade:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field grade", values[i])
+			} else if value.Valid {
+				qq.Grade = new(string)
+				*qq.Grade = value.String
+			}
+		case quizquestion.FieldCredit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field credit", values[i])
+			} else if value.Valid {
+				qq.Credit = value.Float64
+			}
 		case quizquestion.FieldIsCorrect:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_correct", values[i])
@@ -197,6 +272,13 @@ func (qq *QuizQuestion) assignValues(columns []string, values []any) error {
 				qq.IsCorrect = new(bool)
 				*qq.IsCorrect = value.Bool
 			}
+		case quizquestion.FieldServedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field served_at", values[i])
+			} else if value.Valid {
+				qq.ServedAt = new(time.Time)
+				*qq.ServedAt = value.Time
+			}
 		case quizquestion.FieldAnsweredAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field answered_at", values[i])
@@ -204,6 +286,13 @@ func (qq *QuizQuestion) assignValues(columns []string, values []any) error {
 				qq.AnsweredAt = new(time.Time)
 				*qq.AnsweredAt = value.Time
 			}
+		case quizquestion.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				qq.IdempotencyKey = new(string)
+				*qq.IdempotencyKey = value.String
+			}
 		case quizquestion.FieldTimeMs:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for field time_ms", values[i])
@@ -300,24 +389,64 @@ func (qq *QuizQuestion) String() string {
 	builder.WriteString("correct_jpm_id=")
 	builder.WriteString(fmt.Sprintf("%v", qq.CorrectJpmID))
 	builder.WriteString(", ")
+	builder.WriteString("direction=")
+	builder.WriteString(qq.Direction)
+	builder.WriteString(", ")
+	builder.WriteString("question_type=")
+	builder.WriteString(qq.QuestionType)
+	builder.WriteString(", ")
+	builder.WriteString("meaning_name=")
+	builder.WriteString(qq.MeaningName)
+	builder.WriteString(", ")
 	builder.WriteString("choices_jpms=")
 	builder.WriteString(fmt.Sprintf("%v", qq.ChoicesJpms))
 	builder.WriteString(", ")
+	builder.WriteString("choices_words=")
+	builder.WriteString(fmt.Sprintf("%v", qq.ChoicesWords))
+	builder.WriteString(", ")
 	if v := qq.AnswerJpmID; v != nil {
 		builder.WriteString("answer_jpm_id=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := qq.AnswerWordID; v != nil {
+		builder.WriteString("answer_word_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := qq.TypedAnswer; v != nil {
+		builder.WriteString("typed_answer=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := qq.Grade; v != nil {
+		builder.WriteString("grade=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("credit=")
+	builder.WriteString(fmt.Sprintf("%v", qq.Credit))
+	builder.WriteString(", ")
 	if v := qq.IsCorrect; v != nil {
 		builder.WriteString("is_correct=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := qq.ServedAt; v != nil {
+		builder.WriteString("served_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := qq.AnsweredAt; v != nil {
 		builder.WriteString("answered_at=")
 		builder.WriteString(v.Format(time.ANSIC))
 	}
 	builder.WriteString(", ")
+	if v := qq.IdempotencyKey; v != nil {
+		builder.WriteString("idempotency_key=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
 	if v := qq.TimeMs; v != nil {
 		builder.WriteString("time_ms=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
@@ -26,14 +26,34 @@ const (
 	FieldPosID = "pos_id"
 	// FieldCorrectJpmID holds the string denoting the correct_jpm_id field in the database.
 	FieldCorrectJpmID = "correct_jpm_id"
+	// FieldDirection holds the string denoting the direction field in the database.
+	FieldDirection = "direction"
+	// FieldQuestionType holds the string denoting the question_type field in the database.
+	FieldQuestionType = "question_type"
+	// FieldMeaningName holds the string denoting the meaning_name field in the database.
+	FieldMeaningName = "meaning_name"
 	// FieldChoicesJpms holds the string denoting the choices_jpms field in the database.
 	FieldChoicesJpms = "choices_jpms"
+	// FieldChoicesWords holds the string denoting the choices_words field in the database.
+	FieldChoicesWords = "choices_words"
 	// FieldAnswerJpmID holds the string denoting the answer_jpm_id field in the database.
 	FieldAnswerJpmID = "answer_jpm_id"
+	// FieldAnswerWordID holds the string denoting the answer_word_id field in the database.
+	FieldAnswerWordID = "answer_word_id"
+	// FieldTypedAnswer holds the string denoting the typed_answer field in the database.
+	FieldTypedAnswer = "typed_answer"
+	// FieldGrade holds the string denoting the grade field in the database.
+	FieldGrade = "grade"
+	// FieldCredit holds the string denoting the credit field in the database.
+	FieldCredit = "credit"
 	// FieldIsCorrect holds the string denoting the is_correct field in the database.
 	FieldIsCorrect = "is_correct"
+	// FieldServedAt holds the string denoting the served_at field in the database.
+	FieldServedAt = "served_at"
 	// FieldAnsweredAt holds the string denoting the answered_at field in the database.
 	FieldAnsweredAt = "answered_at"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
 	// FieldTimeMs holds the string denoting the time_ms field in the database.
 	FieldTimeMs = "time_ms"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -80,10 +100,20 @@ var Columns = []string{
 	FieldWordName,
 	FieldPosID,
 	FieldCorrectJpmID,
+	FieldDirection,
+	FieldQuestionType,
+	FieldMeaningName,
 	FieldChoicesJpms,
+	FieldChoicesWords,
 	FieldAnswerJpmID,
+	FieldAnswerWordID,
+	FieldTypedAnswer,
+	FieldGrade,
+	FieldCredit,
 	FieldIsCorrect,
+	FieldServedAt,
 	FieldAnsweredAt,
+	FieldIdempotencyKey,
 	FieldTimeMs,
 	FieldCreatedAt,
 	FieldDeletedAt,
@@ -117,6 +147,18 @@ var (
 	WordNameValidator func(string) error
 	// PosIDValidator is a validator for the "pos_id" field. It is called by the builders before save.
 	PosIDValidator func(int) error
+	// DefaultDirection holds the default value on creation for the "direction" field.
+	DefaultDirection string
+	// DirectionValidator is a validator for the "direction" field. It is called by the builders before save.
+	DirectionValidator func(string) error
+	// DefaultQuestionType holds the default value on creation for the "question_type" field.
+	DefaultQuestionType string
+	// QuestionTypeValidator is a validator for the "question_type" field. It is called by the builders before save.
+	QuestionTypeValidator func(string) error
+	// DefaultCredit holds the default value on creation for the "credit" field.
+	DefaultCredit float64
+	// IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	IdempotencyKeyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -159,21 +201,66 @@ func ByCorrectJpmID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCorrectJpmID, opts...).ToFunc()
 }
 
+// ByDirection orders the results by the direction field.
+func ByDirection(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirection, opts...).ToFunc()
+}
+
+// ByQuestionType orders the results by the question_type field.
+func ByQuestionType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionType, opts...).ToFunc()
+}
+
+// ByMeaningName orders the results by the meaning_name field.
+func ByMeaningName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMeaningName, opts...).ToFunc()
+}
+
 // ByAnswerJpmID orders the results by the answer_jpm_id field.
 func ByAnswerJpmID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnswerJpmID, opts...).ToFunc()
 }
 
+// ByAnswerWordID orders the results by the answer_word_id field.
+func ByAnswerWordID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAnswerWordID, opts...).ToFunc()
+}
+
+// ByTypedAnswer orders the results by the typed_answer field.
+func ByTypedAnswer(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTypedAnswer, opts...).ToFunc()
+}
+
+// ByGrade orders the results by the grade field.
+func ByGrade(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGrade, opts...).ToFunc()
+}
+
+// ByCredit orders the results by the credit field.
+func ByCredit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCredit, opts...).ToFunc()
+}
+
 // ByIsCorrect orders the results by the is_correct field.
 func ByIsCorrect(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsCorrect, opts...).ToFunc()
 }
 
+// ByServedAt orders the results by the served_at field.
+func ByServedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldServedAt, opts...).ToFunc()
+}
+
 // ByAnsweredAt orders the results by the answered_at field.
 func ByAnsweredAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAnsweredAt, opts...).ToFunc()
 }
 
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
 // ByTimeMs orders the results by the time_ms field.
 func ByTimeMs(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTimeMs, opts...).ToFunc()
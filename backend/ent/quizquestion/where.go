@@ -85,21 +85,66 @@ func CorrectJpmID(v int) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldCorrectJpmID, v))
 }
 
+// Direction applies equality check predicate on the "direction" field. It's identical to DirectionEQ.
+func Direction(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldDirection, v))
+}
+
+// QuestionType applies equality check predicate on the "question_type" field. It's identical to QuestionTypeEQ.
+func QuestionType(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldQuestionType, v))
+}
+
+// MeaningName applies equality check predicate on the "meaning_name" field. It's identical to MeaningNameEQ.
+func MeaningName(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldMeaningName, v))
+}
+
 // AnswerJpmID applies equality check predicate on the "answer_jpm_id" field. It's identical to AnswerJpmIDEQ.
 func AnswerJpmID(v int) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldAnswerJpmID, v))
 }
 
+// AnswerWordID applies equality check predicate on the "answer_word_id" field. It's identical to AnswerWordIDEQ.
+func AnswerWordID(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldAnswerWordID, v))
+}
+
+// TypedAnswer applies equality check predicate on the "typed_answer" field. It's identical to TypedAnswerEQ.
+func TypedAnswer(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldTypedAnswer, v))
+}
+
+// Grade applies equality check predicate on the "grade" field. It's identical to GradeEQ.
+func Grade(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldGrade, v))
+}
+
+// Credit applies equality check predicate on the "credit" field. It's identical to CreditEQ.
+func Credit(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldCredit, v))
+}
+
 // IsCorrect applies equality check predicate on the "is_correct" field. It's identical to IsCorrectEQ.
 func IsCorrect(v bool) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldIsCorrect, v))
 }
 
+// ServedAt applies equality check predicate on the "served_at" field. It's identical to ServedAtEQ.
+func ServedAt(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldServedAt, v))
+}
+
 // AnsweredAt applies equality check predicate on the "answered_at" field. It's identical to AnsweredAtEQ.
 func AnsweredAt(v time.Time) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldAnsweredAt, v))
 }
 
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
 // TimeMs applies equality check predicate on the "time_ms" field. It's identical to TimeMsEQ.
 func TimeMs(v int) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldTimeMs, v))
@@ -320,6 +365,221 @@ func CorrectJpmIDNotIn(vs ...int) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldNotIn(FieldCorrectJpmID, vs...))
 }
 
+// DirectionEQ applies the EQ predicate on the "direction" field.
+func DirectionEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldDirection, v))
+}
+
+// DirectionNEQ applies the NEQ predicate on the "direction" field.
+func DirectionNEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldDirection, v))
+}
+
+// DirectionIn applies the In predicate on the "direction" field.
+func DirectionIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldDirection, vs...))
+}
+
+// DirectionNotIn applies the NotIn predicate on the "direction" field.
+func DirectionNotIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldDirection, vs...))
+}
+
+// DirectionGT applies the GT predicate on the "direction" field.
+func DirectionGT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldDirection, v))
+}
+
+// DirectionGTE applies the GTE predicate on the "direction" field.
+func DirectionGTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldDirection, v))
+}
+
+// DirectionLT applies the LT predicate on the "direction" field.
+func DirectionLT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldDirection, v))
+}
+
+// DirectionLTE applies the LTE predicate on the "direction" field.
+func DirectionLTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldDirection, v))
+}
+
+// DirectionContains applies the Contains predicate on the "direction" field.
+func DirectionContains(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContains(FieldDirection, v))
+}
+
+// DirectionHasPrefix applies the HasPrefix predicate on the "direction" field.
+func DirectionHasPrefix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasPrefix(FieldDirection, v))
+}
+
+// DirectionHasSuffix applies the HasSuffix predicate on the "direction" field.
+func DirectionHasSuffix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasSuffix(FieldDirection, v))
+}
+
+// DirectionEqualFold applies the EqualFold predicate on the "direction" field.
+func DirectionEqualFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEqualFold(FieldDirection, v))
+}
+
+// DirectionContainsFold applies the ContainsFold predicate on the "direction" field.
+func DirectionContainsFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContainsFold(FieldDirection, v))
+}
+
+// QuestionTypeEQ applies the EQ predicate on the "question_type" field.
+func QuestionTypeEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldQuestionType, v))
+}
+
+// QuestionTypeNEQ applies the NEQ predicate on the "question_type" field.
+func QuestionTypeNEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldQuestionType, v))
+}
+
+// QuestionTypeIn applies the In predicate on the "question_type" field.
+func QuestionTypeIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldQuestionType, vs...))
+}
+
+// QuestionTypeNotIn applies the NotIn predicate on the "question_type" field.
+func QuestionTypeNotIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldQuestionType, vs...))
+}
+
+// QuestionTypeGT applies the GT predicate on the "question_type" field.
+func QuestionTypeGT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldQuestionType, v))
+}
+
+// QuestionTypeGTE applies the GTE predicate on the "question_type" field.
+func QuestionTypeGTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldQuestionType, v))
+}
+
+// QuestionTypeLT applies the LT predicate on the "question_type" field.
+func QuestionTypeLT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldQuestionType, v))
+}
+
+// QuestionTypeLTE applies the LTE predicate on the "question_type" field.
+func QuestionTypeLTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldQuestionType, v))
+}
+
+// QuestionTypeContains applies the Contains predicate on the "question_type" field.
+func QuestionTypeContains(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContains(FieldQuestionType, v))
+}
+
+// QuestionTypeHasPrefix applies the HasPrefix predicate on the "question_type" field.
+func QuestionTypeHasPrefix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasPrefix(FieldQuestionType, v))
+}
+
+// QuestionTypeHasSuffix applies the HasSuffix predicate on the "question_type" field.
+func QuestionTypeHasSuffix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasSuffix(FieldQuestionType, v))
+}
+
+// QuestionTypeEqualFold applies the EqualFold predicate on the "question_type" field.
+func QuestionTypeEqualFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEqualFold(FieldQuestionType, v))
+}
+
+// QuestionTypeContainsFold applies the ContainsFold predicate on the "question_type" field.
+func QuestionTypeContainsFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContainsFold(FieldQuestionType, v))
+}
+
+// MeaningNameEQ applies the EQ predicate on the "meaning_name" field.
+func MeaningNameEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldMeaningName, v))
+}
+
+// MeaningNameNEQ applies the NEQ predicate on the "meaning_name" field.
+func MeaningNameNEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldMeaningName, v))
+}
+
+// MeaningNameIn applies the In predicate on the "meaning_name" field.
+func MeaningNameIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldMeaningName, vs...))
+}
+
+// MeaningNameNotIn applies the NotIn predicate on the "meaning_name" field.
+func MeaningNameNotIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldMeaningName, vs...))
+}
+
+// MeaningNameGT applies the GT predicate on the "meaning_name" field.
+func MeaningNameGT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldMeaningName, v))
+}
+
+// MeaningNameGTE applies the GTE predicate on the "meaning_name" field.
+func MeaningNameGTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldMeaningName, v))
+}
+
+// MeaningNameLT applies the LT predicate on the "meaning_name" field.
+func MeaningNameLT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldMeaningName, v))
+}
+
+// MeaningNameLTE applies the LTE predicate on the "meaning_name" field.
+func MeaningNameLTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldMeaningName, v))
+}
+
+// MeaningNameContains applies the Contains predicate on the "meaning_name" field.
+func MeaningNameContains(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContains(FieldMeaningName, v))
+}
+
+// MeaningNameHasPrefix applies the HasPrefix predicate on the "meaning_name" field.
+func MeaningNameHasPrefix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasPrefix(FieldMeaningName, v))
+}
+
+// MeaningNameHasSuffix applies the HasSuffix predicate on the "meaning_name" field.
+func MeaningNameHasSuffix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasSuffix(FieldMeaningName, v))
+}
+
+// MeaningNameIsNil applies the IsNil predicate on the "meaning_name" field.
+func MeaningNameIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldMeaningName))
+}
+
+// MeaningNameNotNil applies the NotNil predicate on the "meaning_name" field.
+func MeaningNameNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldMeaningName))
+}
+
+// MeaningNameEqualFold applies the EqualFold predicate on the "meaning_name" field.
+func MeaningNameEqualFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEqualFold(FieldMeaningName, v))
+}
+
+// MeaningNameContainsFold applies the ContainsFold predicate on the "meaning_name" field.
+func MeaningNameContainsFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContainsFold(FieldMeaningName, v))
+}
+
+// ChoicesWordsIsNil applies the IsNil predicate on the "choices_words" field.
+func ChoicesWordsIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldChoicesWords))
+}
+
+// ChoicesWordsNotNil applies the NotNil predicate on the "choices_words" field.
+func ChoicesWordsNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldChoicesWords))
+}
+
 // AnswerJpmIDEQ applies the EQ predicate on the "answer_jpm_id" field.
 func AnswerJpmIDEQ(v int) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldAnswerJpmID, v))
@@ -370,6 +630,246 @@ func AnswerJpmIDNotNil() predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldNotNull(FieldAnswerJpmID))
 }
 
+// AnswerWordIDEQ applies the EQ predicate on the "answer_word_id" field.
+func AnswerWordIDEQ(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldAnswerWordID, v))
+}
+
+// AnswerWordIDNEQ applies the NEQ predicate on the "answer_word_id" field.
+func AnswerWordIDNEQ(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldAnswerWordID, v))
+}
+
+// AnswerWordIDIn applies the In predicate on the "answer_word_id" field.
+func AnswerWordIDIn(vs ...int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldAnswerWordID, vs...))
+}
+
+// AnswerWordIDNotIn applies the NotIn predicate on the "answer_word_id" field.
+func AnswerWordIDNotIn(vs ...int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldAnswerWordID, vs...))
+}
+
+// AnswerWordIDGT applies the GT predicate on the "answer_word_id" field.
+func AnswerWordIDGT(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldAnswerWordID, v))
+}
+
+// AnswerWordIDGTE applies the GTE predicate on the "answer_word_id" field.
+func AnswerWordIDGTE(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldAnswerWordID, v))
+}
+
+// AnswerWordIDLT applies the LT predicate on the "answer_word_id" field.
+func AnswerWordIDLT(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldAnswerWordID, v))
+}
+
+// AnswerWordIDLTE applies the LTE predicate on the "answer_word_id" field.
+func AnswerWordIDLTE(v int) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldAnswerWordID, v))
+}
+
+// AnswerWordIDIsNil applies the IsNil predicate on the "answer_word_id" field.
+func AnswerWordIDIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldAnswerWordID))
+}
+
+// AnswerWordIDNotNil applies the NotNil predicate on the "answer_word_id" field.
+func AnswerWordIDNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldAnswerWordID))
+}
+
+// TypedAnswerEQ applies the EQ predicate on the "typed_answer" field.
+func TypedAnswerEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldTypedAnswer, v))
+}
+
+// TypedAnswerNEQ applies the NEQ predicate on the "typed_answer" field.
+func TypedAnswerNEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldTypedAnswer, v))
+}
+
+// TypedAnswerIn applies the In predicate on the "typed_answer" field.
+func TypedAnswerIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldTypedAnswer, vs...))
+}
+
+// TypedAnswerNotIn applies the NotIn predicate on the "typed_answer" field.
+func TypedAnswerNotIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldTypedAnswer, vs...))
+}
+
+// TypedAnswerGT applies the GT predicate on the "typed_answer" field.
+func TypedAnswerGT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldTypedAnswer, v))
+}
+
+// TypedAnswerGTE applies the GTE predicate on the "typed_answer" field.
+func TypedAnswerGTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldTypedAnswer, v))
+}
+
+// TypedAnswerLT applies the LT predicate on the "typed_answer" field.
+func TypedAnswerLT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldTypedAnswer, v))
+}
+
+// TypedAnswerLTE applies the LTE predicate on the "typed_answer" field.
+func TypedAnswerLTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldTypedAnswer, v))
+}
+
+// TypedAnswerContains applies the Contains predicate on the "typed_answer" field.
+func TypedAnswerContains(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContains(FieldTypedAnswer, v))
+}
+
+// TypedAnswerHasPrefix applies the HasPrefix predicate on the "typed_answer" field.
+func TypedAnswerHasPrefix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasPrefix(FieldTypedAnswer, v))
+}
+
+// TypedAnswerHasSuffix applies the HasSuffix predicate on the "typed_answer" field.
+func TypedAnswerHasSuffix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasSuffix(FieldTypedAnswer, v))
+}
+
+// TypedAnswerIsNil applies the IsNil predicate on the "typed_answer" field.
+func TypedAnswerIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldTypedAnswer))
+}
+
+// TypedAnswerNotNil applies the NotNil predicate on the "typed_answer" field.
+func TypedAnswerNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldTypedAnswer))
+}
+
+// TypedAnswerEqualFold applies the EqualFold predicate on the "typed_answer" field.
+func TypedAnswerEqualFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEqualFold(FieldTypedAnswer, v))
+}
+
+// TypedAnswerContainsFold applies the ContainsFold predicate on the "typed_answer" field.
+func TypedAnswerContainsFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContainsFold(FieldTypedAnswer, v))
+}
+
+// GradeEQ applies the EQ predicate on the "grade" field.
+func GradeEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldGrade, v))
+}
+
+// GradeNEQ applies the NEQ predicate on the "grade" field.
+func GradeNEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldGrade, v))
+}
+
+// GradeIn applies the In predicate on the "grade" field.
+func GradeIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldGrade, vs...))
+}
+
+// GradeNotIn applies the NotIn predicate on the "grade" field.
+func GradeNotIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldGrade, vs...))
+}
+
+// GradeGT applies the GT predicate on the "grade" field.
+func GradeGT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldGrade, v))
+}
+
+// GradeGTE applies the GTE predicate on the "grade" field.
+func GradeGTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldGrade, v))
+}
+
+// GradeLT applies the LT predicate on the "grade" field.
+func GradeLT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldGrade, v))
+}
+
+// GradeLTE applies the LTE predicate on the "grade" field.
+func GradeLTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldGrade, v))
+}
+
+// GradeContains applies the Contains predicate on the "grade" field.
+func GradeContains(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContains(FieldGrade, v))
+}
+
+// GradeHasPrefix applies the HasPrefix predicate on the "grade" field.
+func GradeHasPrefix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasPrefix(FieldGrade, v))
+}
+
+// GradeHasSuffix applies the HasSuffix predicate on the "grade" field.
+func GradeHasSuffix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasSuffix(FieldGrade, v))
+}
+
+// GradeIsNil applies the IsNil predicate on the "grade" field.
+func GradeIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldGrade))
+}
+
+// GradeNotNil applies the NotNil predicate on the "grade" field.
+func GradeNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldGrade))
+}
+
+// GradeEqualFold applies the EqualFold predicate on the "grade" field.
+func GradeEqualFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEqualFold(FieldGrade, v))
+}
+
+// GradeContainsFold applies the ContainsFold predicate on the "grade" field.
+func GradeContainsFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContainsFold(FieldGrade, v))
+}
+
+// CreditEQ applies the EQ predicate on the "credit" field.
+func CreditEQ(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldCredit, v))
+}
+
+// CreditNEQ applies the NEQ predicate on the "credit" field.
+func CreditNEQ(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldCredit, v))
+}
+
+// CreditIn applies the In predicate on the "credit" field.
+func CreditIn(vs ...float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldCredit, vs...))
+}
+
+// CreditNotIn applies the NotIn predicate on the "credit" field.
+func CreditNotIn(vs ...float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldCredit, vs...))
+}
+
+// CreditGT applies the GT predicate on the "credit" field.
+func CreditGT(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldCredit, v))
+}
+
+// CreditGTE applies the GTE predicate on the "credit" field.
+func CreditGTE(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldCredit, v))
+}
+
+// CreditLT applies the LT predicate on the "credit" field.
+func CreditLT(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldCredit, v))
+}
+
+// CreditLTE applies the LTE predicate on the "credit" field.
+func CreditLTE(v float64) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldCredit, v))
+}
+
 // IsCorrectEQ applies the EQ predicate on the "is_correct" field.
 func IsCorrectEQ(v bool) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldIsCorrect, v))
@@ -390,6 +890,56 @@ func IsCorrectNotNil() predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldNotNull(FieldIsCorrect))
 }
 
+// ServedAtEQ applies the EQ predicate on the "served_at" field.
+func ServedAtEQ(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldServedAt, v))
+}
+
+// ServedAtNEQ applies the NEQ predicate on the "served_at" field.
+func ServedAtNEQ(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldServedAt, v))
+}
+
+// ServedAtIn applies the In predicate on the "served_at" field.
+func ServedAtIn(vs ...time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldServedAt, vs...))
+}
+
+// ServedAtNotIn applies the NotIn predicate on the "served_at" field.
+func ServedAtNotIn(vs ...time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldServedAt, vs...))
+}
+
+// ServedAtGT applies the GT predicate on the "served_at" field.
+func ServedAtGT(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldServedAt, v))
+}
+
+// ServedAtGTE applies the GTE predicate on the "served_at" field.
+func ServedAtGTE(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldServedAt, v))
+}
+
+// ServedAtLT applies the LT predicate on the "served_at" field.
+func ServedAtLT(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldServedAt, v))
+}
+
+// ServedAtLTE applies the LTE predicate on the "served_at" field.
+func ServedAtLTE(v time.Time) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldServedAt, v))
+}
+
+// ServedAtIsNil applies the IsNil predicate on the "served_at" field.
+func ServedAtIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldServedAt))
+}
+
+// ServedAtNotNil applies the NotNil predicate on the "served_at" field.
+func ServedAtNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldServedAt))
+}
+
 // AnsweredAtEQ applies the EQ predicate on the "answered_at" field.
 func AnsweredAtEQ(v time.Time) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldAnsweredAt, v))
@@ -440,6 +990,81 @@ func AnsweredAtNotNil() predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldNotNull(FieldAnsweredAt))
 }
 
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIsNil applies the IsNil predicate on the "idempotency_key" field.
+func IdempotencyKeyIsNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldIsNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyNotNil applies the NotNil predicate on the "idempotency_key" field.
+func IdempotencyKeyNotNil() predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldNotNull(FieldIdempotencyKey))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.QuizQuestion {
+	return predicate.QuizQuestion(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
 // TimeMsEQ applies the EQ predicate on the "time_ms" field.
 func TimeMsEQ(v int) predicate.QuizQuestion {
 	return predicate.QuizQuestion(sql.FieldEQ(FieldTimeMs, v))
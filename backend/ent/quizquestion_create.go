@@ -62,12 +62,60 @@ func (qqc *QuizQuestionCreate) SetCorrectJpmID(i int) *QuizQuestionCreate {
 	return qqc
 }
 
+// SetDirection sets the "direction" field.
+func (qqc *QuizQuestionCreate) SetDirection(s string) *QuizQuestionCreate {
+	qqc.mutation.SetDirection(s)
+	return qqc
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableDirection(s *string) *QuizQuestionCreate {
+	if s != nil {
+		qqc.SetDirection(*s)
+	}
+	return qqc
+}
+
+// SetQuestionType sets the "question_type" field.
+func (qqc *QuizQuestionCreate) SetQuestionType(s string) *QuizQuestionCreate {
+	qqc.mutation.SetQuestionType(s)
+	return qqc
+}
+
+// SetNillableQuestionType sets the "question_type" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableQuestionType(s *string) *QuizQuestionCreate {
+	if s != nil {
+		qqc.SetQuestionType(*s)
+	}
+	return qqc
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (qqc *QuizQuestionCreate) SetMeaningName(s string) *QuizQuestionCreate {
+	qqc.mutation.SetMeaningName(s)
+	return qqc
+}
+
+// SetNillableMeaningName sets the "meaning_name" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableMeaningName(s *string) *QuizQuestionCreate {
+	if s != nil {
+		qqc.SetMeaningName(*s)
+	}
+	return qqc
+}
+
 // SetChoicesJpms sets the "choices_jpms" field.
 func (qqc *QuizQuestionCreate) SetChoicesJpms(mj []models.ChoiceJpm) *QuizQuestionCreate {
 	qqc.mutation.SetChoicesJpms(mj)
 	return qqc
 }
 
+// SetChoicesWords sets the "choices_words" field.
+func (qqc *QuizQuestionCreate) SetChoicesWords(mw []models.ChoiceWord) *QuizQuestionCreate {
+	qqc.mutation.SetChoicesWords(mw)
+	return qqc
+}
+
 // SetAnswerJpmID sets the "answer_jpm_id" field.
 func (qqc *QuizQuestionCreate) SetAnswerJpmID(i int) *QuizQuestionCreate {
 	qqc.mutation.SetAnswerJpmID(i)
@@ -82,6 +130,62 @@ func (qqc *QuizQuestionCreate) SetNillableAnswerJpmID(i *int) *QuizQuestionCreat
 	return qqc
 }
 
+// SetAnswerWordID sets the "answer_word_id" field.
+func (qqc *QuizQuestionCreate) SetAnswerWordID(i int) *QuizQuestionCreate {
+	qqc.mutation.SetAnswerWordID(i)
+	return qqc
+}
+
+// SetNillableAnswerWordID sets the "answer_word_id" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableAnswerWordID(i *int) *QuizQuestionCreate {
+	if i != nil {
+		qqc.SetAnswerWordID(*i)
+	}
+	return qqc
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (qqc *QuizQuestionCreate) SetTypedAnswer(s string) *QuizQuestionCreate {
+	qqc.mutation.SetTypedAnswer(s)
+	return qqc
+}
+
+// SetNillableTypedAnswer sets the "typed_answer" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableTypedAnswer(s *string) *QuizQuestionCreate {
+	if s != nil {
+		qqc.SetTypedAnswer(*s)
+	}
+	return qqc
+}
+
+// SetGrade sets the "grade" field.
+func (qqc *QuizQuestionCreate) SetGrade(s string) *QuizQuestionCreate {
+	qqc.mutation.SetGrade(s)
+	return qqc
+}
+
+// SetNillableGrade sets the "grade" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableGrade(s *string) *QuizQuestionCreate {
+	if s != nil {
+		qqc.SetGrade(*s)
+	}
+	return qqc
+}
+
+// SetCredit sets the "credit" field.
+func (qqc *QuizQuestionCreate) SetCredit(f float64) *QuizQuestionCreate {
+	qqc.mutation.SetCredit(f)
+	return qqc
+}
+
+// SetNillableCredit sets the "credit" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableCredit(f *float64) *QuizQuestionCreate {
+	if f != nil {
+		qqc.SetCredit(*f)
+	}
+	return qqc
+}
+
 // SetIsCorrect sets the "is_correct" field.
 func (qqc *QuizQuestionCreate) SetIsCorrect(b bool) *QuizQuestionCreate {
 	qqc.mutation.SetIsCorrect(b)
@@ -96,6 +200,20 @@ func (qqc *QuizQuestionCreate) SetNillableIsCorrect(b *bool) *QuizQuestionCreate
 	return qqc
 }
 
+// SetServedAt sets the "served_at" field.
+func (qqc *QuizQuestionCreate) SetServedAt(t time.Time) *QuizQuestionCreate {
+	qqc.mutation.SetServedAt(t)
+	return qqc
+}
+
+// SetNillableServedAt sets the "served_at" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableServedAt(t *time.Time) *QuizQuestionCreate {
+	if t != nil {
+		qqc.SetServedAt(*t)
+	}
+	return qqc
+}
+
 // SetAnsweredAt sets the "answered_at" field.
 func (qqc *QuizQuestionCreate) SetAnsweredAt(t time.Time) *QuizQuestionCreate {
 	qqc.mutation.SetAnsweredAt(t)
@@ -110,6 +228,20 @@ func (qqc *QuizQuestionCreate) SetNillableAnsweredAt(t *time.Time) *QuizQuestion
 	return qqc
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (qqc *QuizQuestionCreate) SetIdempotencyKey(s string) *QuizQuestionCreate {
+	qqc.mutation.SetIdempotencyKey(s)
+	return qqc
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (qqc *QuizQuestionCreate) SetNillableIdempotencyKey(s *string) *QuizQuestionCreate {
+	if s != nil {
+		qqc.SetIdempotencyKey(*s)
+	}
+	return qqc
+}
+
 // SetTimeMs sets the "time_ms" field.
 func (qqc *QuizQuestionCreate) SetTimeMs(i int) *QuizQuestionCreate {
 	qqc.mutation.SetTimeMs(i)
@@ -208,6 +340,18 @@ func (qqc *QuizQuestionCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (qqc *QuizQuestionCreate) defaults() {
+	if _, ok := qqc.mutation.Direction(); !ok {
+		v := quizquestion.DefaultDirection
+		qqc.mutation.SetDirection(v)
+	}
+	if _, ok := qqc.mutation.QuestionType(); !ok {
+		v := quizquestion.DefaultQuestionType
+		qqc.mutation.SetQuestionType(v)
+	}
+	if _, ok := qqc.mutation.Credit(); !ok {
+		v := quizquestion.DefaultCredit
+		qqc.mutation.SetCredit(v)
+	}
 	if _, ok := qqc.mutation.CreatedAt(); !ok {
 		v := quizquestion.DefaultCreatedAt()
 		qqc.mutation.SetCreatedAt(v)
@@ -249,9 +393,33 @@ func (qqc *QuizQuestionCreate) check() error {
 	if _, ok := qqc.mutation.CorrectJpmID(); !ok {
 		return &ValidationError{Name: "correct_jpm_id", err: errors.New(`ent: missing required field "QuizQuestion.correct_jpm_id"`)}
 	}
+	if _, ok := qqc.mutation.Direction(); !ok {
+		return &ValidationError{Name: "direction", err: errors.New(`ent: missing required field "QuizQuestion.direction"`)}
+	}
+	if v, ok := qqc.mutation.Direction(); ok {
+		if err := quizquestion.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.direction": %w`, err)}
+		}
+	}
+	if _, ok := qqc.mutation.QuestionType(); !ok {
+		return &ValidationError{Name: "question_type", err: errors.New(`ent: missing required field "QuizQuestion.question_type"`)}
+	}
+	if v, ok := qqc.mutation.QuestionType(); ok {
+		if err := quizquestion.QuestionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "question_type", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.question_type": %w`, err)}
+		}
+	}
 	if _, ok := qqc.mutation.ChoicesJpms(); !ok {
 		return &ValidationError{Name: "choices_jpms", err: errors.New(`ent: missing required field "QuizQuestion.choices_jpms"`)}
 	}
+	if _, ok := qqc.mutation.Credit(); !ok {
+		return &ValidationError{Name: "credit", err: errors.New(`ent: missing required field "QuizQuestion.credit"`)}
+	}
+	if v, ok := qqc.mutation.IdempotencyKey(); ok {
+		if err := quizquestion.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.idempotency_key": %w`, err)}
+		}
+	}
 	if _, ok := qqc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "QuizQuestion.created_at"`)}
 	}
@@ -303,22 +471,62 @@ func (qqc *QuizQuestionCreate) createSpec() (*QuizQuestion, *sqlgraph.CreateSpec
 		_spec.SetField(quizquestion.FieldPosID, field.TypeInt, value)
 		_node.PosID = value
 	}
+	if value, ok := qqc.mutation.Direction(); ok {
+		_spec.SetField(quizquestion.FieldDirection, field.TypeString, value)
+		_node.Direction = value
+	}
+	if value, ok := qqc.mutation.QuestionType(); ok {
+		_spec.SetField(quizquestion.FieldQuestionType, field.TypeString, value)
+		_node.QuestionType = value
+	}
+	if value, ok := qqc.mutation.MeaningName(); ok {
+		_spec.SetField(quizquestion.FieldMeaningName, field.TypeString, value)
+		_node.MeaningName = value
+	}
 	if value, ok := qqc.mutation.ChoicesJpms(); ok {
 		_spec.SetField(quizquestion.FieldChoicesJpms, field.TypeJSON, value)
 		_node.ChoicesJpms = value
 	}
+	if value, ok := qqc.mutation.ChoicesWords(); ok {
+		_spec.SetField(quizquestion.FieldChoicesWords, field.TypeJSON, value)
+		_node.ChoicesWords = value
+	}
 	if value, ok := qqc.mutation.AnswerJpmID(); ok {
 		_spec.SetField(quizquestion.FieldAnswerJpmID, field.TypeInt, value)
 		_node.AnswerJpmID = &value
 	}
+	if value, ok := qqc.mutation.AnswerWordID(); ok {
+		_spec.SetField(quizquestion.FieldAnswerWordID, field.TypeInt, value)
+		_node.AnswerWordID = &value
+	}
+	if value, ok := qqc.mutation.TypedAnswer(); ok {
+		_spec.SetField(quizquestion.FieldTypedAnswer, field.TypeString, value)
+		_node.TypedAnswer = &value
+	}
+	if value, ok := qqc.mutation.Grade(); ok {
+		_spec.SetField(quizquestion.FieldGrade, field.TypeString, value)
+		_node.Grade = &value
+	}
+	if value, ok := qqc.mutation.Credit(); ok {
+		_spec.SetField(quizquestion.FieldCredit, field.TypeFloat64, value)
+		_node.Credit = value
+	}
 	if value, ok := qqc.mutation.IsCorrect(); ok {
 		_spec.SetField(quizquestion.FieldIsCorrect, field.TypeBool, value)
 		_node.IsCorrect = &value
 	}
+	if value, ok := qqc.mutation.ServedAt(); ok {
+		_spec.SetField(quizquestion.FieldServedAt, field.TypeTime, value)
+		_node.ServedAt = &value
+	}
 	if value, ok := qqc.mutation.AnsweredAt(); ok {
 		_spec.SetField(quizquestion.FieldAnsweredAt, field.TypeTime, value)
 		_node.AnsweredAt = &value
 	}
+	if value, ok := qqc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(quizquestion.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = &value
+	}
 	if value, ok := qqc.mutation.TimeMs(); ok {
 		_spec.SetField(quizquestion.FieldTimeMs, field.TypeInt, value)
 		_node.TimeMs = &value
@@ -518,6 +726,48 @@ func (u *QuizQuestionUpsert) UpdateCorrectJpmID() *QuizQuestionUpsert {
 	return u
 }
 
+// SetDirection sets the "direction" field.
+func (u *QuizQuestionUpsert) SetDirection(v string) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldDirection, v)
+	return u
+}
+
+// UpdateDirection sets the "direction" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateDirection() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldDirection)
+	return u
+}
+
+// SetQuestionType sets the "question_type" field.
+func (u *QuizQuestionUpsert) SetQuestionType(v string) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldQuestionType, v)
+	return u
+}
+
+// UpdateQuestionType sets the "question_type" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateQuestionType() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldQuestionType)
+	return u
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (u *QuizQuestionUpsert) SetMeaningName(v string) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldMeaningName, v)
+	return u
+}
+
+// UpdateMeaningName sets the "meaning_name" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateMeaningName() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldMeaningName)
+	return u
+}
+
+// ClearMeaningName clears the value of the "meaning_name" field.
+func (u *QuizQuestionUpsert) ClearMeaningName() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldMeaningName)
+	return u
+}
+
 // SetChoicesJpms sets the "choices_jpms" field.
 func (u *QuizQuestionUpsert) SetChoicesJpms(v []models.ChoiceJpm) *QuizQuestionUpsert {
 	u.Set(quizquestion.FieldChoicesJpms, v)
@@ -530,6 +780,24 @@ func (u *QuizQuestionUpsert) UpdateChoicesJpms() *QuizQuestionUpsert {
 	return u
 }
 
+// SetChoicesWords sets the "choices_words" field.
+func (u *QuizQuestionUpsert) SetChoicesWords(v []models.ChoiceWord) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldChoicesWords, v)
+	return u
+}
+
+// UpdateChoicesWords sets the "choices_words" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateChoicesWords() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldChoicesWords)
+	return u
+}
+
+// ClearChoicesWords clears the value of the "choices_words" field.
+func (u *QuizQuestionUpsert) ClearChoicesWords() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldChoicesWords)
+	return u
+}
+
 // SetAnswerJpmID sets the "answer_jpm_id" field.
 func (u *QuizQuestionUpsert) SetAnswerJpmID(v int) *QuizQuestionUpsert {
 	u.Set(quizquestion.FieldAnswerJpmID, v)
@@ -554,6 +822,84 @@ func (u *QuizQuestionUpsert) ClearAnswerJpmID() *QuizQuestionUpsert {
 	return u
 }
 
+// SetAnswerWordID sets the "answer_word_id" field.
+func (u *QuizQuestionUpsert) SetAnswerWordID(v int) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldAnswerWordID, v)
+	return u
+}
+
+// UpdateAnswerWordID sets the "answer_word_id" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateAnswerWordID() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldAnswerWordID)
+	return u
+}
+
+// AddAnswerWordID adds v to the "answer_word_id" field.
+func (u *QuizQuestionUpsert) AddAnswerWordID(v int) *QuizQuestionUpsert {
+	u.Add(quizquestion.FieldAnswerWordID, v)
+	return u
+}
+
+// ClearAnswerWordID clears the value of the "answer_word_id" field.
+func (u *QuizQuestionUpsert) ClearAnswerWordID() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldAnswerWordID)
+	return u
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (u *QuizQuestionUpsert) SetTypedAnswer(v string) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldTypedAnswer, v)
+	return u
+}
+
+// UpdateTypedAnswer sets the "typed_answer" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateTypedAnswer() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldTypedAnswer)
+	return u
+}
+
+// ClearTypedAnswer clears the value of the "typed_answer" field.
+func (u *QuizQuestionUpsert) ClearTypedAnswer() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldTypedAnswer)
+	return u
+}
+
+// SetGrade sets the "grade" field.
+func (u *QuizQuestionUpsert) SetGrade(v string) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldGrade, v)
+	return u
+}
+
+// UpdateGrade sets the "grade" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateGrade() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldGrade)
+	return u
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (u *QuizQuestionUpsert) ClearGrade() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldGrade)
+	return u
+}
+
+// SetCredit sets the "credit" field.
+func (u *QuizQuestionUpsert) SetCredit(v float64) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldCredit, v)
+	return u
+}
+
+// UpdateCredit sets the "credit" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateCredit() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldCredit)
+	return u
+}
+
+// AddCredit adds v to the "credit" field.
+func (u *QuizQuestionUpsert) AddCredit(v float64) *QuizQuestionUpsert {
+	u.Add(quizquestion.FieldCredit, v)
+	return u
+}
+
 // SetIsCorrect sets the "is_correct" field.
 func (u *QuizQuestionUpsert) SetIsCorrect(v bool) *QuizQuestionUpsert {
 	u.Set(quizquestion.FieldIsCorrect, v)
@@ -572,6 +918,24 @@ func (u *QuizQuestionUpsert) ClearIsCorrect() *QuizQuestionUpsert {
 	return u
 }
 
+// SetServedAt sets the "served_at" field.
+func (u *QuizQuestionUpsert) SetServedAt(v time.Time) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldServedAt, v)
+	return u
+}
+
+// UpdateServedAt sets the "served_at" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateServedAt() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldServedAt)
+	return u
+}
+
+// ClearServedAt clears the value of the "served_at" field.
+func (u *QuizQuestionUpsert) ClearServedAt() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldServedAt)
+	return u
+}
+
 // SetAnsweredAt sets the "answered_at" field.
 func (u *QuizQuestionUpsert) SetAnsweredAt(v time.Time) *QuizQuestionUpsert {
 	u.Set(quizquestion.FieldAnsweredAt, v)
@@ -590,6 +954,24 @@ func (u *QuizQuestionUpsert) ClearAnsweredAt() *QuizQuestionUpsert {
 	return u
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *QuizQuestionUpsert) SetIdempotencyKey(v string) *QuizQuestionUpsert {
+	u.Set(quizquestion.FieldIdempotencyKey, v)
+	return u
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *QuizQuestionUpsert) UpdateIdempotencyKey() *QuizQuestionUpsert {
+	u.SetExcluded(quizquestion.FieldIdempotencyKey)
+	return u
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *QuizQuestionUpsert) ClearIdempotencyKey() *QuizQuestionUpsert {
+	u.SetNull(quizquestion.FieldIdempotencyKey)
+	return u
+}
+
 // SetTimeMs sets the "time_ms" field.
 func (u *QuizQuestionUpsert) SetTimeMs(v int) *QuizQuestionUpsert {
 	u.Set(quizquestion.FieldTimeMs, v)
@@ -782,6 +1164,55 @@ func (u *QuizQuestionUpsertOne) UpdateCorrectJpmID() *QuizQuestionUpsertOne {
 	})
 }
 
+// SetDirection sets the "direction" field.
+func (u *QuizQuestionUpsertOne) SetDirection(v string) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetDirection(v)
+	})
+}
+
+// UpdateDirection sets the "direction" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateDirection() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateDirection()
+	})
+}
+
+// SetQuestionType sets the "question_type" field.
+func (u *QuizQuestionUpsertOne) SetQuestionType(v string) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetQuestionType(v)
+	})
+}
+
+// UpdateQuestionType sets the "question_type" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateQuestionType() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateQuestionType()
+	})
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (u *QuizQuestionUpsertOne) SetMeaningName(v string) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetMeaningName(v)
+	})
+}
+
+// UpdateMeaningName sets the "meaning_name" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateMeaningName() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateMeaningName()
+	})
+}
+
+// ClearMeaningName clears the value of the "meaning_name" field.
+func (u *QuizQuestionUpsertOne) ClearMeaningName() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearMeaningName()
+	})
+}
+
 // SetChoicesJpms sets the "choices_jpms" field.
 func (u *QuizQuestionUpsertOne) SetChoicesJpms(v []models.ChoiceJpm) *QuizQuestionUpsertOne {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -796,6 +1227,27 @@ func (u *QuizQuestionUpsertOne) UpdateChoicesJpms() *QuizQuestionUpsertOne {
 	})
 }
 
+// SetChoicesWords sets the "choices_words" field.
+func (u *QuizQuestionUpsertOne) SetChoicesWords(v []models.ChoiceWord) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetChoicesWords(v)
+	})
+}
+
+// UpdateChoicesWords sets the "choices_words" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateChoicesWords() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateChoicesWords()
+	})
+}
+
+// ClearChoicesWords clears the value of the "choices_words" field.
+func (u *QuizQuestionUpsertOne) ClearChoicesWords() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearChoicesWords()
+	})
+}
+
 // SetAnswerJpmID sets the "answer_jpm_id" field.
 func (u *QuizQuestionUpsertOne) SetAnswerJpmID(v int) *QuizQuestionUpsertOne {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -824,6 +1276,97 @@ func (u *QuizQuestionUpsertOne) ClearAnswerJpmID() *QuizQuestionUpsertOne {
 	})
 }
 
+// SetAnswerWordID sets the "answer_word_id" field.
+func (u *QuizQuestionUpsertOne) SetAnswerWordID(v int) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetAnswerWordID(v)
+	})
+}
+
+// AddAnswerWordID adds v to the "answer_word_id" field.
+func (u *QuizQuestionUpsertOne) AddAnswerWordID(v int) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.AddAnswerWordID(v)
+	})
+}
+
+// UpdateAnswerWordID sets the "answer_word_id" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateAnswerWordID() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateAnswerWordID()
+	})
+}
+
+// ClearAnswerWordID clears the value of the "answer_word_id" field.
+func (u *QuizQuestionUpsertOne) ClearAnswerWordID() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearAnswerWordID()
+	})
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (u *QuizQuestionUpsertOne) SetTypedAnswer(v string) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetTypedAnswer(v)
+	})
+}
+
+// UpdateTypedAnswer sets the "typed_answer" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateTypedAnswer() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateTypedAnswer()
+	})
+}
+
+// ClearTypedAnswer clears the value of the "typed_answer" field.
+func (u *QuizQuestionUpsertOne) ClearTypedAnswer() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearTypedAnswer()
+	})
+}
+
+// SetGrade sets the "grade" field.
+func (u *QuizQuestionUpsertOne) SetGrade(v string) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetGrade(v)
+	})
+}
+
+// UpdateGrade sets the "grade" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateGrade() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateGrade()
+	})
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (u *QuizQuestionUpsertOne) ClearGrade() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearGrade()
+	})
+}
+
+// SetCredit sets the "credit" field.
+func (u *QuizQuestionUpsertOne) SetCredit(v float64) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetCredit(v)
+	})
+}
+
+// AddCredit adds v to the "credit" field.
+func (u *QuizQuestionUpsertOne) AddCredit(v float64) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.AddCredit(v)
+	})
+}
+
+// UpdateCredit sets the "credit" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateCredit() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateCredit()
+	})
+}
+
 // SetIsCorrect sets the "is_correct" field.
 func (u *QuizQuestionUpsertOne) SetIsCorrect(v bool) *QuizQuestionUpsertOne {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -845,6 +1388,27 @@ func (u *QuizQuestionUpsertOne) ClearIsCorrect() *QuizQuestionUpsertOne {
 	})
 }
 
+// SetServedAt sets the "served_at" field.
+func (u *QuizQuestionUpsertOne) SetServedAt(v time.Time) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetServedAt(v)
+	})
+}
+
+// UpdateServedAt sets the "served_at" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateServedAt() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateServedAt()
+	})
+}
+
+// ClearServedAt clears the value of the "served_at" field.
+func (u *QuizQuestionUpsertOne) ClearServedAt() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearServedAt()
+	})
+}
+
 // SetAnsweredAt sets the "answered_at" field.
 func (u *QuizQuestionUpsertOne) SetAnsweredAt(v time.Time) *QuizQuestionUpsertOne {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -866,6 +1430,27 @@ func (u *QuizQuestionUpsertOne) ClearAnsweredAt() *QuizQuestionUpsertOne {
 	})
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *QuizQuestionUpsertOne) SetIdempotencyKey(v string) *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *QuizQuestionUpsertOne) UpdateIdempotencyKey() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *QuizQuestionUpsertOne) ClearIdempotencyKey() *QuizQuestionUpsertOne {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
 // SetTimeMs sets the "time_ms" field.
 func (u *QuizQuestionUpsertOne) SetTimeMs(v int) *QuizQuestionUpsertOne {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -1231,6 +1816,55 @@ func (u *QuizQuestionUpsertBulk) UpdateCorrectJpmID() *QuizQuestionUpsertBulk {
 	})
 }
 
+// SetDirection sets the "direction" field.
+func (u *QuizQuestionUpsertBulk) SetDirection(v string) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetDirection(v)
+	})
+}
+
+// UpdateDirection sets the "direction" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateDirection() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateDirection()
+	})
+}
+
+// SetQuestionType sets the "question_type" field.
+func (u *QuizQuestionUpsertBulk) SetQuestionType(v string) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetQuestionType(v)
+	})
+}
+
+// UpdateQuestionType sets the "question_type" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateQuestionType() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateQuestionType()
+	})
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (u *QuizQuestionUpsertBulk) SetMeaningName(v string) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetMeaningName(v)
+	})
+}
+
+// UpdateMeaningName sets the "meaning_name" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateMeaningName() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateMeaningName()
+	})
+}
+
+// ClearMeaningName clears the value of the "meaning_name" field.
+func (u *QuizQuestionUpsertBulk) ClearMeaningName() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearMeaningName()
+	})
+}
+
 // SetChoicesJpms sets the "choices_jpms" field.
 func (u *QuizQuestionUpsertBulk) SetChoicesJpms(v []models.ChoiceJpm) *QuizQuestionUpsertBulk {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -1245,6 +1879,27 @@ func (u *QuizQuestionUpsertBulk) UpdateChoicesJpms() *QuizQuestionUpsertBulk {
 	})
 }
 
+// SetChoicesWords sets the "choices_words" field.
+func (u *QuizQuestionUpsertBulk) SetChoicesWords(v []models.ChoiceWord) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetChoicesWords(v)
+	})
+}
+
+// UpdateChoicesWords sets the "choices_words" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateChoicesWords() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateChoicesWords()
+	})
+}
+
+// ClearChoicesWords clears the value of the "choices_words" field.
+func (u *QuizQuestionUpsertBulk) ClearChoicesWords() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearChoicesWords()
+	})
+}
+
 // SetAnswerJpmID sets the "answer_jpm_id" field.
 func (u *QuizQuestionUpsertBulk) SetAnswerJpmID(v int) *QuizQuestionUpsertBulk {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -1273,6 +1928,97 @@ func (u *QuizQuestionUpsertBulk) ClearAnswerJpmID() *QuizQuestionUpsertBulk {
 	})
 }
 
+// SetAnswerWordID sets the "answer_word_id" field.
+func (u *QuizQuestionUpsertBulk) SetAnswerWordID(v int) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetAnswerWordID(v)
+	})
+}
+
+// AddAnswerWordID adds v to the "answer_word_id" field.
+func (u *QuizQuestionUpsertBulk) AddAnswerWordID(v int) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.AddAnswerWordID(v)
+	})
+}
+
+// UpdateAnswerWordID sets the "answer_word_id" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateAnswerWordID() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateAnswerWordID()
+	})
+}
+
+// ClearAnswerWordID clears the value of the "answer_word_id" field.
+func (u *QuizQuestionUpsertBulk) ClearAnswerWordID() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearAnswerWordID()
+	})
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (u *QuizQuestionUpsertBulk) SetTypedAnswer(v string) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetTypedAnswer(v)
+	})
+}
+
+// UpdateTypedAnswer sets the "typed_answer" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateTypedAnswer() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateTypedAnswer()
+	})
+}
+
+// ClearTypedAnswer clears the value of the "typed_answer" field.
+func (u *QuizQuestionUpsertBulk) ClearTypedAnswer() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearTypedAnswer()
+	})
+}
+
+// SetGrade sets the "grade" field.
+func (u *QuizQuestionUpsertBulk) SetGrade(v string) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetGrade(v)
+	})
+}
+
+// UpdateGrade sets the "grade" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateGrade() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateGrade()
+	})
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (u *QuizQuestionUpsertBulk) ClearGrade() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearGrade()
+	})
+}
+
+// SetCredit sets the "credit" field.
+func (u *QuizQuestionUpsertBulk) SetCredit(v float64) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetCredit(v)
+	})
+}
+
+// AddCredit adds v to the "credit" field.
+func (u *QuizQuestionUpsertBulk) AddCredit(v float64) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.AddCredit(v)
+	})
+}
+
+// UpdateCredit sets the "credit" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateCredit() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateCredit()
+	})
+}
+
 // SetIsCorrect sets the "is_correct" field.
 func (u *QuizQuestionUpsertBulk) SetIsCorrect(v bool) *QuizQuestionUpsertBulk {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -1294,6 +2040,27 @@ func (u *QuizQuestionUpsertBulk) ClearIsCorrect() *QuizQuestionUpsertBulk {
 	})
 }
 
+// SetServedAt sets the "served_at" field.
+func (u *QuizQuestionUpsertBulk) SetServedAt(v time.Time) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetServedAt(v)
+	})
+}
+
+// UpdateServedAt sets the "served_at" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateServedAt() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateServedAt()
+	})
+}
+
+// ClearServedAt clears the value of the "served_at" field.
+func (u *QuizQuestionUpsertBulk) ClearServedAt() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearServedAt()
+	})
+}
+
 // SetAnsweredAt sets the "answered_at" field.
 func (u *QuizQuestionUpsertBulk) SetAnsweredAt(v time.Time) *QuizQuestionUpsertBulk {
 	return u.Update(func(s *QuizQuestionUpsert) {
@@ -1315,6 +2082,27 @@ func (u *QuizQuestionUpsertBulk) ClearAnsweredAt() *QuizQuestionUpsertBulk {
 	})
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (u *QuizQuestionUpsertBulk) SetIdempotencyKey(v string) *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.SetIdempotencyKey(v)
+	})
+}
+
+// UpdateIdempotencyKey sets the "idempotency_key" field to the value that was provided on create.
+func (u *QuizQuestionUpsertBulk) UpdateIdempotencyKey() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.UpdateIdempotencyKey()
+	})
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (u *QuizQuestionUpsertBulk) ClearIdempotencyKey() *QuizQuestionUpsertBulk {
+	return u.Update(func(s *QuizQuestionUpsert) {
+		s.ClearIdempotencyKey()
+	})
+}
+
 // SetTimeMs sets the "time_ms" field.
 func (u *QuizQuestionUpsertBulk) SetTimeMs(v int) *QuizQuestionUpsertBulk {
 	return u.Update(func(s *QuizQuestionUpsert) {
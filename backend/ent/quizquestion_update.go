@@ -132,6 +132,54 @@ func (qqu *QuizQuestionUpdate) SetNillableCorrectJpmID(i *int) *QuizQuestionUpda
 	return qqu
 }
 
+// SetDirection sets the "direction" field.
+func (qqu *QuizQuestionUpdate) SetDirection(s string) *QuizQuestionUpdate {
+	qqu.mutation.SetDirection(s)
+	return qqu
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableDirection(s *string) *QuizQuestionUpdate {
+	if s != nil {
+		qqu.SetDirection(*s)
+	}
+	return qqu
+}
+
+// SetQuestionType sets the "question_type" field.
+func (qqu *QuizQuestionUpdate) SetQuestionType(s string) *QuizQuestionUpdate {
+	qqu.mutation.SetQuestionType(s)
+	return qqu
+}
+
+// SetNillableQuestionType sets the "question_type" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableQuestionType(s *string) *QuizQuestionUpdate {
+	if s != nil {
+		qqu.SetQuestionType(*s)
+	}
+	return qqu
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (qqu *QuizQuestionUpdate) SetMeaningName(s string) *QuizQuestionUpdate {
+	qqu.mutation.SetMeaningName(s)
+	return qqu
+}
+
+// SetNillableMeaningName sets the "meaning_name" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableMeaningName(s *string) *QuizQuestionUpdate {
+	if s != nil {
+		qqu.SetMeaningName(*s)
+	}
+	return qqu
+}
+
+// ClearMeaningName clears the value of the "meaning_name" field.
+func (qqu *QuizQuestionUpdate) ClearMeaningName() *QuizQuestionUpdate {
+	qqu.mutation.ClearMeaningName()
+	return qqu
+}
+
 // SetChoicesJpms sets the "choices_jpms" field.
 func (qqu *QuizQuestionUpdate) SetChoicesJpms(mj []models.ChoiceJpm) *QuizQuestionUpdate {
 	qqu.mutation.SetChoicesJpms(mj)
@@ -144,6 +192,24 @@ func (qqu *QuizQuestionUpdate) AppendChoicesJpms(mj []models.ChoiceJpm) *QuizQue
 	return qqu
 }
 
+// SetChoicesWords sets the "choices_words" field.
+func (qqu *QuizQuestionUpdate) SetChoicesWords(mw []models.ChoiceWord) *QuizQuestionUpdate {
+	qqu.mutation.SetChoicesWords(mw)
+	return qqu
+}
+
+// AppendChoicesWords appends mw to the "choices_words" field.
+func (qqu *QuizQuestionUpdate) AppendChoicesWords(mw []models.ChoiceWord) *QuizQuestionUpdate {
+	qqu.mutation.AppendChoicesWords(mw)
+	return qqu
+}
+
+// ClearChoicesWords clears the value of the "choices_words" field.
+func (qqu *QuizQuestionUpdate) ClearChoicesWords() *QuizQuestionUpdate {
+	qqu.mutation.ClearChoicesWords()
+	return qqu
+}
+
 // SetAnswerJpmID sets the "answer_jpm_id" field.
 func (qqu *QuizQuestionUpdate) SetAnswerJpmID(i int) *QuizQuestionUpdate {
 	qqu.mutation.ResetAnswerJpmID()
@@ -171,6 +237,94 @@ func (qqu *QuizQuestionUpdate) ClearAnswerJpmID() *QuizQuestionUpdate {
 	return qqu
 }
 
+// SetAnswerWordID sets the "answer_word_id" field.
+func (qqu *QuizQuestionUpdate) SetAnswerWordID(i int) *QuizQuestionUpdate {
+	qqu.mutation.ResetAnswerWordID()
+	qqu.mutation.SetAnswerWordID(i)
+	return qqu
+}
+
+// SetNillableAnswerWordID sets the "answer_word_id" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableAnswerWordID(i *int) *QuizQuestionUpdate {
+	if i != nil {
+		qqu.SetAnswerWordID(*i)
+	}
+	return qqu
+}
+
+// AddAnswerWordID adds i to the "answer_word_id" field.
+func (qqu *QuizQuestionUpdate) AddAnswerWordID(i int) *QuizQuestionUpdate {
+	qqu.mutation.AddAnswerWordID(i)
+	return qqu
+}
+
+// ClearAnswerWordID clears the value of the "answer_word_id" field.
+func (qqu *QuizQuestionUpdate) ClearAnswerWordID() *QuizQuestionUpdate {
+	qqu.mutation.ClearAnswerWordID()
+	return qqu
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (qqu *QuizQuestionUpdate) SetTypedAnswer(s string) *QuizQuestionUpdate {
+	qqu.mutation.SetTypedAnswer(s)
+	return qqu
+}
+
+// SetNillableTypedAnswer sets the "typed_answer" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableTypedAnswer(s *string) *QuizQuestionUpdate {
+	if s != nil {
+		qqu.SetTypedAnswer(*s)
+	}
+	return qqu
+}
+
+// ClearTypedAnswer clears the value of the "typed_answer" field.
+func (qqu *QuizQuestionUpdate) ClearTypedAnswer() *QuizQuestionUpdate {
+	qqu.mutation.ClearTypedAnswer()
+	return qqu
+}
+
+// SetGrade sets the "grade" field.
+func (qqu *QuizQuestionUpdate) SetGrade(s string) *QuizQuestionUpdate {
+	qqu.mutation.SetGrade(s)
+	return qqu
+}
+
+// SetNillableGrade sets the "grade" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableGrade(s *string) *QuizQuestionUpdate {
+	if s != nil {
+		qqu.SetGrade(*s)
+	}
+	return qqu
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (qqu *QuizQuestionUpdate) ClearGrade() *QuizQuestionUpdate {
+	qqu.mutation.ClearGrade()
+	return qqu
+}
+
+// SetCredit sets the "credit" field.
+func (qqu *QuizQuestionUpdate) SetCredit(f float64) *QuizQuestionUpdate {
+	qqu.mutation.ResetCredit()
+	qqu.mutation.SetCredit(f)
+	return qqu
+}
+
+// SetNillableCredit sets the "credit" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableCredit(f *float64) *QuizQuestionUpdate {
+	if f != nil {
+		qqu.SetCredit(*f)
+	}
+	return qqu
+}
+
+// AddCredit adds f to the "credit" field.
+func (qqu *QuizQuestionUpdate) AddCredit(f float64) *QuizQuestionUpdate {
+	qqu.mutation.AddCredit(f)
+	return qqu
+}
+
 // SetIsCorrect sets the "is_correct" field.
 func (qqu *QuizQuestionUpdate) SetIsCorrect(b bool) *QuizQuestionUpdate {
 	qqu.mutation.SetIsCorrect(b)
@@ -191,6 +345,26 @@ func (qqu *QuizQuestionUpdate) ClearIsCorrect() *QuizQuestionUpdate {
 	return qqu
 }
 
+// SetServedAt sets the "served_at" field.
+func (qqu *QuizQuestionUpdate) SetServedAt(t time.Time) *QuizQuestionUpdate {
+	qqu.mutation.SetServedAt(t)
+	return qqu
+}
+
+// SetNillableServedAt sets the "served_at" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableServedAt(t *time.Time) *QuizQuestionUpdate {
+	if t != nil {
+		qqu.SetServedAt(*t)
+	}
+	return qqu
+}
+
+// ClearServedAt clears the value of the "served_at" field.
+func (qqu *QuizQuestionUpdate) ClearServedAt() *QuizQuestionUpdate {
+	qqu.mutation.ClearServedAt()
+	return qqu
+}
+
 // SetAnsweredAt sets the "answered_at" field.
 func (qqu *QuizQuestionUpdate) SetAnsweredAt(t time.Time) *QuizQuestionUpdate {
 	qqu.mutation.SetAnsweredAt(t)
@@ -211,6 +385,26 @@ func (qqu *QuizQuestionUpdate) ClearAnsweredAt() *QuizQuestionUpdate {
 	return qqu
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (qqu *QuizQuestionUpdate) SetIdempotencyKey(s string) *QuizQuestionUpdate {
+	qqu.mutation.SetIdempotencyKey(s)
+	return qqu
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (qqu *QuizQuestionUpdate) SetNillableIdempotencyKey(s *string) *QuizQuestionUpdate {
+	if s != nil {
+		qqu.SetIdempotencyKey(*s)
+	}
+	return qqu
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (qqu *QuizQuestionUpdate) ClearIdempotencyKey() *QuizQuestionUpdate {
+	qqu.mutation.ClearIdempotencyKey()
+	return qqu
+}
+
 // SetTimeMs sets the "time_ms" field.
 func (qqu *QuizQuestionUpdate) SetTimeMs(i int) *QuizQuestionUpdate {
 	qqu.mutation.ResetTimeMs()
@@ -360,6 +554,21 @@ func (qqu *QuizQuestionUpdate) check() error {
 			return &ValidationError{Name: "pos_id", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.pos_id": %w`, err)}
 		}
 	}
+	if v, ok := qqu.mutation.Direction(); ok {
+		if err := quizquestion.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.direction": %w`, err)}
+		}
+	}
+	if v, ok := qqu.mutation.QuestionType(); ok {
+		if err := quizquestion.QuestionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "question_type", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.question_type": %w`, err)}
+		}
+	}
+	if v, ok := qqu.mutation.IdempotencyKey(); ok {
+		if err := quizquestion.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.idempotency_key": %w`, err)}
+		}
+	}
 	if qqu.mutation.QuizCleared() && len(qqu.mutation.QuizIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "QuizQuestion.quiz"`)
 	}
@@ -405,6 +614,18 @@ func (qqu *QuizQuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := qqu.mutation.AddedPosID(); ok {
 		_spec.AddField(quizquestion.FieldPosID, field.TypeInt, value)
 	}
+	if value, ok := qqu.mutation.Direction(); ok {
+		_spec.SetField(quizquestion.FieldDirection, field.TypeString, value)
+	}
+	if value, ok := qqu.mutation.QuestionType(); ok {
+		_spec.SetField(quizquestion.FieldQuestionType, field.TypeString, value)
+	}
+	if value, ok := qqu.mutation.MeaningName(); ok {
+		_spec.SetField(quizquestion.FieldMeaningName, field.TypeString, value)
+	}
+	if qqu.mutation.MeaningNameCleared() {
+		_spec.ClearField(quizquestion.FieldMeaningName, field.TypeString)
+	}
 	if value, ok := qqu.mutation.ChoicesJpms(); ok {
 		_spec.SetField(quizquestion.FieldChoicesJpms, field.TypeJSON, value)
 	}
@@ -413,6 +634,17 @@ func (qqu *QuizQuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			sqljson.Append(u, quizquestion.FieldChoicesJpms, value)
 		})
 	}
+	if value, ok := qqu.mutation.ChoicesWords(); ok {
+		_spec.SetField(quizquestion.FieldChoicesWords, field.TypeJSON, value)
+	}
+	if value, ok := qqu.mutation.AppendedChoicesWords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizquestion.FieldChoicesWords, value)
+		})
+	}
+	if qqu.mutation.ChoicesWordsCleared() {
+		_spec.ClearField(quizquestion.FieldChoicesWords, field.TypeJSON)
+	}
 	if value, ok := qqu.mutation.AnswerJpmID(); ok {
 		_spec.SetField(quizquestion.FieldAnswerJpmID, field.TypeInt, value)
 	}
@@ -422,18 +654,57 @@ func (qqu *QuizQuestionUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if qqu.mutation.AnswerJpmIDCleared() {
 		_spec.ClearField(quizquestion.FieldAnswerJpmID, field.TypeInt)
 	}
+	if value, ok := qqu.mutation.AnswerWordID(); ok {
+		_spec.SetField(quizquestion.FieldAnswerWordID, field.TypeInt, value)
+	}
+	if value, ok := qqu.mutation.AddedAnswerWordID(); ok {
+		_spec.AddField(quizquestion.FieldAnswerWordID, field.TypeInt, value)
+	}
+	if qqu.mutation.AnswerWordIDCleared() {
+		_spec.ClearField(quizquestion.FieldAnswerWordID, field.TypeInt)
+	}
+	if value, ok := qqu.mutation.TypedAnswer(); ok {
+		_spec.SetField(quizquestion.FieldTypedAnswer, field.TypeString, value)
+	}
+	if qqu.mutation.TypedAnswerCleared() {
+		_spec.ClearField(quizquestion.FieldTypedAnswer, field.TypeString)
+	}
+	if value, ok := qqu.mutation.Grade(); ok {
+		_spec.SetField(quizquestion.FieldGrade, field.TypeString, value)
+	}
+	if qqu.mutation.GradeCleared() {
+		_spec.ClearField(quizquestion.FieldGrade, field.TypeString)
+	}
+	if value, ok := qqu.mutation.Credit(); ok {
+		_spec.SetField(quizquestion.FieldCredit, field.TypeFloat64, value)
+	}
+	if value, ok := qqu.mutation.AddedCredit(); ok {
+		_spec.AddField(quizquestion.FieldCredit, field.TypeFloat64, value)
+	}
 	if value, ok := qqu.mutation.IsCorrect(); ok {
 		_spec.SetField(quizquestion.FieldIsCorrect, field.TypeBool, value)
 	}
 	if qqu.mutation.IsCorrectCleared() {
 		_spec.ClearField(quizquestion.FieldIsCorrect, field.TypeBool)
 	}
+	if value, ok := qqu.mutation.ServedAt(); ok {
+		_spec.SetField(quizquestion.FieldServedAt, field.TypeTime, value)
+	}
+	if qqu.mutation.ServedAtCleared() {
+		_spec.ClearField(quizquestion.FieldServedAt, field.TypeTime)
+	}
 	if value, ok := qqu.mutation.AnsweredAt(); ok {
 		_spec.SetField(quizquestion.FieldAnsweredAt, field.TypeTime, value)
 	}
 	if qqu.mutation.AnsweredAtCleared() {
 		_spec.ClearField(quizquestion.FieldAnsweredAt, field.TypeTime)
 	}
+	if value, ok := qqu.mutation.IdempotencyKey(); ok {
+		_spec.SetField(quizquestion.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if qqu.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(quizquestion.FieldIdempotencyKey, field.TypeString)
+	}
 	if value, ok := qqu.mutation.TimeMs(); ok {
 		_spec.SetField(quizquestion.FieldTimeMs, field.TypeInt, value)
 	}
@@ -659,6 +930,54 @@ func (qquo *QuizQuestionUpdateOne) SetNillableCorrectJpmID(i *int) *QuizQuestion
 	return qquo
 }
 
+// SetDirection sets the "direction" field.
+func (qquo *QuizQuestionUpdateOne) SetDirection(s string) *QuizQuestionUpdateOne {
+	qquo.mutation.SetDirection(s)
+	return qquo
+}
+
+// SetNillableDirection sets the "direction" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableDirection(s *string) *QuizQuestionUpdateOne {
+	if s != nil {
+		qquo.SetDirection(*s)
+	}
+	return qquo
+}
+
+// SetQuestionType sets the "question_type" field.
+func (qquo *QuizQuestionUpdateOne) SetQuestionType(s string) *QuizQuestionUpdateOne {
+	qquo.mutation.SetQuestionType(s)
+	return qquo
+}
+
+// SetNillableQuestionType sets the "question_type" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableQuestionType(s *string) *QuizQuestionUpdateOne {
+	if s != nil {
+		qquo.SetQuestionType(*s)
+	}
+	return qquo
+}
+
+// SetMeaningName sets the "meaning_name" field.
+func (qquo *QuizQuestionUpdateOne) SetMeaningName(s string) *QuizQuestionUpdateOne {
+	qquo.mutation.SetMeaningName(s)
+	return qquo
+}
+
+// SetNillableMeaningName sets the "meaning_name" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableMeaningName(s *string) *QuizQuestionUpdateOne {
+	if s != nil {
+		qquo.SetMeaningName(*s)
+	}
+	return qquo
+}
+
+// ClearMeaningName clears the value of the "meaning_name" field.
+func (qquo *QuizQuestionUpdateOne) ClearMeaningName() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearMeaningName()
+	return qquo
+}
+
 // SetChoicesJpms sets the "choices_jpms" field.
 func (qquo *QuizQuestionUpdateOne) SetChoicesJpms(mj []models.ChoiceJpm) *QuizQuestionUpdateOne {
 	qquo.mutation.SetChoicesJpms(mj)
@@ -671,6 +990,24 @@ func (qquo *QuizQuestionUpdateOne) AppendChoicesJpms(mj []models.ChoiceJpm) *Qui
 	return qquo
 }
 
+// SetChoicesWords sets the "choices_words" field.
+func (qquo *QuizQuestionUpdateOne) SetChoicesWords(mw []models.ChoiceWord) *QuizQuestionUpdateOne {
+	qquo.mutation.SetChoicesWords(mw)
+	return qquo
+}
+
+// AppendChoicesWords appends mw to the "choices_words" field.
+func (qquo *QuizQuestionUpdateOne) AppendChoicesWords(mw []models.ChoiceWord) *QuizQuestionUpdateOne {
+	qquo.mutation.AppendChoicesWords(mw)
+	return qquo
+}
+
+// ClearChoicesWords clears the value of the "choices_words" field.
+func (qquo *QuizQuestionUpdateOne) ClearChoicesWords() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearChoicesWords()
+	return qquo
+}
+
 // SetAnswerJpmID sets the "answer_jpm_id" field.
 func (qquo *QuizQuestionUpdateOne) SetAnswerJpmID(i int) *QuizQuestionUpdateOne {
 	qquo.mutation.ResetAnswerJpmID()
@@ -698,6 +1035,94 @@ func (qquo *QuizQuestionUpdateOne) ClearAnswerJpmID() *QuizQuestionUpdateOne {
 	return qquo
 }
 
+// SetAnswerWordID sets the "answer_word_id" field.
+func (qquo *QuizQuestionUpdateOne) SetAnswerWordID(i int) *QuizQuestionUpdateOne {
+	qquo.mutation.ResetAnswerWordID()
+	qquo.mutation.SetAnswerWordID(i)
+	return qquo
+}
+
+// SetNillableAnswerWordID sets the "answer_word_id" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableAnswerWordID(i *int) *QuizQuestionUpdateOne {
+	if i != nil {
+		qquo.SetAnswerWordID(*i)
+	}
+	return qquo
+}
+
+// AddAnswerWordID adds i to the "answer_word_id" field.
+func (qquo *QuizQuestionUpdateOne) AddAnswerWordID(i int) *QuizQuestionUpdateOne {
+	qquo.mutation.AddAnswerWordID(i)
+	return qquo
+}
+
+// ClearAnswerWordID clears the value of the "answer_word_id" field.
+func (qquo *QuizQuestionUpdateOne) ClearAnswerWordID() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearAnswerWordID()
+	return qquo
+}
+
+// SetTypedAnswer sets the "typed_answer" field.
+func (qquo *QuizQuestionUpdateOne) SetTypedAnswer(s string) *QuizQuestionUpdateOne {
+	qquo.mutation.SetTypedAnswer(s)
+	return qquo
+}
+
+// SetNillableTypedAnswer sets the "typed_answer" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableTypedAnswer(s *string) *QuizQuestionUpdateOne {
+	if s != nil {
+		qquo.SetTypedAnswer(*s)
+	}
+	return qquo
+}
+
+// ClearTypedAnswer clears the value of the "typed_answer" field.
+func (qquo *QuizQuestionUpdateOne) ClearTypedAnswer() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearTypedAnswer()
+	return qquo
+}
+
+// SetGrade sets the "grade" field.
+func (qquo *QuizQuestionUpdateOne) SetGrade(s string) *QuizQuestionUpdateOne {
+	qquo.mutation.SetGrade(s)
+	return qquo
+}
+
+// SetNillableGrade sets the "grade" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableGrade(s *string) *QuizQuestionUpdateOne {
+	if s != nil {
+		qquo.SetGrade(*s)
+	}
+	return qquo
+}
+
+// ClearGrade clears the value of the "grade" field.
+func (qquo *QuizQuestionUpdateOne) ClearGrade() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearGrade()
+	return qquo
+}
+
+// SetCredit sets the "credit" field.
+func (qquo *QuizQuestionUpdateOne) SetCredit(f float64) *QuizQuestionUpdateOne {
+	qquo.mutation.ResetCredit()
+	qquo.mutation.SetCredit(f)
+	return qquo
+}
+
+// SetNillableCredit sets the "credit" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableCredit(f *float64) *QuizQuestionUpdateOne {
+	if f != nil {
+		qquo.SetCredit(*f)
+	}
+	return qquo
+}
+
+// AddCredit adds f to the "credit" field.
+func (qquo *QuizQuestionUpdateOne) AddCredit(f float64) *QuizQuestionUpdateOne {
+	qquo.mutation.AddCredit(f)
+	return qquo
+}
+
 // SetIsCorrect sets the "is_correct" field.
 func (qquo *QuizQuestionUpdateOne) SetIsCorrect(b bool) *QuizQuestionUpdateOne {
 	qquo.mutation.SetIsCorrect(b)
@@ -718,6 +1143,26 @@ func (qquo *QuizQuestionUpdateOne) ClearIsCorrect() *QuizQuestionUpdateOne {
 	return qquo
 }
 
+// SetServedAt sets the "served_at" field.
+func (qquo *QuizQuestionUpdateOne) SetServedAt(t time.Time) *QuizQuestionUpdateOne {
+	qquo.mutation.SetServedAt(t)
+	return qquo
+}
+
+// SetNillableServedAt sets the "served_at" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableServedAt(t *time.Time) *QuizQuestionUpdateOne {
+	if t != nil {
+		qquo.SetServedAt(*t)
+	}
+	return qquo
+}
+
+// ClearServedAt clears the value of the "served_at" field.
+func (qquo *QuizQuestionUpdateOne) ClearServedAt() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearServedAt()
+	return qquo
+}
+
 // SetAnsweredAt sets the "answered_at" field.
 func (qquo *QuizQuestionUpdateOne) SetAnsweredAt(t time.Time) *QuizQuestionUpdateOne {
 	qquo.mutation.SetAnsweredAt(t)
@@ -738,6 +1183,26 @@ func (qquo *QuizQuestionUpdateOne) ClearAnsweredAt() *QuizQuestionUpdateOne {
 	return qquo
 }
 
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (qquo *QuizQuestionUpdateOne) SetIdempotencyKey(s string) *QuizQuestionUpdateOne {
+	qquo.mutation.SetIdempotencyKey(s)
+	return qquo
+}
+
+// SetNillableIdempotencyKey sets the "idempotency_key" field if the given value is not nil.
+func (qquo *QuizQuestionUpdateOne) SetNillableIdempotencyKey(s *string) *QuizQuestionUpdateOne {
+	if s != nil {
+		qquo.SetIdempotencyKey(*s)
+	}
+	return qquo
+}
+
+// ClearIdempotencyKey clears the value of the "idempotency_key" field.
+func (qquo *QuizQuestionUpdateOne) ClearIdempotencyKey() *QuizQuestionUpdateOne {
+	qquo.mutation.ClearIdempotencyKey()
+	return qquo
+}
+
 // SetTimeMs sets the "time_ms" field.
 func (qquo *QuizQuestionUpdateOne) SetTimeMs(i int) *QuizQuestionUpdateOne {
 	qquo.mutation.ResetTimeMs()
@@ -900,6 +1365,21 @@ func (qquo *QuizQuestionUpdateOne) check() error {
 			return &ValidationError{Name: "pos_id", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.pos_id": %w`, err)}
 		}
 	}
+	if v, ok := qquo.mutation.Direction(); ok {
+		if err := quizquestion.DirectionValidator(v); err != nil {
+			return &ValidationError{Name: "direction", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.direction": %w`, err)}
+		}
+	}
+	if v, ok := qquo.mutation.QuestionType(); ok {
+		if err := quizquestion.QuestionTypeValidator(v); err != nil {
+			return &ValidationError{Name: "question_type", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.question_type": %w`, err)}
+		}
+	}
+	if v, ok := qquo.mutation.IdempotencyKey(); ok {
+		if err := quizquestion.IdempotencyKeyValidator(v); err != nil {
+			return &ValidationError{Name: "idempotency_key", err: fmt.Errorf(`ent: validator failed for field "QuizQuestion.idempotency_key": %w`, err)}
+		}
+	}
 	if qquo.mutation.QuizCleared() && len(qquo.mutation.QuizIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "QuizQuestion.quiz"`)
 	}
@@ -962,6 +1442,18 @@ func (qquo *QuizQuestionUpdateOne) sqlSave(ctx context.Context) (_node *QuizQues
 	if value, ok := qquo.mutation.AddedPosID(); ok {
 		_spec.AddField(quizquestion.FieldPosID, field.TypeInt, value)
 	}
+	if value, ok := qquo.mutation.Direction(); ok {
+		_spec.SetField(quizquestion.FieldDirection, field.TypeString, value)
+	}
+	if value, ok := qquo.mutation.QuestionType(); ok {
+		_spec.SetField(quizquestion.FieldQuestionType, field.TypeString, value)
+	}
+	if value, ok := qquo.mutation.MeaningName(); ok {
+		_spec.SetField(quizquestion.FieldMeaningName, field.TypeString, value)
+	}
+	if qquo.mutation.MeaningNameCleared() {
+		_spec.ClearField(quizquestion.FieldMeaningName, field.TypeString)
+	}
 	if value, ok := qquo.mutation.ChoicesJpms(); ok {
 		_spec.SetField(quizquestion.FieldChoicesJpms, field.TypeJSON, value)
 	}
@@ -970,6 +1462,17 @@ func (qquo *QuizQuestionUpdateOne) sqlSave(ctx context.Context) (_node *QuizQues
 			sqljson.Append(u, quizquestion.FieldChoicesJpms, value)
 		})
 	}
+	if value, ok := qquo.mutation.ChoicesWords(); ok {
+		_spec.SetField(quizquestion.FieldChoicesWords, field.TypeJSON, value)
+	}
+	if value, ok := qquo.mutation.AppendedChoicesWords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, quizquestion.FieldChoicesWords, value)
+		})
+	}
+	if qquo.mutation.ChoicesWordsCleared() {
+		_spec.ClearField(quizquestion.FieldChoicesWords, field.TypeJSON)
+	}
 	if value, ok := qquo.mutation.AnswerJpmID(); ok {
 		_spec.SetField(quizquestion.FieldAnswerJpmID, field.TypeInt, value)
 	}
@@ -979,18 +1482,57 @@ func (qquo *QuizQuestionUpdateOne) sqlSave(ctx context.Context) (_node *QuizQues
 	if qquo.mutation.AnswerJpmIDCleared() {
 		_spec.ClearField(quizquestion.FieldAnswerJpmID, field.TypeInt)
 	}
+	if value, ok := qquo.mutation.AnswerWordID(); ok {
+		_spec.SetField(quizquestion.FieldAnswerWordID, field.TypeInt, value)
+	}
+	if value, ok := qquo.mutation.AddedAnswerWordID(); ok {
+		_spec.AddField(quizquestion.FieldAnswerWordID, field.TypeInt, value)
+	}
+	if qquo.mutation.AnswerWordIDCleared() {
+		_spec.ClearField(quizquestion.FieldAnswerWordID, field.TypeInt)
+	}
+	if value, ok := qquo.mutation.TypedAnswer(); ok {
+		_spec.SetField(quizquestion.FieldTypedAnswer, field.TypeString, value)
+	}
+	if qquo.mutation.TypedAnswerCleared() {
+		_spec.ClearField(quizquestion.FieldTypedAnswer, field.TypeString)
+	}
+	if value, ok := qquo.mutation.Grade(); ok {
+		_spec.SetField(quizquestion.FieldGrade, field.TypeString, value)
+	}
+	if qquo.mutation.GradeCleared() {
+		_spec.ClearField(quizquestion.FieldGrade, field.TypeString)
+	}
+	if value, ok := qquo.mutation.Credit(); ok {
+		_spec.SetField(quizquestion.FieldCredit, field.TypeFloat64, value)
+	}
+	if value, ok := qquo.mutation.AddedCredit(); ok {
+		_spec.AddField(quizquestion.FieldCredit, field.TypeFloat64, value)
+	}
 	if value, ok := qquo.mutation.IsCorrect(); ok {
 		_spec.SetField(quizquestion.FieldIsCorrect, field.TypeBool, value)
 	}
 	if qquo.mutation.IsCorrectCleared() {
 		_spec.ClearField(quizquestion.FieldIsCorrect, field.TypeBool)
 	}
+	if value, ok := qquo.mutation.ServedAt(); ok {
+		_spec.SetField(quizquestion.FieldServedAt, field.TypeTime, value)
+	}
+	if qquo.mutation.ServedAtCleared() {
+		_spec.ClearField(quizquestion.FieldServedAt, field.TypeTime)
+	}
 	if value, ok := qquo.mutation.AnsweredAt(); ok {
 		_spec.SetField(quizquestion.FieldAnsweredAt, field.TypeTime, value)
 	}
 	if qquo.mutation.AnsweredAtCleared() {
 		_spec.ClearField(quizquestion.FieldAnsweredAt, field.TypeTime)
 	}
+	if value, ok := qquo.mutation.IdempotencyKey(); ok {
+		_spec.SetField(quizquestion.FieldIdempotencyKey, field.TypeString, value)
+	}
+	if qquo.mutation.IdempotencyKeyCleared() {
+		_spec.ClearField(quizquestion.FieldIdempotencyKey, field.TypeString)
+	}
 	if value, ok := qquo.mutation.TimeMs(); ok {
 		_spec.SetField(quizquestion.FieldTimeMs, field.TypeInt, value)
 	}
@@ -33,6 +33,22 @@ type RegisteredWord struct {
 	CorrectCount int `json:"correct_count,omitempty"`
 	// CorrectRate holds the value of the "correct_rate" field.
 	CorrectRate int `json:"correct_rate,omitempty"`
+	// SM-2 ease factor
+	EaseFactor float64 `json:"ease_factor,omitempty"`
+	// IntervalDays holds the value of the "interval_days" field.
+	IntervalDays int `json:"interval_days,omitempty"`
+	// 連続正解回数（失敗で 0 に戻る）
+	Repetitions int `json:"repetitions,omitempty"`
+	// Lapses holds the value of the "lapses" field.
+	Lapses int `json:"lapses,omitempty"`
+	// FSRS stability (days)
+	Stability float64 `json:"stability,omitempty"`
+	// FSRS difficulty (1-10)
+	Difficulty float64 `json:"difficulty,omitempty"`
+	// 次回復習期限。nil は未スケジュール
+	DueAt *time.Time `json:"due_at,omitempty"`
+	// LastReviewedAt holds the value of the "last_reviewed_at" field.
+	LastReviewedAt *time.Time `json:"last_reviewed_at,omitempty"`
 	// Memo holds the value of the "memo" field.
 	Memo *string `json:"memo,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -96,11 +112,13 @@ func (*RegisteredWord) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case registeredword.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case registeredword.FieldID, registeredword.FieldUserID, registeredword.FieldWordID, registeredword.FieldAttentionLevel, registeredword.FieldQuizCount, registeredword.FieldCorrectCount, registeredword.FieldCorrectRate:
+		case registeredword.FieldEaseFactor, registeredword.FieldStability, registeredword.FieldDifficulty:
+			values[i] = new(sql.NullFloat64)
+		case registeredword.FieldID, registeredword.FieldUserID, registeredword.FieldWordID, registeredword.FieldAttentionLevel, registeredword.FieldQuizCount, registeredword.FieldCorrectCount, registeredword.FieldCorrectRate, registeredword.FieldIntervalDays, registeredword.FieldRepetitions, registeredword.FieldLapses:
 			values[i] = new(sql.NullInt64)
 		case registeredword.FieldMemo:
 			values[i] = new(sql.NullString)
-		case registeredword.FieldCreatedAt, registeredword.FieldUpdatedAt:
+		case registeredword.FieldDueAt, registeredword.FieldLastReviewedAt, registeredword.FieldCreatedAt, registeredword.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -165,6 +183,56 @@ func (rw *RegisteredWord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				rw.CorrectRate = int(value.Int64)
 			}
+		case registeredword.FieldEaseFactor:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ease_factor", values[i])
+			} else if value.Valid {
+				rw.EaseFactor = value.Float64
+			}
+		case registeredword.FieldIntervalDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field interval_days", values[i])
+			} else if value.Valid {
+				rw.IntervalDays = int(value.Int64)
+			}
+		case registeredword.FieldRepetitions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field repetitions", values[i])
+			} else if value.Valid {
+				rw.Repetitions = int(value.Int64)
+			}
+		case registeredword.FieldLapses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lapses", values[i])
+			} else if value.Valid {
+				rw.Lapses = int(value.Int64)
+			}
+		case registeredword.FieldStability:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field stability", values[i])
+			} else if value.Valid {
+				rw.Stability = value.Float64
+			}
+		case registeredword.FieldDifficulty:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field difficulty", values[i])
+			} else if value.Valid {
+				rw.Difficulty = value.Float64
+			}
+		case registeredword.FieldDueAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field due_at", values[i])
+			} else if value.Valid {
+				rw.DueAt = new(time.Time)
+				*rw.DueAt = value.Time
+			}
+		case registeredword.FieldLastReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_reviewed_at", values[i])
+			} else if value.Valid {
+				rw.LastReviewedAt = new(time.Time)
+				*rw.LastReviewedAt = value.Time
+			}
 		case registeredword.FieldMemo:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field memo", values[i])
@@ -256,6 +324,34 @@ func (rw *RegisteredWord) String() string {
 	builder.WriteString("correct_rate=")
 	builder.WriteString(fmt.Sprintf("%v", rw.CorrectRate))
 	builder.WriteString(", ")
+	builder.WriteString("ease_factor=")
+	builder.WriteString(fmt.Sprintf("%v", rw.EaseFactor))
+	builder.WriteString(", ")
+	builder.WriteString("interval_days=")
+	builder.WriteString(fmt.Sprintf("%v", rw.IntervalDays))
+	builder.WriteString(", ")
+	builder.WriteString("repetitions=")
+	builder.WriteString(fmt.Sprintf("%v", rw.Repetitions))
+	builder.WriteString(", ")
+	builder.WriteString("lapses=")
+	builder.WriteString(fmt.Sprintf("%v", rw.Lapses))
+	builder.WriteString(", ")
+	builder.WriteString("stability=")
+	builder.WriteString(fmt.Sprintf("%v", rw.Stability))
+	builder.WriteString(", ")
+	builder.WriteString("difficulty=")
+	builder.WriteString(fmt.Sprintf("%v", rw.Difficulty))
+	builder.WriteString(", ")
+	if v := rw.DueAt; v != nil {
+		builder.WriteString("due_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := rw.LastReviewedAt; v != nil {
+		builder.WriteString("last_reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	if v := rw.Memo; v != nil {
 		builder.WriteString("memo=")
 		builder.WriteString(*v)
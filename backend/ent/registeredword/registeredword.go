@@ -28,6 +28,22 @@ const (
 	FieldCorrectCount = "correct_count"
 	// FieldCorrectRate holds the string denoting the correct_rate field in the database.
 	FieldCorrectRate = "correct_rate"
+	// FieldEaseFactor holds the string denoting the ease_factor field in the database.
+	FieldEaseFactor = "ease_factor"
+	// FieldIntervalDays holds the string denoting the interval_days field in the database.
+	FieldIntervalDays = "interval_days"
+	// FieldRepetitions holds the string denoting the repetitions field in the database.
+	FieldRepetitions = "repetitions"
+	// FieldLapses holds the string denoting the lapses field in the database.
+	FieldLapses = "lapses"
+	// FieldStability holds the string denoting the stability field in the database.
+	FieldStability = "stability"
+	// FieldDifficulty holds the string denoting the difficulty field in the database.
+	FieldDifficulty = "difficulty"
+	// FieldDueAt holds the string denoting the due_at field in the database.
+	FieldDueAt = "due_at"
+	// FieldLastReviewedAt holds the string denoting the last_reviewed_at field in the database.
+	FieldLastReviewedAt = "last_reviewed_at"
 	// FieldMemo holds the string denoting the memo field in the database.
 	FieldMemo = "memo"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -75,6 +91,14 @@ var Columns = []string{
 	FieldQuizCount,
 	FieldCorrectCount,
 	FieldCorrectRate,
+	FieldEaseFactor,
+	FieldIntervalDays,
+	FieldRepetitions,
+	FieldLapses,
+	FieldStability,
+	FieldDifficulty,
+	FieldDueAt,
+	FieldLastReviewedAt,
 	FieldMemo,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -105,6 +129,18 @@ var (
 	DefaultCorrectCount int
 	// DefaultCorrectRate holds the default value on creation for the "correct_rate" field.
 	DefaultCorrectRate int
+	// DefaultEaseFactor holds the default value on creation for the "ease_factor" field.
+	DefaultEaseFactor float64
+	// DefaultIntervalDays holds the default value on creation for the "interval_days" field.
+	DefaultIntervalDays int
+	// DefaultRepetitions holds the default value on creation for the "repetitions" field.
+	DefaultRepetitions int
+	// DefaultLapses holds the default value on creation for the "lapses" field.
+	DefaultLapses int
+	// DefaultStability holds the default value on creation for the "stability" field.
+	DefaultStability float64
+	// DefaultDifficulty holds the default value on creation for the "difficulty" field.
+	DefaultDifficulty float64
 	// MemoValidator is a validator for the "memo" field. It is called by the builders before save.
 	MemoValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -158,6 +194,46 @@ func ByCorrectRate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCorrectRate, opts...).ToFunc()
 }
 
+// ByEaseFactor orders the results by the ease_factor field.
+func ByEaseFactor(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEaseFactor, opts...).ToFunc()
+}
+
+// ByIntervalDays orders the results by the interval_days field.
+func ByIntervalDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIntervalDays, opts...).ToFunc()
+}
+
+// ByRepetitions orders the results by the repetitions field.
+func ByRepetitions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRepetitions, opts...).ToFunc()
+}
+
+// ByLapses orders the results by the lapses field.
+func ByLapses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLapses, opts...).ToFunc()
+}
+
+// ByStability orders the results by the stability field.
+func ByStability(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStability, opts...).ToFunc()
+}
+
+// ByDifficulty orders the results by the difficulty field.
+func ByDifficulty(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDifficulty, opts...).ToFunc()
+}
+
+// ByDueAt orders the results by the due_at field.
+func ByDueAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDueAt, opts...).ToFunc()
+}
+
+// ByLastReviewedAt orders the results by the last_reviewed_at field.
+func ByLastReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastReviewedAt, opts...).ToFunc()
+}
+
 // ByMemo orders the results by the memo field.
 func ByMemo(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMemo, opts...).ToFunc()
@@ -90,6 +90,46 @@ func CorrectRate(v int) predicate.RegisteredWord {
 	return predicate.RegisteredWord(sql.FieldEQ(FieldCorrectRate, v))
 }
 
+// EaseFactor applies equality check predicate on the "ease_factor" field. It's identical to EaseFactorEQ.
+func EaseFactor(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldEaseFactor, v))
+}
+
+// IntervalDays applies equality check predicate on the "interval_days" field. It's identical to IntervalDaysEQ.
+func IntervalDays(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldIntervalDays, v))
+}
+
+// Repetitions applies equality check predicate on the "repetitions" field. It's identical to RepetitionsEQ.
+func Repetitions(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldRepetitions, v))
+}
+
+// Lapses applies equality check predicate on the "lapses" field. It's identical to LapsesEQ.
+func Lapses(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldLapses, v))
+}
+
+// Stability applies equality check predicate on the "stability" field. It's identical to StabilityEQ.
+func Stability(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldStability, v))
+}
+
+// Difficulty applies equality check predicate on the "difficulty" field. It's identical to DifficultyEQ.
+func Difficulty(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// DueAt applies equality check predicate on the "due_at" field. It's identical to DueAtEQ.
+func DueAt(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldDueAt, v))
+}
+
+// LastReviewedAt applies equality check predicate on the "last_reviewed_at" field. It's identical to LastReviewedAtEQ.
+func LastReviewedAt(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldLastReviewedAt, v))
+}
+
 // Memo applies equality check predicate on the "memo" field. It's identical to MemoEQ.
 func Memo(v string) predicate.RegisteredWord {
 	return predicate.RegisteredWord(sql.FieldEQ(FieldMemo, v))
@@ -315,6 +355,346 @@ func CorrectRateLTE(v int) predicate.RegisteredWord {
 	return predicate.RegisteredWord(sql.FieldLTE(FieldCorrectRate, v))
 }
 
+// EaseFactorEQ applies the EQ predicate on the "ease_factor" field.
+func EaseFactorEQ(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldEaseFactor, v))
+}
+
+// EaseFactorNEQ applies the NEQ predicate on the "ease_factor" field.
+func EaseFactorNEQ(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldEaseFactor, v))
+}
+
+// EaseFactorIn applies the In predicate on the "ease_factor" field.
+func EaseFactorIn(vs ...float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldEaseFactor, vs...))
+}
+
+// EaseFactorNotIn applies the NotIn predicate on the "ease_factor" field.
+func EaseFactorNotIn(vs ...float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldEaseFactor, vs...))
+}
+
+// EaseFactorGT applies the GT predicate on the "ease_factor" field.
+func EaseFactorGT(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldEaseFactor, v))
+}
+
+// EaseFactorGTE applies the GTE predicate on the "ease_factor" field.
+func EaseFactorGTE(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldEaseFactor, v))
+}
+
+// EaseFactorLT applies the LT predicate on the "ease_factor" field.
+func EaseFactorLT(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldEaseFactor, v))
+}
+
+// EaseFactorLTE applies the LTE predicate on the "ease_factor" field.
+func EaseFactorLTE(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldEaseFactor, v))
+}
+
+// IntervalDaysEQ applies the EQ predicate on the "interval_days" field.
+func IntervalDaysEQ(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldIntervalDays, v))
+}
+
+// IntervalDaysNEQ applies the NEQ predicate on the "interval_days" field.
+func IntervalDaysNEQ(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldIntervalDays, v))
+}
+
+// IntervalDaysIn applies the In predicate on the "interval_days" field.
+func IntervalDaysIn(vs ...int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldIntervalDays, vs...))
+}
+
+// IntervalDaysNotIn applies the NotIn predicate on the "interval_days" field.
+func IntervalDaysNotIn(vs ...int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldIntervalDays, vs...))
+}
+
+// IntervalDaysGT applies the GT predicate on the "interval_days" field.
+func IntervalDaysGT(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldIntervalDays, v))
+}
+
+// IntervalDaysGTE applies the GTE predicate on the "interval_days" field.
+func IntervalDaysGTE(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldIntervalDays, v))
+}
+
+// IntervalDaysLT applies the LT predicate on the "interval_days" field.
+func IntervalDaysLT(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldIntervalDays, v))
+}
+
+// IntervalDaysLTE applies the LTE predicate on the "interval_days" field.
+func IntervalDaysLTE(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldIntervalDays, v))
+}
+
+// RepetitionsEQ applies the EQ predicate on the "repetitions" field.
+func RepetitionsEQ(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldRepetitions, v))
+}
+
+// RepetitionsNEQ applies the NEQ predicate on the "repetitions" field.
+func RepetitionsNEQ(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldRepetitions, v))
+}
+
+// RepetitionsIn applies the In predicate on the "repetitions" field.
+func RepetitionsIn(vs ...int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldRepetitions, vs...))
+}
+
+// RepetitionsNotIn applies the NotIn predicate on the "repetitions" field.
+func RepetitionsNotIn(vs ...int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldRepetitions, vs...))
+}
+
+// RepetitionsGT applies the GT predicate on the "repetitions" field.
+func RepetitionsGT(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldRepetitions, v))
+}
+
+// RepetitionsGTE applies the GTE predicate on the "repetitions" field.
+func RepetitionsGTE(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldRepetitions, v))
+}
+
+// RepetitionsLT applies the LT predicate on the "repetitions" field.
+func RepetitionsLT(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldRepetitions, v))
+}
+
+// RepetitionsLTE applies the LTE predicate on the "repetitions" field.
+func RepetitionsLTE(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldRepetitions, v))
+}
+
+// LapsesEQ applies the EQ predicate on the "lapses" field.
+func LapsesEQ(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldLapses, v))
+}
+
+// LapsesNEQ applies the NEQ predicate on the "lapses" field.
+func LapsesNEQ(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldLapses, v))
+}
+
+// LapsesIn applies the In predicate on the "lapses" field.
+func LapsesIn(vs ...int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldLapses, vs...))
+}
+
+// LapsesNotIn applies the NotIn predicate on the "lapses" field.
+func LapsesNotIn(vs ...int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldLapses, vs...))
+}
+
+// LapsesGT applies the GT predicate on the "lapses" field.
+func LapsesGT(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldLapses, v))
+}
+
+// LapsesGTE applies the GTE predicate on the "lapses" field.
+func LapsesGTE(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldLapses, v))
+}
+
+// LapsesLT applies the LT predicate on the "lapses" field.
+func LapsesLT(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldLapses, v))
+}
+
+// LapsesLTE applies the LTE predicate on the "lapses" field.
+func LapsesLTE(v int) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldLapses, v))
+}
+
+// StabilityEQ applies the EQ predicate on the "stability" field.
+func StabilityEQ(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldStability, v))
+}
+
+// StabilityNEQ applies the NEQ predicate on the "stability" field.
+func StabilityNEQ(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldStability, v))
+}
+
+// StabilityIn applies the In predicate on the "stability" field.
+func StabilityIn(vs ...float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldStability, vs...))
+}
+
+// StabilityNotIn applies the NotIn predicate on the "stability" field.
+func StabilityNotIn(vs ...float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldStability, vs...))
+}
+
+// StabilityGT applies the GT predicate on the "stability" field.
+func StabilityGT(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldStability, v))
+}
+
+// StabilityGTE applies the GTE predicate on the "stability" field.
+func StabilityGTE(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldStability, v))
+}
+
+// StabilityLT applies the LT predicate on the "stability" field.
+func StabilityLT(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldStability, v))
+}
+
+// StabilityLTE applies the LTE predicate on the "stability" field.
+func StabilityLTE(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldStability, v))
+}
+
+// DifficultyEQ applies the EQ predicate on the "difficulty" field.
+func DifficultyEQ(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldDifficulty, v))
+}
+
+// DifficultyNEQ applies the NEQ predicate on the "difficulty" field.
+func DifficultyNEQ(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldDifficulty, v))
+}
+
+// DifficultyIn applies the In predicate on the "difficulty" field.
+func DifficultyIn(vs ...float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldDifficulty, vs...))
+}
+
+// DifficultyNotIn applies the NotIn predicate on the "difficulty" field.
+func DifficultyNotIn(vs ...float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldDifficulty, vs...))
+}
+
+// DifficultyGT applies the GT predicate on the "difficulty" field.
+func DifficultyGT(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldDifficulty, v))
+}
+
+// DifficultyGTE applies the GTE predicate on the "difficulty" field.
+func DifficultyGTE(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldDifficulty, v))
+}
+
+// DifficultyLT applies the LT predicate on the "difficulty" field.
+func DifficultyLT(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldDifficulty, v))
+}
+
+// DifficultyLTE applies the LTE predicate on the "difficulty" field.
+func DifficultyLTE(v float64) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldDifficulty, v))
+}
+
+// DueAtEQ applies the EQ predicate on the "due_at" field.
+func DueAtEQ(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldDueAt, v))
+}
+
+// DueAtNEQ applies the NEQ predicate on the "due_at" field.
+func DueAtNEQ(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldDueAt, v))
+}
+
+// DueAtIn applies the In predicate on the "due_at" field.
+func DueAtIn(vs ...time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldDueAt, vs...))
+}
+
+// DueAtNotIn applies the NotIn predicate on the "due_at" field.
+func DueAtNotIn(vs ...time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldDueAt, vs...))
+}
+
+// DueAtGT applies the GT predicate on the "due_at" field.
+func DueAtGT(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldDueAt, v))
+}
+
+// DueAtGTE applies the GTE predicate on the "due_at" field.
+func DueAtGTE(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldDueAt, v))
+}
+
+// DueAtLT applies the LT predicate on the "due_at" field.
+func DueAtLT(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldDueAt, v))
+}
+
+// DueAtLTE applies the LTE predicate on the "due_at" field.
+func DueAtLTE(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldDueAt, v))
+}
+
+// DueAtIsNil applies the IsNil predicate on the "due_at" field.
+func DueAtIsNil() predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIsNull(FieldDueAt))
+}
+
+// DueAtNotNil applies the NotNil predicate on the "due_at" field.
+func DueAtNotNil() predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotNull(FieldDueAt))
+}
+
+// LastReviewedAtEQ applies the EQ predicate on the "last_reviewed_at" field.
+func LastReviewedAtEQ(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldEQ(FieldLastReviewedAt, v))
+}
+
+// LastReviewedAtNEQ applies the NEQ predicate on the "last_reviewed_at" field.
+func LastReviewedAtNEQ(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNEQ(FieldLastReviewedAt, v))
+}
+
+// LastReviewedAtIn applies the In predicate on the "last_reviewed_at" field.
+func LastReviewedAtIn(vs ...time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIn(FieldLastReviewedAt, vs...))
+}
+
+// LastReviewedAtNotIn applies the NotIn predicate on the "last_reviewed_at" field.
+func LastReviewedAtNotIn(vs ...time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotIn(FieldLastReviewedAt, vs...))
+}
+
+// LastReviewedAtGT applies the GT predicate on the "last_reviewed_at" field.
+func LastReviewedAtGT(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGT(FieldLastReviewedAt, v))
+}
+
+// LastReviewedAtGTE applies the GTE predicate on the "last_reviewed_at" field.
+func LastReviewedAtGTE(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldGTE(FieldLastReviewedAt, v))
+}
+
+// LastReviewedAtLT applies the LT predicate on the "last_reviewed_at" field.
+func LastReviewedAtLT(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLT(FieldLastReviewedAt, v))
+}
+
+// LastReviewedAtLTE applies the LTE predicate on the "last_reviewed_at" field.
+func LastReviewedAtLTE(v time.Time) predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldLTE(FieldLastReviewedAt, v))
+}
+
+// LastReviewedAtIsNil applies the IsNil predicate on the "last_reviewed_at" field.
+func LastReviewedAtIsNil() predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldIsNull(FieldLastReviewedAt))
+}
+
+// LastReviewedAtNotNil applies the NotNil predicate on the "last_reviewed_at" field.
+func LastReviewedAtNotNil() predicate.RegisteredWord {
+	return predicate.RegisteredWord(sql.FieldNotNull(FieldLastReviewedAt))
+}
+
 // MemoEQ applies the EQ predicate on the "memo" field.
 func MemoEQ(v string) predicate.RegisteredWord {
 	return predicate.RegisteredWord(sql.FieldEQ(FieldMemo, v))
@@ -107,6 +107,118 @@ func (rwc *RegisteredWordCreate) SetNillableCorrectRate(i *int) *RegisteredWordC
 	return rwc
 }
 
+// SetEaseFactor sets the "ease_factor" field.
+func (rwc *RegisteredWordCreate) SetEaseFactor(f float64) *RegisteredWordCreate {
+	rwc.mutation.SetEaseFactor(f)
+	return rwc
+}
+
+// SetNillableEaseFactor sets the "ease_factor" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableEaseFactor(f *float64) *RegisteredWordCreate {
+	if f != nil {
+		rwc.SetEaseFactor(*f)
+	}
+	return rwc
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (rwc *RegisteredWordCreate) SetIntervalDays(i int) *RegisteredWordCreate {
+	rwc.mutation.SetIntervalDays(i)
+	return rwc
+}
+
+// SetNillableIntervalDays sets the "interval_days" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableIntervalDays(i *int) *RegisteredWordCreate {
+	if i != nil {
+		rwc.SetIntervalDays(*i)
+	}
+	return rwc
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (rwc *RegisteredWordCreate) SetRepetitions(i int) *RegisteredWordCreate {
+	rwc.mutation.SetRepetitions(i)
+	return rwc
+}
+
+// SetNillableRepetitions sets the "repetitions" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableRepetitions(i *int) *RegisteredWordCreate {
+	if i != nil {
+		rwc.SetRepetitions(*i)
+	}
+	return rwc
+}
+
+// SetLapses sets the "lapses" field.
+func (rwc *RegisteredWordCreate) SetLapses(i int) *RegisteredWordCreate {
+	rwc.mutation.SetLapses(i)
+	return rwc
+}
+
+// SetNillableLapses sets the "lapses" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableLapses(i *int) *RegisteredWordCreate {
+	if i != nil {
+		rwc.SetLapses(*i)
+	}
+	return rwc
+}
+
+// SetStability sets the "stability" field.
+func (rwc *RegisteredWordCreate) SetStability(f float64) *RegisteredWordCreate {
+	rwc.mutation.SetStability(f)
+	return rwc
+}
+
+// SetNillableStability sets the "stability" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableStability(f *float64) *RegisteredWordCreate {
+	if f != nil {
+		rwc.SetStability(*f)
+	}
+	return rwc
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (rwc *RegisteredWordCreate) SetDifficulty(f float64) *RegisteredWordCreate {
+	rwc.mutation.SetDifficulty(f)
+	return rwc
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableDifficulty(f *float64) *RegisteredWordCreate {
+	if f != nil {
+		rwc.SetDifficulty(*f)
+	}
+	return rwc
+}
+
+// SetDueAt sets the "due_at" field.
+func (rwc *RegisteredWordCreate) SetDueAt(t time.Time) *RegisteredWordCreate {
+	rwc.mutation.SetDueAt(t)
+	return rwc
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableDueAt(t *time.Time) *RegisteredWordCreate {
+	if t != nil {
+		rwc.SetDueAt(*t)
+	}
+	return rwc
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (rwc *RegisteredWordCreate) SetLastReviewedAt(t time.Time) *RegisteredWordCreate {
+	rwc.mutation.SetLastReviewedAt(t)
+	return rwc
+}
+
+// SetNillableLastReviewedAt sets the "last_reviewed_at" field if the given value is not nil.
+func (rwc *RegisteredWordCreate) SetNillableLastReviewedAt(t *time.Time) *RegisteredWordCreate {
+	if t != nil {
+		rwc.SetLastReviewedAt(*t)
+	}
+	return rwc
+}
+
 // SetMemo sets the "memo" field.
 func (rwc *RegisteredWordCreate) SetMemo(s string) *RegisteredWordCreate {
 	rwc.mutation.SetMemo(s)
@@ -229,6 +341,30 @@ func (rwc *RegisteredWordCreate) defaults() {
 		v := registeredword.DefaultCorrectRate
 		rwc.mutation.SetCorrectRate(v)
 	}
+	if _, ok := rwc.mutation.EaseFactor(); !ok {
+		v := registeredword.DefaultEaseFactor
+		rwc.mutation.SetEaseFactor(v)
+	}
+	if _, ok := rwc.mutation.IntervalDays(); !ok {
+		v := registeredword.DefaultIntervalDays
+		rwc.mutation.SetIntervalDays(v)
+	}
+	if _, ok := rwc.mutation.Repetitions(); !ok {
+		v := registeredword.DefaultRepetitions
+		rwc.mutation.SetRepetitions(v)
+	}
+	if _, ok := rwc.mutation.Lapses(); !ok {
+		v := registeredword.DefaultLapses
+		rwc.mutation.SetLapses(v)
+	}
+	if _, ok := rwc.mutation.Stability(); !ok {
+		v := registeredword.DefaultStability
+		rwc.mutation.SetStability(v)
+	}
+	if _, ok := rwc.mutation.Difficulty(); !ok {
+		v := registeredword.DefaultDifficulty
+		rwc.mutation.SetDifficulty(v)
+	}
 	if _, ok := rwc.mutation.CreatedAt(); !ok {
 		v := registeredword.DefaultCreatedAt()
 		rwc.mutation.SetCreatedAt(v)
@@ -272,6 +408,24 @@ func (rwc *RegisteredWordCreate) check() error {
 	if _, ok := rwc.mutation.CorrectRate(); !ok {
 		return &ValidationError{Name: "correct_rate", err: errors.New(`ent: missing required field "RegisteredWord.correct_rate"`)}
 	}
+	if _, ok := rwc.mutation.EaseFactor(); !ok {
+		return &ValidationError{Name: "ease_factor", err: errors.New(`ent: missing required field "RegisteredWord.ease_factor"`)}
+	}
+	if _, ok := rwc.mutation.IntervalDays(); !ok {
+		return &ValidationError{Name: "interval_days", err: errors.New(`ent: missing required field "RegisteredWord.interval_days"`)}
+	}
+	if _, ok := rwc.mutation.Repetitions(); !ok {
+		return &ValidationError{Name: "repetitions", err: errors.New(`ent: missing required field "RegisteredWord.repetitions"`)}
+	}
+	if _, ok := rwc.mutation.Lapses(); !ok {
+		return &ValidationError{Name: "lapses", err: errors.New(`ent: missing required field "RegisteredWord.lapses"`)}
+	}
+	if _, ok := rwc.mutation.Stability(); !ok {
+		return &ValidationError{Name: "stability", err: errors.New(`ent: missing required field "RegisteredWord.stability"`)}
+	}
+	if _, ok := rwc.mutation.Difficulty(); !ok {
+		return &ValidationError{Name: "difficulty", err: errors.New(`ent: missing required field "RegisteredWord.difficulty"`)}
+	}
 	if v, ok := rwc.mutation.Memo(); ok {
 		if err := registeredword.MemoValidator(v); err != nil {
 			return &ValidationError{Name: "memo", err: fmt.Errorf(`ent: validator failed for field "RegisteredWord.memo": %w`, err)}
@@ -336,6 +490,38 @@ func (rwc *RegisteredWordCreate) createSpec() (*RegisteredWord, *sqlgraph.Create
 		_spec.SetField(registeredword.FieldCorrectRate, field.TypeInt, value)
 		_node.CorrectRate = value
 	}
+	if value, ok := rwc.mutation.EaseFactor(); ok {
+		_spec.SetField(registeredword.FieldEaseFactor, field.TypeFloat64, value)
+		_node.EaseFactor = value
+	}
+	if value, ok := rwc.mutation.IntervalDays(); ok {
+		_spec.SetField(registeredword.FieldIntervalDays, field.TypeInt, value)
+		_node.IntervalDays = value
+	}
+	if value, ok := rwc.mutation.Repetitions(); ok {
+		_spec.SetField(registeredword.FieldRepetitions, field.TypeInt, value)
+		_node.Repetitions = value
+	}
+	if value, ok := rwc.mutation.Lapses(); ok {
+		_spec.SetField(registeredword.FieldLapses, field.TypeInt, value)
+		_node.Lapses = value
+	}
+	if value, ok := rwc.mutation.Stability(); ok {
+		_spec.SetField(registeredword.FieldStability, field.TypeFloat64, value)
+		_node.Stability = value
+	}
+	if value, ok := rwc.mutation.Difficulty(); ok {
+		_spec.SetField(registeredword.FieldDifficulty, field.TypeFloat64, value)
+		_node.Difficulty = value
+	}
+	if value, ok := rwc.mutation.DueAt(); ok {
+		_spec.SetField(registeredword.FieldDueAt, field.TypeTime, value)
+		_node.DueAt = &value
+	}
+	if value, ok := rwc.mutation.LastReviewedAt(); ok {
+		_spec.SetField(registeredword.FieldLastReviewedAt, field.TypeTime, value)
+		_node.LastReviewedAt = &value
+	}
 	if value, ok := rwc.mutation.Memo(); ok {
 		_spec.SetField(registeredword.FieldMemo, field.TypeString, value)
 		_node.Memo = &value
@@ -558,6 +744,150 @@ func (u *RegisteredWordUpsert) AddCorrectRate(v int) *RegisteredWordUpsert {
 	return u
 }
 
+// SetEaseFactor sets the "ease_factor" field.
+func (u *RegisteredWordUpsert) SetEaseFactor(v float64) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldEaseFactor, v)
+	return u
+}
+
+// UpdateEaseFactor sets the "ease_factor" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateEaseFactor() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldEaseFactor)
+	return u
+}
+
+// AddEaseFactor adds v to the "ease_factor" field.
+func (u *RegisteredWordUpsert) AddEaseFactor(v float64) *RegisteredWordUpsert {
+	u.Add(registeredword.FieldEaseFactor, v)
+	return u
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (u *RegisteredWordUpsert) SetIntervalDays(v int) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldIntervalDays, v)
+	return u
+}
+
+// UpdateIntervalDays sets the "interval_days" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateIntervalDays() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldIntervalDays)
+	return u
+}
+
+// AddIntervalDays adds v to the "interval_days" field.
+func (u *RegisteredWordUpsert) AddIntervalDays(v int) *RegisteredWordUpsert {
+	u.Add(registeredword.FieldIntervalDays, v)
+	return u
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (u *RegisteredWordUpsert) SetRepetitions(v int) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldRepetitions, v)
+	return u
+}
+
+// UpdateRepetitions sets the "repetitions" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateRepetitions() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldRepetitions)
+	return u
+}
+
+// AddRepetitions adds v to the "repetitions" field.
+func (u *RegisteredWordUpsert) AddRepetitions(v int) *RegisteredWordUpsert {
+	u.Add(registeredword.FieldRepetitions, v)
+	return u
+}
+
+// SetLapses sets the "lapses" field.
+func (u *RegisteredWordUpsert) SetLapses(v int) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldLapses, v)
+	return u
+}
+
+// UpdateLapses sets the "lapses" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateLapses() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldLapses)
+	return u
+}
+
+// AddLapses adds v to the "lapses" field.
+func (u *RegisteredWordUpsert) AddLapses(v int) *RegisteredWordUpsert {
+	u.Add(registeredword.FieldLapses, v)
+	return u
+}
+
+// SetStability sets the "stability" field.
+func (u *RegisteredWordUpsert) SetStability(v float64) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldStability, v)
+	return u
+}
+
+// UpdateStability sets the "stability" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateStability() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldStability)
+	return u
+}
+
+// AddStability adds v to the "stability" field.
+func (u *RegisteredWordUpsert) AddStability(v float64) *RegisteredWordUpsert {
+	u.Add(registeredword.FieldStability, v)
+	return u
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (u *RegisteredWordUpsert) SetDifficulty(v float64) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldDifficulty, v)
+	return u
+}
+
+// UpdateDifficulty sets the "difficulty" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateDifficulty() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldDifficulty)
+	return u
+}
+
+// AddDifficulty adds v to the "difficulty" field.
+func (u *RegisteredWordUpsert) AddDifficulty(v float64) *RegisteredWordUpsert {
+	u.Add(registeredword.FieldDifficulty, v)
+	return u
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *RegisteredWordUpsert) SetDueAt(v time.Time) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldDueAt, v)
+	return u
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateDueAt() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldDueAt)
+	return u
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *RegisteredWordUpsert) ClearDueAt() *RegisteredWordUpsert {
+	u.SetNull(registeredword.FieldDueAt)
+	return u
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (u *RegisteredWordUpsert) SetLastReviewedAt(v time.Time) *RegisteredWordUpsert {
+	u.Set(registeredword.FieldLastReviewedAt, v)
+	return u
+}
+
+// UpdateLastReviewedAt sets the "last_reviewed_at" field to the value that was provided on create.
+func (u *RegisteredWordUpsert) UpdateLastReviewedAt() *RegisteredWordUpsert {
+	u.SetExcluded(registeredword.FieldLastReviewedAt)
+	return u
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (u *RegisteredWordUpsert) ClearLastReviewedAt() *RegisteredWordUpsert {
+	u.SetNull(registeredword.FieldLastReviewedAt)
+	return u
+}
+
 // SetMemo sets the "memo" field.
 func (u *RegisteredWordUpsert) SetMemo(v string) *RegisteredWordUpsert {
 	u.Set(registeredword.FieldMemo, v)
@@ -766,6 +1096,174 @@ func (u *RegisteredWordUpsertOne) UpdateCorrectRate() *RegisteredWordUpsertOne {
 	})
 }
 
+// SetEaseFactor sets the "ease_factor" field.
+func (u *RegisteredWordUpsertOne) SetEaseFactor(v float64) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetEaseFactor(v)
+	})
+}
+
+// AddEaseFactor adds v to the "ease_factor" field.
+func (u *RegisteredWordUpsertOne) AddEaseFactor(v float64) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddEaseFactor(v)
+	})
+}
+
+// UpdateEaseFactor sets the "ease_factor" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateEaseFactor() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateEaseFactor()
+	})
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (u *RegisteredWordUpsertOne) SetIntervalDays(v int) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetIntervalDays(v)
+	})
+}
+
+// AddIntervalDays adds v to the "interval_days" field.
+func (u *RegisteredWordUpsertOne) AddIntervalDays(v int) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddIntervalDays(v)
+	})
+}
+
+// UpdateIntervalDays sets the "interval_days" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateIntervalDays() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateIntervalDays()
+	})
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (u *RegisteredWordUpsertOne) SetRepetitions(v int) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetRepetitions(v)
+	})
+}
+
+// AddRepetitions adds v to the "repetitions" field.
+func (u *RegisteredWordUpsertOne) AddRepetitions(v int) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddRepetitions(v)
+	})
+}
+
+// UpdateRepetitions sets the "repetitions" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateRepetitions() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateRepetitions()
+	})
+}
+
+// SetLapses sets the "lapses" field.
+func (u *RegisteredWordUpsertOne) SetLapses(v int) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetLapses(v)
+	})
+}
+
+// AddLapses adds v to the "lapses" field.
+func (u *RegisteredWordUpsertOne) AddLapses(v int) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddLapses(v)
+	})
+}
+
+// UpdateLapses sets the "lapses" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateLapses() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateLapses()
+	})
+}
+
+// SetStability sets the "stability" field.
+func (u *RegisteredWordUpsertOne) SetStability(v float64) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetStability(v)
+	})
+}
+
+// AddStability adds v to the "stability" field.
+func (u *RegisteredWordUpsertOne) AddStability(v float64) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddStability(v)
+	})
+}
+
+// UpdateStability sets the "stability" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateStability() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateStability()
+	})
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (u *RegisteredWordUpsertOne) SetDifficulty(v float64) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetDifficulty(v)
+	})
+}
+
+// AddDifficulty adds v to the "difficulty" field.
+func (u *RegisteredWordUpsertOne) AddDifficulty(v float64) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddDifficulty(v)
+	})
+}
+
+// UpdateDifficulty sets the "difficulty" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateDifficulty() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateDifficulty()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *RegisteredWordUpsertOne) SetDueAt(v time.Time) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateDueAt() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *RegisteredWordUpsertOne) ClearDueAt() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (u *RegisteredWordUpsertOne) SetLastReviewedAt(v time.Time) *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetLastReviewedAt(v)
+	})
+}
+
+// UpdateLastReviewedAt sets the "last_reviewed_at" field to the value that was provided on create.
+func (u *RegisteredWordUpsertOne) UpdateLastReviewedAt() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateLastReviewedAt()
+	})
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (u *RegisteredWordUpsertOne) ClearLastReviewedAt() *RegisteredWordUpsertOne {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.ClearLastReviewedAt()
+	})
+}
+
 // SetMemo sets the "memo" field.
 func (u *RegisteredWordUpsertOne) SetMemo(v string) *RegisteredWordUpsertOne {
 	return u.Update(func(s *RegisteredWordUpsert) {
@@ -1145,6 +1643,174 @@ func (u *RegisteredWordUpsertBulk) UpdateCorrectRate() *RegisteredWordUpsertBulk
 	})
 }
 
+// SetEaseFactor sets the "ease_factor" field.
+func (u *RegisteredWordUpsertBulk) SetEaseFactor(v float64) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetEaseFactor(v)
+	})
+}
+
+// AddEaseFactor adds v to the "ease_factor" field.
+func (u *RegisteredWordUpsertBulk) AddEaseFactor(v float64) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddEaseFactor(v)
+	})
+}
+
+// UpdateEaseFactor sets the "ease_factor" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateEaseFactor() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateEaseFactor()
+	})
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (u *RegisteredWordUpsertBulk) SetIntervalDays(v int) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetIntervalDays(v)
+	})
+}
+
+// AddIntervalDays adds v to the "interval_days" field.
+func (u *RegisteredWordUpsertBulk) AddIntervalDays(v int) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddIntervalDays(v)
+	})
+}
+
+// UpdateIntervalDays sets the "interval_days" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateIntervalDays() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateIntervalDays()
+	})
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (u *RegisteredWordUpsertBulk) SetRepetitions(v int) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetRepetitions(v)
+	})
+}
+
+// AddRepetitions adds v to the "repetitions" field.
+func (u *RegisteredWordUpsertBulk) AddRepetitions(v int) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddRepetitions(v)
+	})
+}
+
+// UpdateRepetitions sets the "repetitions" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateRepetitions() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateRepetitions()
+	})
+}
+
+// SetLapses sets the "lapses" field.
+func (u *RegisteredWordUpsertBulk) SetLapses(v int) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetLapses(v)
+	})
+}
+
+// AddLapses adds v to the "lapses" field.
+func (u *RegisteredWordUpsertBulk) AddLapses(v int) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddLapses(v)
+	})
+}
+
+// UpdateLapses sets the "lapses" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateLapses() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateLapses()
+	})
+}
+
+// SetStability sets the "stability" field.
+func (u *RegisteredWordUpsertBulk) SetStability(v float64) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetStability(v)
+	})
+}
+
+// AddStability adds v to the "stability" field.
+func (u *RegisteredWordUpsertBulk) AddStability(v float64) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddStability(v)
+	})
+}
+
+// UpdateStability sets the "stability" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateStability() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateStability()
+	})
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (u *RegisteredWordUpsertBulk) SetDifficulty(v float64) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetDifficulty(v)
+	})
+}
+
+// AddDifficulty adds v to the "difficulty" field.
+func (u *RegisteredWordUpsertBulk) AddDifficulty(v float64) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.AddDifficulty(v)
+	})
+}
+
+// UpdateDifficulty sets the "difficulty" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateDifficulty() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateDifficulty()
+	})
+}
+
+// SetDueAt sets the "due_at" field.
+func (u *RegisteredWordUpsertBulk) SetDueAt(v time.Time) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetDueAt(v)
+	})
+}
+
+// UpdateDueAt sets the "due_at" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateDueAt() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateDueAt()
+	})
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (u *RegisteredWordUpsertBulk) ClearDueAt() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.ClearDueAt()
+	})
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (u *RegisteredWordUpsertBulk) SetLastReviewedAt(v time.Time) *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.SetLastReviewedAt(v)
+	})
+}
+
+// UpdateLastReviewedAt sets the "last_reviewed_at" field to the value that was provided on create.
+func (u *RegisteredWordUpsertBulk) UpdateLastReviewedAt() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.UpdateLastReviewedAt()
+	})
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (u *RegisteredWordUpsertBulk) ClearLastReviewedAt() *RegisteredWordUpsertBulk {
+	return u.Update(func(s *RegisteredWordUpsert) {
+		s.ClearLastReviewedAt()
+	})
+}
+
 // SetMemo sets the "memo" field.
 func (u *RegisteredWordUpsertBulk) SetMemo(v string) *RegisteredWordUpsertBulk {
 	return u.Update(func(s *RegisteredWordUpsert) {
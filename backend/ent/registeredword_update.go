@@ -158,6 +158,172 @@ func (rwu *RegisteredWordUpdate) AddCorrectRate(i int) *RegisteredWordUpdate {
 	return rwu
 }
 
+// SetEaseFactor sets the "ease_factor" field.
+func (rwu *RegisteredWordUpdate) SetEaseFactor(f float64) *RegisteredWordUpdate {
+	rwu.mutation.ResetEaseFactor()
+	rwu.mutation.SetEaseFactor(f)
+	return rwu
+}
+
+// SetNillableEaseFactor sets the "ease_factor" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableEaseFactor(f *float64) *RegisteredWordUpdate {
+	if f != nil {
+		rwu.SetEaseFactor(*f)
+	}
+	return rwu
+}
+
+// AddEaseFactor adds f to the "ease_factor" field.
+func (rwu *RegisteredWordUpdate) AddEaseFactor(f float64) *RegisteredWordUpdate {
+	rwu.mutation.AddEaseFactor(f)
+	return rwu
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (rwu *RegisteredWordUpdate) SetIntervalDays(i int) *RegisteredWordUpdate {
+	rwu.mutation.ResetIntervalDays()
+	rwu.mutation.SetIntervalDays(i)
+	return rwu
+}
+
+// SetNillableIntervalDays sets the "interval_days" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableIntervalDays(i *int) *RegisteredWordUpdate {
+	if i != nil {
+		rwu.SetIntervalDays(*i)
+	}
+	return rwu
+}
+
+// AddIntervalDays adds i to the "interval_days" field.
+func (rwu *RegisteredWordUpdate) AddIntervalDays(i int) *RegisteredWordUpdate {
+	rwu.mutation.AddIntervalDays(i)
+	return rwu
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (rwu *RegisteredWordUpdate) SetRepetitions(i int) *RegisteredWordUpdate {
+	rwu.mutation.ResetRepetitions()
+	rwu.mutation.SetRepetitions(i)
+	return rwu
+}
+
+// SetNillableRepetitions sets the "repetitions" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableRepetitions(i *int) *RegisteredWordUpdate {
+	if i != nil {
+		rwu.SetRepetitions(*i)
+	}
+	return rwu
+}
+
+// AddRepetitions adds i to the "repetitions" field.
+func (rwu *RegisteredWordUpdate) AddRepetitions(i int) *RegisteredWordUpdate {
+	rwu.mutation.AddRepetitions(i)
+	return rwu
+}
+
+// SetLapses sets the "lapses" field.
+func (rwu *RegisteredWordUpdate) SetLapses(i int) *RegisteredWordUpdate {
+	rwu.mutation.ResetLapses()
+	rwu.mutation.SetLapses(i)
+	return rwu
+}
+
+// SetNillableLapses sets the "lapses" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableLapses(i *int) *RegisteredWordUpdate {
+	if i != nil {
+		rwu.SetLapses(*i)
+	}
+	return rwu
+}
+
+// AddLapses adds i to the "lapses" field.
+func (rwu *RegisteredWordUpdate) AddLapses(i int) *RegisteredWordUpdate {
+	rwu.mutation.AddLapses(i)
+	return rwu
+}
+
+// SetStability sets the "stability" field.
+func (rwu *RegisteredWordUpdate) SetStability(f float64) *RegisteredWordUpdate {
+	rwu.mutation.ResetStability()
+	rwu.mutation.SetStability(f)
+	return rwu
+}
+
+// SetNillableStability sets the "stability" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableStability(f *float64) *RegisteredWordUpdate {
+	if f != nil {
+		rwu.SetStability(*f)
+	}
+	return rwu
+}
+
+// AddStability adds f to the "stability" field.
+func (rwu *RegisteredWordUpdate) AddStability(f float64) *RegisteredWordUpdate {
+	rwu.mutation.AddStability(f)
+	return rwu
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (rwu *RegisteredWordUpdate) SetDifficulty(f float64) *RegisteredWordUpdate {
+	rwu.mutation.ResetDifficulty()
+	rwu.mutation.SetDifficulty(f)
+	return rwu
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableDifficulty(f *float64) *RegisteredWordUpdate {
+	if f != nil {
+		rwu.SetDifficulty(*f)
+	}
+	return rwu
+}
+
+// AddDifficulty adds f to the "difficulty" field.
+func (rwu *RegisteredWordUpdate) AddDifficulty(f float64) *RegisteredWordUpdate {
+	rwu.mutation.AddDifficulty(f)
+	return rwu
+}
+
+// SetDueAt sets the "due_at" field.
+func (rwu *RegisteredWordUpdate) SetDueAt(t time.Time) *RegisteredWordUpdate {
+	rwu.mutation.SetDueAt(t)
+	return rwu
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableDueAt(t *time.Time) *RegisteredWordUpdate {
+	if t != nil {
+		rwu.SetDueAt(*t)
+	}
+	return rwu
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (rwu *RegisteredWordUpdate) ClearDueAt() *RegisteredWordUpdate {
+	rwu.mutation.ClearDueAt()
+	return rwu
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (rwu *RegisteredWordUpdate) SetLastReviewedAt(t time.Time) *RegisteredWordUpdate {
+	rwu.mutation.SetLastReviewedAt(t)
+	return rwu
+}
+
+// SetNillableLastReviewedAt sets the "last_reviewed_at" field if the given value is not nil.
+func (rwu *RegisteredWordUpdate) SetNillableLastReviewedAt(t *time.Time) *RegisteredWordUpdate {
+	if t != nil {
+		rwu.SetLastReviewedAt(*t)
+	}
+	return rwu
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (rwu *RegisteredWordUpdate) ClearLastReviewedAt() *RegisteredWordUpdate {
+	rwu.mutation.ClearLastReviewedAt()
+	return rwu
+}
+
 // SetMemo sets the "memo" field.
 func (rwu *RegisteredWordUpdate) SetMemo(s string) *RegisteredWordUpdate {
 	rwu.mutation.SetMemo(s)
@@ -368,6 +534,54 @@ func (rwu *RegisteredWordUpdate) sqlSave(ctx context.Context) (n int, err error)
 	if value, ok := rwu.mutation.AddedCorrectRate(); ok {
 		_spec.AddField(registeredword.FieldCorrectRate, field.TypeInt, value)
 	}
+	if value, ok := rwu.mutation.EaseFactor(); ok {
+		_spec.SetField(registeredword.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := rwu.mutation.AddedEaseFactor(); ok {
+		_spec.AddField(registeredword.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := rwu.mutation.IntervalDays(); ok {
+		_spec.SetField(registeredword.FieldIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := rwu.mutation.AddedIntervalDays(); ok {
+		_spec.AddField(registeredword.FieldIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := rwu.mutation.Repetitions(); ok {
+		_spec.SetField(registeredword.FieldRepetitions, field.TypeInt, value)
+	}
+	if value, ok := rwu.mutation.AddedRepetitions(); ok {
+		_spec.AddField(registeredword.FieldRepetitions, field.TypeInt, value)
+	}
+	if value, ok := rwu.mutation.Lapses(); ok {
+		_spec.SetField(registeredword.FieldLapses, field.TypeInt, value)
+	}
+	if value, ok := rwu.mutation.AddedLapses(); ok {
+		_spec.AddField(registeredword.FieldLapses, field.TypeInt, value)
+	}
+	if value, ok := rwu.mutation.Stability(); ok {
+		_spec.SetField(registeredword.FieldStability, field.TypeFloat64, value)
+	}
+	if value, ok := rwu.mutation.AddedStability(); ok {
+		_spec.AddField(registeredword.FieldStability, field.TypeFloat64, value)
+	}
+	if value, ok := rwu.mutation.Difficulty(); ok {
+		_spec.SetField(registeredword.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := rwu.mutation.AddedDifficulty(); ok {
+		_spec.AddField(registeredword.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := rwu.mutation.DueAt(); ok {
+		_spec.SetField(registeredword.FieldDueAt, field.TypeTime, value)
+	}
+	if rwu.mutation.DueAtCleared() {
+		_spec.ClearField(registeredword.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := rwu.mutation.LastReviewedAt(); ok {
+		_spec.SetField(registeredword.FieldLastReviewedAt, field.TypeTime, value)
+	}
+	if rwu.mutation.LastReviewedAtCleared() {
+		_spec.ClearField(registeredword.FieldLastReviewedAt, field.TypeTime)
+	}
 	if value, ok := rwu.mutation.Memo(); ok {
 		_spec.SetField(registeredword.FieldMemo, field.TypeString, value)
 	}
@@ -631,6 +845,172 @@ func (rwuo *RegisteredWordUpdateOne) AddCorrectRate(i int) *RegisteredWordUpdate
 	return rwuo
 }
 
+// SetEaseFactor sets the "ease_factor" field.
+func (rwuo *RegisteredWordUpdateOne) SetEaseFactor(f float64) *RegisteredWordUpdateOne {
+	rwuo.mutation.ResetEaseFactor()
+	rwuo.mutation.SetEaseFactor(f)
+	return rwuo
+}
+
+// SetNillableEaseFactor sets the "ease_factor" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableEaseFactor(f *float64) *RegisteredWordUpdateOne {
+	if f != nil {
+		rwuo.SetEaseFactor(*f)
+	}
+	return rwuo
+}
+
+// AddEaseFactor adds f to the "ease_factor" field.
+func (rwuo *RegisteredWordUpdateOne) AddEaseFactor(f float64) *RegisteredWordUpdateOne {
+	rwuo.mutation.AddEaseFactor(f)
+	return rwuo
+}
+
+// SetIntervalDays sets the "interval_days" field.
+func (rwuo *RegisteredWordUpdateOne) SetIntervalDays(i int) *RegisteredWordUpdateOne {
+	rwuo.mutation.ResetIntervalDays()
+	rwuo.mutation.SetIntervalDays(i)
+	return rwuo
+}
+
+// SetNillableIntervalDays sets the "interval_days" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableIntervalDays(i *int) *RegisteredWordUpdateOne {
+	if i != nil {
+		rwuo.SetIntervalDays(*i)
+	}
+	return rwuo
+}
+
+// AddIntervalDays adds i to the "interval_days" field.
+func (rwuo *RegisteredWordUpdateOne) AddIntervalDays(i int) *RegisteredWordUpdateOne {
+	rwuo.mutation.AddIntervalDays(i)
+	return rwuo
+}
+
+// SetRepetitions sets the "repetitions" field.
+func (rwuo *RegisteredWordUpdateOne) SetRepetitions(i int) *RegisteredWordUpdateOne {
+	rwuo.mutation.ResetRepetitions()
+	rwuo.mutation.SetRepetitions(i)
+	return rwuo
+}
+
+// SetNillableRepetitions sets the "repetitions" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableRepetitions(i *int) *RegisteredWordUpdateOne {
+	if i != nil {
+		rwuo.SetRepetitions(*i)
+	}
+	return rwuo
+}
+
+// AddRepetitions adds i to the "repetitions" field.
+func (rwuo *RegisteredWordUpdateOne) AddRepetitions(i int) *RegisteredWordUpdateOne {
+	rwuo.mutation.AddRepetitions(i)
+	return rwuo
+}
+
+// SetLapses sets the "lapses" field.
+func (rwuo *RegisteredWordUpdateOne) SetLapses(i int) *RegisteredWordUpdateOne {
+	rwuo.mutation.ResetLapses()
+	rwuo.mutation.SetLapses(i)
+	return rwuo
+}
+
+// SetNillableLapses sets the "lapses" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableLapses(i *int) *RegisteredWordUpdateOne {
+	if i != nil {
+		rwuo.SetLapses(*i)
+	}
+	return rwuo
+}
+
+// AddLapses adds i to the "lapses" field.
+func (rwuo *RegisteredWordUpdateOne) AddLapses(i int) *RegisteredWordUpdateOne {
+	rwuo.mutation.AddLapses(i)
+	return rwuo
+}
+
+// SetStability sets the "stability" field.
+func (rwuo *RegisteredWordUpdateOne) SetStability(f float64) *RegisteredWordUpdateOne {
+	rwuo.mutation.ResetStability()
+	rwuo.mutation.SetStability(f)
+	return rwuo
+}
+
+// SetNillableStability sets the "stability" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableStability(f *float64) *RegisteredWordUpdateOne {
+	if f != nil {
+		rwuo.SetStability(*f)
+	}
+	return rwuo
+}
+
+// AddStability adds f to the "stability" field.
+func (rwuo *RegisteredWordUpdateOne) AddStability(f float64) *RegisteredWordUpdateOne {
+	rwuo.mutation.AddStability(f)
+	return rwuo
+}
+
+// SetDifficulty sets the "difficulty" field.
+func (rwuo *RegisteredWordUpdateOne) SetDifficulty(f float64) *RegisteredWordUpdateOne {
+	rwuo.mutation.ResetDifficulty()
+	rwuo.mutation.SetDifficulty(f)
+	return rwuo
+}
+
+// SetNillableDifficulty sets the "difficulty" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableDifficulty(f *float64) *RegisteredWordUpdateOne {
+	if f != nil {
+		rwuo.SetDifficulty(*f)
+	}
+	return rwuo
+}
+
+// AddDifficulty adds f to the "difficulty" field.
+func (rwuo *RegisteredWordUpdateOne) AddDifficulty(f float64) *RegisteredWordUpdateOne {
+	rwuo.mutation.AddDifficulty(f)
+	return rwuo
+}
+
+// SetDueAt sets the "due_at" field.
+func (rwuo *RegisteredWordUpdateOne) SetDueAt(t time.Time) *RegisteredWordUpdateOne {
+	rwuo.mutation.SetDueAt(t)
+	return rwuo
+}
+
+// SetNillableDueAt sets the "due_at" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableDueAt(t *time.Time) *RegisteredWordUpdateOne {
+	if t != nil {
+		rwuo.SetDueAt(*t)
+	}
+	return rwuo
+}
+
+// ClearDueAt clears the value of the "due_at" field.
+func (rwuo *RegisteredWordUpdateOne) ClearDueAt() *RegisteredWordUpdateOne {
+	rwuo.mutation.ClearDueAt()
+	return rwuo
+}
+
+// SetLastReviewedAt sets the "last_reviewed_at" field.
+func (rwuo *RegisteredWordUpdateOne) SetLastReviewedAt(t time.Time) *RegisteredWordUpdateOne {
+	rwuo.mutation.SetLastReviewedAt(t)
+	return rwuo
+}
+
+// SetNillableLastReviewedAt sets the "last_reviewed_at" field if the given value is not nil.
+func (rwuo *RegisteredWordUpdateOne) SetNillableLastReviewedAt(t *time.Time) *RegisteredWordUpdateOne {
+	if t != nil {
+		rwuo.SetLastReviewedAt(*t)
+	}
+	return rwuo
+}
+
+// ClearLastReviewedAt clears the value of the "last_reviewed_at" field.
+func (rwuo *RegisteredWordUpdateOne) ClearLastReviewedAt() *RegisteredWordUpdateOne {
+	rwuo.mutation.ClearLastReviewedAt()
+	return rwuo
+}
+
 // SetMemo sets the "memo" field.
 func (rwuo *RegisteredWordUpdateOne) SetMemo(s string) *RegisteredWordUpdateOne {
 	rwuo.mutation.SetMemo(s)
@@ -871,6 +1251,54 @@ func (rwuo *RegisteredWordUpdateOne) sqlSave(ctx context.Context) (_node *Regist
 	if value, ok := rwuo.mutation.AddedCorrectRate(); ok {
 		_spec.AddField(registeredword.FieldCorrectRate, field.TypeInt, value)
 	}
+	if value, ok := rwuo.mutation.EaseFactor(); ok {
+		_spec.SetField(registeredword.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := rwuo.mutation.AddedEaseFactor(); ok {
+		_spec.AddField(registeredword.FieldEaseFactor, field.TypeFloat64, value)
+	}
+	if value, ok := rwuo.mutation.IntervalDays(); ok {
+		_spec.SetField(registeredword.FieldIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := rwuo.mutation.AddedIntervalDays(); ok {
+		_spec.AddField(registeredword.FieldIntervalDays, field.TypeInt, value)
+	}
+	if value, ok := rwuo.mutation.Repetitions(); ok {
+		_spec.SetField(registeredword.FieldRepetitions, field.TypeInt, value)
+	}
+	if value, ok := rwuo.mutation.AddedRepetitions(); ok {
+		_spec.AddField(registeredword.FieldRepetitions, field.TypeInt, value)
+	}
+	if value, ok := rwuo.mutation.Lapses(); ok {
+		_spec.SetField(registeredword.FieldLapses, field.TypeInt, value)
+	}
+	if value, ok := rwuo.mutation.AddedLapses(); ok {
+		_spec.AddField(registeredword.FieldLapses, field.TypeInt, value)
+	}
+	if value, ok := rwuo.mutation.Stability(); ok {
+		_spec.SetField(registeredword.FieldStability, field.TypeFloat64, value)
+	}
+	if value, ok := rwuo.mutation.AddedStability(); ok {
+		_spec.AddField(registeredword.FieldStability, field.TypeFloat64, value)
+	}
+	if value, ok := rwuo.mutation.Difficulty(); ok {
+		_spec.SetField(registeredword.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := rwuo.mutation.AddedDifficulty(); ok {
+		_spec.AddField(registeredword.FieldDifficulty, field.TypeFloat64, value)
+	}
+	if value, ok := rwuo.mutation.DueAt(); ok {
+		_spec.SetField(registeredword.FieldDueAt, field.TypeTime, value)
+	}
+	if rwuo.mutation.DueAtCleared() {
+		_spec.ClearField(registeredword.FieldDueAt, field.TypeTime)
+	}
+	if value, ok := rwuo.mutation.LastReviewedAt(); ok {
+		_spec.SetField(registeredword.FieldLastReviewedAt, field.TypeTime, value)
+	}
+	if rwuo.mutation.LastReviewedAtCleared() {
+		_spec.ClearField(registeredword.FieldLastReviewedAt, field.TypeTime)
+	}
 	if value, ok := rwuo.mutation.Memo(); ok {
 		_spec.SetField(registeredword.FieldMemo, field.TypeString, value)
 	}
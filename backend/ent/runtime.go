@@ -4,11 +4,18 @@ package ent
 
 import (
 	"time"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
 	"word_app/backend/ent/externalauth"
 	"word_app/backend/ent/japanesemean"
 	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/posmapping"
+	"word_app/backend/ent/posmappingversion"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/refreshtoken"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/rootconfig"
 	"word_app/backend/ent/schema"
@@ -17,12 +24,83 @@ import (
 	"word_app/backend/ent/userdailyusage"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/ent/wordproposal"
 )
 
 // The init function reads all schema descriptors with runtime code
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	deckFields := schema.Deck{}.Fields()
+	_ = deckFields
+	// deckDescUserID is the schema descriptor for user_id field.
+	deckDescUserID := deckFields[0].Descriptor()
+	// deck.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	deck.UserIDValidator = deckDescUserID.Validators[0].(func(int) error)
+	// deckDescName is the schema descriptor for name field.
+	deckDescName := deckFields[1].Descriptor()
+	// deck.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	deck.NameValidator = deckDescName.Validators[0].(func(string) error)
+	// deckDescDescription is the schema descriptor for description field.
+	deckDescDescription := deckFields[2].Descriptor()
+	// deck.DefaultDescription holds the default value on creation for the description field.
+	deck.DefaultDescription = deckDescDescription.Default.(string)
+	// deckDescCreatedAt is the schema descriptor for created_at field.
+	deckDescCreatedAt := deckFields[5].Descriptor()
+	// deck.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deck.DefaultCreatedAt = deckDescCreatedAt.Default.(func() time.Time)
+	// deckDescUpdatedAt is the schema descriptor for updated_at field.
+	deckDescUpdatedAt := deckFields[6].Descriptor()
+	// deck.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	deck.DefaultUpdatedAt = deckDescUpdatedAt.Default.(func() time.Time)
+	// deck.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	deck.UpdateDefaultUpdatedAt = deckDescUpdatedAt.UpdateDefault.(func() time.Time)
+	deckwordFields := schema.DeckWord{}.Fields()
+	_ = deckwordFields
+	// deckwordDescPosition is the schema descriptor for position field.
+	deckwordDescPosition := deckwordFields[2].Descriptor()
+	// deckword.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	deckword.PositionValidator = deckwordDescPosition.Validators[0].(func(int) error)
+	// deckwordDescCreatedAt is the schema descriptor for created_at field.
+	deckwordDescCreatedAt := deckwordFields[3].Descriptor()
+	// deckword.DefaultCreatedAt holds the default value on creation for the created_at field.
+	deckword.DefaultCreatedAt = deckwordDescCreatedAt.Default.(func() time.Time)
+	dictimportfailureFields := schema.DictImportFailure{}.Fields()
+	_ = dictimportfailureFields
+	// dictimportfailureDescEntryIndex is the schema descriptor for entry_index field.
+	dictimportfailureDescEntryIndex := dictimportfailureFields[2].Descriptor()
+	// dictimportfailure.EntryIndexValidator is a validator for the "entry_index" field. It is called by the builders before save.
+	dictimportfailure.EntryIndexValidator = dictimportfailureDescEntryIndex.Validators[0].(func(int) error)
+	// dictimportfailureDescCreatedAt is the schema descriptor for created_at field.
+	dictimportfailureDescCreatedAt := dictimportfailureFields[4].Descriptor()
+	// dictimportfailure.DefaultCreatedAt holds the default value on creation for the created_at field.
+	dictimportfailure.DefaultCreatedAt = dictimportfailureDescCreatedAt.Default.(func() time.Time)
+	dictimportrunFields := schema.DictImportRun{}.Fields()
+	_ = dictimportrunFields
+	// dictimportrunDescFormat is the schema descriptor for format field.
+	dictimportrunDescFormat := dictimportrunFields[1].Descriptor()
+	// dictimportrun.DefaultFormat holds the default value on creation for the format field.
+	dictimportrun.DefaultFormat = dictimportrunDescFormat.Default.(string)
+	// dictimportrunDescStatus is the schema descriptor for status field.
+	dictimportrunDescStatus := dictimportrunFields[3].Descriptor()
+	// dictimportrun.DefaultStatus holds the default value on creation for the status field.
+	dictimportrun.DefaultStatus = dictimportrunDescStatus.Default.(string)
+	// dictimportrun.StatusValidator is a validator for the "status" field. It is called by the builders before save.
+	dictimportrun.StatusValidator = dictimportrunDescStatus.Validators[0].(func(string) error)
+	// dictimportrunDescLastCommittedIndex is the schema descriptor for last_committed_index field.
+	dictimportrunDescLastCommittedIndex := dictimportrunFields[4].Descriptor()
+	// dictimportrun.DefaultLastCommittedIndex holds the default value on creation for the last_committed_index field.
+	dictimportrun.DefaultLastCommittedIndex = dictimportrunDescLastCommittedIndex.Default.(int)
+	// dictimportrunDescStartedAt is the schema descriptor for started_at field.
+	dictimportrunDescStartedAt := dictimportrunFields[6].Descriptor()
+	// dictimportrun.DefaultStartedAt holds the default value on creation for the started_at field.
+	dictimportrun.DefaultStartedAt = dictimportrunDescStartedAt.Default.(func() time.Time)
+	// dictimportrunDescUpdatedAt is the schema descriptor for updated_at field.
+	dictimportrunDescUpdatedAt := dictimportrunFields[8].Descriptor()
+	// dictimportrun.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	dictimportrun.DefaultUpdatedAt = dictimportrunDescUpdatedAt.Default.(func() time.Time)
+	// dictimportrun.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	dictimportrun.UpdateDefaultUpdatedAt = dictimportrunDescUpdatedAt.UpdateDefault.(func() time.Time)
 	externalauthFields := schema.ExternalAuth{}.Fields()
 	_ = externalauthFields
 	// externalauthDescUserID is the schema descriptor for user_id field.
@@ -58,15 +136,15 @@ func init() {
 		}
 	}()
 	// japanesemeanDescWordInfoID is the schema descriptor for word_info_id field.
-	japanesemeanDescWordInfoID := japanesemeanFields[1].Descriptor()
+	japanesemeanDescWordInfoID := japanesemeanFields[2].Descriptor()
 	// japanesemean.WordInfoIDValidator is a validator for the "word_info_id" field. It is called by the builders before save.
 	japanesemean.WordInfoIDValidator = japanesemeanDescWordInfoID.Validators[0].(func(int) error)
 	// japanesemeanDescCreatedAt is the schema descriptor for created_at field.
-	japanesemeanDescCreatedAt := japanesemeanFields[2].Descriptor()
+	japanesemeanDescCreatedAt := japanesemeanFields[3].Descriptor()
 	// japanesemean.DefaultCreatedAt holds the default value on creation for the created_at field.
 	japanesemean.DefaultCreatedAt = japanesemeanDescCreatedAt.Default.(func() time.Time)
 	// japanesemeanDescUpdatedAt is the schema descriptor for updated_at field.
-	japanesemeanDescUpdatedAt := japanesemeanFields[3].Descriptor()
+	japanesemeanDescUpdatedAt := japanesemeanFields[4].Descriptor()
 	// japanesemean.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	japanesemean.DefaultUpdatedAt = japanesemeanDescUpdatedAt.Default.(func() time.Time)
 	// japanesemean.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -87,6 +165,26 @@ func init() {
 	partofspeech.DefaultUpdatedAt = partofspeechDescUpdatedAt.Default.(func() time.Time)
 	// partofspeech.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	partofspeech.UpdateDefaultUpdatedAt = partofspeechDescUpdatedAt.UpdateDefault.(func() time.Time)
+	posmappingFields := schema.PosMapping{}.Fields()
+	_ = posmappingFields
+	// posmappingDescCode is the schema descriptor for code field.
+	posmappingDescCode := posmappingFields[1].Descriptor()
+	// posmapping.CodeValidator is a validator for the "code" field. It is called by the builders before save.
+	posmapping.CodeValidator = posmappingDescCode.Validators[0].(func(string) error)
+	// posmappingDescPartOfSpeechID is the schema descriptor for part_of_speech_id field.
+	posmappingDescPartOfSpeechID := posmappingFields[2].Descriptor()
+	// posmapping.PartOfSpeechIDValidator is a validator for the "part_of_speech_id" field. It is called by the builders before save.
+	posmapping.PartOfSpeechIDValidator = posmappingDescPartOfSpeechID.Validators[0].(func(int) error)
+	posmappingversionFields := schema.PosMappingVersion{}.Fields()
+	_ = posmappingversionFields
+	// posmappingversionDescFallbackPosID is the schema descriptor for fallback_pos_id field.
+	posmappingversionDescFallbackPosID := posmappingversionFields[0].Descriptor()
+	// posmappingversion.FallbackPosIDValidator is a validator for the "fallback_pos_id" field. It is called by the builders before save.
+	posmappingversion.FallbackPosIDValidator = posmappingversionDescFallbackPosID.Validators[0].(func(int) error)
+	// posmappingversionDescCreatedAt is the schema descriptor for created_at field.
+	posmappingversionDescCreatedAt := posmappingversionFields[3].Descriptor()
+	// posmappingversion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	posmappingversion.DefaultCreatedAt = posmappingversionDescCreatedAt.Default.(func() time.Time)
 	quizFields := schema.Quiz{}.Fields()
 	_ = quizFields
 	// quizDescIsRunning is the schema descriptor for is_running field.
@@ -131,8 +229,48 @@ func init() {
 	quiz.DefaultIsSpecialCharacters = quizDescIsSpecialCharacters.Default.(int)
 	// quiz.IsSpecialCharactersValidator is a validator for the "is_special_characters" field. It is called by the builders before save.
 	quiz.IsSpecialCharactersValidator = quizDescIsSpecialCharacters.Validators[0].(func(int) error)
+	// quizDescMode is the schema descriptor for mode field.
+	quizDescMode := quizFields[13].Descriptor()
+	// quiz.DefaultMode holds the default value on creation for the mode field.
+	quiz.DefaultMode = quizDescMode.Default.(string)
+	// quiz.ModeValidator is a validator for the "mode" field. It is called by the builders before save.
+	quiz.ModeValidator = quizDescMode.Validators[0].(func(string) error)
+	// quizDescDirection is the schema descriptor for direction field.
+	quizDescDirection := quizFields[14].Descriptor()
+	// quiz.DefaultDirection holds the default value on creation for the direction field.
+	quiz.DefaultDirection = quizDescDirection.Default.(string)
+	// quiz.DirectionValidator is a validator for the "direction" field. It is called by the builders before save.
+	quiz.DirectionValidator = quizDescDirection.Validators[0].(func(string) error)
+	// quizDescQuestionType is the schema descriptor for question_type field.
+	quizDescQuestionType := quizFields[15].Descriptor()
+	// quiz.DefaultQuestionType holds the default value on creation for the question_type field.
+	quiz.DefaultQuestionType = quizDescQuestionType.Default.(string)
+	// quiz.QuestionTypeValidator is a validator for the "question_type" field. It is called by the builders before save.
+	quiz.QuestionTypeValidator = quizDescQuestionType.Validators[0].(func(string) error)
+	// quizDescDifficulty is the schema descriptor for difficulty field.
+	quizDescDifficulty := quizFields[16].Descriptor()
+	// quiz.DefaultDifficulty holds the default value on creation for the difficulty field.
+	quiz.DefaultDifficulty = quizDescDifficulty.Default.(string)
+	// quiz.DifficultyValidator is a validator for the "difficulty" field. It is called by the builders before save.
+	quiz.DifficultyValidator = quizDescDifficulty.Validators[0].(func(string) error)
+	// quizDescQuestionTimeLimitSec is the schema descriptor for question_time_limit_sec field.
+	quizDescQuestionTimeLimitSec := quizFields[17].Descriptor()
+	// quiz.DefaultQuestionTimeLimitSec holds the default value on creation for the question_time_limit_sec field.
+	quiz.DefaultQuestionTimeLimitSec = quizDescQuestionTimeLimitSec.Default.(int)
+	// quiz.QuestionTimeLimitSecValidator is a validator for the "question_time_limit_sec" field. It is called by the builders before save.
+	quiz.QuestionTimeLimitSecValidator = quizDescQuestionTimeLimitSec.Validators[0].(func(int) error)
+	// quizDescTimeLimitSec is the schema descriptor for time_limit_sec field.
+	quizDescTimeLimitSec := quizFields[18].Descriptor()
+	// quiz.DefaultTimeLimitSec holds the default value on creation for the time_limit_sec field.
+	quiz.DefaultTimeLimitSec = quizDescTimeLimitSec.Default.(int)
+	// quiz.TimeLimitSecValidator is a validator for the "time_limit_sec" field. It is called by the builders before save.
+	quiz.TimeLimitSecValidator = quizDescTimeLimitSec.Validators[0].(func(int) error)
+	// quizDescFinishStatus is the schema descriptor for finish_status field.
+	quizDescFinishStatus := quizFields[20].Descriptor()
+	// quiz.FinishStatusValidator is a validator for the "finish_status" field. It is called by the builders before save.
+	quiz.FinishStatusValidator = quizDescFinishStatus.Validators[0].(func(string) error)
 	// quizDescCreatedAt is the schema descriptor for created_at field.
-	quizDescCreatedAt := quizFields[13].Descriptor()
+	quizDescCreatedAt := quizFields[23].Descriptor()
 	// quiz.DefaultCreatedAt holds the default value on creation for the created_at field.
 	quiz.DefaultCreatedAt = quizDescCreatedAt.Default.(func() time.Time)
 	quizquestionFields := schema.QuizQuestion{}.Fields()
@@ -149,10 +287,48 @@ func init() {
 	quizquestionDescPosID := quizquestionFields[4].Descriptor()
 	// quizquestion.PosIDValidator is a validator for the "pos_id" field. It is called by the builders before save.
 	quizquestion.PosIDValidator = quizquestionDescPosID.Validators[0].(func(int) error)
+	// quizquestionDescDirection is the schema descriptor for direction field.
+	quizquestionDescDirection := quizquestionFields[6].Descriptor()
+	// quizquestion.DefaultDirection holds the default value on creation for the direction field.
+	quizquestion.DefaultDirection = quizquestionDescDirection.Default.(string)
+	// quizquestion.DirectionValidator is a validator for the "direction" field. It is called by the builders before save.
+	quizquestion.DirectionValidator = quizquestionDescDirection.Validators[0].(func(string) error)
+	// quizquestionDescQuestionType is the schema descriptor for question_type field.
+	quizquestionDescQuestionType := quizquestionFields[7].Descriptor()
+	// quizquestion.DefaultQuestionType holds the default value on creation for the question_type field.
+	quizquestion.DefaultQuestionType = quizquestionDescQuestionType.Default.(string)
+	// quizquestion.QuestionTypeValidator is a validator for the "question_type" field. It is called by the builders before save.
+	quizquestion.QuestionTypeValidator = quizquestionDescQuestionType.Validators[0].(func(string) error)
+	// quizquestionDescCredit is the schema descriptor for credit field.
+	quizquestionDescCredit := quizquestionFields[15].Descriptor()
+	// quizquestion.DefaultCredit holds the default value on creation for the credit field.
+	quizquestion.DefaultCredit = quizquestionDescCredit.Default.(float64)
+	// quizquestionDescIdempotencyKey is the schema descriptor for idempotency_key field.
+	quizquestionDescIdempotencyKey := quizquestionFields[19].Descriptor()
+	// quizquestion.IdempotencyKeyValidator is a validator for the "idempotency_key" field. It is called by the builders before save.
+	quizquestion.IdempotencyKeyValidator = quizquestionDescIdempotencyKey.Validators[0].(func(string) error)
 	// quizquestionDescCreatedAt is the schema descriptor for created_at field.
-	quizquestionDescCreatedAt := quizquestionFields[11].Descriptor()
+	quizquestionDescCreatedAt := quizquestionFields[21].Descriptor()
 	// quizquestion.DefaultCreatedAt holds the default value on creation for the created_at field.
 	quizquestion.DefaultCreatedAt = quizquestionDescCreatedAt.Default.(func() time.Time)
+	refreshtokenFields := schema.RefreshToken{}.Fields()
+	_ = refreshtokenFields
+	// refreshtokenDescUserID is the schema descriptor for user_id field.
+	refreshtokenDescUserID := refreshtokenFields[0].Descriptor()
+	// refreshtoken.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	refreshtoken.UserIDValidator = refreshtokenDescUserID.Validators[0].(func(int) error)
+	// refreshtokenDescFamilyID is the schema descriptor for family_id field.
+	refreshtokenDescFamilyID := refreshtokenFields[1].Descriptor()
+	// refreshtoken.FamilyIDValidator is a validator for the "family_id" field. It is called by the builders before save.
+	refreshtoken.FamilyIDValidator = refreshtokenDescFamilyID.Validators[0].(func(string) error)
+	// refreshtokenDescTokenHash is the schema descriptor for token_hash field.
+	refreshtokenDescTokenHash := refreshtokenFields[2].Descriptor()
+	// refreshtoken.TokenHashValidator is a validator for the "token_hash" field. It is called by the builders before save.
+	refreshtoken.TokenHashValidator = refreshtokenDescTokenHash.Validators[0].(func(string) error)
+	// refreshtokenDescCreatedAt is the schema descriptor for created_at field.
+	refreshtokenDescCreatedAt := refreshtokenFields[4].Descriptor()
+	// refreshtoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	refreshtoken.DefaultCreatedAt = refreshtokenDescCreatedAt.Default.(func() time.Time)
 	registeredwordFields := schema.RegisteredWord{}.Fields()
 	_ = registeredwordFields
 	// registeredwordDescUserID is the schema descriptor for user_id field.
@@ -181,16 +357,40 @@ func init() {
 	registeredwordDescCorrectRate := registeredwordFields[6].Descriptor()
 	// registeredword.DefaultCorrectRate holds the default value on creation for the correct_rate field.
 	registeredword.DefaultCorrectRate = registeredwordDescCorrectRate.Default.(int)
+	// registeredwordDescEaseFactor is the schema descriptor for ease_factor field.
+	registeredwordDescEaseFactor := registeredwordFields[7].Descriptor()
+	// registeredword.DefaultEaseFactor holds the default value on creation for the ease_factor field.
+	registeredword.DefaultEaseFactor = registeredwordDescEaseFactor.Default.(float64)
+	// registeredwordDescIntervalDays is the schema descriptor for interval_days field.
+	registeredwordDescIntervalDays := registeredwordFields[8].Descriptor()
+	// registeredword.DefaultIntervalDays holds the default value on creation for the interval_days field.
+	registeredword.DefaultIntervalDays = registeredwordDescIntervalDays.Default.(int)
+	// registeredwordDescRepetitions is the schema descriptor for repetitions field.
+	registeredwordDescRepetitions := registeredwordFields[9].Descriptor()
+	// registeredword.DefaultRepetitions holds the default value on creation for the repetitions field.
+	registeredword.DefaultRepetitions = registeredwordDescRepetitions.Default.(int)
+	// registeredwordDescLapses is the schema descriptor for lapses field.
+	registeredwordDescLapses := registeredwordFields[10].Descriptor()
+	// registeredword.DefaultLapses holds the default value on creation for the lapses field.
+	registeredword.DefaultLapses = registeredwordDescLapses.Default.(int)
+	// registeredwordDescStability is the schema descriptor for stability field.
+	registeredwordDescStability := registeredwordFields[11].Descriptor()
+	// registeredword.DefaultStability holds the default value on creation for the stability field.
+	registeredword.DefaultStability = registeredwordDescStability.Default.(float64)
+	// registeredwordDescDifficulty is the schema descriptor for difficulty field.
+	registeredwordDescDifficulty := registeredwordFields[12].Descriptor()
+	// registeredword.DefaultDifficulty holds the default value on creation for the difficulty field.
+	registeredword.DefaultDifficulty = registeredwordDescDifficulty.Default.(float64)
 	// registeredwordDescMemo is the schema descriptor for memo field.
-	registeredwordDescMemo := registeredwordFields[7].Descriptor()
+	registeredwordDescMemo := registeredwordFields[15].Descriptor()
 	// registeredword.MemoValidator is a validator for the "memo" field. It is called by the builders before save.
 	registeredword.MemoValidator = registeredwordDescMemo.Validators[0].(func(string) error)
 	// registeredwordDescCreatedAt is the schema descriptor for created_at field.
-	registeredwordDescCreatedAt := registeredwordFields[8].Descriptor()
+	registeredwordDescCreatedAt := registeredwordFields[16].Descriptor()
 	// registeredword.DefaultCreatedAt holds the default value on creation for the created_at field.
 	registeredword.DefaultCreatedAt = registeredwordDescCreatedAt.Default.(func() time.Time)
 	// registeredwordDescUpdatedAt is the schema descriptor for updated_at field.
-	registeredwordDescUpdatedAt := registeredwordFields[9].Descriptor()
+	registeredwordDescUpdatedAt := registeredwordFields[17].Descriptor()
 	// registeredword.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	registeredword.DefaultUpdatedAt = registeredwordDescUpdatedAt.Default.(func() time.Time)
 	// registeredword.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -267,6 +467,12 @@ func init() {
 	userDescIsTest := userFields[8].Descriptor()
 	// user.DefaultIsTest holds the default value on creation for the isTest field.
 	user.DefaultIsTest = userDescIsTest.Default.(bool)
+	// userDescTokenVersion is the schema descriptor for token_version field.
+	userDescTokenVersion := userFields[10].Descriptor()
+	// user.DefaultTokenVersion holds the default value on creation for the token_version field.
+	user.DefaultTokenVersion = userDescTokenVersion.Default.(int)
+	// user.TokenVersionValidator is a validator for the "token_version" field. It is called by the builders before save.
+	user.TokenVersionValidator = userDescTokenVersion.Validators[0].(func(int) error)
 	userconfigFields := schema.UserConfig{}.Fields()
 	_ = userconfigFields
 	// userconfigDescUserID is the schema descriptor for user_id field.
@@ -277,6 +483,12 @@ func init() {
 	userconfigDescIsDarkMode := userconfigFields[1].Descriptor()
 	// userconfig.DefaultIsDarkMode holds the default value on creation for the is_dark_mode field.
 	userconfig.DefaultIsDarkMode = userconfigDescIsDarkMode.Default.(bool)
+	// userconfigDescSrsAlgorithm is the schema descriptor for srs_algorithm field.
+	userconfigDescSrsAlgorithm := userconfigFields[2].Descriptor()
+	// userconfig.DefaultSrsAlgorithm holds the default value on creation for the srs_algorithm field.
+	userconfig.DefaultSrsAlgorithm = userconfigDescSrsAlgorithm.Default.(string)
+	// userconfig.SrsAlgorithmValidator is a validator for the "srs_algorithm" field. It is called by the builders before save.
+	userconfig.SrsAlgorithmValidator = userconfigDescSrsAlgorithm.Validators[0].(func(string) error)
 	userdailyusageFields := schema.UserDailyUsage{}.Fields()
 	_ = userdailyusageFields
 	// userdailyusageDescUserID is the schema descriptor for user_id field.
@@ -329,12 +541,16 @@ func init() {
 	wordDescRegistrationCount := wordFields[4].Descriptor()
 	// word.DefaultRegistrationCount holds the default value on creation for the registration_count field.
 	word.DefaultRegistrationCount = wordDescRegistrationCount.Default.(int)
+	// wordDescFrequencyRank is the schema descriptor for frequency_rank field.
+	wordDescFrequencyRank := wordFields[5].Descriptor()
+	// word.FrequencyRankValidator is a validator for the "frequency_rank" field. It is called by the builders before save.
+	word.FrequencyRankValidator = wordDescFrequencyRank.Validators[0].(func(int) error)
 	// wordDescCreatedAt is the schema descriptor for created_at field.
-	wordDescCreatedAt := wordFields[5].Descriptor()
+	wordDescCreatedAt := wordFields[6].Descriptor()
 	// word.DefaultCreatedAt holds the default value on creation for the created_at field.
 	word.DefaultCreatedAt = wordDescCreatedAt.Default.(func() time.Time)
 	// wordDescUpdatedAt is the schema descriptor for updated_at field.
-	wordDescUpdatedAt := wordFields[6].Descriptor()
+	wordDescUpdatedAt := wordFields[7].Descriptor()
 	// word.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	word.DefaultUpdatedAt = wordDescUpdatedAt.Default.(func() time.Time)
 	// word.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -350,13 +566,27 @@ func init() {
 	// wordinfo.PartOfSpeechIDValidator is a validator for the "part_of_speech_id" field. It is called by the builders before save.
 	wordinfo.PartOfSpeechIDValidator = wordinfoDescPartOfSpeechID.Validators[0].(func(int) error)
 	// wordinfoDescCreatedAt is the schema descriptor for created_at field.
-	wordinfoDescCreatedAt := wordinfoFields[2].Descriptor()
+	wordinfoDescCreatedAt := wordinfoFields[7].Descriptor()
 	// wordinfo.DefaultCreatedAt holds the default value on creation for the created_at field.
 	wordinfo.DefaultCreatedAt = wordinfoDescCreatedAt.Default.(func() time.Time)
 	// wordinfoDescUpdatedAt is the schema descriptor for updated_at field.
-	wordinfoDescUpdatedAt := wordinfoFields[3].Descriptor()
+	wordinfoDescUpdatedAt := wordinfoFields[8].Descriptor()
 	// wordinfo.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	wordinfo.DefaultUpdatedAt = wordinfoDescUpdatedAt.Default.(func() time.Time)
 	// wordinfo.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	wordinfo.UpdateDefaultUpdatedAt = wordinfoDescUpdatedAt.UpdateDefault.(func() time.Time)
+	wordproposalFields := schema.WordProposal{}.Fields()
+	_ = wordproposalFields
+	// wordproposalDescUserID is the schema descriptor for user_id field.
+	wordproposalDescUserID := wordproposalFields[0].Descriptor()
+	// wordproposal.UserIDValidator is a validator for the "user_id" field. It is called by the builders before save.
+	wordproposal.UserIDValidator = wordproposalDescUserID.Validators[0].(func(int) error)
+	// wordproposalDescReviewNote is the schema descriptor for review_note field.
+	wordproposalDescReviewNote := wordproposalFields[7].Descriptor()
+	// wordproposal.DefaultReviewNote holds the default value on creation for the review_note field.
+	wordproposal.DefaultReviewNote = wordproposalDescReviewNote.Default.(string)
+	// wordproposalDescCreatedAt is the schema descriptor for created_at field.
+	wordproposalDescCreatedAt := wordproposalFields[8].Descriptor()
+	// wordproposal.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wordproposal.DefaultCreatedAt = wordproposalDescCreatedAt.Default.(func() time.Time)
 }
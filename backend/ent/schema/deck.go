@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Deck はユーザーが作る単語リスト（単語帳）。
+// 非公開・リンク共有・公開のいずれかで、リンク共有は share_token を知っている人だけが見られる。
+type Deck struct {
+	ent.Schema
+}
+
+// Fields of the Deck.
+func (Deck) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive(),
+		field.String("name").
+			NotEmpty().
+			Comment("長さの上限は usecase で文字数として検査する"),
+		field.String("description").
+			Default(""),
+		field.Enum("visibility").
+			Values("private", "link", "public").
+			Default("private"),
+		field.String("share_token").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("リンク共有用のトークン。link 以外では NULL"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Deck.
+func (Deck) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("decks").
+			Unique().
+			Field("user_id").
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("deck_words", DeckWord.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("quizzes", Quiz.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.SetNull}),
+	}
+}
+
+func (Deck) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("visibility", "updated_at"),
+	}
+}
@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DeckWord はデッキに入っている単語と、その並び順
+type DeckWord struct {
+	ent.Schema
+}
+
+// Fields of the DeckWord.
+func (DeckWord) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("deck_id"),
+		field.Int("word_id"),
+		field.Int("position").
+			NonNegative().
+			Comment("デッキ内の並び順（0 始まり）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the DeckWord.
+func (DeckWord) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("deck", Deck.Type).
+			Ref("deck_words").
+			Unique().
+			Field("deck_id").
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.From("word", Word.Type).
+			Ref("deck_words").
+			Unique().
+			Field("word_id").
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (DeckWord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("deck_id", "word_id").Unique(),
+		index.Fields("deck_id", "position"),
+	}
+}
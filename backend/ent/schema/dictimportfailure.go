@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DictImportFailure は辞書インポートで失敗したエントリ
+type DictImportFailure struct {
+	ent.Schema
+}
+
+// Fields of the DictImportFailure.
+func (DictImportFailure) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("run_id"),
+		field.String("entry_id").
+			Comment("JMdict のエントリ ID"),
+		field.Int("entry_index").
+			NonNegative().
+			Comment("ファイル内での 0 始まりの位置"),
+		field.Text("message"),
+		field.Time("created_at").
+			Default(time.Now),
+		field.Time("resolved_at").
+			Optional().
+			Nillable().
+			Comment("--retry-failed で再試行した日時。再び失敗した場合は新しい行が作られる"),
+	}
+}
+
+// Edges of the DictImportFailure.
+func (DictImportFailure) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("run", DictImportRun.Type).
+			Ref("failures").
+			Field("run_id").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (DictImportFailure) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("run_id", "entry_index"),
+		index.Fields("run_id", "entry_id"),
+	}
+}
@@ -0,0 +1,66 @@
+package schema
+
+import (
+	"errors"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// DictImportRun は辞書インポート 1 回分の実行記録（再開用のチェックポイントを持つ）
+type DictImportRun struct {
+	ent.Schema
+}
+
+// Fields of the DictImportRun.
+func (DictImportRun) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("source_path"),
+		field.String("format").
+			Default("jmdict").
+			Comment("jmdict / csv / tsv / anki / edict"),
+		field.String("source_hash").
+			Comment("取り込んだファイルの SHA-256（hex）。同じファイルかの判定に使う"),
+		field.String("status").
+			Default("running").
+			Comment("running: 実行中（または異常終了）, completed: 完了, failed: エラーで中断").
+			Validate(func(s string) error {
+				if s != "running" && s != "completed" && s != "failed" {
+					return errors.New("status must be running, completed or failed")
+				}
+				return nil
+			}),
+		field.Int("last_committed_index").
+			Default(-1).
+			Comment("この index までのエントリは書き込み済みか失敗を記録済み。-1 は未着手"),
+		field.Text("error_message").
+			Optional().
+			Nillable(),
+		field.Time("started_at").
+			Default(time.Now),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the DictImportRun.
+func (DictImportRun) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("failures", DictImportFailure.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (DictImportRun) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("source_hash"),
+	}
+}
@@ -31,6 +31,10 @@ func (JapaneseMean) Fields() []ent.Field {
 				}
 				return nil
 			}),
+		field.String("reading").
+			Optional().
+			Nillable().
+			Comment("name が漢字表記のときの読み（かな）"),
 		field.Int("word_info_id").
 			Positive(),
 		field.Time("created_at").
@@ -32,5 +32,6 @@ func (PartOfSpeech) Fields() []ent.Field {
 func (PartOfSpeech) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("word_infos", WordInfo.Type),
+		edge.To("pos_mappings", PosMapping.Type),
 	}
 }
@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PosMapping は対応表の 1 行（品詞コード → PartOfSpeech）
+type PosMapping struct {
+	ent.Schema
+}
+
+// Fields of the PosMapping.
+func (PosMapping) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("version_id"),
+		field.String("code").
+			NotEmpty().
+			Comment("JMdict の品詞コード（n, v5k, adj-no など）"),
+		field.Int("part_of_speech_id").
+			Positive(),
+	}
+}
+
+// Edges of the PosMapping.
+func (PosMapping) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("version", PosMappingVersion.Type).
+			Ref("mappings").
+			Field("version_id").
+			Unique().
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.From("part_of_speech", PartOfSpeech.Type).
+			Ref("pos_mappings").
+			Field("part_of_speech_id").
+			Unique().
+			Required(),
+	}
+}
+
+func (PosMapping) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("version_id", "code").
+			Unique(),
+	}
+}
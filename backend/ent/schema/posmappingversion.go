@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+)
+
+// PosMappingVersion は品詞コード → PartOfSpeech の対応表の版。
+// 対応表は書き換えず、変更のたびに新しい版を作る（ID が版番号）。
+type PosMappingVersion struct {
+	ent.Schema
+}
+
+// Fields of the PosMappingVersion.
+func (PosMappingVersion) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("fallback_pos_id").
+			Positive().
+			Comment("対応表に無いコードの品詞"),
+		field.String("note").
+			Optional().
+			Comment("変更内容のメモ"),
+		field.Int("created_by").
+			Optional().
+			Nillable().
+			Comment("変更した root ユーザーの ID（シードは NULL）"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PosMappingVersion.
+func (PosMappingVersion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("mappings", PosMapping.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
@@ -64,6 +64,61 @@ func (Quiz) Fields() []ent.Field {
 			}),
 		field.JSON("attention_level_list", []int{}),
 		field.JSON("choices_pos_ids", []int{}),
+		field.String("mode").
+			Default("random").
+			Comment("random: ランダム出題, due: 復習期限順").
+			Validate(func(s string) error {
+				if s != "random" && s != "due" {
+					return errors.New("mode must be random or due")
+				}
+				return nil
+			}),
+		field.String("direction").
+			Default("en_to_ja").
+			Comment("en_to_ja: 英単語→意味, ja_to_en: 意味→英単語").
+			Validate(validateDirection),
+		field.String("question_type").
+			Default("choice").
+			Comment("choice: 選択式, typing: 意味を見て英単語を入力").
+			Validate(validateQuestionType),
+		field.String("difficulty").
+			Default("normal").
+			Comment("誤答の選び方 easy: 他品詞, normal: 同品詞+混同履歴少し, hard: 混同履歴優先").
+			Validate(func(s string) error {
+				if s != "easy" && s != "normal" && s != "hard" {
+					return errors.New("difficulty must be easy, normal or hard")
+				}
+				return nil
+			}),
+		field.Int("question_time_limit_sec").
+			Default(0).
+			NonNegative().
+			Comment("1 問あたりの制限時間（秒）。0 は無制限"),
+		field.Int("time_limit_sec").
+			Default(0).
+			NonNegative().
+			Comment("クイズ全体の制限時間（秒）。0 は無制限"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("created_at + time_limit_sec"),
+		field.String("finish_status").
+			Optional().
+			Nillable().
+			Comment("completed: 全問回答, cancelled: 途中で中断, expired: 期限切れ。実行中は NULL").
+			Validate(func(s string) error {
+				if s != "completed" && s != "cancelled" && s != "expired" {
+					return errors.New("finish_status must be completed, cancelled or expired")
+				}
+				return nil
+			}),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+		field.Int("deck_id").
+			Optional().
+			Nillable().
+			Comment("出題元のデッキ。デッキが削除されると NULL"),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("deleted_at").
@@ -72,6 +127,20 @@ func (Quiz) Fields() []ent.Field {
 	}
 }
 
+func validateDirection(s string) error {
+	if s != "en_to_ja" && s != "ja_to_en" {
+		return errors.New("direction must be en_to_ja or ja_to_en")
+	}
+	return nil
+}
+
+func validateQuestionType(s string) error {
+	if s != "choice" && s != "typing" {
+		return errors.New("question_type must be choice or typing")
+	}
+	return nil
+}
+
 // Edges of the Quiz.
 func (Quiz) Edges() []ent.Edge {
 	return []ent.Edge{
@@ -83,6 +152,10 @@ func (Quiz) Edges() []ent.Edge {
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 		edge.To("quiz_questions", QuizQuestion.Type).
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.From("deck", Deck.Type).
+			Ref("quizzes").
+			Unique().
+			Field("deck_id"),
 	}
 }
 
@@ -31,18 +31,56 @@ func (QuizQuestion) Fields() []ent.Field {
 			Positive(),
 		field.Int("correct_jpm_id").
 			Comment("correct japanese mean id"),
+		field.String("direction").
+			Default("en_to_ja").
+			Comment("en_to_ja: 英単語→意味, ja_to_en: 意味→英単語").
+			Validate(validateDirection),
+		field.String("question_type").
+			Default("choice").
+			Comment("choice: 選択式, typing: 入力式").
+			Validate(validateQuestionType),
+		field.String("meaning_name").
+			Optional().
+			Comment("ja_to_en で提示する意味"),
 		field.JSON("choices_jpms", []models.ChoiceJpm{}).
 			Comment("4 つの選択肢 (正解 + 誤答)"),
+		field.JSON("choices_words", []models.ChoiceWord{}).
+			Optional().
+			Comment("ja_to_en の選択肢 (正解 + 誤答)"),
 		field.Int("answer_jpm_id").
 			Optional().
 			Nillable().
 			Comment("answered japanese mean ids"),
+		field.Int("answer_word_id").
+			Optional().
+			Nillable().
+			Comment("ja_to_en で回答した word id"),
+		field.String("typed_answer").
+			Optional().
+			Nillable().
+			Comment("typing で入力された文字列（正規化前）"),
+		field.String("grade").
+			Optional().
+			Nillable().
+			Comment("correct / near_miss / wrong / timeout"),
+		field.Float("credit").
+			Default(0).
+			Comment("得点 (正解 1, 惜しい 0.5, 不正解 0)"),
 		field.Bool("is_correct").
 			Optional().
 			Nillable(),
+		field.Time("served_at").
+			Optional().
+			Nillable().
+			Comment("実際に出題した時刻（time_ms と制限時間の起点）"),
 		field.Time("answered_at").
 			Optional().
 			Nillable(),
+		field.String("idempotency_key").
+			Optional().
+			Nillable().
+			MaxLen(128).
+			Comment("回答時の Idempotency-Key（再送時に同じ結果を返すため）"),
 		field.Int("time_ms").
 			Optional().
 			Nillable(),
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RefreshToken はサーバー側で管理するリフレッシュトークン。
+// 使うたびに同じ family の新しいトークンへ入れ替え（ローテーション）、使用済みのものが再提示されたら family ごと失効させる。
+type RefreshToken struct {
+	ent.Schema
+}
+
+// Fields of the RefreshToken.
+func (RefreshToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive(),
+		field.String("family_id").
+			NotEmpty().
+			Comment("最初のサインインで発行した系列。ローテーションしても引き継ぐ"),
+		field.String("token_hash").
+			NotEmpty().
+			Unique().
+			Sensitive().
+			Comment("トークン本体の SHA-256（本体は保存しない）"),
+		field.Time("expires_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("used_at").
+			Optional().
+			Nillable().
+			Comment("ローテーションで使われた日時"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the RefreshToken.
+func (RefreshToken) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("refresh_tokens").
+			Unique().
+			Field("user_id").
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (RefreshToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("family_id"),
+		index.Fields("user_id", "revoked_at"),
+	}
+}
@@ -40,6 +40,29 @@ func (RegisteredWord) Fields() []ent.Field {
 			Default(0),
 		field.Int("correct_rate").
 			Default(0),
+		field.Float("ease_factor").
+			Default(2.5).
+			Comment("SM-2 ease factor"),
+		field.Int("interval_days").
+			Default(0),
+		field.Int("repetitions").
+			Default(0).
+			Comment("連続正解回数（失敗で 0 に戻る）"),
+		field.Int("lapses").
+			Default(0),
+		field.Float("stability").
+			Default(0).
+			Comment("FSRS stability (days)"),
+		field.Float("difficulty").
+			Default(0).
+			Comment("FSRS difficulty (1-10)"),
+		field.Time("due_at").
+			Optional().
+			Nillable().
+			Comment("次回復習期限。nil は未スケジュール"),
+		field.Time("last_reviewed_at").
+			Optional().
+			Nillable(),
 		field.String("memo").
 			Optional().
 			Nillable().
@@ -78,5 +101,6 @@ func (RegisteredWord) Edges() []ent.Edge {
 func (RegisteredWord) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("user_id", "word_id").Unique(),
+		index.Fields("user_id", "due_at"),
 	}
 }
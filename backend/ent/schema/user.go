@@ -67,6 +67,14 @@ func (User) Fields() []ent.Field {
 			Default(false),
 		field.Bool("isTest").
 			Default(false),
+		// メール所有の確認が済んだ日時。未確認・メール変更後は nil
+		field.Time("email_verified_at").
+			Nillable().
+			Optional(),
+		// アクセストークンの ver と照合する。上げると発行済みのアクセストークンがすべて無効になる
+		field.Int("token_version").
+			Default(0).
+			NonNegative(),
 	}
 }
 
@@ -85,6 +93,12 @@ func (User) Edges() []ent.Edge {
 		edge.To("user_daily_usage", UserDailyUsage.Type).
 			Unique().
 			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("decks", Deck.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("word_proposals", WordProposal.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+		edge.To("refresh_tokens", RefreshToken.Type).
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
 	}
 }
 
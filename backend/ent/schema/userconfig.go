@@ -3,6 +3,10 @@
 package schema
 
 import (
+	"errors"
+
+	"word_app/backend/src/domain/srs"
+
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
@@ -19,6 +23,15 @@ func (UserConfig) Fields() []ent.Field {
 			Positive(),
 		field.Bool("is_dark_mode").
 			Default(false),
+		field.String("srs_algorithm").
+			Default(srs.DefaultAlgorithm).
+			Comment("sm2 | fsrs").
+			Validate(func(s string) error {
+				if !srs.IsValidAlgorithm(s) {
+					return errors.New("srs_algorithm must be sm2 or fsrs")
+				}
+				return nil
+			}),
 		field.Time("deleted_at").
 			Nillable().
 			Optional(),
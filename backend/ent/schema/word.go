@@ -43,6 +43,11 @@ func (Word) Fields() []ent.Field {
 			Default(false),
 		field.Int("registration_count").
 			Default(0),
+		field.Int("frequency_rank").
+			Optional().
+			Nillable().
+			Positive().
+			Comment("辞書上の頻出度。1: JMdict で common の表記を持つ, 2: それ以外。小さいほど頻出。辞書由来でなければ NULL"),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
@@ -57,6 +62,7 @@ func (Word) Edges() []ent.Edge {
 		edge.To("word_infos", WordInfo.Type),
 		edge.To("registered_words", RegisteredWord.Type),
 		edge.To("quiz_questions", QuizQuestion.Type),
+		edge.To("deck_words", DeckWord.Type),
 	}
 }
 
@@ -66,5 +72,6 @@ func (Word) Edges() []ent.Edge {
 func (Word) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("registration_count"),
+		index.Fields("frequency_rank"),
 	}
 }
@@ -5,6 +5,8 @@ package schema
 import (
 	"time"
 
+	"word_app/backend/src/models"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -22,6 +24,21 @@ func (WordInfo) Fields() []ent.Field {
 			Positive(),
 		field.Int("part_of_speech_id").
 			Positive(),
+		field.Strings("pos_codes").
+			Optional().
+			Comment("辞書の品詞コード。対応表が変わったときの再分類に使う"),
+		field.Strings("field_tags").
+			Optional().
+			Comment("JMdict の分野タグ（comp, med など）"),
+		field.Strings("misc_tags").
+			Optional().
+			Comment("JMdict の用法タグ（uk, arch, col など）"),
+		field.Strings("dialect_tags").
+			Optional().
+			Comment("JMdict の方言タグ（ksb など）"),
+		field.JSON("examples", []models.WordExample{}).
+			Optional().
+			Comment("例文（日本語・英語の対）"),
 		field.Time("created_at").
 			Default(time.Now),
 		field.Time("updated_at").
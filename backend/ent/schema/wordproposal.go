@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// WordProposal は一般ユーザーからの単語マスタ変更の提案。
+// RootConfig.editing_permission が "user" のとき、admin / root の承認を経て反映する。
+type WordProposal struct {
+	ent.Schema
+}
+
+// Fields of the WordProposal.
+func (WordProposal) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Positive(),
+		field.Enum("action").
+			Values("create", "update", "delete"),
+		field.Int("word_id").
+			Optional().
+			Nillable().
+			Comment("update / delete の対象。create は承認して作成された Word の ID"),
+		field.String("word_name").
+			Comment("一覧表示用。create / update は提案後の名前、delete は対象の名前"),
+		field.Text("payload").
+			Comment("提案内容（CreateWordRequest / UpdateWordRequest / DeleteWordRequest の JSON）"),
+		field.Enum("status").
+			Values("pending", "approved", "rejected").
+			Default("pending"),
+		field.Int("reviewer_id").
+			Optional().
+			Nillable(),
+		field.String("review_note").
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the WordProposal.
+func (WordProposal) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("word_proposals").
+			Unique().
+			Field("user_id").
+			Required().
+			Annotations(entsql.Annotation{OnDelete: entsql.Cascade}),
+	}
+}
+
+func (WordProposal) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "created_at"),
+		index.Fields("user_id", "status"),
+	}
+}
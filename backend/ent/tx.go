@@ -14,16 +14,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Deck is the client for interacting with the Deck builders.
+	Deck *DeckClient
+	// DeckWord is the client for interacting with the DeckWord builders.
+	DeckWord *DeckWordClient
+	// DictImportFailure is the client for interacting with the DictImportFailure builders.
+	DictImportFailure *DictImportFailureClient
+	// DictImportRun is the client for interacting with the DictImportRun builders.
+	DictImportRun *DictImportRunClient
 	// ExternalAuth is the client for interacting with the ExternalAuth builders.
 	ExternalAuth *ExternalAuthClient
 	// JapaneseMean is the client for interacting with the JapaneseMean builders.
 	JapaneseMean *JapaneseMeanClient
 	// PartOfSpeech is the client for interacting with the PartOfSpeech builders.
 	PartOfSpeech *PartOfSpeechClient
+	// PosMapping is the client for interacting with the PosMapping builders.
+	PosMapping *PosMappingClient
+	// PosMappingVersion is the client for interacting with the PosMappingVersion builders.
+	PosMappingVersion *PosMappingVersionClient
 	// Quiz is the client for interacting with the Quiz builders.
 	Quiz *QuizClient
 	// QuizQuestion is the client for interacting with the QuizQuestion builders.
 	QuizQuestion *QuizQuestionClient
+	// RefreshToken is the client for interacting with the RefreshToken builders.
+	RefreshToken *RefreshTokenClient
 	// RegisteredWord is the client for interacting with the RegisteredWord builders.
 	RegisteredWord *RegisteredWordClient
 	// RootConfig is the client for interacting with the RootConfig builders.
@@ -38,6 +52,8 @@ type Tx struct {
 	Word *WordClient
 	// WordInfo is the client for interacting with the WordInfo builders.
 	WordInfo *WordInfoClient
+	// WordProposal is the client for interacting with the WordProposal builders.
+	WordProposal *WordProposalClient
 
 	// lazily loaded.
 	client     *Client
@@ -169,11 +185,18 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Deck = NewDeckClient(tx.config)
+	tx.DeckWord = NewDeckWordClient(tx.config)
+	tx.DictImportFailure = NewDictImportFailureClient(tx.config)
+	tx.DictImportRun = NewDictImportRunClient(tx.config)
 	tx.ExternalAuth = NewExternalAuthClient(tx.config)
 	tx.JapaneseMean = NewJapaneseMeanClient(tx.config)
 	tx.PartOfSpeech = NewPartOfSpeechClient(tx.config)
+	tx.PosMapping = NewPosMappingClient(tx.config)
+	tx.PosMappingVersion = NewPosMappingVersionClient(tx.config)
 	tx.Quiz = NewQuizClient(tx.config)
 	tx.QuizQuestion = NewQuizQuestionClient(tx.config)
+	tx.RefreshToken = NewRefreshTokenClient(tx.config)
 	tx.RegisteredWord = NewRegisteredWordClient(tx.config)
 	tx.RootConfig = NewRootConfigClient(tx.config)
 	tx.User = NewUserClient(tx.config)
@@ -181,6 +204,7 @@ func (tx *Tx) init() {
 	tx.UserDailyUsage = NewUserDailyUsageClient(tx.config)
 	tx.Word = NewWordClient(tx.config)
 	tx.WordInfo = NewWordInfoClient(tx.config)
+	tx.WordProposal = NewWordProposalClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -190,7 +214,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: ExternalAuth.QueryXXX(), the query will be executed
+// applies a query, for example: Deck.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
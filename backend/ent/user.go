@@ -38,6 +38,10 @@ type User struct {
 	IsRoot bool `json:"isRoot,omitempty"`
 	// IsTest holds the value of the "isTest" field.
 	IsTest bool `json:"isTest,omitempty"`
+	// EmailVerifiedAt holds the value of the "email_verified_at" field.
+	EmailVerifiedAt *time.Time `json:"email_verified_at,omitempty"`
+	// TokenVersion holds the value of the "token_version" field.
+	TokenVersion int `json:"token_version,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the UserQuery when eager-loading is set.
 	Edges        UserEdges `json:"edges"`
@@ -56,9 +60,15 @@ type UserEdges struct {
 	ExternalAuths []*ExternalAuth `json:"external_auths,omitempty"`
 	// UserDailyUsage holds the value of the user_daily_usage edge.
 	UserDailyUsage *UserDailyUsage `json:"user_daily_usage,omitempty"`
+	// Decks holds the value of the decks edge.
+	Decks []*Deck `json:"decks,omitempty"`
+	// WordProposals holds the value of the word_proposals edge.
+	WordProposals []*WordProposal `json:"word_proposals,omitempty"`
+	// RefreshTokens holds the value of the refresh_tokens edge.
+	RefreshTokens []*RefreshToken `json:"refresh_tokens,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [8]bool
 }
 
 // RegisteredWordsOrErr returns the RegisteredWords value or an error if the edge
@@ -110,6 +120,33 @@ func (e UserEdges) UserDailyUsageOrErr() (*UserDailyUsage, error) {
 	return nil, &NotLoadedError{edge: "user_daily_usage"}
 }
 
+// DecksOrErr returns the Decks value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) DecksOrErr() ([]*Deck, error) {
+	if e.loadedTypes[5] {
+		return e.Decks, nil
+	}
+	return nil, &NotLoadedError{edge: "decks"}
+}
+
+// WordProposalsOrErr returns the WordProposals value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WordProposalsOrErr() ([]*WordProposal, error) {
+	if e.loadedTypes[6] {
+		return e.WordProposals, nil
+	}
+	return nil, &NotLoadedError{edge: "word_proposals"}
+}
+
+// RefreshTokensOrErr returns the RefreshTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) RefreshTokensOrErr() ([]*RefreshToken, error) {
+	if e.loadedTypes[7] {
+		return e.RefreshTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "refresh_tokens"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -117,11 +154,11 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldIsAdmin, user.FieldIsRoot, user.FieldIsTest:
 			values[i] = new(sql.NullBool)
-		case user.FieldID:
+		case user.FieldID, user.FieldTokenVersion:
 			values[i] = new(sql.NullInt64)
 		case user.FieldEmail, user.FieldPassword, user.FieldName:
 			values[i] = new(sql.NullString)
-		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt:
+		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldDeletedAt, user.FieldEmailVerifiedAt:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -201,6 +238,19 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.IsTest = value.Bool
 			}
+		case user.FieldEmailVerifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field email_verified_at", values[i])
+			} else if value.Valid {
+				u.EmailVerifiedAt = new(time.Time)
+				*u.EmailVerifiedAt = value.Time
+			}
+		case user.FieldTokenVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field token_version", values[i])
+			} else if value.Valid {
+				u.TokenVersion = int(value.Int64)
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -239,6 +289,21 @@ func (u *User) QueryUserDailyUsage() *UserDailyUsageQuery {
 	return NewUserClient(u.config).QueryUserDailyUsage(u)
 }
 
+// QueryDecks queries the "decks" edge of the User entity.
+func (u *User) QueryDecks() *DeckQuery {
+	return NewUserClient(u.config).QueryDecks(u)
+}
+
+// QueryWordProposals queries the "word_proposals" edge of the User entity.
+func (u *User) QueryWordProposals() *WordProposalQuery {
+	return NewUserClient(u.config).QueryWordProposals(u)
+}
+
+// QueryRefreshTokens queries the "refresh_tokens" edge of the User entity.
+func (u *User) QueryRefreshTokens() *RefreshTokenQuery {
+	return NewUserClient(u.config).QueryRefreshTokens(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -291,6 +356,14 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("isTest=")
 	builder.WriteString(fmt.Sprintf("%v", u.IsTest))
+	builder.WriteString(", ")
+	if v := u.EmailVerifiedAt; v != nil {
+		builder.WriteString("email_verified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("token_version=")
+	builder.WriteString(fmt.Sprintf("%v", u.TokenVersion))
 	builder.WriteByte(')')
 	return builder.String()
 }
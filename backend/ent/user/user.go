@@ -32,6 +32,10 @@ const (
 	FieldIsRoot = "is_root"
 	// FieldIsTest holds the string denoting the istest field in the database.
 	FieldIsTest = "is_test"
+	// FieldEmailVerifiedAt holds the string denoting the email_verified_at field in the database.
+	FieldEmailVerifiedAt = "email_verified_at"
+	// FieldTokenVersion holds the string denoting the token_version field in the database.
+	FieldTokenVersion = "token_version"
 	// EdgeRegisteredWords holds the string denoting the registered_words edge name in mutations.
 	EdgeRegisteredWords = "registered_words"
 	// EdgeQuizzes holds the string denoting the quizzes edge name in mutations.
@@ -42,6 +46,12 @@ const (
 	EdgeExternalAuths = "external_auths"
 	// EdgeUserDailyUsage holds the string denoting the user_daily_usage edge name in mutations.
 	EdgeUserDailyUsage = "user_daily_usage"
+	// EdgeDecks holds the string denoting the decks edge name in mutations.
+	EdgeDecks = "decks"
+	// EdgeWordProposals holds the string denoting the word_proposals edge name in mutations.
+	EdgeWordProposals = "word_proposals"
+	// EdgeRefreshTokens holds the string denoting the refresh_tokens edge name in mutations.
+	EdgeRefreshTokens = "refresh_tokens"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// RegisteredWordsTable is the table that holds the registered_words relation/edge.
@@ -79,6 +89,27 @@ const (
 	UserDailyUsageInverseTable = "user_daily_usages"
 	// UserDailyUsageColumn is the table column denoting the user_daily_usage relation/edge.
 	UserDailyUsageColumn = "user_id"
+	// DecksTable is the table that holds the decks relation/edge.
+	DecksTable = "decks"
+	// DecksInverseTable is the table name for the Deck entity.
+	// It exists in this package in order to avoid circular dependency with the "deck" package.
+	DecksInverseTable = "decks"
+	// DecksColumn is the table column denoting the decks relation/edge.
+	DecksColumn = "user_id"
+	// WordProposalsTable is the table that holds the word_proposals relation/edge.
+	WordProposalsTable = "word_proposals"
+	// WordProposalsInverseTable is the table name for the WordProposal entity.
+	// It exists in this package in order to avoid circular dependency with the "wordproposal" package.
+	WordProposalsInverseTable = "word_proposals"
+	// WordProposalsColumn is the table column denoting the word_proposals relation/edge.
+	WordProposalsColumn = "user_id"
+	// RefreshTokensTable is the table that holds the refresh_tokens relation/edge.
+	RefreshTokensTable = "refresh_tokens"
+	// RefreshTokensInverseTable is the table name for the RefreshToken entity.
+	// It exists in this package in order to avoid circular dependency with the "refreshtoken" package.
+	RefreshTokensInverseTable = "refresh_tokens"
+	// RefreshTokensColumn is the table column denoting the refresh_tokens relation/edge.
+	RefreshTokensColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -93,6 +124,8 @@ var Columns = []string{
 	FieldIsAdmin,
 	FieldIsRoot,
 	FieldIsTest,
+	FieldEmailVerifiedAt,
+	FieldTokenVersion,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -124,6 +157,10 @@ var (
 	DefaultIsRoot bool
 	// DefaultIsTest holds the default value on creation for the "isTest" field.
 	DefaultIsTest bool
+	// DefaultTokenVersion holds the default value on creation for the "token_version" field.
+	DefaultTokenVersion int
+	// TokenVersionValidator is a validator for the "token_version" field. It is called by the builders before save.
+	TokenVersionValidator func(int) error
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -179,6 +216,16 @@ func ByIsTest(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsTest, opts...).ToFunc()
 }
 
+// ByEmailVerifiedAt orders the results by the email_verified_at field.
+func ByEmailVerifiedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmailVerifiedAt, opts...).ToFunc()
+}
+
+// ByTokenVersion orders the results by the token_version field.
+func ByTokenVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTokenVersion, opts...).ToFunc()
+}
+
 // ByRegisteredWordsCount orders the results by registered_words count.
 func ByRegisteredWordsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -234,6 +281,48 @@ func ByUserDailyUsageField(field string, opts ...sql.OrderTermOption) OrderOptio
 		sqlgraph.OrderByNeighborTerms(s, newUserDailyUsageStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByDecksCount orders the results by decks count.
+func ByDecksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDecksStep(), opts...)
+	}
+}
+
+// ByDecks orders the results by decks terms.
+func ByDecks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDecksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWordProposalsCount orders the results by word_proposals count.
+func ByWordProposalsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWordProposalsStep(), opts...)
+	}
+}
+
+// ByWordProposals orders the results by word_proposals terms.
+func ByWordProposals(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWordProposalsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRefreshTokensCount orders the results by refresh_tokens count.
+func ByRefreshTokensCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRefreshTokensStep(), opts...)
+	}
+}
+
+// ByRefreshTokens orders the results by refresh_tokens terms.
+func ByRefreshTokens(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRefreshTokensStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newRegisteredWordsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -269,3 +358,24 @@ func newUserDailyUsageStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, UserDailyUsageTable, UserDailyUsageColumn),
 	)
 }
+func newDecksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DecksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DecksTable, DecksColumn),
+	)
+}
+func newWordProposalsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WordProposalsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WordProposalsTable, WordProposalsColumn),
+	)
+}
+func newRefreshTokensStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RefreshTokensInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RefreshTokensTable, RefreshTokensColumn),
+	)
+}
@@ -7,13 +7,16 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"word_app/backend/ent/deck"
 	"word_app/backend/ent/externalauth"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/refreshtoken"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/user"
 	"word_app/backend/ent/userconfig"
 	"word_app/backend/ent/userdailyusage"
+	"word_app/backend/ent/wordproposal"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -33,6 +36,9 @@ type UserQuery struct {
 	withUserConfig      *UserConfigQuery
 	withExternalAuths   *ExternalAuthQuery
 	withUserDailyUsage  *UserDailyUsageQuery
+	withDecks           *DeckQuery
+	withWordProposals   *WordProposalQuery
+	withRefreshTokens   *RefreshTokenQuery
 	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -180,6 +186,72 @@ func (uq *UserQuery) QueryUserDailyUsage() *UserDailyUsageQuery {
 	return query
 }
 
+// QueryDecks chains the current query on the "decks" edge.
+func (uq *UserQuery) QueryDecks() *DeckQuery {
+	query := (&DeckClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(deck.Table, deck.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.DecksTable, user.DecksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWordProposals chains the current query on the "word_proposals" edge.
+func (uq *UserQuery) QueryWordProposals() *WordProposalQuery {
+	query := (&WordProposalClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(wordproposal.Table, wordproposal.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WordProposalsTable, user.WordProposalsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRefreshTokens chains the current query on the "refresh_tokens" edge.
+func (uq *UserQuery) QueryRefreshTokens() *RefreshTokenQuery {
+	query := (&RefreshTokenClient{config: uq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := uq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := uq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(refreshtoken.Table, refreshtoken.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.RefreshTokensTable, user.RefreshTokensColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(uq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (uq *UserQuery) First(ctx context.Context) (*User, error) {
@@ -377,6 +449,9 @@ func (uq *UserQuery) Clone() *UserQuery {
 		withUserConfig:      uq.withUserConfig.Clone(),
 		withExternalAuths:   uq.withExternalAuths.Clone(),
 		withUserDailyUsage:  uq.withUserDailyUsage.Clone(),
+		withDecks:           uq.withDecks.Clone(),
+		withWordProposals:   uq.withWordProposals.Clone(),
+		withRefreshTokens:   uq.withRefreshTokens.Clone(),
 		// clone intermediate query.
 		sql:       uq.sql.Clone(),
 		path:      uq.path,
@@ -439,6 +514,39 @@ func (uq *UserQuery) WithUserDailyUsage(opts ...func(*UserDailyUsageQuery)) *Use
 	return uq
 }
 
+// WithDecks tells the query-builder to eager-load the nodes that are connected to
+// the "decks" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithDecks(opts ...func(*DeckQuery)) *UserQuery {
+	query := (&DeckClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withDecks = query
+	return uq
+}
+
+// WithWordProposals tells the query-builder to eager-load the nodes that are connected to
+// the "word_proposals" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithWordProposals(opts ...func(*WordProposalQuery)) *UserQuery {
+	query := (&WordProposalClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withWordProposals = query
+	return uq
+}
+
+// WithRefreshTokens tells the query-builder to eager-load the nodes that are connected to
+// the "refresh_tokens" edge. The optional arguments are used to configure the query builder of the edge.
+func (uq *UserQuery) WithRefreshTokens(opts ...func(*RefreshTokenQuery)) *UserQuery {
+	query := (&RefreshTokenClient{config: uq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	uq.withRefreshTokens = query
+	return uq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -517,12 +625,15 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = uq.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [8]bool{
 			uq.withRegisteredWords != nil,
 			uq.withQuizzes != nil,
 			uq.withUserConfig != nil,
 			uq.withExternalAuths != nil,
 			uq.withUserDailyUsage != nil,
+			uq.withDecks != nil,
+			uq.withWordProposals != nil,
+			uq.withRefreshTokens != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -579,6 +690,27 @@ func (uq *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := uq.withDecks; query != nil {
+		if err := uq.loadDecks(ctx, query, nodes,
+			func(n *User) { n.Edges.Decks = []*Deck{} },
+			func(n *User, e *Deck) { n.Edges.Decks = append(n.Edges.Decks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withWordProposals; query != nil {
+		if err := uq.loadWordProposals(ctx, query, nodes,
+			func(n *User) { n.Edges.WordProposals = []*WordProposal{} },
+			func(n *User, e *WordProposal) { n.Edges.WordProposals = append(n.Edges.WordProposals, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := uq.withRefreshTokens; query != nil {
+		if err := uq.loadRefreshTokens(ctx, query, nodes,
+			func(n *User) { n.Edges.RefreshTokens = []*RefreshToken{} },
+			func(n *User, e *RefreshToken) { n.Edges.RefreshTokens = append(n.Edges.RefreshTokens, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -726,6 +858,96 @@ func (uq *UserQuery) loadUserDailyUsage(ctx context.Context, query *UserDailyUsa
 	}
 	return nil
 }
+func (uq *UserQuery) loadDecks(ctx context.Context, query *DeckQuery, nodes []*User, init func(*User), assign func(*User, *Deck)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deck.FieldUserID)
+	}
+	query.Where(predicate.Deck(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.DecksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadWordProposals(ctx context.Context, query *WordProposalQuery, nodes []*User, init func(*User), assign func(*User, *WordProposal)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(wordproposal.FieldUserID)
+	}
+	query.Where(predicate.WordProposal(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WordProposalsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (uq *UserQuery) loadRefreshTokens(ctx context.Context, query *RefreshTokenQuery, nodes []*User, init func(*User), assign func(*User, *RefreshToken)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(refreshtoken.FieldUserID)
+	}
+	query.Where(predicate.RefreshToken(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.RefreshTokensColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (uq *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := uq.querySpec()
@@ -22,6 +22,8 @@ type UserConfig struct {
 	UserID int `json:"user_id,omitempty"`
 	// IsDarkMode holds the value of the "is_dark_mode" field.
 	IsDarkMode bool `json:"is_dark_mode,omitempty"`
+	// sm2 | fsrs
+	SrsAlgorithm string `json:"srs_algorithm,omitempty"`
 	// DeletedAt holds the value of the "deleted_at" field.
 	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -59,6 +61,8 @@ func (*UserConfig) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case userconfig.FieldID, userconfig.FieldUserID:
 			values[i] = new(sql.NullInt64)
+		case userconfig.FieldSrsAlgorithm:
+			values[i] = new(sql.NullString)
 		case userconfig.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
 		default:
@@ -94,6 +98,12 @@ func (uc *UserConfig) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				uc.IsDarkMode = value.Bool
 			}
+		case userconfig.FieldSrsAlgorithm:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field srs_algorithm", values[i])
+			} else if value.Valid {
+				uc.SrsAlgorithm = value.String
+			}
 		case userconfig.FieldDeletedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
@@ -148,6 +158,9 @@ func (uc *UserConfig) String() string {
 	builder.WriteString("is_dark_mode=")
 	builder.WriteString(fmt.Sprintf("%v", uc.IsDarkMode))
 	builder.WriteString(", ")
+	builder.WriteString("srs_algorithm=")
+	builder.WriteString(uc.SrsAlgorithm)
+	builder.WriteString(", ")
 	if v := uc.DeletedAt; v != nil {
 		builder.WriteString("deleted_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -16,6 +16,8 @@ const (
 	FieldUserID = "user_id"
 	// FieldIsDarkMode holds the string denoting the is_dark_mode field in the database.
 	FieldIsDarkMode = "is_dark_mode"
+	// FieldSrsAlgorithm holds the string denoting the srs_algorithm field in the database.
+	FieldSrsAlgorithm = "srs_algorithm"
 	// FieldDeletedAt holds the string denoting the deleted_at field in the database.
 	FieldDeletedAt = "deleted_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -36,6 +38,7 @@ var Columns = []string{
 	FieldID,
 	FieldUserID,
 	FieldIsDarkMode,
+	FieldSrsAlgorithm,
 	FieldDeletedAt,
 }
 
@@ -54,6 +57,10 @@ var (
 	UserIDValidator func(int) error
 	// DefaultIsDarkMode holds the default value on creation for the "is_dark_mode" field.
 	DefaultIsDarkMode bool
+	// DefaultSrsAlgorithm holds the default value on creation for the "srs_algorithm" field.
+	DefaultSrsAlgorithm string
+	// SrsAlgorithmValidator is a validator for the "srs_algorithm" field. It is called by the builders before save.
+	SrsAlgorithmValidator func(string) error
 )
 
 // OrderOption defines the ordering options for the UserConfig queries.
@@ -74,6 +81,11 @@ func ByIsDarkMode(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsDarkMode, opts...).ToFunc()
 }
 
+// BySrsAlgorithm orders the results by the srs_algorithm field.
+func BySrsAlgorithm(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSrsAlgorithm, opts...).ToFunc()
+}
+
 // ByDeletedAt orders the results by the deleted_at field.
 func ByDeletedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedAt, opts...).ToFunc()
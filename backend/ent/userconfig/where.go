@@ -65,6 +65,11 @@ func IsDarkMode(v bool) predicate.UserConfig {
 	return predicate.UserConfig(sql.FieldEQ(FieldIsDarkMode, v))
 }
 
+// SrsAlgorithm applies equality check predicate on the "srs_algorithm" field. It's identical to SrsAlgorithmEQ.
+func SrsAlgorithm(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldEQ(FieldSrsAlgorithm, v))
+}
+
 // DeletedAt applies equality check predicate on the "deleted_at" field. It's identical to DeletedAtEQ.
 func DeletedAt(v time.Time) predicate.UserConfig {
 	return predicate.UserConfig(sql.FieldEQ(FieldDeletedAt, v))
@@ -100,6 +105,71 @@ func IsDarkModeNEQ(v bool) predicate.UserConfig {
 	return predicate.UserConfig(sql.FieldNEQ(FieldIsDarkMode, v))
 }
 
+// SrsAlgorithmEQ applies the EQ predicate on the "srs_algorithm" field.
+func SrsAlgorithmEQ(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldEQ(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmNEQ applies the NEQ predicate on the "srs_algorithm" field.
+func SrsAlgorithmNEQ(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldNEQ(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmIn applies the In predicate on the "srs_algorithm" field.
+func SrsAlgorithmIn(vs ...string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldIn(FieldSrsAlgorithm, vs...))
+}
+
+// SrsAlgorithmNotIn applies the NotIn predicate on the "srs_algorithm" field.
+func SrsAlgorithmNotIn(vs ...string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldNotIn(FieldSrsAlgorithm, vs...))
+}
+
+// SrsAlgorithmGT applies the GT predicate on the "srs_algorithm" field.
+func SrsAlgorithmGT(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldGT(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmGTE applies the GTE predicate on the "srs_algorithm" field.
+func SrsAlgorithmGTE(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldGTE(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmLT applies the LT predicate on the "srs_algorithm" field.
+func SrsAlgorithmLT(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldLT(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmLTE applies the LTE predicate on the "srs_algorithm" field.
+func SrsAlgorithmLTE(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldLTE(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmContains applies the Contains predicate on the "srs_algorithm" field.
+func SrsAlgorithmContains(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldContains(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmHasPrefix applies the HasPrefix predicate on the "srs_algorithm" field.
+func SrsAlgorithmHasPrefix(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldHasPrefix(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmHasSuffix applies the HasSuffix predicate on the "srs_algorithm" field.
+func SrsAlgorithmHasSuffix(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldHasSuffix(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmEqualFold applies the EqualFold predicate on the "srs_algorithm" field.
+func SrsAlgorithmEqualFold(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldEqualFold(FieldSrsAlgorithm, v))
+}
+
+// SrsAlgorithmContainsFold applies the ContainsFold predicate on the "srs_algorithm" field.
+func SrsAlgorithmContainsFold(v string) predicate.UserConfig {
+	return predicate.UserConfig(sql.FieldContainsFold(FieldSrsAlgorithm, v))
+}
+
 // DeletedAtEQ applies the EQ predicate on the "deleted_at" field.
 func DeletedAtEQ(v time.Time) predicate.UserConfig {
 	return predicate.UserConfig(sql.FieldEQ(FieldDeletedAt, v))
@@ -43,6 +43,20 @@ func (ucc *UserConfigCreate) SetNillableIsDarkMode(b *bool) *UserConfigCreate {
 	return ucc
 }
 
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (ucc *UserConfigCreate) SetSrsAlgorithm(s string) *UserConfigCreate {
+	ucc.mutation.SetSrsAlgorithm(s)
+	return ucc
+}
+
+// SetNillableSrsAlgorithm sets the "srs_algorithm" field if the given value is not nil.
+func (ucc *UserConfigCreate) SetNillableSrsAlgorithm(s *string) *UserConfigCreate {
+	if s != nil {
+		ucc.SetSrsAlgorithm(*s)
+	}
+	return ucc
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (ucc *UserConfigCreate) SetDeletedAt(t time.Time) *UserConfigCreate {
 	ucc.mutation.SetDeletedAt(t)
@@ -101,6 +115,10 @@ func (ucc *UserConfigCreate) defaults() {
 		v := userconfig.DefaultIsDarkMode
 		ucc.mutation.SetIsDarkMode(v)
 	}
+	if _, ok := ucc.mutation.SrsAlgorithm(); !ok {
+		v := userconfig.DefaultSrsAlgorithm
+		ucc.mutation.SetSrsAlgorithm(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -116,6 +134,14 @@ func (ucc *UserConfigCreate) check() error {
 	if _, ok := ucc.mutation.IsDarkMode(); !ok {
 		return &ValidationError{Name: "is_dark_mode", err: errors.New(`ent: missing required field "UserConfig.is_dark_mode"`)}
 	}
+	if _, ok := ucc.mutation.SrsAlgorithm(); !ok {
+		return &ValidationError{Name: "srs_algorithm", err: errors.New(`ent: missing required field "UserConfig.srs_algorithm"`)}
+	}
+	if v, ok := ucc.mutation.SrsAlgorithm(); ok {
+		if err := userconfig.SrsAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "srs_algorithm", err: fmt.Errorf(`ent: validator failed for field "UserConfig.srs_algorithm": %w`, err)}
+		}
+	}
 	if len(ucc.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "UserConfig.user"`)}
 	}
@@ -150,6 +176,10 @@ func (ucc *UserConfigCreate) createSpec() (*UserConfig, *sqlgraph.CreateSpec) {
 		_spec.SetField(userconfig.FieldIsDarkMode, field.TypeBool, value)
 		_node.IsDarkMode = value
 	}
+	if value, ok := ucc.mutation.SrsAlgorithm(); ok {
+		_spec.SetField(userconfig.FieldSrsAlgorithm, field.TypeString, value)
+		_node.SrsAlgorithm = value
+	}
 	if value, ok := ucc.mutation.DeletedAt(); ok {
 		_spec.SetField(userconfig.FieldDeletedAt, field.TypeTime, value)
 		_node.DeletedAt = &value
@@ -247,6 +277,18 @@ func (u *UserConfigUpsert) UpdateIsDarkMode() *UserConfigUpsert {
 	return u
 }
 
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (u *UserConfigUpsert) SetSrsAlgorithm(v string) *UserConfigUpsert {
+	u.Set(userconfig.FieldSrsAlgorithm, v)
+	return u
+}
+
+// UpdateSrsAlgorithm sets the "srs_algorithm" field to the value that was provided on create.
+func (u *UserConfigUpsert) UpdateSrsAlgorithm() *UserConfigUpsert {
+	u.SetExcluded(userconfig.FieldSrsAlgorithm)
+	return u
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *UserConfigUpsert) SetDeletedAt(v time.Time) *UserConfigUpsert {
 	u.Set(userconfig.FieldDeletedAt, v)
@@ -333,6 +375,20 @@ func (u *UserConfigUpsertOne) UpdateIsDarkMode() *UserConfigUpsertOne {
 	})
 }
 
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (u *UserConfigUpsertOne) SetSrsAlgorithm(v string) *UserConfigUpsertOne {
+	return u.Update(func(s *UserConfigUpsert) {
+		s.SetSrsAlgorithm(v)
+	})
+}
+
+// UpdateSrsAlgorithm sets the "srs_algorithm" field to the value that was provided on create.
+func (u *UserConfigUpsertOne) UpdateSrsAlgorithm() *UserConfigUpsertOne {
+	return u.Update(func(s *UserConfigUpsert) {
+		s.UpdateSrsAlgorithm()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *UserConfigUpsertOne) SetDeletedAt(v time.Time) *UserConfigUpsertOne {
 	return u.Update(func(s *UserConfigUpsert) {
@@ -586,6 +642,20 @@ func (u *UserConfigUpsertBulk) UpdateIsDarkMode() *UserConfigUpsertBulk {
 	})
 }
 
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (u *UserConfigUpsertBulk) SetSrsAlgorithm(v string) *UserConfigUpsertBulk {
+	return u.Update(func(s *UserConfigUpsert) {
+		s.SetSrsAlgorithm(v)
+	})
+}
+
+// UpdateSrsAlgorithm sets the "srs_algorithm" field to the value that was provided on create.
+func (u *UserConfigUpsertBulk) UpdateSrsAlgorithm() *UserConfigUpsertBulk {
+	return u.Update(func(s *UserConfigUpsert) {
+		s.UpdateSrsAlgorithm()
+	})
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (u *UserConfigUpsertBulk) SetDeletedAt(v time.Time) *UserConfigUpsertBulk {
 	return u.Update(func(s *UserConfigUpsert) {
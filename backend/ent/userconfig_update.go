@@ -58,6 +58,20 @@ func (ucu *UserConfigUpdate) SetNillableIsDarkMode(b *bool) *UserConfigUpdate {
 	return ucu
 }
 
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (ucu *UserConfigUpdate) SetSrsAlgorithm(s string) *UserConfigUpdate {
+	ucu.mutation.SetSrsAlgorithm(s)
+	return ucu
+}
+
+// SetNillableSrsAlgorithm sets the "srs_algorithm" field if the given value is not nil.
+func (ucu *UserConfigUpdate) SetNillableSrsAlgorithm(s *string) *UserConfigUpdate {
+	if s != nil {
+		ucu.SetSrsAlgorithm(*s)
+	}
+	return ucu
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (ucu *UserConfigUpdate) SetDeletedAt(t time.Time) *UserConfigUpdate {
 	ucu.mutation.SetDeletedAt(t)
@@ -128,6 +142,11 @@ func (ucu *UserConfigUpdate) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserConfig.user_id": %w`, err)}
 		}
 	}
+	if v, ok := ucu.mutation.SrsAlgorithm(); ok {
+		if err := userconfig.SrsAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "srs_algorithm", err: fmt.Errorf(`ent: validator failed for field "UserConfig.srs_algorithm": %w`, err)}
+		}
+	}
 	if ucu.mutation.UserCleared() && len(ucu.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UserConfig.user"`)
 	}
@@ -155,6 +174,9 @@ func (ucu *UserConfigUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := ucu.mutation.IsDarkMode(); ok {
 		_spec.SetField(userconfig.FieldIsDarkMode, field.TypeBool, value)
 	}
+	if value, ok := ucu.mutation.SrsAlgorithm(); ok {
+		_spec.SetField(userconfig.FieldSrsAlgorithm, field.TypeString, value)
+	}
 	if value, ok := ucu.mutation.DeletedAt(); ok {
 		_spec.SetField(userconfig.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -240,6 +262,20 @@ func (ucuo *UserConfigUpdateOne) SetNillableIsDarkMode(b *bool) *UserConfigUpdat
 	return ucuo
 }
 
+// SetSrsAlgorithm sets the "srs_algorithm" field.
+func (ucuo *UserConfigUpdateOne) SetSrsAlgorithm(s string) *UserConfigUpdateOne {
+	ucuo.mutation.SetSrsAlgorithm(s)
+	return ucuo
+}
+
+// SetNillableSrsAlgorithm sets the "srs_algorithm" field if the given value is not nil.
+func (ucuo *UserConfigUpdateOne) SetNillableSrsAlgorithm(s *string) *UserConfigUpdateOne {
+	if s != nil {
+		ucuo.SetSrsAlgorithm(*s)
+	}
+	return ucuo
+}
+
 // SetDeletedAt sets the "deleted_at" field.
 func (ucuo *UserConfigUpdateOne) SetDeletedAt(t time.Time) *UserConfigUpdateOne {
 	ucuo.mutation.SetDeletedAt(t)
@@ -323,6 +359,11 @@ func (ucuo *UserConfigUpdateOne) check() error {
 			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "UserConfig.user_id": %w`, err)}
 		}
 	}
+	if v, ok := ucuo.mutation.SrsAlgorithm(); ok {
+		if err := userconfig.SrsAlgorithmValidator(v); err != nil {
+			return &ValidationError{Name: "srs_algorithm", err: fmt.Errorf(`ent: validator failed for field "UserConfig.srs_algorithm": %w`, err)}
+		}
+	}
 	if ucuo.mutation.UserCleared() && len(ucuo.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "UserConfig.user"`)
 	}
@@ -367,6 +408,9 @@ func (ucuo *UserConfigUpdateOne) sqlSave(ctx context.Context) (_node *UserConfig
 	if value, ok := ucuo.mutation.IsDarkMode(); ok {
 		_spec.SetField(userconfig.FieldIsDarkMode, field.TypeBool, value)
 	}
+	if value, ok := ucuo.mutation.SrsAlgorithm(); ok {
+		_spec.SetField(userconfig.FieldSrsAlgorithm, field.TypeString, value)
+	}
 	if value, ok := ucuo.mutation.DeletedAt(); ok {
 		_spec.SetField(userconfig.FieldDeletedAt, field.TypeTime, value)
 	}
@@ -27,6 +27,8 @@ type Word struct {
 	IsSpecialCharacters bool `json:"is_special_characters,omitempty"`
 	// RegistrationCount holds the value of the "registration_count" field.
 	RegistrationCount int `json:"registration_count,omitempty"`
+	// 辞書上の頻出度。1: JMdict で common の表記を持つ, 2: それ以外。小さいほど頻出。辞書由来でなければ NULL
+	FrequencyRank *int `json:"frequency_rank,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -45,9 +47,11 @@ type WordEdges struct {
 	RegisteredWords []*RegisteredWord `json:"registered_words,omitempty"`
 	// QuizQuestions holds the value of the quiz_questions edge.
 	QuizQuestions []*QuizQuestion `json:"quiz_questions,omitempty"`
+	// DeckWords holds the value of the deck_words edge.
+	DeckWords []*DeckWord `json:"deck_words,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [4]bool
 }
 
 // WordInfosOrErr returns the WordInfos value or an error if the edge
@@ -77,6 +81,15 @@ func (e WordEdges) QuizQuestionsOrErr() ([]*QuizQuestion, error) {
 	return nil, &NotLoadedError{edge: "quiz_questions"}
 }
 
+// DeckWordsOrErr returns the DeckWords value or an error if the edge
+// was not loaded in eager-loading.
+func (e WordEdges) DeckWordsOrErr() ([]*DeckWord, error) {
+	if e.loadedTypes[3] {
+		return e.DeckWords, nil
+	}
+	return nil, &NotLoadedError{edge: "deck_words"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Word) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -84,7 +97,7 @@ func (*Word) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case word.FieldIsIdioms, word.FieldIsSpecialCharacters:
 			values[i] = new(sql.NullBool)
-		case word.FieldID, word.FieldRegistrationCount:
+		case word.FieldID, word.FieldRegistrationCount, word.FieldFrequencyRank:
 			values[i] = new(sql.NullInt64)
 		case word.FieldName, word.FieldVoiceID:
 			values[i] = new(sql.NullString)
@@ -142,6 +155,13 @@ func (w *Word) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				w.RegistrationCount = int(value.Int64)
 			}
+		case word.FieldFrequencyRank:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field frequency_rank", values[i])
+			} else if value.Valid {
+				w.FrequencyRank = new(int)
+				*w.FrequencyRank = int(value.Int64)
+			}
 		case word.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -182,6 +202,11 @@ func (w *Word) QueryQuizQuestions() *QuizQuestionQuery {
 	return NewWordClient(w.config).QueryQuizQuestions(w)
 }
 
+// QueryDeckWords queries the "deck_words" edge of the Word entity.
+func (w *Word) QueryDeckWords() *DeckWordQuery {
+	return NewWordClient(w.config).QueryDeckWords(w)
+}
+
 // Update returns a builder for updating this Word.
 // Note that you need to call Word.Unwrap() before calling this method if this Word
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -222,6 +247,11 @@ func (w *Word) String() string {
 	builder.WriteString("registration_count=")
 	builder.WriteString(fmt.Sprintf("%v", w.RegistrationCount))
 	builder.WriteString(", ")
+	if v := w.FrequencyRank; v != nil {
+		builder.WriteString("frequency_rank=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(w.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -80,6 +80,11 @@ func RegistrationCount(v int) predicate.Word {
 	return predicate.Word(sql.FieldEQ(FieldRegistrationCount, v))
 }
 
+// FrequencyRank applies equality check predicate on the "frequency_rank" field. It's identical to FrequencyRankEQ.
+func FrequencyRank(v int) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldFrequencyRank, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Word {
 	return predicate.Word(sql.FieldEQ(FieldCreatedAt, v))
@@ -290,6 +295,56 @@ func RegistrationCountLTE(v int) predicate.Word {
 	return predicate.Word(sql.FieldLTE(FieldRegistrationCount, v))
 }
 
+// FrequencyRankEQ applies the EQ predicate on the "frequency_rank" field.
+func FrequencyRankEQ(v int) predicate.Word {
+	return predicate.Word(sql.FieldEQ(FieldFrequencyRank, v))
+}
+
+// FrequencyRankNEQ applies the NEQ predicate on the "frequency_rank" field.
+func FrequencyRankNEQ(v int) predicate.Word {
+	return predicate.Word(sql.FieldNEQ(FieldFrequencyRank, v))
+}
+
+// FrequencyRankIn applies the In predicate on the "frequency_rank" field.
+func FrequencyRankIn(vs ...int) predicate.Word {
+	return predicate.Word(sql.FieldIn(FieldFrequencyRank, vs...))
+}
+
+// FrequencyRankNotIn applies the NotIn predicate on the "frequency_rank" field.
+func FrequencyRankNotIn(vs ...int) predicate.Word {
+	return predicate.Word(sql.FieldNotIn(FieldFrequencyRank, vs...))
+}
+
+// FrequencyRankGT applies the GT predicate on the "frequency_rank" field.
+func FrequencyRankGT(v int) predicate.Word {
+	return predicate.Word(sql.FieldGT(FieldFrequencyRank, v))
+}
+
+// FrequencyRankGTE applies the GTE predicate on the "frequency_rank" field.
+func FrequencyRankGTE(v int) predicate.Word {
+	return predicate.Word(sql.FieldGTE(FieldFrequencyRank, v))
+}
+
+// FrequencyRankLT applies the LT predicate on the "frequency_rank" field.
+func FrequencyRankLT(v int) predicate.Word {
+	return predicate.Word(sql.FieldLT(FieldFrequencyRank, v))
+}
+
+// FrequencyRankLTE applies the LTE predicate on the "frequency_rank" field.
+func FrequencyRankLTE(v int) predicate.Word {
+	return predicate.Word(sql.FieldLTE(FieldFrequencyRank, v))
+}
+
+// FrequencyRankIsNil applies the IsNil predicate on the "frequency_rank" field.
+func FrequencyRankIsNil() predicate.Word {
+	return predicate.Word(sql.FieldIsNull(FieldFrequencyRank))
+}
+
+// FrequencyRankNotNil applies the NotNil predicate on the "frequency_rank" field.
+func FrequencyRankNotNil() predicate.Word {
+	return predicate.Word(sql.FieldNotNull(FieldFrequencyRank))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Word {
 	return predicate.Word(sql.FieldEQ(FieldCreatedAt, v))
@@ -439,6 +494,29 @@ func HasQuizQuestionsWith(preds ...predicate.QuizQuestion) predicate.Word {
 	})
 }
 
+// HasDeckWords applies the HasEdge predicate on the "deck_words" edge.
+func HasDeckWords() predicate.Word {
+	return predicate.Word(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, DeckWordsTable, DeckWordsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasDeckWordsWith applies the HasEdge predicate on the "deck_words" edge with a given conditions (other predicates).
+func HasDeckWordsWith(preds ...predicate.DeckWord) predicate.Word {
+	return predicate.Word(func(s *sql.Selector) {
+		step := newDeckWordsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Word) predicate.Word {
 	return predicate.Word(sql.AndPredicates(predicates...))
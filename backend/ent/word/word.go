@@ -24,6 +24,8 @@ const (
 	FieldIsSpecialCharacters = "is_special_characters"
 	// FieldRegistrationCount holds the string denoting the registration_count field in the database.
 	FieldRegistrationCount = "registration_count"
+	// FieldFrequencyRank holds the string denoting the frequency_rank field in the database.
+	FieldFrequencyRank = "frequency_rank"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +36,8 @@ const (
 	EdgeRegisteredWords = "registered_words"
 	// EdgeQuizQuestions holds the string denoting the quiz_questions edge name in mutations.
 	EdgeQuizQuestions = "quiz_questions"
+	// EdgeDeckWords holds the string denoting the deck_words edge name in mutations.
+	EdgeDeckWords = "deck_words"
 	// Table holds the table name of the word in the database.
 	Table = "words"
 	// WordInfosTable is the table that holds the word_infos relation/edge.
@@ -57,6 +61,13 @@ const (
 	QuizQuestionsInverseTable = "quiz_questions"
 	// QuizQuestionsColumn is the table column denoting the quiz_questions relation/edge.
 	QuizQuestionsColumn = "word_id"
+	// DeckWordsTable is the table that holds the deck_words relation/edge.
+	DeckWordsTable = "deck_words"
+	// DeckWordsInverseTable is the table name for the DeckWord entity.
+	// It exists in this package in order to avoid circular dependency with the "deckword" package.
+	DeckWordsInverseTable = "deck_words"
+	// DeckWordsColumn is the table column denoting the deck_words relation/edge.
+	DeckWordsColumn = "word_id"
 )
 
 // Columns holds all SQL columns for word fields.
@@ -67,6 +78,7 @@ var Columns = []string{
 	FieldIsIdioms,
 	FieldIsSpecialCharacters,
 	FieldRegistrationCount,
+	FieldFrequencyRank,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -90,6 +102,8 @@ var (
 	DefaultIsSpecialCharacters bool
 	// DefaultRegistrationCount holds the default value on creation for the "registration_count" field.
 	DefaultRegistrationCount int
+	// FrequencyRankValidator is a validator for the "frequency_rank" field. It is called by the builders before save.
+	FrequencyRankValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -131,6 +145,11 @@ func ByRegistrationCount(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldRegistrationCount, opts...).ToFunc()
 }
 
+// ByFrequencyRank orders the results by the frequency_rank field.
+func ByFrequencyRank(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrequencyRank, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -182,6 +201,20 @@ func ByQuizQuestions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newQuizQuestionsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByDeckWordsCount orders the results by deck_words count.
+func ByDeckWordsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newDeckWordsStep(), opts...)
+	}
+}
+
+// ByDeckWords orders the results by deck_words terms.
+func ByDeckWords(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newDeckWordsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newWordInfosStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -203,3 +236,10 @@ func newQuizQuestionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, QuizQuestionsTable, QuizQuestionsColumn),
 	)
 }
+func newDeckWordsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(DeckWordsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, DeckWordsTable, DeckWordsColumn),
+	)
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"word_app/backend/ent/deckword"
 	"word_app/backend/ent/quizquestion"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/word"
@@ -87,6 +88,20 @@ func (wc *WordCreate) SetNillableRegistrationCount(i *int) *WordCreate {
 	return wc
 }
 
+// SetFrequencyRank sets the "frequency_rank" field.
+func (wc *WordCreate) SetFrequencyRank(i int) *WordCreate {
+	wc.mutation.SetFrequencyRank(i)
+	return wc
+}
+
+// SetNillableFrequencyRank sets the "frequency_rank" field if the given value is not nil.
+func (wc *WordCreate) SetNillableFrequencyRank(i *int) *WordCreate {
+	if i != nil {
+		wc.SetFrequencyRank(*i)
+	}
+	return wc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wc *WordCreate) SetCreatedAt(t time.Time) *WordCreate {
 	wc.mutation.SetCreatedAt(t)
@@ -160,6 +175,21 @@ func (wc *WordCreate) AddQuizQuestions(q ...*QuizQuestion) *WordCreate {
 	return wc.AddQuizQuestionIDs(ids...)
 }
 
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by IDs.
+func (wc *WordCreate) AddDeckWordIDs(ids ...int) *WordCreate {
+	wc.mutation.AddDeckWordIDs(ids...)
+	return wc
+}
+
+// AddDeckWords adds the "deck_words" edges to the DeckWord entity.
+func (wc *WordCreate) AddDeckWords(d ...*DeckWord) *WordCreate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return wc.AddDeckWordIDs(ids...)
+}
+
 // Mutation returns the WordMutation object of the builder.
 func (wc *WordCreate) Mutation() *WordMutation {
 	return wc.mutation
@@ -236,6 +266,11 @@ func (wc *WordCreate) check() error {
 	if _, ok := wc.mutation.RegistrationCount(); !ok {
 		return &ValidationError{Name: "registration_count", err: errors.New(`ent: missing required field "Word.registration_count"`)}
 	}
+	if v, ok := wc.mutation.FrequencyRank(); ok {
+		if err := word.FrequencyRankValidator(v); err != nil {
+			return &ValidationError{Name: "frequency_rank", err: fmt.Errorf(`ent: validator failed for field "Word.frequency_rank": %w`, err)}
+		}
+	}
 	if _, ok := wc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Word.created_at"`)}
 	}
@@ -289,6 +324,10 @@ func (wc *WordCreate) createSpec() (*Word, *sqlgraph.CreateSpec) {
 		_spec.SetField(word.FieldRegistrationCount, field.TypeInt, value)
 		_node.RegistrationCount = value
 	}
+	if value, ok := wc.mutation.FrequencyRank(); ok {
+		_spec.SetField(word.FieldFrequencyRank, field.TypeInt, value)
+		_node.FrequencyRank = &value
+	}
 	if value, ok := wc.mutation.CreatedAt(); ok {
 		_spec.SetField(word.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -345,6 +384,22 @@ func (wc *WordCreate) createSpec() (*Word, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := wc.mutation.DeckWordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -469,6 +524,30 @@ func (u *WordUpsert) AddRegistrationCount(v int) *WordUpsert {
 	return u
 }
 
+// SetFrequencyRank sets the "frequency_rank" field.
+func (u *WordUpsert) SetFrequencyRank(v int) *WordUpsert {
+	u.Set(word.FieldFrequencyRank, v)
+	return u
+}
+
+// UpdateFrequencyRank sets the "frequency_rank" field to the value that was provided on create.
+func (u *WordUpsert) UpdateFrequencyRank() *WordUpsert {
+	u.SetExcluded(word.FieldFrequencyRank)
+	return u
+}
+
+// AddFrequencyRank adds v to the "frequency_rank" field.
+func (u *WordUpsert) AddFrequencyRank(v int) *WordUpsert {
+	u.Add(word.FieldFrequencyRank, v)
+	return u
+}
+
+// ClearFrequencyRank clears the value of the "frequency_rank" field.
+func (u *WordUpsert) ClearFrequencyRank() *WordUpsert {
+	u.SetNull(word.FieldFrequencyRank)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *WordUpsert) SetCreatedAt(v time.Time) *WordUpsert {
 	u.Set(word.FieldCreatedAt, v)
@@ -617,6 +696,34 @@ func (u *WordUpsertOne) UpdateRegistrationCount() *WordUpsertOne {
 	})
 }
 
+// SetFrequencyRank sets the "frequency_rank" field.
+func (u *WordUpsertOne) SetFrequencyRank(v int) *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.SetFrequencyRank(v)
+	})
+}
+
+// AddFrequencyRank adds v to the "frequency_rank" field.
+func (u *WordUpsertOne) AddFrequencyRank(v int) *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.AddFrequencyRank(v)
+	})
+}
+
+// UpdateFrequencyRank sets the "frequency_rank" field to the value that was provided on create.
+func (u *WordUpsertOne) UpdateFrequencyRank() *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateFrequencyRank()
+	})
+}
+
+// ClearFrequencyRank clears the value of the "frequency_rank" field.
+func (u *WordUpsertOne) ClearFrequencyRank() *WordUpsertOne {
+	return u.Update(func(s *WordUpsert) {
+		s.ClearFrequencyRank()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *WordUpsertOne) SetCreatedAt(v time.Time) *WordUpsertOne {
 	return u.Update(func(s *WordUpsert) {
@@ -933,6 +1040,34 @@ func (u *WordUpsertBulk) UpdateRegistrationCount() *WordUpsertBulk {
 	})
 }
 
+// SetFrequencyRank sets the "frequency_rank" field.
+func (u *WordUpsertBulk) SetFrequencyRank(v int) *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.SetFrequencyRank(v)
+	})
+}
+
+// AddFrequencyRank adds v to the "frequency_rank" field.
+func (u *WordUpsertBulk) AddFrequencyRank(v int) *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.AddFrequencyRank(v)
+	})
+}
+
+// UpdateFrequencyRank sets the "frequency_rank" field to the value that was provided on create.
+func (u *WordUpsertBulk) UpdateFrequencyRank() *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.UpdateFrequencyRank()
+	})
+}
+
+// ClearFrequencyRank clears the value of the "frequency_rank" field.
+func (u *WordUpsertBulk) ClearFrequencyRank() *WordUpsertBulk {
+	return u.Update(func(s *WordUpsert) {
+		s.ClearFrequencyRank()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *WordUpsertBulk) SetCreatedAt(v time.Time) *WordUpsertBulk {
 	return u.Update(func(s *WordUpsert) {
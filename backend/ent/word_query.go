@@ -7,6 +7,7 @@ import (
 	"database/sql/driver"
 	"fmt"
 	"math"
+	"word_app/backend/ent/deckword"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quizquestion"
 	"word_app/backend/ent/registeredword"
@@ -29,6 +30,7 @@ type WordQuery struct {
 	withWordInfos       *WordInfoQuery
 	withRegisteredWords *RegisteredWordQuery
 	withQuizQuestions   *QuizQuestionQuery
+	withDeckWords       *DeckWordQuery
 	modifiers           []func(*sql.Selector)
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -132,6 +134,28 @@ func (wq *WordQuery) QueryQuizQuestions() *QuizQuestionQuery {
 	return query
 }
 
+// QueryDeckWords chains the current query on the "deck_words" edge.
+func (wq *WordQuery) QueryDeckWords() *DeckWordQuery {
+	query := (&DeckWordClient{config: wq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(word.Table, word.FieldID, selector),
+			sqlgraph.To(deckword.Table, deckword.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, word.DeckWordsTable, word.DeckWordsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Word entity from the query.
 // Returns a *NotFoundError when no Word was found.
 func (wq *WordQuery) First(ctx context.Context) (*Word, error) {
@@ -327,6 +351,7 @@ func (wq *WordQuery) Clone() *WordQuery {
 		withWordInfos:       wq.withWordInfos.Clone(),
 		withRegisteredWords: wq.withRegisteredWords.Clone(),
 		withQuizQuestions:   wq.withQuizQuestions.Clone(),
+		withDeckWords:       wq.withDeckWords.Clone(),
 		// clone intermediate query.
 		sql:       wq.sql.Clone(),
 		path:      wq.path,
@@ -367,6 +392,17 @@ func (wq *WordQuery) WithQuizQuestions(opts ...func(*QuizQuestionQuery)) *WordQu
 	return wq
 }
 
+// WithDeckWords tells the query-builder to eager-load the nodes that are connected to
+// the "deck_words" edge. The optional arguments are used to configure the query builder of the edge.
+func (wq *WordQuery) WithDeckWords(opts ...func(*DeckWordQuery)) *WordQuery {
+	query := (&DeckWordClient{config: wq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wq.withDeckWords = query
+	return wq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -445,10 +481,11 @@ func (wq *WordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Word, e
 	var (
 		nodes       = []*Word{}
 		_spec       = wq.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [4]bool{
 			wq.withWordInfos != nil,
 			wq.withRegisteredWords != nil,
 			wq.withQuizQuestions != nil,
+			wq.withDeckWords != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -493,6 +530,13 @@ func (wq *WordQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Word, e
 			return nil, err
 		}
 	}
+	if query := wq.withDeckWords; query != nil {
+		if err := wq.loadDeckWords(ctx, query, nodes,
+			func(n *Word) { n.Edges.DeckWords = []*DeckWord{} },
+			func(n *Word, e *DeckWord) { n.Edges.DeckWords = append(n.Edges.DeckWords, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -587,6 +631,36 @@ func (wq *WordQuery) loadQuizQuestions(ctx context.Context, query *QuizQuestionQ
 	}
 	return nil
 }
+func (wq *WordQuery) loadDeckWords(ctx context.Context, query *DeckWordQuery, nodes []*Word, init func(*Word), assign func(*Word, *DeckWord)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Word)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(deckword.FieldWordID)
+	}
+	query.Where(predicate.DeckWord(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(word.DeckWordsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.WordID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "word_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (wq *WordQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := wq.querySpec()
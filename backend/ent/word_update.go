@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"time"
+	"word_app/backend/ent/deckword"
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quizquestion"
 	"word_app/backend/ent/registeredword"
@@ -115,6 +116,33 @@ func (wu *WordUpdate) AddRegistrationCount(i int) *WordUpdate {
 	return wu
 }
 
+// SetFrequencyRank sets the "frequency_rank" field.
+func (wu *WordUpdate) SetFrequencyRank(i int) *WordUpdate {
+	wu.mutation.ResetFrequencyRank()
+	wu.mutation.SetFrequencyRank(i)
+	return wu
+}
+
+// SetNillableFrequencyRank sets the "frequency_rank" field if the given value is not nil.
+func (wu *WordUpdate) SetNillableFrequencyRank(i *int) *WordUpdate {
+	if i != nil {
+		wu.SetFrequencyRank(*i)
+	}
+	return wu
+}
+
+// AddFrequencyRank adds i to the "frequency_rank" field.
+func (wu *WordUpdate) AddFrequencyRank(i int) *WordUpdate {
+	wu.mutation.AddFrequencyRank(i)
+	return wu
+}
+
+// ClearFrequencyRank clears the value of the "frequency_rank" field.
+func (wu *WordUpdate) ClearFrequencyRank() *WordUpdate {
+	wu.mutation.ClearFrequencyRank()
+	return wu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wu *WordUpdate) SetCreatedAt(t time.Time) *WordUpdate {
 	wu.mutation.SetCreatedAt(t)
@@ -180,6 +208,21 @@ func (wu *WordUpdate) AddQuizQuestions(q ...*QuizQuestion) *WordUpdate {
 	return wu.AddQuizQuestionIDs(ids...)
 }
 
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by IDs.
+func (wu *WordUpdate) AddDeckWordIDs(ids ...int) *WordUpdate {
+	wu.mutation.AddDeckWordIDs(ids...)
+	return wu
+}
+
+// AddDeckWords adds the "deck_words" edges to the DeckWord entity.
+func (wu *WordUpdate) AddDeckWords(d ...*DeckWord) *WordUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return wu.AddDeckWordIDs(ids...)
+}
+
 // Mutation returns the WordMutation object of the builder.
 func (wu *WordUpdate) Mutation() *WordMutation {
 	return wu.mutation
@@ -248,6 +291,27 @@ func (wu *WordUpdate) RemoveQuizQuestions(q ...*QuizQuestion) *WordUpdate {
 	return wu.RemoveQuizQuestionIDs(ids...)
 }
 
+// ClearDeckWords clears all "deck_words" edges to the DeckWord entity.
+func (wu *WordUpdate) ClearDeckWords() *WordUpdate {
+	wu.mutation.ClearDeckWords()
+	return wu
+}
+
+// RemoveDeckWordIDs removes the "deck_words" edge to DeckWord entities by IDs.
+func (wu *WordUpdate) RemoveDeckWordIDs(ids ...int) *WordUpdate {
+	wu.mutation.RemoveDeckWordIDs(ids...)
+	return wu
+}
+
+// RemoveDeckWords removes "deck_words" edges to DeckWord entities.
+func (wu *WordUpdate) RemoveDeckWords(d ...*DeckWord) *WordUpdate {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return wu.RemoveDeckWordIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (wu *WordUpdate) Save(ctx context.Context) (int, error) {
 	wu.defaults()
@@ -291,6 +355,11 @@ func (wu *WordUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Word.name": %w`, err)}
 		}
 	}
+	if v, ok := wu.mutation.FrequencyRank(); ok {
+		if err := word.FrequencyRankValidator(v); err != nil {
+			return &ValidationError{Name: "frequency_rank", err: fmt.Errorf(`ent: validator failed for field "Word.frequency_rank": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -333,6 +402,15 @@ func (wu *WordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := wu.mutation.AddedRegistrationCount(); ok {
 		_spec.AddField(word.FieldRegistrationCount, field.TypeInt, value)
 	}
+	if value, ok := wu.mutation.FrequencyRank(); ok {
+		_spec.SetField(word.FieldFrequencyRank, field.TypeInt, value)
+	}
+	if value, ok := wu.mutation.AddedFrequencyRank(); ok {
+		_spec.AddField(word.FieldFrequencyRank, field.TypeInt, value)
+	}
+	if wu.mutation.FrequencyRankCleared() {
+		_spec.ClearField(word.FieldFrequencyRank, field.TypeInt)
+	}
 	if value, ok := wu.mutation.CreatedAt(); ok {
 		_spec.SetField(word.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -474,6 +552,51 @@ func (wu *WordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wu.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.RemovedDeckWordsIDs(); len(nodes) > 0 && !wu.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wu.mutation.DeckWordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(wu.modifiers...)
 	if n, err = sqlgraph.UpdateNodes(ctx, wu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
@@ -579,6 +702,33 @@ func (wuo *WordUpdateOne) AddRegistrationCount(i int) *WordUpdateOne {
 	return wuo
 }
 
+// SetFrequencyRank sets the "frequency_rank" field.
+func (wuo *WordUpdateOne) SetFrequencyRank(i int) *WordUpdateOne {
+	wuo.mutation.ResetFrequencyRank()
+	wuo.mutation.SetFrequencyRank(i)
+	return wuo
+}
+
+// SetNillableFrequencyRank sets the "frequency_rank" field if the given value is not nil.
+func (wuo *WordUpdateOne) SetNillableFrequencyRank(i *int) *WordUpdateOne {
+	if i != nil {
+		wuo.SetFrequencyRank(*i)
+	}
+	return wuo
+}
+
+// AddFrequencyRank adds i to the "frequency_rank" field.
+func (wuo *WordUpdateOne) AddFrequencyRank(i int) *WordUpdateOne {
+	wuo.mutation.AddFrequencyRank(i)
+	return wuo
+}
+
+// ClearFrequencyRank clears the value of the "frequency_rank" field.
+func (wuo *WordUpdateOne) ClearFrequencyRank() *WordUpdateOne {
+	wuo.mutation.ClearFrequencyRank()
+	return wuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wuo *WordUpdateOne) SetCreatedAt(t time.Time) *WordUpdateOne {
 	wuo.mutation.SetCreatedAt(t)
@@ -644,6 +794,21 @@ func (wuo *WordUpdateOne) AddQuizQuestions(q ...*QuizQuestion) *WordUpdateOne {
 	return wuo.AddQuizQuestionIDs(ids...)
 }
 
+// AddDeckWordIDs adds the "deck_words" edge to the DeckWord entity by IDs.
+func (wuo *WordUpdateOne) AddDeckWordIDs(ids ...int) *WordUpdateOne {
+	wuo.mutation.AddDeckWordIDs(ids...)
+	return wuo
+}
+
+// AddDeckWords adds the "deck_words" edges to the DeckWord entity.
+func (wuo *WordUpdateOne) AddDeckWords(d ...*DeckWord) *WordUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return wuo.AddDeckWordIDs(ids...)
+}
+
 // Mutation returns the WordMutation object of the builder.
 func (wuo *WordUpdateOne) Mutation() *WordMutation {
 	return wuo.mutation
@@ -712,6 +877,27 @@ func (wuo *WordUpdateOne) RemoveQuizQuestions(q ...*QuizQuestion) *WordUpdateOne
 	return wuo.RemoveQuizQuestionIDs(ids...)
 }
 
+// ClearDeckWords clears all "deck_words" edges to the DeckWord entity.
+func (wuo *WordUpdateOne) ClearDeckWords() *WordUpdateOne {
+	wuo.mutation.ClearDeckWords()
+	return wuo
+}
+
+// RemoveDeckWordIDs removes the "deck_words" edge to DeckWord entities by IDs.
+func (wuo *WordUpdateOne) RemoveDeckWordIDs(ids ...int) *WordUpdateOne {
+	wuo.mutation.RemoveDeckWordIDs(ids...)
+	return wuo
+}
+
+// RemoveDeckWords removes "deck_words" edges to DeckWord entities.
+func (wuo *WordUpdateOne) RemoveDeckWords(d ...*DeckWord) *WordUpdateOne {
+	ids := make([]int, len(d))
+	for i := range d {
+		ids[i] = d[i].ID
+	}
+	return wuo.RemoveDeckWordIDs(ids...)
+}
+
 // Where appends a list predicates to the WordUpdate builder.
 func (wuo *WordUpdateOne) Where(ps ...predicate.Word) *WordUpdateOne {
 	wuo.mutation.Where(ps...)
@@ -768,6 +954,11 @@ func (wuo *WordUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Word.name": %w`, err)}
 		}
 	}
+	if v, ok := wuo.mutation.FrequencyRank(); ok {
+		if err := word.FrequencyRankValidator(v); err != nil {
+			return &ValidationError{Name: "frequency_rank", err: fmt.Errorf(`ent: validator failed for field "Word.frequency_rank": %w`, err)}
+		}
+	}
 	return nil
 }
 
@@ -827,6 +1018,15 @@ func (wuo *WordUpdateOne) sqlSave(ctx context.Context) (_node *Word, err error)
 	if value, ok := wuo.mutation.AddedRegistrationCount(); ok {
 		_spec.AddField(word.FieldRegistrationCount, field.TypeInt, value)
 	}
+	if value, ok := wuo.mutation.FrequencyRank(); ok {
+		_spec.SetField(word.FieldFrequencyRank, field.TypeInt, value)
+	}
+	if value, ok := wuo.mutation.AddedFrequencyRank(); ok {
+		_spec.AddField(word.FieldFrequencyRank, field.TypeInt, value)
+	}
+	if wuo.mutation.FrequencyRankCleared() {
+		_spec.ClearField(word.FieldFrequencyRank, field.TypeInt)
+	}
 	if value, ok := wuo.mutation.CreatedAt(); ok {
 		_spec.SetField(word.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -968,6 +1168,51 @@ func (wuo *WordUpdateOne) sqlSave(ctx context.Context) (_node *Word, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if wuo.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.RemovedDeckWordsIDs(); len(nodes) > 0 && !wuo.mutation.DeckWordsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wuo.mutation.DeckWordsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   word.DeckWordsTable,
+			Columns: []string{word.DeckWordsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(deckword.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_spec.AddModifiers(wuo.modifiers...)
 	_node = &Word{config: wuo.config}
 	_spec.Assign = _node.assignValues
@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 	"word_app/backend/ent/partofspeech"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/models"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,6 +25,16 @@ type WordInfo struct {
 	WordID int `json:"word_id,omitempty"`
 	// PartOfSpeechID holds the value of the "part_of_speech_id" field.
 	PartOfSpeechID int `json:"part_of_speech_id,omitempty"`
+	// 辞書の品詞コード。対応表が変わったときの再分類に使う
+	PosCodes []string `json:"pos_codes,omitempty"`
+	// JMdict の分野タグ（comp, med など）
+	FieldTags []string `json:"field_tags,omitempty"`
+	// JMdict の用法タグ（uk, arch, col など）
+	MiscTags []string `json:"misc_tags,omitempty"`
+	// JMdict の方言タグ（ksb など）
+	DialectTags []string `json:"dialect_tags,omitempty"`
+	// 例文（日本語・英語の対）
+	Examples []models.WordExample `json:"examples,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -82,6 +94,8 @@ func (*WordInfo) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case wordinfo.FieldPosCodes, wordinfo.FieldFieldTags, wordinfo.FieldMiscTags, wordinfo.FieldDialectTags, wordinfo.FieldExamples:
+			values[i] = new([]byte)
 		case wordinfo.FieldID, wordinfo.FieldWordID, wordinfo.FieldPartOfSpeechID:
 			values[i] = new(sql.NullInt64)
 		case wordinfo.FieldCreatedAt, wordinfo.FieldUpdatedAt:
@@ -119,6 +133,46 @@ func (wi *WordInfo) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				wi.PartOfSpeechID = int(value.Int64)
 			}
+		case wordinfo.FieldPosCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field pos_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wi.PosCodes); err != nil {
+					return fmt.Errorf("unmarshal field pos_codes: %w", err)
+				}
+			}
+		case wordinfo.FieldFieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field field_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wi.FieldTags); err != nil {
+					return fmt.Errorf("unmarshal field field_tags: %w", err)
+				}
+			}
+		case wordinfo.FieldMiscTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field misc_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wi.MiscTags); err != nil {
+					return fmt.Errorf("unmarshal field misc_tags: %w", err)
+				}
+			}
+		case wordinfo.FieldDialectTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field dialect_tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wi.DialectTags); err != nil {
+					return fmt.Errorf("unmarshal field dialect_tags: %w", err)
+				}
+			}
+		case wordinfo.FieldExamples:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field examples", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &wi.Examples); err != nil {
+					return fmt.Errorf("unmarshal field examples: %w", err)
+				}
+			}
 		case wordinfo.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -188,6 +242,21 @@ func (wi *WordInfo) String() string {
 	builder.WriteString("part_of_speech_id=")
 	builder.WriteString(fmt.Sprintf("%v", wi.PartOfSpeechID))
 	builder.WriteString(", ")
+	builder.WriteString("pos_codes=")
+	builder.WriteString(fmt.Sprintf("%v", wi.PosCodes))
+	builder.WriteString(", ")
+	builder.WriteString("field_tags=")
+	builder.WriteString(fmt.Sprintf("%v", wi.FieldTags))
+	builder.WriteString(", ")
+	builder.WriteString("misc_tags=")
+	builder.WriteString(fmt.Sprintf("%v", wi.MiscTags))
+	builder.WriteString(", ")
+	builder.WriteString("dialect_tags=")
+	builder.WriteString(fmt.Sprintf("%v", wi.DialectTags))
+	builder.WriteString(", ")
+	builder.WriteString("examples=")
+	builder.WriteString(fmt.Sprintf("%v", wi.Examples))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(wi.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -115,6 +115,56 @@ func PartOfSpeechIDNotIn(vs ...int) predicate.WordInfo {
 	return predicate.WordInfo(sql.FieldNotIn(FieldPartOfSpeechID, vs...))
 }
 
+// PosCodesIsNil applies the IsNil predicate on the "pos_codes" field.
+func PosCodesIsNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldIsNull(FieldPosCodes))
+}
+
+// PosCodesNotNil applies the NotNil predicate on the "pos_codes" field.
+func PosCodesNotNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldNotNull(FieldPosCodes))
+}
+
+// FieldTagsIsNil applies the IsNil predicate on the "field_tags" field.
+func FieldTagsIsNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldIsNull(FieldFieldTags))
+}
+
+// FieldTagsNotNil applies the NotNil predicate on the "field_tags" field.
+func FieldTagsNotNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldNotNull(FieldFieldTags))
+}
+
+// MiscTagsIsNil applies the IsNil predicate on the "misc_tags" field.
+func MiscTagsIsNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldIsNull(FieldMiscTags))
+}
+
+// MiscTagsNotNil applies the NotNil predicate on the "misc_tags" field.
+func MiscTagsNotNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldNotNull(FieldMiscTags))
+}
+
+// DialectTagsIsNil applies the IsNil predicate on the "dialect_tags" field.
+func DialectTagsIsNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldIsNull(FieldDialectTags))
+}
+
+// DialectTagsNotNil applies the NotNil predicate on the "dialect_tags" field.
+func DialectTagsNotNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldNotNull(FieldDialectTags))
+}
+
+// ExamplesIsNil applies the IsNil predicate on the "examples" field.
+func ExamplesIsNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldIsNull(FieldExamples))
+}
+
+// ExamplesNotNil applies the NotNil predicate on the "examples" field.
+func ExamplesNotNil() predicate.WordInfo {
+	return predicate.WordInfo(sql.FieldNotNull(FieldExamples))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.WordInfo {
 	return predicate.WordInfo(sql.FieldEQ(FieldCreatedAt, v))
@@ -18,6 +18,16 @@ const (
 	FieldWordID = "word_id"
 	// FieldPartOfSpeechID holds the string denoting the part_of_speech_id field in the database.
 	FieldPartOfSpeechID = "part_of_speech_id"
+	// FieldPosCodes holds the string denoting the pos_codes field in the database.
+	FieldPosCodes = "pos_codes"
+	// FieldFieldTags holds the string denoting the field_tags field in the database.
+	FieldFieldTags = "field_tags"
+	// FieldMiscTags holds the string denoting the misc_tags field in the database.
+	FieldMiscTags = "misc_tags"
+	// FieldDialectTags holds the string denoting the dialect_tags field in the database.
+	FieldDialectTags = "dialect_tags"
+	// FieldExamples holds the string denoting the examples field in the database.
+	FieldExamples = "examples"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -58,6 +68,11 @@ var Columns = []string{
 	FieldID,
 	FieldWordID,
 	FieldPartOfSpeechID,
+	FieldPosCodes,
+	FieldFieldTags,
+	FieldMiscTags,
+	FieldDialectTags,
+	FieldExamples,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -11,6 +11,7 @@ import (
 	"word_app/backend/ent/partofspeech"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/models"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -37,6 +38,36 @@ func (wic *WordInfoCreate) SetPartOfSpeechID(i int) *WordInfoCreate {
 	return wic
 }
 
+// SetPosCodes sets the "pos_codes" field.
+func (wic *WordInfoCreate) SetPosCodes(s []string) *WordInfoCreate {
+	wic.mutation.SetPosCodes(s)
+	return wic
+}
+
+// SetFieldTags sets the "field_tags" field.
+func (wic *WordInfoCreate) SetFieldTags(s []string) *WordInfoCreate {
+	wic.mutation.SetFieldTags(s)
+	return wic
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (wic *WordInfoCreate) SetMiscTags(s []string) *WordInfoCreate {
+	wic.mutation.SetMiscTags(s)
+	return wic
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (wic *WordInfoCreate) SetDialectTags(s []string) *WordInfoCreate {
+	wic.mutation.SetDialectTags(s)
+	return wic
+}
+
+// SetExamples sets the "examples" field.
+func (wic *WordInfoCreate) SetExamples(me []models.WordExample) *WordInfoCreate {
+	wic.mutation.SetExamples(me)
+	return wic
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wic *WordInfoCreate) SetCreatedAt(t time.Time) *WordInfoCreate {
 	wic.mutation.SetCreatedAt(t)
@@ -192,6 +223,26 @@ func (wic *WordInfoCreate) createSpec() (*WordInfo, *sqlgraph.CreateSpec) {
 		_spec = sqlgraph.NewCreateSpec(wordinfo.Table, sqlgraph.NewFieldSpec(wordinfo.FieldID, field.TypeInt))
 	)
 	_spec.OnConflict = wic.conflict
+	if value, ok := wic.mutation.PosCodes(); ok {
+		_spec.SetField(wordinfo.FieldPosCodes, field.TypeJSON, value)
+		_node.PosCodes = value
+	}
+	if value, ok := wic.mutation.FieldTags(); ok {
+		_spec.SetField(wordinfo.FieldFieldTags, field.TypeJSON, value)
+		_node.FieldTags = value
+	}
+	if value, ok := wic.mutation.MiscTags(); ok {
+		_spec.SetField(wordinfo.FieldMiscTags, field.TypeJSON, value)
+		_node.MiscTags = value
+	}
+	if value, ok := wic.mutation.DialectTags(); ok {
+		_spec.SetField(wordinfo.FieldDialectTags, field.TypeJSON, value)
+		_node.DialectTags = value
+	}
+	if value, ok := wic.mutation.Examples(); ok {
+		_spec.SetField(wordinfo.FieldExamples, field.TypeJSON, value)
+		_node.Examples = value
+	}
 	if value, ok := wic.mutation.CreatedAt(); ok {
 		_spec.SetField(wordinfo.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -326,6 +377,96 @@ func (u *WordInfoUpsert) UpdatePartOfSpeechID() *WordInfoUpsert {
 	return u
 }
 
+// SetPosCodes sets the "pos_codes" field.
+func (u *WordInfoUpsert) SetPosCodes(v []string) *WordInfoUpsert {
+	u.Set(wordinfo.FieldPosCodes, v)
+	return u
+}
+
+// UpdatePosCodes sets the "pos_codes" field to the value that was provided on create.
+func (u *WordInfoUpsert) UpdatePosCodes() *WordInfoUpsert {
+	u.SetExcluded(wordinfo.FieldPosCodes)
+	return u
+}
+
+// ClearPosCodes clears the value of the "pos_codes" field.
+func (u *WordInfoUpsert) ClearPosCodes() *WordInfoUpsert {
+	u.SetNull(wordinfo.FieldPosCodes)
+	return u
+}
+
+// SetFieldTags sets the "field_tags" field.
+func (u *WordInfoUpsert) SetFieldTags(v []string) *WordInfoUpsert {
+	u.Set(wordinfo.FieldFieldTags, v)
+	return u
+}
+
+// UpdateFieldTags sets the "field_tags" field to the value that was provided on create.
+func (u *WordInfoUpsert) UpdateFieldTags() *WordInfoUpsert {
+	u.SetExcluded(wordinfo.FieldFieldTags)
+	return u
+}
+
+// ClearFieldTags clears the value of the "field_tags" field.
+func (u *WordInfoUpsert) ClearFieldTags() *WordInfoUpsert {
+	u.SetNull(wordinfo.FieldFieldTags)
+	return u
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (u *WordInfoUpsert) SetMiscTags(v []string) *WordInfoUpsert {
+	u.Set(wordinfo.FieldMiscTags, v)
+	return u
+}
+
+// UpdateMiscTags sets the "misc_tags" field to the value that was provided on create.
+func (u *WordInfoUpsert) UpdateMiscTags() *WordInfoUpsert {
+	u.SetExcluded(wordinfo.FieldMiscTags)
+	return u
+}
+
+// ClearMiscTags clears the value of the "misc_tags" field.
+func (u *WordInfoUpsert) ClearMiscTags() *WordInfoUpsert {
+	u.SetNull(wordinfo.FieldMiscTags)
+	return u
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (u *WordInfoUpsert) SetDialectTags(v []string) *WordInfoUpsert {
+	u.Set(wordinfo.FieldDialectTags, v)
+	return u
+}
+
+// UpdateDialectTags sets the "dialect_tags" field to the value that was provided on create.
+func (u *WordInfoUpsert) UpdateDialectTags() *WordInfoUpsert {
+	u.SetExcluded(wordinfo.FieldDialectTags)
+	return u
+}
+
+// ClearDialectTags clears the value of the "dialect_tags" field.
+func (u *WordInfoUpsert) ClearDialectTags() *WordInfoUpsert {
+	u.SetNull(wordinfo.FieldDialectTags)
+	return u
+}
+
+// SetExamples sets the "examples" field.
+func (u *WordInfoUpsert) SetExamples(v []models.WordExample) *WordInfoUpsert {
+	u.Set(wordinfo.FieldExamples, v)
+	return u
+}
+
+// UpdateExamples sets the "examples" field to the value that was provided on create.
+func (u *WordInfoUpsert) UpdateExamples() *WordInfoUpsert {
+	u.SetExcluded(wordinfo.FieldExamples)
+	return u
+}
+
+// ClearExamples clears the value of the "examples" field.
+func (u *WordInfoUpsert) ClearExamples() *WordInfoUpsert {
+	u.SetNull(wordinfo.FieldExamples)
+	return u
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *WordInfoUpsert) SetCreatedAt(v time.Time) *WordInfoUpsert {
 	u.Set(wordinfo.FieldCreatedAt, v)
@@ -418,6 +559,111 @@ func (u *WordInfoUpsertOne) UpdatePartOfSpeechID() *WordInfoUpsertOne {
 	})
 }
 
+// SetPosCodes sets the "pos_codes" field.
+func (u *WordInfoUpsertOne) SetPosCodes(v []string) *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetPosCodes(v)
+	})
+}
+
+// UpdatePosCodes sets the "pos_codes" field to the value that was provided on create.
+func (u *WordInfoUpsertOne) UpdatePosCodes() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdatePosCodes()
+	})
+}
+
+// ClearPosCodes clears the value of the "pos_codes" field.
+func (u *WordInfoUpsertOne) ClearPosCodes() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearPosCodes()
+	})
+}
+
+// SetFieldTags sets the "field_tags" field.
+func (u *WordInfoUpsertOne) SetFieldTags(v []string) *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetFieldTags(v)
+	})
+}
+
+// UpdateFieldTags sets the "field_tags" field to the value that was provided on create.
+func (u *WordInfoUpsertOne) UpdateFieldTags() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateFieldTags()
+	})
+}
+
+// ClearFieldTags clears the value of the "field_tags" field.
+func (u *WordInfoUpsertOne) ClearFieldTags() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearFieldTags()
+	})
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (u *WordInfoUpsertOne) SetMiscTags(v []string) *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetMiscTags(v)
+	})
+}
+
+// UpdateMiscTags sets the "misc_tags" field to the value that was provided on create.
+func (u *WordInfoUpsertOne) UpdateMiscTags() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateMiscTags()
+	})
+}
+
+// ClearMiscTags clears the value of the "misc_tags" field.
+func (u *WordInfoUpsertOne) ClearMiscTags() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearMiscTags()
+	})
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (u *WordInfoUpsertOne) SetDialectTags(v []string) *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetDialectTags(v)
+	})
+}
+
+// UpdateDialectTags sets the "dialect_tags" field to the value that was provided on create.
+func (u *WordInfoUpsertOne) UpdateDialectTags() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateDialectTags()
+	})
+}
+
+// ClearDialectTags clears the value of the "dialect_tags" field.
+func (u *WordInfoUpsertOne) ClearDialectTags() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearDialectTags()
+	})
+}
+
+// SetExamples sets the "examples" field.
+func (u *WordInfoUpsertOne) SetExamples(v []models.WordExample) *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetExamples(v)
+	})
+}
+
+// UpdateExamples sets the "examples" field to the value that was provided on create.
+func (u *WordInfoUpsertOne) UpdateExamples() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateExamples()
+	})
+}
+
+// ClearExamples clears the value of the "examples" field.
+func (u *WordInfoUpsertOne) ClearExamples() *WordInfoUpsertOne {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearExamples()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *WordInfoUpsertOne) SetCreatedAt(v time.Time) *WordInfoUpsertOne {
 	return u.Update(func(s *WordInfoUpsert) {
@@ -678,6 +924,111 @@ func (u *WordInfoUpsertBulk) UpdatePartOfSpeechID() *WordInfoUpsertBulk {
 	})
 }
 
+// SetPosCodes sets the "pos_codes" field.
+func (u *WordInfoUpsertBulk) SetPosCodes(v []string) *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetPosCodes(v)
+	})
+}
+
+// UpdatePosCodes sets the "pos_codes" field to the value that was provided on create.
+func (u *WordInfoUpsertBulk) UpdatePosCodes() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdatePosCodes()
+	})
+}
+
+// ClearPosCodes clears the value of the "pos_codes" field.
+func (u *WordInfoUpsertBulk) ClearPosCodes() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearPosCodes()
+	})
+}
+
+// SetFieldTags sets the "field_tags" field.
+func (u *WordInfoUpsertBulk) SetFieldTags(v []string) *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetFieldTags(v)
+	})
+}
+
+// UpdateFieldTags sets the "field_tags" field to the value that was provided on create.
+func (u *WordInfoUpsertBulk) UpdateFieldTags() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateFieldTags()
+	})
+}
+
+// ClearFieldTags clears the value of the "field_tags" field.
+func (u *WordInfoUpsertBulk) ClearFieldTags() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearFieldTags()
+	})
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (u *WordInfoUpsertBulk) SetMiscTags(v []string) *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetMiscTags(v)
+	})
+}
+
+// UpdateMiscTags sets the "misc_tags" field to the value that was provided on create.
+func (u *WordInfoUpsertBulk) UpdateMiscTags() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateMiscTags()
+	})
+}
+
+// ClearMiscTags clears the value of the "misc_tags" field.
+func (u *WordInfoUpsertBulk) ClearMiscTags() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearMiscTags()
+	})
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (u *WordInfoUpsertBulk) SetDialectTags(v []string) *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetDialectTags(v)
+	})
+}
+
+// UpdateDialectTags sets the "dialect_tags" field to the value that was provided on create.
+func (u *WordInfoUpsertBulk) UpdateDialectTags() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateDialectTags()
+	})
+}
+
+// ClearDialectTags clears the value of the "dialect_tags" field.
+func (u *WordInfoUpsertBulk) ClearDialectTags() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearDialectTags()
+	})
+}
+
+// SetExamples sets the "examples" field.
+func (u *WordInfoUpsertBulk) SetExamples(v []models.WordExample) *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.SetExamples(v)
+	})
+}
+
+// UpdateExamples sets the "examples" field to the value that was provided on create.
+func (u *WordInfoUpsertBulk) UpdateExamples() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.UpdateExamples()
+	})
+}
+
+// ClearExamples clears the value of the "examples" field.
+func (u *WordInfoUpsertBulk) ClearExamples() *WordInfoUpsertBulk {
+	return u.Update(func(s *WordInfoUpsert) {
+		s.ClearExamples()
+	})
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (u *WordInfoUpsertBulk) SetCreatedAt(v time.Time) *WordInfoUpsertBulk {
 	return u.Update(func(s *WordInfoUpsert) {
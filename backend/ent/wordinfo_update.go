@@ -12,9 +12,11 @@ import (
 	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/word"
 	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/models"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -60,6 +62,96 @@ func (wiu *WordInfoUpdate) SetNillablePartOfSpeechID(i *int) *WordInfoUpdate {
 	return wiu
 }
 
+// SetPosCodes sets the "pos_codes" field.
+func (wiu *WordInfoUpdate) SetPosCodes(s []string) *WordInfoUpdate {
+	wiu.mutation.SetPosCodes(s)
+	return wiu
+}
+
+// AppendPosCodes appends s to the "pos_codes" field.
+func (wiu *WordInfoUpdate) AppendPosCodes(s []string) *WordInfoUpdate {
+	wiu.mutation.AppendPosCodes(s)
+	return wiu
+}
+
+// ClearPosCodes clears the value of the "pos_codes" field.
+func (wiu *WordInfoUpdate) ClearPosCodes() *WordInfoUpdate {
+	wiu.mutation.ClearPosCodes()
+	return wiu
+}
+
+// SetFieldTags sets the "field_tags" field.
+func (wiu *WordInfoUpdate) SetFieldTags(s []string) *WordInfoUpdate {
+	wiu.mutation.SetFieldTags(s)
+	return wiu
+}
+
+// AppendFieldTags appends s to the "field_tags" field.
+func (wiu *WordInfoUpdate) AppendFieldTags(s []string) *WordInfoUpdate {
+	wiu.mutation.AppendFieldTags(s)
+	return wiu
+}
+
+// ClearFieldTags clears the value of the "field_tags" field.
+func (wiu *WordInfoUpdate) ClearFieldTags() *WordInfoUpdate {
+	wiu.mutation.ClearFieldTags()
+	return wiu
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (wiu *WordInfoUpdate) SetMiscTags(s []string) *WordInfoUpdate {
+	wiu.mutation.SetMiscTags(s)
+	return wiu
+}
+
+// AppendMiscTags appends s to the "misc_tags" field.
+func (wiu *WordInfoUpdate) AppendMiscTags(s []string) *WordInfoUpdate {
+	wiu.mutation.AppendMiscTags(s)
+	return wiu
+}
+
+// ClearMiscTags clears the value of the "misc_tags" field.
+func (wiu *WordInfoUpdate) ClearMiscTags() *WordInfoUpdate {
+	wiu.mutation.ClearMiscTags()
+	return wiu
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (wiu *WordInfoUpdate) SetDialectTags(s []string) *WordInfoUpdate {
+	wiu.mutation.SetDialectTags(s)
+	return wiu
+}
+
+// AppendDialectTags appends s to the "dialect_tags" field.
+func (wiu *WordInfoUpdate) AppendDialectTags(s []string) *WordInfoUpdate {
+	wiu.mutation.AppendDialectTags(s)
+	return wiu
+}
+
+// ClearDialectTags clears the value of the "dialect_tags" field.
+func (wiu *WordInfoUpdate) ClearDialectTags() *WordInfoUpdate {
+	wiu.mutation.ClearDialectTags()
+	return wiu
+}
+
+// SetExamples sets the "examples" field.
+func (wiu *WordInfoUpdate) SetExamples(me []models.WordExample) *WordInfoUpdate {
+	wiu.mutation.SetExamples(me)
+	return wiu
+}
+
+// AppendExamples appends me to the "examples" field.
+func (wiu *WordInfoUpdate) AppendExamples(me []models.WordExample) *WordInfoUpdate {
+	wiu.mutation.AppendExamples(me)
+	return wiu
+}
+
+// ClearExamples clears the value of the "examples" field.
+func (wiu *WordInfoUpdate) ClearExamples() *WordInfoUpdate {
+	wiu.mutation.ClearExamples()
+	return wiu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wiu *WordInfoUpdate) SetCreatedAt(t time.Time) *WordInfoUpdate {
 	wiu.mutation.SetCreatedAt(t)
@@ -218,6 +310,61 @@ func (wiu *WordInfoUpdate) sqlSave(ctx context.Context) (n int, err error) {
 			}
 		}
 	}
+	if value, ok := wiu.mutation.PosCodes(); ok {
+		_spec.SetField(wordinfo.FieldPosCodes, field.TypeJSON, value)
+	}
+	if value, ok := wiu.mutation.AppendedPosCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldPosCodes, value)
+		})
+	}
+	if wiu.mutation.PosCodesCleared() {
+		_spec.ClearField(wordinfo.FieldPosCodes, field.TypeJSON)
+	}
+	if value, ok := wiu.mutation.FieldTags(); ok {
+		_spec.SetField(wordinfo.FieldFieldTags, field.TypeJSON, value)
+	}
+	if value, ok := wiu.mutation.AppendedFieldTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldFieldTags, value)
+		})
+	}
+	if wiu.mutation.FieldTagsCleared() {
+		_spec.ClearField(wordinfo.FieldFieldTags, field.TypeJSON)
+	}
+	if value, ok := wiu.mutation.MiscTags(); ok {
+		_spec.SetField(wordinfo.FieldMiscTags, field.TypeJSON, value)
+	}
+	if value, ok := wiu.mutation.AppendedMiscTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldMiscTags, value)
+		})
+	}
+	if wiu.mutation.MiscTagsCleared() {
+		_spec.ClearField(wordinfo.FieldMiscTags, field.TypeJSON)
+	}
+	if value, ok := wiu.mutation.DialectTags(); ok {
+		_spec.SetField(wordinfo.FieldDialectTags, field.TypeJSON, value)
+	}
+	if value, ok := wiu.mutation.AppendedDialectTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldDialectTags, value)
+		})
+	}
+	if wiu.mutation.DialectTagsCleared() {
+		_spec.ClearField(wordinfo.FieldDialectTags, field.TypeJSON)
+	}
+	if value, ok := wiu.mutation.Examples(); ok {
+		_spec.SetField(wordinfo.FieldExamples, field.TypeJSON, value)
+	}
+	if value, ok := wiu.mutation.AppendedExamples(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldExamples, value)
+		})
+	}
+	if wiu.mutation.ExamplesCleared() {
+		_spec.ClearField(wordinfo.FieldExamples, field.TypeJSON)
+	}
 	if value, ok := wiu.mutation.CreatedAt(); ok {
 		_spec.SetField(wordinfo.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -377,6 +524,96 @@ func (wiuo *WordInfoUpdateOne) SetNillablePartOfSpeechID(i *int) *WordInfoUpdate
 	return wiuo
 }
 
+// SetPosCodes sets the "pos_codes" field.
+func (wiuo *WordInfoUpdateOne) SetPosCodes(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.SetPosCodes(s)
+	return wiuo
+}
+
+// AppendPosCodes appends s to the "pos_codes" field.
+func (wiuo *WordInfoUpdateOne) AppendPosCodes(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.AppendPosCodes(s)
+	return wiuo
+}
+
+// ClearPosCodes clears the value of the "pos_codes" field.
+func (wiuo *WordInfoUpdateOne) ClearPosCodes() *WordInfoUpdateOne {
+	wiuo.mutation.ClearPosCodes()
+	return wiuo
+}
+
+// SetFieldTags sets the "field_tags" field.
+func (wiuo *WordInfoUpdateOne) SetFieldTags(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.SetFieldTags(s)
+	return wiuo
+}
+
+// AppendFieldTags appends s to the "field_tags" field.
+func (wiuo *WordInfoUpdateOne) AppendFieldTags(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.AppendFieldTags(s)
+	return wiuo
+}
+
+// ClearFieldTags clears the value of the "field_tags" field.
+func (wiuo *WordInfoUpdateOne) ClearFieldTags() *WordInfoUpdateOne {
+	wiuo.mutation.ClearFieldTags()
+	return wiuo
+}
+
+// SetMiscTags sets the "misc_tags" field.
+func (wiuo *WordInfoUpdateOne) SetMiscTags(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.SetMiscTags(s)
+	return wiuo
+}
+
+// AppendMiscTags appends s to the "misc_tags" field.
+func (wiuo *WordInfoUpdateOne) AppendMiscTags(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.AppendMiscTags(s)
+	return wiuo
+}
+
+// ClearMiscTags clears the value of the "misc_tags" field.
+func (wiuo *WordInfoUpdateOne) ClearMiscTags() *WordInfoUpdateOne {
+	wiuo.mutation.ClearMiscTags()
+	return wiuo
+}
+
+// SetDialectTags sets the "dialect_tags" field.
+func (wiuo *WordInfoUpdateOne) SetDialectTags(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.SetDialectTags(s)
+	return wiuo
+}
+
+// AppendDialectTags appends s to the "dialect_tags" field.
+func (wiuo *WordInfoUpdateOne) AppendDialectTags(s []string) *WordInfoUpdateOne {
+	wiuo.mutation.AppendDialectTags(s)
+	return wiuo
+}
+
+// ClearDialectTags clears the value of the "dialect_tags" field.
+func (wiuo *WordInfoUpdateOne) ClearDialectTags() *WordInfoUpdateOne {
+	wiuo.mutation.ClearDialectTags()
+	return wiuo
+}
+
+// SetExamples sets the "examples" field.
+func (wiuo *WordInfoUpdateOne) SetExamples(me []models.WordExample) *WordInfoUpdateOne {
+	wiuo.mutation.SetExamples(me)
+	return wiuo
+}
+
+// AppendExamples appends me to the "examples" field.
+func (wiuo *WordInfoUpdateOne) AppendExamples(me []models.WordExample) *WordInfoUpdateOne {
+	wiuo.mutation.AppendExamples(me)
+	return wiuo
+}
+
+// ClearExamples clears the value of the "examples" field.
+func (wiuo *WordInfoUpdateOne) ClearExamples() *WordInfoUpdateOne {
+	wiuo.mutation.ClearExamples()
+	return wiuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (wiuo *WordInfoUpdateOne) SetCreatedAt(t time.Time) *WordInfoUpdateOne {
 	wiuo.mutation.SetCreatedAt(t)
@@ -565,6 +802,61 @@ func (wiuo *WordInfoUpdateOne) sqlSave(ctx context.Context) (_node *WordInfo, er
 			}
 		}
 	}
+	if value, ok := wiuo.mutation.PosCodes(); ok {
+		_spec.SetField(wordinfo.FieldPosCodes, field.TypeJSON, value)
+	}
+	if value, ok := wiuo.mutation.AppendedPosCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldPosCodes, value)
+		})
+	}
+	if wiuo.mutation.PosCodesCleared() {
+		_spec.ClearField(wordinfo.FieldPosCodes, field.TypeJSON)
+	}
+	if value, ok := wiuo.mutation.FieldTags(); ok {
+		_spec.SetField(wordinfo.FieldFieldTags, field.TypeJSON, value)
+	}
+	if value, ok := wiuo.mutation.AppendedFieldTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldFieldTags, value)
+		})
+	}
+	if wiuo.mutation.FieldTagsCleared() {
+		_spec.ClearField(wordinfo.FieldFieldTags, field.TypeJSON)
+	}
+	if value, ok := wiuo.mutation.MiscTags(); ok {
+		_spec.SetField(wordinfo.FieldMiscTags, field.TypeJSON, value)
+	}
+	if value, ok := wiuo.mutation.AppendedMiscTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldMiscTags, value)
+		})
+	}
+	if wiuo.mutation.MiscTagsCleared() {
+		_spec.ClearField(wordinfo.FieldMiscTags, field.TypeJSON)
+	}
+	if value, ok := wiuo.mutation.DialectTags(); ok {
+		_spec.SetField(wordinfo.FieldDialectTags, field.TypeJSON, value)
+	}
+	if value, ok := wiuo.mutation.AppendedDialectTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldDialectTags, value)
+		})
+	}
+	if wiuo.mutation.DialectTagsCleared() {
+		_spec.ClearField(wordinfo.FieldDialectTags, field.TypeJSON)
+	}
+	if value, ok := wiuo.mutation.Examples(); ok {
+		_spec.SetField(wordinfo.FieldExamples, field.TypeJSON, value)
+	}
+	if value, ok := wiuo.mutation.AppendedExamples(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, wordinfo.FieldExamples, value)
+		})
+	}
+	if wiuo.mutation.ExamplesCleared() {
+		_spec.ClearField(wordinfo.FieldExamples, field.TypeJSON)
+	}
 	if value, ok := wiuo.mutation.CreatedAt(); ok {
 		_spec.SetField(wordinfo.FieldCreatedAt, field.TypeTime, value)
 	}
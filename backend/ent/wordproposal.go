@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"word_app/backend/ent/user"
+	"word_app/backend/ent/wordproposal"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// WordProposal is the model entity for the WordProposal schema.
+type WordProposal struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action wordproposal.Action `json:"action,omitempty"`
+	// update / delete の対象。create は承認して作成された Word の ID
+	WordID *int `json:"word_id,omitempty"`
+	// 一覧表示用。create / update は提案後の名前、delete は対象の名前
+	WordName string `json:"word_name,omitempty"`
+	// 提案内容（CreateWordRequest / UpdateWordRequest / DeleteWordRequest の JSON）
+	Payload string `json:"payload,omitempty"`
+	// Status holds the value of the "status" field.
+	Status wordproposal.Status `json:"status,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int `json:"reviewer_id,omitempty"`
+	// ReviewNote holds the value of the "review_note" field.
+	ReviewNote string `json:"review_note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WordProposalQuery when eager-loading is set.
+	Edges        WordProposalEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WordProposalEdges holds the relations/edges for other nodes in the graph.
+type WordProposalEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WordProposalEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WordProposal) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wordproposal.FieldID, wordproposal.FieldUserID, wordproposal.FieldWordID, wordproposal.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case wordproposal.FieldAction, wordproposal.FieldWordName, wordproposal.FieldPayload, wordproposal.FieldStatus, wordproposal.FieldReviewNote:
+			values[i] = new(sql.NullString)
+		case wordproposal.FieldCreatedAt, wordproposal.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WordProposal fields.
+func (wp *WordProposal) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wordproposal.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			wp.ID = int(value.Int64)
+		case wordproposal.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				wp.UserID = int(value.Int64)
+			}
+		case wordproposal.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				wp.Action = wordproposal.Action(value.String)
+			}
+		case wordproposal.FieldWordID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_id", values[i])
+			} else if value.Valid {
+				wp.WordID = new(int)
+				*wp.WordID = int(value.Int64)
+			}
+		case wordproposal.FieldWordName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field word_name", values[i])
+			} else if value.Valid {
+				wp.WordName = value.String
+			}
+		case wordproposal.FieldPayload:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value.Valid {
+				wp.Payload = value.String
+			}
+		case wordproposal.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				wp.Status = wordproposal.Status(value.String)
+			}
+		case wordproposal.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				wp.ReviewerID = new(int)
+				*wp.ReviewerID = int(value.Int64)
+			}
+		case wordproposal.FieldReviewNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_note", values[i])
+			} else if value.Valid {
+				wp.ReviewNote = value.String
+			}
+		case wordproposal.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				wp.CreatedAt = value.Time
+			}
+		case wordproposal.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				wp.ReviewedAt = new(time.Time)
+				*wp.ReviewedAt = value.Time
+			}
+		default:
+			wp.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WordProposal.
+// This includes values selected through modifiers, order, etc.
+func (wp *WordProposal) Value(name string) (ent.Value, error) {
+	return wp.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WordProposal entity.
+func (wp *WordProposal) QueryUser() *UserQuery {
+	return NewWordProposalClient(wp.config).QueryUser(wp)
+}
+
+// Update returns a builder for updating this WordProposal.
+// Note that you need to call WordProposal.Unwrap() before calling this method if this WordProposal
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (wp *WordProposal) Update() *WordProposalUpdateOne {
+	return NewWordProposalClient(wp.config).UpdateOne(wp)
+}
+
+// Unwrap unwraps the WordProposal entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (wp *WordProposal) Unwrap() *WordProposal {
+	_tx, ok := wp.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WordProposal is not a transactional entity")
+	}
+	wp.config.driver = _tx.drv
+	return wp
+}
+
+// String implements the fmt.Stringer.
+func (wp *WordProposal) String() string {
+	var builder strings.Builder
+	builder.WriteString("WordProposal(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", wp.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", wp.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", wp.Action))
+	builder.WriteString(", ")
+	if v := wp.WordID; v != nil {
+		builder.WriteString("word_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("word_name=")
+	builder.WriteString(wp.WordName)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(wp.Payload)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", wp.Status))
+	builder.WriteString(", ")
+	if v := wp.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("review_note=")
+	builder.WriteString(wp.ReviewNote)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(wp.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := wp.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WordProposals is a parsable slice of WordProposal.
+type WordProposals []*WordProposal
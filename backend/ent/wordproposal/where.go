@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wordproposal
+
+import (
+	"time"
+	"word_app/backend/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldUserID, v))
+}
+
+// WordID applies equality check predicate on the "word_id" field. It's identical to WordIDEQ.
+func WordID(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldWordID, v))
+}
+
+// WordName applies equality check predicate on the "word_name" field. It's identical to WordNameEQ.
+func WordName(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldWordName, v))
+}
+
+// Payload applies equality check predicate on the "payload" field. It's identical to PayloadEQ.
+func Payload(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldPayload, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewNote applies equality check predicate on the "review_note" field. It's identical to ReviewNoteEQ.
+func ReviewNote(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// WordIDEQ applies the EQ predicate on the "word_id" field.
+func WordIDEQ(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldWordID, v))
+}
+
+// WordIDNEQ applies the NEQ predicate on the "word_id" field.
+func WordIDNEQ(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldWordID, v))
+}
+
+// WordIDIn applies the In predicate on the "word_id" field.
+func WordIDIn(vs ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldWordID, vs...))
+}
+
+// WordIDNotIn applies the NotIn predicate on the "word_id" field.
+func WordIDNotIn(vs ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldWordID, vs...))
+}
+
+// WordIDGT applies the GT predicate on the "word_id" field.
+func WordIDGT(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldWordID, v))
+}
+
+// WordIDGTE applies the GTE predicate on the "word_id" field.
+func WordIDGTE(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldWordID, v))
+}
+
+// WordIDLT applies the LT predicate on the "word_id" field.
+func WordIDLT(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldWordID, v))
+}
+
+// WordIDLTE applies the LTE predicate on the "word_id" field.
+func WordIDLTE(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldWordID, v))
+}
+
+// WordIDIsNil applies the IsNil predicate on the "word_id" field.
+func WordIDIsNil() predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIsNull(FieldWordID))
+}
+
+// WordIDNotNil applies the NotNil predicate on the "word_id" field.
+func WordIDNotNil() predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotNull(FieldWordID))
+}
+
+// WordNameEQ applies the EQ predicate on the "word_name" field.
+func WordNameEQ(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldWordName, v))
+}
+
+// WordNameNEQ applies the NEQ predicate on the "word_name" field.
+func WordNameNEQ(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldWordName, v))
+}
+
+// WordNameIn applies the In predicate on the "word_name" field.
+func WordNameIn(vs ...string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldWordName, vs...))
+}
+
+// WordNameNotIn applies the NotIn predicate on the "word_name" field.
+func WordNameNotIn(vs ...string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldWordName, vs...))
+}
+
+// WordNameGT applies the GT predicate on the "word_name" field.
+func WordNameGT(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldWordName, v))
+}
+
+// WordNameGTE applies the GTE predicate on the "word_name" field.
+func WordNameGTE(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldWordName, v))
+}
+
+// WordNameLT applies the LT predicate on the "word_name" field.
+func WordNameLT(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldWordName, v))
+}
+
+// WordNameLTE applies the LTE predicate on the "word_name" field.
+func WordNameLTE(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldWordName, v))
+}
+
+// WordNameContains applies the Contains predicate on the "word_name" field.
+func WordNameContains(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldContains(FieldWordName, v))
+}
+
+// WordNameHasPrefix applies the HasPrefix predicate on the "word_name" field.
+func WordNameHasPrefix(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldHasPrefix(FieldWordName, v))
+}
+
+// WordNameHasSuffix applies the HasSuffix predicate on the "word_name" field.
+func WordNameHasSuffix(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldHasSuffix(FieldWordName, v))
+}
+
+// WordNameEqualFold applies the EqualFold predicate on the "word_name" field.
+func WordNameEqualFold(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEqualFold(FieldWordName, v))
+}
+
+// WordNameContainsFold applies the ContainsFold predicate on the "word_name" field.
+func WordNameContainsFold(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldContainsFold(FieldWordName, v))
+}
+
+// PayloadEQ applies the EQ predicate on the "payload" field.
+func PayloadEQ(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldPayload, v))
+}
+
+// PayloadNEQ applies the NEQ predicate on the "payload" field.
+func PayloadNEQ(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldPayload, v))
+}
+
+// PayloadIn applies the In predicate on the "payload" field.
+func PayloadIn(vs ...string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldPayload, vs...))
+}
+
+// PayloadNotIn applies the NotIn predicate on the "payload" field.
+func PayloadNotIn(vs ...string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldPayload, vs...))
+}
+
+// PayloadGT applies the GT predicate on the "payload" field.
+func PayloadGT(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldPayload, v))
+}
+
+// PayloadGTE applies the GTE predicate on the "payload" field.
+func PayloadGTE(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldPayload, v))
+}
+
+// PayloadLT applies the LT predicate on the "payload" field.
+func PayloadLT(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldPayload, v))
+}
+
+// PayloadLTE applies the LTE predicate on the "payload" field.
+func PayloadLTE(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldPayload, v))
+}
+
+// PayloadContains applies the Contains predicate on the "payload" field.
+func PayloadContains(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldContains(FieldPayload, v))
+}
+
+// PayloadHasPrefix applies the HasPrefix predicate on the "payload" field.
+func PayloadHasPrefix(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldHasPrefix(FieldPayload, v))
+}
+
+// PayloadHasSuffix applies the HasSuffix predicate on the "payload" field.
+func PayloadHasSuffix(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldHasSuffix(FieldPayload, v))
+}
+
+// PayloadEqualFold applies the EqualFold predicate on the "payload" field.
+func PayloadEqualFold(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEqualFold(FieldPayload, v))
+}
+
+// PayloadContainsFold applies the ContainsFold predicate on the "payload" field.
+func PayloadContainsFold(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldContainsFold(FieldPayload, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewNoteEQ applies the EQ predicate on the "review_note" field.
+func ReviewNoteEQ(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteNEQ applies the NEQ predicate on the "review_note" field.
+func ReviewNoteNEQ(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldReviewNote, v))
+}
+
+// ReviewNoteIn applies the In predicate on the "review_note" field.
+func ReviewNoteIn(vs ...string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteNotIn applies the NotIn predicate on the "review_note" field.
+func ReviewNoteNotIn(vs ...string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldReviewNote, vs...))
+}
+
+// ReviewNoteGT applies the GT predicate on the "review_note" field.
+func ReviewNoteGT(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldReviewNote, v))
+}
+
+// ReviewNoteGTE applies the GTE predicate on the "review_note" field.
+func ReviewNoteGTE(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldReviewNote, v))
+}
+
+// ReviewNoteLT applies the LT predicate on the "review_note" field.
+func ReviewNoteLT(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldReviewNote, v))
+}
+
+// ReviewNoteLTE applies the LTE predicate on the "review_note" field.
+func ReviewNoteLTE(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldReviewNote, v))
+}
+
+// ReviewNoteContains applies the Contains predicate on the "review_note" field.
+func ReviewNoteContains(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldContains(FieldReviewNote, v))
+}
+
+// ReviewNoteHasPrefix applies the HasPrefix predicate on the "review_note" field.
+func ReviewNoteHasPrefix(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldHasPrefix(FieldReviewNote, v))
+}
+
+// ReviewNoteHasSuffix applies the HasSuffix predicate on the "review_note" field.
+func ReviewNoteHasSuffix(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldHasSuffix(FieldReviewNote, v))
+}
+
+// ReviewNoteEqualFold applies the EqualFold predicate on the "review_note" field.
+func ReviewNoteEqualFold(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEqualFold(FieldReviewNote, v))
+}
+
+// ReviewNoteContainsFold applies the ContainsFold predicate on the "review_note" field.
+func ReviewNoteContainsFold(v string) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldContainsFold(FieldReviewNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.WordProposal {
+	return predicate.WordProposal(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.WordProposal {
+	return predicate.WordProposal(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.WordProposal {
+	return predicate.WordProposal(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WordProposal) predicate.WordProposal {
+	return predicate.WordProposal(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WordProposal) predicate.WordProposal {
+	return predicate.WordProposal(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WordProposal) predicate.WordProposal {
+	return predicate.WordProposal(sql.NotPredicates(p))
+}
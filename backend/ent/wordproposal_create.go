@@ -0,0 +1,1098 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/user"
+	"word_app/backend/ent/wordproposal"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordProposalCreate is the builder for creating a WordProposal entity.
+type WordProposalCreate struct {
+	config
+	mutation *WordProposalMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetUserID sets the "user_id" field.
+func (wpc *WordProposalCreate) SetUserID(i int) *WordProposalCreate {
+	wpc.mutation.SetUserID(i)
+	return wpc
+}
+
+// SetAction sets the "action" field.
+func (wpc *WordProposalCreate) SetAction(w wordproposal.Action) *WordProposalCreate {
+	wpc.mutation.SetAction(w)
+	return wpc
+}
+
+// SetWordID sets the "word_id" field.
+func (wpc *WordProposalCreate) SetWordID(i int) *WordProposalCreate {
+	wpc.mutation.SetWordID(i)
+	return wpc
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (wpc *WordProposalCreate) SetNillableWordID(i *int) *WordProposalCreate {
+	if i != nil {
+		wpc.SetWordID(*i)
+	}
+	return wpc
+}
+
+// SetWordName sets the "word_name" field.
+func (wpc *WordProposalCreate) SetWordName(s string) *WordProposalCreate {
+	wpc.mutation.SetWordName(s)
+	return wpc
+}
+
+// SetPayload sets the "payload" field.
+func (wpc *WordProposalCreate) SetPayload(s string) *WordProposalCreate {
+	wpc.mutation.SetPayload(s)
+	return wpc
+}
+
+// SetStatus sets the "status" field.
+func (wpc *WordProposalCreate) SetStatus(w wordproposal.Status) *WordProposalCreate {
+	wpc.mutation.SetStatus(w)
+	return wpc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wpc *WordProposalCreate) SetNillableStatus(w *wordproposal.Status) *WordProposalCreate {
+	if w != nil {
+		wpc.SetStatus(*w)
+	}
+	return wpc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (wpc *WordProposalCreate) SetReviewerID(i int) *WordProposalCreate {
+	wpc.mutation.SetReviewerID(i)
+	return wpc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (wpc *WordProposalCreate) SetNillableReviewerID(i *int) *WordProposalCreate {
+	if i != nil {
+		wpc.SetReviewerID(*i)
+	}
+	return wpc
+}
+
+// SetReviewNote sets the "review_note" field.
+func (wpc *WordProposalCreate) SetReviewNote(s string) *WordProposalCreate {
+	wpc.mutation.SetReviewNote(s)
+	return wpc
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (wpc *WordProposalCreate) SetNillableReviewNote(s *string) *WordProposalCreate {
+	if s != nil {
+		wpc.SetReviewNote(*s)
+	}
+	return wpc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (wpc *WordProposalCreate) SetCreatedAt(t time.Time) *WordProposalCreate {
+	wpc.mutation.SetCreatedAt(t)
+	return wpc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (wpc *WordProposalCreate) SetNillableCreatedAt(t *time.Time) *WordProposalCreate {
+	if t != nil {
+		wpc.SetCreatedAt(*t)
+	}
+	return wpc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (wpc *WordProposalCreate) SetReviewedAt(t time.Time) *WordProposalCreate {
+	wpc.mutation.SetReviewedAt(t)
+	return wpc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (wpc *WordProposalCreate) SetNillableReviewedAt(t *time.Time) *WordProposalCreate {
+	if t != nil {
+		wpc.SetReviewedAt(*t)
+	}
+	return wpc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wpc *WordProposalCreate) SetUser(u *User) *WordProposalCreate {
+	return wpc.SetUserID(u.ID)
+}
+
+// Mutation returns the WordProposalMutation object of the builder.
+func (wpc *WordProposalCreate) Mutation() *WordProposalMutation {
+	return wpc.mutation
+}
+
+// Save creates the WordProposal in the database.
+func (wpc *WordProposalCreate) Save(ctx context.Context) (*WordProposal, error) {
+	wpc.defaults()
+	return withHooks(ctx, wpc.sqlSave, wpc.mutation, wpc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (wpc *WordProposalCreate) SaveX(ctx context.Context) *WordProposal {
+	v, err := wpc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpc *WordProposalCreate) Exec(ctx context.Context) error {
+	_, err := wpc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpc *WordProposalCreate) ExecX(ctx context.Context) {
+	if err := wpc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (wpc *WordProposalCreate) defaults() {
+	if _, ok := wpc.mutation.Status(); !ok {
+		v := wordproposal.DefaultStatus
+		wpc.mutation.SetStatus(v)
+	}
+	if _, ok := wpc.mutation.ReviewNote(); !ok {
+		v := wordproposal.DefaultReviewNote
+		wpc.mutation.SetReviewNote(v)
+	}
+	if _, ok := wpc.mutation.CreatedAt(); !ok {
+		v := wordproposal.DefaultCreatedAt()
+		wpc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpc *WordProposalCreate) check() error {
+	if _, ok := wpc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WordProposal.user_id"`)}
+	}
+	if v, ok := wpc.mutation.UserID(); ok {
+		if err := wordproposal.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WordProposal.user_id": %w`, err)}
+		}
+	}
+	if _, ok := wpc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`ent: missing required field "WordProposal.action"`)}
+	}
+	if v, ok := wpc.mutation.Action(); ok {
+		if err := wordproposal.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "WordProposal.action": %w`, err)}
+		}
+	}
+	if _, ok := wpc.mutation.WordName(); !ok {
+		return &ValidationError{Name: "word_name", err: errors.New(`ent: missing required field "WordProposal.word_name"`)}
+	}
+	if _, ok := wpc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "WordProposal.payload"`)}
+	}
+	if _, ok := wpc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "WordProposal.status"`)}
+	}
+	if v, ok := wpc.mutation.Status(); ok {
+		if err := wordproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WordProposal.status": %w`, err)}
+		}
+	}
+	if _, ok := wpc.mutation.ReviewNote(); !ok {
+		return &ValidationError{Name: "review_note", err: errors.New(`ent: missing required field "WordProposal.review_note"`)}
+	}
+	if _, ok := wpc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WordProposal.created_at"`)}
+	}
+	if len(wpc.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WordProposal.user"`)}
+	}
+	return nil
+}
+
+func (wpc *WordProposalCreate) sqlSave(ctx context.Context) (*WordProposal, error) {
+	if err := wpc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := wpc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, wpc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	wpc.mutation.id = &_node.ID
+	wpc.mutation.done = true
+	return _node, nil
+}
+
+func (wpc *WordProposalCreate) createSpec() (*WordProposal, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WordProposal{config: wpc.config}
+		_spec = sqlgraph.NewCreateSpec(wordproposal.Table, sqlgraph.NewFieldSpec(wordproposal.FieldID, field.TypeInt))
+	)
+	_spec.OnConflict = wpc.conflict
+	if value, ok := wpc.mutation.Action(); ok {
+		_spec.SetField(wordproposal.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := wpc.mutation.WordID(); ok {
+		_spec.SetField(wordproposal.FieldWordID, field.TypeInt, value)
+		_node.WordID = &value
+	}
+	if value, ok := wpc.mutation.WordName(); ok {
+		_spec.SetField(wordproposal.FieldWordName, field.TypeString, value)
+		_node.WordName = value
+	}
+	if value, ok := wpc.mutation.Payload(); ok {
+		_spec.SetField(wordproposal.FieldPayload, field.TypeString, value)
+		_node.Payload = value
+	}
+	if value, ok := wpc.mutation.Status(); ok {
+		_spec.SetField(wordproposal.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := wpc.mutation.ReviewerID(); ok {
+		_spec.SetField(wordproposal.FieldReviewerID, field.TypeInt, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := wpc.mutation.ReviewNote(); ok {
+		_spec.SetField(wordproposal.FieldReviewNote, field.TypeString, value)
+		_node.ReviewNote = value
+	}
+	if value, ok := wpc.mutation.CreatedAt(); ok {
+		_spec.SetField(wordproposal.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := wpc.mutation.ReviewedAt(); ok {
+		_spec.SetField(wordproposal.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if nodes := wpc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wordproposal.UserTable,
+			Columns: []string{wordproposal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WordProposal.Create().
+//		SetUserID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WordProposalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (wpc *WordProposalCreate) OnConflict(opts ...sql.ConflictOption) *WordProposalUpsertOne {
+	wpc.conflict = opts
+	return &WordProposalUpsertOne{
+		create: wpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WordProposal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wpc *WordProposalCreate) OnConflictColumns(columns ...string) *WordProposalUpsertOne {
+	wpc.conflict = append(wpc.conflict, sql.ConflictColumns(columns...))
+	return &WordProposalUpsertOne{
+		create: wpc,
+	}
+}
+
+type (
+	// WordProposalUpsertOne is the builder for "upsert"-ing
+	//  one WordProposal node.
+	WordProposalUpsertOne struct {
+		create *WordProposalCreate
+	}
+
+	// WordProposalUpsert is the "OnConflict" setter.
+	WordProposalUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUserID sets the "user_id" field.
+func (u *WordProposalUpsert) SetUserID(v int) *WordProposalUpsert {
+	u.Set(wordproposal.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateUserID() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldUserID)
+	return u
+}
+
+// SetAction sets the "action" field.
+func (u *WordProposalUpsert) SetAction(v wordproposal.Action) *WordProposalUpsert {
+	u.Set(wordproposal.FieldAction, v)
+	return u
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateAction() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldAction)
+	return u
+}
+
+// SetWordID sets the "word_id" field.
+func (u *WordProposalUpsert) SetWordID(v int) *WordProposalUpsert {
+	u.Set(wordproposal.FieldWordID, v)
+	return u
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateWordID() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldWordID)
+	return u
+}
+
+// AddWordID adds v to the "word_id" field.
+func (u *WordProposalUpsert) AddWordID(v int) *WordProposalUpsert {
+	u.Add(wordproposal.FieldWordID, v)
+	return u
+}
+
+// ClearWordID clears the value of the "word_id" field.
+func (u *WordProposalUpsert) ClearWordID() *WordProposalUpsert {
+	u.SetNull(wordproposal.FieldWordID)
+	return u
+}
+
+// SetWordName sets the "word_name" field.
+func (u *WordProposalUpsert) SetWordName(v string) *WordProposalUpsert {
+	u.Set(wordproposal.FieldWordName, v)
+	return u
+}
+
+// UpdateWordName sets the "word_name" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateWordName() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldWordName)
+	return u
+}
+
+// SetPayload sets the "payload" field.
+func (u *WordProposalUpsert) SetPayload(v string) *WordProposalUpsert {
+	u.Set(wordproposal.FieldPayload, v)
+	return u
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdatePayload() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldPayload)
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *WordProposalUpsert) SetStatus(v wordproposal.Status) *WordProposalUpsert {
+	u.Set(wordproposal.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateStatus() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldStatus)
+	return u
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *WordProposalUpsert) SetReviewerID(v int) *WordProposalUpsert {
+	u.Set(wordproposal.FieldReviewerID, v)
+	return u
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateReviewerID() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldReviewerID)
+	return u
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *WordProposalUpsert) AddReviewerID(v int) *WordProposalUpsert {
+	u.Add(wordproposal.FieldReviewerID, v)
+	return u
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *WordProposalUpsert) ClearReviewerID() *WordProposalUpsert {
+	u.SetNull(wordproposal.FieldReviewerID)
+	return u
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *WordProposalUpsert) SetReviewNote(v string) *WordProposalUpsert {
+	u.Set(wordproposal.FieldReviewNote, v)
+	return u
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateReviewNote() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldReviewNote)
+	return u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *WordProposalUpsert) SetReviewedAt(v time.Time) *WordProposalUpsert {
+	u.Set(wordproposal.FieldReviewedAt, v)
+	return u
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *WordProposalUpsert) UpdateReviewedAt() *WordProposalUpsert {
+	u.SetExcluded(wordproposal.FieldReviewedAt)
+	return u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *WordProposalUpsert) ClearReviewedAt() *WordProposalUpsert {
+	u.SetNull(wordproposal.FieldReviewedAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create.
+// Using this option is equivalent to using:
+//
+//	client.WordProposal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WordProposalUpsertOne) UpdateNewValues() *WordProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(wordproposal.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WordProposal.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *WordProposalUpsertOne) Ignore() *WordProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WordProposalUpsertOne) DoNothing() *WordProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WordProposalCreate.OnConflict
+// documentation for more info.
+func (u *WordProposalUpsertOne) Update(set func(*WordProposalUpsert)) *WordProposalUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WordProposalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *WordProposalUpsertOne) SetUserID(v int) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateUserID() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *WordProposalUpsertOne) SetAction(v wordproposal.Action) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateAction() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetWordID sets the "word_id" field.
+func (u *WordProposalUpsertOne) SetWordID(v int) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetWordID(v)
+	})
+}
+
+// AddWordID adds v to the "word_id" field.
+func (u *WordProposalUpsertOne) AddWordID(v int) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.AddWordID(v)
+	})
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateWordID() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateWordID()
+	})
+}
+
+// ClearWordID clears the value of the "word_id" field.
+func (u *WordProposalUpsertOne) ClearWordID() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.ClearWordID()
+	})
+}
+
+// SetWordName sets the "word_name" field.
+func (u *WordProposalUpsertOne) SetWordName(v string) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetWordName(v)
+	})
+}
+
+// UpdateWordName sets the "word_name" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateWordName() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateWordName()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *WordProposalUpsertOne) SetPayload(v string) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdatePayload() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WordProposalUpsertOne) SetStatus(v wordproposal.Status) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateStatus() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *WordProposalUpsertOne) SetReviewerID(v int) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *WordProposalUpsertOne) AddReviewerID(v int) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateReviewerID() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *WordProposalUpsertOne) ClearReviewerID() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *WordProposalUpsertOne) SetReviewNote(v string) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetReviewNote(v)
+	})
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateReviewNote() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateReviewNote()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *WordProposalUpsertOne) SetReviewedAt(v time.Time) *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *WordProposalUpsertOne) UpdateReviewedAt() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *WordProposalUpsertOne) ClearReviewedAt() *WordProposalUpsertOne {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WordProposalUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WordProposalCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WordProposalUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *WordProposalUpsertOne) ID(ctx context.Context) (id int, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *WordProposalUpsertOne) IDX(ctx context.Context) int {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// WordProposalCreateBulk is the builder for creating many WordProposal entities in bulk.
+type WordProposalCreateBulk struct {
+	config
+	err      error
+	builders []*WordProposalCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the WordProposal entities in the database.
+func (wpcb *WordProposalCreateBulk) Save(ctx context.Context) ([]*WordProposal, error) {
+	if wpcb.err != nil {
+		return nil, wpcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(wpcb.builders))
+	nodes := make([]*WordProposal, len(wpcb.builders))
+	mutators := make([]Mutator, len(wpcb.builders))
+	for i := range wpcb.builders {
+		func(i int, root context.Context) {
+			builder := wpcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WordProposalMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, wpcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = wpcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, wpcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, wpcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpcb *WordProposalCreateBulk) SaveX(ctx context.Context) []*WordProposal {
+	v, err := wpcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (wpcb *WordProposalCreateBulk) Exec(ctx context.Context) error {
+	_, err := wpcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpcb *WordProposalCreateBulk) ExecX(ctx context.Context) {
+	if err := wpcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.WordProposal.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.WordProposalUpsert) {
+//			SetUserID(v+v).
+//		}).
+//		Exec(ctx)
+func (wpcb *WordProposalCreateBulk) OnConflict(opts ...sql.ConflictOption) *WordProposalUpsertBulk {
+	wpcb.conflict = opts
+	return &WordProposalUpsertBulk{
+		create: wpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.WordProposal.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (wpcb *WordProposalCreateBulk) OnConflictColumns(columns ...string) *WordProposalUpsertBulk {
+	wpcb.conflict = append(wpcb.conflict, sql.ConflictColumns(columns...))
+	return &WordProposalUpsertBulk{
+		create: wpcb,
+	}
+}
+
+// WordProposalUpsertBulk is the builder for "upsert"-ing
+// a bulk of WordProposal nodes.
+type WordProposalUpsertBulk struct {
+	create *WordProposalCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.WordProposal.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//		).
+//		Exec(ctx)
+func (u *WordProposalUpsertBulk) UpdateNewValues() *WordProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(wordproposal.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.WordProposal.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *WordProposalUpsertBulk) Ignore() *WordProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *WordProposalUpsertBulk) DoNothing() *WordProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the WordProposalCreateBulk.OnConflict
+// documentation for more info.
+func (u *WordProposalUpsertBulk) Update(set func(*WordProposalUpsert)) *WordProposalUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&WordProposalUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *WordProposalUpsertBulk) SetUserID(v int) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateUserID() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetAction sets the "action" field.
+func (u *WordProposalUpsertBulk) SetAction(v wordproposal.Action) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetAction(v)
+	})
+}
+
+// UpdateAction sets the "action" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateAction() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateAction()
+	})
+}
+
+// SetWordID sets the "word_id" field.
+func (u *WordProposalUpsertBulk) SetWordID(v int) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetWordID(v)
+	})
+}
+
+// AddWordID adds v to the "word_id" field.
+func (u *WordProposalUpsertBulk) AddWordID(v int) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.AddWordID(v)
+	})
+}
+
+// UpdateWordID sets the "word_id" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateWordID() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateWordID()
+	})
+}
+
+// ClearWordID clears the value of the "word_id" field.
+func (u *WordProposalUpsertBulk) ClearWordID() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.ClearWordID()
+	})
+}
+
+// SetWordName sets the "word_name" field.
+func (u *WordProposalUpsertBulk) SetWordName(v string) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetWordName(v)
+	})
+}
+
+// UpdateWordName sets the "word_name" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateWordName() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateWordName()
+	})
+}
+
+// SetPayload sets the "payload" field.
+func (u *WordProposalUpsertBulk) SetPayload(v string) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetPayload(v)
+	})
+}
+
+// UpdatePayload sets the "payload" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdatePayload() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdatePayload()
+	})
+}
+
+// SetStatus sets the "status" field.
+func (u *WordProposalUpsertBulk) SetStatus(v wordproposal.Status) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateStatus() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (u *WordProposalUpsertBulk) SetReviewerID(v int) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetReviewerID(v)
+	})
+}
+
+// AddReviewerID adds v to the "reviewer_id" field.
+func (u *WordProposalUpsertBulk) AddReviewerID(v int) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.AddReviewerID(v)
+	})
+}
+
+// UpdateReviewerID sets the "reviewer_id" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateReviewerID() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateReviewerID()
+	})
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (u *WordProposalUpsertBulk) ClearReviewerID() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.ClearReviewerID()
+	})
+}
+
+// SetReviewNote sets the "review_note" field.
+func (u *WordProposalUpsertBulk) SetReviewNote(v string) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetReviewNote(v)
+	})
+}
+
+// UpdateReviewNote sets the "review_note" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateReviewNote() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateReviewNote()
+	})
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (u *WordProposalUpsertBulk) SetReviewedAt(v time.Time) *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.SetReviewedAt(v)
+	})
+}
+
+// UpdateReviewedAt sets the "reviewed_at" field to the value that was provided on create.
+func (u *WordProposalUpsertBulk) UpdateReviewedAt() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.UpdateReviewedAt()
+	})
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (u *WordProposalUpsertBulk) ClearReviewedAt() *WordProposalUpsertBulk {
+	return u.Update(func(s *WordProposalUpsert) {
+		s.ClearReviewedAt()
+	})
+}
+
+// Exec executes the query.
+func (u *WordProposalUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the WordProposalCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for WordProposalCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *WordProposalUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
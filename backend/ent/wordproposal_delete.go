@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/ent/wordproposal"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordProposalDelete is the builder for deleting a WordProposal entity.
+type WordProposalDelete struct {
+	config
+	hooks    []Hook
+	mutation *WordProposalMutation
+}
+
+// Where appends a list predicates to the WordProposalDelete builder.
+func (wpd *WordProposalDelete) Where(ps ...predicate.WordProposal) *WordProposalDelete {
+	wpd.mutation.Where(ps...)
+	return wpd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (wpd *WordProposalDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, wpd.sqlExec, wpd.mutation, wpd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpd *WordProposalDelete) ExecX(ctx context.Context) int {
+	n, err := wpd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (wpd *WordProposalDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(wordproposal.Table, sqlgraph.NewFieldSpec(wordproposal.FieldID, field.TypeInt))
+	if ps := wpd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, wpd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	wpd.mutation.done = true
+	return affected, err
+}
+
+// WordProposalDeleteOne is the builder for deleting a single WordProposal entity.
+type WordProposalDeleteOne struct {
+	wpd *WordProposalDelete
+}
+
+// Where appends a list predicates to the WordProposalDelete builder.
+func (wpdo *WordProposalDeleteOne) Where(ps ...predicate.WordProposal) *WordProposalDeleteOne {
+	wpdo.wpd.mutation.Where(ps...)
+	return wpdo
+}
+
+// Exec executes the deletion query.
+func (wpdo *WordProposalDeleteOne) Exec(ctx context.Context) error {
+	n, err := wpdo.wpd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{wordproposal.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpdo *WordProposalDeleteOne) ExecX(ctx context.Context) {
+	if err := wpdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,629 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/ent/user"
+	"word_app/backend/ent/wordproposal"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordProposalQuery is the builder for querying WordProposal entities.
+type WordProposalQuery struct {
+	config
+	ctx        *QueryContext
+	order      []wordproposal.OrderOption
+	inters     []Interceptor
+	predicates []predicate.WordProposal
+	withUser   *UserQuery
+	modifiers  []func(*sql.Selector)
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the WordProposalQuery builder.
+func (wpq *WordProposalQuery) Where(ps ...predicate.WordProposal) *WordProposalQuery {
+	wpq.predicates = append(wpq.predicates, ps...)
+	return wpq
+}
+
+// Limit the number of records to be returned by this query.
+func (wpq *WordProposalQuery) Limit(limit int) *WordProposalQuery {
+	wpq.ctx.Limit = &limit
+	return wpq
+}
+
+// Offset to start from.
+func (wpq *WordProposalQuery) Offset(offset int) *WordProposalQuery {
+	wpq.ctx.Offset = &offset
+	return wpq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (wpq *WordProposalQuery) Unique(unique bool) *WordProposalQuery {
+	wpq.ctx.Unique = &unique
+	return wpq
+}
+
+// Order specifies how the records should be ordered.
+func (wpq *WordProposalQuery) Order(o ...wordproposal.OrderOption) *WordProposalQuery {
+	wpq.order = append(wpq.order, o...)
+	return wpq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (wpq *WordProposalQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: wpq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := wpq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := wpq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wordproposal.Table, wordproposal.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wordproposal.UserTable, wordproposal.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(wpq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first WordProposal entity from the query.
+// Returns a *NotFoundError when no WordProposal was found.
+func (wpq *WordProposalQuery) First(ctx context.Context) (*WordProposal, error) {
+	nodes, err := wpq.Limit(1).All(setContextOp(ctx, wpq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{wordproposal.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (wpq *WordProposalQuery) FirstX(ctx context.Context) *WordProposal {
+	node, err := wpq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first WordProposal ID from the query.
+// Returns a *NotFoundError when no WordProposal ID was found.
+func (wpq *WordProposalQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wpq.Limit(1).IDs(setContextOp(ctx, wpq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{wordproposal.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (wpq *WordProposalQuery) FirstIDX(ctx context.Context) int {
+	id, err := wpq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single WordProposal entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one WordProposal entity is found.
+// Returns a *NotFoundError when no WordProposal entities are found.
+func (wpq *WordProposalQuery) Only(ctx context.Context) (*WordProposal, error) {
+	nodes, err := wpq.Limit(2).All(setContextOp(ctx, wpq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{wordproposal.Label}
+	default:
+		return nil, &NotSingularError{wordproposal.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (wpq *WordProposalQuery) OnlyX(ctx context.Context) *WordProposal {
+	node, err := wpq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only WordProposal ID in the query.
+// Returns a *NotSingularError when more than one WordProposal ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (wpq *WordProposalQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = wpq.Limit(2).IDs(setContextOp(ctx, wpq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{wordproposal.Label}
+	default:
+		err = &NotSingularError{wordproposal.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (wpq *WordProposalQuery) OnlyIDX(ctx context.Context) int {
+	id, err := wpq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of WordProposals.
+func (wpq *WordProposalQuery) All(ctx context.Context) ([]*WordProposal, error) {
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryAll)
+	if err := wpq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*WordProposal, *WordProposalQuery]()
+	return withInterceptors[[]*WordProposal](ctx, wpq, qr, wpq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (wpq *WordProposalQuery) AllX(ctx context.Context) []*WordProposal {
+	nodes, err := wpq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of WordProposal IDs.
+func (wpq *WordProposalQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if wpq.ctx.Unique == nil && wpq.path != nil {
+		wpq.Unique(true)
+	}
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryIDs)
+	if err = wpq.Select(wordproposal.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (wpq *WordProposalQuery) IDsX(ctx context.Context) []int {
+	ids, err := wpq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (wpq *WordProposalQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryCount)
+	if err := wpq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, wpq, querierCount[*WordProposalQuery](), wpq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (wpq *WordProposalQuery) CountX(ctx context.Context) int {
+	count, err := wpq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (wpq *WordProposalQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, wpq.ctx, ent.OpQueryExist)
+	switch _, err := wpq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (wpq *WordProposalQuery) ExistX(ctx context.Context) bool {
+	exist, err := wpq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the WordProposalQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (wpq *WordProposalQuery) Clone() *WordProposalQuery {
+	if wpq == nil {
+		return nil
+	}
+	return &WordProposalQuery{
+		config:     wpq.config,
+		ctx:        wpq.ctx.Clone(),
+		order:      append([]wordproposal.OrderOption{}, wpq.order...),
+		inters:     append([]Interceptor{}, wpq.inters...),
+		predicates: append([]predicate.WordProposal{}, wpq.predicates...),
+		withUser:   wpq.withUser.Clone(),
+		// clone intermediate query.
+		sql:       wpq.sql.Clone(),
+		path:      wpq.path,
+		modifiers: append([]func(*sql.Selector){}, wpq.modifiers...),
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (wpq *WordProposalQuery) WithUser(opts ...func(*UserQuery)) *WordProposalQuery {
+	query := (&UserClient{config: wpq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	wpq.withUser = query
+	return wpq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.WordProposal.Query().
+//		GroupBy(wordproposal.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (wpq *WordProposalQuery) GroupBy(field string, fields ...string) *WordProposalGroupBy {
+	wpq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &WordProposalGroupBy{build: wpq}
+	grbuild.flds = &wpq.ctx.Fields
+	grbuild.label = wordproposal.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.WordProposal.Query().
+//		Select(wordproposal.FieldUserID).
+//		Scan(ctx, &v)
+func (wpq *WordProposalQuery) Select(fields ...string) *WordProposalSelect {
+	wpq.ctx.Fields = append(wpq.ctx.Fields, fields...)
+	sbuild := &WordProposalSelect{WordProposalQuery: wpq}
+	sbuild.label = wordproposal.Label
+	sbuild.flds, sbuild.scan = &wpq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a WordProposalSelect configured with the given aggregations.
+func (wpq *WordProposalQuery) Aggregate(fns ...AggregateFunc) *WordProposalSelect {
+	return wpq.Select().Aggregate(fns...)
+}
+
+func (wpq *WordProposalQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range wpq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, wpq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range wpq.ctx.Fields {
+		if !wordproposal.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if wpq.path != nil {
+		prev, err := wpq.path(ctx)
+		if err != nil {
+			return err
+		}
+		wpq.sql = prev
+	}
+	return nil
+}
+
+func (wpq *WordProposalQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*WordProposal, error) {
+	var (
+		nodes       = []*WordProposal{}
+		_spec       = wpq.querySpec()
+		loadedTypes = [1]bool{
+			wpq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*WordProposal).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &WordProposal{config: wpq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	if len(wpq.modifiers) > 0 {
+		_spec.Modifiers = wpq.modifiers
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, wpq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := wpq.withUser; query != nil {
+		if err := wpq.loadUser(ctx, query, nodes, nil,
+			func(n *WordProposal, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (wpq *WordProposalQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*WordProposal, init func(*WordProposal), assign func(*WordProposal, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*WordProposal)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (wpq *WordProposalQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := wpq.querySpec()
+	if len(wpq.modifiers) > 0 {
+		_spec.Modifiers = wpq.modifiers
+	}
+	_spec.Node.Columns = wpq.ctx.Fields
+	if len(wpq.ctx.Fields) > 0 {
+		_spec.Unique = wpq.ctx.Unique != nil && *wpq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, wpq.driver, _spec)
+}
+
+func (wpq *WordProposalQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(wordproposal.Table, wordproposal.Columns, sqlgraph.NewFieldSpec(wordproposal.FieldID, field.TypeInt))
+	_spec.From = wpq.sql
+	if unique := wpq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if wpq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := wpq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordproposal.FieldID)
+		for i := range fields {
+			if fields[i] != wordproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if wpq.withUser != nil {
+			_spec.Node.AddColumnOnce(wordproposal.FieldUserID)
+		}
+	}
+	if ps := wpq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := wpq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := wpq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := wpq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (wpq *WordProposalQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(wpq.driver.Dialect())
+	t1 := builder.Table(wordproposal.Table)
+	columns := wpq.ctx.Fields
+	if len(columns) == 0 {
+		columns = wordproposal.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if wpq.sql != nil {
+		selector = wpq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if wpq.ctx.Unique != nil && *wpq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, m := range wpq.modifiers {
+		m(selector)
+	}
+	for _, p := range wpq.predicates {
+		p(selector)
+	}
+	for _, p := range wpq.order {
+		p(selector)
+	}
+	if offset := wpq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := wpq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wpq *WordProposalQuery) Modify(modifiers ...func(s *sql.Selector)) *WordProposalSelect {
+	wpq.modifiers = append(wpq.modifiers, modifiers...)
+	return wpq.Select()
+}
+
+// WordProposalGroupBy is the group-by builder for WordProposal entities.
+type WordProposalGroupBy struct {
+	selector
+	build *WordProposalQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (wpgb *WordProposalGroupBy) Aggregate(fns ...AggregateFunc) *WordProposalGroupBy {
+	wpgb.fns = append(wpgb.fns, fns...)
+	return wpgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wpgb *WordProposalGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wpgb.build.ctx, ent.OpQueryGroupBy)
+	if err := wpgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WordProposalQuery, *WordProposalGroupBy](ctx, wpgb.build, wpgb, wpgb.build.inters, v)
+}
+
+func (wpgb *WordProposalGroupBy) sqlScan(ctx context.Context, root *WordProposalQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(wpgb.fns))
+	for _, fn := range wpgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*wpgb.flds)+len(wpgb.fns))
+		for _, f := range *wpgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*wpgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wpgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// WordProposalSelect is the builder for selecting fields of WordProposal entities.
+type WordProposalSelect struct {
+	*WordProposalQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (wps *WordProposalSelect) Aggregate(fns ...AggregateFunc) *WordProposalSelect {
+	wps.fns = append(wps.fns, fns...)
+	return wps
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (wps *WordProposalSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, wps.ctx, ent.OpQuerySelect)
+	if err := wps.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*WordProposalQuery, *WordProposalSelect](ctx, wps.WordProposalQuery, wps, wps.inters, v)
+}
+
+func (wps *WordProposalSelect) sqlScan(ctx context.Context, root *WordProposalQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(wps.fns))
+	for _, fn := range wps.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*wps.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := wps.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// Modify adds a query modifier for attaching custom logic to queries.
+func (wps *WordProposalSelect) Modify(modifiers ...func(s *sql.Selector)) *WordProposalSelect {
+	wps.modifiers = append(wps.modifiers, modifiers...)
+	return wps
+}
@@ -0,0 +1,719 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/ent/user"
+	"word_app/backend/ent/wordproposal"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// WordProposalUpdate is the builder for updating WordProposal entities.
+type WordProposalUpdate struct {
+	config
+	hooks     []Hook
+	mutation  *WordProposalMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// Where appends a list predicates to the WordProposalUpdate builder.
+func (wpu *WordProposalUpdate) Where(ps ...predicate.WordProposal) *WordProposalUpdate {
+	wpu.mutation.Where(ps...)
+	return wpu
+}
+
+// SetUserID sets the "user_id" field.
+func (wpu *WordProposalUpdate) SetUserID(i int) *WordProposalUpdate {
+	wpu.mutation.SetUserID(i)
+	return wpu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableUserID(i *int) *WordProposalUpdate {
+	if i != nil {
+		wpu.SetUserID(*i)
+	}
+	return wpu
+}
+
+// SetAction sets the "action" field.
+func (wpu *WordProposalUpdate) SetAction(w wordproposal.Action) *WordProposalUpdate {
+	wpu.mutation.SetAction(w)
+	return wpu
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableAction(w *wordproposal.Action) *WordProposalUpdate {
+	if w != nil {
+		wpu.SetAction(*w)
+	}
+	return wpu
+}
+
+// SetWordID sets the "word_id" field.
+func (wpu *WordProposalUpdate) SetWordID(i int) *WordProposalUpdate {
+	wpu.mutation.ResetWordID()
+	wpu.mutation.SetWordID(i)
+	return wpu
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableWordID(i *int) *WordProposalUpdate {
+	if i != nil {
+		wpu.SetWordID(*i)
+	}
+	return wpu
+}
+
+// AddWordID adds i to the "word_id" field.
+func (wpu *WordProposalUpdate) AddWordID(i int) *WordProposalUpdate {
+	wpu.mutation.AddWordID(i)
+	return wpu
+}
+
+// ClearWordID clears the value of the "word_id" field.
+func (wpu *WordProposalUpdate) ClearWordID() *WordProposalUpdate {
+	wpu.mutation.ClearWordID()
+	return wpu
+}
+
+// SetWordName sets the "word_name" field.
+func (wpu *WordProposalUpdate) SetWordName(s string) *WordProposalUpdate {
+	wpu.mutation.SetWordName(s)
+	return wpu
+}
+
+// SetNillableWordName sets the "word_name" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableWordName(s *string) *WordProposalUpdate {
+	if s != nil {
+		wpu.SetWordName(*s)
+	}
+	return wpu
+}
+
+// SetPayload sets the "payload" field.
+func (wpu *WordProposalUpdate) SetPayload(s string) *WordProposalUpdate {
+	wpu.mutation.SetPayload(s)
+	return wpu
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillablePayload(s *string) *WordProposalUpdate {
+	if s != nil {
+		wpu.SetPayload(*s)
+	}
+	return wpu
+}
+
+// SetStatus sets the "status" field.
+func (wpu *WordProposalUpdate) SetStatus(w wordproposal.Status) *WordProposalUpdate {
+	wpu.mutation.SetStatus(w)
+	return wpu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableStatus(w *wordproposal.Status) *WordProposalUpdate {
+	if w != nil {
+		wpu.SetStatus(*w)
+	}
+	return wpu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (wpu *WordProposalUpdate) SetReviewerID(i int) *WordProposalUpdate {
+	wpu.mutation.ResetReviewerID()
+	wpu.mutation.SetReviewerID(i)
+	return wpu
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableReviewerID(i *int) *WordProposalUpdate {
+	if i != nil {
+		wpu.SetReviewerID(*i)
+	}
+	return wpu
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (wpu *WordProposalUpdate) AddReviewerID(i int) *WordProposalUpdate {
+	wpu.mutation.AddReviewerID(i)
+	return wpu
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (wpu *WordProposalUpdate) ClearReviewerID() *WordProposalUpdate {
+	wpu.mutation.ClearReviewerID()
+	return wpu
+}
+
+// SetReviewNote sets the "review_note" field.
+func (wpu *WordProposalUpdate) SetReviewNote(s string) *WordProposalUpdate {
+	wpu.mutation.SetReviewNote(s)
+	return wpu
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableReviewNote(s *string) *WordProposalUpdate {
+	if s != nil {
+		wpu.SetReviewNote(*s)
+	}
+	return wpu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (wpu *WordProposalUpdate) SetReviewedAt(t time.Time) *WordProposalUpdate {
+	wpu.mutation.SetReviewedAt(t)
+	return wpu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (wpu *WordProposalUpdate) SetNillableReviewedAt(t *time.Time) *WordProposalUpdate {
+	if t != nil {
+		wpu.SetReviewedAt(*t)
+	}
+	return wpu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (wpu *WordProposalUpdate) ClearReviewedAt() *WordProposalUpdate {
+	wpu.mutation.ClearReviewedAt()
+	return wpu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wpu *WordProposalUpdate) SetUser(u *User) *WordProposalUpdate {
+	return wpu.SetUserID(u.ID)
+}
+
+// Mutation returns the WordProposalMutation object of the builder.
+func (wpu *WordProposalUpdate) Mutation() *WordProposalMutation {
+	return wpu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wpu *WordProposalUpdate) ClearUser() *WordProposalUpdate {
+	wpu.mutation.ClearUser()
+	return wpu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (wpu *WordProposalUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, wpu.sqlSave, wpu.mutation, wpu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpu *WordProposalUpdate) SaveX(ctx context.Context) int {
+	affected, err := wpu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (wpu *WordProposalUpdate) Exec(ctx context.Context) error {
+	_, err := wpu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpu *WordProposalUpdate) ExecX(ctx context.Context) {
+	if err := wpu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpu *WordProposalUpdate) check() error {
+	if v, ok := wpu.mutation.UserID(); ok {
+		if err := wordproposal.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WordProposal.user_id": %w`, err)}
+		}
+	}
+	if v, ok := wpu.mutation.Action(); ok {
+		if err := wordproposal.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "WordProposal.action": %w`, err)}
+		}
+	}
+	if v, ok := wpu.mutation.Status(); ok {
+		if err := wordproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WordProposal.status": %w`, err)}
+		}
+	}
+	if wpu.mutation.UserCleared() && len(wpu.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WordProposal.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wpu *WordProposalUpdate) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WordProposalUpdate {
+	wpu.modifiers = append(wpu.modifiers, modifiers...)
+	return wpu
+}
+
+func (wpu *WordProposalUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := wpu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wordproposal.Table, wordproposal.Columns, sqlgraph.NewFieldSpec(wordproposal.FieldID, field.TypeInt))
+	if ps := wpu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpu.mutation.Action(); ok {
+		_spec.SetField(wordproposal.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := wpu.mutation.WordID(); ok {
+		_spec.SetField(wordproposal.FieldWordID, field.TypeInt, value)
+	}
+	if value, ok := wpu.mutation.AddedWordID(); ok {
+		_spec.AddField(wordproposal.FieldWordID, field.TypeInt, value)
+	}
+	if wpu.mutation.WordIDCleared() {
+		_spec.ClearField(wordproposal.FieldWordID, field.TypeInt)
+	}
+	if value, ok := wpu.mutation.WordName(); ok {
+		_spec.SetField(wordproposal.FieldWordName, field.TypeString, value)
+	}
+	if value, ok := wpu.mutation.Payload(); ok {
+		_spec.SetField(wordproposal.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wpu.mutation.Status(); ok {
+		_spec.SetField(wordproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wpu.mutation.ReviewerID(); ok {
+		_spec.SetField(wordproposal.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := wpu.mutation.AddedReviewerID(); ok {
+		_spec.AddField(wordproposal.FieldReviewerID, field.TypeInt, value)
+	}
+	if wpu.mutation.ReviewerIDCleared() {
+		_spec.ClearField(wordproposal.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := wpu.mutation.ReviewNote(); ok {
+		_spec.SetField(wordproposal.FieldReviewNote, field.TypeString, value)
+	}
+	if value, ok := wpu.mutation.ReviewedAt(); ok {
+		_spec.SetField(wordproposal.FieldReviewedAt, field.TypeTime, value)
+	}
+	if wpu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(wordproposal.FieldReviewedAt, field.TypeTime)
+	}
+	if wpu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wordproposal.UserTable,
+			Columns: []string{wordproposal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wordproposal.UserTable,
+			Columns: []string{wordproposal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wpu.modifiers...)
+	if n, err = sqlgraph.UpdateNodes(ctx, wpu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	wpu.mutation.done = true
+	return n, nil
+}
+
+// WordProposalUpdateOne is the builder for updating a single WordProposal entity.
+type WordProposalUpdateOne struct {
+	config
+	fields    []string
+	hooks     []Hook
+	mutation  *WordProposalMutation
+	modifiers []func(*sql.UpdateBuilder)
+}
+
+// SetUserID sets the "user_id" field.
+func (wpuo *WordProposalUpdateOne) SetUserID(i int) *WordProposalUpdateOne {
+	wpuo.mutation.SetUserID(i)
+	return wpuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableUserID(i *int) *WordProposalUpdateOne {
+	if i != nil {
+		wpuo.SetUserID(*i)
+	}
+	return wpuo
+}
+
+// SetAction sets the "action" field.
+func (wpuo *WordProposalUpdateOne) SetAction(w wordproposal.Action) *WordProposalUpdateOne {
+	wpuo.mutation.SetAction(w)
+	return wpuo
+}
+
+// SetNillableAction sets the "action" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableAction(w *wordproposal.Action) *WordProposalUpdateOne {
+	if w != nil {
+		wpuo.SetAction(*w)
+	}
+	return wpuo
+}
+
+// SetWordID sets the "word_id" field.
+func (wpuo *WordProposalUpdateOne) SetWordID(i int) *WordProposalUpdateOne {
+	wpuo.mutation.ResetWordID()
+	wpuo.mutation.SetWordID(i)
+	return wpuo
+}
+
+// SetNillableWordID sets the "word_id" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableWordID(i *int) *WordProposalUpdateOne {
+	if i != nil {
+		wpuo.SetWordID(*i)
+	}
+	return wpuo
+}
+
+// AddWordID adds i to the "word_id" field.
+func (wpuo *WordProposalUpdateOne) AddWordID(i int) *WordProposalUpdateOne {
+	wpuo.mutation.AddWordID(i)
+	return wpuo
+}
+
+// ClearWordID clears the value of the "word_id" field.
+func (wpuo *WordProposalUpdateOne) ClearWordID() *WordProposalUpdateOne {
+	wpuo.mutation.ClearWordID()
+	return wpuo
+}
+
+// SetWordName sets the "word_name" field.
+func (wpuo *WordProposalUpdateOne) SetWordName(s string) *WordProposalUpdateOne {
+	wpuo.mutation.SetWordName(s)
+	return wpuo
+}
+
+// SetNillableWordName sets the "word_name" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableWordName(s *string) *WordProposalUpdateOne {
+	if s != nil {
+		wpuo.SetWordName(*s)
+	}
+	return wpuo
+}
+
+// SetPayload sets the "payload" field.
+func (wpuo *WordProposalUpdateOne) SetPayload(s string) *WordProposalUpdateOne {
+	wpuo.mutation.SetPayload(s)
+	return wpuo
+}
+
+// SetNillablePayload sets the "payload" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillablePayload(s *string) *WordProposalUpdateOne {
+	if s != nil {
+		wpuo.SetPayload(*s)
+	}
+	return wpuo
+}
+
+// SetStatus sets the "status" field.
+func (wpuo *WordProposalUpdateOne) SetStatus(w wordproposal.Status) *WordProposalUpdateOne {
+	wpuo.mutation.SetStatus(w)
+	return wpuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableStatus(w *wordproposal.Status) *WordProposalUpdateOne {
+	if w != nil {
+		wpuo.SetStatus(*w)
+	}
+	return wpuo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (wpuo *WordProposalUpdateOne) SetReviewerID(i int) *WordProposalUpdateOne {
+	wpuo.mutation.ResetReviewerID()
+	wpuo.mutation.SetReviewerID(i)
+	return wpuo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableReviewerID(i *int) *WordProposalUpdateOne {
+	if i != nil {
+		wpuo.SetReviewerID(*i)
+	}
+	return wpuo
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (wpuo *WordProposalUpdateOne) AddReviewerID(i int) *WordProposalUpdateOne {
+	wpuo.mutation.AddReviewerID(i)
+	return wpuo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (wpuo *WordProposalUpdateOne) ClearReviewerID() *WordProposalUpdateOne {
+	wpuo.mutation.ClearReviewerID()
+	return wpuo
+}
+
+// SetReviewNote sets the "review_note" field.
+func (wpuo *WordProposalUpdateOne) SetReviewNote(s string) *WordProposalUpdateOne {
+	wpuo.mutation.SetReviewNote(s)
+	return wpuo
+}
+
+// SetNillableReviewNote sets the "review_note" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableReviewNote(s *string) *WordProposalUpdateOne {
+	if s != nil {
+		wpuo.SetReviewNote(*s)
+	}
+	return wpuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (wpuo *WordProposalUpdateOne) SetReviewedAt(t time.Time) *WordProposalUpdateOne {
+	wpuo.mutation.SetReviewedAt(t)
+	return wpuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (wpuo *WordProposalUpdateOne) SetNillableReviewedAt(t *time.Time) *WordProposalUpdateOne {
+	if t != nil {
+		wpuo.SetReviewedAt(*t)
+	}
+	return wpuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (wpuo *WordProposalUpdateOne) ClearReviewedAt() *WordProposalUpdateOne {
+	wpuo.mutation.ClearReviewedAt()
+	return wpuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (wpuo *WordProposalUpdateOne) SetUser(u *User) *WordProposalUpdateOne {
+	return wpuo.SetUserID(u.ID)
+}
+
+// Mutation returns the WordProposalMutation object of the builder.
+func (wpuo *WordProposalUpdateOne) Mutation() *WordProposalMutation {
+	return wpuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (wpuo *WordProposalUpdateOne) ClearUser() *WordProposalUpdateOne {
+	wpuo.mutation.ClearUser()
+	return wpuo
+}
+
+// Where appends a list predicates to the WordProposalUpdate builder.
+func (wpuo *WordProposalUpdateOne) Where(ps ...predicate.WordProposal) *WordProposalUpdateOne {
+	wpuo.mutation.Where(ps...)
+	return wpuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (wpuo *WordProposalUpdateOne) Select(field string, fields ...string) *WordProposalUpdateOne {
+	wpuo.fields = append([]string{field}, fields...)
+	return wpuo
+}
+
+// Save executes the query and returns the updated WordProposal entity.
+func (wpuo *WordProposalUpdateOne) Save(ctx context.Context) (*WordProposal, error) {
+	return withHooks(ctx, wpuo.sqlSave, wpuo.mutation, wpuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (wpuo *WordProposalUpdateOne) SaveX(ctx context.Context) *WordProposal {
+	node, err := wpuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (wpuo *WordProposalUpdateOne) Exec(ctx context.Context) error {
+	_, err := wpuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (wpuo *WordProposalUpdateOne) ExecX(ctx context.Context) {
+	if err := wpuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (wpuo *WordProposalUpdateOne) check() error {
+	if v, ok := wpuo.mutation.UserID(); ok {
+		if err := wordproposal.UserIDValidator(v); err != nil {
+			return &ValidationError{Name: "user_id", err: fmt.Errorf(`ent: validator failed for field "WordProposal.user_id": %w`, err)}
+		}
+	}
+	if v, ok := wpuo.mutation.Action(); ok {
+		if err := wordproposal.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`ent: validator failed for field "WordProposal.action": %w`, err)}
+		}
+	}
+	if v, ok := wpuo.mutation.Status(); ok {
+		if err := wordproposal.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "WordProposal.status": %w`, err)}
+		}
+	}
+	if wpuo.mutation.UserCleared() && len(wpuo.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WordProposal.user"`)
+	}
+	return nil
+}
+
+// Modify adds a statement modifier for attaching custom logic to the UPDATE statement.
+func (wpuo *WordProposalUpdateOne) Modify(modifiers ...func(u *sql.UpdateBuilder)) *WordProposalUpdateOne {
+	wpuo.modifiers = append(wpuo.modifiers, modifiers...)
+	return wpuo
+}
+
+func (wpuo *WordProposalUpdateOne) sqlSave(ctx context.Context) (_node *WordProposal, err error) {
+	if err := wpuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wordproposal.Table, wordproposal.Columns, sqlgraph.NewFieldSpec(wordproposal.FieldID, field.TypeInt))
+	id, ok := wpuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WordProposal.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := wpuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wordproposal.FieldID)
+		for _, f := range fields {
+			if !wordproposal.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wordproposal.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := wpuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := wpuo.mutation.Action(); ok {
+		_spec.SetField(wordproposal.FieldAction, field.TypeEnum, value)
+	}
+	if value, ok := wpuo.mutation.WordID(); ok {
+		_spec.SetField(wordproposal.FieldWordID, field.TypeInt, value)
+	}
+	if value, ok := wpuo.mutation.AddedWordID(); ok {
+		_spec.AddField(wordproposal.FieldWordID, field.TypeInt, value)
+	}
+	if wpuo.mutation.WordIDCleared() {
+		_spec.ClearField(wordproposal.FieldWordID, field.TypeInt)
+	}
+	if value, ok := wpuo.mutation.WordName(); ok {
+		_spec.SetField(wordproposal.FieldWordName, field.TypeString, value)
+	}
+	if value, ok := wpuo.mutation.Payload(); ok {
+		_spec.SetField(wordproposal.FieldPayload, field.TypeString, value)
+	}
+	if value, ok := wpuo.mutation.Status(); ok {
+		_spec.SetField(wordproposal.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := wpuo.mutation.ReviewerID(); ok {
+		_spec.SetField(wordproposal.FieldReviewerID, field.TypeInt, value)
+	}
+	if value, ok := wpuo.mutation.AddedReviewerID(); ok {
+		_spec.AddField(wordproposal.FieldReviewerID, field.TypeInt, value)
+	}
+	if wpuo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(wordproposal.FieldReviewerID, field.TypeInt)
+	}
+	if value, ok := wpuo.mutation.ReviewNote(); ok {
+		_spec.SetField(wordproposal.FieldReviewNote, field.TypeString, value)
+	}
+	if value, ok := wpuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(wordproposal.FieldReviewedAt, field.TypeTime, value)
+	}
+	if wpuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(wordproposal.FieldReviewedAt, field.TypeTime)
+	}
+	if wpuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wordproposal.UserTable,
+			Columns: []string{wordproposal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := wpuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wordproposal.UserTable,
+			Columns: []string{wordproposal.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_spec.AddModifiers(wpuo.modifiers...)
+	_node = &WordProposal{config: wpuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, wpuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wordproposal.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	wpuo.mutation.done = true
+	return _node, nil
+}
@@ -25,6 +25,7 @@ require (
 	github.com/stretchr/testify v1.10.0
 	golang.org/x/crypto v0.42.0
 	golang.org/x/oauth2 v0.30.0
+	golang.org/x/text v0.29.0
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1
 )
 
@@ -78,7 +79,6 @@ require (
 	golang.org/x/net v0.44.0 // indirect
 	golang.org/x/sync v0.17.0 // indirect
 	golang.org/x/sys v0.36.0 // indirect
-	golang.org/x/text v0.29.0 // indirect
 	golang.org/x/tools v0.37.0 // indirect
 	google.golang.org/protobuf v1.36.6 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -5,11 +5,16 @@ import (
 	"word_app/backend/config"
 	AuthH "word_app/backend/src/handlers/auth"
 	BulkH "word_app/backend/src/handlers/bulk"
+	deckH "word_app/backend/src/handlers/deck"
+	exportH "word_app/backend/src/handlers/export"
 	quizH "word_app/backend/src/handlers/quiz"
 	resultH "word_app/backend/src/handlers/result"
 	settingH "word_app/backend/src/handlers/setting"
 	userH "word_app/backend/src/handlers/user"
+	vocabimportH "word_app/backend/src/handlers/vocabimport"
+	wellknownH "word_app/backend/src/handlers/wellknown"
 	wordH "word_app/backend/src/handlers/word"
+	wordproposalH "word_app/backend/src/handlers/wordproposal"
 	"word_app/backend/src/infrastructure/jwt"
 	"word_app/backend/src/interfaces"
 	"word_app/backend/src/interfaces/http/quiz"
@@ -18,25 +23,35 @@ import (
 )
 
 type Handlers struct {
-	Auth    AuthH.Handler
-	Bulk    BulkH.Handler
-	Setting settingH.Handler
-	User    userH.Handler
-	Word    word.Handler
-	Quiz    quiz.Handler
-	Result  result.Handler
+	Auth      AuthH.Handler
+	Bulk      BulkH.Handler
+	Setting   settingH.Handler
+	User      userH.Handler
+	Word      word.Handler
+	Deck      deckH.Handler
+	Quiz      quiz.Handler
+	Result    result.Handler
+	Export    exportH.Handler
+	Import    vocabimportH.Handler
+	Proposal  wordproposalH.Handler
+	WellKnown wellknownH.Handler
 }
 
 func NewHandlers(config *config.Config, uc *UseCases, client interfaces.ClientInterface, s *Services) *Handlers {
-	jwtGen := jwt.NewMyJWTGenerator(config.JWT.Secret)
+	jwtGen := jwt.NewMyJWTGenerator(uc.Keys, accessTTL(&config.JWT))
 	// 既存のservice 層は “薄い Facade” として存続させる想定
 	return &Handlers{
-		Auth:    AuthH.NewHandler(uc.Auth, jwtGen, config),
-		Bulk:    BulkH.NewHandler(uc.BulkToken, uc.BulkRegister, &config.Limits),
-		Setting: settingH.NewHandler(uc.Setting),
-		User:    userH.NewHandler(uc.User, jwtGen),
-		Word:    wordH.NewHandler(s.Word),
-		Quiz:    quizH.NewHandler(s.Quiz),
-		Result:  resultH.NewHandler(s.Result),
+		Auth:      AuthH.NewHandler(uc.Auth, jwtGen, config),
+		Bulk:      BulkH.NewHandler(uc.BulkToken, uc.BulkRegister, &config.Limits),
+		Setting:   settingH.NewHandler(uc.Setting),
+		User:      userH.NewHandler(uc.User, uc.Session, uc.Account),
+		Word:      wordH.NewHandler(s.Word, uc.EditPolicy, uc.WordProposal),
+		Deck:      deckH.NewHandler(uc.Deck),
+		Quiz:      quizH.NewHandler(s.Quiz),
+		Result:    resultH.NewHandler(s.Result),
+		Export:    exportH.NewHandler(uc.Export),
+		Import:    vocabimportH.NewHandler(uc.VocabImport, &config.Limits),
+		Proposal:  wordproposalH.NewHandler(uc.WordProposal),
+		WellKnown: wellknownH.NewHandler(uc.Keys),
 	}
 }
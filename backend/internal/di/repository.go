@@ -3,12 +3,17 @@ package di
 
 import (
 	authRepo "word_app/backend/src/infrastructure/repository/auth"
+	deckRepo "word_app/backend/src/infrastructure/repository/deck"
+	exportRepo "word_app/backend/src/infrastructure/repository/export"
+	posmappingRepo "word_app/backend/src/infrastructure/repository/posmapping"
 	registeredwordRepo "word_app/backend/src/infrastructure/repository/registeredword"
+	sessionRepo "word_app/backend/src/infrastructure/repository/session"
 	settingRepo "word_app/backend/src/infrastructure/repository/setting"
 	txRepo "word_app/backend/src/infrastructure/repository/tx"
 	userRepo "word_app/backend/src/infrastructure/repository/user"
 	userdailyusageRepo "word_app/backend/src/infrastructure/repository/userdailyusage"
 	wordRepo "word_app/backend/src/infrastructure/repository/word"
+	wordproposalRepo "word_app/backend/src/infrastructure/repository/wordproposal"
 	"word_app/backend/src/interfaces"
 	"word_app/backend/src/interfaces/sqlexec"
 )
@@ -19,10 +24,15 @@ type Repos struct {
 	Auth                authRepo.ExternalAuthRepository
 	RootSetting         settingRepo.RootConfigRepository
 	UserSetting         settingRepo.UserConfigRepository
+	PosMapping          posmappingRepo.Repository
 	UserDailyUsage      userdailyusageRepo.Repository
 	WordRead            wordRepo.ReadRepository
 	RegisteredWordRead  registeredwordRepo.ReadRepository
 	RegisteredWordWrite registeredwordRepo.WriteRepository
+	Deck                deckRepo.Repository
+	Export              exportRepo.Repository
+	WordProposal        wordproposalRepo.Repository
+	Session             sessionRepo.Repository
 }
 
 func NewRepositories(cli interfaces.ClientInterface, s sqlexec.Runner) *Repos {
@@ -32,9 +42,14 @@ func NewRepositories(cli interfaces.ClientInterface, s sqlexec.Runner) *Repos {
 		Auth:                authRepo.NewEntExtAuthRepo(cli),
 		RootSetting:         settingRepo.NewEntRootConfigRepo(cli),
 		UserSetting:         settingRepo.NewEntUserConfigRepo(cli),
+		PosMapping:          posmappingRepo.NewEntPosMappingRepo(cli),
 		UserDailyUsage:      userdailyusageRepo.NewEntUserDailyUsageRepo(cli, s),
 		WordRead:            wordRepo.NewEntWordReadRepo(cli),
 		RegisteredWordRead:  registeredwordRepo.NewEntRegisteredWordReadRepo(cli),
 		RegisteredWordWrite: registeredwordRepo.NewEntRegisteredWordWriteRepo(cli),
+		Deck:                deckRepo.NewEntDeckRepo(cli),
+		Export:              exportRepo.NewEntExportRepo(cli),
+		WordProposal:        wordproposalRepo.NewEntWordProposalRepo(cli),
+		Session:             sessionRepo.NewEntSessionRepo(cli),
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"word_app/backend/config"
 	"word_app/backend/src/interfaces"
 	"word_app/backend/src/usecase/clock"
+	wordproposalUc "word_app/backend/src/usecase/wordproposal"
 
 	quizSvc "word_app/backend/src/service/quiz"
 	resultSvc "word_app/backend/src/service/result"
@@ -18,10 +19,14 @@ type Services struct {
 }
 
 func NewServices(config *config.Config, uc *UseCases, client interfaces.ClientInterface, r *Repos) *Services {
+	word := wordSvc.NewWordService(client, r.User, &config.Limits, r.Tx, uc.EditPolicy)
+	// 提案の承認は word service で単語マスタへ反映する
+	uc.WordProposal = wordproposalUc.NewUsecase(r.WordProposal, r.WordRead, uc.EditPolicy, word,
+		clock.SystemClock{}, &config.Limits)
+
 	return &Services{
-		Word: wordSvc.NewWordService(client, r.User,
-			&config.Limits, r.Tx),
+		Word:   word,
 		Quiz:   quizSvc.NewService(client, r.UserDailyUsage, clock.SystemClock{}, &config.Limits),
-		Result: resultSvc.NewService(client),
+		Result: resultSvc.NewService(client, clock.SystemClock{}),
 	}
 }
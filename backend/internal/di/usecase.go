@@ -2,40 +2,79 @@
 package di
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"word_app/backend/config"
-	"word_app/backend/src/infrastructure/auth/line"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
 	"word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/infrastructure/mailer"
 	"word_app/backend/src/infrastructure/ratelimit"
+	accountUc "word_app/backend/src/usecase/account"
 	authUc "word_app/backend/src/usecase/auth"
 	bulkUc "word_app/backend/src/usecase/bulk"
 	"word_app/backend/src/usecase/clock"
+	deckUc "word_app/backend/src/usecase/deck"
+	"word_app/backend/src/usecase/editpolicy"
+	exportUc "word_app/backend/src/usecase/export"
 	jwtUc "word_app/backend/src/usecase/jwt"
+	sessionUc "word_app/backend/src/usecase/session"
 	settingUc "word_app/backend/src/usecase/setting"
 	userUc "word_app/backend/src/usecase/user"
+	vocabimportUc "word_app/backend/src/usecase/vocabimport"
+	wordproposalUc "word_app/backend/src/usecase/wordproposal"
 	"word_app/backend/src/utils/tempjwt"
 )
 
 type UseCases struct {
 	Auth         *authUc.AuthUsecase
+	Account      accountUc.Usecase
+	Session      sessionUc.Usecase
 	BulkToken    bulkUc.TokenizeUsecase
 	BulkRegister bulkUc.RegisterUsecase
+	Deck         deckUc.Usecase
+	Export       exportUc.Usecase
+	VocabImport  vocabimportUc.Usecase
+	EditPolicy   editpolicy.Policy
+	WordProposal wordproposalUc.Usecase  // word service を使うので NewServices で組み立てる
 	Setting      settingUc.SettingFacade // interface
 	User         *userUc.UserUsecase     // interface
 	Jwt          *jwtUc.JwtUsecase       // interface
+	Keys         *jwt.KeySet             // JWKS の公開用にハンドラへも渡す
 }
 
 func NewUseCases(config *config.Config, r *Repos) (*UseCases, error) {
 	// -------- Auth -----------
-	// LINE 認証プロバイダの初期化
-	lineProv, _ := line.NewProvider(config.Line)
+	// ソーシャルログインのプロバイダ（LINE と OIDC_PROVIDERS）を名前で登録
+	providers := authprovider.NewRegistryFromConfig(context.Background(), config)
 	// JWT 生成器の初期化
-	// JWTSecret は環境変数から取得することを想定
-	jwtGen := jwt.NewMyJWTGenerator(config.JWT.Secret)
-	tempJwt := tempjwt.New(config.JWT.TempSecret)
+	// 署名鍵は JWT_SIGNING_KEY（無ければ JWT_SECRET）、旧鍵は JWT_VERIFY_KEYS から読む
+	keys, err := jwt.NewKeySetFromConfig(&config.JWT)
+	if err != nil {
+		return nil, err
+	}
+	jwtGen := jwt.NewMyJWTGenerator(keys, accessTTL(&config.JWT))
+	tempJwt, err := tempjwt.New(config.JWT.TempSecret)
+	if err != nil {
+		return nil, fmt.Errorf("TEMP_JWT_SECRET: %w", err)
+	}
+	// メール確認・パスワード再設定のリンクも一時トークン用の鍵で署名する
+	actionTok, err := jwt.NewActionToken(config.JWT.TempSecret)
+	if err != nil {
+		return nil, fmt.Errorf("TEMP_JWT_SECRET: %w", err)
+	}
 	rl, err := ratelimit.NewRateLimiterFromEnv()
 	if err != nil {
 		return nil, err
 	}
+	// メール送信（MAIL_DRIVER で SMTP / ファイル / ログを切り替え）
+	mail, err := mailer.NewMailerFromConfig(config.Mail)
+	if err != nil {
+		return nil, err
+	}
+	// アクセストークン＋リフレッシュトークンの発行・ローテーション・失効
+	sessions := sessionUc.NewUsecase(r.Tx, r.Session, r.User, jwtGen, clock.SystemClock{}, &config.JWT)
 	// -------- Setting -----------
 	// 各種設定ユースケースの初期化
 	// authCfgUc := settingUc.NewAuthConfig(r.RootSetting)
@@ -44,17 +83,33 @@ func NewUseCases(config *config.Config, r *Repos) (*UseCases, error) {
 	getUserUc := settingUc.NewGetUserConfig(r.UserSetting)
 	updateRootUc := settingUc.NewUpdateRootConfig(r.RootSetting, r.User)
 	updateUserUc := settingUc.NewUpdateUserConfig(r.Tx, r.UserSetting)
-	settingFacade := settingUc.NewSettingFacade(getRootUc, getRuntimeConfigUc, getUserUc, updateRootUc, updateUserUc)
+	getPosMappingUc := settingUc.NewGetPosMapping(r.User, r.PosMapping)
+	updatePosMappingUc := settingUc.NewUpdatePosMapping(r.Tx, r.User, r.PosMapping)
+	settingFacade := settingUc.NewSettingFacade(getRootUc, getRuntimeConfigUc, getUserUc, updateRootUc, updateUserUc,
+		getPosMappingUc, updatePosMappingUc)
 
 	return &UseCases{
-		Auth: authUc.NewUsecase(r.Tx, lineProv, r.User, r.UserSetting,
-			r.Auth, jwtGen, tempJwt, r.RootSetting, r.UserDailyUsage, clock.SystemClock{}, rl),
+		Auth: authUc.NewUsecase(r.Tx, providers, r.User, r.UserSetting,
+			r.Auth, jwtGen, sessions, tempJwt, r.RootSetting, r.UserDailyUsage, clock.SystemClock{}, rl),
+		Account: accountUc.NewUsecase(r.User, r.RootSetting, actionTok, mail,
+			sessions, clock.SystemClock{}, &config.JWT, &config.Mail),
+		Session: sessions,
 
 		BulkToken:    bulkUc.NewTokenizeUsecase(r.WordRead, r.RegisteredWordRead, r.UserDailyUsage, clock.SystemClock{}, &config.Limits),
 		BulkRegister: bulkUc.NewRegisterUsecase(r.WordRead, r.RegisteredWordRead, r.RegisteredWordWrite, r.Tx, r.User, &config.Limits),
+		Deck:         deckUc.NewUsecase(r.Tx, r.Deck, r.RegisteredWordRead, r.RegisteredWordWrite, r.User, &config.Limits),
+		Export:       exportUc.NewUsecase(r.Export),
+		VocabImport:  vocabimportUc.NewUsecase(r.WordRead, r.RegisteredWordRead, r.RegisteredWordWrite, r.Tx, r.User, &config.Limits),
+		EditPolicy:   editpolicy.NewPolicy(r.RootSetting, r.User),
 
 		Setting: settingFacade, // まとめ役だけ保持
 		User:    userUc.NewUserUsecase(r.Tx, r.User, r.UserSetting, r.Auth),
-		Jwt:     jwtUc.NewJwtUsecase(jwt.NewHS256Verifier(config.JWT.Secret), r.User),
+		Jwt:     jwtUc.NewJwtUsecase(jwt.NewVerifier(keys), r.User),
+		Keys:    keys,
 	}, nil
 }
+
+// accessTTL は JWT_EXPIRATION_HOURS / JWT_EXPIRATION_MINUTES からアクセストークンの有効期限を求める
+func accessTTL(c *config.JWTCfg) time.Duration {
+	return time.Duration(c.ExpireHour)*time.Hour + time.Duration(c.ExpireMinute)*time.Minute
+}
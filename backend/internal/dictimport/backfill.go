@@ -0,0 +1,77 @@
+package dictimport
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"word_app/backend/src/domain/posmap"
+)
+
+// legacyPosMapping は品詞コードを保存するようになる前の取り込みの対応（固定の posCode2ID）。
+// 当時は未知のコードも慣用句にしていたため、Fallback を慣用句にすると Resolve が同じ品詞を返す。
+var legacyPosMapping = &posmap.Mapping{
+	Codes: map[string]int{
+		"n":  posmap.Noun,
+		"pn": posmap.Pronoun,
+		"vs": posmap.Verb, "v5r": posmap.Verb, "v1": posmap.Verb, "vi": posmap.Verb, "vt": posmap.Verb,
+		"adj-i": posmap.Adjective, "adj-na": posmap.Adjective, "adj-f": posmap.Adjective,
+		"adv": posmap.Adverb, "adv-to": posmap.Adverb,
+		"aux-v": posmap.Auxiliary,
+		"prep":  posmap.Preposition,
+		"art":   posmap.Article,
+		"int":   posmap.Interjection,
+		"conj":  posmap.Conjunction,
+		"exp":   posmap.Idiom,
+		"unc":   posmap.Other,
+	},
+	Fallback: posmap.Idiom,
+}
+
+type namePOS struct {
+	word  string
+	posID int
+}
+
+// PosCodeIndex は (単語, 以前の取り込みで付いた品詞) → 品詞コード。
+// 品詞コードを持たない WordInfo に、どのコードから作られた行なのかを補うために使う。
+type PosCodeIndex struct {
+	codes map[namePOS][]string
+}
+
+// Len は索引に載っている (単語, 品詞) の数
+func (x *PosCodeIndex) Len() int { return len(x.codes) }
+
+func (x *PosCodeIndex) lookup(word string, posID int) []string {
+	if x == nil {
+		return nil
+	}
+	return x.codes[namePOS{word, posID}]
+}
+
+// LegacyPosCodes は辞書ファイルを src の形式で読み、以前の対応で決まっていた品詞ごとに品詞コードを集める。
+// 取り込み済みの WordInfo と同じ辞書ファイルを渡す。品詞名で指定された行（表形式）は品詞コードが無いので載らない。
+func LegacyPosCodes(ctx context.Context, r io.Reader, src Source) (*PosCodeIndex, error) {
+	x := &PosCodeIndex{codes: map[namePOS][]string{}}
+	err := src.Entries(ctx, r, func(index int, e JMEntry) error {
+		for _, row := range entryRows(index, e, legacyPosMapping) {
+			if len(row.tags.pos) == 0 {
+				continue
+			}
+			if row, _ = validateRow(row); row.word == "" {
+				continue
+			}
+			key := namePOS{row.word, row.posID}
+			for _, c := range row.tags.pos {
+				if !slices.Contains(x.codes[key], c) {
+					x.codes[key] = append(x.codes[key], c)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return x, nil
+}
@@ -0,0 +1,357 @@
+package dictimport
+
+import (
+	"context"
+	"regexp"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/word"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/domain/posmap"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// 1 文の INSERT / IN 句に載せる最大件数（PostgreSQL のプレースホルダ上限 65535 に収める）
+const maxRowsPerStatement = 1000
+
+var (
+	reSpace  = regexp.MustCompile(`\s`)
+	reSymbol = regexp.MustCompile(`[!?\(\)0-9]`)
+)
+
+// glossRow は 1 つの英語 Gloss から作る Word / WordInfo / JapaneseMean の組
+type glossRow struct {
+	entryID    string
+	entryIndex int
+	word       string
+	rank       int // Word.frequency_rank
+	posID      int
+	tags       usageTags
+	means      []jpMean
+}
+
+// entryRows はエントリを Gloss 単位の行に展開する。品詞は pm で品詞コードから決める。
+func entryRows(index int, e JMEntry, pm *posmap.Mapping) []glossRow {
+	means := japaneseMeans(e)
+	rank := frequencyRank(e)
+	var rows []glossRow
+	for _, s := range e.Sense {
+		posID := s.posID
+		if posID == 0 {
+			if len(s.PartOfSpeech) == 0 {
+				continue
+			}
+			posID = pm.Resolve(s.PartOfSpeech)
+		}
+		tags := senseTags(s)
+		for _, g := range s.Gloss {
+			if g.Lang != "eng" {
+				continue
+			}
+			rows = append(rows, glossRow{
+				entryID:    e.ID,
+				entryIndex: index,
+				word:       g.Text,
+				rank:       rank,
+				posID:      posID,
+				tags:       tags,
+				means:      means,
+			})
+		}
+	}
+	return rows
+}
+
+// japaneseMeans は日本語訳の候補。kanji.text 優先（読みを添える）、無い場合 kana[0]
+func japaneseMeans(e JMEntry) []jpMean {
+	var means []jpMean
+	for _, kj := range e.Kanji {
+		if kj.Text != "" {
+			means = append(means, jpMean{name: kj.Text, reading: readingOf(e, kj.Text)})
+		}
+	}
+	if len(means) == 0 && len(e.Kana) > 0 && e.Kana[0].Text != "" {
+		means = append(means, jpMean{name: e.Kana[0].Text})
+	}
+	return means
+}
+
+// validateRow はスキーマの Validator で弾かれる値を事前に除く。
+// 一括 INSERT は 1 件でも不正な値があると全体が失敗するため。
+func validateRow(r glossRow) (glossRow, []ImportErr) {
+	if err := word.NameValidator(r.word); err != nil {
+		return glossRow{}, []ImportErr{{ID: r.entryID, Index: r.entryIndex, Message: r.word + ": " + err.Error()}}
+	}
+	var errs []ImportErr
+	means := make([]jpMean, 0, len(r.means))
+	for _, m := range r.means {
+		if err := japanesemean.NameValidator(m.name); err != nil {
+			errs = append(errs, ImportErr{ID: r.entryID, Index: r.entryIndex, Message: m.name + ": " + err.Error()})
+			continue
+		}
+		means = append(means, m)
+	}
+	r.means = means
+	return r, errs
+}
+
+type wordPOS struct {
+	wordID int
+	posID  int
+}
+
+// writeBatch は行のまとまりを 1 トランザクションで書き込む。
+// Word・JapaneseMean は ON CONFLICT DO NOTHING の一括 INSERT、
+// WordInfo は一意制約が無いため既存行を 1 回で引いてから不足分だけ一括 INSERT する。
+// 同じ単語は常に同じワーカーに振り分けるので、WordInfo の重複作成は起きない。
+func writeBatch(ctx context.Context, cli *ent.Client, rows []glossRow) error {
+	return withTx(ctx, cli, func(tx *ent.Tx) error {
+		wordIDs, err := upsertWords(ctx, tx, rows)
+		if err != nil {
+			return err
+		}
+		infoIDs, err := ensureWordInfos(ctx, tx, rows, wordIDs)
+		if err != nil {
+			return err
+		}
+		return upsertJapaneseMeans(ctx, tx, rows, wordIDs, infoIDs)
+	})
+}
+
+// upsertWords は単語を一括 upsert し、名前 → ID を返す。
+// 既存の単語は頻出度が上がる場合だけ frequency_rank を更新する。
+func upsertWords(ctx context.Context, tx *ent.Tx, rows []glossRow) (map[string]int, error) {
+	ranks := map[string]int{}
+	var names []string
+	for _, r := range rows {
+		cur, ok := ranks[r.word]
+		if !ok {
+			names = append(names, r.word)
+		}
+		if !ok || r.rank < cur {
+			ranks[r.word] = r.rank
+		}
+	}
+
+	ids := make(map[string]int, len(names))
+	promote := map[int][]int{} // rank → 更新する Word の ID
+	for _, chunk := range chunks(names, maxRowsPerStatement) {
+		builders := make([]*ent.WordCreate, 0, len(chunk))
+		for _, name := range chunk {
+			builders = append(builders, tx.Word.Create().
+				SetName(name).
+				SetIsIdioms(reSpace.MatchString(name)).
+				SetIsSpecialCharacters(reSymbol.MatchString(name)).
+				SetRegistrationCount(0).
+				SetFrequencyRank(ranks[name]))
+		}
+		if err := tx.Word.CreateBulk(builders...).
+			OnConflictColumns(word.FieldName).
+			DoNothing().
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		found, err := tx.Word.Query().
+			Where(word.NameIn(chunk...)).
+			Select(word.FieldID, word.FieldName, word.FieldFrequencyRank).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range found {
+			ids[w.Name] = w.ID
+			if want := ranks[w.Name]; w.FrequencyRank == nil || *w.FrequencyRank > want {
+				promote[want] = append(promote[want], w.ID)
+			}
+		}
+	}
+
+	for rank, wordIDs := range promote {
+		for _, chunk := range chunks(wordIDs, maxRowsPerStatement) {
+			if err := tx.Word.Update().
+				Where(word.IDIn(chunk...)).
+				SetFrequencyRank(rank).
+				Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ids, nil
+}
+
+// ensureWordInfos は (word, 品詞) の WordInfo を揃え、その ID を返す。
+// 既存の WordInfo には足りない品詞コード・タグ・例文を追記する。
+func ensureWordInfos(
+	ctx context.Context,
+	tx *ent.Tx,
+	rows []glossRow,
+	wordIDs map[string]int,
+) (map[wordPOS]int, error) {
+	want := map[wordPOS]*usageTags{}
+	var keys []wordPOS
+	for _, r := range rows {
+		key := wordPOS{wordIDs[r.word], r.posID}
+		t, ok := want[key]
+		if !ok {
+			t = &usageTags{}
+			want[key] = t
+			keys = append(keys, key)
+		}
+		t.merge(r.tags)
+	}
+
+	ids, err := updateWordInfos(ctx, tx, wordIDs, want)
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []wordPOS
+	for _, key := range keys {
+		if _, ok := ids[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	for _, chunk := range chunks(missing, maxRowsPerStatement) {
+		builders := make([]*ent.WordInfoCreate, 0, len(chunk))
+		for _, k := range chunk {
+			t := want[k]
+			builders = append(builders, tx.WordInfo.Create().
+				SetWordID(k.wordID).
+				SetPartOfSpeechID(k.posID).
+				SetPosCodes(t.pos).
+				SetFieldTags(t.field).
+				SetMiscTags(t.misc).
+				SetDialectTags(t.dialect).
+				SetExamples(t.examples))
+		}
+		created, err := tx.WordInfo.CreateBulk(builders...).Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, wi := range created {
+			ids[wordPOS{wi.WordID, wi.PartOfSpeechID}] = wi.ID
+		}
+	}
+	return ids, nil
+}
+
+// updateWordInfos は既存の WordInfo を 1 回で引き、品詞コード・タグ・例文が増えるものだけ更新する
+func updateWordInfos(
+	ctx context.Context,
+	tx *ent.Tx,
+	wordIDs map[string]int,
+	want map[wordPOS]*usageTags,
+) (map[wordPOS]int, error) {
+	idList := make([]int, 0, len(wordIDs))
+	for _, id := range wordIDs {
+		idList = append(idList, id)
+	}
+
+	ids := map[wordPOS]int{}
+	for _, chunk := range chunks(idList, maxRowsPerStatement) {
+		existing, err := tx.WordInfo.Query().
+			Where(wordinfo.WordIDIn(chunk...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, wi := range existing {
+			key := wordPOS{wi.WordID, wi.PartOfSpeechID}
+			ids[key] = wi.ID
+			t, ok := want[key]
+			if !ok || t.empty() {
+				continue
+			}
+			merged := tagsOf(wi)
+			if !merged.merge(*t) {
+				continue
+			}
+			if err := tx.WordInfo.UpdateOneID(wi.ID).
+				SetPosCodes(merged.pos).
+				SetFieldTags(merged.field).
+				SetMiscTags(merged.misc).
+				SetDialectTags(merged.dialect).
+				SetExamples(merged.examples).
+				Exec(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ids, nil
+}
+
+// upsertJapaneseMeans は日本語訳を一括 upsert する。
+// 読みがある訳は既存行の読みも更新し、読みの無い訳は既存行をそのまま残す。
+func upsertJapaneseMeans(
+	ctx context.Context,
+	tx *ent.Tx,
+	rows []glossRow,
+	wordIDs map[string]int,
+	infoIDs map[wordPOS]int,
+) error {
+	type meanKey struct {
+		infoID int
+		name   string
+	}
+	readings := map[meanKey]string{}
+	var keys []meanKey
+	for _, r := range rows {
+		infoID := infoIDs[wordPOS{wordIDs[r.word], r.posID}]
+		for _, m := range r.means {
+			k := meanKey{infoID, m.name}
+			cur, ok := readings[k]
+			if !ok {
+				keys = append(keys, k)
+			}
+			if cur == "" {
+				readings[k] = m.reading
+			}
+		}
+	}
+
+	var withReading, withoutReading []*ent.JapaneseMeanCreate
+	for _, k := range keys {
+		c := tx.JapaneseMean.Create().
+			SetWordInfoID(k.infoID).
+			SetName(k.name)
+		if reading := readings[k]; reading != "" {
+			withReading = append(withReading, c.SetReading(reading))
+		} else {
+			withoutReading = append(withoutReading, c)
+		}
+	}
+
+	conflict := sql.ConflictColumns(japanesemean.FieldWordInfoID, japanesemean.FieldName)
+	for _, chunk := range chunks(withReading, maxRowsPerStatement) {
+		if err := tx.JapaneseMean.CreateBulk(chunk...).
+			OnConflict(conflict).
+			UpdateReading().
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	for _, chunk := range chunks(withoutReading, maxRowsPerStatement) {
+		if err := tx.JapaneseMean.CreateBulk(chunk...).
+			OnConflict(conflict).
+			DoNothing().
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func chunks[T any](xs []T, n int) [][]T {
+	var out [][]T
+	for len(xs) > n {
+		out = append(out, xs[:n])
+		xs = xs[n:]
+	}
+	if len(xs) > 0 {
+		out = append(out, xs)
+	}
+	return out
+}
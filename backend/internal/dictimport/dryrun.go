@@ -0,0 +1,248 @@
+package dictimport
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/word"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repository/posmapping"
+)
+
+// ドライランで 1 回に照合する行数
+const dryRunBatch = 1000
+
+// DiffSummary はドライランの集計
+type DiffSummary struct {
+	Entries   int // 読み込んだエントリ数
+	Failures  int // 取り込めないエントリ・値の数
+	NewWords  int
+	NewInfos  int
+	NewMeans  int
+	Unchanged int // すでに全て登録済みの行（Gloss）の数
+}
+
+func (d DiffSummary) String() string {
+	return fmt.Sprintf("entries=%d failures=%d +words=%d +word_infos=%d +japanese_means=%d unchanged=%d",
+		d.Entries, d.Failures, d.NewWords, d.NewInfos, d.NewMeans, d.Unchanged)
+}
+
+// DryRun は辞書ファイルを src の形式で読み、書き込まずに現在の Word / WordInfo / JapaneseMean との差分を w に出力する。
+//
+// 追加される行は「+」、取り込めない値は「!」（エントリ ID・位置・理由）で始まる:
+//
+//	! 4 #3 café: invalid word name
+//	+ word apple
+//	+ info apple [名詞]
+//	+ mean apple [名詞] 林檎 (りんご)
+func DryRun(ctx context.Context, path string, cli *ent.Client, src Source, w io.Writer) (DiffSummary, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return DiffSummary{}, err
+	}
+	defer func() { _ = f.Close() }()
+
+	pos, err := cli.PartOfSpeech.Query().All(ctx)
+	if err != nil {
+		return DiffSummary{}, err
+	}
+	pm, err := posmapping.Load(ctx, cli)
+	if err != nil {
+		return DiffSummary{}, err
+	}
+	posNames := make(map[int]string, len(pos))
+	for _, p := range pos {
+		posNames[p.ID] = p.Name
+	}
+	d := &differ{
+		cli:      cli,
+		out:      bufio.NewWriter(w),
+		posNames: posNames,
+		posMap:   pm,
+		seen:     map[string]struct{}{},
+	}
+
+	err = src.Entries(ctx, f, func(index int, e JMEntry) error {
+		d.sum.Entries++
+		if e.problem != "" {
+			d.failure(ImportErr{ID: e.ID, Index: index, Message: e.problem})
+		}
+		for _, row := range entryRows(index, e, d.posMap) {
+			row, errs := validateRow(row)
+			for _, ie := range errs {
+				d.failure(ie)
+			}
+			if row.word == "" {
+				continue
+			}
+			d.pending = append(d.pending, row)
+			if len(d.pending) >= dryRunBatch {
+				if err := d.flush(ctx); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err == nil {
+		err = d.flush(ctx)
+	}
+	if ferr := d.out.Flush(); err == nil {
+		err = ferr
+	}
+	return d.sum, err
+}
+
+// differ は行をまとめて DB と照合し、新しく作られるものを出力する
+type differ struct {
+	cli      *ent.Client
+	out      *bufio.Writer
+	posNames map[int]string
+	posMap   *posmap.Mapping
+	pending  []glossRow
+	seen     map[string]struct{} // 出力済みの追加（ファイル内の重複を 1 回にする）
+	sum      DiffSummary
+}
+
+// existing は照合対象の行について、登録済みの単語・WordInfo・日本語訳
+type existing struct {
+	words map[string]int
+	infos map[wordPOS]int
+	means map[int]map[string]struct{} // WordInfo ID → 日本語訳
+}
+
+func (d *differ) failure(ie ImportErr) {
+	d.sum.Failures++
+	d.printf("! %s #%d %s\n", ie.ID, ie.Index, ie.Message)
+}
+
+func (d *differ) flush(ctx context.Context) error {
+	if len(d.pending) == 0 {
+		return nil
+	}
+	ex, err := d.lookup(ctx, d.pending)
+	if err != nil {
+		return err
+	}
+	for _, r := range d.pending {
+		d.diffRow(r, ex)
+	}
+	d.pending = d.pending[:0]
+	return nil
+}
+
+func (d *differ) lookup(ctx context.Context, rows []glossRow) (existing, error) {
+	ex := existing{words: map[string]int{}, infos: map[wordPOS]int{}, means: map[int]map[string]struct{}{}}
+
+	names := make([]string, 0, len(rows))
+	for _, r := range rows {
+		names = append(names, r.word)
+	}
+	words, err := d.cli.Word.Query().
+		Where(word.NameIn(names...)).
+		Select(word.FieldID, word.FieldName).
+		All(ctx)
+	if err != nil {
+		return ex, err
+	}
+	wordIDs := make([]int, 0, len(words))
+	for _, w := range words {
+		ex.words[w.Name] = w.ID
+		wordIDs = append(wordIDs, w.ID)
+	}
+
+	infos, err := d.cli.WordInfo.Query().
+		Where(wordinfo.WordIDIn(wordIDs...)).
+		Select(wordinfo.FieldID, wordinfo.FieldWordID, wordinfo.FieldPartOfSpeechID).
+		All(ctx)
+	if err != nil {
+		return ex, err
+	}
+	infoIDs := make([]int, 0, len(infos))
+	for _, wi := range infos {
+		ex.infos[wordPOS{wi.WordID, wi.PartOfSpeechID}] = wi.ID
+		infoIDs = append(infoIDs, wi.ID)
+	}
+
+	means, err := d.cli.JapaneseMean.Query().
+		Where(japanesemean.WordInfoIDIn(infoIDs...)).
+		Select(japanesemean.FieldWordInfoID, japanesemean.FieldName).
+		All(ctx)
+	if err != nil {
+		return ex, err
+	}
+	for _, jm := range means {
+		if ex.means[jm.WordInfoID] == nil {
+			ex.means[jm.WordInfoID] = map[string]struct{}{}
+		}
+		ex.means[jm.WordInfoID][jm.Name] = struct{}{}
+	}
+	return ex, nil
+}
+
+func (d *differ) diffRow(r glossRow, ex existing) {
+	wordID, hasWord := ex.words[r.word]
+	infoID, hasInfo := ex.infos[wordPOS{wordID, r.posID}]
+	hasInfo = hasWord && hasInfo
+	pos := d.posName(r.posID)
+
+	changed := false
+	if !hasWord && d.first("w\x00"+r.word) {
+		d.sum.NewWords++
+		d.printf("+ word %s\n", r.word)
+		changed = true
+	}
+	if !hasInfo && d.first("i\x00"+r.word+"\x00"+pos) {
+		d.sum.NewInfos++
+		d.printf("+ info %s [%s]\n", r.word, pos)
+		changed = true
+	}
+	for _, m := range r.means {
+		if hasInfo {
+			if _, ok := ex.means[infoID][m.name]; ok {
+				continue
+			}
+		}
+		if !d.first("m\x00" + r.word + "\x00" + pos + "\x00" + m.name) {
+			continue
+		}
+		d.sum.NewMeans++
+		changed = true
+		if m.reading != "" {
+			d.printf("+ mean %s [%s] %s (%s)\n", r.word, pos, m.name, m.reading)
+		} else {
+			d.printf("+ mean %s [%s] %s\n", r.word, pos, m.name)
+		}
+	}
+	if !changed {
+		d.sum.Unchanged++
+	}
+}
+
+// printf の書き込みエラーは bufio.Writer に残り、最後の Flush で返る
+func (d *differ) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(d.out, format, args...)
+}
+
+// first は追加を初めて見たときだけ true を返す
+func (d *differ) first(key string) bool {
+	if _, ok := d.seen[key]; ok {
+		return false
+	}
+	d.seen[key] = struct{}{}
+	return true
+}
+
+func (d *differ) posName(id int) string {
+	if name, ok := d.posNames[id]; ok {
+		return name
+	}
+	return "#" + strconv.Itoa(id)
+}
@@ -2,235 +2,251 @@ package dictimport
 
 import (
 	"context"
-	"encoding/json"
+	"hash/fnv"
+	"io"
 	"os"
-	"regexp"
 	"sync"
 
 	"word_app/backend/ent"
-	"word_app/backend/ent/word"
-	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repository/posmapping"
 
-	"log"
-
-	"entgo.io/ent/dialect/sql"
 	"github.com/sirupsen/logrus"
 )
 
-// ImportJMdict は巨大な JMdictJSON を並列インポートするエントリポイント
-func ImportJMdict(ctx context.Context, path string, cli *ent.Client, opt Options) ([]ImportErr, error) {
+// ImportJMdict は JMdict JSON を取り込む（Import の Source を JMdict に固定したもの）
+func ImportJMdict(ctx context.Context, path string, cli *ent.Client, opt Options) (*Result, error) {
+	opt.Source = JMdictSource{}
+	return Import(ctx, path, cli, opt)
+}
+
+// Import は辞書ファイルを opt.Source の形式でストリームとして読みながら並列インポートするエントリポイント。
+// メモリに載るのはデコード中の 1 エントリと、各ワーカーのバッチ（BatchSize 行）だけ。
+// 実行ごとに DictImportRun を残し、チェックポイントと失敗したエントリを記録する。
+func Import(ctx context.Context, path string, cli *ent.Client, opt Options) (*Result, error) {
+	opt = opt.withDefaults()
+	if opt.PosMapping == nil {
+		pm, err := posmapping.Load(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
+		opt.PosMapping = pm
+	}
 	f, err := os.Open(path)
 	if err != nil {
 		return nil, err
 	}
 	defer func() {
 		if err := f.Close(); err != nil {
-			logrus.Fatalf("failed to close ent client: %v", err)
+			logrus.Errorf("failed to close %s: %v", path, err)
 		}
 	}()
 
-	dec := json.NewDecoder(f)
-	var root JMdictJSON
-	if err := dec.Decode(&root); err != nil {
+	hash, err := fileHash(f)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	var size int64
+	if st, err := f.Stat(); err == nil {
+		size = st.Size()
+	}
+
+	run, err := openRun(ctx, cli, path, hash, opt.Source.Format(), opt.Mode)
+	if err != nil {
 		return nil, err
 	}
+	if run.mode == ModeRetryFailed && len(run.targets) == 0 {
+		return run.result(), nil
+	}
 
-	errCh := make(chan ImportErr, 1024)
+	importErr := importStream(ctx, f, size, cli, run, opt)
+	if err := run.close(ctx, importErr); err != nil {
+		logrus.Errorf("dict import: failed to finish run #%d: %v", run.id, err)
+	}
+	return run.result(), importErr
+}
 
-	var (
-		errs   []ImportErr
-		mu     sync.Mutex
-		recvWG sync.WaitGroup
-		wg     sync.WaitGroup
-	)
+func importStream(ctx context.Context, r io.Reader, size int64, cli *ent.Client, run *importRun, opt Options) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	// ---- エラー集約 goroutine ----
-	recvWG.Add(1)
-	go func() {
-		defer recvWG.Done()
-		for ie := range errCh {
-			mu.Lock()
-			errs = append(errs, ie)
-			mu.Unlock()
-		}
-	}()
+	counter := newProgressCounter(size)
+	var wg sync.WaitGroup
 
 	// ---- ワーカープール ----
-	jobs := make(chan JMEntry, opt.Workers*2)
-	for i := 0; i < opt.Workers; i++ {
+	// 同じ単語は常に同じワーカーに渡し、WordInfo の重複作成とロック競合を避ける
+	lanes := make([]chan glossRow, opt.Workers)
+	for i := range lanes {
+		lanes[i] = make(chan glossRow, opt.BatchSize)
 		wg.Add(1)
-		go func() {
+		go func(in <-chan glossRow) {
 			defer wg.Done()
-			for e := range jobs {
-				if err := importOne(ctx, cli, e); err != nil {
-					log.Println("id", e.ID)
-					errCh <- ImportErr{ID: e.ID, Message: err.Error()}
-				}
-			}
-		}()
+			runWorker(ctx, cli, run, opt.BatchSize, in, counter)
+		}(lanes[i])
 	}
 
-	for _, e := range root.Words {
-		jobs <- e
+	// ---- 進捗表示・チェックポイント保存 ----
+	stop := make(chan struct{})
+	progressDone := make(chan struct{})
+	if opt.ProgressInterval > 0 {
+		go counter.report(opt.ProgressInterval, opt.OnProgress, stop, progressDone)
+	} else {
+		close(progressDone)
 	}
-	close(jobs)
+	checkpointDone := make(chan struct{})
+	go run.saveCheckpoint(ctx, opt.CheckpointInterval, stop, checkpointDone)
 
-	wg.Wait()
-	close(errCh)
-	recvWG.Wait()
+	decodeErr := opt.Source.Entries(ctx, countingReader{r: r, n: counter}, func(index int, e JMEntry) error {
+		if !run.wants(index) {
+			run.skip(index)
+			return nil
+		}
+		counter.entries.Add(1)
+		return dispatch(ctx, run, lanes, index, e, opt.PosMapping, counter)
+	})
+	if decodeErr != nil {
+		cancel() // 書き込み中のバッチも打ち切る
+	}
 
-	return errs, nil
+	for _, l := range lanes {
+		close(l)
+	}
+	wg.Wait()
+	close(stop)
+	<-progressDone
+	<-checkpointDone
+	return decodeErr
 }
 
-// ---------------- 内部処理 ----------------
-
-// withTx は Tx を張って関数 fn を実行し、commit / rollback を一元管理する。
-func withTx(ctx context.Context, cli *ent.Client, fn func(*ent.Tx) error) error {
-	tx, err := cli.Tx(ctx)
-	if err != nil {
-		return err
+// dispatch はエントリを行に展開してワーカーに振り分ける。
+// 検証で弾いた値は、エントリを登録する前に失敗として記録する。
+func dispatch(
+	ctx context.Context,
+	run *importRun,
+	lanes []chan glossRow,
+	index int,
+	e JMEntry,
+	pm *posmap.Mapping,
+	counter *progressCounter,
+) error {
+	var (
+		rows []glossRow
+		errs []ImportErr
+	)
+	if e.problem != "" {
+		errs = append(errs, ImportErr{ID: e.ID, Index: index, Message: e.problem})
 	}
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p)
+	for _, row := range entryRows(index, e, pm) {
+		row, rowErrs := validateRow(row)
+		errs = append(errs, rowErrs...)
+		if row.word != "" {
+			rows = append(rows, row)
 		}
-	}()
+	}
+	if len(errs) > 0 {
+		counter.failures.Add(int64(len(errs)))
+		if err := run.fail(ctx, errs); err != nil {
+			return err
+		}
+	}
 
-	if err := fn(tx); err != nil {
-		_ = tx.Rollback()
-		return err
+	run.begin(ctx, index, len(rows))
+	for _, row := range rows {
+		select {
+		case lanes[laneOf(row.word, len(lanes))] <- row:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
-	return tx.Commit()
+	return nil
 }
 
-func importOne(ctx context.Context, cli *ent.Client, e JMEntry) error {
-	return withTx(ctx, cli, func(tx *ent.Tx) error {
-		for _, s := range e.Sense {
-			if len(s.PartOfSpeech) == 0 {
-				continue
-			}
-			posID := pickPosID(s.PartOfSpeech)
-
-			for _, g := range s.Gloss {
-				if g.Lang != "eng" {
-					continue
-				}
-				if err := processGloss(ctx, tx, g.Text, posID, e); err != nil {
-					return err
-				}
+// runWorker は行を BatchSize ずつまとめて書き込む。
+// 失敗したバッチは含まれるエントリをすべて失敗として記録し、次のバッチに進む。
+// 失敗を記録できなかった行は完了にしないため、チェックポイントはその手前で止まる。
+func runWorker(
+	ctx context.Context,
+	cli *ent.Client,
+	run *importRun,
+	batchSize int,
+	in <-chan glossRow,
+	counter *progressCounter,
+) {
+	batch := make([]glossRow, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := writeBatch(ctx, cli, batch); err != nil {
+			if !recordBatchFailure(ctx, run, batch, err, counter) {
+				batch = batch[:0]
+				return
 			}
+		} else {
+			counter.rows.Add(int64(len(batch)))
 		}
-		return nil
-	})
-}
+		for _, r := range batch {
+			run.finish(ctx, r.entryIndex)
+		}
+		batch = batch[:0]
+	}
 
-// pickPosID は Priority 付き part‑of‑speech スライスから最適な posID を決定する
-func pickPosID(parts []string) int {
-	id := mappedPosID(parts[0])
-	if id == 11 && len(parts) > 1 {
-		for _, c := range parts[1:] {
-			if m := mappedPosID(c); m != 11 {
-				return m
-			}
+	for r := range in {
+		batch = append(batch, r)
+		if len(batch) >= batchSize {
+			flush()
 		}
 	}
-	return id
+	flush()
 }
 
-// processGloss は 1 つの英語 Gloss に対して Word / WordInfo / JapaneseMean を確立する
-func processGloss(ctx context.Context, tx *ent.Tx, text string, posID int, entry JMEntry) error {
-	w, err := ensureWord(ctx, tx, text)
-	if err != nil {
-		return err
+// recordBatchFailure はバッチに含まれるエントリを 1 件ずつ失敗として記録する
+func recordBatchFailure(ctx context.Context, run *importRun, batch []glossRow, cause error, counter *progressCounter) bool {
+	reported := map[int]struct{}{}
+	var errs []ImportErr
+	for _, r := range batch {
+		if _, ok := reported[r.entryIndex]; ok {
+			continue
+		}
+		reported[r.entryIndex] = struct{}{}
+		errs = append(errs, ImportErr{ID: r.entryID, Index: r.entryIndex, Message: cause.Error()})
 	}
-
-	wi, err := ensureWordInfo(ctx, tx, w.ID, posID)
-	if err != nil {
-		return err
+	counter.failures.Add(int64(len(errs)))
+	if err := run.fail(ctx, errs); err != nil {
+		logrus.Errorf("dict import: failed to record %d failures: %v", len(errs), err)
+		return false
 	}
-
-	return ensureJapaneseMeans(ctx, tx, wi.ID, entry)
+	return true
 }
 
-// ensureWord は text に対応する ent.Word を取得 / 作成する
-func ensureWord(ctx context.Context, tx *ent.Tx, text string) (*ent.Word, error) {
-	w, err := tx.Word.Query().Where(word.NameEQ(text)).Only(ctx)
-	if ent.IsNotFound(err) {
-		reSpace := regexp.MustCompile(`\s`)
-		reSymbol := regexp.MustCompile(`[!?\(\)0-9]`)
-		return tx.Word.Create().
-			SetName(text).
-			SetIsIdioms(reSpace.MatchString(text)).
-			SetIsSpecialCharacters(reSymbol.MatchString(text)).
-			SetRegistrationCount(0).
-			Save(ctx)
-	}
-	return w, err
+// laneOf は単語名からワーカーを決める
+func laneOf(name string, n int) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return int(h.Sum32() % uint32(n))
 }
 
-// ensureWordInfo は (wordID,posID) に対応する WordInfo を取得 / 作成する
-func ensureWordInfo(ctx context.Context, tx *ent.Tx, wordID, posID int) (*ent.WordInfo, error) {
-	wi, err := tx.WordInfo.Query().
-		Where(wordinfo.WordID(wordID), wordinfo.PartOfSpeechIDEQ(posID)).
-		Only(ctx)
-	if ent.IsNotFound(err) {
-		return tx.WordInfo.Create().
-			SetWordID(wordID).
-			SetPartOfSpeechID(posID).
-			Save(ctx)
-	}
-	return wi, err
-}
+// ---------------- 内部処理 ----------------
 
-// ensureJapaneseMeans は Entry 情報から日本語訳を upsert する
-func ensureJapaneseMeans(ctx context.Context, tx *ent.Tx, wiID int, entry JMEntry) error {
-	// kanji.text 優先、無い場合 kana[0]
-	if len(entry.Kanji) > 0 {
-		for _, kj := range entry.Kanji {
-			if kj.Text == "" {
-				continue
-			}
-			if err := upsertJapaneseMean(ctx, tx, wiID, kj.Text); err != nil {
-				return err
-			}
-		}
-	} else if len(entry.Kana) > 0 && entry.Kana[0].Text != "" {
-		if err := upsertJapaneseMean(ctx, tx, wiID, entry.Kana[0].Text); err != nil {
-			return err
-		}
+// withTx は Tx を張って関数 fn を実行し、commit / rollback を一元管理する。
+func withTx(ctx context.Context, cli *ent.Client, fn func(*ent.Tx) error) error {
+	tx, err := cli.Tx(ctx)
+	if err != nil {
+		return err
 	}
-	return nil
-}
-
-func upsertJapaneseMean(ctx context.Context, tx *ent.Tx, wiID int, name string) error {
-	return tx.JapaneseMean.Create().
-		SetWordInfoID(wiID).
-		SetName(name).
-		OnConflict(sql.ConflictColumns("word_info_id", "name")).
-		DoNothing().
-		Exec(ctx)
-}
-
-var posCode2ID = map[string]int{
-	"n":  1,
-	"pn": 2,
-	"vs": 3, "v5r": 3, "v1": 3, "vi": 3, "vt": 3,
-	"adj-i": 4, "adj-na": 4, "adj-f": 4,
-	"adv": 5, "adv-to": 5,
-	"aux-v": 6,
-	"prep":  7,
-	"art":   8,
-	"int":   9,
-	"conj":  10,
-	"exp":   11,
-	"unc":   12,
-}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
 
-// mappedPosID は 品詞コード → 内部 ID 変換（未知コードは 11）
-func mappedPosID(code string) int {
-	if id, ok := posCode2ID[code]; ok {
-		return id
+	if err := fn(tx); err != nil {
+		_ = tx.Rollback()
+		return err
 	}
-	return 11
+	return tx.Commit()
 }
@@ -1,40 +1,118 @@
 package dictimport
 
-type JMdictJSON struct {
-	Version string    `json:"version"`
-	Words   []JMEntry `json:"words"`
-}
+import (
+	"time"
+
+	"word_app/backend/src/domain/posmap"
+
+	"github.com/sirupsen/logrus"
+)
 
+// JMdict JSON (jmdict-simplified) の words の 1 要素。
+// ファイル全体は decodeEntries でストリームとして読む。
 type JMEntry struct {
 	ID    string  `json:"id"`
 	Kana  []Kana  `json:"kana"`
 	Kanji []Kanji `json:"kanji"`
 	Sense []Sense `json:"sense"`
+
+	problem string // 読み込み時に見つかった不備。空でなければエントリ全体を失敗として記録する
 }
 
 type Kana struct {
-	Text string `json:"text"`
+	Common         bool     `json:"common"`
+	Text           string   `json:"text"`
+	Tags           []string `json:"tags"`
+	AppliesToKanji []string `json:"appliesToKanji"` // "*" はすべての漢字表記
 }
 
 type Kanji struct {
-	Text string `json:"text"`
+	Common bool     `json:"common"`
+	Text   string   `json:"text"`
+	Tags   []string `json:"tags"`
 }
 
 type Sense struct {
-	PartOfSpeech []string `json:"partOfSpeech"`
-	Gloss        []Gloss  `json:"gloss"`
+	PartOfSpeech []string  `json:"partOfSpeech"`
+	Field        []string  `json:"field"`
+	Misc         []string  `json:"misc"`
+	Dialect      []string  `json:"dialect"`
+	Gloss        []Gloss   `json:"gloss"`
+	Examples     []Example `json:"examples"` // jmdict-examples 版のみ
+
+	posID int // 品詞コードを持たない形式（品詞名で指定した CSV など）で品詞 ID を直接指定する
 }
 
 type Gloss struct {
 	Lang string `json:"lang"`
 	Text string `json:"text"`
 }
+
+type Example struct {
+	Text      string            `json:"text"`
+	Sentences []ExampleSentence `json:"sentences"`
+}
+
+type ExampleSentence struct {
+	Lang string `json:"land"` // jmdict-simplified のキー名は "land"
+	Text string `json:"text"`
+}
+
 type Options struct {
-	Workers   int
-	BatchSize int
+	Workers            int
+	BatchSize          int            // 1 トランザクションで書き込む Gloss 数
+	ProgressInterval   time.Duration  // 0 なら進捗を出さない
+	OnProgress         func(Progress) // 未指定ならログに出す
+	Source             Source         // 未指定なら JMdict JSON
+	Mode               Mode
+	CheckpointInterval time.Duration   // チェックポイントを保存する間隔
+	PosMapping         *posmap.Mapping // 品詞コードの対応表。未指定なら DB の最新版
+}
+
+// Mode はインポートの実行方法
+type Mode int
+
+const (
+	ModeFresh       Mode = iota // 新しい実行として最初から取り込む
+	ModeResume                  // 同じファイルの未完了の実行をチェックポイントの次から再開する
+	ModeRetryFailed             // 同じファイルの直近の実行で失敗したエントリだけを取り込み直す
+)
+
+const (
+	defaultWorkers            = 4
+	defaultBatchSize          = 500
+	defaultCheckpointInterval = 5 * time.Second
+)
+
+func (o Options) withDefaults() Options {
+	if o.Workers <= 0 {
+		o.Workers = defaultWorkers
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = defaultBatchSize
+	}
+	if o.Source == nil {
+		o.Source = JMdictSource{}
+	}
+	if o.CheckpointInterval <= 0 {
+		o.CheckpointInterval = defaultCheckpointInterval
+	}
+	if o.OnProgress == nil {
+		o.OnProgress = func(p Progress) { logrus.Infof("dict import: %s", p) }
+	}
+	return o
 }
 
 type ImportErr struct {
 	ID      string `json:"id"`
+	Index   int    `json:"index"` // ファイル内での 0 始まりの位置
 	Message string `json:"error_message"`
 }
+
+// Result はインポート 1 回分の結果
+type Result struct {
+	RunID    int         // DictImportRun の ID
+	Skipped  int         // 再開時にチェックポイントまで読み飛ばしたエントリ数
+	Retried  int         // 再試行の対象にしたエントリ数
+	Failures []ImportErr // 今回失敗したエントリ
+}
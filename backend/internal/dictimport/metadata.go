@@ -0,0 +1,115 @@
+package dictimport
+
+import (
+	"slices"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/models"
+)
+
+// Word.frequency_rank の値
+const (
+	rankCommon   = 1 // JMdict で common の表記を持つ
+	rankUncommon = 2
+)
+
+// 1 つの WordInfo に保存する例文の上限
+const maxExamplesPerInfo = 3
+
+// jpMean は日本語訳と、漢字表記のときの読み
+type jpMean struct {
+	name    string
+	reading string
+}
+
+// usageTags は Sense の品詞コードと分野・用法・方言タグ。WordInfo 単位でまとめる。
+// 品詞コードは対応表が変わったときの再分類（cmd/reclassify_pos）に使う。
+type usageTags struct {
+	pos      []string
+	field    []string
+	misc     []string
+	dialect  []string
+	examples []models.WordExample
+}
+
+// frequencyRank は表記のどれかが common ならそのエントリを頻出とみなす
+func frequencyRank(e JMEntry) int {
+	for _, k := range e.Kanji {
+		if k.Common {
+			return rankCommon
+		}
+	}
+	for _, k := range e.Kana {
+		if k.Common {
+			return rankCommon
+		}
+	}
+	return rankUncommon
+}
+
+// readingOf は漢字表記に当てはまる最初のかな表記を読みとして返す
+func readingOf(e JMEntry, kanji string) string {
+	for _, k := range e.Kana {
+		if k.Text == "" {
+			continue
+		}
+		if len(k.AppliesToKanji) == 0 || slices.Contains(k.AppliesToKanji, "*") || slices.Contains(k.AppliesToKanji, kanji) {
+			return k.Text
+		}
+	}
+	return ""
+}
+
+func senseTags(s Sense) usageTags {
+	t := usageTags{pos: s.PartOfSpeech, field: s.Field, misc: s.Misc, dialect: s.Dialect}
+	for _, ex := range s.Examples {
+		var we models.WordExample
+		for _, st := range ex.Sentences {
+			switch st.Lang {
+			case "jpn":
+				we.Japanese = st.Text
+			case "eng":
+				we.English = st.Text
+			}
+		}
+		if we.Japanese != "" {
+			t.examples = append(t.examples, we)
+		}
+	}
+	return t
+}
+
+// tagsOf は保存済みの WordInfo のタグを取り出す
+func tagsOf(wi *ent.WordInfo) usageTags {
+	return usageTags{pos: wi.PosCodes, field: wi.FieldTags, misc: wi.MiscTags, dialect: wi.DialectTags, examples: wi.Examples}
+}
+
+// merge は o のタグと例文を重複なく足し、増えたかどうかを返す
+func (t *usageTags) merge(o usageTags) bool {
+	changed := false
+	for _, pair := range []struct {
+		dst *[]string
+		src []string
+	}{{&t.pos, o.pos}, {&t.field, o.field}, {&t.misc, o.misc}, {&t.dialect, o.dialect}} {
+		for _, tag := range pair.src {
+			if !slices.Contains(*pair.dst, tag) {
+				*pair.dst = append(*pair.dst, tag)
+				changed = true
+			}
+		}
+	}
+	for _, ex := range o.examples {
+		if len(t.examples) >= maxExamplesPerInfo {
+			break
+		}
+		if !slices.ContainsFunc(t.examples, func(e models.WordExample) bool { return e.Japanese == ex.Japanese }) {
+			t.examples = append(t.examples, ex)
+			changed = true
+		}
+	}
+	return changed
+}
+
+func (t usageTags) empty() bool {
+	return len(t.pos) == 0 && len(t.field) == 0 && len(t.misc) == 0 && len(t.dialect) == 0 && len(t.examples) == 0
+}
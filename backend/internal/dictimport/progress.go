@@ -0,0 +1,81 @@
+package dictimport
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Progress はインポートの途中経過
+type Progress struct {
+	Entries    int64         // 読み込んだエントリ数
+	Rows       int64         // 書き込んだ Gloss 数
+	Failures   int64         // 失敗したエントリ・行の数
+	BytesRead  int64         // 読み込んだバイト数
+	TotalBytes int64         // ファイルサイズ（不明なら 0）
+	Elapsed    time.Duration //
+	Rate       float64       // entries/sec
+	ETA        time.Duration // 残り時間の見積もり（不明なら 0）
+}
+
+func (p Progress) String() string {
+	pct := 0.0
+	if p.TotalBytes > 0 {
+		pct = float64(p.BytesRead) * 100 / float64(p.TotalBytes)
+	}
+	return fmt.Sprintf("entries=%d rows=%d failures=%d (%.1f%%) %.0f entries/sec elapsed=%s eta=%s",
+		p.Entries, p.Rows, p.Failures, pct, p.Rate,
+		p.Elapsed.Truncate(time.Second), p.ETA.Truncate(time.Second))
+}
+
+// progressCounter はワーカーから並行に更新されるカウンタ
+type progressCounter struct {
+	start    time.Time
+	total    int64
+	entries  atomic.Int64
+	rows     atomic.Int64
+	failures atomic.Int64
+	bytes    atomic.Int64
+}
+
+func newProgressCounter(total int64) *progressCounter {
+	return &progressCounter{start: time.Now(), total: total}
+}
+
+func (c *progressCounter) addBytes(n int) { c.bytes.Add(int64(n)) }
+
+func (c *progressCounter) snapshot() Progress {
+	p := Progress{
+		Entries:    c.entries.Load(),
+		Rows:       c.rows.Load(),
+		Failures:   c.failures.Load(),
+		BytesRead:  c.bytes.Load(),
+		TotalBytes: c.total,
+		Elapsed:    time.Since(c.start),
+	}
+	if secs := p.Elapsed.Seconds(); secs > 0 {
+		p.Rate = float64(p.Entries) / secs
+	}
+	// 読み込み済みバイト数の割合から残り時間を見積もる
+	if p.TotalBytes > 0 && p.BytesRead > 0 && p.BytesRead < p.TotalBytes {
+		remaining := float64(p.TotalBytes-p.BytesRead) / float64(p.BytesRead)
+		p.ETA = time.Duration(float64(p.Elapsed) * remaining)
+	}
+	return p
+}
+
+// report は interval ごとに fn を呼ぶ。stop が閉じられたら最後に 1 回呼んで終わる。
+func (c *progressCounter) report(interval time.Duration, fn func(Progress), stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			fn(c.snapshot())
+		case <-stop:
+			fn(c.snapshot())
+			return
+		}
+	}
+}
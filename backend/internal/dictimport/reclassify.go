@@ -0,0 +1,326 @@
+package dictimport
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repository/posmapping"
+	"word_app/backend/src/models"
+)
+
+// 再分類で 1 回に読む WordInfo の件数
+const defaultReclassifyBatch = 500
+
+// ReclassifyOptions は Reclassify の設定
+type ReclassifyOptions struct {
+	BatchSize  int             // 1 トランザクションで見る WordInfo の件数
+	DryRun     bool            // true なら書き込まずに件数だけ数える
+	PosMapping *posmap.Mapping // 未指定なら DB の最新版
+	// Backfill があれば、品詞コードを持たない WordInfo（品詞コードを保存する前に取り込んだもの）に
+	// 辞書ファイルから品詞コードを補ってから判定する（LegacyPosCodes で作る）
+	Backfill *PosCodeIndex
+}
+
+// PosChange は品詞の付け替え（PartOfSpeech ID）
+type PosChange struct {
+	From int
+	To   int
+}
+
+// ReclassifyResult は再分類の集計
+type ReclassifyResult struct {
+	Version    int               // 使った対応表の版（0 は既定）
+	Scanned    int               // 読んだ WordInfo
+	Backfilled int               // 辞書ファイルから品詞コードを補った WordInfo
+	Skipped    int               // 品詞コードを持たず補えもしない（品詞名で取り込んだ・手入力など）WordInfo
+	Moved      int               // 品詞だけを付け替えた WordInfo
+	Merged     int               // 同じ単語・品詞の WordInfo に統合して削除した WordInfo
+	Changes    map[PosChange]int // 付け替えの内訳
+}
+
+func (r ReclassifyResult) String() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "version=%d scanned=%d backfilled=%d skipped=%d moved=%d merged=%d",
+		r.Version, r.Scanned, r.Backfilled, r.Skipped, r.Moved, r.Merged)
+	keys := slices.SortedFunc(maps.Keys(r.Changes), func(a, b PosChange) int {
+		if a.From != b.From {
+			return a.From - b.From
+		}
+		return a.To - b.To
+	})
+	for _, k := range keys {
+		_, _ = fmt.Fprintf(&b, " %d->%d:%d", k.From, k.To, r.Changes[k])
+	}
+	return b.String()
+}
+
+// Reclassify は保存済みの品詞コード（WordInfo.pos_codes）を対応表で引き直し、品詞が変わる WordInfo を付け替える。
+// 品詞コードを持たない WordInfo は opt.Backfill で補えたものだけを対象にする。
+// 付け替え先の (単語, 品詞) の WordInfo がすでにあれば、日本語訳・タグをそちらに移して元の行を削除する。
+// WordInfo は品詞コードの和集合で判定するため、1 つの WordInfo がまとめて移る（Sense ごとには分けない）。
+func Reclassify(ctx context.Context, cli *ent.Client, opt ReclassifyOptions) (*ReclassifyResult, error) {
+	if opt.BatchSize <= 0 {
+		opt.BatchSize = defaultReclassifyBatch
+	}
+	pm := opt.PosMapping
+	if pm == nil {
+		var err error
+		if pm, err = posmapping.Load(ctx, cli); err != nil {
+			return nil, err
+		}
+	}
+	res := &ReclassifyResult{Version: pm.Version, Changes: map[PosChange]int{}}
+
+	for last := 0; ; {
+		q := cli.WordInfo.Query().
+			Where(wordinfo.IDGT(last)).
+			Order(ent.Asc(wordinfo.FieldID)).
+			Limit(opt.BatchSize)
+		if opt.Backfill != nil {
+			q = q.WithWord()
+		}
+		page, err := q.All(ctx)
+		if err != nil {
+			return res, err
+		}
+		if len(page) == 0 {
+			return res, nil
+		}
+		last = page[len(page)-1].ID
+
+		var candidates, filled []*ent.WordInfo
+		for _, wi := range page {
+			res.Scanned++
+			if len(wi.PosCodes) == 0 && wi.Edges.Word != nil {
+				if codes := opt.Backfill.lookup(wi.Edges.Word.Name, wi.PartOfSpeechID); len(codes) > 0 {
+					wi.PosCodes = codes
+					filled = append(filled, wi)
+					res.Backfilled++
+				}
+			}
+			switch {
+			case len(wi.PosCodes) == 0:
+				res.Skipped++
+			case pm.Resolve(wi.PosCodes) != wi.PartOfSpeechID:
+				candidates = append(candidates, wi)
+			}
+		}
+		if len(candidates) == 0 && len(filled) == 0 {
+			continue
+		}
+		if opt.DryRun {
+			err = reclassifyPage(ctx, cli, pm, candidates, res, false)
+		} else {
+			err = withTx(ctx, cli, func(tx *ent.Tx) error {
+				for _, wi := range filled {
+					if err := tx.WordInfo.UpdateOneID(wi.ID).SetPosCodes(wi.PosCodes).Exec(ctx); err != nil {
+						return err
+					}
+				}
+				return reclassifyPage(ctx, tx.Client(), pm, candidates, res, true)
+			})
+		}
+		if err != nil {
+			return res, err
+		}
+	}
+}
+
+// reclassifyPage は付け替え候補を順に処理する。
+// 同じページ内で統合・付け替えが続いても判定がずれないよう、WordInfo の状態をメモリ上でも更新する。
+func reclassifyPage(
+	ctx context.Context,
+	cli *ent.Client,
+	pm *posmap.Mapping,
+	candidates []*ent.WordInfo,
+	res *ReclassifyResult,
+	write bool,
+) error {
+	if len(candidates) == 0 {
+		return nil
+	}
+	wordIDs := make([]int, 0, len(candidates))
+	for _, wi := range candidates {
+		wordIDs = append(wordIDs, wi.WordID)
+	}
+	existing, err := cli.WordInfo.Query().
+		Where(wordinfo.WordIDIn(wordIDs...)).
+		Order(ent.Asc(wordinfo.FieldID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	infos := make(map[int]*ent.WordInfo, len(existing))
+	byKey := map[wordPOS]int{}
+	for _, wi := range existing {
+		infos[wi.ID] = wi
+		key := wordPOS{wi.WordID, wi.PartOfSpeechID}
+		if _, ok := byKey[key]; !ok {
+			byKey[key] = wi.ID
+		}
+	}
+
+	// ドライランでは補った品詞コードが DB に無いので、候補の値で上書きしておく
+	for _, c := range candidates {
+		if wi, ok := infos[c.ID]; ok && len(wi.PosCodes) == 0 {
+			wi.PosCodes = c.PosCodes
+		}
+	}
+
+	for _, c := range candidates {
+		src, ok := infos[c.ID]
+		if !ok {
+			continue // 先に統合されて消えた
+		}
+		to := pm.Resolve(src.PosCodes)
+		from := src.PartOfSpeechID
+		if to == from {
+			continue
+		}
+		res.Changes[PosChange{from, to}]++
+		if byKey[wordPOS{src.WordID, from}] == src.ID {
+			delete(byKey, wordPOS{src.WordID, from})
+		}
+
+		dstID, ok := byKey[wordPOS{src.WordID, to}]
+		if !ok {
+			res.Moved++
+			if write {
+				if err := cli.WordInfo.UpdateOneID(src.ID).SetPartOfSpeechID(to).Exec(ctx); err != nil {
+					return err
+				}
+			}
+			src.PartOfSpeechID = to
+			byKey[wordPOS{src.WordID, to}] = src.ID
+			continue
+		}
+
+		res.Merged++
+		dst := infos[dstID]
+		merged := tagsOf(dst)
+		changed := merged.merge(tagsOf(src))
+		if write {
+			if err := mergeWordInfo(ctx, cli, src, dst, merged, changed); err != nil {
+				return err
+			}
+		}
+		dst.PosCodes = merged.pos
+		delete(infos, src.ID)
+	}
+	return nil
+}
+
+// mergeWordInfo は src の日本語訳を dst に移し、src を削除する。
+// dst に同じ訳があれば src 側の訳を削除し、それを正解・回答にしているクイズの設問を dst 側の訳に付け替える。
+func mergeWordInfo(ctx context.Context, cli *ent.Client, src, dst *ent.WordInfo, tags usageTags, tagsChanged bool) error {
+	if tagsChanged {
+		if err := cli.WordInfo.UpdateOneID(dst.ID).
+			SetPosCodes(tags.pos).
+			SetFieldTags(tags.field).
+			SetMiscTags(tags.misc).
+			SetDialectTags(tags.dialect).
+			SetExamples(tags.examples).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+
+	means, err := cli.JapaneseMean.Query().
+		Where(japanesemean.WordInfoIDIn(src.ID, dst.ID)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	kept := map[string]*ent.JapaneseMean{}
+	for _, jm := range means {
+		if jm.WordInfoID == dst.ID {
+			kept[jm.Name] = jm
+		}
+	}
+	remap := map[int]int{} // 削除する訳 → 残す訳
+	var move []int
+	for _, jm := range means {
+		if jm.WordInfoID != src.ID {
+			continue
+		}
+		k, dup := kept[jm.Name]
+		if !dup {
+			move = append(move, jm.ID)
+			continue
+		}
+		remap[jm.ID] = k.ID
+		if k.Reading == nil && jm.Reading != nil {
+			if err := cli.JapaneseMean.UpdateOneID(k.ID).SetReading(*jm.Reading).Exec(ctx); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(move) > 0 {
+		if err := cli.JapaneseMean.Update().
+			Where(japanesemean.IDIn(move...)).
+			SetWordInfoID(dst.ID).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	if err := repointQuestions(ctx, cli, remap); err != nil {
+		return err
+	}
+	if len(remap) > 0 {
+		if _, err := cli.JapaneseMean.Delete().
+			Where(japanesemean.IDIn(slices.Collect(maps.Keys(remap))...)).
+			Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return cli.WordInfo.DeleteOneID(src.ID).Exec(ctx)
+}
+
+// repointQuestions は削除する訳を参照している設問を残す訳に付け替える。
+// 正解の訳は選択肢にも含まれているので、未回答の設問が正しく採点されるよう選択肢の ID も書き換える。
+func repointQuestions(ctx context.Context, cli *ent.Client, remap map[int]int) error {
+	if len(remap) == 0 {
+		return nil
+	}
+	old := slices.Collect(maps.Keys(remap))
+	qs, err := cli.QuizQuestion.Query().
+		Where(quizquestion.Or(
+			quizquestion.CorrectJpmIDIn(old...),
+			quizquestion.AnswerJpmIDIn(old...),
+		)).
+		All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, q := range qs {
+		upd := cli.QuizQuestion.UpdateOneID(q.ID)
+		if to, ok := remap[q.CorrectJpmID]; ok {
+			upd.SetCorrectJpmID(to)
+		}
+		if q.AnswerJpmID != nil {
+			if to, ok := remap[*q.AnswerJpmID]; ok {
+				upd.SetAnswerJpmID(to)
+			}
+		}
+		choices := make([]models.ChoiceJpm, len(q.ChoicesJpms))
+		for i, ch := range q.ChoicesJpms {
+			if to, ok := remap[ch.JapaneseMeanID]; ok {
+				ch.JapaneseMeanID = to
+			}
+			choices[i] = ch
+		}
+		if err := upd.SetChoicesJpms(choices).Exec(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
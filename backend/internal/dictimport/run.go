@@ -0,0 +1,321 @@
+package dictimport
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/dictimportrun"
+
+	"github.com/sirupsen/logrus"
+)
+
+// ErrNoRunToRetry は --retry-failed の対象になる過去の実行が無い
+var ErrNoRunToRetry = errors.New("dictimport: no previous import run for this file")
+
+const (
+	runRunning   = "running"
+	runCompleted = "completed"
+	runFailed    = "failed"
+)
+
+// importRun は 1 回のインポートの実行記録（DictImportRun）への書き込みをまとめる
+type importRun struct {
+	cli     *ent.Client
+	id      int
+	mode    Mode
+	start   int           // この index より前のエントリは読み飛ばす（再開時）
+	targets map[int][]int // 再試行時: 対象エントリの index → 解消する失敗行の ID
+	cp      *checkpoint
+
+	mu   sync.Mutex
+	errs []ImportErr
+}
+
+// fileHash はファイル内容の SHA-256 を hex で返す
+func fileHash(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// openRun はモードに応じて実行記録を作る、または過去の実行を引き継ぐ
+func openRun(ctx context.Context, cli *ent.Client, path, hash, format string, mode Mode) (*importRun, error) {
+	switch mode {
+	case ModeResume:
+		prev, err := cli.DictImportRun.Query().
+			Where(
+				dictimportrun.SourceHash(hash),
+				dictimportrun.Format(format),
+				dictimportrun.StatusNEQ(runCompleted),
+			).
+			Order(ent.Desc(dictimportrun.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			logrus.Infof("dict import: no unfinished run for %s, starting a new run", path)
+			return newRun(ctx, cli, path, hash, format)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return resumeRun(ctx, cli, prev)
+	case ModeRetryFailed:
+		prev, err := cli.DictImportRun.Query().
+			Where(dictimportrun.SourceHash(hash), dictimportrun.Format(format)).
+			Order(ent.Desc(dictimportrun.FieldID)).
+			First(ctx)
+		if ent.IsNotFound(err) {
+			return nil, ErrNoRunToRetry
+		}
+		if err != nil {
+			return nil, err
+		}
+		return retryRun(ctx, cli, prev)
+	default:
+		return newRun(ctx, cli, path, hash, format)
+	}
+}
+
+func newRun(ctx context.Context, cli *ent.Client, path, hash, format string) (*importRun, error) {
+	r, err := cli.DictImportRun.Create().
+		SetSourcePath(path).
+		SetFormat(format).
+		SetSourceHash(hash).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &importRun{cli: cli, id: r.ID, mode: ModeFresh, cp: newCheckpoint(0)}, nil
+}
+
+// resumeRun はチェックポイントの次のエントリから再開する。
+// チェックポイントより後ろの失敗は取り込み直すので、記録を消しておく。
+func resumeRun(ctx context.Context, cli *ent.Client, prev *ent.DictImportRun) (*importRun, error) {
+	err := withTx(ctx, cli, func(tx *ent.Tx) error {
+		if _, err := tx.DictImportFailure.Delete().
+			Where(
+				dictimportfailure.RunID(prev.ID),
+				dictimportfailure.EntryIndexGT(prev.LastCommittedIndex),
+			).
+			Exec(ctx); err != nil {
+			return err
+		}
+		return tx.DictImportRun.UpdateOneID(prev.ID).
+			SetStatus(runRunning).
+			ClearFinishedAt().
+			ClearErrorMessage().
+			Exec(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	start := prev.LastCommittedIndex + 1
+	return &importRun{cli: cli, id: prev.ID, mode: ModeResume, start: start, cp: newCheckpoint(start)}, nil
+}
+
+// retryRun は未解消の失敗エントリだけを対象にする
+func retryRun(ctx context.Context, cli *ent.Client, prev *ent.DictImportRun) (*importRun, error) {
+	failures, err := cli.DictImportFailure.Query().
+		Where(
+			dictimportfailure.RunID(prev.ID),
+			dictimportfailure.ResolvedAtIsNil(),
+		).
+		Select(dictimportfailure.FieldID, dictimportfailure.FieldEntryIndex).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[int][]int, len(failures))
+	for _, f := range failures {
+		targets[f.EntryIndex] = append(targets[f.EntryIndex], f.ID)
+	}
+	return &importRun{cli: cli, id: prev.ID, mode: ModeRetryFailed, targets: targets, cp: newCheckpoint(0)}, nil
+}
+
+// wants はエントリを取り込むかどうか
+func (r *importRun) wants(index int) bool {
+	if r.mode == ModeRetryFailed {
+		_, ok := r.targets[index]
+		return ok
+	}
+	return index >= r.start
+}
+
+// begin はエントリの行数を登録する。行が無ければその場で完了になる
+func (r *importRun) begin(ctx context.Context, index, rows int) {
+	r.cp.begin(index, rows)
+	if rows == 0 {
+		r.resolve(ctx, index)
+	}
+}
+
+// finish は行の書き込み（または失敗の記録）が終わったことを知らせる
+func (r *importRun) finish(ctx context.Context, index int) {
+	if r.cp.finish(index) {
+		r.resolve(ctx, index)
+	}
+}
+
+// skip は取り込まないエントリをチェックポイント上は完了として扱う
+func (r *importRun) skip(index int) {
+	r.cp.begin(index, 0)
+}
+
+// fail は失敗したエントリを記録する。
+// チェックポイントを進める前に呼び、異常終了しても失敗が失われないようにする。
+func (r *importRun) fail(ctx context.Context, errs []ImportErr) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	r.mu.Lock()
+	r.errs = append(r.errs, errs...)
+	r.mu.Unlock()
+
+	builders := make([]*ent.DictImportFailureCreate, 0, len(errs))
+	for _, ie := range errs {
+		builders = append(builders, r.cli.DictImportFailure.Create().
+			SetRunID(r.id).
+			SetEntryID(ie.ID).
+			SetEntryIndex(ie.Index).
+			SetMessage(ie.Message))
+	}
+	return r.cli.DictImportFailure.CreateBulk(builders...).Exec(ctx)
+}
+
+// resolve は再試行を終えたエントリの以前の失敗を解消済みにする。
+// 再び失敗した場合は fail で新しい行が作られている。
+func (r *importRun) resolve(ctx context.Context, index int) {
+	if r.mode != ModeRetryFailed {
+		return
+	}
+	ids := r.targets[index]
+	if len(ids) == 0 {
+		return
+	}
+	if err := r.cli.DictImportFailure.Update().
+		Where(dictimportfailure.IDIn(ids...)).
+		SetResolvedAt(time.Now()).
+		Exec(ctx); err != nil {
+		logrus.Warnf("dict import: failed to resolve failures of entry #%d: %v", index, err)
+	}
+}
+
+// saveCheckpoint は interval ごとにチェックポイントを保存する。stop が閉じられたら終わる。
+// 再試行ではチェックポイントを動かさない。
+func (r *importRun) saveCheckpoint(ctx context.Context, interval time.Duration, stop <-chan struct{}, done chan<- struct{}) {
+	defer close(done)
+	if r.mode == ModeRetryFailed {
+		return
+	}
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	saved := r.cp.last()
+	for {
+		select {
+		case <-t.C:
+			last := r.cp.last()
+			if last == saved {
+				continue
+			}
+			if err := r.cli.DictImportRun.UpdateOneID(r.id).
+				SetLastCommittedIndex(last).
+				Exec(ctx); err != nil {
+				logrus.Warnf("dict import: failed to save checkpoint: %v", err)
+				continue
+			}
+			saved = last
+		case <-stop:
+			return
+		}
+	}
+}
+
+// close は実行記録を最終状態にする。中断されても記録できるよう ctx のキャンセルは引き継がない。
+func (r *importRun) close(ctx context.Context, importErr error) error {
+	ctx = context.WithoutCancel(ctx)
+	if r.mode == ModeRetryFailed {
+		return r.cli.DictImportRun.UpdateOneID(r.id).Exec(ctx) // updated_at だけ更新
+	}
+	upd := r.cli.DictImportRun.UpdateOneID(r.id).
+		SetLastCommittedIndex(r.cp.last()).
+		SetFinishedAt(time.Now())
+	if importErr != nil {
+		upd.SetStatus(runFailed).SetErrorMessage(importErr.Error())
+	} else {
+		upd.SetStatus(runCompleted)
+	}
+	return upd.Exec(ctx)
+}
+
+func (r *importRun) result() *Result {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	res := &Result{RunID: r.id, Failures: r.errs}
+	switch r.mode {
+	case ModeResume:
+		res.Skipped = r.start
+	case ModeRetryFailed:
+		res.Retried = len(r.targets)
+	}
+	return res
+}
+
+// checkpoint はエントリごとの未完了の行数を数え、先頭から途切れずに完了した位置を求める。
+// ワーカーはバッチ単位で並行に書き込むため、完了順は index 順にならない。
+type checkpoint struct {
+	mu      sync.Mutex
+	pending map[int]int // index → 未完了の行数
+	next    int         // 次に登録される index
+	done    int         // これより前の index はすべて完了
+}
+
+func newCheckpoint(start int) *checkpoint {
+	return &checkpoint{pending: map[int]int{}, next: start, done: start}
+}
+
+func (c *checkpoint) begin(index, rows int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next = index + 1
+	if rows > 0 {
+		c.pending[index] = rows
+	}
+	c.advance()
+}
+
+// finish は 1 行の完了を記録し、エントリの全行が終わったら true を返す
+func (c *checkpoint) finish(index int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.pending[index]--
+	if c.pending[index] > 0 {
+		return false
+	}
+	delete(c.pending, index)
+	c.advance()
+	return true
+}
+
+func (c *checkpoint) advance() {
+	for c.done < c.next {
+		if _, ok := c.pending[c.done]; ok {
+			return
+		}
+		c.done++
+	}
+}
+
+// last は完了した最後の index（未着手なら開始位置 - 1）
+func (c *checkpoint) last() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.done - 1
+}
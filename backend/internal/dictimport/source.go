@@ -0,0 +1,103 @@
+package dictimport
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"word_app/backend/src/domain/posmap"
+)
+
+// Source は辞書ファイルの形式ごとの読み方。
+// どの形式もエントリを JMEntry に揃え、0 始まりの位置と共に 1 件ずつ emit に渡す。
+type Source interface {
+	Format() string
+	Entries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error
+}
+
+// 対応している形式（cmd/import_dict の -format）
+const (
+	FormatJMdict = "jmdict"
+	FormatCSV    = "csv"
+	FormatTSV    = "tsv"
+	FormatAnki   = "anki"
+	FormatEDICT  = "edict"
+)
+
+// SourceFor は形式名から Source を返す
+func SourceFor(format string) (Source, error) {
+	switch strings.ToLower(format) {
+	case "", FormatJMdict:
+		return JMdictSource{}, nil
+	case FormatCSV:
+		return CSVSource{Comma: ','}, nil
+	case FormatTSV:
+		return CSVSource{Comma: '\t'}, nil
+	case FormatAnki:
+		return AnkiSource{}, nil
+	case FormatEDICT:
+		return EDICTSource{}, nil
+	default:
+		return nil, fmt.Errorf("dictimport: unknown format %q", format)
+	}
+}
+
+// JMdictSource は jmdict-simplified の JSON
+type JMdictSource struct{}
+
+func (JMdictSource) Format() string { return FormatJMdict }
+
+func (JMdictSource) Entries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error {
+	return decodeEntries(ctx, r, emit)
+}
+
+// posNameToID は PartOfSpeech のシード名 → 内部 ID
+var posNameToID = map[string]int{
+	"名詞": 1, "代名詞": 2, "動詞": 3, "形容詞": 4, "副詞": 5, "助動詞": 6,
+	"前置詞": 7, "冠詞": 8, "間投詞": 9, "接続詞": 10, "慣用句": 11, "その他": 12,
+}
+
+// parsePos は表形式の品詞欄を、品詞だけを持つ Sense にする。
+// JMdict の品詞コード（n, v5r など）は対応表で決めるためコードのまま残し、
+// シード名（名詞など）と ID の数字は品詞 ID として直接指定する。
+func parsePos(s string) (Sense, error) {
+	s = strings.TrimSpace(s)
+	if posmap.IsKnownCode(s) {
+		return Sense{PartOfSpeech: []string{s}}, nil
+	}
+	if id, ok := posNameToID[s]; ok {
+		return Sense{posID: id}, nil
+	}
+	if id, err := strconv.Atoi(s); err == nil && id >= 1 && id <= len(posNameToID) {
+		return Sense{posID: id}, nil
+	}
+	return Sense{}, fmt.Errorf("unknown part of speech %q", s)
+}
+
+// splitMeanings は「;」「、」「,」区切りの日本語訳を分ける
+func splitMeanings(s string) []string {
+	parts := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ';' || r == '；' || r == '、' || r == ','
+	})
+	out := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// simpleEntry は 英単語・品詞・日本語訳 だけの行を JMEntry にする。
+// pos は parsePos の結果。日本語訳は Kanji に入れ、表記として扱う。
+func simpleEntry(id, word string, pos Sense, means []string) JMEntry {
+	e := JMEntry{ID: id}
+	for _, m := range means {
+		e.Kanji = append(e.Kanji, Kanji{Text: m})
+	}
+	pos.Gloss = []Gloss{{Lang: "eng", Text: word}}
+	e.Sense = []Sense{pos}
+	return e
+}
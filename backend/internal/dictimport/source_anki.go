@@ -0,0 +1,271 @@
+package dictimport
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"word_app/backend/ent/word"
+
+	_ "github.com/mattn/go-sqlite3" // .apkg の中身は SQLite
+)
+
+// AnkiSource は Anki の書き出し。.apkg（デッキパッケージ）と .txt（テキスト形式のノート）を中身で判別する。
+// 1 番目のフィールドを英単語、2 番目を日本語訳とみなす（逆向きのデッキは入れ替える）。
+// Anki のノートには品詞が無いため、品詞名・品詞コードと一致するタグがあればそれを使い、無ければ「その他」にする。
+type AnkiSource struct{}
+
+// ankiDefaultPosID は品詞の分からないノートの品詞（その他）
+const ankiDefaultPosID = 12
+
+func (AnkiSource) Format() string { return FormatAnki }
+
+func (AnkiSource) Entries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error {
+	br := bufio.NewReader(r)
+	magic, _ := br.Peek(4)
+	if bytes.Equal(magic, []byte("PK\x03\x04")) {
+		return ankiPackageEntries(ctx, br, emit)
+	}
+	return ankiTextEntries(ctx, br, emit)
+}
+
+// ---------------- .txt ----------------
+
+// ankiTextEntries は「ノートをテキストで書き出す」の出力を読む。
+// 先頭の #separator / #html / #xxx column: の行で区切り文字と列の意味が決まる。
+func ankiTextEntries(ctx context.Context, br *bufio.Reader, emit func(int, JMEntry) error) error {
+	hdr, err := readAnkiHeader(br)
+	if err != nil {
+		return err
+	}
+	cr := csv.NewReader(br)
+	cr.Comma = hdr.sep
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+
+	for index := 0; ; index++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line, _ := cr.FieldPos(0)
+		var fields []string
+		var tags string
+		for i, col := range rec {
+			switch {
+			case i+1 == hdr.tagsCol:
+				tags = col
+			case hdr.metaCols[i+1]:
+			default:
+				fields = append(fields, col)
+			}
+		}
+		if err := emit(index, ankiEntry("L"+strconv.Itoa(line), fields, tags)); err != nil {
+			return err
+		}
+	}
+}
+
+type ankiHeader struct {
+	sep      rune
+	tagsCol  int          // 1 始まり。0 は無し
+	metaCols map[int]bool // guid / notetype / deck の列
+}
+
+func readAnkiHeader(br *bufio.Reader) (ankiHeader, error) {
+	hdr := ankiHeader{sep: '\t', metaCols: map[int]bool{}}
+	for {
+		peek, err := br.Peek(1)
+		if err != nil || peek[0] != '#' {
+			return hdr, nil
+		}
+		line, err := br.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return hdr, err
+		}
+		key, val, _ := strings.Cut(strings.TrimSpace(strings.TrimPrefix(line, "#")), ":")
+		switch {
+		case key == "separator":
+			hdr.sep = ankiSeparator(val)
+		case key == "tags column":
+			hdr.tagsCol, _ = strconv.Atoi(val)
+		case strings.HasSuffix(key, " column"):
+			if n, err := strconv.Atoi(val); err == nil {
+				hdr.metaCols[n] = true
+			}
+		}
+	}
+}
+
+func ankiSeparator(v string) rune {
+	switch strings.ToLower(v) {
+	case "comma":
+		return ','
+	case "semicolon":
+		return ';'
+	case "pipe":
+		return '|'
+	case "space":
+		return ' '
+	case "tab", "":
+		return '\t'
+	default:
+		return []rune(v)[0]
+	}
+}
+
+// ---------------- .apkg ----------------
+
+// ankiPackageEntries は .apkg（zip）から collection を取り出し、notes テーブルを読む。
+// Anki 2.1.50 以降の既定（collection.anki21b, zstd 圧縮）は読めないため、
+// 「旧バージョンの Anki をサポート」を有効にして書き出したものを受け付ける。
+func ankiPackageEntries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error {
+	dir, err := os.MkdirTemp("", "dictimport-anki-")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	pkgPath := filepath.Join(dir, "package.apkg")
+	if err := copyToFile(pkgPath, r); err != nil {
+		return err
+	}
+	zr, err := zip.OpenReader(pkgPath)
+	if err != nil {
+		return fmt.Errorf("anki: open package: %w", err)
+	}
+	defer func() { _ = zr.Close() }()
+
+	col, err := pickCollection(&zr.Reader)
+	if err != nil {
+		return err
+	}
+	rc, err := col.Open()
+	if err != nil {
+		return err
+	}
+	colPath := filepath.Join(dir, "collection.db")
+	err = copyToFile(colPath, rc)
+	_ = rc.Close()
+	if err != nil {
+		return err
+	}
+	return ankiNotes(ctx, colPath, emit)
+}
+
+func pickCollection(zr *zip.Reader) (*zip.File, error) {
+	files := map[string]*zip.File{}
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+	if f, ok := files["collection.anki21"]; ok {
+		return f, nil
+	}
+	if _, ok := files["collection.anki21b"]; ok {
+		// anki21b と一緒に入っている collection.anki2 は「Anki を更新してください」のダミー
+		return nil, errors.New("anki: collection.anki21b is not supported; re-export with \"Support older Anki versions\" enabled")
+	}
+	if f, ok := files["collection.anki2"]; ok {
+		return f, nil
+	}
+	return nil, errors.New("anki: no collection in package")
+}
+
+func ankiNotes(ctx context.Context, path string, emit func(int, JMEntry) error) error {
+	db, err := sql.Open("sqlite3", "file:"+path+"?mode=ro")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = db.Close() }()
+
+	rows, err := db.QueryContext(ctx, "SELECT id, flds, tags FROM notes ORDER BY id")
+	if err != nil {
+		return fmt.Errorf("anki: read notes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	for index := 0; rows.Next(); index++ {
+		var (
+			id         int64
+			flds, tags string
+		)
+		if err := rows.Scan(&id, &flds, &tags); err != nil {
+			return err
+		}
+		// フィールドは 0x1f 区切り
+		e := ankiEntry("anki:"+strconv.FormatInt(id, 10), strings.Split(flds, "\x1f"), tags)
+		if err := emit(index, e); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func copyToFile(path string, r io.Reader) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ---------------- 共通 ----------------
+
+var (
+	reHTMLBreak = regexp.MustCompile(`(?i)<br\s*/?>|</div>|</p>|</li>`)
+	reHTMLTag   = regexp.MustCompile(`<[^>]*>`)
+	reSound     = regexp.MustCompile(`\[sound:[^\]]*\]`)
+)
+
+// ankiText は HTML のフィールドをテキストにする。改行にあたるタグは訳の区切りにする。
+func ankiText(s string) string {
+	s = reSound.ReplaceAllString(s, "")
+	s = reHTMLBreak.ReplaceAllString(s, ";")
+	s = reHTMLTag.ReplaceAllString(s, "")
+	return strings.TrimSpace(html.UnescapeString(s))
+}
+
+func ankiEntry(id string, fields []string, tags string) JMEntry {
+	if len(fields) < 2 {
+		return JMEntry{ID: id, problem: "note needs at least two fields"}
+	}
+	front, back := ankiText(fields[0]), ankiText(fields[1])
+	if word.NameValidator(front) != nil && word.NameValidator(back) == nil {
+		front, back = back, front // 日本語 → 英語のデッキ
+	}
+
+	pos := Sense{posID: ankiDefaultPosID}
+	for _, tag := range strings.Fields(tags) {
+		if p, err := parsePos(tag); err == nil {
+			pos = p
+			break
+		}
+	}
+	means := splitMeanings(back)
+	if len(means) == 0 {
+		return JMEntry{ID: id, problem: "no meanings"}
+	}
+	return simpleEntry(id, front, pos, means)
+}
@@ -0,0 +1,79 @@
+package dictimport
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// CSVSource はスプレッドシートから書き出した表（英単語, 品詞, 日本語訳）。
+// 日本語訳は 3 列目以降に分けても、1 つの欄に「;」「、」区切りで並べてもよい。
+// 1 行目が見出し（word / 英単語）なら読み飛ばす。
+type CSVSource struct {
+	Comma rune // ',' または '\t'
+}
+
+func (s CSVSource) Format() string {
+	if s.Comma == '\t' {
+		return FormatTSV
+	}
+	return FormatCSV
+}
+
+func (s CSVSource) Entries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error {
+	cr := csv.NewReader(r)
+	cr.Comma = s.Comma
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = s.Comma == '\t'
+	cr.ReuseRecord = true
+
+	for index, first := 0, true; ; first = false {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if first {
+			rec[0] = strings.TrimPrefix(rec[0], "\ufeff") // Excel の BOM
+			if isCSVHeader(rec[0]) {
+				continue
+			}
+		}
+		line, _ := cr.FieldPos(0)
+		if err := emit(index, csvEntry("L"+strconv.Itoa(line), rec)); err != nil {
+			return err
+		}
+		index++
+	}
+}
+
+func isCSVHeader(first string) bool {
+	first = strings.TrimSpace(first)
+	return strings.EqualFold(first, "word") || first == "英単語"
+}
+
+func csvEntry(id string, rec []string) JMEntry {
+	if len(rec) < 3 {
+		return JMEntry{ID: id, problem: "expected columns: word, part of speech, meanings"}
+	}
+	pos, err := parsePos(rec[1])
+	if err != nil {
+		return JMEntry{ID: id, problem: err.Error()}
+	}
+	var means []string
+	for _, col := range rec[2:] {
+		means = append(means, splitMeanings(col)...)
+	}
+	if len(means) == 0 {
+		return JMEntry{ID: id, problem: "no meanings"}
+	}
+	return simpleEntry(id, strings.TrimSpace(rec[0]), pos, means)
+}
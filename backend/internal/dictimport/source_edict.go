@@ -0,0 +1,236 @@
+package dictimport
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/japanese"
+)
+
+// EDICTSource は EDICT / EDICT2 のテキスト形式。
+//
+//	漢字;感じ(P) [かんじ(P)] /(n) (1) feeling/(2) {comp} sense/(P)/EntL1234567X/
+//
+// 配布ファイルの EUC-JP と、変換済みの UTF-8 のどちらも読める。
+type EDICTSource struct{}
+
+func (EDICTSource) Format() string { return FormatEDICT }
+
+func (EDICTSource) Entries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error {
+	sc := bufio.NewScanner(edictReader(r))
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	index := 0
+	for line := 1; sc.Scan(); line++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		text := strings.TrimSpace(sc.Text())
+		// 1 行目は「　？？？ /,/ EDICT, ...」の見出し（全角空白は TrimSpace で落ちる）
+		if text == "" || strings.HasPrefix(text, "#") || strings.HasPrefix(text, "？？？") {
+			continue
+		}
+		if err := emit(index, parseEDICTLine(text, line)); err != nil {
+			return err
+		}
+		index++
+	}
+	return sc.Err()
+}
+
+// edictReader は先頭が UTF-8 として読めなければ EUC-JP とみなして変換する
+func edictReader(r io.Reader) io.Reader {
+	br := bufio.NewReaderSize(r, 64*1024)
+	head, _ := br.Peek(64 * 1024)
+	if utf8.Valid(trimPartialRune(head)) {
+		return br
+	}
+	return japanese.EUCJP.NewDecoder().Reader(br)
+}
+
+// trimPartialRune は末尾で途切れた UTF-8 の文字を除く
+func trimPartialRune(b []byte) []byte {
+	for i := len(b) - 1; i >= 0 && i >= len(b)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(b[i]) {
+			if !utf8.FullRune(b[i:]) {
+				return b[:i]
+			}
+			break
+		}
+	}
+	return b
+}
+
+var (
+	reEDICTID    = regexp.MustCompile(`^EntL(\d+)X?$`)
+	reEDICTVerb  = regexp.MustCompile(`^v[1245knrszit]`)
+	reEDICTParen = regexp.MustCompile(`^\(([^)]*)\)\s*`)
+	reEDICTField = regexp.MustCompile(`^\{([^}]*)\}\s*`)
+)
+
+// edictPOS は動詞（v で始まるもの）以外の品詞コード
+var edictPOS = map[string]bool{
+	"adj-i": true, "adj-ix": true, "adj-na": true, "adj-no": true, "adj-pn": true, "adj-t": true,
+	"adj-f": true, "adj-ku": true, "adj-shiku": true, "adj-nari": true, "adj-kari": true,
+	"adv": true, "adv-to": true, "aux": true, "aux-v": true, "aux-adj": true,
+	"conj": true, "cop": true, "ctr": true, "exp": true, "int": true,
+	"n": true, "n-adv": true, "n-suf": true, "n-pref": true, "n-t": true, "n-pr": true,
+	"num": true, "pn": true, "pref": true, "prt": true, "suf": true, "unc": true,
+}
+
+func isEDICTPos(tag string) bool {
+	return edictPOS[tag] || reEDICTVerb.MatchString(tag)
+}
+
+// parseEDICTLine は 1 行を JMEntry にする
+func parseEDICTLine(text string, line int) JMEntry {
+	head, rest, ok := strings.Cut(text, "/")
+	if !ok {
+		return JMEntry{ID: "L" + strconv.Itoa(line), problem: "missing glosses"}
+	}
+	e := JMEntry{ID: "L" + strconv.Itoa(line)}
+	common := parseEDICTHead(&e, strings.TrimSpace(head))
+
+	var (
+		cur     Sense
+		lastPos []string
+	)
+	flush := func() {
+		if len(cur.Gloss) == 0 {
+			return
+		}
+		if len(cur.PartOfSpeech) == 0 {
+			cur.PartOfSpeech = lastPos // 品詞は次に指定されるまで引き継ぐ
+		}
+		lastPos = cur.PartOfSpeech
+		e.Sense = append(e.Sense, cur)
+		cur = Sense{}
+	}
+	for _, seg := range strings.Split(strings.TrimSuffix(rest, "/"), "/") {
+		seg = strings.TrimSpace(seg)
+		if m := reEDICTID.FindStringSubmatch(seg); m != nil {
+			e.ID = m[1]
+			continue
+		}
+		if seg == "(P)" {
+			common = true
+			continue
+		}
+		if gloss := parseEDICTGloss(seg, &cur, flush); gloss != "" {
+			cur.Gloss = append(cur.Gloss, Gloss{Lang: "eng", Text: gloss})
+		}
+	}
+	flush()
+
+	if common {
+		for i := range e.Kanji {
+			e.Kanji[i].Common = true
+		}
+		for i := range e.Kana {
+			e.Kana[i].Common = true
+		}
+	}
+	return e
+}
+
+// parseEDICTHead は「漢字;漢字 [かな;かな]」を表記と読みにする。(P) が付いていれば true
+func parseEDICTHead(e *JMEntry, head string) bool {
+	common := false
+	strip := func(s string) string {
+		if strings.Contains(s, "(P)") {
+			common = true
+		}
+		if i := strings.Index(s, "("); i >= 0 {
+			s = s[:i]
+		}
+		return strings.TrimSpace(s)
+	}
+
+	kanji, kana, hasKana := strings.Cut(head, "[")
+	if !hasKana {
+		// かなのみの見出し
+		for _, k := range strings.Split(kanji, ";") {
+			if k = strip(k); k != "" {
+				e.Kana = append(e.Kana, Kana{Text: k, AppliesToKanji: []string{"*"}})
+			}
+		}
+		return common
+	}
+	for _, k := range strings.Split(kanji, ";") {
+		if k = strip(k); k != "" {
+			e.Kanji = append(e.Kanji, Kanji{Text: k})
+		}
+	}
+	for _, k := range strings.Split(strings.TrimSuffix(strings.TrimSpace(kana), "]"), ";") {
+		if k = strip(k); k != "" {
+			e.Kana = append(e.Kana, Kana{Text: k, AppliesToKanji: []string{"*"}})
+		}
+	}
+	return common
+}
+
+// edictMisc は品詞以外のタグ（これ以外の括弧は英訳の一部として残す）
+var edictMisc = map[string]bool{
+	"P": true, "uk": true, "uK": true, "arch": true, "col": true, "fam": true, "hon": true, "hum": true,
+	"pol": true, "sl": true, "m-sl": true, "vulg": true, "abbr": true, "id": true, "obs": true, "obsc": true,
+	"on-mim": true, "X": true, "male": true, "fem": true, "poet": true, "rare": true, "sens": true,
+	"derog": true, "joc": true, "chn": true, "yoji": true, "proverb": true, "ateji": true, "gikun": true,
+	"iK": true, "ik": true, "io": true, "ok": true, "oK": true, "rK": true, "sK": true,
+}
+
+// parseEDICTGloss は Gloss 先頭の (品詞,タグ) (番号) {分野} を読み取り、残りの英訳を返す。
+// 番号か品詞があれば新しい Sense の始まりなので、それまでの Sense を flush する。
+func parseEDICTGloss(seg string, cur *Sense, flush func()) string {
+	started := false
+	for {
+		if m := reEDICTField.FindStringSubmatch(seg); m != nil {
+			cur.Field = append(cur.Field, m[1])
+			seg = seg[len(m[0]):]
+			continue
+		}
+		m := reEDICTParen.FindStringSubmatch(seg)
+		if m == nil {
+			return strings.TrimSpace(seg)
+		}
+		tags := strings.Split(m[1], ",")
+		if !allEDICTTags(tags) {
+			return strings.TrimSpace(seg) // "(to) run" のような英訳の括弧
+		}
+		if !started && (isNumber(tags[0]) || isEDICTPos(tags[0])) {
+			flush()
+			started = true
+		}
+		for _, tag := range tags {
+			switch tag = strings.TrimSpace(tag); {
+			case isNumber(tag), tag == "P":
+			case isEDICTPos(tag):
+				cur.PartOfSpeech = append(cur.PartOfSpeech, tag)
+			case strings.HasSuffix(tag, ":"):
+				cur.Dialect = append(cur.Dialect, strings.TrimSuffix(tag, ":"))
+			default:
+				cur.Misc = append(cur.Misc, tag)
+			}
+		}
+		seg = seg[len(m[0]):]
+	}
+}
+
+func allEDICTTags(tags []string) bool {
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if !isNumber(tag) && !isEDICTPos(tag) && !edictMisc[tag] && !strings.HasSuffix(tag, ":") {
+			return false
+		}
+	}
+	return true
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.Atoi(s)
+	return err == nil
+}
@@ -0,0 +1,79 @@
+package dictimport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// decodeEntries は JMdict JSON をトークン単位で読み、words の要素を 0 始まりの位置と共に 1 件ずつ emit に渡す。
+// ファイル全体をメモリに載せないため、words 以外のキー（tags など）は読み飛ばす。
+func decodeEntries(ctx context.Context, r io.Reader, emit func(int, JMEntry) error) error {
+	index := 0
+	dec := json.NewDecoder(r)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("jmdict: unexpected token %v", tok)
+		}
+		if key != "words" {
+			var skip json.RawMessage
+			if err := dec.Decode(&skip); err != nil {
+				return fmt.Errorf("jmdict: skip %q: %w", key, err)
+			}
+			continue
+		}
+
+		if err := expectDelim(dec, '['); err != nil {
+			return err
+		}
+		for dec.More() {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			var e JMEntry
+			if err := dec.Decode(&e); err != nil {
+				return fmt.Errorf("jmdict: decode entry: %w", err)
+			}
+			if err := emit(index, e); err != nil {
+				return err
+			}
+			index++
+		}
+		if err := expectDelim(dec, ']'); err != nil {
+			return err
+		}
+	}
+	return expectDelim(dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("jmdict: expected %q, got %v", want, tok)
+	}
+	return nil
+}
+
+// countingReader は読み込んだバイト数を数える（進捗・ETA 用）
+type countingReader struct {
+	r io.Reader
+	n *progressCounter
+}
+
+func (c countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n.addBytes(n)
+	return n, err
+}
@@ -0,0 +1,27 @@
+package dictimport_test
+
+import (
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+// openClient は接続を 1 本に絞った SQLite を開く。
+// 共有キャッシュのインメモリ DB は書き込みが並ぶと "table is locked" で即失敗するため、
+// ワーカーと失敗記録の書き込みを接続待ちで直列にする。
+func openClient(t *testing.T) *ent.Client {
+	db, err := sql.Open("sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	db.SetMaxOpenConns(1)
+	cli := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	t.Cleanup(func() { _ = cli.Close() })
+	return cli
+}
@@ -0,0 +1,165 @@
+package dictimport_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/word"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/internal/dictimport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// words 以外のキー（前後どちらにあっても）は読み飛ばす
+const sampleJMdict = `{
+  "version": "3.6.1",
+  "tags": {"n": "noun", "v5r": "Godan verb"},
+  "words": [
+    {"id": "1", "kanji": [{"text": "林檎"}], "kana": [{"text": "りんご"}],
+     "sense": [{"partOfSpeech": ["n"], "gloss": [
+       {"lang": "eng", "text": "apple"}, {"lang": "eng", "text": "apple tree"}, {"lang": "ger", "text": "Apfel"}]}]},
+    {"id": "2", "kanji": [], "kana": [{"text": "はしる"}],
+     "sense": [{"partOfSpeech": ["v5r"], "gloss": [{"lang": "eng", "text": "run"}]}]},
+    {"id": "3", "kanji": [{"text": "走る"}], "kana": [{"text": "はしる"}],
+     "sense": [{"partOfSpeech": ["v5r"], "gloss": [{"lang": "eng", "text": "run"}]},
+               {"partOfSpeech": [], "gloss": [{"lang": "eng", "text": "ignored"}]}]},
+    {"id": "4", "kanji": [{"text": "喫茶店"}], "kana": [],
+     "sense": [{"partOfSpeech": ["n"], "gloss": [{"lang": "eng", "text": "café"}, {"lang": "eng", "text": "coffee shop"}]}]},
+    {"id": "5", "kanji": [{"text": "走行"}], "kana": [],
+     "sense": [{"partOfSpeech": ["n"], "gloss": [{"lang": "eng", "text": "run"}]}]}
+  ],
+  "dictDate": "2025-04-01"
+}`
+
+func TestImportJMdict(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*ent.Client, string) {
+		cli := openClient(t)
+		for i := 1; i <= 12; i++ {
+			cli.PartOfSpeech.Create().SetName(fmt.Sprintf("品詞%d", i)).SaveX(ctx)
+		}
+		path := filepath.Join(t.TempDir(), "jmdict.json")
+		require.NoError(t, os.WriteFile(path, []byte(sampleJMdict), 0o600))
+		return cli, path
+	}
+	meansOf := func(t *testing.T, cli *ent.Client, name string, posID int) []string {
+		names := cli.JapaneseMean.Query().
+			Where(japanesemean.HasWordInfoWith(
+				wordinfo.PartOfSpeechID(posID),
+				wordinfo.HasWordWith(word.Name(name)),
+			)).
+			Select(japanesemean.FieldName).
+			StringsX(ctx)
+		sort.Strings(names)
+		return names
+	}
+
+	t.Run("エントリを単語・品詞・意味に展開して書き込む", func(t *testing.T) {
+		cli, path := setup(t)
+		var last dictimport.Progress
+		res, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{
+			Workers:          3,
+			BatchSize:        2,
+			ProgressInterval: time.Hour, // 終了時の 1 回だけ
+			OnProgress:       func(p dictimport.Progress) { last = p },
+		})
+		require.NoError(t, err)
+
+		require.Len(t, res.Failures, 1)
+		assert.Equal(t, "4", res.Failures[0].ID, "不正な単語名は行単位で失敗にする")
+		assert.Equal(t, 3, res.Failures[0].Index)
+
+		names := cli.Word.Query().Select(word.FieldName).StringsX(ctx)
+		assert.ElementsMatch(t, []string{"apple", "apple tree", "run", "coffee shop"}, names)
+
+		tree := cli.Word.Query().Where(word.Name("apple tree")).OnlyX(ctx)
+		assert.True(t, tree.IsIdioms)
+
+		assert.Equal(t, []string{"林檎"}, meansOf(t, cli, "apple", 1))
+		assert.Equal(t, []string{"はしる", "走る"}, meansOf(t, cli, "run", 3), "同じ単語・品詞は 1 つの WordInfo にまとめる")
+		assert.Equal(t, []string{"走行"}, meansOf(t, cli, "run", 1))
+		assert.Equal(t, 2, cli.WordInfo.Query().Where(wordinfo.HasWordWith(word.Name("run"))).CountX(ctx))
+
+		assert.Equal(t, int64(5), last.Entries)
+		assert.Equal(t, int64(6), last.Rows)
+		assert.Equal(t, int64(1), last.Failures)
+		assert.Equal(t, last.TotalBytes, last.BytesRead)
+	})
+
+	t.Run("再実行しても重複しない", func(t *testing.T) {
+		cli, path := setup(t)
+		for range 2 {
+			_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 2, BatchSize: 3})
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 4, cli.Word.Query().CountX(ctx))
+		assert.Equal(t, 5, cli.WordInfo.Query().CountX(ctx))
+		assert.Equal(t, 6, cli.JapaneseMean.Query().CountX(ctx))
+	})
+
+	t.Run("読み・頻出度・用法タグ・例文を取り込む", func(t *testing.T) {
+		cli, _ := setup(t)
+		path := filepath.Join(t.TempDir(), "meta.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"words": [
+		  {"id": "1", "kanji": [{"common": false, "text": "疾走"}], "kana": [{"common": false, "text": "しっそう", "appliesToKanji": ["*"]}],
+		   "sense": [{"partOfSpeech": ["vs"], "field": ["sports"], "misc": [], "dialect": [],
+		     "gloss": [{"lang": "eng", "text": "dash"}]}]},
+		  {"id": "2", "kanji": [{"common": true, "text": "駆ける"}, {"common": false, "text": "駈ける"}],
+		   "kana": [{"common": true, "text": "かける", "appliesToKanji": ["駆ける"]}, {"common": false, "text": "かけるる", "appliesToKanji": ["駈ける"]}],
+		   "sense": [{"partOfSpeech": ["v5r"], "field": ["sports"], "misc": ["uk"], "dialect": ["ksb"],
+		     "gloss": [{"lang": "eng", "text": "dash"}],
+		     "examples": [{"source": {"type": "tatoeba", "value": "1"}, "text": "駆ける",
+		       "sentences": [{"land": "jpn", "text": "彼は駆けていった。"}, {"land": "eng", "text": "He dashed off."}]}]}]},
+		  {"id": "3", "kanji": [], "kana": [{"common": false, "text": "ぴゅん"}],
+		   "sense": [{"partOfSpeech": ["n"], "gloss": [{"lang": "eng", "text": "whoosh"}]}]}
+		]}`), 0o600))
+		_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1, BatchSize: 1})
+		require.NoError(t, err)
+
+		dash := cli.Word.Query().Where(word.Name("dash")).OnlyX(ctx)
+		require.NotNil(t, dash.FrequencyRank)
+		assert.Equal(t, 1, *dash.FrequencyRank, "common なエントリがあれば頻出度を上げる")
+		whoosh := cli.Word.Query().Where(word.Name("whoosh")).OnlyX(ctx)
+		assert.Equal(t, 2, *whoosh.FrequencyRank)
+
+		wi := cli.WordInfo.Query().Where(wordinfo.WordID(dash.ID)).OnlyX(ctx)
+		assert.Equal(t, []string{"sports"}, wi.FieldTags, "同じ WordInfo のタグは重複なくまとめる")
+		assert.Equal(t, []string{"uk"}, wi.MiscTags)
+		assert.Equal(t, []string{"ksb"}, wi.DialectTags)
+		require.Len(t, wi.Examples, 1)
+		assert.Equal(t, "He dashed off.", wi.Examples[0].English)
+
+		readings := map[string]string{}
+		for _, jm := range cli.JapaneseMean.Query().AllX(ctx) {
+			if jm.Reading != nil {
+				readings[jm.Name] = *jm.Reading
+			} else {
+				readings[jm.Name] = ""
+			}
+		}
+		assert.Equal(t, map[string]string{
+			"疾走":  "しっそう",
+			"駆ける": "かける",
+			"駈ける": "かけるる",
+			"ぴゅん": "",
+		}, readings)
+	})
+
+	t.Run("壊れた JSON はエラーを返す", func(t *testing.T) {
+		cli, _ := setup(t)
+		path := filepath.Join(t.TempDir(), "broken.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"words": [{"id": "1", "kana": [`), 0o600))
+		_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1, BatchSize: 10})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,203 @@
+package dictimport_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/word"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/internal/dictimport"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repository/posmapping"
+	"word_app/backend/src/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const posJMdict = `{"words": [
+  {"id": "10", "kanji": [{"text": "書く"}], "kana": [{"text": "かく"}],
+   "sense": [{"partOfSpeech": ["v5k", "vt"], "gloss": [{"lang": "eng", "text": "write"}]}]},
+  {"id": "11", "kanji": [{"text": "明るい"}], "kana": [],
+   "sense": [{"partOfSpeech": ["adj-i"], "gloss": [{"lang": "eng", "text": "bright"}]}]},
+  {"id": "12", "kanji": [{"text": "明るい"}, {"text": "陽気"}], "kana": [],
+   "sense": [{"partOfSpeech": ["adj-no"], "gloss": [{"lang": "eng", "text": "bright"}]}]},
+  {"id": "13", "kanji": [{"text": "巻"}], "kana": [],
+   "sense": [{"partOfSpeech": ["ctr"], "gloss": [{"lang": "eng", "text": "volume"}]}]}
+]}`
+
+// oldMapping は v5k・adj-no・ctr を慣用句に寄せていた以前の対応
+var oldMapping = &posmap.Mapping{
+	Codes:    map[string]int{"v5k": posmap.Idiom, "vt": posmap.Idiom, "adj-i": posmap.Adjective, "adj-no": posmap.Idiom, "ctr": posmap.Idiom},
+	Fallback: posmap.Idiom,
+}
+
+func TestPosMapping(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*ent.Client, string) {
+		cli := openClient(t)
+		for i := 1; i <= 12; i++ {
+			cli.PartOfSpeech.Create().SetName(fmt.Sprintf("品詞%d", i)).SaveX(ctx)
+		}
+		path := filepath.Join(t.TempDir(), "jmdict.json")
+		require.NoError(t, os.WriteFile(path, []byte(posJMdict), 0o600))
+		return cli, path
+	}
+	infoOf := func(t *testing.T, cli *ent.Client, name string, posID int) *ent.WordInfo {
+		return cli.WordInfo.Query().
+			Where(wordinfo.PartOfSpeechID(posID), wordinfo.HasWordWith(word.Name(name))).
+			OnlyX(ctx)
+	}
+	meanNames := func(t *testing.T, cli *ent.Client, infoID int) []string {
+		return cli.JapaneseMean.Query().
+			Where(japanesemean.WordInfoID(infoID)).
+			Select(japanesemean.FieldName).
+			StringsX(ctx)
+	}
+
+	t.Run("既定の対応表で品詞を決め、品詞コードを残す", func(t *testing.T) {
+		cli, path := setup(t)
+		_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"v5k", "vt"}, infoOf(t, cli, "write", posmap.Verb).PosCodes)
+		bright := infoOf(t, cli, "bright", posmap.Adjective)
+		assert.ElementsMatch(t, []string{"adj-i", "adj-no"}, bright.PosCodes)
+		assert.ElementsMatch(t, []string{"明るい", "陽気"}, meanNames(t, cli, bright.ID))
+		infoOf(t, cli, "volume", posmap.Other)
+		assert.Zero(t, cli.WordInfo.Query().Where(wordinfo.PartOfSpeechID(posmap.Idiom)).CountX(ctx))
+	})
+
+	t.Run("DB に版があれば最新版を使う", func(t *testing.T) {
+		cli, path := setup(t)
+		_, err := posmapping.Save(ctx, cli, oldMapping)
+		require.NoError(t, err)
+		_, err = dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1})
+		require.NoError(t, err)
+		infoOf(t, cli, "write", posmap.Idiom)
+	})
+
+	t.Run("対応表の変更を既存の WordInfo に反映する", func(t *testing.T) {
+		cli, path := setup(t)
+		_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1, PosMapping: oldMapping})
+		require.NoError(t, err)
+		manual := cli.Word.Create().SetName("manual").SaveX(ctx)
+		cli.WordInfo.Create().SetWordID(manual.ID).SetPartOfSpeechID(posmap.Idiom).SaveX(ctx)
+
+		// 統合で消える訳を正解にした未回答の設問
+		oldInfo := infoOf(t, cli, "bright", posmap.Idiom)
+		dup := cli.JapaneseMean.Query().Where(japanesemean.WordInfoID(oldInfo.ID), japanesemean.Name("明るい")).OnlyX(ctx)
+		u := cli.User.Create().SetEmail("quiz@example.com").SetName("quiz user").SetPassword("Password123$").SaveX(ctx)
+		q := cli.Quiz.Create().SetUserID(u.ID).SetQuizNumber(1).SetAttentionLevelList([]int{}).SetChoicesPosIds([]int{}).SaveX(ctx)
+		qq := cli.QuizQuestion.Create().
+			SetQuizID(q.ID).
+			SetQuestionNumber(1).
+			SetWordID(oldInfo.WordID).
+			SetWordName("bright").
+			SetPosID(posmap.Idiom).
+			SetCorrectJpmID(dup.ID).
+			SetChoicesJpms([]models.ChoiceJpm{{JapaneseMeanID: 999, Name: "暗い"}, {JapaneseMeanID: dup.ID, Name: "明るい"}}).
+			SaveX(ctx)
+
+		dry, err := dictimport.Reclassify(ctx, cli, dictimport.ReclassifyOptions{DryRun: true, BatchSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 5, dry.Scanned)
+		assert.Equal(t, 1, dry.Skipped, "品詞コードの無い WordInfo は触らない")
+		assert.Equal(t, 2, dry.Moved)
+		assert.Equal(t, 1, dry.Merged)
+		assert.Equal(t, map[dictimport.PosChange]int{
+			{From: posmap.Idiom, To: posmap.Verb}:      1,
+			{From: posmap.Idiom, To: posmap.Adjective}: 1,
+			{From: posmap.Idiom, To: posmap.Other}:     1,
+		}, dry.Changes)
+		assert.Equal(t, 3, cli.WordInfo.Query().Where(wordinfo.PartOfSpeechID(posmap.Idiom), wordinfo.HasWordWith(word.NameNEQ("manual"))).CountX(ctx), "ドライランは書き込まない")
+
+		res, err := dictimport.Reclassify(ctx, cli, dictimport.ReclassifyOptions{BatchSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, dry.Changes, res.Changes)
+
+		infoOf(t, cli, "write", posmap.Verb)
+		infoOf(t, cli, "volume", posmap.Other)
+		infoOf(t, cli, "manual", posmap.Idiom)
+		bright := infoOf(t, cli, "bright", posmap.Adjective)
+		assert.ElementsMatch(t, []string{"明るい", "陽気"}, meanNames(t, cli, bright.ID))
+		assert.ElementsMatch(t, []string{"adj-i", "adj-no"}, bright.PosCodes)
+
+		kept := cli.JapaneseMean.Query().Where(japanesemean.WordInfoID(bright.ID), japanesemean.Name("明るい")).OnlyX(ctx)
+		qq = cli.QuizQuestion.GetX(ctx, qq.ID)
+		assert.Equal(t, kept.ID, qq.CorrectJpmID)
+		assert.Equal(t, []models.ChoiceJpm{{JapaneseMeanID: 999, Name: "暗い"}, {JapaneseMeanID: kept.ID, Name: "明るい"}}, qq.ChoicesJpms)
+
+		again, err := dictimport.Reclassify(ctx, cli, dictimport.ReclassifyOptions{})
+		require.NoError(t, err)
+		assert.Zero(t, again.Moved+again.Merged, "2 回目は何も変わらない")
+	})
+
+	t.Run("品詞コードを保存する前の WordInfo は辞書ファイルから補って反映する", func(t *testing.T) {
+		cli, path := setup(t)
+		// 以前の取り込みで作られた行（品詞コード無し）。adj-no・ctr は慣用句になっていた
+		legacy := func(name string, posID int, means ...string) {
+			w, err := cli.Word.Query().Where(word.Name(name)).Only(ctx)
+			if ent.IsNotFound(err) {
+				w = cli.Word.Create().SetName(name).SaveX(ctx)
+			}
+			wi := cli.WordInfo.Create().SetWordID(w.ID).SetPartOfSpeechID(posID).SaveX(ctx)
+			for _, m := range means {
+				cli.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName(m).SaveX(ctx)
+			}
+		}
+		legacy("write", posmap.Verb, "書く")
+		legacy("bright", posmap.Adjective, "明るい")
+		legacy("bright", posmap.Idiom, "明るい", "陽気")
+		legacy("volume", posmap.Idiom, "巻")
+		legacy("manual", posmap.Idiom)
+
+		// 取り込み直すと、品詞が変わらない行には品詞コードが付くが、変わる行は古いまま残る
+		_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 2, cli.WordInfo.Query().Where(wordinfo.HasWordWith(word.Name("volume"))).CountX(ctx))
+
+		plain, err := dictimport.Reclassify(ctx, cli, dictimport.ReclassifyOptions{DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, 3, plain.Skipped)
+		assert.Zero(t, plain.Moved+plain.Merged)
+
+		f, err := os.Open(path)
+		require.NoError(t, err)
+		defer func() { _ = f.Close() }()
+		index, err := dictimport.LegacyPosCodes(ctx, f, dictimport.JMdictSource{})
+		require.NoError(t, err)
+
+		dry, err := dictimport.Reclassify(ctx, cli, dictimport.ReclassifyOptions{DryRun: true, Backfill: index, BatchSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, 2, dry.Backfilled)
+		assert.Equal(t, 1, dry.Skipped, "辞書に無い単語は補えない")
+		assert.Equal(t, 2, dry.Merged)
+		assert.Equal(t, map[dictimport.PosChange]int{
+			{From: posmap.Idiom, To: posmap.Adjective}: 1,
+			{From: posmap.Idiom, To: posmap.Other}:     1,
+		}, dry.Changes)
+		assert.Equal(t, 2, cli.WordInfo.Query().Where(wordinfo.HasWordWith(word.Name("volume"))).CountX(ctx), "ドライランは書き込まない")
+
+		res, err := dictimport.Reclassify(ctx, cli, dictimport.ReclassifyOptions{Backfill: index, BatchSize: 2})
+		require.NoError(t, err)
+		assert.Equal(t, dry.Changes, res.Changes)
+
+		volume := cli.WordInfo.Query().Where(wordinfo.HasWordWith(word.Name("volume"))).OnlyX(ctx)
+		assert.Equal(t, posmap.Other, volume.PartOfSpeechID)
+		assert.Equal(t, []string{"ctr"}, volume.PosCodes)
+		assert.Equal(t, []string{"巻"}, meanNames(t, cli, volume.ID))
+		bright := cli.WordInfo.Query().Where(wordinfo.HasWordWith(word.Name("bright"))).OnlyX(ctx)
+		assert.Equal(t, posmap.Adjective, bright.PartOfSpeechID)
+		assert.ElementsMatch(t, []string{"adj-i", "adj-no"}, bright.PosCodes)
+		assert.ElementsMatch(t, []string{"明るい", "陽気"}, meanNames(t, cli, bright.ID))
+		assert.Equal(t, []string{"v5k", "vt"}, infoOf(t, cli, "write", posmap.Verb).PosCodes)
+		assert.Empty(t, infoOf(t, cli, "manual", posmap.Idiom).PosCodes)
+	})
+}
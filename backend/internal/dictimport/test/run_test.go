@@ -0,0 +1,138 @@
+package dictimport_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/dictimportfailure"
+	"word_app/backend/ent/word"
+	"word_app/backend/internal/dictimport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportRun(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T, posCount int, body string) (*ent.Client, string) {
+		cli := openClient(t)
+		for i := 1; i <= posCount; i++ {
+			cli.PartOfSpeech.Create().SetName(fmt.Sprintf("品詞%d", i)).SaveX(ctx)
+		}
+		path := filepath.Join(t.TempDir(), "jmdict.json")
+		require.NoError(t, os.WriteFile(path, []byte(body), 0o600))
+		return cli, path
+	}
+	unresolved := func(t *testing.T, cli *ent.Client) []string {
+		return cli.DictImportFailure.Query().
+			Where(dictimportfailure.ResolvedAtIsNil()).
+			Select(dictimportfailure.FieldEntryID).
+			StringsX(ctx)
+	}
+
+	t.Run("実行記録にハッシュ・チェックポイント・失敗を残す", func(t *testing.T) {
+		cli, path := setup(t, 12, sampleJMdict)
+		res, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 2, BatchSize: 2})
+		require.NoError(t, err)
+
+		run := cli.DictImportRun.GetX(ctx, res.RunID)
+		assert.Equal(t, "completed", run.Status)
+		assert.Equal(t, 4, run.LastCommittedIndex)
+		assert.Len(t, run.SourceHash, 64)
+		assert.NotNil(t, run.FinishedAt)
+
+		f := cli.DictImportFailure.Query().OnlyX(ctx)
+		assert.Equal(t, res.RunID, f.RunID)
+		assert.Equal(t, "4", f.EntryID)
+		assert.Equal(t, 3, f.EntryIndex)
+		assert.Contains(t, f.Message, "café")
+	})
+
+	t.Run("再開はチェックポイントの次のエントリから取り込む", func(t *testing.T) {
+		cli, path := setup(t, 12, sampleJMdict)
+		first, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 2, BatchSize: 2})
+		require.NoError(t, err)
+
+		// index 1 まで書いたところで落ちた状態を作る
+		cli.DictImportRun.UpdateOneID(first.RunID).
+			SetStatus("running").
+			SetLastCommittedIndex(1).
+			ClearFinishedAt().
+			ExecX(ctx)
+		cli.JapaneseMean.Delete().ExecX(ctx)
+		cli.WordInfo.Delete().ExecX(ctx)
+		cli.Word.Delete().ExecX(ctx)
+
+		res, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{
+			Workers: 2, BatchSize: 2, Mode: dictimport.ModeResume,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, first.RunID, res.RunID, "同じ実行記録を引き継ぐ")
+		assert.Equal(t, 2, res.Skipped)
+
+		names := cli.Word.Query().Select(word.FieldName).StringsX(ctx)
+		assert.ElementsMatch(t, []string{"run", "coffee shop"}, names)
+
+		run := cli.DictImportRun.GetX(ctx, res.RunID)
+		assert.Equal(t, "completed", run.Status)
+		assert.Equal(t, 4, run.LastCommittedIndex)
+		assert.Equal(t, 1, cli.DictImportFailure.Query().CountX(ctx), "取り込み直したエントリの失敗は重複しない")
+		assert.Equal(t, 1, cli.DictImportRun.Query().CountX(ctx))
+	})
+
+	t.Run("再開する実行が無ければ新しく始める", func(t *testing.T) {
+		cli, path := setup(t, 12, sampleJMdict)
+		res, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Mode: dictimport.ModeResume})
+		require.NoError(t, err)
+		assert.Zero(t, res.Skipped)
+		assert.Equal(t, 4, cli.Word.Query().CountX(ctx))
+	})
+
+	t.Run("失敗したエントリだけを取り込み直す", func(t *testing.T) {
+		// 品詞 12 (unc) が無いので id=11 は外部キー違反で失敗する
+		cli, path := setup(t, 11, `{"words": [
+		  {"id": "10", "kanji": [{"text": "茶"}], "kana": [], "sense": [{"partOfSpeech": ["n"], "gloss": [{"lang": "eng", "text": "tea"}]}]},
+		  {"id": "11", "kanji": [], "kana": [{"text": "わお"}], "sense": [{"partOfSpeech": ["unc"], "gloss": [{"lang": "eng", "text": "wow"}]}]},
+		  {"id": "12", "kanji": [{"text": "喫茶店"}], "kana": [], "sense": [{"partOfSpeech": ["n"], "gloss": [{"lang": "eng", "text": "café"}]}]}
+		]}`)
+		first, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Workers: 1, BatchSize: 1})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"11", "12"}, unresolved(t, cli))
+
+		cli.PartOfSpeech.Create().SetName("品詞12").SaveX(ctx)
+		var last dictimport.Progress
+		res, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{
+			Workers:          1,
+			BatchSize:        1,
+			Mode:             dictimport.ModeRetryFailed,
+			OnProgress:       func(p dictimport.Progress) { last = p },
+			ProgressInterval: time.Hour,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, first.RunID, res.RunID)
+		assert.Equal(t, 2, res.Retried)
+		assert.Equal(t, int64(2), last.Entries, "成功済みのエントリは読み飛ばす")
+
+		assert.True(t, cli.Word.Query().Where(word.Name("wow")).ExistX(ctx))
+		require.Len(t, res.Failures, 1)
+		assert.Equal(t, "12", res.Failures[0].ID)
+		assert.Equal(t, []string{"12"}, unresolved(t, cli), "再び失敗したものだけが未解消で残る")
+		assert.Equal(t, 3, cli.DictImportFailure.Query().CountX(ctx), "以前の失敗は監査用に残す")
+
+		run := cli.DictImportRun.GetX(ctx, res.RunID)
+		assert.Equal(t, "completed", run.Status)
+		assert.Equal(t, 2, run.LastCommittedIndex)
+	})
+
+	t.Run("過去の実行が無ければ再試行できない", func(t *testing.T) {
+		cli, path := setup(t, 12, sampleJMdict)
+		_, err := dictimport.ImportJMdict(ctx, path, cli, dictimport.Options{Mode: dictimport.ModeRetryFailed})
+		assert.ErrorIs(t, err, dictimport.ErrNoRunToRetry)
+	})
+}
@@ -0,0 +1,219 @@
+package dictimport_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/word"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/internal/dictimport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/encoding/japanese"
+)
+
+func TestSources(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) *ent.Client {
+		cli := openClient(t)
+		for i := 1; i <= 12; i++ {
+			cli.PartOfSpeech.Create().SetName(fmt.Sprintf("品詞%d", i)).SaveX(ctx)
+		}
+		return cli
+	}
+	write := func(t *testing.T, name string, body []byte) string {
+		path := filepath.Join(t.TempDir(), name)
+		require.NoError(t, os.WriteFile(path, body, 0o600))
+		return path
+	}
+	importAs := func(t *testing.T, cli *ent.Client, format, path string) *dictimport.Result {
+		src, err := dictimport.SourceFor(format)
+		require.NoError(t, err)
+		res, err := dictimport.Import(ctx, path, cli, dictimport.Options{Source: src, Workers: 1, BatchSize: 10})
+		require.NoError(t, err)
+		return res
+	}
+	meansOf := func(t *testing.T, cli *ent.Client, name string, posID int) []string {
+		return cli.JapaneseMean.Query().
+			Where(japanesemean.HasWordInfoWith(
+				wordinfo.PartOfSpeechID(posID),
+				wordinfo.HasWordWith(word.Name(name)),
+			)).
+			Select(japanesemean.FieldName).
+			StringsX(ctx)
+	}
+	entriesOf := func(t *testing.T, src dictimport.Source, body []byte) []dictimport.JMEntry {
+		var out []dictimport.JMEntry
+		require.NoError(t, src.Entries(ctx, bytes.NewReader(body), func(_ int, e dictimport.JMEntry) error {
+			out = append(out, e)
+			return nil
+		}))
+		return out
+	}
+
+	t.Run("CSV は品詞コード・品詞名・ID を受け付ける", func(t *testing.T) {
+		cli := setup(t)
+		path := write(t, "words.csv", []byte("\ufeffword,pos,meanings\n"+
+			"apple,n,りんご\n"+
+			"run,動詞,走る;運営する\n"+
+			"quickly,5,速く,素早く\n"+
+			"broken,???,壊れた\n"))
+		res := importAs(t, cli, dictimport.FormatCSV, path)
+
+		assert.Equal(t, []string{"りんご"}, meansOf(t, cli, "apple", 1))
+		assert.ElementsMatch(t, []string{"走る", "運営する"}, meansOf(t, cli, "run", 3))
+		assert.ElementsMatch(t, []string{"速く", "素早く"}, meansOf(t, cli, "quickly", 5))
+		require.Len(t, res.Failures, 1)
+		assert.Equal(t, "L5", res.Failures[0].ID)
+		assert.Contains(t, res.Failures[0].Message, "unknown part of speech")
+	})
+
+	t.Run("TSV", func(t *testing.T) {
+		cli := setup(t)
+		path := write(t, "words.tsv", []byte("英単語\t品詞\t意味\nclean\tadj-na\tきれいな、清潔な\n"))
+		importAs(t, cli, dictimport.FormatTSV, path)
+		assert.ElementsMatch(t, []string{"きれいな", "清潔な"}, meansOf(t, cli, "clean", 4))
+	})
+
+	t.Run("Anki のテキスト書き出し", func(t *testing.T) {
+		cli := setup(t)
+		path := write(t, "deck.txt", []byte("#separator:tab\n#html:true\n#deck column:1\n#tags column:4\n"+
+			"TOEIC\tapple\tりんご<br>林檎\tnoun-ish 名詞\n"+
+			"TOEIC\t走る\t<b>run</b>\t\n"))
+		importAs(t, cli, dictimport.FormatAnki, path)
+
+		assert.ElementsMatch(t, []string{"りんご", "林檎"}, meansOf(t, cli, "apple", 1), "タグの品詞名を使う")
+		assert.Equal(t, []string{"走る"}, meansOf(t, cli, "run", 12), "逆向きのカードは入れ替え、品詞が無ければその他")
+	})
+
+	t.Run("Anki のデッキパッケージ", func(t *testing.T) {
+		cli := setup(t)
+		path := write(t, "deck.apkg", apkg(t, map[string][][2]string{
+			"collection.anki21": {{"bake\x1f焼く", "v5r"}, {"bread\x1fパン", ""}},
+		}))
+		importAs(t, cli, dictimport.FormatAnki, path)
+		assert.Equal(t, []string{"焼く"}, meansOf(t, cli, "bake", 3))
+		assert.Equal(t, []string{"パン"}, meansOf(t, cli, "bread", 12))
+	})
+
+	t.Run("anki21b だけのパッケージは読めない", func(t *testing.T) {
+		cli := setup(t)
+		path := write(t, "new.apkg", apkg(t, map[string][][2]string{
+			"collection.anki2":   {{"Please update\x1fダミー", ""}},
+			"collection.anki21b": nil,
+		}))
+		_, err := dictimport.Import(ctx, path, cli, dictimport.Options{Source: dictimport.AnkiSource{}})
+		assert.ErrorContains(t, err, "anki21b")
+	})
+
+	edict := "　？？？ /,/ EDICT, EDRDG/\n" +
+		"感じ(P);感ずる [かんじ(P)] /(n) (1) feeling/sense/(2) {comp} impression/(P)/EntL1000010X/\n" +
+		"ぴかぴか /(adv,uk) glittering/(vs,ksb:) (to) sparkle/EntL1000020X/\n"
+
+	t.Run("EDICT を解析する", func(t *testing.T) {
+		entries := entriesOf(t, dictimport.EDICTSource{}, []byte(edict))
+		require.Len(t, entries, 2)
+
+		kanji := entries[0]
+		assert.Equal(t, "1000010", kanji.ID)
+		assert.Equal(t, []dictimport.Kanji{{Text: "感じ", Common: true}, {Text: "感ずる", Common: true}}, kanji.Kanji)
+		assert.Equal(t, "かんじ", kanji.Kana[0].Text)
+		require.Len(t, kanji.Sense, 2)
+		assert.Equal(t, []string{"n"}, kanji.Sense[0].PartOfSpeech)
+		assert.Equal(t, []dictimport.Gloss{{Lang: "eng", Text: "feeling"}, {Lang: "eng", Text: "sense"}}, kanji.Sense[0].Gloss)
+		assert.Equal(t, []string{"n"}, kanji.Sense[1].PartOfSpeech, "品詞は次の指定まで引き継ぐ")
+		assert.Equal(t, []string{"comp"}, kanji.Sense[1].Field)
+
+		kana := entries[1]
+		assert.Empty(t, kana.Kanji)
+		assert.Equal(t, "ぴかぴか", kana.Kana[0].Text)
+		require.Len(t, kana.Sense, 2)
+		assert.Equal(t, []string{"uk"}, kana.Sense[0].Misc)
+		assert.Equal(t, []string{"ksb"}, kana.Sense[1].Dialect)
+		assert.Equal(t, "(to) sparkle", kana.Sense[1].Gloss[0].Text, "タグでない括弧は英訳に残す")
+	})
+
+	t.Run("EUC-JP の EDICT も読める", func(t *testing.T) {
+		euc, err := japanese.EUCJP.NewEncoder().String(edict)
+		require.NoError(t, err)
+		entries := entriesOf(t, dictimport.EDICTSource{}, []byte(euc))
+		require.Len(t, entries, 2)
+		assert.Equal(t, "感じ", entries[0].Kanji[0].Text)
+	})
+
+	t.Run("ドライランは書き込まずに差分を出す", func(t *testing.T) {
+		cli := setup(t)
+		apple := cli.Word.Create().SetName("apple").SaveX(ctx)
+		info := cli.WordInfo.Create().SetWordID(apple.ID).SetPartOfSpeechID(1).SaveX(ctx)
+		cli.JapaneseMean.Create().SetWordInfoID(info.ID).SetName("りんご").SaveX(ctx)
+
+		path := write(t, "words.csv", []byte("apple,n,りんご\n"+
+			"apple,n,林檎\n"+
+			"apple,3,りんごを取る\n"+
+			"pear,n,梨\n"+
+			"pear,n,梨\n"+
+			"café,n,カフェ\n"))
+		var out strings.Builder
+		sum, err := dictimport.DryRun(ctx, path, cli, dictimport.CSVSource{Comma: ','}, &out)
+		require.NoError(t, err)
+
+		assert.Equal(t, dictimport.DiffSummary{
+			Entries: 6, Failures: 1, NewWords: 1, NewInfos: 2, NewMeans: 3, Unchanged: 2,
+		}, sum)
+		assert.Equal(t, strings.Join([]string{
+			"! L6 #5 café: invalid word name",
+			"+ mean apple [品詞1] 林檎",
+			"+ info apple [品詞3]",
+			"+ mean apple [品詞3] りんごを取る",
+			"+ word pear",
+			"+ info pear [品詞1]",
+			"+ mean pear [品詞1] 梨",
+		}, "\n")+"\n", out.String())
+
+		assert.Equal(t, 1, cli.Word.Query().CountX(ctx), "DB は変わらない")
+		assert.Zero(t, cli.DictImportRun.Query().CountX(ctx))
+	})
+
+	t.Run("未知の形式はエラー", func(t *testing.T) {
+		_, err := dictimport.SourceFor("xml")
+		assert.Error(t, err)
+	})
+}
+
+// apkg は notes テーブルだけを持つ collection を zip にする。notes は (flds, tags) の組
+func apkg(t *testing.T, collections map[string][][2]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, notes := range collections {
+		path := filepath.Join(t.TempDir(), "collection.db")
+		db, err := sql.Open("sqlite3", path)
+		require.NoError(t, err)
+		_, err = db.Exec("CREATE TABLE notes (id INTEGER PRIMARY KEY, flds TEXT NOT NULL, tags TEXT NOT NULL)")
+		require.NoError(t, err)
+		for i, n := range notes {
+			_, err = db.Exec("INSERT INTO notes (id, flds, tags) VALUES (?, ?, ?)", 1700000000000+i, n[0], n[1])
+			require.NoError(t, err)
+		}
+		require.NoError(t, db.Close())
+
+		body, err := os.ReadFile(path)
+		require.NoError(t, err)
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write(body)
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
@@ -5,8 +5,13 @@ import (
 
 	"word_app/backend/src/handlers/auth"
 	"word_app/backend/src/handlers/bulk"
+	"word_app/backend/src/handlers/deck"
+	"word_app/backend/src/handlers/export"
 	"word_app/backend/src/handlers/setting"
 	"word_app/backend/src/handlers/user"
+	"word_app/backend/src/handlers/vocabimport"
+	"word_app/backend/src/handlers/wellknown"
+	"word_app/backend/src/handlers/wordproposal"
 	"word_app/backend/src/interfaces/http/quiz"
 	"word_app/backend/src/interfaces/http/result"
 	"word_app/backend/src/interfaces/http/word"
@@ -16,14 +21,19 @@ import (
 )
 
 type Implementation struct {
-	JwtMiddleware  jwt.Middleware
-	AuthHandler    auth.Handler
-	BulkHandler    bulk.Handler
-	UserHandler    user.Handler
-	SettingHandler setting.Handler
-	WordHandler    word.Handler
-	QuizHandler    quiz.Handler
-	ResultHandler  result.Handler
+	JwtMiddleware    jwt.Middleware
+	AuthHandler      auth.Handler
+	BulkHandler      bulk.Handler
+	UserHandler      user.Handler
+	SettingHandler   setting.Handler
+	WordHandler      word.Handler
+	DeckHandler      deck.Handler
+	QuizHandler      quiz.Handler
+	ResultHandler    result.Handler
+	ExportHandler    export.Handler
+	ImportHandler    vocabimport.Handler
+	ProposalHandler  wordproposal.Handler
+	WellKnownHandler wellknown.Handler
 }
 
 func NewRouter(
@@ -33,18 +43,28 @@ func NewRouter(
 	userHandler user.Handler,
 	settingHandler setting.Handler,
 	wordHandler word.Handler,
+	deckHandler deck.Handler,
 	quizHandler quiz.Handler,
 	resultHandler result.Handler,
+	exportHandler export.Handler,
+	importHandler vocabimport.Handler,
+	proposalHandler wordproposal.Handler,
+	wellKnownHandler wellknown.Handler,
 ) *Implementation {
 	return &Implementation{
-		JwtMiddleware:  jwtMiddleware,
-		AuthHandler:    authHandler,
-		BulkHandler:    bulkHandler,
-		UserHandler:    userHandler,
-		SettingHandler: settingHandler,
-		WordHandler:    wordHandler,
-		QuizHandler:    quizHandler,
-		ResultHandler:  resultHandler,
+		JwtMiddleware:    jwtMiddleware,
+		AuthHandler:      authHandler,
+		BulkHandler:      bulkHandler,
+		UserHandler:      userHandler,
+		SettingHandler:   settingHandler,
+		WordHandler:      wordHandler,
+		DeckHandler:      deckHandler,
+		QuizHandler:      quizHandler,
+		ResultHandler:    resultHandler,
+		ExportHandler:    exportHandler,
+		ImportHandler:    importHandler,
+		ProposalHandler:  proposalHandler,
+		WellKnownHandler: wellKnownHandler,
 	}
 }
 
@@ -59,14 +79,23 @@ func (r *Implementation) MountRoutes(router *gin.Engine) {
 
 	// 公開API
 	router.GET("/public/runtime-config", r.SettingHandler.GetRuntimeConfigHandler())
+	// アクセストークン検証用の公開鍵
+	router.GET("/.well-known/jwks.json", r.WellKnownHandler.JWKSHandler())
 
 	userRoutes := router.Group("/users")
 	{
 		userRoutes.POST("/sign_up", r.UserHandler.SignUpHandler())
 		userRoutes.POST("/sign_in", r.UserHandler.SignInHandler())
-		userRoutes.GET("/auth/line/login", r.AuthHandler.LineLogin())
-		userRoutes.GET("/auth/line/callback", r.AuthHandler.LineCallback())
-		userRoutes.POST("/auth/line/complete", r.AuthHandler.LineComplete())
+		userRoutes.POST("/token/refresh", r.UserHandler.RefreshHandler())
+		userRoutes.POST("/sign_out", r.UserHandler.SignOutHandler())
+		userRoutes.POST("/email/verification", r.UserHandler.SendVerificationHandler())
+		userRoutes.POST("/email/verify", r.UserHandler.VerifyEmailHandler())
+		userRoutes.POST("/password/forgot", r.UserHandler.ForgotPasswordHandler())
+		userRoutes.POST("/password/reset", r.UserHandler.ResetPasswordHandler())
+		userRoutes.GET("/auth/providers", r.AuthHandler.ListProvidersHandler())
+		userRoutes.GET("/auth/:provider/login", r.AuthHandler.ProviderLogin())
+		userRoutes.GET("/auth/:provider/callback", r.AuthHandler.ProviderCallback())
+		userRoutes.POST("/auth/:provider/complete", r.AuthHandler.ProviderComplete())
 		userRoutes.POST("/auth/test-login", r.AuthHandler.TestLoginHandler())
 	}
 
@@ -83,6 +112,7 @@ func (r *Implementation) MountRoutes(router *gin.Engine) {
 		protectedRoutes.GET("/users/my_page", r.UserHandler.MyPageHandler())
 		protectedRoutes.GET("/users", r.UserHandler.ListHandler())
 		protectedRoutes.GET("/users/me", r.UserHandler.MeHandler())
+		protectedRoutes.POST("/users/sign_out_all", r.UserHandler.SignOutAllHandler())
 		protectedRoutes.GET("/users/:id", r.UserHandler.ShowHandler())
 		protectedRoutes.PUT("/users/:id", r.UserHandler.EditHandler())
 		protectedRoutes.DELETE("/users/:id", r.UserHandler.DeleteHandler())
@@ -92,6 +122,8 @@ func (r *Implementation) MountRoutes(router *gin.Engine) {
 		protectedRoutes.POST("/setting/user_config", r.SettingHandler.SaveUserConfigHandler())
 		protectedRoutes.GET("/setting/root_config", r.SettingHandler.GetRootConfigHandler())
 		protectedRoutes.POST("/setting/root_config", r.SettingHandler.SaveRootConfigHandler())
+		protectedRoutes.GET("/setting/pos_mapping", r.SettingHandler.GetPosMappingHandler())
+		protectedRoutes.PUT("/setting/pos_mapping", r.SettingHandler.SavePosMappingHandler())
 
 		protectedRoutes.GET("/words", r.WordHandler.ListHandler())
 		protectedRoutes.GET("/words/:id", r.WordHandler.ShowHandler())
@@ -101,14 +133,34 @@ func (r *Implementation) MountRoutes(router *gin.Engine) {
 		protectedRoutes.POST("/words/new", r.WordHandler.CreateHandler())
 		protectedRoutes.PUT("/words/:id", r.WordHandler.UpdateHandler())
 		protectedRoutes.DELETE("/words/:id", r.WordHandler.DeleteHandler())
+		protectedRoutes.GET("/words/proposals", r.ProposalHandler.ListHandler())
+		protectedRoutes.POST("/words/proposals/:id/approve", r.ProposalHandler.ApproveHandler())
+		protectedRoutes.POST("/words/proposals/:id/reject", r.ProposalHandler.RejectHandler())
 		protectedRoutes.POST("/words/bulk_tokenize", r.BulkHandler.TokenizeHandler())
 		protectedRoutes.POST("/words/bulk_register", r.BulkHandler.RegisterHandler())
+		protectedRoutes.POST("/words/import/preview", r.ImportHandler.PreviewHandler())
+		protectedRoutes.POST("/words/import", r.ImportHandler.CommitHandler())
+
+		protectedRoutes.GET("/decks", r.DeckHandler.ListHandler())
+		protectedRoutes.POST("/decks", r.DeckHandler.CreateHandler())
+		protectedRoutes.GET("/decks/public", r.DeckHandler.ListPublicHandler())
+		protectedRoutes.GET("/decks/shared/:token", r.DeckHandler.SharedHandler())
+		protectedRoutes.POST("/decks/shared/:token/copy", r.DeckHandler.CopySharedHandler())
+		protectedRoutes.GET("/decks/:id", r.DeckHandler.ShowHandler())
+		protectedRoutes.PUT("/decks/:id", r.DeckHandler.UpdateHandler())
+		protectedRoutes.DELETE("/decks/:id", r.DeckHandler.DeleteHandler())
+		protectedRoutes.POST("/decks/:id/copy", r.DeckHandler.CopyHandler())
+
+		protectedRoutes.GET("/export", r.ExportHandler.ExportHandler())
 
 		protectedRoutes.POST("/quizzes/new", r.QuizHandler.CreateHandler())
 		protectedRoutes.POST("/quizzes/answers/:id", r.QuizHandler.PostAnswerAndRouteHandler())
 		protectedRoutes.GET("/quizzes", r.QuizHandler.GetHandler())
+		protectedRoutes.GET("/quizzes/unfinished", r.QuizHandler.GetUnfinishedHandler())
+		protectedRoutes.POST("/quizzes/cancel/:id", r.QuizHandler.CancelHandler())
 
 		protectedRoutes.GET("/results", r.ResultHandler.GetIndexHandler())
+		protectedRoutes.GET("/results/stats", r.ResultHandler.GetStatsHandler())
 		protectedRoutes.GET("/results/:quizNo", r.ResultHandler.GetHandler())
 	}
 }
@@ -10,6 +10,8 @@ import (
 	"word_app/backend/ent/rootconfig"
 	"word_app/backend/ent/user"
 	"word_app/backend/ent/word"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repository/posmapping"
 	"word_app/backend/src/interfaces"
 
 	"golang.org/x/crypto/bcrypt"
@@ -20,6 +22,7 @@ func RunSeeder(ctx context.Context, client interfaces.ClientInterface) {
 	SeedAdminUsers(ctx, client)
 	SeedRootConfig(ctx, client)
 	SeedPartOfSpeech(ctx, client)
+	SeedPosMapping(ctx, client)
 }
 
 // SeedAdminUsers シードデータを流す
@@ -130,6 +133,32 @@ func SeedPartOfSpeech(ctx context.Context, client interfaces.ClientInterface) {
 	}
 }
 
+// SeedPosMapping 品詞コード対応表の初版（既定の対応表）のシード
+func SeedPosMapping(ctx context.Context, client interfaces.ClientInterface) {
+	entClient := client.EntClient()
+	exists, err := entClient.PosMappingVersion.Query().Exist(ctx)
+	if err != nil {
+		log.Fatalf("failed to query pos mapping: %v", err)
+	}
+	if exists {
+		return
+	}
+	m := posmap.Default()
+	m.Note = "default"
+	tx, err := entClient.Tx(ctx)
+	if err != nil {
+		log.Fatalf("failed to begin transaction: %v", err)
+	}
+	if _, err := posmapping.Save(ctx, tx.Client(), m); err != nil {
+		_ = tx.Rollback()
+		log.Fatalf("failed to create pos mapping: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		log.Fatalf("failed to commit pos mapping: %v", err)
+	}
+	log.Printf("Pos mapping seeded (%d codes)\n", len(m.Codes))
+}
+
 func SeedWords(ctx context.Context, client interfaces.ClientInterface) {
 	entClient := client.EntClient()
 	// 単語、品詞、日本語の意味を持つデータセット
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+// デッキの公開範囲
+const (
+	DeckVisibilityPrivate = "private" // 作成者だけ
+	DeckVisibilityLink    = "link"    // 共有リンク（share_token）を知っている人
+	DeckVisibilityPublic  = "public"  // 全ユーザー
+)
+
+// Deck はユーザーが作る単語リスト
+type Deck struct {
+	ID          int        `json:"id"`
+	UserID      int        `json:"userID"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Visibility  string     `json:"visibility"`
+	ShareToken  *string    `json:"shareToken,omitempty"` // 作成者にだけ返す
+	WordCount   int        `json:"wordCount"`
+	Words       []DeckWord `json:"words,omitempty"` // 一覧では空
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+// DeckWord はデッキ内の単語（Position 順）
+type DeckWord struct {
+	WordID   int    `json:"wordID"`
+	Name     string `json:"name"`
+	Position int    `json:"position"`
+}
+
+// IsValidDeckVisibility は公開範囲の値が正しいか
+func IsValidDeckVisibility(v string) bool {
+	switch v {
+	case DeckVisibilityPrivate, DeckVisibilityLink, DeckVisibilityPublic:
+		return true
+	}
+	return false
+}
+
+// CanView は viewerID のユーザーが ID 指定でデッキを見られるか。
+// リンク共有のデッキは ID では見られず、share_token で引いたときだけ見られる。
+func (d *Deck) CanView(viewerID int) bool {
+	return d.UserID == viewerID || d.Visibility == DeckVisibilityPublic
+}
+
+// WordIDs はデッキ内の単語 ID を並び順で返す
+func (d *Deck) WordIDs() []int {
+	ids := make([]int, 0, len(d.Words))
+	for _, w := range d.Words {
+		ids = append(ids, w.WordID)
+	}
+	return ids
+}
@@ -0,0 +1,45 @@
+package domain
+
+// 単語マスタの編集権限（RootConfig.EditingPermission）
+const (
+	EditingPermissionUser  = "user"  // admin / root は直接、一般ユーザーは提案して承認待ち
+	EditingPermissionAdmin = "admin" // admin / root だけが直接編集（既定）
+	EditingPermissionRoot  = "root"  // root だけが直接編集
+)
+
+// MasterEdit は単語マスタを変更しようとしたときの扱い
+type MasterEdit int
+
+const (
+	MasterEditDeny     MasterEdit = iota // 変更できない
+	MasterEditAllow                      // そのまま反映する
+	MasterEditModerate                   // 提案として保存し、承認されたら反映する
+)
+
+// DecideMasterEdit は編集権限の設定とユーザーの役割から扱いを決める。
+// 未知の設定値は既定の "admin" とみなす。テストユーザーは提案もできない。
+func DecideMasterEdit(permission string, u *User) MasterEdit {
+	if u == nil {
+		return MasterEditDeny
+	}
+	if u.IsRoot {
+		return MasterEditAllow
+	}
+	if u.IsTest {
+		return MasterEditDeny
+	}
+	switch permission {
+	case EditingPermissionRoot:
+		return MasterEditDeny
+	case EditingPermissionUser:
+		if u.IsAdmin {
+			return MasterEditAllow
+		}
+		return MasterEditModerate
+	default:
+		if u.IsAdmin {
+			return MasterEditAllow
+		}
+		return MasterEditDeny
+	}
+}
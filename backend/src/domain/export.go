@@ -0,0 +1,71 @@
+package domain
+
+import "time"
+
+// エクスポート形式
+const (
+	ExportFormatCSV  = "csv"  // words.csv と quiz_history.csv を zip にまとめる
+	ExportFormatJSON = "json" // { words: [...], quizzes: [...] }
+	ExportFormatAnki = "anki" // Anki の「テキストファイルから読み込む」用 TSV（登録中の単語だけ）
+)
+
+// ExportWord はエクスポートする登録単語 1 件
+type ExportWord struct {
+	ID             int             `json:"-"` // RegisteredWord の ID（ページング用）
+	Word           string          `json:"word"`
+	Meanings       []ExportMeaning `json:"meanings"`
+	Memo           string          `json:"memo"`
+	AttentionLevel int             `json:"attentionLevel"`
+	QuizCount      int             `json:"quizCount"`
+	CorrectCount   int             `json:"correctCount"`
+	CorrectRate    int             `json:"correctRate"`
+	IsActive       bool            `json:"isActive"`
+	RegisteredAt   time.Time       `json:"registeredAt"`
+	LastReviewedAt *time.Time      `json:"lastReviewedAt"`
+	DueAt          *time.Time      `json:"dueAt"`
+}
+
+// ExportMeaning は意味 1 つ（品詞ごと）
+type ExportMeaning struct {
+	PartOfSpeech string `json:"partOfSpeech"`
+	Name         string `json:"name"`
+	Reading      string `json:"reading,omitempty"`
+}
+
+// ExportQuiz は終了済みクイズ 1 回分
+type ExportQuiz struct {
+	ID                  int              `json:"-"` // Quiz の ID（ページング用）
+	QuizNumber          int              `json:"quizNumber"`
+	Status              string           `json:"status"`
+	Mode                string           `json:"mode"`
+	Direction           string           `json:"direction"`
+	QuestionType        string           `json:"questionType"`
+	Difficulty          string           `json:"difficulty"`
+	TotalQuestionsCount int              `json:"totalQuestionsCount"`
+	CorrectCount        int              `json:"correctCount"`
+	CorrectRate         float64          `json:"correctRate"`
+	CreatedAt           time.Time        `json:"createdAt"`
+	FinishedAt          *time.Time       `json:"finishedAt"`
+	Questions           []ExportQuestion `json:"questions"`
+}
+
+// ExportQuestion は出題 1 問と回答
+type ExportQuestion struct {
+	QuestionNumber int        `json:"questionNumber"`
+	Word           string     `json:"word"`
+	Prompt         string     `json:"prompt"`        // 提示した文字列（en_to_ja は単語、ja_to_en は意味）
+	CorrectAnswer  string     `json:"correctAnswer"` // 正解の文字列
+	Answer         string     `json:"answer"`        // 回答の文字列。未回答は空
+	Grade          string     `json:"grade"`
+	IsCorrect      bool       `json:"isCorrect"`
+	TimeMs         *int       `json:"timeMs"`
+	AnsweredAt     *time.Time `json:"answeredAt"`
+}
+
+func IsValidExportFormat(f string) bool {
+	switch f {
+	case ExportFormatCSV, ExportFormatJSON, ExportFormatAnki:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package lemma
+
+// irregular は規則で戻せない活用形 → 見出し語。
+// 複数の見出し語がありうる形（lay → lie / lay、better → good / well）は確からしい順に並べる。
+var irregular = map[string][]string{
+	"am":         {"be"},
+	"analyses":   {"analysis"},
+	"appendices": {"appendix"},
+	"are":        {"be"},
+	"arisen":     {"arise"},
+	"arose":      {"arise"},
+	"ate":        {"eat"},
+	"awoke":      {"awake"},
+	"awoken":     {"awake"},
+	"axes":       {"axis"},
+	"bacteria":   {"bacterium"},
+	"bases":      {"basis"},
+	"beaten":     {"beat"},
+	"became":     {"become"},
+	"been":       {"be"},
+	"began":      {"begin"},
+	"begun":      {"begin"},
+	"being":      {"be"},
+	"bent":       {"bend"},
+	"best":       {"good", "well"},
+	"better":     {"good", "well"},
+	"bit":        {"bite"},
+	"bitten":     {"bite"},
+	"bled":       {"bleed"},
+	"blew":       {"blow"},
+	"blown":      {"blow"},
+	"bore":       {"bear"},
+	"born":       {"bear"},
+	"borne":      {"bear"},
+	"bought":     {"buy"},
+	"bound":      {"bind"},
+	"bred":       {"breed"},
+	"broke":      {"break"},
+	"broken":     {"break"},
+	"brought":    {"bring"},
+	"built":      {"build"},
+	"burnt":      {"burn"},
+	"buses":      {"bus"},
+	"cacti":      {"cactus"},
+	"calves":     {"calf"},
+	"came":       {"come"},
+	"caught":     {"catch"},
+	"children":   {"child"},
+	"chose":      {"choose"},
+	"chosen":     {"choose"},
+	"clung":      {"cling"},
+	"crept":      {"creep"},
+	"crises":     {"crisis"},
+	"criteria":   {"criterion"},
+	"curricula":  {"curriculum"},
+	"dealt":      {"deal"},
+	"diagnoses":  {"diagnosis"},
+	"did":        {"do"},
+	"does":       {"do"},
+	"doing":      {"do"},
+	"done":       {"do"},
+	"drank":      {"drink"},
+	"drawn":      {"draw"},
+	"dreamt":     {"dream"},
+	"drew":       {"draw"},
+	"driven":     {"drive"},
+	"drove":      {"drive"},
+	"drunk":      {"drink"},
+	"dug":        {"dig"},
+	"eaten":      {"eat"},
+	"elder":      {"old"},
+	"eldest":     {"old"},
+	"fallen":     {"fall"},
+	"farther":    {"far"},
+	"farthest":   {"far"},
+	"fed":        {"feed"},
+	"feet":       {"foot"},
+	"fell":       {"fall"},
+	"felt":       {"feel"},
+	"fled":       {"flee"},
+	"flew":       {"fly"},
+	"flies":      {"fly"},
+	"flown":      {"fly"},
+	"flung":      {"fling"},
+	"forbade":    {"forbid"},
+	"forbidden":  {"forbid"},
+	"forgave":    {"forgive"},
+	"forgiven":   {"forgive"},
+	"forgot":     {"forget"},
+	"forgotten":  {"forget"},
+	"fought":     {"fight"},
+	"found":      {"find"},
+	"froze":      {"freeze"},
+	"frozen":     {"freeze"},
+	"fungi":      {"fungus"},
+	"further":    {"far"},
+	"furthest":   {"far"},
+	"gave":       {"give"},
+	"geese":      {"goose"},
+	"given":      {"give"},
+	"goes":       {"go"},
+	"gone":       {"go"},
+	"got":        {"get"},
+	"gotten":     {"get"},
+	"grew":       {"grow"},
+	"ground":     {"grind"},
+	"grown":      {"grow"},
+	"had":        {"have"},
+	"halves":     {"half"},
+	"has":        {"have"},
+	"having":     {"have"},
+	"heard":      {"hear"},
+	"held":       {"hold"},
+	"hid":        {"hide"},
+	"hidden":     {"hide"},
+	"hung":       {"hang"},
+	"hypotheses": {"hypothesis"},
+	"indices":    {"index"},
+	"is":         {"be"},
+	"kept":       {"keep"},
+	"knelt":      {"kneel"},
+	"knew":       {"know"},
+	"knives":     {"knife"},
+	"known":      {"know"},
+	"laid":       {"lay"},
+	"lain":       {"lie"},
+	"lay":        {"lie"},
+	"leant":      {"lean"},
+	"leapt":      {"leap"},
+	"learnt":     {"learn"},
+	"least":      {"little"},
+	"leaves":     {"leaf"},
+	"led":        {"lead"},
+	"left":       {"leave"},
+	"lent":       {"lend"},
+	"less":       {"little"},
+	"lice":       {"louse"},
+	"lit":        {"light"},
+	"lives":      {"life"},
+	"loaves":     {"loaf"},
+	"lost":       {"lose"},
+	"made":       {"make"},
+	"matrices":   {"matrix"},
+	"meant":      {"mean"},
+	"media":      {"medium"},
+	"men":        {"man"},
+	"met":        {"meet"},
+	"mice":       {"mouse"},
+	"misled":     {"mislead"},
+	"mistaken":   {"mistake"},
+	"mistook":    {"mistake"},
+	"more":       {"many", "much"},
+	"most":       {"many", "much"},
+	"nuclei":     {"nucleus"},
+	"overcame":   {"overcome"},
+	"oxen":       {"ox"},
+	"paid":       {"pay"},
+	"people":     {"person"},
+	"phenomena":  {"phenomenon"},
+	"proven":     {"prove"},
+	"quizzes":    {"quiz"},
+	"ran":        {"run"},
+	"rang":       {"ring"},
+	"ridden":     {"ride"},
+	"risen":      {"rise"},
+	"rode":       {"ride"},
+	"rose":       {"rise"},
+	"rung":       {"ring"},
+	"said":       {"say"},
+	"sang":       {"sing"},
+	"sank":       {"sink"},
+	"sat":        {"sit"},
+	"saw":        {"see"},
+	"says":       {"say"},
+	"seen":       {"see"},
+	"selves":     {"self"},
+	"sent":       {"send"},
+	"sewn":       {"sew"},
+	"shaken":     {"shake"},
+	"shelves":    {"shelf"},
+	"shone":      {"shine"},
+	"shook":      {"shake"},
+	"shot":       {"shoot"},
+	"shown":      {"show"},
+	"shrank":     {"shrink"},
+	"shrunk":     {"shrink"},
+	"slept":      {"sleep"},
+	"slid":       {"slide"},
+	"sold":       {"sell"},
+	"sought":     {"seek"},
+	"spat":       {"spit"},
+	"sped":       {"speed"},
+	"spent":      {"spend"},
+	"spoke":      {"speak"},
+	"spoken":     {"speak"},
+	"sprang":     {"spring"},
+	"sprung":     {"spring"},
+	"spun":       {"spin"},
+	"stank":      {"stink"},
+	"stimuli":    {"stimulus"},
+	"stole":      {"steal"},
+	"stolen":     {"steal"},
+	"stood":      {"stand"},
+	"stricken":   {"strike"},
+	"striven":    {"strive"},
+	"strove":     {"strive"},
+	"struck":     {"strike"},
+	"stuck":      {"stick"},
+	"stung":      {"sting"},
+	"stunk":      {"stink"},
+	"sung":       {"sing"},
+	"sunk":       {"sink"},
+	"swam":       {"swim"},
+	"swept":      {"sweep"},
+	"swore":      {"swear"},
+	"sworn":      {"swear"},
+	"swum":       {"swim"},
+	"swung":      {"swing"},
+	"taken":      {"take"},
+	"taught":     {"teach"},
+	"teeth":      {"tooth"},
+	"theses":     {"thesis"},
+	"thieves":    {"thief"},
+	"thought":    {"think"},
+	"threw":      {"throw"},
+	"thrown":     {"throw"},
+	"told":       {"tell"},
+	"took":       {"take"},
+	"tore":       {"tear"},
+	"torn":       {"tear"},
+	"trod":       {"tread"},
+	"trodden":    {"tread"},
+	"understood": {"understand"},
+	"undertaken": {"undertake"},
+	"undertook":  {"undertake"},
+	"used":       {"use"},
+	"uses":       {"use"},
+	"using":      {"use"},
+	"was":        {"be"},
+	"went":       {"go"},
+	"wept":       {"weep"},
+	"were":       {"be"},
+	"withdrawn":  {"withdraw"},
+	"withdrew":   {"withdraw"},
+	"wives":      {"wife"},
+	"woke":       {"wake"},
+	"woken":      {"wake"},
+	"wolves":     {"wolf"},
+	"women":      {"woman"},
+	"won":        {"win"},
+	"wore":       {"wear"},
+	"worn":       {"wear"},
+	"worse":      {"bad", "badly"},
+	"worst":      {"bad", "badly"},
+	"wound":      {"wind"},
+	"wove":       {"weave"},
+	"woven":      {"weave"},
+	"written":    {"write"},
+	"wrote":      {"write"},
+}
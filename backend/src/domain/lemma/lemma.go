@@ -0,0 +1,146 @@
+// Package lemma は英単語の活用形（複数形・過去形・進行形・比較級など）から見出し語の候補を作る。
+// 辞書を持たないので候補は「ありうる形」の列挙で、実在するかは呼び出し側が単語マスタで確かめる。
+package lemma
+
+import "strings"
+
+// 語幹として認める最短の長さ（"is" → "i" のような誤りを防ぐ）
+const minStem = 2
+
+// Candidates は小文字の語 w の見出し語候補を、確からしい順に重複なく返す。w 自身は含めない。
+// 不規則変化表にある語は表の形を最優先し、続けて規則変化の語尾を外した形を並べる。
+func Candidates(w string) []string {
+	c := &collector{self: w, seen: map[string]bool{}}
+	w = stripClitic(w, c)
+	if base, ok := irregular[w]; ok {
+		for _, b := range base {
+			c.add(b)
+		}
+	}
+	for _, rule := range rules {
+		if strings.HasSuffix(w, rule.suffix) {
+			rule.apply(strings.TrimSuffix(w, rule.suffix), c)
+		}
+	}
+	return c.out
+}
+
+type collector struct {
+	self string
+	seen map[string]bool
+	out  []string
+}
+
+func (c *collector) add(s string) {
+	if len(s) < minStem || s == c.self || c.seen[s] {
+		return
+	}
+	c.seen[s] = true
+	c.out = append(c.out, s)
+}
+
+// stripClitic は所有格や縮約の 's 'll 've 're 'd 'm を外す（n't は don't など語として登録されているので外さない）
+func stripClitic(w string, c *collector) string {
+	i := strings.LastIndexByte(w, '\'')
+	if i <= 0 {
+		return w
+	}
+	switch w[i+1:] {
+	case "s", "ll", "ve", "re", "d", "m":
+		c.add(w[:i])
+		return w[:i]
+	}
+	return w
+}
+
+type rule struct {
+	suffix string
+	apply  func(stem string, c *collector)
+}
+
+// 長い語尾から順に試す。1 つの語に複数の規則が当たってもよい（studies は -ies と -es と -s）
+var rules = []rule{
+	// studies → study
+	{"ies", func(s string, c *collector) { c.add(s + "y") }},
+	// wolves → wolf, knives → knife
+	{"ves", func(s string, c *collector) { c.add(s + "f"); c.add(s + "fe") }},
+	// boxes → box, watches → watch, goes → go, causes → cause, buses → bus
+	{"es", func(s string, c *collector) {
+		switch {
+		case strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "ss"):
+			c.add(s + "e")
+			c.add(s)
+		case sibilant(s) || strings.HasSuffix(s, "o"):
+			c.add(s)
+		}
+	}},
+	// cats → cat（class, bus, analysis は複数形ではない）
+	{"s", func(s string, c *collector) {
+		if !strings.HasSuffix(s, "s") && !strings.HasSuffix(s, "u") && !strings.HasSuffix(s, "i") {
+			c.add(s)
+		}
+	}},
+	// studied → study
+	{"ied", func(s string, c *collector) { c.add(s + "y") }},
+	// walked → walk, used → use, stopped → stop
+	{"ed", func(s string, c *collector) { verbStem(s, c) }},
+	// lying → lie
+	{"ying", func(s string, c *collector) { c.add(s + "ie") }},
+	// walking → walk, making → make, running → run
+	{"ing", func(s string, c *collector) { verbStem(s, c) }},
+	// happier / happiest → happy
+	{"ier", func(s string, c *collector) { c.add(s + "y") }},
+	{"iest", func(s string, c *collector) { c.add(s + "y") }},
+	// taller → tall, larger → large, bigger → big
+	{"er", func(s string, c *collector) { verbStem(s, c) }},
+	{"est", func(s string, c *collector) { verbStem(s, c) }},
+	// basically → basic
+	{"ically", func(s string, c *collector) { c.add(s + "ic") }},
+	// happily → happy
+	{"ily", func(s string, c *collector) { c.add(s + "y") }},
+	// quickly → quick, gently → gentle
+	{"ly", func(s string, c *collector) { c.add(s); c.add(s + "le") }},
+}
+
+// verbStem は -ed / -ing / -er / -est を外した語幹から元の形を推す。
+// 「子音 + 母音 + 子音」で終わる語幹は e が落ちた形とみなして e を補った形を先にする（hoping → hope, hated → hate）。
+// 子音が重なった語幹は重なりを戻した形も候補にする（running → run, stopped → stop）。
+func verbStem(s string, c *collector) {
+	if len(s) < minStem {
+		return
+	}
+	n := len(s)
+	withE := ""
+	if !vowel(s[n-1]) || s[n-1] == 'u' {
+		withE = s + "e"
+	}
+	// v と u で終わる英単語はほぼ無い（loving → love, arguing → argue）
+	if cvc(s) || s[n-1] == 'v' || s[n-1] == 'u' {
+		c.add(withE)
+	}
+	c.add(s)
+	if n >= 3 && s[n-1] == s[n-2] && !vowel(s[n-1]) {
+		c.add(s[:n-1])
+	}
+	c.add(withE)
+}
+
+// cvc は語幹が「子音 + 母音 + 子音」で終わるか（w, x, y で終わるものは除く）
+func cvc(s string) bool {
+	n := len(s)
+	if n < 2 || vowel(s[n-1]) || strings.IndexByte("wxy", s[n-1]) >= 0 || !vowel(s[n-2]) {
+		return false
+	}
+	return n == 2 || !vowel(s[n-3])
+}
+
+func vowel(b byte) bool { return strings.IndexByte("aeiou", b) >= 0 }
+
+func sibilant(s string) bool {
+	for _, suf := range []string{"s", "x", "z", "ch", "sh"} {
+		if strings.HasSuffix(s, suf) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package lemma_test
+
+import (
+	"testing"
+
+	"word_app/backend/src/domain/lemma"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidates_FirstIsLemma(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		// 不規則変化
+		{"went", "go"},
+		{"was", "be"},
+		{"children", "child"},
+		{"better", "good"},
+		// 名詞の複数形・三単現
+		{"cats", "cat"},
+		{"studies", "study"},
+		{"boxes", "box"},
+		{"watches", "watch"},
+		{"goes", "go"},
+		{"causes", "cause"},
+		{"wolves", "wolf"},
+		// 過去形・過去分詞
+		{"walked", "walk"},
+		{"studied", "study"},
+		{"hoped", "hope"},
+		{"added", "add"},
+		{"wanted", "want"},
+		{"played", "play"},
+		// 進行形
+		{"walking", "walk"},
+		{"hoping", "hope"},
+		{"using", "use"},
+		{"eating", "eat"},
+		{"lying", "lie"},
+		{"loving", "love"},
+		{"arguing", "argue"},
+		// 比較級・最上級
+		{"taller", "tall"},
+		{"happiest", "happy"},
+		// 副詞
+		{"quickly", "quick"},
+		{"happily", "happy"},
+		{"basically", "basic"},
+		// 所有格・縮約
+		{"teacher's", "teacher"},
+		{"they're", "they"},
+	}
+	for _, tt := range tests {
+		got := lemma.Candidates(tt.in)
+		if assert.NotEmpty(t, got, tt.in) {
+			assert.Equal(t, tt.want, got[0], "%s: %v", tt.in, got)
+		}
+	}
+}
+
+func TestCandidates_IncludesLaterGuesses(t *testing.T) {
+	// 子音の重なりは、重なったままの形が無ければ戻した形で当たる
+	assert.Contains(t, lemma.Candidates("running"), "run")
+	assert.Contains(t, lemma.Candidates("stopped"), "stop")
+	assert.Contains(t, lemma.Candidates("bigger"), "big")
+	assert.Contains(t, lemma.Candidates("larger"), "large")
+	// 同じ綴りで見出し語が複数ありうる
+	assert.Equal(t, []string{"good", "well"}, lemma.Candidates("better")[:2])
+}
+
+func TestCandidates_NoGuess(t *testing.T) {
+	for _, w := range []string{"class", "bus", "is", "a", "don't", "analysis"} {
+		got := lemma.Candidates(w)
+		assert.NotContains(t, got, w, "%s 自身は含めない", w)
+		for _, c := range got {
+			assert.GreaterOrEqual(t, len(c), 2, "%s: %v", w, got)
+		}
+	}
+	assert.Empty(t, lemma.Candidates("class"))
+	assert.Empty(t, lemma.Candidates("don't"))
+}
@@ -0,0 +1,161 @@
+// Package posmap は辞書の品詞コード（JMdict の n, v5k, adj-no など）から
+// PartOfSpeech の ID への対応表を扱う。
+// 対応表は DB に版として保存され、root が編集できる。DB に無ければ Default を使う。
+package posmap
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+)
+
+// シードの PartOfSpeech ID（seeder.SeedPartOfSpeech と同じ並び）
+const (
+	Noun         = 1  // 名詞
+	Pronoun      = 2  // 代名詞
+	Verb         = 3  // 動詞
+	Adjective    = 4  // 形容詞
+	Adverb       = 5  // 副詞
+	Auxiliary    = 6  // 助動詞
+	Preposition  = 7  // 前置詞
+	Article      = 8  // 冠詞
+	Interjection = 9  // 間投詞
+	Conjunction  = 10 // 接続詞
+	Idiom        = 11 // 慣用句
+	Other        = 12 // その他
+
+	// NumPartOfSpeech はシードされる品詞の数
+	NumPartOfSpeech = 12
+)
+
+// Mapping は 1 つの版の対応表
+type Mapping struct {
+	Version   int            `json:"version"` // 0 は DB に保存されていない既定の対応表
+	Codes     map[string]int `json:"codes"`   // 品詞コード → PartOfSpeech ID
+	Fallback  int            `json:"fallback_pos_id"`
+	Note      string         `json:"note,omitempty"`
+	CreatedBy *int           `json:"created_by,omitempty"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// defaultCodes は JMdict の品詞コード（entity）すべての既定の対応。
+// prep / art は JMdict には無いが、表形式のファイルで前置詞・冠詞を指定するために残している。
+var defaultCodes = map[string]int{
+	// 名詞
+	"n": Noun, "n-adv": Noun, "n-pr": Noun, "n-pref": Noun, "n-suf": Noun, "n-t": Noun, "num": Noun,
+	// 代名詞
+	"pn": Pronoun,
+	// 動詞
+	"v-unspec": Verb, "vi": Verb, "vt": Verb, "vk": Verb, "vn": Verb, "vr": Verb, "vz": Verb,
+	"vs": Verb, "vs-c": Verb, "vs-i": Verb, "vs-s": Verb,
+	"v1": Verb, "v1-s": Verb,
+	"v2a-s": Verb, "v2b-k": Verb, "v2b-s": Verb, "v2d-k": Verb, "v2d-s": Verb, "v2g-k": Verb, "v2g-s": Verb,
+	"v2h-k": Verb, "v2h-s": Verb, "v2k-k": Verb, "v2k-s": Verb, "v2m-k": Verb, "v2m-s": Verb, "v2n-s": Verb,
+	"v2r-k": Verb, "v2r-s": Verb, "v2s-s": Verb, "v2t-k": Verb, "v2t-s": Verb, "v2w-s": Verb, "v2y-k": Verb,
+	"v2y-s": Verb, "v2z-s": Verb,
+	"v4b": Verb, "v4g": Verb, "v4h": Verb, "v4k": Verb, "v4m": Verb, "v4n": Verb, "v4r": Verb, "v4s": Verb, "v4t": Verb,
+	"v5aru": Verb, "v5b": Verb, "v5g": Verb, "v5k": Verb, "v5k-s": Verb, "v5m": Verb, "v5n": Verb, "v5r": Verb,
+	"v5r-i": Verb, "v5s": Verb, "v5t": Verb, "v5u": Verb, "v5u-s": Verb, "v5uru": Verb,
+	// 形容詞
+	"adj-f": Adjective, "adj-i": Adjective, "adj-ix": Adjective, "adj-kari": Adjective, "adj-ku": Adjective,
+	"adj-na": Adjective, "adj-nari": Adjective, "adj-no": Adjective, "adj-pn": Adjective, "adj-shiku": Adjective,
+	"adj-t": Adjective,
+	// 副詞
+	"adv": Adverb, "adv-to": Adverb,
+	// 助動詞
+	"aux": Auxiliary, "aux-v": Auxiliary, "aux-adj": Auxiliary, "cop": Auxiliary,
+	"prep": Preposition,
+	"art":  Article,
+	"int":  Interjection,
+	"conj": Conjunction,
+	"exp":  Idiom,
+	// その他（助詞・接頭辞・接尾辞・助数詞・未分類）
+	"prt": Other, "pref": Other, "suf": Other, "ctr": Other, "unc": Other,
+}
+
+// Default は既定の対応表
+func Default() *Mapping {
+	return &Mapping{Codes: maps.Clone(defaultCodes), Fallback: Other}
+}
+
+// IsKnownCode は既定の対応表にある品詞コードかどうか
+func IsKnownCode(code string) bool {
+	_, ok := defaultCodes[code]
+	return ok
+}
+
+// Resolve はエントリ（Sense）の品詞コードの並びから PartOfSpeech ID を決める。
+// 慣用句（exp）は他の品詞と併記されることが多いため、慣用句以外の最初のコードを優先する。
+// 対応の分かるコードが 1 つも無ければ Fallback。
+func (m *Mapping) Resolve(codes []string) int {
+	first := 0
+	for _, c := range codes {
+		id, ok := m.Codes[c]
+		if !ok {
+			continue
+		}
+		if id != Idiom {
+			return id
+		}
+		if first == 0 {
+			first = id
+		}
+	}
+	if first != 0 {
+		return first
+	}
+	return m.Fallback
+}
+
+// Validate は保存する前に対応表を検査する
+func (m *Mapping) Validate() error {
+	if len(m.Codes) == 0 {
+		return errors.New("codes must not be empty")
+	}
+	if !validPosID(m.Fallback) {
+		return fmt.Errorf("fallback_pos_id must be between 1 and %d", NumPartOfSpeech)
+	}
+	for _, code := range slices.Sorted(maps.Keys(m.Codes)) {
+		if code == "" || strings.ContainsAny(code, " \t\r\n") {
+			return fmt.Errorf("invalid code %q", code)
+		}
+		if !validPosID(m.Codes[code]) {
+			return fmt.Errorf("%s: part of speech id must be between 1 and %d", code, NumPartOfSpeech)
+		}
+	}
+	return nil
+}
+
+func validPosID(id int) bool {
+	return id >= 1 && id <= NumPartOfSpeech
+}
+
+// Change は再分類で品詞が変わる対応
+type Change struct {
+	Code string `json:"code"`
+	From int    `json:"from"`
+	To   int    `json:"to"`
+}
+
+// Diff は m から next への変更点をコード順に返す（対応表から消えたコードは Fallback への変更とみなす）
+func (m *Mapping) Diff(next *Mapping) []Change {
+	codes := slices.Sorted(maps.Keys(m.Codes))
+	for code := range next.Codes {
+		if _, ok := m.Codes[code]; !ok {
+			codes = append(codes, code)
+		}
+	}
+	slices.Sort(codes)
+
+	var out []Change
+	for _, code := range codes {
+		from, to := m.Resolve([]string{code}), next.Resolve([]string{code})
+		if from != to {
+			out = append(out, Change{Code: code, From: from, To: to})
+		}
+	}
+	return out
+}
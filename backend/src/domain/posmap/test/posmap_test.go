@@ -0,0 +1,69 @@
+package posmap_test
+
+import (
+	"testing"
+
+	"word_app/backend/src/domain/posmap"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolve(t *testing.T) {
+	m := posmap.Default()
+	tests := []struct {
+		name  string
+		codes []string
+		want  int
+	}{
+		{"五段動詞", []string{"v5k"}, posmap.Verb},
+		{"サ変は名詞が先なら名詞", []string{"n", "vs"}, posmap.Noun},
+		{"の形容詞", []string{"adj-no"}, posmap.Adjective},
+		{"副詞的名詞", []string{"n-adv"}, posmap.Noun},
+		{"慣用句より他の品詞を優先", []string{"exp", "adj-na"}, posmap.Adjective},
+		{"慣用句だけ", []string{"exp"}, posmap.Idiom},
+		{"接尾辞・助数詞はその他", []string{"suf", "ctr"}, posmap.Other},
+		{"未知のコードは飛ばす", []string{"x-new", "adv"}, posmap.Adverb},
+		{"未知のコードだけなら Fallback", []string{"x-new"}, posmap.Other},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, m.Resolve(tt.codes))
+		})
+	}
+}
+
+func TestDefaultIsCopy(t *testing.T) {
+	m := posmap.Default()
+	m.Codes["v5k"] = posmap.Other
+	assert.Equal(t, posmap.Verb, posmap.Default().Resolve([]string{"v5k"}))
+}
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, posmap.Default().Validate())
+
+	tests := []struct {
+		name string
+		m    posmap.Mapping
+	}{
+		{"空", posmap.Mapping{Fallback: posmap.Other}},
+		{"Fallback が範囲外", posmap.Mapping{Codes: map[string]int{"n": 1}, Fallback: 0}},
+		{"品詞 ID が範囲外", posmap.Mapping{Codes: map[string]int{"n": 13}, Fallback: posmap.Other}},
+		{"空白を含むコード", posmap.Mapping{Codes: map[string]int{"v 5": 3}, Fallback: posmap.Other}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Error(t, tt.m.Validate())
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	prev := &posmap.Mapping{Codes: map[string]int{"n": 1, "ctr": 11, "suf": 11}, Fallback: posmap.Idiom}
+	next := &posmap.Mapping{Codes: map[string]int{"n": 1, "ctr": 12, "v5k": 3}, Fallback: posmap.Other}
+
+	assert.Equal(t, []posmap.Change{
+		{Code: "ctr", From: 11, To: 12},
+		{Code: "suf", From: 11, To: 12}, // 消えたコードは Fallback に
+		{Code: "v5k", From: 11, To: 3},
+	}, prev.Diff(next))
+}
@@ -0,0 +1,15 @@
+package domain
+
+import "time"
+
+// RefreshToken はサーバー側で管理するリフレッシュトークン（本体は持たずハッシュだけ）
+type RefreshToken struct {
+	ID        int
+	UserID    int
+	FamilyID  string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
@@ -1,6 +1,10 @@
 package repository
 
-import "word_app/backend/src/domain"
+import (
+	"time"
+
+	"word_app/backend/src/domain"
+)
 
 type UserListFilter struct {
 	Search string
@@ -21,4 +25,9 @@ type UserUpdateFields struct {
 	PasswordHash *string // 新パスワードのハッシュ（nil=変更なし）
 	// 役割（is_admin）
 	SetAdmin *bool // nil=変更なし、true/falseで変更
+	// メール確認
+	EmailVerifiedAt    *time.Time // 確認済みにする日時（nil=変更なし）
+	ClearEmailVerified bool       // true なら未確認に戻す（メール変更時）
+	// true なら token_version を上げ、発行済みのアクセストークンを無効にする
+	BumpTokenVersion bool
 }
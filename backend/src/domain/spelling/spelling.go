@@ -0,0 +1,104 @@
+// Package spelling は入力式問題の正規化と採点を扱う。
+// 正規化は一括登録のトークン抽出 (usecase/bulk) と同じ規則を使う。
+package spelling
+
+import (
+	"regexp"
+	"strings"
+)
+
+// WordPattern は英字と省略形を 1 語として抽出する。
+// 例: don't -> 1語として扱う
+var WordPattern = regexp.MustCompile(`[A-Za-z]+(?:'[A-Za-z]+)?`)
+
+// 採点結果
+const (
+	GradeCorrect  = "correct"
+	GradeNearMiss = "near_miss"
+	GradeWrong    = "wrong"
+)
+
+// 得点
+const (
+	CreditCorrect  = 1.0
+	CreditNearMiss = 0.5
+	CreditWrong    = 0.0
+)
+
+// 入力されやすい全角・曲がりアポストロフィを ASCII に揃える
+var apostropheReplacer = strings.NewReplacer("’", "'", "‘", "'", "`", "'", "＇", "'")
+
+// Result は 1 問ぶんの採点結果。
+type Result struct {
+	Grade    string
+	Credit   float64
+	Distance int
+}
+
+// Normalize は小文字化し、WordPattern に一致する語だけを空白区切りで連結する。
+func Normalize(s string) string {
+	tokens := WordPattern.FindAllString(apostropheReplacer.Replace(s), -1)
+	for i, t := range tokens {
+		tokens[i] = strings.ToLower(t)
+	}
+	return strings.Join(tokens, " ")
+}
+
+// Grade は入力と正解を正規化して比較する。
+// 編集距離が NearMissDistance 以内なら惜しい (部分点) とする。
+func Grade(typed, answer string) Result {
+	t, a := Normalize(typed), Normalize(answer)
+	if t == "" {
+		return Result{Grade: GradeWrong, Credit: CreditWrong, Distance: len([]rune(a))}
+	}
+	d := Distance(t, a)
+	switch {
+	case d == 0:
+		return Result{Grade: GradeCorrect, Credit: CreditCorrect}
+	case d <= NearMissDistance(a):
+		return Result{Grade: GradeNearMiss, Credit: CreditNearMiss, Distance: d}
+	default:
+		return Result{Grade: GradeWrong, Credit: CreditWrong, Distance: d}
+	}
+}
+
+// NearMissDistance は正解の長さに応じた「惜しい」の許容距離。
+// 短い語は 1 文字違いで別の語になりやすいので部分点を与えない。
+func NearMissDistance(answer string) int {
+	n := len([]rune(answer))
+	switch {
+	case n <= 3:
+		return 0
+	case n <= 7:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Distance は隣接文字の入れ替えを 1 操作とみなす編集距離 (OSA) を返す。
+func Distance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	// 3 行ぶんだけ保持する
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				cur[j] = min(cur[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, cur = prev, cur, prev2
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,57 @@
+package spelling_test
+
+import (
+	"testing"
+
+	"word_app/backend/src/domain/spelling"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Apple", "apple"},
+		{"  don't ", "don't"},
+		{"DON’T", "don't"},
+		{"look  after.", "look after"},
+		{"e-mail", "e mail"},
+		{"123", ""},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, spelling.Normalize(tt.in), tt.in)
+	}
+}
+
+func TestDistance(t *testing.T) {
+	assert.Equal(t, 0, spelling.Distance("apple", "apple"))
+	assert.Equal(t, 1, spelling.Distance("aple", "apple"))
+	assert.Equal(t, 1, spelling.Distance("appel", "apple"), "隣接入れ替えは 1")
+	assert.Equal(t, 3, spelling.Distance("", "abc"))
+	assert.Equal(t, 2, spelling.Distance("recieve", "receiver"))
+}
+
+func TestGrade(t *testing.T) {
+	tests := []struct {
+		name         string
+		typed, answr string
+		want         string
+		credit       float64
+	}{
+		{"完全一致", "Apple", "apple", spelling.GradeCorrect, 1},
+		{"アポストロフィ違い", "don’t", "don't", spelling.GradeCorrect, 1},
+		{"1文字違い", "aple", "apple", spelling.GradeNearMiss, 0.5},
+		{"長い語は2文字まで", "acommodation", "accommodation", spelling.GradeNearMiss, 0.5},
+		{"短い語は部分点なし", "cut", "cat", spelling.GradeWrong, 0},
+		{"大きく違う", "banana", "apple", spelling.GradeWrong, 0},
+		{"空入力", "", "apple", spelling.GradeWrong, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := spelling.Grade(tt.typed, tt.answr)
+			assert.Equal(t, tt.want, got.Grade)
+			assert.InDelta(t, tt.credit, got.Credit, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package srs
+
+import "math"
+
+// FSRS は Free Spaced Repetition Scheduler (v4.5) の実装。
+// 重みは公開されている既定値を使い、目標保持率は RequestRetention で指定する。
+type FSRS struct {
+	W                [17]float64
+	RequestRetention float64
+}
+
+// fsrsDefaultWeights は FSRS-4.5 の既定パラメータ。
+var fsrsDefaultWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206,
+	5.1618, 1.2298, 0.8975, 0.031,
+	1.6474, 0.1367, 1.0461, 2.1072,
+	0.0793, 0.3246, 1.587, 0.2272, 2.8755,
+}
+
+const (
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81.0
+
+	minDifficulty = 1.0
+	maxDifficulty = 10.0
+	minStability  = 0.01
+)
+
+// FSRS の評価 (Again / Hard / Good / Easy)
+type rating int
+
+const (
+	ratingAgain rating = 1
+	ratingHard  rating = 2
+	ratingGood  rating = 3
+	ratingEasy  rating = 4
+)
+
+func NewFSRS() *FSRS {
+	return &FSRS{W: fsrsDefaultWeights, RequestRetention: 0.9}
+}
+
+func (*FSRS) Name() string { return AlgorithmFSRS }
+
+func (f *FSRS) Next(prev State, r Review) State {
+	next := prev
+	g := ratingOf(GradeOf(r))
+
+	if prev.Stability <= 0 {
+		// 初回レビュー
+		next.Stability = math.Max(f.W[g-1], minStability)
+		next.Difficulty = f.initDifficulty(g)
+	} else {
+		retr := f.retrievability(elapsedDays(prev, r.ReviewedAt), prev.Stability)
+		next.Difficulty = f.nextDifficulty(prev.Difficulty, g)
+		if g == ratingAgain {
+			next.Stability = f.forgetStability(prev.Difficulty, prev.Stability, retr)
+		} else {
+			next.Stability = f.recallStability(prev.Difficulty, prev.Stability, retr, g)
+		}
+	}
+
+	if g == ratingAgain {
+		next.Repetitions = 0
+		if prev.Repetitions > 0 || prev.LastReviewedAt != nil {
+			next.Lapses = prev.Lapses + 1
+		}
+		next.IntervalDays = 1
+	} else {
+		next.Repetitions = prev.Repetitions + 1
+		next.IntervalDays = f.interval(next.Stability)
+	}
+
+	reviewedAt := r.ReviewedAt
+	next.LastReviewedAt = &reviewedAt
+	next.DueAt = dueAfter(reviewedAt, next.IntervalDays)
+	return next
+}
+
+func ratingOf(q Grade) rating {
+	switch {
+	case q >= GradeEasy:
+		return ratingEasy
+	case q >= GradeGood:
+		return ratingGood
+	case q >= GradeHard:
+		return ratingHard
+	default:
+		return ratingAgain
+	}
+}
+
+func (f *FSRS) retrievability(elapsed, stability float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsed/stability, fsrsDecay)
+}
+
+func (f *FSRS) interval(stability float64) int {
+	return clampInterval(stability / fsrsFactor * (math.Pow(f.RequestRetention, 1/fsrsDecay) - 1))
+}
+
+func (f *FSRS) initDifficulty(g rating) float64 {
+	return clampDifficulty(f.W[4] - float64(g-ratingGood)*f.W[5])
+}
+
+func (f *FSRS) nextDifficulty(d float64, g rating) float64 {
+	nd := d - f.W[6]*float64(g-ratingGood)
+	// 平均回帰 (Good 初期値へ寄せる)
+	return clampDifficulty(f.W[7]*f.initDifficulty(ratingGood) + (1-f.W[7])*nd)
+}
+
+func (f *FSRS) recallStability(d, s, retr float64, g rating) float64 {
+	hardPenalty, easyBonus := 1.0, 1.0
+	if g == ratingHard {
+		hardPenalty = f.W[15]
+	}
+	if g == ratingEasy {
+		easyBonus = f.W[16]
+	}
+	return s * (1 + math.Exp(f.W[8])*
+		(11-d)*
+		math.Pow(s, -f.W[9])*
+		(math.Exp((1-retr)*f.W[10])-1)*
+		hardPenalty*easyBonus)
+}
+
+func (f *FSRS) forgetStability(d, s, retr float64) float64 {
+	ns := f.W[11] *
+		math.Pow(d, -f.W[12]) *
+		(math.Pow(s+1, f.W[13]) - 1) *
+		math.Exp((1-retr)*f.W[14])
+	return math.Max(math.Min(ns, s), minStability)
+}
+
+func clampDifficulty(d float64) float64 {
+	return math.Min(math.Max(d, minDifficulty), maxDifficulty)
+}
@@ -0,0 +1,137 @@
+// Package srs は RegisteredWord の間隔反復 (Spaced Repetition) スケジューリングを扱う。
+// アルゴリズムは Scheduler インターフェースの実装として差し替え可能で、
+// ユーザーごとに UserConfig.srs_algorithm で選択される。
+package srs
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	AlgorithmSM2  = "sm2"
+	AlgorithmFSRS = "fsrs"
+
+	DefaultAlgorithm = AlgorithmSM2
+
+	// 初期 ease factor (SM-2)
+	DefaultEaseFactor = 2.5
+	minEaseFactor     = 1.3
+
+	maxIntervalDays = 36500
+)
+
+// State は RegisteredWord に永続化される復習スケジュールの状態。
+// SM-2 は EaseFactor、FSRS は Stability/Difficulty を主に使うが、
+// どちらのアルゴリズムでも全フィールドを保持しておき切替時に引き継げるようにする。
+type State struct {
+	EaseFactor     float64
+	IntervalDays   int
+	Repetitions    int // 連続正解回数（失敗で 0 に戻る）
+	Lapses         int // 忘却回数
+	Stability      float64
+	Difficulty     float64
+	DueAt          *time.Time
+	LastReviewedAt *time.Time
+}
+
+// Review は 1 問ぶんの回答結果。
+type Review struct {
+	Answered   bool
+	IsCorrect  bool
+	NearMiss   bool // 入力式で惜しい誤り（部分点）
+	TimeMs     int  // 0 以下は計測なし
+	ReviewedAt time.Time
+}
+
+// Grade は SM-2 の quality (0-5)。
+type Grade int
+
+const (
+	GradeBlackout Grade = 0 // 未回答
+	GradeWrong    Grade = 1
+	GradeHard     Grade = 3
+	GradeGood     Grade = 4
+	GradeEasy     Grade = 5
+)
+
+// 回答時間による正解の重み付け境界
+const (
+	easyThresholdMs = 3000
+	hardThresholdMs = 10000
+)
+
+// GradeOf は正誤と回答時間から quality を決める。
+func GradeOf(r Review) Grade {
+	switch {
+	case !r.Answered:
+		return GradeBlackout
+	case !r.IsCorrect && r.NearMiss:
+		// 思い出せてはいるので合格の下限として扱う
+		return GradeHard
+	case !r.IsCorrect:
+		return GradeWrong
+	case r.TimeMs <= 0:
+		return GradeGood
+	case r.TimeMs <= easyThresholdMs:
+		return GradeEasy
+	case r.TimeMs <= hardThresholdMs:
+		return GradeGood
+	default:
+		return GradeHard
+	}
+}
+
+// Scheduler は前回の状態と今回の回答から次の状態を計算する。
+type Scheduler interface {
+	Name() string
+	Next(prev State, r Review) State
+}
+
+// New はアルゴリズム名から Scheduler を返す。未知の名前は SM-2 にフォールバックする。
+func New(algorithm string) Scheduler {
+	switch algorithm {
+	case AlgorithmFSRS:
+		return NewFSRS()
+	default:
+		return NewSM2()
+	}
+}
+
+// IsValidAlgorithm は UserConfig に保存可能なアルゴリズム名かどうかを返す。
+func IsValidAlgorithm(algorithm string) bool {
+	return algorithm == AlgorithmSM2 || algorithm == AlgorithmFSRS
+}
+
+// IsDue は基準時刻で復習期限が来ているかを返す。未スケジュールは期限切れ扱い。
+func (s State) IsDue(now time.Time) bool {
+	return s.DueAt == nil || !s.DueAt.After(now)
+}
+
+func dueAfter(t time.Time, days int) *time.Time {
+	d := t.AddDate(0, 0, days)
+	return &d
+}
+
+func clampInterval(days float64) int {
+	n := int(math.Round(days))
+	if n < 1 {
+		return 1
+	}
+	if n > maxIntervalDays {
+		return maxIntervalDays
+	}
+	return n
+}
+
+// elapsedDays は前回復習からの経過日数 (小数) を返す。
+func elapsedDays(prev State, now time.Time) float64 {
+	if prev.LastReviewedAt == nil {
+		return 0
+	}
+	d := now.Sub(*prev.LastReviewedAt).Hours() / 24
+	if d < 0 {
+		return 0
+	}
+	return d
+}
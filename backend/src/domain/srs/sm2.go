@@ -0,0 +1,49 @@
+package srs
+
+// SM2 は SuperMemo-2 アルゴリズムの実装。
+type SM2 struct{}
+
+func NewSM2() *SM2 { return &SM2{} }
+
+func (*SM2) Name() string { return AlgorithmSM2 }
+
+func (*SM2) Next(prev State, r Review) State {
+	next := prev
+	q := GradeOf(r)
+
+	ef := prev.EaseFactor
+	if ef <= 0 {
+		ef = DefaultEaseFactor
+	}
+
+	if q < GradeHard {
+		// 失敗: 連続正解をリセットして翌日に再出題
+		next.Repetitions = 0
+		next.IntervalDays = 1
+		if prev.Repetitions > 0 || prev.LastReviewedAt != nil {
+			next.Lapses = prev.Lapses + 1
+		}
+	} else {
+		next.Repetitions = prev.Repetitions + 1
+		switch next.Repetitions {
+		case 1:
+			next.IntervalDays = 1
+		case 2:
+			next.IntervalDays = 6
+		default:
+			next.IntervalDays = clampInterval(float64(prev.IntervalDays) * ef)
+		}
+	}
+
+	diff := float64(GradeEasy - q)
+	ef += 0.1 - diff*(0.08+diff*0.02)
+	if ef < minEaseFactor {
+		ef = minEaseFactor
+	}
+	next.EaseFactor = ef
+
+	reviewedAt := r.ReviewedAt
+	next.LastReviewedAt = &reviewedAt
+	next.DueAt = dueAfter(reviewedAt, next.IntervalDays)
+	return next
+}
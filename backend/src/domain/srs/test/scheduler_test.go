@@ -0,0 +1,125 @@
+package srs_test
+
+import (
+	"testing"
+	"time"
+
+	"word_app/backend/src/domain/srs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var base = time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+func review(correct bool, timeMs int, at time.Time) srs.Review {
+	return srs.Review{Answered: true, IsCorrect: correct, TimeMs: timeMs, ReviewedAt: at}
+}
+
+func TestGradeOf(t *testing.T) {
+	tests := []struct {
+		name string
+		in   srs.Review
+		want srs.Grade
+	}{
+		{"未回答", srs.Review{}, srs.GradeBlackout},
+		{"不正解", review(false, 1000, base), srs.GradeWrong},
+		{"惜しい", srs.Review{Answered: true, NearMiss: true, TimeMs: 1000, ReviewedAt: base}, srs.GradeHard},
+		{"正解・即答", review(true, 2000, base), srs.GradeEasy},
+		{"正解・通常", review(true, 6000, base), srs.GradeGood},
+		{"正解・遅い", review(true, 20000, base), srs.GradeHard},
+		{"正解・時間不明", review(true, 0, base), srs.GradeGood},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, srs.GradeOf(tt.in))
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	assert.Equal(t, srs.AlgorithmSM2, srs.New("sm2").Name())
+	assert.Equal(t, srs.AlgorithmFSRS, srs.New("fsrs").Name())
+	assert.Equal(t, srs.AlgorithmSM2, srs.New("unknown").Name(), "未知はSM-2")
+	assert.True(t, srs.IsValidAlgorithm("fsrs"))
+	assert.False(t, srs.IsValidAlgorithm(""))
+}
+
+func TestSM2_Next(t *testing.T) {
+	sched := srs.NewSM2()
+
+	t.Run("連続正解で 1 → 6 → EF 倍に伸びる", func(t *testing.T) {
+		st := srs.State{EaseFactor: srs.DefaultEaseFactor}
+
+		st = sched.Next(st, review(true, 6000, base))
+		assert.Equal(t, 1, st.Repetitions)
+		assert.Equal(t, 1, st.IntervalDays)
+		require.NotNil(t, st.DueAt)
+		assert.Equal(t, base.AddDate(0, 0, 1), *st.DueAt)
+
+		st = sched.Next(st, review(true, 6000, base.AddDate(0, 0, 1)))
+		assert.Equal(t, 2, st.Repetitions)
+		assert.Equal(t, 6, st.IntervalDays)
+
+		st = sched.Next(st, review(true, 6000, base.AddDate(0, 0, 7)))
+		assert.Equal(t, 3, st.Repetitions)
+		assert.Equal(t, 15, st.IntervalDays) // 6 * 2.5
+		assert.InDelta(t, srs.DefaultEaseFactor, st.EaseFactor, 1e-9)
+	})
+
+	t.Run("不正解でリセットし EF が下がる", func(t *testing.T) {
+		last := base.AddDate(0, 0, -6)
+		prev := srs.State{EaseFactor: 2.5, IntervalDays: 6, Repetitions: 2, LastReviewedAt: &last}
+
+		st := sched.Next(prev, review(false, 3000, base))
+		assert.Equal(t, 0, st.Repetitions)
+		assert.Equal(t, 1, st.IntervalDays)
+		assert.Equal(t, 1, st.Lapses)
+		assert.InDelta(t, 1.96, st.EaseFactor, 1e-9)
+		assert.Equal(t, base, *st.LastReviewedAt)
+	})
+
+	t.Run("EF は 1.3 を下回らない", func(t *testing.T) {
+		st := srs.State{EaseFactor: 1.3}
+		st = sched.Next(st, srs.Review{ReviewedAt: base})
+		assert.InDelta(t, 1.3, st.EaseFactor, 1e-9)
+	})
+}
+
+func TestFSRS_Next(t *testing.T) {
+	sched := srs.NewFSRS()
+
+	t.Run("初回 Good は既定 stability", func(t *testing.T) {
+		st := sched.Next(srs.State{}, review(true, 6000, base))
+		assert.InDelta(t, 3.7145, st.Stability, 1e-9)
+		assert.Equal(t, 4, st.IntervalDays)
+		assert.Equal(t, 1, st.Repetitions)
+		assert.GreaterOrEqual(t, st.Difficulty, 1.0)
+		assert.LessOrEqual(t, st.Difficulty, 10.0)
+	})
+
+	t.Run("期限どおりの正解で間隔が伸びる", func(t *testing.T) {
+		st := sched.Next(srs.State{}, review(true, 6000, base))
+		next := sched.Next(st, review(true, 6000, *st.DueAt))
+		assert.Greater(t, next.Stability, st.Stability)
+		assert.Greater(t, next.IntervalDays, st.IntervalDays)
+	})
+
+	t.Run("忘却で stability が下がり lapse が増える", func(t *testing.T) {
+		st := sched.Next(srs.State{}, review(true, 6000, base))
+		st = sched.Next(st, review(true, 6000, *st.DueAt))
+		failed := sched.Next(st, review(false, 6000, *st.DueAt))
+		assert.Less(t, failed.Stability, st.Stability)
+		assert.Greater(t, failed.Difficulty, st.Difficulty)
+		assert.Equal(t, 1, failed.Lapses)
+		assert.Equal(t, 0, failed.Repetitions)
+		assert.Equal(t, 1, failed.IntervalDays)
+	})
+}
+
+func TestState_IsDue(t *testing.T) {
+	assert.True(t, srs.State{}.IsDue(base), "未スケジュールは期限切れ扱い")
+	future := base.Add(time.Hour)
+	assert.False(t, srs.State{DueAt: &future}.IsDue(base))
+	assert.True(t, srs.State{DueAt: &base}.IsDue(base))
+}
@@ -0,0 +1,78 @@
+// Package stats は学習履歴の集計（正答率・連続学習日数）を扱う。
+// 日付はすべて JST の 0:00 に丸めた time.Time で受け渡す。
+package stats
+
+import (
+	"sort"
+	"time"
+)
+
+// Tally は回答数・正答数・得点の集計
+type Tally struct {
+	Answered int
+	Correct  int
+	Credit   float64 // 入力式の「惜しい」は部分点として含める
+}
+
+func (t *Tally) Add(correct bool, credit float64) {
+	t.Answered++
+	if correct {
+		t.Correct++
+	}
+	t.Credit += credit
+}
+
+// Accuracy は得点ベースの正答率 (0-100)。クイズ結果の正答率と同じ定義。
+func (t Tally) Accuracy() float64 {
+	if t.Answered == 0 {
+		return 0
+	}
+	return t.Credit * 100 / float64(t.Answered)
+}
+
+// WeekStart は day を含む週の月曜日
+func WeekStart(day time.Time) time.Time {
+	offset := (int(day.Weekday()) + 6) % 7 // 月曜=0
+	return day.AddDate(0, 0, -offset)
+}
+
+// Days は from から to までの日付を 1 日ずつ返す（両端を含む）
+func Days(from, to time.Time) []time.Time {
+	var out []time.Time
+	for d := from; !d.After(to); d = d.AddDate(0, 0, 1) {
+		out = append(out, d)
+	}
+	return out
+}
+
+// Streaks は学習した日付から現在と最長の連続学習日数を返す。
+// 今日まだ学習していなくても、昨日まで続いていれば現在の連続は途切れていないとみなす。
+func Streaks(studyDays []time.Time, today time.Time) (current, longest int) {
+	if len(studyDays) == 0 {
+		return 0, 0
+	}
+	days := make([]time.Time, len(studyDays))
+	copy(days, studyDays)
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+	run := 0
+	var prev time.Time
+	for i, d := range days {
+		switch {
+		case i > 0 && d.Equal(prev):
+			continue
+		case i > 0 && d.Equal(prev.AddDate(0, 0, 1)):
+			run++
+		default:
+			run = 1
+		}
+		prev = d
+		longest = max(longest, run)
+	}
+
+	// 最後の学習日が今日か昨日なら、その連続が現在の連続
+	if prev.Equal(today) || prev.Equal(today.AddDate(0, 0, -1)) {
+		current = run
+	}
+	return current, longest
+}
@@ -0,0 +1,66 @@
+package stats_test
+
+import (
+	"testing"
+	"time"
+
+	"word_app/backend/src/domain/stats"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+func day(m time.Month, d int) time.Time {
+	return time.Date(2025, m, d, 0, 0, 0, 0, jst)
+}
+
+func TestStreaks(t *testing.T) {
+	today := day(4, 10)
+	tests := []struct {
+		name             string
+		days             []time.Time
+		current, longest int
+	}{
+		{"履歴なし", nil, 0, 0},
+		{"今日まで 3 日連続", []time.Time{day(4, 8), day(4, 9), day(4, 10)}, 3, 3},
+		{"昨日まで続いていれば継続中", []time.Time{day(4, 8), day(4, 9)}, 2, 2},
+		{"一昨日で途切れた", []time.Time{day(4, 7), day(4, 8)}, 0, 2},
+		{
+			"同じ日の複数回答と順不同",
+			[]time.Time{day(4, 10), day(4, 1), day(4, 2), day(4, 3), day(4, 10), day(4, 9), day(4, 2)},
+			2, 3,
+		},
+		{"月をまたぐ", []time.Time{day(3, 30), day(3, 31), day(4, 1)}, 0, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			current, longest := stats.Streaks(tt.days, today)
+			assert.Equal(t, tt.current, current)
+			assert.Equal(t, tt.longest, longest)
+		})
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	assert.Equal(t, day(4, 7), stats.WeekStart(day(4, 7)), "月曜はそのまま")
+	assert.Equal(t, day(4, 7), stats.WeekStart(day(4, 13)), "日曜は前の月曜")
+	assert.Equal(t, day(3, 31), stats.WeekStart(day(4, 2)))
+}
+
+func TestTally(t *testing.T) {
+	var tl stats.Tally
+	assert.Zero(t, tl.Accuracy())
+	tl.Add(true, 1)
+	tl.Add(false, 0.5) // near_miss
+	tl.Add(false, 0)
+	tl.Add(true, 1)
+	assert.Equal(t, 4, tl.Answered)
+	assert.Equal(t, 2, tl.Correct)
+	assert.InDelta(t, 62.5, tl.Accuracy(), 0.001)
+}
+
+func TestDays(t *testing.T) {
+	assert.Len(t, stats.Days(day(3, 30), day(4, 2)), 4)
+	assert.Empty(t, stats.Days(day(4, 2), day(4, 1)))
+}
@@ -20,6 +20,11 @@ type User struct {
 	HasPassword bool
 	HasLine     bool
 
+	// メール確認済みの日時（未確認は nil）
+	EmailVerifiedAt *time.Time
+	// アクセストークンの ver と照合する
+	TokenVersion int
+
 	CreatedAt time.Time
 	UpdatedAt time.Time
 	DeletedAt *time.Time
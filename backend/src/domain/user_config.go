@@ -1,7 +1,8 @@
 package domain
 
 type UserConfig struct {
-	ID         int
-	UserID     int
-	IsDarkMode bool `json:"is_dark_mode"` // true=ダーク・false=ライト
+	ID           int
+	UserID       int
+	IsDarkMode   bool   `json:"is_dark_mode"`  // true=ダーク・false=ライト
+	SrsAlgorithm string `json:"srs_algorithm"` // 復習スケジューラ: sm2 | fsrs
 }
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 提案の種類
+const (
+	ProposalActionCreate = "create"
+	ProposalActionUpdate = "update"
+	ProposalActionDelete = "delete"
+)
+
+// 提案の状態
+const (
+	ProposalStatusPending  = "pending"
+	ProposalStatusApproved = "approved"
+	ProposalStatusRejected = "rejected"
+)
+
+// WordProposal は一般ユーザーからの単語マスタ変更の提案
+type WordProposal struct {
+	ID         int             `json:"id"`
+	UserID     int             `json:"userID"`
+	Action     string          `json:"action"`
+	WordID     *int            `json:"wordID"` // create は承認後に作成された Word
+	WordName   string          `json:"wordName"`
+	Payload    json.RawMessage `json:"payload"` // 提案内容（Action ごとのリクエスト）
+	Status     string          `json:"status"`
+	ReviewerID *int            `json:"reviewerID"`
+	ReviewNote string          `json:"reviewNote"`
+	CreatedAt  time.Time       `json:"createdAt"`
+	ReviewedAt *time.Time      `json:"reviewedAt"`
+}
+
+// IsValidProposalStatus は状態の値が正しいか
+func IsValidProposalStatus(s string) bool {
+	switch s {
+	case ProposalStatusPending, ProposalStatusApproved, ProposalStatusRejected:
+		return true
+	}
+	return false
+}
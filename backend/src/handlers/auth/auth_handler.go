@@ -27,9 +27,10 @@ func NewHandler(
 }
 
 type Handler interface {
-	LineLogin() gin.HandlerFunc
-	LineCallback() gin.HandlerFunc
-	LineComplete() gin.HandlerFunc
+	ListProvidersHandler() gin.HandlerFunc
+	ProviderLogin() gin.HandlerFunc
+	ProviderCallback() gin.HandlerFunc
+	ProviderComplete() gin.HandlerFunc
 	TestLoginHandler() gin.HandlerFunc
 	TestLogoutHandler() gin.HandlerFunc
 	AuthMeHandler() gin.HandlerFunc
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"crypto/subtle"
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/utils/oauthutil"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ソーシャルログイン。:provider はレジストリに登録された名前（line / google / github など）。
+
+func (h *AuthHandler) ListProvidersHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"providers": h.AuthUsecase.Providers()})
+	}
+}
+
+func (h *AuthHandler) ProviderLogin() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		state, err := oauthutil.NewState(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		nonce, err := oauthutil.NewNonce(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+
+		ctx := c.Request.Context()
+
+		url, err := h.AuthUsecase.StartLogin(ctx, c.Param("provider"), state, nonce)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.Redirect(http.StatusFound, url)
+	}
+}
+
+func (h *AuthHandler) ProviderCallback() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		code := c.Query("code")
+		// ログイン開始時に cookie に置いた state と一致しなければ、別のブラウザで始めたログイン（CSRF）とみなす
+		state := oauthutil.LoadState(c)
+		nonce := oauthutil.LoadNonce(c)
+		if state == "" || subtle.ConstantTimeCompare([]byte(state), []byte(c.Query("state"))) != 1 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid state"})
+			return
+		}
+
+		res, err := h.AuthUsecase.HandleCallback(ctx, c.Param("provider"), code, nonce)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, res)
+	}
+}
+
+func (h *AuthHandler) ProviderComplete() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		var req struct {
+			TempToken string  `json:"temp_token"`
+			Password  *string `json:"password"`
+		}
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		pair, err := h.AuthUsecase.CompleteSignUp(ctx, c.Param("provider"), req.TempToken, req.Password)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{
+			"token":         pair.AccessToken,
+			"refresh_token": pair.RefreshToken,
+			"expires_in":    pair.ExpiresIn,
+		})
+	}
+}
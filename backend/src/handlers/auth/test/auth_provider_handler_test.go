@@ -0,0 +1,242 @@
+// handler_test.go
+package auth_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"word_app/backend/config"
+	auth_handler "word_app/backend/src/handlers/auth"
+	jwt_mock "word_app/backend/src/mocks/infrastructure/jwt"
+	auth_mock "word_app/backend/src/mocks/usecase/auth"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/auth"
+	"word_app/backend/src/usecase/session"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// newProviderRouter は :provider を解決できるようにルーター経由で呼ぶ
+func newProviderRouter(uc *auth_mock.MockUsecase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	h := auth_handler.NewHandler(uc, new(jwt_mock.MockJWTGenerator), &config.Config{})
+	r := gin.New()
+	r.GET("/users/auth/providers", h.ListProvidersHandler())
+	r.GET("/users/auth/:provider/login", h.ProviderLogin())
+	r.GET("/users/auth/:provider/callback", h.ProviderCallback())
+	r.POST("/users/auth/:provider/complete", h.ProviderComplete())
+	return r
+}
+
+func TestAuthProviderHandler(t *testing.T) {
+	t.Run("TestListProviders", func(t *testing.T) {
+		mockUC := new(auth_mock.MockUsecase)
+		mockUC.On("Providers").Return([]string{"github", "google", "line"})
+
+		w := httptest.NewRecorder()
+		newProviderRouter(mockUC).ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/auth/providers", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t, `{"providers":["github","google","line"]}`, w.Body.String())
+	})
+
+	t.Run("TestProviderLogin", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			provider    string
+			redirectURL string
+			mockErr     error
+			wantStatus  int
+			wantHeader  string
+		}{
+			{"redirect_success", "line", "https://line.me/oauth", nil, http.StatusFound, "https://line.me/oauth"},
+			{"redirect_success_google", "google", "https://accounts.google.com/o/oauth2/v2/auth", nil,
+				http.StatusFound, "https://accounts.google.com/o/oauth2/v2/auth"},
+			{"unknown_provider", "nope", "", apperror.NotFoundf("unknown auth provider", nil), http.StatusNotFound, ""},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockUC := new(auth_mock.MockUsecase)
+				mockUC.
+					On("StartLogin", mock.Anything, tt.provider, mock.AnythingOfType("string"), mock.AnythingOfType("string")).
+					Return(tt.redirectURL, tt.mockErr)
+
+				w := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodGet, "/users/auth/"+tt.provider+"/login", nil)
+				newProviderRouter(mockUC).ServeHTTP(w, req)
+
+				assert.Equal(t, tt.wantStatus, w.Code)
+				assert.Equal(t, tt.wantHeader, w.Header().Get("Location"))
+				mockUC.AssertExpectations(t)
+
+				// コールバックで照合できるよう、渡した state / nonce を cookie にも置く
+				cookies := map[string]string{}
+				for _, ck := range w.Result().Cookies() {
+					cookies[ck.Name] = ck.Value
+				}
+				call := mockUC.Calls[0]
+				assert.Equal(t, call.Arguments.String(2), cookies["oauth_state"])
+				assert.Equal(t, call.Arguments.String(3), cookies["oauth_nonce"])
+			})
+		}
+	})
+
+	t.Run("TestProviderCallback", func(t *testing.T) {
+
+		successRes := &auth.CallbackResult{Token: "jwt123"}
+		usecaseErr := errors.New("db error")
+		// ログイン開始時に置かれる cookie
+		started := []*http.Cookie{
+			{Name: "oauth_state", Value: "xyz"},
+			{Name: "oauth_nonce", Value: "n1"},
+		}
+
+		tests := []struct {
+			name           string
+			query          string
+			cookies        []*http.Cookie
+			callUsecase    bool
+			mockReturn     *auth.CallbackResult
+			mockErr        error
+			wantStatusCode int
+			wantContains   string
+		}{
+			{
+				name:           "success",
+				query:          "?code=abc&state=xyz",
+				cookies:        started,
+				callUsecase:    true,
+				mockReturn:     successRes,
+				mockErr:        nil,
+				wantStatusCode: http.StatusOK,
+				wantContains:   `"token":"jwt123"`,
+			},
+			{
+				name:           "handle_callback_error",
+				query:          "?code=abc&state=xyz",
+				cookies:        started,
+				callUsecase:    true,
+				mockReturn:     nil,
+				mockErr:        usecaseErr,
+				wantStatusCode: http.StatusInternalServerError,
+				wantContains:   `error`,
+			},
+			{
+				name:           "nonce_mismatch",
+				query:          "?code=abc&state=xyz",
+				cookies:        started,
+				callUsecase:    true,
+				mockErr:        apperror.Unauthorizedf("invalid nonce", nil),
+				wantStatusCode: http.StatusUnauthorized,
+				wantContains:   `invalid nonce`,
+			},
+			{
+				name:           "state_mismatch",
+				query:          "?code=abc&state=forged",
+				cookies:        started,
+				wantStatusCode: http.StatusBadRequest,
+				wantContains:   `invalid state`,
+			},
+			{
+				name:           "no_state_cookie",
+				query:          "?code=abc&state=",
+				wantStatusCode: http.StatusBadRequest,
+				wantContains:   `invalid state`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockUC := new(auth_mock.MockUsecase)
+				if tt.callUsecase {
+					mockUC.
+						On("HandleCallback", mock.Anything, "line", "abc", "n1").
+						Return(tt.mockReturn, tt.mockErr)
+				}
+
+				w := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodGet, "/users/auth/line/callback"+tt.query, nil)
+				for _, ck := range tt.cookies {
+					req.AddCookie(ck)
+				}
+				newProviderRouter(mockUC).ServeHTTP(w, req)
+
+				assert.Equal(t, tt.wantStatusCode, w.Code)
+				assert.Contains(t, w.Body.String(), tt.wantContains)
+				mockUC.AssertExpectations(t)
+			})
+		}
+	})
+
+	t.Run("TestProviderComplete", func(t *testing.T) {
+
+		type body struct {
+			TempToken string `json:"temp_token"`
+			Password  string `json:"password"`
+		}
+		validBody, _ := json.Marshal(body{TempToken: "tmp123", Password: "pass1"})
+		invalidJSON := []byte(`{"temp_token":1`) // bind エラー用
+
+		completeErr := errors.New("complete signup failed")
+
+		tests := []struct {
+			name           string
+			requestBody    []byte
+			mockPair       *session.TokenPair
+			mockErr        error
+			wantStatusCode int
+			wantContains   string
+		}{
+			{
+				name:           "success",
+				requestBody:    validBody,
+				mockPair:       &session.TokenPair{AccessToken: "jwt456", RefreshToken: "rt456", ExpiresIn: 900},
+				mockErr:        nil,
+				wantStatusCode: http.StatusOK,
+				wantContains:   `"refresh_token":"rt456","token":"jwt456"`,
+			},
+			{
+				name:           "json_bind_error",
+				requestBody:    invalidJSON,
+				wantStatusCode: http.StatusBadRequest,
+				wantContains:   `error`,
+			},
+			{
+				name:           "complete_signup_error",
+				requestBody:    validBody,
+				mockPair:       nil,
+				mockErr:        completeErr,
+				wantStatusCode: http.StatusInternalServerError,
+				wantContains:   `error`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				mockUC := new(auth_mock.MockUsecase)
+				// Bind エラーケースでは CompleteSignUp は呼ばれない想定
+				if tt.name != "json_bind_error" {
+					pass := "pass1"
+					mockUC.
+						On("CompleteSignUp", mock.Anything, "line", "tmp123", &pass).
+						Return(tt.mockPair, tt.mockErr)
+				}
+
+				w := httptest.NewRecorder()
+				req := httptest.NewRequest(http.MethodPost, "/users/auth/line/complete", bytes.NewReader(tt.requestBody))
+				req.Header.Set("Content-Type", "application/json")
+				newProviderRouter(mockUC).ServeHTTP(w, req)
+
+				assert.Equal(t, tt.wantStatusCode, w.Code)
+				assert.Contains(t, w.Body.String(), tt.wantContains)
+				mockUC.AssertExpectations(t)
+			})
+		}
+	})
+}
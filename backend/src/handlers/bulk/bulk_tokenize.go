@@ -33,11 +33,11 @@ func (h *BulkHandler) TokenizeHandler() gin.HandlerFunc {
 			return
 		}
 
-		cands, regs, notExist, err := h.tokenizeUsecase.Execute(ctx, userID, req.Text)
+		cands, regs, notExist, lemmas, err := h.tokenizeUsecase.Execute(ctx, userID, req.Text)
 		if err != nil {
 			httperr.Write(c, err) // apperrorをそのまま返す（429も含む）
 			return
 		}
-		c.JSON(http.StatusOK, models.BulkTokenizeResponse{Candidates: cands, Registered: regs, NotExistWord: notExist})
+		c.JSON(http.StatusOK, models.BulkTokenizeResponse{Candidates: cands, Registered: regs, NotExistWord: notExist, Lemmas: lemmas})
 	})
 }
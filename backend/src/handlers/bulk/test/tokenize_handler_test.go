@@ -34,7 +34,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 		reqBody, _ := json.Marshal(req)
 
 		tu.On("Execute", mock.Anything, 1, req.Text).
-			Return([]string{"hello", "world"}, []string{}, []string{"test"}, nil)
+			Return([]string{"hello", "world"}, []string{}, []string{"test"}, nil, nil)
 
 		w := httptest.NewRecorder()
 		httpReq := httptest.NewRequest(http.MethodPost, "/bulk/tokenize", bytes.NewBuffer(reqBody))
@@ -117,7 +117,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 		reqBody, _ := json.Marshal(req)
 
 		tu.On("Execute", mock.Anything, 1, "").
-			Return([]string{}, []string{}, []string{}, nil)
+			Return([]string{}, []string{}, []string{}, nil, nil)
 
 		w := httptest.NewRecorder()
 		httpReq := httptest.NewRequest(http.MethodPost, "/bulk/tokenize", bytes.NewBuffer(reqBody))
@@ -148,7 +148,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 		reqBody, _ := json.Marshal(req)
 
 		tu.On("Execute", mock.Anything, 1, "Hello world").
-			Return(nil, nil, nil, apperror.TooManyRequestsf("quota exceeded", nil))
+			Return(nil, nil, nil, nil, apperror.TooManyRequestsf("quota exceeded", nil))
 
 		w := httptest.NewRecorder()
 		httpReq := httptest.NewRequest(http.MethodPost, "/bulk/tokenize", bytes.NewBuffer(reqBody))
@@ -175,7 +175,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 		reqBody, _ := json.Marshal(req)
 
 		tu.On("Execute", mock.Anything, 1, "Hello world").
-			Return(nil, nil, nil, apperror.Internalf("database error", nil))
+			Return(nil, nil, nil, nil, apperror.Internalf("database error", nil))
 
 		w := httptest.NewRecorder()
 		httpReq := httptest.NewRequest(http.MethodPost, "/bulk/tokenize", bytes.NewBuffer(reqBody))
@@ -198,7 +198,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 	t.Run("200 OK - mixed candidates, registered, and not exists", func(t *testing.T) {
 		tu := bulk_mocks.NewMockTokenizeUsecase(t)
 
-		req := Req{Text: "apple banana cherry donut elephant"}
+		req := Req{Text: "apples banana cherry donut elephant"}
 		reqBody, _ := json.Marshal(req)
 
 		tu.On("Execute", mock.Anything, 1, req.Text).
@@ -206,6 +206,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 				[]string{"apple", "banana"}, // candidates
 				[]string{"cherry", "donut"}, // registered
 				[]string{"elephant"},        // not exists
+				[]models.BulkLemma{{Surface: "apples", Lemma: "apple"}},
 				nil,
 			)
 
@@ -228,6 +229,7 @@ func TestTokenizeHandler_AllPaths(t *testing.T) {
 		assert.Equal(t, []string{"apple", "banana"}, resp.Candidates)
 		assert.Equal(t, []string{"cherry", "donut"}, resp.Registered)
 		assert.Equal(t, []string{"elephant"}, resp.NotExistWord)
+		assert.Equal(t, []models.BulkLemma{{Surface: "apples", Lemma: "apple"}}, resp.Lemmas)
 		tu.AssertExpectations(t)
 	})
 
@@ -0,0 +1,68 @@
+package deck
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/deck"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *DeckHandler) CreateHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		var in deck.InputCreateDeck
+		if err := c.ShouldBindJSON(&in); err != nil {
+			httperr.Write(c, apperror.BadRequestf("invalid json", err))
+			return
+		}
+		in.UserID = userID
+
+		d, err := h.deckUsecase.Create(c.Request.Context(), in)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, d)
+	})
+}
+
+func (h *DeckHandler) UpdateHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		id, err := deckID(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		var in deck.InputUpdateDeck
+		if err := c.ShouldBindJSON(&in); err != nil {
+			httperr.Write(c, apperror.BadRequestf("invalid json", err))
+			return
+		}
+		in.UserID, in.DeckID = userID, id
+
+		d, err := h.deckUsecase.Update(c.Request.Context(), in)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, d)
+	})
+}
+
+func (h *DeckHandler) DeleteHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		id, err := deckID(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		if err := h.deckUsecase.Delete(c.Request.Context(), deck.InputDeleteDeck{UserID: userID, DeckID: id}); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "deleted"})
+	})
+}
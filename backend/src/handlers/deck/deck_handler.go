@@ -0,0 +1,39 @@
+package deck
+
+import (
+	"strconv"
+
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/deck"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DeckHandler struct {
+	deckUsecase deck.Usecase
+}
+
+func NewHandler(deckUsecase deck.Usecase) *DeckHandler {
+	return &DeckHandler{deckUsecase: deckUsecase}
+}
+
+type Handler interface {
+	ListHandler() gin.HandlerFunc
+	ListPublicHandler() gin.HandlerFunc
+	CreateHandler() gin.HandlerFunc
+	ShowHandler() gin.HandlerFunc
+	SharedHandler() gin.HandlerFunc
+	UpdateHandler() gin.HandlerFunc
+	DeleteHandler() gin.HandlerFunc
+	CopyHandler() gin.HandlerFunc
+	CopySharedHandler() gin.HandlerFunc
+}
+
+// deckID はパスパラメータ :id を読む
+func deckID(c *gin.Context) (int, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return 0, apperror.Validationf("invalid deck ID", nil)
+	}
+	return id, nil
+}
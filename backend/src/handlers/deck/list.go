@@ -0,0 +1,41 @@
+package deck
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/deck"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ListHandler は自分のデッキ一覧を返す
+func (h *DeckHandler) ListHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		decks, err := h.deckUsecase.List(c.Request.Context(), userID)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"decks": decks})
+	})
+}
+
+// ListPublicHandler は公開デッキの一覧を返す（?offset=&limit=）
+func (h *DeckHandler) ListPublicHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, _ int) {
+		var in deck.InputListPublicDecks
+		if err := c.ShouldBindQuery(&in); err != nil {
+			httperr.Write(c, apperror.BadRequestf("invalid query", err))
+			return
+		}
+		decks, err := h.deckUsecase.ListPublic(c.Request.Context(), in)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"decks": decks})
+	})
+}
@@ -0,0 +1,69 @@
+package deck
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/deck"
+
+	"github.com/gin-gonic/gin"
+)
+
+// ShowHandler は自分のデッキか公開デッキを単語付きで返す
+func (h *DeckHandler) ShowHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		id, err := deckID(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		d, err := h.deckUsecase.Get(c.Request.Context(), deck.InputGetDeck{UserID: userID, DeckID: id})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, d)
+	})
+}
+
+// SharedHandler は共有リンク（/decks/shared/:token）のデッキを返す
+func (h *DeckHandler) SharedHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		d, err := h.deckUsecase.Get(c.Request.Context(), deck.InputGetDeck{UserID: userID, ShareToken: c.Param("token")})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, d)
+	})
+}
+
+// CopyHandler は公開デッキを自分のデッキとして複製し、単語を登録する
+func (h *DeckHandler) CopyHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		id, err := deckID(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		out, err := h.deckUsecase.Copy(c.Request.Context(), deck.InputCopyDeck{UserID: userID, DeckID: id})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	})
+}
+
+// CopySharedHandler は共有リンクのデッキを自分のデッキとして複製し、単語を登録する
+func (h *DeckHandler) CopySharedHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		out, err := h.deckUsecase.Copy(c.Request.Context(), deck.InputCopyDeck{UserID: userID, ShareToken: c.Param("token")})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusCreated, out)
+	})
+}
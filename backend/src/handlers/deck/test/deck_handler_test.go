@@ -0,0 +1,86 @@
+package deck_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"word_app/backend/src/domain"
+	h "word_app/backend/src/handlers/deck"
+	deckmock "word_app/backend/src/mocks/usecase/deck"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/deck"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newContext(method, target string, body any, params ...gin.Param) (*gin.Context, *httptest.ResponseRecorder) {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, &buf)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = params
+	c.Set("principalKey", models.Principal{UserID: 1})
+	return c, w
+}
+
+func TestDeckHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("201 - 作成したデッキを返す", func(t *testing.T) {
+		uc := deckmock.NewMockUsecase(t)
+		uc.On("Create", mock.Anything, deck.InputCreateDeck{UserID: 1, Name: "fruits", WordIDs: []int{3, 1}}).
+			Return(&domain.Deck{ID: 5, UserID: 1, Name: "fruits", WordCount: 2}, nil)
+
+		c, w := newContext(http.MethodPost, "/decks", map[string]any{"name": "fruits", "wordIDs": []int{3, 1}})
+		h.NewHandler(uc).CreateHandler()(c)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var got domain.Deck
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 5, got.ID)
+	})
+
+	t.Run("400 - 不正な ID", func(t *testing.T) {
+		uc := deckmock.NewMockUsecase(t)
+		c, w := newContext(http.MethodGet, "/decks/abc", nil, gin.Param{Key: "id", Value: "abc"})
+		h.NewHandler(uc).ShowHandler()(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("404 - 見られないデッキ", func(t *testing.T) {
+		uc := deckmock.NewMockUsecase(t)
+		uc.On("Get", mock.Anything, deck.InputGetDeck{UserID: 1, DeckID: 2}).
+			Return(nil, apperror.NotFoundf("deck not found", nil))
+
+		c, w := newContext(http.MethodGet, "/decks/2", nil, gin.Param{Key: "id", Value: "2"})
+		h.NewHandler(uc).ShowHandler()(c)
+
+		assert.Equal(t, http.StatusNotFound, w.Code)
+	})
+
+	t.Run("201 - 共有リンクのデッキをコピーする", func(t *testing.T) {
+		uc := deckmock.NewMockUsecase(t)
+		uc.On("Copy", mock.Anything, deck.InputCopyDeck{UserID: 1, ShareToken: "tok"}).
+			Return(&deck.OutputCopyDeck{Deck: &domain.Deck{ID: 8}, Registered: 2}, nil)
+
+		c, w := newContext(http.MethodPost, "/decks/shared/tok/copy", nil, gin.Param{Key: "token", Value: "tok"})
+		h.NewHandler(uc).CopySharedHandler()(c)
+
+		require.Equal(t, http.StatusCreated, w.Code)
+		var got deck.OutputCopyDeck
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 2, got.Registered)
+	})
+}
@@ -0,0 +1,72 @@
+package export
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"word_app/backend/logger/logx"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/export"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ExportHandler struct {
+	exportUsecase export.Usecase
+}
+
+func NewHandler(exportUsecase export.Usecase) *ExportHandler {
+	return &ExportHandler{exportUsecase: exportUsecase}
+}
+
+type Handler interface {
+	ExportHandler() gin.HandlerFunc
+}
+
+// ExportHandler は GET /export?format=csv|json|anki（既定は json）。
+// 本文はユースケースが書いた順にそのまま流す。
+func (h *ExportHandler) ExportHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		format := c.DefaultQuery("format", domain.ExportFormatJSON)
+		sw := &streamWriter{c: c, format: format}
+
+		err := h.exportUsecase.Export(c.Request.Context(), export.Input{UserID: userID, Format: format}, sw)
+		switch {
+		case err == nil && !sw.started:
+			sw.writeHeader()
+		case err != nil && !sw.started:
+			httperr.Write(c, err)
+		case err != nil:
+			// ステータスはもう送ったので、途中で切れたことをログに残すだけ
+			logx.From(c.Request.Context()).WithError(err).Error("export aborted while streaming")
+			_ = c.Error(err)
+		}
+	})
+}
+
+// streamWriter は最初の書き込みでヘッダを送る。
+// 書き出し前のエラーは JSON のエラー応答にできるよう、ヘッダを遅らせる。
+type streamWriter struct {
+	c       *gin.Context
+	format  string
+	started bool
+}
+
+func (w *streamWriter) writeHeader() {
+	w.started = true
+	w.c.Header("Content-Type", export.ContentType(w.format))
+	w.c.Header("Content-Disposition",
+		fmt.Sprintf(`attachment; filename="%s"`, export.FileName(w.format, time.Now())))
+	w.c.Header("Cache-Control", "no-store")
+	w.c.Status(http.StatusOK)
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.writeHeader()
+	}
+	return w.c.Writer.Write(p)
+}
@@ -0,0 +1,89 @@
+package export_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	h "word_app/backend/src/handlers/export"
+	exportmock "word_app/backend/src/mocks/usecase/export"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/export"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newContext(target string) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, target, nil)
+	c.Set("principalKey", models.Principal{UserID: 1})
+	return c, w
+}
+
+func TestExportHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("200 - 添付ファイルとして流す", func(t *testing.T) {
+		uc := exportmock.NewMockUsecase(t)
+		uc.On("Export", mock.Anything, export.Input{UserID: 1, Format: "csv"}, mock.Anything).
+			Return(func(_ context.Context, _ export.Input, w io.Writer) error {
+				_, err := w.Write([]byte("PK"))
+				return err
+			})
+
+		c, w := newContext("/export?format=csv")
+		h.NewHandler(uc).ExportHandler()(c)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/zip", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), `attachment; filename="word_app-export-`)
+		assert.Equal(t, "PK", w.Body.String())
+	})
+
+	t.Run("既定は json", func(t *testing.T) {
+		uc := exportmock.NewMockUsecase(t)
+		uc.On("Export", mock.Anything, export.Input{UserID: 1, Format: "json"}, mock.Anything).Return(nil)
+
+		c, w := newContext("/export")
+		h.NewHandler(uc).ExportHandler()(c)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Header().Get("Content-Type"), "application/json")
+	})
+
+	t.Run("400 - 書き出し前のエラーは通常のエラー応答", func(t *testing.T) {
+		uc := exportmock.NewMockUsecase(t)
+		uc.On("Export", mock.Anything, export.Input{UserID: 1, Format: "xml"}, mock.Anything).
+			Return(apperror.Validationf("unsupported format", nil))
+
+		c, w := newContext("/export?format=xml")
+		h.NewHandler(uc).ExportHandler()(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Empty(t, w.Header().Get("Content-Disposition"))
+	})
+
+	t.Run("書き出し途中のエラーはステータスを変えない", func(t *testing.T) {
+		uc := exportmock.NewMockUsecase(t)
+		uc.On("Export", mock.Anything, mock.Anything, mock.Anything).
+			Return(func(_ context.Context, _ export.Input, w io.Writer) error {
+				_, _ = w.Write([]byte(`{"words":[`))
+				return errors.New("db down")
+			})
+
+		c, w := newContext("/export?format=json")
+		h.NewHandler(uc).ExportHandler()(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, `{"words":[`, w.Body.String())
+		assert.Len(t, c.Errors, 1)
+	})
+}
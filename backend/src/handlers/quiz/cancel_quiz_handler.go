@@ -0,0 +1,54 @@
+package quiz
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+
+	"github.com/gin-gonic/gin"
+	"github.com/sirupsen/logrus"
+)
+
+func (h *Handler) CancelHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		ctx := c.Request.Context()
+
+		quizID, err := strconv.Atoi(c.Param("id"))
+		if err != nil || quizID <= 0 {
+			httperr.Write(c, apperror.Validationf("invalid quizID type", nil))
+			return
+		}
+		req, err := h.parseCancelQuizRequest(c)
+		if err != nil {
+			logrus.Errorf("Failed to parse request: %v", err)
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		response, err := h.quizService.CancelQuiz(ctx, userID, quizID, req)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, response)
+	})
+}
+
+// リクエスト構造体を解析（body 省略時は採点しない）
+func (h *Handler) parseCancelQuizRequest(c *gin.Context) (*models.CancelQuizReq, error) {
+	var req models.CancelQuizReq
+
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		logrus.Errorf("Failed to bind JSON: %v", err)
+		return nil, err
+	}
+
+	return &req, nil
+}
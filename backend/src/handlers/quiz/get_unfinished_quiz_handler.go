@@ -0,0 +1,24 @@
+package quiz
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (h *Handler) GetUnfinishedHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		ctx := c.Request.Context()
+
+		quizzes, err := h.quizService.ListUnfinished(ctx, userID)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"quizzes": quizzes})
+	})
+}
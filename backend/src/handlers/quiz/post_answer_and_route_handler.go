@@ -1,8 +1,10 @@
 package quiz
 
 import (
+	"fmt"
 	"net/http"
 
+	"word_app/backend/src/handlers/httperr"
 	"word_app/backend/src/middleware/jwt"
 	"word_app/backend/src/models"
 
@@ -10,6 +12,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// quiz_questions.idempotency_key の最大長
+const maxIdempotencyKeyLen = 128
+
 func (h *Handler) PostAnswerAndRouteHandler() gin.HandlerFunc {
 	return jwt.WithUser(func(c *gin.Context, userID int) {
 		ctx := c.Request.Context()
@@ -24,10 +29,12 @@ func (h *Handler) PostAnswerAndRouteHandler() gin.HandlerFunc {
 		// サービス層にリクエストを渡して処理
 		response, err := h.quizService.SubmitAnswerAndRoute(ctx, userID, req)
 		if err != nil {
-			logrus.Errorf("Failed to submit answer: %v", err)
-			c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to submit answer"})
+			httperr.Write(c, err) // 終了済み・回答済み・順番違いは 409
 			return
 		}
+		if response.Replayed {
+			c.Header("Idempotent-Replayed", "true")
+		}
 
 		c.JSON(http.StatusOK, response)
 	})
@@ -42,6 +49,10 @@ func (h *Handler) parsePostAnswerQuizRequest(c *gin.Context) (*models.PostAnswer
 		logrus.Errorf("Failed to bind JSON: %v", err)
 		return nil, err
 	}
+	req.IdempotencyKey = c.GetHeader("Idempotency-Key")
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLen {
+		return nil, fmt.Errorf("Idempotency-Key must be at most %d characters", maxIdempotencyKeyLen)
+	}
 
 	return &req, nil
 }
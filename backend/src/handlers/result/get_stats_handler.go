@@ -0,0 +1,48 @@
+package result
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/models"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GET /results/stats?from=YYYY-MM-DD&to=YYYY-MM-DD&limit=N
+func (h *Handler) GetStatsHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		ctx := c.Request.Context()
+
+		req, err := parseStatsRequest(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		res, err := h.resultService.GetStats(ctx, userID, req)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, res)
+	})
+}
+
+func parseStatsRequest(c *gin.Context) (*models.StatsRequest, error) {
+	req := &models.StatsRequest{
+		From: c.Query("from"),
+		To:   c.Query("to"),
+	}
+	if s := c.Query("limit"); s != "" {
+		limit, err := strconv.Atoi(s)
+		if err != nil || limit <= 0 {
+			return nil, errors.New("invalid 'limit' query parameter: must be a positive integer")
+		}
+		req.WeakLimit = limit
+	}
+	return req, nil
+}
@@ -0,0 +1,53 @@
+package setting
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/apperror"
+	settingUc "word_app/backend/src/usecase/setting"
+
+	"github.com/gin-gonic/gin"
+)
+
+// GetPosMappingHandler は品詞コード対応表の最新版を返す（root のみ）
+func (h *AuthSettingHandler) GetPosMappingHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := jwt.GetPrincipal(c)
+		if !ok || !principal.IsRoot {
+			httperr.Write(c, apperror.Unauthorizedf("unauthorized", nil))
+			return
+		}
+		m, err := h.settingUsecase.GetPosMapping(c.Request.Context(), settingUc.InputGetPosMapping{UserID: principal.UserID})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, m)
+	}
+}
+
+// SavePosMappingHandler は対応表を新しい版として保存する（root のみ）
+func (h *AuthSettingHandler) SavePosMappingHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		principal, ok := jwt.GetPrincipal(c)
+		if !ok || !principal.IsRoot {
+			httperr.Write(c, apperror.Unauthorizedf("unauthorized", nil))
+			return
+		}
+		var req settingUc.InputUpdatePosMapping
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		req.UserID = principal.UserID
+
+		out, err := h.settingUsecase.UpdatePosMapping(c.Request.Context(), req)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, out)
+	}
+}
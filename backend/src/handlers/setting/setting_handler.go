@@ -23,4 +23,6 @@ type Handler interface {
 	GetRootConfigHandler() gin.HandlerFunc
 	SaveRootConfigHandler() gin.HandlerFunc
 	GetRuntimeConfigHandler() gin.HandlerFunc
+	GetPosMappingHandler() gin.HandlerFunc
+	SavePosMappingHandler() gin.HandlerFunc
 }
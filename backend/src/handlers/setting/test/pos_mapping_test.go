@@ -0,0 +1,90 @@
+package setting_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"word_app/backend/src/domain/posmap"
+	settinghdlr "word_app/backend/src/handlers/setting"
+	mockSettinguc "word_app/backend/src/mocks/usecase/setting"
+	"word_app/backend/src/test"
+	"word_app/backend/src/usecase/apperror"
+	settingUc "word_app/backend/src/usecase/setting"
+)
+
+func TestGetPosMappingHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("root は最新版を取得できる (200)", func(t *testing.T) {
+		mockUc := mockSettinguc.NewMockSettingFacade(t)
+		mockUc.On("GetPosMapping", mock.Anything, settingUc.InputGetPosMapping{UserID: 99}).
+			Return(&posmap.Mapping{Version: 3, Codes: map[string]int{"v5k": 3}, Fallback: 12}, nil)
+
+		c, w := test.NewTestCtx("GET", "/setting/pos_mapping", nil)
+		test.InjectUser(c, 99, true)
+		settinghdlr.NewHandler(mockUc).GetPosMappingHandler()(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got posmap.Mapping
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 3, got.Version)
+		assert.Equal(t, 3, got.Codes["v5k"])
+	})
+
+	t.Run("root 以外は 401", func(t *testing.T) {
+		mockUc := mockSettinguc.NewMockSettingFacade(t)
+		c, w := test.NewTestCtx("GET", "/setting/pos_mapping", nil)
+		test.InjectUser(c, 99, false)
+		settinghdlr.NewHandler(mockUc).GetPosMappingHandler()(c)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestSavePosMappingHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	body := map[string]any{"codes": map[string]int{"v5k": 3}, "fallback_pos_id": 12, "note": "v5k"}
+
+	t.Run("新しい版と変更点を返す (200)", func(t *testing.T) {
+		mockUc := mockSettinguc.NewMockSettingFacade(t)
+		want := settingUc.InputUpdatePosMapping{UserID: 99, Codes: map[string]int{"v5k": 3}, Fallback: 12, Note: "v5k"}
+		mockUc.On("UpdatePosMapping", mock.Anything, want).
+			Return(&settingUc.OutputUpdatePosMapping{
+				Mapping: &posmap.Mapping{Version: 2, Codes: want.Codes, Fallback: 12},
+				Changes: []posmap.Change{{Code: "v5k", From: 11, To: 3}},
+			}, nil)
+
+		c, w := test.NewTestCtx("PUT", "/setting/pos_mapping", body)
+		test.InjectUser(c, 99, true)
+		settinghdlr.NewHandler(mockUc).SavePosMappingHandler()(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var got settingUc.OutputUpdatePosMapping
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 2, got.Mapping.Version)
+		assert.Equal(t, []posmap.Change{{Code: "v5k", From: 11, To: 3}}, got.Changes)
+	})
+
+	t.Run("検証エラーは 400", func(t *testing.T) {
+		mockUc := mockSettinguc.NewMockSettingFacade(t)
+		mockUc.On("UpdatePosMapping", mock.Anything, mock.Anything).
+			Return(nil, apperror.Validationf("codes must not be empty", nil))
+
+		c, w := test.NewTestCtx("PUT", "/setting/pos_mapping", map[string]any{"codes": map[string]int{}})
+		test.InjectUser(c, 99, true)
+		settinghdlr.NewHandler(mockUc).SavePosMappingHandler()(c)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("root 以外は 401", func(t *testing.T) {
+		mockUc := mockSettinguc.NewMockSettingFacade(t)
+		c, w := test.NewTestCtx("PUT", "/setting/pos_mapping", body)
+		test.InjectUser(c, 99, false)
+		settinghdlr.NewHandler(mockUc).SavePosMappingHandler()(c)
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
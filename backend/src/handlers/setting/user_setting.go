@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 
+	"word_app/backend/src/handlers/httperr"
 	"word_app/backend/src/middleware/jwt"
 	settingUc "word_app/backend/src/usecase/setting"
 
@@ -37,7 +38,7 @@ func (h *AuthSettingHandler) SaveUserConfigHandler() gin.HandlerFunc {
 
 		setting, err := h.settingUsecase.UpdateUser(ctx, req)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			httperr.Write(c, err)
 			return
 		}
 		c.JSON(http.StatusOK, setting)
@@ -0,0 +1,89 @@
+package user
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers"
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+	userfields "word_app/backend/src/validators/user/userFields"
+
+	"github.com/gin-gonic/gin"
+)
+
+// メール確認・パスワード再設定。どれも未ログインで呼べる。
+// 依頼系（再送・再設定メール）はアドレスの有無を漏らさないよう、常に 202 を返す。
+
+func (h *UserHandler) SendVerificationHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req models.EmailRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		if err := h.accountUsecase.SendVerification(c.Request.Context(), req.Email); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"message": "If the address is registered, a verification email has been sent"})
+	}
+}
+
+func (h *UserHandler) VerifyEmailHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req models.VerifyEmailRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		if err := h.accountUsecase.VerifyEmail(c.Request.Context(), req.Token); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "Email verified"})
+	}
+}
+
+func (h *UserHandler) ForgotPasswordHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req models.EmailRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		if err := h.accountUsecase.RequestPasswordReset(c.Request.Context(), req.Email); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusAccepted, gin.H{"message": "If the address is registered, a password reset email has been sent"})
+	}
+}
+
+func (h *UserHandler) ResetPasswordHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req models.ResetPasswordRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		if fs := userfields.ValidateUserPassword(req.Password); len(fs) > 0 {
+			httperr.Write(c, apperror.WithFieldErrors(apperror.Validation, "invalid input", fs))
+			return
+		}
+		if err := h.accountUsecase.ResetPassword(c.Request.Context(), req.Token, req.Password); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "Password updated"})
+	}
+}
+
+// bindJSON は失敗時に 400 を書いて false を返す
+func bindJSON(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil {
+		if fs := handlers.FieldsFromBindError(err); len(fs) > 0 {
+			httperr.Write(c, apperror.WithFieldErrors(apperror.Validation, "invalid input", fs))
+			return false
+		}
+		httperr.Write(c, apperror.Validationf("invalid input", err))
+		return false
+	}
+	return true
+}
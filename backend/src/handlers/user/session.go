@@ -0,0 +1,66 @@
+package user
+
+import (
+	"net/http"
+
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/session"
+
+	"github.com/gin-gonic/gin"
+)
+
+// リフレッシュトークンは使うたびに新しいものへ入れ替わる。
+// 古いほうが再び使われたら、その系列はまとめて失効する。
+
+func (h *UserHandler) RefreshHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req models.RefreshTokenRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		pair, err := h.sessions.Refresh(c.Request.Context(), req.RefreshToken)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, tokenResponse("Token refreshed", pair))
+	}
+}
+
+// SignOutHandler はこの端末のリフレッシュトークンを失効させる。
+// アクセストークンは期限まで有効なので、クライアント側でも破棄する。
+func (h *UserHandler) SignOutHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req models.RefreshTokenRequest
+		if !bindJSON(c, &req) {
+			return
+		}
+		if err := h.sessions.Logout(c.Request.Context(), req.RefreshToken); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "Signed out"})
+	}
+}
+
+// SignOutAllHandler はすべての端末からログアウトさせる（発行済みのアクセストークンも無効）
+func (h *UserHandler) SignOutAllHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		if err := h.sessions.LogoutAll(c.Request.Context(), userID); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"message": "Signed out from all devices"})
+	})
+}
+
+func tokenResponse(message string, p *session.TokenPair) gin.H {
+	return gin.H{
+		"message":       message,
+		"token":         p.AccessToken,
+		"refresh_token": p.RefreshToken,
+		"expires_in":    p.ExpiresIn,
+	}
+}
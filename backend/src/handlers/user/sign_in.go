@@ -1,7 +1,6 @@
 package user
 
 import (
-	"fmt"
 	"net/http"
 
 	"word_app/backend/src/handlers"
@@ -46,16 +45,19 @@ func (h *UserHandler) SignInHandler() gin.HandlerFunc {
 			httperr.Write(c, apperror.Validationf("invalid request", nil))
 			return
 		}
-		// JWT発行
-		token, err := h.jwtGenerator.GenerateJWT(fmt.Sprintf("%d", signInUser.UserID))
+		// メール確認が必須なら未確認ユーザーは通さない（パスワード照合の後に判定して確認状態を漏らさない）
+		if err := h.accountUsecase.CheckSignIn(ctx, signInUser.UserID); err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		// アクセストークンとリフレッシュトークンを発行
+		pair, err := h.sessions.Issue(ctx, signInUser.UserID)
 		if err != nil {
 			httperr.Write(c, apperror.Validationf("invalid request", nil))
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{
-			"message": "Authentication successful", "token": token,
-		})
+		c.JSON(http.StatusOK, tokenResponse("Authentication successful", pair))
 	}
 }
 
@@ -3,10 +3,10 @@ package user
 import (
 	"bytes"
 	"errors"
-	"fmt"
 	"io"
 	"net/http"
 
+	"word_app/backend/logger/logx"
 	"word_app/backend/src/handlers/httperr"
 	"word_app/backend/src/usecase/apperror"
 	user_usecase "word_app/backend/src/usecase/user"
@@ -43,14 +43,29 @@ func (h *UserHandler) SignUpHandler() gin.HandlerFunc {
 			return
 		}
 
+		// メール確認が必須なら確認メールだけ送り、トークンは確認後のサインインで発行する
+		required, err := h.accountUsecase.VerificationRequired(ctx)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		if required {
+			if err := h.accountUsecase.SendVerification(ctx, req.Email); err != nil {
+				// アカウントは作成済み。送信失敗は再送 API でやり直せるので記録だけする
+				logx.From(ctx).WithError(err).Warn("failed to send verification mail")
+			}
+			c.JSON(http.StatusAccepted, gin.H{"message": "Verification email sent", "verificationRequired": true})
+			return
+		}
+
 		// 作成したユーザーでサインイン（トークン発行）
-		token, err := h.jwtGenerator.GenerateJWT(fmt.Sprintf("%d", user.UserID))
+		pair, err := h.sessions.Issue(ctx, user.UserID)
 		if err != nil {
 			httperr.Write(c, apperror.Validationf("Failed to generate token", err))
 			return
 		}
 
-		c.JSON(http.StatusOK, gin.H{"message": "Authentication successful", "token": token})
+		c.JSON(http.StatusOK, tokenResponse("Authentication successful", pair))
 	}
 }
 
@@ -0,0 +1,91 @@
+package user_test
+
+import (
+	"net/http"
+	"testing"
+
+	h "word_app/backend/src/handlers/user"
+	account_mocks "word_app/backend/src/mocks/usecase/account"
+	"word_app/backend/src/usecase/apperror"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newAccountRouter(acc *account_mocks.MockUsecase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	hd := h.NewHandler(nil, nil, acc)
+	r.POST("/email/verification", hd.SendVerificationHandler())
+	r.POST("/email/verify", hd.VerifyEmailHandler())
+	r.POST("/password/forgot", hd.ForgotPasswordHandler())
+	r.POST("/password/reset", hd.ResetPasswordHandler())
+	return r
+}
+
+func TestAccountHandlers(t *testing.T) {
+	t.Run("202 - resend verification", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+		acc.On("SendVerification", mock.Anything, "alice@example.com").Return(nil)
+
+		w := postJSON(newAccountRouter(acc), "/email/verification", map[string]string{"email": "alice@example.com"})
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("200 - verify email", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+		acc.On("VerifyEmail", mock.Anything, "tok").Return(nil)
+
+		w := postJSON(newAccountRouter(acc), "/email/verify", map[string]string{"token": "tok"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("400 - used or expired token", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+		acc.On("VerifyEmail", mock.Anything, "tok").Return(apperror.Validationf("invalid or expired token", nil))
+
+		w := postJSON(newAccountRouter(acc), "/email/verify", map[string]string{"token": "tok"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{"error":"invalid or expired token"}`, w.Body.String())
+	})
+
+	t.Run("400 - token missing", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+
+		w := postJSON(newAccountRouter(acc), "/email/verify", map[string]string{})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("202 - forgot password", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+		acc.On("RequestPasswordReset", mock.Anything, "nobody@example.com").Return(nil)
+
+		w := postJSON(newAccountRouter(acc), "/password/forgot", map[string]string{"email": "nobody@example.com"})
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+
+	t.Run("200 - reset password", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+		acc.On("ResetPassword", mock.Anything, "tok", "NewSecret_123!").Return(nil)
+
+		w := postJSON(newAccountRouter(acc), "/password/reset",
+			map[string]string{"token": "tok", "password": "NewSecret_123!"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("400 - weak new password is rejected before using the token", func(t *testing.T) {
+		acc := account_mocks.NewMockUsecase(t)
+
+		w := postJSON(newAccountRouter(acc), "/password/reset",
+			map[string]string{"token": "tok", "password": "short"})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
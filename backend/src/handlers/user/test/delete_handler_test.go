@@ -9,7 +9,6 @@ import (
 
 	h "word_app/backend/src/handlers/user"
 	"word_app/backend/src/middleware/jwt"
-	"word_app/backend/src/mocks"
 	user_mocks "word_app/backend/src/mocks/usecase/user"
 	"word_app/backend/src/models"
 	"word_app/backend/src/usecase/apperror"
@@ -45,7 +44,7 @@ func newDeleteRouterWithUserID(uc *user_mocks.MockUsecase, userID any) *gin.Engi
 			c.Next()
 		})
 	}
-	handler := h.NewHandler(uc, &mocks.MockJwtGenerator{})
+	handler := h.NewHandler(uc, nil, nil)
 	r.DELETE("/users/:id", handler.DeleteHandler())
 	return r
 }
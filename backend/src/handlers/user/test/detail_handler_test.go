@@ -45,7 +45,7 @@ func newMeRouter(uc *user_mocks.MockUsecase, userID any) *gin.Engine {
 			c.Next()
 		})
 	}
-	hd := h.NewHandler(uc, nil)
+	hd := h.NewHandler(uc, nil, nil)
 	r.GET("/me", hd.MeHandler())
 	return r
 }
@@ -75,7 +75,7 @@ func newShowRouter(uc *user_mocks.MockUsecase, userID any) *gin.Engine {
 			c.Next()
 		})
 	}
-	hd := h.NewHandler(uc, nil)
+	hd := h.NewHandler(uc, nil, nil)
 	r.GET("/users/:id", hd.ShowHandler())
 	return r
 }
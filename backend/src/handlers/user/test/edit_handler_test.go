@@ -47,7 +47,7 @@ func newEditRouter(uc *user_mocks.MockUsecase, rls *roles) *gin.Engine {
 		})
 	}
 
-	hd := h.NewHandler(uc, nil)
+	hd := h.NewHandler(uc, nil, nil)
 	r.PUT("/users/:id", hd.EditHandler())
 	return r
 }
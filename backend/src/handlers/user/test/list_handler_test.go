@@ -10,7 +10,6 @@ import (
 
 	"word_app/backend/src/handlers/user"
 	"word_app/backend/src/middleware/jwt"
-	"word_app/backend/src/mocks"
 	user_mocks "word_app/backend/src/mocks/usecase/user"
 	"word_app/backend/src/models"
 	user_usecase "word_app/backend/src/usecase/user"
@@ -70,8 +69,7 @@ func performGet(r *gin.Engine, rawURL string) *httptest.ResponseRecorder {
 func TestUserListHandler_AllPaths(t *testing.T) {
 	t.Run("200 OK - defaults applied", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		mockJWTGen := &mocks.MockJwtGenerator{}
-		h := user.NewHandler(mockClient, mockJWTGen)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		// 期待されるリクエスト値（デフォルト）
 		expected := &user_usecase.ListUsersInput{
@@ -118,7 +116,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("200 OK - forwards query params", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		q := url.Values{}
 		q.Set("search", "bob")
@@ -170,7 +168,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("500 - service error from ListUsers", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		anyReq := mock.AnythingOfType("user.ListUsersInput")
 		mockClient.
@@ -189,7 +187,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - userID missing in context", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		// userID をコンテキストに入れない
 		r := newRouterWithUserID(h, nil)
@@ -206,7 +204,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - userID type invalid", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		// userID を string 型にして型不正を誘発
 		r := newRouterWithUserID(h, "1")
@@ -221,7 +219,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - page invalid (zero)", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		r := newRouterWithUserID(h, 1)
 		w := performGet(r, "/users?page=0")
@@ -235,7 +233,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - page invalid (nan)", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		r := newRouterWithUserID(h, 1)
 		w := performGet(r, "/users?page=abc")
@@ -249,7 +247,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - limit invalid (zero)", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		r := newRouterWithUserID(h, 1)
 		w := performGet(r, "/users?limit=0")
@@ -263,7 +261,7 @@ func TestUserListHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - limit invalid (nan)", func(t *testing.T) {
 		mockClient := new(user_mocks.MockUsecase)
-		h := user.NewHandler(mockClient, nil)
+		h := user.NewHandler(mockClient, nil, nil)
 
 		r := newRouterWithUserID(h, 1)
 		w := performGet(r, "/users?limit=abc")
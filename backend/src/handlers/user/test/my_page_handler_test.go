@@ -9,7 +9,6 @@ import (
 
 	"word_app/backend/src/handlers/user"
 	"word_app/backend/src/middleware/jwt"
-	"word_app/backend/src/mocks"
 	"word_app/backend/src/models"
 
 	user_mocks "word_app/backend/src/mocks/usecase/user"
@@ -23,9 +22,8 @@ func TestMyPageHandler(t *testing.T) {
 	// テスト用のGinコンテキストとHTTPリクエストを準備
 	gin.SetMode(gin.TestMode)
 	mockClient := new(user_mocks.MockUsecase)
-	mockJWTGen := &mocks.MockJwtGenerator{}
 
-	userHandler := user.NewHandler(mockClient, mockJWTGen)
+	userHandler := user.NewHandler(mockClient, nil, nil)
 
 	// 正常時
 	t.Run("Success", func(t *testing.T) {
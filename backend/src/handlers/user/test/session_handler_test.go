@@ -0,0 +1,81 @@
+package user_test
+
+import (
+	"net/http"
+	"testing"
+
+	h "word_app/backend/src/handlers/user"
+	"word_app/backend/src/middleware/jwt"
+	session_mocks "word_app/backend/src/mocks/usecase/session"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/session"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newSessionRouter(sess *session_mocks.MockUsecase) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	hd := h.NewHandler(nil, sess, nil)
+	r.POST("/token/refresh", hd.RefreshHandler())
+	r.POST("/sign_out", hd.SignOutHandler())
+	r.POST("/sign_out_all", func(c *gin.Context) {
+		jwt.SetPrincipal(c, models.Principal{UserID: 1})
+		c.Next()
+	}, hd.SignOutAllHandler())
+	return r
+}
+
+func TestSessionHandlers(t *testing.T) {
+	t.Run("200 - refresh rotates the pair", func(t *testing.T) {
+		sess := session_mocks.NewMockUsecase(t)
+		sess.On("Refresh", mock.Anything, "rt_old").
+			Return(&session.TokenPair{AccessToken: "acc", RefreshToken: "rt_new", ExpiresIn: 900}, nil)
+
+		w := postJSON(newSessionRouter(sess), "/token/refresh", map[string]string{"refresh_token": "rt_old"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.JSONEq(t,
+			`{"message":"Token refreshed","token":"acc","refresh_token":"rt_new","expires_in":900}`,
+			w.Body.String())
+	})
+
+	t.Run("401 - reused or unknown refresh token", func(t *testing.T) {
+		sess := session_mocks.NewMockUsecase(t)
+		sess.On("Refresh", mock.Anything, "rt_old").
+			Return(nil, apperror.Unauthorizedf("invalid refresh token", nil))
+
+		w := postJSON(newSessionRouter(sess), "/token/refresh", map[string]string{"refresh_token": "rt_old"})
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+
+	t.Run("400 - refresh token missing", func(t *testing.T) {
+		sess := session_mocks.NewMockUsecase(t)
+
+		w := postJSON(newSessionRouter(sess), "/token/refresh", map[string]string{})
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("200 - sign out", func(t *testing.T) {
+		sess := session_mocks.NewMockUsecase(t)
+		sess.On("Logout", mock.Anything, "rt").Return(nil)
+
+		w := postJSON(newSessionRouter(sess), "/sign_out", map[string]string{"refresh_token": "rt"})
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("200 - sign out from all devices", func(t *testing.T) {
+		sess := session_mocks.NewMockUsecase(t)
+		sess.On("LogoutAll", mock.Anything, 1).Return(nil)
+
+		w := postJSON(newSessionRouter(sess), "/sign_out_all", nil)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+}
@@ -8,9 +8,11 @@ import (
 	"testing"
 
 	h "word_app/backend/src/handlers/user"
-	"word_app/backend/src/mocks"
+	account_mocks "word_app/backend/src/mocks/usecase/account"
+	session_mocks "word_app/backend/src/mocks/usecase/session"
 	user_mocks "word_app/backend/src/mocks/usecase/user"
 	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/session"
 	user_usecase "word_app/backend/src/usecase/user"
 
 	"github.com/gin-gonic/gin"
@@ -19,10 +21,17 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
-func newSignInRouter(uc *user_mocks.MockUsecase, jwt *mocks.MockJwtGenerator) *gin.Engine {
+func newSignInRouter(uc *user_mocks.MockUsecase, sess *session_mocks.MockUsecase) *gin.Engine {
+	// メール確認が不要な設定（既定）
+	acc := new(account_mocks.MockUsecase)
+	acc.On("CheckSignIn", mock.Anything, mock.Anything).Return(nil)
+	return newSignInRouterWithAccount(uc, sess, acc)
+}
+
+func newSignInRouterWithAccount(uc *user_mocks.MockUsecase, sess *session_mocks.MockUsecase, acc *account_mocks.MockUsecase) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
-	hd := h.NewHandler(uc, jwt)
+	hd := h.NewHandler(uc, sess, acc)
 	r.POST("/signin", hd.SignInHandler())
 	return r
 }
@@ -53,8 +62,8 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 
 	t.Run("200 OK - success", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 
 		req := Req{Email: "alice@example.com", Password: "Secret_123!"}
 		uc.On("FindByEmail", mock.Anything, req.Email).
@@ -62,7 +71,7 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 				UserID:         42,
 				HashedPassword: hash(req.Password),
 			}, nil)
-		jwt.On("GenerateJWT", "42").Return("tok_abc", nil)
+		sess.On("Issue", mock.Anything, 42).Return(&session.TokenPair{AccessToken: "tok_abc", RefreshToken: "rt_abc", ExpiresIn: 900}, nil)
 
 		w := performJSON(r, "/signin", req)
 
@@ -71,14 +80,16 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 		_ = json.Unmarshal(w.Body.Bytes(), &got)
 		assert.Equal(t, "Authentication successful", got["message"])
 		assert.Equal(t, "tok_abc", got["token"])
+		assert.Equal(t, "rt_abc", got["refresh_token"])
+		assert.EqualValues(t, 900, got["expires_in"])
 		uc.AssertExpectations(t)
-		jwt.AssertExpectations(t)
+		sess.AssertExpectations(t)
 	})
 
 	t.Run("400 - invalid JSON (bind error)", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 
 		// 壊れたJSON
 		w := func() *httptest.ResponseRecorder {
@@ -96,8 +107,8 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - validation error (missing fields)", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 
 		// 空フィールド → ValidateSignIn が FieldErrors を返す想定
 		w := performJSON(r, "/signin", Req{Email: "", Password: ""})
@@ -114,8 +125,8 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 
 	t.Run("404 - user not found", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 
 		req := Req{Email: "unknown@example.com", Password: "Secret_123!"}
 		uc.On("FindByEmail", mock.Anything, req.Email).
@@ -130,8 +141,8 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 
 	t.Run("400 - password mismatch", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 
 		req := Req{Email: "bob@example.com", Password: "Wrong_123!"}
 		uc.On("FindByEmail", mock.Anything, req.Email).
@@ -145,13 +156,13 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 		// comparePasswordPtrエラー時はメッセージを固定化（情報リーク防止）
 		assert.JSONEq(t, `{"error":"invalid request"}`, w.Body.String())
-		jwt.AssertNotCalled(t, "GenerateJWT", mock.Anything)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 	})
 
 	t.Run("400 - password hash not set (external-only account)", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 		req := Req{Email: "line-only@example.com", Password: "Secret_123!"}
 		uc.On("FindByEmail", mock.Anything, req.Email).
 			Return(&user_usecase.FindByEmailOutput{
@@ -163,13 +174,13 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 		assert.JSONEq(t, `{"error":"invalid request"}`, w.Body.String())
-		jwt.AssertNotCalled(t, "GenerateJWT", mock.Anything)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 	})
 
-	t.Run("400 - jwt generation failure", func(t *testing.T) {
+	t.Run("400 - token issue failure", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignInRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignInRouter(uc, sess)
 
 		req := Req{Email: "ok@example.com", Password: "Secret_123!"}
 		uc.On("FindByEmail", mock.Anything, req.Email).
@@ -177,11 +188,51 @@ func TestSignInHandler_AllPaths(t *testing.T) {
 				UserID:         55,
 				HashedPassword: hash(req.Password),
 			}, nil)
-		jwt.On("GenerateJWT", "55").Return("", assert.AnError)
+		sess.On("Issue", mock.Anything, 55).Return(nil, assert.AnError)
 
 		w := performJSON(r, "/signin", req)
 
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 		assert.JSONEq(t, `{"error":"invalid request"}`, w.Body.String())
 	})
+
+	t.Run("403 - email not verified", func(t *testing.T) {
+		uc := new(user_mocks.MockUsecase)
+		sess := new(session_mocks.MockUsecase)
+		acc := new(account_mocks.MockUsecase)
+		r := newSignInRouterWithAccount(uc, sess, acc)
+
+		req := Req{Email: "new@example.com", Password: "Secret_123!"}
+		uc.On("FindByEmail", mock.Anything, req.Email).
+			Return(&user_usecase.FindByEmailOutput{
+				UserID:         61,
+				HashedPassword: hash(req.Password),
+			}, nil)
+		acc.On("CheckSignIn", mock.Anything, 61).Return(apperror.Forbiddenf("email not verified", nil))
+
+		w := performJSON(r, "/signin", req)
+
+		assert.Equal(t, http.StatusForbidden, w.Code)
+		assert.JSONEq(t, `{"error":"email not verified"}`, w.Body.String())
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("400 - password mismatch is checked before verification", func(t *testing.T) {
+		uc := new(user_mocks.MockUsecase)
+		sess := new(session_mocks.MockUsecase)
+		acc := new(account_mocks.MockUsecase)
+		r := newSignInRouterWithAccount(uc, sess, acc)
+
+		req := Req{Email: "new@example.com", Password: "Secret_123!"}
+		uc.On("FindByEmail", mock.Anything, req.Email).
+			Return(&user_usecase.FindByEmailOutput{
+				UserID:         61,
+				HashedPassword: hash("Other_456!"),
+			}, nil)
+
+		w := performJSON(r, "/signin", req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		acc.AssertNotCalled(t, "CheckSignIn", mock.Anything, mock.Anything)
+	})
 }
@@ -9,9 +9,11 @@ import (
 	"testing"
 
 	h "word_app/backend/src/handlers/user"
-	"word_app/backend/src/mocks"
+	account_mocks "word_app/backend/src/mocks/usecase/account"
+	session_mocks "word_app/backend/src/mocks/usecase/session"
 	user_mocks "word_app/backend/src/mocks/usecase/user"
 	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/session"
 	user_usecase "word_app/backend/src/usecase/user"
 
 	"github.com/gin-gonic/gin"
@@ -21,10 +23,17 @@ import (
 
 /************ テスト用ヘルパー ************/
 
-func newSignUpRouter(uc *user_mocks.MockUsecase, jwt *mocks.MockJwtGenerator) *gin.Engine {
+func newSignUpRouter(uc *user_mocks.MockUsecase, sess *session_mocks.MockUsecase) *gin.Engine {
+	// メール確認が不要な設定（既定）
+	acc := new(account_mocks.MockUsecase)
+	acc.On("VerificationRequired", mock.Anything).Return(false, nil)
+	return newSignUpRouterWithAccount(uc, sess, acc)
+}
+
+func newSignUpRouterWithAccount(uc *user_mocks.MockUsecase, sess *session_mocks.MockUsecase, acc *account_mocks.MockUsecase) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 	r := gin.New()
-	hd := h.NewHandler(uc, jwt)
+	hd := h.NewHandler(uc, sess, acc)
 	r.POST("/signup", hd.SignUpHandler())
 	return r
 }
@@ -52,8 +61,8 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 
 	t.Run("200 OK - success", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignUpRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignUpRouter(uc, sess)
 
 		req := Req{
 			Name:     "Alice",
@@ -67,7 +76,7 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 		})
 		uc.On("SignUp", mock.Anything, argMatcher).
 			Return(&user_usecase.SignUpOutput{UserID: 42}, nil)
-		jwt.On("GenerateJWT", "42").Return("tok_abc", nil)
+		sess.On("Issue", mock.Anything, 42).Return(&session.TokenPair{AccessToken: "tok_abc", RefreshToken: "rt_abc", ExpiresIn: 900}, nil)
 
 		w := postJSON(r, "/signup", req)
 
@@ -76,14 +85,16 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 		_ = json.Unmarshal(w.Body.Bytes(), &got)
 		assert.Equal(t, "Authentication successful", got["message"])
 		assert.Equal(t, "tok_abc", got["token"])
+		assert.Equal(t, "rt_abc", got["refresh_token"])
+		assert.EqualValues(t, 900, got["expires_in"])
 		uc.AssertExpectations(t)
-		jwt.AssertExpectations(t)
+		sess.AssertExpectations(t)
 	})
 
 	t.Run("500 - invalid JSON (bind error at parseRequest)", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignUpRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignUpRouter(uc, sess)
 
 		// email を number にして JSON バインドエラーを発生させる
 		w := func() *httptest.ResponseRecorder {
@@ -97,13 +108,13 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 		assert.JSONEq(t, `{"error":"internal error"}`, w.Body.String())
 		uc.AssertNotCalled(t, "SignUp", mock.Anything, mock.Anything)
-		jwt.AssertNotCalled(t, "GenerateJWT", mock.Anything)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 	})
 
 	t.Run("400 - validation error (ValidateSignUp)", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignUpRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignUpRouter(uc, sess)
 
 		// わざと不正: 短すぎる名前/パスワードなど（実装側の validator に合わせて調整）
 		req := Req{
@@ -121,13 +132,13 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 		_, hasFields := got["fields"] // fields が返ってくることのみ確認（詳細は validator 側の責務）
 		assert.True(t, hasFields)
 		uc.AssertNotCalled(t, "SignUp", mock.Anything, mock.Anything)
-		jwt.AssertNotCalled(t, "GenerateJWT", mock.Anything)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 	})
 
 	t.Run("409 - conflict from usecase (duplicate email)", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignUpRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignUpRouter(uc, sess)
 
 		req := Req{
 			Name:     "Bob",
@@ -145,14 +156,14 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 
 		assert.Equal(t, http.StatusConflict, w.Code)
 		assert.JSONEq(t, `{"error":"email already exists"}`, w.Body.String())
-		jwt.AssertNotCalled(t, "GenerateJWT", mock.Anything)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 		uc.AssertExpectations(t)
 	})
 
 	t.Run("500 - internal from usecase", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignUpRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignUpRouter(uc, sess)
 
 		req := Req{
 			Name:     "Carol",
@@ -167,14 +178,14 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 
 		assert.Equal(t, http.StatusInternalServerError, w.Code)
 		assert.JSONEq(t, `{"error":"internal error"}`, w.Body.String())
-		jwt.AssertNotCalled(t, "GenerateJWT", mock.Anything)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 		uc.AssertExpectations(t)
 	})
 
 	t.Run("400 - JWT generation failure", func(t *testing.T) {
 		uc := new(user_mocks.MockUsecase)
-		jwt := &mocks.MockJwtGenerator{}
-		r := newSignUpRouter(uc, jwt)
+		sess := new(session_mocks.MockUsecase)
+		r := newSignUpRouter(uc, sess)
 
 		req := Req{
 			Name:     "Dave",
@@ -184,7 +195,7 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 
 		uc.On("SignUp", mock.Anything, mock.Anything).
 			Return(&user_usecase.SignUpOutput{UserID: 777}, nil)
-		jwt.On("GenerateJWT", "777").Return("", assert.AnError)
+		sess.On("Issue", mock.Anything, 777).Return(nil, assert.AnError)
 
 		w := postJSON(r, "/signup", req)
 
@@ -195,6 +206,47 @@ func TestSignUpHandler_AllPaths(t *testing.T) {
 		// メッセージ文字列はコードに合わせる（固定文言にしている場合は JSONEq でもOK）
 		assert.Equal(t, "Failed to generate token", got["error"])
 		uc.AssertExpectations(t)
-		jwt.AssertExpectations(t)
+		sess.AssertExpectations(t)
+	})
+
+	t.Run("202 - verification required: mail sent, no token", func(t *testing.T) {
+		uc := new(user_mocks.MockUsecase)
+		sess := new(session_mocks.MockUsecase)
+		acc := new(account_mocks.MockUsecase)
+		r := newSignUpRouterWithAccount(uc, sess, acc)
+
+		req := Req{Name: "Alice", Email: "alice@example.com", Password: "Secret_123!"}
+		uc.On("SignUp", mock.Anything, mock.Anything).
+			Return(&user_usecase.SignUpOutput{UserID: 42}, nil)
+		acc.On("VerificationRequired", mock.Anything).Return(true, nil)
+		acc.On("SendVerification", mock.Anything, req.Email).Return(nil)
+
+		w := postJSON(r, "/signup", req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		var got map[string]any
+		_ = json.Unmarshal(w.Body.Bytes(), &got)
+		assert.Equal(t, true, got["verificationRequired"])
+		assert.NotContains(t, got, "token")
+		acc.AssertExpectations(t)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
+	})
+
+	t.Run("202 - verification required: mail failure does not fail sign up", func(t *testing.T) {
+		uc := new(user_mocks.MockUsecase)
+		sess := new(session_mocks.MockUsecase)
+		acc := new(account_mocks.MockUsecase)
+		r := newSignUpRouterWithAccount(uc, sess, acc)
+
+		req := Req{Name: "Alice", Email: "alice@example.com", Password: "Secret_123!"}
+		uc.On("SignUp", mock.Anything, mock.Anything).
+			Return(&user_usecase.SignUpOutput{UserID: 42}, nil)
+		acc.On("VerificationRequired", mock.Anything).Return(true, nil)
+		acc.On("SendVerification", mock.Anything, req.Email).Return(apperror.Internalf("failed to send mail", nil))
+
+		w := postJSON(r, "/signup", req)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		sess.AssertNotCalled(t, "Issue", mock.Anything, mock.Anything)
 	})
 }
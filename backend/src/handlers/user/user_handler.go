@@ -2,24 +2,28 @@
 package user
 
 import (
-	"word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/usecase/account"
+	"word_app/backend/src/usecase/session"
 	"word_app/backend/src/usecase/user"
 
 	"github.com/gin-gonic/gin"
 )
 
 type UserHandler struct {
-	userUsecase  user.Usecase
-	jwtGenerator jwt.JWTGenerator
+	userUsecase    user.Usecase
+	sessions       session.Usecase // トークン発行・リフレッシュ・ログアウト
+	accountUsecase account.Usecase // メール確認・パスワード再設定
 }
 
 func NewHandler(
 	usecase user.Usecase,
-	jwtGen jwt.JWTGenerator,
+	sessions session.Usecase,
+	accountUc account.Usecase,
 ) *UserHandler {
 	return &UserHandler{
-		userUsecase:  usecase,
-		jwtGenerator: jwtGen,
+		userUsecase:    usecase,
+		sessions:       sessions,
+		accountUsecase: accountUc,
 	}
 }
 
@@ -32,4 +36,11 @@ type Handler interface {
 	DeleteHandler() gin.HandlerFunc
 	MeHandler() gin.HandlerFunc
 	ShowHandler() gin.HandlerFunc
+	SendVerificationHandler() gin.HandlerFunc
+	VerifyEmailHandler() gin.HandlerFunc
+	ForgotPasswordHandler() gin.HandlerFunc
+	ResetPasswordHandler() gin.HandlerFunc
+	RefreshHandler() gin.HandlerFunc
+	SignOutHandler() gin.HandlerFunc
+	SignOutAllHandler() gin.HandlerFunc
 }
@@ -3,7 +3,7 @@ package user
 import (
 	"testing"
 
-	"word_app/backend/src/mocks"
+	"word_app/backend/src/mocks/usecase/session"
 	"word_app/backend/src/mocks/usecase/user"
 
 	"github.com/stretchr/testify/assert"
@@ -12,13 +12,13 @@ import (
 func TestNewUserHandler(t *testing.T) {
 	// Arrange: Create mocks for dependencies.
 	mockUserUsecase := new(user.MockUsecase)
-	mockJWTGenerator := new(mocks.MockJwtGenerator)
+	mockSessions := new(session.MockUsecase)
 
 	// Act: Create a new UserHandler instance.
-	handler := NewHandler(mockUserUsecase, mockJWTGenerator)
+	handler := NewHandler(mockUserUsecase, mockSessions, nil)
 
 	// Assert: Verify that the handler is properly initialized.
 	assert.NotNil(t, handler)
 	assert.Equal(t, mockUserUsecase, handler.userUsecase, "userUsecase should match the mock instance")
-	assert.Equal(t, mockJWTGenerator, handler.jwtGenerator, "jwtGenerator should match the mock instance")
+	assert.Equal(t, mockSessions, handler.sessions, "sessions should match the mock instance")
 }
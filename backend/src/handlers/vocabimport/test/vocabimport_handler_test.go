@@ -0,0 +1,107 @@
+package vocabimport_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"word_app/backend/config"
+	h "word_app/backend/src/handlers/vocabimport"
+	importmock "word_app/backend/src/mocks/usecase/vocabimport"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/vocabimport"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newContext(target, contentType string, body []byte) (*gin.Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, target, bytes.NewReader(body))
+	c.Request.Header.Set("Content-Type", contentType)
+	c.Set("principalKey", models.Principal{UserID: 1})
+	return c, w
+}
+
+func multipartBody(t *testing.T, filename, content string) (string, []byte) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fw, err := mw.CreateFormFile("file", filename)
+	require.NoError(t, err)
+	_, _ = fw.Write([]byte(content))
+	require.NoError(t, mw.Close())
+	return mw.FormDataContentType(), buf.Bytes()
+}
+
+func TestVocabImportHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	limits := &config.LimitsCfg{VocabImportMaxBytes: 64}
+
+	t.Run("200 - multipart のファイルを拡張子の形式でプレビューする", func(t *testing.T) {
+		uc := importmock.NewMockUsecase(t)
+		uc.On("Preview", mock.Anything, vocabimport.Input{UserID: 1, Format: vocabimport.FormatTSV, Data: []byte("apple\tmemo\n")}).
+			Return(&vocabimport.Result{Summary: vocabimport.Summary{Total: 1, New: 1}}, nil)
+
+		ct, body := multipartBody(t, "words.tsv", "apple\tmemo\n")
+		c, w := newContext("/words/import/preview", ct, body)
+		h.NewHandler(uc, limits).PreviewHandler()(c)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), `"new":1`)
+	})
+
+	t.Run("200 - 本文そのままを取り込み、report=csv なら競合レポートを返す", func(t *testing.T) {
+		uc := importmock.NewMockUsecase(t)
+		uc.On("Commit", mock.Anything, vocabimport.Input{UserID: 1, Format: vocabimport.FormatCSV, Data: []byte("apple\ndurian\n")}).
+			Return(&vocabimport.Result{Committed: true, Rows: []vocabimport.Row{
+				{Line: 1, Word: "apple", Status: vocabimport.StatusNew},
+				{Line: 2, Word: "durian", Status: vocabimport.StatusUnknown},
+			}}, nil)
+
+		c, w := newContext("/words/import?format=csv&report=csv", "text/csv", []byte("apple\ndurian\n"))
+		h.NewHandler(uc, limits).CommitHandler()(c)
+
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "text/csv; charset=utf-8", w.Header().Get("Content-Type"))
+		assert.Contains(t, w.Header().Get("Content-Disposition"), "attachment")
+		assert.Equal(t, "line,word,status,detail\n2,durian,unknown_word,\n", w.Body.String())
+	})
+
+	t.Run("413 - 上限を超えるファイル", func(t *testing.T) {
+		uc := importmock.NewMockUsecase(t)
+		c, w := newContext("/words/import/preview", "text/csv", []byte(strings.Repeat("a\n", 40)))
+		h.NewHandler(uc, limits).PreviewHandler()(c)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("400 - multipart に file が無い", func(t *testing.T) {
+		uc := importmock.NewMockUsecase(t)
+		var buf bytes.Buffer
+		mw := multipart.NewWriter(&buf)
+		_ = mw.WriteField("other", "x")
+		_ = mw.Close()
+
+		c, w := newContext("/words/import/preview", mw.FormDataContentType(), buf.Bytes())
+		h.NewHandler(uc, limits).PreviewHandler()(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestVocabImportHandler_MultipartTooLarge(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	uc := importmock.NewMockUsecase(t)
+
+	ct, body := multipartBody(t, "words.csv", strings.Repeat("apple\n", 4000))
+	c, w := newContext("/words/import/preview", ct, body)
+	h.NewHandler(uc, &config.LimitsCfg{VocabImportMaxBytes: 64}).PreviewHandler()(c)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+}
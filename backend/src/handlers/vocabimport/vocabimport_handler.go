@@ -0,0 +1,135 @@
+package vocabimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"word_app/backend/config"
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/vocabimport"
+
+	"github.com/gin-gonic/gin"
+)
+
+// multipart で本文に上乗せされる分の許容量
+const multipartOverhead = 16 * 1024
+
+type VocabImportHandler struct {
+	importUsecase vocabimport.Usecase
+	limits        *config.LimitsCfg
+}
+
+func NewHandler(importUsecase vocabimport.Usecase, limits *config.LimitsCfg) *VocabImportHandler {
+	return &VocabImportHandler{importUsecase: importUsecase, limits: limits}
+}
+
+type Handler interface {
+	PreviewHandler() gin.HandlerFunc
+	CommitHandler() gin.HandlerFunc
+}
+
+// PreviewHandler は POST /words/import/preview。保存せずに各行の判定を返す
+func (h *VocabImportHandler) PreviewHandler() gin.HandlerFunc {
+	return h.handle(vocabimport.Usecase.Preview)
+}
+
+// CommitHandler は POST /words/import。プレビューと同じファイルを送って保存する
+func (h *VocabImportHandler) CommitHandler() gin.HandlerFunc {
+	return h.handle(vocabimport.Usecase.Commit)
+}
+
+// handle はファイルを読んでユースケースに渡す。
+// ファイルは multipart の file 項目か、リクエスト本文そのもの（text/csv など）で受け取る。
+// ?format=csv|tsv で区切りを指定（省略時は拡張子か 1 行目から判定）、
+// ?report=csv なら JSON の代わりに取り込めなかった行のレポートを CSV で返す。
+func (h *VocabImportHandler) handle(
+	run func(vocabimport.Usecase, context.Context, vocabimport.Input) (*vocabimport.Result, error),
+) gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		data, name, err := h.readFile(c)
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		format := c.Query("format")
+		if format == "" {
+			format = formatFromName(name)
+		}
+
+		res, err := run(h.importUsecase, c.Request.Context(), vocabimport.Input{UserID: userID, Format: format, Data: data})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		if c.Query("report") != "csv" {
+			c.JSON(http.StatusOK, res)
+			return
+		}
+		c.Header("Content-Type", "text/csv; charset=utf-8")
+		c.Header("Content-Disposition", `attachment; filename="import-report.csv"`)
+		c.Status(http.StatusOK)
+		if err := vocabimport.WriteReport(c.Writer, res); err != nil {
+			_ = c.Error(err)
+		}
+	})
+}
+
+func (h *VocabImportHandler) maxBytes() int {
+	if h.limits.VocabImportMaxBytes > 0 {
+		return h.limits.VocabImportMaxBytes
+	}
+	return 512 * 1024
+}
+
+// readFile は上限 + 1 バイトまで読み、超えていれば 413 にする
+func (h *VocabImportHandler) readFile(c *gin.Context) ([]byte, string, error) {
+	var (
+		r    io.Reader = c.Request.Body
+		name string
+	)
+	limit := h.maxBytes()
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		// 境界やヘッダの分だけ余裕を見て、巨大なアップロードは読み切る前に止める
+		c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, int64(limit)+multipartOverhead)
+		fh, err := c.FormFile("file")
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				return nil, "", apperror.TooLargeRequestsf(fmt.Sprintf("file too large (limit %d bytes)", limit), nil)
+			}
+			return nil, "", apperror.BadRequestf("file is required", err)
+		}
+		f, err := fh.Open()
+		if err != nil {
+			return nil, "", apperror.BadRequestf("invalid file", err)
+		}
+		defer func() { _ = f.Close() }()
+		r, name = f, fh.Filename
+	}
+
+	data, err := io.ReadAll(&io.LimitedReader{R: r, N: int64(limit) + 1})
+	if err != nil {
+		return nil, "", apperror.BadRequestf("invalid body", err)
+	}
+	if len(data) > limit {
+		return nil, "", apperror.TooLargeRequestsf(fmt.Sprintf("file too large (limit %d bytes)", limit), nil)
+	}
+	return data, name, nil
+}
+
+func formatFromName(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return vocabimport.FormatCSV
+	case ".tsv", ".tab":
+		return vocabimport.FormatTSV
+	}
+	return vocabimport.FormatAuto
+}
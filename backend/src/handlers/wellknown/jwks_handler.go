@@ -0,0 +1,36 @@
+package wellknown
+
+import (
+	"net/http"
+
+	"word_app/backend/src/infrastructure/jwt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// KeySource は公開できる検証鍵を返す（*jwt.KeySet）
+type KeySource interface {
+	JWKS() jwt.JWKSet
+}
+
+type WellKnownHandler struct {
+	keys KeySource
+}
+
+func NewHandler(keys KeySource) *WellKnownHandler {
+	return &WellKnownHandler{keys: keys}
+}
+
+type Handler interface {
+	JWKSHandler() gin.HandlerFunc
+}
+
+// JWKSHandler は GET /.well-known/jwks.json。
+// ローテーション中は新旧の鍵を両方返すので、利用側は kid で選べばよい。
+func (h *WellKnownHandler) JWKSHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 鍵の入れ替えに追従できるよう、キャッシュは短めにする
+		c.Header("Cache-Control", "public, max-age=300")
+		c.JSON(http.StatusOK, h.keys.JWKS())
+	}
+}
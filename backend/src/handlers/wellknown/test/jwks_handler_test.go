@@ -0,0 +1,40 @@
+package wellknown_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"word_app/backend/src/handlers/wellknown"
+	"word_app/backend/src/infrastructure/jwt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticKeys jwt.JWKSet
+
+func (s staticKeys) JWKS() jwt.JWKSet { return jwt.JWKSet(s) }
+
+func TestJWKSHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	keys := staticKeys{Keys: []jwt.JWK{
+		{Kty: "OKP", Kid: "new", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "xx"},
+		{Kty: "RSA", Kid: "old", Use: "sig", Alg: "RS256", N: "nn", E: "AQAB"},
+	}}
+	r := gin.New()
+	r.GET("/.well-known/jwks.json", wellknown.NewHandler(keys).JWKSHandler())
+
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+
+	require.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Header().Get("Cache-Control"), "max-age=")
+	var got jwt.JWKSet
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+	assert.Equal(t, jwt.JWKSet(keys), got)
+	// RSA 用のメンバーは Ed25519 の鍵には出さない
+	assert.NotContains(t, w.Body.String(), `"n":""`)
+}
@@ -5,10 +5,9 @@ import (
 	"net/http"
 	"strconv"
 
-	"word_app/backend/src/handlers/httperr"
-	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/domain"
 	"word_app/backend/src/models"
-	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/wordproposal"
 
 	"github.com/gin-gonic/gin"
 	"github.com/sirupsen/logrus"
@@ -18,18 +17,23 @@ import (
 func (h *Handler) DeleteHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
-		principal, ok := jwt.GetPrincipal(c)
-		if !ok || !principal.IsAdmin {
-			httperr.Write(c, apperror.Unauthorizedf("unauthorized", nil))
+		userID, decision, ok := h.decideEdit(c)
+		if !ok {
 			return
 		}
 
-		req, err := h.parseDeleteWordRequest(c, principal.UserID)
+		req, err := h.parseDeleteWordRequest(c, userID)
 		if err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
 
+		// 一般ユーザーは提案として保存し、承認を待つ
+		if decision == domain.MasterEditModerate {
+			h.submitProposal(c, wordproposal.InputSubmit{UserID: userID, Action: domain.ProposalActionDelete, Delete: req})
+			return
+		}
+
 		// 削除処理を呼び出し
 		response, err := h.wordService.DeleteWord(ctx, req)
 		if err != nil {
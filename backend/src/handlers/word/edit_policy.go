@@ -0,0 +1,43 @@
+package word
+
+import (
+	"net/http"
+
+	"word_app/backend/src/domain"
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/wordproposal"
+
+	"github.com/gin-gonic/gin"
+)
+
+// decideEdit は単語マスタを変更できるかを決める。
+// 変更できないときはレスポンスを書いて ok=false を返す
+func (h *Handler) decideEdit(c *gin.Context) (userID int, d domain.MasterEdit, ok bool) {
+	principal, ok := jwt.GetPrincipal(c)
+	if !ok {
+		httperr.Write(c, apperror.Unauthorizedf("unauthorized", nil))
+		return 0, domain.MasterEditDeny, false
+	}
+	d, err := h.policy.Decide(c.Request.Context(), principal.UserID)
+	if err != nil {
+		httperr.Write(c, err)
+		return 0, domain.MasterEditDeny, false
+	}
+	if d == domain.MasterEditDeny {
+		httperr.Write(c, apperror.Unauthorizedf("unauthorized", nil))
+		return 0, domain.MasterEditDeny, false
+	}
+	return principal.UserID, d, true
+}
+
+// submitProposal は直接編集できないユーザーの変更を提案として保存し、202 を返す
+func (h *Handler) submitProposal(c *gin.Context, in wordproposal.InputSubmit) {
+	p, err := h.proposals.Submit(c.Request.Context(), in)
+	if err != nil {
+		httperr.Write(c, err)
+		return
+	}
+	c.JSON(http.StatusAccepted, gin.H{"proposal": p})
+}
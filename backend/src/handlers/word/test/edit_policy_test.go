@@ -0,0 +1,115 @@
+package word_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"word_app/backend/src/domain"
+	h "word_app/backend/src/handlers/word"
+	"word_app/backend/src/mocks"
+	policymock "word_app/backend/src/mocks/usecase/editpolicy"
+	proposalmock "word_app/backend/src/mocks/usecase/wordproposal"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/wordproposal"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newEditContext(method, target string, body any, params ...gin.Param) (*gin.Context, *httptest.ResponseRecorder) {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, &buf)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = params
+	c.Set("principalKey", models.Principal{UserID: 1})
+	return c, w
+}
+
+var createBody = models.CreateWordRequest{
+	Name: "apple",
+	WordInfos: []models.WordInfo{
+		{PartOfSpeechID: 1, JapaneseMeans: []models.JapaneseMean{{Name: "りんご"}}},
+	},
+}
+
+func TestEditPolicy_CreateHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Allow - そのまま作成する", func(t *testing.T) {
+		svc := new(mocks.WordService)
+		policy := policymock.NewMockPolicy(t)
+		proposals := proposalmock.NewMockUsecase(t)
+		policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditAllow, nil)
+		svc.On("CreateWord", mock.Anything, mock.MatchedBy(func(r *models.CreateWordRequest) bool {
+			return r.Name == "apple" && r.UserID == 1
+		})).Return(&models.CreateWordResponse{ID: 3, Name: "apple"}, nil)
+
+		c, w := newEditContext(http.MethodPost, "/words/new", createBody)
+		h.NewHandler(svc, policy, proposals).CreateHandler()(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("Moderate - 提案として保存し 202", func(t *testing.T) {
+		svc := new(mocks.WordService)
+		policy := policymock.NewMockPolicy(t)
+		proposals := proposalmock.NewMockUsecase(t)
+		policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditModerate, nil)
+		proposals.On("Submit", mock.Anything, mock.MatchedBy(func(in wordproposal.InputSubmit) bool {
+			return in.UserID == 1 && in.Action == domain.ProposalActionCreate && in.Create.Name == "apple"
+		})).Return(&domain.WordProposal{ID: 7, Status: domain.ProposalStatusPending}, nil)
+
+		c, w := newEditContext(http.MethodPost, "/words/new", createBody)
+		h.NewHandler(svc, policy, proposals).CreateHandler()(c)
+
+		require.Equal(t, http.StatusAccepted, w.Code)
+		var got struct{ Proposal domain.WordProposal }
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &got))
+		assert.Equal(t, 7, got.Proposal.ID)
+		svc.AssertNotCalled(t, "CreateWord", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Deny - 401", func(t *testing.T) {
+		svc := new(mocks.WordService)
+		policy := policymock.NewMockPolicy(t)
+		proposals := proposalmock.NewMockUsecase(t)
+		policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditDeny, nil)
+
+		c, w := newEditContext(http.MethodPost, "/words/new", createBody)
+		h.NewHandler(svc, policy, proposals).CreateHandler()(c)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		svc.AssertNotCalled(t, "CreateWord", mock.Anything, mock.Anything)
+	})
+}
+
+func TestEditPolicy_DeleteHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("Moderate - 削除も提案になる", func(t *testing.T) {
+		svc := new(mocks.WordService)
+		policy := policymock.NewMockPolicy(t)
+		proposals := proposalmock.NewMockUsecase(t)
+		policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditModerate, nil)
+		proposals.On("Submit", mock.Anything, wordproposal.InputSubmit{
+			UserID: 1, Action: domain.ProposalActionDelete, Delete: &models.DeleteWordRequest{WordID: 10, UserID: 1},
+		}).Return(&domain.WordProposal{ID: 8}, nil)
+
+		c, w := newEditContext(http.MethodDelete, "/words/10", nil, gin.Param{Key: "id", Value: "10"})
+		h.NewHandler(svc, policy, proposals).DeleteHandler()(c)
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		svc.AssertNotCalled(t, "DeleteWord", mock.Anything, mock.Anything)
+	})
+}
@@ -3,10 +3,9 @@ package word
 import (
 	"net/http"
 
-	"word_app/backend/src/handlers/httperr"
-	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/domain"
 	"word_app/backend/src/models"
-	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/wordproposal"
 	"word_app/backend/src/validators/word"
 
 	"github.com/gin-gonic/gin"
@@ -16,13 +15,12 @@ import (
 func (h *Handler) UpdateHandler() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
-		principal, ok := jwt.GetPrincipal(c)
-		if !ok || !principal.IsAdmin {
-			httperr.Write(c, apperror.Unauthorizedf("unauthorized", nil))
+		userID, decision, ok := h.decideEdit(c)
+		if !ok {
 			return
 		}
 		// リクエストを解析
-		req, err := h.parseUpdateWordRequest(c, principal.UserID)
+		req, err := h.parseUpdateWordRequest(c, userID)
 		if err != nil {
 			logrus.Errorf("Failed to parse request: %v", err)
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -36,6 +34,12 @@ func (h *Handler) UpdateHandler() gin.HandlerFunc {
 			return
 		}
 
+		// 一般ユーザーは提案として保存し、承認を待つ
+		if decision == domain.MasterEditModerate {
+			h.submitProposal(c, wordproposal.InputSubmit{UserID: userID, Action: domain.ProposalActionUpdate, Update: req})
+			return
+		}
+
 		// サービス層にリクエストを渡して処理
 		response, err := h.wordService.UpdateWord(ctx, req)
 		if err != nil {
@@ -2,16 +2,24 @@ package word
 
 import (
 	"word_app/backend/src/interfaces/http/word"
+	"word_app/backend/src/usecase/editpolicy"
+	"word_app/backend/src/usecase/wordproposal"
 )
 
 type Handler struct {
 	wordService word.Service
+	policy      editpolicy.Policy
+	proposals   wordproposal.Usecase
 }
 
 func NewHandler(
 	wordService word.Service,
+	policy editpolicy.Policy,
+	proposals wordproposal.Usecase,
 ) *Handler {
 	return &Handler{
 		wordService: wordService,
+		policy:      policy,
+		proposals:   proposals,
 	}
 }
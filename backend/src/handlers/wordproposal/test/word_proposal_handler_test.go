@@ -0,0 +1,80 @@
+package wordproposal_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"word_app/backend/src/domain"
+	h "word_app/backend/src/handlers/wordproposal"
+	proposalmock "word_app/backend/src/mocks/usecase/wordproposal"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/wordproposal"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newContext(method, target string, body any, params ...gin.Param) (*gin.Context, *httptest.ResponseRecorder) {
+	var buf bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&buf).Encode(body)
+	}
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(method, target, &buf)
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = params
+	c.Set("principalKey", models.Principal{UserID: 2})
+	return c, w
+}
+
+func TestProposalHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("200 - 一覧", func(t *testing.T) {
+		uc := proposalmock.NewMockUsecase(t)
+		uc.On("List", mock.Anything, wordproposal.InputList{UserID: 2, Status: "pending", Page: 3}).
+			Return([]*domain.WordProposal{{ID: 1}}, nil)
+
+		c, w := newContext(http.MethodGet, "/words/proposals?status=pending&page=3", nil)
+		h.NewHandler(uc).ListHandler()(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("200 - 承認（body なし）", func(t *testing.T) {
+		uc := proposalmock.NewMockUsecase(t)
+		uc.On("Approve", mock.Anything, wordproposal.InputReview{ReviewerID: 2, ProposalID: 5}).
+			Return(&domain.WordProposal{ID: 5, Status: domain.ProposalStatusApproved}, nil)
+
+		c, w := newContext(http.MethodPost, "/words/proposals/5/approve", nil, gin.Param{Key: "id", Value: "5"})
+		h.NewHandler(uc).ApproveHandler()(c)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("409 - 却下済み", func(t *testing.T) {
+		uc := proposalmock.NewMockUsecase(t)
+		uc.On("Reject", mock.Anything, wordproposal.InputReview{ReviewerID: 2, ProposalID: 5, Note: "dup"}).
+			Return(nil, apperror.Conflictf("proposal is already reviewed", nil))
+
+		c, w := newContext(http.MethodPost, "/words/proposals/5/reject", map[string]string{"note": "dup"},
+			gin.Param{Key: "id", Value: "5"})
+		h.NewHandler(uc).RejectHandler()(c)
+
+		assert.Equal(t, http.StatusConflict, w.Code)
+	})
+
+	t.Run("400 - 不正な ID", func(t *testing.T) {
+		uc := proposalmock.NewMockUsecase(t)
+		c, w := newContext(http.MethodPost, "/words/proposals/x/approve", nil, gin.Param{Key: "id", Value: "x"})
+		h.NewHandler(uc).ApproveHandler()(c)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
@@ -0,0 +1,86 @@
+package wordproposal
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"word_app/backend/src/domain"
+	"word_app/backend/src/handlers/httperr"
+	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/wordproposal"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ProposalHandler struct {
+	proposalUsecase wordproposal.Usecase
+}
+
+func NewHandler(proposalUsecase wordproposal.Usecase) *ProposalHandler {
+	return &ProposalHandler{proposalUsecase: proposalUsecase}
+}
+
+type Handler interface {
+	ListHandler() gin.HandlerFunc
+	ApproveHandler() gin.HandlerFunc
+	RejectHandler() gin.HandlerFunc
+}
+
+type reviewRequest struct {
+	Note string `json:"note"`
+}
+
+// ListHandler は提案の一覧（?status=pending|approved|rejected&page=）を返す。
+// 審査できるユーザーには全員分、それ以外には自分の提案だけ
+func (h *ProposalHandler) ListHandler() gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		page, _ := strconv.Atoi(c.Query("page"))
+		list, err := h.proposalUsecase.List(c.Request.Context(), wordproposal.InputList{
+			UserID: userID,
+			Status: c.Query("status"),
+			Page:   page,
+		})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"proposals": list})
+	})
+}
+
+// ApproveHandler は提案を承認して単語マスタへ反映する
+func (h *ProposalHandler) ApproveHandler() gin.HandlerFunc {
+	return h.review(h.proposalUsecase.Approve)
+}
+
+// RejectHandler は提案を却下する（body の note は任意）
+func (h *ProposalHandler) RejectHandler() gin.HandlerFunc {
+	return h.review(h.proposalUsecase.Reject)
+}
+
+func (h *ProposalHandler) review(
+	do func(ctx context.Context, in wordproposal.InputReview) (*domain.WordProposal, error),
+) gin.HandlerFunc {
+	return jwt.WithUser(func(c *gin.Context, userID int) {
+		id, err := strconv.Atoi(c.Param("id"))
+		if err != nil || id <= 0 {
+			httperr.Write(c, apperror.Validationf("invalid proposal ID", nil))
+			return
+		}
+		var req reviewRequest
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindJSON(&req); err != nil {
+				httperr.Write(c, apperror.BadRequestf("invalid request body", err))
+				return
+			}
+		}
+		p, err := do(c.Request.Context(), wordproposal.InputReview{ReviewerID: userID, ProposalID: id, Note: req.Note})
+		if err != nil {
+			httperr.Write(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, gin.H{"proposal": p})
+	})
+}
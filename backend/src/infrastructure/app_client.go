@@ -134,6 +134,16 @@ func (c *appClient) GetNextOrResume(_ context.Context, userID int, req *models.G
 	panic("unimplemented")
 }
 
+// CancelQuiz implements ClientInterface.
+func (c *appClient) CancelQuiz(_ context.Context, userID int, quizID int, req *models.CancelQuizReq) (*models.CancelQuizResponse, error) {
+	panic("unimplemented")
+}
+
+// ListUnfinished implements ClientInterface.
+func (c *appClient) ListUnfinished(_ context.Context, userID int) ([]models.UnfinishedQuiz, error) {
+	panic("unimplemented")
+}
+
 // BulkRegister implements interfaces.ClientInterface.
 func (c *appClient) BulkRegister(_ context.Context, userID int, words []string) (*models.BulkRegisterResponse, error) {
 	panic("unimplemented")
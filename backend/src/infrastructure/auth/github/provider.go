@@ -0,0 +1,132 @@
+// Package github は GitHub でのログインを扱う。
+// GitHub は OIDC の ID トークンを発行しないので、OAuth2 のアクセストークンで REST API からユーザーを引く。
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"golang.org/x/oauth2"
+	githubOAuth "golang.org/x/oauth2/github"
+
+	"word_app/backend/config"
+	"word_app/backend/src/utils/tempjwt"
+)
+
+const (
+	ProviderName   = "github"
+	defaultAPIBase = "https://api.github.com"
+)
+
+var ErrNoIDToken = errors.New("github: no id_token to validate")
+
+type AuthProvider struct {
+	cfg     *oauth2.Config
+	apiBase string
+}
+
+func NewProvider(c config.OIDCProviderCfg) *AuthProvider {
+	scopes := c.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"read:user", "user:email"}
+	}
+	return &AuthProvider{
+		cfg: &oauth2.Config{
+			ClientID:     c.ClientID,
+			ClientSecret: c.ClientSecret,
+			RedirectURL:  c.RedirectURI,
+			Scopes:       scopes,
+			Endpoint:     githubOAuth.Endpoint,
+		},
+		apiBase: defaultAPIBase,
+	}
+}
+
+func NewTestProvider(cfg *oauth2.Config, apiBase string) *AuthProvider {
+	return &AuthProvider{cfg: cfg, apiBase: apiBase}
+}
+
+// AuthURL は nonce を使わない（ID トークンが無いため）。CSRF 対策は state で行う
+func (p *AuthProvider) AuthURL(state, _ string) string {
+	return p.cfg.AuthCodeURL(state)
+}
+
+// Exchange が返す ID トークンは常に nil（GitHub は ID トークンを発行しない）
+func (p *AuthProvider) Exchange(ctx context.Context, code string) (*tempjwt.Identity, *oidc.IDToken, error) {
+	tok, err := p.cfg.Exchange(ctx, code)
+	if err != nil {
+		return nil, nil, err
+	}
+	client := p.cfg.Client(ctx, tok)
+
+	var u struct {
+		ID    int64   `json:"id"`
+		Login string  `json:"login"`
+		Name  string  `json:"name"`
+		Email *string `json:"email"`
+	}
+	if err := p.get(ctx, client, "/user", &u); err != nil {
+		return nil, nil, err
+	}
+	if u.ID == 0 {
+		return nil, nil, errors.New("github: user id missing")
+	}
+	// 公開アドレスは確認済みとは限らないので、確認済みの primary を使う
+	email, err := p.primaryEmail(ctx, client)
+	if err != nil {
+		return nil, nil, err
+	}
+	name := u.Name
+	if name == "" {
+		name = u.Login
+	}
+	return &tempjwt.Identity{
+		Provider: ProviderName,
+		Subject:  strconv.FormatInt(u.ID, 10),
+		Email:    email,
+		Name:     name,
+	}, nil, nil
+}
+
+func (p *AuthProvider) ValidateNonce(_ *oidc.IDToken, _ string) error {
+	return ErrNoIDToken
+}
+
+func (p *AuthProvider) primaryEmail(ctx context.Context, client *http.Client) (*string, error) {
+	var emails []struct {
+		Email    string `json:"email"`
+		Primary  bool   `json:"primary"`
+		Verified bool   `json:"verified"`
+	}
+	if err := p.get(ctx, client, "/user/emails", &emails); err != nil {
+		return nil, err
+	}
+	for _, e := range emails {
+		if e.Primary && e.Verified {
+			return &e.Email, nil
+		}
+	}
+	return nil, nil
+}
+
+func (p *AuthProvider) get(ctx context.Context, client *http.Client, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.apiBase+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("github: GET %s: %s", path, res.Status)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
@@ -8,6 +8,7 @@ import (
 	"golang.org/x/oauth2"
 
 	"word_app/backend/config"
+	"word_app/backend/src/utils/oauthutil"
 	"word_app/backend/src/utils/tempjwt"
 )
 
@@ -21,13 +22,10 @@ type AuthProvider struct {
 	verifier *oidc.IDTokenVerifier
 }
 
-type Provider interface {
-	AuthURL(state, nonce string) string
-	Exchange(ctx context.Context, code string) (*tempjwt.Identity, error)
-	ValidateNonce(idTok *oidc.IDToken, expected string) error
-}
+// ProviderName は ExternalAuth.provider と /users/auth/:provider に使う名前
+const ProviderName = "line"
 
-func NewProvider(c config.LineOAuthCfg) (Provider, error) {
+func NewProvider(c config.LineOAuthCfg) (*AuthProvider, error) {
 	oidcProvider, err := oidc.NewProvider(context.Background(), "https://access.line.me")
 	if err != nil {
 		return nil, err
@@ -63,20 +61,20 @@ func (p *AuthProvider) AuthURL(state, nonce string) string {
 	)
 }
 
-func (p *AuthProvider) Exchange(ctx context.Context, code string) (*tempjwt.Identity, error) {
+func (p *AuthProvider) Exchange(ctx context.Context, code string) (*tempjwt.Identity, *oidc.IDToken, error) {
 	tok, err := p.cfg.Exchange(ctx, code)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	rawID, ok := tok.Extra("id_token").(string)
 	if !ok {
-		return nil, errors.New("id_token missing")
+		return nil, nil, errors.New("id_token missing")
 	}
 
 	idTok, err := p.verifier.Verify(ctx, rawID)
 	if err != nil {
-		return nil, errors.New("verify missing")
+		return nil, nil, errors.New("verify missing")
 	}
 
 	var cl struct {
@@ -85,26 +83,17 @@ func (p *AuthProvider) Exchange(ctx context.Context, code string) (*tempjwt.Iden
 		Name  string  `json:"name"`
 	}
 	if err := idTok.Claims(&cl); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	return &tempjwt.Identity{
-		Provider: "line",
+		Provider: ProviderName,
 		Subject:  cl.Sub,
 		Email:    cl.Email,
 		Name:     cl.Name,
-	}, nil
+	}, idTok, nil
 }
 
 func (p *AuthProvider) ValidateNonce(idTok *oidc.IDToken, expected string) error {
-	var cl struct {
-		Nonce string `json:"nonce"`
-	}
-	if err := idTok.Claims(&cl); err != nil {
-		return err
-	}
-	if cl.Nonce != expected {
-		return errors.New("oidc: nonce mismatch")
-	}
-	return nil
+	return oauthutil.CheckNonce(idTok, expected)
 }
@@ -302,14 +302,16 @@ func TestProvider_Exchange(t *testing.T) {
 			p, closeFn := tt.setUp(t)
 			defer closeFn()
 
-			id, err := p.Exchange(context.Background(), "dummy-code")
+			id, idTok, err := p.Exchange(context.Background(), "dummy-code")
 			if tt.ok {
 				require.NoError(t, err)
 				require.NotNil(t, id)
 				require.Equal(t, "line-user-sub", id.Subject)
+				require.NotNil(t, idTok)
 			} else {
 				require.ErrorContains(t, err, tt.errContains)
 				require.Nil(t, id)
+				require.Nil(t, idTok)
 			}
 		})
 	}
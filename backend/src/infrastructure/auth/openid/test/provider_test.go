@@ -0,0 +1,283 @@
+package openid_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/config"
+	"word_app/backend/src/infrastructure/auth/openid"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
+	jwti "word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/utils/oauthutil"
+)
+
+const (
+	clientID     = "client-id"
+	clientSecret = "client-secret"
+	redirectURI  = "https://app.example/auth/mock/callback"
+)
+
+/* ------------------------------------------------------------------ */
+/*                       ローカルのモック issuer                        */
+/* ------------------------------------------------------------------ */
+
+// mockIssuer は discovery / authorize / token / jwks を持つ最小の OIDC issuer。
+// authorize で発行した code は 1 回だけ token と交換できる。
+type mockIssuer struct {
+	srv  *httptest.Server
+	keys *jwti.KeySet
+
+	mu    sync.Mutex
+	codes map[string]string // code -> nonce
+
+	// テストごとに変えるクレーム
+	claims   jwt.MapClaims
+	audience string
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	key, err := jwti.ParsePrivateKeyPEM(pem.EncodeToMemory(&pem.Block{
+		Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv),
+	}))
+	require.NoError(t, err)
+	keys, err := jwti.NewKeySet(key)
+	require.NoError(t, err)
+
+	m := &mockIssuer{
+		keys:     keys,
+		codes:    map[string]string{},
+		audience: clientID,
+		claims: jwt.MapClaims{
+			"sub":            "mock-user-1",
+			"email":          "alice@example.com",
+			"email_verified": true,
+			"name":           "Alice",
+		},
+	}
+	mux := http.NewServeMux()
+	m.srv = httptest.NewServer(mux)
+	t.Cleanup(m.srv.Close)
+
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, map[string]any{
+			"issuer":                                m.srv.URL,
+			"authorization_endpoint":                m.srv.URL + "/authorize",
+			"token_endpoint":                        m.srv.URL + "/token",
+			"jwks_uri":                              m.srv.URL + "/keys",
+			"response_types_supported":              []string{"code"},
+			"subject_types_supported":               []string{"public"},
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	// 公開鍵はアプリ側の JWKS 出力をそのまま使う
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, m.keys.JWKS())
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("client_id") != clientID || q.Get("redirect_uri") != redirectURI || q.Get("response_type") != "code" {
+			http.Error(w, "invalid_request", http.StatusBadRequest)
+			return
+		}
+		code := "code-" + q.Get("state")
+		m.mu.Lock()
+		m.codes[code] = q.Get("nonce")
+		m.mu.Unlock()
+		http.Redirect(w, r, redirectURI+"?"+url.Values{"code": {code}, "state": {q.Get("state")}}.Encode(), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		id, secret, ok := r.BasicAuth()
+		if !ok {
+			id, secret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+		if id != clientID || secret != clientSecret {
+			http.Error(w, `{"error":"invalid_client"}`, http.StatusUnauthorized)
+			return
+		}
+		m.mu.Lock()
+		nonce, ok := m.codes[r.PostForm.Get("code")]
+		delete(m.codes, r.PostForm.Get("code"))
+		m.mu.Unlock()
+		if !ok || r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("redirect_uri") != redirectURI {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		idToken := m.idToken(t, nonce)
+		writeJSON(w, map[string]any{
+			"access_token": "access",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	return m
+}
+
+func (m *mockIssuer) idToken(t *testing.T, nonce string) string {
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":   m.srv.URL,
+		"aud":   m.audience,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+		"nonce": nonce,
+	}
+	for k, v := range m.claims {
+		claims[k] = v
+	}
+	s, err := m.keys.Signing().Sign(claims)
+	require.NoError(t, err)
+	return s
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (m *mockIssuer) cfg(name string) config.OIDCProviderCfg {
+	return config.OIDCProviderCfg{
+		Name: name, Issuer: m.srv.URL,
+		ClientID: clientID, ClientSecret: clientSecret, RedirectURI: redirectURI,
+	}
+}
+
+// authorize はブラウザの代わりに AuthURL を開き、リダイレクト先から code を取り出す
+func authorize(t *testing.T, authURL, wantState string) string {
+	t.Helper()
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	res, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer func() { _ = res.Body.Close() }()
+	require.Equal(t, http.StatusFound, res.StatusCode)
+
+	loc, err := url.Parse(res.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, wantState, loc.Query().Get("state"))
+	return loc.Query().Get("code")
+}
+
+/* ------------------------------------------------------------------ */
+/*                               tests                                */
+/* ------------------------------------------------------------------ */
+
+func TestOIDCLogin_Integration(t *testing.T) {
+	ctx := context.Background()
+	iss := newMockIssuer(t)
+
+	// 設定 → レジストリ → 名前で引く、まで本番と同じ経路を通す
+	reg := authprovider.NewRegistryFromConfig(ctx, &config.Config{OIDC: []config.OIDCProviderCfg{iss.cfg("mock")}})
+	require.Equal(t, []string{"mock"}, reg.Names())
+	p, ok := reg.Get("mock")
+	require.True(t, ok)
+
+	code := authorize(t, p.AuthURL("state-1", "nonce-1"), "state-1")
+
+	id, idTok, err := p.Exchange(ctx, code)
+	require.NoError(t, err)
+	assert.Equal(t, "mock", id.Provider)
+	assert.Equal(t, "mock-user-1", id.Subject)
+	require.NotNil(t, id.Email)
+	assert.Equal(t, "alice@example.com", *id.Email)
+	assert.Equal(t, "Alice", id.Name)
+
+	// 発行された ID トークンの nonce はログイン開始時の値
+	require.NotNil(t, idTok)
+	assert.NoError(t, p.ValidateNonce(idTok, "nonce-1"))
+	// 別のログイン（cookie の nonce が違う）や、ログイン開始を経ていない（cookie が無い）コールバックでは使えない
+	assert.ErrorIs(t, p.ValidateNonce(idTok, "nonce-2"), oauthutil.ErrNonceMismatch)
+	assert.ErrorIs(t, p.ValidateNonce(idTok, ""), oauthutil.ErrNonceMismatch)
+
+	// 2 回目のログインで取った ID トークンは、1 回目の nonce では通らない
+	code2 := authorize(t, p.AuthURL("state-2", "nonce-2"), "state-2")
+	_, idTok2, err := p.Exchange(ctx, code2)
+	require.NoError(t, err)
+	assert.ErrorIs(t, p.ValidateNonce(idTok2, "nonce-1"), oauthutil.ErrNonceMismatch)
+
+	// code は使い回せない
+	_, _, err = p.Exchange(ctx, code)
+	assert.Error(t, err)
+}
+
+func TestOIDCProvider_Exchange(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("未確認のメールアドレスは使わない", func(t *testing.T) {
+		iss := newMockIssuer(t)
+		iss.claims["email_verified"] = false
+		p, err := openid.NewProvider(ctx, iss.cfg("mock"))
+		require.NoError(t, err)
+
+		id, _, err := p.Exchange(ctx, authorize(t, p.AuthURL("s", "n"), "s"))
+		require.NoError(t, err)
+		assert.Nil(t, id.Email)
+	})
+
+	t.Run("name が無ければ preferred_username", func(t *testing.T) {
+		iss := newMockIssuer(t)
+		delete(iss.claims, "name")
+		iss.claims["preferred_username"] = "alice"
+		p, err := openid.NewProvider(ctx, iss.cfg("mock"))
+		require.NoError(t, err)
+
+		id, _, err := p.Exchange(ctx, authorize(t, p.AuthURL("s", "n"), "s"))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", id.Name)
+	})
+
+	t.Run("別のクライアント宛ての ID トークンは拒否", func(t *testing.T) {
+		iss := newMockIssuer(t)
+		iss.audience = "someone-else"
+		p, err := openid.NewProvider(ctx, iss.cfg("mock"))
+		require.NoError(t, err)
+
+		_, _, err = p.Exchange(ctx, authorize(t, p.AuthURL("s", "n"), "s"))
+		assert.ErrorContains(t, err, "verify id_token")
+	})
+
+	t.Run("クライアントシークレットが違えば拒否", func(t *testing.T) {
+		iss := newMockIssuer(t)
+		c := iss.cfg("mock")
+		c.ClientSecret = "wrong"
+		p, err := openid.NewProvider(ctx, c)
+		require.NoError(t, err)
+
+		_, _, err = p.Exchange(ctx, authorize(t, p.AuthURL("s", "n"), "s"))
+		assert.Error(t, err)
+	})
+}
+
+func TestNewRegistryFromConfig_DiscoveryFailure(t *testing.T) {
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+	iss := newMockIssuer(t)
+
+	// 落ちている issuer だけ外し、他のプロバイダは使える
+	reg := authprovider.NewRegistryFromConfig(context.Background(), &config.Config{OIDC: []config.OIDCProviderCfg{
+		{Name: "down", Issuer: down.URL, ClientID: clientID},
+		iss.cfg("mock"),
+	}})
+	assert.Equal(t, []string{"mock"}, reg.Names())
+	_, ok := reg.Get("down")
+	assert.False(t, ok)
+}
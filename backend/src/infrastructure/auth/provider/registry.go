@@ -0,0 +1,85 @@
+// Package provider はソーシャルログインの認証プロバイダを名前で引けるようにまとめる。
+// 名前は /users/auth/:provider のパスと ExternalAuth.provider にそのまま使う。
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/sirupsen/logrus"
+
+	"word_app/backend/config"
+	"word_app/backend/src/infrastructure/auth/github"
+	"word_app/backend/src/infrastructure/auth/line"
+	"word_app/backend/src/infrastructure/auth/openid"
+	"word_app/backend/src/utils/tempjwt"
+)
+
+type Provider interface {
+	AuthURL(state, nonce string) string
+	// Exchange は認可コードをトークンに替えて利用者を返す。
+	// ID トークンを発行するプロバイダは検証済みの ID トークンも返す（OAuth2 だけのプロバイダは nil）
+	Exchange(ctx context.Context, code string) (*tempjwt.Identity, *oidc.IDToken, error)
+	ValidateNonce(idTok *oidc.IDToken, expected string) error
+}
+
+type Registry interface {
+	Get(name string) (Provider, bool)
+	// Names は登録済みのプロバイダ名（昇順）
+	Names() []string
+}
+
+type ProviderRegistry struct {
+	providers map[string]Provider
+}
+
+func NewRegistry() *ProviderRegistry {
+	return &ProviderRegistry{providers: map[string]Provider{}}
+}
+
+// Register は同じ名前があれば置き換える。名前は小文字で扱う
+func (r *ProviderRegistry) Register(name string, p Provider) {
+	r.providers[strings.ToLower(name)] = p
+}
+
+func (r *ProviderRegistry) Get(name string) (Provider, bool) {
+	p, ok := r.providers[strings.ToLower(name)]
+	return p, ok
+}
+
+func (r *ProviderRegistry) Names() []string {
+	names := make([]string, 0, len(r.providers))
+	for n := range r.providers {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewRegistryFromConfig は設定されたプロバイダを登録する。
+// discovery に失敗したプロバイダはログを出して外し、他のログイン手段は使えるようにする
+func NewRegistryFromConfig(ctx context.Context, c *config.Config) *ProviderRegistry {
+	r := NewRegistry()
+	if c.Line.ClientID != "" {
+		if p, err := line.NewProvider(c.Line); err != nil {
+			logrus.Warnf("auth provider %s disabled: %v", line.ProviderName, err)
+		} else {
+			r.Register(line.ProviderName, p)
+		}
+	}
+	for _, pc := range c.OIDC {
+		if pc.Name == github.ProviderName {
+			r.Register(pc.Name, github.NewProvider(pc))
+			continue
+		}
+		p, err := openid.NewProvider(ctx, pc)
+		if err != nil {
+			logrus.Warnf("auth provider %s disabled: %v", pc.Name, err)
+			continue
+		}
+		r.Register(pc.Name, p)
+	}
+	return r
+}
@@ -0,0 +1,29 @@
+package jwt
+
+import (
+	"time"
+
+	"word_app/backend/src/utils/tempjwt"
+)
+
+// ActionTokenIssuer はメールリンク（確認・パスワード再設定）用の署名付きトークンを扱う
+type ActionTokenIssuer interface {
+	GenerateAction(a *tempjwt.Action, ttl time.Duration) (string, error)
+	ParseAction(tok, purpose string) (*tempjwt.Action, error)
+}
+
+func NewActionToken(secret string) (ActionTokenIssuer, error) {
+	inner, err := tempjwt.New(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &TempJWTAdapter{inner: inner}, nil
+}
+
+func (t *TempJWTAdapter) GenerateAction(a *tempjwt.Action, ttl time.Duration) (string, error) {
+	return t.inner.GenerateAction(a, ttl)
+}
+
+func (t *TempJWTAdapter) ParseAction(tok, purpose string) (*tempjwt.Action, error) {
+	return t.inner.ParseAction(tok, purpose)
+}
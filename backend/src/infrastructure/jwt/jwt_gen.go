@@ -1,59 +1,53 @@
 package jwt
 
 import (
-	"log"
-	"os"
-	"strconv"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v4"
 )
 
+// アクセストークンの既定の有効期限。長期のセッションはリフレッシュトークンで維持する
+const DefaultAccessTTL = 15 * time.Minute
+
 type MyJWTGenerator struct {
-	secretKey string
+	keys *KeySet
+	ttl  time.Duration
 }
 type Claims struct {
 	UserID string `json:"userID"`
+	// users.token_version。一致しなければ失効扱い
+	Version int `json:"ver"`
 	jwt.RegisteredClaims
 }
 
 type JWTGenerator interface {
-	GenerateJWT(userID string) (string, error)
+	GenerateJWT(userID string, version int) (string, error)
+	// TTL はアクセストークンの有効期限（expires_in の計算用）
+	TTL() time.Duration
 }
 
-func NewMyJWTGenerator(secretKey string) *MyJWTGenerator {
-	if secretKey == "" {
-		log.Fatal("JWT_SECRET environment variable is required")
+func NewMyJWTGenerator(keys *KeySet, ttl time.Duration) *MyJWTGenerator {
+	if ttl <= 0 {
+		ttl = DefaultAccessTTL
 	}
-	return &MyJWTGenerator{secretKey: secretKey}
+	return &MyJWTGenerator{keys: keys, ttl: ttl}
 }
 
-func (j *MyJWTGenerator) GenerateJWT(userID string) (string, error) {
-	// 有効期限のデフォルト: 1時間
-	expirationTime := time.Now().Add(1 * time.Hour)
-
-	// 環境変数から有効期限を取得（オプション）
-	if hours := os.Getenv("JWT_EXPIRATION_HOURS"); hours != "" {
-		if h, err := strconv.Atoi(hours); err == nil {
-			expirationTime = time.Now().Add(time.Duration(h) * time.Hour)
-		}
-	}
-	if minutes := os.Getenv("JWT_EXPIRATION_MINUTES"); minutes != "" {
-		if m, err := strconv.Atoi(minutes); err == nil {
-			expirationTime = expirationTime.Add(time.Duration(m) * time.Minute)
-		}
-	}
+func (j *MyJWTGenerator) TTL() time.Duration { return j.ttl }
 
+func (j *MyJWTGenerator) GenerateJWT(userID string, version int) (string, error) {
+	now := time.Now()
 	// クレームを作成
 	claims := &Claims{
-		UserID: userID,
+		UserID:  userID,
+		Version: version,
 		RegisteredClaims: jwt.RegisteredClaims{
 			Subject:   userID,
-			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Audience:  jwt.ClaimStrings{AudienceAccess},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(j.ttl)),
 		},
 	}
-	// トークンを生成
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	return token.SignedString([]byte(j.secretKey))
+	// 現在の署名鍵で署名（ヘッダに kid が付く）
+	return j.keys.Signing().Sign(claims)
 }
@@ -0,0 +1,257 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strings"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+
+	"word_app/backend/config"
+)
+
+// アクセストークンの aud。一時トークン（LINE 連携・メールリンク）とは別の値にして取り違えを防ぐ
+const AudienceAccess = "word_app/access"
+
+// hmacKeyID は JWT_SECRET だけで動かすとき（ローカル開発用）の kid
+const hmacKeyID = "local"
+
+var (
+	ErrUnknownKey = errors.New("unknown signing key")
+	ErrNoKey      = errors.New("JWT_SIGNING_KEY or JWT_SECRET is required")
+)
+
+// Key は kid で識別される署名鍵。private が nil なら検証専用（ローテーション前の鍵）
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	private interface{}
+	public  interface{}
+}
+
+// Sign は kid をヘッダに付けて署名する
+func (k *Key) Sign(claims jwt.Claims) (string, error) {
+	if k.private == nil {
+		return "", fmt.Errorf("key %s is verification only", k.ID)
+	}
+	token := jwt.NewWithClaims(k.Method, claims)
+	token.Header["kid"] = k.ID
+	return token.SignedString(k.private)
+}
+
+// KeySet は現在の署名鍵と、まだ受け付ける検証鍵の集合。
+// 鍵を入れ替えるときは古い鍵を検証用に残しておけば、発行済みのトークンが切れるまで使える。
+type KeySet struct {
+	signing *Key
+	keys    []*Key
+	byID    map[string]*Key
+}
+
+func NewKeySet(signing *Key, verifyOnly ...*Key) (*KeySet, error) {
+	if signing == nil || signing.private == nil {
+		return nil, ErrNoKey
+	}
+	s := &KeySet{signing: signing, byID: map[string]*Key{}}
+	for _, k := range append([]*Key{signing}, verifyOnly...) {
+		if _, dup := s.byID[k.ID]; dup {
+			continue
+		}
+		s.byID[k.ID] = k
+		s.keys = append(s.keys, k)
+	}
+	return s, nil
+}
+
+func (s *KeySet) Signing() *Key { return s.signing }
+
+func (s *KeySet) Lookup(kid string) (*Key, bool) {
+	k, ok := s.byID[kid]
+	return k, ok
+}
+
+// NewKeySetFromConfig は JWT_SIGNING_KEY / JWT_VERIFY_KEYS から鍵を読む。
+// 非対称鍵が無ければ JWT_SECRET の HS256 にフォールバックする（JWKS には何も出ない）。
+func NewKeySetFromConfig(c *config.JWTCfg) (*KeySet, error) {
+	var signing *Key
+	switch {
+	case c.SigningKey != "":
+		data, err := readPEM(c.SigningKey)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_SIGNING_KEY: %w", err)
+		}
+		if signing, err = ParsePrivateKeyPEM(data); err != nil {
+			return nil, fmt.Errorf("JWT_SIGNING_KEY: %w", err)
+		}
+	case c.Secret != "":
+		signing = NewHMACKey(c.Secret)
+	default:
+		return nil, ErrNoKey
+	}
+
+	verifyOnly := make([]*Key, 0, len(c.VerifyKeys))
+	for i, v := range c.VerifyKeys {
+		data, err := readPEM(v)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_VERIFY_KEYS[%d]: %w", i, err)
+		}
+		k, err := ParsePublicKeyPEM(data)
+		if err != nil {
+			return nil, fmt.Errorf("JWT_VERIFY_KEYS[%d]: %w", i, err)
+		}
+		verifyOnly = append(verifyOnly, k)
+	}
+	return NewKeySet(signing, verifyOnly...)
+}
+
+func NewHMACKey(secret string) *Key {
+	return &Key{ID: hmacKeyID, Method: jwt.SigningMethodHS256, private: []byte(secret), public: []byte(secret)}
+}
+
+// ParsePrivateKeyPEM は RSA（PKCS#1/PKCS#8）と Ed25519（PKCS#8）の秘密鍵を読む
+func ParsePrivateKeyPEM(data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	var raw interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		raw, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		raw, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch k := raw.(type) {
+	case *rsa.PrivateKey:
+		return newKey(k, &k.PublicKey)
+	case ed25519.PrivateKey:
+		return newKey(k, k.Public())
+	default:
+		return nil, fmt.Errorf("unsupported private key %T", raw)
+	}
+}
+
+// ParsePublicKeyPEM は検証専用の公開鍵を読む。秘密鍵の PEM を渡しても公開鍵だけを使う
+func ParsePublicKeyPEM(data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM block")
+	}
+	var raw interface{}
+	var err error
+	switch block.Type {
+	case "PUBLIC KEY":
+		raw, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		raw, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "RSA PRIVATE KEY", "PRIVATE KEY":
+		k, err := ParsePrivateKeyPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		return &Key{ID: k.ID, Method: k.Method, public: k.public}, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newKey(nil, raw)
+}
+
+func newKey(private, public interface{}) (*Key, error) {
+	k := &Key{private: private, public: public}
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		if pub.N.BitLen() < 2048 {
+			return nil, errors.New("RSA key must be at least 2048 bits")
+		}
+		k.Method = jwt.SigningMethodRS256
+	case ed25519.PublicKey:
+		k.Method = jwt.SigningMethodEdDSA
+	default:
+		return nil, fmt.Errorf("unsupported public key %T", public)
+	}
+	// 鍵の内容から決まる値（RFC 7638 の thumbprint）を kid にするので、設定で名前を付ける必要はない
+	k.ID = thumbprint(k.jwk())
+	return k, nil
+}
+
+// readPEM は PEM 文字列そのもの、またはファイルパスを受け付ける。
+// 環境変数に入れるときの "\n" は改行に戻す
+func readPEM(v string) ([]byte, error) {
+	if strings.Contains(v, "-----BEGIN") {
+		return []byte(strings.ReplaceAll(v, `\n`, "\n")), nil
+	}
+	return os.ReadFile(v)
+}
+
+// ---------- JWKS ----------
+
+// JWK は公開鍵の JSON 表現（RFC 7517）。RSA は n/e、Ed25519 は crv/x を使う
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS は検証に使える公開鍵をすべて返す。HS256 の共有鍵は公開しない
+func (s *KeySet) JWKS() JWKSet {
+	out := JWKSet{Keys: []JWK{}}
+	for _, k := range s.keys {
+		if j := k.jwk(); j.Kty != "" {
+			out.Keys = append(out.Keys, j)
+		}
+	}
+	return out
+}
+
+func (k *Key) jwk() JWK {
+	b64 := base64.RawURLEncoding.EncodeToString
+	switch pub := k.public.(type) {
+	case *rsa.PublicKey:
+		return JWK{
+			Kty: "RSA", Kid: k.ID, Use: "sig", Alg: k.Method.Alg(),
+			N: b64(pub.N.Bytes()), E: b64(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case ed25519.PublicKey:
+		return JWK{Kty: "OKP", Kid: k.ID, Use: "sig", Alg: k.Method.Alg(), Crv: "Ed25519", X: b64(pub)}
+	default:
+		return JWK{}
+	}
+}
+
+// thumbprint は RFC 7638 の JWK Thumbprint（必須メンバーを辞書順に並べた JSON の SHA-256）
+func thumbprint(j JWK) string {
+	var s string
+	switch j.Kty {
+	case "RSA":
+		s = fmt.Sprintf(`{"e":%q,"kty":"RSA","n":%q}`, j.E, j.N)
+	case "OKP":
+		s = fmt.Sprintf(`{"crv":%q,"kty":"OKP","x":%q}`, j.Crv, j.X)
+	}
+	sum := sha256.Sum256([]byte(s))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
@@ -15,8 +15,12 @@ type TempTokenGenerator interface {
 	ParseTemp(tok string) (*tempjwt.Identity, error)
 }
 
-func New(secret string) TempTokenGenerator {
-	return &TempJWTAdapter{inner: tempjwt.New(secret)}
+func New(secret string) (TempTokenGenerator, error) {
+	inner, err := tempjwt.New(secret)
+	if err != nil {
+		return nil, err
+	}
+	return &TempJWTAdapter{inner: inner}, nil
 }
 
 func (t *TempJWTAdapter) GenerateTemp(id *tempjwt.Identity, ttl time.Duration) (string, error) {
@@ -0,0 +1,125 @@
+package jwt_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	jwt_infra "word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/utils/tempjwt"
+)
+
+func actionToken(t *testing.T, secret string) jwt_infra.ActionTokenIssuer {
+	t.Helper()
+	issuer, err := jwt_infra.NewActionToken(secret)
+	require.NoError(t, err)
+	return issuer
+}
+
+func tempToken(t *testing.T, secret string) jwt_infra.TempTokenGenerator {
+	t.Helper()
+	gen, err := jwt_infra.New(secret)
+	require.NoError(t, err)
+	return gen
+}
+
+func TestTempSecretRequired(t *testing.T) {
+	// 空の鍵で署名したトークンは誰でも作れるので、作る時点で拒否する
+	_, err := jwt_infra.NewActionToken("")
+	assert.ErrorIs(t, err, tempjwt.ErrEmptySecret)
+	_, err = jwt_infra.New("")
+	assert.ErrorIs(t, err, tempjwt.ErrEmptySecret)
+	_, err = tempjwt.New("")
+	assert.ErrorIs(t, err, tempjwt.ErrEmptySecret)
+}
+
+func TestActionToken(t *testing.T) {
+	issuer := actionToken(t, "temp_secret")
+
+	t.Run("発行したトークンを同じ用途で読める", func(t *testing.T) {
+		tok, err := issuer.GenerateAction(&tempjwt.Action{
+			Purpose: tempjwt.PurposeVerifyEmail, UserID: 7, Fingerprint: "fp",
+		}, time.Minute)
+		require.NoError(t, err)
+
+		a, err := issuer.ParseAction(tok, tempjwt.PurposeVerifyEmail)
+		require.NoError(t, err)
+		assert.Equal(t, 7, a.UserID)
+		assert.Equal(t, "fp", a.Fingerprint)
+	})
+
+	t.Run("用途が違えば拒否", func(t *testing.T) {
+		tok, err := issuer.GenerateAction(&tempjwt.Action{
+			Purpose: tempjwt.PurposeVerifyEmail, UserID: 7, Fingerprint: "fp",
+		}, time.Minute)
+		require.NoError(t, err)
+
+		_, err = issuer.ParseAction(tok, tempjwt.PurposeResetPassword)
+		assert.ErrorIs(t, err, tempjwt.ErrInvalidAction)
+	})
+
+	t.Run("期限切れは拒否", func(t *testing.T) {
+		tok, err := issuer.GenerateAction(&tempjwt.Action{
+			Purpose: tempjwt.PurposeResetPassword, UserID: 7, Fingerprint: "fp",
+		}, -time.Minute)
+		require.NoError(t, err)
+
+		_, err = issuer.ParseAction(tok, tempjwt.PurposeResetPassword)
+		assert.Error(t, err)
+	})
+
+	t.Run("別の鍵で署名されたものは拒否", func(t *testing.T) {
+		tok, err := actionToken(t, "other").GenerateAction(&tempjwt.Action{
+			Purpose: tempjwt.PurposeResetPassword, UserID: 7, Fingerprint: "fp",
+		}, time.Minute)
+		require.NoError(t, err)
+
+		_, err = issuer.ParseAction(tok, tempjwt.PurposeResetPassword)
+		assert.Error(t, err)
+	})
+
+	t.Run("LINE 連携用の一時トークンは拒否", func(t *testing.T) {
+		tok, err := tempToken(t, "temp_secret").GenerateTemp(&tempjwt.Identity{
+			Provider: "line", Subject: "U1",
+		}, time.Minute)
+		require.NoError(t, err)
+
+		_, err = issuer.ParseAction(tok, tempjwt.PurposeResetPassword)
+		assert.ErrorIs(t, err, tempjwt.ErrInvalidAction)
+	})
+}
+
+func TestTempToken(t *testing.T) {
+	gen := tempToken(t, "temp_secret")
+
+	t.Run("発行したトークンを読める", func(t *testing.T) {
+		tok, err := gen.GenerateTemp(&tempjwt.Identity{Provider: "line", Subject: "U1", Name: "alice"}, time.Minute)
+		require.NoError(t, err)
+
+		id, err := gen.ParseTemp(tok)
+		require.NoError(t, err)
+		assert.Equal(t, "U1", id.Subject)
+		assert.Equal(t, "alice", id.Name)
+	})
+
+	t.Run("メールリンク用のトークンは拒否", func(t *testing.T) {
+		tok, err := actionToken(t, "temp_secret").GenerateAction(&tempjwt.Action{
+			Purpose: tempjwt.PurposeVerifyEmail, UserID: 7, Fingerprint: "fp",
+		}, time.Minute)
+		require.NoError(t, err)
+
+		_, err = gen.ParseTemp(tok)
+		assert.ErrorIs(t, err, tempjwt.ErrInvalidTemp)
+	})
+
+	t.Run("アクセストークンは拒否", func(t *testing.T) {
+		// 同じ秘密鍵で署名されていても aud が違う
+		tok, err := jwt_infra.NewMyJWTGenerator(hmacKeys(t, "temp_secret"), 0).GenerateJWT("7", 0)
+		require.NoError(t, err)
+
+		_, err = gen.ParseTemp(tok)
+		assert.ErrorIs(t, err, tempjwt.ErrInvalidTemp)
+	})
+}
@@ -1,16 +1,25 @@
 package jwt_test
 
 import (
+	"crypto/ed25519"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	jwt_infra "word_app/backend/src/infrastructure/jwt"
 )
 
+func hmacKeys(t *testing.T, secret string) *jwt_infra.KeySet {
+	t.Helper()
+	keys, err := jwt_infra.NewKeySet(jwt_infra.NewHMACKey(secret))
+	require.NoError(t, err)
+	return keys
+}
+
 func TestGenerateJWT(t *testing.T) {
 	// 1. 共通キーを準備
 	testSecret := "test_secret_key"
@@ -19,10 +28,10 @@ func TestGenerateJWT(t *testing.T) {
 	userID := "12345"
 
 	// 2. 正しく初期化
-	jwtGen := jwt_infra.NewMyJWTGenerator(testSecret)
+	jwtGen := jwt_infra.NewMyJWTGenerator(hmacKeys(t, testSecret), 0)
 
 	// 3. トークン生成
-	tokenString, err := jwtGen.GenerateJWT(userID)
+	tokenString, err := jwtGen.GenerateJWT(userID, 3)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, tokenString, "token string must not be empty")
 
@@ -36,9 +45,46 @@ func TestGenerateJWT(t *testing.T) {
 	// 5. クレームの検証
 	claims := tok.Claims.(*jwt_infra.Claims)
 	assert.Equal(t, userID, claims.UserID)
+	assert.Equal(t, 3, claims.Version)
+	assert.True(t, claims.VerifyAudience(jwt_infra.AudienceAccess, true))
+	assert.NotEmpty(t, tok.Header["kid"])
+	// TTL 未指定なら短命の既定値
+	assert.Equal(t, jwt_infra.DefaultAccessTTL, jwtGen.TTL())
 	assert.WithinDuration(t,
-		time.Now().Add(1*time.Hour),
+		time.Now().Add(jwt_infra.DefaultAccessTTL),
 		claims.ExpiresAt.Time,
 		2*time.Second,
 	)
 }
+
+func TestGenerateJWT_CustomTTL(t *testing.T) {
+	jwtGen := jwt_infra.NewMyJWTGenerator(hmacKeys(t, "test_secret_key"), 2*time.Hour)
+
+	tokenString, err := jwtGen.GenerateJWT("1", 0)
+	assert.NoError(t, err)
+
+	claims := &jwt_infra.Claims{}
+	_, err = jwt.ParseWithClaims(tokenString, claims, func(_ *jwt.Token) (interface{}, error) {
+		return []byte("test_secret_key"), nil
+	})
+	assert.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(2*time.Hour), claims.ExpiresAt.Time, 2*time.Second)
+}
+
+func TestGenerateJWT_EdDSA(t *testing.T) {
+	key, priv := newEdKey(t)
+	keys, err := jwt_infra.NewKeySet(key)
+	require.NoError(t, err)
+
+	tokenString, err := jwt_infra.NewMyJWTGenerator(keys, 0).GenerateJWT("7", 1)
+	require.NoError(t, err)
+
+	// 公開鍵だけで検証でき、ヘッダの kid で鍵を特定できる
+	tok, err := jwt.ParseWithClaims(tokenString, &jwt_infra.Claims{}, func(_ *jwt.Token) (interface{}, error) {
+		return priv.Public().(ed25519.PublicKey), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "EdDSA", tok.Method.Alg())
+	assert.Equal(t, key.ID, tok.Header["kid"])
+	assert.Equal(t, "7", tok.Claims.(*jwt_infra.Claims).UserID)
+}
@@ -3,8 +3,8 @@ package jwt_test
 
 import (
 	"context"
-	"crypto/rand"
 	"crypto/rsa"
+	"crypto/x509"
 	"testing"
 	"time"
 
@@ -12,51 +12,80 @@ import (
 	"github.com/stretchr/testify/require"
 
 	jwti "word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/utils/tempjwt"
 )
 
 const secret = "test_secret"
 
-func makeHS256Token(t *testing.T, uid string, expFromNow time.Duration, key []byte) string {
+// makeToken は鍵 k で署名したアクセストークン相当を作る。aud を省略すると付けない
+func makeToken(t *testing.T, k *jwti.Key, uid string, expFromNow time.Duration, aud ...string) string {
 	t.Helper()
 	claims := &jwti.Claims{
 		UserID: uid,
 		RegisteredClaims: stdjwt.RegisteredClaims{
 			Subject:   uid,
+			Audience:  aud,
 			ExpiresAt: stdjwt.NewNumericDate(time.Now().Add(expFromNow)),
 		},
 	}
-	token := stdjwt.NewWithClaims(stdjwt.SigningMethodHS256, claims)
-	s, err := token.SignedString(key)
+	s, err := k.Sign(claims)
 	require.NoError(t, err)
 	return s
 }
 
-func makeRS256Token(t *testing.T, uid string, expFromNow time.Duration, priv *rsa.PrivateKey) string {
+func makeHS256Token(t *testing.T, uid string, expFromNow time.Duration, key []byte) string {
 	t.Helper()
-	claims := &jwti.Claims{
-		UserID: uid,
+	return makeToken(t, jwti.NewHMACKey(string(key)), uid, expFromNow, jwti.AudienceAccess)
+}
+
+func makeVersionedToken(t *testing.T, keys *jwti.KeySet, uid string, version int) string {
+	t.Helper()
+	s, err := jwti.NewMyJWTGenerator(keys, time.Hour).GenerateJWT(uid, version)
+	require.NoError(t, err)
+	return s
+}
+
+// makeConfusedToken は RSA 鍵の kid を名乗り、公開鍵の DER を HMAC の鍵にしたもの
+func makeConfusedToken(t *testing.T, kid string, pub *rsa.PublicKey) string {
+	t.Helper()
+	token := stdjwt.NewWithClaims(stdjwt.SigningMethodHS256, &jwti.Claims{
+		UserID: "42",
 		RegisteredClaims: stdjwt.RegisteredClaims{
-			Subject:   uid,
-			ExpiresAt: stdjwt.NewNumericDate(time.Now().Add(expFromNow)),
+			Audience:  stdjwt.ClaimStrings{jwti.AudienceAccess},
+			ExpiresAt: stdjwt.NewNumericDate(time.Now().Add(time.Hour)),
 		},
-	}
-	token := stdjwt.NewWithClaims(stdjwt.SigningMethodRS256, claims)
-	s, err := token.SignedString(priv)
+	})
+	token.Header["kid"] = kid
+	s, err := token.SignedString(x509.MarshalPKCS1PublicKey(pub))
 	require.NoError(t, err)
 	return s
 }
 
-func TestHS256Verifier_VerifyAndExtractSubject(t *testing.T) {
+func TestVerifier_VerifyAndExtractSubject(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
-	verifier := jwti.NewHS256Verifier(secret)
-	rsaPriv, err := rsa.GenerateKey(rand.Reader, 2048)
+
+	// 現在の署名鍵は HS256、RS256 と EdDSA はローテーション前の鍵（検証専用）として持つ
+	rsaKey, rsaPriv := newRSAKey(t)
+	edKey, edPriv := newEdKey(t)
+	rsaPub, err := jwti.ParsePublicKeyPEM(publicPEM(t, &rsaPriv.PublicKey))
+	require.NoError(t, err)
+	edPub, err := jwti.ParsePublicKeyPEM(publicPEM(t, edPriv.Public()))
+	require.NoError(t, err)
+	keys, err := jwti.NewKeySet(jwti.NewHMACKey(secret), rsaPub, edPub)
+	require.NoError(t, err)
+	verifier := jwti.NewVerifier(keys)
+	unknownKey, _ := newEdKey(t)
+
+	// LINE 連携の一時トークン。最悪の設定（同じ秘密鍵）でもアクセストークンとしては通らない
+	tempTok, err := tempToken(t, secret).GenerateTemp(&tempjwt.Identity{Provider: "line", Subject: "U1"}, time.Hour)
 	require.NoError(t, err)
 
 	tests := []struct {
 		name     string
 		rawToken string
 		wantSub  string
+		wantVer  int
 		wantErr  error // ← 文字列ではなくエラー型で
 	}{
 		{
@@ -64,6 +93,27 @@ func TestHS256Verifier_VerifyAndExtractSubject(t *testing.T) {
 			rawToken: makeHS256Token(t, "42", time.Hour, []byte(secret)),
 			wantSub:  "42",
 		},
+		{
+			name:     "success_with_token_version",
+			rawToken: makeVersionedToken(t, keys, "42", 5),
+			wantSub:  "42",
+			wantVer:  5,
+		},
+		{
+			name:     "success_RS256_rotated_key",
+			rawToken: makeToken(t, rsaKey, "42", time.Hour, jwti.AudienceAccess),
+			wantSub:  "42",
+		},
+		{
+			name:     "success_EdDSA_rotated_key",
+			rawToken: makeToken(t, edKey, "42", time.Hour, jwti.AudienceAccess),
+			wantSub:  "42",
+		},
+		{
+			name:     "unknown_kid",
+			rawToken: makeToken(t, unknownKey, "42", time.Hour, jwti.AudienceAccess),
+			wantErr:  jwti.ErrTokenInvalid,
+		},
 		{
 			name:     "invalid_signature_wrong_secret",
 			rawToken: makeHS256Token(t, "42", time.Hour, []byte("wrong")),
@@ -80,8 +130,8 @@ func TestHS256Verifier_VerifyAndExtractSubject(t *testing.T) {
 			wantErr:  jwti.ErrTokenInvalid,
 		},
 		{
-			name:     "unexpected_signing_method_RS256",
-			rawToken: makeRS256Token(t, "42", time.Hour, rsaPriv),
+			name:     "unexpected_signing_method_for_kid",
+			rawToken: makeConfusedToken(t, rsaKey.ID, &rsaPriv.PublicKey),
 			wantErr:  jwti.ErrTokenInvalid,
 		},
 		{
@@ -89,19 +139,35 @@ func TestHS256Verifier_VerifyAndExtractSubject(t *testing.T) {
 			rawToken: makeHS256Token(t, "", time.Hour, []byte(secret)),
 			wantErr:  jwti.ErrClaimsInvalid,
 		},
+		{
+			name:     "claims_invalid_missing_audience",
+			rawToken: makeToken(t, rsaKey, "42", time.Hour),
+			wantErr:  jwti.ErrClaimsInvalid,
+		},
+		{
+			name:     "claims_invalid_temp_audience",
+			rawToken: makeToken(t, rsaKey, "42", time.Hour, tempjwt.AudienceSocialSignUp),
+			wantErr:  jwti.ErrClaimsInvalid,
+		},
+		{
+			name:     "social_signup_temp_token",
+			rawToken: tempTok,
+			wantErr:  jwti.ErrTokenInvalid,
+		},
 	}
 
 	for _, tc := range tests {
 		tc := tc
 		t.Run(tc.name, func(t *testing.T) {
 			t.Parallel()
-			sub, err := verifier.VerifyAndExtractSubject(ctx, tc.rawToken)
+			sub, ver, err := verifier.VerifyAndExtractSubject(ctx, tc.rawToken)
 			if tc.wantErr != nil {
 				require.ErrorIs(t, err, tc.wantErr)
 				return
 			}
 			require.NoError(t, err)
 			require.Equal(t, tc.wantSub, sub)
+			require.Equal(t, tc.wantVer, ver)
 		})
 	}
 }
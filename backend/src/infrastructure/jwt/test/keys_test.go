@@ -0,0 +1,151 @@
+package jwt_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/config"
+	jwt_infra "word_app/backend/src/infrastructure/jwt"
+)
+
+func privatePEM(t *testing.T, priv interface{}) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func publicPEM(t *testing.T, pub interface{}) []byte {
+	t.Helper()
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+}
+
+func newEdKey(t *testing.T) (*jwt_infra.Key, ed25519.PrivateKey) {
+	t.Helper()
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	k, err := jwt_infra.ParsePrivateKeyPEM(privatePEM(t, priv))
+	require.NoError(t, err)
+	return k, priv
+}
+
+func newRSAKey(t *testing.T) (*jwt_infra.Key, *rsa.PrivateKey) {
+	t.Helper()
+	priv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	k, err := jwt_infra.ParsePrivateKeyPEM(pem.EncodeToMemory(&pem.Block{
+		Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(priv),
+	}))
+	require.NoError(t, err)
+	return k, priv
+}
+
+func TestParseKeys(t *testing.T) {
+	t.Run("RSA は RS256、Ed25519 は EdDSA", func(t *testing.T) {
+		rsaKey, _ := newRSAKey(t)
+		edKey, _ := newEdKey(t)
+		assert.Equal(t, "RS256", rsaKey.Method.Alg())
+		assert.Equal(t, "EdDSA", edKey.Method.Alg())
+		assert.NotEqual(t, rsaKey.ID, edKey.ID)
+	})
+
+	t.Run("kid は鍵の内容から決まり、公開鍵から読んでも同じ", func(t *testing.T) {
+		priv, privKey := newEdKey(t)
+		pub, err := jwt_infra.ParsePublicKeyPEM(publicPEM(t, privKey.Public()))
+		require.NoError(t, err)
+		assert.Equal(t, priv.ID, pub.ID)
+
+		// 公開鍵だけでは署名できない
+		_, err = pub.Sign(&jwt_infra.Claims{UserID: "1"})
+		assert.Error(t, err)
+	})
+
+	t.Run("短い RSA 鍵は拒否", func(t *testing.T) {
+		priv, err := rsa.GenerateKey(rand.Reader, 1024)
+		require.NoError(t, err)
+		_, err = jwt_infra.ParsePrivateKeyPEM(privatePEM(t, priv))
+		assert.Error(t, err)
+	})
+
+	t.Run("PEM でなければ拒否", func(t *testing.T) {
+		_, err := jwt_infra.ParsePrivateKeyPEM([]byte("not a key"))
+		assert.Error(t, err)
+	})
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	current, _ := newEdKey(t)
+	old, _ := newRSAKey(t)
+	keys, err := jwt_infra.NewKeySet(current, old, jwt_infra.NewHMACKey("secret"))
+	require.NoError(t, err)
+
+	set := keys.JWKS()
+	// HS256 の共有鍵は公開しない
+	require.Len(t, set.Keys, 2)
+	assert.Equal(t, current.ID, set.Keys[0].Kid)
+	assert.Equal(t, "OKP", set.Keys[0].Kty)
+	assert.Equal(t, "Ed25519", set.Keys[0].Crv)
+	assert.NotEmpty(t, set.Keys[0].X)
+	assert.Equal(t, old.ID, set.Keys[1].Kid)
+	assert.Equal(t, "RSA", set.Keys[1].Kty)
+	assert.Equal(t, "RS256", set.Keys[1].Alg)
+	assert.Equal(t, "AQAB", set.Keys[1].E)
+	for _, k := range set.Keys {
+		assert.Equal(t, "sig", k.Use)
+	}
+
+	_, ok := keys.Lookup(old.ID)
+	assert.True(t, ok)
+	assert.Same(t, current, keys.Signing())
+}
+
+func TestNewKeySetFromConfig(t *testing.T) {
+	_, current := newEdKey(t)
+	_, old := newRSAKey(t)
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.pub.pem")
+	require.NoError(t, os.WriteFile(oldPath, publicPEM(t, &old.PublicKey), 0o600))
+	// 環境変数に入れるときのように改行を \n にしたもの
+	inline := strings.ReplaceAll(string(privatePEM(t, current)), "\n", `\n`)
+
+	t.Run("署名鍵は PEM 文字列、旧鍵はファイルパス", func(t *testing.T) {
+		keys, err := jwt_infra.NewKeySetFromConfig(&config.JWTCfg{
+			SigningKey: inline, VerifyKeys: []string{oldPath}, Secret: "ignored",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodEdDSA, keys.Signing().Method)
+		assert.Len(t, keys.JWKS().Keys, 2)
+	})
+
+	t.Run("非対称鍵が無ければ JWT_SECRET の HS256", func(t *testing.T) {
+		keys, err := jwt_infra.NewKeySetFromConfig(&config.JWTCfg{Secret: "secret"})
+		require.NoError(t, err)
+		assert.Equal(t, jwt.SigningMethodHS256, keys.Signing().Method)
+		assert.Empty(t, keys.JWKS().Keys)
+	})
+
+	t.Run("鍵が何も無ければエラー", func(t *testing.T) {
+		_, err := jwt_infra.NewKeySetFromConfig(&config.JWTCfg{})
+		assert.ErrorIs(t, err, jwt_infra.ErrNoKey)
+	})
+
+	t.Run("読めない旧鍵はエラー", func(t *testing.T) {
+		_, err := jwt_infra.NewKeySetFromConfig(&config.JWTCfg{
+			Secret: "secret", VerifyKeys: []string{filepath.Join(dir, "missing.pem")},
+		})
+		assert.Error(t, err)
+	})
+}
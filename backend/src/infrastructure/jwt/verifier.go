@@ -9,38 +9,46 @@ import (
 	jwt "github.com/golang-jwt/jwt/v4"
 )
 
-type HS256Verifier struct{ secret []byte }
+// Verifier はヘッダの kid で鍵を選んで検証する。ローテーション前の鍵で署名されたものも受け付ける
+type Verifier struct{ keys *KeySet }
 
-func NewHS256Verifier(secret string) *HS256Verifier {
-	return &HS256Verifier{secret: []byte(secret)}
+func NewVerifier(keys *KeySet) *Verifier {
+	return &Verifier{keys: keys}
 }
 
 type TokenVerifier interface {
-	// 署名OKか？期限切れか？だけ判断し、アプリにとって意味のある主語（sub/userID）を返す
-	VerifyAndExtractSubject(ctx context.Context, raw string) (subject string, err error)
+	// 署名OKか？期限切れか？だけ判断し、アプリにとって意味のある主語（sub/userID）と ver を返す
+	VerifyAndExtractSubject(ctx context.Context, raw string) (subject string, version int, err error)
 }
 
-func (v *HS256Verifier) VerifyAndExtractSubject(ctx context.Context, raw string) (string, error) {
+func (v *Verifier) VerifyAndExtractSubject(ctx context.Context, raw string) (string, int, error) {
 	tok, err := jwt.ParseWithClaims(raw, &Claims{}, func(t *jwt.Token) (interface{}, error) {
-		if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
+		kid, _ := t.Header["kid"].(string)
+		k, ok := v.keys.Lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("%w", ErrUnknownKey)
+		}
+		// 公開鍵を HMAC の鍵として使わせるような alg の差し替えを弾く
+		if t.Method.Alg() != k.Method.Alg() {
 			// ベース非nil + wrap
 			return nil, fmt.Errorf("%w", ErrUnexpectedAlg)
 			// もし repoerr を使いたいなら:
 			// return nil, repoerr.FromEnt(ErrUnexpectedAlg, ErrUnexpectedAlg.Error(), "")
 		}
-		return v.secret, nil
+		return k.public, nil
 	})
 	if err != nil || !tok.Valid {
-		return "", fmt.Errorf("%w", ErrTokenInvalid)
+		return "", 0, fmt.Errorf("%w", ErrTokenInvalid)
 		// or: return "", repoerr.FromEnt(ErrTokenInvalid, ErrTokenInvalid.Error(), "")
 	}
 
 	c, ok := tok.Claims.(*Claims)
-	if !ok || c.UserID == "" {
-		return "", fmt.Errorf("%w", ErrClaimsInvalid)
+	// aud が違う＝一時トークンなど別用途のもの
+	if !ok || c.UserID == "" || !c.VerifyAudience(AudienceAccess, true) {
+		return "", 0, fmt.Errorf("%w", ErrClaimsInvalid)
 		// or: return "", repoerr.FromEnt(ErrClaimsInvalid, ErrClaimsInvalid.Error(), "")
 	}
-	return c.UserID, nil
+	return c.UserID, c.Version, nil
 }
 
 var (
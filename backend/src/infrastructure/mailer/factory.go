@@ -0,0 +1,23 @@
+package mailer
+
+import (
+	"fmt"
+
+	"word_app/backend/config"
+)
+
+func NewMailerFromConfig(cfg config.MailCfg) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		if cfg.SMTPHost == "" {
+			return nil, fmt.Errorf("SMTP_HOST is required")
+		}
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUser, cfg.SMTPPassword, cfg.From), nil
+	case "file":
+		return NewFileMailer(cfg.FileDir, cfg.From), nil
+	case "log", "":
+		return NewLogMailer(cfg.From), nil
+	default:
+		return nil, fmt.Errorf("unknown MAIL_DRIVER %q", cfg.Driver)
+	}
+}
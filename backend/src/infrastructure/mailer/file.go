@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+
+	"word_app/backend/logger/logx"
+)
+
+// FileMailer はローカル開発用。送らずに .eml ファイルとして dir に書き出す。
+// dir が空ならログに出すだけ。
+type FileMailer struct {
+	dir  string
+	from string
+	now  func() time.Time
+}
+
+func NewFileMailer(dir, from string) *FileMailer {
+	return &FileMailer{dir: dir, from: from, now: time.Now}
+}
+
+func NewLogMailer(from string) *FileMailer {
+	return NewFileMailer("", from)
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9._@-]`)
+
+func (f *FileMailer) Send(ctx context.Context, m Message) error {
+	now := f.now()
+	msg, err := build(f.from, m, now)
+	if err != nil {
+		return err
+	}
+	if f.dir == "" {
+		logx.From(ctx).WithField("to", m.To).Infof("mail (not sent)\n%s", msg)
+		return nil
+	}
+	if err := os.MkdirAll(f.dir, 0o755); err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s-%s.eml", now.Format("20060102T150405.000000000"), unsafeFileChars.ReplaceAllString(m.To, "_"))
+	path := filepath.Join(f.dir, name)
+	if err := os.WriteFile(path, msg, 0o600); err != nil {
+		return err
+	}
+	logx.From(ctx).WithField("to", m.To).Infof("mail written to %s", path)
+	return nil
+}
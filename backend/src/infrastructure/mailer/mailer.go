@@ -0,0 +1,45 @@
+// Package mailer はメール送信の抽象と実装（SMTP / 開発用のファイル・ログ出力）をまとめる
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"strings"
+	"time"
+)
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string // text/plain（UTF-8）
+}
+
+type Mailer interface {
+	Send(ctx context.Context, m Message) error
+}
+
+var ErrInvalidHeader = errors.New("mail header contains line break")
+
+// build は RFC 5322 形式のメッセージを組み立てる。
+// 宛先・件名に改行があるとヘッダーを差し込まれるので弾く。
+func build(from string, m Message, now time.Time) ([]byte, error) {
+	for _, h := range []string{from, m.To, m.Subject} {
+		if strings.ContainsAny(h, "\r\n") {
+			return nil, ErrInvalidHeader
+		}
+	}
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To)
+	fmt.Fprintf(&b, "Subject: %s\r\n", mime.BEncoding.Encode("UTF-8", m.Subject))
+	fmt.Fprintf(&b, "Date: %s\r\n", now.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(m.Body, "\r\n", "\n"), "\n", "\r\n"))
+	return b.Bytes(), nil
+}
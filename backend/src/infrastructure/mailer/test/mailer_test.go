@@ -0,0 +1,66 @@
+package mailer_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/config"
+	"word_app/backend/src/infrastructure/mailer"
+)
+
+func TestFileMailer(t *testing.T) {
+	t.Run("eml として書き出す", func(t *testing.T) {
+		dir := t.TempDir()
+		m := mailer.NewFileMailer(dir, "no-reply@example.com")
+
+		err := m.Send(context.Background(), mailer.Message{
+			To: "alice@example.com", Subject: "メールアドレスの確認", Body: "line1\nline2",
+		})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+		b, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		s := string(b)
+		assert.Contains(t, s, "From: no-reply@example.com\r\n")
+		assert.Contains(t, s, "To: alice@example.com\r\n")
+		assert.Contains(t, s, "Subject: =?UTF-8?b?")
+		assert.Contains(t, s, "\r\n\r\nline1\r\nline2")
+	})
+
+	t.Run("ヘッダーに改行があれば送らない", func(t *testing.T) {
+		dir := t.TempDir()
+		m := mailer.NewFileMailer(dir, "no-reply@example.com")
+
+		err := m.Send(context.Background(), mailer.Message{
+			To: "alice@example.com\r\nBcc: evil@example.com", Subject: "x", Body: "y",
+		})
+		assert.ErrorIs(t, err, mailer.ErrInvalidHeader)
+
+		files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+		assert.Empty(t, files)
+	})
+}
+
+func TestNewMailerFromConfig(t *testing.T) {
+	_, err := mailer.NewMailerFromConfig(config.MailCfg{Driver: "smtp"})
+	assert.Error(t, err, "SMTP_HOST が無ければエラー")
+
+	_, err = mailer.NewMailerFromConfig(config.MailCfg{Driver: "pigeon"})
+	assert.Error(t, err)
+
+	m, err := mailer.NewMailerFromConfig(config.MailCfg{Driver: "smtp", SMTPHost: "localhost", SMTPPort: 25})
+	require.NoError(t, err)
+	assert.IsType(t, &mailer.SMTPMailer{}, m)
+
+	m, err = mailer.NewMailerFromConfig(config.MailCfg{Driver: "log"})
+	require.NoError(t, err)
+	assert.IsType(t, &mailer.FileMailer{}, m)
+}
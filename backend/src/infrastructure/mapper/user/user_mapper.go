@@ -68,6 +68,9 @@ func MapEntUser(u *ent.User, opt ...Option) *dm.User {
 		HasLine:     hasLine,
 		CreatedAt:   u.CreatedAt,
 		UpdatedAt:   u.UpdatedAt,
+
+		EmailVerifiedAt: u.EmailVerifiedAt,
+		TokenVersion:    u.TokenVersion,
 	}
 }
 
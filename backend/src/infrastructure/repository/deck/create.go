@@ -0,0 +1,23 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/ent/deck"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntDeckRepo) Create(ctx context.Context, d *domain.Deck) (*domain.Deck, error) {
+	e, err := r.entClient(ctx).Deck.Create().
+		SetUserID(d.UserID).
+		SetName(d.Name).
+		SetDescription(d.Description).
+		SetVisibility(deck.Visibility(d.Visibility)).
+		SetNillableShareToken(d.ShareToken).
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "user not found", "failed to create deck")
+	}
+	return toDomain(e, 0), nil
+}
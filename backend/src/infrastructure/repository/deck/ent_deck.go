@@ -0,0 +1,48 @@
+// src/infrastructure/repository/deck/ent_deck.go
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	serviceinterfaces "word_app/backend/src/interfaces/service_interfaces"
+)
+
+type EntDeckRepo struct {
+	client serviceinterfaces.EntClientInterface
+}
+
+func NewEntDeckRepo(client serviceinterfaces.EntClientInterface) *EntDeckRepo {
+	return &EntDeckRepo{client: client}
+}
+
+// Deck リポジトリ
+type Repository interface {
+	// デッキを作成する（単語は ReplaceWords で入れる）
+	Create(ctx context.Context, d *domain.Deck) (*domain.Deck, error)
+	// 単語（並び順）付きで返す。無ければ NotFound
+	FindByID(ctx context.Context, id int) (*domain.Deck, error)
+	// リンク共有のトークンで引く。単語付き。無ければ NotFound
+	FindByShareToken(ctx context.Context, token string) (*domain.Deck, error)
+	// ユーザーのデッキ一覧（更新の新しい順、単語は含めず件数だけ）
+	ListByUser(ctx context.Context, userID int) ([]*domain.Deck, error)
+	// 公開デッキの一覧（更新の新しい順、単語は含めず件数だけ）
+	ListPublic(ctx context.Context, offset, limit int) ([]*domain.Deck, error)
+	// ユーザーのデッキ数
+	CountByUser(ctx context.Context, userID int) (int, error)
+	// 名前・説明・公開範囲・共有トークンを更新する
+	Update(ctx context.Context, d *domain.Deck) (*domain.Deck, error)
+	// デッキの単語を wordIDs の並びで置き換える。存在しない単語があれば NotFound
+	ReplaceWords(ctx context.Context, deckID int, wordIDs []int) error
+	Delete(ctx context.Context, id int) error
+}
+
+// entClient は ctx に Tx があればそのクライアントを返す
+func (r *EntDeckRepo) entClient(ctx context.Context) *ent.Client {
+	if tx, ok := txRepo.TxFromContext(ctx); ok && tx != nil {
+		return tx.Client()
+	}
+	return r.client.EntClient()
+}
@@ -0,0 +1,56 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/predicate"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntDeckRepo) FindByID(ctx context.Context, id int) (*domain.Deck, error) {
+	return r.findOne(ctx, deck.ID(id))
+}
+
+func (r *EntDeckRepo) FindByShareToken(ctx context.Context, token string) (*domain.Deck, error) {
+	return r.findOne(ctx, deck.ShareToken(token))
+}
+
+func (r *EntDeckRepo) findOne(ctx context.Context, p predicate.Deck) (*domain.Deck, error) {
+	e, err := r.entClient(ctx).Deck.Query().
+		Where(p).
+		WithDeckWords(func(q *ent.DeckWordQuery) {
+			q.Order(ent.Asc(deckword.FieldPosition)).WithWord()
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "deck not found", "")
+	}
+	d := toDomain(e, len(e.Edges.DeckWords))
+	d.Words = make([]domain.DeckWord, 0, len(e.Edges.DeckWords))
+	for _, dw := range e.Edges.DeckWords {
+		w := domain.DeckWord{WordID: dw.WordID, Position: dw.Position}
+		if dw.Edges.Word != nil {
+			w.Name = dw.Edges.Word.Name
+		}
+		d.Words = append(d.Words, w)
+	}
+	return d, nil
+}
+
+func toDomain(e *ent.Deck, wordCount int) *domain.Deck {
+	return &domain.Deck{
+		ID:          e.ID,
+		UserID:      e.UserID,
+		Name:        e.Name,
+		Description: e.Description,
+		Visibility:  string(e.Visibility),
+		ShareToken:  e.ShareToken,
+		WordCount:   wordCount,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+	}
+}
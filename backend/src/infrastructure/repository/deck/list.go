@@ -0,0 +1,74 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntDeckRepo) ListByUser(ctx context.Context, userID int) ([]*domain.Deck, error) {
+	rows, err := r.entClient(ctx).Deck.Query().
+		Where(deck.UserID(userID)).
+		Order(ent.Desc(deck.FieldUpdatedAt), ent.Desc(deck.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "deck not found", "")
+	}
+	return r.withCounts(ctx, rows)
+}
+
+func (r *EntDeckRepo) ListPublic(ctx context.Context, offset, limit int) ([]*domain.Deck, error) {
+	rows, err := r.entClient(ctx).Deck.Query().
+		Where(deck.VisibilityEQ(deck.VisibilityPublic)).
+		Order(ent.Desc(deck.FieldUpdatedAt), ent.Desc(deck.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "deck not found", "")
+	}
+	return r.withCounts(ctx, rows)
+}
+
+func (r *EntDeckRepo) CountByUser(ctx context.Context, userID int) (int, error) {
+	n, err := r.entClient(ctx).Deck.Query().Where(deck.UserID(userID)).Count(ctx)
+	if err != nil {
+		return 0, repoerr.FromEnt(err, "deck not found", "")
+	}
+	return n, nil
+}
+
+// withCounts はデッキごとの単語数を 1 クエリで集計して詰める
+func (r *EntDeckRepo) withCounts(ctx context.Context, rows []*ent.Deck) ([]*domain.Deck, error) {
+	out := make([]*domain.Deck, 0, len(rows))
+	if len(rows) == 0 {
+		return out, nil
+	}
+	ids := make([]int, 0, len(rows))
+	for _, e := range rows {
+		ids = append(ids, e.ID)
+	}
+	var counts []struct {
+		DeckID int `json:"deck_id"`
+		Count  int `json:"count"`
+	}
+	if err := r.entClient(ctx).DeckWord.Query().
+		Where(deckword.DeckIDIn(ids...)).
+		GroupBy(deckword.FieldDeckID).
+		Aggregate(ent.Count()).
+		Scan(ctx, &counts); err != nil {
+		return nil, repoerr.FromEnt(err, "deck not found", "")
+	}
+	byDeck := make(map[int]int, len(counts))
+	for _, c := range counts {
+		byDeck[c.DeckID] = c.Count
+	}
+	for _, e := range rows {
+		out = append(out, toDomain(e, byDeck[e.ID]))
+	}
+	return out, nil
+}
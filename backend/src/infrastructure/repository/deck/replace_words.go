@@ -0,0 +1,46 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/word"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+// ReplaceWords は削除と INSERT を行うため、Tx（txRepo.Manager.WithTx）の中で呼ぶ。
+// wordIDs は重複を除いたものを渡す。
+func (r *EntDeckRepo) ReplaceWords(ctx context.Context, deckID int, wordIDs []int) error {
+	cli := r.entClient(ctx)
+	if len(wordIDs) > 0 {
+		n, err := cli.Word.Query().Where(word.IDIn(wordIDs...)).Count(ctx)
+		if err != nil {
+			return repoerr.FromEnt(err, "word not found", "")
+		}
+		if n != len(wordIDs) {
+			return repoerr.NotFound("word")
+		}
+	}
+	// updated_at を進める（一覧は更新の新しい順）
+	if err := cli.Deck.UpdateOneID(deckID).Exec(ctx); err != nil {
+		return repoerr.FromEnt(err, "deck not found", "")
+	}
+	if _, err := cli.DeckWord.Delete().Where(deckword.DeckID(deckID)).Exec(ctx); err != nil {
+		return repoerr.FromEnt(err, "deck not found", "")
+	}
+	if len(wordIDs) == 0 {
+		return nil
+	}
+	builders := make([]*ent.DeckWordCreate, 0, len(wordIDs))
+	for i, id := range wordIDs {
+		builders = append(builders, cli.DeckWord.Create().
+			SetDeckID(deckID).
+			SetWordID(id).
+			SetPosition(i))
+	}
+	if err := cli.DeckWord.CreateBulk(builders...).Exec(ctx); err != nil {
+		return repoerr.FromEnt(err, "deck not found", "duplicate word in deck")
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package deck_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3" // SQLite ドライバ登録
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repository/deck"
+	"word_app/backend/src/usecase/apperror"
+)
+
+// entAdapter は service_interfaces.EntClientInterface を *ent.Client で満たす薄いラッパー
+type entAdapter struct{ *ent.Client }
+
+func (a entAdapter) EntClient() *ent.Client                    { return a.Client }
+func (a entAdapter) ExternalAuth() *ent.ExternalAuthClient     { return a.Client.ExternalAuth }
+func (a entAdapter) JapaneseMean() *ent.JapaneseMeanClient     { return a.Client.JapaneseMean }
+func (a entAdapter) Quiz() *ent.QuizClient                     { return a.Client.Quiz }
+func (a entAdapter) QuizQuestion() *ent.QuizQuestionClient     { return a.Client.QuizQuestion }
+func (a entAdapter) RegisteredWord() *ent.RegisteredWordClient { return a.Client.RegisteredWord }
+func (a entAdapter) RootConfig() *ent.RootConfigClient         { return a.Client.RootConfig }
+func (a entAdapter) Tx(ctx context.Context) (*ent.Tx, error)   { return a.Client.Tx(ctx) }
+func (a entAdapter) User() *ent.UserClient                     { return a.Client.User }
+func (a entAdapter) UserConfig() *ent.UserConfigClient         { return a.Client.UserConfig }
+func (a entAdapter) Word() *ent.WordClient                     { return a.Client.Word }
+func (a entAdapter) WordInfo() *ent.WordInfoClient             { return a.Client.WordInfo }
+func (a entAdapter) UserDailyUsage() *ent.UserDailyUsageClient { return a.Client.UserDailyUsage }
+
+type fixture struct {
+	cli   *ent.Client
+	repo  *deck.EntDeckRepo
+	users []int
+	words []int
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctx := context.Background()
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = cli.Close() })
+
+	f := &fixture{cli: cli, repo: deck.NewEntDeckRepo(entAdapter{cli})}
+	for i := range 2 {
+		u := cli.User.Create().SetEmail(fmt.Sprintf("u%d@example.com", i)).SetName("user").SaveX(ctx)
+		f.users = append(f.users, u.ID)
+	}
+	for _, name := range []string{"apple", "banana", "cherry"} {
+		f.words = append(f.words, cli.Word.Create().SetName(name).SaveX(ctx).ID)
+	}
+	return f
+}
+
+func TestEntDeckRepo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("単語は指定した並びで保存され、置き換えられる", func(t *testing.T) {
+		f := newFixture(t)
+		d, err := f.repo.Create(ctx, &domain.Deck{UserID: f.users[0], Name: "fruits", Visibility: domain.DeckVisibilityPrivate})
+		require.NoError(t, err)
+
+		w := f.words
+		require.NoError(t, f.repo.ReplaceWords(ctx, d.ID, []int{w[2], w[0], w[1]}))
+		got, err := f.repo.FindByID(ctx, d.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []int{w[2], w[0], w[1]}, got.WordIDs())
+		assert.Equal(t, "cherry", got.Words[0].Name)
+		assert.Equal(t, 3, got.WordCount)
+
+		require.NoError(t, f.repo.ReplaceWords(ctx, d.ID, []int{w[1]}))
+		got, err = f.repo.FindByID(ctx, d.ID)
+		require.NoError(t, err)
+		assert.Equal(t, []int{w[1]}, got.WordIDs())
+		assert.Equal(t, 0, got.Words[0].Position)
+	})
+
+	t.Run("存在しない単語は NotFound で、元の単語は残る", func(t *testing.T) {
+		f := newFixture(t)
+		d, err := f.repo.Create(ctx, &domain.Deck{UserID: f.users[0], Name: "d", Visibility: domain.DeckVisibilityPrivate})
+		require.NoError(t, err)
+		require.NoError(t, f.repo.ReplaceWords(ctx, d.ID, f.words[:1]))
+
+		err = f.repo.ReplaceWords(ctx, d.ID, []int{f.words[1], 9999})
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+		n := f.cli.DeckWord.Query().Where(deckword.DeckID(d.ID)).CountX(ctx)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("一覧は単語数付きで、公開デッキだけを公開一覧に出す", func(t *testing.T) {
+		f := newFixture(t)
+		mine, err := f.repo.Create(ctx, &domain.Deck{UserID: f.users[0], Name: "mine", Visibility: domain.DeckVisibilityPrivate})
+		require.NoError(t, err)
+		require.NoError(t, f.repo.ReplaceWords(ctx, mine.ID, f.words))
+		pub, err := f.repo.Create(ctx, &domain.Deck{UserID: f.users[1], Name: "pub", Visibility: domain.DeckVisibilityPublic})
+		require.NoError(t, err)
+		require.NoError(t, f.repo.ReplaceWords(ctx, pub.ID, f.words[:2]))
+
+		list, err := f.repo.ListByUser(ctx, f.users[0])
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, 3, list[0].WordCount)
+		assert.Empty(t, list[0].Words)
+
+		public, err := f.repo.ListPublic(ctx, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, public, 1)
+		assert.Equal(t, pub.ID, public[0].ID)
+		assert.Equal(t, 2, public[0].WordCount)
+
+		n, err := f.repo.CountByUser(ctx, f.users[1])
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("共有トークンで引け、トークンを外すと引けなくなる", func(t *testing.T) {
+		f := newFixture(t)
+		token := "tok"
+		d, err := f.repo.Create(ctx, &domain.Deck{UserID: f.users[0], Name: "d", Visibility: domain.DeckVisibilityLink, ShareToken: &token})
+		require.NoError(t, err)
+
+		got, err := f.repo.FindByShareToken(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, d.ID, got.ID)
+
+		got.Visibility, got.ShareToken = domain.DeckVisibilityPrivate, nil
+		_, err = f.repo.Update(ctx, got)
+		require.NoError(t, err)
+		_, err = f.repo.FindByShareToken(ctx, token)
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+	})
+
+	t.Run("削除するとデッキの単語も消える", func(t *testing.T) {
+		f := newFixture(t)
+		d, err := f.repo.Create(ctx, &domain.Deck{UserID: f.users[0], Name: "d", Visibility: domain.DeckVisibilityPrivate})
+		require.NoError(t, err)
+		require.NoError(t, f.repo.ReplaceWords(ctx, d.ID, f.words))
+
+		require.NoError(t, f.repo.Delete(ctx, d.ID))
+		assert.Zero(t, f.cli.DeckWord.Query().CountX(ctx))
+		_, err = f.repo.FindByID(ctx, d.ID)
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+	})
+}
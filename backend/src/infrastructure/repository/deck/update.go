@@ -0,0 +1,33 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/ent/deck"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntDeckRepo) Update(ctx context.Context, d *domain.Deck) (*domain.Deck, error) {
+	upd := r.entClient(ctx).Deck.UpdateOneID(d.ID).
+		SetName(d.Name).
+		SetDescription(d.Description).
+		SetVisibility(deck.Visibility(d.Visibility))
+	if d.ShareToken != nil {
+		upd.SetShareToken(*d.ShareToken)
+	} else {
+		upd.ClearShareToken()
+	}
+	e, err := upd.Save(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "deck not found", "failed to update deck")
+	}
+	return toDomain(e, d.WordCount), nil
+}
+
+func (r *EntDeckRepo) Delete(ctx context.Context, id int) error {
+	if err := r.entClient(ctx).Deck.DeleteOneID(id).Exec(ctx); err != nil {
+		return repoerr.FromEnt(err, "deck not found", "")
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+// src/infrastructure/repository/export/ent_export.go
+package export
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	serviceinterfaces "word_app/backend/src/interfaces/service_interfaces"
+)
+
+type EntExportRepo struct {
+	client serviceinterfaces.EntClientInterface
+}
+
+func NewEntExportRepo(client serviceinterfaces.EntClientInterface) *EntExportRepo {
+	return &EntExportRepo{client: client}
+}
+
+// エクスポート用の読み出しリポジトリ。
+// 大きなユーザーでもメモリに載せきらないよう、ID の昇順で afterID より後ろを limit 件ずつ返す。
+type Repository interface {
+	// 登録単語（解除済みも含む）を意味付きで返す
+	RegisteredWords(ctx context.Context, userID, afterID, limit int) ([]domain.ExportWord, error)
+	// 終了済みのクイズ（削除済み・実行中は除く）を問題付きで返す
+	Quizzes(ctx context.Context, userID, afterID, limit int) ([]domain.ExportQuiz, error)
+}
+
+// entClient は ctx に Tx があればそのクライアントを返す
+func (r *EntExportRepo) entClient(ctx context.Context) *ent.Client {
+	if tx, ok := txRepo.TxFromContext(ctx); ok && tx != nil {
+		return tx.Client()
+	}
+	return r.client.EntClient()
+}
@@ -0,0 +1,128 @@
+package export
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/domain/spelling"
+	"word_app/backend/src/infrastructure/repoerr"
+	"word_app/backend/src/models"
+)
+
+func (r *EntExportRepo) Quizzes(ctx context.Context, userID, afterID, limit int) ([]domain.ExportQuiz, error) {
+	rows, err := r.entClient(ctx).Quiz.Query().
+		Where(
+			quiz.UserID(userID),
+			quiz.IDGT(afterID),
+			quiz.IsRunning(false),
+			quiz.DeletedAtIsNil(),
+		).
+		Order(ent.Asc(quiz.FieldID)).
+		Limit(limit).
+		WithQuizQuestions(func(qq *ent.QuizQuestionQuery) {
+			qq.Where(quizquestion.DeletedAtIsNil()).
+				Order(ent.Asc(quizquestion.FieldQuestionNumber))
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "quiz not found", "")
+	}
+
+	out := make([]domain.ExportQuiz, 0, len(rows))
+	for _, q := range rows {
+		out = append(out, toExportQuiz(q))
+	}
+	return out, nil
+}
+
+func toExportQuiz(q *ent.Quiz) domain.ExportQuiz {
+	status := models.QuizStatusCompleted // finish_status 導入前の行は全問回答済みとみなす
+	if q.FinishStatus != nil {
+		status = *q.FinishStatus
+	}
+	out := domain.ExportQuiz{
+		ID:                  q.ID,
+		QuizNumber:          q.QuizNumber,
+		Status:              status,
+		Mode:                q.Mode,
+		Direction:           q.Direction,
+		QuestionType:        q.QuestionType,
+		Difficulty:          q.Difficulty,
+		TotalQuestionsCount: q.TotalQuestionsCount,
+		CorrectCount:        q.CorrectCount,
+		CorrectRate:         q.ResultCorrectRate,
+		CreatedAt:           q.CreatedAt,
+		FinishedAt:          q.FinishedAt,
+		Questions:           make([]domain.ExportQuestion, 0, len(q.Edges.QuizQuestions)),
+	}
+	for _, qq := range q.Edges.QuizQuestions {
+		out.Questions = append(out.Questions, toExportQuestion(qq))
+	}
+	return out
+}
+
+// toExportQuestion は出題形式ごとに ID で持っている選択肢を文字列に戻す
+func toExportQuestion(qq *ent.QuizQuestion) domain.ExportQuestion {
+	out := domain.ExportQuestion{
+		QuestionNumber: qq.QuestionNumber,
+		Word:           qq.WordName,
+		Grade:          gradeOf(qq),
+		IsCorrect:      qq.IsCorrect != nil && *qq.IsCorrect,
+		TimeMs:         qq.TimeMs,
+		AnsweredAt:     qq.AnsweredAt,
+	}
+	switch {
+	case qq.QuestionType == "typing":
+		out.Prompt, out.CorrectAnswer = qq.MeaningName, qq.WordName
+		if qq.TypedAnswer != nil {
+			out.Answer = *qq.TypedAnswer
+		}
+	case qq.Direction == "ja_to_en":
+		out.Prompt, out.CorrectAnswer = qq.MeaningName, qq.WordName
+		if qq.AnswerWordID != nil {
+			out.Answer = wordChoiceName(qq.ChoicesWords, *qq.AnswerWordID)
+		}
+	default:
+		out.Prompt = qq.WordName
+		out.CorrectAnswer = jpmChoiceName(qq.ChoicesJpms, qq.CorrectJpmID)
+		if qq.AnswerJpmID != nil {
+			out.Answer = jpmChoiceName(qq.ChoicesJpms, *qq.AnswerJpmID)
+		}
+	}
+	return out
+}
+
+// gradeOf は採点結果を返す。grade 導入前の回答は正誤から補う。
+func gradeOf(qq *ent.QuizQuestion) string {
+	switch {
+	case qq.Grade != nil:
+		return *qq.Grade
+	case qq.IsCorrect == nil:
+		return ""
+	case *qq.IsCorrect:
+		return spelling.GradeCorrect
+	default:
+		return spelling.GradeWrong
+	}
+}
+
+func jpmChoiceName(choices []models.ChoiceJpm, id int) string {
+	for _, c := range choices {
+		if c.JapaneseMeanID == id {
+			return c.Name
+		}
+	}
+	return ""
+}
+
+func wordChoiceName(choices []models.ChoiceWord, id int) string {
+	for _, c := range choices {
+		if c.WordID == id {
+			return c.Name
+		}
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package export_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // SQLite ドライバ登録
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/infrastructure"
+	"word_app/backend/src/infrastructure/repository/export"
+	"word_app/backend/src/models"
+)
+
+type fixture struct {
+	cli  *ent.Client
+	repo *export.EntExportRepo
+	user int
+}
+
+func newFixture(t *testing.T) *fixture {
+	ctx := context.Background()
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = cli.Close() })
+
+	u := cli.User.Create().SetEmail("u@example.com").SetName("user").SaveX(ctx)
+	return &fixture{cli: cli, repo: export.NewEntExportRepo(infrastructure.NewAppClient(cli)), user: u.ID}
+}
+
+// word は品詞 pos の意味を持つ単語を作り、意味の ID を返す
+func (f *fixture) word(t *testing.T, name, pos string, means ...string) (*ent.Word, []int) {
+	ctx := context.Background()
+	p := f.cli.PartOfSpeech.Create().SetName(pos).SaveX(ctx)
+	w := f.cli.Word.Create().SetName(name).SaveX(ctx)
+	wi := f.cli.WordInfo.Create().SetWordID(w.ID).SetPartOfSpeechID(p.ID).SaveX(ctx)
+	ids := make([]int, 0, len(means))
+	for _, m := range means {
+		ids = append(ids, f.cli.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName(m).SaveX(ctx).ID)
+	}
+	return w, ids
+}
+
+func TestEntExportRepo_RegisteredWords(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	apple, _ := f.word(t, "apple", "名詞", "林檎", "りんごの木")
+	run, _ := f.word(t, "run", "動詞", "走る")
+	other := f.cli.User.Create().SetEmail("o@example.com").SetName("other").SaveX(ctx)
+
+	memo := "赤い"
+	first := f.cli.RegisteredWord.Create().SetUserID(f.user).SetWordID(apple.ID).
+		SetAttentionLevel(3).SetQuizCount(4).SetCorrectCount(3).SetCorrectRate(75).SetMemo(memo).SaveX(ctx)
+	f.cli.RegisteredWord.Create().SetUserID(f.user).SetWordID(run.ID).SetIsActive(false).SaveX(ctx)
+	f.cli.RegisteredWord.Create().SetUserID(other.ID).SetWordID(run.ID).SaveX(ctx)
+
+	t.Run("意味とメモ・集計を付けて ID 順に返し、解除済みも含める", func(t *testing.T) {
+		got, err := f.repo.RegisteredWords(ctx, f.user, 0, 10)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		assert.Equal(t, "apple", got[0].Word)
+		assert.Equal(t, memo, got[0].Memo)
+		assert.Equal(t, 3, got[0].AttentionLevel)
+		assert.Equal(t, 75, got[0].CorrectRate)
+		require.Len(t, got[0].Meanings, 2)
+		assert.Equal(t, "名詞", got[0].Meanings[0].PartOfSpeech)
+		assert.Equal(t, "林檎", got[0].Meanings[0].Name)
+
+		assert.Equal(t, "run", got[1].Word)
+		assert.False(t, got[1].IsActive)
+	})
+
+	t.Run("afterID より後ろを limit 件ずつ返す", func(t *testing.T) {
+		got, err := f.repo.RegisteredWords(ctx, f.user, 0, 1)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, first.ID, got[0].ID)
+
+		got, err = f.repo.RegisteredWords(ctx, f.user, first.ID, 1)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, "run", got[0].Word)
+	})
+}
+
+func TestEntExportRepo_Quizzes(t *testing.T) {
+	ctx := context.Background()
+	f := newFixture(t)
+	apple, jpms := f.word(t, "apple", "名詞", "林檎", "蜜柑")
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newQuiz := func(no int, running bool, deleted *time.Time) *ent.Quiz {
+		return f.cli.Quiz.Create().SetUserID(f.user).SetQuizNumber(no).SetIsRunning(running).
+			SetAttentionLevelList([]int{}).SetChoicesPosIds([]int{}).
+			SetTotalQuestionsCount(2).SetCorrectCount(1).SetResultCorrectRate(50).
+			SetNillableDeletedAt(deleted).SaveX(ctx)
+	}
+	done := newQuiz(1, false, nil)
+	newQuiz(2, true, nil)
+	newQuiz(3, false, &now)
+
+	choices := []models.ChoiceJpm{{JapaneseMeanID: jpms[0], Name: "林檎"}, {JapaneseMeanID: jpms[1], Name: "蜜柑"}}
+	f.cli.QuizQuestion.Create().SetQuizID(done.ID).SetQuestionNumber(2).SetWordID(apple.ID).SetWordName("apple").
+		SetPosID(1).SetCorrectJpmID(jpms[0]).SetChoicesJpms(choices).
+		SetAnswerJpmID(jpms[1]).SetIsCorrect(false).SetAnsweredAt(now).SaveX(ctx)
+	f.cli.QuizQuestion.Create().SetQuizID(done.ID).SetQuestionNumber(1).SetWordID(apple.ID).SetWordName("apple").
+		SetPosID(1).SetCorrectJpmID(jpms[0]).SetChoicesJpms([]models.ChoiceJpm{}).
+		SetDirection("ja_to_en").SetQuestionType("typing").SetMeaningName("林檎").
+		SetTypedAnswer("appel").SetGrade("near_miss").SetIsCorrect(false).SaveX(ctx)
+
+	got, err := f.repo.Quizzes(ctx, f.user, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, got, 1, "実行中と削除済みは含めない")
+
+	q := got[0]
+	assert.Equal(t, 1, q.QuizNumber)
+	assert.Equal(t, models.QuizStatusCompleted, q.Status)
+	require.Len(t, q.Questions, 2)
+
+	typing := q.Questions[0]
+	assert.Equal(t, 1, typing.QuestionNumber)
+	assert.Equal(t, "林檎", typing.Prompt)
+	assert.Equal(t, "apple", typing.CorrectAnswer)
+	assert.Equal(t, "appel", typing.Answer)
+	assert.Equal(t, "near_miss", typing.Grade)
+
+	choice := q.Questions[1]
+	assert.Equal(t, "apple", choice.Prompt)
+	assert.Equal(t, "林檎", choice.CorrectAnswer)
+	assert.Equal(t, "蜜柑", choice.Answer)
+	assert.Equal(t, "wrong", choice.Grade)
+}
@@ -0,0 +1,77 @@
+package export
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/registeredword"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntExportRepo) RegisteredWords(ctx context.Context, userID, afterID, limit int) ([]domain.ExportWord, error) {
+	rows, err := r.entClient(ctx).RegisteredWord.Query().
+		Where(
+			registeredword.UserID(userID),
+			registeredword.IDGT(afterID),
+		).
+		Order(ent.Asc(registeredword.FieldID)).
+		Limit(limit).
+		WithWord(func(wq *ent.WordQuery) {
+			wq.WithWordInfos(func(wiq *ent.WordInfoQuery) {
+				wiq.Order(ent.Asc(wordinfo.FieldID)).
+					WithPartOfSpeech().
+					WithJapaneseMeans(func(jq *ent.JapaneseMeanQuery) {
+						jq.Order(ent.Asc(japanesemean.FieldID))
+					})
+			})
+		}).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "registered word not found", "")
+	}
+
+	out := make([]domain.ExportWord, 0, len(rows))
+	for _, rw := range rows {
+		out = append(out, toExportWord(rw))
+	}
+	return out, nil
+}
+
+func toExportWord(rw *ent.RegisteredWord) domain.ExportWord {
+	w := domain.ExportWord{
+		ID:             rw.ID,
+		Meanings:       []domain.ExportMeaning{},
+		AttentionLevel: rw.AttentionLevel,
+		QuizCount:      rw.QuizCount,
+		CorrectCount:   rw.CorrectCount,
+		CorrectRate:    rw.CorrectRate,
+		IsActive:       rw.IsActive,
+		RegisteredAt:   rw.CreatedAt,
+		LastReviewedAt: rw.LastReviewedAt,
+		DueAt:          rw.DueAt,
+	}
+	if rw.Memo != nil {
+		w.Memo = *rw.Memo
+	}
+	if rw.Edges.Word == nil {
+		return w
+	}
+	w.Word = rw.Edges.Word.Name
+	for _, wi := range rw.Edges.Word.Edges.WordInfos {
+		pos := ""
+		if wi.Edges.PartOfSpeech != nil {
+			pos = wi.Edges.PartOfSpeech.Name
+		}
+		for _, jm := range wi.Edges.JapaneseMeans {
+			m := domain.ExportMeaning{PartOfSpeech: pos, Name: jm.Name}
+			if jm.Reading != nil {
+				m.Reading = *jm.Reading
+			}
+			w.Meanings = append(w.Meanings, m)
+		}
+	}
+	return w
+}
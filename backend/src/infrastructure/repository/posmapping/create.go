@@ -0,0 +1,51 @@
+package posmapping
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+// Create は版と対応を別々に INSERT するため、Tx（txRepo.Manager.WithTx）の中で呼ぶ
+func (r *EntPosMappingRepo) Create(ctx context.Context, m *posmap.Mapping) (*posmap.Mapping, error) {
+	out, err := Save(ctx, r.entClient(ctx), m)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "part of speech not found", "invalid pos mapping")
+	}
+	return out, nil
+}
+
+// Save は m を新しい版として書き込む。cli はトランザクションのクライアントを渡す。
+func Save(ctx context.Context, cli *ent.Client, m *posmap.Mapping) (*posmap.Mapping, error) {
+	v, err := cli.PosMappingVersion.Create().
+		SetFallbackPosID(m.Fallback).
+		SetNote(m.Note).
+		SetNillableCreatedBy(m.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	codes := slices.Sorted(maps.Keys(m.Codes))
+	builders := make([]*ent.PosMappingCreate, 0, len(codes))
+	for _, code := range codes {
+		builders = append(builders, cli.PosMapping.Create().
+			SetVersionID(v.ID).
+			SetCode(code).
+			SetPartOfSpeechID(m.Codes[code]))
+	}
+	if err := cli.PosMapping.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &posmap.Mapping{
+		Version:   v.ID,
+		Codes:     maps.Clone(m.Codes),
+		Fallback:  v.FallbackPosID,
+		Note:      v.Note,
+		CreatedBy: v.CreatedBy,
+		CreatedAt: v.CreatedAt,
+	}, nil
+}
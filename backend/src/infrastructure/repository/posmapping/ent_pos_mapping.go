@@ -0,0 +1,35 @@
+// src/infrastructure/repository/posmapping/ent_pos_mapping.go
+package posmapping
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain/posmap"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	serviceinterfaces "word_app/backend/src/interfaces/service_interfaces"
+)
+
+type EntPosMappingRepo struct {
+	client serviceinterfaces.EntClientInterface
+}
+
+func NewEntPosMappingRepo(client serviceinterfaces.EntClientInterface) *EntPosMappingRepo {
+	return &EntPosMappingRepo{client: client}
+}
+
+// 品詞コード対応表のリポジトリ
+type Repository interface {
+	// 最新版を返す。DB に版が無ければ posmap.Default()
+	Latest(ctx context.Context) (*posmap.Mapping, error)
+	// m を新しい版として保存し、採番された版を返す
+	Create(ctx context.Context, m *posmap.Mapping) (*posmap.Mapping, error)
+}
+
+// entClient は ctx に Tx があればそのクライアントを返す
+func (r *EntPosMappingRepo) entClient(ctx context.Context) *ent.Client {
+	if tx, ok := txRepo.TxFromContext(ctx); ok && tx != nil {
+		return tx.Client()
+	}
+	return r.client.EntClient()
+}
@@ -0,0 +1,48 @@
+package posmapping
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/posmappingversion"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntPosMappingRepo) Latest(ctx context.Context) (*posmap.Mapping, error) {
+	m, err := Load(ctx, r.entClient(ctx))
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "pos mapping not found", "")
+	}
+	return m, nil
+}
+
+// Load は最新版の対応表を読む。辞書インポートなど *ent.Client を直接持つ処理からも使う。
+func Load(ctx context.Context, cli *ent.Client) (*posmap.Mapping, error) {
+	v, err := cli.PosMappingVersion.Query().
+		Order(ent.Desc(posmappingversion.FieldID)).
+		WithMappings().
+		First(ctx)
+	if ent.IsNotFound(err) {
+		return posmap.Default(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomain(v), nil
+}
+
+func toDomain(v *ent.PosMappingVersion) *posmap.Mapping {
+	m := &posmap.Mapping{
+		Version:   v.ID,
+		Codes:     make(map[string]int, len(v.Edges.Mappings)),
+		Fallback:  v.FallbackPosID,
+		Note:      v.Note,
+		CreatedBy: v.CreatedBy,
+		CreatedAt: v.CreatedAt,
+	}
+	for _, pm := range v.Edges.Mappings {
+		m.Codes[pm.Code] = pm.PartOfSpeechID
+	}
+	return m
+}
@@ -0,0 +1,87 @@
+package posmapping_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3" // SQLite ドライバ登録
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/domain/posmap"
+	"word_app/backend/src/infrastructure/repository/posmapping"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	"word_app/backend/src/usecase/apperror"
+)
+
+// entAdapter は service_interfaces.EntClientInterface を *ent.Client で満たす薄いラッパー
+type entAdapter struct{ *ent.Client }
+
+func (a entAdapter) EntClient() *ent.Client                    { return a.Client }
+func (a entAdapter) ExternalAuth() *ent.ExternalAuthClient     { return a.Client.ExternalAuth }
+func (a entAdapter) JapaneseMean() *ent.JapaneseMeanClient     { return a.Client.JapaneseMean }
+func (a entAdapter) Quiz() *ent.QuizClient                     { return a.Client.Quiz }
+func (a entAdapter) QuizQuestion() *ent.QuizQuestionClient     { return a.Client.QuizQuestion }
+func (a entAdapter) RegisteredWord() *ent.RegisteredWordClient { return a.Client.RegisteredWord }
+func (a entAdapter) RootConfig() *ent.RootConfigClient         { return a.Client.RootConfig }
+func (a entAdapter) Tx(ctx context.Context) (*ent.Tx, error)   { return a.Client.Tx(ctx) }
+func (a entAdapter) User() *ent.UserClient                     { return a.Client.User }
+func (a entAdapter) UserConfig() *ent.UserConfigClient         { return a.Client.UserConfig }
+func (a entAdapter) Word() *ent.WordClient                     { return a.Client.Word }
+func (a entAdapter) WordInfo() *ent.WordInfoClient             { return a.Client.WordInfo }
+func (a entAdapter) UserDailyUsage() *ent.UserDailyUsageClient { return a.Client.UserDailyUsage }
+
+func newRepo(t *testing.T) (*ent.Client, *posmapping.EntPosMappingRepo) {
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = cli.Close() })
+	for i := 1; i <= posmap.NumPartOfSpeech; i++ {
+		cli.PartOfSpeech.Create().SetName(fmt.Sprintf("品詞%d", i)).SaveX(context.Background())
+	}
+	return cli, posmapping.NewEntPosMappingRepo(entAdapter{cli})
+}
+
+func TestEntPosMappingRepo(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("版が無ければ既定の対応表", func(t *testing.T) {
+		_, repo := newRepo(t)
+		m, err := repo.Latest(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, m.Version)
+		assert.Equal(t, posmap.Default().Codes, m.Codes)
+	})
+
+	t.Run("保存するたびに新しい版になる", func(t *testing.T) {
+		cli, repo := newRepo(t)
+		root := 7
+		first, err := repo.Create(ctx, &posmap.Mapping{Codes: map[string]int{"n": 1, "v5k": 11}, Fallback: 11})
+		require.NoError(t, err)
+		second, err := repo.Create(ctx, &posmap.Mapping{
+			Codes: map[string]int{"n": 1, "v5k": 3}, Fallback: 12, Note: "v5k を動詞に", CreatedBy: &root,
+		})
+		require.NoError(t, err)
+		assert.Greater(t, second.Version, first.Version)
+
+		latest, err := repo.Latest(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, second.Version, latest.Version)
+		assert.Equal(t, map[string]int{"n": 1, "v5k": 3}, latest.Codes)
+		assert.Equal(t, 12, latest.Fallback)
+		assert.Equal(t, "v5k を動詞に", latest.Note)
+		assert.Equal(t, &root, latest.CreatedBy)
+		assert.Equal(t, 4, cli.PosMapping.Query().CountX(ctx), "前の版の行も残る")
+	})
+
+	t.Run("Tx に参加し、失敗すれば版も残らない", func(t *testing.T) {
+		cli, repo := newRepo(t)
+		err := txRepo.NewEntManager(entAdapter{cli}).WithTx(ctx, func(txCtx context.Context) error {
+			_, err := repo.Create(txCtx, &posmap.Mapping{Codes: map[string]int{"n": 99}, Fallback: 12})
+			return err
+		})
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "存在しない品詞は制約違反: %v", err)
+		assert.Zero(t, cli.PosMappingVersion.Query().CountX(ctx))
+	})
+}
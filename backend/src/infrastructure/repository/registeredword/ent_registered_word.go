@@ -49,4 +49,6 @@ type WriteRepository interface {
 	Activate(ctx context.Context, userID, wordID int) error
 	// 新規作成。UNIQUE(user_id, word_id) 競合時は ErrConflict を返す実装でもOK
 	CreateActive(ctx context.Context, userID, wordID int) error
+	// memo / attention_level のうち nil でないものだけ更新する。両方 nil なら何もしない
+	UpdateNote(ctx context.Context, userID, wordID int, memo *string, attentionLevel *int) error
 }
@@ -0,0 +1,66 @@
+// backend/src/infrastructure/repository/registeredword/update_note_test.go
+package registeredword_test
+
+import (
+	"context"
+	"testing"
+
+	entrw "word_app/backend/ent/registeredword"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEntRegisteredWordWriteRepo_UpdateNote(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("success - update memo and attention level", func(t *testing.T) {
+		adapter, repo := newWriteRepo(t)
+
+		user := createUser(t, adapter.EntClient(), "note@example.com", "Note User")
+		word := createWord(t, adapter.EntClient(), "apple")
+		createRegisteredWord(t, adapter.EntClient(), user.ID, word.ID, true)
+
+		memo, level := "赤い果物", 4
+		require.NoError(t, repo.UpdateNote(ctx, user.ID, word.ID, &memo, &level))
+
+		rw, err := adapter.EntClient().RegisteredWord.Query().
+			Where(entrw.UserIDEQ(user.ID), entrw.WordIDEQ(word.ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, rw.Memo)
+		assert.Equal(t, memo, *rw.Memo)
+		assert.Equal(t, level, rw.AttentionLevel)
+	})
+
+	t.Run("success - nil fields are left unchanged", func(t *testing.T) {
+		adapter, repo := newWriteRepo(t)
+
+		user := createUser(t, adapter.EntClient(), "partial@example.com", "Partial User")
+		word := createWord(t, adapter.EntClient(), "apple")
+		createRegisteredWord(t, adapter.EntClient(), user.ID, word.ID, true)
+
+		memo := "memo"
+		require.NoError(t, repo.UpdateNote(ctx, user.ID, word.ID, &memo, nil))
+		require.NoError(t, repo.UpdateNote(ctx, user.ID, word.ID, nil, nil))
+
+		rw, err := adapter.EntClient().RegisteredWord.Query().
+			Where(entrw.UserIDEQ(user.ID), entrw.WordIDEQ(word.ID)).
+			Only(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, memo, *rw.Memo)
+		assert.Equal(t, 1, rw.AttentionLevel)
+	})
+
+	t.Run("error - invalid attention level", func(t *testing.T) {
+		adapter, repo := newWriteRepo(t)
+
+		user := createUser(t, adapter.EntClient(), "invalid@example.com", "Invalid User")
+		word := createWord(t, adapter.EntClient(), "apple")
+		createRegisteredWord(t, adapter.EntClient(), user.ID, word.ID, true)
+
+		level := 9
+		assert.Error(t, repo.UpdateNote(ctx, user.ID, word.ID, nil, &level))
+	})
+}
@@ -0,0 +1,23 @@
+package registeredword
+
+import (
+	"context"
+
+	"word_app/backend/ent/registeredword"
+)
+
+func (r *EntRegisteredWordWriteRepo) UpdateNote(ctx context.Context, userID, wordID int, memo *string, attentionLevel *int) error {
+	if memo == nil && attentionLevel == nil {
+		return nil
+	}
+	_, err := r.client.RegisteredWord().
+		Update().
+		Where(
+			registeredword.UserIDEQ(userID),
+			registeredword.WordIDEQ(wordID),
+		).
+		SetNillableMemo(memo).
+		SetNillableAttentionLevel(attentionLevel).
+		Save(ctx)
+	return err
+}
@@ -0,0 +1,54 @@
+// src/infrastructure/repository/session/ent_session.go
+package session
+
+import (
+	"context"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	serviceinterfaces "word_app/backend/src/interfaces/service_interfaces"
+)
+
+type EntSessionRepo struct {
+	client serviceinterfaces.EntClientInterface
+}
+
+func NewEntSessionRepo(client serviceinterfaces.EntClientInterface) *EntSessionRepo {
+	return &EntSessionRepo{client: client}
+}
+
+// リフレッシュトークンのリポジトリ
+type Repository interface {
+	Create(ctx context.Context, t *domain.RefreshToken) (*domain.RefreshToken, error)
+	// 無ければ NotFound
+	FindByHash(ctx context.Context, hash string) (*domain.RefreshToken, error)
+	// 未使用・未失効のものだけを使用済みにする。既に使われていれば false
+	MarkUsed(ctx context.Context, id int, at time.Time) (bool, error)
+	// family の未失効トークンをすべて失効させる
+	RevokeFamily(ctx context.Context, familyID string, at time.Time) error
+	// ユーザーの未失効トークンをすべて失効させる
+	RevokeAllByUser(ctx context.Context, userID int, at time.Time) error
+}
+
+// entClient は ctx に Tx があればそのクライアントを返す
+func (r *EntSessionRepo) entClient(ctx context.Context) *ent.Client {
+	if tx, ok := txRepo.TxFromContext(ctx); ok && tx != nil {
+		return tx.Client()
+	}
+	return r.client.EntClient()
+}
+
+func toDomain(e *ent.RefreshToken) *domain.RefreshToken {
+	return &domain.RefreshToken{
+		ID:        e.ID,
+		UserID:    e.UserID,
+		FamilyID:  e.FamilyID,
+		TokenHash: e.TokenHash,
+		ExpiresAt: e.ExpiresAt,
+		CreatedAt: e.CreatedAt,
+		UsedAt:    e.UsedAt,
+		RevokedAt: e.RevokedAt,
+	}
+}
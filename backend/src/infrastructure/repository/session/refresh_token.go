@@ -0,0 +1,71 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"word_app/backend/ent/refreshtoken"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntSessionRepo) Create(ctx context.Context, t *domain.RefreshToken) (*domain.RefreshToken, error) {
+	e, err := r.entClient(ctx).RefreshToken.Create().
+		SetUserID(t.UserID).
+		SetFamilyID(t.FamilyID).
+		SetTokenHash(t.TokenHash).
+		SetExpiresAt(t.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "user not found", "failed to create refresh token")
+	}
+	return toDomain(e), nil
+}
+
+func (r *EntSessionRepo) FindByHash(ctx context.Context, hash string) (*domain.RefreshToken, error) {
+	e, err := r.entClient(ctx).RefreshToken.Query().
+		Where(refreshtoken.TokenHash(hash)).
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "refresh token not found", "")
+	}
+	return toDomain(e), nil
+}
+
+func (r *EntSessionRepo) MarkUsed(ctx context.Context, id int, at time.Time) (bool, error) {
+	// 同じトークンで同時にリフレッシュされてもローテーションは 1 回だけにする
+	n, err := r.entClient(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.ID(id),
+			refreshtoken.UsedAtIsNil(),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetUsedAt(at).
+		Save(ctx)
+	if err != nil {
+		return false, repoerr.FromEnt(err, "refresh token not found", "")
+	}
+	return n == 1, nil
+}
+
+func (r *EntSessionRepo) RevokeFamily(ctx context.Context, familyID string, at time.Time) error {
+	_, err := r.entClient(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.FamilyID(familyID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(at).
+		Save(ctx)
+	return repoerr.FromEnt(err, "refresh token not found", "")
+}
+
+func (r *EntSessionRepo) RevokeAllByUser(ctx context.Context, userID int, at time.Time) error {
+	_, err := r.entClient(ctx).RefreshToken.Update().
+		Where(
+			refreshtoken.UserID(userID),
+			refreshtoken.RevokedAtIsNil(),
+		).
+		SetRevokedAt(at).
+		Save(ctx)
+	return repoerr.FromEnt(err, "refresh token not found", "")
+}
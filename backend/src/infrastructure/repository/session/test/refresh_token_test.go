@@ -0,0 +1,115 @@
+package session_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // SQLite ドライバ登録
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repository/session"
+	"word_app/backend/src/usecase/apperror"
+)
+
+// entAdapter は service_interfaces.EntClientInterface を *ent.Client で満たす薄いラッパー
+type entAdapter struct{ *ent.Client }
+
+func (a entAdapter) EntClient() *ent.Client                    { return a.Client }
+func (a entAdapter) ExternalAuth() *ent.ExternalAuthClient     { return a.Client.ExternalAuth }
+func (a entAdapter) JapaneseMean() *ent.JapaneseMeanClient     { return a.Client.JapaneseMean }
+func (a entAdapter) Quiz() *ent.QuizClient                     { return a.Client.Quiz }
+func (a entAdapter) QuizQuestion() *ent.QuizQuestionClient     { return a.Client.QuizQuestion }
+func (a entAdapter) RegisteredWord() *ent.RegisteredWordClient { return a.Client.RegisteredWord }
+func (a entAdapter) RootConfig() *ent.RootConfigClient         { return a.Client.RootConfig }
+func (a entAdapter) Tx(ctx context.Context) (*ent.Tx, error)   { return a.Client.Tx(ctx) }
+func (a entAdapter) User() *ent.UserClient                     { return a.Client.User }
+func (a entAdapter) UserConfig() *ent.UserConfigClient         { return a.Client.UserConfig }
+func (a entAdapter) Word() *ent.WordClient                     { return a.Client.Word }
+func (a entAdapter) WordInfo() *ent.WordInfoClient             { return a.Client.WordInfo }
+func (a entAdapter) UserDailyUsage() *ent.UserDailyUsageClient { return a.Client.UserDailyUsage }
+
+func TestEntSessionRepo(t *testing.T) {
+	ctx := context.Background()
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = cli.Close() })
+	repo := session.NewEntSessionRepo(entAdapter{cli})
+
+	alice := cli.User.Create().SetEmail("alice@example.com").SetName("alice").SaveX(ctx).ID
+	bob := cli.User.Create().SetEmail("bob@example.com").SetName("bob").SaveX(ctx).ID
+	now := time.Now().Truncate(time.Second)
+
+	create := func(userID int, family, hash string) *domain.RefreshToken {
+		tk, err := repo.Create(ctx, &domain.RefreshToken{
+			UserID: userID, FamilyID: family, TokenHash: hash, ExpiresAt: now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		return tk
+	}
+	a1 := create(alice, "fam-a", "hash-a1")
+	create(alice, "fam-a", "hash-a2")
+	create(alice, "fam-b", "hash-b1")
+	create(bob, "fam-c", "hash-c1")
+
+	t.Run("create and find by hash", func(t *testing.T) {
+		got, err := repo.FindByHash(ctx, "hash-a1")
+		require.NoError(t, err)
+		assert.Equal(t, a1.ID, got.ID)
+		assert.Equal(t, alice, got.UserID)
+		assert.Equal(t, "fam-a", got.FamilyID)
+		assert.Nil(t, got.UsedAt)
+		assert.Nil(t, got.RevokedAt)
+
+		_, err = repo.FindByHash(ctx, "unknown")
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+	})
+
+	t.Run("duplicate hash is a conflict", func(t *testing.T) {
+		_, err := repo.Create(ctx, &domain.RefreshToken{
+			UserID: alice, FamilyID: "fam-x", TokenHash: "hash-a1", ExpiresAt: now,
+		})
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "%v", err)
+	})
+
+	t.Run("mark used only once", func(t *testing.T) {
+		ok, err := repo.MarkUsed(ctx, a1.ID, now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = repo.MarkUsed(ctx, a1.ID, now)
+		require.NoError(t, err)
+		assert.False(t, ok, "second use must be detected")
+	})
+
+	t.Run("revoke family", func(t *testing.T) {
+		require.NoError(t, repo.RevokeFamily(ctx, "fam-a", now))
+
+		got, err := repo.FindByHash(ctx, "hash-a2")
+		require.NoError(t, err)
+		require.NotNil(t, got.RevokedAt)
+		other, err := repo.FindByHash(ctx, "hash-b1")
+		require.NoError(t, err)
+		assert.Nil(t, other.RevokedAt, "other families are untouched")
+
+		// 失効済みのものは使用済みにできない
+		ok, err := repo.MarkUsed(ctx, got.ID, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("revoke all by user", func(t *testing.T) {
+		require.NoError(t, repo.RevokeAllByUser(ctx, alice, now))
+
+		got, err := repo.FindByHash(ctx, "hash-b1")
+		require.NoError(t, err)
+		assert.NotNil(t, got.RevokedAt)
+		bobs, err := repo.FindByHash(ctx, "hash-c1")
+		require.NoError(t, err)
+		assert.Nil(t, bobs.RevokedAt)
+	})
+}
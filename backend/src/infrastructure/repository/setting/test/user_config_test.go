@@ -115,6 +115,29 @@ func TestEntUserConfigRepo_Upsert(t *testing.T) {
 		assert.True(t, dbRec.IsDarkMode)
 	})
 
+	t.Run("SrsAlgorithm 空なら既存値を維持", func(t *testing.T) {
+		cli, repo := newRepo(t)
+		u, _ := cli.User().
+			Create().
+			SetEmail("alpha@mail.com").
+			SetName("seed").
+			SetPassword("Password123$").
+			Save(ctx)
+
+		got, err := repo.Upsert(ctx, &domain.UserConfig{UserID: u.ID})
+		assert.NoError(t, err)
+		assert.Equal(t, "sm2", got.SrsAlgorithm, "新規は既定値")
+
+		got, err = repo.Upsert(ctx, &domain.UserConfig{UserID: u.ID, SrsAlgorithm: "fsrs"})
+		assert.NoError(t, err)
+		assert.Equal(t, "fsrs", got.SrsAlgorithm)
+
+		got, err = repo.Upsert(ctx, &domain.UserConfig{UserID: u.ID, IsDarkMode: true})
+		assert.NoError(t, err)
+		assert.Equal(t, "fsrs", got.SrsAlgorithm)
+		assert.True(t, got.IsDarkMode)
+	})
+
 	t.Run("Query エラーを透過 (ctx cancel)", func(t *testing.T) {
 		cli, repo := newRepo(t)
 		// u, _ := cli.User().Create().Save(ctx)
@@ -35,9 +35,10 @@ func (r *EntUserConfigRepo) GetByUserID(ctx context.Context, uid int) (*domain.U
 	}
 
 	return &domain.UserConfig{
-		ID:         uc.ID,
-		UserID:     uc.UserID,
-		IsDarkMode: uc.IsDarkMode,
+		ID:           uc.ID,
+		UserID:       uc.UserID,
+		IsDarkMode:   uc.IsDarkMode,
+		SrsAlgorithm: uc.SrsAlgorithm,
 	}, nil
 }
 
@@ -50,20 +51,27 @@ func (r *EntUserConfigRepo) Upsert(ctx context.Context, cfg *domain.UserConfig)
 		return nil, err
 	}
 
+	// SrsAlgorithm は空なら既存値（新規は既定値）を維持する
 	if uc == nil {
-		uc, err = r.client.UserConfig().
+		c := r.client.UserConfig().
 			Create().
 			SetUserID(cfg.UserID).
-			SetIsDarkMode(cfg.IsDarkMode).
-			Save(ctx)
+			SetIsDarkMode(cfg.IsDarkMode)
+		if cfg.SrsAlgorithm != "" {
+			c.SetSrsAlgorithm(cfg.SrsAlgorithm)
+		}
+		uc, err = c.Save(ctx)
 	} else {
-		uc, err = r.client.UserConfig().
+		u := r.client.UserConfig().
 			UpdateOne(uc).
-			SetIsDarkMode(cfg.IsDarkMode).
-			Save(ctx)
+			SetIsDarkMode(cfg.IsDarkMode)
+		if cfg.SrsAlgorithm != "" {
+			u.SetSrsAlgorithm(cfg.SrsAlgorithm)
+		}
+		uc, err = u.Save(ctx)
 	}
 	if err != nil {
 		return nil, err
 	}
-	return &domain.UserConfig{UserID: uc.UserID, IsDarkMode: uc.IsDarkMode}, nil
+	return &domain.UserConfig{UserID: uc.UserID, IsDarkMode: uc.IsDarkMode, SrsAlgorithm: uc.SrsAlgorithm}, nil
 }
@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntUserRepo) BumpTokenVersion(ctx context.Context, userID int) error {
+	err := r.client.User().UpdateOneID(userID).
+		AddTokenVersion(1).
+		Exec(ctx)
+	return repoerr.FromEnt(err, "user not found", "")
+}
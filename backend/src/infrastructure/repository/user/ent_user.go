@@ -30,6 +30,8 @@ type Repository interface {
 	Exists(ctx context.Context, id int) (bool, error)
 	IsTest(ctx context.Context, id int) (bool, error)
 	LockByID(ctx context.Context, userID int) error
+	// token_version を上げて、発行済みのアクセストークンをすべて無効にする
+	BumpTokenVersion(ctx context.Context, userID int) error
 }
 
 func NewEntUserRepo(c serviceinterfaces.EntClientInterface) *EntUserRepo {
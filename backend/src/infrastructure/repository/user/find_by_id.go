@@ -19,6 +19,7 @@ func (r *EntUserRepo) FindByID(ctx context.Context, id int) (*domain.User, error
 			user.FieldIsAdmin,
 			user.FieldIsTest,
 			user.FieldDeletedAt,
+			user.FieldTokenVersion,
 		).
 		Only(ctx)
 	if err != nil {
@@ -34,5 +35,7 @@ func (r *EntUserRepo) FindByID(ctx context.Context, id int) (*domain.User, error
 		IsAdmin:   u.IsAdmin,
 		IsTest:    u.IsTest,
 		DeletedAt: u.DeletedAt,
+
+		TokenVersion: u.TokenVersion,
 	}, nil
 }
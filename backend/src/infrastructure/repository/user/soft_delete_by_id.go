@@ -11,6 +11,7 @@ func (r *EntUserRepo) SoftDeleteByID(ctx context.Context, id int, t time.Time) e
 	_, err := r.client.User().
 		UpdateOneID(id).
 		SetDeletedAt(t).
+		AddTokenVersion(1). // 発行済みのアクセストークンも無効にする
 		Save(ctx)
 	if err != nil {
 		return repoerr.FromEnt(err, "internal", "internal server error")
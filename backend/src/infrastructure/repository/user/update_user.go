@@ -28,6 +28,7 @@ func (r *EntUserRepo) FindForUpdate(ctx context.Context, id int) (*domain.User,
 			user.FieldIsAdmin,
 			user.FieldIsRoot,
 			user.FieldIsTest,
+			user.FieldEmailVerifiedAt,
 			user.FieldCreatedAt,
 			user.FieldUpdatedAt,
 		).
@@ -57,6 +58,8 @@ func (r *EntUserRepo) FindForUpdate(ctx context.Context, id int) (*domain.User,
 		IsTest:    u.IsTest,
 		CreatedAt: u.CreatedAt,
 		UpdatedAt: u.UpdatedAt,
+
+		EmailVerifiedAt: u.EmailVerifiedAt,
 	}, nil
 }
 
@@ -75,6 +78,15 @@ func (r *EntUserRepo) UpdatePartial(ctx context.Context, targetID int, f *reposi
 	if f.SetAdmin != nil {
 		u.SetIsAdmin(*f.SetAdmin)
 	}
+	if f.BumpTokenVersion {
+		u.AddTokenVersion(1)
+	}
+	switch {
+	case f.EmailVerifiedAt != nil:
+		u.SetEmailVerifiedAt(*f.EmailVerifiedAt)
+	case f.ClearEmailVerified:
+		u.ClearEmailVerifiedAt()
+	}
 	user, err := u.Save(ctx)
 	if err != nil {
 		if ent.IsConstraintError(err) {
@@ -21,4 +21,6 @@ func NewEntWordReadRepo(
 type ReadRepository interface {
 	// names に含まれる単語の ID を map[name]id で返す
 	FindIDsByNames(ctx context.Context, names []string) (map[string]int, error)
+	// ID から単語名を返す。無ければ NotFound
+	NameByID(ctx context.Context, id int) (string, error)
 }
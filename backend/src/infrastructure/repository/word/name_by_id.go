@@ -0,0 +1,16 @@
+package word
+
+import (
+	"context"
+
+	"word_app/backend/ent/word"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntWordReadRepo) NameByID(ctx context.Context, id int) (string, error) {
+	name, err := r.client.Word().Query().Where(word.ID(id)).Select(word.FieldName).String(ctx)
+	if err != nil {
+		return "", repoerr.FromEnt(err, "word not found", "")
+	}
+	return name, nil
+}
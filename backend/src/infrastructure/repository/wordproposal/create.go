@@ -0,0 +1,23 @@
+package wordproposal
+
+import (
+	"context"
+
+	"word_app/backend/ent/wordproposal"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntWordProposalRepo) Create(ctx context.Context, p *domain.WordProposal) (*domain.WordProposal, error) {
+	e, err := r.entClient(ctx).WordProposal.Create().
+		SetUserID(p.UserID).
+		SetAction(wordproposal.Action(p.Action)).
+		SetNillableWordID(p.WordID).
+		SetWordName(p.WordName).
+		SetPayload(string(p.Payload)).
+		Save(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "user not found", "failed to create proposal")
+	}
+	return toDomain(e), nil
+}
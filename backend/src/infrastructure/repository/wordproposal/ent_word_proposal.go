@@ -0,0 +1,69 @@
+// src/infrastructure/repository/wordproposal/ent_word_proposal.go
+package wordproposal
+
+import (
+	"context"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	serviceinterfaces "word_app/backend/src/interfaces/service_interfaces"
+)
+
+type EntWordProposalRepo struct {
+	client serviceinterfaces.EntClientInterface
+}
+
+func NewEntWordProposalRepo(client serviceinterfaces.EntClientInterface) *EntWordProposalRepo {
+	return &EntWordProposalRepo{client: client}
+}
+
+// ListFilter は一覧の絞り込み。ゼロ値の項目は絞り込まない
+type ListFilter struct {
+	UserID int
+	Status string
+	Offset int
+	Limit  int
+}
+
+// WordProposal リポジトリ
+type Repository interface {
+	Create(ctx context.Context, p *domain.WordProposal) (*domain.WordProposal, error)
+	// 無ければ NotFound
+	FindByID(ctx context.Context, id int) (*domain.WordProposal, error)
+	// 新しい順
+	List(ctx context.Context, f ListFilter) ([]*domain.WordProposal, error)
+	// ユーザーの承認待ちの件数
+	CountPendingByUser(ctx context.Context, userID int) (int, error)
+	// pending のものだけを status にする。既に審査済みなら false
+	Review(ctx context.Context, id, reviewerID int, status, note string, at time.Time) (bool, error)
+	// 反映に失敗したときに pending へ戻す
+	Reopen(ctx context.Context, id int) error
+	// create の提案が反映されたときに作成された Word を記録する
+	SetWordID(ctx context.Context, id, wordID int) error
+}
+
+// entClient は ctx に Tx があればそのクライアントを返す
+func (r *EntWordProposalRepo) entClient(ctx context.Context) *ent.Client {
+	if tx, ok := txRepo.TxFromContext(ctx); ok && tx != nil {
+		return tx.Client()
+	}
+	return r.client.EntClient()
+}
+
+func toDomain(e *ent.WordProposal) *domain.WordProposal {
+	return &domain.WordProposal{
+		ID:         e.ID,
+		UserID:     e.UserID,
+		Action:     string(e.Action),
+		WordID:     e.WordID,
+		WordName:   e.WordName,
+		Payload:    []byte(e.Payload),
+		Status:     string(e.Status),
+		ReviewerID: e.ReviewerID,
+		ReviewNote: e.ReviewNote,
+		CreatedAt:  e.CreatedAt,
+		ReviewedAt: e.ReviewedAt,
+	}
+}
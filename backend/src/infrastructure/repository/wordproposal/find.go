@@ -0,0 +1,53 @@
+package wordproposal
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/wordproposal"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntWordProposalRepo) FindByID(ctx context.Context, id int) (*domain.WordProposal, error) {
+	e, err := r.entClient(ctx).WordProposal.Get(ctx, id)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "proposal not found", "")
+	}
+	return toDomain(e), nil
+}
+
+func (r *EntWordProposalRepo) List(ctx context.Context, f ListFilter) ([]*domain.WordProposal, error) {
+	q := r.entClient(ctx).WordProposal.Query()
+	if f.UserID > 0 {
+		q = q.Where(wordproposal.UserID(f.UserID))
+	}
+	if f.Status != "" {
+		q = q.Where(wordproposal.StatusEQ(wordproposal.Status(f.Status)))
+	}
+	if f.Limit > 0 {
+		q = q.Offset(f.Offset).Limit(f.Limit)
+	}
+	rows, err := q.Order(ent.Desc(wordproposal.FieldCreatedAt), ent.Desc(wordproposal.FieldID)).All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "proposal not found", "")
+	}
+	out := make([]*domain.WordProposal, 0, len(rows))
+	for _, e := range rows {
+		out = append(out, toDomain(e))
+	}
+	return out, nil
+}
+
+func (r *EntWordProposalRepo) CountPendingByUser(ctx context.Context, userID int) (int, error) {
+	n, err := r.entClient(ctx).WordProposal.Query().
+		Where(
+			wordproposal.UserID(userID),
+			wordproposal.StatusEQ(wordproposal.StatusPending),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, repoerr.FromEnt(err, "proposal not found", "")
+	}
+	return n, nil
+}
@@ -0,0 +1,44 @@
+package wordproposal
+
+import (
+	"context"
+	"time"
+
+	"word_app/backend/ent/wordproposal"
+	"word_app/backend/src/infrastructure/repoerr"
+)
+
+func (r *EntWordProposalRepo) Review(ctx context.Context, id, reviewerID int, status, note string, at time.Time) (bool, error) {
+	// 同時に審査されても反映は 1 回だけになるよう、pending を条件に更新する
+	n, err := r.entClient(ctx).WordProposal.Update().
+		Where(
+			wordproposal.ID(id),
+			wordproposal.StatusEQ(wordproposal.StatusPending),
+		).
+		SetStatus(wordproposal.Status(status)).
+		SetReviewerID(reviewerID).
+		SetReviewNote(note).
+		SetReviewedAt(at).
+		Save(ctx)
+	if err != nil {
+		return false, repoerr.FromEnt(err, "proposal not found", "")
+	}
+	return n == 1, nil
+}
+
+func (r *EntWordProposalRepo) Reopen(ctx context.Context, id int) error {
+	err := r.entClient(ctx).WordProposal.UpdateOneID(id).
+		SetStatus(wordproposal.StatusPending).
+		ClearReviewerID().
+		SetReviewNote("").
+		ClearReviewedAt().
+		Exec(ctx)
+	return repoerr.FromEnt(err, "proposal not found", "")
+}
+
+func (r *EntWordProposalRepo) SetWordID(ctx context.Context, id, wordID int) error {
+	err := r.entClient(ctx).WordProposal.UpdateOneID(id).
+		SetWordID(wordID).
+		Exec(ctx)
+	return repoerr.FromEnt(err, "proposal not found", "")
+}
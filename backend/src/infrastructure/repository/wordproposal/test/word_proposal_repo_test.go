@@ -0,0 +1,117 @@
+package wordproposal_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3" // SQLite ドライバ登録
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/repository/wordproposal"
+	"word_app/backend/src/usecase/apperror"
+)
+
+// entAdapter は service_interfaces.EntClientInterface を *ent.Client で満たす薄いラッパー
+type entAdapter struct{ *ent.Client }
+
+func (a entAdapter) EntClient() *ent.Client                    { return a.Client }
+func (a entAdapter) ExternalAuth() *ent.ExternalAuthClient     { return a.Client.ExternalAuth }
+func (a entAdapter) JapaneseMean() *ent.JapaneseMeanClient     { return a.Client.JapaneseMean }
+func (a entAdapter) Quiz() *ent.QuizClient                     { return a.Client.Quiz }
+func (a entAdapter) QuizQuestion() *ent.QuizQuestionClient     { return a.Client.QuizQuestion }
+func (a entAdapter) RegisteredWord() *ent.RegisteredWordClient { return a.Client.RegisteredWord }
+func (a entAdapter) RootConfig() *ent.RootConfigClient         { return a.Client.RootConfig }
+func (a entAdapter) Tx(ctx context.Context) (*ent.Tx, error)   { return a.Client.Tx(ctx) }
+func (a entAdapter) User() *ent.UserClient                     { return a.Client.User }
+func (a entAdapter) UserConfig() *ent.UserConfigClient         { return a.Client.UserConfig }
+func (a entAdapter) Word() *ent.WordClient                     { return a.Client.Word }
+func (a entAdapter) WordInfo() *ent.WordInfoClient             { return a.Client.WordInfo }
+func (a entAdapter) UserDailyUsage() *ent.UserDailyUsageClient { return a.Client.UserDailyUsage }
+
+func TestEntWordProposalRepo(t *testing.T) {
+	ctx := context.Background()
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = cli.Close() })
+	repo := wordproposal.NewEntWordProposalRepo(entAdapter{cli})
+
+	alice := cli.User.Create().SetEmail("alice@example.com").SetName("alice").SaveX(ctx).ID
+	bob := cli.User.Create().SetEmail("bob@example.com").SetName("bob").SaveX(ctx).ID
+	admin := cli.User.Create().SetEmail("admin@example.com").SetName("admin").SetIsAdmin(true).SaveX(ctx).ID
+
+	create := func(userID int, name string) *domain.WordProposal {
+		p, err := repo.Create(ctx, &domain.WordProposal{
+			UserID:   userID,
+			Action:   domain.ProposalActionCreate,
+			WordName: name,
+			Payload:  []byte(`{"name":"` + name + `"}`),
+		})
+		require.NoError(t, err)
+		return p
+	}
+	p1 := create(alice, "apple")
+	create(alice, "banana")
+	create(bob, "cherry")
+
+	t.Run("create and find", func(t *testing.T) {
+		got, err := repo.FindByID(ctx, p1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProposalStatusPending, got.Status)
+		assert.Equal(t, "apple", got.WordName)
+		assert.JSONEq(t, `{"name":"apple"}`, string(got.Payload))
+		assert.Nil(t, got.ReviewerID)
+
+		_, err = repo.FindByID(ctx, 9999)
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+	})
+
+	t.Run("list and count", func(t *testing.T) {
+		all, err := repo.List(ctx, wordproposal.ListFilter{})
+		require.NoError(t, err)
+		assert.Len(t, all, 3)
+
+		own, err := repo.List(ctx, wordproposal.ListFilter{UserID: alice})
+		require.NoError(t, err)
+		assert.Len(t, own, 2)
+
+		n, err := repo.CountPendingByUser(ctx, alice)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+	})
+
+	t.Run("review only once", func(t *testing.T) {
+		now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		ok, err := repo.Review(ctx, p1.ID, admin, domain.ProposalStatusApproved, "", now)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = repo.Review(ctx, p1.ID, admin, domain.ProposalStatusRejected, "late", now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		require.NoError(t, repo.SetWordID(ctx, p1.ID, 42))
+		got, err := repo.FindByID(ctx, p1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProposalStatusApproved, got.Status)
+		assert.Equal(t, admin, *got.ReviewerID)
+		assert.Equal(t, 42, *got.WordID)
+
+		pending, err := repo.List(ctx, wordproposal.ListFilter{Status: domain.ProposalStatusPending})
+		require.NoError(t, err)
+		assert.Len(t, pending, 2)
+	})
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, repo.Reopen(ctx, p1.ID))
+		got, err := repo.FindByID(ctx, p1.ID)
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProposalStatusPending, got.Status)
+		assert.Nil(t, got.ReviewerID)
+		assert.Nil(t, got.ReviewedAt)
+	})
+}
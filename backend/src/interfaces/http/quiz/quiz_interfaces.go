@@ -14,6 +14,8 @@ type Handler interface {
 	CreateHandler() gin.HandlerFunc             // POST /quizzes
 	PostAnswerAndRouteHandler() gin.HandlerFunc // POST /quizzes/:id/answers
 	GetHandler() gin.HandlerFunc                // GET  /quizzes/next-or-resume
+	CancelHandler() gin.HandlerFunc             // POST /quizzes/cancel/:id
+	GetUnfinishedHandler() gin.HandlerFunc      // GET  /quizzes/unfinished
 }
 
 // Service defines the business-logic layer used by the quiz HTTP handlers.
@@ -22,4 +24,6 @@ type Service interface {
 	CreateQuiz(ctx context.Context, userID int, CreateQuizRequest *models.CreateQuizReq) (*models.CreateQuizResponse, error)
 	SubmitAnswerAndRoute(ctx context.Context, userID int, in *models.PostAnswerQuestionRequest) (*models.AnswerRouteRes, error)
 	GetNextOrResume(ctx context.Context, userID int, req *models.GetQuizRequest) (*models.GetQuizResponse, error)
+	CancelQuiz(ctx context.Context, userID int, quizID int, req *models.CancelQuizReq) (*models.CancelQuizResponse, error)
+	ListUnfinished(ctx context.Context, userID int) ([]models.UnfinishedQuiz, error)
 }
@@ -11,9 +11,11 @@ import (
 type Handler interface {
 	GetIndexHandler() gin.HandlerFunc
 	GetHandler() gin.HandlerFunc
+	GetStatsHandler() gin.HandlerFunc
 }
 
 type Service interface {
 	GetSummaries(ctx context.Context, userID int) ([]models.ResultSummary, error)
 	GetByQuizNo(ctx context.Context, userID int, QuizNo int) (*models.Result, error)
+	GetStats(ctx context.Context, userID int, req *models.StatsRequest) (*models.StatsResponse, error)
 }
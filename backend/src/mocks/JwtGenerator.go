@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"time"
+
 	gin "github.com/gin-gonic/gin"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -10,11 +12,16 @@ type MockJwtGenerator struct {
 	mock.Mock
 }
 
-func (m *MockJwtGenerator) GenerateJWT(userID string) (string, error) {
-	args := m.Called(userID)
+func (m *MockJwtGenerator) GenerateJWT(userID string, version int) (string, error) {
+	args := m.Called(userID, version)
 	return args.String(0), args.Error(1)
 }
 
+func (m *MockJwtGenerator) TTL() time.Duration {
+	args := m.Called()
+	return args.Get(0).(time.Duration)
+}
+
 func MockAuthMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Set("userID", 1) // 常に userID を 1 に設定
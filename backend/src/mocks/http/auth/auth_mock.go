@@ -6,6 +6,7 @@ package auth
 
 import (
 	"github.com/gin-gonic/gin"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -82,12 +83,58 @@ func (_c *MockHandler_AuthMeHandler_Call) RunAndReturn(run func() gin.HandlerFun
 	return _c
 }
 
-// LineCallback provides a mock function for the type MockHandler
-func (_mock *MockHandler) LineCallback() gin.HandlerFunc {
+// ListProvidersHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ListProvidersHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListProvidersHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_ListProvidersHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListProvidersHandler'
+type MockHandler_ListProvidersHandler_Call struct {
+	*mock.Call
+}
+
+// ListProvidersHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) ListProvidersHandler() *MockHandler_ListProvidersHandler_Call {
+	return &MockHandler_ListProvidersHandler_Call{Call: _e.mock.On("ListProvidersHandler")}
+}
+
+func (_c *MockHandler_ListProvidersHandler_Call) Run(run func()) *MockHandler_ListProvidersHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_ListProvidersHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_ListProvidersHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_ListProvidersHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_ListProvidersHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProviderCallback provides a mock function for the type MockHandler
+func (_mock *MockHandler) ProviderCallback() gin.HandlerFunc {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for LineCallback")
+		panic("no return value specified for ProviderCallback")
 	}
 
 	var r0 gin.HandlerFunc
@@ -101,39 +148,39 @@ func (_mock *MockHandler) LineCallback() gin.HandlerFunc {
 	return r0
 }
 
-// MockHandler_LineCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LineCallback'
-type MockHandler_LineCallback_Call struct {
+// MockHandler_ProviderCallback_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderCallback'
+type MockHandler_ProviderCallback_Call struct {
 	*mock.Call
 }
 
-// LineCallback is a helper method to define mock.On call
-func (_e *MockHandler_Expecter) LineCallback() *MockHandler_LineCallback_Call {
-	return &MockHandler_LineCallback_Call{Call: _e.mock.On("LineCallback")}
+// ProviderCallback is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) ProviderCallback() *MockHandler_ProviderCallback_Call {
+	return &MockHandler_ProviderCallback_Call{Call: _e.mock.On("ProviderCallback")}
 }
 
-func (_c *MockHandler_LineCallback_Call) Run(run func()) *MockHandler_LineCallback_Call {
+func (_c *MockHandler_ProviderCallback_Call) Run(run func()) *MockHandler_ProviderCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockHandler_LineCallback_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_LineCallback_Call {
+func (_c *MockHandler_ProviderCallback_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_ProviderCallback_Call {
 	_c.Call.Return(handlerFunc)
 	return _c
 }
 
-func (_c *MockHandler_LineCallback_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_LineCallback_Call {
+func (_c *MockHandler_ProviderCallback_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_ProviderCallback_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LineComplete provides a mock function for the type MockHandler
-func (_mock *MockHandler) LineComplete() gin.HandlerFunc {
+// ProviderComplete provides a mock function for the type MockHandler
+func (_mock *MockHandler) ProviderComplete() gin.HandlerFunc {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for LineComplete")
+		panic("no return value specified for ProviderComplete")
 	}
 
 	var r0 gin.HandlerFunc
@@ -147,39 +194,39 @@ func (_mock *MockHandler) LineComplete() gin.HandlerFunc {
 	return r0
 }
 
-// MockHandler_LineComplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LineComplete'
-type MockHandler_LineComplete_Call struct {
+// MockHandler_ProviderComplete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderComplete'
+type MockHandler_ProviderComplete_Call struct {
 	*mock.Call
 }
 
-// LineComplete is a helper method to define mock.On call
-func (_e *MockHandler_Expecter) LineComplete() *MockHandler_LineComplete_Call {
-	return &MockHandler_LineComplete_Call{Call: _e.mock.On("LineComplete")}
+// ProviderComplete is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) ProviderComplete() *MockHandler_ProviderComplete_Call {
+	return &MockHandler_ProviderComplete_Call{Call: _e.mock.On("ProviderComplete")}
 }
 
-func (_c *MockHandler_LineComplete_Call) Run(run func()) *MockHandler_LineComplete_Call {
+func (_c *MockHandler_ProviderComplete_Call) Run(run func()) *MockHandler_ProviderComplete_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockHandler_LineComplete_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_LineComplete_Call {
+func (_c *MockHandler_ProviderComplete_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_ProviderComplete_Call {
 	_c.Call.Return(handlerFunc)
 	return _c
 }
 
-func (_c *MockHandler_LineComplete_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_LineComplete_Call {
+func (_c *MockHandler_ProviderComplete_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_ProviderComplete_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// LineLogin provides a mock function for the type MockHandler
-func (_mock *MockHandler) LineLogin() gin.HandlerFunc {
+// ProviderLogin provides a mock function for the type MockHandler
+func (_mock *MockHandler) ProviderLogin() gin.HandlerFunc {
 	ret := _mock.Called()
 
 	if len(ret) == 0 {
-		panic("no return value specified for LineLogin")
+		panic("no return value specified for ProviderLogin")
 	}
 
 	var r0 gin.HandlerFunc
@@ -193,29 +240,29 @@ func (_mock *MockHandler) LineLogin() gin.HandlerFunc {
 	return r0
 }
 
-// MockHandler_LineLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LineLogin'
-type MockHandler_LineLogin_Call struct {
+// MockHandler_ProviderLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProviderLogin'
+type MockHandler_ProviderLogin_Call struct {
 	*mock.Call
 }
 
-// LineLogin is a helper method to define mock.On call
-func (_e *MockHandler_Expecter) LineLogin() *MockHandler_LineLogin_Call {
-	return &MockHandler_LineLogin_Call{Call: _e.mock.On("LineLogin")}
+// ProviderLogin is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) ProviderLogin() *MockHandler_ProviderLogin_Call {
+	return &MockHandler_ProviderLogin_Call{Call: _e.mock.On("ProviderLogin")}
 }
 
-func (_c *MockHandler_LineLogin_Call) Run(run func()) *MockHandler_LineLogin_Call {
+func (_c *MockHandler_ProviderLogin_Call) Run(run func()) *MockHandler_ProviderLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		run()
 	})
 	return _c
 }
 
-func (_c *MockHandler_LineLogin_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_LineLogin_Call {
+func (_c *MockHandler_ProviderLogin_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_ProviderLogin_Call {
 	_c.Call.Return(handlerFunc)
 	return _c
 }
 
-func (_c *MockHandler_LineLogin_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_LineLogin_Call {
+func (_c *MockHandler_ProviderLogin_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_ProviderLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -36,6 +36,52 @@ func (_m *MockHandler) EXPECT() *MockHandler_Expecter {
 	return &MockHandler_Expecter{mock: &_m.Mock}
 }
 
+// GetPosMappingHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) GetPosMappingHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPosMappingHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_GetPosMappingHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPosMappingHandler'
+type MockHandler_GetPosMappingHandler_Call struct {
+	*mock.Call
+}
+
+// GetPosMappingHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) GetPosMappingHandler() *MockHandler_GetPosMappingHandler_Call {
+	return &MockHandler_GetPosMappingHandler_Call{Call: _e.mock.On("GetPosMappingHandler")}
+}
+
+func (_c *MockHandler_GetPosMappingHandler_Call) Run(run func()) *MockHandler_GetPosMappingHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_GetPosMappingHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_GetPosMappingHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_GetPosMappingHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_GetPosMappingHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRootConfigHandler provides a mock function for the type MockHandler
 func (_mock *MockHandler) GetRootConfigHandler() gin.HandlerFunc {
 	ret := _mock.Called()
@@ -174,6 +220,52 @@ func (_c *MockHandler_GetUserConfigHandler_Call) RunAndReturn(run func() gin.Han
 	return _c
 }
 
+// SavePosMappingHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SavePosMappingHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SavePosMappingHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_SavePosMappingHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SavePosMappingHandler'
+type MockHandler_SavePosMappingHandler_Call struct {
+	*mock.Call
+}
+
+// SavePosMappingHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) SavePosMappingHandler() *MockHandler_SavePosMappingHandler_Call {
+	return &MockHandler_SavePosMappingHandler_Call{Call: _e.mock.On("SavePosMappingHandler")}
+}
+
+func (_c *MockHandler_SavePosMappingHandler_Call) Run(run func()) *MockHandler_SavePosMappingHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_SavePosMappingHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_SavePosMappingHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_SavePosMappingHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_SavePosMappingHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SaveRootConfigHandler provides a mock function for the type MockHandler
 func (_mock *MockHandler) SaveRootConfigHandler() gin.HandlerFunc {
 	ret := _mock.Called()
@@ -128,6 +128,52 @@ func (_c *MockHandler_EditHandler_Call) RunAndReturn(run func() gin.HandlerFunc)
 	return _c
 }
 
+// ForgotPasswordHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ForgotPasswordHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ForgotPasswordHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_ForgotPasswordHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ForgotPasswordHandler'
+type MockHandler_ForgotPasswordHandler_Call struct {
+	*mock.Call
+}
+
+// ForgotPasswordHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) ForgotPasswordHandler() *MockHandler_ForgotPasswordHandler_Call {
+	return &MockHandler_ForgotPasswordHandler_Call{Call: _e.mock.On("ForgotPasswordHandler")}
+}
+
+func (_c *MockHandler_ForgotPasswordHandler_Call) Run(run func()) *MockHandler_ForgotPasswordHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_ForgotPasswordHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_ForgotPasswordHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_ForgotPasswordHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_ForgotPasswordHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListHandler provides a mock function for the type MockHandler
 func (_mock *MockHandler) ListHandler() gin.HandlerFunc {
 	ret := _mock.Called()
@@ -266,6 +312,144 @@ func (_c *MockHandler_MyPageHandler_Call) RunAndReturn(run func() gin.HandlerFun
 	return _c
 }
 
+// RefreshHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) RefreshHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_RefreshHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshHandler'
+type MockHandler_RefreshHandler_Call struct {
+	*mock.Call
+}
+
+// RefreshHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) RefreshHandler() *MockHandler_RefreshHandler_Call {
+	return &MockHandler_RefreshHandler_Call{Call: _e.mock.On("RefreshHandler")}
+}
+
+func (_c *MockHandler_RefreshHandler_Call) Run(run func()) *MockHandler_RefreshHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_RefreshHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_RefreshHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_RefreshHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_RefreshHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPasswordHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) ResetPasswordHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPasswordHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_ResetPasswordHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPasswordHandler'
+type MockHandler_ResetPasswordHandler_Call struct {
+	*mock.Call
+}
+
+// ResetPasswordHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) ResetPasswordHandler() *MockHandler_ResetPasswordHandler_Call {
+	return &MockHandler_ResetPasswordHandler_Call{Call: _e.mock.On("ResetPasswordHandler")}
+}
+
+func (_c *MockHandler_ResetPasswordHandler_Call) Run(run func()) *MockHandler_ResetPasswordHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_ResetPasswordHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_ResetPasswordHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_ResetPasswordHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_ResetPasswordHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SendVerificationHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SendVerificationHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SendVerificationHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_SendVerificationHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SendVerificationHandler'
+type MockHandler_SendVerificationHandler_Call struct {
+	*mock.Call
+}
+
+// SendVerificationHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) SendVerificationHandler() *MockHandler_SendVerificationHandler_Call {
+	return &MockHandler_SendVerificationHandler_Call{Call: _e.mock.On("SendVerificationHandler")}
+}
+
+func (_c *MockHandler_SendVerificationHandler_Call) Run(run func()) *MockHandler_SendVerificationHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_SendVerificationHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_SendVerificationHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_SendVerificationHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_SendVerificationHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ShowHandler provides a mock function for the type MockHandler
 func (_mock *MockHandler) ShowHandler() gin.HandlerFunc {
 	ret := _mock.Called()
@@ -358,6 +542,98 @@ func (_c *MockHandler_SignInHandler_Call) RunAndReturn(run func() gin.HandlerFun
 	return _c
 }
 
+// SignOutAllHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SignOutAllHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignOutAllHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_SignOutAllHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignOutAllHandler'
+type MockHandler_SignOutAllHandler_Call struct {
+	*mock.Call
+}
+
+// SignOutAllHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) SignOutAllHandler() *MockHandler_SignOutAllHandler_Call {
+	return &MockHandler_SignOutAllHandler_Call{Call: _e.mock.On("SignOutAllHandler")}
+}
+
+func (_c *MockHandler_SignOutAllHandler_Call) Run(run func()) *MockHandler_SignOutAllHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_SignOutAllHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_SignOutAllHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_SignOutAllHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_SignOutAllHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignOutHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) SignOutHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignOutHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_SignOutHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignOutHandler'
+type MockHandler_SignOutHandler_Call struct {
+	*mock.Call
+}
+
+// SignOutHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) SignOutHandler() *MockHandler_SignOutHandler_Call {
+	return &MockHandler_SignOutHandler_Call{Call: _e.mock.On("SignOutHandler")}
+}
+
+func (_c *MockHandler_SignOutHandler_Call) Run(run func()) *MockHandler_SignOutHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_SignOutHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_SignOutHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_SignOutHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_SignOutHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SignUpHandler provides a mock function for the type MockHandler
 func (_mock *MockHandler) SignUpHandler() gin.HandlerFunc {
 	ret := _mock.Called()
@@ -403,3 +679,49 @@ func (_c *MockHandler_SignUpHandler_Call) RunAndReturn(run func() gin.HandlerFun
 	_c.Call.Return(run)
 	return _c
 }
+
+// VerifyEmailHandler provides a mock function for the type MockHandler
+func (_mock *MockHandler) VerifyEmailHandler() gin.HandlerFunc {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmailHandler")
+	}
+
+	var r0 gin.HandlerFunc
+	if returnFunc, ok := ret.Get(0).(func() gin.HandlerFunc); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(gin.HandlerFunc)
+		}
+	}
+	return r0
+}
+
+// MockHandler_VerifyEmailHandler_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmailHandler'
+type MockHandler_VerifyEmailHandler_Call struct {
+	*mock.Call
+}
+
+// VerifyEmailHandler is a helper method to define mock.On call
+func (_e *MockHandler_Expecter) VerifyEmailHandler() *MockHandler_VerifyEmailHandler_Call {
+	return &MockHandler_VerifyEmailHandler_Call{Call: _e.mock.On("VerifyEmailHandler")}
+}
+
+func (_c *MockHandler_VerifyEmailHandler_Call) Run(run func()) *MockHandler_VerifyEmailHandler_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockHandler_VerifyEmailHandler_Call) Return(handlerFunc gin.HandlerFunc) *MockHandler_VerifyEmailHandler_Call {
+	_c.Call.Return(handlerFunc)
+	return _c
+}
+
+func (_c *MockHandler_VerifyEmailHandler_Call) RunAndReturn(run func() gin.HandlerFunc) *MockHandler_VerifyEmailHandler_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,14 +2,15 @@
 // github.com/vektra/mockery
 // template: testify
 
-package line
+package provider
 
 import (
 	"context"
-
+	"word_app/backend/src/infrastructure/auth/provider"
 	"word_app/backend/src/utils/tempjwt"
 
 	"github.com/coreos/go-oidc/v3/oidc"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -98,7 +99,7 @@ func (_c *MockProvider_AuthURL_Call) RunAndReturn(run func(state string, nonce s
 }
 
 // Exchange provides a mock function for the type MockProvider
-func (_mock *MockProvider) Exchange(ctx context.Context, code string) (*tempjwt.Identity, error) {
+func (_mock *MockProvider) Exchange(ctx context.Context, code string) (*tempjwt.Identity, *oidc.IDToken, error) {
 	ret := _mock.Called(ctx, code)
 
 	if len(ret) == 0 {
@@ -106,8 +107,9 @@ func (_mock *MockProvider) Exchange(ctx context.Context, code string) (*tempjwt.
 	}
 
 	var r0 *tempjwt.Identity
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*tempjwt.Identity, error)); ok {
+	var r1 *oidc.IDToken
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*tempjwt.Identity, *oidc.IDToken, error)); ok {
 		return returnFunc(ctx, code)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *tempjwt.Identity); ok {
@@ -117,12 +119,19 @@ func (_mock *MockProvider) Exchange(ctx context.Context, code string) (*tempjwt.
 			r0 = ret.Get(0).(*tempjwt.Identity)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) *oidc.IDToken); ok {
 		r1 = returnFunc(ctx, code)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*oidc.IDToken)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, code)
+	} else {
+		r2 = ret.Error(2)
 	}
-	return r0, r1
+	return r0, r1, r2
 }
 
 // MockProvider_Exchange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Exchange'
@@ -155,12 +164,12 @@ func (_c *MockProvider_Exchange_Call) Run(run func(ctx context.Context, code str
 	return _c
 }
 
-func (_c *MockProvider_Exchange_Call) Return(identity *tempjwt.Identity, err error) *MockProvider_Exchange_Call {
-	_c.Call.Return(identity, err)
+func (_c *MockProvider_Exchange_Call) Return(identity *tempjwt.Identity, iDToken *oidc.IDToken, err error) *MockProvider_Exchange_Call {
+	_c.Call.Return(identity, iDToken, err)
 	return _c
 }
 
-func (_c *MockProvider_Exchange_Call) RunAndReturn(run func(ctx context.Context, code string) (*tempjwt.Identity, error)) *MockProvider_Exchange_Call {
+func (_c *MockProvider_Exchange_Call) RunAndReturn(run func(ctx context.Context, code string) (*tempjwt.Identity, *oidc.IDToken, error)) *MockProvider_Exchange_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -221,3 +230,136 @@ func (_c *MockProvider_ValidateNonce_Call) RunAndReturn(run func(idTok *oidc.IDT
 	_c.Call.Return(run)
 	return _c
 }
+
+// NewMockRegistry creates a new instance of MockRegistry. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockRegistry(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockRegistry {
+	mock := &MockRegistry{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockRegistry is an autogenerated mock type for the Registry type
+type MockRegistry struct {
+	mock.Mock
+}
+
+type MockRegistry_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockRegistry) EXPECT() *MockRegistry_Expecter {
+	return &MockRegistry_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function for the type MockRegistry
+func (_mock *MockRegistry) Get(name string) (provider.Provider, bool) {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 provider.Provider
+	var r1 bool
+	if returnFunc, ok := ret.Get(0).(func(string) (provider.Provider, bool)); ok {
+		return returnFunc(name)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) provider.Provider); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Get(0).(provider.Provider)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = returnFunc(name)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+	return r0, r1
+}
+
+// MockRegistry_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type MockRegistry_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - name string
+func (_e *MockRegistry_Expecter) Get(name interface{}) *MockRegistry_Get_Call {
+	return &MockRegistry_Get_Call{Call: _e.mock.On("Get", name)}
+}
+
+func (_c *MockRegistry_Get_Call) Run(run func(name string)) *MockRegistry_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRegistry_Get_Call) Return(provider provider.Provider, b bool) *MockRegistry_Get_Call {
+	_c.Call.Return(provider, b)
+	return _c
+}
+
+func (_c *MockRegistry_Get_Call) RunAndReturn(run func(name string) (provider.Provider, bool)) *MockRegistry_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Names provides a mock function for the type MockRegistry
+func (_mock *MockRegistry) Names() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Names")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockRegistry_Names_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Names'
+type MockRegistry_Names_Call struct {
+	*mock.Call
+}
+
+// Names is a helper method to define mock.On call
+func (_e *MockRegistry_Expecter) Names() *MockRegistry_Names_Call {
+	return &MockRegistry_Names_Call{Call: _e.mock.On("Names")}
+}
+
+func (_c *MockRegistry_Names_Call) Run(run func()) *MockRegistry_Names_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockRegistry_Names_Call) Return(s []string) *MockRegistry_Names_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockRegistry_Names_Call) RunAndReturn(run func() []string) *MockRegistry_Names_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -13,6 +13,167 @@ import (
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockActionTokenIssuer creates a new instance of MockActionTokenIssuer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockActionTokenIssuer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockActionTokenIssuer {
+	mock := &MockActionTokenIssuer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockActionTokenIssuer is an autogenerated mock type for the ActionTokenIssuer type
+type MockActionTokenIssuer struct {
+	mock.Mock
+}
+
+type MockActionTokenIssuer_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockActionTokenIssuer) EXPECT() *MockActionTokenIssuer_Expecter {
+	return &MockActionTokenIssuer_Expecter{mock: &_m.Mock}
+}
+
+// GenerateAction provides a mock function for the type MockActionTokenIssuer
+func (_mock *MockActionTokenIssuer) GenerateAction(a *tempjwt.Action, ttl time.Duration) (string, error) {
+	ret := _mock.Called(a, ttl)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GenerateAction")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(*tempjwt.Action, time.Duration) (string, error)); ok {
+		return returnFunc(a, ttl)
+	}
+	if returnFunc, ok := ret.Get(0).(func(*tempjwt.Action, time.Duration) string); ok {
+		r0 = returnFunc(a, ttl)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(*tempjwt.Action, time.Duration) error); ok {
+		r1 = returnFunc(a, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockActionTokenIssuer_GenerateAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GenerateAction'
+type MockActionTokenIssuer_GenerateAction_Call struct {
+	*mock.Call
+}
+
+// GenerateAction is a helper method to define mock.On call
+//   - a *tempjwt.Action
+//   - ttl time.Duration
+func (_e *MockActionTokenIssuer_Expecter) GenerateAction(a interface{}, ttl interface{}) *MockActionTokenIssuer_GenerateAction_Call {
+	return &MockActionTokenIssuer_GenerateAction_Call{Call: _e.mock.On("GenerateAction", a, ttl)}
+}
+
+func (_c *MockActionTokenIssuer_GenerateAction_Call) Run(run func(a *tempjwt.Action, ttl time.Duration)) *MockActionTokenIssuer_GenerateAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *tempjwt.Action
+		if args[0] != nil {
+			arg0 = args[0].(*tempjwt.Action)
+		}
+		var arg1 time.Duration
+		if args[1] != nil {
+			arg1 = args[1].(time.Duration)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockActionTokenIssuer_GenerateAction_Call) Return(s string, err error) *MockActionTokenIssuer_GenerateAction_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockActionTokenIssuer_GenerateAction_Call) RunAndReturn(run func(a *tempjwt.Action, ttl time.Duration) (string, error)) *MockActionTokenIssuer_GenerateAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseAction provides a mock function for the type MockActionTokenIssuer
+func (_mock *MockActionTokenIssuer) ParseAction(tok string, purpose string) (*tempjwt.Action, error) {
+	ret := _mock.Called(tok, purpose)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseAction")
+	}
+
+	var r0 *tempjwt.Action
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*tempjwt.Action, error)); ok {
+		return returnFunc(tok, purpose)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *tempjwt.Action); ok {
+		r0 = returnFunc(tok, purpose)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*tempjwt.Action)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(tok, purpose)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockActionTokenIssuer_ParseAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseAction'
+type MockActionTokenIssuer_ParseAction_Call struct {
+	*mock.Call
+}
+
+// ParseAction is a helper method to define mock.On call
+//   - tok string
+//   - purpose string
+func (_e *MockActionTokenIssuer_Expecter) ParseAction(tok interface{}, purpose interface{}) *MockActionTokenIssuer_ParseAction_Call {
+	return &MockActionTokenIssuer_ParseAction_Call{Call: _e.mock.On("ParseAction", tok, purpose)}
+}
+
+func (_c *MockActionTokenIssuer_ParseAction_Call) Run(run func(tok string, purpose string)) *MockActionTokenIssuer_ParseAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockActionTokenIssuer_ParseAction_Call) Return(action *tempjwt.Action, err error) *MockActionTokenIssuer_ParseAction_Call {
+	_c.Call.Return(action, err)
+	return _c
+}
+
+func (_c *MockActionTokenIssuer_ParseAction_Call) RunAndReturn(run func(tok string, purpose string) (*tempjwt.Action, error)) *MockActionTokenIssuer_ParseAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockJWTGenerator creates a new instance of MockJWTGenerator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockJWTGenerator(t interface {
@@ -41,8 +202,8 @@ func (_m *MockJWTGenerator) EXPECT() *MockJWTGenerator_Expecter {
 }
 
 // GenerateJWT provides a mock function for the type MockJWTGenerator
-func (_mock *MockJWTGenerator) GenerateJWT(userID string) (string, error) {
-	ret := _mock.Called(userID)
+func (_mock *MockJWTGenerator) GenerateJWT(userID string, version int) (string, error) {
+	ret := _mock.Called(userID, version)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GenerateJWT")
@@ -50,16 +211,16 @@ func (_mock *MockJWTGenerator) GenerateJWT(userID string) (string, error) {
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(string, int) (string, error)); ok {
+		return returnFunc(userID, version)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(0).(func(string, int) string); ok {
+		r0 = returnFunc(userID, version)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(userID)
+	if returnFunc, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = returnFunc(userID, version)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -73,18 +234,24 @@ type MockJWTGenerator_GenerateJWT_Call struct {
 
 // GenerateJWT is a helper method to define mock.On call
 //   - userID string
-func (_e *MockJWTGenerator_Expecter) GenerateJWT(userID interface{}) *MockJWTGenerator_GenerateJWT_Call {
-	return &MockJWTGenerator_GenerateJWT_Call{Call: _e.mock.On("GenerateJWT", userID)}
+//   - version int
+func (_e *MockJWTGenerator_Expecter) GenerateJWT(userID interface{}, version interface{}) *MockJWTGenerator_GenerateJWT_Call {
+	return &MockJWTGenerator_GenerateJWT_Call{Call: _e.mock.On("GenerateJWT", userID, version)}
 }
 
-func (_c *MockJWTGenerator_GenerateJWT_Call) Run(run func(userID string)) *MockJWTGenerator_GenerateJWT_Call {
+func (_c *MockJWTGenerator_GenerateJWT_Call) Run(run func(userID string, version int)) *MockJWTGenerator_GenerateJWT_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
@@ -95,7 +262,51 @@ func (_c *MockJWTGenerator_GenerateJWT_Call) Return(s string, err error) *MockJW
 	return _c
 }
 
-func (_c *MockJWTGenerator_GenerateJWT_Call) RunAndReturn(run func(userID string) (string, error)) *MockJWTGenerator_GenerateJWT_Call {
+func (_c *MockJWTGenerator_GenerateJWT_Call) RunAndReturn(run func(userID string, version int) (string, error)) *MockJWTGenerator_GenerateJWT_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TTL provides a mock function for the type MockJWTGenerator
+func (_mock *MockJWTGenerator) TTL() time.Duration {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for TTL")
+	}
+
+	var r0 time.Duration
+	if returnFunc, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = returnFunc()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+	return r0
+}
+
+// MockJWTGenerator_TTL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TTL'
+type MockJWTGenerator_TTL_Call struct {
+	*mock.Call
+}
+
+// TTL is a helper method to define mock.On call
+func (_e *MockJWTGenerator_Expecter) TTL() *MockJWTGenerator_TTL_Call {
+	return &MockJWTGenerator_TTL_Call{Call: _e.mock.On("TTL")}
+}
+
+func (_c *MockJWTGenerator_TTL_Call) Run(run func()) *MockJWTGenerator_TTL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockJWTGenerator_TTL_Call) Return(duration time.Duration) *MockJWTGenerator_TTL_Call {
+	_c.Call.Return(duration)
+	return _c
+}
+
+func (_c *MockJWTGenerator_TTL_Call) RunAndReturn(run func() time.Duration) *MockJWTGenerator_TTL_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -283,7 +494,7 @@ func (_m *MockTokenVerifier) EXPECT() *MockTokenVerifier_Expecter {
 }
 
 // VerifyAndExtractSubject provides a mock function for the type MockTokenVerifier
-func (_mock *MockTokenVerifier) VerifyAndExtractSubject(ctx context.Context, raw string) (string, error) {
+func (_mock *MockTokenVerifier) VerifyAndExtractSubject(ctx context.Context, raw string) (string, int, error) {
 	ret := _mock.Called(ctx, raw)
 
 	if len(ret) == 0 {
@@ -291,8 +502,9 @@ func (_mock *MockTokenVerifier) VerifyAndExtractSubject(ctx context.Context, raw
 	}
 
 	var r0 string
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+	var r1 int
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, int, error)); ok {
 		return returnFunc(ctx, raw)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
@@ -300,12 +512,17 @@ func (_mock *MockTokenVerifier) VerifyAndExtractSubject(ctx context.Context, raw
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) int); ok {
 		r1 = returnFunc(ctx, raw)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(int)
 	}
-	return r0, r1
+	if returnFunc, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = returnFunc(ctx, raw)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
 }
 
 // MockTokenVerifier_VerifyAndExtractSubject_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyAndExtractSubject'
@@ -338,12 +555,12 @@ func (_c *MockTokenVerifier_VerifyAndExtractSubject_Call) Run(run func(ctx conte
 	return _c
 }
 
-func (_c *MockTokenVerifier_VerifyAndExtractSubject_Call) Return(subject string, err error) *MockTokenVerifier_VerifyAndExtractSubject_Call {
-	_c.Call.Return(subject, err)
+func (_c *MockTokenVerifier_VerifyAndExtractSubject_Call) Return(subject string, version int, err error) *MockTokenVerifier_VerifyAndExtractSubject_Call {
+	_c.Call.Return(subject, version, err)
 	return _c
 }
 
-func (_c *MockTokenVerifier_VerifyAndExtractSubject_Call) RunAndReturn(run func(ctx context.Context, raw string) (string, error)) *MockTokenVerifier_VerifyAndExtractSubject_Call {
+func (_c *MockTokenVerifier_VerifyAndExtractSubject_Call) RunAndReturn(run func(ctx context.Context, raw string) (string, int, error)) *MockTokenVerifier_VerifyAndExtractSubject_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -403,3 +403,78 @@ func (_c *MockWriteRepository_CreateActive_Call) RunAndReturn(run func(ctx conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateNote provides a mock function for the type MockWriteRepository
+func (_mock *MockWriteRepository) UpdateNote(ctx context.Context, userID int, wordID int, memo *string, attentionLevel *int) error {
+	ret := _mock.Called(ctx, userID, wordID, memo, attentionLevel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, int, *string, *int) error); ok {
+		r0 = returnFunc(ctx, userID, wordID, memo, attentionLevel)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockWriteRepository_UpdateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNote'
+type MockWriteRepository_UpdateNote_Call struct {
+	*mock.Call
+}
+
+// UpdateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+//   - wordID int
+//   - memo *string
+//   - attentionLevel *int
+func (_e *MockWriteRepository_Expecter) UpdateNote(ctx interface{}, userID interface{}, wordID interface{}, memo interface{}, attentionLevel interface{}) *MockWriteRepository_UpdateNote_Call {
+	return &MockWriteRepository_UpdateNote_Call{Call: _e.mock.On("UpdateNote", ctx, userID, wordID, memo, attentionLevel)}
+}
+
+func (_c *MockWriteRepository_UpdateNote_Call) Run(run func(ctx context.Context, userID int, wordID int, memo *string, attentionLevel *int)) *MockWriteRepository_UpdateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
+		}
+		var arg4 *int
+		if args[4] != nil {
+			arg4 = args[4].(*int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockWriteRepository_UpdateNote_Call) Return(err error) *MockWriteRepository_UpdateNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockWriteRepository_UpdateNote_Call) RunAndReturn(run func(ctx context.Context, userID int, wordID int, memo *string, attentionLevel *int) error) *MockWriteRepository_UpdateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
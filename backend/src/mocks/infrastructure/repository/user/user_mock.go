@@ -41,6 +41,63 @@ func (_m *MockRepository) EXPECT() *MockRepository_Expecter {
 	return &MockRepository_Expecter{mock: &_m.Mock}
 }
 
+// BumpTokenVersion provides a mock function for the type MockRepository
+func (_mock *MockRepository) BumpTokenVersion(ctx context.Context, userID int) error {
+	ret := _mock.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BumpTokenVersion")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) error); ok {
+		r0 = returnFunc(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockRepository_BumpTokenVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BumpTokenVersion'
+type MockRepository_BumpTokenVersion_Call struct {
+	*mock.Call
+}
+
+// BumpTokenVersion is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID int
+func (_e *MockRepository_Expecter) BumpTokenVersion(ctx interface{}, userID interface{}) *MockRepository_BumpTokenVersion_Call {
+	return &MockRepository_BumpTokenVersion_Call{Call: _e.mock.On("BumpTokenVersion", ctx, userID)}
+}
+
+func (_c *MockRepository_BumpTokenVersion_Call) Run(run func(ctx context.Context, userID int)) *MockRepository_BumpTokenVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockRepository_BumpTokenVersion_Call) Return(err error) *MockRepository_BumpTokenVersion_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockRepository_BumpTokenVersion_Call) RunAndReturn(run func(ctx context.Context, userID int) error) *MockRepository_BumpTokenVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function for the type MockRepository
 func (_mock *MockRepository) Create(ctx context.Context, u *domain.User) (*domain.User, error) {
 	ret := _mock.Called(ctx, u)
@@ -104,3 +104,69 @@ func (_c *MockReadRepository_FindIDsByNames_Call) RunAndReturn(run func(ctx cont
 	_c.Call.Return(run)
 	return _c
 }
+
+// NameByID provides a mock function for the type MockReadRepository
+func (_mock *MockReadRepository) NameByID(ctx context.Context, id int) (string, error) {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for NameByID")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) (string, error)); ok {
+		return returnFunc(ctx, id)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int) string); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = returnFunc(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockReadRepository_NameByID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'NameByID'
+type MockReadRepository_NameByID_Call struct {
+	*mock.Call
+}
+
+// NameByID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int
+func (_e *MockReadRepository_Expecter) NameByID(ctx interface{}, id interface{}) *MockReadRepository_NameByID_Call {
+	return &MockReadRepository_NameByID_Call{Call: _e.mock.On("NameByID", ctx, id)}
+}
+
+func (_c *MockReadRepository_NameByID_Call) Run(run func(ctx context.Context, id int)) *MockReadRepository_NameByID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 int
+		if args[1] != nil {
+			arg1 = args[1].(int)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockReadRepository_NameByID_Call) Return(s string, err error) *MockReadRepository_NameByID_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockReadRepository_NameByID_Call) RunAndReturn(run func(ctx context.Context, id int) (string, error)) *MockReadRepository_NameByID_Call {
+	_c.Call.Return(run)
+	return _c
+}
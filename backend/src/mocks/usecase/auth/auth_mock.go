@@ -6,8 +6,8 @@ package auth
 
 import (
 	"context"
-
 	"word_app/backend/src/usecase/auth"
+	"word_app/backend/src/usecase/session"
 
 	mock "github.com/stretchr/testify/mock"
 )
@@ -40,25 +40,27 @@ func (_m *MockUsecase) EXPECT() *MockUsecase_Expecter {
 }
 
 // CompleteSignUp provides a mock function for the type MockUsecase
-func (_mock *MockUsecase) CompleteSignUp(ctx context.Context, tempToken string, pass *string) (string, error) {
-	ret := _mock.Called(ctx, tempToken, pass)
+func (_mock *MockUsecase) CompleteSignUp(ctx context.Context, provider string, tempToken string, pass *string) (*session.TokenPair, error) {
+	ret := _mock.Called(ctx, provider, tempToken, pass)
 
 	if len(ret) == 0 {
 		panic("no return value specified for CompleteSignUp")
 	}
 
-	var r0 string
+	var r0 *session.TokenPair
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) (string, error)); ok {
-		return returnFunc(ctx, tempToken, pass)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *string) (*session.TokenPair, error)); ok {
+		return returnFunc(ctx, provider, tempToken, pass)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) string); ok {
-		r0 = returnFunc(ctx, tempToken, pass)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, *string) *session.TokenPair); ok {
+		r0 = returnFunc(ctx, provider, tempToken, pass)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*session.TokenPair)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, *string) error); ok {
-		r1 = returnFunc(ctx, tempToken, pass)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, *string) error); ok {
+		r1 = returnFunc(ctx, provider, tempToken, pass)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -72,13 +74,14 @@ type MockUsecase_CompleteSignUp_Call struct {
 
 // CompleteSignUp is a helper method to define mock.On call
 //   - ctx context.Context
+//   - provider string
 //   - tempToken string
 //   - pass *string
-func (_e *MockUsecase_Expecter) CompleteSignUp(ctx interface{}, tempToken interface{}, pass interface{}) *MockUsecase_CompleteSignUp_Call {
-	return &MockUsecase_CompleteSignUp_Call{Call: _e.mock.On("CompleteSignUp", ctx, tempToken, pass)}
+func (_e *MockUsecase_Expecter) CompleteSignUp(ctx interface{}, provider interface{}, tempToken interface{}, pass interface{}) *MockUsecase_CompleteSignUp_Call {
+	return &MockUsecase_CompleteSignUp_Call{Call: _e.mock.On("CompleteSignUp", ctx, provider, tempToken, pass)}
 }
 
-func (_c *MockUsecase_CompleteSignUp_Call) Run(run func(ctx context.Context, tempToken string, pass *string)) *MockUsecase_CompleteSignUp_Call {
+func (_c *MockUsecase_CompleteSignUp_Call) Run(run func(ctx context.Context, provider string, tempToken string, pass *string)) *MockUsecase_CompleteSignUp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -88,32 +91,37 @@ func (_c *MockUsecase_CompleteSignUp_Call) Run(run func(ctx context.Context, tem
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *string
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(*string)
+			arg2 = args[2].(string)
+		}
+		var arg3 *string
+		if args[3] != nil {
+			arg3 = args[3].(*string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUsecase_CompleteSignUp_Call) Return(s string, err error) *MockUsecase_CompleteSignUp_Call {
-	_c.Call.Return(s, err)
+func (_c *MockUsecase_CompleteSignUp_Call) Return(tokenPair *session.TokenPair, err error) *MockUsecase_CompleteSignUp_Call {
+	_c.Call.Return(tokenPair, err)
 	return _c
 }
 
-func (_c *MockUsecase_CompleteSignUp_Call) RunAndReturn(run func(ctx context.Context, tempToken string, pass *string) (string, error)) *MockUsecase_CompleteSignUp_Call {
+func (_c *MockUsecase_CompleteSignUp_Call) RunAndReturn(run func(ctx context.Context, provider string, tempToken string, pass *string) (*session.TokenPair, error)) *MockUsecase_CompleteSignUp_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // HandleCallback provides a mock function for the type MockUsecase
-func (_mock *MockUsecase) HandleCallback(ctx context.Context, code string) (*auth.CallbackResult, error) {
-	ret := _mock.Called(ctx, code)
+func (_mock *MockUsecase) HandleCallback(ctx context.Context, provider string, code string, nonce string) (*auth.CallbackResult, error) {
+	ret := _mock.Called(ctx, provider, code, nonce)
 
 	if len(ret) == 0 {
 		panic("no return value specified for HandleCallback")
@@ -121,18 +129,18 @@ func (_mock *MockUsecase) HandleCallback(ctx context.Context, code string) (*aut
 
 	var r0 *auth.CallbackResult
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*auth.CallbackResult, error)); ok {
-		return returnFunc(ctx, code)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (*auth.CallbackResult, error)); ok {
+		return returnFunc(ctx, provider, code, nonce)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *auth.CallbackResult); ok {
-		r0 = returnFunc(ctx, code)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) *auth.CallbackResult); ok {
+		r0 = returnFunc(ctx, provider, code, nonce)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*auth.CallbackResult)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, code)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, code, nonce)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -146,12 +154,14 @@ type MockUsecase_HandleCallback_Call struct {
 
 // HandleCallback is a helper method to define mock.On call
 //   - ctx context.Context
+//   - provider string
 //   - code string
-func (_e *MockUsecase_Expecter) HandleCallback(ctx interface{}, code interface{}) *MockUsecase_HandleCallback_Call {
-	return &MockUsecase_HandleCallback_Call{Call: _e.mock.On("HandleCallback", ctx, code)}
+//   - nonce string
+func (_e *MockUsecase_Expecter) HandleCallback(ctx interface{}, provider interface{}, code interface{}, nonce interface{}) *MockUsecase_HandleCallback_Call {
+	return &MockUsecase_HandleCallback_Call{Call: _e.mock.On("HandleCallback", ctx, provider, code, nonce)}
 }
 
-func (_c *MockUsecase_HandleCallback_Call) Run(run func(ctx context.Context, code string)) *MockUsecase_HandleCallback_Call {
+func (_c *MockUsecase_HandleCallback_Call) Run(run func(ctx context.Context, provider string, code string, nonce string)) *MockUsecase_HandleCallback_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -161,9 +171,19 @@ func (_c *MockUsecase_HandleCallback_Call) Run(run func(ctx context.Context, cod
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
@@ -174,26 +194,81 @@ func (_c *MockUsecase_HandleCallback_Call) Return(callbackResult *auth.CallbackR
 	return _c
 }
 
-func (_c *MockUsecase_HandleCallback_Call) RunAndReturn(run func(ctx context.Context, code string) (*auth.CallbackResult, error)) *MockUsecase_HandleCallback_Call {
+func (_c *MockUsecase_HandleCallback_Call) RunAndReturn(run func(ctx context.Context, provider string, code string, nonce string) (*auth.CallbackResult, error)) *MockUsecase_HandleCallback_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Providers provides a mock function for the type MockUsecase
+func (_mock *MockUsecase) Providers() []string {
+	ret := _mock.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Providers")
+	}
+
+	var r0 []string
+	if returnFunc, ok := ret.Get(0).(func() []string); ok {
+		r0 = returnFunc()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	return r0
+}
+
+// MockUsecase_Providers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Providers'
+type MockUsecase_Providers_Call struct {
+	*mock.Call
+}
+
+// Providers is a helper method to define mock.On call
+func (_e *MockUsecase_Expecter) Providers() *MockUsecase_Providers_Call {
+	return &MockUsecase_Providers_Call{Call: _e.mock.On("Providers")}
+}
+
+func (_c *MockUsecase_Providers_Call) Run(run func()) *MockUsecase_Providers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockUsecase_Providers_Call) Return(s []string) *MockUsecase_Providers_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockUsecase_Providers_Call) RunAndReturn(run func() []string) *MockUsecase_Providers_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
 // StartLogin provides a mock function for the type MockUsecase
-func (_mock *MockUsecase) StartLogin(ctx context.Context, state string, nonce string) string {
-	ret := _mock.Called(ctx, state, nonce)
+func (_mock *MockUsecase) StartLogin(ctx context.Context, provider string, state string, nonce string) (string, error) {
+	ret := _mock.Called(ctx, provider, state, nonce)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartLogin")
 	}
 
 	var r0 string
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
-		r0 = returnFunc(ctx, state, nonce)
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (string, error)); ok {
+		return returnFunc(ctx, provider, state, nonce)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = returnFunc(ctx, provider, state, nonce)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, provider, state, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
 // MockUsecase_StartLogin_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartLogin'
@@ -203,13 +278,14 @@ type MockUsecase_StartLogin_Call struct {
 
 // StartLogin is a helper method to define mock.On call
 //   - ctx context.Context
+//   - provider string
 //   - state string
 //   - nonce string
-func (_e *MockUsecase_Expecter) StartLogin(ctx interface{}, state interface{}, nonce interface{}) *MockUsecase_StartLogin_Call {
-	return &MockUsecase_StartLogin_Call{Call: _e.mock.On("StartLogin", ctx, state, nonce)}
+func (_e *MockUsecase_Expecter) StartLogin(ctx interface{}, provider interface{}, state interface{}, nonce interface{}) *MockUsecase_StartLogin_Call {
+	return &MockUsecase_StartLogin_Call{Call: _e.mock.On("StartLogin", ctx, provider, state, nonce)}
 }
 
-func (_c *MockUsecase_StartLogin_Call) Run(run func(ctx context.Context, state string, nonce string)) *MockUsecase_StartLogin_Call {
+func (_c *MockUsecase_StartLogin_Call) Run(run func(ctx context.Context, provider string, state string, nonce string)) *MockUsecase_StartLogin_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -223,21 +299,26 @@ func (_c *MockUsecase_StartLogin_Call) Run(run func(ctx context.Context, state s
 		if args[2] != nil {
 			arg2 = args[2].(string)
 		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockUsecase_StartLogin_Call) Return(s string) *MockUsecase_StartLogin_Call {
-	_c.Call.Return(s)
+func (_c *MockUsecase_StartLogin_Call) Return(s string, err error) *MockUsecase_StartLogin_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockUsecase_StartLogin_Call) RunAndReturn(run func(ctx context.Context, state string, nonce string) string) *MockUsecase_StartLogin_Call {
+func (_c *MockUsecase_StartLogin_Call) RunAndReturn(run func(ctx context.Context, provider string, state string, nonce string) (string, error)) *MockUsecase_StartLogin_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -141,7 +141,7 @@ func (_m *MockTokenizeUsecase) EXPECT() *MockTokenizeUsecase_Expecter {
 }
 
 // Execute provides a mock function for the type MockTokenizeUsecase
-func (_mock *MockTokenizeUsecase) Execute(ctx context.Context, userID int, text string) ([]string, []string, []string, error) {
+func (_mock *MockTokenizeUsecase) Execute(ctx context.Context, userID int, text string) ([]string, []string, []string, []models.BulkLemma, error) {
 	ret := _mock.Called(ctx, userID, text)
 
 	if len(ret) == 0 {
@@ -151,8 +151,9 @@ func (_mock *MockTokenizeUsecase) Execute(ctx context.Context, userID int, text
 	var r0 []string
 	var r1 []string
 	var r2 []string
-	var r3 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) ([]string, []string, []string, error)); ok {
+	var r3 []models.BulkLemma
+	var r4 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) ([]string, []string, []string, []models.BulkLemma, error)); ok {
 		return returnFunc(ctx, userID, text)
 	}
 	if returnFunc, ok := ret.Get(0).(func(context.Context, int, string) []string); ok {
@@ -176,12 +177,19 @@ func (_mock *MockTokenizeUsecase) Execute(ctx context.Context, userID int, text
 			r2 = ret.Get(2).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(3).(func(context.Context, int, string) error); ok {
+	if returnFunc, ok := ret.Get(3).(func(context.Context, int, string) []models.BulkLemma); ok {
 		r3 = returnFunc(ctx, userID, text)
 	} else {
-		r3 = ret.Error(3)
+		if ret.Get(3) != nil {
+			r3 = ret.Get(3).([]models.BulkLemma)
+		}
+	}
+	if returnFunc, ok := ret.Get(4).(func(context.Context, int, string) error); ok {
+		r4 = returnFunc(ctx, userID, text)
+	} else {
+		r4 = ret.Error(4)
 	}
-	return r0, r1, r2, r3
+	return r0, r1, r2, r3, r4
 }
 
 // MockTokenizeUsecase_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
@@ -220,12 +228,12 @@ func (_c *MockTokenizeUsecase_Execute_Call) Run(run func(ctx context.Context, us
 	return _c
 }
 
-func (_c *MockTokenizeUsecase_Execute_Call) Return(cands []string, regs []string, notExist []string, err error) *MockTokenizeUsecase_Execute_Call {
-	_c.Call.Return(cands, regs, notExist, err)
+func (_c *MockTokenizeUsecase_Execute_Call) Return(cands []string, regs []string, notExist []string, lemmas []models.BulkLemma, err error) *MockTokenizeUsecase_Execute_Call {
+	_c.Call.Return(cands, regs, notExist, lemmas, err)
 	return _c
 }
 
-func (_c *MockTokenizeUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, userID int, text string) ([]string, []string, []string, error)) *MockTokenizeUsecase_Execute_Call {
+func (_c *MockTokenizeUsecase_Execute_Call) RunAndReturn(run func(ctx context.Context, userID int, text string) ([]string, []string, []string, []models.BulkLemma, error)) *MockTokenizeUsecase_Execute_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -8,11 +8,107 @@ import (
 	"context"
 
 	"word_app/backend/src/domain"
+	"word_app/backend/src/domain/posmap"
 	settinguc "word_app/backend/src/usecase/setting"
 
 	mock "github.com/stretchr/testify/mock"
 )
 
+// NewMockGetPosMapping creates a new instance of MockGetPosMapping. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockGetPosMapping(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockGetPosMapping {
+	mock := &MockGetPosMapping{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockGetPosMapping is an autogenerated mock type for the GetPosMapping type
+type MockGetPosMapping struct {
+	mock.Mock
+}
+
+type MockGetPosMapping_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockGetPosMapping) EXPECT() *MockGetPosMapping_Expecter {
+	return &MockGetPosMapping_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockGetPosMapping
+func (_mock *MockGetPosMapping) Execute(ctx context.Context, in settinguc.InputGetPosMapping) (*posmap.Mapping, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *posmap.Mapping
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputGetPosMapping) (*posmap.Mapping, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputGetPosMapping) *posmap.Mapping); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*posmap.Mapping)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, settinguc.InputGetPosMapping) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGetPosMapping_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockGetPosMapping_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in settinguc.InputGetPosMapping
+func (_e *MockGetPosMapping_Expecter) Execute(ctx interface{}, in interface{}) *MockGetPosMapping_Execute_Call {
+	return &MockGetPosMapping_Execute_Call{Call: _e.mock.On("Execute", ctx, in)}
+}
+
+func (_c *MockGetPosMapping_Execute_Call) Run(run func(ctx context.Context, in settinguc.InputGetPosMapping)) *MockGetPosMapping_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 settinguc.InputGetPosMapping
+		if args[1] != nil {
+			arg1 = args[1].(settinguc.InputGetPosMapping)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGetPosMapping_Execute_Call) Return(mapping *posmap.Mapping, err error) *MockGetPosMapping_Execute_Call {
+	_c.Call.Return(mapping, err)
+	return _c
+}
+
+func (_c *MockGetPosMapping_Execute_Call) RunAndReturn(run func(ctx context.Context, in settinguc.InputGetPosMapping) (*posmap.Mapping, error)) *MockGetPosMapping_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockGetRootConfig creates a new instance of MockGetRootConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockGetRootConfig(t interface {
@@ -319,6 +415,74 @@ func (_m *MockSettingFacade) EXPECT() *MockSettingFacade_Expecter {
 	return &MockSettingFacade_Expecter{mock: &_m.Mock}
 }
 
+// GetPosMapping provides a mock function for the type MockSettingFacade
+func (_mock *MockSettingFacade) GetPosMapping(ctx context.Context, in settinguc.InputGetPosMapping) (*posmap.Mapping, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPosMapping")
+	}
+
+	var r0 *posmap.Mapping
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputGetPosMapping) (*posmap.Mapping, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputGetPosMapping) *posmap.Mapping); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*posmap.Mapping)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, settinguc.InputGetPosMapping) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSettingFacade_GetPosMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPosMapping'
+type MockSettingFacade_GetPosMapping_Call struct {
+	*mock.Call
+}
+
+// GetPosMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in settinguc.InputGetPosMapping
+func (_e *MockSettingFacade_Expecter) GetPosMapping(ctx interface{}, in interface{}) *MockSettingFacade_GetPosMapping_Call {
+	return &MockSettingFacade_GetPosMapping_Call{Call: _e.mock.On("GetPosMapping", ctx, in)}
+}
+
+func (_c *MockSettingFacade_GetPosMapping_Call) Run(run func(ctx context.Context, in settinguc.InputGetPosMapping)) *MockSettingFacade_GetPosMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 settinguc.InputGetPosMapping
+		if args[1] != nil {
+			arg1 = args[1].(settinguc.InputGetPosMapping)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSettingFacade_GetPosMapping_Call) Return(mapping *posmap.Mapping, err error) *MockSettingFacade_GetPosMapping_Call {
+	_c.Call.Return(mapping, err)
+	return _c
+}
+
+func (_c *MockSettingFacade_GetPosMapping_Call) RunAndReturn(run func(ctx context.Context, in settinguc.InputGetPosMapping) (*posmap.Mapping, error)) *MockSettingFacade_GetPosMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetRoot provides a mock function for the type MockSettingFacade
 func (_mock *MockSettingFacade) GetRoot(ctx context.Context, in settinguc.InputGetRootConfig) (*settinguc.OutputGetRootConfig, error) {
 	ret := _mock.Called(ctx, in)
@@ -517,6 +681,74 @@ func (_c *MockSettingFacade_GetUser_Call) RunAndReturn(run func(ctx context.Cont
 	return _c
 }
 
+// UpdatePosMapping provides a mock function for the type MockSettingFacade
+func (_mock *MockSettingFacade) UpdatePosMapping(ctx context.Context, in settinguc.InputUpdatePosMapping) (*settinguc.OutputUpdatePosMapping, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePosMapping")
+	}
+
+	var r0 *settinguc.OutputUpdatePosMapping
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputUpdatePosMapping) (*settinguc.OutputUpdatePosMapping, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputUpdatePosMapping) *settinguc.OutputUpdatePosMapping); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settinguc.OutputUpdatePosMapping)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, settinguc.InputUpdatePosMapping) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSettingFacade_UpdatePosMapping_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePosMapping'
+type MockSettingFacade_UpdatePosMapping_Call struct {
+	*mock.Call
+}
+
+// UpdatePosMapping is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in settinguc.InputUpdatePosMapping
+func (_e *MockSettingFacade_Expecter) UpdatePosMapping(ctx interface{}, in interface{}) *MockSettingFacade_UpdatePosMapping_Call {
+	return &MockSettingFacade_UpdatePosMapping_Call{Call: _e.mock.On("UpdatePosMapping", ctx, in)}
+}
+
+func (_c *MockSettingFacade_UpdatePosMapping_Call) Run(run func(ctx context.Context, in settinguc.InputUpdatePosMapping)) *MockSettingFacade_UpdatePosMapping_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 settinguc.InputUpdatePosMapping
+		if args[1] != nil {
+			arg1 = args[1].(settinguc.InputUpdatePosMapping)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSettingFacade_UpdatePosMapping_Call) Return(outputUpdatePosMapping *settinguc.OutputUpdatePosMapping, err error) *MockSettingFacade_UpdatePosMapping_Call {
+	_c.Call.Return(outputUpdatePosMapping, err)
+	return _c
+}
+
+func (_c *MockSettingFacade_UpdatePosMapping_Call) RunAndReturn(run func(ctx context.Context, in settinguc.InputUpdatePosMapping) (*settinguc.OutputUpdatePosMapping, error)) *MockSettingFacade_UpdatePosMapping_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRoot provides a mock function for the type MockSettingFacade
 func (_mock *MockSettingFacade) UpdateRoot(ctx context.Context, in settinguc.InputUpdateRootConfig) (*domain.RootConfig, error) {
 	ret := _mock.Called(ctx, in)
@@ -653,6 +885,101 @@ func (_c *MockSettingFacade_UpdateUser_Call) RunAndReturn(run func(ctx context.C
 	return _c
 }
 
+// NewMockUpdatePosMapping creates a new instance of MockUpdatePosMapping. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockUpdatePosMapping(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockUpdatePosMapping {
+	mock := &MockUpdatePosMapping{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockUpdatePosMapping is an autogenerated mock type for the UpdatePosMapping type
+type MockUpdatePosMapping struct {
+	mock.Mock
+}
+
+type MockUpdatePosMapping_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockUpdatePosMapping) EXPECT() *MockUpdatePosMapping_Expecter {
+	return &MockUpdatePosMapping_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function for the type MockUpdatePosMapping
+func (_mock *MockUpdatePosMapping) Execute(ctx context.Context, in settinguc.InputUpdatePosMapping) (*settinguc.OutputUpdatePosMapping, error) {
+	ret := _mock.Called(ctx, in)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 *settinguc.OutputUpdatePosMapping
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputUpdatePosMapping) (*settinguc.OutputUpdatePosMapping, error)); ok {
+		return returnFunc(ctx, in)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, settinguc.InputUpdatePosMapping) *settinguc.OutputUpdatePosMapping); ok {
+		r0 = returnFunc(ctx, in)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*settinguc.OutputUpdatePosMapping)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, settinguc.InputUpdatePosMapping) error); ok {
+		r1 = returnFunc(ctx, in)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockUpdatePosMapping_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type MockUpdatePosMapping_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in settinguc.InputUpdatePosMapping
+func (_e *MockUpdatePosMapping_Expecter) Execute(ctx interface{}, in interface{}) *MockUpdatePosMapping_Execute_Call {
+	return &MockUpdatePosMapping_Execute_Call{Call: _e.mock.On("Execute", ctx, in)}
+}
+
+func (_c *MockUpdatePosMapping_Execute_Call) Run(run func(ctx context.Context, in settinguc.InputUpdatePosMapping)) *MockUpdatePosMapping_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 settinguc.InputUpdatePosMapping
+		if args[1] != nil {
+			arg1 = args[1].(settinguc.InputUpdatePosMapping)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockUpdatePosMapping_Execute_Call) Return(outputUpdatePosMapping *settinguc.OutputUpdatePosMapping, err error) *MockUpdatePosMapping_Execute_Call {
+	_c.Call.Return(outputUpdatePosMapping, err)
+	return _c
+}
+
+func (_c *MockUpdatePosMapping_Execute_Call) RunAndReturn(run func(ctx context.Context, in settinguc.InputUpdatePosMapping) (*settinguc.OutputUpdatePosMapping, error)) *MockUpdatePosMapping_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewMockUpdateRootConfig creates a new instance of MockUpdateRootConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewMockUpdateRootConfig(t interface {
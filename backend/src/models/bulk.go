@@ -7,13 +7,22 @@ type BulkTokenizeRequest struct {
 }
 
 // BulkTokenizeResponse groups the results of a bulk tokenization.
-//   - Candidates    — all tokens detected in the text
+//   - Candidates    — all tokens detected in the text (idioms as one entry)
 //   - Registered    — tokens already saved by the user
 //   - NotExistWord  — tokens that don’t exist in the master dictionary
+//   - Lemmas        — tokens matched through their base form (e.g. running → run)
 type BulkTokenizeResponse struct {
-	Candidates   []string `json:"candidates"`
-	Registered   []string `json:"registered"`
-	NotExistWord []string `json:"not_exists"`
+	Candidates   []string    `json:"candidates"`
+	Registered   []string    `json:"registered"`
+	NotExistWord []string    `json:"not_exists"`
+	Lemmas       []BulkLemma `json:"lemmas"`
+}
+
+// BulkLemma pairs a surface form found in the text with the master word it
+// was matched to. Candidates and Registered contain the lemma, not the surface.
+type BulkLemma struct {
+	Surface string `json:"surface"`
+	Lemma   string `json:"lemma"`
 }
 
 // BulkRegisterRequest is used to register up to 200 words at once.
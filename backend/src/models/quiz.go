@@ -2,38 +2,92 @@ package models
 
 import "time"
 
+// 出題方向
+const (
+	QuizDirectionEnToJa = "en_to_ja" // 英単語を見て意味を選ぶ
+	QuizDirectionJaToEn = "ja_to_en" // 意味を見て英単語を選ぶ
+)
+
+// 問題形式
+const (
+	QuestionTypeChoice = "choice" // 選択式
+	QuestionTypeTyping = "typing" // 意味を見て英単語を入力
+)
+
+// 誤答の難易度
+const (
+	QuizDifficultyEasy   = "easy"   // 他品詞の意味から誤答を選ぶ
+	QuizDifficultyNormal = "normal" // 同品詞から選び、過去に混同した意味を 1 つ混ぜる
+	QuizDifficultyHard   = "hard"   // 過去に混同した意味を優先する
+)
+
+// QuestionGradeTimeout は制限時間を過ぎた回答・未回答の採点結果
+const QuestionGradeTimeout = "timeout"
+
+// クイズの終了理由
+const (
+	QuizStatusCompleted = "completed" // 全問回答して終了
+	QuizStatusCancelled = "cancelled" // ユーザーが途中で中断
+	QuizStatusExpired   = "expired"   // 制限時間切れ・放置で自動終了
+)
+
+// 出題モード
+const (
+	QuizModeRandom = "random" // 条件に合う単語をランダムに出題
+	QuizModeDue    = "due"    // 復習期限の来た単語を優先して出題
+)
+
 type CreateQuizDTO struct {
-	QuestionCount       int   `json:"questionCount" validate:"required,min=10,max=100"`
-	IsSaveResult        bool  `json:"isSaveResult"`
-	IsRegisteredWords   int   `json:"isRegisteredWords"   validate:"oneof=0 1 2"`
-	CorrectRate         int   `json:"correctRate"         validate:"min=0,max=100"`
-	AttentionLevelList  []int `json:"attentionLevelList"  validate:"dive,min=1,max=5"`
-	PartsOfSpeeches     []int `json:"partsOfSpeeches"     validate:"required,dive,min=1,max=12"`
-	IsIdioms            int   `json:"isIdioms"            validate:"oneof=0 1 2"`
-	IsSpecialCharacters int   `json:"isSpecialCharacters" validate:"oneof=0 1 2"`
+	QuestionCount        int    `json:"questionCount" validate:"required,min=10,max=100"`
+	IsSaveResult         bool   `json:"isSaveResult"`
+	IsRegisteredWords    int    `json:"isRegisteredWords"   validate:"oneof=0 1 2"`
+	CorrectRate          int    `json:"correctRate"         validate:"min=0,max=100"`
+	AttentionLevelList   []int  `json:"attentionLevelList"  validate:"dive,min=1,max=5"`
+	PartsOfSpeeches      []int  `json:"partsOfSpeeches"     validate:"required,dive,min=1,max=12"`
+	IsIdioms             int    `json:"isIdioms"            validate:"oneof=0 1 2"`
+	IsSpecialCharacters  int    `json:"isSpecialCharacters" validate:"oneof=0 1 2"`
+	Mode                 string `json:"mode"                validate:"omitempty,oneof=random due"`
+	Direction            string `json:"direction"           validate:"omitempty,oneof=en_to_ja ja_to_en"`
+	QuestionType         string `json:"questionType"        validate:"omitempty,oneof=choice typing"`
+	Difficulty           string `json:"difficulty"          validate:"omitempty,oneof=easy normal hard"`
+	QuestionTimeLimitSec int    `json:"questionTimeLimitSec" validate:"omitempty,min=5,max=600"`
+	TimeLimitSec         int    `json:"timeLimitSec"        validate:"omitempty,min=30,max=7200"`
+	DeckID               int    `json:"deckID"              validate:"omitempty,min=1"`
 }
 
 type CreateQuizReq struct {
-	QuestionCount       int   `json:"questionCount" binding:"required,min=10,max=100"`
-	IsSaveResult        bool  `json:"isSaveResult"`
-	IsRegisteredWords   int   `json:"isRegisteredWords" binding:"oneof=0 1 2"`
-	CorrectRate         int   `json:"correctRate" binding:"required,min=0,max=100"`
-	AttentionLevelList  []int `json:"attentionLevelList"` // 1‑5 配列
-	PartsOfSpeeches     []int `json:"partsOfSpeeches"`    // 1‑12 配列
-	IsIdioms            int   `json:"isIdioms" binding:"oneof=0 1 2"`
-	IsSpecialCharacters int   `json:"isSpecialCharacters" binding:"oneof=0 1 2"`
+	QuestionCount        int    `json:"questionCount" binding:"required,min=10,max=100"`
+	IsSaveResult         bool   `json:"isSaveResult"`
+	IsRegisteredWords    int    `json:"isRegisteredWords" binding:"oneof=0 1 2"`
+	CorrectRate          int    `json:"correctRate" binding:"required,min=0,max=100"`
+	AttentionLevelList   []int  `json:"attentionLevelList"` // 1‑5 配列
+	PartsOfSpeeches      []int  `json:"partsOfSpeeches"`    // 1‑12 配列
+	IsIdioms             int    `json:"isIdioms" binding:"oneof=0 1 2"`
+	IsSpecialCharacters  int    `json:"isSpecialCharacters" binding:"oneof=0 1 2"`
+	Mode                 string `json:"mode" binding:"omitempty,oneof=random due"`              // 未指定は random
+	Direction            string `json:"direction" binding:"omitempty,oneof=en_to_ja ja_to_en"`  // 未指定は en_to_ja
+	QuestionType         string `json:"questionType" binding:"omitempty,oneof=choice typing"`   // 未指定は choice。typing は常に意味→英単語
+	Difficulty           string `json:"difficulty" binding:"omitempty,oneof=easy normal hard"`  // 未指定は normal
+	QuestionTimeLimitSec int    `json:"questionTimeLimitSec" binding:"omitempty,min=5,max=600"` // 1 問の制限時間(秒)。0 は無制限
+	TimeLimitSec         int    `json:"timeLimitSec" binding:"omitempty,min=30,max=7200"`       // クイズ全体の制限時間(秒)。0 は無制限
+	DeckID               int    `json:"deckID" binding:"omitempty,min=1"`                       // 指定するとデッキの単語だけから出題（自分のデッキか公開デッキ）
+	CommonOnly           bool   `json:"commonOnly"`                                             // true なら辞書で頻出（common）の単語だけから出題
 }
 
 type CreateQuizResponse struct {
 	QuizID               int          `json:"quizID"`
 	TotalCreatedQuestion int          `json:"totalCreatedQuestion"`
 	NextQuestion         NextQuestion `json:"nextQuestion"`
+	DueRemaining         int          `json:"dueRemaining"` // due モードで出題しきれなかった期限切れ件数
 }
 
 type PostAnswerQuestionRequest struct {
-	QuizID         int `json:"quizID"`
-	QuestionNumber int `json:"questionNumber"`
-	AnswerJpmID    int `json:"answerJpmID"`
+	QuizID         int    `json:"quizID"`
+	QuestionNumber int    `json:"questionNumber"`
+	AnswerJpmID    int    `json:"answerJpmID"`
+	AnswerWordID   int    `json:"answerWordID"` // ja_to_en の回答
+	TypedAnswer    string `json:"typedAnswer"`  // typing の回答
+	IdempotencyKey string `json:"-"`            // Idempotency-Key ヘッダ
 }
 
 // type PostAnswerQuestionResponse struct {
@@ -50,20 +104,53 @@ type GetQuizResponse struct {
 	NextQuestion  NextQuestion `json:"nextQuestion"`
 }
 
+type CancelQuizReq struct {
+	GradeAnswered bool `json:"gradeAnswered"` // 回答済みの問題を登録単語・復習スケジュールに反映する
+}
+
+type CancelQuizResponse struct {
+	QuizID        int    `json:"quizID"`
+	QuizNumber    int    `json:"quizNumber"`
+	Status        string `json:"status"` // 期限切れだった場合は expired
+	AnsweredCount int    `json:"answeredCount"`
+	CorrectCount  int    `json:"correctCount"`
+}
+
+// UnfinishedQuiz は中断中（実行中）のクイズの概要
+type UnfinishedQuiz struct {
+	QuizID              int        `json:"quizID"`
+	QuizNumber          int        `json:"quizNumber"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	ExpiresAt           *time.Time `json:"expiresAt,omitempty"`
+	Mode                string     `json:"mode"`
+	Direction           string     `json:"direction"`
+	QuestionType        string     `json:"questionType"`
+	Difficulty          string     `json:"difficulty"`
+	TotalQuestionsCount int        `json:"totalQuestionsCount"`
+	AnsweredCount       int        `json:"answeredCount"`
+}
+
 type AnswerRouteRes struct {
-	IsFinish  bool `json:"isFinish"`
-	IsCorrect bool `json:"isCorrect"`
+	IsFinish  bool   `json:"isFinish"`
+	IsCorrect bool   `json:"isCorrect"`
+	Grade     string `json:"grade"` // correct / near_miss / wrong / timeout
 	// PostAnswerQuestionResponse PostAnswerQuestionResponse `json:"postAnswerQuestionResponse"`
 	NextQuestion NextQuestion `json:"nextQuestion,omitempty"`
 	// Result       Result       `json:"result"`
-	QuizNumber int `json:"quizNumber,omitempty"`
+	QuizNumber int  `json:"quizNumber,omitempty"`
+	Replayed   bool `json:"-"` // 回答済みの問題への再送（保存済みの結果を返した）
 }
 
 type NextQuestion struct {
-	QuizID         int         `json:"quizID"`
-	QuestionNumber int         `json:"questionNumber"`
-	WordName       string      `json:"wordName"`
-	ChoicesJpms    []ChoiceJpm `json:"choicesJpms"`
+	QuizID         int          `json:"quizID"`
+	QuestionNumber int          `json:"questionNumber"`
+	Direction      string       `json:"direction"`
+	QuestionType   string       `json:"questionType"`
+	WordName       string       `json:"wordName,omitempty"`     // en_to_ja
+	ChoicesJpms    []ChoiceJpm  `json:"choicesJpms,omitempty"`  // en_to_ja
+	MeaningName    string       `json:"meaningName,omitempty"`  // ja_to_en / typing
+	ChoicesWords   []ChoiceWord `json:"choicesWords,omitempty"` // ja_to_en
+	Deadline       *time.Time   `json:"deadline,omitempty"`     // 制限時間ありの場合の回答期限
 }
 
 type ChoiceJpm struct {
@@ -71,8 +158,14 @@ type ChoiceJpm struct {
 	Name           string `json:"name"`
 }
 
+type ChoiceWord struct {
+	WordID int    `json:"wordID"`
+	Name   string `json:"name"`
+}
+
 type Result struct {
 	QuizNumber          int              `json:"quizNumber"`
+	Status              string           `json:"status"`
 	TotalQuestionsCount int              `json:"totalQuestionsCount"`
 	CorrectCount        int              `json:"correctCount"`
 	ResultCorrectRate   float64          `json:"resultCorrectRate"`
@@ -82,42 +175,64 @@ type Result struct {
 
 type ResultQuestion struct {
 	QuestionNumber int            `json:"questionNumber"`
+	Direction      string         `json:"direction"`
 	WordName       string         `json:"wordName"`
 	WordID         int            `json:"wordID"`
 	PosID          int            `json:"posID"`
 	CorrectJpmID   int            `json:"correctJpmID"`
+	MeaningName    string         `json:"meaningName,omitempty"`
 	ChoicesJpms    []ChoiceJpm    `json:"choicesJpms"`
+	ChoicesWords   []ChoiceWord   `json:"choicesWords,omitempty"`
 	AnswerJpmID    int            `json:"answerJpmID"`
+	AnswerWordID   int            `json:"answerWordID,omitempty"`
+	QuestionType   string         `json:"questionType"`
+	TypedAnswer    string         `json:"typedAnswer,omitempty"`
+	Grade          string         `json:"grade"` // near_miss / timeout は不正解と区別して表示する
+	Credit         float64        `json:"credit"`
 	IsCorrect      bool           `json:"isCorrect"`
 	TimeMs         int            `json:"timeMs"`
 	RegisteredWord RegisteredWord `json:"registeredWord"`
 }
 
 type RegisteredWord struct {
-	IsRegistered   bool `json:"isRegistered"`
-	AttentionLevel int  `json:"attentionLevel"`
-	QuizCount      int  `json:"quizCount"`
-	CorrectCount   int  `json:"correctCount"`
+	IsRegistered   bool       `json:"isRegistered"`
+	AttentionLevel int        `json:"attentionLevel"`
+	QuizCount      int        `json:"quizCount"`
+	CorrectCount   int        `json:"correctCount"`
+	IntervalDays   int        `json:"intervalDays"`
+	DueAt          *time.Time `json:"dueAt,omitempty"`
 }
 
 type ResultSetting struct {
-	IsSaveResult        bool  `json:"isSaveResult"`
-	IsRegisteredWords   int   `json:"isRegisteredWords"`
-	SettingCorrectRate  int   `json:"settingCorrectRate"`
-	IsIdioms            int   `json:"isIdioms"`
-	IsSpecialCharacters int   `json:"isSpecialCharacters"`
-	AttentionLevelList  []int `json:"attentionLevelList"`
-	ChoicesPosIDs       []int `json:"choicesPosIds"`
+	IsSaveResult         bool   `json:"isSaveResult"`
+	IsRegisteredWords    int    `json:"isRegisteredWords"`
+	SettingCorrectRate   int    `json:"settingCorrectRate"`
+	IsIdioms             int    `json:"isIdioms"`
+	IsSpecialCharacters  int    `json:"isSpecialCharacters"`
+	AttentionLevelList   []int  `json:"attentionLevelList"`
+	ChoicesPosIDs        []int  `json:"choicesPosIds"`
+	Mode                 string `json:"mode"`
+	Direction            string `json:"direction"`
+	QuestionType         string `json:"questionType"`
+	Difficulty           string `json:"difficulty"`
+	QuestionTimeLimitSec int    `json:"questionTimeLimitSec"`
+	TimeLimitSec         int    `json:"timeLimitSec"`
 }
 
 type ResultSummary struct {
-	QuizNumber          int       `json:"quizNumber"`
-	CreatedAt           time.Time `json:"createdAt"`
-	IsRegisteredWords   int       `json:"isRegisteredWords"`
-	IsIdioms            int       `json:"isIdioms"`
-	IsSpecialCharacters int       `json:"isSpecialCharacters"`
-	ChoicesPosIDs       []int     `json:"choicesPosIds"`
-	TotalQuestionsCount int       `json:"totalQuestionsCount"`
-	CorrectCount        int       `json:"correctCount"`
-	ResultCorrectRate   float64   `json:"resultCorrectRate"`
+	QuizNumber          int        `json:"quizNumber"`
+	Status              string     `json:"status"` // completed / cancelled / expired
+	CreatedAt           time.Time  `json:"createdAt"`
+	FinishedAt          *time.Time `json:"finishedAt,omitempty"`
+	IsRegisteredWords   int        `json:"isRegisteredWords"`
+	IsIdioms            int        `json:"isIdioms"`
+	IsSpecialCharacters int        `json:"isSpecialCharacters"`
+	ChoicesPosIDs       []int      `json:"choicesPosIds"`
+	Mode                string     `json:"mode"`
+	Direction           string     `json:"direction"`
+	QuestionType        string     `json:"questionType"`
+	Difficulty          string     `json:"difficulty"`
+	TotalQuestionsCount int        `json:"totalQuestionsCount"`
+	CorrectCount        int        `json:"correctCount"`
+	ResultCorrectRate   float64    `json:"resultCorrectRate"`
 }
@@ -0,0 +1,60 @@
+package models
+
+// StatsDateLayout は統計 API の日付形式（JST の日付）
+const StatsDateLayout = "2006-01-02"
+
+type StatsRequest struct {
+	From      string // YYYY-MM-DD (JST)。未指定は To の 29 日前
+	To        string // YYYY-MM-DD (JST)。未指定は今日
+	WeakLimit int    // 苦手単語の件数。0 は既定値
+}
+
+type StatsResponse struct {
+	From             string              `json:"from"`
+	To               string              `json:"to"`
+	Total            AccuracyPoint       `json:"total"`
+	Daily            []AccuracyPoint     `json:"daily"`
+	Weekly           []AccuracyPoint     `json:"weekly"` // 月曜始まり
+	Streak           Streak              `json:"streak"` // 期間に関係なく全履歴から計算
+	ByPartOfSpeech   []PosAccuracy       `json:"byPartOfSpeech"`
+	ByAttentionLevel []AttentionAccuracy `json:"byAttentionLevel"`
+	WeakWords        []WeakWord          `json:"weakWords"`
+}
+
+// AccuracyPoint は期間ごとの正答率。Accuracy は得点ベース (near_miss は 0.5)。
+type AccuracyPoint struct {
+	Date     string  `json:"date,omitempty"` // 日・週の開始日
+	Answered int     `json:"answered"`
+	Correct  int     `json:"correct"`
+	Accuracy float64 `json:"accuracy"`
+}
+
+type Streak struct {
+	Current       int    `json:"current"`
+	Longest       int    `json:"longest"`
+	LastStudyDate string `json:"lastStudyDate,omitempty"`
+}
+
+type PosAccuracy struct {
+	PosID    int     `json:"posID"`
+	Name     string  `json:"name"`
+	Answered int     `json:"answered"`
+	Correct  int     `json:"correct"`
+	Accuracy float64 `json:"accuracy"`
+}
+
+// AttentionAccuracy は登録単語の注意レベル別の正答率（未登録の単語はレベル 1）
+type AttentionAccuracy struct {
+	AttentionLevel int     `json:"attentionLevel"`
+	Answered       int     `json:"answered"`
+	Correct        int     `json:"correct"`
+	Accuracy       float64 `json:"accuracy"`
+}
+
+type WeakWord struct {
+	WordID   int     `json:"wordID"`
+	WordName string  `json:"wordName"`
+	Answered int     `json:"answered"`
+	Correct  int     `json:"correct"`
+	Accuracy float64 `json:"accuracy"`
+}
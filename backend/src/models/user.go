@@ -47,3 +47,21 @@ type MyPageResponse struct {
 	User    User `json:"user" binding:"required"`
 	IsLogin bool `json:"isLogin"`
 }
+
+// メール確認の再送・パスワード再設定の依頼
+type EmailRequest struct {
+	Email string `json:"email" binding:"required"`
+}
+
+type VerifyEmailRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
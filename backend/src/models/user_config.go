@@ -1,5 +1,6 @@
 package models
 
 type UserConfig struct {
-	IsDarkMode bool `json:"is_dark_mode"`
+	IsDarkMode   bool   `json:"is_dark_mode"`
+	SrsAlgorithm string `json:"srs_algorithm"`
 }
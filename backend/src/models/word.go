@@ -48,8 +48,15 @@ type PartOfSpeech struct {
 }
 
 type JapaneseMean struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID      int     `json:"id"`
+	Name    string  `json:"name"`
+	Reading *string `json:"reading,omitempty"` // name が漢字表記のときの読み（辞書から取り込んだ意味だけ）
+}
+
+// WordExample は辞書から取り込んだ例文
+type WordExample struct {
+	Japanese string `json:"japanese"`
+	English  string `json:"english"`
 }
 
 type WordListRequest struct {
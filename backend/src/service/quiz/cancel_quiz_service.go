@@ -0,0 +1,80 @@
+package quiz
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/src/infrastructure/repoerr"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+// CancelQuiz = 実行中のクイズを中断して終了する。
+// GradeAnswered なら回答済みの問題を登録単語・復習スケジュールに反映する。
+func (s *ServiceImpl) CancelQuiz(
+	ctx context.Context,
+	userID int,
+	quizID int,
+	req *models.CancelQuizReq,
+) (_ *models.CancelQuizResponse, err error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finishTx(&err, tx)
+
+	q, err := tx.Quiz.
+		Query().
+		Where(
+			quiz.ID(quizID),
+			quiz.UserID(userID),
+			quiz.DeletedAtIsNil(),
+		).
+		WithQuizQuestions().
+		Only(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "quiz not found", "database error")
+	}
+	if !q.IsRunning {
+		return nil, ucerr.Conflict("quiz is already finished")
+	}
+
+	answered, correct := answeredCounts(q.Edges.QuizQuestions)
+	res := &models.CancelQuizResponse{
+		QuizID:        q.ID,
+		QuizNumber:    q.QuizNumber,
+		Status:        models.QuizStatusCancelled,
+		AnsweredCount: answered,
+		CorrectCount:  correct,
+	}
+
+	// 中断前に期限が切れていたら期限切れとして終了する
+	expired, err := s.expireIfStale(ctx, tx, q, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		res.Status = models.QuizStatusExpired
+		return res, nil
+	}
+
+	if err = s.finishQuizTx(ctx, tx, q, userID, finishCancelled(req.GradeAnswered)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// answeredCounts は回答済みの問題数と正答数を返す
+func answeredCounts(qqs []*ent.QuizQuestion) (answered, correct int) {
+	for _, qq := range qqs {
+		if qq.AnsweredAt == nil {
+			continue
+		}
+		answered++
+		if qq.IsCorrect != nil && *qq.IsCorrect {
+			correct++
+		}
+	}
+	return answered, correct
+}
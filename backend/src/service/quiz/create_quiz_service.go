@@ -7,7 +7,9 @@ import (
 	"strings"
 
 	"word_app/backend/ent"
-	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/deckword"
+	"word_app/backend/ent/predicate"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/registeredword"
 	"word_app/backend/ent/word"
@@ -36,8 +38,21 @@ func (s *ServiceImpl) CreateQuiz(
 	if cap <= 0 {
 		cap = 20
 	}
+	if req.DeckID > 0 {
+		if err = s.checkDeck(ctx, userID, req.DeckID); err != nil {
+			return
+		}
+	}
 	// ① ドメイン集約 ― 候補単語
-	words, err := s.fetchCandidates(ctx, userID, req)
+	var (
+		words        []*ent.Word
+		dueRemaining int
+	)
+	if req.Mode == models.QuizModeDue {
+		words, dueRemaining, err = s.fetchDueCandidates(ctx, userID, req)
+	} else {
+		words, err = s.fetchCandidates(ctx, userID, req)
+	}
 	if err != nil {
 		return
 	}
@@ -71,6 +86,7 @@ func (s *ServiceImpl) CreateQuiz(
 			QuizID:               qEnt.ID,
 			TotalCreatedQuestion: req.QuestionCount,
 			NextQuestion:         first,
+			DueRemaining:         dueRemaining,
 		}
 		return nil
 	})
@@ -102,6 +118,32 @@ func (s *ServiceImpl) fetchCandidates(
 	return words, nil
 }
 
+// checkDeck は出題元のデッキが自分のものか公開デッキかを確かめる。
+// リンク共有のデッキは ID だけでは使えない（コピーしてから出題する）。
+func (s *ServiceImpl) checkDeck(ctx context.Context, userID, deckID int) error {
+	ok, err := s.client.EntClient().Deck.Query().
+		Where(
+			deck.ID(deckID),
+			deck.Or(deck.UserID(userID), deck.VisibilityEQ(deck.VisibilityPublic)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return repoerr.FromEnt(err, "deck not found", "database error")
+	}
+	if !ok {
+		return ucerr.NotFound("deck not found")
+	}
+	return nil
+}
+
+// deckID は未指定（0）を NULL として扱う
+func deckID(id int) *int {
+	if id <= 0 {
+		return nil
+	}
+	return &id
+}
+
 // ensureQuizRecord は「排他チェック＋Quiz行作成」をまとめた 1 ユースケース。
 // ここも将来 QuizRepository へ切り出し可能。
 func (s *ServiceImpl) ensureQuizRecord(
@@ -110,16 +152,26 @@ func (s *ServiceImpl) ensureQuizRecord(
 	userID int,
 	req *models.CreateQuizReq,
 ) (*ent.Quiz, error) {
-	exists, err := tx.Quiz.Query().
+	now := s.clock.Now()
+	running, err := tx.Quiz.Query().
 		Where(quiz.UserID(userID), quiz.IsRunning(true)).
-		Exist(ctx)
-	if err != nil {
+		WithQuizQuestions().
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+	case err != nil:
 		// データベースエラーをapperrorにラップ
 		return nil, repoerr.FromEnt(err, "failed to check running quiz", "database error")
-	}
-	if exists {
-		// apperrorにラップして409エラーとして返す
-		return nil, ucerr.Conflict("another quiz is running")
+	default:
+		// 期限切れ・放置されたクイズは自動で終了させて新規作成を通す
+		expired, err := s.expireIfStale(ctx, tx, running, now)
+		if err != nil {
+			return nil, repoerr.FromEnt(err, "failed to expire running quiz", "database error")
+		}
+		if !expired {
+			// apperrorにラップして409エラーとして返す
+			return nil, ucerr.Conflict("another quiz is running")
+		}
 	}
 
 	qCount, err := tx.Quiz.Query().Where(quiz.UserID(userID)).Count(ctx)
@@ -140,6 +192,15 @@ func (s *ServiceImpl) ensureQuizRecord(
 		SetIsSpecialCharacters(req.IsSpecialCharacters).
 		SetAttentionLevelList(req.AttentionLevelList).
 		SetChoicesPosIds(req.PartsOfSpeeches).
+		SetMode(quizMode(req.Mode)).
+		SetDirection(quizDirection(req)).
+		SetQuestionType(questionType(req.QuestionType)).
+		SetDifficulty(quizDifficulty(req.Difficulty)).
+		SetQuestionTimeLimitSec(req.QuestionTimeLimitSec).
+		SetTimeLimitSec(req.TimeLimitSec).
+		SetNillableExpiresAt(expiresAt(now, req.TimeLimitSec)).
+		SetNillableDeckID(deckID(req.DeckID)).
+		SetCreatedAt(now).
 		Save(ctx)
 	if err != nil {
 		// 制約エラーの場合は409 Conflictとして返す（レースコンディション対応）
@@ -154,6 +215,7 @@ func (s *ServiceImpl) ensureQuizRecord(
 }
 
 // generateQuestions は「ドメインロジック：誤答抽出＋問題行作成」
+// 誤答候補は品詞ごとの集合クエリでまとめて取得し、問題行は CreateBulk で一括作成する。
 // 今は Ent を直叩きだが、あとで QuestionRepository に。
 func (s *ServiceImpl) generateQuestions(
 	ctx context.Context,
@@ -163,7 +225,8 @@ func (s *ServiceImpl) generateQuestions(
 ) (models.NextQuestion, error) {
 	var first models.NextQuestion
 
-	for i, w := range words {
+	inputs := make([]DistractorInput, 0, len(words))
+	for _, w := range words {
 		// 配列の存在チェックを追加（パニック防止）
 		if len(w.Edges.WordInfos) == 0 {
 			return first, ucerr.BadRequest("word has no word info")
@@ -173,54 +236,102 @@ func (s *ServiceImpl) generateQuestions(
 		if len(wi.Edges.JapaneseMeans) == 0 {
 			return first, ucerr.BadRequest("word info has no japanese means")
 		}
-		correct := wi.Edges.JapaneseMeans[0]
-
-		wrongs, err := tx.JapaneseMean.Query().
-			Where(
-				japanesemean.IDNEQ(correct.ID),
-				japanesemean.HasWordInfoWith(wordinfo.PartOfSpeechID(wi.PartOfSpeechID)),
-			).
-			Order(func(s *sql.Selector) { s.OrderBy("RANDOM()") }).
-			Limit(3).
-			All(ctx)
-		if err != nil {
-			// データベースエラーをapperrorにラップ
-			return first, repoerr.FromEnt(err, "failed to fetch wrong answers", "database error")
-		}
-
-		choices := buildChoices(correct, wrongs)
+		inputs = append(inputs, DistractorInput{
+			Word:     w,
+			WordInfo: wi,
+			Correct:  wi.Edges.JapaneseMeans[0],
+			Count:    3,
+		})
+	}
 
-		// 選択肢が2つ未満の場合はエラー（クイズとして不適切）
-		if len(choices) < 2 {
-			return first, ucerr.BadRequest("not enough choices for quiz question")
+	// 出題形式ごとに必要な候補だけを取得
+	var (
+		distractors DistractorStrategy
+		jpmPool     *DistractorPool
+		wordPool    wordChoicePool
+		err         error
+	)
+	switch {
+	case qEnt.QuestionType == models.QuestionTypeTyping:
+		// 入力式は選択肢を持たない
+	case qEnt.Direction == models.QuizDirectionJaToEn:
+		if wordPool, err = loadWordChoicePool(ctx, tx, inputs); err != nil {
+			return first, err
+		}
+	default:
+		distractors = NewDistractorStrategy(qEnt.Difficulty)
+		if jpmPool, err = distractors.Load(ctx, tx, qEnt.UserID, inputs); err != nil {
+			return first, err
 		}
+	}
 
-		qq, err := tx.QuizQuestion.Create().
+	builders := make([]*ent.QuizQuestionCreate, 0, len(inputs))
+	for i, in := range inputs {
+		create := tx.QuizQuestion.Create().
 			SetQuizID(qEnt.ID).
 			SetQuestionNumber(i + 1).
-			SetWordID(w.ID).
-			SetWordName(w.Name).
-			SetPosID(wi.PartOfSpeechID).
-			SetCorrectJpmID(correct.ID).
-			SetChoicesJpms(choices).
-			Save(ctx)
-		if err != nil {
-			// データベースエラーをapperrorにラップ
-			return first, repoerr.FromEnt(err, "failed to create quiz question", "database error")
+			SetWordID(in.Word.ID).
+			SetWordName(in.Word.Name).
+			SetPosID(in.WordInfo.PartOfSpeechID).
+			SetCorrectJpmID(in.Correct.ID).
+			SetDirection(qEnt.Direction).
+			SetQuestionType(qEnt.QuestionType)
+		if i == 0 {
+			// 1 問目はこのレスポンスで出題される
+			create.SetServedAt(s.clock.Now())
 		}
 
-		if i == 0 {
-			first = models.NextQuestion{
-				QuizID:         qEnt.ID,
-				QuestionNumber: qq.QuestionNumber,
-				WordName:       qq.WordName,
-				ChoicesJpms:    qq.ChoicesJpms,
+		switch {
+		case qEnt.QuestionType == models.QuestionTypeTyping:
+			create.
+				SetMeaningName(in.Correct.Name).
+				SetChoicesJpms([]models.ChoiceJpm{})
+		case qEnt.Direction == models.QuizDirectionJaToEn:
+			choices, err := buildWordChoices(in.Word, wordPool.pick(in, 3))
+			if err != nil {
+				return first, err
+			}
+			create.
+				SetMeaningName(in.Correct.Name).
+				SetChoicesJpms([]models.ChoiceJpm{}).
+				SetChoicesWords(choices)
+		default:
+			choices := buildChoices(in.Correct, distractors.Pick(jpmPool, in))
+			// 選択肢が2つ未満の場合はエラー（クイズとして不適切）
+			if len(choices) < 2 {
+				return first, ucerr.BadRequest("not enough choices for quiz question")
 			}
+			create.SetChoicesJpms(choices)
 		}
+		builders = append(builders, create)
+	}
+
+	qqs, err := tx.QuizQuestion.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		// データベースエラーをapperrorにラップ
+		return first, repoerr.FromEnt(err, "failed to create quiz question", "database error")
+	}
+	if len(qqs) > 0 {
+		first = toNextQuestion(qEnt, qqs[0])
 	}
 	return first, nil
 }
 
+// buildWordChoices は意味→英単語の選択肢（正解 + 誤答）をシャッフルして返す。
+func buildWordChoices(correct *ent.Word, wrongs []*ent.Word) ([]models.ChoiceWord, error) {
+	choices := make([]models.ChoiceWord, 0, len(wrongs)+1)
+	for _, ww := range wrongs {
+		choices = append(choices, models.ChoiceWord{WordID: ww.ID, Name: ww.Name})
+	}
+	choices = append(choices, models.ChoiceWord{WordID: correct.ID, Name: correct.Name})
+
+	if len(choices) < 2 {
+		return nil, ucerr.BadRequest("not enough choices for quiz question")
+	}
+	rand.Shuffle(len(choices), func(i, j int) { choices[i], choices[j] = choices[j], choices[i] })
+	return choices, nil
+}
+
 /*==================== “query builder” ====================*/
 
 // baseWordQuery はフィルタ条件を組み立てるだけ。
@@ -229,12 +340,9 @@ func (s *ServiceImpl) baseWordQuery(
 	userID int,
 	req *models.CreateQuizReq,
 ) *ent.WordQuery {
-	q := s.client.Word().
+	return s.client.Word().
 		Query().
-		Where(word.HasWordInfosWith(
-			wordinfo.PartOfSpeechIDIn(req.PartsOfSpeeches...),
-			wordinfo.HasJapaneseMeans(),
-		)).
+		Where(wordFilters(userID, req)...).
 		WithWordInfos(func(wi *ent.WordInfoQuery) {
 			wi.Where(wordinfo.PartOfSpeechIDIn(req.PartsOfSpeeches...)).
 				WithJapaneseMeans()
@@ -242,27 +350,48 @@ func (s *ServiceImpl) baseWordQuery(
 		WithRegisteredWords(func(rw *ent.RegisteredWordQuery) {
 			rw.Where(registeredword.UserID(userID))
 		})
+}
+
+// wordFilters は出題条件を Word の述語として返す。
+// RegisteredWord 側からの絞り込み (HasWordWith) でも同じ条件を使う。
+func wordFilters(
+	userID int,
+	req *models.CreateQuizReq,
+) []predicate.Word {
+	ps := []predicate.Word{
+		word.HasWordInfosWith(
+			wordinfo.PartOfSpeechIDIn(req.PartsOfSpeeches...),
+			wordinfo.HasJapaneseMeans(),
+		),
+	}
 
 	switch req.IsRegisteredWords {
 	case 1:
-		q = q.Where(word.HasRegisteredWordsWith(
+		ps = append(ps, word.HasRegisteredWordsWith(
 			registeredword.UserID(userID),
 			registeredword.IsActiveEQ(true),
 			registeredword.CorrectRateLTE(req.CorrectRate),
 			registeredword.AttentionLevelIn(req.AttentionLevelList...),
 		))
 	case 2:
-		q = q.Where(word.Not(word.HasRegisteredWordsWith(
+		ps = append(ps, word.Not(word.HasRegisteredWordsWith(
 			registeredword.UserID(userID),
 			registeredword.IsActiveEQ(true))))
 	}
+	if req.DeckID > 0 {
+		ps = append(ps, word.HasDeckWordsWith(deckword.DeckID(req.DeckID)))
+	}
 	if flag := req.IsIdioms; flag != 0 {
-		q = q.Where(word.IsIdiomsEQ(flag == 1))
+		ps = append(ps, word.IsIdiomsEQ(flag == 1))
 	}
 	if flag := req.IsSpecialCharacters; flag != 0 {
-		q = q.Where(word.IsSpecialCharactersEQ(flag == 1))
+		ps = append(ps, word.IsSpecialCharactersEQ(flag == 1))
+	}
+	// 頻出度は辞書の取り込みで付く。手で登録した単語（NULL）は含めない
+	if req.CommonOnly {
+		ps = append(ps, word.FrequencyRank(1))
 	}
-	return q
+	return ps
 }
 
 /*==================== tx wrapper & utility ====================*/
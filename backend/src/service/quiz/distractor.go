@@ -0,0 +1,382 @@
+package quiz
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/word"
+	"word_app/backend/ent/wordinfo"
+	"word_app/backend/src/infrastructure/repoerr"
+	"word_app/backend/src/models"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// DistractorInput は 1 問ぶんの誤答選択に必要な情報。
+// Word は WordInfos / JapaneseMeans をロード済みであること。
+type DistractorInput struct {
+	Word     *ent.Word
+	WordInfo *ent.WordInfo
+	Correct  *ent.JapaneseMean
+	Count    int
+}
+
+// DistractorStrategy は英単語→意味の誤答 (JapaneseMean) の選び方。
+// 候補はクイズ全体ぶんを Load で集合クエリにまとめて取得し、Pick は DB に触れない。
+// どの実装も同じ Word の意味と、正解の単語と同名の意味（実質的な正解）は返さない。
+type DistractorStrategy interface {
+	Load(ctx context.Context, tx *ent.Tx, userID int, inputs []DistractorInput) (*DistractorPool, error)
+	Pick(pool *DistractorPool, in DistractorInput) []*ent.JapaneseMean
+}
+
+// DistractorPool は品詞ごとにサンプリングした誤答候補と混同履歴。
+// JapaneseMean は WordInfo をロード済み（同一 Word の除外に使う）。
+type DistractorPool struct {
+	samePOS  map[int][]*ent.JapaneseMean // pos_id → 同品詞の候補
+	otherPOS map[int][]*ent.JapaneseMean // pos_id → 他品詞の候補
+	confused map[int][]*ent.JapaneseMean // word_id → 混同した意味（多い順）
+}
+
+// NewDistractorStrategy は難易度に応じた戦略を返す。未知の値は normal。
+func NewDistractorStrategy(difficulty string) DistractorStrategy {
+	switch difficulty {
+	case models.QuizDifficultyEasy:
+		return &posDistractors{samePOS: false}
+	case models.QuizDifficultyHard:
+		return &confusedDistractors{max: -1}
+	default:
+		return &confusedDistractors{max: 1}
+	}
+}
+
+// posDistractors は品詞で絞ってランダムに選ぶ。
+// samePOS=false は他品詞から選び（見分けやすい）、足りなければ同品詞で補う。
+type posDistractors struct {
+	samePOS bool
+}
+
+func (d *posDistractors) Load(ctx context.Context, tx *ent.Tx, _ int, inputs []DistractorInput) (*DistractorPool, error) {
+	pool := &DistractorPool{}
+	var err error
+	if pool.samePOS, err = loadPOSPools(ctx, tx, inputs, true); err != nil {
+		return nil, err
+	}
+	if !d.samePOS {
+		if pool.otherPOS, err = loadPOSPools(ctx, tx, inputs, false); err != nil {
+			return nil, err
+		}
+	}
+	return pool, nil
+}
+
+func (d *posDistractors) Pick(pool *DistractorPool, in DistractorInput) []*ent.JapaneseMean {
+	pos := in.WordInfo.PartOfSpeechID
+	var out []*ent.JapaneseMean
+	if !d.samePOS {
+		out = sample(pool.otherPOS[pos], in, nil, in.Count)
+	}
+	return append(out, sample(pool.samePOS[pos], in, out, in.Count-len(out))...)
+}
+
+// confusedDistractors は過去に混同した意味を最大 max 件（負なら上限なし）入れ、
+// 残りを同品詞のランダムで補う。
+type confusedDistractors struct {
+	max int
+}
+
+func (d *confusedDistractors) Load(ctx context.Context, tx *ent.Tx, userID int, inputs []DistractorInput) (*DistractorPool, error) {
+	pool, err := (&posDistractors{samePOS: true}).Load(ctx, tx, userID, inputs)
+	if err != nil {
+		return nil, err
+	}
+	if pool.confused, err = loadConfused(ctx, tx, userID, inputs); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (d *confusedDistractors) Pick(pool *DistractorPool, in DistractorInput) []*ent.JapaneseMean {
+	limit := in.Count
+	if d.max >= 0 && d.max < limit {
+		limit = d.max
+	}
+	var out []*ent.JapaneseMean
+	for _, jm := range pool.confused[in.Word.ID] {
+		if len(out) >= limit {
+			break
+		}
+		if usable(jm, in, out) {
+			out = append(out, jm)
+		}
+	}
+	return append(out, sample(pool.samePOS[in.WordInfo.PartOfSpeechID], in, out, in.Count-len(out))...)
+}
+
+// distractorPoolSize は品詞ごとに取得する候補数。
+// 1 問 3 件 + 同一単語の除外ぶんの余裕を見て、問題数に比例させる。
+func distractorPoolSize(questions int) int {
+	return questions*3 + 8
+}
+
+// loadPOSPools は品詞ごとに 1 回だけランダムサンプリングする。
+// same=false のときはその品詞“以外”からサンプリングする。
+func loadPOSPools(
+	ctx context.Context,
+	tx *ent.Tx,
+	inputs []DistractorInput,
+	same bool,
+) (map[int][]*ent.JapaneseMean, error) {
+	perPOS := map[int]int{}
+	for _, in := range inputs {
+		perPOS[in.WordInfo.PartOfSpeechID]++
+	}
+	pools := make(map[int][]*ent.JapaneseMean, len(perPOS))
+	for pos, n := range perPOS {
+		cond := wordinfo.PartOfSpeechID(pos)
+		if !same {
+			cond = wordinfo.PartOfSpeechIDNEQ(pos)
+		}
+		means, err := tx.JapaneseMean.Query().
+			Where(japanesemean.HasWordInfoWith(cond)).
+			WithWordInfo().
+			Order(func(s *sql.Selector) { s.OrderBy("RANDOM()") }).
+			Limit(distractorPoolSize(n)).
+			All(ctx)
+		if err != nil {
+			// データベースエラーをapperrorにラップ
+			return nil, repoerr.FromEnt(err, "failed to fetch wrong answers", "database error")
+		}
+		pools[pos] = means
+	}
+	return pools, nil
+}
+
+// loadConfused はユーザーの回答履歴から混同した意味を単語ごとに多い順で返す。
+//   - その単語の問題で誤って選んだ意味
+//   - 他の単語の問題でその単語の意味を選んでしまった時の、その問題の正解
+func loadConfused(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int,
+	inputs []DistractorInput,
+) (map[int][]*ent.JapaneseMean, error) {
+	wordIDs := make([]int, 0, len(inputs))
+	meanToWord := map[int]int{}
+	for _, in := range inputs {
+		wordIDs = append(wordIDs, in.Word.ID)
+		for _, id := range meaningIDsOf(in.Word) {
+			meanToWord[id] = in.Word.ID
+		}
+	}
+	meanIDs := make([]int, 0, len(meanToWord))
+	for id := range meanToWord {
+		meanIDs = append(meanIDs, id)
+	}
+
+	var rows []struct {
+		WordID       int `json:"word_id"`
+		CorrectJpmID int `json:"correct_jpm_id"`
+		AnswerJpmID  int `json:"answer_jpm_id"`
+	}
+	err := tx.QuizQuestion.Query().
+		Where(
+			quizquestion.HasQuizWith(quiz.UserID(userID)),
+			quizquestion.IsCorrect(false),
+			quizquestion.AnswerJpmIDNotNil(),
+			quizquestion.Or(
+				quizquestion.WordIDIn(wordIDs...),
+				quizquestion.AnswerJpmIDIn(meanIDs...),
+			),
+		).
+		Select(
+			quizquestion.FieldWordID,
+			quizquestion.FieldCorrectJpmID,
+			quizquestion.FieldAnswerJpmID,
+		).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "failed to fetch answer history", "database error")
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+
+	inQuiz := make(map[int]bool, len(wordIDs))
+	for _, id := range wordIDs {
+		inQuiz[id] = true
+	}
+	freq := map[int]map[int]int{} // word_id → jpm_id → 回数
+	count := func(wordID, jpmID int) {
+		if freq[wordID] == nil {
+			freq[wordID] = map[int]int{}
+		}
+		freq[wordID][jpmID]++
+	}
+	ids := map[int]struct{}{}
+	for _, r := range rows {
+		if inQuiz[r.WordID] {
+			count(r.WordID, r.AnswerJpmID)
+			ids[r.AnswerJpmID] = struct{}{}
+		}
+		if w, ok := meanToWord[r.AnswerJpmID]; ok && w != r.WordID {
+			count(w, r.CorrectJpmID)
+			ids[r.CorrectJpmID] = struct{}{}
+		}
+	}
+
+	idList := make([]int, 0, len(ids))
+	for id := range ids {
+		idList = append(idList, id)
+	}
+	means, err := tx.JapaneseMean.Query().
+		Where(japanesemean.IDIn(idList...)).
+		WithWordInfo().
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "failed to fetch wrong answers", "database error")
+	}
+	byID := make(map[int]*ent.JapaneseMean, len(means))
+	for _, jm := range means {
+		byID[jm.ID] = jm
+	}
+
+	out := make(map[int][]*ent.JapaneseMean, len(freq))
+	for wordID, f := range freq {
+		list := make([]*ent.JapaneseMean, 0, len(f))
+		for id := range f {
+			if jm, ok := byID[id]; ok {
+				list = append(list, jm)
+			}
+		}
+		sort.Slice(list, func(i, j int) bool {
+			if f[list[i].ID] != f[list[j].ID] {
+				return f[list[i].ID] > f[list[j].ID]
+			}
+			return list[i].ID < list[j].ID
+		})
+		out[wordID] = list
+	}
+	return out, nil
+}
+
+// sample は候補からランダムに n 件選ぶ（除外条件と選択済みを除く）。
+func sample(cands []*ent.JapaneseMean, in DistractorInput, picked []*ent.JapaneseMean, n int) []*ent.JapaneseMean {
+	if n <= 0 {
+		return nil
+	}
+	out := make([]*ent.JapaneseMean, 0, n)
+	for _, i := range rand.Perm(len(cands)) {
+		if len(out) >= n {
+			break
+		}
+		if usable(cands[i], in, picked) && usable(cands[i], in, out) {
+			out = append(out, cands[i])
+		}
+	}
+	return out
+}
+
+// usable は同じ Word の意味・正解の単語と同名の意味（同義語）・選択済みを除く。
+func usable(jm *ent.JapaneseMean, in DistractorInput, picked []*ent.JapaneseMean) bool {
+	if jm.ID == in.Correct.ID || jm.Name == in.Correct.Name {
+		return false
+	}
+	if jm.Edges.WordInfo != nil && jm.Edges.WordInfo.WordID == in.Word.ID {
+		return false
+	}
+	for _, wi := range in.Word.Edges.WordInfos {
+		for _, own := range wi.Edges.JapaneseMeans {
+			if own.ID == jm.ID || own.Name == jm.Name {
+				return false
+			}
+		}
+	}
+	for _, p := range picked {
+		if p.ID == jm.ID || p.Name == jm.Name {
+			return false
+		}
+	}
+	return true
+}
+
+func meaningIDsOf(w *ent.Word) []int {
+	ids := []int{}
+	for _, wi := range w.Edges.WordInfos {
+		for _, jm := range wi.Edges.JapaneseMeans {
+			ids = append(ids, jm.ID)
+		}
+	}
+	return ids
+}
+
+/*==================== ja_to_en ====================*/
+
+// wordChoicePool は意味→英単語の誤答候補（品詞ごとにサンプリング）。
+// Word は WordInfos / JapaneseMeans をロード済み。
+type wordChoicePool map[int][]*ent.Word
+
+func loadWordChoicePool(ctx context.Context, tx *ent.Tx, inputs []DistractorInput) (wordChoicePool, error) {
+	perPOS := map[int]int{}
+	for _, in := range inputs {
+		perPOS[in.WordInfo.PartOfSpeechID]++
+	}
+	pool := make(wordChoicePool, len(perPOS))
+	for pos, n := range perPOS {
+		words, err := tx.Word.Query().
+			Where(word.HasWordInfosWith(wordinfo.PartOfSpeechID(pos))).
+			WithWordInfos(func(q *ent.WordInfoQuery) { q.WithJapaneseMeans() }).
+			Order(func(s *sql.Selector) { s.OrderBy("RANDOM()") }).
+			Limit(distractorPoolSize(n)).
+			All(ctx)
+		if err != nil {
+			return nil, repoerr.FromEnt(err, "failed to fetch wrong answers", "database error")
+		}
+		pool[pos] = words
+	}
+	return pool, nil
+}
+
+// pick は正解と同じ意味を持つ単語（どちらも正解になり得る）を除いて n 件選ぶ。
+func (p wordChoicePool) pick(in DistractorInput, n int) []*ent.Word {
+	own := map[string]bool{}
+	for _, name := range meaningNamesOf(in.Word) {
+		own[name] = true
+	}
+	cands := p[in.WordInfo.PartOfSpeechID]
+	out := make([]*ent.Word, 0, n)
+	for _, i := range rand.Perm(len(cands)) {
+		if len(out) >= n {
+			break
+		}
+		w := cands[i]
+		if w.ID == in.Word.ID || overlaps(w, own) {
+			continue
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+func overlaps(w *ent.Word, names map[string]bool) bool {
+	for _, name := range meaningNamesOf(w) {
+		if names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+func meaningNamesOf(w *ent.Word) []string {
+	names := []string{}
+	for _, wi := range w.Edges.WordInfos {
+		for _, jm := range wi.Edges.JapaneseMeans {
+			names = append(names, jm.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,141 @@
+package quiz
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/registeredword"
+	"word_app/backend/ent/word"
+	"word_app/backend/src/infrastructure/repoerr"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/shared/ucerr"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// fetchDueCandidates は「今日復習すべき単語」を選ぶ。
+// 期限切れ (due_at <= now) を期限超過の大きい順 → 間隔の短い順 (緊急度) に並べ、
+// 足りない分は未スケジュールの単語で補う。戻り値の int は今回出題しきれなかった期限切れ件数。
+func (s *ServiceImpl) fetchDueCandidates(
+	ctx context.Context,
+	userID int,
+	req *models.CreateQuizReq,
+) ([]*ent.Word, int, error) {
+	now := s.clock.Now()
+
+	dueQ := s.client.RegisteredWord().
+		Query().
+		Where(
+			registeredword.UserID(userID),
+			registeredword.IsActive(true),
+			registeredword.DueAtLTE(now),
+			registeredword.HasWordWith(wordFilters(userID, req)...),
+		)
+	dueTotal, err := dueQ.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, repoerr.FromEnt(err, "failed to count due words", "database error")
+	}
+	dueIDs, err := dueQ.
+		Order(
+			ent.Asc(registeredword.FieldDueAt),
+			ent.Asc(registeredword.FieldIntervalDays),
+			ent.Asc(registeredword.FieldID),
+		).
+		Limit(req.QuestionCount).
+		Select(registeredword.FieldWordID).
+		Ints(ctx)
+	if err != nil {
+		return nil, 0, repoerr.FromEnt(err, "failed to fetch due words", "database error")
+	}
+
+	words, err := s.loadWordsInOrder(ctx, userID, req, dueIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// 期限切れだけで足りなければ新規 (未スケジュール) 単語で補充
+	if short := req.QuestionCount - len(words); short > 0 {
+		fresh, err := s.baseWordQuery(userID, req).
+			Where(word.Not(word.HasRegisteredWordsWith(
+				registeredword.UserID(userID),
+				registeredword.DueAtNotNil(),
+			))).
+			Order(func(s *sql.Selector) { s.OrderBy("RANDOM()") }).
+			Limit(short).
+			All(ctx)
+		if err != nil {
+			return nil, 0, repoerr.FromEnt(err, "failed to fetch words", "database error")
+		}
+		words = append(words, fresh...)
+	}
+
+	if len(words) < req.QuestionCount {
+		return nil, 0, ucerr.BadRequest("quiz question is not enough")
+	}
+	return words, dueTotal - len(dueIDs), nil
+}
+
+// loadWordsInOrder は出題に必要な edge 付きで Word を取得し、ids の順に並べ直す。
+func (s *ServiceImpl) loadWordsInOrder(
+	ctx context.Context,
+	userID int,
+	req *models.CreateQuizReq,
+	ids []int,
+) ([]*ent.Word, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	rows, err := s.baseWordQuery(userID, req).
+		Where(word.IDIn(ids...)).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "failed to fetch words", "database error")
+	}
+	byID := make(map[int]*ent.Word, len(rows))
+	for _, w := range rows {
+		byID[w.ID] = w
+	}
+	out := make([]*ent.Word, 0, len(ids))
+	for _, id := range ids {
+		if w, ok := byID[id]; ok {
+			out = append(out, w)
+		}
+	}
+	return out, nil
+}
+
+// quizDirection は未指定を英単語→意味として扱う。入力式は常に意味→英単語。
+func quizDirection(req *models.CreateQuizReq) string {
+	switch {
+	case req.QuestionType == models.QuestionTypeTyping:
+		return models.QuizDirectionJaToEn
+	case req.Direction == "":
+		return models.QuizDirectionEnToJa
+	default:
+		return req.Direction
+	}
+}
+
+// quizDifficulty は未指定を normal として扱う
+func quizDifficulty(d string) string {
+	if d == "" {
+		return models.QuizDifficultyNormal
+	}
+	return d
+}
+
+// questionType は未指定を選択式として扱う
+func questionType(t string) string {
+	if t == "" {
+		return models.QuestionTypeChoice
+	}
+	return t
+}
+
+// quizMode は未指定をランダム出題として扱う
+func quizMode(mode string) string {
+	if mode == "" {
+		return models.QuizModeRandom
+	}
+	return mode
+}
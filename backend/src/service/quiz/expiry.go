@@ -0,0 +1,133 @@
+package quiz
+
+import (
+	"context"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/models"
+)
+
+// answerGrace は通信遅延ぶんとして期限後も受け付ける猶予
+const answerGrace = 2 * time.Second
+
+// 制限時間なしのクイズを放置とみなすまでの既定値
+const defaultAbandonAfter = 24 * time.Hour
+
+// servedAt は出題時刻を返す。served_at 導入前の行は created_at で代用する。
+func servedAt(qq *ent.QuizQuestion) time.Time {
+	if qq.ServedAt != nil {
+		return *qq.ServedAt
+	}
+	return qq.CreatedAt
+}
+
+// questionDeadline は 1 問の回答期限（1 問の制限とクイズ全体の期限の早い方）。
+// 制限なし・未出題なら nil。
+func questionDeadline(q *ent.Quiz, qq *ent.QuizQuestion) *time.Time {
+	var deadline *time.Time
+	if q.QuestionTimeLimitSec > 0 && qq.ServedAt != nil {
+		d := qq.ServedAt.Add(time.Duration(q.QuestionTimeLimitSec) * time.Second)
+		deadline = &d
+	}
+	if q.ExpiresAt != nil && (deadline == nil || q.ExpiresAt.Before(*deadline)) {
+		d := *q.ExpiresAt
+		deadline = &d
+	}
+	return deadline
+}
+
+// expiresAt はクイズ全体の期限。制限なしなら nil。
+func expiresAt(now time.Time, limitSec int) *time.Time {
+	if limitSec <= 0 {
+		return nil
+	}
+	t := now.Add(time.Duration(limitSec) * time.Second)
+	return &t
+}
+
+// isLate は猶予込みで期限を過ぎているか
+func isLate(deadline *time.Time, now time.Time) bool {
+	return deadline != nil && now.After(deadline.Add(answerGrace))
+}
+
+// quizExpired は実行中クイズをもう続けられないか判定する。
+//   - クイズ全体の制限時間を過ぎた
+//   - 1 問の制限がある場合: 最後の操作から残り問題ぶんの制限時間を過ぎた
+//   - 制限なしの場合: 最後の操作から abandonAfter 以上経過した
+func (s *ServiceImpl) quizExpired(q *ent.Quiz, qqs []*ent.QuizQuestion, now time.Time) bool {
+	if isLate(q.ExpiresAt, now) {
+		return true
+	}
+	last := q.CreatedAt
+	remaining := 0
+	for _, qq := range qqs {
+		if qq.ServedAt != nil && qq.ServedAt.After(last) {
+			last = *qq.ServedAt
+		}
+		if qq.AnsweredAt != nil {
+			if qq.AnsweredAt.After(last) {
+				last = *qq.AnsweredAt
+			}
+		} else {
+			remaining++
+		}
+	}
+	window := s.abandonAfter()
+	if q.QuestionTimeLimitSec > 0 {
+		window = time.Duration(q.QuestionTimeLimitSec*remaining) * time.Second
+	}
+	return now.After(last.Add(window + answerGrace))
+}
+
+func (s *ServiceImpl) abandonAfter() time.Duration {
+	if s.limits != nil && s.limits.QuizAbandonMinutes > 0 {
+		return time.Duration(s.limits.QuizAbandonMinutes) * time.Minute
+	}
+	return defaultAbandonAfter
+}
+
+// expireIfStale は期限切れなら未回答を timeout として採点しクイズを終了する。
+// 終了した場合は true を返す。q は QuizQuestions をロード済みであること。
+func (s *ServiceImpl) expireIfStale(
+	ctx context.Context,
+	tx *ent.Tx,
+	q *ent.Quiz,
+	now time.Time,
+) (bool, error) {
+	if !s.quizExpired(q, q.Edges.QuizQuestions, now) {
+		return false, nil
+	}
+	return true, s.expireQuizTx(ctx, tx, q)
+}
+
+// expireQuizTx は未回答の問題を timeout にしてからクイズを終了する。
+func (s *ServiceImpl) expireQuizTx(ctx context.Context, tx *ent.Tx, q *ent.Quiz) error {
+	if _, err := tx.QuizQuestion.
+		Update().
+		Where(
+			quizquestion.QuizIDEQ(q.ID),
+			quizquestion.AnsweredAtIsNil(),
+		).
+		SetIsCorrect(false).
+		SetGrade(models.QuestionGradeTimeout).
+		SetCredit(0).
+		Save(ctx); err != nil {
+		return err
+	}
+	// 更新後の問題を読み直させる
+	q.Edges.QuizQuestions = nil
+	return s.finishQuizTx(ctx, tx, q, q.UserID, finishExpired)
+}
+
+// markServed は未出題なら出題時刻を記録する（再開時は元の時刻を維持）。
+func (s *ServiceImpl) markServed(ctx context.Context, tx *ent.Tx, qq *ent.QuizQuestion) (*ent.QuizQuestion, error) {
+	if qq.ServedAt != nil {
+		return qq, nil
+	}
+	return tx.QuizQuestion.
+		UpdateOneID(qq.ID).
+		SetServedAt(s.clock.Now()).
+		Save(ctx)
+}
@@ -10,15 +10,37 @@ import (
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
 	"word_app/backend/ent/registeredword"
+	"word_app/backend/ent/userconfig"
+	"word_app/backend/src/domain/spelling"
+	"word_app/backend/src/domain/srs"
+	"word_app/backend/src/models"
 )
 
 /*==================== public ====================*/
 
+// quizFinish はクイズの終了理由と採点範囲
+type quizFinish struct {
+	status       string
+	answeredOnly bool // 未回答の問題を採点対象から外す（中断時）
+	review       bool // 回答を RegisteredWord / 復習スケジュールに反映する
+}
+
+var (
+	finishCompleted = quizFinish{status: models.QuizStatusCompleted, review: true}
+	finishExpired   = quizFinish{status: models.QuizStatusExpired, review: true}
+)
+
+// finishCancelled は中断時の終了理由。grade=false なら成績だけ残し学習記録は変えない。
+func finishCancelled(grade bool) quizFinish {
+	return quizFinish{status: models.QuizStatusCancelled, answeredOnly: true, review: grade}
+}
+
 func (s *ServiceImpl) finishQuizTx(
 	ctx context.Context,
 	tx *ent.Tx,
 	q *ent.Quiz,
 	userID int,
+	fin quizFinish,
 ) error {
 
 	// ① 質問一覧を確実に取得
@@ -28,19 +50,35 @@ func (s *ServiceImpl) finishQuizTx(
 	}
 
 	// ② 各質問を処理し、正答数をカウント
+	sched, err := s.schedulerFor(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+	graded := 0
 	correct := 0
+	credit := 0.0 // 入力式の「惜しい」は部分点として正答率に含める
 	for _, qq := range q.Edges.QuizQuestions {
-		isCor, err := s.upsertRegisteredWord(ctx, tx, qq, userID)
-		if err != nil {
-			return err
+		if fin.answeredOnly && qq.AnsweredAt == nil {
+			continue
+		}
+		graded++
+		isCor := qq.IsCorrect != nil && *qq.IsCorrect
+		if fin.review {
+			if isCor, err = s.upsertRegisteredWord(ctx, tx, qq, userID, sched); err != nil {
+				return err
+			}
 		}
 		if isCor {
 			correct++
 		}
+		credit += qq.Credit
 	}
 
-	// ③ クイズ本体を完了状態に更新
-	if err := s.updateQuizResult(ctx, tx, q, correct); err != nil {
+	// ③ クイズ本体を完了状態に更新（中断時は回答済みの問題数で正答率を出す）
+	if !fin.answeredOnly {
+		graded = q.TotalQuestionsCount
+	}
+	if err := s.updateQuizResult(ctx, tx, q, fin.status, graded, correct, credit); err != nil {
 		return err
 	}
 
@@ -71,15 +109,37 @@ func (s *ServiceImpl) loadQuizQuestions(
 		Only(ctx)
 }
 
+// ユーザー設定の SRS アルゴリズムを取得（設定行が無ければ既定）
+func (s *ServiceImpl) schedulerFor(
+	ctx context.Context,
+	tx *ent.Tx,
+	userID int,
+) (srs.Scheduler, error) {
+	cfg, err := tx.UserConfig.
+		Query().
+		Where(userconfig.UserID(userID)).
+		First(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return srs.New(srs.DefaultAlgorithm), nil
+	case err != nil:
+		return nil, fmt.Errorf("query user_config: %w", err)
+	}
+	return srs.New(cfg.SrsAlgorithm), nil
+}
+
 // RegisteredWord を作成 / 更新し、正答かどうかを返す
+// 回答結果から次回の復習スケジュールも再計算する
 func (s *ServiceImpl) upsertRegisteredWord(
 	ctx context.Context,
 	tx *ent.Tx,
 	qq *ent.QuizQuestion,
 	userID int,
+	sched srs.Scheduler,
 ) (isCorrect bool, err error) {
 
 	isCorrect = qq.IsCorrect != nil && *qq.IsCorrect
+	review := s.reviewOf(qq)
 
 	rw, err := tx.RegisteredWord.
 		Query().
@@ -95,6 +155,7 @@ func (s *ServiceImpl) upsertRegisteredWord(
 		if isCorrect {
 			initCor = 1
 		}
+		st := sched.Next(srs.State{EaseFactor: srs.DefaultEaseFactor}, review)
 		_, err = tx.RegisteredWord.
 			Create().
 			SetUserID(userID).
@@ -104,6 +165,14 @@ func (s *ServiceImpl) upsertRegisteredWord(
 			SetQuizCount(1).
 			SetCorrectCount(initCor).
 			SetCorrectRate(initCor * 100).
+			SetEaseFactor(st.EaseFactor).
+			SetIntervalDays(st.IntervalDays).
+			SetRepetitions(st.Repetitions).
+			SetLapses(st.Lapses).
+			SetStability(st.Stability).
+			SetDifficulty(st.Difficulty).
+			SetNillableDueAt(st.DueAt).
+			SetNillableLastReviewedAt(st.LastReviewedAt).
 			Save(ctx)
 
 	case err != nil: // クエリエラー
@@ -115,11 +184,20 @@ func (s *ServiceImpl) upsertRegisteredWord(
 		if isCorrect {
 			newCorrect++
 		}
+		st := sched.Next(srsStateOf(rw), review)
 		_, err = tx.RegisteredWord.
 			UpdateOneID(rw.ID).
 			SetQuizCount(newQuiz).
 			SetCorrectCount(newCorrect).
 			SetCorrectRate(newCorrect * 100 / newQuiz).
+			SetEaseFactor(st.EaseFactor).
+			SetIntervalDays(st.IntervalDays).
+			SetRepetitions(st.Repetitions).
+			SetLapses(st.Lapses).
+			SetStability(st.Stability).
+			SetDifficulty(st.Difficulty).
+			SetNillableDueAt(st.DueAt).
+			SetNillableLastReviewedAt(st.LastReviewedAt).
 			Save(ctx)
 	}
 	if err != nil {
@@ -128,17 +206,55 @@ func (s *ServiceImpl) upsertRegisteredWord(
 	return
 }
 
+// QuizQuestion の回答内容を SRS の Review に変換
+func (s *ServiceImpl) reviewOf(qq *ent.QuizQuestion) srs.Review {
+	r := srs.Review{
+		Answered:   qq.AnsweredAt != nil,
+		IsCorrect:  qq.IsCorrect != nil && *qq.IsCorrect,
+		NearMiss:   qq.Grade != nil && *qq.Grade == spelling.GradeNearMiss,
+		ReviewedAt: s.clock.Now(),
+	}
+	if qq.TimeMs != nil {
+		r.TimeMs = *qq.TimeMs
+	}
+	if qq.AnsweredAt != nil {
+		r.ReviewedAt = *qq.AnsweredAt
+	}
+	return r
+}
+
+func srsStateOf(rw *ent.RegisteredWord) srs.State {
+	return srs.State{
+		EaseFactor:     rw.EaseFactor,
+		IntervalDays:   rw.IntervalDays,
+		Repetitions:    rw.Repetitions,
+		Lapses:         rw.Lapses,
+		Stability:      rw.Stability,
+		Difficulty:     rw.Difficulty,
+		DueAt:          rw.DueAt,
+		LastReviewedAt: rw.LastReviewedAt,
+	}
+}
+
 // クイズ本体の完了情報を更新
 func (s *ServiceImpl) updateQuizResult(
 	ctx context.Context,
 	tx *ent.Tx,
 	q *ent.Quiz,
+	status string,
+	graded int,
 	correct int,
+	credit float64,
 ) error {
-	rate := float64(correct) * 100 / float64(q.TotalQuestionsCount)
+	rate := 0.0
+	if graded > 0 {
+		rate = credit * 100 / float64(graded)
+	}
 	_, err := tx.Quiz.
 		UpdateOneID(q.ID).
 		SetIsRunning(false).
+		SetFinishStatus(status).
+		SetFinishedAt(s.clock.Now()).
 		SetCorrectCount(correct).
 		SetResultCorrectRate(rate).
 		Save(ctx)
@@ -15,29 +15,20 @@ func (s *ServiceImpl) GetNextOrResume(
 	ctx context.Context,
 	userID int,
 	req *models.GetQuizRequest,
-) (*models.GetQuizResponse, error) {
+) (_ *models.GetQuizResponse, err error) {
 	tx, err := s.client.Tx(ctx)
 	if err != nil {
-		defer func() {
-			if p := recover(); p != nil {
-				logrus.Error(err)
-				_ = tx.Rollback()
-				panic(p)
-			} else if err != nil {
-				logrus.Error(err)
-				_ = tx.Rollback()
-			} else {
-				err = tx.Commit()
-			}
-		}()
+		logrus.Error(err)
 		return nil, err
 	}
+	defer finishTx(&err, tx)
 	var q *ent.Quiz
 
 	q, err = tx.Quiz.
 		Query().
 		Where(quiz.UserID(userID), quiz.IsRunning(true)).
 		Order(ent.Desc(quiz.FieldID)).
+		WithQuizQuestions().
 		First(ctx)
 	if err != nil {
 		return &models.GetQuizResponse{
@@ -45,6 +36,17 @@ func (s *ServiceImpl) GetNextOrResume(
 		}, err
 	}
 
+	// 期限切れ・放置されたクイズは再開させずに終了する
+	expired, err := s.expireIfStale(ctx, tx, q, s.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if expired {
+		return &models.GetQuizResponse{
+			IsRunningQuiz: false,
+		}, nil
+	}
+
 	// questionNumber を決定
 	var targetNum int
 	if req.BeforeQuestionNumber != nil {
@@ -54,7 +56,7 @@ func (s *ServiceImpl) GetNextOrResume(
 			Query().
 			Where(
 				quizquestion.QuizIDEQ(q.ID),
-				quizquestion.AnswerJpmIDIsNil(),
+				quizquestion.AnsweredAtIsNil(),
 			).
 			Order(ent.Asc(quizquestion.FieldQuestionNumber)).
 			First(ctx)
@@ -83,14 +85,13 @@ func (s *ServiceImpl) GetNextOrResume(
 			IsRunningQuiz: false,
 		}, nil
 	}
+	// 再開時は最初に出題した時刻を維持する
+	if qq, err = s.markServed(ctx, tx, qq); err != nil {
+		return nil, err
+	}
 
 	return &models.GetQuizResponse{
 		IsRunningQuiz: true,
-		NextQuestion: models.NextQuestion{
-			QuizID:         q.ID,
-			QuestionNumber: qq.QuestionNumber,
-			WordName:       qq.WordName,
-			ChoicesJpms:    qq.ChoicesJpms,
-		},
+		NextQuestion:  toNextQuestion(q, qq),
 	}, nil
 }
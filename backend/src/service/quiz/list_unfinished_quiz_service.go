@@ -0,0 +1,63 @@
+package quiz
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/src/infrastructure/repoerr"
+	"word_app/backend/src/models"
+)
+
+// ListUnfinished = 中断中（実行中）のクイズ一覧。
+// 期限切れ・放置のクイズはここで終了させ、一覧には含めない。
+func (s *ServiceImpl) ListUnfinished(
+	ctx context.Context,
+	userID int,
+) (_ []models.UnfinishedQuiz, err error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer finishTx(&err, tx)
+
+	rows, err := tx.Quiz.
+		Query().
+		Where(
+			quiz.UserID(userID),
+			quiz.IsRunning(true),
+			quiz.DeletedAtIsNil(),
+		).
+		WithQuizQuestions().
+		Order(ent.Desc(quiz.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, repoerr.FromEnt(err, "failed to fetch running quizzes", "database error")
+	}
+
+	now := s.clock.Now()
+	out := make([]models.UnfinishedQuiz, 0, len(rows))
+	for _, q := range rows {
+		answered, _ := answeredCounts(q.Edges.QuizQuestions)
+		expired, err := s.expireIfStale(ctx, tx, q, now)
+		if err != nil {
+			return nil, err
+		}
+		if expired {
+			continue
+		}
+		out = append(out, models.UnfinishedQuiz{
+			QuizID:              q.ID,
+			QuizNumber:          q.QuizNumber,
+			CreatedAt:           q.CreatedAt,
+			ExpiresAt:           q.ExpiresAt,
+			Mode:                q.Mode,
+			Direction:           q.Direction,
+			QuestionType:        q.QuestionType,
+			Difficulty:          q.Difficulty,
+			TotalQuestionsCount: q.TotalQuestionsCount,
+			AnsweredCount:       answered,
+		})
+	}
+	return out, nil
+}
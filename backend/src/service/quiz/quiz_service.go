@@ -4,8 +4,10 @@ import (
 	"errors"
 
 	"word_app/backend/config"
+	"word_app/backend/ent"
 	"word_app/backend/src/infrastructure/repository/userdailyusage"
 	"word_app/backend/src/interfaces"
+	"word_app/backend/src/models"
 	"word_app/backend/src/usecase/clock"
 )
 
@@ -36,3 +38,26 @@ var (
 	ErrDeleteQuiz      = errors.New("failed to delete word")
 	ErrDatabaseFailure = errors.New("database failure")
 )
+
+// toNextQuestion は出題方向に応じて解答を漏らさない形で問題を返す
+func toNextQuestion(q *ent.Quiz, qq *ent.QuizQuestion) models.NextQuestion {
+	nq := models.NextQuestion{
+		QuizID:         q.ID,
+		QuestionNumber: qq.QuestionNumber,
+		Direction:      qq.Direction,
+		QuestionType:   qq.QuestionType,
+		Deadline:       questionDeadline(q, qq),
+	}
+	if qq.QuestionType == models.QuestionTypeTyping {
+		nq.MeaningName = qq.MeaningName
+		return nq
+	}
+	if qq.Direction == models.QuizDirectionJaToEn {
+		nq.MeaningName = qq.MeaningName
+		nq.ChoicesWords = qq.ChoicesWords
+		return nq
+	}
+	nq.WordName = qq.WordName
+	nq.ChoicesJpms = qq.ChoicesJpms
+	return nq
+}
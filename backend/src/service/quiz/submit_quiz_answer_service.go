@@ -2,12 +2,16 @@ package quiz
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"word_app/backend/ent"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/domain/spelling"
+	"word_app/backend/src/infrastructure/repoerr"
 	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/shared/ucerr"
 )
 
 // SubmitAnswerAndRoute = 回答を保存し、次の分岐を判断
@@ -32,22 +36,43 @@ func (s *ServiceImpl) SubmitAnswerAndRoute(
 		WithQuiz().
 		Only(ctx)
 	if err != nil {
-		return nil, err
+		return nil, repoerr.FromEnt(err, "question not found", "database error")
 	}
 
-	isCorrect := qq.CorrectJpmID == in.AnswerJpmID
-	elapsedMs := int(time.Since(qq.CreatedAt).Milliseconds())
+	q := qq.Edges.Quiz
+	// 回答は 1 問 1 回。再送には保存済みの結果を返す（上書き・二重集計しない）
+	if qq.AnsweredAt != nil {
+		return s.replayAnswer(ctx, tx, q, qq, in)
+	}
+	if !q.IsRunning {
+		// 中断・期限切れで終了したクイズには回答できない
+		return nil, ucerr.Conflict("quiz is already finished")
+	}
+	if err = ensureInOrder(ctx, tx, qq); err != nil {
+		return nil, err
+	}
+	now := s.clock.Now()
 
 	// ② 回答を保存
-	if _, err = tx.QuizQuestion.
-		UpdateOneID(qq.ID).
-		SetAnswerJpmID(in.AnswerJpmID).
-		SetIsCorrect(isCorrect).
-		SetAnsweredAt(time.Now()).
-		SetTimeMs(elapsedMs).
-		Save(ctx); err != nil {
+	graded, err := s.saveAnswer(ctx, tx, q, qq, in, now)
+	if err != nil {
 		return nil, err
 	}
+	isCorrect := graded.Grade == spelling.GradeCorrect
+
+	// クイズ全体の期限切れなら残りを timeout にして終了
+	if isLate(q.ExpiresAt, now) {
+		if err = s.expireQuizTx(ctx, tx, q); err != nil {
+			return nil, err
+		}
+		res = &models.AnswerRouteRes{
+			IsFinish:   true,
+			IsCorrect:  isCorrect,
+			Grade:      graded.Grade,
+			QuizNumber: q.QuizNumber,
+		}
+		return
+	}
 
 	// ③ 次問題があるか？
 	nextQQ, errN := tx.QuizQuestion.
@@ -58,14 +83,15 @@ func (s *ServiceImpl) SubmitAnswerAndRoute(
 		).
 		Only(ctx)
 	if ent.IsNotFound(errN) {
-		if finishedErr := s.finishQuizTx(ctx, tx, qq.Edges.Quiz, userID); finishedErr != nil {
+		if finishedErr := s.finishQuizTx(ctx, tx, q, userID, finishCompleted); finishedErr != nil {
 			err = finishedErr
 			return nil, err
 		}
 		res = &models.AnswerRouteRes{
 			IsFinish:   true,
 			IsCorrect:  isCorrect,
-			QuizNumber: qq.Edges.Quiz.QuizNumber,
+			Grade:      graded.Grade,
+			QuizNumber: q.QuizNumber,
 		}
 		return
 	} else if errN != nil {
@@ -73,15 +99,147 @@ func (s *ServiceImpl) SubmitAnswerAndRoute(
 		return nil, err
 	}
 	// ---------- 次の問題あり ----------
+	if nextQQ, err = s.markServed(ctx, tx, nextQQ); err != nil {
+		return nil, err
+	}
 	res = &models.AnswerRouteRes{
-		IsFinish: false,
-		NextQuestion: models.NextQuestion{
-			QuizID:         in.QuizID,
-			QuestionNumber: nextQQ.QuestionNumber,
-			WordName:       nextQQ.WordName,
-			ChoicesJpms:    nextQQ.ChoicesJpms,
-		},
-		IsCorrect: isCorrect,
+		IsFinish:     false,
+		NextQuestion: toNextQuestion(q, nextQQ),
+		IsCorrect:    isCorrect,
+		Grade:        graded.Grade,
 	}
 	return
 }
+
+// saveAnswer は回答を採点して保存する（出題方向で回答の種類が異なる）。
+// 既に回答済みなら Conflict。
+func (s *ServiceImpl) saveAnswer(
+	ctx context.Context,
+	tx *ent.Tx,
+	q *ent.Quiz,
+	qq *ent.QuizQuestion,
+	in *models.PostAnswerQuestionRequest,
+	now time.Time,
+) (spelling.Result, error) {
+	// 問題行はクイズ作成時にまとめて作るため、経過時間は実際の出題時刻から測る
+	elapsedMs := int(now.Sub(servedAt(qq)).Milliseconds())
+
+	upd := tx.QuizQuestion.
+		Update().
+		Where(
+			quizquestion.ID(qq.ID),
+			quizquestion.AnsweredAtIsNil(), // 同時送信でも先に保存した 1 件だけ有効
+		).
+		SetAnsweredAt(now).
+		SetTimeMs(elapsedMs)
+	if in.IdempotencyKey != "" {
+		upd.SetIdempotencyKey(in.IdempotencyKey)
+	}
+	var graded spelling.Result
+	switch {
+	case qq.QuestionType == models.QuestionTypeTyping:
+		graded = spelling.Grade(in.TypedAnswer, qq.WordName)
+		upd.SetTypedAnswer(in.TypedAnswer)
+	case qq.Direction == models.QuizDirectionJaToEn:
+		graded = choiceResult(qq.WordID == in.AnswerWordID)
+		upd.SetAnswerWordID(in.AnswerWordID)
+	default:
+		graded = choiceResult(qq.CorrectJpmID == in.AnswerJpmID)
+		upd.SetAnswerJpmID(in.AnswerJpmID)
+	}
+	if isLate(questionDeadline(q, qq), now) {
+		// 回答内容は残すが採点は時間切れ
+		graded = spelling.Result{Grade: models.QuestionGradeTimeout, Credit: 0}
+	}
+	saved, err := upd.
+		SetIsCorrect(graded.Grade == spelling.GradeCorrect).
+		SetGrade(graded.Grade).
+		SetCredit(graded.Credit).
+		Save(ctx)
+	if err != nil {
+		return spelling.Result{}, err
+	}
+	if saved == 0 {
+		return spelling.Result{}, ucerr.Conflict("question is already answered")
+	}
+	return graded, nil
+}
+
+// ensureInOrder は前の問題が未回答のまま先の問題に回答していないか確認する
+func ensureInOrder(ctx context.Context, tx *ent.Tx, qq *ent.QuizQuestion) error {
+	skipped, err := tx.QuizQuestion.
+		Query().
+		Where(
+			quizquestion.QuizIDEQ(qq.QuizID),
+			quizquestion.QuestionNumberLT(qq.QuestionNumber),
+			quizquestion.AnsweredAtIsNil(),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if skipped {
+		return ucerr.Conflict(fmt.Sprintf("question %d is out of order: answer the previous questions first", qq.QuestionNumber))
+	}
+	return nil
+}
+
+// replayAnswer は回答済みの問題への再送に、最初の回答と同じ応答を返す。
+// Idempotency-Key があればキーで、なければ回答内容で同じ送信かを判定する。
+func (s *ServiceImpl) replayAnswer(
+	ctx context.Context,
+	tx *ent.Tx,
+	q *ent.Quiz,
+	qq *ent.QuizQuestion,
+	in *models.PostAnswerQuestionRequest,
+) (*models.AnswerRouteRes, error) {
+	if !isSameSubmission(qq, in) {
+		return nil, ucerr.Conflict("question is already answered")
+	}
+	res := &models.AnswerRouteRes{
+		IsCorrect: qq.IsCorrect != nil && *qq.IsCorrect,
+		Replayed:  true,
+	}
+	if qq.Grade != nil {
+		res.Grade = *qq.Grade
+	}
+	if !q.IsRunning {
+		res.IsFinish = true
+		res.QuizNumber = q.QuizNumber
+		return res, nil
+	}
+	nextQQ, err := tx.QuizQuestion.
+		Query().
+		Where(
+			quizquestion.QuizIDEQ(q.ID),
+			quizquestion.QuestionNumber(qq.QuestionNumber+1),
+		).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res.NextQuestion = toNextQuestion(q, nextQQ)
+	return res, nil
+}
+
+func isSameSubmission(qq *ent.QuizQuestion, in *models.PostAnswerQuestionRequest) bool {
+	if in.IdempotencyKey != "" {
+		return qq.IdempotencyKey != nil && *qq.IdempotencyKey == in.IdempotencyKey
+	}
+	switch {
+	case qq.QuestionType == models.QuestionTypeTyping:
+		return qq.TypedAnswer != nil && *qq.TypedAnswer == in.TypedAnswer
+	case qq.Direction == models.QuizDirectionJaToEn:
+		return qq.AnswerWordID != nil && *qq.AnswerWordID == in.AnswerWordID
+	default:
+		return qq.AnswerJpmID != nil && *qq.AnswerJpmID == in.AnswerJpmID
+	}
+}
+
+// choiceResult は選択式の正誤を採点結果に変換する（部分点なし）
+func choiceResult(ok bool) spelling.Result {
+	if ok {
+		return spelling.Result{Grade: spelling.GradeCorrect, Credit: spelling.CreditCorrect}
+	}
+	return spelling.Result{Grade: spelling.GradeWrong, Credit: spelling.CreditWrong}
+}
@@ -0,0 +1,137 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/registeredword"
+	"word_app/backend/src/models"
+	quizSvc "word_app/backend/src/service/quiz"
+	"word_app/backend/src/usecase/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelQuiz(t *testing.T) {
+	ctx := context.Background()
+
+	// 3 問のクイズを作り、先頭 answered 問に正解しておく
+	setup := func(t *testing.T, answered int) (*ent.Client, *quizSvc.ServiceImpl, *fixedClock, int, int) {
+		cli, svc, clk := newService(t)
+		u := seedUser(t, cli)
+		seedWords(t, cli, 6)
+		res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+			QuestionCount:   3,
+			IsSaveResult:    true,
+			PartsOfSpeeches: []int{testPosID},
+		})
+		require.NoError(t, err)
+		for n := 1; n <= answered; n++ {
+			qq := cli.QuizQuestion.Query().
+				Where(quizquestion.QuizID(res.QuizID), quizquestion.QuestionNumber(n)).
+				OnlyX(ctx)
+			_, err := svc.SubmitAnswerAndRoute(ctx, u.ID, &models.PostAnswerQuestionRequest{
+				QuizID:         res.QuizID,
+				QuestionNumber: n,
+				AnswerJpmID:    qq.CorrectJpmID,
+			})
+			require.NoError(t, err)
+		}
+		return cli, svc, clk, u.ID, res.QuizID
+	}
+
+	t.Run("回答済みの問題だけ採点して中断する", func(t *testing.T) {
+		cli, svc, _, userID, quizID := setup(t, 1)
+
+		out, err := svc.CancelQuiz(ctx, userID, quizID, &models.CancelQuizReq{GradeAnswered: true})
+		require.NoError(t, err)
+		assert.Equal(t, models.QuizStatusCancelled, out.Status)
+		assert.Equal(t, 1, out.AnsweredCount)
+		assert.Equal(t, 1, out.CorrectCount)
+
+		q := cli.Quiz.Query().Where(quiz.ID(quizID)).OnlyX(ctx)
+		assert.False(t, q.IsRunning)
+		require.NotNil(t, q.FinishStatus)
+		assert.Equal(t, models.QuizStatusCancelled, *q.FinishStatus)
+		assert.NotNil(t, q.FinishedAt)
+		assert.Equal(t, 1, q.CorrectCount)
+		assert.InDelta(t, 100.0, q.ResultCorrectRate, 0.001, "回答済み 1 問中 1 問正解")
+
+		assert.Equal(t, 1, cli.RegisteredWord.Query().Where(registeredword.UserID(userID)).CountX(ctx),
+			"未回答の単語は学習記録に入れない")
+
+		// 新しいクイズを始められる
+		_, err = svc.CreateQuiz(ctx, userID, &models.CreateQuizReq{
+			QuestionCount:   3,
+			PartsOfSpeeches: []int{testPosID},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("採点しない中断は学習記録を変えない", func(t *testing.T) {
+		cli, svc, _, userID, quizID := setup(t, 2)
+
+		out, err := svc.CancelQuiz(ctx, userID, quizID, &models.CancelQuizReq{})
+		require.NoError(t, err)
+		assert.Equal(t, models.QuizStatusCancelled, out.Status)
+		assert.Equal(t, 2, out.AnsweredCount)
+
+		q := cli.Quiz.Query().Where(quiz.ID(quizID)).OnlyX(ctx)
+		assert.False(t, q.IsRunning)
+		assert.Equal(t, models.QuizStatusCancelled, *q.FinishStatus)
+		assert.Zero(t, cli.RegisteredWord.Query().Where(registeredword.UserID(userID)).CountX(ctx))
+	})
+
+	t.Run("終了済みのクイズは中断・回答できない", func(t *testing.T) {
+		_, svc, _, userID, quizID := setup(t, 0)
+		_, err := svc.CancelQuiz(ctx, userID, quizID, &models.CancelQuizReq{})
+		require.NoError(t, err)
+
+		_, err = svc.CancelQuiz(ctx, userID, quizID, &models.CancelQuizReq{})
+		assert.True(t, apperror.IsKind(err, apperror.Conflict))
+
+		_, err = svc.SubmitAnswerAndRoute(ctx, userID, &models.PostAnswerQuestionRequest{
+			QuizID:         quizID,
+			QuestionNumber: 1,
+		})
+		assert.True(t, apperror.IsKind(err, apperror.Conflict))
+	})
+
+	t.Run("他人のクイズは見つからない", func(t *testing.T) {
+		_, svc, _, userID, quizID := setup(t, 0)
+		_, err := svc.CancelQuiz(ctx, userID+1, quizID, &models.CancelQuizReq{})
+		assert.True(t, apperror.IsKind(err, apperror.NotFound))
+	})
+
+	t.Run("全問回答したクイズは completed", func(t *testing.T) {
+		cli, _, _, _, quizID := setup(t, 3)
+		q := cli.Quiz.Query().Where(quiz.ID(quizID)).OnlyX(ctx)
+		assert.False(t, q.IsRunning)
+		assert.Equal(t, models.QuizStatusCompleted, *q.FinishStatus)
+	})
+
+	t.Run("未完了一覧は期限切れのクイズを終了させて除外する", func(t *testing.T) {
+		cli, svc, clk, userID, quizID := setup(t, 1)
+
+		list, err := svc.ListUnfinished(ctx, userID)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, quizID, list[0].QuizID)
+		assert.Equal(t, 3, list[0].TotalQuestionsCount)
+		assert.Equal(t, 1, list[0].AnsweredCount)
+
+		clk.now = clk.now.Add(25 * time.Hour)
+		list, err = svc.ListUnfinished(ctx, userID)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+
+		q := cli.Quiz.Query().Where(quiz.ID(quizID)).OnlyX(ctx)
+		assert.False(t, q.IsRunning)
+		assert.Equal(t, models.QuizStatusExpired, *q.FinishStatus)
+	})
+}
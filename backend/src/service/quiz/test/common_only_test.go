@@ -0,0 +1,43 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateQuiz_CommonOnly(t *testing.T) {
+	ctx := context.Background()
+	cli, svc, _ := newService(t)
+	u := seedUser(t, cli)
+	words := seedWords(t, cli, 25)
+	// 先頭 10 語だけ common。rank 2 と辞書由来でない（NULL）単語は出さない
+	common := map[int]bool{}
+	for i, w := range words {
+		switch {
+		case i < 10:
+			cli.Word.UpdateOne(w).SetFrequencyRank(1).ExecX(ctx)
+			common[w.ID] = true
+		case i < 20:
+			cli.Word.UpdateOne(w).SetFrequencyRank(2).ExecX(ctx)
+		}
+	}
+
+	res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+		QuestionCount:   10,
+		PartsOfSpeeches: []int{testPosID},
+		CommonOnly:      true,
+	})
+	require.NoError(t, err)
+
+	got := cli.QuizQuestion.Query().Where(quizquestion.QuizID(res.QuizID)).AllX(ctx)
+	require.Len(t, got, 10)
+	for _, qq := range got {
+		assert.True(t, common[qq.WordID], "common でない単語 %d が出題された", qq.WordID)
+	}
+}
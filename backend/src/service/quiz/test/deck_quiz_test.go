@@ -0,0 +1,82 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/deck"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateQuiz_FromDeck(t *testing.T) {
+	ctx := context.Background()
+
+	newDeck := func(cli *ent.Client, userID int, vis deck.Visibility, words []*ent.Word) *ent.Deck {
+		d := cli.Deck.Create().SetUserID(userID).SetName("deck").SetVisibility(vis).SaveX(ctx)
+		for i, w := range words {
+			cli.DeckWord.Create().SetDeckID(d.ID).SetWordID(w.ID).SetPosition(i).SaveX(ctx)
+		}
+		return d
+	}
+	req := func(deckID int) *models.CreateQuizReq {
+		return &models.CreateQuizReq{QuestionCount: 10, PartsOfSpeeches: []int{testPosID}, DeckID: deckID}
+	}
+
+	t.Run("デッキの単語だけから出題し、出題元を記録する", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		words := seedWords(t, cli, 15)
+		d := newDeck(cli, u.ID, deck.VisibilityPrivate, words[:10])
+
+		res, err := svc.CreateQuiz(ctx, u.ID, req(d.ID))
+		require.NoError(t, err)
+
+		got := cli.QuizQuestion.Query().Where(quizquestion.QuizID(res.QuizID)).AllX(ctx)
+		require.Len(t, got, 10)
+		inDeck := map[int]bool{}
+		for _, w := range words[:10] {
+			inDeck[w.ID] = true
+		}
+		for _, qq := range got {
+			assert.True(t, inDeck[qq.WordID], "デッキ外の単語 %d が出題された", qq.WordID)
+		}
+		q := cli.Quiz.GetX(ctx, res.QuizID)
+		require.NotNil(t, q.DeckID)
+		assert.Equal(t, d.ID, *q.DeckID)
+	})
+
+	t.Run("他人の公開デッキからも出題できる", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		owner, u := seedUser(t, cli), seedUser(t, cli)
+		d := newDeck(cli, owner.ID, deck.VisibilityPublic, seedWords(t, cli, 10))
+
+		_, err := svc.CreateQuiz(ctx, u.ID, req(d.ID))
+		assert.NoError(t, err)
+	})
+
+	t.Run("他人の非公開・リンク共有デッキは NotFound", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		owner, u := seedUser(t, cli), seedUser(t, cli)
+		words := seedWords(t, cli, 10)
+		for _, vis := range []deck.Visibility{deck.VisibilityPrivate, deck.VisibilityLink} {
+			d := newDeck(cli, owner.ID, vis, words)
+			_, err := svc.CreateQuiz(ctx, u.ID, req(d.ID))
+			assert.True(t, apperror.IsKind(err, apperror.NotFound), "%s: %v", vis, err)
+		}
+	})
+
+	t.Run("デッキの単語が足りなければ BadRequest", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		d := newDeck(cli, u.ID, deck.VisibilityPrivate, seedWords(t, cli, 12)[:5])
+
+		_, err := svc.CreateQuiz(ctx, u.ID, req(d.ID))
+		assert.True(t, apperror.IsKind(err, apperror.BadRequest), "%v", err)
+	})
+}
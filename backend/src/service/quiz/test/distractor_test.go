@@ -0,0 +1,229 @@
+package quiz_service_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/ent/japanesemean"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/word"
+	"word_app/backend/src/models"
+	quizSvc "word_app/backend/src/service/quiz"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistractorStrategy(t *testing.T) {
+	ctx := context.Background()
+
+	type fixture struct {
+		cli   *ent.Client
+		user  *ent.User
+		words []*ent.Word
+	}
+	setup := func(t *testing.T) fixture {
+		cli, _, _ := newService(t)
+		u := seedUser(t, cli)
+		words := seedWords(t, cli, 8)
+
+		// word0 に別の意味を足し、word1 にも word0 と同名の意味（同義語）を持たせる
+		wi0 := words[0].QueryWordInfos().OnlyX(ctx)
+		cli.JapaneseMean.Create().SetWordInfoID(wi0.ID).SetName("別の意味").SaveX(ctx)
+		wi1 := words[1].QueryWordInfos().OnlyX(ctx)
+		cli.JapaneseMean.Create().SetWordInfoID(wi1.ID).SetName(jpName(0)).SaveX(ctx)
+		return fixture{cli: cli, user: u, words: words}
+	}
+
+	inputFor := func(t *testing.T, f fixture, w *ent.Word) quizSvc.DistractorInput {
+		loaded := f.cli.Word.Query().
+			Where(word.ID(w.ID)).
+			WithWordInfos(func(q *ent.WordInfoQuery) { q.WithJapaneseMeans() }).
+			OnlyX(ctx)
+		wi := loaded.Edges.WordInfos[0]
+		return quizSvc.DistractorInput{
+			Word:     loaded,
+			WordInfo: wi,
+			Correct:  wi.Edges.JapaneseMeans[0],
+			Count:    3,
+		}
+	}
+
+	pick := func(t *testing.T, f fixture, difficulty string, in quizSvc.DistractorInput) []*ent.JapaneseMean {
+		tx, err := f.cli.Tx(ctx)
+		require.NoError(t, err)
+		defer func() { _ = tx.Rollback() }()
+		strategy := quizSvc.NewDistractorStrategy(difficulty)
+		pool, err := strategy.Load(ctx, tx, f.user.ID, []quizSvc.DistractorInput{in})
+		require.NoError(t, err)
+		return strategy.Pick(pool, in)
+	}
+
+	meanOf := func(f fixture, w *ent.Word) *ent.JapaneseMean {
+		return f.cli.JapaneseMean.Query().
+			Where(japanesemean.Name(jpName(indexOf(f.words, w)))).
+			FirstX(ctx)
+	}
+
+	t.Run("同じ単語の意味と同名の意味は除外する", func(t *testing.T) {
+		f := setup(t)
+		in := inputFor(t, f, f.words[0])
+		for _, d := range []string{models.QuizDifficultyEasy, models.QuizDifficultyNormal, models.QuizDifficultyHard} {
+			for range 10 {
+				got := pick(t, f, d, in)
+				assert.Len(t, got, 3)
+				for _, jm := range got {
+					assert.NotEqual(t, "別の意味", jm.Name, d)
+					assert.NotEqual(t, jpName(0), jm.Name, d)
+				}
+			}
+		}
+	})
+
+	t.Run("混同履歴の意味を優先する", func(t *testing.T) {
+		f := setup(t)
+		confused := []*ent.JapaneseMean{meanOf(f, f.words[5]), meanOf(f, f.words[6])}
+
+		// 過去のクイズで word0 に対し word5 の意味を 2 回、word6 の意味を 1 回選んだ
+		past := f.cli.Quiz.Create().
+			SetUserID(f.user.ID).
+			SetQuizNumber(1).
+			SetAttentionLevelList([]int{}).
+			SetChoicesPosIds([]int{testPosID}).
+			SaveX(ctx)
+		correct := meanOf(f, f.words[0])
+		for i, jm := range []*ent.JapaneseMean{confused[0], confused[0], confused[1]} {
+			f.cli.QuizQuestion.Create().
+				SetQuizID(past.ID).
+				SetQuestionNumber(i + 1).
+				SetWordID(f.words[0].ID).
+				SetWordName(f.words[0].Name).
+				SetPosID(testPosID).
+				SetCorrectJpmID(correct.ID).
+				SetChoicesJpms([]models.ChoiceJpm{}).
+				SetAnswerJpmID(jm.ID).
+				SetIsCorrect(false).
+				SaveX(ctx)
+		}
+
+		in := inputFor(t, f, f.words[0])
+
+		got := pick(t, f, models.QuizDifficultyHard, in)
+		require.Len(t, got, 3)
+		assert.Equal(t, confused[0].ID, got[0].ID, "多く混同した順")
+		assert.Equal(t, confused[1].ID, got[1].ID)
+
+		got = pick(t, f, models.QuizDifficultyNormal, in)
+		require.Len(t, got, 3)
+		assert.Equal(t, confused[0].ID, got[0].ID, "normal は 1 件だけ")
+
+		// 逆方向: word5 の問題では word0 の意味を混同した履歴として扱う
+		got = pick(t, f, models.QuizDifficultyHard, inputFor(t, f, f.words[5]))
+		require.NotEmpty(t, got)
+		assert.Equal(t, correct.ID, got[0].ID)
+	})
+
+	t.Run("easy は他品詞から選び、足りなければ同品詞で補う", func(t *testing.T) {
+		f := setup(t)
+		pos2 := f.cli.PartOfSpeech.Create().SetName("動詞").SaveX(ctx)
+		for i, name := range []string{"走る", "歩く"} {
+			w := f.cli.Word.Create().SetName([]string{"run", "walk"}[i]).SaveX(ctx)
+			wi := f.cli.WordInfo.Create().SetWordID(w.ID).SetPartOfSpeechID(pos2.ID).SaveX(ctx)
+			f.cli.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName(name).SaveX(ctx)
+		}
+
+		got := pick(t, f, models.QuizDifficultyEasy, inputFor(t, f, f.words[2]))
+		require.Len(t, got, 3)
+		names := []string{got[0].Name, got[1].Name, got[2].Name}
+		assert.Contains(t, names, "走る")
+		assert.Contains(t, names, "歩く")
+	})
+
+	t.Run("クイズに難易度を保存する", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		seedWords(t, cli, 5)
+
+		res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+			QuestionCount:   2,
+			PartsOfSpeeches: []int{testPosID},
+			Difficulty:      models.QuizDifficultyHard,
+		})
+		require.NoError(t, err)
+		q := cli.Quiz.Query().Where(quiz.ID(res.QuizID)).OnlyX(ctx)
+		assert.Equal(t, models.QuizDifficultyHard, q.Difficulty)
+		assert.Len(t, res.NextQuestion.ChoicesJpms, 4)
+	})
+}
+
+func indexOf(words []*ent.Word, w *ent.Word) int {
+	for i, x := range words {
+		if x.ID == w.ID {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestCreateQuiz_BoundedQueries(t *testing.T) {
+	ctx := context.Background()
+
+	// 問題数を変えても誤答取得と問題作成のクエリ数は変わらない
+	queriesFor := func(t *testing.T, questions int, difficulty string) (means, inserts int) {
+		var (
+			mu   sync.Mutex
+			logs []string
+		)
+		cli, svc, _ := newService(t, enttest.WithOptions(
+			ent.Log(func(v ...any) {
+				mu.Lock()
+				defer mu.Unlock()
+				logs = append(logs, fmt.Sprint(v...))
+			}),
+			ent.Debug(),
+		))
+		u := seedUser(t, cli)
+		seedWords(t, cli, questions+5)
+
+		mu.Lock()
+		logs = nil
+		mu.Unlock()
+		_, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+			QuestionCount:   questions,
+			PartsOfSpeeches: []int{testPosID},
+			Difficulty:      difficulty,
+		})
+		require.NoError(t, err)
+
+		for _, l := range logs {
+			if strings.Contains(l, "FROM `japanese_means`") {
+				means++
+			}
+			if strings.Contains(l, "INSERT INTO `quiz_questions`") {
+				inserts++
+			}
+		}
+		return means, inserts
+	}
+
+	for _, d := range []string{models.QuizDifficultyEasy, models.QuizDifficultyHard} {
+		t.Run(d, func(t *testing.T) {
+			counts := map[int][2]int{}
+			for _, n := range []int{5, 40} {
+				t.Run(fmt.Sprint(n), func(t *testing.T) {
+					means, inserts := queriesFor(t, n, d)
+					counts[n] = [2]int{means, inserts}
+				})
+			}
+			assert.Positive(t, counts[5][0])
+			assert.Equal(t, counts[5][0], counts[40][0], "japanese_means のクエリ数")
+			assert.Equal(t, 1, counts[5][1], "quiz_questions は一括 INSERT")
+			assert.Equal(t, 1, counts[40][1])
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateQuiz_DueMode(t *testing.T) {
+	ctx := context.Background()
+
+	setup := func(t *testing.T) (*ent.Client, func(req *models.CreateQuizReq) (*models.CreateQuizResponse, error), []*ent.Word) {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		words := seedWords(t, cli, 12)
+
+		// word0: 3日超過 / word1: 1日超過 / word2: 2日超過 / word3: 未来
+		for i, days := range map[int]int{0: -3, 1: -1, 2: -2, 3: 5} {
+			cli.RegisteredWord.Create().
+				SetUserID(u.ID).
+				SetWordID(words[i].ID).
+				SetIntervalDays(1).
+				SetDueAt(baseTime.AddDate(0, 0, days)).
+				SaveX(ctx)
+		}
+		return cli, func(req *models.CreateQuizReq) (*models.CreateQuizResponse, error) {
+			return svc.CreateQuiz(ctx, u.ID, req)
+		}, words
+	}
+
+	questionWords := func(t *testing.T, cli *ent.Client, quizID int) []int {
+		qqs := cli.QuizQuestion.Query().
+			Where(quizquestion.QuizID(quizID)).
+			Order(ent.Asc(quizquestion.FieldQuestionNumber)).
+			AllX(ctx)
+		ids := make([]int, 0, len(qqs))
+		for _, qq := range qqs {
+			ids = append(ids, qq.WordID)
+		}
+		return ids
+	}
+
+	t.Run("期限超過の大きい順に出題し、残件数を返す", func(t *testing.T) {
+		cli, create, words := setup(t)
+
+		res, err := create(&models.CreateQuizReq{
+			QuestionCount:   2,
+			PartsOfSpeeches: []int{testPosID},
+			Mode:            models.QuizModeDue,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, res.DueRemaining)
+		assert.Equal(t, []int{words[0].ID, words[2].ID}, questionWords(t, cli, res.QuizID))
+
+		q := cli.Quiz.GetX(ctx, res.QuizID)
+		assert.Equal(t, models.QuizModeDue, q.Mode)
+	})
+
+	t.Run("足りない分は未スケジュールの単語で補う", func(t *testing.T) {
+		cli, create, words := setup(t)
+
+		res, err := create(&models.CreateQuizReq{
+			QuestionCount:   10,
+			PartsOfSpeeches: []int{testPosID},
+			Mode:            models.QuizModeDue,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.DueRemaining)
+
+		got := questionWords(t, cli, res.QuizID)
+		require.Len(t, got, 10)
+		assert.Equal(t, []int{words[0].ID, words[2].ID, words[1].ID}, got[:3])
+		assert.NotContains(t, got, words[3].ID, "期限前の単語は出題しない")
+	})
+
+	t.Run("登録を外した単語は期限切れでも出題しない", func(t *testing.T) {
+		cli, create, words := setup(t)
+		// 最も期限超過が大きいが、登録は無効
+		u := cli.RegisteredWord.Query().FirstX(ctx).UserID
+		cli.RegisteredWord.Create().
+			SetUserID(u).
+			SetWordID(words[4].ID).
+			SetIntervalDays(1).
+			SetDueAt(baseTime.AddDate(0, 0, -10)).
+			SetIsActive(false).
+			SaveX(ctx)
+
+		res, err := create(&models.CreateQuizReq{
+			QuestionCount:   3,
+			PartsOfSpeeches: []int{testPosID},
+			Mode:            models.QuizModeDue,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.DueRemaining)
+		assert.Equal(t, []int{words[0].ID, words[2].ID, words[1].ID}, questionWords(t, cli, res.QuizID))
+	})
+
+	t.Run("候補不足は BadRequest", func(t *testing.T) {
+		_, create, _ := setup(t)
+
+		_, err := create(&models.CreateQuizReq{
+			QuestionCount:   12,
+			PartsOfSpeeches: []int{testPosID},
+			Mode:            models.QuizModeDue,
+		})
+		assert.Error(t, err)
+	})
+}
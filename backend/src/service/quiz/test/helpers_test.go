@@ -0,0 +1,80 @@
+package quiz_service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"word_app/backend/config"
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/infrastructure"
+	udumock "word_app/backend/src/mocks/infrastructure/repository/userdailyusage"
+	quizSvc "word_app/backend/src/service/quiz"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c *fixedClock) Now() time.Time { return c.now }
+
+var baseTime = time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)
+
+const testPosID = 1
+
+// newService は SQLite(in-memory) 上に QuizService を組み立てる。
+func newService(t *testing.T, opts ...enttest.Option) (*ent.Client, *quizSvc.ServiceImpl, *fixedClock) {
+	t.Helper()
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()), opts...)
+	t.Cleanup(func() { _ = cli.Close() })
+
+	udu := udumock.NewMockRepository(t)
+	udu.On("IncQuizOr429", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
+
+	clk := &fixedClock{now: baseTime}
+	svc := quizSvc.NewService(
+		infrastructure.NewAppClient(cli),
+		udu,
+		clk,
+		&config.LimitsCfg{QuizMaxQuestions: 100, QuizMaxPerDay: 20},
+	)
+	return cli, svc, clk
+}
+
+func seedUser(t *testing.T, cli *ent.Client) *ent.User {
+	t.Helper()
+	u, err := cli.User.Create().
+		SetEmail(fmt.Sprintf("%d@example.com", time.Now().UnixNano())).
+		SetName("quiz user").
+		SetPassword("Password123$").
+		Save(context.Background())
+	require.NoError(t, err)
+	return u
+}
+
+// seedWords は 1 単語 1 品詞 1 意味の単語を n 件作る。
+func seedWords(t *testing.T, cli *ent.Client, n int) []*ent.Word {
+	t.Helper()
+	ctx := context.Background()
+	if ok, _ := cli.PartOfSpeech.Query().Exist(ctx); !ok {
+		cli.PartOfSpeech.Create().SetName("名詞").SaveX(ctx)
+	}
+	words := make([]*ent.Word, 0, n)
+	for i := 0; i < n; i++ {
+		w := cli.Word.Create().SetName(fmt.Sprintf("word%d", i)).SaveX(ctx)
+		wi := cli.WordInfo.Create().SetWordID(w.ID).SetPartOfSpeechID(testPosID).SaveX(ctx)
+		cli.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName(jpName(i)).SaveX(ctx)
+		words = append(words, w)
+	}
+	return words
+}
+
+// jpName は ASCII を含まない一意な意味文字列を返す。
+func jpName(i int) string {
+	return "意味" + string(rune('あ'+i/80)) + string(rune('ア'+i%80))
+}
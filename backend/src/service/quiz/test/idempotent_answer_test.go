@@ -0,0 +1,120 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/registeredword"
+	"word_app/backend/src/models"
+	quizSvc "word_app/backend/src/service/quiz"
+	"word_app/backend/src/usecase/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitAnswer_Idempotent(t *testing.T) {
+	ctx := context.Background()
+
+	type fixture struct {
+		cli    *ent.Client
+		svc    *quizSvc.ServiceImpl
+		userID int
+		quizID int
+	}
+	setup := func(t *testing.T) fixture {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		seedWords(t, cli, 5)
+		res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+			QuestionCount:   2,
+			IsSaveResult:    true,
+			PartsOfSpeeches: []int{testPosID},
+		})
+		require.NoError(t, err)
+		return fixture{cli: cli, svc: svc, userID: u.ID, quizID: res.QuizID}
+	}
+	qqOf := func(f fixture, n int) *ent.QuizQuestion {
+		return f.cli.QuizQuestion.Query().
+			Where(quizquestion.QuizID(f.quizID), quizquestion.QuestionNumber(n)).
+			OnlyX(ctx)
+	}
+	submit := func(f fixture, n, jpmID int, key string) (*models.AnswerRouteRes, error) {
+		return f.svc.SubmitAnswerAndRoute(ctx, f.userID, &models.PostAnswerQuestionRequest{
+			QuizID:         f.quizID,
+			QuestionNumber: n,
+			AnswerJpmID:    jpmID,
+			IdempotencyKey: key,
+		})
+	}
+	wrongOf := func(qq *ent.QuizQuestion) int {
+		for _, c := range qq.ChoicesJpms {
+			if c.JapaneseMeanID != qq.CorrectJpmID {
+				return c.JapaneseMeanID
+			}
+		}
+		return 0
+	}
+
+	t.Run("最終問題の再送で二重集計しない", func(t *testing.T) {
+		f := setup(t)
+		_, err := submit(f, 1, qqOf(f, 1).CorrectJpmID, "k1")
+		require.NoError(t, err)
+		first, err := submit(f, 2, qqOf(f, 2).CorrectJpmID, "k2")
+		require.NoError(t, err)
+		require.True(t, first.IsFinish)
+		assert.False(t, first.Replayed)
+
+		again, err := submit(f, 2, qqOf(f, 2).CorrectJpmID, "k2")
+		require.NoError(t, err)
+		assert.True(t, again.Replayed)
+		again.Replayed = false
+		assert.Equal(t, first, again, "同じ応答を返す")
+
+		rw := f.cli.RegisteredWord.Query().
+			Where(registeredword.UserID(f.userID), registeredword.WordID(qqOf(f, 2).WordID)).
+			OnlyX(ctx)
+		assert.Equal(t, 1, rw.QuizCount)
+	})
+
+	t.Run("途中の問題の再送は同じ次の問題を返す", func(t *testing.T) {
+		f := setup(t)
+		first, err := submit(f, 1, wrongOf(qqOf(f, 1)), "")
+		require.NoError(t, err)
+		require.False(t, first.IsFinish)
+
+		again, err := submit(f, 1, wrongOf(qqOf(f, 1)), "")
+		require.NoError(t, err)
+		assert.True(t, again.Replayed)
+		assert.False(t, again.IsCorrect)
+		assert.Equal(t, first.NextQuestion, again.NextQuestion)
+	})
+
+	t.Run("回答済みの問題は上書きできない", func(t *testing.T) {
+		f := setup(t)
+		wrong := wrongOf(qqOf(f, 1))
+		_, err := submit(f, 1, wrong, "k1")
+		require.NoError(t, err)
+
+		_, err = submit(f, 1, qqOf(f, 1).CorrectJpmID, "k1-retry")
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "別のキー")
+		_, err = submit(f, 1, qqOf(f, 1).CorrectJpmID, "")
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "キーなしで別の回答")
+
+		qq := qqOf(f, 1)
+		assert.Equal(t, wrong, *qq.AnswerJpmID)
+		assert.False(t, *qq.IsCorrect)
+		assert.Equal(t, "k1", *qq.IdempotencyKey)
+	})
+
+	t.Run("順番を飛ばした回答は拒否する", func(t *testing.T) {
+		f := setup(t)
+		_, err := submit(f, 2, qqOf(f, 2).CorrectJpmID, "")
+		require.Error(t, err)
+		assert.True(t, apperror.IsKind(err, apperror.Conflict))
+		assert.Contains(t, err.Error(), "out of order")
+		assert.Nil(t, qqOf(f, 2).AnsweredAt)
+	})
+}
@@ -0,0 +1,121 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateQuiz_JaToEn(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("意味を提示し英単語の選択肢を返す", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		words := seedWords(t, cli, 6)
+
+		// word5 は word0 と同じ意味を持つ（誤答にすると正解が 2 つになる）
+		wi := cli.WordInfo.Query().AllX(ctx)
+		for _, info := range wi {
+			if info.WordID == words[5].ID {
+				cli.JapaneseMean.Create().SetWordInfoID(info.ID).SetName(jpName(0)).SaveX(ctx)
+			}
+		}
+
+		res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+			QuestionCount:   5,
+			PartsOfSpeeches: []int{testPosID},
+			Direction:       models.QuizDirectionJaToEn,
+		})
+		require.NoError(t, err)
+
+		nq := res.NextQuestion
+		assert.Equal(t, models.QuizDirectionJaToEn, nq.Direction)
+		assert.Empty(t, nq.WordName, "英単語は出題時に伏せる")
+		assert.Empty(t, nq.ChoicesJpms)
+		assert.NotEmpty(t, nq.MeaningName)
+		assert.Len(t, nq.ChoicesWords, 4)
+
+		assert.Equal(t, models.QuizDirectionJaToEn,
+			cli.Quiz.Query().Where(quiz.ID(res.QuizID)).OnlyX(ctx).Direction)
+
+		qqs := cli.QuizQuestion.Query().Where(quizquestion.QuizID(res.QuizID)).AllX(ctx)
+		for _, qq := range qqs {
+			ids := make([]int, 0, len(qq.ChoicesWords))
+			for _, c := range qq.ChoicesWords {
+				ids = append(ids, c.WordID)
+			}
+			assert.Contains(t, ids, qq.WordID, "正解の単語を含む")
+			if qq.WordID == words[0].ID {
+				assert.NotContains(t, ids, words[5].ID, "同じ意味の単語は誤答にしない")
+			}
+			if qq.WordID == words[5].ID {
+				assert.NotContains(t, ids, words[0].ID, "同じ意味の単語は誤答にしない")
+			}
+		}
+	})
+
+	t.Run("AnswerWordID で採点する", func(t *testing.T) {
+		cli, svc, _ := newService(t)
+		u := seedUser(t, cli)
+		seedWords(t, cli, 6)
+
+		res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+			QuestionCount:   2,
+			PartsOfSpeeches: []int{testPosID},
+			Direction:       models.QuizDirectionJaToEn,
+		})
+		require.NoError(t, err)
+
+		question := func(n int) *ent.QuizQuestion {
+			return cli.QuizQuestion.Query().
+				Where(quizquestion.QuizID(res.QuizID), quizquestion.QuestionNumber(n)).
+				OnlyX(ctx)
+		}
+
+		q1 := question(1)
+		out, err := svc.SubmitAnswerAndRoute(ctx, u.ID, &models.PostAnswerQuestionRequest{
+			QuizID:         res.QuizID,
+			QuestionNumber: 1,
+			AnswerWordID:   q1.WordID,
+		})
+		require.NoError(t, err)
+		assert.True(t, out.IsCorrect)
+		assert.Equal(t, models.QuizDirectionJaToEn, out.NextQuestion.Direction)
+		assert.Empty(t, out.NextQuestion.WordName)
+
+		q1 = question(1)
+		require.NotNil(t, q1.AnswerWordID)
+		assert.Equal(t, q1.WordID, *q1.AnswerWordID)
+		assert.Nil(t, q1.AnswerJpmID)
+
+		// 途中再開でも同じ問題（2 問目）が返る
+		resume, err := svc.GetNextOrResume(ctx, u.ID, &models.GetQuizRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, 2, resume.NextQuestion.QuestionNumber)
+
+		q2 := question(2)
+		var wrong int
+		for _, c := range q2.ChoicesWords {
+			if c.WordID != q2.WordID {
+				wrong = c.WordID
+				break
+			}
+		}
+		out, err = svc.SubmitAnswerAndRoute(ctx, u.ID, &models.PostAnswerQuestionRequest{
+			QuizID:         res.QuizID,
+			QuestionNumber: 2,
+			AnswerWordID:   wrong,
+		})
+		require.NoError(t, err)
+		assert.False(t, out.IsCorrect)
+		assert.True(t, out.IsFinish)
+	})
+}
@@ -0,0 +1,150 @@
+package quiz_service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/apperror"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimedQuiz(t *testing.T) {
+	ctx := context.Background()
+
+	type env struct {
+		cli    *ent.Client
+		clk    *fixedClock
+		create func(req models.CreateQuizReq) (*models.CreateQuizResponse, error)
+		answer func(quizID, n int) *models.AnswerRouteRes
+		qq     func(quizID, n int) *ent.QuizQuestion
+		resume func() *models.GetQuizResponse
+	}
+	setup := func(t *testing.T) env {
+		cli, svc, clk := newService(t)
+		u := seedUser(t, cli)
+		seedWords(t, cli, 6)
+		e := env{cli: cli, clk: clk}
+		e.create = func(req models.CreateQuizReq) (*models.CreateQuizResponse, error) {
+			req.PartsOfSpeeches = []int{testPosID}
+			if req.QuestionCount == 0 {
+				req.QuestionCount = 3
+			}
+			return svc.CreateQuiz(ctx, u.ID, &req)
+		}
+		e.qq = func(quizID, n int) *ent.QuizQuestion {
+			return cli.QuizQuestion.Query().
+				Where(quizquestion.QuizID(quizID), quizquestion.QuestionNumber(n)).
+				OnlyX(ctx)
+		}
+		e.answer = func(quizID, n int) *models.AnswerRouteRes {
+			out, err := svc.SubmitAnswerAndRoute(ctx, u.ID, &models.PostAnswerQuestionRequest{
+				QuizID:         quizID,
+				QuestionNumber: n,
+				AnswerJpmID:    e.qq(quizID, n).CorrectJpmID,
+			})
+			require.NoError(t, err)
+			return out
+		}
+		e.resume = func() *models.GetQuizResponse {
+			out, err := svc.GetNextOrResume(ctx, u.ID, &models.GetQuizRequest{})
+			require.NoError(t, err)
+			return out
+		}
+		return e
+	}
+	advance := func(clk *fixedClock, d time.Duration) { clk.now = clk.now.Add(d) }
+
+	t.Run("回答時間は実際の出題時刻から測る", func(t *testing.T) {
+		e := setup(t)
+		res, err := e.create(models.CreateQuizReq{})
+		require.NoError(t, err)
+		assert.Nil(t, res.NextQuestion.Deadline, "制限なし")
+
+		require.NotNil(t, e.qq(res.QuizID, 1).ServedAt)
+		assert.Nil(t, e.qq(res.QuizID, 2).ServedAt, "2 問目はまだ出題していない")
+
+		advance(e.clk, 5*time.Second)
+		e.answer(res.QuizID, 1)
+		advance(e.clk, 3*time.Second)
+		e.answer(res.QuizID, 2)
+
+		assert.Equal(t, 5000, *e.qq(res.QuizID, 1).TimeMs)
+		assert.Equal(t, 3000, *e.qq(res.QuizID, 2).TimeMs)
+	})
+
+	t.Run("1 問の制限時間を過ぎた回答は timeout", func(t *testing.T) {
+		e := setup(t)
+		res, err := e.create(models.CreateQuizReq{QuestionTimeLimitSec: 10})
+		require.NoError(t, err)
+		require.NotNil(t, res.NextQuestion.Deadline)
+		assert.Equal(t, baseTime.Add(10*time.Second), *res.NextQuestion.Deadline)
+
+		advance(e.clk, 11*time.Second) // 猶予内
+		out := e.answer(res.QuizID, 1)
+		assert.True(t, out.IsCorrect)
+
+		advance(e.clk, 20*time.Second)
+		out = e.answer(res.QuizID, 2)
+		assert.False(t, out.IsCorrect)
+		assert.Equal(t, models.QuestionGradeTimeout, out.Grade)
+		assert.Equal(t, models.QuestionGradeTimeout, *e.qq(res.QuizID, 2).Grade)
+		assert.NotNil(t, e.qq(res.QuizID, 2).AnswerJpmID, "回答内容は残す")
+	})
+
+	t.Run("クイズ全体の制限時間を過ぎたら終了する", func(t *testing.T) {
+		e := setup(t)
+		res, err := e.create(models.CreateQuizReq{TimeLimitSec: 30, IsSaveResult: true})
+		require.NoError(t, err)
+
+		advance(e.clk, 40*time.Second)
+		out := e.answer(res.QuizID, 1)
+		assert.True(t, out.IsFinish)
+		assert.Equal(t, models.QuestionGradeTimeout, out.Grade)
+
+		q := e.cli.Quiz.Query().Where(quiz.ID(res.QuizID)).OnlyX(ctx)
+		assert.False(t, q.IsRunning)
+		for n := 2; n <= 3; n++ {
+			qq := e.qq(res.QuizID, n)
+			assert.Equal(t, models.QuestionGradeTimeout, *qq.Grade)
+			assert.Nil(t, qq.AnsweredAt)
+		}
+	})
+
+	t.Run("放置されたクイズは新規作成時に自動終了する", func(t *testing.T) {
+		e := setup(t)
+		first, err := e.create(models.CreateQuizReq{})
+		require.NoError(t, err)
+
+		advance(e.clk, time.Hour)
+		_, err = e.create(models.CreateQuizReq{})
+		require.Error(t, err)
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "まだ実行中")
+
+		advance(e.clk, 24*time.Hour)
+		second, err := e.create(models.CreateQuizReq{})
+		require.NoError(t, err)
+		assert.NotEqual(t, first.QuizID, second.QuizID)
+
+		old := e.cli.Quiz.Query().Where(quiz.ID(first.QuizID)).OnlyX(ctx)
+		assert.False(t, old.IsRunning)
+	})
+
+	t.Run("1 問制限のクイズは残り時間を使い切ったら再開できない", func(t *testing.T) {
+		e := setup(t)
+		_, err := e.create(models.CreateQuizReq{QuestionTimeLimitSec: 10})
+		require.NoError(t, err)
+
+		advance(e.clk, 20*time.Second)
+		assert.True(t, e.resume().IsRunningQuiz, "3 問 × 10 秒以内")
+
+		advance(e.clk, 20*time.Second)
+		assert.False(t, e.resume().IsRunningQuiz)
+	})
+}
@@ -0,0 +1,85 @@
+package quiz_service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/word"
+	"word_app/backend/src/domain/spelling"
+	"word_app/backend/src/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTypingQuiz(t *testing.T) {
+	ctx := context.Background()
+	cli, svc, _ := newService(t)
+	u := seedUser(t, cli)
+	// 正規化で数字は落ちるので英字のみの綴りにする
+	for i, w := range seedWords(t, cli, 3) {
+		cli.Word.UpdateOneID(w.ID).SetName([]string{"apple", "banana", "cherry"}[i]).ExecX(ctx)
+	}
+
+	res, err := svc.CreateQuiz(ctx, u.ID, &models.CreateQuizReq{
+		QuestionCount:   3,
+		PartsOfSpeeches: []int{testPosID},
+		QuestionType:    models.QuestionTypeTyping,
+	})
+	require.NoError(t, err)
+
+	nq := res.NextQuestion
+	assert.Equal(t, models.QuestionTypeTyping, nq.QuestionType)
+	assert.Equal(t, models.QuizDirectionJaToEn, nq.Direction, "入力式は意味→英単語")
+	assert.NotEmpty(t, nq.MeaningName)
+	assert.Empty(t, nq.WordName)
+	assert.Empty(t, nq.ChoicesJpms)
+	assert.Empty(t, nq.ChoicesWords)
+
+	answerOf := func(n int) string {
+		qq := cli.QuizQuestion.Query().
+			Where(quizquestion.QuizID(res.QuizID), quizquestion.QuestionNumber(n)).
+			OnlyX(ctx)
+		return cli.Word.Query().Where(word.ID(qq.WordID)).OnlyX(ctx).Name
+	}
+
+	// 1: 正解（大文字・前後空白）/ 2: 1文字欠け / 3: 無関係
+	a1, a2 := answerOf(1), answerOf(2)
+	typed := []string{
+		" " + strings.ToUpper(a1) + " ",
+		a2[:len(a2)-1],
+		"zzzzzz",
+	}
+	wantGrades := []string{spelling.GradeCorrect, spelling.GradeNearMiss, spelling.GradeWrong}
+
+	for i, in := range typed {
+		out, err := svc.SubmitAnswerAndRoute(ctx, u.ID, &models.PostAnswerQuestionRequest{
+			QuizID:         res.QuizID,
+			QuestionNumber: i + 1,
+			TypedAnswer:    in,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, wantGrades[i], out.Grade, in)
+		assert.Equal(t, i == 0, out.IsCorrect)
+	}
+
+	qqs := cli.QuizQuestion.Query().
+		Where(quizquestion.QuizID(res.QuizID)).
+		Order(quizquestion.ByQuestionNumber()).
+		AllX(ctx)
+	for i, qq := range qqs {
+		require.NotNil(t, qq.TypedAnswer)
+		assert.Equal(t, typed[i], *qq.TypedAnswer, "入力値はそのまま保存する")
+		require.NotNil(t, qq.Grade)
+		assert.Equal(t, wantGrades[i], *qq.Grade)
+	}
+
+	// 正解 1 + 惜しい 0.5 → 1.5 / 3
+	q := cli.Quiz.Query().Where(quiz.ID(res.QuizID)).OnlyX(ctx)
+	assert.False(t, q.IsRunning)
+	assert.Equal(t, 1, q.CorrectCount)
+	assert.InDelta(t, 50.0, q.ResultCorrectRate, 1e-9)
+}
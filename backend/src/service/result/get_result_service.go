@@ -2,11 +2,13 @@ package result
 
 import (
 	"context"
+	"time"
 
 	"word_app/backend/ent"
 	"word_app/backend/ent/quiz"
 	"word_app/backend/ent/quizquestion"
 	"word_app/backend/ent/registeredword"
+	"word_app/backend/src/domain/spelling"
 	"word_app/backend/src/models"
 )
 
@@ -35,9 +37,13 @@ func (s *ServiceImpl) GetByQuizNo(
 
 	resultQs := make([]models.ResultQuestion, 0, len(q.Edges.QuizQuestions))
 	correctCnt := 0
+	answeredCnt := 0
 
 	for _, qq := range q.Edges.QuizQuestions {
 		isCor := qq.IsCorrect != nil && *qq.IsCorrect
+		if qq.AnsweredAt != nil {
+			answeredCnt++
+		}
 		if isCor {
 			correctCnt++
 		}
@@ -53,12 +59,20 @@ func (s *ServiceImpl) GetByQuizNo(
 
 		resQ := models.ResultQuestion{
 			QuestionNumber: qq.QuestionNumber,
+			Direction:      qq.Direction,
 			WordID:         qq.WordID,
 			WordName:       qq.WordName,
 			PosID:          qq.PosID,
 			CorrectJpmID:   qq.CorrectJpmID,
+			MeaningName:    qq.MeaningName,
 			ChoicesJpms:    qq.ChoicesJpms,
+			ChoicesWords:   qq.ChoicesWords,
 			AnswerJpmID:    derefInt(qq.AnswerJpmID),
+			AnswerWordID:   derefInt(qq.AnswerWordID),
+			QuestionType:   qq.QuestionType,
+			TypedAnswer:    derefString(qq.TypedAnswer),
+			Grade:          gradeOf(qq),
+			Credit:         qq.Credit,
 			IsCorrect:      isCor,
 			TimeMs:         derefInt(qq.TimeMs),
 			RegisteredWord: models.RegisteredWord{
@@ -66,24 +80,39 @@ func (s *ServiceImpl) GetByQuizNo(
 				AttentionLevel: ifNil(rw, 1, rw.AttentionLevel),
 				QuizCount:      ifNil(rw, 0, rw.QuizCount),
 				CorrectCount:   ifNil(rw, 0, rw.CorrectCount),
+				IntervalDays:   ifNil(rw, 0, rw.IntervalDays),
+				DueAt:          ifNil[*time.Time](rw, nil, rw.DueAt),
 			},
 		}
 		resultQs = append(resultQs, resQ)
 	}
 
+	// 中断したクイズは回答済みの問題だけで正答率を出す
+	denom := q.TotalQuestionsCount
+	if statusOf(q) == models.QuizStatusCancelled {
+		denom = answeredCnt
+	}
+
 	return &models.Result{
 		QuizNumber:          q.QuizNumber,
+		Status:              statusOf(q),
 		TotalQuestionsCount: q.TotalQuestionsCount,
 		CorrectCount:        correctCnt,
-		ResultCorrectRate:   percent(correctCnt, q.TotalQuestionsCount),
+		ResultCorrectRate:   percent(correctCnt, denom),
 		ResultSetting: models.ResultSetting{
-			IsSaveResult:        q.IsSaveResult,
-			IsRegisteredWords:   q.IsRegisteredWords,
-			SettingCorrectRate:  q.SettingCorrectRate,
-			IsIdioms:            q.IsIdioms,
-			IsSpecialCharacters: q.IsSpecialCharacters,
-			AttentionLevelList:  q.AttentionLevelList,
-			ChoicesPosIDs:       q.ChoicesPosIds,
+			IsSaveResult:         q.IsSaveResult,
+			IsRegisteredWords:    q.IsRegisteredWords,
+			SettingCorrectRate:   q.SettingCorrectRate,
+			IsIdioms:             q.IsIdioms,
+			IsSpecialCharacters:  q.IsSpecialCharacters,
+			AttentionLevelList:   q.AttentionLevelList,
+			ChoicesPosIDs:        q.ChoicesPosIds,
+			Mode:                 q.Mode,
+			Direction:            q.Direction,
+			QuestionType:         q.QuestionType,
+			Difficulty:           q.Difficulty,
+			QuestionTimeLimitSec: q.QuestionTimeLimitSec,
+			TimeLimitSec:         q.TimeLimitSec,
 		},
 		ResultQuestions: resultQs,
 	}, nil
@@ -110,3 +139,32 @@ func derefInt(ptr *int) int {
 	}
 	return *ptr
 }
+
+func derefString(ptr *string) string {
+	if ptr == nil {
+		return ""
+	}
+	return *ptr
+}
+
+// statusOf はクイズの終了理由を返す。finish_status 導入前の行は全問回答済みとみなす。
+func statusOf(q *ent.Quiz) string {
+	if q.FinishStatus != nil {
+		return *q.FinishStatus
+	}
+	return models.QuizStatusCompleted
+}
+
+// gradeOf は採点結果を返す。grade 導入前の回答は正誤から補う。
+func gradeOf(qq *ent.QuizQuestion) string {
+	switch {
+	case qq.Grade != nil:
+		return *qq.Grade
+	case qq.IsCorrect == nil:
+		return ""
+	case *qq.IsCorrect:
+		return spelling.GradeCorrect
+	default:
+		return spelling.GradeWrong
+	}
+}
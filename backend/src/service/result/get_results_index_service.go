@@ -32,11 +32,17 @@ func (s *ServiceImpl) GetSummaries(
 	for _, q := range rows {
 		summaries = append(summaries, models.ResultSummary{
 			QuizNumber:          q.QuizNumber,
+			Status:              statusOf(q),
 			CreatedAt:           q.CreatedAt,
+			FinishedAt:          q.FinishedAt,
 			IsRegisteredWords:   q.IsRegisteredWords,
 			IsIdioms:            q.IsIdioms,
 			IsSpecialCharacters: q.IsSpecialCharacters,
 			ChoicesPosIDs:       q.ChoicesPosIds,
+			Mode:                q.Mode,
+			Direction:           q.Direction,
+			QuestionType:        q.QuestionType,
+			Difficulty:          q.Difficulty,
 			TotalQuestionsCount: q.TotalQuestionsCount,
 			CorrectCount:        q.CorrectCount,
 			ResultCorrectRate:   q.ResultCorrectRate,
@@ -0,0 +1,353 @@
+package result
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/partofspeech"
+	"word_app/backend/ent/quiz"
+	"word_app/backend/ent/quizquestion"
+	"word_app/backend/ent/registeredword"
+	"word_app/backend/src/domain/stats"
+	"word_app/backend/src/models"
+	"word_app/backend/src/usecase/shared/ucerr"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+)
+
+const (
+	defaultStatsDays = 30
+	maxStatsDays     = 366
+	defaultWeakLimit = 10
+	maxWeakLimit     = 50
+)
+
+// GET /results/stats
+// 回答履歴 (QuizQuestion) を集計する。結果を保存しない設定のクイズも学習履歴として含める。
+func (s *ServiceImpl) GetStats(
+	ctx context.Context,
+	userID int,
+	req *models.StatsRequest,
+) (*models.StatsResponse, error) {
+	today := s.truncateToJST0(s.clock.Now())
+	from, to, err := s.statsRange(req, today)
+	if err != nil {
+		return nil, err
+	}
+
+	// 期間内の回答
+	answers, err := s.client.QuizQuestion().
+		Query().
+		Where(
+			quizquestion.HasQuizWith(quiz.UserID(userID)),
+			quizquestion.AnsweredAtGTE(from),
+			quizquestion.AnsweredAtLT(to.AddDate(0, 0, 1)),
+		).
+		Select(
+			quizquestion.FieldWordID,
+			quizquestion.FieldWordName,
+			quizquestion.FieldPosID,
+			quizquestion.FieldIsCorrect,
+			quizquestion.FieldCredit,
+			quizquestion.FieldAnsweredAt,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	streak, err := s.streakOf(ctx, userID, today)
+	if err != nil {
+		return nil, err
+	}
+	byPos, err := s.accuracyByPos(ctx, answers)
+	if err != nil {
+		return nil, err
+	}
+	byLevel, err := s.accuracyByAttention(ctx, userID, answers)
+	if err != nil {
+		return nil, err
+	}
+
+	daily, weekly, total := s.accuracySeries(answers, from, to)
+	return &models.StatsResponse{
+		From:             from.Format(models.StatsDateLayout),
+		To:               to.Format(models.StatsDateLayout),
+		Total:            total,
+		Daily:            daily,
+		Weekly:           weekly,
+		Streak:           streak,
+		ByPartOfSpeech:   byPos,
+		ByAttentionLevel: byLevel,
+		WeakWords:        weakWords(answers, weakLimit(req.WeakLimit)),
+	}, nil
+}
+
+// statsRange は集計期間 [from, to]（JST の日付、両端を含む）を決める
+func (s *ServiceImpl) statsRange(req *models.StatsRequest, today time.Time) (from, to time.Time, err error) {
+	to = today
+	if req.To != "" {
+		if to, err = time.ParseInLocation(models.StatsDateLayout, req.To, s.jst); err != nil {
+			return from, to, ucerr.Validation("invalid 'to': must be YYYY-MM-DD")
+		}
+	}
+	from = to.AddDate(0, 0, -(defaultStatsDays - 1))
+	if req.From != "" {
+		if from, err = time.ParseInLocation(models.StatsDateLayout, req.From, s.jst); err != nil {
+			return from, to, ucerr.Validation("invalid 'from': must be YYYY-MM-DD")
+		}
+	}
+	switch {
+	case from.After(to):
+		return from, to, ucerr.Validation("'from' must be on or before 'to'")
+	case to.Sub(from) >= maxStatsDays*24*time.Hour:
+		return from, to, ucerr.Validation("date range must be at most 366 days")
+	}
+	return from, to, nil
+}
+
+func weakLimit(n int) int {
+	switch {
+	case n <= 0:
+		return defaultWeakLimit
+	case n > maxWeakLimit:
+		return maxWeakLimit
+	}
+	return n
+}
+
+// JSTのその日0:00に丸める（userdailyusage と同じ日付の区切り）
+func (s *ServiceImpl) truncateToJST0(t time.Time) time.Time {
+	tt := t.In(s.jst)
+	y, m, d := tt.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, s.jst)
+}
+
+// accuracySeries は日別・週別・期間全体の正答率。回答のない日・週も 0 件で返す。
+func (s *ServiceImpl) accuracySeries(
+	answers []*ent.QuizQuestion,
+	from, to time.Time,
+) (daily, weekly []models.AccuracyPoint, total models.AccuracyPoint) {
+	byDay := map[string]*stats.Tally{}
+	byWeek := map[string]*stats.Tally{}
+	var all stats.Tally
+	for _, qq := range answers {
+		day := s.truncateToJST0(*qq.AnsweredAt)
+		addTo(byDay, day.Format(models.StatsDateLayout), qq)
+		addTo(byWeek, stats.WeekStart(day).Format(models.StatsDateLayout), qq)
+		all.Add(isCorrect(qq), qq.Credit)
+	}
+
+	for _, day := range stats.Days(from, to) {
+		key := day.Format(models.StatsDateLayout)
+		daily = append(daily, pointOf(key, byDay[key]))
+	}
+	for week := stats.WeekStart(from); !week.After(to); week = week.AddDate(0, 0, 7) {
+		key := week.Format(models.StatsDateLayout)
+		weekly = append(weekly, pointOf(key, byWeek[key]))
+	}
+	return daily, weekly, pointOf("", &all)
+}
+
+// streakOf は全期間の回答日から連続学習日数を計算する。
+// 回答は期間で絞れないので、JST の回答日を DB で重複なく求めてから数える
+func (s *ServiceImpl) streakOf(ctx context.Context, userID int, today time.Time) (models.Streak, error) {
+	answered, err := s.client.QuizQuestion().
+		Query().
+		Where(
+			quizquestion.HasQuizWith(quiz.UserID(userID)),
+			quizquestion.AnsweredAtNotNil(),
+		).
+		Modify(func(sel *entsql.Selector) {
+			sel.Select(jstDate(sel)).Distinct()
+		}).
+		Strings(ctx)
+	if err != nil {
+		return models.Streak{}, err
+	}
+	days := make([]time.Time, 0, len(answered))
+	var last time.Time
+	for _, d := range answered {
+		day, err := time.ParseInLocation(models.StatsDateLayout, d, s.jst)
+		if err != nil {
+			return models.Streak{}, err
+		}
+		days = append(days, day)
+		if day.After(last) {
+			last = day
+		}
+	}
+	current, longest := stats.Streaks(days, today)
+	out := models.Streak{Current: current, Longest: longest}
+	if !last.IsZero() {
+		out.LastStudyDate = last.Format(models.StatsDateLayout)
+	}
+	return out, nil
+}
+
+// jstDate は answered_at の JST の日付（YYYY-MM-DD の文字列）を返す式。
+// 本番は PostgreSQL、テストは SQLite なので方言ごとに書き分ける
+func jstDate(sel *entsql.Selector) string {
+	col := sel.C(quizquestion.FieldAnsweredAt)
+	if sel.Dialect() == dialect.Postgres {
+		return "to_char(" + col + " AT TIME ZONE 'Asia/Tokyo', 'YYYY-MM-DD')"
+	}
+	return "date(" + col + ", '+9 hours')"
+}
+
+// accuracyByPos は品詞別の正答率（品詞 ID 順）
+func (s *ServiceImpl) accuracyByPos(ctx context.Context, answers []*ent.QuizQuestion) ([]models.PosAccuracy, error) {
+	tallies := map[int]*stats.Tally{}
+	for _, qq := range answers {
+		addTo(tallies, qq.PosID, qq)
+	}
+	names := map[int]string{}
+	if len(tallies) > 0 {
+		poss, err := s.client.EntClient().PartOfSpeech.
+			Query().
+			Where(partofspeech.IDIn(keysOf(tallies)...)).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range poss {
+			names[p.ID] = p.Name
+		}
+	}
+
+	out := make([]models.PosAccuracy, 0, len(tallies))
+	for _, id := range keysOf(tallies) {
+		t := tallies[id]
+		out = append(out, models.PosAccuracy{
+			PosID:    id,
+			Name:     names[id],
+			Answered: t.Answered,
+			Correct:  t.Correct,
+			Accuracy: t.Accuracy(),
+		})
+	}
+	return out, nil
+}
+
+// accuracyByAttention は現在の注意レベル別の正答率（レベル順）
+func (s *ServiceImpl) accuracyByAttention(
+	ctx context.Context,
+	userID int,
+	answers []*ent.QuizQuestion,
+) ([]models.AttentionAccuracy, error) {
+	wordIDs := map[int]struct{}{}
+	for _, qq := range answers {
+		wordIDs[qq.WordID] = struct{}{}
+	}
+	levels := map[int]int{}
+	if len(wordIDs) > 0 {
+		rws, err := s.client.RegisteredWord().
+			Query().
+			Where(
+				registeredword.UserID(userID),
+				registeredword.WordIDIn(keysOf(wordIDs)...),
+			).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, rw := range rws {
+			levels[rw.WordID] = rw.AttentionLevel
+		}
+	}
+
+	tallies := map[int]*stats.Tally{}
+	for _, qq := range answers {
+		level, ok := levels[qq.WordID]
+		if !ok {
+			level = 1
+		}
+		addTo(tallies, level, qq)
+	}
+	out := make([]models.AttentionAccuracy, 0, len(tallies))
+	for _, level := range keysOf(tallies) {
+		t := tallies[level]
+		out = append(out, models.AttentionAccuracy{
+			AttentionLevel: level,
+			Answered:       t.Answered,
+			Correct:        t.Correct,
+			Accuracy:       t.Accuracy(),
+		})
+	}
+	return out, nil
+}
+
+// weakWords は正答率の低い単語を上位 limit 件返す。
+// 全問正解の単語は含めない。同率なら回答回数の多い順。
+func weakWords(answers []*ent.QuizQuestion, limit int) []models.WeakWord {
+	tallies := map[int]*stats.Tally{}
+	names := map[int]string{}
+	for _, qq := range answers {
+		addTo(tallies, qq.WordID, qq)
+		names[qq.WordID] = qq.WordName
+	}
+
+	out := make([]models.WeakWord, 0, len(tallies))
+	for id, t := range tallies {
+		if t.Accuracy() >= 100 {
+			continue
+		}
+		out = append(out, models.WeakWord{
+			WordID:   id,
+			WordName: names[id],
+			Answered: t.Answered,
+			Correct:  t.Correct,
+			Accuracy: t.Accuracy(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		a, b := out[i], out[j]
+		if a.Accuracy != b.Accuracy {
+			return a.Accuracy < b.Accuracy
+		}
+		if a.Answered != b.Answered {
+			return a.Answered > b.Answered
+		}
+		return a.WordID < b.WordID
+	})
+	if len(out) > limit {
+		out = out[:limit]
+	}
+	return out
+}
+
+func addTo[K comparable](m map[K]*stats.Tally, key K, qq *ent.QuizQuestion) {
+	t, ok := m[key]
+	if !ok {
+		t = &stats.Tally{}
+		m[key] = t
+	}
+	t.Add(isCorrect(qq), qq.Credit)
+}
+
+func pointOf(date string, t *stats.Tally) models.AccuracyPoint {
+	p := models.AccuracyPoint{Date: date}
+	if t != nil {
+		p.Answered = t.Answered
+		p.Correct = t.Correct
+		p.Accuracy = t.Accuracy()
+	}
+	return p
+}
+
+func isCorrect(qq *ent.QuizQuestion) bool {
+	return qq.IsCorrect != nil && *qq.IsCorrect
+}
+
+// keysOf は map のキーを昇順で返す
+func keysOf[V any](m map[int]V) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
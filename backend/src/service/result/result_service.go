@@ -2,16 +2,25 @@ package result
 
 import (
 	"errors"
+	"time"
 
 	serviceinterfaces "word_app/backend/src/interfaces/service_interfaces"
+	"word_app/backend/src/usecase/clock"
 )
 
 type ServiceImpl struct {
 	client serviceinterfaces.EntClientInterface
+	clock  clock.Clock
+	jst    *time.Location
 }
 
-func NewService(client serviceinterfaces.EntClientInterface) *ServiceImpl {
-	return &ServiceImpl{client: client}
+func NewService(client serviceinterfaces.EntClientInterface, clock clock.Clock) *ServiceImpl {
+	jst, _ := time.LoadLocation("Asia/Tokyo")
+	return &ServiceImpl{
+		client: client,
+		clock:  clock,
+		jst:    jst,
+	}
 }
 
 var (
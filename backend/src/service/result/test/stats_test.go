@@ -0,0 +1,156 @@
+package result_service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/infrastructure"
+	"word_app/backend/src/models"
+	resultSvc "word_app/backend/src/service/result"
+	"word_app/backend/src/usecase/apperror"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c *fixedClock) Now() time.Time { return c.now }
+
+var jst = time.FixedZone("JST", 9*60*60)
+
+func TestGetStats(t *testing.T) {
+	ctx := context.Background()
+
+	cli := enttest.Open(t, "sqlite3", fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	t.Cleanup(func() { _ = cli.Close() })
+	svc := resultSvc.NewService(
+		infrastructure.NewAppClient(cli),
+		&fixedClock{now: time.Date(2025, 4, 10, 12, 0, 0, 0, jst)},
+	)
+
+	noun := cli.PartOfSpeech.Create().SetName("名詞").SaveX(ctx)
+	verb := cli.PartOfSpeech.Create().SetName("動詞").SaveX(ctx)
+	type seeded struct {
+		word *ent.Word
+		jm   *ent.JapaneseMean
+	}
+	seedWord := func(name, mean string, pos *ent.PartOfSpeech) seeded {
+		w := cli.Word.Create().SetName(name).SaveX(ctx)
+		wi := cli.WordInfo.Create().SetWordID(w.ID).SetPartOfSpeechID(pos.ID).SaveX(ctx)
+		jm := cli.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName(mean).SaveX(ctx)
+		return seeded{word: w, jm: jm}
+	}
+	apple := seedWord("apple", "りんご", noun)
+	bake := seedWord("bake", "焼く", verb)
+
+	seedUser := func(email string) *ent.User {
+		return cli.User.Create().SetEmail(email).SetName("stats user").SetPassword("Password123$").SaveX(ctx)
+	}
+	me := seedUser("me@example.com")
+	other := seedUser("other@example.com")
+
+	quizNo := 0
+	answer := func(u *ent.User, w seeded, pos *ent.PartOfSpeech, at *time.Time, grade string, credit float64) {
+		quizNo++
+		q := cli.Quiz.Create().
+			SetUserID(u.ID).
+			SetQuizNumber(quizNo).
+			SetAttentionLevelList([]int{}).
+			SetChoicesPosIds([]int{pos.ID}).
+			SaveX(ctx)
+		create := cli.QuizQuestion.Create().
+			SetQuizID(q.ID).
+			SetQuestionNumber(1).
+			SetWordID(w.word.ID).
+			SetWordName(w.word.Name).
+			SetPosID(pos.ID).
+			SetCorrectJpmID(w.jm.ID).
+			SetChoicesJpms([]models.ChoiceJpm{})
+		if at != nil {
+			create.
+				SetAnsweredAt(*at).
+				SetIsCorrect(grade == "correct").
+				SetGrade(grade).
+				SetCredit(credit)
+		}
+		create.SaveX(ctx)
+	}
+	at := func(d, h, m int) *time.Time {
+		t := time.Date(2025, 4, d, h, m, 0, 0, jst)
+		return &t
+	}
+
+	// UTC では同じ 4/9 だが JST では 4/9 と 4/10 に分かれる
+	answer(me, apple, noun, at(9, 23, 30), "correct", 1)
+	answer(me, apple, noun, at(10, 0, 30), "wrong", 0)
+	answer(me, bake, verb, at(8, 10, 0), "near_miss", 0.5)
+	answer(me, bake, verb, nil, "", 0)                    // 未回答は数えない
+	answer(me, apple, noun, at(1, 12, 0), "correct", 1)   // 期間外
+	answer(other, bake, verb, at(9, 12, 0), "correct", 1) // 他人の回答
+	cli.RegisteredWord.Create().
+		SetUserID(me.ID).
+		SetWordID(apple.word.ID).
+		SetAttentionLevel(3).
+		SaveX(ctx)
+
+	t.Run("期間内の回答を JST の日付で集計する", func(t *testing.T) {
+		res, err := svc.GetStats(ctx, me.ID, &models.StatsRequest{From: "2025-04-08", To: "2025-04-10"})
+		require.NoError(t, err)
+
+		assert.Equal(t, models.AccuracyPoint{Answered: 3, Correct: 1, Accuracy: 50}, res.Total)
+		assert.Equal(t, []models.AccuracyPoint{
+			{Date: "2025-04-08", Answered: 1, Correct: 0, Accuracy: 50},
+			{Date: "2025-04-09", Answered: 1, Correct: 1, Accuracy: 100},
+			{Date: "2025-04-10", Answered: 1, Correct: 0, Accuracy: 0},
+		}, res.Daily)
+		assert.Equal(t, []models.AccuracyPoint{
+			{Date: "2025-04-07", Answered: 3, Correct: 1, Accuracy: 50},
+		}, res.Weekly)
+
+		assert.Equal(t, []models.PosAccuracy{
+			{PosID: noun.ID, Name: "名詞", Answered: 2, Correct: 1, Accuracy: 50},
+			{PosID: verb.ID, Name: "動詞", Answered: 1, Correct: 0, Accuracy: 50},
+		}, res.ByPartOfSpeech)
+		assert.Equal(t, []models.AttentionAccuracy{
+			{AttentionLevel: 1, Answered: 1, Correct: 0, Accuracy: 50},
+			{AttentionLevel: 3, Answered: 2, Correct: 1, Accuracy: 50},
+		}, res.ByAttentionLevel)
+
+		require.Len(t, res.WeakWords, 2)
+		assert.Equal(t, "apple", res.WeakWords[0].WordName, "同率なら回答回数の多い順")
+		assert.Equal(t, "bake", res.WeakWords[1].WordName)
+	})
+
+	t.Run("連続学習日数は期間に関係なく計算する", func(t *testing.T) {
+		res, err := svc.GetStats(ctx, me.ID, &models.StatsRequest{From: "2025-04-10", To: "2025-04-10"})
+		require.NoError(t, err)
+		assert.Equal(t, models.Streak{Current: 3, Longest: 3, LastStudyDate: "2025-04-10"}, res.Streak)
+	})
+
+	t.Run("既定は今日までの 30 日間", func(t *testing.T) {
+		res, err := svc.GetStats(ctx, me.ID, &models.StatsRequest{WeakLimit: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "2025-03-12", res.From)
+		assert.Equal(t, "2025-04-10", res.To)
+		assert.Len(t, res.Daily, 30)
+		assert.Equal(t, 4, res.Total.Answered)
+		assert.Len(t, res.WeakWords, 1)
+	})
+
+	t.Run("不正な期間は検証エラー", func(t *testing.T) {
+		for _, req := range []models.StatsRequest{
+			{From: "2025/04/01"},
+			{From: "2025-04-10", To: "2025-04-01"},
+			{From: "2024-01-01", To: "2025-04-01"},
+		} {
+			_, err := svc.GetStats(ctx, me.ID, &req)
+			assert.True(t, apperror.IsKind(err, apperror.Validation), req)
+		}
+	})
+}
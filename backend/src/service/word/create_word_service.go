@@ -32,14 +32,9 @@ func (s *ServiceImpl) CreateWord(ctx context.Context, CreateWordRequest *models.
 		}
 	}()
 
-	// 管理者チェック
-	userEntity, err := tx.User.Get(ctx, CreateWordRequest.UserID)
-	if err != nil {
-		logrus.Error(err)
-		return nil, ErrDatabaseFailure
-	}
-	if !userEntity.IsAdmin {
-		return nil, ErrUnauthorized
+	// 編集権限チェック（RootConfig.editing_permission とユーザーの役割）
+	if err = s.policy.Authorize(ctx, CreateWordRequest.UserID); err != nil {
+		return nil, err
 	}
 
 	// 単語の存在確認
@@ -26,8 +26,8 @@ func (s *ServiceImpl) DeleteWord(
 	}
 	defer finishTxWithLog(&err, tx)
 
-	// --- ① 管理者チェック ---
-	if err = s.assertAdmin(ctx, tx, req.UserID); err != nil {
+	// --- ① 編集権限チェック ---
+	if err = s.policy.Authorize(ctx, req.UserID); err != nil {
 		return nil, err
 	}
 
@@ -63,24 +63,6 @@ func finishTxWithLog(perr *error, tx *ent.Tx) {
 
 /*========== domain-like helpers ==========*/
 
-// 管理者チェックだけ担当
-func (s *ServiceImpl) assertAdmin(
-	ctx context.Context,
-	tx *ent.Tx,
-	userID int,
-) error {
-	u, err := tx.User.Get(ctx, userID)
-	switch {
-	case ent.IsNotFound(err):
-		return ErrUnauthorized
-	case err != nil:
-		return ErrDeleteWord
-	case !u.IsAdmin:
-		return ErrUnauthorized
-	}
-	return nil
-}
-
 // Word, WordInfo, JapaneseMean, RegisteredWord を削除
 func (s *ServiceImpl) cascadeDeleteWord(
 	ctx context.Context,
@@ -75,8 +75,9 @@ func (s *ServiceImpl) GetWordDetails(ctx context.Context, WordShowRequest *model
 		japaneseMeans := make([]models.JapaneseMean, len(wordInfo.Edges.JapaneseMeans))
 		for j, mean := range wordInfo.Edges.JapaneseMeans {
 			japaneseMeans[j] = models.JapaneseMean{
-				ID:   mean.ID,
-				Name: mean.Name,
+				ID:      mean.ID,
+				Name:    mean.Name,
+				Reading: mean.Reading,
 			}
 		}
 
@@ -0,0 +1,43 @@
+package word_service_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/ent/enttest"
+	"word_app/backend/src/infrastructure"
+	"word_app/backend/src/models"
+	word_service "word_app/backend/src/service/word"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetWordDetails_Reading(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:reading?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	wordService := word_service.NewWordService(infrastructure.NewAppClient(client), nil, nil, nil, nil)
+
+	client.PartOfSpeech.Create().SetName("名詞").SaveX(ctx)
+	u := client.User.Create().SetName("user").SetEmail("reading@example.com").SetPassword("Password123$").SaveX(ctx)
+	w := client.Word.Create().SetName("apple").SaveX(ctx)
+	wi := client.WordInfo.Create().SetWordID(w.ID).SetPartOfSpeechID(1).SaveX(ctx)
+	client.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName("林檎").SetReading("りんご").SaveX(ctx)
+	client.JapaneseMean.Create().SetWordInfoID(wi.ID).SetName("りんご").SaveX(ctx)
+
+	res, err := wordService.GetWordDetails(ctx, &models.WordShowRequest{WordID: w.ID, UserID: u.ID})
+	require.NoError(t, err)
+
+	require.Len(t, res.WordInfos, 1)
+	readings := map[string]*string{}
+	for _, m := range res.WordInfos[0].JapaneseMeans {
+		readings[m.Name] = m.Reading
+	}
+	require.Len(t, readings, 2)
+	// 辞書から取り込んだ読みを返す。読みの無い意味は nil（JSON では省く）
+	require.NotNil(t, readings["林檎"])
+	assert.Equal(t, "りんご", *readings["林檎"])
+	assert.Nil(t, readings["りんご"])
+}
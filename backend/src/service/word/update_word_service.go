@@ -26,8 +26,8 @@ func (s *ServiceImpl) UpdateWord(
 	}
 	defer finishTxWithLog(&err, tx)
 
-	// ① 管理者チェック
-	if err = s.assertAdmin(ctx, tx, req.UserID); err != nil {
+	// ① 編集権限チェック
+	if err = s.policy.Authorize(ctx, req.UserID); err != nil {
 		return nil, err
 	}
 
@@ -74,22 +74,6 @@ func (s *ServiceImpl) UpdateWord(
 
 /*==================== domain / repository-like helpers ====================*/
 
-// // 管理者かチェック
-// func (s *ServiceImpl) assertAdmin(
-// 	ctx context.Context,
-// 	tx *ent.Tx,
-// 	userID int,
-// ) error {
-// 	u, err := tx.User.Get(ctx, userID)
-// 	if err != nil {
-// 		return ErrUnauthorized
-// 	}
-// 	if !u.IsAdmin {
-// 		return ErrUnauthorized
-// 	}
-// 	return nil
-// }
-
 // 既存 Word を取得し、名前重複を検証
 func (s *ServiceImpl) fetchAndValidateName(
 	ctx context.Context,
@@ -100,7 +84,7 @@ func (s *ServiceImpl) fetchAndValidateName(
 
 	w, err := tx.Word.Get(ctx, wordID)
 	if ent.IsNotFound(err) {
-		return nil, errors.New("word not found")
+		return nil, ErrWordNotFound
 	}
 	if err != nil {
 		return nil, errors.New("failed to fetch word")
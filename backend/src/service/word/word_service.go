@@ -7,6 +7,7 @@ import (
 	"word_app/backend/src/infrastructure/repository/tx"
 	"word_app/backend/src/infrastructure/repository/user"
 	"word_app/backend/src/interfaces"
+	"word_app/backend/src/usecase/editpolicy"
 )
 
 type ServiceImpl struct {
@@ -14,6 +15,7 @@ type ServiceImpl struct {
 	userRepo user.Repository
 	limits   *config.LimitsCfg
 	txm      tx.Manager
+	policy   editpolicy.Policy
 }
 
 func NewWordService(
@@ -21,12 +23,14 @@ func NewWordService(
 	userRepo user.Repository,
 	limits *config.LimitsCfg,
 	txm tx.Manager,
+	policy editpolicy.Policy,
 ) *ServiceImpl {
 	return &ServiceImpl{
 		client:   client,
 		userRepo: userRepo,
 		limits:   limits,
 		txm:      txm,
+		policy:   policy,
 	}
 }
 
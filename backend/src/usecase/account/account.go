@@ -0,0 +1,157 @@
+// Package account はメールアドレスの確認とパスワード再設定を扱う。
+// どちらも署名付き・期限付きのリンクをメールで送り、リンクは一度使うと無効になる。
+package account
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"word_app/backend/config"
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/infrastructure/mailer"
+	settingRepo "word_app/backend/src/infrastructure/repository/setting"
+	userRepo "word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/clock"
+	"word_app/backend/src/usecase/session"
+	"word_app/backend/src/usecase/shared/ucerr"
+	"word_app/backend/src/utils/tempjwt"
+)
+
+type Usecase interface {
+	// VerificationRequired は RootConfig.is_email_authentication_check を返す
+	VerificationRequired(ctx context.Context) (bool, error)
+	// SendVerification は未確認のアドレスへ確認リンクを送る。該当ユーザーがいなくてもエラーにしない
+	SendVerification(ctx context.Context, email string) error
+	VerifyEmail(ctx context.Context, token string) error
+	// RequestPasswordReset は再設定リンクを送る。該当ユーザーがいなくてもエラーにしない
+	RequestPasswordReset(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	// CheckSignIn はメール確認が必須のときに未確認ユーザーのサインインを拒否する
+	CheckSignIn(ctx context.Context, userID int) error
+}
+
+type AccountUsecase struct {
+	userRepo userRepo.Repository
+	rootRepo settingRepo.RootConfigRepository
+	tokens   jwt.ActionTokenIssuer
+	mailer   mailer.Mailer
+	sessions session.Usecase // 再設定後に全端末をログアウトさせる
+	clock    clock.Clock
+
+	linkBaseURL string
+	verifyTTL   time.Duration
+	resetTTL    time.Duration
+}
+
+func NewUsecase(
+	u userRepo.Repository,
+	r settingRepo.RootConfigRepository,
+	tokens jwt.ActionTokenIssuer,
+	m mailer.Mailer,
+	s session.Usecase,
+	c clock.Clock,
+	jwtCfg *config.JWTCfg,
+	mailCfg *config.MailCfg,
+) *AccountUsecase {
+	return &AccountUsecase{
+		userRepo:    u,
+		rootRepo:    r,
+		tokens:      tokens,
+		mailer:      m,
+		sessions:    s,
+		clock:       c,
+		linkBaseURL: mailCfg.LinkBaseURL,
+		verifyTTL:   time.Duration(jwtCfg.EmailVerifyTTLMinutes) * time.Minute,
+		resetTTL:    time.Duration(jwtCfg.PasswordResetTTLMinutes) * time.Minute,
+	}
+}
+
+var errInvalidToken = ucerr.Validation("invalid or expired token")
+
+func (uc *AccountUsecase) VerificationRequired(ctx context.Context) (bool, error) {
+	cfg, err := uc.rootRepo.Get(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, ucerr.Internal("failed to load root config", err)
+	}
+	return cfg.IsEmailAuthenticationCheck, nil
+}
+
+// findByEmail は存在しない・メールで扱えないユーザーを nil で返す（列挙対策で呼び出し側は黙って成功にする）
+func (uc *AccountUsecase) findByEmail(ctx context.Context, email string) (*domain.User, error) {
+	u, err := uc.userRepo.FindActiveByEmail(ctx, email)
+	if err != nil {
+		if apperror.IsKind(err, apperror.NotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if u.IsTest || u.Email == nil {
+		return nil, nil
+	}
+	return u, nil
+}
+
+// loadByToken はトークンを検証し、発行時から状態が変わっていないユーザーを返す
+func (uc *AccountUsecase) loadByToken(ctx context.Context, token, purpose string) (*domain.User, error) {
+	a, err := uc.tokens.ParseAction(token, purpose)
+	if err != nil {
+		return nil, errInvalidToken
+	}
+	u, err := uc.userRepo.FindForUpdate(ctx, a.UserID)
+	if err != nil {
+		if apperror.IsKind(err, apperror.NotFound) {
+			return nil, errInvalidToken
+		}
+		return nil, err
+	}
+	if u.IsTest || u.Email == nil || fingerprint(purpose, u) != a.Fingerprint {
+		return nil, errInvalidToken
+	}
+	return u, nil
+}
+
+// link は用途つきトークンを発行し、フロントエンドのページへのリンクにする
+func (uc *AccountUsecase) link(u *domain.User, purpose string, ttl time.Duration, path string) (string, error) {
+	tok, err := uc.tokens.GenerateAction(&tempjwt.Action{
+		Purpose:     purpose,
+		UserID:      u.ID,
+		Fingerprint: fingerprint(purpose, u),
+	}, ttl)
+	if err != nil {
+		return "", ucerr.Internal("failed to issue token", err)
+	}
+	return strings.TrimRight(uc.linkBaseURL, "/") + path + "?token=" + url.QueryEscape(tok), nil
+}
+
+func (uc *AccountUsecase) send(ctx context.Context, m mailer.Message) error {
+	if err := uc.mailer.Send(ctx, m); err != nil {
+		return ucerr.Internal("failed to send mail", err)
+	}
+	return nil
+}
+
+// fingerprint はリンクを一度きりにするための値。
+// 確認済み日時・パスワードハッシュ・アドレスのどれかが変われば別の値になり、古いリンクは通らない。
+func fingerprint(purpose string, u *domain.User) string {
+	verified := "-"
+	if u.EmailVerifiedAt != nil {
+		verified = strconv.FormatInt(u.EmailVerifiedAt.UnixNano(), 10)
+	}
+	var email string
+	if u.Email != nil {
+		email = *u.Email
+	}
+	sum := sha256.Sum256([]byte(purpose + "\x00" + email + "\x00" + u.Password + "\x00" + verified))
+	return base64.RawURLEncoding.EncodeToString(sum[:16])
+}
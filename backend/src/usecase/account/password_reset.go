@@ -0,0 +1,55 @@
+package account
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"word_app/backend/src/domain/repository"
+	"word_app/backend/src/infrastructure/mailer"
+	"word_app/backend/src/utils/tempjwt"
+)
+
+func (uc *AccountUsecase) RequestPasswordReset(ctx context.Context, email string) error {
+	u, err := uc.findByEmail(ctx, strings.TrimSpace(email))
+	if err != nil || u == nil {
+		return err
+	}
+
+	link, err := uc.link(u, tempjwt.PurposeResetPassword, uc.resetTTL, "/reset-password")
+	if err != nil {
+		return err
+	}
+	return uc.send(ctx, mailer.Message{
+		To:      *u.Email,
+		Subject: "パスワードの再設定",
+		Body: "以下のリンクから新しいパスワードを設定してください。\n" +
+			"リンクの有効期限は " + uc.resetTTL.String() + " です。\n\n" +
+			link + "\n\n" +
+			"このメールに心当たりがない場合は破棄してください。パスワードは変更されません。\n",
+	})
+}
+
+func (uc *AccountUsecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	u, err := uc.loadByToken(ctx, token, tempjwt.PurposeResetPassword)
+	if err != nil {
+		return err
+	}
+	b, err := bcrypt.GenerateFromPassword([]byte(newPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	hash := string(b)
+	f := &repository.UserUpdateFields{PasswordHash: &hash}
+	// リンクを開けた＝アドレスの持ち主なので確認済みにする
+	if u.EmailVerifiedAt == nil {
+		now := uc.clock.Now()
+		f.EmailVerifiedAt = &now
+	}
+	if _, err := uc.userRepo.UpdatePartial(ctx, u.ID, f); err != nil {
+		return err
+	}
+	// 乗っ取られていた場合に備えて、他の端末のセッションもすべて切る
+	return uc.sessions.LogoutAll(ctx, u.ID)
+}
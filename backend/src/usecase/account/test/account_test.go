@@ -0,0 +1,224 @@
+package account_test
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"testing"
+	"time"
+
+	"word_app/backend/config"
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/domain/repository"
+	"word_app/backend/src/infrastructure/jwt"
+	"word_app/backend/src/infrastructure/mailer"
+	mailermock "word_app/backend/src/mocks/infrastructure/mailer"
+	settingmock "word_app/backend/src/mocks/infrastructure/repository/setting"
+	usermock "word_app/backend/src/mocks/infrastructure/repository/user"
+	sessionmock "word_app/backend/src/mocks/usecase/session"
+	"word_app/backend/src/usecase/account"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/utils/tempjwt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+var now = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	users    *usermock.MockRepository
+	roots    *settingmock.MockRootConfigRepository
+	mailer   *mailermock.MockMailer
+	sessions *sessionmock.MockUsecase
+	sent     []mailer.Message
+}
+
+func newUsecase(t *testing.T) (*account.AccountUsecase, *mocks) {
+	m := &mocks{
+		users:    usermock.NewMockRepository(t),
+		roots:    settingmock.NewMockRootConfigRepository(t),
+		mailer:   mailermock.NewMockMailer(t),
+		sessions: sessionmock.NewMockUsecase(t),
+	}
+	m.mailer.On("Send", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { m.sent = append(m.sent, args.Get(1).(mailer.Message)) }).
+		Return(nil).Maybe()
+	tokens, err := jwt.NewActionToken("temp_secret")
+	require.NoError(t, err)
+	uc := account.NewUsecase(m.users, m.roots, tokens, m.mailer, m.sessions, fixedClock{now},
+		&config.JWTCfg{EmailVerifyTTLMinutes: 60, PasswordResetTTLMinutes: 30},
+		&config.MailCfg{LinkBaseURL: "http://front.example/"})
+	return uc, m
+}
+
+var reToken = regexp.MustCompile(`\?token=(\S+)`)
+
+// tokenFrom はメール本文のリンクからトークンを取り出す
+func tokenFrom(t *testing.T, msg mailer.Message) string {
+	t.Helper()
+	m := reToken.FindStringSubmatch(msg.Body)
+	require.NotNil(t, m, msg.Body)
+	tok, err := url.QueryUnescape(m[1])
+	require.NoError(t, err)
+	return tok
+}
+
+func ptr[T any](v T) *T { return &v }
+
+func TestSendVerificationAndVerify(t *testing.T) {
+	ctx := context.Background()
+	uc, m := newUsecase(t)
+	alice := &domain.User{ID: 7, Email: ptr("alice@example.com"), Password: "h1"}
+	m.users.On("FindActiveByEmail", mock.Anything, "alice@example.com").Return(alice, nil).Once()
+
+	require.NoError(t, uc.SendVerification(ctx, " alice@example.com "))
+	require.Len(t, m.sent, 1)
+	assert.Equal(t, "alice@example.com", m.sent[0].To)
+	assert.Contains(t, m.sent[0].Body, "http://front.example/verify-email?token=")
+	tok := tokenFrom(t, m.sent[0])
+
+	// 1 回目は確認済みにする
+	m.users.On("FindForUpdate", mock.Anything, 7).Return(alice, nil).Once()
+	m.users.On("UpdatePartial", mock.Anything, 7, mock.MatchedBy(func(f *repository.UserUpdateFields) bool {
+		return f.EmailVerifiedAt != nil && f.EmailVerifiedAt.Equal(now) && f.PasswordHash == nil
+	})).Return(alice, nil).Once()
+	require.NoError(t, uc.VerifyEmail(ctx, tok))
+
+	// 2 回目は確認済み日時が変わっているので通らない
+	verified := *alice
+	verified.EmailVerifiedAt = ptr(now)
+	m.users.On("FindForUpdate", mock.Anything, 7).Return(&verified, nil).Once()
+	err := uc.VerifyEmail(ctx, tok)
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+
+	// パスワード再設定用のトークンとしては使えない
+	err = uc.ResetPassword(ctx, tok, "NewSecret_123!")
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+}
+
+func TestSendVerification_Silent(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("存在しないアドレスでもエラーにしない", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.users.On("FindActiveByEmail", mock.Anything, "nobody@example.com").
+			Return(nil, apperror.NotFoundf("user not found", nil))
+		require.NoError(t, uc.SendVerification(ctx, "nobody@example.com"))
+		assert.Empty(t, m.sent)
+	})
+
+	t.Run("確認済みなら送らない", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.users.On("FindActiveByEmail", mock.Anything, "alice@example.com").
+			Return(&domain.User{ID: 7, Email: ptr("alice@example.com"), EmailVerifiedAt: ptr(now)}, nil)
+		require.NoError(t, uc.SendVerification(ctx, "alice@example.com"))
+		assert.Empty(t, m.sent)
+	})
+}
+
+func TestPasswordReset(t *testing.T) {
+	ctx := context.Background()
+	uc, m := newUsecase(t)
+	old, _ := bcrypt.GenerateFromPassword([]byte("Old_secret_1"), bcrypt.MinCost)
+	alice := &domain.User{ID: 7, Email: ptr("alice@example.com"), Password: string(old)}
+	m.users.On("FindActiveByEmail", mock.Anything, "alice@example.com").Return(alice, nil).Once()
+
+	require.NoError(t, uc.RequestPasswordReset(ctx, "alice@example.com"))
+	require.Len(t, m.sent, 1)
+	assert.Contains(t, m.sent[0].Body, "http://front.example/reset-password?token=")
+	tok := tokenFrom(t, m.sent[0])
+
+	// メール確認用のトークンとしては使えない
+	err := uc.VerifyEmail(ctx, tok)
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+
+	var saved string
+	m.users.On("FindForUpdate", mock.Anything, 7).Return(alice, nil).Once()
+	m.users.On("UpdatePartial", mock.Anything, 7, mock.MatchedBy(func(f *repository.UserUpdateFields) bool {
+		// 再設定できた＝アドレスの持ち主なので確認済みにもする
+		return f.PasswordHash != nil && f.EmailVerifiedAt != nil
+	})).Run(func(args mock.Arguments) {
+		saved = *args.Get(2).(*repository.UserUpdateFields).PasswordHash
+	}).Return(alice, nil).Once()
+	// 再設定したら他の端末のセッションも切る
+	m.sessions.On("LogoutAll", mock.Anything, 7).Return(nil).Once()
+	require.NoError(t, uc.ResetPassword(ctx, tok, "NewSecret_123!"))
+	require.NoError(t, bcrypt.CompareHashAndPassword([]byte(saved), []byte("NewSecret_123!")))
+
+	// パスワードが変わった後は同じリンクを使えない
+	changed := *alice
+	changed.Password = saved
+	changed.EmailVerifiedAt = ptr(now)
+	m.users.On("FindForUpdate", mock.Anything, 7).Return(&changed, nil).Once()
+	err = uc.ResetPassword(ctx, tok, "Another_123!")
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+}
+
+func TestPasswordReset_InvalidToken(t *testing.T) {
+	ctx := context.Background()
+	uc, _ := newUsecase(t)
+
+	err := uc.ResetPassword(ctx, "not-a-token", "NewSecret_123!")
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+
+	// 別の鍵で署名されたもの
+	other, err := jwt.NewActionToken("other_secret")
+	require.NoError(t, err)
+	forged, err := other.GenerateAction(&tempjwt.Action{
+		Purpose: tempjwt.PurposeResetPassword, UserID: 7, Fingerprint: "fp",
+	}, time.Minute)
+	require.NoError(t, err)
+	err = uc.ResetPassword(ctx, forged, "NewSecret_123!")
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+}
+
+func TestCheckSignIn(t *testing.T) {
+	ctx := context.Background()
+	cfg := func(on bool) *domain.RootConfig {
+		return &domain.RootConfig{ID: 1, IsEmailAuthenticationCheck: on}
+	}
+
+	t.Run("設定が無効なら誰でも通す", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.roots.On("Get", mock.Anything).Return(cfg(false), nil)
+		assert.NoError(t, uc.CheckSignIn(ctx, 7))
+	})
+
+	t.Run("RootConfig が無ければ無効扱い", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.roots.On("Get", mock.Anything).Return(nil, &ent.NotFoundError{})
+		assert.NoError(t, uc.CheckSignIn(ctx, 7))
+	})
+
+	cases := []struct {
+		name string
+		user *domain.User
+		ok   bool
+	}{
+		{"未確認は拒否", &domain.User{ID: 7, Email: ptr("a@example.com")}, false},
+		{"確認済みは通す", &domain.User{ID: 7, Email: ptr("a@example.com"), EmailVerifiedAt: ptr(now)}, true},
+		{"root は未確認でも通す", &domain.User{ID: 7, IsRoot: true}, true},
+		{"test は未確認でも通す", &domain.User{ID: 7, IsTest: true}, true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, m := newUsecase(t)
+			m.roots.On("Get", mock.Anything).Return(cfg(true), nil)
+			m.users.On("FindForUpdate", mock.Anything, 7).Return(tc.user, nil)
+			err := uc.CheckSignIn(ctx, 7)
+			if tc.ok {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, apperror.IsKind(err, apperror.Forbidden))
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package account
+
+import (
+	"context"
+	"strings"
+
+	"word_app/backend/src/domain/repository"
+	"word_app/backend/src/infrastructure/mailer"
+	"word_app/backend/src/usecase/shared/ucerr"
+	"word_app/backend/src/utils/tempjwt"
+)
+
+func (uc *AccountUsecase) SendVerification(ctx context.Context, email string) error {
+	u, err := uc.findByEmail(ctx, strings.TrimSpace(email))
+	if err != nil || u == nil {
+		return err
+	}
+	if u.EmailVerifiedAt != nil {
+		return nil
+	}
+
+	link, err := uc.link(u, tempjwt.PurposeVerifyEmail, uc.verifyTTL, "/verify-email")
+	if err != nil {
+		return err
+	}
+	return uc.send(ctx, mailer.Message{
+		To:      *u.Email,
+		Subject: "メールアドレスの確認",
+		Body: "以下のリンクを開いてメールアドレスの確認を完了してください。\n\n" +
+			link + "\n\n" +
+			"このメールに心当たりがない場合は破棄してください。\n",
+	})
+}
+
+func (uc *AccountUsecase) VerifyEmail(ctx context.Context, token string) error {
+	u, err := uc.loadByToken(ctx, token, tempjwt.PurposeVerifyEmail)
+	if err != nil {
+		return err
+	}
+	now := uc.clock.Now()
+	_, err = uc.userRepo.UpdatePartial(ctx, u.ID, &repository.UserUpdateFields{EmailVerifiedAt: &now})
+	return err
+}
+
+func (uc *AccountUsecase) CheckSignIn(ctx context.Context, userID int) error {
+	required, err := uc.VerificationRequired(ctx)
+	if err != nil || !required {
+		return err
+	}
+	u, err := uc.userRepo.FindForUpdate(ctx, userID)
+	if err != nil {
+		return err
+	}
+	// root は設定を戻せなくならないように、test はメールを持たないので対象外
+	if u.IsRoot || u.IsTest || u.EmailVerifiedAt != nil {
+		return nil
+	}
+	return ucerr.Forbidden("email not verified")
+}
@@ -2,27 +2,51 @@ package auth
 
 import (
 	"context"
-	"fmt"
+	"strings"
 	"time"
 
 	"word_app/backend/src/domain"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
+	"word_app/backend/src/usecase/session"
+	"word_app/backend/src/usecase/shared/ucerr"
 )
 
-func (u *AuthUsecase) StartLogin(_ context.Context, state, nonce string) string {
-	return u.provider.AuthURL(state, nonce)
+func (u *AuthUsecase) Providers() []string {
+	return u.providers.Names()
 }
 
-func (u *AuthUsecase) HandleCallback(ctx context.Context, code string) (*CallbackResult, error) {
-	id, err := u.provider.Exchange(ctx, code)
+func (u *AuthUsecase) StartLogin(_ context.Context, provider, state, nonce string) (string, error) {
+	p, err := u.provider(provider)
+	if err != nil {
+		return "", err
+	}
+	return p.AuthURL(state, nonce), nil
+}
+
+func (u *AuthUsecase) HandleCallback(ctx context.Context, provider, code, nonce string) (*CallbackResult, error) {
+	p, err := u.provider(provider)
+	if err != nil {
+		return nil, err
+	}
+	id, idTok, err := p.Exchange(ctx, code)
 	if err != nil {
 		return nil, err
 	}
+	// ID トークンはこのログインのために発行されたものに限る（他のログインで取ったトークンの使い回しを防ぐ）
+	if idTok != nil {
+		if err := p.ValidateNonce(idTok, nonce); err != nil {
+			return nil, ucerr.Unauthorized("invalid nonce")
+		}
+	}
 
 	// ユーザ検索
 	user, _ := u.userRepo.FindByProvider(ctx, id.Provider, id.Subject)
 	if user != nil {
-		token, _ := u.jwtGenerator.GenerateJWT(fmt.Sprintf("%d", user.ID))
-		return &CallbackResult{Token: token}, nil
+		pair, err := u.sessions.Issue(ctx, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		return &CallbackResult{Token: pair.AccessToken, RefreshToken: pair.RefreshToken, ExpiresIn: pair.ExpiresIn}, nil
 	}
 	// 初回登録フロー
 	temp, _ := u.tempJwtGen.GenerateTemp(id, 5*time.Minute)
@@ -33,20 +57,24 @@ func (u *AuthUsecase) HandleCallback(ctx context.Context, code string) (*Callbac
 	}, nil
 }
 
-func (u *AuthUsecase) CompleteSignUp(ctx context.Context, tempToken string, pass *string) (string, error) {
+func (u *AuthUsecase) CompleteSignUp(ctx context.Context, provider, tempToken string, pass *string) (*session.TokenPair, error) {
 	id, err := u.tempJwtGen.ParseTemp(tempToken)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	// 別のプロバイダで取った一時トークンは受け付けない
+	if !strings.EqualFold(id.Provider, provider) {
+		return nil, ucerr.Validation("provider mismatch")
 	}
 
 	user, err := domain.NewUser(id.Name, id.Email, pass)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	// Tx開始
 	txCtx, done, err := u.txm.Begin(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	commit := false
 	defer func() { _ = done(commit) }()
@@ -55,21 +83,30 @@ func (u *AuthUsecase) CompleteSignUp(ctx context.Context, tempToken string, pass
 
 	createdUser, err := u.userRepo.Create(ctx, user)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	ext.UserID = createdUser.ID
 	err = u.extAuthRepo.Create(ctx, ext)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	if err := u.settingRepo.CreateDefault(txCtx, createdUser.ID); err != nil {
-		return "", err
+		return nil, err
 	}
 
 	commit = true
 	if err := done(commit); err != nil {
-		return "", err
+		return nil, err
+	}
+	return u.sessions.Issue(ctx, createdUser.ID)
+}
+
+// provider は名前からプロバイダを引く。未登録（無効化を含む）なら NotFound
+func (u *AuthUsecase) provider(name string) (authprovider.Provider, error) {
+	p, ok := u.providers.Get(name)
+	if !ok {
+		return nil, ucerr.NotFound("unknown auth provider")
 	}
-	return u.jwtGenerator.GenerateJWT(fmt.Sprintf("%d", user.ID))
+	return p, nil
 }
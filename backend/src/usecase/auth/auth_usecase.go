@@ -3,7 +3,7 @@ package auth
 import (
 	"context"
 
-	"word_app/backend/src/infrastructure/auth/line"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
 	"word_app/backend/src/infrastructure/jwt"
 	ratelimiter "word_app/backend/src/infrastructure/ratelimit"
 	auth_repo "word_app/backend/src/infrastructure/repository/auth"
@@ -12,15 +12,17 @@ import (
 	user_repo "word_app/backend/src/infrastructure/repository/user"
 	userDailyUsageRepo "word_app/backend/src/infrastructure/repository/userdailyusage"
 	clock "word_app/backend/src/usecase/clock"
+	"word_app/backend/src/usecase/session"
 )
 
 type AuthUsecase struct {
 	txm                tx_repo.Manager
-	provider           line.Provider
+	providers          authprovider.Registry
 	userRepo           user_repo.Repository
 	settingRepo        setting_repo.UserConfigRepository
 	extAuthRepo        auth_repo.ExternalAuthRepository
 	jwtGenerator       jwt.JWTGenerator
+	sessions           session.Usecase
 	tempJwtGen         jwt.TempTokenGenerator
 	rootSettingRepo    setting_repo.RootConfigRepository
 	userDailyUsageRepo userDailyUsageRepo.Repository
@@ -30,11 +32,12 @@ type AuthUsecase struct {
 
 func NewUsecase(
 	txm tx_repo.Manager,
-	provider line.Provider,
+	providers authprovider.Registry,
 	userRepo user_repo.Repository,
 	settingRepo setting_repo.UserConfigRepository,
 	extAuthRepo auth_repo.ExternalAuthRepository,
 	jwtGen jwt.JWTGenerator,
+	sessions session.Usecase,
 	tempJwtGen jwt.TempTokenGenerator,
 	rootSettingRepo setting_repo.RootConfigRepository,
 	userDailyUsageRepo userDailyUsageRepo.Repository,
@@ -43,11 +46,12 @@ func NewUsecase(
 ) *AuthUsecase {
 	return &AuthUsecase{
 		txm:                txm,
-		provider:           provider,
+		providers:          providers,
 		userRepo:           userRepo,
 		settingRepo:        settingRepo,
 		extAuthRepo:        extAuthRepo,
 		jwtGenerator:       jwtGen,
+		sessions:           sessions,
 		tempJwtGen:         tempJwtGen,
 		rootSettingRepo:    rootSettingRepo,
 		userDailyUsageRepo: userDailyUsageRepo,
@@ -57,9 +61,11 @@ func NewUsecase(
 }
 
 type Usecase interface {
-	StartLogin(ctx context.Context, state, nonce string) string
-	HandleCallback(ctx context.Context, code string) (*CallbackResult, error)
-	CompleteSignUp(ctx context.Context, tempToken string, pass *string) (string, error)
+	// Providers はログインに使えるプロバイダ名を返す
+	Providers() []string
+	StartLogin(ctx context.Context, provider, state, nonce string) (string, error)
+	HandleCallback(ctx context.Context, provider, code, nonce string) (*CallbackResult, error)
+	CompleteSignUp(ctx context.Context, provider, tempToken string, pass *string) (*session.TokenPair, error)
 	TestLoginWithRateLimit(
 		ctx context.Context,
 		ip, uaHash, route, jump string,
@@ -69,6 +75,8 @@ type Usecase interface {
 
 type CallbackResult struct {
 	Token         string  `json:"token,omitempty"`
+	RefreshToken  string  `json:"refresh_token,omitempty"`
+	ExpiresIn     int     `json:"expires_in,omitempty"`
 	NeedPassword  bool    `json:"need_password,omitempty"`
 	TempToken     string  `json:"temp_token,omitempty"`
 	SuggestedMail *string `json:"suggested_mail,omitempty"`
@@ -0,0 +1,155 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"word_app/backend/src/domain"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
+	providermock "word_app/backend/src/mocks/infrastructure/auth/provider"
+	jwtmock "word_app/backend/src/mocks/infrastructure/jwt"
+	usermock "word_app/backend/src/mocks/infrastructure/repository/user"
+	sessionmock "word_app/backend/src/mocks/usecase/session"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/auth"
+	"word_app/backend/src/usecase/session"
+	"word_app/backend/src/utils/oauthutil"
+	"word_app/backend/src/utils/tempjwt"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type providerDeps struct {
+	google   *providermock.MockProvider
+	github   *providermock.MockProvider
+	users    *usermock.MockRepository
+	temp     *jwtmock.MockTempTokenGenerator
+	sessions *sessionmock.MockUsecase
+}
+
+func newProviderUC(t *testing.T) (*auth.AuthUsecase, *providerDeps) {
+	d := &providerDeps{
+		google:   providermock.NewMockProvider(t),
+		github:   providermock.NewMockProvider(t),
+		users:    usermock.NewMockRepository(t),
+		temp:     jwtmock.NewMockTempTokenGenerator(t),
+		sessions: sessionmock.NewMockUsecase(t),
+	}
+	providers := authprovider.NewRegistry()
+	providers.Register("google", d.google)
+	providers.Register("GitHub", d.github)
+	uc := auth.NewUsecase(nil, providers, d.users, nil, nil, nil, d.sessions, d.temp, nil, nil,
+		&mockClock{now: time.Now()}, nil)
+	return uc, d
+}
+
+func TestAuthUsecase_Providers(t *testing.T) {
+	uc, _ := newProviderUC(t)
+	assert.Equal(t, []string{"github", "google"}, uc.Providers())
+}
+
+func TestAuthUsecase_StartLogin(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("名前でプロバイダを選ぶ", func(t *testing.T) {
+		uc, d := newProviderUC(t)
+		d.github.On("AuthURL", "st", "no").Return("https://github.example/authorize?state=st")
+
+		url, err := uc.StartLogin(ctx, "github", "st", "no")
+		require.NoError(t, err)
+		assert.Equal(t, "https://github.example/authorize?state=st", url)
+	})
+
+	t.Run("未登録なら NotFound", func(t *testing.T) {
+		uc, _ := newProviderUC(t)
+		_, err := uc.StartLogin(ctx, "line", "st", "no")
+		assert.True(t, apperror.IsKind(err, apperror.NotFound))
+	})
+}
+
+func TestAuthUsecase_HandleCallback(t *testing.T) {
+	ctx := context.Background()
+	email := "alice@example.com"
+	id := &tempjwt.Identity{Provider: "google", Subject: "g-1", Email: &email, Name: "Alice"}
+	idTok := &oidc.IDToken{Subject: "g-1"}
+
+	t.Run("連携済みならトークンを発行", func(t *testing.T) {
+		uc, d := newProviderUC(t)
+		d.google.On("Exchange", mock.Anything, "code").Return(id, idTok, nil)
+		d.google.On("ValidateNonce", idTok, "nonce").Return(nil)
+		d.users.On("FindByProvider", mock.Anything, "google", "g-1").Return(&domain.User{ID: 3}, nil)
+		d.sessions.On("Issue", mock.Anything, 3).
+			Return(&session.TokenPair{AccessToken: "at", RefreshToken: "rt", ExpiresIn: 900}, nil)
+
+		res, err := uc.HandleCallback(ctx, "google", "code", "nonce")
+		require.NoError(t, err)
+		assert.Equal(t, "at", res.Token)
+		assert.Equal(t, "rt", res.RefreshToken)
+		assert.False(t, res.NeedPassword)
+	})
+
+	t.Run("初回は一時トークンを返す", func(t *testing.T) {
+		uc, d := newProviderUC(t)
+		d.google.On("Exchange", mock.Anything, "code").Return(id, idTok, nil)
+		d.google.On("ValidateNonce", idTok, "nonce").Return(nil)
+		d.users.On("FindByProvider", mock.Anything, "google", "g-1").
+			Return(nil, apperror.NotFoundf("user not found", nil))
+		d.temp.On("GenerateTemp", id, 5*time.Minute).Return("temp", nil)
+
+		res, err := uc.HandleCallback(ctx, "google", "code", "nonce")
+		require.NoError(t, err)
+		assert.True(t, res.NeedPassword)
+		assert.Equal(t, "temp", res.TempToken)
+		assert.Equal(t, &email, res.SuggestedMail)
+	})
+
+	t.Run("交換に失敗したらエラー", func(t *testing.T) {
+		uc, d := newProviderUC(t)
+		d.google.On("Exchange", mock.Anything, "code").Return(nil, nil, errors.New("invalid_grant"))
+
+		_, err := uc.HandleCallback(ctx, "google", "code", "nonce")
+		assert.EqualError(t, err, "invalid_grant")
+	})
+
+	t.Run("未登録なら NotFound", func(t *testing.T) {
+		uc, _ := newProviderUC(t)
+		_, err := uc.HandleCallback(ctx, "keycloak", "code", "nonce")
+		assert.True(t, apperror.IsKind(err, apperror.NotFound))
+	})
+
+	t.Run("nonce が違う ID トークンは拒否", func(t *testing.T) {
+		uc, d := newProviderUC(t)
+		d.google.On("Exchange", mock.Anything, "code").Return(id, idTok, nil)
+		d.google.On("ValidateNonce", idTok, "other").Return(oauthutil.ErrNonceMismatch)
+
+		_, err := uc.HandleCallback(ctx, "google", "code", "other")
+		assert.True(t, apperror.IsKind(err, apperror.Unauthorized))
+	})
+
+	t.Run("ID トークンの無いプロバイダは nonce を見ない", func(t *testing.T) {
+		uc, d := newProviderUC(t)
+		gh := &tempjwt.Identity{Provider: "github", Subject: "42", Name: "octocat"}
+		d.github.On("Exchange", mock.Anything, "code").Return(gh, nil, nil)
+		d.users.On("FindByProvider", mock.Anything, "github", "42").Return(&domain.User{ID: 4}, nil)
+		d.sessions.On("Issue", mock.Anything, 4).
+			Return(&session.TokenPair{AccessToken: "at", RefreshToken: "rt", ExpiresIn: 900}, nil)
+
+		res, err := uc.HandleCallback(ctx, "github", "code", "")
+		require.NoError(t, err)
+		assert.Equal(t, "at", res.Token)
+	})
+}
+
+func TestAuthUsecase_CompleteSignUp_ProviderMismatch(t *testing.T) {
+	uc, d := newProviderUC(t)
+	d.temp.On("ParseTemp", "temp").Return(&tempjwt.Identity{Provider: "google", Subject: "g-1"}, nil)
+
+	// google で取った一時トークンを github の完了 API に送っても登録しない
+	_, err := uc.CompleteSignUp(context.Background(), "github", "temp", nil)
+	assert.True(t, apperror.IsKind(err, apperror.Validation))
+}
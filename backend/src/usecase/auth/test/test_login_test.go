@@ -7,8 +7,9 @@ import (
 	"time"
 
 	"word_app/backend/src/domain"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
 	"word_app/backend/src/infrastructure/ratelimit"
-	linemock "word_app/backend/src/mocks/infrastructure/auth/line"
+	providermock "word_app/backend/src/mocks/infrastructure/auth/provider"
 	jwtmock "word_app/backend/src/mocks/infrastructure/jwt"
 	ratelimitmock "word_app/backend/src/mocks/infrastructure/ratelimit"
 	authmock "word_app/backend/src/mocks/infrastructure/repository/auth"
@@ -30,8 +31,10 @@ func (m *mockClock) Now() time.Time {
 	return m.now
 }
 
-func makeAuthUC(t *testing.T, tm *txmock.MockManager, provider *linemock.MockProvider, userRepo *usermock.MockRepository, settingRepo *settingmock.MockUserConfigRepository, extAuthRepo *authmock.MockExternalAuthRepository, jwtGen *jwtmock.MockJWTGenerator, tempJwtGen *jwtmock.MockTempTokenGenerator, rootSettingRepo *settingmock.MockRootConfigRepository, userDailyUsageRepo *udumock.MockRepository, clock *mockClock, rateLimiter *ratelimitmock.MockRateLimiter) *auth.AuthUsecase {
-	return auth.NewUsecase(tm, provider, userRepo, settingRepo, extAuthRepo, jwtGen, tempJwtGen, rootSettingRepo, userDailyUsageRepo, clock, rateLimiter)
+func makeAuthUC(t *testing.T, tm *txmock.MockManager, provider *providermock.MockProvider, userRepo *usermock.MockRepository, settingRepo *settingmock.MockUserConfigRepository, extAuthRepo *authmock.MockExternalAuthRepository, jwtGen *jwtmock.MockJWTGenerator, tempJwtGen *jwtmock.MockTempTokenGenerator, rootSettingRepo *settingmock.MockRootConfigRepository, userDailyUsageRepo *udumock.MockRepository, clock *mockClock, rateLimiter *ratelimitmock.MockRateLimiter) *auth.AuthUsecase {
+	providers := authprovider.NewRegistry()
+	providers.Register("line", provider)
+	return auth.NewUsecase(tm, providers, userRepo, settingRepo, extAuthRepo, jwtGen, nil, tempJwtGen, rootSettingRepo, userDailyUsageRepo, clock, rateLimiter)
 }
 
 func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
@@ -46,7 +49,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("success - test user created (rate limit passed, no cache)", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -77,7 +80,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 		userDailyUsageRepo.On("CreateIfNotExists", ctx, 123, now).Return(nil)
 
 		// JWT生成
-		jwtGen.On("GenerateJWT", "123").Return("test-jwt-token", nil)
+		jwtGen.On("GenerateJWT", "123", 0).Return("test-jwt-token", nil)
 
 		// 成功レスポンスの保存
 		rateLimiter.On("SaveLastResult", ctx, testIP, testUAHash, testRoute, mock.Anything).
@@ -101,7 +104,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("success - cached response returned (rate limit passed, cache exists)", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -140,7 +143,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("success - cached response returned (rate limit exceeded, cache exists)", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -177,7 +180,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - rate limit exceeded (no cache)", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -215,7 +218,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - CheckRateLimit fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -246,7 +249,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - test user mode disabled", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -275,7 +278,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - Get root setting fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -303,7 +306,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - Begin fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -332,7 +335,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - Create user fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -363,7 +366,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - CreateDefault fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -395,7 +398,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - CreateIfNotExists fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -428,7 +431,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 
 	t.Run("error - GenerateJWT fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -449,7 +452,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 			Return(&domain.User{ID: 123, Name: "テストユーザー@abc12345"}, nil)
 		settingRepo.On("CreateDefault", ctx, 123).Return(nil)
 		userDailyUsageRepo.On("CreateIfNotExists", ctx, 123, now).Return(nil)
-		jwtGen.On("GenerateJWT", "123").Return("", errors.New("jwt generation error"))
+		jwtGen.On("GenerateJWT", "123", 0).Return("", errors.New("jwt generation error"))
 
 		result, lastPayload, retryAfter, err := uc.TestLoginWithRateLimit(ctx, testIP, testUAHash, testRoute, testJump)
 
@@ -476,7 +479,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 		for _, tt := range tests {
 			t.Run(tt.name, func(t *testing.T) {
 				tm := txmock.NewMockManager(t)
-				provider := linemock.NewMockProvider(t)
+				provider := providermock.NewMockProvider(t)
 				userRepo := usermock.NewMockRepository(t)
 				settingRepo := settingmock.NewMockUserConfigRepository(t)
 				extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -497,7 +500,7 @@ func TestAuthUsecase_TestLoginWithRateLimit(t *testing.T) {
 					Return(&domain.User{ID: 123, Name: "テストユーザー@abc12345"}, nil)
 				settingRepo.On("CreateDefault", ctx, 123).Return(nil)
 				userDailyUsageRepo.On("CreateIfNotExists", ctx, 123, now).Return(nil)
-				jwtGen.On("GenerateJWT", "123").Return("test-token", nil)
+				jwtGen.On("GenerateJWT", "123", 0).Return("test-token", nil)
 				rateLimiter.On("SaveLastResult", ctx, testIP, testUAHash, testRoute, mock.Anything).
 					Return(nil)
 
@@ -6,7 +6,8 @@ import (
 	"testing"
 	"time"
 
-	linemock "word_app/backend/src/mocks/infrastructure/auth/line"
+	authprovider "word_app/backend/src/infrastructure/auth/provider"
+	providermock "word_app/backend/src/mocks/infrastructure/auth/provider"
 	jwtmock "word_app/backend/src/mocks/infrastructure/jwt"
 	ratelimitmock "word_app/backend/src/mocks/infrastructure/ratelimit"
 	authmock "word_app/backend/src/mocks/infrastructure/repository/auth"
@@ -20,8 +21,10 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
-func makeAuthUCForTestLogout(t *testing.T, tm *txmock.MockManager, provider *linemock.MockProvider, userRepo *usermock.MockRepository, settingRepo *settingmock.MockUserConfigRepository, extAuthRepo *authmock.MockExternalAuthRepository, jwtGen *jwtmock.MockJWTGenerator, tempJwtGen *jwtmock.MockTempTokenGenerator, rootSettingRepo *settingmock.MockRootConfigRepository, userDailyUsageRepo *udumock.MockRepository, clock clock.Clock, rateLimiter *ratelimitmock.MockRateLimiter) *auth.AuthUsecase {
-	return auth.NewUsecase(tm, provider, userRepo, settingRepo, extAuthRepo, jwtGen, tempJwtGen, rootSettingRepo, userDailyUsageRepo, clock, rateLimiter)
+func makeAuthUCForTestLogout(t *testing.T, tm *txmock.MockManager, provider *providermock.MockProvider, userRepo *usermock.MockRepository, settingRepo *settingmock.MockUserConfigRepository, extAuthRepo *authmock.MockExternalAuthRepository, jwtGen *jwtmock.MockJWTGenerator, tempJwtGen *jwtmock.MockTempTokenGenerator, rootSettingRepo *settingmock.MockRootConfigRepository, userDailyUsageRepo *udumock.MockRepository, clock clock.Clock, rateLimiter *ratelimitmock.MockRateLimiter) *auth.AuthUsecase {
+	providers := authprovider.NewRegistry()
+	providers.Register("line", provider)
+	return auth.NewUsecase(tm, providers, userRepo, settingRepo, extAuthRepo, jwtGen, nil, tempJwtGen, rootSettingRepo, userDailyUsageRepo, clock, rateLimiter)
 }
 
 func TestAuthUsecase_TestLogout(t *testing.T) {
@@ -29,7 +32,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("success - test user deleted", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -54,7 +57,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("success - already deleted (idempotent)", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -79,7 +82,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("error - forbidden for non-test user", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -106,7 +109,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("error - Begin fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -129,7 +132,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("error - DeleteIfTest fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -153,7 +156,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("error - Exists fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -178,7 +181,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("error - IsTest fails", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
@@ -204,7 +207,7 @@ func TestAuthUsecase_TestLogout(t *testing.T) {
 
 	t.Run("success - test user exists but DeleteIfTest returns false", func(t *testing.T) {
 		tm := txmock.NewMockManager(t)
-		provider := linemock.NewMockProvider(t)
+		provider := providermock.NewMockProvider(t)
 		userRepo := usermock.NewMockRepository(t)
 		settingRepo := settingmock.NewMockUserConfigRepository(t)
 		extAuthRepo := authmock.NewMockExternalAuthRepository(t)
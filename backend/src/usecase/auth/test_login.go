@@ -104,7 +104,8 @@ func (uc *AuthUsecase) TestLoginWithRateLimit(
 	}
 
 	// 3) JWT発行
-	token, err := uc.jwtGenerator.GenerateJWT(fmt.Sprintf("%d", createdUser.ID))
+	// 直近の結果を再送するため、ローテーションするリフレッシュトークンは発行しない
+	token, err := uc.jwtGenerator.GenerateJWT(fmt.Sprintf("%d", createdUser.ID), createdUser.TokenVersion)
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -0,0 +1,122 @@
+package bulk
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"word_app/backend/src/domain/lemma"
+)
+
+// 熟語・句動詞として照合する最大語数（"at the end of the day" のような長いものは対象外）
+const maxPhraseWords = 5
+
+// 熟語の候補を 1 回の IN (...) で引く最大件数。長い本文は分けて引く
+const maxPhraseLookup = 1000
+
+// 文・節の区切り。句は区切りをまたいで照合しない
+var reSegment = regexp.MustCompile(`[^.!?;:,()\[\]"\n]+`)
+
+// splitSegments は本文を区切りごとの小文字トークン列に分ける
+func splitSegments(text string) [][]string {
+	var segs [][]string
+	for _, s := range reSegment.FindAllString(text, -1) {
+		toks := reWord.FindAllString(s, -1)
+		if len(toks) == 0 {
+			continue
+		}
+		for i, t := range toks {
+			toks[i] = strings.ToLower(t)
+		}
+		segs = append(segs, toks)
+	}
+	return segs
+}
+
+// phraseNgrams は 2〜maxPhraseWords 語の連続をユニークに列挙する。
+// 先頭の語が活用している句（"looked forward to"）も引けるよう、先頭を見出し語の候補に替えた形も加える
+func phraseNgrams(segs [][]string) []string {
+	seen := make(map[string]struct{})
+	var out []string
+	add := func(g string) {
+		if _, ok := seen[g]; ok {
+			return
+		}
+		seen[g] = struct{}{}
+		out = append(out, g)
+	}
+	for _, toks := range segs {
+		for i := range toks {
+			heads := lemma.Candidates(toks[i])
+			for n := 2; n <= maxPhraseWords && i+n <= len(toks); n++ {
+				rest := strings.Join(toks[i+1:i+n], " ")
+				add(toks[i] + " " + rest)
+				for _, h := range heads {
+					add(h + " " + rest)
+				}
+			}
+		}
+	}
+	return out
+}
+
+// findPhrases は master にある熟語（名前に空白を含む語）を map[name]id で返す
+func (uc *tokenizeUsecase) findPhrases(ctx context.Context, segs [][]string) (map[string]int, error) {
+	grams := phraseNgrams(segs)
+	phrases := make(map[string]int)
+	for start := 0; start < len(grams); start += maxPhraseLookup {
+		found, err := uc.wordRepo.FindIDsByNames(ctx, grams[start:min(start+maxPhraseLookup, len(grams))])
+		if err != nil {
+			return nil, err
+		}
+		for name, id := range found {
+			phrases[name] = id
+		}
+	}
+	return phrases, nil
+}
+
+// joinUnits は各区切りを左から最長一致で熟語にまとめ、出現順の単位列を返す。
+// 熟語に取り込まれた語は単独の語としては出さない。
+// 先頭の語を見出し語に戻して当たった句は本文の形のまま単位にし、inflected に 本文の形 → 熟語 を入れる
+func joinUnits(segs [][]string, phrases map[string]int) (units []string, inflected map[string]string) {
+	inflected = make(map[string]string)
+	for _, toks := range segs {
+		for i := 0; i < len(toks); {
+			n := 1
+			if len(phrases) > 0 {
+				if m, name := matchPhrase(toks[i:], phrases); m > 0 {
+					n = m
+					if surface := strings.Join(toks[i:i+n], " "); surface != name {
+						inflected[surface] = name
+					}
+				}
+			}
+			units = append(units, strings.Join(toks[i:i+n], " "))
+			i += n
+		}
+	}
+	return units, inflected
+}
+
+// matchPhrase は toks の先頭から始まる最長の熟語の語数と熟語名を返す（無ければ 0）。
+// 同じ長さなら本文の形を、続けて先頭の語を見出し語の候補に替えた形を試す
+func matchPhrase(toks []string, phrases map[string]int) (int, string) {
+	if len(toks) < 2 {
+		return 0, ""
+	}
+	heads := lemma.Candidates(toks[0])
+	for m := min(maxPhraseWords, len(toks)); m >= 2; m-- {
+		g := strings.Join(toks[:m], " ")
+		if _, ok := phrases[g]; ok {
+			return m, g
+		}
+		rest := strings.Join(toks[1:m], " ")
+		for _, h := range heads {
+			if _, ok := phrases[h+" "+rest]; ok {
+				return m, h + " " + rest
+			}
+		}
+	}
+	return 0, ""
+}
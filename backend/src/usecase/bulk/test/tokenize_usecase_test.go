@@ -3,6 +3,9 @@ package bulk_test
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -11,6 +14,7 @@ import (
 	regmock "word_app/backend/src/mocks/infrastructure/repository/registeredword"
 	udumock "word_app/backend/src/mocks/infrastructure/repository/userdailyusage"
 	wordmock "word_app/backend/src/mocks/infrastructure/repository/word"
+	"word_app/backend/src/models"
 	"word_app/backend/src/usecase/bulk"
 	"word_app/backend/src/usecase/shared/ucerr"
 
@@ -60,6 +64,8 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"hello world", "hello world test", "world test"}).
+			Return(map[string]int{}, nil)
 		wordRepo.On("FindIDsByNames", ctx, []string{"hello", "world", "test"}).
 			Return(map[string]int{"hello": 1, "world": 2, "test": 3}, nil)
 		// mapの順序は不定なので、mock.MatchedByを使用
@@ -71,7 +77,7 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 			return len(ids) == 3 && idMap[1] && idMap[2] && idMap[3]
 		})).Return(map[int]struct{}{1: {}}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello world test")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello world test")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"world", "test"}, cands)
@@ -89,6 +95,8 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"hello world"}).
+			Return(map[string]int{}, nil)
 		wordRepo.On("FindIDsByNames", ctx, []string{"hello", "world"}).
 			Return(map[string]int{"hello": 1, "world": 2}, nil)
 		// mapの順序は不定なので、mock.MatchedByを使用
@@ -100,7 +108,7 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 			return len(ids) == 2 && idMap[1] && idMap[2]
 		})).Return(map[int]struct{}{}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello world")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello world")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"hello", "world"}, cands)
@@ -118,13 +126,17 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"hello notexists"}).
+			Return(map[string]int{}, nil)
 		wordRepo.On("FindIDsByNames", ctx, []string{"hello", "notexists"}).
 			Return(map[string]int{"hello": 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"notexist"}).
+			Return(map[string]int{}, nil)
 		regReadRepo.On("ActiveWordIDSetByUser", ctx, 1, mock.MatchedBy(func(ids []int) bool {
 			return len(ids) == 1 && ids[0] == 1
 		})).Return(map[int]struct{}{}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello notexists")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello notexists")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"hello"}, cands)
@@ -143,7 +155,7 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "")
 
 		assert.NoError(t, err)
 		assert.Nil(t, cands)
@@ -161,13 +173,15 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"hello hello", "hello hello hello"}).
+			Return(map[string]int{}, nil)
 		wordRepo.On("FindIDsByNames", ctx, []string{"hello"}).
 			Return(map[string]int{"hello": 1}, nil)
 		regReadRepo.On("ActiveWordIDSetByUser", ctx, 1, mock.MatchedBy(func(ids []int) bool {
 			return len(ids) == 1 && ids[0] == 1
 		})).Return(map[int]struct{}{}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello HELLO hello")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello HELLO hello")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"hello"}, cands) // 正規化されて1つ
@@ -185,6 +199,8 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"don't can't"}).
+			Return(map[string]int{}, nil)
 		wordRepo.On("FindIDsByNames", ctx, []string{"don't", "can't"}).
 			Return(map[string]int{"don't": 1, "can't": 2}, nil)
 		// mapの順序は不定なので、mock.MatchedByを使用
@@ -196,7 +212,7 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 			return len(ids) == 2 && idMap[1] && idMap[2]
 		})).Return(map[int]struct{}{}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "don't can't")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "don't can't")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"don't", "can't"}, cands)
@@ -204,6 +220,164 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 		assert.Empty(t, notExist)
 		userDailyUsageRepo.AssertExpectations(t)
 	})
+	t.Run("inflected forms resolve to lemmas", func(t *testing.T) {
+		wordRepo := wordmock.NewMockReadRepository(t)
+		regReadRepo := regmock.NewMockReadRepository(t)
+		userDailyUsageRepo := udumock.NewMockRepository(t)
+
+		uc := makeTokenizeUC(t, wordRepo, regReadRepo, userDailyUsageRepo, clock, limits)
+
+		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
+			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		// 熟語の候補には先頭の語を見出し語の候補に替えた形も入る
+		wordRepo.On("FindIDsByNames", ctx, []string{
+			"run running", "run running went", "run running went studies",
+			"running went", "runn went", "run went", "runne went",
+			"running went studies", "runn went studies", "run went studies", "runne went studies",
+			"went studies", "go studies",
+		}).Return(map[string]int{}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"run", "running", "went", "studies"}).
+			Return(map[string]int{"run": 1}, nil)
+		// run は 1 回目で当たっているので引き直さない
+		wordRepo.On("FindIDsByNames", ctx, []string{"runn", "runne", "go", "study", "studie"}).
+			Return(map[string]int{"go": 2, "study": 3}, nil)
+		regReadRepo.On("ActiveWordIDSetByUser", ctx, 1, mock.MatchedBy(func(ids []int) bool {
+			idMap := make(map[int]bool)
+			for _, id := range ids {
+				idMap[id] = true
+			}
+			return len(ids) == 3 && idMap[1] && idMap[2] && idMap[3]
+		})).Return(map[int]struct{}{2: {}}, nil)
+
+		cands, regs, notExist, lemmas, err := uc.Execute(ctx, 1, "run running went studies")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"run", "study"}, cands) // running は run にまとまる
+		assert.Equal(t, []string{"go"}, regs)
+		assert.Empty(t, notExist)
+		assert.Equal(t, []models.BulkLemma{
+			{Surface: "running", Lemma: "run"},
+			{Surface: "went", Lemma: "go"},
+			{Surface: "studies", Lemma: "study"},
+		}, lemmas)
+		userDailyUsageRepo.AssertExpectations(t)
+	})
+
+	t.Run("idioms by longest match", func(t *testing.T) {
+		wordRepo := wordmock.NewMockReadRepository(t)
+		regReadRepo := regmock.NewMockReadRepository(t)
+		userDailyUsageRepo := udumock.NewMockRepository(t)
+
+		uc := makeTokenizeUC(t, wordRepo, regReadRepo, userDailyUsageRepo, clock, limits)
+
+		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
+			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		// 区切り（.）をまたぐ "it we" などは照合しない
+		wordRepo.On("FindIDsByNames", ctx, []string{
+			"look forward", "look forward to", "look forward to it",
+			"forward to", "forward to it", "to it",
+			"we look", "we look forward",
+		}).Return(map[string]int{"look forward": 10, "look forward to": 11}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"it", "we"}).
+			Return(map[string]int{"it": 2, "we": 3}, nil)
+		regReadRepo.On("ActiveWordIDSetByUser", ctx, 1, mock.MatchedBy(func(ids []int) bool {
+			idMap := make(map[int]bool)
+			for _, id := range ids {
+				idMap[id] = true
+			}
+			return len(ids) == 4 && idMap[2] && idMap[3] && idMap[10] && idMap[11]
+		})).Return(map[int]struct{}{10: {}}, nil)
+
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Look forward to it. We look forward")
+
+		assert.NoError(t, err)
+		// look / forward / to は熟語に取り込まれるので単独では出さない
+		assert.Equal(t, []string{"look forward to", "it", "we"}, cands)
+		assert.Equal(t, []string{"look forward"}, regs)
+		assert.Empty(t, notExist)
+		userDailyUsageRepo.AssertExpectations(t)
+	})
+
+	t.Run("idioms with an inflected head", func(t *testing.T) {
+		wordRepo := wordmock.NewMockReadRepository(t)
+		regReadRepo := regmock.NewMockReadRepository(t)
+		userDailyUsageRepo := udumock.NewMockRepository(t)
+
+		uc := makeTokenizeUC(t, wordRepo, regReadRepo, userDailyUsageRepo, clock, limits)
+
+		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
+			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		wordRepo.On("FindIDsByNames", ctx, mock.MatchedBy(func(names []string) bool {
+			return slices.Contains(names, "look forward to")
+		})).Return(map[string]int{"look forward to": 11}, nil)
+		wordRepo.On("FindIDsByNames", ctx, []string{"we", "it", "are"}).
+			Return(map[string]int{"it": 2, "we": 3, "are": 4}, nil)
+		regReadRepo.On("ActiveWordIDSetByUser", ctx, 1, mock.MatchedBy(func(ids []int) bool {
+			return len(ids) == 4
+		})).Return(map[int]struct{}{}, nil)
+
+		cands, regs, notExist, lemmas, err := uc.Execute(ctx, 1, "We looked forward to it. We are looking forward to it")
+
+		assert.NoError(t, err)
+		// looked / looking で始まる句も look forward to にまとまり、forward / to は単独で出さない
+		assert.Equal(t, []string{"we", "look forward to", "it", "are"}, cands)
+		assert.Empty(t, regs)
+		assert.Empty(t, notExist)
+		assert.Equal(t, []models.BulkLemma{
+			{Surface: "looked forward to", Lemma: "look forward to"},
+			{Surface: "looking forward to", Lemma: "look forward to"},
+		}, lemmas)
+		userDailyUsageRepo.AssertExpectations(t)
+	})
+
+	t.Run("long text splits the idiom lookup", func(t *testing.T) {
+		wordRepo := wordmock.NewMockReadRepository(t)
+		regReadRepo := regmock.NewMockReadRepository(t)
+		userDailyUsageRepo := udumock.NewMockRepository(t)
+
+		// 熟語が語数の上限で切られないよう上限を広げる
+		uc := makeTokenizeUC(t, wordRepo, regReadRepo, userDailyUsageRepo, clock,
+			&config.LimitsCfg{BulkMaxPerDay: 5, BulkTokenizeMaxTokens: 500})
+
+		// 活用しない 400 語（熟語の候補は 1,000 件を超える）の後ろに熟語を置く
+		var sb strings.Builder
+		for i := 0; i < 400; i++ {
+			fmt.Fprintf(&sb, "z%c%c ", 'a'+i/26, 'a'+i%26)
+		}
+		sb.WriteString("look forward to")
+
+		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
+			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
+		var phraseCalls [][]string
+		wordRepo.On("FindIDsByNames", ctx, mock.MatchedBy(func(names []string) bool {
+			return strings.Contains(names[0], " ")
+		})).Run(func(args mock.Arguments) {
+			phraseCalls = append(phraseCalls, args.Get(1).([]string))
+		}).Return(func(_ context.Context, names []string) map[string]int {
+			if slices.Contains(names, "look forward to") {
+				return map[string]int{"look forward to": 11}
+			}
+			return map[string]int{}
+		}, nil)
+		wordRepo.On("FindIDsByNames", ctx, mock.Anything).Return(map[string]int{}, nil)
+		regReadRepo.On("ActiveWordIDSetByUser", ctx, 1, []int{11}).Return(map[int]struct{}{}, nil)
+
+		cands, _, notExist, _, err := uc.Execute(ctx, 1, sb.String())
+
+		assert.NoError(t, err)
+		// 1 回の IN (...) は 1,000 件まで。後ろの塊で当たった熟語もまとめる
+		assert.Len(t, phraseCalls, 2)
+		total := 0
+		for _, names := range phraseCalls {
+			assert.LessOrEqual(t, len(names), 1000)
+			total += len(names)
+		}
+		assert.Greater(t, total, 1000)
+		assert.Equal(t, []string{"look forward to"}, cands)
+		assert.Len(t, notExist, 400)
+		userDailyUsageRepo.AssertExpectations(t)
+	})
+
 	t.Run("non-test user unlimited quota", func(t *testing.T) {
 		wordRepo := wordmock.NewMockReadRepository(t)
 		regReadRepo := regmock.NewMockReadRepository(t)
@@ -219,7 +393,7 @@ func testTokenizeExecute_SuccessCases(t *testing.T, ctx context.Context, now tim
 			return len(ids) == 1 && ids[0] == 1
 		})).Return(map[int]struct{}{}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 2, "Hello")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 2, "Hello")
 
 		assert.NoError(t, err)
 		assert.Equal(t, []string{"hello"}, cands)
@@ -241,7 +415,7 @@ func testTokenizeExecute_ErrorCases(t *testing.T, ctx context.Context, now time.
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(nil, ucerr.TooManyRequests("daily quota exceeded"))
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello world")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello world")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "quota")
@@ -267,7 +441,7 @@ func testTokenizeExecute_ErrorCases(t *testing.T, ctx context.Context, now time.
 		userDailyUsageRepo.On("IncBulkOr429", ctx, 1, now, 999999).
 			Return(&domain.DailyUsageUpdateResult{BulkCount: 1}, nil)
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, largeText)
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, largeText)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "too many tokens")
@@ -289,7 +463,7 @@ func testTokenizeExecute_ErrorCases(t *testing.T, ctx context.Context, now time.
 		wordRepo.On("FindIDsByNames", ctx, []string{"hello"}).
 			Return(nil, errors.New("database error"))
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
@@ -314,7 +488,7 @@ func testTokenizeExecute_ErrorCases(t *testing.T, ctx context.Context, now time.
 			return len(ids) == 1 && ids[0] == 1
 		})).Return(nil, errors.New("database error"))
 
-		cands, regs, notExist, err := uc.Execute(ctx, 1, "Hello")
+		cands, regs, notExist, _, err := uc.Execute(ctx, 1, "Hello")
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "database error")
@@ -4,23 +4,27 @@ package bulk
 import (
 	"context"
 	"fmt"
-	"regexp"
 	"strings"
 
 	"word_app/backend/config"
+	"word_app/backend/src/domain/lemma"
+	"word_app/backend/src/domain/spelling"
 	"word_app/backend/src/infrastructure/repository/registeredword"
 	udurepo "word_app/backend/src/infrastructure/repository/userdailyusage"
 	"word_app/backend/src/infrastructure/repository/word"
 	"word_app/backend/src/middleware/jwt"
+	"word_app/backend/src/models"
 	"word_app/backend/src/usecase/clock"
 )
 
 // 正規表現で単語抽出（英字と省略形）
-// 例: don't -> 1語として扱う
-var reWord = regexp.MustCompile(`[A-Za-z]+(?:'[A-Za-z]+)?`)
+// 入力式クイズの採点と同じ規則を使う
+var reWord = spelling.WordPattern
 
 type TokenizeUsecase interface {
-	Execute(ctx context.Context, userID int, text string) (cands, regs, notExist []string, err error)
+	// cands / regs は見出し語（熟語は "look forward to" のように 1 件）、notExist は本文の形のまま。
+	// lemmas は活用形から見出し語に戻して当たった語の対応（running → run）
+	Execute(ctx context.Context, userID int, text string) (cands, regs, notExist []string, lemmas []models.BulkLemma, err error)
 }
 
 type tokenizeUsecase struct {
@@ -61,6 +65,7 @@ func (uc *tokenizeUsecase) Execute(
 	[]string,
 	[]string,
 	[]string,
+	[]models.BulkLemma,
 	error,
 ) {
 	// 日次クォータ上限取得
@@ -81,56 +86,148 @@ func (uc *tokenizeUsecase) Execute(
 	// 0) 日次クォータ消費（原子的に +1、上限なら 429 相当エラーを返す）
 	if _, err := uc.userDailyUsageRepo.IncBulkOr429(ctx, userID, uc.clock.Now(), effectiveCap); err != nil {
 		// ucerr.TooManyRequests を返す
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
 	// 1) トークン抽出
 	raw := reWord.FindAllString(text, -1)
 	if len(raw) == 0 {
-		return nil, nil, nil, nil
+		return nil, nil, nil, nil, nil
 	}
 	// 荒いフィルタ：生トークンが上限の5倍超なら早期終了
 	if len(raw) > uc.maxTokens*5 {
-		return nil, nil, nil, fmt.Errorf("too many tokens: %d > %d", len(raw), uc.maxTokens*5)
+		return nil, nil, nil, nil, fmt.Errorf("too many tokens: %d > %d", len(raw), uc.maxTokens*5)
 	}
 
-	// 2) 正規化（小文字化＋ユニーク＋maxTokens で打ち切り）
-	words := uniqueLowerLimited(raw, uc.maxTokens)
-	if len(words) == 0 {
-		return nil, nil, nil, nil
+	// 2) 熟語・句動詞を最長一致でまとめる
+	segs := splitSegments(text)
+	phrases, err := uc.findPhrases(ctx, segs)
+	if err != nil {
+		return nil, nil, nil, nil, err
 	}
 
-	// 3) master word から存在する単語の ID を取得
-	nameToID, err := uc.wordRepo.FindIDsByNames(ctx, words)
-	if err != nil {
-		return nil, nil, nil, err
+	// 3) 正規化（小文字化＋ユニーク＋maxTokens で打ち切り）
+	//    先頭の語が活用している熟語（looked forward to）は見出し語の熟語として扱う
+	joined, inflected := joinUnits(segs, phrases)
+	units := uniqueLowerLimited(joined, uc.maxTokens)
+	if len(units) == 0 {
+		return nil, nil, nil, nil, nil
+	}
+	nameToID := make(map[string]int, len(units))
+	words := make([]string, 0, len(units))
+	for _, u := range units {
+		if name, ok := inflected[u]; ok {
+			nameToID[name] = phrases[name]
+			continue
+		}
+		if id, ok := phrases[u]; ok {
+			nameToID[u] = id
+			continue
+		}
+		words = append(words, u)
 	}
 
-	// 4) user の active registered_word セットを取得
+	// 4) master word から存在する単語の ID を取得し、
+	//    そのままでは無い語は活用形とみなして見出し語の候補で引き直す
+	lemmaOf := inflected
+	if len(words) > 0 {
+		found, err := uc.wordRepo.FindIDsByNames(ctx, words)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		for name, id := range found {
+			nameToID[name] = id
+		}
+		wordLemmas, err := uc.resolveLemmas(ctx, words, nameToID)
+		if err != nil {
+			return nil, nil, nil, nil, err
+		}
+		for w, l := range wordLemmas {
+			lemmaOf[w] = l
+		}
+	}
+
+	// 5) user の active registered_word セットを取得
 	ids := make([]int, 0, len(nameToID))
 	for _, id := range nameToID {
 		ids = append(ids, id)
 	}
 	activeSet, err := uc.regReadRepo.ActiveWordIDSetByUser(ctx, userID, ids)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, nil, nil, nil, err
 	}
 
-	// 5) 仕分け：候補/登録済み/存在なし
+	// 6) 仕分け：候補/登録済み/存在なし（同じ見出し語は 1 回だけ）
 	var cand, regs, notExist []string
-	for _, w := range words {
-		id, ok := nameToID[w]
+	var lemmas []models.BulkLemma
+	seen := make(map[string]struct{}, len(units))
+	for _, w := range units {
+		name := w
+		if l, ok := lemmaOf[w]; ok {
+			name = l
+			lemmas = append(lemmas, models.BulkLemma{Surface: w, Lemma: l})
+		}
+		id, ok := nameToID[name]
 		if !ok {
 			notExist = append(notExist, w)
 			continue
 		}
+		if _, dup := seen[name]; dup {
+			continue
+		}
+		seen[name] = struct{}{}
 		if _, ok := activeSet[id]; ok {
-			regs = append(regs, w)
+			regs = append(regs, name)
 		} else {
-			cand = append(cand, w)
+			cand = append(cand, name)
+		}
+	}
+	return cand, regs, notExist, lemmas, nil
+}
+
+// resolveLemmas は nameToID に無い語について lemma.Candidates の候補をまとめて引き、
+// 最初に当たった候補を map[表層形]見出し語 で返す。当たった見出し語は nameToID にも足す。
+func (uc *tokenizeUsecase) resolveLemmas(ctx context.Context, words []string, nameToID map[string]int) (map[string]string, error) {
+	candsOf := make(map[string][]string)
+	var lookup []string
+	queued := make(map[string]struct{})
+	for _, w := range words {
+		if _, ok := nameToID[w]; ok {
+			continue
+		}
+		cands := lemma.Candidates(w)
+		candsOf[w] = cands
+		for _, c := range cands {
+			if _, ok := nameToID[c]; ok {
+				continue
+			}
+			if _, ok := queued[c]; ok {
+				continue
+			}
+			queued[c] = struct{}{}
+			lookup = append(lookup, c)
+		}
+	}
+	if len(lookup) > 0 {
+		found, err := uc.wordRepo.FindIDsByNames(ctx, lookup)
+		if err != nil {
+			return nil, err
+		}
+		for name, id := range found {
+			nameToID[name] = id
+		}
+	}
+
+	lemmaOf := make(map[string]string, len(candsOf))
+	for w, cands := range candsOf {
+		for _, c := range cands {
+			if _, ok := nameToID[c]; ok {
+				lemmaOf[w] = c
+				break
+			}
 		}
 	}
-	return cand, regs, notExist, nil
+	return lemmaOf, nil
 }
 
 func uniqueLowerLimited(words []string, limit int) []string {
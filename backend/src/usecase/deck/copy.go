@@ -0,0 +1,105 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/src/domain"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+// InputCopyDeck は DeckID（公開デッキ）か ShareToken（リンク共有のデッキ）で元のデッキを指定する
+type InputCopyDeck struct {
+	UserID     int    `json:"-"`
+	DeckID     int    `json:"-"`
+	ShareToken string `json:"-"`
+}
+
+// OutputCopyDeck はコピーしてできた自分のデッキと、登録単語への反映結果
+type OutputCopyDeck struct {
+	Deck              *domain.Deck `json:"deck"`
+	Registered        int          `json:"registered"`        // 新たに登録した（または登録を戻した）単語数
+	AlreadyRegistered int          `json:"alreadyRegistered"` // すでに登録済みだった単語数
+	LimitReached      int          `json:"limitReached"`      // 登録単語数の上限で登録できなかった単語数
+}
+
+// Copy は他人のデッキを非公開の自分のデッキとして複製し、その単語を並び順に登録単語へ追加する。
+// 登録単語数の上限に達した分はデッキには入るが登録されない。
+func (uc *deckUsecase) Copy(ctx context.Context, in InputCopyDeck) (*OutputCopyDeck, error) {
+	src, err := uc.viewable(ctx, in.UserID, in.DeckID, in.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+	wordIDs := src.WordIDs()
+
+	out := &OutputCopyDeck{}
+	err = uc.txm.WithTx(ctx, func(txCtx context.Context) error {
+		if err := uc.locker.LockByID(txCtx, in.UserID); err != nil {
+			return err
+		}
+		n, err := uc.decks.CountByUser(txCtx, in.UserID)
+		if err != nil {
+			return err
+		}
+		if n >= uc.maxDecks() {
+			return ucerr.TooManyRequests("deck limit exceeded")
+		}
+		d, err := uc.decks.Create(txCtx, &domain.Deck{
+			UserID:      in.UserID,
+			Name:        src.Name,
+			Description: src.Description,
+			Visibility:  domain.DeckVisibilityPrivate,
+		})
+		if err != nil {
+			return err
+		}
+		if err := uc.decks.ReplaceWords(txCtx, d.ID, wordIDs); err != nil {
+			return err
+		}
+		if err := uc.register(txCtx, in.UserID, wordIDs, out); err != nil {
+			return err
+		}
+		out.Deck, err = uc.decks.FindByID(txCtx, d.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// register は wordIDs を順に登録単語にする（bulk 登録と同じく上限は全ユーザーに適用）
+func (uc *deckUsecase) register(ctx context.Context, userID int, wordIDs []int, out *OutputCopyDeck) error {
+	if len(wordIDs) == 0 {
+		return nil
+	}
+	active, err := uc.rwRead.CountActiveByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	remain := uc.maxRegistered() - active
+	existing, err := uc.rwRead.FindActiveMapByUserAndWordIDs(ctx, userID, wordIDs)
+	if err != nil {
+		return err
+	}
+	for _, id := range wordIDs {
+		isActive, had := existing[id]
+		switch {
+		case had && isActive:
+			out.AlreadyRegistered++
+			continue
+		case remain <= 0:
+			out.LimitReached++
+			continue
+		case had:
+			err = uc.rwWrite.Activate(ctx, userID, id)
+		default:
+			err = uc.rwWrite.CreateActive(ctx, userID, id)
+		}
+		if err != nil {
+			return err
+		}
+		remain--
+		out.Registered++
+	}
+	return nil
+}
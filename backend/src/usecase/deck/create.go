@@ -0,0 +1,61 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/src/domain"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+type InputCreateDeck struct {
+	UserID      int    `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"` // 未指定は private
+	WordIDs     []int  `json:"wordIDs"`    // この並びがデッキ内の順番になる
+}
+
+func (uc *deckUsecase) Create(ctx context.Context, in InputCreateDeck) (*domain.Deck, error) {
+	name, desc, vis, wordIDs, err := uc.deckFields(in.Name, in.Description, in.Visibility, in.WordIDs)
+	if err != nil {
+		return nil, err
+	}
+	token, err := uc.shareToken(vis, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *domain.Deck
+	err = uc.txm.WithTx(ctx, func(txCtx context.Context) error {
+		// 上限チェックと作成の間に同じユーザーの作成が割り込まないよう直列化
+		if err := uc.locker.LockByID(txCtx, in.UserID); err != nil {
+			return err
+		}
+		n, err := uc.decks.CountByUser(txCtx, in.UserID)
+		if err != nil {
+			return err
+		}
+		if n >= uc.maxDecks() {
+			return ucerr.TooManyRequests("deck limit exceeded")
+		}
+		d, err := uc.decks.Create(txCtx, &domain.Deck{
+			UserID:      in.UserID,
+			Name:        name,
+			Description: desc,
+			Visibility:  vis,
+			ShareToken:  token,
+		})
+		if err != nil {
+			return err
+		}
+		if err := uc.decks.ReplaceWords(txCtx, d.ID, wordIDs); err != nil {
+			return err
+		}
+		out, err = uc.decks.FindByID(txCtx, d.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,193 @@
+// Package deck はユーザーが作る単語リスト（デッキ）のユースケース
+package deck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"word_app/backend/config"
+	"word_app/backend/src/domain"
+	deckRepo "word_app/backend/src/infrastructure/repository/deck"
+	"word_app/backend/src/infrastructure/repository/registeredword"
+	"word_app/backend/src/infrastructure/repository/tx"
+	"word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/usecase/shared/ucerr"
+	"word_app/backend/src/utils/oauthutil"
+)
+
+const (
+	maxNameLen        = 100
+	maxDescriptionLen = 1000
+	defaultPublicPage = 20
+	maxPublicPage     = 100
+)
+
+type Usecase interface {
+	Create(ctx context.Context, in InputCreateDeck) (*domain.Deck, error)
+	List(ctx context.Context, userID int) ([]*domain.Deck, error)
+	ListPublic(ctx context.Context, in InputListPublicDecks) ([]*domain.Deck, error)
+	Get(ctx context.Context, in InputGetDeck) (*domain.Deck, error)
+	Update(ctx context.Context, in InputUpdateDeck) (*domain.Deck, error)
+	Delete(ctx context.Context, in InputDeleteDeck) error
+	Copy(ctx context.Context, in InputCopyDeck) (*OutputCopyDeck, error)
+}
+
+type deckUsecase struct {
+	txm      tx.Manager
+	decks    deckRepo.Repository
+	rwRead   registeredword.ReadRepository
+	rwWrite  registeredword.WriteRepository
+	locker   user.Repository
+	limits   *config.LimitsCfg
+	newToken func() (string, error)
+}
+
+func NewUsecase(
+	txm tx.Manager,
+	decks deckRepo.Repository,
+	rwRead registeredword.ReadRepository,
+	rwWrite registeredword.WriteRepository,
+	locker user.Repository,
+	limits *config.LimitsCfg,
+) Usecase {
+	return &deckUsecase{
+		txm:      txm,
+		decks:    decks,
+		rwRead:   rwRead,
+		rwWrite:  rwWrite,
+		locker:   locker,
+		limits:   limits,
+		newToken: oauthutil.NewRandomString,
+	}
+}
+
+func (uc *deckUsecase) maxDecks() int {
+	if uc.limits.DecksPerUser > 0 {
+		return uc.limits.DecksPerUser
+	}
+	return 50
+}
+
+func (uc *deckUsecase) maxWords() int {
+	if uc.limits.DeckMaxWords > 0 {
+		return uc.limits.DeckMaxWords
+	}
+	return 500
+}
+
+func (uc *deckUsecase) maxRegistered() int {
+	if uc.limits.RegisteredWordsPerUser > 0 {
+		return uc.limits.RegisteredWordsPerUser
+	}
+	return 200
+}
+
+// deckFields は作成・更新で共通の項目を検査して正規化する
+func (uc *deckUsecase) deckFields(name, description, visibility string, wordIDs []int) (string, string, string, []int, error) {
+	name = strings.TrimSpace(name)
+	description = strings.TrimSpace(description)
+	if visibility == "" {
+		visibility = domain.DeckVisibilityPrivate
+	}
+	switch {
+	case name == "":
+		return "", "", "", nil, ucerr.Validation("name is required")
+	case utf8.RuneCountInString(name) > maxNameLen:
+		return "", "", "", nil, ucerr.Validation(fmt.Sprintf("name must be at most %d characters", maxNameLen))
+	case utf8.RuneCountInString(description) > maxDescriptionLen:
+		return "", "", "", nil, ucerr.Validation(fmt.Sprintf("description must be at most %d characters", maxDescriptionLen))
+	case !domain.IsValidDeckVisibility(visibility):
+		return "", "", "", nil, ucerr.Validation("visibility must be private, link or public")
+	}
+	ids, err := uc.normalizeWordIDs(wordIDs)
+	if err != nil {
+		return "", "", "", nil, err
+	}
+	return name, description, visibility, ids, nil
+}
+
+// normalizeWordIDs は重複を除き、最初に出てきた位置の並びを残す。nil は nil のまま返す。
+func (uc *deckUsecase) normalizeWordIDs(ids []int) ([]int, error) {
+	if ids == nil {
+		return nil, nil
+	}
+	seen := make(map[int]struct{}, len(ids))
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, ucerr.Validation("wordIDs must be positive")
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		out = append(out, id)
+	}
+	if len(out) > uc.maxWords() {
+		return nil, ucerr.Validation(fmt.Sprintf("a deck can have at most %d words", uc.maxWords()))
+	}
+	return out, nil
+}
+
+// shareToken は公開範囲に合わせた共有トークンを返す。
+// link の間は同じトークンを使い続け、link 以外にすると破棄する（再び link にすると新しいリンクになる）。
+func (uc *deckUsecase) shareToken(visibility string, current *string) (*string, error) {
+	if visibility != domain.DeckVisibilityLink {
+		return nil, nil
+	}
+	if current != nil {
+		return current, nil
+	}
+	t, err := uc.newToken()
+	if err != nil {
+		return nil, ucerr.Internal("failed to generate share token", err)
+	}
+	return &t, nil
+}
+
+// ownDeck は userID が持ち主のデッキを返す。見えないデッキは NotFound、見えるが他人のものは Forbidden。
+func (uc *deckUsecase) ownDeck(ctx context.Context, userID, deckID int) (*domain.Deck, error) {
+	d, err := uc.decks.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+	if d.UserID == userID {
+		return d, nil
+	}
+	if d.CanView(userID) {
+		return nil, ucerr.Forbidden("not the owner of the deck")
+	}
+	return nil, ucerr.NotFound("deck not found")
+}
+
+// viewable は ID または共有トークンでデッキを引き、userID が見られるものだけを返す
+func (uc *deckUsecase) viewable(ctx context.Context, userID, deckID int, token string) (*domain.Deck, error) {
+	if token != "" {
+		d, err := uc.decks.FindByShareToken(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		if d.Visibility != domain.DeckVisibilityLink && d.UserID != userID {
+			return nil, ucerr.NotFound("deck not found")
+		}
+		return d, nil
+	}
+	d, err := uc.decks.FindByID(ctx, deckID)
+	if err != nil {
+		return nil, err
+	}
+	if !d.CanView(userID) {
+		return nil, ucerr.NotFound("deck not found")
+	}
+	return d, nil
+}
+
+// forViewer は持ち主以外には共有トークンを返さない
+func forViewer(d *domain.Deck, viewerID int) *domain.Deck {
+	if d.UserID != viewerID {
+		d.ShareToken = nil
+	}
+	return d
+}
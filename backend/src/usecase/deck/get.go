@@ -0,0 +1,47 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/src/domain"
+)
+
+// InputGetDeck は DeckID か ShareToken のどちらかで指定する（ShareToken が優先）
+type InputGetDeck struct {
+	UserID     int
+	DeckID     int
+	ShareToken string
+}
+
+type InputListPublicDecks struct {
+	Offset int `form:"offset"`
+	Limit  int `form:"limit"` // 未指定は 20、上限 100
+}
+
+func (uc *deckUsecase) Get(ctx context.Context, in InputGetDeck) (*domain.Deck, error) {
+	d, err := uc.viewable(ctx, in.UserID, in.DeckID, in.ShareToken)
+	if err != nil {
+		return nil, err
+	}
+	return forViewer(d, in.UserID), nil
+}
+
+func (uc *deckUsecase) List(ctx context.Context, userID int) ([]*domain.Deck, error) {
+	return uc.decks.ListByUser(ctx, userID)
+}
+
+func (uc *deckUsecase) ListPublic(ctx context.Context, in InputListPublicDecks) ([]*domain.Deck, error) {
+	limit := in.Limit
+	if limit <= 0 {
+		limit = defaultPublicPage
+	}
+	limit = min(limit, maxPublicPage)
+	decks, err := uc.decks.ListPublic(ctx, max(in.Offset, 0), limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range decks {
+		d.ShareToken = nil
+	}
+	return decks, nil
+}
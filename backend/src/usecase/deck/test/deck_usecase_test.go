@@ -0,0 +1,188 @@
+package deck_test
+
+import (
+	"context"
+	"testing"
+
+	"word_app/backend/config"
+	"word_app/backend/src/domain"
+	deckmock "word_app/backend/src/mocks/infrastructure/repository/deck"
+	regmock "word_app/backend/src/mocks/infrastructure/repository/registeredword"
+	txmock "word_app/backend/src/mocks/infrastructure/repository/tx"
+	usermock "word_app/backend/src/mocks/infrastructure/repository/user"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/deck"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mocks struct {
+	tm      *txmock.MockManager
+	decks   *deckmock.MockRepository
+	rwRead  *regmock.MockReadRepository
+	rwWrite *regmock.MockWriteRepository
+	users   *usermock.MockRepository
+}
+
+func newUC(t *testing.T, limits *config.LimitsCfg) (deck.Usecase, *mocks) {
+	m := &mocks{
+		tm:      txmock.NewMockManager(t),
+		decks:   deckmock.NewMockRepository(t),
+		rwRead:  regmock.NewMockReadRepository(t),
+		rwWrite: regmock.NewMockWriteRepository(t),
+		users:   usermock.NewMockRepository(t),
+	}
+	// WithTx はコールバックをそのまま実行する
+	m.tm.On("WithTx", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) }).
+		Maybe()
+	return deck.NewUsecase(m.tm, m.decks, m.rwRead, m.rwWrite, m.users, limits), m
+}
+
+func TestDeckUsecase_Create(t *testing.T) {
+	ctx := context.Background()
+	limits := &config.LimitsCfg{DecksPerUser: 2, DeckMaxWords: 3}
+
+	t.Run("入力の検査はリポジトリを呼ばない", func(t *testing.T) {
+		uc, _ := newUC(t, limits)
+		for name, in := range map[string]deck.InputCreateDeck{
+			"名前が空":      {Name: "  "},
+			"公開範囲が不正":   {Name: "d", Visibility: "friends"},
+			"単語 ID が不正": {Name: "d", WordIDs: []int{1, 0}},
+			"単語が多すぎる":   {Name: "d", WordIDs: []int{1, 2, 3, 4}},
+		} {
+			_, err := uc.Create(ctx, in)
+			assert.True(t, apperror.IsKind(err, apperror.Validation), "%s: %v", name, err)
+		}
+	})
+
+	t.Run("重複を除いた並びで保存し、リンク共有ならトークンを発行する", func(t *testing.T) {
+		uc, m := newUC(t, limits)
+		m.users.On("LockByID", mock.Anything, 7).Return(nil)
+		m.decks.On("CountByUser", mock.Anything, 7).Return(1, nil)
+		m.decks.On("Create", mock.Anything, mock.MatchedBy(func(d *domain.Deck) bool {
+			return d.UserID == 7 && d.Name == "fruits" && d.Visibility == domain.DeckVisibilityLink &&
+				d.ShareToken != nil && *d.ShareToken != ""
+		})).Return(&domain.Deck{ID: 3, UserID: 7}, nil)
+		m.decks.On("ReplaceWords", mock.Anything, 3, []int{5, 2, 9}).Return(nil)
+		m.decks.On("FindByID", mock.Anything, 3).Return(&domain.Deck{ID: 3, UserID: 7, WordCount: 3}, nil)
+
+		d, err := uc.Create(ctx, deck.InputCreateDeck{
+			UserID: 7, Name: " fruits ", Visibility: domain.DeckVisibilityLink, WordIDs: []int{5, 2, 5, 9, 2},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, d.WordCount)
+	})
+
+	t.Run("デッキ数の上限", func(t *testing.T) {
+		uc, m := newUC(t, limits)
+		m.users.On("LockByID", mock.Anything, 7).Return(nil)
+		m.decks.On("CountByUser", mock.Anything, 7).Return(2, nil)
+
+		_, err := uc.Create(ctx, deck.InputCreateDeck{UserID: 7, Name: "d"})
+		assert.True(t, apperror.IsKind(err, apperror.TooManyRequests), "%v", err)
+	})
+}
+
+func TestDeckUsecase_Get(t *testing.T) {
+	ctx := context.Background()
+	token := "tok"
+
+	t.Run("他人の非公開・リンク共有デッキは ID では見られない", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.decks.On("FindByID", mock.Anything, 1).Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityPrivate}, nil).Once()
+		m.decks.On("FindByID", mock.Anything, 1).Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityLink, ShareToken: &token}, nil).Once()
+
+		for range 2 {
+			_, err := uc.Get(ctx, deck.InputGetDeck{UserID: 9, DeckID: 1})
+			assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+		}
+	})
+
+	t.Run("共有トークンなら見られるが、トークンは返さない", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.decks.On("FindByShareToken", mock.Anything, token).
+			Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityLink, ShareToken: &token}, nil)
+
+		d, err := uc.Get(ctx, deck.InputGetDeck{UserID: 9, ShareToken: token})
+		require.NoError(t, err)
+		assert.Nil(t, d.ShareToken)
+	})
+
+	t.Run("持ち主にはトークンを返す", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.decks.On("FindByID", mock.Anything, 1).
+			Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityLink, ShareToken: &token}, nil)
+
+		d, err := uc.Get(ctx, deck.InputGetDeck{UserID: 2, DeckID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, &token, d.ShareToken)
+	})
+}
+
+func TestDeckUsecase_Update(t *testing.T) {
+	ctx := context.Background()
+	token := "tok"
+
+	t.Run("他人の公開デッキは Forbidden", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.decks.On("FindByID", mock.Anything, 1).Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityPublic}, nil)
+
+		_, err := uc.Update(ctx, deck.InputUpdateDeck{UserID: 9, DeckID: 1, Name: "x"})
+		assert.True(t, apperror.IsKind(err, apperror.Forbidden), "%v", err)
+	})
+
+	t.Run("リンク共有をやめるとトークンを破棄し、WordIDs が nil なら単語は変えない", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.decks.On("FindByID", mock.Anything, 1).
+			Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityLink, ShareToken: &token}, nil)
+		m.decks.On("Update", mock.Anything, mock.MatchedBy(func(d *domain.Deck) bool {
+			return d.Name == "renamed" && d.Visibility == domain.DeckVisibilityPublic && d.ShareToken == nil
+		})).Return(&domain.Deck{ID: 1}, nil)
+
+		_, err := uc.Update(ctx, deck.InputUpdateDeck{UserID: 2, DeckID: 1, Name: "renamed", Visibility: domain.DeckVisibilityPublic})
+		require.NoError(t, err)
+		m.decks.AssertNotCalled(t, "ReplaceWords", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestDeckUsecase_Copy(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("非公開のコピーを作り、上限まで並び順に登録する", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{RegisteredWordsPerUser: 3})
+		src := &domain.Deck{ID: 1, UserID: 2, Name: "shared", Visibility: domain.DeckVisibilityPublic, Words: []domain.DeckWord{
+			{WordID: 10}, {WordID: 11}, {WordID: 12}, {WordID: 13},
+		}}
+		m.decks.On("FindByID", mock.Anything, 1).Return(src, nil)
+		m.users.On("LockByID", mock.Anything, 9).Return(nil)
+		m.decks.On("CountByUser", mock.Anything, 9).Return(0, nil)
+		m.decks.On("Create", mock.Anything, mock.MatchedBy(func(d *domain.Deck) bool {
+			return d.UserID == 9 && d.Name == "shared" && d.Visibility == domain.DeckVisibilityPrivate
+		})).Return(&domain.Deck{ID: 5, UserID: 9}, nil)
+		m.decks.On("ReplaceWords", mock.Anything, 5, []int{10, 11, 12, 13}).Return(nil)
+		m.rwRead.On("CountActiveByUser", mock.Anything, 9).Return(1, nil)
+		m.rwRead.On("FindActiveMapByUserAndWordIDs", mock.Anything, 9, []int{10, 11, 12, 13}).
+			Return(map[int]bool{10: true, 11: false}, nil)
+		m.rwWrite.On("Activate", mock.Anything, 9, 11).Return(nil)
+		m.rwWrite.On("CreateActive", mock.Anything, 9, 12).Return(nil)
+		m.decks.On("FindByID", mock.Anything, 5).Return(&domain.Deck{ID: 5, UserID: 9, WordCount: 4}, nil)
+
+		out, err := uc.Copy(ctx, deck.InputCopyDeck{UserID: 9, DeckID: 1})
+		require.NoError(t, err)
+		assert.Equal(t, 5, out.Deck.ID)
+		assert.Equal(t, 2, out.Registered)
+		assert.Equal(t, 1, out.AlreadyRegistered)
+		assert.Equal(t, 1, out.LimitReached)
+	})
+
+	t.Run("他人の非公開デッキはコピーできない", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.decks.On("FindByID", mock.Anything, 1).Return(&domain.Deck{ID: 1, UserID: 2, Visibility: domain.DeckVisibilityPrivate}, nil)
+
+		_, err := uc.Copy(ctx, deck.InputCopyDeck{UserID: 9, DeckID: 1})
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+	})
+}
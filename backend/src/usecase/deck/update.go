@@ -0,0 +1,66 @@
+package deck
+
+import (
+	"context"
+
+	"word_app/backend/src/domain"
+)
+
+// InputUpdateDeck は名前・説明・公開範囲を置き換える。WordIDs が nil なら単語は変えない。
+type InputUpdateDeck struct {
+	UserID      int    `json:"-"`
+	DeckID      int    `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"` // 未指定は private
+	WordIDs     []int  `json:"wordIDs"`
+}
+
+type InputDeleteDeck struct {
+	UserID int
+	DeckID int
+}
+
+func (uc *deckUsecase) Update(ctx context.Context, in InputUpdateDeck) (*domain.Deck, error) {
+	name, desc, vis, wordIDs, err := uc.deckFields(in.Name, in.Description, in.Visibility, in.WordIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var out *domain.Deck
+	err = uc.txm.WithTx(ctx, func(txCtx context.Context) error {
+		d, err := uc.ownDeck(txCtx, in.UserID, in.DeckID)
+		if err != nil {
+			return err
+		}
+		token, err := uc.shareToken(vis, d.ShareToken)
+		if err != nil {
+			return err
+		}
+		d.Name, d.Description, d.Visibility, d.ShareToken = name, desc, vis, token
+		if _, err := uc.decks.Update(txCtx, d); err != nil {
+			return err
+		}
+		if wordIDs != nil {
+			if err := uc.decks.ReplaceWords(txCtx, d.ID, wordIDs); err != nil {
+				return err
+			}
+		}
+		out, err = uc.decks.FindByID(txCtx, d.ID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (uc *deckUsecase) Delete(ctx context.Context, in InputDeleteDeck) error {
+	return uc.txm.WithTx(ctx, func(txCtx context.Context) error {
+		d, err := uc.ownDeck(txCtx, in.UserID, in.DeckID)
+		if err != nil {
+			return err
+		}
+		return uc.decks.Delete(txCtx, d.ID)
+	})
+}
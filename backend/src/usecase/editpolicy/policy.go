@@ -0,0 +1,66 @@
+// Package editpolicy は単語マスタ変更の認可をまとめる。
+// RootConfig.editing_permission とユーザーの役割を見て、直接反映・提案・拒否を決める。
+package editpolicy
+
+import (
+	"context"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	settingRepo "word_app/backend/src/infrastructure/repository/setting"
+	userRepo "word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+type Policy interface {
+	// Decide は userID のユーザーが単語マスタを変更しようとしたときの扱いを返す
+	Decide(ctx context.Context, userID int) (domain.MasterEdit, error)
+	// Authorize は直接反映してよいときだけ nil を返す
+	Authorize(ctx context.Context, userID int) error
+}
+
+type policy struct {
+	rootRepo settingRepo.RootConfigRepository
+	userRepo userRepo.Repository
+}
+
+func NewPolicy(r settingRepo.RootConfigRepository, u userRepo.Repository) Policy {
+	return &policy{rootRepo: r, userRepo: u}
+}
+
+func (p *policy) Decide(ctx context.Context, userID int) (domain.MasterEdit, error) {
+	u, err := p.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if apperror.IsKind(err, apperror.NotFound) {
+			return domain.MasterEditDeny, nil
+		}
+		return domain.MasterEditDeny, err
+	}
+	if u.DeletedAt != nil {
+		return domain.MasterEditDeny, nil
+	}
+
+	permission := domain.EditingPermissionAdmin
+	cfg, err := p.rootRepo.Get(ctx)
+	switch {
+	case err == nil:
+		permission = cfg.EditingPermission
+	case ent.IsNotFound(err):
+		// RootConfig がまだ無ければ既定の "admin"
+	default:
+		return domain.MasterEditDeny, ucerr.Internal("failed to load root config", err)
+	}
+	return domain.DecideMasterEdit(permission, u), nil
+}
+
+func (p *policy) Authorize(ctx context.Context, userID int) error {
+	d, err := p.Decide(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if d != domain.MasterEditAllow {
+		return ucerr.Unauthorized("unauthorized")
+	}
+	return nil
+}
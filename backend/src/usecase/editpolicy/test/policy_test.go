@@ -0,0 +1,116 @@
+package editpolicy_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"word_app/backend/ent"
+	"word_app/backend/src/domain"
+	settingmock "word_app/backend/src/mocks/infrastructure/repository/setting"
+	usermock "word_app/backend/src/mocks/infrastructure/repository/user"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/editpolicy"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	regular = &domain.User{ID: 1}
+	admin   = &domain.User{ID: 2, IsAdmin: true}
+	root    = &domain.User{ID: 3, IsRoot: true}
+	tester  = &domain.User{ID: 4, IsTest: true}
+)
+
+func newPolicy(t *testing.T, u *domain.User, permission string) editpolicy.Policy {
+	roots := settingmock.NewMockRootConfigRepository(t)
+	users := usermock.NewMockRepository(t)
+	users.On("FindByID", mock.Anything, u.ID).Return(u, nil)
+	roots.On("Get", mock.Anything).Return(&domain.RootConfig{ID: 1, EditingPermission: permission}, nil).Maybe()
+	return editpolicy.NewPolicy(roots, users)
+}
+
+func TestPolicy_Decide(t *testing.T) {
+	ctx := context.Background()
+	cases := []struct {
+		permission string
+		want       map[*domain.User]domain.MasterEdit
+	}{
+		{domain.EditingPermissionRoot, map[*domain.User]domain.MasterEdit{
+			regular: domain.MasterEditDeny,
+			admin:   domain.MasterEditDeny,
+			root:    domain.MasterEditAllow,
+			tester:  domain.MasterEditDeny,
+		}},
+		{domain.EditingPermissionAdmin, map[*domain.User]domain.MasterEdit{
+			regular: domain.MasterEditDeny,
+			admin:   domain.MasterEditAllow,
+			root:    domain.MasterEditAllow,
+			tester:  domain.MasterEditDeny,
+		}},
+		{domain.EditingPermissionUser, map[*domain.User]domain.MasterEdit{
+			regular: domain.MasterEditModerate,
+			admin:   domain.MasterEditAllow,
+			root:    domain.MasterEditAllow,
+			tester:  domain.MasterEditDeny,
+		}},
+	}
+	for _, tc := range cases {
+		for u, want := range tc.want {
+			t.Run(fmt.Sprintf("%s/user%d", tc.permission, u.ID), func(t *testing.T) {
+				got, err := newPolicy(t, u, tc.permission).Decide(ctx, u.ID)
+				require.NoError(t, err)
+				assert.Equal(t, want, got)
+			})
+		}
+	}
+}
+
+func TestPolicy_Authorize(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("直接反映できるときだけ通す", func(t *testing.T) {
+		assert.NoError(t, newPolicy(t, admin, domain.EditingPermissionUser).Authorize(ctx, admin.ID))
+
+		err := newPolicy(t, regular, domain.EditingPermissionUser).Authorize(ctx, regular.ID)
+		assert.True(t, apperror.IsKind(err, apperror.Unauthorized), "%v", err)
+	})
+
+	t.Run("RootConfig が無ければ admin とみなす", func(t *testing.T) {
+		roots := settingmock.NewMockRootConfigRepository(t)
+		users := usermock.NewMockRepository(t)
+		users.On("FindByID", mock.Anything, admin.ID).Return(admin, nil)
+		roots.On("Get", mock.Anything).Return(nil, &ent.NotFoundError{})
+
+		assert.NoError(t, editpolicy.NewPolicy(roots, users).Authorize(ctx, admin.ID))
+	})
+
+	t.Run("存在しない・退会したユーザーは拒否", func(t *testing.T) {
+		roots := settingmock.NewMockRootConfigRepository(t)
+		users := usermock.NewMockRepository(t)
+		deleted := time.Now()
+		users.On("FindByID", mock.Anything, 8).Return(nil, apperror.NotFoundf("user not found", nil))
+		users.On("FindByID", mock.Anything, 9).Return(&domain.User{ID: 9, IsAdmin: true, DeletedAt: &deleted}, nil)
+		p := editpolicy.NewPolicy(roots, users)
+
+		for _, id := range []int{8, 9} {
+			d, err := p.Decide(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, domain.MasterEditDeny, d)
+		}
+	})
+
+	t.Run("設定の読み込みに失敗したらエラー", func(t *testing.T) {
+		roots := settingmock.NewMockRootConfigRepository(t)
+		users := usermock.NewMockRepository(t)
+		users.On("FindByID", mock.Anything, admin.ID).Return(admin, nil)
+		roots.On("Get", mock.Anything).Return(nil, errors.New("db down"))
+
+		err := editpolicy.NewPolicy(roots, users).Authorize(ctx, admin.ID)
+		assert.True(t, apperror.IsKind(err, apperror.Internal), "%v", err)
+	})
+}
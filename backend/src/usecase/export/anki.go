@@ -0,0 +1,60 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"word_app/backend/src/domain"
+)
+
+// Anki の「テキストファイルから読み込む」が解釈するヘッダ。
+// 1 列目が表（単語）、2 列目が裏（意味とメモ）、3 列目がタグ。
+const ankiHeader = "#separator:tab\n#html:true\n#tags column:3\n"
+
+// writeAnki は登録中の単語だけを Anki 用 TSV で書き出す（クイズ履歴は含めない）
+func (uc *exportUsecase) writeAnki(ctx context.Context, userID int, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(ankiHeader); err != nil {
+		return err
+	}
+	if err := uc.eachWord(ctx, userID, func(ew domain.ExportWord) error {
+		if !ew.IsActive {
+			return nil
+		}
+		_, err := fmt.Fprintf(bw, "%s\t%s\t%s\n", ankiField(ew.Word), ankiBack(ew), ankiTags(ew))
+		return err
+	}); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// ankiBack は意味を 1 行ずつ、メモがあれば空行を挟んで続ける
+func ankiBack(w domain.ExportWord) string {
+	lines := make([]string, 0, len(w.Meanings)+2)
+	for i, m := range meaningTexts(w.Meanings) {
+		if pos := w.Meanings[i].PartOfSpeech; pos != "" {
+			m = "[" + pos + "] " + m
+		}
+		lines = append(lines, ankiField(m))
+	}
+	if w.Memo != "" {
+		lines = append(lines, "", ankiField(w.Memo))
+	}
+	return strings.Join(lines, "<br>")
+}
+
+func ankiTags(w domain.ExportWord) string {
+	return fmt.Sprintf("word_app attention_%d", w.AttentionLevel)
+}
+
+// ankiField は HTML として読まれるのでエスケープし、区切りになるタブと改行を潰す
+func ankiField(s string) string {
+	s = html.EscapeString(s)
+	s = strings.NewReplacer("\r\n", "<br>", "\n", "<br>", "\r", "<br>", "\t", " ").Replace(s)
+	return s
+}
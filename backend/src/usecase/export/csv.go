@@ -0,0 +1,153 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"word_app/backend/src/domain"
+)
+
+var (
+	wordsHeader = []string{
+		"word", "parts_of_speech", "meanings", "memo", "attention_level",
+		"quiz_count", "correct_count", "correct_rate", "is_active",
+		"registered_at", "last_reviewed_at", "due_at",
+	}
+	historyHeader = []string{
+		"quiz_number", "status", "mode", "direction", "question_type", "difficulty",
+		"quiz_created_at", "quiz_finished_at", "question_number", "word",
+		"prompt", "correct_answer", "answer", "grade", "is_correct", "time_ms", "answered_at",
+	}
+)
+
+// writeCSV は words.csv と quiz_history.csv（1 行 1 問）を zip にまとめて書き出す
+func (uc *exportUsecase) writeCSV(ctx context.Context, userID int, w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	f, err := zw.Create("words.csv")
+	if err != nil {
+		return err
+	}
+	cw := csv.NewWriter(f)
+	if err := cw.Write(wordsHeader); err != nil {
+		return err
+	}
+	if err := uc.eachWord(ctx, userID, func(ew domain.ExportWord) error {
+		return cw.Write(wordRecord(ew))
+	}); err != nil {
+		return err
+	}
+	if err := flush(cw); err != nil {
+		return err
+	}
+
+	f, err = zw.Create("quiz_history.csv")
+	if err != nil {
+		return err
+	}
+	cw = csv.NewWriter(f)
+	if err := cw.Write(historyHeader); err != nil {
+		return err
+	}
+	if err := uc.eachQuiz(ctx, userID, func(q domain.ExportQuiz) error {
+		for _, qq := range q.Questions {
+			if err := cw.Write(historyRecord(q, qq)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := flush(cw); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func flush(cw *csv.Writer) error {
+	cw.Flush()
+	return cw.Error()
+}
+
+func wordRecord(w domain.ExportWord) []string {
+	return []string{
+		w.Word,
+		strings.Join(partsOfSpeech(w.Meanings), " / "),
+		strings.Join(meaningTexts(w.Meanings), "; "),
+		w.Memo,
+		strconv.Itoa(w.AttentionLevel),
+		strconv.Itoa(w.QuizCount),
+		strconv.Itoa(w.CorrectCount),
+		strconv.Itoa(w.CorrectRate),
+		strconv.FormatBool(w.IsActive),
+		formatTime(&w.RegisteredAt),
+		formatTime(w.LastReviewedAt),
+		formatTime(w.DueAt),
+	}
+}
+
+func historyRecord(q domain.ExportQuiz, qq domain.ExportQuestion) []string {
+	timeMs := ""
+	if qq.TimeMs != nil {
+		timeMs = strconv.Itoa(*qq.TimeMs)
+	}
+	return []string{
+		strconv.Itoa(q.QuizNumber),
+		q.Status,
+		q.Mode,
+		q.Direction,
+		q.QuestionType,
+		q.Difficulty,
+		formatTime(&q.CreatedAt),
+		formatTime(q.FinishedAt),
+		strconv.Itoa(qq.QuestionNumber),
+		qq.Word,
+		qq.Prompt,
+		qq.CorrectAnswer,
+		qq.Answer,
+		qq.Grade,
+		strconv.FormatBool(qq.IsCorrect),
+		timeMs,
+		formatTime(qq.AnsweredAt),
+	}
+}
+
+// partsOfSpeech は意味に現れる品詞を出現順に重複なく返す
+func partsOfSpeech(ms []domain.ExportMeaning) []string {
+	seen := map[string]bool{}
+	out := []string{}
+	for _, m := range ms {
+		if m.PartOfSpeech == "" || seen[m.PartOfSpeech] {
+			continue
+		}
+		seen[m.PartOfSpeech] = true
+		out = append(out, m.PartOfSpeech)
+	}
+	return out
+}
+
+// meaningTexts は「林檎（りんご）」のように読みを添えた意味の一覧
+func meaningTexts(ms []domain.ExportMeaning) []string {
+	out := make([]string, 0, len(ms))
+	for _, m := range ms {
+		if m.Reading != "" && m.Reading != m.Name {
+			out = append(out, m.Name+"（"+m.Reading+"）")
+			continue
+		}
+		out = append(out, m.Name)
+	}
+	return out
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,112 @@
+// Package export はユーザーの登録単語とクイズ履歴の書き出し（データポータビリティ）のユースケース
+package export
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"word_app/backend/src/domain"
+	exportRepo "word_app/backend/src/infrastructure/repository/export"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+// 1 回のクエリで読む件数。書き出しはこの単位で流すので、全件をメモリに載せることはない
+const pageSize = 200
+
+type Input struct {
+	UserID int
+	Format string // domain.ExportFormatXXX
+}
+
+type Usecase interface {
+	// Export は in.Format の形式で w に書き出す。
+	// 入力が不正なときは w に何も書かずに Validation を返す。
+	Export(ctx context.Context, in Input, w io.Writer) error
+}
+
+type exportUsecase struct {
+	repo exportRepo.Repository
+}
+
+func NewUsecase(repo exportRepo.Repository) Usecase {
+	return &exportUsecase{repo: repo}
+}
+
+func (uc *exportUsecase) Export(ctx context.Context, in Input, w io.Writer) error {
+	if in.UserID <= 0 {
+		return ucerr.Validation("invalid user id")
+	}
+	switch in.Format {
+	case domain.ExportFormatCSV:
+		return uc.writeCSV(ctx, in.UserID, w)
+	case domain.ExportFormatJSON:
+		return uc.writeJSON(ctx, in.UserID, w)
+	case domain.ExportFormatAnki:
+		return uc.writeAnki(ctx, in.UserID, w)
+	}
+	return ucerr.Validation(fmt.Sprintf("unsupported format %q (csv, json, anki)", in.Format))
+}
+
+// ContentType は形式ごとの Content-Type
+func ContentType(format string) string {
+	switch format {
+	case domain.ExportFormatCSV:
+		return "application/zip"
+	case domain.ExportFormatJSON:
+		return "application/json; charset=utf-8"
+	default:
+		return "text/tab-separated-values; charset=utf-8"
+	}
+}
+
+// FileName はダウンロード時のファイル名（例: word_app-export-20260102.zip）
+func FileName(format string, now time.Time) string {
+	ext := map[string]string{
+		domain.ExportFormatCSV:  "zip",
+		domain.ExportFormatJSON: "json",
+		domain.ExportFormatAnki: "txt",
+	}[format]
+	return fmt.Sprintf("word_app-export-%s.%s", now.Format("20060102"), ext)
+}
+
+// eachWord は登録単語を ID 順に 1 件ずつ fn に渡す
+func (uc *exportUsecase) eachWord(ctx context.Context, userID int, fn func(domain.ExportWord) error) error {
+	after := 0
+	for {
+		page, err := uc.repo.RegisteredWords(ctx, userID, after, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, w := range page {
+			if err := fn(w); err != nil {
+				return err
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
+
+// eachQuiz は終了済みのクイズを ID 順に 1 件ずつ fn に渡す
+func (uc *exportUsecase) eachQuiz(ctx context.Context, userID int, fn func(domain.ExportQuiz) error) error {
+	after := 0
+	for {
+		page, err := uc.repo.Quizzes(ctx, userID, after, pageSize)
+		if err != nil {
+			return err
+		}
+		for _, q := range page {
+			if err := fn(q); err != nil {
+				return err
+			}
+		}
+		if len(page) < pageSize {
+			return nil
+		}
+		after = page[len(page)-1].ID
+	}
+}
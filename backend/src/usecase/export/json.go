@@ -0,0 +1,53 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+
+	"word_app/backend/src/domain"
+)
+
+// writeJSON は {"words":[...],"quizzes":[...]} を要素ごとに書き出す。
+// 全体を 1 つの値として Marshal しないので、件数が多くてもメモリは 1 ページ分で済む。
+func (uc *exportUsecase) writeJSON(ctx context.Context, userID int, w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(`{"words":[`); err != nil {
+		return err
+	}
+	first := true
+	if err := uc.eachWord(ctx, userID, func(ew domain.ExportWord) error {
+		return writeElem(bw, &first, ew)
+	}); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString(`],"quizzes":[`); err != nil {
+		return err
+	}
+	first = true
+	if err := uc.eachQuiz(ctx, userID, func(q domain.ExportQuiz) error {
+		return writeElem(bw, &first, q)
+	}); err != nil {
+		return err
+	}
+	if _, err := bw.WriteString("]}\n"); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func writeElem(bw *bufio.Writer, first *bool, v any) error {
+	if !*first {
+		if err := bw.WriteByte(','); err != nil {
+			return err
+		}
+	}
+	*first = false
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = bw.Write(b)
+	return err
+}
@@ -0,0 +1,145 @@
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"word_app/backend/src/domain"
+	exportmock "word_app/backend/src/mocks/infrastructure/repository/export"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/export"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	apple = domain.ExportWord{
+		ID: 1, Word: "apple", Memo: "赤い\tまるい", AttentionLevel: 2, IsActive: true,
+		Meanings: []domain.ExportMeaning{{PartOfSpeech: "名詞", Name: "林檎", Reading: "りんご"}, {PartOfSpeech: "名詞", Name: "<木>"}},
+	}
+	run = domain.ExportWord{ID: 2, Word: "run", IsActive: false, Meanings: []domain.ExportMeaning{{PartOfSpeech: "動詞", Name: "走る"}}}
+	q1  = domain.ExportQuiz{ID: 7, QuizNumber: 1, Status: "completed", Questions: []domain.ExportQuestion{
+		{QuestionNumber: 1, Word: "apple", Prompt: "apple", CorrectAnswer: "林檎", Answer: "林檎", Grade: "correct", IsCorrect: true},
+		{QuestionNumber: 2, Word: "run", Prompt: "run", CorrectAnswer: "走る", Grade: "wrong"},
+	}}
+)
+
+func newUC(t *testing.T, words []domain.ExportWord, quizzes []domain.ExportQuiz) (export.Usecase, *exportmock.MockRepository) {
+	repo := exportmock.NewMockRepository(t)
+	repo.On("RegisteredWords", mock.Anything, 1, 0, mock.Anything).Return(words, nil).Maybe()
+	repo.On("Quizzes", mock.Anything, 1, 0, mock.Anything).Return(quizzes, nil).Maybe()
+	return export.NewUsecase(repo), repo
+}
+
+func TestExportUsecase_Validation(t *testing.T) {
+	uc, _ := newUC(t, nil, nil)
+	var buf bytes.Buffer
+	for _, in := range []export.Input{{UserID: 1, Format: "xml"}, {UserID: 0, Format: domain.ExportFormatJSON}} {
+		err := uc.Export(context.Background(), in, &buf)
+		assert.True(t, apperror.IsKind(err, apperror.Validation), "%+v: %v", in, err)
+	}
+	assert.Zero(t, buf.Len(), "検査で落ちたら何も書かない")
+}
+
+func TestExportUsecase_JSON(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("単語とクイズを 1 つの JSON として書く", func(t *testing.T) {
+		uc, _ := newUC(t, []domain.ExportWord{apple, run}, []domain.ExportQuiz{q1})
+		var buf bytes.Buffer
+		require.NoError(t, uc.Export(ctx, export.Input{UserID: 1, Format: domain.ExportFormatJSON}, &buf))
+
+		var got struct {
+			Words   []domain.ExportWord `json:"words"`
+			Quizzes []domain.ExportQuiz `json:"quizzes"`
+		}
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		require.Len(t, got.Words, 2)
+		assert.Equal(t, "林檎", got.Words[0].Meanings[0].Name)
+		require.Len(t, got.Quizzes, 1)
+		assert.Len(t, got.Quizzes[0].Questions, 2)
+	})
+
+	t.Run("空でも配列を書く", func(t *testing.T) {
+		uc, _ := newUC(t, []domain.ExportWord{}, []domain.ExportQuiz{})
+		var buf bytes.Buffer
+		require.NoError(t, uc.Export(ctx, export.Input{UserID: 1, Format: domain.ExportFormatJSON}, &buf))
+		assert.JSONEq(t, `{"words":[],"quizzes":[]}`, buf.String())
+	})
+
+	t.Run("ページが埋まっていれば続きを最後の ID から読む", func(t *testing.T) {
+		repo := exportmock.NewMockRepository(t)
+		page := make([]domain.ExportWord, 200)
+		for i := range page {
+			page[i] = domain.ExportWord{ID: i + 1, Word: "w"}
+		}
+		repo.On("RegisteredWords", mock.Anything, 1, 0, 200).Return(page, nil)
+		repo.On("RegisteredWords", mock.Anything, 1, 200, 200).Return([]domain.ExportWord{{ID: 201, Word: "last"}}, nil)
+		repo.On("Quizzes", mock.Anything, 1, 0, 200).Return([]domain.ExportQuiz{}, nil)
+
+		var buf bytes.Buffer
+		require.NoError(t, export.NewUsecase(repo).Export(ctx, export.Input{UserID: 1, Format: domain.ExportFormatJSON}, &buf))
+		var got struct{ Words []domain.ExportWord }
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &got))
+		assert.Len(t, got.Words, 201)
+	})
+
+	t.Run("読み出しの失敗はそのまま返す", func(t *testing.T) {
+		repo := exportmock.NewMockRepository(t)
+		repo.On("RegisteredWords", mock.Anything, 1, 0, 200).Return(nil, errors.New("db down"))
+		err := export.NewUsecase(repo).Export(ctx, export.Input{UserID: 1, Format: domain.ExportFormatJSON}, io.Discard)
+		assert.EqualError(t, err, "db down")
+	})
+}
+
+func TestExportUsecase_CSV(t *testing.T) {
+	uc, _ := newUC(t, []domain.ExportWord{apple, run}, []domain.ExportQuiz{q1})
+	var buf bytes.Buffer
+	require.NoError(t, uc.Export(context.Background(), export.Input{UserID: 1, Format: domain.ExportFormatCSV}, &buf))
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	files := map[string][][]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		rows, err := csv.NewReader(rc).ReadAll()
+		require.NoError(t, err)
+		_ = rc.Close()
+		files[f.Name] = rows
+	}
+
+	words := files["words.csv"]
+	require.Len(t, words, 3)
+	assert.Equal(t, "word", words[0][0])
+	assert.Equal(t, []string{"apple", "名詞", "林檎（りんご）; <木>", "赤い\tまるい"}, words[1][:4])
+
+	history := files["quiz_history.csv"]
+	require.Len(t, history, 3, "ヘッダ + 1 行 1 問")
+	assert.Equal(t, "1", history[1][0])
+	assert.Equal(t, "走る", history[2][11])
+}
+
+func TestExportUsecase_Anki(t *testing.T) {
+	uc, _ := newUC(t, []domain.ExportWord{apple, run}, nil)
+	var buf bytes.Buffer
+	require.NoError(t, uc.Export(context.Background(), export.Input{UserID: 1, Format: domain.ExportFormatAnki}, &buf))
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Len(t, lines, 4, "ヘッダ 3 行 + 登録中の単語だけ")
+	assert.Equal(t, "#separator:tab", lines[0])
+	fields := strings.Split(lines[3], "\t")
+	require.Len(t, fields, 3)
+	assert.Equal(t, "apple", fields[0])
+	assert.Equal(t, "[名詞] 林檎（りんご）<br>[名詞] &lt;木&gt;<br><br>赤い まるい", fields[1])
+	assert.Equal(t, "word_app attention_2", fields[2])
+}
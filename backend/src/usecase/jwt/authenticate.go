@@ -25,7 +25,7 @@ type Authenticator interface {
 }
 
 func (s *JwtUsecase) Authenticate(ctx context.Context, raw string) (models.Principal, error) {
-	sub, err := s.verifier.VerifyAndExtractSubject(ctx, raw)
+	sub, ver, err := s.verifier.VerifyAndExtractSubject(ctx, raw)
 	if err != nil {
 		return models.Principal{}, err
 	}
@@ -42,6 +42,10 @@ func (s *JwtUsecase) Authenticate(ctx context.Context, raw string) (models.Princ
 	if u.DeletedAt != nil { // RequireActiveUser 相当
 		return models.Principal{}, ucerr.Unauthorized("Unauthorized")
 	}
+	// 役割変更・全端末ログアウトで token_version が上がっていれば、発行済みのトークンは使えない
+	if ver != u.TokenVersion {
+		return models.Principal{}, ucerr.Unauthorized("token revoked")
+	}
 
 	return models.Principal{
 		UserID:  id,
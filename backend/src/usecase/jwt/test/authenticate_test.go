@@ -28,7 +28,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "valid_token").
-			Return("123", nil)
+			Return("123", 0, nil)
 		userRepo.On("FindByID", ctx, 123).
 			Return(&domain.User{
 				ID:        123,
@@ -55,7 +55,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "admin_token").
-			Return("456", nil)
+			Return("456", 0, nil)
 		userRepo.On("FindByID", ctx, 456).
 			Return(&domain.User{
 				ID:        456,
@@ -82,7 +82,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "root_token").
-			Return("789", nil)
+			Return("789", 0, nil)
 		userRepo.On("FindByID", ctx, 789).
 			Return(&domain.User{
 				ID:        789,
@@ -109,7 +109,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "test_token").
-			Return("999", nil)
+			Return("999", 0, nil)
 		userRepo.On("FindByID", ctx, 999).
 			Return(&domain.User{
 				ID:        999,
@@ -136,7 +136,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "invalid_token").
-			Return("", errors.New("token invalid"))
+			Return("", 0, errors.New("token invalid"))
 
 		principal, err := uc.Authenticate(ctx, "invalid_token")
 
@@ -154,7 +154,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "bad_subject_token").
-			Return("not_a_number", nil)
+			Return("not_a_number", 0, nil)
 
 		principal, err := uc.Authenticate(ctx, "bad_subject_token")
 
@@ -172,7 +172,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "notfound_token").
-			Return("999", nil)
+			Return("999", 0, nil)
 		userRepo.On("FindByID", ctx, 999).
 			Return(nil, errors.New("user not found"))
 
@@ -192,7 +192,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 
 		now := time.Now()
 		verifier.On("VerifyAndExtractSubject", ctx, "deleted_token").
-			Return("111", nil)
+			Return("111", 0, nil)
 		userRepo.On("FindByID", ctx, 111).
 			Return(&domain.User{
 				ID:        111,
@@ -217,7 +217,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "db_error_token").
-			Return("222", nil)
+			Return("222", 0, nil)
 		userRepo.On("FindByID", ctx, 222).
 			Return(nil, errors.New("database connection error"))
 
@@ -229,6 +229,41 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		verifier.AssertExpectations(t)
 	})
 
+	t.Run("error - token version is stale (role changed / signed out everywhere)", func(t *testing.T) {
+		verifier := jwtmock.NewMockTokenVerifier(t)
+		userRepo := usermock.NewMockRepository(t)
+
+		uc := makeAuthUC(t, verifier, userRepo)
+
+		verifier.On("VerifyAndExtractSubject", ctx, "stale_token").
+			Return("333", 1, nil)
+		userRepo.On("FindByID", ctx, 333).
+			Return(&domain.User{ID: 333, IsAdmin: true, TokenVersion: 2}, nil)
+
+		principal, err := uc.Authenticate(ctx, "stale_token")
+
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "token revoked")
+		assert.Equal(t, 0, principal.UserID)
+	})
+
+	t.Run("success - token version matches", func(t *testing.T) {
+		verifier := jwtmock.NewMockTokenVerifier(t)
+		userRepo := usermock.NewMockRepository(t)
+
+		uc := makeAuthUC(t, verifier, userRepo)
+
+		verifier.On("VerifyAndExtractSubject", ctx, "fresh_token").
+			Return("333", 2, nil)
+		userRepo.On("FindByID", ctx, 333).
+			Return(&domain.User{ID: 333, TokenVersion: 2}, nil)
+
+		principal, err := uc.Authenticate(ctx, "fresh_token")
+
+		assert.NoError(t, err)
+		assert.Equal(t, 333, principal.UserID)
+	})
+
 	t.Run("success - zero user ID", func(t *testing.T) {
 		verifier := jwtmock.NewMockTokenVerifier(t)
 		userRepo := usermock.NewMockRepository(t)
@@ -236,7 +271,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "zero_token").
-			Return("0", nil)
+			Return("0", 0, nil)
 		userRepo.On("FindByID", ctx, 0).
 			Return(&domain.User{
 				ID:        0,
@@ -263,7 +298,7 @@ func TestJwtUsecase_Authenticate(t *testing.T) {
 		uc := makeAuthUC(t, verifier, userRepo)
 
 		verifier.On("VerifyAndExtractSubject", ctx, "empty_subject_token").
-			Return("", nil)
+			Return("", 0, nil)
 
 		principal, err := uc.Authenticate(ctx, "empty_subject_token")
 
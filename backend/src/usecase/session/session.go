@@ -0,0 +1,218 @@
+// Package session はアクセストークンとリフレッシュトークンの発行・ローテーション・失効を扱う。
+// アクセストークンは短命の JWT、リフレッシュトークンはランダム値でサーバー側にはハッシュだけを保存する。
+// 使用済みのリフレッシュトークンが再び使われたら漏洩とみなし、同じ系列（family）をまとめて失効させる。
+package session
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"word_app/backend/config"
+	"word_app/backend/src/domain"
+	"word_app/backend/src/infrastructure/jwt"
+	sessionRepo "word_app/backend/src/infrastructure/repository/session"
+	txRepo "word_app/backend/src/infrastructure/repository/tx"
+	userRepo "word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/clock"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+// DefaultRefreshTTL は設定が無いときのリフレッシュトークンの有効期限
+const DefaultRefreshTTL = 30 * 24 * time.Hour
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+	// ExpiresIn はアクセストークンの有効期限(秒)
+	ExpiresIn int
+}
+
+type Usecase interface {
+	// Issue はサインイン時に新しい系列でトークンを発行する
+	Issue(ctx context.Context, userID int) (*TokenPair, error)
+	// Refresh はリフレッシュトークンを使用済みにして、同じ系列の新しいトークンを発行する
+	Refresh(ctx context.Context, refreshToken string) (*TokenPair, error)
+	// Logout はリフレッシュトークンの系列を失効させる。未知のトークンでもエラーにしない
+	Logout(ctx context.Context, refreshToken string) error
+	// LogoutAll はユーザーの全リフレッシュトークンを失効させ、発行済みのアクセストークンも無効にする
+	LogoutAll(ctx context.Context, userID int) error
+}
+
+type SessionUsecase struct {
+	txm        txRepo.Manager
+	sessions   sessionRepo.Repository
+	users      userRepo.Repository
+	jwtGen     jwt.JWTGenerator
+	clock      clock.Clock
+	refreshTTL time.Duration
+}
+
+func NewUsecase(
+	txm txRepo.Manager,
+	s sessionRepo.Repository,
+	u userRepo.Repository,
+	jwtGen jwt.JWTGenerator,
+	c clock.Clock,
+	cfg *config.JWTCfg,
+) *SessionUsecase {
+	ttl := time.Duration(cfg.RefreshTTLDays) * 24 * time.Hour
+	if ttl <= 0 {
+		ttl = DefaultRefreshTTL
+	}
+	return &SessionUsecase{txm: txm, sessions: s, users: u, jwtGen: jwtGen, clock: c, refreshTTL: ttl}
+}
+
+var errInvalidRefresh = ucerr.Unauthorized("invalid refresh token")
+
+func (uc *SessionUsecase) Issue(ctx context.Context, userID int) (*TokenPair, error) {
+	u, err := uc.activeUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.issue(ctx, u, uuid.NewString(), uc.clock.Now())
+}
+
+func (uc *SessionUsecase) Refresh(ctx context.Context, refreshToken string) (*TokenPair, error) {
+	now := uc.clock.Now()
+	t, err := uc.find(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil || t.RevokedAt != nil || !now.Before(t.ExpiresAt) {
+		return nil, errInvalidRefresh
+	}
+	// 使用済みのトークンが再び来た＝どこかで複製されている
+	if t.UsedAt != nil {
+		return nil, uc.revokeFamily(ctx, t.FamilyID, now)
+	}
+	u, err := uc.activeUser(ctx, t.UserID)
+	if err != nil {
+		if apperror.IsKind(err, apperror.Unauthorized) {
+			return nil, uc.revokeFamily(ctx, t.FamilyID, now)
+		}
+		return nil, err
+	}
+
+	var pair *TokenPair
+	reused := false
+	err = uc.txm.WithTx(ctx, func(ctx context.Context) error {
+		ok, err := uc.sessions.MarkUsed(ctx, t.ID, now)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// 同じトークンで同時にリフレッシュされた
+			reused = true
+			return nil
+		}
+		pair, err = uc.issue(ctx, u, t.FamilyID, now)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reused {
+		return nil, uc.revokeFamily(ctx, t.FamilyID, now)
+	}
+	return pair, nil
+}
+
+func (uc *SessionUsecase) Logout(ctx context.Context, refreshToken string) error {
+	t, err := uc.find(ctx, refreshToken)
+	if err != nil || t == nil {
+		return err
+	}
+	return uc.sessions.RevokeFamily(ctx, t.FamilyID, uc.clock.Now())
+}
+
+func (uc *SessionUsecase) LogoutAll(ctx context.Context, userID int) error {
+	if err := uc.sessions.RevokeAllByUser(ctx, userID, uc.clock.Now()); err != nil {
+		return err
+	}
+	return uc.users.BumpTokenVersion(ctx, userID)
+}
+
+// ---------- helpers ----------
+
+func (uc *SessionUsecase) issue(ctx context.Context, u *domain.User, familyID string, now time.Time) (*TokenPair, error) {
+	access, err := uc.jwtGen.GenerateJWT(strconv.Itoa(u.ID), u.TokenVersion)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := newRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.sessions.Create(ctx, &domain.RefreshToken{
+		UserID:    u.ID,
+		FamilyID:  familyID,
+		TokenHash: hashToken(raw),
+		ExpiresAt: now.Add(uc.refreshTTL),
+	}); err != nil {
+		return nil, err
+	}
+	return &TokenPair{
+		AccessToken:  access,
+		RefreshToken: raw,
+		ExpiresIn:    int(uc.jwtGen.TTL().Seconds()),
+	}, nil
+}
+
+// find はトークンに対応するレコードを返す。見つからなければ (nil, nil)
+func (uc *SessionUsecase) find(ctx context.Context, raw string) (*domain.RefreshToken, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	t, err := uc.sessions.FindByHash(ctx, hashToken(raw))
+	if err != nil {
+		if apperror.IsKind(err, apperror.NotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// activeUser は削除済みのユーザーを Unauthorized にする
+func (uc *SessionUsecase) activeUser(ctx context.Context, userID int) (*domain.User, error) {
+	u, err := uc.users.FindByID(ctx, userID)
+	if err != nil {
+		if apperror.IsKind(err, apperror.NotFound) {
+			return nil, ucerr.Unauthorized("Unauthorized")
+		}
+		return nil, err
+	}
+	if u.DeletedAt != nil {
+		return nil, ucerr.Unauthorized("Unauthorized")
+	}
+	return u, nil
+}
+
+// revokeFamily は系列を失効させ、呼び出し元にはトークン不正として返す
+func (uc *SessionUsecase) revokeFamily(ctx context.Context, familyID string, now time.Time) error {
+	if err := uc.sessions.RevokeFamily(ctx, familyID, now); err != nil {
+		return err
+	}
+	return errInvalidRefresh
+}
+
+func newRefreshToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,222 @@
+package session_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"word_app/backend/config"
+	"word_app/backend/src/domain"
+	jwtmock "word_app/backend/src/mocks/infrastructure/jwt"
+	sessionmock "word_app/backend/src/mocks/infrastructure/repository/session"
+	txmock "word_app/backend/src/mocks/infrastructure/repository/tx"
+	usermock "word_app/backend/src/mocks/infrastructure/repository/user"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/session"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+var now = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+type mocks struct {
+	tm       *txmock.MockManager
+	sessions *sessionmock.MockRepository
+	users    *usermock.MockRepository
+	jwtGen   *jwtmock.MockJWTGenerator
+}
+
+func newUsecase(t *testing.T) (*session.SessionUsecase, *mocks) {
+	m := &mocks{
+		tm:       txmock.NewMockManager(t),
+		sessions: sessionmock.NewMockRepository(t),
+		users:    usermock.NewMockRepository(t),
+		jwtGen:   jwtmock.NewMockJWTGenerator(t),
+	}
+	// WithTx はコールバックをそのまま実行する
+	m.tm.On("WithTx", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) }).
+		Maybe()
+	m.jwtGen.On("TTL").Return(15 * time.Minute).Maybe()
+	uc := session.NewUsecase(m.tm, m.sessions, m.users, m.jwtGen, fixedClock{now}, &config.JWTCfg{RefreshTTLDays: 30})
+	return uc, m
+}
+
+func hashOf(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+// expectCreate は保存されたリフレッシュトークンを受け取る
+func expectCreate(m *mocks, saved **domain.RefreshToken) {
+	m.sessions.On("Create", mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) { *saved = args.Get(1).(*domain.RefreshToken) }).
+		Return(func(_ context.Context, t *domain.RefreshToken) *domain.RefreshToken { return t }, nil).Once()
+}
+
+func isUnauthorized(t *testing.T, err error) {
+	t.Helper()
+	assert.True(t, apperror.IsKind(err, apperror.Unauthorized), "%v", err)
+}
+
+func TestIssue(t *testing.T) {
+	ctx := context.Background()
+	uc, m := newUsecase(t)
+	m.users.On("FindByID", mock.Anything, 7).Return(&domain.User{ID: 7, TokenVersion: 2}, nil)
+	m.jwtGen.On("GenerateJWT", "7", 2).Return("access", nil)
+	var saved *domain.RefreshToken
+	expectCreate(m, &saved)
+
+	pair, err := uc.Issue(ctx, 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, "access", pair.AccessToken)
+	assert.Equal(t, 900, pair.ExpiresIn)
+	require.NotEmpty(t, pair.RefreshToken)
+	// 本体は保存せずハッシュだけ
+	assert.Equal(t, hashOf(pair.RefreshToken), saved.TokenHash)
+	assert.NotEqual(t, pair.RefreshToken, saved.TokenHash)
+	assert.NotEmpty(t, saved.FamilyID)
+	assert.Equal(t, now.Add(30*24*time.Hour), saved.ExpiresAt)
+}
+
+func TestIssue_DeletedUser(t *testing.T) {
+	uc, m := newUsecase(t)
+	deleted := now.Add(-time.Hour)
+	m.users.On("FindByID", mock.Anything, 7).Return(&domain.User{ID: 7, DeletedAt: &deleted}, nil)
+
+	_, err := uc.Issue(context.Background(), 7)
+	isUnauthorized(t, err)
+}
+
+func TestRefresh_Rotates(t *testing.T) {
+	ctx := context.Background()
+	uc, m := newUsecase(t)
+	m.sessions.On("FindByHash", mock.Anything, hashOf("old")).Return(&domain.RefreshToken{
+		ID: 1, UserID: 7, FamilyID: "fam", ExpiresAt: now.Add(time.Hour),
+	}, nil)
+	m.users.On("FindByID", mock.Anything, 7).Return(&domain.User{ID: 7, TokenVersion: 3}, nil)
+	m.sessions.On("MarkUsed", mock.Anything, 1, now).Return(true, nil).Once()
+	m.jwtGen.On("GenerateJWT", "7", 3).Return("access2", nil)
+	var saved *domain.RefreshToken
+	expectCreate(m, &saved)
+
+	pair, err := uc.Refresh(ctx, "old")
+
+	require.NoError(t, err)
+	assert.Equal(t, "access2", pair.AccessToken)
+	assert.NotEqual(t, "old", pair.RefreshToken)
+	assert.Equal(t, "fam", saved.FamilyID, "rotation stays in the same family")
+	assert.Equal(t, hashOf(pair.RefreshToken), saved.TokenHash)
+}
+
+func TestRefresh_ReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("使用済みのトークンが再び来た", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		used := now.Add(-time.Minute)
+		m.sessions.On("FindByHash", mock.Anything, hashOf("old")).Return(&domain.RefreshToken{
+			ID: 1, UserID: 7, FamilyID: "fam", ExpiresAt: now.Add(time.Hour), UsedAt: &used,
+		}, nil)
+		m.sessions.On("RevokeFamily", mock.Anything, "fam", now).Return(nil).Once()
+
+		_, err := uc.Refresh(ctx, "old")
+		isUnauthorized(t, err)
+		m.sessions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("同時に使われて先を越された", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.sessions.On("FindByHash", mock.Anything, hashOf("old")).Return(&domain.RefreshToken{
+			ID: 1, UserID: 7, FamilyID: "fam", ExpiresAt: now.Add(time.Hour),
+		}, nil)
+		m.users.On("FindByID", mock.Anything, 7).Return(&domain.User{ID: 7}, nil)
+		m.sessions.On("MarkUsed", mock.Anything, 1, now).Return(false, nil).Once()
+		m.sessions.On("RevokeFamily", mock.Anything, "fam", now).Return(nil).Once()
+
+		_, err := uc.Refresh(ctx, "old")
+		isUnauthorized(t, err)
+		m.sessions.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("削除済みユーザー", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		deleted := now.Add(-time.Hour)
+		m.sessions.On("FindByHash", mock.Anything, hashOf("old")).Return(&domain.RefreshToken{
+			ID: 1, UserID: 7, FamilyID: "fam", ExpiresAt: now.Add(time.Hour),
+		}, nil)
+		m.users.On("FindByID", mock.Anything, 7).Return(&domain.User{ID: 7, DeletedAt: &deleted}, nil)
+		m.sessions.On("RevokeFamily", mock.Anything, "fam", now).Return(nil).Once()
+
+		_, err := uc.Refresh(ctx, "old")
+		isUnauthorized(t, err)
+	})
+}
+
+func TestRefresh_Rejected(t *testing.T) {
+	ctx := context.Background()
+	revoked := now.Add(-time.Minute)
+	cases := []struct {
+		name  string
+		token *domain.RefreshToken
+		err   error
+	}{
+		{"未知のトークン", nil, apperror.NotFoundf("refresh token not found", nil)},
+		{"期限切れ", &domain.RefreshToken{ID: 1, UserID: 7, FamilyID: "fam", ExpiresAt: now}, nil},
+		{"失効済み", &domain.RefreshToken{ID: 1, UserID: 7, FamilyID: "fam", ExpiresAt: now.Add(time.Hour), RevokedAt: &revoked}, nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			uc, m := newUsecase(t)
+			m.sessions.On("FindByHash", mock.Anything, hashOf("old")).Return(tc.token, tc.err)
+
+			_, err := uc.Refresh(ctx, "old")
+			isUnauthorized(t, err)
+			m.sessions.AssertNotCalled(t, "RevokeFamily", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("空のトークン", func(t *testing.T) {
+		uc, _ := newUsecase(t)
+		_, err := uc.Refresh(ctx, "")
+		isUnauthorized(t, err)
+	})
+}
+
+func TestLogout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("系列を失効させる", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.sessions.On("FindByHash", mock.Anything, hashOf("rt")).
+			Return(&domain.RefreshToken{ID: 1, UserID: 7, FamilyID: "fam"}, nil)
+		m.sessions.On("RevokeFamily", mock.Anything, "fam", now).Return(nil).Once()
+
+		require.NoError(t, uc.Logout(ctx, "rt"))
+	})
+
+	t.Run("未知のトークンでもエラーにしない", func(t *testing.T) {
+		uc, m := newUsecase(t)
+		m.sessions.On("FindByHash", mock.Anything, hashOf("rt")).
+			Return(nil, apperror.NotFoundf("refresh token not found", nil))
+
+		require.NoError(t, uc.Logout(ctx, "rt"))
+	})
+}
+
+func TestLogoutAll(t *testing.T) {
+	uc, m := newUsecase(t)
+	m.sessions.On("RevokeAllByUser", mock.Anything, 7, now).Return(nil).Once()
+	m.users.On("BumpTokenVersion", mock.Anything, 7).Return(nil).Once()
+
+	require.NoError(t, uc.LogoutAll(context.Background(), 7))
+}
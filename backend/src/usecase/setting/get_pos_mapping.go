@@ -0,0 +1,38 @@
+package settinguc
+
+import (
+	"context"
+
+	"word_app/backend/src/domain/posmap"
+	posmappingRepo "word_app/backend/src/infrastructure/repository/posmapping"
+	userRepo "word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+type InputGetPosMapping struct {
+	UserID int
+}
+
+type GetPosMapping interface {
+	Execute(ctx context.Context, in InputGetPosMapping) (*posmap.Mapping, error)
+}
+
+type GetPosMappingInteractor struct {
+	userRepo userRepo.Repository
+	posRepo  posmappingRepo.Repository
+}
+
+func NewGetPosMapping(u userRepo.Repository, p posmappingRepo.Repository) *GetPosMappingInteractor {
+	return &GetPosMappingInteractor{userRepo: u, posRepo: p}
+}
+
+func (uc *GetPosMappingInteractor) Execute(ctx context.Context, in InputGetPosMapping) (*posmap.Mapping, error) {
+	ok, err := uc.userRepo.IsRoot(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ucerr.Unauthorized("unauthorized")
+	}
+	return uc.posRepo.Latest(ctx)
+}
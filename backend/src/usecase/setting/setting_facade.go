@@ -5,6 +5,7 @@ import (
 	"context"
 
 	"word_app/backend/src/domain"
+	"word_app/backend/src/domain/posmap"
 )
 
 // Clean Architecture的に構造体は定義しつつ、使用時にはまとめて使用する
@@ -21,6 +22,8 @@ type SettingFacade interface {
 	GetUser(ctx context.Context, in InputGetUserConfig) (*OutputGetUserConfig, error)
 	UpdateRoot(ctx context.Context, in InputUpdateRootConfig) (*domain.RootConfig, error)
 	UpdateUser(ctx context.Context, in InputUpdateUserConfig) (*domain.UserConfig, error)
+	GetPosMapping(ctx context.Context, in InputGetPosMapping) (*posmap.Mapping, error)
+	UpdatePosMapping(ctx context.Context, in InputUpdatePosMapping) (*OutputUpdatePosMapping, error)
 }
 
 type settingFacade struct {
@@ -30,6 +33,8 @@ type settingFacade struct {
 	getUser          GetUserConfig
 	updateRoot       UpdateRootConfig
 	updateUser       UpdateUserConfig
+	getPosMapping    GetPosMapping
+	updatePosMapping UpdatePosMapping
 }
 
 func NewSettingFacade(
@@ -39,8 +44,10 @@ func NewSettingFacade(
 	gu GetUserConfig,
 	ur UpdateRootConfig,
 	uu UpdateUserConfig,
+	gp GetPosMapping,
+	up UpdatePosMapping,
 ) SettingFacade {
-	return &settingFacade{gr, grc, gu, ur, uu}
+	return &settingFacade{gr, grc, gu, ur, uu, gp, up}
 }
 
 // ↓ 各メソッドは単に委譲
@@ -67,3 +74,11 @@ func (f *settingFacade) UpdateRoot(ctx context.Context, in InputUpdateRootConfig
 func (f *settingFacade) UpdateUser(ctx context.Context, in InputUpdateUserConfig) (*domain.UserConfig, error) {
 	return f.updateUser.Execute(ctx, in)
 }
+
+func (f *settingFacade) GetPosMapping(ctx context.Context, in InputGetPosMapping) (*posmap.Mapping, error) {
+	return f.getPosMapping.Execute(ctx, in)
+}
+
+func (f *settingFacade) UpdatePosMapping(ctx context.Context, in InputUpdatePosMapping) (*OutputUpdatePosMapping, error) {
+	return f.updatePosMapping.Execute(ctx, in)
+}
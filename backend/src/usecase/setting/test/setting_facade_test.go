@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"word_app/backend/src/domain"
+	"word_app/backend/src/domain/posmap"
 	settingUc "word_app/backend/src/usecase/setting"
 
 	// mockery 生成パッケージを調整
@@ -39,6 +40,8 @@ func TestSettingFacade_GetRuntimeConfig(t *testing.T) {
 			mockSetting.NewMockGetUserConfig(t),
 			mockSetting.NewMockUpdateRootConfig(t),
 			mockSetting.NewMockUpdateUserConfig(t),
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		got, err := f.GetRuntimeConfig(ctx)
 		assert.NoError(t, err)
@@ -57,6 +60,8 @@ func TestSettingFacade_GetRuntimeConfig(t *testing.T) {
 			mockSetting.NewMockGetUserConfig(t),
 			mockSetting.NewMockUpdateRootConfig(t),
 			mockSetting.NewMockUpdateUserConfig(t),
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		_, err := f.GetRuntimeConfig(ctx)
 		assert.ErrorIs(t, err, errFoo)
@@ -81,6 +86,8 @@ func TestSettingFacade_GetRoot(t *testing.T) {
 			gu,
 			mockSetting.NewMockUpdateRootConfig(t),
 			mockSetting.NewMockUpdateUserConfig(t),
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		out, err := f.GetUser(ctx, in)
 		assert.NoError(t, err)
@@ -98,6 +105,8 @@ func TestSettingFacade_GetRoot(t *testing.T) {
 			gu,
 			mockSetting.NewMockUpdateRootConfig(t),
 			mockSetting.NewMockUpdateUserConfig(t),
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		_, err := f.GetUser(ctx, in)
 		assert.ErrorIs(t, err, errFoo)
@@ -122,6 +131,8 @@ func TestSettingFacade_UpdateRoot(t *testing.T) {
 			mockSetting.NewMockGetUserConfig(t),
 			ur,
 			mockSetting.NewMockUpdateUserConfig(t),
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		out, err := f.UpdateRoot(ctx, in)
 		assert.NoError(t, err)
@@ -139,6 +150,8 @@ func TestSettingFacade_UpdateRoot(t *testing.T) {
 			mockSetting.NewMockGetUserConfig(t),
 			ur,
 			mockSetting.NewMockUpdateUserConfig(t),
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		_, err := f.UpdateRoot(ctx, in)
 		assert.ErrorIs(t, err, errFoo)
@@ -163,6 +176,8 @@ func TestSettingFacade_UpdateUser(t *testing.T) {
 			mockSetting.NewMockGetUserConfig(t),
 			mockSetting.NewMockUpdateRootConfig(t),
 			uu,
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		out, err := f.UpdateUser(ctx, in)
 		assert.NoError(t, err)
@@ -180,9 +195,40 @@ func TestSettingFacade_UpdateUser(t *testing.T) {
 			mockSetting.NewMockGetUserConfig(t),
 			mockSetting.NewMockUpdateRootConfig(t),
 			uu,
+			mockSetting.NewMockGetPosMapping(t),
+			mockSetting.NewMockUpdatePosMapping(t),
 		)
 		_, err := f.UpdateUser(ctx, in)
 		assert.ErrorIs(t, err, errFoo)
 		uu.AssertExpectations(t)
 	}
 }
+
+/* -------------------------------------------------------------------------- */
+/*                                 PosMapping                                 */
+/* -------------------------------------------------------------------------- */
+
+func TestSettingFacade_PosMapping(t *testing.T) {
+	gp := mockSetting.NewMockGetPosMapping(t)
+	up := mockSetting.NewMockUpdatePosMapping(t)
+	getIn := settingUc.InputGetPosMapping{UserID: 1}
+	updIn := settingUc.InputUpdatePosMapping{UserID: 1, Codes: map[string]int{"v5k": 3}, Fallback: 12}
+	gp.On("Execute", ctx, getIn).Return(&posmap.Mapping{Version: 1}, nil)
+	up.On("Execute", ctx, updIn).Return((*settingUc.OutputUpdatePosMapping)(nil), errFoo)
+
+	f := settingUc.NewSettingFacade(
+		mockSetting.NewMockGetRootConfig(t),
+		mockSetting.NewMockGetRuntimeConfig(t),
+		mockSetting.NewMockGetUserConfig(t),
+		mockSetting.NewMockUpdateRootConfig(t),
+		mockSetting.NewMockUpdateUserConfig(t),
+		gp,
+		up,
+	)
+	got, err := f.GetPosMapping(ctx, getIn)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got.Version)
+
+	_, err = f.UpdatePosMapping(ctx, updIn)
+	assert.ErrorIs(t, err, errFoo)
+}
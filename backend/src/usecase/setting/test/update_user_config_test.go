@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"word_app/backend/src/domain"
+	"word_app/backend/src/usecase/apperror"
 	settingUc "word_app/backend/src/usecase/setting"
 
 	mockRepo "word_app/backend/src/mocks/infrastructure/repository/setting"
@@ -89,3 +90,41 @@ func TestUpdateUserConfigInteractor_Execute(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateUserConfigInteractor_SrsAlgorithm(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("不正なアルゴリズム -> BadRequest (Tx は開始しない)", func(t *testing.T) {
+		tx := mockTx.NewMockManager(t)
+		repo := mockRepo.NewMockUserConfigRepository(t)
+
+		uc := settingUc.NewUpdateUserConfig(tx, repo)
+		out, err := uc.Execute(ctx, settingUc.InputUpdateUserConfig{UserID: 42, SrsAlgorithm: "leitner"})
+
+		assert.Error(t, err)
+		assert.True(t, apperror.IsKind(err, apperror.BadRequest))
+		assert.Nil(t, out)
+	})
+
+	t.Run("fsrs をそのままリポジトリへ渡す", func(t *testing.T) {
+		tx := mockTx.NewMockManager(t)
+		repo := mockRepo.NewMockUserConfigRepository(t)
+
+		tx.
+			On("WithTx", ctx, mock.AnythingOfType("func(context.Context) error")).
+			Run(func(args mock.Arguments) {
+				cb := args.Get(1).(func(context.Context) error)
+				_ = cb(context.Background())
+			}).
+			Return(nil)
+		repo.
+			On("Upsert", mock.Anything, &domain.UserConfig{UserID: 42, SrsAlgorithm: "fsrs"}).
+			Return(&domain.UserConfig{UserID: 42, SrsAlgorithm: "fsrs"}, nil)
+
+		uc := settingUc.NewUpdateUserConfig(tx, repo)
+		out, err := uc.Execute(ctx, settingUc.InputUpdateUserConfig{UserID: 42, SrsAlgorithm: "fsrs"})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "fsrs", out.SrsAlgorithm)
+	})
+}
@@ -0,0 +1,74 @@
+package settinguc
+
+import (
+	"context"
+
+	"word_app/backend/src/domain/posmap"
+	posmappingRepo "word_app/backend/src/infrastructure/repository/posmapping"
+	"word_app/backend/src/infrastructure/repository/tx"
+	userRepo "word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+// InputUpdatePosMapping は対応表の全体（差分ではない）。保存すると新しい版になる。
+type InputUpdatePosMapping struct {
+	UserID   int
+	Codes    map[string]int `json:"codes"`
+	Fallback int            `json:"fallback_pos_id"`
+	Note     string         `json:"note"`
+}
+
+// OutputUpdatePosMapping は保存した版と、前の版から品詞が変わるコード。
+// 既存の WordInfo には cmd/reclassify_pos を実行するまで反映されない。
+type OutputUpdatePosMapping struct {
+	Mapping *posmap.Mapping `json:"mapping"`
+	Changes []posmap.Change `json:"changes"`
+}
+
+type UpdatePosMapping interface {
+	Execute(ctx context.Context, in InputUpdatePosMapping) (*OutputUpdatePosMapping, error)
+}
+
+type UpdatePosMappingInteractor struct {
+	Tx       tx.Manager
+	userRepo userRepo.Repository
+	posRepo  posmappingRepo.Repository
+}
+
+func NewUpdatePosMapping(t tx.Manager, u userRepo.Repository, p posmappingRepo.Repository) *UpdatePosMappingInteractor {
+	return &UpdatePosMappingInteractor{Tx: t, userRepo: u, posRepo: p}
+}
+
+func (uc *UpdatePosMappingInteractor) Execute(ctx context.Context, in InputUpdatePosMapping) (*OutputUpdatePosMapping, error) {
+	ok, err := uc.userRepo.IsRoot(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ucerr.Unauthorized("unauthorized")
+	}
+
+	next := &posmap.Mapping{Codes: in.Codes, Fallback: in.Fallback, Note: in.Note, CreatedBy: &in.UserID}
+	if err := next.Validate(); err != nil {
+		return nil, ucerr.Validation(err.Error())
+	}
+
+	out := &OutputUpdatePosMapping{}
+	err = uc.Tx.WithTx(ctx, func(txCtx context.Context) error {
+		prev, err := uc.posRepo.Latest(txCtx)
+		if err != nil {
+			return err
+		}
+		saved, err := uc.posRepo.Create(txCtx, next)
+		if err != nil {
+			return err
+		}
+		out.Mapping = saved
+		out.Changes = prev.Diff(saved)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -4,13 +4,16 @@ import (
 	"context"
 
 	"word_app/backend/src/domain"
+	"word_app/backend/src/domain/srs"
 	settingRepo "word_app/backend/src/infrastructure/repository/setting"
 	"word_app/backend/src/infrastructure/repository/tx"
+	"word_app/backend/src/usecase/shared/ucerr"
 )
 
 type InputUpdateUserConfig struct {
-	UserID     int  `json:"user_id"`      // 取得済み (Auth MW 等で)
-	IsDarkMode bool `json:"is_dark_mode"` // true=ダーク・false=ライト
+	UserID       int    `json:"user_id"`       // 取得済み (Auth MW 等で)
+	IsDarkMode   bool   `json:"is_dark_mode"`  // true=ダーク・false=ライト
+	SrsAlgorithm string `json:"srs_algorithm"` // 空なら変更しない
 }
 
 type UpdateUserConfigInteractor struct {
@@ -27,9 +30,12 @@ func NewUpdateUserConfig(tx tx.Manager, u settingRepo.UserConfigRepository) *Upd
 }
 
 func (uc *UpdateUserConfigInteractor) Execute(ctx context.Context, in InputUpdateUserConfig) (*domain.UserConfig, error) {
+	if in.SrsAlgorithm != "" && !srs.IsValidAlgorithm(in.SrsAlgorithm) {
+		return nil, ucerr.BadRequest("srs_algorithm must be sm2 or fsrs")
+	}
 	var out *domain.UserConfig
 	err := uc.Tx.WithTx(ctx, func(txCtx context.Context) error {
-		cfg := &domain.UserConfig{UserID: in.UserID, IsDarkMode: in.IsDarkMode}
+		cfg := &domain.UserConfig{UserID: in.UserID, IsDarkMode: in.IsDarkMode, SrsAlgorithm: in.SrsAlgorithm}
 		var err error
 		out, err = uc.userRepo.Upsert(txCtx, cfg) // ← txCtx を渡す
 		return err
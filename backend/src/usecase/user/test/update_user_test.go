@@ -73,6 +73,7 @@ func TestUpdateUser_SelfUpdateNameEmail_Success(t *testing.T) {
 		}
 		return *f.Name == "Alice" &&
 			*f.Email == "alice@example.com" &&
+			f.ClearEmailVerified && // メールが変わったので確認をやり直す
 			f.PasswordHash == nil && f.SetAdmin == nil
 	})
 	updated := &domain.User{
@@ -108,7 +109,8 @@ func TestUpdateUser_RootUpdatesRoleToAdmin_Success(t *testing.T) {
 
 	match := mock.MatchedBy(func(f *repository.UserUpdateFields) bool {
 		return f != nil && f.SetAdmin != nil && *f.SetAdmin == true &&
-			f.Name == nil && f.Email == nil && f.PasswordHash == nil
+			f.Name == nil && f.Email == nil && f.PasswordHash == nil &&
+			f.BumpTokenVersion // 役割が変わったので発行済みのトークンは無効
 	})
 	after := *target
 	after.IsAdmin = true
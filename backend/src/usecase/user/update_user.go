@@ -100,6 +100,10 @@ func (uc *UserUsecase) buildUpdateFields(editor, target *domain.User, in UpdateU
 			return nil, err
 		}
 		out.Email = &e
+		// 別のアドレスに変えたら確認をやり直す
+		if target.Email == nil || *target.Email != e {
+			out.ClearEmailVerified = true
+		}
 	}
 
 	// password
@@ -118,6 +122,8 @@ func (uc *UserUsecase) buildUpdateFields(editor, target *domain.User, in UpdateU
 			return nil, err
 		}
 		out.SetAdmin = &admin
+		// 役割が変わったら発行済みのアクセストークンを無効にし、次のリフレッシュで新しい役割を反映させる
+		out.BumpTokenVersion = admin != target.IsAdmin
 	}
 
 	return out, nil
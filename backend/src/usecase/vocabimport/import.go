@@ -0,0 +1,169 @@
+package vocabimport
+
+import (
+	"context"
+	"fmt"
+)
+
+func (uc *vocabImportUsecase) Preview(ctx context.Context, in Input) (*Result, error) {
+	rows, nameToID, err := uc.prepare(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.classify(ctx, in.UserID, rows, nameToID); err != nil {
+		return nil, err
+	}
+	return newResult(false, rows), nil
+}
+
+func (uc *vocabImportUsecase) Commit(ctx context.Context, in Input) (*Result, error) {
+	rows, nameToID, err := uc.prepare(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	err = uc.txm.WithTx(ctx, func(txCtx context.Context) error {
+		// bulk_register と同じく、ユーザー単位で直列化してから残り枠を数える
+		if err := uc.locker.LockByID(txCtx, in.UserID); err != nil {
+			return err
+		}
+		if err := uc.classify(txCtx, in.UserID, rows, nameToID); err != nil {
+			return err
+		}
+		for i := range rows {
+			uc.apply(txCtx, in.UserID, &rows[i], nameToID[rows[i].Word])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return newResult(true, rows), nil
+}
+
+// prepare はファイルを読み、単語マスタの ID を引く
+func (uc *vocabImportUsecase) prepare(ctx context.Context, in Input) ([]Row, map[string]int, error) {
+	rows, err := parseRows(in.Data, in.Format, uc.maxRows())
+	if err != nil {
+		return nil, nil, err
+	}
+	names := make([]string, 0, len(rows))
+	seen := make(map[string]bool, len(rows))
+	for _, r := range rows {
+		if r.Status == "" && !seen[r.Word] {
+			seen[r.Word] = true
+			names = append(names, r.Word)
+		}
+	}
+	nameToID := map[string]int{}
+	if len(names) > 0 {
+		if nameToID, err = uc.wordsRead.FindIDsByNames(ctx, names); err != nil {
+			return nil, nil, err
+		}
+	}
+	return rows, nameToID, nil
+}
+
+// classify は行の並び順に判定する。同じ単語は最初の行だけを取り込み、
+// 新規登録と登録の戻しは残り枠を使い切った時点で over_limit にする。
+func (uc *vocabImportUsecase) classify(ctx context.Context, userID int, rows []Row, nameToID map[string]int) error {
+	curActive, err := uc.rwRead.CountActiveByUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	remain := uc.maxTotal() - curActive
+
+	ids := make([]int, 0, len(nameToID))
+	for _, id := range nameToID {
+		ids = append(ids, id)
+	}
+	existMap, err := uc.rwRead.FindActiveMapByUserAndWordIDs(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+
+	firstLine := make(map[string]int, len(rows))
+	for i := range rows {
+		r := &rows[i]
+		if r.Status == StatusInvalid {
+			continue
+		}
+		if line, dup := firstLine[r.Word]; dup {
+			r.Status, r.Detail = StatusDuplicate, fmt.Sprintf("same word on line %d", line)
+			continue
+		}
+		firstLine[r.Word] = r.Line
+
+		wordID, ok := nameToID[r.Word]
+		if !ok {
+			r.Status = StatusUnknown
+			continue
+		}
+		isActive, had := existMap[wordID]
+		switch {
+		case had && isActive && (r.Memo != nil || r.AttentionLevel != nil):
+			r.Status = StatusUpdate
+		case had && isActive:
+			r.Status = StatusUnchanged
+		case remain <= 0:
+			r.Status = StatusOverLimit
+		case had:
+			r.Status = StatusReactivate
+			remain--
+		default:
+			r.Status = StatusNew
+			remain--
+		}
+	}
+	return nil
+}
+
+// apply は判定済みの 1 行を保存する。
+// DB 例外は bulk_register と同じく行の失敗（db_error）に丸めて続ける。
+func (uc *vocabImportUsecase) apply(ctx context.Context, userID int, r *Row, wordID int) {
+	var err error
+	switch r.Status {
+	case StatusNew:
+		err = uc.rwWrite.CreateActive(ctx, userID, wordID)
+	case StatusReactivate:
+		err = uc.rwWrite.Activate(ctx, userID, wordID)
+	case StatusUpdate:
+	default:
+		return
+	}
+	if err == nil {
+		err = uc.rwWrite.UpdateNote(ctx, userID, wordID, r.Memo, r.AttentionLevel)
+	}
+	if err != nil {
+		r.Status, r.Detail = StatusFailed, "could not save"
+	}
+}
+
+func newResult(committed bool, rows []Row) *Result {
+	res := &Result{Committed: committed, Rows: rows}
+	s := &res.Summary
+	s.Total = len(rows)
+	for _, r := range rows {
+		switch r.Status {
+		case StatusNew:
+			s.New++
+		case StatusReactivate:
+			s.Reactivated++
+		case StatusUpdate:
+			s.Updated++
+		case StatusUnchanged:
+			s.Unchanged++
+		case StatusUnknown:
+			s.Unknown++
+		case StatusDuplicate:
+			s.Duplicates++
+		case StatusOverLimit:
+			s.OverLimit++
+		case StatusInvalid:
+			s.Invalid++
+		case StatusFailed:
+			s.Failed++
+		}
+	}
+	return res
+}
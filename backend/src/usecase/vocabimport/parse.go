@@ -0,0 +1,158 @@
+package vocabimport
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+// 取り込みファイルの形式
+const (
+	FormatCSV  = "csv"
+	FormatTSV  = "tsv"
+	FormatAuto = "" // 1 行目にタブがあれば TSV、なければ CSV
+)
+
+// RegisteredWord.memo の上限（ent のスキーマ検証と同じくバイト数）
+const maxMemoBytes = 200
+
+// 見出し行として認める列名。見出しが無ければ「単語, メモ, 注意度」の順とみなす
+var headerAliases = map[string]string{
+	"word":            "word",
+	"単語":              "word",
+	"memo":            "memo",
+	"note":            "memo",
+	"メモ":              "memo",
+	"attention":       "attention",
+	"attention_level": "attention",
+	"attentionlevel":  "attention",
+	"注意度":             "attention",
+}
+
+type columns struct{ word, memo, attention int }
+
+var positional = columns{word: 0, memo: 1, attention: 2}
+
+// parseRows はファイルを行に分解する。行ごとの不備は StatusInvalid の行として返し、
+// ファイル全体として読めないとき（形式不正・行数超過）だけエラーにする。
+func parseRows(data []byte, format string, maxRows int) ([]Row, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf")) // Excel が付ける BOM
+	comma, err := separator(data, format)
+	if err != nil {
+		return nil, err
+	}
+
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = comma
+	r.Comment = '#'
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	r.TrimLeadingSpace = true
+
+	cols := positional
+	rows := make([]Row, 0)
+	first := true
+	for {
+		rec, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, ucerr.Validation(fmt.Sprintf("cannot parse file: %v", err))
+		}
+		line, _ := r.FieldPos(0)
+		if first {
+			first = false
+			if c, ok := headerColumns(rec); ok {
+				cols = c
+				continue
+			}
+		}
+		if blank(rec) {
+			continue
+		}
+		if len(rows) >= maxRows {
+			return nil, ucerr.Validation(fmt.Sprintf("too many rows (limit %d)", maxRows))
+		}
+		rows = append(rows, toRow(line, rec, cols))
+	}
+	if len(rows) == 0 {
+		return nil, ucerr.Validation("no rows to import")
+	}
+	return rows, nil
+}
+
+func separator(data []byte, format string) (rune, error) {
+	switch format {
+	case FormatCSV:
+		return ',', nil
+	case FormatTSV:
+		return '\t', nil
+	case FormatAuto:
+		firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+		if bytes.ContainsRune(firstLine, '\t') {
+			return '\t', nil
+		}
+		return ',', nil
+	}
+	return 0, ucerr.Validation(fmt.Sprintf("unsupported format %q (csv, tsv)", format))
+}
+
+// headerColumns は 1 行目が見出しなら列の位置を返す
+func headerColumns(rec []string) (columns, bool) {
+	cols := columns{word: -1, memo: -1, attention: -1}
+	for i, cell := range rec {
+		switch headerAliases[strings.ToLower(strings.TrimSpace(cell))] {
+		case "word":
+			cols.word = i
+		case "memo":
+			cols.memo = i
+		case "attention":
+			cols.attention = i
+		}
+	}
+	return cols, cols.word >= 0
+}
+
+func blank(rec []string) bool {
+	for _, cell := range rec {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func cell(rec []string, i int) string {
+	if i < 0 || i >= len(rec) {
+		return ""
+	}
+	return strings.TrimSpace(rec[i])
+}
+
+func toRow(line int, rec []string, cols columns) Row {
+	row := Row{Line: line, Word: strings.ToLower(cell(rec, cols.word))}
+	if row.Word == "" {
+		return row.invalid("word is empty")
+	}
+	if memo := cell(rec, cols.memo); memo != "" {
+		if len(memo) > maxMemoBytes {
+			return row.invalid(fmt.Sprintf("memo must be under %d bytes", maxMemoBytes))
+		}
+		row.Memo = &memo
+	}
+	if s := cell(rec, cols.attention); s != "" {
+		level, err := strconv.Atoi(s)
+		if err != nil || level < 1 || level > 5 {
+			return row.invalid("attention level must be between 1 and 5")
+		}
+		row.AttentionLevel = &level
+	}
+	return row
+}
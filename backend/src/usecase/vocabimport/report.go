@@ -0,0 +1,25 @@
+package vocabimport
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// WriteReport は取り込めなかった行（単語マスタに無い・上限超過・重複・不正・保存失敗）を CSV で書き出す
+func WriteReport(w io.Writer, res *Result) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"line", "word", "status", "detail"}); err != nil {
+		return err
+	}
+	for _, r := range res.Rows {
+		if !r.IsConflict() {
+			continue
+		}
+		if err := cw.Write([]string{strconv.Itoa(r.Line), r.Word, r.Status, r.Detail}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
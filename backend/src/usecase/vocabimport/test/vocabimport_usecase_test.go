@@ -0,0 +1,166 @@
+package vocabimport_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"testing"
+
+	"word_app/backend/config"
+	regmock "word_app/backend/src/mocks/infrastructure/repository/registeredword"
+	txmock "word_app/backend/src/mocks/infrastructure/repository/tx"
+	usermock "word_app/backend/src/mocks/infrastructure/repository/user"
+	wordmock "word_app/backend/src/mocks/infrastructure/repository/word"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/vocabimport"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type mocks struct {
+	words   *wordmock.MockReadRepository
+	rwRead  *regmock.MockReadRepository
+	rwWrite *regmock.MockWriteRepository
+	tm      *txmock.MockManager
+	users   *usermock.MockRepository
+}
+
+func newUC(t *testing.T, limits *config.LimitsCfg) (vocabimport.Usecase, *mocks) {
+	m := &mocks{
+		words:   wordmock.NewMockReadRepository(t),
+		rwRead:  regmock.NewMockReadRepository(t),
+		rwWrite: regmock.NewMockWriteRepository(t),
+		tm:      txmock.NewMockManager(t),
+		users:   usermock.NewMockRepository(t),
+	}
+	// WithTx はコールバックをそのまま実行する
+	m.tm.On("WithTx", mock.Anything, mock.AnythingOfType("func(context.Context) error")).
+		Return(func(ctx context.Context, f func(context.Context) error) error { return f(ctx) }).
+		Maybe()
+	return vocabimport.NewUsecase(m.words, m.rwRead, m.rwWrite, m.tm, m.users, limits), m
+}
+
+func statuses(res *vocabimport.Result) []string {
+	out := make([]string, 0, len(res.Rows))
+	for _, r := range res.Rows {
+		out = append(out, r.Status)
+	}
+	return out
+}
+
+// 単語マスタに apple(1) banana(2) cherry(3) がある。
+// apple は登録中、banana は解除済み
+func expectLookups(m *mocks, active int) {
+	m.words.On("FindIDsByNames", mock.Anything, mock.Anything).
+		Return(map[string]int{"apple": 1, "banana": 2, "cherry": 3}, nil)
+	m.rwRead.On("CountActiveByUser", mock.Anything, 7).Return(active, nil)
+	m.rwRead.On("FindActiveMapByUserAndWordIDs", mock.Anything, 7, mock.Anything).
+		Return(map[int]bool{1: true, 2: false}, nil)
+}
+
+func TestVocabImport_Preview(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("見出し付き CSV を行ごとに判定し、何も保存しない", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{RegisteredWordsPerUser: 10})
+		expectLookups(m, 1)
+
+		data := "\xef\xbb\xbfword,memo,attention\n" +
+			"Apple,赤い,3\n" + // 登録済み + メモ → update
+			"banana,,\n" + // 解除済み → reactivate
+			"cherry\n" + // → new
+			"durian,,\n" + // マスタに無い
+			"apple,,\n" + // 重複
+			"egg,,9\n" // 注意度が不正
+		res, err := uc.Preview(ctx, vocabimport.Input{UserID: 7, Data: []byte(data)})
+		require.NoError(t, err)
+
+		assert.False(t, res.Committed)
+		assert.Equal(t, []string{
+			vocabimport.StatusUpdate, vocabimport.StatusReactivate, vocabimport.StatusNew,
+			vocabimport.StatusUnknown, vocabimport.StatusDuplicate, vocabimport.StatusInvalid,
+		}, statuses(res))
+		assert.Equal(t, 2, res.Rows[0].Line)
+		assert.Equal(t, "apple", res.Rows[0].Word)
+		assert.Equal(t, "same word on line 2", res.Rows[4].Detail)
+		assert.Equal(t, vocabimport.Summary{Total: 6, New: 1, Reactivated: 1, Updated: 1, Unknown: 1, Duplicates: 1, Invalid: 1}, res.Summary)
+		m.users.AssertNotCalled(t, "LockByID", mock.Anything, mock.Anything)
+	})
+
+	t.Run("見出しの無い TSV は 単語・メモ・注意度 の順。残り枠を超えた行は over_limit", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{RegisteredWordsPerUser: 2})
+		expectLookups(m, 1)
+
+		res, err := uc.Preview(ctx, vocabimport.Input{UserID: 7, Data: []byte("cherry\tメモ\t2\nbanana\n")})
+		require.NoError(t, err)
+		assert.Equal(t, []string{vocabimport.StatusNew, vocabimport.StatusOverLimit}, statuses(res))
+		assert.Equal(t, "メモ", *res.Rows[0].Memo)
+		assert.Equal(t, 2, *res.Rows[0].AttentionLevel)
+	})
+
+	t.Run("ファイルとして読めなければ Validation", func(t *testing.T) {
+		uc, _ := newUC(t, &config.LimitsCfg{VocabImportMaxRows: 2})
+		for name, in := range map[string]vocabimport.Input{
+			"空":      {UserID: 7, Data: []byte("word,memo\n")},
+			"行が多すぎる": {UserID: 7, Data: []byte("a\nb\nc\n")},
+			"形式が不正":  {UserID: 7, Format: "xlsx", Data: []byte("a\n")},
+		} {
+			_, err := uc.Preview(ctx, in)
+			assert.True(t, apperror.IsKind(err, apperror.Validation), "%s: %v", name, err)
+		}
+	})
+}
+
+func TestVocabImport_Commit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ロックしてから判定し、行ごとに保存する", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{RegisteredWordsPerUser: 10})
+		m.users.On("LockByID", mock.Anything, 7).Return(nil)
+		expectLookups(m, 1)
+
+		memo := "赤い"
+		m.rwWrite.On("UpdateNote", mock.Anything, 7, 1, &memo, (*int)(nil)).Return(nil)
+		m.rwWrite.On("Activate", mock.Anything, 7, 2).Return(nil)
+		m.rwWrite.On("UpdateNote", mock.Anything, 7, 2, (*string)(nil), (*int)(nil)).Return(nil)
+		m.rwWrite.On("CreateActive", mock.Anything, 7, 3).Return(assert.AnError)
+
+		res, err := uc.Commit(ctx, vocabimport.Input{UserID: 7, Format: vocabimport.FormatCSV, Data: []byte("apple,赤い\nbanana\ncherry\n")})
+		require.NoError(t, err)
+
+		assert.True(t, res.Committed)
+		assert.Equal(t, []string{vocabimport.StatusUpdate, vocabimport.StatusReactivate, vocabimport.StatusFailed}, statuses(res))
+		assert.Equal(t, 1, res.Summary.Failed)
+	})
+
+	t.Run("ロックに失敗したら保存しない", func(t *testing.T) {
+		uc, m := newUC(t, &config.LimitsCfg{})
+		m.words.On("FindIDsByNames", mock.Anything, []string{"apple"}).Return(map[string]int{"apple": 1}, nil)
+		m.users.On("LockByID", mock.Anything, 7).Return(assert.AnError)
+
+		_, err := uc.Commit(ctx, vocabimport.Input{UserID: 7, Data: []byte("apple\n")})
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+}
+
+func TestWriteReport(t *testing.T) {
+	res := &vocabimport.Result{Rows: []vocabimport.Row{
+		{Line: 1, Word: "apple", Status: vocabimport.StatusNew},
+		{Line: 2, Word: "durian", Status: vocabimport.StatusUnknown},
+		{Line: 3, Word: "apple", Status: vocabimport.StatusDuplicate, Detail: "same word on line 1"},
+		{Line: 4, Word: "fig", Status: vocabimport.StatusOverLimit},
+	}}
+	var buf bytes.Buffer
+	require.NoError(t, vocabimport.WriteReport(&buf, res))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{
+		{"line", "word", "status", "detail"},
+		{"2", "durian", "unknown_word", ""},
+		{"3", "apple", "duplicate", "same word on line 1"},
+		{"4", "fig", "over_limit", ""},
+	}, rows)
+}
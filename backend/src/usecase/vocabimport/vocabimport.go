@@ -0,0 +1,123 @@
+// Package vocabimport はユーザーの単語帳ファイル（CSV/TSV）を登録単語に取り込むユースケース
+package vocabimport
+
+import (
+	"context"
+
+	"word_app/backend/config"
+	"word_app/backend/src/infrastructure/repository/registeredword"
+	"word_app/backend/src/infrastructure/repository/tx"
+	"word_app/backend/src/infrastructure/repository/user"
+	"word_app/backend/src/infrastructure/repository/word"
+)
+
+// 行の判定結果
+const (
+	StatusNew        = "new"          // 新しく登録する
+	StatusReactivate = "reactivate"   // 解除済みの登録を戻す
+	StatusUpdate     = "update"       // 登録済み。メモ・注意度を上書きする
+	StatusUnchanged  = "unchanged"    // 登録済みで、変える値も無い
+	StatusUnknown    = "unknown_word" // 単語マスタに無い
+	StatusDuplicate  = "duplicate"    // ファイル内で同じ単語が先に出ている
+	StatusOverLimit  = "over_limit"   // 登録単語数の上限を超える
+	StatusInvalid    = "invalid"      // 行の値が不正
+	StatusFailed     = "db_error"     // 取り込み時に保存できなかった
+)
+
+type Input struct {
+	UserID int
+	Format string // FormatCSV / FormatTSV / FormatAuto
+	Data   []byte
+}
+
+// Row はファイルの 1 行と、その判定結果
+type Row struct {
+	Line           int     `json:"line"`
+	Word           string  `json:"word"`
+	Memo           *string `json:"memo,omitempty"`
+	AttentionLevel *int    `json:"attentionLevel,omitempty"`
+	Status         string  `json:"status"`
+	Detail         string  `json:"detail,omitempty"`
+}
+
+func (r Row) invalid(detail string) Row {
+	r.Status, r.Detail = StatusInvalid, detail
+	return r
+}
+
+// IsConflict は取り込めなかった行（競合レポートに載せる行）か
+func (r Row) IsConflict() bool {
+	switch r.Status {
+	case StatusUnknown, StatusDuplicate, StatusOverLimit, StatusInvalid, StatusFailed:
+		return true
+	}
+	return false
+}
+
+type Summary struct {
+	Total       int `json:"total"`
+	New         int `json:"new"`
+	Reactivated int `json:"reactivated"`
+	Updated     int `json:"updated"`
+	Unchanged   int `json:"unchanged"`
+	Unknown     int `json:"unknownWords"`
+	Duplicates  int `json:"duplicates"`
+	OverLimit   int `json:"overLimit"`
+	Invalid     int `json:"invalid"`
+	Failed      int `json:"failed"`
+}
+
+type Result struct {
+	Committed bool    `json:"committed"` // false ならプレビュー（何も保存していない）
+	Summary   Summary `json:"summary"`
+	Rows      []Row   `json:"rows"`
+}
+
+type Usecase interface {
+	// Preview は保存せずに各行がどう取り込まれるかを返す
+	Preview(ctx context.Context, in Input) (*Result, error)
+	// Commit はユーザーをロックしたうえで判定し直して保存する
+	Commit(ctx context.Context, in Input) (*Result, error)
+}
+
+type vocabImportUsecase struct {
+	wordsRead word.ReadRepository
+	rwRead    registeredword.ReadRepository
+	rwWrite   registeredword.WriteRepository
+	txm       tx.Manager
+	locker    user.Repository
+	limits    *config.LimitsCfg
+}
+
+func NewUsecase(
+	wordsRead word.ReadRepository,
+	rwRead registeredword.ReadRepository,
+	rwWrite registeredword.WriteRepository,
+	txm tx.Manager,
+	locker user.Repository,
+	limits *config.LimitsCfg,
+) Usecase {
+	return &vocabImportUsecase{
+		wordsRead: wordsRead,
+		rwRead:    rwRead,
+		rwWrite:   rwWrite,
+		txm:       txm,
+		locker:    locker,
+		limits:    limits,
+	}
+}
+
+func (uc *vocabImportUsecase) maxRows() int {
+	if uc.limits.VocabImportMaxRows > 0 {
+		return uc.limits.VocabImportMaxRows
+	}
+	return 2000
+}
+
+// bulk_register と同じ既定値
+func (uc *vocabImportUsecase) maxTotal() int {
+	if uc.limits.RegisteredWordsPerUser > 0 {
+		return uc.limits.RegisteredWordsPerUser
+	}
+	return 200
+}
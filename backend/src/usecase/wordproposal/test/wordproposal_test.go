@@ -0,0 +1,196 @@
+package wordproposal_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"word_app/backend/config"
+	"word_app/backend/src/domain"
+	proposalRepo "word_app/backend/src/infrastructure/repository/wordproposal"
+	wordmock "word_app/backend/src/mocks/infrastructure/repository/word"
+	proposalmock "word_app/backend/src/mocks/infrastructure/repository/wordproposal"
+	policymock "word_app/backend/src/mocks/usecase/editpolicy"
+	ucmock "word_app/backend/src/mocks/usecase/wordproposal"
+	"word_app/backend/src/models"
+	wordSvc "word_app/backend/src/service/word"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/wordproposal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+type fixedClock struct{ now time.Time }
+
+func (c fixedClock) Now() time.Time { return c.now }
+
+type mocks struct {
+	proposals *proposalmock.MockRepository
+	words     *wordmock.MockReadRepository
+	policy    *policymock.MockPolicy
+	applier   *ucmock.MockApplier
+}
+
+var now = time.Date(2026, 3, 4, 5, 6, 7, 0, time.UTC)
+
+func newUC(t *testing.T) (wordproposal.Usecase, *mocks) {
+	m := &mocks{
+		proposals: proposalmock.NewMockRepository(t),
+		words:     wordmock.NewMockReadRepository(t),
+		policy:    policymock.NewMockPolicy(t),
+		applier:   ucmock.NewMockApplier(t),
+	}
+	uc := wordproposal.NewUsecase(m.proposals, m.words, m.policy, m.applier,
+		fixedClock{now}, &config.LimitsCfg{WordProposalMaxPending: 2})
+	return uc, m
+}
+
+func TestProposalUsecase_Submit(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("直接編集できるユーザーや拒否されるユーザーは提案しない", func(t *testing.T) {
+		for _, d := range []domain.MasterEdit{domain.MasterEditAllow, domain.MasterEditDeny} {
+			uc, m := newUC(t)
+			m.policy.On("Decide", mock.Anything, 1).Return(d, nil)
+
+			_, err := uc.Submit(ctx, wordproposal.InputSubmit{UserID: 1, Action: domain.ProposalActionCreate,
+				Create: &models.CreateWordRequest{Name: "apple"}})
+			assert.True(t, apperror.IsKind(err, apperror.Unauthorized), "%v", err)
+		}
+	})
+
+	t.Run("削除の提案は対象の名前を記録し、payload に userId を残さない", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditModerate, nil)
+		m.words.On("NameByID", mock.Anything, 10).Return("apple", nil)
+		m.proposals.On("CountPendingByUser", mock.Anything, 1).Return(1, nil)
+		m.proposals.On("Create", mock.Anything, mock.MatchedBy(func(p *domain.WordProposal) bool {
+			return p.UserID == 1 && p.Action == domain.ProposalActionDelete &&
+				*p.WordID == 10 && p.WordName == "apple" &&
+				string(p.Payload) == `{"id":10,"userId":0}`
+		})).Return(&domain.WordProposal{ID: 5}, nil)
+
+		p, err := uc.Submit(ctx, wordproposal.InputSubmit{UserID: 1, Action: domain.ProposalActionDelete,
+			Delete: &models.DeleteWordRequest{WordID: 10, UserID: 1}})
+		require.NoError(t, err)
+		assert.Equal(t, 5, p.ID)
+	})
+
+	t.Run("承認待ちの上限", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditModerate, nil)
+		m.proposals.On("CountPendingByUser", mock.Anything, 1).Return(2, nil)
+
+		_, err := uc.Submit(ctx, wordproposal.InputSubmit{UserID: 1, Action: domain.ProposalActionCreate,
+			Create: &models.CreateWordRequest{Name: "apple"}})
+		assert.True(t, apperror.IsKind(err, apperror.TooManyRequests), "%v", err)
+	})
+}
+
+func TestProposalUsecase_List(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("審査できないユーザーには自分の提案だけ", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Decide", mock.Anything, 1).Return(domain.MasterEditModerate, nil)
+		m.proposals.On("List", mock.Anything, proposalRepo.ListFilter{UserID: 1, Status: "pending", Offset: 50, Limit: 50}).
+			Return([]*domain.WordProposal{}, nil)
+
+		_, err := uc.List(ctx, wordproposal.InputList{UserID: 1, Status: "pending", Page: 2})
+		require.NoError(t, err)
+	})
+
+	t.Run("審査できるユーザーには全員分", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Decide", mock.Anything, 2).Return(domain.MasterEditAllow, nil)
+		m.proposals.On("List", mock.Anything, proposalRepo.ListFilter{Limit: 50}).Return([]*domain.WordProposal{}, nil)
+
+		_, err := uc.List(ctx, wordproposal.InputList{UserID: 2})
+		require.NoError(t, err)
+	})
+
+	t.Run("不正な状態", func(t *testing.T) {
+		uc, _ := newUC(t)
+		_, err := uc.List(ctx, wordproposal.InputList{UserID: 1, Status: "done"})
+		assert.True(t, apperror.IsKind(err, apperror.BadRequest), "%v", err)
+	})
+}
+
+func TestProposalUsecase_Approve(t *testing.T) {
+	ctx := context.Background()
+	pending := &domain.WordProposal{ID: 5, UserID: 1, Action: domain.ProposalActionCreate, WordName: "apple",
+		Payload: []byte(`{"name":"apple","wordInfos":[],"userId":0}`), Status: domain.ProposalStatusPending}
+
+	t.Run("審査した人の権限で反映し、作成された Word を記録する", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Authorize", mock.Anything, 2).Return(nil)
+		m.proposals.On("FindByID", mock.Anything, 5).Return(pending, nil).Once()
+		m.proposals.On("Review", mock.Anything, 5, 2, domain.ProposalStatusApproved, "", now).Return(true, nil)
+		m.applier.On("CreateWord", mock.Anything, mock.MatchedBy(func(r *models.CreateWordRequest) bool {
+			return r.Name == "apple" && r.UserID == 2
+		})).Return(&models.CreateWordResponse{ID: 42, Name: "apple"}, nil)
+		m.proposals.On("SetWordID", mock.Anything, 5, 42).Return(nil)
+		m.proposals.On("FindByID", mock.Anything, 5).Return(&domain.WordProposal{ID: 5, Status: domain.ProposalStatusApproved}, nil).Once()
+
+		p, err := uc.Approve(ctx, wordproposal.InputReview{ReviewerID: 2, ProposalID: 5})
+		require.NoError(t, err)
+		assert.Equal(t, domain.ProposalStatusApproved, p.Status)
+	})
+
+	t.Run("反映に失敗したら承認待ちに戻す", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Authorize", mock.Anything, 2).Return(nil)
+		m.proposals.On("FindByID", mock.Anything, 5).Return(pending, nil)
+		m.proposals.On("Review", mock.Anything, 5, 2, domain.ProposalStatusApproved, "", now).Return(true, nil)
+		m.applier.On("CreateWord", mock.Anything, mock.Anything).Return(nil, wordSvc.ErrWordExists)
+		m.proposals.On("Reopen", mock.Anything, 5).Return(nil)
+
+		_, err := uc.Approve(ctx, wordproposal.InputReview{ReviewerID: 2, ProposalID: 5})
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "%v", err)
+	})
+
+	t.Run("審査済みなら Conflict で、反映しない", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Authorize", mock.Anything, 2).Return(nil)
+		m.proposals.On("FindByID", mock.Anything, 5).Return(pending, nil)
+		m.proposals.On("Review", mock.Anything, 5, 2, domain.ProposalStatusApproved, "", now).Return(false, nil)
+
+		_, err := uc.Approve(ctx, wordproposal.InputReview{ReviewerID: 2, ProposalID: 5})
+		assert.True(t, apperror.IsKind(err, apperror.Conflict), "%v", err)
+		m.applier.AssertNotCalled(t, "CreateWord", mock.Anything, mock.Anything)
+	})
+
+	t.Run("審査できないユーザー", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Authorize", mock.Anything, 1).Return(apperror.Unauthorizedf("unauthorized", nil))
+
+		_, err := uc.Approve(ctx, wordproposal.InputReview{ReviewerID: 1, ProposalID: 5})
+		assert.True(t, apperror.IsKind(err, apperror.Unauthorized), "%v", err)
+	})
+}
+
+func TestProposalUsecase_Reject(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("メモ付きで却下する", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Authorize", mock.Anything, 2).Return(nil)
+		m.proposals.On("FindByID", mock.Anything, 5).Return(&domain.WordProposal{ID: 5}, nil)
+		m.proposals.On("Review", mock.Anything, 5, 2, domain.ProposalStatusRejected, "duplicate", now).Return(true, nil)
+
+		_, err := uc.Reject(ctx, wordproposal.InputReview{ReviewerID: 2, ProposalID: 5, Note: "duplicate"})
+		require.NoError(t, err)
+	})
+
+	t.Run("存在しない提案", func(t *testing.T) {
+		uc, m := newUC(t)
+		m.policy.On("Authorize", mock.Anything, 2).Return(nil)
+		m.proposals.On("FindByID", mock.Anything, 9).Return(nil, apperror.NotFoundf("proposal not found", errors.New("x")))
+
+		_, err := uc.Reject(ctx, wordproposal.InputReview{ReviewerID: 2, ProposalID: 9})
+		assert.True(t, apperror.IsKind(err, apperror.NotFound), "%v", err)
+	})
+}
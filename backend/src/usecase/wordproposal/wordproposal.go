@@ -0,0 +1,281 @@
+// Package wordproposal は一般ユーザーからの単語マスタ変更の提案と、その審査のユースケース。
+// RootConfig.editing_permission が "user" のときに使う。
+package wordproposal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"word_app/backend/config"
+	"word_app/backend/src/domain"
+	wordRepo "word_app/backend/src/infrastructure/repository/word"
+	proposalRepo "word_app/backend/src/infrastructure/repository/wordproposal"
+	"word_app/backend/src/models"
+	wordSvc "word_app/backend/src/service/word"
+	"word_app/backend/src/usecase/apperror"
+	"word_app/backend/src/usecase/clock"
+	"word_app/backend/src/usecase/editpolicy"
+	"word_app/backend/src/usecase/shared/ucerr"
+)
+
+const (
+	maxNoteLen = 500
+	pageSize   = 50
+)
+
+// Applier は承認した提案を単語マスタへ反映する（service/word が満たす）
+type Applier interface {
+	CreateWord(ctx context.Context, req *models.CreateWordRequest) (*models.CreateWordResponse, error)
+	UpdateWord(ctx context.Context, req *models.UpdateWordRequest) (*models.UpdateWordResponse, error)
+	DeleteWord(ctx context.Context, req *models.DeleteWordRequest) (*models.DeleteWordResponse, error)
+}
+
+type Usecase interface {
+	// Submit は変更を提案として保存する。直接反映できるユーザーは使わない
+	Submit(ctx context.Context, in InputSubmit) (*domain.WordProposal, error)
+	// List は審査できるユーザーには全員分、それ以外には自分の提案を返す
+	List(ctx context.Context, in InputList) ([]*domain.WordProposal, error)
+	Approve(ctx context.Context, in InputReview) (*domain.WordProposal, error)
+	Reject(ctx context.Context, in InputReview) (*domain.WordProposal, error)
+}
+
+// InputSubmit は Action に応じて Create / Update / Delete のどれか 1 つを持つ
+type InputSubmit struct {
+	UserID int
+	Action string
+	Create *models.CreateWordRequest
+	Update *models.UpdateWordRequest
+	Delete *models.DeleteWordRequest
+}
+
+type InputList struct {
+	UserID int
+	Status string // 空なら全状態
+	Page   int    // 1 始まり
+}
+
+type InputReview struct {
+	ReviewerID int
+	ProposalID int
+	Note       string
+}
+
+type proposalUsecase struct {
+	proposals proposalRepo.Repository
+	words     wordRepo.ReadRepository
+	policy    editpolicy.Policy
+	applier   Applier
+	clock     clock.Clock
+	limits    *config.LimitsCfg
+}
+
+func NewUsecase(
+	proposals proposalRepo.Repository,
+	words wordRepo.ReadRepository,
+	policy editpolicy.Policy,
+	applier Applier,
+	clock clock.Clock,
+	limits *config.LimitsCfg,
+) Usecase {
+	return &proposalUsecase{
+		proposals: proposals,
+		words:     words,
+		policy:    policy,
+		applier:   applier,
+		clock:     clock,
+		limits:    limits,
+	}
+}
+
+func (uc *proposalUsecase) maxPending() int {
+	if uc.limits != nil && uc.limits.WordProposalMaxPending > 0 {
+		return uc.limits.WordProposalMaxPending
+	}
+	return 20
+}
+
+func (uc *proposalUsecase) Submit(ctx context.Context, in InputSubmit) (*domain.WordProposal, error) {
+	d, err := uc.policy.Decide(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if d != domain.MasterEditModerate {
+		return nil, ucerr.Unauthorized("unauthorized")
+	}
+
+	p := &domain.WordProposal{UserID: in.UserID, Action: in.Action}
+	var body any
+	switch in.Action {
+	case domain.ProposalActionCreate:
+		if in.Create == nil {
+			return nil, ucerr.BadRequest("create request is required")
+		}
+		p.WordName = in.Create.Name
+		req := *in.Create
+		req.UserID = 0 // 反映するときは審査した人の ID を入れる
+		body = req
+	case domain.ProposalActionUpdate:
+		if in.Update == nil {
+			return nil, ucerr.BadRequest("update request is required")
+		}
+		if _, err := uc.words.NameByID(ctx, in.Update.ID); err != nil {
+			return nil, err
+		}
+		p.WordID = &in.Update.ID
+		p.WordName = in.Update.Name
+		req := *in.Update
+		req.UserID = 0
+		body = req
+	case domain.ProposalActionDelete:
+		if in.Delete == nil {
+			return nil, ucerr.BadRequest("delete request is required")
+		}
+		name, err := uc.words.NameByID(ctx, in.Delete.WordID)
+		if err != nil {
+			return nil, err
+		}
+		p.WordID = &in.Delete.WordID
+		p.WordName = name
+		req := *in.Delete
+		req.UserID = 0
+		body = req
+	default:
+		return nil, ucerr.BadRequest("invalid action")
+	}
+
+	n, err := uc.proposals.CountPendingByUser(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if n >= uc.maxPending() {
+		return nil, ucerr.TooManyRequests("too many pending proposals")
+	}
+
+	if p.Payload, err = json.Marshal(body); err != nil {
+		return nil, ucerr.Internal("failed to encode proposal", err)
+	}
+	return uc.proposals.Create(ctx, p)
+}
+
+func (uc *proposalUsecase) List(ctx context.Context, in InputList) ([]*domain.WordProposal, error) {
+	if in.Status != "" && !domain.IsValidProposalStatus(in.Status) {
+		return nil, ucerr.BadRequest("invalid status")
+	}
+	page := max(in.Page, 1)
+	f := proposalRepo.ListFilter{Status: in.Status, Offset: (page - 1) * pageSize, Limit: pageSize}
+
+	d, err := uc.policy.Decide(ctx, in.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if d != domain.MasterEditAllow {
+		f.UserID = in.UserID
+	}
+	return uc.proposals.List(ctx, f)
+}
+
+func (uc *proposalUsecase) Approve(ctx context.Context, in InputReview) (*domain.WordProposal, error) {
+	p, err := uc.claim(ctx, in, domain.ProposalStatusApproved)
+	if err != nil {
+		return nil, err
+	}
+
+	// 反映は審査した人の権限で行う。失敗したら審査前に戻す
+	wordID, err := uc.apply(ctx, in.ReviewerID, p)
+	if err != nil {
+		if rerr := uc.proposals.Reopen(ctx, p.ID); rerr != nil {
+			return nil, errors.Join(err, rerr)
+		}
+		return nil, err
+	}
+	if p.Action == domain.ProposalActionCreate {
+		if err := uc.proposals.SetWordID(ctx, p.ID, wordID); err != nil {
+			return nil, err
+		}
+	}
+	return uc.proposals.FindByID(ctx, p.ID)
+}
+
+func (uc *proposalUsecase) Reject(ctx context.Context, in InputReview) (*domain.WordProposal, error) {
+	p, err := uc.claim(ctx, in, domain.ProposalStatusRejected)
+	if err != nil {
+		return nil, err
+	}
+	return uc.proposals.FindByID(ctx, p.ID)
+}
+
+// claim は審査できるかを確かめ、pending の提案を status にする
+func (uc *proposalUsecase) claim(ctx context.Context, in InputReview, status string) (*domain.WordProposal, error) {
+	if len([]rune(in.Note)) > maxNoteLen {
+		return nil, ucerr.Validation("note is too long")
+	}
+	if err := uc.policy.Authorize(ctx, in.ReviewerID); err != nil {
+		return nil, err
+	}
+	p, err := uc.proposals.FindByID(ctx, in.ProposalID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := uc.proposals.Review(ctx, p.ID, in.ReviewerID, status, in.Note, uc.clock.Now())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, ucerr.Conflict("proposal is already reviewed")
+	}
+	return p, nil
+}
+
+// apply は提案を単語マスタへ反映し、対象の Word ID を返す
+func (uc *proposalUsecase) apply(ctx context.Context, reviewerID int, p *domain.WordProposal) (int, error) {
+	switch p.Action {
+	case domain.ProposalActionCreate:
+		var req models.CreateWordRequest
+		if err := json.Unmarshal(p.Payload, &req); err != nil {
+			return 0, ucerr.Internal("broken proposal", err)
+		}
+		req.UserID = reviewerID
+		res, err := uc.applier.CreateWord(ctx, &req)
+		if err != nil {
+			return 0, applyErr(err)
+		}
+		return res.ID, nil
+	case domain.ProposalActionUpdate:
+		var req models.UpdateWordRequest
+		if err := json.Unmarshal(p.Payload, &req); err != nil {
+			return 0, ucerr.Internal("broken proposal", err)
+		}
+		req.UserID = reviewerID
+		res, err := uc.applier.UpdateWord(ctx, &req)
+		if err != nil {
+			return 0, applyErr(err)
+		}
+		return res.ID, nil
+	case domain.ProposalActionDelete:
+		var req models.DeleteWordRequest
+		if err := json.Unmarshal(p.Payload, &req); err != nil {
+			return 0, ucerr.Internal("broken proposal", err)
+		}
+		req.UserID = reviewerID
+		if _, err := uc.applier.DeleteWord(ctx, &req); err != nil {
+			return 0, applyErr(err)
+		}
+		return req.WordID, nil
+	}
+	return 0, ucerr.Internal("unknown proposal action", nil)
+}
+
+// applyErr は service/word の素のエラーを apperror に寄せる
+func applyErr(err error) error {
+	var ae *apperror.Error
+	switch {
+	case errors.As(err, &ae):
+		return err
+	case errors.Is(err, wordSvc.ErrWordExists):
+		return ucerr.Conflict("there is already a word with the same name")
+	case errors.Is(err, wordSvc.ErrWordNotFound):
+		return ucerr.NotFound("word not found")
+	}
+	return ucerr.Internal("failed to apply proposal", err)
+}
@@ -0,0 +1,24 @@
+package oauthutil
+
+import (
+	"errors"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+)
+
+var ErrNonceMismatch = errors.New("oidc: nonce mismatch")
+
+// CheckNonce は ID トークンの nonce がログイン開始時の値と一致するかを確かめる
+func CheckNonce(idTok *oidc.IDToken, expected string) error {
+	var cl struct {
+		Nonce string `json:"nonce"`
+	}
+	if err := idTok.Claims(&cl); err != nil {
+		return err
+	}
+	// cookie が無い（ログイン開始を経ていない）ときは、nonce の無いトークンでも通さない
+	if expected == "" || cl.Nonce != expected {
+		return ErrNonceMismatch
+	}
+	return nil
+}
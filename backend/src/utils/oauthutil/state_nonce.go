@@ -4,10 +4,10 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-// cookie 名は固定文字列で OK
+// cookie 名は固定文字列で OK（どのプロバイダのログインでも同じ名前を使う）
 const (
-	stateCookie     = "line_oauth_state"
-	nonceCookie     = "line_oauth_nonce"
+	stateCookie     = "oauth_state"
+	nonceCookie     = "oauth_nonce"
 	cookieMaxAgeSec = 300 // 5 分
 )
 
@@ -31,7 +31,14 @@ func NewNonce(c *gin.Context) (string, error) {
 	return n, nil
 }
 
-// loadNonce (state も同様に必要なら) -----
+// loadState / loadNonce -----
+
+// LoadState はログイン開始時に cookie に置いた state を取り出し、cookie は失効させる
+func LoadState(c *gin.Context) string {
+	val, _ := c.Cookie(stateCookie)
+	writeCookie(c, stateCookie, "", -1)
+	return val
+}
 
 func LoadNonce(c *gin.Context) string {
 	val, _ := c.Cookie(nonceCookie)
@@ -0,0 +1,53 @@
+package tempjwt
+
+import (
+	"errors"
+	"time"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// メールリンク用トークンの用途
+const (
+	PurposeVerifyEmail   = "verify_email"
+	PurposeResetPassword = "reset_password"
+)
+
+var ErrInvalidAction = errors.New("invalid action token")
+
+// Action はメール確認・パスワード再設定リンクに載せるクレーム。
+// Fingerprint は発行時のユーザー状態から作る値で、状態が変わる（＝一度使われる）と一致しなくなる。
+type Action struct {
+	Purpose     string `json:"purpose"`
+	UserID      int    `json:"uid"`
+	Fingerprint string `json:"fp"`
+	jwt.RegisteredClaims
+}
+
+func (t *TempJWT) GenerateAction(a *Action, ttl time.Duration) (string, error) {
+	now := time.Now()
+	a.Audience = jwt.ClaimStrings{AudienceAction}
+	a.IssuedAt = jwt.NewNumericDate(now)
+	a.ExpiresAt = jwt.NewNumericDate(now.Add(ttl))
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, a)
+	return token.SignedString(t.secret)
+}
+
+// ParseAction は署名・期限・用途を検証する。Fingerprint の照合は呼び出し側で行う。
+func (t *TempJWT) ParseAction(tok, purpose string) (*Action, error) {
+	var a Action
+	_, err := jwt.ParseWithClaims(tok, &a, func(tk *jwt.Token) (interface{}, error) {
+		if tk.Method != jwt.SigningMethodHS256 {
+			return nil, ErrInvalidAction
+		}
+		return t.secret, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// LINE 連携の一時トークンなど、同じ鍵で署名された別用途のトークンを弾く
+	if !a.VerifyAudience(AudienceAction, true) || a.Purpose != purpose || a.UserID <= 0 || a.Fingerprint == "" {
+		return nil, ErrInvalidAction
+	}
+	return &a, nil
+}
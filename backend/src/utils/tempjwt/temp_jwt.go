@@ -1,16 +1,35 @@
 package tempjwt
 
 import (
+	"errors"
 	"time"
 
 	jwt "github.com/golang-jwt/jwt/v4"
 )
 
+// 一時トークンは TEMP_JWT_SECRET で署名し、用途ごとに aud を分ける。
+// アクセストークンとは鍵も aud も違うので、取り違えて受け付けることはない
+const (
+	AudienceSocialSignUp = "word_app/social_signup"
+	AudienceAction       = "word_app/action"
+)
+
+var (
+	ErrInvalidTemp = errors.New("invalid temp token")
+	// 空の鍵でも HS256 の署名・検証は通ってしまうので、作る時点で弾く
+	ErrEmptySecret = errors.New("temp token secret is empty")
+)
+
 type TempJWT struct {
 	secret []byte
 }
 
-func New(secret string) *TempJWT { return &TempJWT{secret: []byte(secret)} }
+func New(secret string) (*TempJWT, error) {
+	if secret == "" {
+		return nil, ErrEmptySecret
+	}
+	return &TempJWT{secret: []byte(secret)}, nil
+}
 
 type Identity struct {
 	Provider string  `json:"provider"`
@@ -21,6 +40,7 @@ type Identity struct {
 }
 
 func (t *TempJWT) GenerateTemp(id *Identity, ttl time.Duration) (string, error) {
+	id.Audience = jwt.ClaimStrings{AudienceSocialSignUp}
 	id.ExpiresAt = jwt.NewNumericDate(time.Now().Add(ttl))
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, id)
 	return token.SignedString(t.secret)
@@ -28,9 +48,15 @@ func (t *TempJWT) GenerateTemp(id *Identity, ttl time.Duration) (string, error)
 
 func (t *TempJWT) ParseTemp(tok string) (*Identity, error) {
 	var id Identity
-	_, err := jwt.ParseWithClaims(tok, &id, func(_ *jwt.Token) (interface{}, error) {
+	_, err := jwt.ParseWithClaims(tok, &id, func(tk *jwt.Token) (interface{}, error) {
+		if tk.Method != jwt.SigningMethodHS256 {
+			return nil, ErrInvalidTemp
+		}
 		return t.secret, nil
 	})
+	if err == nil && !id.VerifyAudience(AudienceSocialSignUp, true) {
+		err = ErrInvalidTemp
+	}
 	authID := &Identity{
 		Provider:         id.Provider,
 		Subject:          id.Subject,
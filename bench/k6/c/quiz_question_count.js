@@ -0,0 +1,73 @@
+import { check } from "k6";
+import http from "k6/http";
+import { Trend } from "k6/metrics";
+import { getToken, withAuth, isLambdaEnv } from "./helpers_c.js";
+
+// 問題数ごとのクイズ生成レイテンシを測る（誤答の一括取得・CreateBulk の効果確認用）
+// 1 iteration で各問題数を 1 回ずつ順番に生成する。
+// 実行中クイズは 1 ユーザー 1 件のため、リクエストごとに test-login で別ユーザーを使う。
+
+const baseUrl = __ENV.BASE_URL;
+const isLambda = isLambdaEnv(baseUrl);
+const counts = (__ENV.QUESTION_COUNTS || "10,25,50,100")
+  .split(",")
+  .map((s) => parseInt(s, 10));
+const difficulty = __ENV.DIFFICULTY || "normal";
+
+const latency = new Trend("quiz_new_latency", true);
+
+// 問題数ごとのサブメトリクスをサマリーに出すため、問題数ごとに閾値を定義する
+const p95Limit = isLambda ? 3000 : 1000;
+const thresholds = {
+  "http_req_failed{endpoint:quiz_new}": ["rate<0.01"],
+};
+for (const n of counts) {
+  thresholds[`quiz_new_latency{question_count:${n}}`] = [
+    `p(95)<${p95Limit}`,
+  ];
+}
+
+export const options = {
+  setupTimeout: isLambda ? "120s" : "30s",
+  scenarios: {
+    question_count: {
+      executor: "per-vu-iterations",
+      vus: 1, // 同時実行による DB 競合を除き、問題数による差だけを見る
+      iterations: parseInt(__ENV.ITERATIONS || "10", 10),
+      maxDuration: "10m",
+    },
+  },
+  thresholds,
+};
+
+export default function () {
+  for (const n of counts) {
+    const token = getToken(baseUrl);
+    const body = {
+      questionCount: n,
+      isSaveResult: false,
+      isRegisteredWords: 0,
+      correctRate: 1,
+      attentionLevelList: [],
+      partsOfSpeeches: [1],
+      isIdioms: 0,
+      isSpecialCharacters: 0,
+      difficulty,
+    };
+    const res = http.post(
+      `${baseUrl}/quizzes/new`,
+      JSON.stringify(body),
+      withAuth(token, { endpoint: "quiz_new", question_count: String(n) })
+    );
+    check(res, { "2xx": (r) => r.status >= 200 && r.status < 300 });
+    if (res.status >= 200 && res.status < 300) {
+      latency.add(res.timings.duration, { question_count: String(n) });
+    } else {
+      console.error(
+        `quiz_new NG: count=${n} status=${res.status} body=${String(
+          res.body
+        ).slice(0, 300)}`
+      );
+    }
+  }
+}
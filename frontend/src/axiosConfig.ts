@@ -1,5 +1,5 @@
 // axiosConfig.ts
-import axios from 'axios'
+import axios, { InternalAxiosRequestConfig } from 'axios'
 
 const API_BASE_URL = import.meta.env.VITE_API_URL || 'http://localhost:8080'
 
@@ -28,13 +28,63 @@ axiosInstance.interceptors.request.use(
   },
 )
 
+// サインイン系 API のレスポンスからトークンを保存する
+export const saveTokens = (data?: {
+  token?: string
+  refresh_token?: string
+}) => {
+  if (data?.token) localStorage.setItem('token', data.token)
+  if (data?.refresh_token)
+    localStorage.setItem('refreshToken', data.refresh_token)
+}
+
+// アクセストークンは短命なので、切れたらリフレッシュトークンで取り直す。
+// リフレッシュトークンは使うたびに入れ替わるため、同時に 401 が来ても問い合わせは 1 回にまとめる
+let refreshing: Promise<string | null> | null = null
+
+const refreshAccessToken = (): Promise<string | null> => {
+  const refreshToken = localStorage.getItem('refreshToken')
+  if (!refreshToken) return Promise.resolve(null)
+  if (!refreshing) {
+    refreshing = axios
+      .post(`${API_BASE_URL}/users/token/refresh`, {
+        refresh_token: refreshToken,
+      })
+      .then((res) => {
+        saveTokens(res.data)
+        return (res.data?.token as string) ?? null
+      })
+      .catch(() => {
+        localStorage.removeItem('token')
+        localStorage.removeItem('refreshToken')
+        return null
+      })
+      .finally(() => {
+        refreshing = null
+      })
+  }
+  return refreshing
+}
+
 // レスポンスインターセプター
 axiosInstance.interceptors.response.use(
   (response) => {
     // 成功時の処理
     return response
   },
-  (error) => {
+  async (error) => {
+    const original = error.config as
+      | (InternalAxiosRequestConfig & { _retry?: boolean })
+      | undefined
+    if (error.response?.status === 401 && original && !original._retry) {
+      // 1 回だけ取り直して再送する
+      original._retry = true
+      const token = await refreshAccessToken()
+      if (token) {
+        original.headers.Authorization = `Bearer ${token}`
+        return axiosInstance(original)
+      }
+    }
     if (error.response?.status === 401) {
       const isHomePage = window.location.pathname === '/' // 現在のページがトップページかを確認
       // トークン切れでトップページでなければリダイレクト
@@ -1,7 +1,7 @@
 import { useEffect } from 'react';
 import { useNavigate } from 'react-router-dom';
 
-import axios from '@/axiosConfig';
+import axios, { saveTokens } from '@/axiosConfig';
 
 const LineCallback: React.FC = () => {
   const nav = useNavigate();
@@ -10,10 +10,12 @@ const LineCallback: React.FC = () => {
     (async () => {
       const qs = new URLSearchParams(location.search);
       try {
+        // ログイン開始時に API が置いた state / nonce の cookie を送る
         const { data } = await axios.get('/users/auth/line/callback', {
           params: { code: qs.get('code'), state: qs.get('state') },
+          withCredentials: true,
         });
-        localStorage.setItem('token', data.token);
+        saveTokens(data);
         nav('/mypage');
       } catch {
         nav('/signin?err=line');
@@ -34,6 +34,7 @@ const MyPage: React.FC = () => {
 
   const signOutLocally = (msg: string) => {
     localStorage.removeItem('token')
+    localStorage.removeItem('refreshToken')
     clearTestLoginCache() // テストログインキャッシュもクリア
     localStorage.setItem('logoutMessage', msg)
     setUser(null)
@@ -41,8 +42,17 @@ const MyPage: React.FC = () => {
   }
 
   // 通常サインアウト
-  const onSignOut = () => {
+  const onSignOut = async () => {
     if (signingOut) return
+    setSigningOut(true)
+    // サーバー側のリフレッシュトークンも失効させる（失敗してもローカルは破棄）
+    const refreshToken = localStorage.getItem('refreshToken')
+    if (refreshToken) {
+      await axiosInstance
+        .post('/users/sign_out', { refresh_token: refreshToken })
+        .catch(() => undefined)
+    }
+    setSigningOut(false)
     signOutLocally('ログアウトしました')
   }
 
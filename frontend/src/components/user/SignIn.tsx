@@ -3,7 +3,7 @@ import { Input } from '@headlessui/react'
 import React, { useState } from 'react'
 import { Link, useNavigate } from 'react-router-dom'
 
-import axiosInstance from '@/axiosConfig'
+import axiosInstance, { saveTokens } from '@/axiosConfig'
 import { Card } from '@/components/ui/card'
 import { Button } from '@/components/ui/ui'
 import { useRuntimeConfig } from '@/contexts/runtimeConfig/useRuntimeConfig'
@@ -27,8 +27,7 @@ const SignIn: React.FC = () => {
         email,
         password,
       })
-      const token = res.data?.token
-      if (token) localStorage.setItem('token', token)
+      saveTokens(res.data)
       navigate('/mypage') // ルートが /my_page の場合はここを変更
     } catch (err: any) {
       const fieldError: string =
@@ -2,7 +2,7 @@
 import React, { useState } from 'react'
 import { Link, useNavigate } from 'react-router-dom'
 
-import axiosInstance from '@/axiosConfig'
+import axiosInstance, { saveTokens } from '@/axiosConfig'
 import { Card, Input } from '@/components/ui/card'
 import { Button } from '@/components/ui/ui'
 import { useTheme } from '@/contexts/themeContext'
@@ -30,8 +30,7 @@ const SignUp: React.FC = () => {
         email,
         password,
       })
-      const token = res.data?.token
-      if (token) localStorage.setItem('token', token)
+      saveTokens(res.data)
       localStorage.setItem('logoutMessage', 'サインアップしました。')
 
       // 任意：ユーザー設定からテーマ反映（失敗しても無視）
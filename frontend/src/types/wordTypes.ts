@@ -6,6 +6,7 @@ export interface PartOfSpeech {
 export interface JapaneseMean {
   id: number
   name: string
+  reading?: string // 漢字表記の読み（辞書から取り込んだ意味だけ）
 }
 
 export interface WordInfo {
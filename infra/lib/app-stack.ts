@@ -187,6 +187,7 @@ export class AppStack extends Stack {
     fn.addEnvironment("LIMIT_REGISTERED_WORDS_PER_USER", "200");
     fn.addEnvironment("LIMIT_QUIZ_MAX_PER_DAY", "20");
     fn.addEnvironment("LIMIT_QUIZ_MAX_QUESTIONS", "100");
+    fn.addEnvironment("LIMIT_QUIZ_ABANDON_MINUTES", "1440");
     fn.addEnvironment("LIMIT_BULK_MAX_PER_DAY", "5");
     fn.addEnvironment("LIMIT_BULK_MAX_BYTES", "51200");
     fn.addEnvironment("LIMIT_BULK_TOKENIZE_MAX_TOKENS", "51200");